@@ -0,0 +1,169 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// mo-cdc captures the changes of the tables from the logtail server of the
+// DN, and writes them as the Debezium change events in JSON, one event per
+// line, to stdout or to the files of the tables in a directory.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+)
+
+const (
+	defaultUsername           = "dump"
+	defaultPassword           = "111"
+	defaultHost               = "127.0.0.1"
+	defaultPort               = 6001
+	defaultLogtailAddress     = "127.0.0.1:22001"
+	defaultCheckpointFile     = "mo-cdc.checkpoint.json"
+	defaultServerName         = "matrixone"
+	defaultCheckpointInterval = time.Second
+	timeout                   = 10 * time.Second
+)
+
+func main() {
+	var (
+		username, password, host string
+		port                     int
+		logtailAddress           string
+		tableList                string
+		checkpointFile           string
+		output                   string
+		serverName               string
+		from                     string
+		fsConfig                 string
+		checkpointInterval       time.Duration
+	)
+	flag.StringVar(&username, "u", defaultUsername, "username")
+	flag.StringVar(&password, "p", defaultPassword, "password")
+	flag.StringVar(&host, "h", defaultHost, "hostname")
+	flag.IntVar(&port, "P", defaultPort, "portNumber")
+	flag.StringVar(&logtailAddress, "logtail", defaultLogtailAddress, "address of the logtail server of the DN")
+	flag.StringVar(&tableList, "tables", "", "comma separated tables like db.table, must be specified")
+	flag.StringVar(&checkpointFile, "checkpoint", defaultCheckpointFile, "file saving the positions of the tables")
+	flag.StringVar(&output, "output", "-", "directory of the change event files, - for stdout")
+	flag.StringVar(&serverName, "name", defaultServerName, "logical name of the cluster in the change events")
+	flag.StringVar(&from, "from", "", "timestamp to start the tables without positions from, e.g. the snapshot of mo-dump")
+	flag.StringVar(&fsConfig, "fileservice", "", "toml file of the shared fileservice, to read the blocks written by CN and to resume")
+	flag.DurationVar(&checkpointInterval, "checkpoint-interval", defaultCheckpointInterval, "interval to save the positions")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := run(ctx, username, password, host, port, logtailAddress, tableList,
+		checkpointFile, output, serverName, from, fsConfig, checkpointInterval); err != nil {
+		fmt.Fprintf(os.Stderr, "mo-cdc error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(
+	ctx context.Context,
+	username, password, host string,
+	port int,
+	logtailAddress, tableList, checkpointFile, output, serverName, from, fsConfig string,
+	checkpointInterval time.Duration,
+) error {
+	names, err := parseTables(ctx, tableList)
+	if err != nil {
+		return err
+	}
+	var opts []cdc.Option
+	if from != "" {
+		ts, err := timestamp.ParseTimestamp(from)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, cdc.WithStartFrom(ts))
+	}
+	if fsConfig != "" {
+		var cfg fileservice.Config
+		if _, err = toml.DecodeFile(fsConfig, &cfg); err != nil {
+			return err
+		}
+		fs, err := fileservice.NewFileService(cfg, nil)
+		if err != nil {
+			return err
+		}
+		blockio.Start()
+		defer blockio.Stop()
+		opts = append(opts, cdc.WithFileService(fs))
+	}
+	opts = append(opts, cdc.WithCheckpointInterval(checkpointInterval))
+
+	tables, err := getTables(ctx, username, password, host, port, names)
+	if err != nil {
+		return err
+	}
+
+	var sink cdc.Sink
+	if output == "-" {
+		sink = cdc.NewWriterSink(serverName, os.Stdout)
+	} else if sink, err = cdc.NewFileSink(serverName, output); err != nil {
+		return err
+	}
+	defer sink.Close()
+
+	err = cdc.NewSubscriber(logtailAddress, tables, sink,
+		cdc.NewFileCheckpointer(checkpointFile), opts...).Run(ctx)
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// getTables reads the definitions of the tables from MatrixOne.
+func getTables(ctx context.Context, username, password, host string, port int, names [][2]string) ([]*cdc.TableInfo, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/", username, password, host, port)
+	conn, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	pingCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err = conn.PingContext(pingCtx); err != nil {
+		if pingCtx.Err() != nil {
+			return nil, moerr.NewInternalError(ctx, "connect to %s timeout", dsn)
+		}
+		return nil, err
+	}
+
+	tables := make([]*cdc.TableInfo, 0, len(names))
+	for _, name := range names {
+		info, err := getTableInfo(ctx, conn, name[0], name[1])
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, info)
+	}
+	return tables, nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTables(t *testing.T) {
+	ctx := context.Background()
+	tables, err := parseTables(ctx, "db1.t1, db2.t2,")
+	require.NoError(t, err)
+	require.Equal(t, [][2]string{{"db1", "t1"}, {"db2", "t2"}}, tables)
+
+	for _, value := range []string{"", "t1", "db1.", ".t1"} {
+		_, err = parseTables(ctx, value)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// parseTables parses the comma separated list of the tables like db.table.
+func parseTables(ctx context.Context, value string) ([][2]string, error) {
+	var tables [][2]string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		db, table, ok := strings.Cut(name, ".")
+		if !ok || db == "" || table == "" {
+			return nil, moerr.NewInvalidInput(ctx, "table %s should be like db.table", name)
+		}
+		tables = append(tables, [2]string{db, table})
+	}
+	if len(tables) == 0 {
+		return nil, moerr.NewInvalidInput(ctx, "tables must be specified")
+	}
+	return tables, nil
+}
+
+// getTableInfo reads the definition of the table from the catalog.
+func getTableInfo(ctx context.Context, q queryer, db, table string) (*cdc.TableInfo, error) {
+	info := &cdc.TableInfo{
+		Database: db,
+		Table:    table,
+	}
+	var kind string
+	err := q.QueryRowContext(ctx,
+		"select rel_id, reldatabase_id, relkind from mo_catalog.mo_tables where reldatabase = '"+
+			escape(db)+"' and relname = '"+escape(table)+"'").
+		Scan(&info.TableID, &info.DatabaseID, &kind)
+	if err == sql.ErrNoRows {
+		return nil, moerr.NewNoSuchTable(ctx, db, table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if kind != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewNotSupported(ctx, "capture the changes of %s.%s of kind %s", db, table, kind)
+	}
+
+	tableID := strconv.FormatUint(info.TableID, 10)
+	r, err := q.QueryContext(ctx,
+		"select attname, atttyp, attr_seqnum, att_constraint_type, att_is_hidden, attr_enum from mo_catalog.mo_columns where att_relname_id = "+
+			tableID+" and attisdropped = 0 order by attnum")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	for r.Next() {
+		var col cdc.Column
+		var typ []byte
+		var constraint string
+		var enumValues sql.NullString
+		if err = r.Scan(&col.Name, &typ, &col.Seqnum, &constraint, &col.Hidden, &enumValues); err != nil {
+			return nil, err
+		}
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		col.Type = types.DecodeType(typ)
+		if enumValues.Valid && enumValues.String != "" {
+			if col.EnumValues, err = types.ParseEnumValues(enumValues.String); err != nil {
+				return nil, err
+			}
+		}
+		if constraint == catalog.SystemColPKConstraint {
+			info.PrimaryKey = col.Name
+		}
+		info.Columns = append(info.Columns, col)
+	}
+	if err = r.Err(); err != nil {
+		return nil, err
+	}
+	if info.PrimaryKey == "" {
+		return nil, moerr.NewInternalError(ctx, "no primary key of %s.%s", db, table)
+	}
+
+	keys, err := q.QueryContext(ctx,
+		"select column_name from mo_catalog.mo_indexes where table_id = "+
+			tableID+" and type = 'PRIMARY' order by ordinal_position")
+	if err != nil {
+		return nil, err
+	}
+	defer keys.Close()
+	for keys.Next() {
+		var name string
+		if err = keys.Scan(&name); err != nil {
+			return nil, err
+		}
+		info.PrimaryKeys = append(info.PrimaryKeys, name)
+	}
+	if err = keys.Err(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func escape(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
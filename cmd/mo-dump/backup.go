@@ -0,0 +1,459 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	// the databases managed by the system are not backed up
+	backupSkipDatabases = map[string]struct{}{
+		"mo_catalog":         {},
+		"information_schema": {},
+		"system":             {},
+		"system_metrics":     {},
+		"mysql":              {},
+		"mo_task":            {},
+	}
+	// the roles created with the account
+	builtinRoles = map[string]struct{}{
+		"moadmin":      {},
+		"accountadmin": {},
+		"public":       {},
+	}
+)
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// backup dumps all the databases of the account into dir. The snapshot
+// timestamp is read once and pinned on every connection by the session
+// variable snapshot_ts, so the tables dumped in parallel are consistent.
+// The backup of sys lists the other accounts, but their databases are not
+// dumped, see AccountInfo.
+func backup(ctx context.Context, dir string, parallel int) (*Manifest, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	m := &Manifest{
+		Version:   manifestVersion,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	err = c.QueryRowContext(ctx, "select current_snapshot(), current_account_name()").Scan(&m.Snapshot, &m.Account)
+	if err != nil {
+		return nil, err
+	}
+	if err = pinSnapshot(ctx, c, m.Snapshot); err != nil {
+		return nil, err
+	}
+
+	if m.Account == sysAccount {
+		if m.Accounts, err = getAccounts(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	if m.Roles, m.Users, m.Grants, err = getPrivileges(ctx, c); err != nil {
+		return nil, err
+	}
+	if m.Databases, err = getDatabases(ctx, c); err != nil {
+		return nil, err
+	}
+	for _, db := range m.Databases {
+		if err = os.MkdirAll(filepath.Join(dir, db.Name), 0755); err != nil {
+			return nil, err
+		}
+	}
+	if err = backupTables(ctx, dir, parallel, m); err != nil {
+		return nil, err
+	}
+	return m, writeManifest(dir, m)
+}
+
+func pinSnapshot(ctx context.Context, c *sql.Conn, snapshot string) error {
+	_, err := c.ExecContext(ctx, "set snapshot_ts = '"+snapshot+"'")
+	return err
+}
+
+// backupTables dumps the data of the tables by parallel workers, each worker
+// reads the tables at the snapshot of the manifest.
+func backupTables(ctx context.Context, dir string, parallel int, m *Manifest) error {
+	type job struct {
+		db  string
+		tbl *TableInfo
+	}
+	jobs := make(chan job)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		defer close(jobs)
+		for _, db := range m.Databases {
+			for _, tbl := range db.Tables {
+				if tbl.Kind != catalog.SystemOrdinaryRel {
+					continue
+				}
+				select {
+				case jobs <- job{db.Name, tbl}:
+				case <-gCtx.Done():
+					return gCtx.Err()
+				}
+			}
+		}
+		return nil
+	})
+	for i := 0; i < parallel; i++ {
+		g.Go(func() error {
+			c, err := conn.Conn(gCtx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if err = pinSnapshot(gCtx, c, m.Snapshot); err != nil {
+				return err
+			}
+			for j := range jobs {
+				if err = backupTable(gCtx, c, dir, j.db, j.tbl); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func backupTable(ctx context.Context, c *sql.Conn, dir, db string, tbl *TableInfo) error {
+	file := filepath.Join(db, tbl.Name+".csv")
+	f, err := os.Create(filepath.Join(dir, file))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := c.QueryContext(ctx, "select * from `"+db+"`.`"+tbl.Name+"`")
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	cols, args, err := getColumns(r)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(f, defaultNetBufferLength)
+	if tbl.Rows, err = writeCsv(w, r, args, cols); err != nil {
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	tbl.File = file
+	return nil
+}
+
+func writeManifest(dir string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, manifestFileName), data, 0644)
+}
+
+func getDatabases(ctx context.Context, q queryer) ([]*DatabaseInfo, error) {
+	rows, err := queryStrings(ctx, q, "select datname, dat_type from mo_catalog.mo_database where account_id = current_account_id() order by datname")
+	if err != nil {
+		return nil, err
+	}
+	dbs := make([]*DatabaseInfo, 0, len(rows))
+	for _, row := range rows {
+		name := row[0]
+		if _, ok := backupSkipDatabases[name]; ok || row[1] == catalog.SystemDBTypeSubscription {
+			continue
+		}
+		db := &DatabaseInfo{Name: name}
+		if db.Create, err = getCreateDB(ctx, q, name); err != nil {
+			return nil, err
+		}
+		if err = getDatabaseObjects(ctx, q, db); err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, db)
+	}
+	return dbs, nil
+}
+
+func getDatabaseObjects(ctx context.Context, q queryer, db *DatabaseInfo) error {
+	tables, err := getTables(ctx, q, db.Name, nil)
+	if err != nil {
+		return err
+	}
+	var views Tables
+	var viewCreates []string
+	for _, tbl := range tables {
+		switch tbl.Kind {
+		case catalog.SystemOrdinaryRel, catalog.SystemExternalRel, catalog.SystemViewRel:
+			create, err := getCreateTable(ctx, q, db.Name, tbl.Name)
+			if err != nil {
+				return err
+			}
+			if tbl.Kind == catalog.SystemViewRel {
+				views = append(views, tbl)
+				viewCreates = append(viewCreates, create)
+				continue
+			}
+			db.Tables = append(db.Tables, &TableInfo{Name: tbl.Name, Kind: tbl.Kind, Create: create})
+		case catalog.SystemSequenceRel:
+			seq, err := getSequence(ctx, q, db.Name, tbl.Name)
+			if err != nil {
+				return err
+			}
+			db.Sequences = append(db.Sequences, seq)
+		default:
+			return moerr.NewNotSupported(ctx, "table type %s", tbl.Kind)
+		}
+	}
+	adjustViewOrder(viewCreates, views, 0)
+	for i, view := range views {
+		db.Views = append(db.Views, &TableInfo{Name: view.Name, Kind: view.Kind, Create: viewCreates[i]})
+	}
+	db.Procedures, err = getProcedures(ctx, q, db.Name)
+	return err
+}
+
+func getSequence(ctx context.Context, q queryer, db, name string) (*SequenceInfo, error) {
+	r, err := q.QueryContext(ctx, "select last_seq_num, min_value, max_value, start_value, increment_value, cycle, is_called from `"+db+"`.`"+name+"`")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	colTypes, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	if !r.Next() {
+		if err = r.Err(); err != nil {
+			return nil, err
+		}
+		return nil, moerr.NewInternalError(ctx, "sequence %s.%s has no data", db, name)
+	}
+	var minValue, maxValue, startValue, increment string
+	var cycle bool
+	seq := &SequenceInfo{Name: name}
+	err = r.Scan(&seq.LastValue, &minValue, &maxValue, &startValue, &increment, &cycle, &seq.IsCalled)
+	if err != nil {
+		return nil, err
+	}
+	seq.Create = fmt.Sprintf("CREATE SEQUENCE `%s` AS %s INCREMENT BY %s MINVALUE %s MAXVALUE %s START WITH %s",
+		name, sequenceType(colTypes[0].DatabaseTypeName()), increment, minValue, maxValue, startValue)
+	if cycle {
+		seq.Create += " CYCLE"
+	} else {
+		seq.Create += " NO CYCLE"
+	}
+	return seq, nil
+}
+
+// sequenceType converts the type name returned by the server, e.g.
+// "UNSIGNED BIGINT", into the column type of CREATE SEQUENCE.
+func sequenceType(typ string) string {
+	typ = strings.ToLower(typ)
+	if strings.HasPrefix(typ, "unsigned ") {
+		return strings.TrimPrefix(typ, "unsigned ") + " unsigned"
+	}
+	return typ
+}
+
+// procedureArg is the argument of a stored procedure kept in mo_catalog.
+type procedureArg struct {
+	Name *tree.UnresolvedName
+	Type struct {
+		InternalType tree.InternalType
+	}
+	InOutType tree.InOutArgType
+}
+
+func getProcedures(ctx context.Context, q queryer, db string) ([]string, error) {
+	rows, err := queryStrings(ctx, q, "select name, args, body from mo_catalog.mo_stored_procedure where db = "+quoteString(db)+" order by proc_id")
+	if err != nil {
+		return nil, err
+	}
+	procs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		create, err := createProcedureSql(row[0], row[1], row[2])
+		if err != nil {
+			return nil, err
+		}
+		procs = append(procs, create)
+	}
+	return procs, nil
+}
+
+// createProcedureSql rebuilds the CREATE PROCEDURE statement. The arguments
+// are kept as a json object, so they are ordered by their names.
+func createProcedureSql(name, argsJson, body string) (string, error) {
+	var args map[string]procedureArg
+	if err := json.Unmarshal([]byte(argsJson), &args); err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(args))
+	for argName := range args {
+		names = append(names, argName)
+	}
+	sort.Strings(names)
+	decls := make([]string, 0, len(names))
+	for _, argName := range names {
+		arg := args[argName]
+		decl := tree.NewProcedureArgDecl(arg.InOutType, arg.Name, &tree.T{InternalType: arg.Type.InternalType})
+		decls = append(decls, tree.String(decl, dialect.MYSQL))
+	}
+	return fmt.Sprintf("CREATE PROCEDURE `%s` (%s) %s", name, strings.Join(decls, ", "), quoteString(body)), nil
+}
+
+func getAccounts(ctx context.Context, q queryer) ([]*AccountInfo, error) {
+	rows, err := queryStrings(ctx, q, "select account_name, status, ifnull(comments, '') from mo_catalog.mo_account where account_name != '"+sysAccount+"' order by account_id")
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*AccountInfo, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, &AccountInfo{Name: row[0], Status: row[1], Comments: row[2], ShellOnly: true})
+	}
+	return accounts, nil
+}
+
+// getPrivileges returns the roles, the users and the statements granting
+// the roles and the privileges. The builtin roles are created with the
+// account, so they and the grants on them are skipped.
+func getPrivileges(ctx context.Context, q queryer) (roles []string, users []*UserInfo, grants []string, err error) {
+	rows, err := queryStrings(ctx, q, "select role_name from mo_catalog.mo_role order by role_id")
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		if !isBuiltinRole(row[0]) {
+			roles = append(roles, row[0])
+		}
+	}
+
+	rows, err = queryStrings(ctx, q, "select u.user_name, u.user_host, ifnull(r.role_name, '') from mo_catalog.mo_user u left join mo_catalog.mo_role r on u.default_role = r.role_id order by u.user_id")
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		users = append(users, &UserInfo{Name: row[0], Host: row[1], DefaultRole: row[2]})
+	}
+
+	rows, err = queryStrings(ctx, q, "select p.role_name, p.privilege_name, p.obj_type, p.privilege_level, p.with_grant_option from mo_catalog.mo_role_privs p order by p.role_id")
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		if isBuiltinRole(row[0]) {
+			continue
+		}
+		level := row[3]
+		if row[2] == "account" {
+			level = ""
+		}
+		grant := fmt.Sprintf("GRANT %s ON %s %s TO `%s`", row[1], row[2], level, row[0])
+		if row[4] == "1" || row[4] == "true" {
+			grant += " WITH GRANT OPTION"
+		}
+		grants = append(grants, grant)
+	}
+
+	rows, err = queryStrings(ctx, q, "select a.role_name, b.role_name from mo_catalog.mo_role_grant g join mo_catalog.mo_role a on g.granted_id = a.role_id join mo_catalog.mo_role b on g.grantee_id = b.role_id")
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		if !isBuiltinRole(row[0]) && !isBuiltinRole(row[1]) {
+			grants = append(grants, fmt.Sprintf("GRANT `%s` TO `%s`", row[0], row[1]))
+		}
+	}
+
+	rows, err = queryStrings(ctx, q, "select r.role_name, u.user_name from mo_catalog.mo_user_grant g join mo_catalog.mo_role r on g.role_id = r.role_id join mo_catalog.mo_user u on g.user_id = u.user_id")
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		if !isBuiltinRole(row[0]) {
+			grants = append(grants, fmt.Sprintf("GRANT `%s` TO `%s`", row[0], row[1]))
+		}
+	}
+	return
+}
+
+func isBuiltinRole(role string) bool {
+	_, ok := builtinRoles[role]
+	return ok
+}
+
+// queryStrings returns all the rows of the query as strings, NULL is
+// returned as the empty string.
+func queryStrings(ctx context.Context, q queryer, query string) ([][]string, error) {
+	r, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	cols, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	values := make([]sql.NullString, len(cols))
+	args := make([]any, len(cols))
+	for i := range values {
+		args[i] = &values[i]
+	}
+	for r.Next() {
+		if err = r.Scan(args...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(values))
+		for i, v := range values {
+			row[i] = v.String
+		}
+		rows = append(rows, row)
+	}
+	return rows, r.Err()
+}
+
+func quoteString(s string) string {
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	return "'" + strings.Replace(s, "'", "\\'", -1) + "'"
+}
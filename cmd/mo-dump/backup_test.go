@@ -0,0 +1,108 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceType(t *testing.T) {
+	require.Equal(t, "bigint", sequenceType("BIGINT"))
+	require.Equal(t, "int unsigned", sequenceType("UNSIGNED INT"))
+}
+
+func TestCreateProcedureSql(t *testing.T) {
+	create := "create procedure p (in b int, out a varchar(10)) 'begin select ''x''; end'"
+	stmt, err := mysql.ParseOne(context.TODO(), create, 1)
+	require.NoError(t, err)
+	cp := stmt.(*tree.CreateProcedure)
+
+	// the arguments are kept in the same way as InitProcedure
+	args := make(map[string]tree.ProcedureArgForMarshal)
+	for _, arg := range cp.Args {
+		decl := arg.(*tree.ProcedureArgDecl)
+		args[decl.Name.Parts[0]] = tree.ProcedureArgForMarshal{
+			Name:      decl.Name,
+			Type:      decl.Type,
+			InOutType: decl.InOutType,
+		}
+	}
+	argsJson, err := json.Marshal(args)
+	require.NoError(t, err)
+
+	sql, err := createProcedureSql("p", string(argsJson), cp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE PROCEDURE `p` (out a varchar(10), in b int) 'begin select \\'x\\'; end'", sql)
+	_, err = mysql.ParseOne(context.TODO(), sql, 1)
+	require.NoError(t, err)
+}
+
+func TestManifest(t *testing.T) {
+	dir := t.TempDir()
+	m := &Manifest{
+		Version:  manifestVersion,
+		Snapshot: "1-1",
+		Account:  "sys",
+		Accounts: []*AccountInfo{{Name: "acc", Status: "open", ShellOnly: true}},
+		Roles:    []string{"r1"},
+		Users:    []*UserInfo{{Name: "u1", Host: "localhost", DefaultRole: "r1"}},
+		Databases: []*DatabaseInfo{{
+			Name:      "db",
+			Create:    "create database `db`",
+			Tables:    []*TableInfo{{Name: "t", Kind: "r", Create: "create table t (a int)", File: "db/t.csv", Rows: 3}},
+			Sequences: []*SequenceInfo{{Name: "s", Create: "CREATE SEQUENCE `s`", LastValue: "2", IsCalled: true}},
+		}},
+		Grants: []string{"GRANT `r1` TO `u1`"},
+	}
+	require.NoError(t, writeManifest(dir, m))
+	m2, err := readManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, m, m2)
+
+	m.Version++
+	require.NoError(t, writeManifest(dir, m))
+	_, err = readManifest(dir)
+	require.Error(t, err)
+}
+
+func TestRestoreUsersSql(t *testing.T) {
+	m := &Manifest{
+		Accounts: []*AccountInfo{{Name: "acc", Status: "suspend", Comments: "it's"}},
+		Roles:    []string{"r1"},
+		Users: []*UserInfo{
+			{Name: "u1", DefaultRole: "r1"},
+			{Name: "u2", DefaultRole: "public"},
+		},
+	}
+	require.Equal(t, []string{
+		"CREATE ACCOUNT IF NOT EXISTS `acc` ADMIN_NAME 'admin' IDENTIFIED BY 'pwd' SUSPEND COMMENT 'it\\'s'",
+		"CREATE ROLE IF NOT EXISTS `r1`",
+		"CREATE USER IF NOT EXISTS `u1` IDENTIFIED BY 'pwd' DEFAULT ROLE `r1`",
+		"CREATE USER IF NOT EXISTS `u2` IDENTIFIED BY 'pwd'",
+	}, restoreUsersSql(m, "pwd"))
+
+	for _, stmt := range restoreUsersSql(m, "pwd") {
+		_, err := mysql.ParseOne(context.TODO(), stmt, 1)
+		require.NoError(t, err, stmt)
+	}
+	_, err := mysql.ParseOne(context.TODO(), loadDataSql("/tmp/db/t.csv", "db", "t"), 1)
+	require.NoError(t, err)
+}
@@ -20,6 +20,7 @@ import (
 	"database/sql"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -48,6 +49,9 @@ func main() {
 		createTable                        []string
 		err                                error
 		toCsv, localInfile                 bool
+		backupDir, restoreDir              string
+		restorePassword                    string
+		parallel                           int
 	)
 	dumpStart := time.Now()
 	defer func() {
@@ -78,6 +82,10 @@ func main() {
 	flag.Var(&tables, "tbl", "tableNameList, default all")
 	flag.BoolVar(&toCsv, "csv", defaultCsv, "set export format to csv")
 	flag.BoolVar(&localInfile, "local-infile", defaultLocalInfile, "use load data local infile")
+	flag.StringVar(&backupDir, "backup-dir", "", "back up all the databases at one snapshot into the directory")
+	flag.StringVar(&restoreDir, "restore-dir", "", "restore the backup in the directory")
+	flag.StringVar(&restorePassword, "restore-password", "", "password of the users and the account admins created by restore")
+	flag.IntVar(&parallel, "parallel", defaultParallel, "number of the tables backed up or restored in parallel")
 	flag.Parse()
 	if netBufferLength < minNetBufferLength {
 		fmt.Fprintf(os.Stderr, "net_buffer_length must be greater than %d, set to %d\n", minNetBufferLength, minNetBufferLength)
@@ -87,7 +95,15 @@ func main() {
 		fmt.Fprintf(os.Stderr, "net_buffer_length must be less than %d, set to %d\n", maxNetBufferLength, maxNetBufferLength)
 		netBufferLength = maxNetBufferLength
 	}
-	if len(database) == 0 {
+	if len(backupDir) > 0 && len(restoreDir) > 0 {
+		err = moerr.NewInvalidInput(ctx, "backup-dir and restore-dir can not be specified together")
+		return
+	}
+	backupMode := len(backupDir) > 0 || len(restoreDir) > 0
+	if parallel < 1 {
+		parallel = 1
+	}
+	if len(database) == 0 && !backupMode {
 		err = moerr.NewInvalidInput(ctx, "database must be specified")
 		return
 	}
@@ -110,8 +126,25 @@ func main() {
 	if err != nil {
 		return
 	}
+	if backupMode {
+		var m *Manifest
+		if len(backupDir) > 0 {
+			m, err = backup(ctx, backupDir, parallel)
+		} else {
+			m, err = restore(ctx, restoreDir, parallel, restorePassword)
+		}
+		if err == nil {
+			fmt.Fprintf(os.Stdout, "/* SNAPSHOT %s, %d DATABASES */\n", m.Snapshot, len(m.Databases))
+			for _, account := range m.Accounts {
+				if account.ShellOnly {
+					fmt.Fprintf(os.Stdout, "/* ACCOUNT %s WITHOUT DATA, RESTORE IT FROM THE BACKUP OF THE ACCOUNT */\n", account.Name)
+				}
+			}
+		}
+		return
+	}
 	if len(tables) == 0 { //dump all tables
-		createDb, err = getCreateDB(ctx, database)
+		createDb, err = getCreateDB(ctx, conn, database)
 		if err != nil {
 			return
 		}
@@ -119,13 +152,13 @@ func main() {
 		fmt.Println(createDb, ";")
 		fmt.Printf("USE `%s`;\n\n\n", database)
 	}
-	tables, err = getTables(database, tables)
+	tables, err = getTables(ctx, conn, database, tables)
 	if err != nil {
 		return
 	}
 	createTable = make([]string, len(tables))
 	for i, tbl := range tables {
-		createTable[i], err = getCreateTable(database, tbl.Name)
+		createTable[i], err = getCreateTable(ctx, conn, database, tbl.Name)
 		if err != nil {
 			return
 		}
@@ -231,7 +264,7 @@ func showCreateTable(createSql string, withNextLine bool) {
 	fmt.Printf("%s%s\n", createSql, suffix)
 }
 
-func getTables(db string, tables Tables) (Tables, error) {
+func getTables(ctx context.Context, q queryer, db string, tables Tables) (Tables, error) {
 	sql := "select relname,relkind from mo_catalog.mo_tables where reldatabase = '" + db + "'"
 	if len(tables) > 0 {
 		sql += " and relname in ("
@@ -243,7 +276,7 @@ func getTables(db string, tables Tables) (Tables, error) {
 		}
 		sql += ")"
 	}
-	r, err := conn.Query(sql) //TODO: after unified sys table prefix, add condition in where clause
+	r, err := q.QueryContext(ctx, sql) //TODO: after unified sys table prefix, add condition in where clause
 	if err != nil {
 		return nil, err
 	}
@@ -271,8 +304,8 @@ func getTables(db string, tables Tables) (Tables, error) {
 	return tables, nil
 }
 
-func getCreateDB(ctx context.Context, db string) (string, error) {
-	r := conn.QueryRow("show create database `" + db + "`")
+func getCreateDB(ctx context.Context, q queryer, db string) (string, error) {
+	r := q.QueryRowContext(ctx, "show create database `"+db+"`")
 	var create string
 	err := r.Scan(&db, &create)
 	if err != nil {
@@ -282,8 +315,8 @@ func getCreateDB(ctx context.Context, db string) (string, error) {
 	return create, err
 }
 
-func getCreateTable(db, tbl string) (string, error) {
-	r := conn.QueryRow("show create table `" + db + "`.`" + tbl + "`")
+func getCreateTable(ctx context.Context, q queryer, db, tbl string) (string, error) {
+	r := q.QueryRowContext(ctx, "show create table `"+db+"`.`"+tbl+"`")
 	var create string
 	err := r.Scan(&tbl, &create)
 	if err != nil {
@@ -366,33 +399,44 @@ func showLoad(r *sql.Rows, args []any, cols []*Column, db string, tbl string, lo
 	}
 	defer f.Close()
 
+	if _, err = writeCsv(f, r, args, cols); err != nil {
+		return err
+	}
+	if localInfile {
+		fmt.Printf("LOAD DATA LOCAL INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
+	} else {
+		fmt.Printf("LOAD DATA INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
+	}
+	return nil
+}
+
+// writeCsv writes the rows in the format of showLoad's LOAD DATA statement
+// and returns the number of the rows written.
+func writeCsv(w io.Writer, r *sql.Rows, args []any, cols []*Column) (int64, error) {
+	var rows int64
 	for r.Next() {
-		err = r.Scan(args...)
+		err := r.Scan(args...)
 		if err != nil {
-			return err
+			return rows, err
 		}
 		for i, v := range args {
 			dt, format := convertValue2(v, cols[i].Type)
-			_, err = fmt.Fprintf(f, format, dt)
+			_, err = fmt.Fprintf(w, format, dt)
 			if err != nil {
-				return err
+				return rows, err
 			}
 			ch := '\t'
 			if i == len(args)-1 {
 				ch = '\n'
 			}
-			_, err = fmt.Fprintf(f, "%c", ch)
+			_, err = fmt.Fprintf(w, "%c", ch)
 			if err != nil {
-				return err
+				return rows, err
 			}
 		}
+		rows++
 	}
-	if localInfile {
-		fmt.Printf("LOAD DATA LOCAL INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
-	} else {
-		fmt.Printf("LOAD DATA INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
-	}
-	return nil
+	return rows, r.Err()
 }
 
 func genOutput(db string, tbl string, bufPool *sync.Pool, netBufferLength int, toCsv bool, localInfile bool) error {
@@ -400,10 +444,22 @@ func genOutput(db string, tbl string, bufPool *sync.Pool, netBufferLength int, t
 	if err != nil {
 		return err
 	}
-	colTypes, err := r.ColumnTypes()
+	cols, args, err := getColumns(r)
 	if err != nil {
 		return err
 	}
+	if !toCsv {
+		return showInsert(r, args, cols, tbl, bufPool, netBufferLength)
+	}
+	return showLoad(r, args, cols, db, tbl, localInfile)
+}
+
+// getColumns returns the columns of the rows and the arguments to scan them.
+func getColumns(r *sql.Rows) ([]*Column, []any, error) {
+	colTypes, err := r.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
 	cols := make([]*Column, 0, len(colTypes))
 	for _, col := range colTypes {
 		var c Column
@@ -416,10 +472,7 @@ func genOutput(db string, tbl string, bufPool *sync.Pool, netBufferLength int, t
 		var v sql.RawBytes
 		args = append(args, &v)
 	}
-	if !toCsv {
-		return showInsert(r, args, cols, tbl, bufPool, netBufferLength)
-	}
-	return showLoad(r, args, cols, db, tbl, localInfile)
+	return cols, args, nil
 }
 
 func convertValue(v any, typ string) string {
@@ -0,0 +1,183 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"golang.org/x/sync/errgroup"
+)
+
+// restore loads the output of backup in dir. The objects are created in the
+// order of their dependencies and the data files are loaded in parallel.
+// Only the password hashes are kept in the system tables, so the users and
+// the admins of the accounts are created with the given password.
+func restore(ctx context.Context, dir string, parallel int, password string) (*Manifest, error) {
+	m, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if password == "" && (len(m.Accounts) > 0 || len(m.Users) > 0) {
+		return nil, moerr.NewInvalidInput(ctx, "restore-password must be specified to create the accounts and the users")
+	}
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	if err = execAll(ctx, c, restoreUsersSql(m, password)); err != nil {
+		return nil, err
+	}
+	for _, db := range m.Databases {
+		stmts := []string{db.Create, "USE `" + db.Name + "`"}
+		for _, tbl := range db.Tables {
+			stmts = append(stmts, tbl.Create)
+		}
+		for _, seq := range db.Sequences {
+			stmts = append(stmts, seq.Create,
+				fmt.Sprintf("SELECT SETVAL('%s', '%s', %v)", seq.Name, seq.LastValue, seq.IsCalled))
+		}
+		if err = execAll(ctx, c, stmts); err != nil {
+			return nil, err
+		}
+	}
+	if err = restoreTables(ctx, dir, parallel, m); err != nil {
+		return nil, err
+	}
+	for _, db := range m.Databases {
+		stmts := []string{"USE `" + db.Name + "`"}
+		for _, view := range db.Views {
+			stmts = append(stmts, view.Create)
+		}
+		stmts = append(stmts, db.Procedures...)
+		if err = execAll(ctx, c, stmts); err != nil {
+			return nil, err
+		}
+	}
+	return m, execAll(ctx, c, m.Grants)
+}
+
+// restoreUsersSql returns the statements creating the accounts, the roles
+// and the users in the manifest.
+func restoreUsersSql(m *Manifest, password string) []string {
+	var stmts []string
+	for _, account := range m.Accounts {
+		stmt := fmt.Sprintf("CREATE ACCOUNT IF NOT EXISTS `%s` ADMIN_NAME '%s' IDENTIFIED BY %s",
+			account.Name, defaultRestoreAdmin, quoteString(password))
+		if account.Status == "suspend" {
+			stmt += " SUSPEND"
+		}
+		if account.Comments != "" {
+			stmt += " COMMENT " + quoteString(account.Comments)
+		}
+		stmts = append(stmts, stmt)
+	}
+	for _, role := range m.Roles {
+		stmts = append(stmts, fmt.Sprintf("CREATE ROLE IF NOT EXISTS `%s`", role))
+	}
+	for _, user := range m.Users {
+		stmt := fmt.Sprintf("CREATE USER IF NOT EXISTS `%s` IDENTIFIED BY %s", user.Name, quoteString(password))
+		if user.DefaultRole != "" && !isBuiltinRole(user.DefaultRole) {
+			stmt += fmt.Sprintf(" DEFAULT ROLE `%s`", user.DefaultRole)
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts
+}
+
+func restoreTables(ctx context.Context, dir string, parallel int, m *Manifest) error {
+	type job struct {
+		db  string
+		tbl *TableInfo
+	}
+	jobs := make(chan job)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		defer close(jobs)
+		for _, db := range m.Databases {
+			for _, tbl := range db.Tables {
+				if tbl.File == "" || tbl.Rows == 0 {
+					continue
+				}
+				select {
+				case jobs <- job{db.Name, tbl}:
+				case <-gCtx.Done():
+					return gCtx.Err()
+				}
+			}
+		}
+		return nil
+	})
+	for i := 0; i < parallel; i++ {
+		g.Go(func() error {
+			c, err := conn.Conn(gCtx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			for j := range jobs {
+				file, err := filepath.Abs(filepath.Join(dir, j.tbl.File))
+				if err != nil {
+					return err
+				}
+				mysql.RegisterLocalFile(file)
+				_, err = c.ExecContext(gCtx, loadDataSql(file, j.db, j.tbl.Name))
+				mysql.DeregisterLocalFile(file)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func loadDataSql(file, db, tbl string) string {
+	return fmt.Sprintf("LOAD DATA LOCAL INFILE %s INTO TABLE `%s`.`%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE'",
+		quoteString(file), db, tbl)
+}
+
+func readManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	if m.Version != manifestVersion {
+		return nil, moerr.NewNotSupportedNoCtx("manifest version %d", m.Version)
+	}
+	return m, nil
+}
+
+func execAll(ctx context.Context, c *sql.Conn, stmts []string) error {
+	for _, stmt := range stmts {
+		if _, err := c.ExecContext(ctx, stmt); err != nil {
+			return moerr.NewInternalError(ctx, "execute %s: %v", stmt, err)
+		}
+	}
+	return nil
+}
@@ -30,9 +30,18 @@ const (
 	maxNetBufferLength     = mpool.MB * 16
 	defaultCsv             = false
 	defaultLocalInfile     = true
+	defaultParallel        = 4
 	timeout                = 10 * time.Second
 )
 
+const (
+	manifestVersion  = 1
+	manifestFileName = "manifest.json"
+	sysAccount       = "sys"
+	// the admin of the accounts created by the restore
+	defaultRestoreAdmin = "admin"
+)
+
 const (
 	quoteFmt   = "%q"
 	defaultFmt = "%s"
@@ -55,3 +64,63 @@ type Table struct {
 }
 
 type Tables []Table
+
+// Manifest describes the output of a backup. All the data are read at the
+// same snapshot of the account that runs the backup.
+type Manifest struct {
+	Version   int             `json:"version"`
+	Snapshot  string          `json:"snapshot"`
+	Account   string          `json:"account"`
+	CreatedAt string          `json:"created_at"`
+	Accounts  []*AccountInfo  `json:"accounts,omitempty"`
+	Roles     []string        `json:"roles,omitempty"`
+	Users     []*UserInfo     `json:"users,omitempty"`
+	Databases []*DatabaseInfo `json:"databases"`
+	// Grants are the statements granting the roles and the privileges,
+	// they are executed after all the objects are restored.
+	Grants []string `json:"grants,omitempty"`
+}
+
+// AccountInfo is a tenant listed by the backup of sys. The databases of the
+// tenant are not readable by sys, so only the account itself is restored,
+// ShellOnly records that the data of the tenant must be restored from a
+// backup taken by the tenant.
+type AccountInfo struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Comments  string `json:"comments"`
+	ShellOnly bool   `json:"shell_only"`
+}
+
+type UserInfo struct {
+	Name        string `json:"name"`
+	Host        string `json:"host"`
+	DefaultRole string `json:"default_role"`
+}
+
+type DatabaseInfo struct {
+	Name   string       `json:"name"`
+	Create string       `json:"create"`
+	Tables []*TableInfo `json:"tables,omitempty"`
+	// Views are sorted by their dependencies.
+	Views      []*TableInfo    `json:"views,omitempty"`
+	Sequences  []*SequenceInfo `json:"sequences,omitempty"`
+	Procedures []string        `json:"procedures,omitempty"`
+}
+
+type TableInfo struct {
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Create string `json:"create"`
+	// File is the data file relative to the backup directory, it is empty
+	// for the tables without data, e.g. the external tables.
+	File string `json:"file,omitempty"`
+	Rows int64  `json:"rows"`
+}
+
+type SequenceInfo struct {
+	Name      string `json:"name"`
+	Create    string `json:"create"`
+	LastValue string `json:"last_value"`
+	IsCalled  bool   `json:"is_called"`
+}
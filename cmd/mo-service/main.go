@@ -95,6 +95,14 @@ func main() {
 		if err := parseConfigFromFile(*configFile, cfg); err != nil {
 			panic(fmt.Sprintf("failed to parse config from %s, error: %s", *configFile, err.Error()))
 		}
+		if *restorePathFlag != "" {
+			if err := restoreDNService(ctx, cfg, stopper, globalCounterSet); err != nil {
+				panic(err)
+			}
+			stopper.Stop()
+			logutil.GetGlobalLogger().Info("Restore complete")
+			return
+		}
 		if err := startService(ctx, cfg, stopper, globalCounterSet); err != nil {
 			panic(err)
 		}
@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/dnservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+)
+
+var (
+	restorePathFlag = flag.String("restore", "", "restore the dn data from the backup path and exit, the dn must be configured with an empty log service")
+	restoreTSFlag   = flag.String("restore-ts", "", "restore to the timestamp in physical-logical, default is the end of the backup")
+)
+
+// restoreDNService rebuilds the data of the DN in cfg from the backup made
+// by the backup ctl command, the services of the cluster are started as
+// usual after it.
+func restoreDNService(ctx context.Context, cfg *Config, stopper *stopper.Stopper, globalCounterSet *perfcounter.CounterSet) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	st, err := cfg.getServiceType()
+	if err != nil {
+		return err
+	}
+	if st != metadata.ServiceType_DN {
+		return moerr.NewInvalidInput(ctx, "restore with %s config", st.String())
+	}
+	var ts timestamp.Timestamp
+	if *restoreTSFlag != "" {
+		if ts, err = timestamp.ParseTimestamp(*restoreTSFlag); err != nil {
+			return err
+		}
+	}
+	setupProcessLevelRuntime(cfg, stopper)
+
+	uuid, err := getNodeUUID(ctx, st, cfg)
+	if err != nil {
+		return err
+	}
+	fs, err := cfg.createFileService(defines.LocalFileServiceName, globalCounterSet, st, uuid)
+	if err != nil {
+		return err
+	}
+	c := cfg.getDNServiceConfig()
+	return dnservice.Restore(ctx, &c, fs, *restorePathFlag, ts)
+}
@@ -87,6 +87,12 @@ func ParseEntryList(es []*api.Entry) (any, []*api.Entry, error) {
 			return genDropOrTruncateTables(GenRows(bat)), es[1:], nil
 		} else if e.EntryType == api.Entry_Update {
 			return genUpdateConstraint(GenRows(bat)), es[1:], nil
+		} else if e.EntryType == api.Entry_Alter {
+			reqs, err := genAlterTables(GenRows(bat))
+			if err != nil {
+				return nil, nil, err
+			}
+			return reqs, es[1:], nil
 		}
 		cmds := genCreateTables(GenRows(bat))
 		idx := 0
@@ -206,6 +212,17 @@ func genUpdateConstraint(rows [][]any) []UpdateConstraint {
 	return cmds
 }
 
+func genAlterTables(rows [][]any) ([]*api.AlterTableReq, error) {
+	reqs := make([]*api.AlterTableReq, len(rows))
+	for i, row := range rows {
+		reqs[i] = new(api.AlterTableReq)
+		if err := reqs[i].Unmarshal(row[MO_TABLES_ALTER_TABLE].([]byte)); err != nil {
+			return nil, err
+		}
+	}
+	return reqs, nil
+}
+
 func genDropOrTruncateTables(rows [][]any) []DropOrTruncateTable {
 	cmds := make([]DropOrTruncateTable, len(rows))
 	for i, row := range rows {
@@ -279,6 +296,7 @@ func genTableDefs(row []any) (engine.TableDef, error) {
 	attr.AutoIncrement = row[MO_COLUMNS_ATT_IS_AUTO_INCREMENT_IDX].(int8) == 1
 	attr.Primary = string(row[MO_COLUMNS_ATT_CONSTRAINT_TYPE_IDX].([]byte)) == "p"
 	attr.ClusterBy = row[MO_COLUMNS_ATT_IS_CLUSTERBY].(int8) == 1
+	attr.EnumValues = string(row[MO_COLUMNS_ATT_ENUM_IDX].([]byte))
 	return &engine.AttributeDef{Attr: attr}, nil
 }
 
@@ -320,7 +338,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			col := vector.MustFixedCol[uint16](vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -330,7 +348,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			col := vector.MustFixedCol[uint64](vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -395,7 +413,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_text:
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_array_float32, types.T_text:
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = vec.GetBytesAt(j)
 			}
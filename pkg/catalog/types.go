@@ -31,6 +31,11 @@ const (
 	PrefixPriColName     = "__mo_cpkey_"
 	PrefixCBColName      = "__mo_cbkey_"
 	PrefixIndexTableName = "__mo_index_"
+	// The results of a materialized view are kept in a hidden table named by
+	// the prefix and the view name, its columns are named by
+	// MaterializedViewColNamePrefix and their positions in the view.
+	MaterializedViewTablePrefix   = "__mo_mv_"
+	MaterializedViewColNamePrefix = "__mo_mv_col_"
 	// Compound primary key column name, which is a hidden column
 	CPrimaryKeyColName = "__mo_cpkey_col"
 	// FakePrimaryKeyColName for tables without a primary key, a new hidden primary key column
@@ -40,9 +45,28 @@ const (
 	// IndexTable has two column at most, the first is idx col, the second is origin table primary col
 	IndexTableIndexColName   = "__mo_index_idx_col"
 	IndexTablePrimaryColName = "__mo_index_pri_col"
-	ExternalFilePath         = "__mo_filepath"
-	IndexTableNamePrefix     = "__mo_index_unique__"
-	AutoIncrTableName        = "%!%mo_increment_columns"
+	// The fulltext index table has two more columns, the word and its count in
+	// the document. The row of the empty word keeps the length of the document.
+	IndexTableWordColName  = "__mo_index_word"
+	IndexTableCountColName = "__mo_index_count"
+	// IndexAlgoFullText is the algorithm of the fulltext index
+	IndexAlgoFullText = "fulltext"
+	// The ivfflat index table keeps the list of each row, the lists are the
+	// clusters of the vectors and their centroids are kept in another table,
+	// whose name is the index table name with IvfFlatCentroidsTableSuffix.
+	IndexTableListColName       = "__mo_index_list"
+	IndexTableVectorColName     = "__mo_index_vector"
+	IvfFlatCentroidsTableSuffix = "_centroids"
+	// IndexAlgoIvfFlat is the algorithm of the ivfflat index
+	IndexAlgoIvfFlat = "ivfflat"
+	// The expression index table keeps the value of the indexed expression
+	// of each row.
+	IndexTableValueColName = "__mo_index_value"
+	// IndexAlgoExpression is the algorithm of the index on an expression
+	IndexAlgoExpression  = "expression"
+	ExternalFilePath     = "__mo_filepath"
+	IndexTableNamePrefix = "__mo_index_unique__"
+	AutoIncrTableName    = "%!%mo_increment_columns"
 )
 
 var AutoIncrColumnNames = []string{Row_ID, "name", "offset", "step"}
@@ -134,6 +158,7 @@ const (
 	SystemColAttr_Update          = "attr_update"
 	SystemColAttr_IsClusterBy     = "attr_is_clusterby"
 	SystemColAttr_Seqnum          = "attr_seqnum"
+	SystemColAttr_EnumValues      = "attr_enum"
 
 	BlockMeta_ID              = "block_id"
 	BlockMeta_Delete_ID       = "block_delete_id"
@@ -187,6 +212,11 @@ const (
 	MO_TABLES_UPDATE_CONSTRAINT = 4
 )
 
+// index use to alter table, the column stores the marshaled api.AlterTableReq
+const (
+	MO_TABLES_ALTER_TABLE = 4
+)
+
 // column's index in catalog table
 const (
 	MO_DATABASE_DAT_ID_IDX           = 0
@@ -240,6 +270,7 @@ const (
 	MO_COLUMNS_ATT_UPDATE_IDX            = 20
 	MO_COLUMNS_ATT_IS_CLUSTERBY          = 21
 	MO_COLUMNS_ATT_SEQNUM_IDX            = 22
+	MO_COLUMNS_ATT_ENUM_IDX              = 23
 
 	BLOCKMETA_ID_IDX         = 0
 	BLOCKMETA_ENTRYSTATE_IDX = 1
@@ -445,6 +476,7 @@ var (
 		SystemColAttr_Update,
 		SystemColAttr_IsClusterBy,
 		SystemColAttr_Seqnum,
+		SystemColAttr_EnumValues,
 	}
 	MoTableMetaSchema = []string{
 		BlockMeta_ID,
@@ -509,6 +541,7 @@ var (
 		types.New(types.T_varchar, 2048, 0), // att_update
 		types.New(types.T_int8, 0, 0),       // att_is_clusterby
 		types.New(types.T_uint16, 0, 0),     // att_seqnum
+		types.New(types.T_varchar, 2048, 0), // att_enum
 	}
 	MoTableMetaTypes = []types.Type{
 		types.New(types.T_Blockid, 0, 0),                   // block_id
@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+// fileCheckpointer saves the positions into a JSON file, the positions are
+// the timestamps like "1686000000000000000-1" by the names of the tables.
+type fileCheckpointer struct {
+	path string
+}
+
+// NewFileCheckpointer returns a checkpointer saving the positions into the
+// file of the path.
+func NewFileCheckpointer(path string) Checkpointer {
+	return &fileCheckpointer{path: path}
+}
+
+func (c *fileCheckpointer) Load(_ context.Context) (map[string]timestamp.Timestamp, error) {
+	data, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return map[string]timestamp.Timestamp{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]string
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	positions := make(map[string]timestamp.Timestamp, len(values))
+	for name, value := range values {
+		if positions[name], err = timestamp.ParseTimestamp(value); err != nil {
+			return nil, err
+		}
+	}
+	return positions, nil
+}
+
+func (c *fileCheckpointer) Save(_ context.Context, positions map[string]timestamp.Timestamp) error {
+	values := make(map[string]string, len(positions))
+	for name, ts := range positions {
+		values[name] = ts.DebugString()
+	}
+	data, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+	// replace the file at once, the old positions are kept if crashed
+	tmp := c.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/version"
+)
+
+const connectorName = "matrixone"
+
+// Envelope is the value of a Debezium change event without the schema,
+// like the output of the JSON converter with schemas.enable=false.
+//
+// Unlike Debezium, Before of the updates and the deletes only has the primary
+// key columns, the old values of the other columns are not kept by MatrixOne.
+type Envelope struct {
+	Before      Row    `json:"before"`
+	After       Row    `json:"after"`
+	Source      Source `json:"source"`
+	Op          Op     `json:"op"`
+	TsMs        int64  `json:"ts_ms"`
+	Transaction any    `json:"transaction"`
+}
+
+// Source is the source metadata of a Debezium change event.
+type Source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	// Name is the logical name of the captured cluster, the prefix of the
+	// Debezium topics.
+	Name     string `json:"name"`
+	TsMs     int64  `json:"ts_ms"`
+	Snapshot string `json:"snapshot"`
+	Db       string `json:"db"`
+	Table    string `json:"table"`
+	// CommitTs is the commit timestamp of the txn, a table resumed from it
+	// gets the changes committed after the txn.
+	CommitTs string `json:"commit_ts"`
+}
+
+// NewEnvelope returns the Debezium change event of the change, now is the
+// time the change is processed.
+func NewEnvelope(name string, change *Change, now time.Time) *Envelope {
+	return &Envelope{
+		Before: change.Before,
+		After:  change.After,
+		Source: Source{
+			Version:   version.Version,
+			Connector: connectorName,
+			Name:      name,
+			TsMs:      change.CommitTS.PhysicalTime / int64(time.Millisecond),
+			Snapshot:  "false",
+			Db:        change.Table.Database,
+			Table:     change.Table.Table,
+			CommitTs:  change.CommitTS.DebugString(),
+		},
+		Op:   change.Op,
+		TsMs: now.UnixMilli(),
+	}
+}
+
+// MarshalDebezium encodes the change as a Debezium change event in JSON.
+func MarshalDebezium(name string, change *Change, now time.Time) ([]byte, error) {
+	return json.Marshal(NewEnvelope(name, change, now))
+}
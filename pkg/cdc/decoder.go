@@ -0,0 +1,580 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	taelogtail "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+)
+
+// rowChange is an inserted or deleted row in the logtail.
+type rowChange struct {
+	ts      types.TS
+	deleted bool
+	// rowid pairs the insert of a row and the delete of it by the same txn
+	rowid types.Rowid
+	// pk is the primary key in the storage, it pairs the delete and the
+	// insert of an update.
+	pk  string
+	key Row
+	row Row
+}
+
+// blockMeta is the metadata of a block in the logtail.
+type blockMeta struct {
+	id         types.Blockid
+	appendable bool
+	metaLoc    objectio.Location
+	deltaLoc   objectio.Location
+	createTS   types.TS
+	commitTS   types.TS
+	deleteTS   types.TS
+}
+
+// decoder turns the logtail of a table into the row level changes.
+type decoder struct {
+	table *TableInfo
+	// fs reads the blocks written to the object storage, the blocks written
+	// by CN directly and the blocks to resume from, can't be read if nil.
+	fs fileservice.FileService
+	// the visible columns and the primary key column read from the blocks
+	seqnums []uint16
+	typs    []types.Type
+	cols    []*Column
+	pkCol   *Column
+}
+
+func newDecoder(table *TableInfo, fs fileservice.FileService) *decoder {
+	d := &decoder{
+		table: table,
+		fs:    fs,
+	}
+	for i := range table.Columns {
+		col := &table.Columns[i]
+		if col.Name == table.PrimaryKey {
+			d.pkCol = col
+		}
+		if !col.Hidden || col.Name == table.PrimaryKey {
+			d.seqnums = append(d.seqnums, col.Seqnum)
+			d.typs = append(d.typs, col.Type)
+			d.cols = append(d.cols, col)
+		}
+	}
+	return d
+}
+
+// decodeTail decodes the logtail of a txn pushed by the DN, the changes
+// committed before or at from are skipped.
+func (d *decoder) decodeTail(ctx context.Context, tail *logtail.TableLogtail, from types.TS) ([]*Change, error) {
+	var rows []*rowChange
+	var metas []*api.Entry
+	dropBlocks := false
+	for i := range tail.Commands {
+		e := &tail.Commands[i]
+		var err error
+		switch {
+		case logtailreplay.IsBlkTable(e.TableName):
+			if e.EntryType == api.Entry_Delete {
+				dropBlocks = true
+			} else {
+				metas = append(metas, e)
+			}
+		case logtailreplay.IsSegTable(e.TableName):
+		case e.EntryType == api.Entry_Insert:
+			rows, err = d.appendInserts(rows, e.Bat, from, types.MaxTs())
+		default:
+			rows, err = d.appendDeletes(rows, e.Bat, from, types.MaxTs(), nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The blocks written by CN are committed without dropping any block.
+	// The blocks flushed and merged by the DN hold the rows sent before.
+	if !dropBlocks {
+		for _, e := range metas {
+			blocks, err := decodeBlockMetas(e.Bat)
+			if err != nil {
+				return nil, err
+			}
+			for _, blk := range blocks {
+				if blk.appendable || blk.metaLoc.IsEmpty() ||
+					!blk.createTS.Equal(blk.commitTS) || blk.createTS.LessEq(from) {
+					continue
+				}
+				if rows, err = d.appendBlock(ctx, rows, blk); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return d.toChanges(rows), nil
+}
+
+// decodeSnapshot decodes the changes committed in (from, to] from the
+// logtail of the subscription, which holds the whole table at to. The rows
+// flushed are read from the blocks, which are kept by the DN for the GC
+// retention.
+func (d *decoder) decodeSnapshot(ctx context.Context, tail *logtail.TableLogtail, from, to types.TS) ([]*Change, error) {
+	entries := make([]*api.Entry, 0, len(tail.Commands))
+	if tail.CkpLocation != "" {
+		if d.fs == nil {
+			return nil, moerr.NewInvalidInputNoCtx("resuming %s needs the fileservice to read the checkpoints", d.table.Name())
+		}
+		ckpEntries, err := taelogtail.LoadCheckpointEntries(ctx, tail.CkpLocation,
+			d.table.TableID, d.table.Table, d.table.DatabaseID, d.table.Database, d.fs)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, ckpEntries...)
+	}
+	for i := range tail.Commands {
+		entries = append(entries, &tail.Commands[i])
+	}
+
+	var rows []*rowChange
+	var ids []types.Blockid
+	blocks := make(map[types.Blockid]*blockMeta)
+	// the deletes are both in the memory and in the delta locations
+	deleted := make(map[types.Rowid]struct{})
+	for _, e := range entries {
+		var err error
+		switch {
+		case logtailreplay.IsBlkTable(e.TableName):
+			err = mergeBlockMetas(blocks, &ids, e)
+		case logtailreplay.IsSegTable(e.TableName):
+		case e.EntryType == api.Entry_Insert:
+			rows, err = d.appendInserts(rows, e.Bat, from, to)
+		default:
+			rows, err = d.appendDeletes(rows, e.Bat, from, to, deleted)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// a block created when other blocks are dropped is flushed or merged
+	// from them
+	merged := make(map[types.TS]struct{})
+	for _, blk := range blocks {
+		if !blk.deleteTS.IsEmpty() {
+			merged[blk.deleteTS] = struct{}{}
+		}
+	}
+	for _, id := range ids {
+		blk := blocks[id]
+		var err error
+		if !blk.metaLoc.IsEmpty() {
+			if blk.appendable {
+				rows, err = d.appendABlock(ctx, rows, blk, from, to)
+			} else if _, ok := merged[blk.createTS]; !ok &&
+				blk.createTS.Greater(from) && blk.createTS.LessEq(to) {
+				rows, err = d.appendBlock(ctx, rows, blk)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !blk.deltaLoc.IsEmpty() {
+			if rows, err = d.appendDeltas(ctx, rows, blk, from, to, deleted); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d.toChanges(rows), nil
+}
+
+// appendInserts appends the rows of the insert batch committed in (from, to].
+func (d *decoder) appendInserts(rows []*rowChange, input *api.Batch, from, to types.TS) ([]*rowChange, error) {
+	bat, err := batch.ProtoBatchToBatch(input)
+	if err != nil {
+		return nil, err
+	}
+	rowids := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	cols := make([]*Column, len(bat.Vecs))
+	pkIdx := -1
+	for i := 2; i < len(bat.Vecs); i++ {
+		cols[i] = d.table.Column(bat.Attrs[i])
+		if cols[i] != nil && cols[i].Name == d.table.PrimaryKey {
+			pkIdx = i
+		}
+	}
+	if pkIdx < 0 {
+		return nil, moerr.NewInternalErrorNoCtx("no primary key %s in the logtail of %s", d.table.PrimaryKey, d.table.Name())
+	}
+	for i, ts := range tss {
+		if ts.LessEq(from) || ts.Greater(to) {
+			continue
+		}
+		r := &rowChange{ts: ts, rowid: rowids[i]}
+		if err = d.setKey(r, bat.Vecs[pkIdx], i); err != nil {
+			return nil, err
+		}
+		for j := 2; j < len(bat.Vecs); j++ {
+			if cols[j] == nil || cols[j].Hidden {
+				continue
+			}
+			v, err := fieldValue(cols[j], bat.Vecs[j], i)
+			if err != nil {
+				return nil, err
+			}
+			r.row = append(r.row, Field{Name: cols[j].Name, Value: v})
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// appendDeletes appends the rows of the delete batch committed in
+// (from, to]. The batch is the row ids, the commit timestamps and the
+// primary keys. The rows in deleted are skipped if it is not nil.
+func (d *decoder) appendDeletes(rows []*rowChange, input *api.Batch, from, to types.TS, deleted map[types.Rowid]struct{}) ([]*rowChange, error) {
+	bat, err := batch.ProtoBatchToBatch(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(bat.Vecs) < 3 {
+		return nil, moerr.NewInternalErrorNoCtx("no primary key in the deletes of %s", d.table.Name())
+	}
+	rowids := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	for i, ts := range tss {
+		if ts.LessEq(from) || ts.Greater(to) {
+			continue
+		}
+		if deleted != nil {
+			if _, ok := deleted[rowids[i]]; ok {
+				continue
+			}
+			deleted[rowids[i]] = struct{}{}
+		}
+		r := &rowChange{ts: ts, deleted: true, rowid: rowids[i]}
+		if err = d.setKey(r, bat.Vecs[2], i); err != nil {
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// appendBlock appends all the rows of a block written by CN, which are
+// committed at the creation of the block.
+func (d *decoder) appendBlock(ctx context.Context, rows []*rowChange, blk *blockMeta) ([]*rowChange, error) {
+	bat, err := d.loadBlock(ctx, blk.metaLoc, d.seqnums, d.typs)
+	if err != nil {
+		return nil, err
+	}
+	return d.appendBlockRows(rows, bat, &blk.id, blk.createTS, nil)
+}
+
+// appendABlock appends the rows of a flushed appendable block committed in
+// (from, to].
+func (d *decoder) appendABlock(ctx context.Context, rows []*rowChange, blk *blockMeta, from, to types.TS) ([]*rowChange, error) {
+	seqnums := append(append([]uint16(nil), d.seqnums...), objectio.SEQNUM_COMMITTS, objectio.SEQNUM_ABORT)
+	typs := append(append([]types.Type(nil), d.typs...), types.T_TS.ToType(), types.T_bool.ToType())
+	bat, err := d.loadBlock(ctx, blk.metaLoc, seqnums, typs)
+	if err != nil {
+		return nil, err
+	}
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[len(d.seqnums)])
+	aborts := vector.MustFixedCol[bool](bat.Vecs[len(d.seqnums)+1])
+	return d.appendBlockRows(rows, bat, &blk.id, types.TS{}, func(i int) (types.TS, bool) {
+		return tss[i], !aborts[i] && tss[i].Greater(from) && tss[i].LessEq(to)
+	})
+}
+
+// appendBlockRows appends the rows of the batch read from a block, the
+// rows are committed at ts if filter is nil.
+func (d *decoder) appendBlockRows(rows []*rowChange, bat *batch.Batch, blkID *types.Blockid, ts types.TS, filter func(int) (types.TS, bool)) ([]*rowChange, error) {
+	pkIdx := -1
+	for i, col := range d.cols {
+		if col == d.pkCol {
+			pkIdx = i
+		}
+	}
+	if pkIdx < 0 {
+		return nil, moerr.NewInternalErrorNoCtx("no primary key %s in %s", d.table.PrimaryKey, d.table.Name())
+	}
+	for i := 0; i < bat.Vecs[0].Length(); i++ {
+		rowTS := ts
+		if filter != nil {
+			var ok bool
+			if rowTS, ok = filter(i); !ok {
+				continue
+			}
+		}
+		r := &rowChange{ts: rowTS, rowid: *objectio.NewRowid(blkID, uint32(i))}
+		if err := d.setKey(r, bat.Vecs[pkIdx], i); err != nil {
+			return nil, err
+		}
+		for j, col := range d.cols {
+			if col.Hidden {
+				continue
+			}
+			v, err := fieldValue(col, bat.Vecs[j], i)
+			if err != nil {
+				return nil, err
+			}
+			r.row = append(r.row, Field{Name: col.Name, Value: v})
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// appendDeltas appends the deletes of the block persisted in its delta
+// location committed in (from, to].
+func (d *decoder) appendDeltas(ctx context.Context, rows []*rowChange, blk *blockMeta, from, to types.TS, deleted map[types.Rowid]struct{}) ([]*rowChange, error) {
+	// rowid, commit ts, primary key, aborted
+	bat, err := d.loadBlock(ctx, blk.deltaLoc, []uint16{0, 1, 2, 3}, nil)
+	if err != nil {
+		return nil, err
+	}
+	rowids := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	aborts := vector.MustFixedCol[bool](bat.Vecs[3])
+	for i, ts := range tss {
+		if aborts[i] || ts.LessEq(from) || ts.Greater(to) {
+			continue
+		}
+		if _, ok := deleted[rowids[i]]; ok {
+			continue
+		}
+		deleted[rowids[i]] = struct{}{}
+		r := &rowChange{ts: ts, deleted: true, rowid: rowids[i]}
+		if err = d.setKey(r, bat.Vecs[2], i); err != nil {
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+func (d *decoder) loadBlock(ctx context.Context, loc objectio.Location, seqnums []uint16, typs []types.Type) (*batch.Batch, error) {
+	if d.fs == nil {
+		return nil, moerr.NewInvalidInputNoCtx("reading the block %s of %s needs the fileservice", loc.Name().String(), d.table.Name())
+	}
+	return blockio.LoadColumns(ctx, seqnums, typs, d.fs, loc, nil)
+}
+
+// setKey sets the primary key of the row from the primary key column.
+func (d *decoder) setKey(r *rowChange, vec *vector.Vector, row int) error {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return moerr.NewInternalErrorNoCtx("null primary key in the logtail of %s", d.table.Name())
+	}
+	varlen := vec.GetType().IsVarlen()
+	if varlen {
+		r.pk = string(vec.GetBytesAt(row))
+	}
+
+	// the composite primary key is encoded as a tuple of the keys
+	if len(d.table.PrimaryKeys) > 1 {
+		tuple, err := types.Unpack(vec.GetBytesAt(row))
+		if err != nil {
+			return err
+		}
+		if len(tuple) != len(d.table.PrimaryKeys) {
+			return moerr.NewInternalErrorNoCtx("bad composite primary key of %s", d.table.Name())
+		}
+		r.key = make(Row, len(tuple))
+		for i, name := range d.table.PrimaryKeys {
+			col := d.table.Column(name)
+			if col == nil {
+				return moerr.NewInternalErrorNoCtx("no primary key column %s in %s", name, d.table.Name())
+			}
+			r.key[i] = Field{Name: name, Value: tupleValue(col, tuple[i])}
+		}
+		return nil
+	}
+
+	v, err := fieldValue(d.table.Column(d.table.PrimaryKey), vec, row)
+	if err != nil {
+		return err
+	}
+	if !varlen {
+		r.pk = fmt.Sprint(v)
+	}
+	r.key = Row{{Name: d.table.PrimaryKey, Value: v}}
+	return nil
+}
+
+// toChanges sorts the rows by their commit timestamps, the delete and the
+// insert of the same primary key by a txn is an update. A row inserted and
+// deleted by the same txn is not a change.
+func (d *decoder) toChanges(rows []*rowChange) []*Change {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].ts.Less(rows[j].ts)
+	})
+	rows = dropTxnLocalRows(rows)
+	changes := make([]*Change, 0, len(rows))
+	var inserts, deletes map[string]*Change
+	var last types.TS
+	for i, r := range rows {
+		if i == 0 || !r.ts.Equal(last) {
+			inserts = make(map[string]*Change)
+			deletes = make(map[string]*Change)
+			last = r.ts
+		}
+		if r.deleted {
+			if c, ok := inserts[r.pk]; ok {
+				delete(inserts, r.pk)
+				c.Op = OpUpdate
+				c.Before = r.key
+				continue
+			}
+			c := &Change{
+				Table:    d.table,
+				Op:       OpDelete,
+				CommitTS: r.ts.ToTimestamp(),
+				Key:      r.key,
+				Before:   r.key,
+			}
+			deletes[r.pk] = c
+			changes = append(changes, c)
+			continue
+		}
+		if c, ok := deletes[r.pk]; ok {
+			delete(deletes, r.pk)
+			c.Op = OpUpdate
+			c.After = r.row
+			continue
+		}
+		c := &Change{
+			Table:    d.table,
+			Op:       OpCreate,
+			CommitTS: r.ts.ToTimestamp(),
+			Key:      r.key,
+			After:    r.row,
+		}
+		inserts[r.pk] = c
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// dropTxnLocalRows removes the rows inserted and deleted by the same txn, and
+// their deletes. The rows are sorted by their commit timestamps.
+func dropTxnLocalRows(rows []*rowChange) []*rowChange {
+	res := rows[:0]
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].ts.Equal(rows[start].ts) {
+			end++
+		}
+		inserted := make(map[types.Rowid]bool)
+		for _, r := range rows[start:end] {
+			if !r.deleted {
+				inserted[r.rowid] = true
+			}
+		}
+		dropped := make(map[types.Rowid]bool)
+		for _, r := range rows[start:end] {
+			if r.deleted && inserted[r.rowid] {
+				dropped[r.rowid] = true
+			}
+		}
+		for _, r := range rows[start:end] {
+			if !dropped[r.rowid] {
+				res = append(res, r)
+			}
+		}
+		start = end
+	}
+	return res
+}
+
+// decodeBlockMetas decodes the block metadata batch, see also
+// logtailreplay.PartitionState.HandleMetadataInsert.
+func decodeBlockMetas(input *api.Batch) ([]*blockMeta, error) {
+	bat, err := batch.ProtoBatchToBatch(input)
+	if err != nil {
+		return nil, err
+	}
+	createTSs := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	ids := vector.MustFixedCol[types.Blockid](bat.Vecs[2])
+	appendables := vector.MustFixedCol[bool](bat.Vecs[3])
+	commitTSs := vector.MustFixedCol[types.TS](bat.Vecs[7])
+	blocks := make([]*blockMeta, len(ids))
+	for i, id := range ids {
+		blocks[i] = &blockMeta{
+			id:         id,
+			appendable: appendables[i],
+			metaLoc:    objectio.Location(bat.Vecs[5].GetBytesAt(i)),
+			deltaLoc:   objectio.Location(bat.Vecs[6].GetBytesAt(i)),
+			createTS:   createTSs[i],
+			commitTS:   commitTSs[i],
+		}
+	}
+	return blocks, nil
+}
+
+// mergeBlockMetas merges the block metadata entry into blocks, ids are the
+// blocks in the order they appear.
+func mergeBlockMetas(blocks map[types.Blockid]*blockMeta, ids *[]types.Blockid, e *api.Entry) error {
+	if e.EntryType == api.Entry_Delete {
+		bat, err := batch.ProtoBatchToBatch(e.Bat)
+		if err != nil {
+			return err
+		}
+		rowids := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+		tss := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		for i, rowid := range rowids {
+			if blk, ok := blocks[*rowid.BorrowBlockID()]; ok {
+				blk.deleteTS = tss[i]
+			}
+		}
+		return nil
+	}
+	metas, err := decodeBlockMetas(e.Bat)
+	if err != nil {
+		return err
+	}
+	for _, meta := range metas {
+		blk, ok := blocks[meta.id]
+		if !ok {
+			blocks[meta.id] = meta
+			*ids = append(*ids, meta.id)
+			continue
+		}
+		if !meta.metaLoc.IsEmpty() {
+			blk.metaLoc = meta.metaLoc
+		}
+		if !meta.deltaLoc.IsEmpty() {
+			blk.deltaLoc = meta.deltaLoc
+		}
+		if !meta.createTS.IsEmpty() {
+			blk.createTS = meta.createTS
+		}
+		if !meta.commitTS.IsEmpty() {
+			blk.commitTS = meta.commitTS
+		}
+	}
+	return nil
+}
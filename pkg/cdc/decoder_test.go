@@ -0,0 +1,243 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+)
+
+func newTestTable() *TableInfo {
+	return &TableInfo{
+		Database:   "db",
+		Table:      "t",
+		DatabaseID: 1,
+		TableID:    2,
+		Columns: []Column{
+			{Name: "a", Seqnum: 0, Type: types.T_int64.ToType()},
+			{Name: "b", Seqnum: 1, Type: types.T_varchar.ToType()},
+		},
+		PrimaryKey:  "a",
+		PrimaryKeys: []string{"a"},
+	}
+}
+
+func newTestEntry(t *testing.T, typ api.Entry_EntryType, attrs []string, vecs ...*vector.Vector) api.Entry {
+	bat := batch.NewWithSize(len(vecs))
+	bat.Attrs = attrs
+	bat.Vecs = vecs
+	pb, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return api.Entry{
+		EntryType:    typ,
+		TableId:      2,
+		TableName:    "t",
+		DatabaseId:   1,
+		DatabaseName: "db",
+		Bat:          pb,
+	}
+}
+
+func newTestRowids(t *testing.T, mp *mpool.MPool, offsets ...uint32) *vector.Vector {
+	blk := objectio.NewBlockid(objectio.NewSegmentid(), 0, 0)
+	vec := vector.NewVec(types.T_Rowid.ToType())
+	for _, offset := range offsets {
+		require.NoError(t, vector.AppendFixed(vec, *objectio.NewRowid(blk, offset), false, mp))
+	}
+	return vec
+}
+
+func newTestTSs(t *testing.T, mp *mpool.MPool, tss ...int64) *vector.Vector {
+	vec := vector.NewVec(types.T_TS.ToType())
+	for _, ts := range tss {
+		require.NoError(t, vector.AppendFixed(vec, types.BuildTS(ts, 0), false, mp))
+	}
+	return vec
+}
+
+func TestDecodeTail(t *testing.T) {
+	mp := mpool.MustNewZero()
+	a := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(a, []int64{1, 2, 3}, nil, mp))
+	b := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(b, []string{"x", "y", "z"}, nil, mp))
+	pk := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(pk, []int64{2, 4}, nil, mp))
+
+	tail := &logtail.TableLogtail{
+		Table: &api.TableID{DbId: 1, TbId: 2},
+		Commands: []api.Entry{
+			newTestEntry(t, api.Entry_Insert,
+				[]string{catalog.Row_ID, "commit_time", "a", "b"},
+				newTestRowids(t, mp, 0, 1, 2), newTestTSs(t, mp, 10, 20, 20), a, b),
+			newTestEntry(t, api.Entry_Delete,
+				[]string{catalog.Row_ID, "commit_time", "a"},
+				newTestRowids(t, mp, 5, 6), newTestTSs(t, mp, 20, 20), pk),
+		},
+	}
+	d := newDecoder(newTestTable(), nil)
+
+	changes, err := d.decodeTail(context.Background(), tail, types.TS{})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(changes))
+
+	require.Equal(t, OpCreate, changes[0].Op)
+	require.Equal(t, Row{{"a", int64(1)}, {"b", "x"}}, changes[0].After)
+	require.Nil(t, changes[0].Before)
+
+	// the delete and the insert of 2 by the same txn is an update
+	require.Equal(t, OpUpdate, changes[1].Op)
+	require.Equal(t, Row{{"a", int64(2)}}, changes[1].Before)
+	require.Equal(t, Row{{"a", int64(2)}, {"b", "y"}}, changes[1].After)
+	require.Equal(t, int64(20), changes[1].CommitTS.PhysicalTime)
+
+	require.Equal(t, OpCreate, changes[2].Op)
+	require.Equal(t, Row{{"a", int64(3)}}, changes[2].Key)
+
+	require.Equal(t, OpDelete, changes[3].Op)
+	require.Equal(t, Row{{"a", int64(4)}}, changes[3].Key)
+	require.Nil(t, changes[3].After)
+
+	// the changes committed before the position are skipped
+	changes, err = d.decodeTail(context.Background(), tail, types.BuildTS(10, 0))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(changes))
+	require.Equal(t, OpUpdate, changes[0].Op)
+}
+
+func TestDecodeCompositeKey(t *testing.T) {
+	mp := mpool.MustNewZero()
+	table := &TableInfo{
+		Database: "db",
+		Table:    "t",
+		Columns: []Column{
+			{Name: "a", Seqnum: 0, Type: types.T_int32.ToType()},
+			{Name: "b", Seqnum: 1, Type: types.T_varchar.ToType()},
+			{Name: catalog.CPrimaryKeyColName, Seqnum: 2, Type: types.T_varchar.ToType(), Hidden: true},
+		},
+		PrimaryKey:  catalog.CPrimaryKeyColName,
+		PrimaryKeys: []string{"b", "a"},
+	}
+
+	packer := types.NewPacker(mp)
+	defer packer.FreeMem()
+	packer.EncodeStringType([]byte("x"))
+	packer.EncodeInt32(1)
+	pk := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendBytes(pk, packer.Bytes(), false, mp))
+
+	tail := &logtail.TableLogtail{
+		Commands: []api.Entry{
+			newTestEntry(t, api.Entry_Delete,
+				[]string{catalog.Row_ID, "commit_time", catalog.CPrimaryKeyColName},
+				newTestRowids(t, mp, 0), newTestTSs(t, mp, 10), pk),
+		},
+	}
+	changes, err := newDecoder(table, nil).decodeTail(context.Background(), tail, types.TS{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, OpDelete, changes[0].Op)
+	require.Equal(t, Row{{"b", "x"}, {"a", int32(1)}}, changes[0].Key)
+}
+
+func TestDecodeBlockWithoutFileService(t *testing.T) {
+	mp := mpool.MustNewZero()
+	blk := objectio.NewBlockid(objectio.NewSegmentid(), 0, 0)
+	loc := objectio.BuildLocation(objectio.BuildObjectName(objectio.NewSegmentid(), 0), objectio.Extent{}, 10, 0)
+
+	ids := vector.NewVec(types.T_Blockid.ToType())
+	require.NoError(t, vector.AppendFixed(ids, *blk, false, mp))
+	appendables := vector.NewVec(types.T_bool.ToType())
+	require.NoError(t, vector.AppendFixed(appendables, false, false, mp))
+	sorted := vector.NewVec(types.T_bool.ToType())
+	require.NoError(t, vector.AppendFixed(sorted, false, false, mp))
+	metaLocs := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendBytes(metaLocs, loc, false, mp))
+	deltaLocs := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendBytes(deltaLocs, nil, false, mp))
+	segs := vector.NewVec(types.T_uuid.ToType())
+	require.NoError(t, vector.AppendFixed(segs, types.Uuid{}, false, mp))
+
+	tail := &logtail.TableLogtail{
+		Commands: []api.Entry{
+			newTestEntry(t, api.Entry_Insert,
+				[]string{catalog.Row_ID, "commit_time", catalog.BlockMeta_ID, catalog.BlockMeta_EntryState,
+					catalog.BlockMeta_Sorted, catalog.BlockMeta_MetaLoc, catalog.BlockMeta_DeltaLoc,
+					catalog.BlockMeta_CommitTs, catalog.BlockMeta_SegmentID},
+				newTestRowids(t, mp, 0), newTestTSs(t, mp, 10), ids, appendables, sorted,
+				metaLocs, deltaLocs, newTestTSs(t, mp, 10), segs),
+		},
+	}
+	tail.Commands[0].TableName = "_2_meta"
+
+	// the block written by CN needs the fileservice to read
+	_, err := newDecoder(newTestTable(), nil).decodeTail(context.Background(), tail, types.TS{})
+	require.Error(t, err)
+
+	// the block flushed by the DN is skipped
+	tail.Commands[0].Bat.Vecs[7], _ = vector.VectorToProtoVector(newTestTSs(t, mp, 20))
+	changes, err := newDecoder(newTestTable(), nil).decodeTail(context.Background(), tail, types.TS{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+}
+
+func TestDecodeTxnLocalRows(t *testing.T) {
+	mp := mpool.MustNewZero()
+	blk := objectio.NewBlockid(objectio.NewSegmentid(), 0, 0)
+	rowids := func(offsets ...uint32) *vector.Vector {
+		vec := vector.NewVec(types.T_Rowid.ToType())
+		for _, offset := range offsets {
+			require.NoError(t, vector.AppendFixed(vec, *objectio.NewRowid(blk, offset), false, mp))
+		}
+		return vec
+	}
+	a := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(a, []int64{1, 2, 2}, nil, mp))
+	b := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(b, []string{"x", "y", "z"}, nil, mp))
+	pk := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(pk, []int64{1, 2, 2}, nil, mp))
+
+	// the txn at 20 inserts 1 and deletes it, and updates 2 twice
+	tail := &logtail.TableLogtail{
+		Table: &api.TableID{DbId: 1, TbId: 2},
+		Commands: []api.Entry{
+			newTestEntry(t, api.Entry_Insert,
+				[]string{catalog.Row_ID, "commit_time", "a", "b"},
+				rowids(0, 1, 2), newTestTSs(t, mp, 20, 20, 20), a, b),
+			newTestEntry(t, api.Entry_Delete,
+				[]string{catalog.Row_ID, "commit_time", "a"},
+				rowids(0, 5, 1), newTestTSs(t, mp, 20, 20, 20), pk),
+		},
+	}
+	d := newDecoder(newTestTable(), nil)
+	changes, err := d.decodeTail(context.Background(), tail, types.TS{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, OpUpdate, changes[0].Op)
+	require.Equal(t, Row{{"a", int64(2)}}, changes[0].Before)
+	require.Equal(t, Row{{"a", int64(2)}, {"b", "z"}}, changes[0].After)
+}
@@ -0,0 +1,171 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// Field is a column value of a row.
+type Field struct {
+	Name  string
+	Value any
+}
+
+// Row is the column values of a row, it is encoded as a JSON object keeping
+// the order of the columns.
+type Row []Field
+
+// Get returns the value of the column named name.
+func (r Row) Get(name string) (any, bool) {
+	for _, f := range r {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (r Row) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range r {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// fieldValue returns the value of the row of the column vector. The values
+// are converted like Debezium: the decimals are strings, the temporal
+// values are strings in UTC and the binary strings are base64 encoded.
+func fieldValue(col *Column, vec *vector.Vector, row int) (any, error) {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return nil, nil
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, row), nil
+	case types.T_int8:
+		return vector.GetFixedAt[int8](vec, row), nil
+	case types.T_int16:
+		return vector.GetFixedAt[int16](vec, row), nil
+	case types.T_int32:
+		return vector.GetFixedAt[int32](vec, row), nil
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, row), nil
+	case types.T_uint8:
+		return vector.GetFixedAt[uint8](vec, row), nil
+	case types.T_uint16:
+		return vector.GetFixedAt[uint16](vec, row), nil
+	case types.T_uint32:
+		return vector.GetFixedAt[uint32](vec, row), nil
+	case types.T_uint64, types.T_bit:
+		return vector.GetFixedAt[uint64](vec, row), nil
+	case types.T_float32:
+		return vector.GetFixedAt[float32](vec, row), nil
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, row), nil
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, row).Format(typ.Scale), nil
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, row).Format(typ.Scale), nil
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, row).String(), nil
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, row).String2(typ.Scale), nil
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, row).String2(typ.Scale), nil
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, row).String2(time.UTC, typ.Scale), nil
+	case types.T_year:
+		return vector.GetFixedAt[uint16](vec, row), nil
+	case types.T_char, types.T_varchar, types.T_text:
+		return vec.GetStringAt(row), nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return append([]byte(nil), vec.GetBytesAt(row)...), nil
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(row)).String(), nil
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, row).ToString(), nil
+	case types.T_array_float32:
+		return types.BytesToArrayF32(vec.GetBytesAt(row)), nil
+	case types.T_enum:
+		idx := vector.GetFixedAt[uint16](vec, row)
+		if col == nil || len(col.EnumValues) == 0 {
+			return idx, nil
+		}
+		return types.EnumToString(col.EnumValues, idx)
+	case types.T_set:
+		mask := vector.GetFixedAt[uint64](vec, row)
+		if col == nil || len(col.EnumValues) == 0 {
+			return mask, nil
+		}
+		return types.SetToString(col.EnumValues, mask), nil
+	}
+	return nil, moerr.NewNotSupportedNoCtx("capture the changes of type %s", typ.String())
+}
+
+// tupleValue converts the element of the composite primary key of the
+// column col the same as fieldValue.
+func tupleValue(col *Column, v any) any {
+	switch v := v.(type) {
+	case []byte:
+		switch col.Type.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return v
+		}
+		return string(v)
+	case types.Decimal64:
+		return v.Format(col.Type.Scale)
+	case types.Decimal128:
+		return v.Format(col.Type.Scale)
+	case types.Date:
+		return v.String()
+	case types.Time:
+		return v.String2(col.Type.Scale)
+	case types.Datetime:
+		return v.String2(col.Type.Scale)
+	case types.Timestamp:
+		return v.String2(time.UTC, col.Type.Scale)
+	}
+	return v
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// writerSink writes the Debezium change events of all the tables into a
+// writer, one event per line.
+type writerSink struct {
+	name string
+	w    *bufio.Writer
+}
+
+// NewWriterSink returns a sink writing the Debezium change events into w,
+// name is the logical name of the captured cluster.
+func NewWriterSink(name string, w io.Writer) Sink {
+	return &writerSink{
+		name: name,
+		w:    bufio.NewWriter(w),
+	}
+}
+
+func (s *writerSink) Send(_ context.Context, change *Change) error {
+	return writeEvent(s.w, s.name, change)
+}
+
+func (s *writerSink) Flush(_ context.Context) error {
+	return s.w.Flush()
+}
+
+func (s *writerSink) Close() error {
+	return s.w.Flush()
+}
+
+// fileSink writes the Debezium change events of each table into the file
+// <database>.<table>.json in the directory, one event per line. The events
+// are appended to the files.
+type fileSink struct {
+	name  string
+	dir   string
+	files map[string]*bufferedFile
+}
+
+type bufferedFile struct {
+	f *os.File
+	w *bufio.Writer
+}
+
+// NewFileSink returns a sink writing the Debezium change events into the
+// files in dir, name is the logical name of the captured cluster.
+func NewFileSink(name, dir string) (Sink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		name:  name,
+		dir:   dir,
+		files: make(map[string]*bufferedFile),
+	}, nil
+}
+
+func (s *fileSink) Send(_ context.Context, change *Change) error {
+	name := change.Table.Name()
+	file, ok := s.files[name]
+	if !ok {
+		f, err := os.OpenFile(filepath.Join(s.dir, name+".json"),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		file = &bufferedFile{f: f, w: bufio.NewWriter(f)}
+		s.files[name] = file
+	}
+	return writeEvent(file.w, s.name, change)
+}
+
+func (s *fileSink) Flush(_ context.Context) error {
+	for _, file := range s.files {
+		if err := file.w.Flush(); err != nil {
+			return err
+		}
+		if err := file.f.Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fileSink) Close() error {
+	err := s.Flush(context.Background())
+	for _, file := range s.files {
+		if e := file.f.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	s.files = nil
+	return err
+}
+
+func writeEvent(w *bufio.Writer, name string, change *Change) error {
+	data, err := MarshalDebezium(name, change, time.Now())
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+func TestWriterSink(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	sink := NewWriterSink("mo", &buf)
+	change := &Change{
+		Table:    newTestTable(),
+		Op:       OpUpdate,
+		CommitTS: timestamp.Timestamp{PhysicalTime: 1686000000123456789, LogicalTime: 1},
+		Key:      Row{{"a", int64(2)}},
+		Before:   Row{{"a", int64(2)}},
+		After:    Row{{"a", int64(2)}, {"b", "y"}},
+	}
+	require.NoError(t, sink.Send(ctx, change))
+	require.NoError(t, sink.Send(ctx, &Change{Table: change.Table, Op: OpDelete, Key: change.Key, Before: change.Before}))
+	require.NoError(t, sink.Flush(ctx))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Equal(t, 2, len(lines))
+	require.Contains(t, string(lines[0]), `"before":{"a":2},"after":{"a":2,"b":"y"}`)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &event))
+	require.Equal(t, "u", event["op"])
+	source := event["source"].(map[string]any)
+	require.Equal(t, "matrixone", source["connector"])
+	require.Equal(t, "mo", source["name"])
+	require.Equal(t, "db", source["db"])
+	require.Equal(t, "t", source["table"])
+	require.Equal(t, float64(1686000000123), source["ts_ms"])
+	require.Equal(t, "1686000000123456789-1", source["commit_ts"])
+
+	require.NoError(t, json.Unmarshal(lines[1], &event))
+	require.Equal(t, "d", event["op"])
+	require.Nil(t, event["after"])
+}
+
+func TestFileSinkAndCheckpointer(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sink, err := NewFileSink("mo", dir)
+	require.NoError(t, err)
+	change := &Change{
+		Table: newTestTable(),
+		Op:    OpCreate,
+		Key:   Row{{"a", int64(1)}},
+		After: Row{{"a", int64(1)}, {"b", "x"}},
+	}
+	require.NoError(t, sink.Send(ctx, change))
+	require.NoError(t, sink.Close())
+	data, err := os.ReadFile(filepath.Join(dir, "db.t.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"after":{"a":1,"b":"x"}`)
+
+	ckp := NewFileCheckpointer(filepath.Join(dir, "checkpoint.json"))
+	positions, err := ckp.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(positions))
+	positions["db.t"] = timestamp.Timestamp{PhysicalTime: 10, LogicalTime: 2}
+	require.NoError(t, ckp.Save(ctx, positions))
+	loaded, err := ckp.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, positions, loaded)
+}
@@ -0,0 +1,305 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail/service"
+)
+
+const defaultCheckpointInterval = time.Second
+
+// Option is the option of the Subscriber.
+type Option func(*Subscriber)
+
+// WithFileService sets the fileservice reading the blocks written by CN
+// directly, and the checkpoints and the flushed blocks to resume from.
+func WithFileService(fs fileservice.FileService) Option {
+	return func(s *Subscriber) {
+		s.fs = fs
+	}
+}
+
+// WithLogger sets the logger.
+func WithLogger(logger *zap.Logger) Option {
+	return func(s *Subscriber) {
+		s.logger = logger
+	}
+}
+
+// WithCheckpointInterval sets the interval to flush the sink and save the
+// positions of the tables.
+func WithCheckpointInterval(interval time.Duration) Option {
+	return func(s *Subscriber) {
+		s.checkpointInterval = interval
+	}
+}
+
+// WithStartFrom sets the position of the tables without saved positions,
+// e.g. the snapshot of the backup by mo-dump. The tables without positions
+// start from the time they are subscribed.
+func WithStartFrom(ts timestamp.Timestamp) Option {
+	return func(s *Subscriber) {
+		s.startFrom = ts
+	}
+}
+
+// Subscriber subscribes the tables from the logtail server of the DN, and
+// sends their changes to the sink. The changes are sent at least once, the
+// changes sent after the last saved positions are sent again after
+// restarting.
+type Subscriber struct {
+	address            string
+	tables             []*TableInfo
+	sink               Sink
+	checkpointer       Checkpointer
+	fs                 fileservice.FileService
+	logger             *zap.Logger
+	checkpointInterval time.Duration
+	startFrom          timestamp.Timestamp
+}
+
+// tableState is the capturing state of a table.
+type tableState struct {
+	info       *TableInfo
+	decoder    *decoder
+	subscribed bool
+	// position is the timestamp all the changes committed before or at it
+	// are sent.
+	position types.TS
+}
+
+// NewSubscriber returns a Subscriber of the tables from the logtail server
+// at address.
+func NewSubscriber(
+	address string,
+	tables []*TableInfo,
+	sink Sink,
+	checkpointer Checkpointer,
+	opts ...Option,
+) *Subscriber {
+	s := &Subscriber{
+		address:            address,
+		tables:             tables,
+		sink:               sink,
+		checkpointer:       checkpointer,
+		checkpointInterval: defaultCheckpointInterval,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.logger == nil {
+		s.logger = logutil.GetGlobalLogger().Named("cdc")
+	}
+	return s
+}
+
+// Run sends the changes of the tables until ctx is done or an error occurs.
+// The sink is flushed and the positions are saved before returning.
+func (s *Subscriber) Run(ctx context.Context) (err error) {
+	positions, err := s.checkpointer.Load(ctx)
+	if err != nil {
+		return err
+	}
+	states := make(map[uint64]*tableState, len(s.tables))
+	for _, t := range s.tables {
+		state := &tableState{
+			info:    t,
+			decoder: newDecoder(t, s.fs),
+		}
+		if ts, ok := positions[t.Name()]; ok {
+			state.position = types.TimestampToTS(ts)
+		} else {
+			state.position = types.TimestampToTS(s.startFrom)
+		}
+		states[t.TableID] = state
+	}
+
+	rpcClient, stream, err := newLogtailStream(s.address, s.logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rpcClient.Close()
+	}()
+	client, err := service.NewLogtailClient(stream)
+	if err != nil {
+		_ = stream.Close(true)
+		return err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Receive doesn't wait for ctx, close the client to stop it
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		_ = client.Close()
+	}()
+
+	defer func() {
+		if e := s.save(states, positions); e != nil && err == nil {
+			err = e
+		}
+	}()
+	for _, state := range states {
+		table := api.TableID{DbId: state.info.DatabaseID, TbId: state.info.TableID}
+		if err = client.Subscribe(ctx, table); err != nil {
+			return err
+		}
+	}
+
+	lastSave := time.Now()
+	for {
+		resp, err := client.Receive()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if err = s.handleResponse(ctx, states, resp); err != nil {
+			return err
+		}
+		if time.Since(lastSave) >= s.checkpointInterval {
+			if err = s.save(states, positions); err != nil {
+				return err
+			}
+			lastSave = time.Now()
+		}
+	}
+}
+
+func (s *Subscriber) handleResponse(ctx context.Context, states map[uint64]*tableState, resp *service.LogtailResponse) error {
+	if e := resp.GetError(); e != nil {
+		return moerr.NewInternalError(ctx, "capture table %d: %s", e.Table.GetTbId(), e.Status.Message)
+	}
+
+	if sub := resp.GetSubscribeResponse(); sub != nil {
+		tail := &sub.Logtail
+		state, ok := states[tail.Table.GetTbId()]
+		if !ok {
+			return nil
+		}
+		to := types.TimestampToTS(*tail.Ts)
+		state.subscribed = true
+		if state.position.IsEmpty() {
+			state.position = to
+		} else if state.position.Less(to) {
+			changes, err := state.decoder.decodeSnapshot(ctx, tail, state.position, to)
+			if err != nil {
+				return err
+			}
+			if err = s.send(ctx, changes); err != nil {
+				return err
+			}
+			state.position = to
+		}
+		s.logger.Info("table subscribed",
+			zap.String("table", state.info.Name()),
+			zap.String("position", state.position.ToString()))
+		return nil
+	}
+
+	if update := resp.GetUpdateResponse(); update != nil {
+		for i := range update.LogtailList {
+			tail := &update.LogtailList[i]
+			state, ok := states[tail.Table.GetTbId()]
+			if !ok || !state.subscribed {
+				continue
+			}
+			changes, err := state.decoder.decodeTail(ctx, tail, state.position)
+			if err != nil {
+				return err
+			}
+			if err = s.send(ctx, changes); err != nil {
+				return err
+			}
+		}
+		if update.To != nil {
+			to := types.TimestampToTS(*update.To)
+			for _, state := range states {
+				if state.subscribed && state.position.Less(to) {
+					state.position = to
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Subscriber) send(ctx context.Context, changes []*Change) error {
+	for _, change := range changes {
+		if err := s.sink.Send(ctx, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// save flushes the sink and saves the positions of the tables, the
+// positions of the other tables in the checkpoint are kept.
+func (s *Subscriber) save(states map[uint64]*tableState, positions map[string]timestamp.Timestamp) error {
+	ctx := context.Background()
+	if err := s.sink.Flush(ctx); err != nil {
+		return err
+	}
+	for _, state := range states {
+		if !state.position.IsEmpty() {
+			positions[state.info.Name()] = state.position.ToTimestamp()
+		}
+	}
+	return s.checkpointer.Save(ctx, positions)
+}
+
+// newLogtailStream connects to the logtail server, see also the logtail
+// client of disttae.
+func newLogtailStream(address string, logger *zap.Logger) (morpc.RPCClient, morpc.Stream, error) {
+	codec := morpc.NewMessageCodec(func() morpc.Message {
+		return &service.LogtailResponseSegment{}
+	})
+	factory := morpc.NewGoettyBasedBackendFactory(codec,
+		morpc.WithBackendGoettyOptions(
+			goetty.WithSessionRWBUfferSize(1<<20, 1<<20),
+		),
+		morpc.WithBackendLogger(logger),
+	)
+	client, err := morpc.NewClient(factory,
+		morpc.WithClientTag("cdc-logtail-client"),
+		morpc.WithClientLogger(logger),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	stream, err := client.NewStream(address, true)
+	if err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+	return client, stream, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+// Op is the operation of a change, the values are the same as Debezium.
+type Op string
+
+const (
+	OpCreate Op = "c"
+	OpUpdate Op = "u"
+	OpDelete Op = "d"
+)
+
+// Column is a column of the captured table.
+type Column struct {
+	Name   string
+	Seqnum uint16
+	Type   types.Type
+	// Hidden columns, e.g. the composite primary key, are not in the changes.
+	Hidden bool
+	// EnumValues are the members of the enum and set columns.
+	EnumValues []string
+}
+
+// TableInfo describes a captured table.
+type TableInfo struct {
+	Database   string
+	Table      string
+	DatabaseID uint64
+	TableID    uint64
+	Columns    []Column
+	// PrimaryKey is the column holding the primary key in the storage. It is
+	// a hidden column for the tables with a composite primary key or without
+	// primary key.
+	PrimaryKey string
+	// PrimaryKeys are the primary key columns of the table, it is empty for
+	// the tables without primary key.
+	PrimaryKeys []string
+}
+
+// Name returns the qualified name of the table.
+func (t *TableInfo) Name() string {
+	return t.Database + "." + t.Table
+}
+
+// Column returns the column named name, nil if it doesn't exist.
+func (t *TableInfo) Column(name string) *Column {
+	for i := range t.Columns {
+		if t.Columns[i].Name == name {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+// Change is a row level change committed by a txn. MatrixOne doesn't keep
+// the old value of the updated and deleted rows, so Before only has the
+// primary key of the row.
+type Change struct {
+	Table    *TableInfo
+	Op       Op
+	CommitTS timestamp.Timestamp
+	// Key is the primary key of the row.
+	Key    Row
+	Before Row
+	After  Row
+}
+
+// Sink receives the changes in the order of their commit timestamps.
+type Sink interface {
+	// Send sends a change.
+	Send(ctx context.Context, change *Change) error
+	// Flush persists the sent changes. The positions of the tables are
+	// saved after Flush, so the changes sent before Flush are not sent
+	// again after restarting.
+	Flush(ctx context.Context) error
+	// Close flushes and closes the sink.
+	Close() error
+}
+
+// Checkpointer saves the positions of the captured tables. A position is
+// the timestamp all the changes committed before or at it are sent, the
+// tables are resumed from their positions.
+type Checkpointer interface {
+	// Load returns the positions by the names of the tables.
+	Load(ctx context.Context) (map[string]timestamp.Timestamp, error)
+	// Save saves the positions by the names of the tables.
+	Save(ctx context.Context, positions map[string]timestamp.Timestamp) error
+}
@@ -261,6 +261,7 @@ func newDNService(dn logpb.DNStore) metadata.DNService {
 		LogTailServiceAddress: dn.LogtailServerAddress,
 		LockServiceAddress:    dn.LockServiceAddress,
 		CtlAddress:            dn.CtlAddress,
+		GCRetention:           dn.GCRetention,
 	}
 	v.Shards = make([]metadata.DNShard, 0, len(dn.Shards))
 	for _, s := range dn.Shards {
@@ -17,6 +17,7 @@ package cnservice
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/fagongzi/goetty/v2"
@@ -26,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -33,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
@@ -307,6 +310,12 @@ func (s *service) initEngine(
 	cancelMoServerCtx context.Context,
 	pu *config.ParameterUnit,
 ) error {
+	if s.cfg.Engine.Compression != "" {
+		objectio.SetDefaultCompression(
+			uint8(compress.Algorithms[strings.ToLower(s.cfg.Engine.Compression)]),
+			s.cfg.Engine.CompressionLevel)
+	}
+
 	switch s.cfg.Engine.Type {
 
 	case EngineDistributedTAE:
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/ctlservice"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -97,6 +98,11 @@ type Config struct {
 		ScanInterval        toml.Duration        `toml:"scan-interval"`
 		IncrementalInterval toml.Duration        `toml:"incremental-interval"`
 		GlobalMinCount      int64                `toml:"global-min-count"`
+		// Compression the algorithm compressing the objects written by the
+		// cn. [lz4|zstd|none], default lz4.
+		Compression string `toml:"compression"`
+		// CompressionLevel the level of zstd, default 3.
+		CompressionLevel int `toml:"compression-level"`
 	}
 
 	// parameters for cn-server related buffer.
@@ -237,6 +243,11 @@ func (c *Config) Validate() error {
 	if c.Engine.Logstore == "" {
 		c.Engine.Logstore = options.LogstoreLogservice
 	}
+	if c.Engine.Compression != "" {
+		if _, ok := compress.Algorithms[strings.ToLower(c.Engine.Compression)]; !ok {
+			return moerr.NewInternalError(context.Background(), "%s compression not support", c.Engine.Compression)
+		}
+	}
 	if c.Cluster.RefreshInterval.Duration == 0 {
 		c.Cluster.RefreshInterval.Duration = time.Second * 10
 	}
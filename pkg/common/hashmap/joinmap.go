@@ -31,6 +31,19 @@ func NewJoinMap(sels [][]int32, expr *plan.Expr, mp *StrHashMap, hasNull bool) *
 	}
 }
 
+// NewSpilledJoinMap returns the join map of the build side spilled to disk.
+func NewSpilledJoinMap(spilled Spilled) *JoinMap {
+	cnt := int64(1)
+	return &JoinMap{
+		cnt:     &cnt,
+		spilled: spilled,
+	}
+}
+
+func (jm *JoinMap) Spilled() Spilled {
+	return jm.spilled
+}
+
 func (jm *JoinMap) Sels() [][]int32 {
 	return jm.sels
 }
@@ -48,6 +61,12 @@ func (jm *JoinMap) HasNull() bool {
 }
 
 func (jm *JoinMap) Dup() *JoinMap {
+	if jm.spilled != nil {
+		return &JoinMap{
+			cnt:     jm.cnt,
+			spilled: jm.spilled,
+		}
+	}
 	m0 := &StrHashMap{
 		m:             jm.mp.m,
 		hashMap:       jm.mp.hashMap,
@@ -86,6 +105,11 @@ func (jm *JoinMap) Free() {
 	if atomic.AddInt64(jm.cnt, -1) != 0 {
 		return
 	}
+	if jm.spilled != nil {
+		jm.spilled.Free()
+		jm.spilled = nil
+		return
+	}
 	for i := range jm.sels {
 		jm.sels[i] = nil
 	}
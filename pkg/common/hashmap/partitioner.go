@@ -0,0 +1,55 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashmap
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// NewPartitioner returns a Partitioner of n partitions.
+func NewPartitioner(n uint64) *Partitioner {
+	return &Partitioner{
+		n:     n,
+		parts: make([]uint64, UnitLimit),
+		mp: &StrHashMap{
+			hasNull:       true,
+			keys:          make([][]byte, UnitLimit),
+			values:        make([]uint64, UnitLimit),
+			zValues:       make([]int64, UnitLimit),
+			strHashStates: make([][3]uint64, UnitLimit),
+		},
+	}
+}
+
+// Partition returns the partitions of vecs[start, start+count), count is
+// at most UnitLimit.
+func (p *Partitioner) Partition(vecs []*vector.Vector, start, count int) []uint64 {
+	m := p.mp
+	defer func() {
+		for i := 0; i < count; i++ {
+			m.keys[i] = m.keys[i][:0]
+		}
+	}()
+	copy(m.zValues[:count], OneInt64s[:count])
+	m.encodeHashKeys(vecs, start, count)
+	hashtable.BytesBatchGenHashStates(&m.keys[0], &m.strHashStates[0], count)
+	// the first two hash values are used by the hash table for the cells and
+	// the buckets, so the rows of a partition are still spread in them.
+	for i := 0; i < count; i++ {
+		p.parts[i] = m.strHashStates[i][2] % p.n
+	}
+	return p.parts[:count]
+}
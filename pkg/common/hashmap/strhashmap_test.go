@@ -448,3 +448,24 @@ func newStringVector(n int, typ types.Type, m *mpool.MPool, random bool, vs []st
 	}
 	return vec
 }
+
+func TestPartitioner(t *testing.T) {
+	m := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < 2*Rows; i++ {
+		require.NoError(t, vector.AppendFixed(vec, int64(i%Rows), false, m))
+	}
+	require.NoError(t, vector.AppendFixed(vec, int64(0), true, m))
+	p := NewPartitioner(4)
+	parts := append([]uint64{}, p.Partition([]*vector.Vector{vec}, 0, vec.Length())...)
+	for i, part := range parts {
+		require.Less(t, part, uint64(4))
+		if i >= Rows && i < 2*Rows {
+			// the same keys are in the same partition
+			require.Equal(t, parts[i-Rows], part)
+		}
+	}
+	// the partition does not depend on the start of the rows
+	require.Equal(t, parts[Rows:2*Rows], p.Partition([]*vector.Vector{vec}, Rows, Rows))
+	vec.Free(m)
+}
@@ -70,6 +70,22 @@ type JoinMap struct {
 	expr    *plan.Expr
 	mp      *StrHashMap
 	hasNull bool
+	// spilled is set instead of mp when the build side is spilled to disk
+	spilled Spilled
+}
+
+// Spilled is the build side of a hash join spilled to disk.
+type Spilled interface {
+	// Free deletes the spilled data.
+	Free()
+}
+
+// Partitioner splits rows into partitions by the hash of their keys, the
+// rows with the same keys are always in the same partition.
+type Partitioner struct {
+	n     uint64
+	parts []uint64
+	mp    *StrHashMap
 }
 
 // StrHashMap key is []byte, value is an uint64 value (starting from 1)
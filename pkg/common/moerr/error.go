@@ -126,6 +126,10 @@ const (
 	ErrNoConfig                     uint16 = 20443
 	ErrNoSuchSequence               uint16 = 20444
 	ErrProcedureAlreadyExists       uint16 = 20445
+	ErrTriggerAlreadyExists         uint16 = 20446
+	ErrDropNonExistsTrigger         uint16 = 20447
+	ErrTriggerOnSystemTable         uint16 = 20448
+	ErrTableChangesUnavailable      uint16 = 20449
 
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
@@ -178,6 +182,8 @@ const (
 	ErrTAEDebug                  uint16 = 20626
 	ErrDuplicateKey              uint16 = 20627
 	ErrTxnNeedRetry              uint16 = 20628
+	// ErrSavepointNotExist the savepoint is not set in the transaction
+	ErrSavepointNotExist uint16 = 20629
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
@@ -186,6 +192,8 @@ const (
 	ErrLockTableBindChanged uint16 = 20702
 	// ErrLockTableNotFound lock table not found on remote lock service instance
 	ErrLockTableNotFound uint16 = 20703
+	// ErrLockConflict lock conflict encountered and the lock is not waited
+	ErrLockConflict uint16 = 20704
 
 	// ErrEnd, the max value of MOErrorCode
 	ErrEnd uint16 = 65535
@@ -263,6 +271,10 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrTableAlreadyExists:           {ER_TABLE_EXISTS_ERROR, []string{MySQLDefaultSqlState}, "table %s already exists"},
 	ErrFunctionAlreadyExists:        {ER_UDF_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "function %s already exists"},
 	ErrProcedureAlreadyExists:       {ER_UDF_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "procedure %s already exists"},
+	ErrTriggerAlreadyExists:         {ER_TRG_ALREADY_EXISTS, []string{"HY000"}, "trigger %s already exists"},
+	ErrDropNonExistsTrigger:         {ER_TRG_DOES_NOT_EXIST, []string{"HY000"}, "trigger %s does not exist"},
+	ErrTriggerOnSystemTable:         {ER_NO_TRIGGERS_ON_SYSTEM_SCHEMA, []string{"HY000"}, "triggers can not be created on system tables"},
+	ErrTableChangesUnavailable:      {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "changes of table %s since %s are unavailable"},
 	ErrDropNonExistsFunction:        {ER_CANT_FIND_UDF, []string{MySQLDefaultSqlState}, "function %s doesn't exist"},
 	ErrNoService:                    {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "service %s not found"},
 	ErrDupServiceName:               {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "duplicate service name %s"},
@@ -321,11 +333,13 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrAppendableBlockNotFound:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "appendable block not found"},
 	ErrDuplicateKey:              {ER_DUP_KEYNAME, []string{MySQLDefaultSqlState}, "duplicate key name '%s'"},
 	ErrTxnNeedRetry:              {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "txn need retry in rc mode"},
+	ErrSavepointNotExist:         {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
 	ErrLockTableBindChanged: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "lock table bind chaged"},
 	ErrLockTableNotFound:    {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "lock table not found on remote lock service"},
+	ErrLockConflict:         {ER_LOCK_NOWAIT, []string{"HY000"}, "lock could not be acquired immediately and NOWAIT is set"},
 
 	// Group End: max value of MOErrorCode
 	ErrEnd: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: end of errcode code"},
@@ -703,6 +717,10 @@ func NewNoSuchSequence(ctx context.Context, db, tbl string) *Error {
 	return newError(ctx, ErrNoSuchSequence, db, tbl)
 }
 
+func NewTableChangesUnavailable(ctx context.Context, tbl, ts string) *Error {
+	return newError(ctx, ErrTableChangesUnavailable, tbl, ts)
+}
+
 func NewBadView(ctx context.Context, db, v string) *Error {
 	return newError(ctx, ErrBadView, db, v)
 }
@@ -932,6 +950,10 @@ func NewTxnNeedRetry(ctx context.Context) *Error {
 	return newError(ctx, ErrTxnNeedRetry)
 }
 
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
 func NewDeadLockDetected(ctx context.Context) *Error {
 	return newError(ctx, ErrDeadLockDetected)
 }
@@ -944,6 +966,10 @@ func NewLockTableNotFound(ctx context.Context) *Error {
 	return newError(ctx, ErrLockTableNotFound)
 }
 
+func NewLockConflict(ctx context.Context) *Error {
+	return newError(ctx, ErrLockConflict)
+}
+
 var contextFunc atomic.Value
 
 func SetContextFunc(f func() context.Context) {
@@ -288,6 +288,14 @@ func NewLockTableNotFoundNoCtx() *Error {
 	return newError(Context(), ErrLockTableNotFound)
 }
 
+func NewSavepointNotExistNoCtx(name string) *Error {
+	return newError(Context(), ErrSavepointNotExist, name)
+}
+
+func NewLockConflictNoCtx() *Error {
+	return newError(Context(), ErrLockConflict)
+}
+
 func NewUDFAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrFunctionAlreadyExists, f)
 }
@@ -300,6 +308,18 @@ func NewProcedureAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrProcedureAlreadyExists, f)
 }
 
+func NewTriggerAlreadyExistsNoCtx(t string) *Error {
+	return newError(Context(), ErrTriggerAlreadyExists, t)
+}
+
+func NewDropNonExistsTriggerNoCtx(t string) *Error {
+	return newError(Context(), ErrDropNonExistsTrigger, t)
+}
+
+func NewTriggerOnSystemTableNoCtx() *Error {
+	return newError(Context(), ErrTriggerOnSystemTable)
+}
+
 func NewTxnNeedRetryNoCtx() *Error {
 	return newError(Context(), ErrTxnNeedRetry)
 }
@@ -15,15 +15,23 @@
 package compress
 
 import (
+	"github.com/DataDog/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
+	"zstd": Zstd,
 	"none": None,
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, 0)
+}
+
+// CompressWithLevel compresses src into dst, the level is only used by zstd
+// and the default level is used if it is out of range.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,10 +39,26 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		if level <= 0 || level > MaxZstdLevel {
+			level = DefaultZstdLevel
+		}
+		return zstd.CompressLevel(dst, src, level)
 	}
 	return nil, nil
 }
 
+// CompressBlockBound returns the size of dst needed by Compress in the worst case.
+func CompressBlockBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		return zstd.CompressBound(n)
+	}
+	return n
+}
+
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -43,6 +67,8 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.Decompress(dst, src)
 	}
 	return nil, nil
 }
@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"testing"
@@ -41,3 +42,28 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 10)
+	}
+	raw := types.EncodeSlice(xs)
+	for _, level := range []int{0, 1, DefaultZstdLevel, MaxZstdLevel, MaxZstdLevel + 1} {
+		buf := make([]byte, CompressBlockBound(len(raw), Zstd))
+		buf, err := CompressWithLevel(raw, buf, Zstd, level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) >= len(raw) {
+			t.Fatalf("level %d: compressed %d bytes to %d bytes", level, len(raw), len(buf))
+		}
+		data, err := Decompress(buf, make([]byte, len(raw)), Zstd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("level %d: unexpected decompressed data", level)
+		}
+	}
+}
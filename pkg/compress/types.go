@@ -19,6 +19,14 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+)
+
+const (
+	// DefaultZstdLevel is the zstd level used if the level isn't specified
+	DefaultZstdLevel = 3
+	// MaxZstdLevel is the maximum zstd level
+	MaxZstdLevel = 22
 )
 
 type T uint8
@@ -29,6 +37,8 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -211,6 +211,9 @@ type FrontendParameters struct {
 	//process.Limitation.PartitionRows. default: 10 << 32 = 42949672960
 	ProcessLimitationPartitionRows int64 `toml:"processLimitationPartitionRows"`
 
+	//the memory budget of a query in bytes, the hash join, group by and order by spill to disk over it. default: 0, no limit
+	QueryMemoryLimit int64 `toml:"queryMemoryLimit"`
+
 	//the root directory of the storage and matrixcube's data. The actual dir is cubeDirPrefix + nodeID
 	StorePath string `toml:"storePath"`
 
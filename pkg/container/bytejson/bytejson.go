@@ -673,3 +673,244 @@ func ParseJsonByteFromString(s string) ([]byte, error) {
 	}
 	return buf, err
 }
+
+// Lookup returns the values matched by the path. Unlike Query, a missing key
+// or index matches nothing, so it tells a missing value from a json null.
+func (bj ByteJson) Lookup(path *Path) []ByteJson {
+	return bj.lookup(nil, path.paths)
+}
+
+func (bj ByteJson) lookup(cur []ByteJson, legs []subPath) []ByteJson {
+	if len(legs) == 0 {
+		return append(cur, bj)
+	}
+	sub := legs[0]
+	switch sub.tp {
+	case subPathDoubleStar:
+		cur = bj.lookup(cur, legs[1:])
+		if bj.Type == TpCodeObject {
+			for i := 0; i < bj.GetElemCnt(); i++ {
+				cur = bj.getObjectVal(i).lookup(cur, legs)
+			}
+		} else if bj.Type == TpCodeArray {
+			for i := 0; i < bj.GetElemCnt(); i++ {
+				cur = bj.getArrayElem(i).lookup(cur, legs)
+			}
+		}
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return cur
+		}
+		cnt := bj.GetElemCnt()
+		if sub.key == "*" {
+			for i := 0; i < cnt; i++ {
+				cur = bj.getObjectVal(i).lookup(cur, legs[1:])
+			}
+			return cur
+		}
+		key := util.UnsafeStringToBytes(sub.key)
+		idx := sort.Search(cnt, func(i int) bool {
+			return bytes.Compare(bj.getObjectKey(i), key) >= 0
+		})
+		if idx < cnt && bytes.Equal(bj.getObjectKey(idx), key) {
+			cur = bj.getObjectVal(idx).lookup(cur, legs[1:])
+		}
+	case subPathIdx, subPathRange:
+		cnt := 1
+		if bj.Type == TpCodeArray {
+			cnt = bj.GetElemCnt()
+		}
+		start, end := 0, cnt-1
+		if sub.tp == subPathIdx && !(sub.idx.tp == numberIndices && sub.idx.num == subPathIdxALL) {
+			start = resolveIndex(sub.idx, cnt)
+			end = start
+		} else if sub.tp == subPathRange {
+			start, end = resolveIndex(sub.iRange.start, cnt), resolveIndex(sub.iRange.end, cnt)
+		}
+		if start < 0 {
+			start = 0
+		}
+		if end >= cnt {
+			end = cnt - 1
+		}
+		for i := start; i <= end; i++ {
+			if bj.Type == TpCodeArray {
+				cur = bj.getArrayElem(i).lookup(cur, legs[1:])
+			} else {
+				// a value which is not an array is treated as an array of one element
+				cur = bj.lookup(cur, legs[1:])
+			}
+		}
+	}
+	return cur
+}
+
+// Keys returns the keys of the object as a json array.
+func (bj ByteJson) Keys() ByteJson {
+	cnt := bj.GetElemCnt()
+	keys := make([]interface{}, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = string(bj.getObjectKey(i))
+	}
+	ret, _ := createByteJson(keys)
+	return ret
+}
+
+// Length returns the number of the elements of an array or the members of an
+// object, the length of a scalar is 1.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeArray || bj.Type == TpCodeObject {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
+
+// TypeName returns the type name of the value as JSON_TYPE shows it.
+func (bj ByteJson) TypeName() string {
+	switch bj.Type {
+	case TpCodeObject:
+		return "OBJECT"
+	case TpCodeArray:
+		return "ARRAY"
+	case TpCodeInt64:
+		return "INTEGER"
+	case TpCodeUint64:
+		return "UNSIGNED INTEGER"
+	case TpCodeFloat64:
+		return "DOUBLE"
+	case TpCodeString:
+		return "STRING"
+	case TpCodeLiteral:
+		if bj.IsNull() {
+			return "NULL"
+		}
+		return "BOOLEAN"
+	}
+	return "UNKNOWN"
+}
+
+// Equal reports whether the two values are equal, the numbers are compared by
+// their values whatever their types are.
+func (bj ByteJson) Equal(other ByteJson) bool {
+	if bj.isNumber() && other.isNumber() {
+		return compareNumber(bj, other) == 0
+	}
+	if bj.Type != other.Type {
+		return false
+	}
+	switch bj.Type {
+	case TpCodeLiteral:
+		return bj.Data[0] == other.Data[0]
+	case TpCodeString:
+		return bytes.Equal(bj.GetString(), other.GetString())
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		if cnt != other.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			if !bj.getArrayElem(i).Equal(other.getArrayElem(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeObject:
+		cnt := bj.GetElemCnt()
+		if cnt != other.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			if !bytes.Equal(bj.getObjectKey(i), other.getObjectKey(i)) ||
+				!bj.getObjectVal(i).Equal(other.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func compareNumber(a, b ByteJson) int {
+	if a.Type == b.Type {
+		switch a.Type {
+		case TpCodeInt64:
+			return compare(a.GetInt64(), b.GetInt64())
+		case TpCodeUint64:
+			return compare(a.GetUint64(), b.GetUint64())
+		}
+	}
+	if a.Type == TpCodeInt64 && b.Type == TpCodeUint64 {
+		if a.GetInt64() < 0 {
+			return -1
+		}
+		return compare(uint64(a.GetInt64()), b.GetUint64())
+	}
+	if a.Type == TpCodeUint64 && b.Type == TpCodeInt64 {
+		return -compareNumber(b, a)
+	}
+	return compare(a.toFloat(), b.toFloat())
+}
+
+func (bj ByteJson) toFloat() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
+func compare[T int64 | uint64 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Contains reports whether the candidate is contained in the document as
+// JSON_CONTAINS defines: a scalar contains an equal scalar, an array contains
+// a candidate array whose elements are all contained in its elements and a
+// non-array candidate contained in any of its elements, an object contains
+// a candidate object whose members are all contained in its members.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i := 0; i < candidate.GetElemCnt(); i++ {
+				if !bj.Contains(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := 0; i < bj.GetElemCnt(); i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i := 0; i < candidate.GetElemCnt(); i++ {
+			val := bj.Lookup(&Path{paths: []subPath{{tp: subPathKey, key: string(candidate.getObjectKey(i))}}})
+			if len(val) == 0 || !val[0].Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	if candidate.Type == TpCodeArray || candidate.Type == TpCodeObject {
+		return false
+	}
+	return bj.Equal(candidate)
+}
@@ -0,0 +1,291 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// ModifyType is the way JSON_SET, JSON_INSERT and JSON_REPLACE treat the
+// value at a path.
+type ModifyType byte
+
+const (
+	// ModifySet replaces the existing value and adds the missing one.
+	ModifySet ModifyType = iota + 1
+	// ModifyInsert only adds the missing value.
+	ModifyInsert
+	// ModifyReplace only replaces the existing value.
+	ModifyReplace
+)
+
+// CreateArray builds a json array of the elements.
+func CreateArray(elems []ByteJson) ByteJson {
+	return *mergeToArray(elems)
+}
+
+// CreateObject builds a json object of the key-value pairs, the value of a
+// duplicate key is the last one.
+func CreateObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		obj[key] = vals[i]
+	}
+	return createByteJson(obj)
+}
+
+// ConcatArrays concatenates the elements of the arrays into one array, a value
+// which is not an array is taken as an element.
+func ConcatArrays(arrs []ByteJson) ByteJson {
+	elems := make([]ByteJson, 0, len(arrs))
+	for _, arr := range arrs {
+		if arr.Type != TpCodeArray {
+			elems = append(elems, arr)
+			continue
+		}
+		for i := 0; i < arr.GetElemCnt(); i++ {
+			elems = append(elems, arr.getArrayElem(i))
+		}
+	}
+	return CreateArray(elems)
+}
+
+// MergeObjects merges the members of the objects into one object, the value of
+// a duplicate key is the last one.
+func MergeObjects(objs []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{})
+	for _, o := range objs {
+		if o.Type != TpCodeObject {
+			return Null, moerr.NewInvalidInputNoCtx("json value %s is not an object", o.String())
+		}
+		for i := 0; i < o.GetElemCnt(); i++ {
+			obj[string(o.getObjectKey(i))] = o.getObjectVal(i)
+		}
+	}
+	return createByteJson(obj)
+}
+
+func createByteJson(in interface{}) (ByteJson, error) {
+	var bj ByteJson
+	err := bj.UnmarshalObject(in)
+	return bj, err
+}
+
+// decodeArray returns the elements of the array, the returned slice can be
+// changed and encoded again by addElem.
+func (bj ByteJson) decodeArray() []interface{} {
+	cnt := bj.GetElemCnt()
+	elems := make([]interface{}, cnt)
+	for i := 0; i < cnt; i++ {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+// decodeObject returns the members of the object, the returned map can be
+// changed and encoded again by addElem.
+func (bj ByteJson) decodeObject() map[string]interface{} {
+	cnt := bj.GetElemCnt()
+	obj := make(map[string]interface{}, cnt)
+	for i := 0; i < cnt; i++ {
+		obj[string(bj.getObjectKey(i))] = bj.getObjectVal(i)
+	}
+	return obj
+}
+
+// checkModifyPath checks that the path can be used to change a document, which
+// can't contain wildcards or ranges.
+func checkModifyPath(path *Path) error {
+	if path.flag != 0 {
+		return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the * and ** tokens")
+	}
+	for _, sub := range path.paths {
+		if sub.tp == subPathRange {
+			return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the range tokens")
+		}
+	}
+	return nil
+}
+
+// resolveIndex returns the position of the index in an array of cnt elements,
+// the position of 'last - n' may be negative.
+func resolveIndex(idx *subPathIndices, cnt int) int {
+	if idx.tp == lastIndices {
+		return cnt - 1 - idx.num
+	}
+	return idx.num
+}
+
+// Modify sets the values at the paths one by one, the way it treats the
+// existing and the missing values depends on tp.
+func (bj ByteJson) Modify(paths []*Path, vals []ByteJson, tp ModifyType) (ByteJson, error) {
+	if len(paths) != len(vals) {
+		return bj, moerr.NewInvalidInputNoCtx("the number of paths and values are not equal")
+	}
+	for i, path := range paths {
+		if err := checkModifyPath(path); err != nil {
+			return bj, err
+		}
+		res, changed := modifyAt(bj, path.paths, vals[i], tp)
+		if !changed {
+			continue
+		}
+		var err error
+		if bj, err = createByteJson(res); err != nil {
+			return bj, err
+		}
+	}
+	return bj, nil
+}
+
+func modifyAt(bj ByteJson, legs []subPath, val ByteJson, tp ModifyType) (interface{}, bool) {
+	if len(legs) == 0 {
+		if tp == ModifyInsert {
+			return bj, false
+		}
+		return val, true
+	}
+	sub := legs[0]
+	switch sub.tp {
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return bj, false
+		}
+		obj := bj.decodeObject()
+		if child, ok := obj[sub.key]; ok {
+			res, changed := modifyAt(child.(ByteJson), legs[1:], val, tp)
+			if changed {
+				obj[sub.key] = res
+			}
+			return obj, changed
+		}
+		if len(legs) == 1 && tp != ModifyReplace {
+			obj[sub.key] = val
+			return obj, true
+		}
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			// a value which is not an array is treated as an array of one element
+			idx := resolveIndex(sub.idx, 1)
+			if idx == 0 {
+				return modifyAt(bj, legs[1:], val, tp)
+			}
+			if idx > 0 && len(legs) == 1 && tp != ModifyReplace {
+				return []interface{}{bj, val}, true
+			}
+			return bj, false
+		}
+		elems := bj.decodeArray()
+		idx := resolveIndex(sub.idx, len(elems))
+		if idx >= 0 && idx < len(elems) {
+			res, changed := modifyAt(elems[idx].(ByteJson), legs[1:], val, tp)
+			if changed {
+				elems[idx] = res
+			}
+			return elems, changed
+		}
+		if idx >= len(elems) && len(legs) == 1 && tp != ModifyReplace {
+			return append(elems, val), true
+		}
+	}
+	return bj, false
+}
+
+// Remove removes the values at the paths one by one.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	for _, path := range paths {
+		if err := checkModifyPath(path); err != nil {
+			return bj, err
+		}
+		if path.empty() {
+			return bj, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in this context")
+		}
+		res, changed := removeAt(bj, path.paths)
+		if !changed {
+			continue
+		}
+		var err error
+		if bj, err = createByteJson(res); err != nil {
+			return bj, err
+		}
+	}
+	return bj, nil
+}
+
+func removeAt(bj ByteJson, legs []subPath) (interface{}, bool) {
+	sub := legs[0]
+	switch {
+	case sub.tp == subPathKey && bj.Type == TpCodeObject:
+		obj := bj.decodeObject()
+		child, ok := obj[sub.key]
+		if !ok {
+			return bj, false
+		}
+		if len(legs) == 1 {
+			delete(obj, sub.key)
+			return obj, true
+		}
+		res, changed := removeAt(child.(ByteJson), legs[1:])
+		if changed {
+			obj[sub.key] = res
+		}
+		return obj, changed
+	case sub.tp == subPathIdx && bj.Type == TpCodeArray:
+		elems := bj.decodeArray()
+		idx := resolveIndex(sub.idx, len(elems))
+		if idx < 0 || idx >= len(elems) {
+			return bj, false
+		}
+		if len(legs) == 1 {
+			return append(elems[:idx], elems[idx+1:]...), true
+		}
+		res, changed := removeAt(elems[idx].(ByteJson), legs[1:])
+		if changed {
+			elems[idx] = res
+		}
+		return elems, changed
+	}
+	return bj, false
+}
+
+// MergePatch merges the patch into the document as RFC 7396 describes.
+func (bj ByteJson) MergePatch(patch ByteJson) (ByteJson, error) {
+	return createByteJson(mergePatch(bj, patch))
+}
+
+func mergePatch(target, patch ByteJson) interface{} {
+	if patch.Type != TpCodeObject {
+		return patch
+	}
+	obj := make(map[string]interface{})
+	if target.Type == TpCodeObject {
+		obj = target.decodeObject()
+	}
+	cnt := patch.GetElemCnt()
+	for i := 0; i < cnt; i++ {
+		key := string(patch.getObjectKey(i))
+		val := patch.getObjectVal(i)
+		if val.IsNull() {
+			delete(obj, key)
+			continue
+		}
+		old, ok := obj[key]
+		if !ok {
+			old = Null
+		}
+		obj[key] = mergePatch(old.(ByteJson), val)
+	}
+	return obj
+}
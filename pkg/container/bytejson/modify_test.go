@@ -0,0 +1,188 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func mustParsePaths(t *testing.T, ss ...string) []*Path {
+	paths := make([]*Path, len(ss))
+	for i, s := range ss {
+		p, err := ParseJsonPath(s)
+		require.NoError(t, err)
+		paths[i] = &p
+	}
+	return paths
+}
+
+func TestCreate(t *testing.T) {
+	arr := CreateArray([]ByteJson{mustParse(t, `1`), Null, mustParse(t, `{"a": [true]}`)})
+	require.Equal(t, `[1, null, {"a": [true]}]`, arr.String())
+
+	obj, err := CreateObject([]string{"b", "a", "b"}, []ByteJson{mustParse(t, `1`), arr, mustParse(t, `"x"`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [1, null, {"a": [true]}], "b": "x"}`, obj.String())
+}
+
+func TestConcatAndMerge(t *testing.T) {
+	arr := ConcatArrays([]ByteJson{mustParse(t, `[1, 2]`), mustParse(t, `[]`), mustParse(t, `"x"`), mustParse(t, `[[3]]`)})
+	require.Equal(t, `[1, 2, "x", [3]]`, arr.String())
+
+	obj, err := MergeObjects([]ByteJson{mustParse(t, `{"a": 1, "b": 2}`), mustParse(t, `{"b": 3}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": 3}`, obj.String())
+	_, err = MergeObjects([]ByteJson{mustParse(t, `[1]`)})
+	require.Error(t, err)
+}
+
+func TestModify(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3]}`
+	cases := []struct {
+		tp   ModifyType
+		path string
+		val  string
+		want string
+	}{
+		{tp: ModifySet, path: "$.a", val: `10`, want: `{"a": 10, "b": [2, 3]}`},
+		{tp: ModifySet, path: "$.c", val: `"x"`, want: `{"a": 1, "b": [2, 3], "c": "x"}`},
+		{tp: ModifySet, path: "$.b[5]", val: `4`, want: `{"a": 1, "b": [2, 3, 4]}`},
+		{tp: ModifySet, path: "$.b[last]", val: `4`, want: `{"a": 1, "b": [2, 4]}`},
+		{tp: ModifySet, path: "$.a[1]", val: `4`, want: `{"a": [1, 4], "b": [2, 3]}`},
+		{tp: ModifySet, path: "$.c.d", val: `4`, want: doc},
+		{tp: ModifyInsert, path: "$.a", val: `10`, want: doc},
+		{tp: ModifyInsert, path: "$.c", val: `[]`, want: `{"a": 1, "b": [2, 3], "c": []}`},
+		{tp: ModifyReplace, path: "$.a", val: `{"x": 1}`, want: `{"a": {"x": 1}, "b": [2, 3]}`},
+		{tp: ModifyReplace, path: "$.c", val: `10`, want: doc},
+		{tp: ModifyReplace, path: "$.b[0]", val: `10`, want: `{"a": 1, "b": [10, 3]}`},
+		{tp: ModifySet, path: "$", val: `10`, want: `10`},
+	}
+	for i, c := range cases {
+		ret, err := mustParse(t, doc).Modify(mustParsePaths(t, c.path), []ByteJson{mustParse(t, c.val)}, c.tp)
+		require.NoError(t, err, i)
+		require.Equal(t, c.want, ret.String(), i)
+	}
+
+	ret, err := mustParse(t, doc).Modify(mustParsePaths(t, "$.a", "$.b[0]"), []ByteJson{mustParse(t, `5`), mustParse(t, `6`)}, ModifySet)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 5, "b": [6, 3]}`, ret.String())
+
+	_, err = mustParse(t, doc).Modify(mustParsePaths(t, "$.*"), []ByteJson{mustParse(t, `5`)}, ModifySet)
+	require.Error(t, err)
+	_, err = mustParse(t, doc).Modify(mustParsePaths(t, "$.b[0 to 1]"), []ByteJson{mustParse(t, `5`)}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	doc := mustParse(t, `{"a": 1, "b": [2, {"c": 3}]}`)
+	ret, err := doc.Remove(mustParsePaths(t, "$.a"))
+	require.NoError(t, err)
+	require.Equal(t, `{"b": [2, {"c": 3}]}`, ret.String())
+	ret, err = doc.Remove(mustParsePaths(t, "$.b[0]", "$.b[0].c", "$.x"))
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [{}]}`, ret.String())
+	_, err = doc.Remove(mustParsePaths(t, "$"))
+	require.Error(t, err)
+}
+
+func TestMergePatch(t *testing.T) {
+	cases := []struct {
+		target, patch, want string
+	}{
+		{target: `{"a": 1, "b": 2}`, patch: `{"a": 3, "c": 4}`, want: `{"a": 3, "b": 2, "c": 4}`},
+		{target: `{"a": 1, "b": 2}`, patch: `{"b": null}`, want: `{"a": 1}`},
+		{target: `{"a": {"x": 1}}`, patch: `{"a": {"y": 2, "z": null}}`, want: `{"a": {"x": 1, "y": 2}}`},
+		{target: `[1, 2]`, patch: `{"a": 1}`, want: `{"a": 1}`},
+		{target: `{"a": 1}`, patch: `[1, 2]`, want: `[1, 2]`},
+	}
+	for i, c := range cases {
+		ret, err := mustParse(t, c.target).MergePatch(mustParse(t, c.patch))
+		require.NoError(t, err, i)
+		require.Equal(t, c.want, ret.String(), i)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	doc := mustParse(t, `{"a": null, "b": [1, 2, {"c": 3}]}`)
+	cases := []struct {
+		path string
+		want []string
+	}{
+		{path: "$.a", want: []string{"null"}},
+		{path: "$.x", want: nil},
+		{path: "$.b[5]", want: nil},
+		{path: "$.b[last]", want: []string{`{"c": 3}`}},
+		{path: "$.b[0 to 1]", want: []string{"1", "2"}},
+		{path: "$.b[*].c", want: []string{"3"}},
+		{path: "$**.c", want: []string{"3"}},
+		{path: "$.b[2][0].c", want: []string{"3"}},
+	}
+	for _, c := range cases {
+		vals := doc.Lookup(mustParsePaths(t, c.path)[0])
+		var got []string
+		for _, v := range vals {
+			got = append(got, v.String())
+		}
+		require.Equal(t, c.want, got, c.path)
+	}
+}
+
+func TestContains(t *testing.T) {
+	cases := []struct {
+		target, candidate string
+		want              bool
+	}{
+		{target: `{"a": 1, "b": 2, "c": {"d": 4}}`, candidate: `1`, want: false},
+		{target: `{"a": 1, "b": 2, "c": {"d": 4}}`, candidate: `{"a": 1}`, want: true},
+		{target: `{"a": 1, "b": 2, "c": {"d": 4}}`, candidate: `{"a": 1, "c": {"d": 4}}`, want: true},
+		{target: `{"a": 1, "b": 2, "c": {"d": 4}}`, candidate: `{"a": 2}`, want: false},
+		{target: `[1, 2, [3, 4]]`, candidate: `[1, 3]`, want: true},
+		{target: `[1, 2, [3, 4]]`, candidate: `[1, 5]`, want: false},
+		{target: `[1, 2, {"a": "x"}]`, candidate: `{"a": "x"}`, want: true},
+		{target: `[1.0, 2]`, candidate: `1`, want: true},
+		{target: `1`, candidate: `[1]`, want: false},
+		{target: `"a"`, candidate: `"a"`, want: true},
+	}
+	for i, c := range cases {
+		require.Equal(t, c.want, mustParse(t, c.target).Contains(mustParse(t, c.candidate)), i)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	doc := mustParse(t, `{"b": 1, "a": [1, 2, 3]}`)
+	require.Equal(t, `["a", "b"]`, doc.Keys().String())
+	require.Equal(t, 2, doc.Length())
+	require.Equal(t, 1, mustParse(t, `"x"`).Length())
+	require.Equal(t, "OBJECT", doc.TypeName())
+	require.Equal(t, "ARRAY", mustParse(t, `[]`).TypeName())
+	require.Equal(t, "INTEGER", mustParse(t, `-1`).TypeName())
+	require.Equal(t, "UNSIGNED INTEGER", mustParse(t, `18446744073709551615`).TypeName())
+	require.Equal(t, "DOUBLE", mustParse(t, `1.5`).TypeName())
+	require.Equal(t, "BOOLEAN", mustParse(t, `true`).TypeName())
+	require.Equal(t, "NULL", mustParse(t, `null`).TypeName())
+	require.Equal(t, "STRING", mustParse(t, `"x"`).TypeName())
+
+	require.True(t, mustParse(t, `[1, {"a": 2}]`).Equal(mustParse(t, `[1.0, {"a": 2}]`)))
+	require.False(t, mustParse(t, `[1, 2]`).Equal(mustParse(t, `[2, 1]`)))
+	require.False(t, mustParse(t, `-1`).Equal(mustParse(t, `18446744073709551615`)))
+}
@@ -76,6 +76,11 @@ func addElem(buf []byte, in interface{}) (TpCode, []byte, error) {
 	case uint64:
 		tpCode = TpCodeUint64
 		buf = addUint64(buf, x)
+	case float64:
+		tpCode = TpCodeFloat64
+		if err = checkFloat64(x); err == nil {
+			buf = addFloat64(buf, x)
+		}
 	case json.Number:
 		tpCode, buf, err = addJsonNumber(buf, x)
 	case string:
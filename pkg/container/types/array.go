@@ -0,0 +1,91 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// MaxArrayDimension is the max dimension of VECTOR(n).
+	MaxArrayDimension = 16000
+)
+
+// BytesToArrayF32 returns the elements of a vector value. The result shares
+// the memory of bs.
+func BytesToArrayF32(bs []byte) []float32 {
+	if len(bs) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*float32)(unsafe.Pointer(&bs[0])), len(bs)/4)
+}
+
+// ArrayF32ToBytes returns the stored form of a vector value. The result
+// shares the memory of arr.
+func ArrayF32ToBytes(arr []float32) []byte {
+	if len(arr) == 0 {
+		return []byte{}
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&arr[0])), len(arr)*4)
+}
+
+// ParseArrayF32 parses the text form of a vector, e.g. [1, 2.5, -3]. If dim is
+// positive, the dimension of the vector must be dim.
+func ParseArrayF32(s string, dim int32) ([]float32, error) {
+	t := strings.TrimSpace(s)
+	if len(t) < 2 || t[0] != '[' || t[len(t)-1] != ']' {
+		return nil, moerr.NewInvalidInputNoCtx("malformed vector '%s'", s)
+	}
+	t = strings.TrimSpace(t[1 : len(t)-1])
+
+	var arr []float32
+	if len(t) > 0 {
+		parts := strings.Split(t, ",")
+		arr = make([]float32, len(parts))
+		for i, p := range parts {
+			f, err := strconv.ParseFloat(strings.TrimSpace(p), 32)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, moerr.NewInvalidInputNoCtx("malformed vector '%s'", s)
+			}
+			arr[i] = float32(f)
+		}
+	}
+	if len(arr) == 0 || len(arr) > MaxArrayDimension {
+		return nil, moerr.NewInvalidInputNoCtx("vector dimension %d out of range [1, %d]", len(arr), MaxArrayDimension)
+	}
+	if dim > 0 && int32(len(arr)) != dim {
+		return nil, moerr.NewInvalidInputNoCtx("expected vector dimension %d, got %d", dim, len(arr))
+	}
+	return arr, nil
+}
+
+// ArrayF32ToString returns the text form of a vector.
+func ArrayF32ToString(arr []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range arr {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
@@ -311,11 +311,11 @@ func DecodeValue(val []byte, t T) any {
 		return DecodeFixed[int64](val)
 	case T_uint8:
 		return DecodeFixed[uint8](val)
-	case T_uint16:
+	case T_uint16, T_year, T_enum:
 		return DecodeFixed[uint16](val)
 	case T_uint32:
 		return DecodeFixed[uint32](val)
-	case T_uint64:
+	case T_uint64, T_bit, T_set:
 		return DecodeFixed[uint64](val)
 	case T_float32:
 		return DecodeFixed[float32](val)
@@ -339,7 +339,7 @@ func DecodeValue(val []byte, t T) any {
 		return DecodeFixed[TS](val)
 	case T_Rowid:
 		return DecodeFixed[Rowid](val)
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_array_float32, T_text, T_binary, T_varbinary:
 		return val
 	default:
 		panic(fmt.Sprintf("unsupported type %v", t))
@@ -360,11 +360,11 @@ func EncodeValue(val any, t T) []byte {
 		return EncodeFixed(val.(int64))
 	case T_uint8:
 		return EncodeFixed(val.(uint8))
-	case T_uint16:
+	case T_uint16, T_year, T_enum:
 		return EncodeFixed(val.(uint16))
 	case T_uint32:
 		return EncodeFixed(val.(uint32))
-	case T_uint64:
+	case T_uint64, T_bit, T_set:
 		return EncodeFixed(val.(uint64))
 	case T_float32:
 		return EncodeFixed(val.(float32))
@@ -388,7 +388,7 @@ func EncodeValue(val any, t T) []byte {
 		return EncodeFixed(val.(TS))
 	case T_Rowid:
 		return EncodeFixed(val.(Rowid))
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_array_float32, T_text, T_binary, T_varbinary:
 		return val.([]byte)
 	default:
 		panic(fmt.Sprintf("unsupported type %v", t))
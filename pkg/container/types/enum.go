@@ -0,0 +1,244 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	MaxEnumLen = 65535
+	MaxSetLen  = 64
+	MaxBitLen  = 64
+
+	MinYear = 1901
+	MaxYear = 2155
+)
+
+// FormatEnumValues returns the members of an enum or set column in the form
+// used by the column definition, e.g. 'a','b'. The members are kept in
+// this form in the catalog, so that SHOW CREATE TABLE can print it as is.
+func FormatEnumValues(values []string) string {
+	var b strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\'')
+		b.WriteString(strings.ReplaceAll(v, "'", "''"))
+		b.WriteByte('\'')
+	}
+	return b.String()
+}
+
+// ParseEnumValues parses the members formatted by FormatEnumValues.
+func ParseEnumValues(s string) ([]string, error) {
+	var values []string
+	for i := 0; i < len(s); {
+		if s[i] != '\'' {
+			return nil, moerr.NewInvalidInputNoCtx("invalid enum values %s", s)
+		}
+		var b strings.Builder
+		closed := false
+		for i++; i < len(s); i++ {
+			if s[i] == '\'' {
+				if i+1 < len(s) && s[i+1] == '\'' {
+					b.WriteByte('\'')
+					i++
+					continue
+				}
+				closed = true
+				i++
+				break
+			}
+			b.WriteByte(s[i])
+		}
+		if !closed {
+			return nil, moerr.NewInvalidInputNoCtx("invalid enum values %s", s)
+		}
+		values = append(values, b.String())
+		if i < len(s) {
+			if s[i] != ',' || i == len(s)-1 {
+				return nil, moerr.NewInvalidInputNoCtx("invalid enum values %s", s)
+			}
+			i++
+		}
+	}
+	return values, nil
+}
+
+// ParseEnum returns the 1-based index of the member matched by str. Like
+// mysql, a number which is not a member is taken as the index.
+func ParseEnum(values []string, str string) (uint16, error) {
+	str = strings.TrimRight(str, " ")
+	for i, v := range values {
+		if strings.EqualFold(v, str) {
+			return uint16(i + 1), nil
+		}
+	}
+	if idx, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return ParseEnumIndex(values, idx)
+	}
+	return 0, moerr.NewDataTruncatedNoCtx("enum", "value '%s' is not in %s", str, FormatEnumValues(values))
+}
+
+// ParseEnumIndex checks the 1-based index of the enum members.
+func ParseEnumIndex(values []string, idx uint64) (uint16, error) {
+	if idx == 0 || idx > uint64(len(values)) {
+		return 0, moerr.NewDataTruncatedNoCtx("enum", "index %d is out of range", idx)
+	}
+	return uint16(idx), nil
+}
+
+// EnumToString returns the member of the 1-based index, 0 is the empty
+// string as mysql.
+func EnumToString(values []string, idx uint16) (string, error) {
+	if idx == 0 {
+		return "", nil
+	}
+	if int(idx) > len(values) {
+		return "", moerr.NewDataTruncatedNoCtx("enum", "index %d is out of range", idx)
+	}
+	return values[idx-1], nil
+}
+
+// ParseSet returns the bitmask of the comma separated members in str.
+func ParseSet(values []string, str string) (uint64, error) {
+	var mask uint64
+	if str == "" {
+		return 0, nil
+	}
+	for _, s := range strings.Split(str, ",") {
+		s = strings.TrimRight(s, " ")
+		found := false
+		for i, v := range values {
+			if strings.EqualFold(v, s) {
+				mask |= 1 << uint(i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			if len(values) > 0 {
+				if n, err := strconv.ParseUint(str, 10, 64); err == nil {
+					return ParseSetMask(values, n)
+				}
+			}
+			return 0, moerr.NewDataTruncatedNoCtx("set", "value '%s' is not in %s", s, FormatEnumValues(values))
+		}
+	}
+	return mask, nil
+}
+
+// ParseSetMask checks the bitmask of the set members.
+func ParseSetMask(values []string, mask uint64) (uint64, error) {
+	if len(values) < MaxSetLen && mask>>uint(len(values)) != 0 {
+		return 0, moerr.NewDataTruncatedNoCtx("set", "value %d is out of range", mask)
+	}
+	return mask, nil
+}
+
+// SetToString returns the members in the bitmask separated by comma, in
+// the order of the column definition.
+func SetToString(values []string, mask uint64) string {
+	var b strings.Builder
+	for i, v := range values {
+		if mask&(1<<uint(i)) != 0 {
+			if b.Len() > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+// ParseYear converts an integer to year, 1-69 and 70-99 are years in
+// 2001-2069 and 1970-1999, 0 is the zero year.
+func ParseYear(v int64) (uint16, error) {
+	switch {
+	case v == 0:
+		return 0, nil
+	case v > 0 && v < 70:
+		return uint16(v + 2000), nil
+	case v >= 70 && v < 100:
+		return uint16(v + 1900), nil
+	case v >= MinYear && v <= MaxYear:
+		return uint16(v), nil
+	}
+	return 0, moerr.NewOutOfRangeNoCtx("year", "value %d", v)
+}
+
+// ParseYearString converts a string to year. Different from the integer,
+// '0' and '00' are the year 2000.
+func ParseYearString(s string) (uint16, error) {
+	s = strings.TrimSpace(s)
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, moerr.NewInvalidArgNoCtx("parse year", s)
+	}
+	if v == 0 && len(s) < 4 {
+		return 2000, nil
+	}
+	return ParseYear(v)
+}
+
+// YearToString returns the year in 4 digits.
+func YearToString(y uint16) string {
+	s := strconv.FormatUint(uint64(y), 10)
+	if len(s) < 4 {
+		s = strings.Repeat("0", 4-len(s)) + s
+	}
+	return s
+}
+
+// ParseBit checks the value fits in the bit-value type with width bits.
+func ParseBit(v uint64, width int32) (uint64, error) {
+	if width < MaxBitLen && v>>uint(width) != 0 {
+		return 0, moerr.NewOutOfRangeNoCtx("bit", "value %d", v)
+	}
+	return v, nil
+}
+
+// ParseBitBytes converts the bytes to the bit-value in big endian, as mysql
+// does for the string assigned to a bit column.
+func ParseBitBytes(b []byte, width int32) (uint64, error) {
+	if len(b) > 8 {
+		return 0, moerr.NewOutOfRangeNoCtx("bit", "value %x", b)
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return ParseBit(v, width)
+}
+
+// BitToBytes returns the bit-value in (width+7)/8 bytes in big endian, which
+// is what mysql sends to the client.
+func BitToBytes(v uint64, width int32) []byte {
+	n := (width + 7) / 8
+	if n <= 0 || n > 8 {
+		n = 8
+	}
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	return b
+}
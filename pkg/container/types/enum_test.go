@@ -0,0 +1,122 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValues(t *testing.T) {
+	values := []string{"a", "b'c", ""}
+	s := FormatEnumValues(values)
+	require.Equal(t, "'a','b''c',''", s)
+	parsed, err := ParseEnumValues(s)
+	require.NoError(t, err)
+	require.Equal(t, values, parsed)
+
+	for _, s := range []string{"a", "'a", "'a',", "'a'b"} {
+		_, err = ParseEnumValues(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	values := []string{"x", "y", "z"}
+	idx, err := ParseEnum(values, "Y ")
+	require.NoError(t, err)
+	require.Equal(t, uint16(2), idx)
+	idx, err = ParseEnum(values, "3")
+	require.NoError(t, err)
+	require.Equal(t, uint16(3), idx)
+	_, err = ParseEnum(values, "4")
+	require.Error(t, err)
+	_, err = ParseEnum(values, "w")
+	require.Error(t, err)
+
+	s, err := EnumToString(values, 1)
+	require.NoError(t, err)
+	require.Equal(t, "x", s)
+	s, err = EnumToString(values, 0)
+	require.NoError(t, err)
+	require.Equal(t, "", s)
+	_, err = EnumToString(values, 4)
+	require.Error(t, err)
+}
+
+func TestParseSet(t *testing.T) {
+	values := []string{"a", "b", "c"}
+	mask, err := ParseSet(values, "c,A")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), mask)
+	require.Equal(t, "a,c", SetToString(values, mask))
+	mask, err = ParseSet(values, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), mask)
+	mask, err = ParseSet(values, "7")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), mask)
+	_, err = ParseSet(values, "8")
+	require.Error(t, err)
+	_, err = ParseSet(values, "a,d")
+	require.Error(t, err)
+}
+
+func TestParseYear(t *testing.T) {
+	cases := []struct {
+		in     int64
+		expect uint16
+	}{
+		{0, 0}, {1, 2001}, {69, 2069}, {70, 1970}, {99, 1999}, {1901, 1901}, {2155, 2155},
+	}
+	for _, c := range cases {
+		y, err := ParseYear(c.in)
+		require.NoError(t, err)
+		require.Equal(t, c.expect, y)
+	}
+	for _, v := range []int64{-1, 100, 1900, 2156} {
+		_, err := ParseYear(v)
+		require.Error(t, err)
+	}
+
+	y, err := ParseYearString("00")
+	require.NoError(t, err)
+	require.Equal(t, uint16(2000), y)
+	y, err = ParseYearString("0000")
+	require.NoError(t, err)
+	require.Equal(t, uint16(0), y)
+	require.Equal(t, "0000", YearToString(0))
+	require.Equal(t, "2023", YearToString(2023))
+}
+
+func TestParseBit(t *testing.T) {
+	v, err := ParseBit(255, 8)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), v)
+	_, err = ParseBit(256, 8)
+	require.Error(t, err)
+	v, err = ParseBit(1<<63, MaxBitLen)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63), v)
+
+	v, err = ParseBitBytes([]byte{1, 2}, 16)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x0102), v)
+	_, err = ParseBitBytes([]byte{1, 2}, 8)
+	require.Error(t, err)
+	require.Equal(t, []byte{1, 2}, BitToBytes(0x0102, 10))
+	require.Equal(t, []byte{0}, BitToBytes(0, 1))
+}
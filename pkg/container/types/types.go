@@ -53,6 +53,9 @@ const (
 	T_decimal128 T = 33
 	T_decimal256 T = 34
 
+	// bit-value, stored as uint64, width is the count of bits
+	T_bit T = 35
+
 	// pseudo numerics, not used
 
 	// date and time
@@ -61,6 +64,8 @@ const (
 	T_datetime  T = 52
 	T_timestamp T = 53
 	T_interval  T = 54
+	// year, stored as uint16, 0 is the zero year
+	T_year T = 55
 
 	// string family
 	T_char      T = 60
@@ -74,6 +79,17 @@ const (
 	T_blob T = 70
 	T_text T = 71
 
+	// enum and set, the members are kept in the column definition.
+	// enum is stored as the 1-based index (uint16) of the member, 0 is the
+	// empty string for the invalid value. set is stored as the bitmask (uint64)
+	// of the members.
+	T_enum T = 80
+	T_set  T = 81
+
+	// array of float32, the embedding vector. width is the dimension, the
+	// values are stored as the little-endian bytes of the elements.
+	T_array_float32 T = 90
+
 	// Transaction TS
 	T_TS      T = 100
 	T_Rowid   T = 101
@@ -352,6 +368,11 @@ var Types map[string]T = map[string]T{
 	"time":      T_time,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
+	"year":      T_year,
+
+	"bit":  T_bit,
+	"enum": T_enum,
+	"set":  T_set,
 
 	"char":    T_char,
 	"varchar": T_varchar,
@@ -364,6 +385,8 @@ var Types map[string]T = map[string]T{
 	"blob": T_blob,
 	"uuid": T_uuid,
 
+	"vector": T_array_float32,
+
 	"transaction timestamp": T_TS,
 	"rowid":                 T_Rowid,
 	"blockid":               T_Blockid,
@@ -479,6 +502,10 @@ func (t Type) DescString() string {
 		return fmt.Sprintf("DECIMAL(%d,%d)", t.Width, t.Scale)
 	case T_decimal128:
 		return fmt.Sprintf("DECIAML(%d,%d)", t.Width, t.Scale)
+	case T_bit:
+		return fmt.Sprintf("BIT(%d)", t.Width)
+	case T_array_float32:
+		return fmt.Sprintf("VECTOR(%d)", t.Width)
 	}
 	return t.Oid.String()
 }
@@ -493,6 +520,54 @@ func (t Type) Eq(b Type) bool {
 	}
 }
 
+// IsInPlaceWidening returns true if the values stored as type t can be read as type b
+// without any conversion, e.g. varchar(10) to varchar(20) or decimal(10,2) to decimal(12,2).
+func IsInPlaceWidening(t, b Type) bool {
+	if t.Eq(b) {
+		return true
+	}
+	switch t.Oid {
+	case T_char, T_varchar:
+		switch b.Oid {
+		case T_varchar, T_char:
+			return (t.Oid == b.Oid || b.Oid == T_varchar) && b.Width >= t.Width
+		case T_text:
+			return true
+		}
+	case T_binary, T_varbinary:
+		switch b.Oid {
+		case T_binary, T_varbinary:
+			return (t.Oid == b.Oid || b.Oid == T_varbinary) && b.Width >= t.Width
+		case T_blob:
+			return true
+		}
+	case T_decimal64, T_decimal128:
+		return t.Oid == b.Oid && t.Scale == b.Scale && b.Width >= t.Width
+	case T_bit:
+		return t.Oid == b.Oid && b.Width >= t.Width
+	}
+	return false
+}
+
+// IsWidening returns true if the values stored as type t can be read as type b. It's
+// not an in-place widening, e.g. int to bigint, the values are converted as they are read.
+func IsWidening(t, b Type) bool {
+	if IsInPlaceWidening(t, b) {
+		return true
+	}
+	switch {
+	case t.Oid.IsSignedInt():
+		return b.Oid.IsSignedInt() && b.Oid.TypeLen() > t.Oid.TypeLen()
+	case t.Oid.IsUnsignedInt():
+		return b.Oid.IsInteger() && b.Oid.TypeLen() > t.Oid.TypeLen()
+	case t.Oid == T_float32:
+		return b.Oid == T_float64
+	case t.Oid == T_decimal64:
+		return b.Oid == T_decimal128 && t.Scale == b.Scale && b.Width >= t.Width
+	}
+	return false
+}
+
 func (t T) ToType() Type {
 	var typ Type
 
@@ -510,12 +585,15 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_year, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set:
+		typ.Size = 8
+	case T_bit:
 		typ.Size = 8
+		typ.Width = MaxBitLen
 	case T_float32:
 		typ.Size = 4
 	case T_float64:
@@ -551,6 +629,9 @@ func (t T) ToType() Type {
 	case T_varbinary:
 		typ.Size = VarlenaSize
 		typ.Width = MaxVarBinaryLen
+	case T_array_float32:
+		typ.Size = VarlenaSize
+		typ.Width = MaxArrayDimension
 	case T_any:
 		// XXX I don't know about this one ...
 		typ.Size = 0
@@ -626,6 +707,16 @@ func (t T) String() string {
 		return "BLOCKID"
 	case T_interval:
 		return "INTERVAL"
+	case T_bit:
+		return "BIT"
+	case T_year:
+		return "YEAR"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
+	case T_array_float32:
+		return "VECTOR"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -693,6 +784,16 @@ func (t T) OidString() string {
 		return "T_Blockid"
 	case T_interval:
 		return "T_interval"
+	case T_bit:
+		return "T_bit"
+	case T_year:
+		return "T_year"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
+	case T_array_float32:
+		return "T_array_float32"
 	}
 	return "unknown_type"
 }
@@ -712,17 +813,17 @@ func (t T) TypeLen() int {
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_year, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_bit, T_set:
 		return 8
 	case T_float32:
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -751,11 +852,11 @@ func (t T) FixedLength() int {
 		return 0
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_year, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp, T_bit, T_set:
 		return 8
 	case T_decimal64:
 		return 8
@@ -771,7 +872,7 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32:
 		return -24
 	}
 	panic(moerr.NewInternalErrorNoCtx(fmt.Sprintf("unknown type %d", t)))
@@ -124,6 +124,34 @@ func TestType_Eq(t *testing.T) {
 	require.True(t, myType.Eq(myType1))
 }
 
+func TestIsInPlaceWidening(t *testing.T) {
+	require.True(t, IsInPlaceWidening(T_int64.ToType(), T_int64.ToType()))
+	require.True(t, IsInPlaceWidening(New(T_varchar, 10, 0), New(T_varchar, 20, 0)))
+	require.True(t, IsInPlaceWidening(New(T_char, 10, 0), New(T_varchar, 10, 0)))
+	require.True(t, IsInPlaceWidening(New(T_varchar, 10, 0), T_text.ToType()))
+	require.True(t, IsInPlaceWidening(New(T_varbinary, 10, 0), T_blob.ToType()))
+	require.True(t, IsInPlaceWidening(New(T_decimal64, 10, 2), New(T_decimal64, 12, 2)))
+	require.False(t, IsInPlaceWidening(New(T_varchar, 20, 0), New(T_varchar, 10, 0)))
+	require.False(t, IsInPlaceWidening(New(T_varchar, 10, 0), New(T_char, 20, 0)))
+	require.False(t, IsInPlaceWidening(New(T_decimal64, 10, 2), New(T_decimal64, 12, 3)))
+	require.False(t, IsInPlaceWidening(New(T_decimal64, 10, 2), New(T_decimal128, 20, 2)))
+	require.False(t, IsInPlaceWidening(T_int32.ToType(), T_int64.ToType()))
+}
+
+func TestIsWidening(t *testing.T) {
+	require.True(t, IsWidening(New(T_varchar, 10, 0), New(T_varchar, 20, 0)))
+	require.True(t, IsWidening(T_int32.ToType(), T_int64.ToType()))
+	require.True(t, IsWidening(T_uint8.ToType(), T_int16.ToType()))
+	require.True(t, IsWidening(T_uint16.ToType(), T_uint64.ToType()))
+	require.True(t, IsWidening(T_float32.ToType(), T_float64.ToType()))
+	require.True(t, IsWidening(New(T_decimal64, 10, 2), New(T_decimal128, 20, 2)))
+	require.False(t, IsWidening(T_int64.ToType(), T_int32.ToType()))
+	require.False(t, IsWidening(T_int32.ToType(), T_uint64.ToType()))
+	require.False(t, IsWidening(T_uint32.ToType(), T_int32.ToType()))
+	require.False(t, IsWidening(T_int32.ToType(), T_float64.ToType()))
+	require.False(t, IsWidening(New(T_decimal64, 10, 2), New(T_decimal128, 20, 3)))
+}
+
 func TestT_ToType(t *testing.T) {
 	require.Equal(t, int32(1), T_int8.ToType().Size)
 	require.Equal(t, int32(2), T_int16.ToType().Size)
@@ -496,7 +496,7 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool) FunctionResultWra
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 		// IF STRING type.
 		return newResultFunc[types.Varlena](v, mp)
-	case types.T_json:
+	case types.T_json, types.T_array_float32:
 		return newResultFunc[types.Varlena](v, mp)
 	}
 
@@ -513,11 +513,11 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool) FunctionResultWra
 		return newResultFunc[int64](v, mp)
 	case types.T_uint8:
 		return newResultFunc[uint8](v, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return newResultFunc[uint16](v, mp)
 	case types.T_uint32:
 		return newResultFunc[uint32](v, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return newResultFunc[uint64](v, mp)
 	case types.T_float32:
 		return newResultFunc[float32](v, mp)
@@ -179,11 +179,11 @@ func (v *Vector) setupColFromData() {
 			v.col = DecodeFixedCol[int64](v)
 		case types.T_uint8:
 			v.col = DecodeFixedCol[uint8](v)
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			v.col = DecodeFixedCol[uint16](v)
 		case types.T_uint32:
 			v.col = DecodeFixedCol[uint32](v)
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			v.col = DecodeFixedCol[uint64](v)
 		case types.T_float32:
 			v.col = DecodeFixedCol[float32](v)
@@ -283,11 +283,11 @@ func (v *Vector) CompareAndCheckIntersect(vec *Vector) (bool, error) {
 		return checkNumberIntersect[int64](v, vec)
 	case types.T_uint8:
 		return checkNumberIntersect[uint8](v, vec)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return checkNumberIntersect[uint16](v, vec)
 	case types.T_uint32:
 		return checkNumberIntersect[uint32](v, vec)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return checkNumberIntersect[uint64](v, vec)
 	case types.T_float32:
 		return checkNumberIntersect[float32](v, vec)
@@ -406,11 +406,11 @@ func (v *Vector) CompareAndCheckAnyResultIsTrue(ctx context.Context, vec *Vector
 		return compareNumber[int64](ctx, v, vec, funName)
 	case types.T_uint8:
 		return compareNumber[uint8](ctx, v, vec, funName)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return compareNumber[uint16](ctx, v, vec, funName)
 	case types.T_uint32:
 		return compareNumber[uint32](ctx, v, vec, funName)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return compareNumber[uint64](ctx, v, vec, funName)
 	case types.T_float32:
 		return compareNumber[float32](ctx, v, vec, funName)
@@ -607,11 +607,11 @@ func MakeAppendBytesFunc(vec *Vector) func([]byte, bool, *mpool.MPool) error {
 		return appendBytesToFixSized[int64](vec)
 	case types.T_uint8:
 		return appendBytesToFixSized[uint8](vec)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return appendBytesToFixSized[uint16](vec)
 	case types.T_uint32:
 		return appendBytesToFixSized[uint32](vec)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return appendBytesToFixSized[uint64](vec)
 	case types.T_float32:
 		return appendBytesToFixSized[float32](vec)
@@ -545,17 +545,17 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		shrinkFixed[int64](v, sels, negate)
 	case types.T_uint8:
 		shrinkFixed[uint8](v, sels, negate)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		shrinkFixed[uint16](v, sels, negate)
 	case types.T_uint32:
 		shrinkFixed[uint32](v, sels, negate)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		shrinkFixed[uint64](v, sels, negate)
 	case types.T_float32:
 		shrinkFixed[float32](v, sels, negate)
 	case types.T_float64:
 		shrinkFixed[float64](v, sels, negate)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		// XXX shrink varlena, but did not shrink area.  For our vector, this
 		// may well be the right thing.  If want to shrink area as well, we
 		// have to copy each varlena value and swizzle pointer.
@@ -604,17 +604,17 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) error {
 		shuffleFixed[int64](v, sels, mp)
 	case types.T_uint8:
 		shuffleFixed[uint8](v, sels, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		shuffleFixed[uint16](v, sels, mp)
 	case types.T_uint32:
 		shuffleFixed[uint32](v, sels, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		shuffleFixed[uint64](v, sels, mp)
 	case types.T_float32:
 		shuffleFixed[float32](v, sels, mp)
 	case types.T_float64:
 		shuffleFixed[float64](v, sels, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		shuffleFixed[types.Varlena](v, sels, mp)
 	case types.T_date:
 		shuffleFixed[types.Date](v, sels, mp)
@@ -864,7 +864,7 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 			v.length += w.length
 			return nil
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				if err := appendMultiFixed(v, 0, true, w.length, mp); err != nil {
@@ -924,7 +924,7 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 			v.length += w.length
 			return nil
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				if err := appendMultiFixed(v, 0, true, w.length, mp); err != nil {
@@ -1285,7 +1285,7 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 			return nil
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				if err := appendMultiFixed(v, 0, true, w.length, mp); err != nil {
@@ -1436,7 +1436,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return appendOneFixed(v, ws[sel], nulls.Contains(&w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, uint16(0), true, mp)
@@ -1458,7 +1458,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return appendOneFixed(v, ws[sel], nulls.Contains(&w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, uint64(0), true, mp)
@@ -1591,7 +1591,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return appendOneFixed(v, ws[sel], nulls.Contains(&w.nsp, uint64(sel)), mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, types.Varlena{}, true, mp)
@@ -1688,7 +1688,7 @@ func GetConstSetFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return SetConstFixed(v, ws[sel], length, mp)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return func(v, w *Vector, sel int64, length int) error {
 			if w.IsConstNull() || w.nsp.Contains(uint64(sel)) {
 				return SetConstNull(v, length, mp)
@@ -1710,7 +1710,7 @@ func GetConstSetFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return SetConstFixed(v, ws[sel], length, mp)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return func(v, w *Vector, sel int64, length int) error {
 			if w.IsConstNull() || w.nsp.Contains(uint64(sel)) {
 				return SetConstNull(v, length, mp)
@@ -1843,7 +1843,7 @@ func GetConstSetFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return SetConstFixed(v, ws[sel], length, mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		return func(v, w *Vector, sel int64, length int) error {
 			if w.IsConstNull() || w.nsp.Contains(uint64(sel)) {
 				return SetConstNull(v, length, mp)
@@ -2199,11 +2199,11 @@ func (v *Vector) String() string {
 		return vecToString[int64](v)
 	case types.T_uint8:
 		return vecToString[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return vecToString[uint16](v)
 	case types.T_uint32:
 		return vecToString[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return vecToString[uint64](v)
 	case types.T_float32:
 		return vecToString[float32](v)
@@ -2229,7 +2229,7 @@ func (v *Vector) String() string {
 		return vecToString[types.Rowid](v)
 	case types.T_Blockid:
 		return vecToString[types.Blockid](v)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		col := MustStrCol(v)
 		if len(col) == 1 {
 			if nulls.Contains(&v.nsp, 0) {
@@ -2312,11 +2312,11 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(int64), false, mp)
 	case types.T_uint8:
 		return appendOneFixed(vec, val.(uint8), false, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return appendOneFixed(vec, val.(uint16), false, mp)
 	case types.T_uint32:
 		return appendOneFixed(vec, val.(uint32), false, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return appendOneFixed(vec, val.(uint64), false, mp)
 	case types.T_float32:
 		return appendOneFixed(vec, val.(float32), false, mp)
@@ -2342,7 +2342,7 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(types.Rowid), false, mp)
 	case types.T_Blockid:
 		return appendOneFixed(vec, val.(types.Blockid), false, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		return appendOneBytes(vec, val.([]byte), false, mp)
 	}
 	return nil
@@ -48,6 +48,7 @@ const (
 	MYSQL_TYPE_TIME2       MysqlType = 0x13 /**< Internal to MySQL. Not used in protocol */
 	MYSQL_TYPE_TYPED_ARRAY MysqlType = 0x14 /**< Used for replication only */
 
+	MYSQL_TYPE_VECTOR      MysqlType = 240 // add vector for the float32 array, not used in protocol
 	MYSQL_TYPE_TEXT        MysqlType = 241 // add text to distinct blob and blob
 	MYSQL_TYPE_INVALID     MysqlType = 242
 	MYSQL_TYPE_UUID        MysqlType = 243
@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/ctlservice"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
@@ -102,6 +103,14 @@ type Config struct {
 		GlobalMinCount      int64         `toml:"global-min-count"`
 	}
 
+	GC struct {
+		// Retention the history versions are kept at least for the retention
+		// to be read by AS OF TIMESTAMP, the objects deleted in it are not
+		// collected. CN reads it from the heartbeat of DN in HAKeeper. Default
+		// is 1h.
+		Retention toml.Duration `toml:"retention"`
+	}
+
 	LogtailServer struct {
 		ListenAddress              string        `toml:"listen-address"`
 		ServiceAddress             string        `toml:"service-address"`
@@ -128,6 +137,12 @@ type Config struct {
 			Backend StorageType `toml:"backend"`
 			// LogBackend the backend used to store logs
 			LogBackend string `toml:"log-backend"`
+			// Compression the algorithm compressing the objects, the checkpoint
+			// files and the logs. [lz4|zstd|none], default lz4 and the logs
+			// aren't compressed.
+			Compression string `toml:"compression"`
+			// CompressionLevel the level of zstd, default 3.
+			CompressionLevel int `toml:"compression-level"`
 		}
 	}
 
@@ -173,7 +188,12 @@ func (c *Config) Validate() error {
 		c.Txn.Storage.LogBackend = defaultLogBackend
 	}
 	if _, ok := supportTxnStorageBackends[c.Txn.Storage.Backend]; !ok {
-		return moerr.NewInternalError(context.Background(), "%s txn storage backend not support", c.Txn.Storage)
+		return moerr.NewInternalError(context.Background(), "%s txn storage backend not support", c.Txn.Storage.Backend)
+	}
+	if c.Txn.Storage.Compression != "" {
+		if _, ok := compress.Algorithms[strings.ToLower(c.Txn.Storage.Compression)]; !ok {
+			return moerr.NewInternalError(context.Background(), "%s compression not support", c.Txn.Storage.Compression)
+		}
 	}
 	if c.Txn.ZombieTimeout.Duration == 0 {
 		c.Txn.ZombieTimeout.Duration = defaultZombieTimeout
@@ -235,3 +255,24 @@ func (c *Config) Validate() error {
 	c.LockService.Validate()
 	return nil
 }
+
+// getCompressionCfg returns the compression of the storage, it returns nil if
+// the compression isn't configured.
+func (c *Config) getCompressionCfg() *options.CompressionCfg {
+	if c.Txn.Storage.Compression == "" {
+		return nil
+	}
+	return &options.CompressionCfg{
+		Alg:   uint8(compress.Algorithms[strings.ToLower(c.Txn.Storage.Compression)]),
+		Level: c.Txn.Storage.CompressionLevel,
+	}
+}
+
+// gcRetention returns the retention of the GC of the storage, it is reported
+// to HAKeeper for CN to reject the snapshots out of it.
+func (c *Config) gcRetention() time.Duration {
+	if c.GC.Retention.Duration > 0 {
+		return c.GC.Retention.Duration
+	}
+	return options.DefaultGCTTL
+}
@@ -17,6 +17,9 @@ package dnservice
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,4 +36,12 @@ func TestValidate(t *testing.T) {
 	assert.Equal(t, defaultHeatbeatInterval, c.HAKeeper.HeatbeatInterval.Duration)
 	assert.Equal(t, defaultHeatbeatTimeout, c.HAKeeper.HeatbeatTimeout.Duration)
 	assert.Equal(t, defaultConnectTimeout, c.LogService.ConnectTimeout.Duration)
+	assert.Nil(t, c.getCompressionCfg())
+
+	c.Txn.Storage.Compression = "ZSTD"
+	c.Txn.Storage.CompressionLevel = 9
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, &options.CompressionCfg{Alg: compress.Zstd, Level: 9}, c.getCompressionCfg())
+	c.Txn.Storage.Compression = "snappy"
+	assert.Error(t, c.Validate())
 }
@@ -0,0 +1,58 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnservice
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+// Restore rebuilds the TAE data of the DN from the backup made by the backup
+// ctl command. The txns committed after ts are dropped, the end of the backup
+// is used if ts is empty. It must be called before the DN is started, and the
+// DN is started with an empty log service after it.
+func Restore(
+	ctx context.Context,
+	cfg *Config,
+	fileService fileservice.FileService,
+	backup string,
+	ts timestamp.Timestamp) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if cfg.Txn.Storage.Backend != StorageTAE {
+		return moerr.NewNotSupported(ctx, "restore %s storage", cfg.Txn.Storage.Backend)
+	}
+	fs, err := fileservice.Get[fileservice.FileService](fileService, defines.SharedFileServiceName)
+	if err != nil {
+		return err
+	}
+	backupFS, dir, err := db.GetBackupFS(backup)
+	if err != nil {
+		return err
+	}
+	opts := &options.Options{
+		Fs:             fs,
+		CompressionCfg: cfg.getCompressionCfg(),
+	}
+	return db.Restore(ctx, backupFS, dir, cfg.Txn.Storage.dataDir, opts, types.TimestampToTS(ts))
+}
@@ -62,6 +62,7 @@ func (s *store) heartbeat(ctx context.Context) {
 		LogtailServerAddress: s.cfg.LogtailServer.ServiceAddress,
 		LockServiceAddress:   s.cfg.LockService.ServiceAddress,
 		CtlAddress:           s.cfg.Ctl.Address.ServiceAddress,
+		GCRetention:          int64(s.cfg.gcRetention()),
 	}
 	cb, err := s.hakeeperClient.SendDNHeartbeat(ctx2, hb)
 	if err != nil {
@@ -163,6 +163,12 @@ func (ti *TenantInfo) GetUseSecondaryRole() bool {
 	return ti.useAllSecondaryRole
 }
 
+// Copy returns a copy of the tenant info.
+func (ti *TenantInfo) Copy() *TenantInfo {
+	cp := *ti
+	return &cp
+}
+
 func (ti *TenantInfo) GetVersion() string {
 	return ti.version
 }
@@ -726,6 +732,9 @@ var (
 		"mo_role_privs":               0,
 		"mo_user_defined_function":    0,
 		"mo_stored_procedure":         0,
+		"mo_triggers":                 0,
+		"mo_mviews":                   0,
+		"mo_column_stats":             0,
 		"mo_mysql_compatibility_mode": 0,
 		catalog.AutoIncrTableName:     0,
 	}
@@ -742,6 +751,9 @@ var (
 		"mo_role_privs":               0,
 		"mo_user_defined_function":    0,
 		"mo_stored_procedure":         0,
+		"mo_triggers":                 0,
+		"mo_mviews":                   0,
+		"mo_column_stats":             0,
 		"mo_mysql_compatibility_mode": 0,
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
@@ -890,6 +902,39 @@ var (
 				database_collation varchar(64),
 				primary key(proc_id)
 			);`,
+		`create table mo_triggers(
+				trigger_id int auto_increment,
+				name       varchar(100),
+				db         varchar(100),
+				table_name varchar(100),
+				timing     varchar(10),
+				event      varchar(10),
+				body       text,
+				definer    varchar(50),
+				created_time timestamp,
+				primary key(trigger_id)
+			);`,
+		`create table mo_mviews(
+				mview_id       int auto_increment,
+				name           varchar(100),
+				db             varchar(100),
+				refresh_ts     varchar(64),
+				refreshed_time timestamp,
+				created_time   timestamp,
+				primary key(mview_id)
+			);`,
+		`create table mo_column_stats(
+				db            varchar(100),
+				table_name    varchar(100),
+				column_name   varchar(256),
+				table_rows    bigint,
+				ndv           bigint,
+				null_count    bigint,
+				histogram     text,
+				mcv           text,
+				analyzed_time timestamp,
+				primary key(db, table_name, column_name)
+			);`,
 	}
 
 	//drop tables for the tenant
@@ -901,6 +946,9 @@ var (
 		`drop table if exists mo_catalog.mo_role_privs;`,
 		`drop table if exists mo_catalog.mo_user_defined_function;`,
 		`drop table if exists mo_catalog.mo_stored_procedure;`,
+		`drop table if exists mo_catalog.mo_triggers;`,
+		`drop table if exists mo_catalog.mo_mviews;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
 		`drop table if exists mo_catalog.mo_mysql_compatibility_mode;`,
 	}
 	dropMoPubsSql     = `drop table if exists mo_catalog.mo_pubs;`
@@ -5129,6 +5177,21 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
+	case *tree.CreateTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Table.SchemaName)
+	case *tree.DropTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
+	case *tree.RefreshMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
 	case *tree.Select, *tree.Do:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
@@ -5175,7 +5238,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar,
+		*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
@@ -413,6 +413,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					AutoIncr:    attr.Attr.AutoIncrement,
 					Table:       tableName,
 					NotNullable: attr.Attr.Default != nil && !attr.Attr.Default.NullAbility,
+					Enumvalues:  attr.Attr.EnumValues,
 				},
 				Primary:   attr.Attr.Primary,
 				Default:   attr.Attr.Default,
@@ -678,7 +679,15 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef) bool {
 	}
 	tableName := obj.GetObjName()
 	ctx, table, _ := tcc.getRelation(dbName, tableName, sub)
-	return table.Stats(ctx, tcc.GetSession().statsCache.GetStatsInfoMap(table.GetTableID(ctx)))
+	s := tcc.GetSession().statsCache.GetStatsInfoMap(table.GetTableID(ctx))
+	if !table.Stats(ctx, s) {
+		return false
+	}
+	// the statistics of the published tables are in the other account
+	if sub == nil {
+		tcc.loadColumnStats(table.GetTableID(ctx), dbName, tableName, s)
+	}
+	return true
 }
 
 func (tcc *TxnCompilerContext) GetProcess() *process.Process {
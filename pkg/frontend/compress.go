@@ -0,0 +1,263 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/DataDog/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// CompressionAlgorithm is the algorithm of the mysql compressed protocol.
+type CompressionAlgorithm uint8
+
+const (
+	CompressionNone CompressionAlgorithm = iota
+	// CompressionZlib is negotiated by CLIENT_COMPRESS.
+	CompressionZlib
+	// CompressionZstd is negotiated by CLIENT_ZSTD_COMPRESSION_ALGORITHM.
+	CompressionZstd
+)
+
+const (
+	// CompressedHeaderLength is the length of the header of a compressed packet.
+	// int<3> length of compressed payload
+	// int<1> compressed sequence id
+	// int<3> length of payload before compression, 0 means not compressed
+	CompressedHeaderLength = 7
+
+	// minCompressLength is the length under which the payload is sent
+	// without compression, the same as the mysql server.
+	minCompressLength = 50
+
+	// DefaultZstdCompressionLevel is used when the client does not send a
+	// valid zstd compression level.
+	DefaultZstdCompressionLevel = 3
+	maxZstdCompressionLevel     = 22
+)
+
+func (a CompressionAlgorithm) String() string {
+	switch a {
+	case CompressionZlib:
+		return "zlib"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return "uncompressed"
+	}
+}
+
+// compressedConn wraps a net.Conn with the mysql compressed packet framing.
+// The mysql packets are put into the compressed packets as a byte stream,
+// so that the codec reading from the conn is not aware of the compression.
+//
+// The compressed sequence id is independent of the sequence id of the mysql
+// packets. It is reset to 0 by the client at the beginning of each command,
+// and the server responds with the next one of the last received.
+type compressedConn struct {
+	net.Conn
+	algorithm CompressionAlgorithm
+	level     int
+
+	// seq is the sequence id of the next compressed packet to write.
+	seq struct {
+		sync.Mutex
+		id uint8
+	}
+
+	// reading side
+	header  [CompressedHeaderLength]byte
+	payload []byte
+	// plain holds the decompressed data not read yet.
+	plain []byte
+	zr    io.ReadCloser
+
+	// writing side
+	wmu  sync.Mutex
+	wbuf bytes.Buffer
+	zw   *zlib.Writer
+}
+
+// NewCompressedConn returns a net.Conn which reads and writes the mysql
+// compressed packets on conn with the algorithm. The level is only used
+// by zstd.
+func NewCompressedConn(conn net.Conn, algorithm CompressionAlgorithm, level int) net.Conn {
+	if level <= 0 || level > maxZstdCompressionLevel {
+		level = DefaultZstdCompressionLevel
+	}
+	return &compressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		level:     level,
+	}
+}
+
+// Read implements the io.Reader interface. It returns the decompressed data.
+func (c *compressedConn) Read(p []byte) (int, error) {
+	for len(c.plain) == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.plain)
+	c.plain = c.plain[n:]
+	return n, nil
+}
+
+func (c *compressedConn) readPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.header[:]); err != nil {
+		return err
+	}
+	compressedLen := int(uint32(c.header[0]) | uint32(c.header[1])<<8 | uint32(c.header[2])<<16)
+	seq := c.header[3]
+	plainLen := int(uint32(c.header[4]) | uint32(c.header[5])<<8 | uint32(c.header[6])<<16)
+
+	if cap(c.payload) < compressedLen {
+		c.payload = make([]byte, compressedLen)
+	}
+	payload := c.payload[:compressedLen]
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+
+	c.seq.Lock()
+	c.seq.id = seq + 1
+	c.seq.Unlock()
+
+	if plainLen == 0 {
+		// the payload is not compressed. It is copied because the payload
+		// buffer is reused by the next packet.
+		c.plain = append(make([]byte, 0, compressedLen), payload...)
+		return nil
+	}
+	plain, err := c.decompress(payload, plainLen)
+	if err != nil {
+		return err
+	}
+	if len(plain) != plainLen {
+		return moerr.NewInternalErrorNoCtx("invalid compressed packet: decompressed length %d, expected %d",
+			len(plain), plainLen)
+	}
+	c.plain = plain
+	return nil
+}
+
+func (c *compressedConn) decompress(src []byte, plainLen int) ([]byte, error) {
+	dst := make([]byte, plainLen)
+	switch c.algorithm {
+	case CompressionZlib:
+		var err error
+		if c.zr == nil {
+			c.zr, err = zlib.NewReader(bytes.NewReader(src))
+		} else {
+			err = c.zr.(zlib.Resetter).Reset(bytes.NewReader(src), nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		n, err := io.ReadFull(c.zr, dst)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		return dst[:n], nil
+	case CompressionZstd:
+		return zstd.Decompress(dst, src)
+	default:
+		return nil, moerr.NewInternalErrorNoCtx("unsupported compression algorithm %s", c.algorithm)
+	}
+}
+
+// Write implements the io.Writer interface. The data is split into
+// compressed packets with the payload no longer than MaxPayloadSize.
+func (c *compressedConn) Write(p []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	written := 0
+	for len(p) > 0 {
+		n := len(p)
+		if n > int(MaxPayloadSize) {
+			n = int(MaxPayloadSize)
+		}
+		if err := c.writePacket(p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (c *compressedConn) writePacket(data []byte) error {
+	c.wbuf.Reset()
+	c.wbuf.Write(make([]byte, CompressedHeaderLength))
+	plainLen := len(data)
+	if len(data) >= minCompressLength {
+		if err := c.compress(data); err != nil {
+			return err
+		}
+	}
+	// send the data as is when it is small or can not be compressed.
+	if c.wbuf.Len() == CompressedHeaderLength || c.wbuf.Len()-CompressedHeaderLength >= len(data) {
+		c.wbuf.Truncate(CompressedHeaderLength)
+		c.wbuf.Write(data)
+		plainLen = 0
+	}
+
+	packet := c.wbuf.Bytes()
+	compressedLen := len(packet) - CompressedHeaderLength
+	packet[0] = byte(compressedLen)
+	packet[1] = byte(compressedLen >> 8)
+	packet[2] = byte(compressedLen >> 16)
+	c.seq.Lock()
+	packet[3] = c.seq.id
+	c.seq.id++
+	c.seq.Unlock()
+	packet[4] = byte(plainLen)
+	packet[5] = byte(plainLen >> 8)
+	packet[6] = byte(plainLen >> 16)
+
+	_, err := c.Conn.Write(packet)
+	return err
+}
+
+// compress appends the compressed data into wbuf.
+func (c *compressedConn) compress(data []byte) error {
+	switch c.algorithm {
+	case CompressionZlib:
+		if c.zw == nil {
+			c.zw = zlib.NewWriter(&c.wbuf)
+		} else {
+			c.zw.Reset(&c.wbuf)
+		}
+		if _, err := c.zw.Write(data); err != nil {
+			return err
+		}
+		return c.zw.Close()
+	case CompressionZstd:
+		out, err := zstd.CompressLevel(nil, data, c.level)
+		if err != nil {
+			return err
+		}
+		c.wbuf.Write(out)
+		return nil
+	default:
+		return moerr.NewInternalErrorNoCtx("unsupported compression algorithm %s", c.algorithm)
+	}
+}
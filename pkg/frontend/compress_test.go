@@ -0,0 +1,80 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedConn(t *testing.T) {
+	small := []byte("select 1")
+	large := bytes.Repeat([]byte("select * from t where a = 1;"), 1000)
+	// the payload is split into several compressed packets.
+	huge := bytes.Repeat([]byte{'a'}, int(MaxPayloadSize)+100)
+
+	for _, algorithm := range []CompressionAlgorithm{CompressionZlib, CompressionZstd} {
+		for _, data := range [][]byte{small, large, huge} {
+			c1, c2 := net.Pipe()
+			client := NewCompressedConn(c1, algorithm, 0)
+			server := NewCompressedConn(c2, algorithm, 0)
+
+			done := make(chan error, 1)
+			go func() {
+				_, err := client.Write(data)
+				done <- err
+			}()
+			got := make([]byte, len(data))
+			_, err := io.ReadFull(server, got)
+			require.NoError(t, err)
+			require.NoError(t, <-done)
+			require.Equal(t, data, got)
+
+			// the server responds with the next sequence id.
+			n := (len(data) + int(MaxPayloadSize) - 1) / int(MaxPayloadSize)
+			require.Equal(t, uint8(n), server.(*compressedConn).seq.id)
+
+			require.NoError(t, client.Close())
+			require.NoError(t, server.Close())
+		}
+	}
+}
+
+func TestCompressedConnHeader(t *testing.T) {
+	var buf bytes.Buffer
+	c1, c2 := net.Pipe()
+	conn := NewCompressedConn(c1, CompressionZlib, 0)
+	go func() {
+		_, _ = conn.Write([]byte("short"))
+		_, _ = conn.Write(bytes.Repeat([]byte{'a'}, 100))
+		_ = conn.Close()
+	}()
+	_, err := io.Copy(&buf, c2)
+	require.NoError(t, err)
+
+	data := buf.Bytes()
+	// the short payload is sent without compression.
+	require.Equal(t, []byte{5, 0, 0, 0, 0, 0, 0}, data[:CompressedHeaderLength])
+	require.Equal(t, []byte("short"), data[CompressedHeaderLength:CompressedHeaderLength+5])
+
+	data = data[CompressedHeaderLength+5:]
+	require.Equal(t, uint8(1), data[3])
+	require.Equal(t, []byte{100, 0, 0}, data[4:7])
+	require.Less(t, int(data[0]), 100)
+}
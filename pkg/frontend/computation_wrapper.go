@@ -159,6 +159,9 @@ func (cwft *TxnComputationWrapper) GetColumns() ([]interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = setColEnumValues(c, col.Typ); err != nil {
+			return nil, err
+		}
 		setColFlag(c)
 		setColLength(c, col.Typ.Width)
 		setCharacter(c)
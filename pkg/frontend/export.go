@@ -456,6 +456,9 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 				value := addEscapeToString(vec.GetBytesAt(i))
 				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+			case types.T_array_float32:
+				val := types.ArrayF32ToString(types.BytesToArrayF32(vec.GetBytesAt(i)))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, true)
 			case types.T_date:
 				val := vector.GetFixedAt[types.Date](vec, i)
 				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
@@ -489,6 +492,25 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_Blockid:
 				val := vector.GetFixedAt[types.Blockid](vec, i)
 				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
+			case types.T_bit:
+				val := types.BitToBytes(vector.GetFixedAt[uint64](vec, i), vec.GetType().Width)
+				writeByte = appendBytes(writeByte, addEscapeToString(val), symbol[j], closeby, true)
+			case types.T_year:
+				val := types.YearToString(vector.GetFixedAt[uint16](vec, i))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+			case types.T_enum:
+				val, err := types.EnumToString(enumValuesOfColumn(ses, j), vector.GetFixedAt[uint16](vec, i))
+				if err != nil {
+					ByteChan <- &BatchByte{
+						err: err,
+					}
+					bat.Clean(ses.GetMemPool())
+					return
+				}
+				writeByte = appendBytes(writeByte, addEscapeToString([]byte(val)), symbol[j], closeby, true)
+			case types.T_set:
+				val := types.SetToString(enumValuesOfColumn(ses, j), vector.GetFixedAt[uint64](vec, i))
+				writeByte = appendBytes(writeByte, addEscapeToString([]byte(val)), symbol[j], closeby, true)
 			default:
 				logErrorf(ses.GetDebugString(), "constructByte : unsupported type %d", vec.GetType().Oid)
 				ByteChan <- &BatchByte{
@@ -631,7 +653,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			}
 		// Binary/varbinary has mysql_type_varchar.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_BIT:
 			value, err := oq.mrs.GetValue(oq.ctx, 0, i)
 			if err != nil {
 				return err
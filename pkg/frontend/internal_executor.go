@@ -384,6 +384,14 @@ func (ip *internalProtocol) sendEOFOrOkPacket(warnings uint16, status uint16) er
 	return nil
 }
 
+func (ip *internalProtocol) sendEOFPacket(warnings uint16, status uint16) error {
+	return nil
+}
+
+func (ip *internalProtocol) changeUser(ctx context.Context, data []byte) error {
+	return nil
+}
+
 func (ip *internalProtocol) ResetStatistics() {
 	ip.result.affectedRows = 0
 	ip.result.dropped = 0
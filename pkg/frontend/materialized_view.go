@@ -0,0 +1,253 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+const (
+	fetchViewDefFormat = `select viewdef from mo_catalog.mo_tables where reldatabase = '%s' and relname = '%s';`
+
+	// the row of the view is locked, so that the view is refreshed by one
+	// transaction at a time
+	fetchMoMViewFormat = `select mview_id, refresh_ts from mo_catalog.mo_mviews where db = '%s' and name = '%s' for update;`
+
+	insertMoMViewFormat = `insert into mo_catalog.mo_mviews(name, db, refresh_ts, refreshed_time, created_time) values ('%s', '%s', '%s', '%s', '%s');`
+
+	updateMoMViewFormat = `update mo_catalog.mo_mviews set refresh_ts = '%s', refreshed_time = '%s' where mview_id = %d;`
+
+	deleteMoMViewsOfViewFormat = `delete from mo_catalog.mo_mviews where db = '%s' and name = '%s';`
+
+	deleteMoMViewsOfDatabaseFormat = `delete from mo_catalog.mo_mviews where db = '%s';`
+
+	clearMaterializedViewFormat = "delete from `%s`.`%s`;"
+)
+
+// getMaterializedViewDatabase returns the database of the materialized view.
+func getMaterializedViewDatabase(ses *Session, name *tree.TableName) (string, error) {
+	dbName := string(name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	return dbName, nil
+}
+
+// getMaterializedViewData returns the data of the view db.name, the data isn't
+// materialized if the view isn't a materialized view.
+func getMaterializedViewData(ctx context.Context, bh BackgroundExec, dbName, viewName string) (*plan2.ViewData, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(fetchViewDefFormat, sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(viewName)))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewNoSuchTable(ctx, dbName, viewName)
+	}
+	viewDef, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if viewDef == "" {
+		return nil, moerr.NewBadView(ctx, dbName, viewName)
+	}
+	viewData := &plan2.ViewData{}
+	if err = json.Unmarshal([]byte(viewDef), viewData); err != nil {
+		return nil, err
+	}
+	return viewData, nil
+}
+
+// getBackgroundSnapshotTS returns the snapshot of the txn of the background
+// handler, which is the snapshot the last statement read.
+func getBackgroundSnapshotTS(bh BackgroundExec) (timestamp.Timestamp, error) {
+	handler, ok := bh.(*BackgroundHandler)
+	if !ok {
+		return timestamp.Timestamp{}, moerr.NewInternalErrorNoCtx("unexpected background handler %T", bh)
+	}
+	_, op := handler.ses.GetTxnHandler().GetTxnOperator()
+	if op == nil {
+		return timestamp.Timestamp{}, moerr.NewInternalErrorNoCtx("no txn in the background handler")
+	}
+	return op.Txn().SnapshotTS, nil
+}
+
+// doRefreshMaterializedView recomputes the results of the materialized view
+// dbName.viewName in its hidden table. The incremental refresh appends the
+// results of the rows inserted into the base table since the last refresh,
+// it falls back to the full refresh if the changes are unavailable, e.g.
+// rows have been deleted. The created view is refreshed the first time.
+func doRefreshMaterializedView(ctx context.Context, ses *Session, dbName, viewName string, incremental, created bool) error {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err := refreshMaterializedView(ctx, bh, dbName, viewName, incremental, created)
+	if incremental && moerr.IsMoErrCode(err, moerr.ErrTableChangesUnavailable) {
+		err = refreshMaterializedView(ctx, bh, dbName, viewName, false, created)
+	}
+	return err
+}
+
+func refreshMaterializedView(ctx context.Context, bh BackgroundExec, dbName, viewName string, incremental, created bool) error {
+	var err error
+	var sql string
+	var mviewId int64
+	var refreshTS string
+	var snapshot timestamp.Timestamp
+	var viewData *plan2.ViewData
+	var erArray []ExecResult
+	var now string
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	viewData, err = getMaterializedViewData(ctx, bh, dbName, viewName)
+	if err != nil {
+		goto handleFailed
+	}
+	if viewData.Materialized == nil {
+		// CREATE MATERIALIZED VIEW IF NOT EXISTS on an existing view
+		if created {
+			return bh.Exec(ctx, "rollback;")
+		}
+		err = moerr.NewInternalError(ctx, "'%s.%s' is not a materialized view", dbName, viewName)
+		goto handleFailed
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(fetchMoMViewFormat, sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(viewName)))
+	if err != nil {
+		goto handleFailed
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		goto handleFailed
+	}
+	if execResultArrayHasData(erArray) {
+		// CREATE MATERIALIZED VIEW IF NOT EXISTS on an existing view
+		if created {
+			return bh.Exec(ctx, "rollback;")
+		}
+		if mviewId, err = erArray[0].GetInt64(ctx, 0, 0); err != nil {
+			goto handleFailed
+		}
+		if refreshTS, err = erArray[0].GetString(ctx, 0, 1); err != nil {
+			goto handleFailed
+		}
+	} else if !created {
+		err = moerr.NewInternalError(ctx, "materialized view '%s.%s' is not registered", dbName, viewName)
+		goto handleFailed
+	}
+
+	if viewData.DefaultDatabase != "" {
+		err = bh.Exec(ctx, fmt.Sprintf("use `%s`;", sqlIdentEscaper.Replace(viewData.DefaultDatabase)))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
+	if incremental && refreshTS != "" {
+		sql, err = plan2.MaterializedViewRefreshSql(ctx, viewData, dbName, refreshTS)
+		if err != nil {
+			goto handleFailed
+		}
+	} else {
+		err = bh.Exec(ctx, fmt.Sprintf(clearMaterializedViewFormat,
+			sqlIdentEscaper.Replace(dbName), sqlIdentEscaper.Replace(viewData.Materialized.Table)))
+		if err != nil {
+			goto handleFailed
+		}
+		sql, err = plan2.MaterializedViewRefreshSql(ctx, viewData, dbName, "")
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		goto handleFailed
+	}
+	// the next incremental refresh starts from the snapshot of this one
+	snapshot, err = getBackgroundSnapshotTS(bh)
+	if err != nil {
+		goto handleFailed
+	}
+
+	now = types.CurrentTimestamp().String2(time.UTC, 0)
+	if created {
+		sql = fmt.Sprintf(insertMoMViewFormat, sqlStringEscaper.Replace(viewName), sqlStringEscaper.Replace(dbName), snapshot.DebugString(), now, now)
+	} else {
+		sql = fmt.Sprintf(updateMoMViewFormat, snapshot.DebugString(), now, mviewId)
+	}
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doCreateMaterializedView fills the hidden table of the materialized view
+// created by the statement and registers the view.
+func doCreateMaterializedView(ctx context.Context, ses *Session, cv *tree.CreateView) error {
+	dbName, err := getMaterializedViewDatabase(ses, cv.Name)
+	if err != nil {
+		return err
+	}
+	return doRefreshMaterializedView(ctx, ses, dbName, string(cv.Name.ObjectName), false, true)
+}
+
+func doRefreshMaterializedViewStmt(ctx context.Context, ses *Session, rm *tree.RefreshMaterializedView) error {
+	dbName, err := getMaterializedViewDatabase(ses, rm.Name)
+	if err != nil {
+		return err
+	}
+	return doRefreshMaterializedView(ctx, ses, dbName, string(rm.Name.ObjectName), rm.Incremental, false)
+}
+
+// deleteMaterializedViewsOfDroppedObjects unregisters the materialized views
+// dropped by DROP VIEW or DROP DATABASE.
+func deleteMaterializedViewsOfDroppedObjects(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	return deleteRecordsOfDroppedObjects(ctx, ses, stmt, deleteMoMViewsOfViewFormat, deleteMoMViewsOfDatabaseFormat)
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getMaterializedViewDataEscapesNames(t *testing.T) {
+	bh := &backgroundExecTest{}
+	bh.init()
+	bh.sql2result["select viewdef from mo_catalog.mo_tables where reldatabase = 'd\\'b' and relname = 'v\\\\1';"] = &MysqlResultSet{}
+
+	_, err := getMaterializedViewData(context.Background(), bh, "d'b", `v\1`)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoSuchTable))
+	require.Equal(t, "select viewdef from mo_catalog.mo_tables where reldatabase = 'd\\'b' and relname = 'v\\\\1';", bh.currentSql)
+}
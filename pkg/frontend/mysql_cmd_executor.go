@@ -74,6 +74,10 @@ func unclassifiedStatementInUncommittedTxnErrorInfo() string {
 	return "unclassified statement appears in uncommitted transaction"
 }
 
+func writeOnPinnedSnapshotErrorInfo() string {
+	return "can not write data when the snapshot is pinned by snapshot_ts"
+}
+
 func abortTransactionErrorInfo() string {
 	return "Previous DML conflicts with existing constraints or data format. This transaction has to be aborted"
 }
@@ -372,6 +376,9 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	if bat == nil {
 		return nil
 	}
+	if cursor := ses.getCursor(); cursor != nil {
+		return cursor.fill(ses, bat)
+	}
 
 	begin := time.Now()
 	proto := ses.GetMysqlProtocol()
@@ -949,27 +956,6 @@ func constructVarBatch(ses *Session, rows [][]interface{}) (*batch.Batch, error)
 	return bat, nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.GetDoQueryFunc()(requestCtx, sql)
-}
-
 // Note: for pass the compile quickly. We will remove the comments in the future.
 func (mce *MysqlCmdExecutor) handleExplainStmt(requestCtx context.Context, stmt *tree.ExplainStmt) error {
 	es, err := getExplainOption(requestCtx, stmt.Options)
@@ -1106,6 +1092,9 @@ func doDeallocate(ctx context.Context, ses *Session, st *tree.Deallocate) error
 }
 
 func doReset(ctx context.Context, ses *Session, st *tree.Reset) error {
+	if preStmt, err := ses.GetPrepareStmt(string(st.Name)); err == nil {
+		preStmt.Reset()
+	}
 	return nil
 }
 
@@ -1220,6 +1209,18 @@ func (mce *MysqlCmdExecutor) handleDropProcedure(ctx context.Context, dp *tree.D
 	return doDropProcedure(ctx, mce.GetSession(), dp)
 }
 
+func (mce *MysqlCmdExecutor) handleCreateTrigger(ctx context.Context, ct *tree.CreateTrigger) error {
+	return doCreateTrigger(ctx, mce.GetSession(), ct)
+}
+
+func (mce *MysqlCmdExecutor) handleDropTrigger(ctx context.Context, dt *tree.DropTrigger) error {
+	return doDropTrigger(ctx, mce.GetSession(), dt)
+}
+
+func (mce *MysqlCmdExecutor) handleRefreshMaterializedView(ctx context.Context, rm *tree.RefreshMaterializedView) error {
+	return doRefreshMaterializedViewStmt(ctx, mce.GetSession(), rm)
+}
+
 func (mce *MysqlCmdExecutor) handleCallProcedure(ctx context.Context, call *tree.CallStmt, proc *process.Process, cwIndex, cwsLen int) error {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
@@ -1814,6 +1815,30 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			rt: st,
 		}
+	case *tree.SavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = &SavePointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			sp: st,
+		}
+	case *tree.RollbackToSavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = &RollbackToSavePointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rsp: st,
+		}
+	case *tree.ReleaseSavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = &ReleaseSavePointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rsp: st,
+		}
 	case *tree.SetRole:
 		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
 		ret = &SetRoleExecutor{
@@ -2398,6 +2423,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.MaxMsgSize = pu.SV.MaxMessageSize
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = ses.getQueryMemoryLimit()
 	proc.SessionInfo = process.SessionInfo{
 		User:          ses.GetUserName(),
 		Host:          pu.SV.Host,
@@ -2517,8 +2543,16 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		}
 
+		if isWriteStatementToExecute(ses, stmt) {
+			if _, pinned, _ := ses.getPinnedSnapshotTS(); pinned {
+				err = moerr.NewInternalError(requestCtx, writeOnPinnedSnapshotErrorInfo())
+				logStatementStatus(requestCtx, ses, stmt, fail, err)
+				return err
+			}
+		}
+
 		//check transaction states
-		switch stmt.(type) {
+		switch st := stmt.(type) {
 		case *tree.BeginTransaction:
 			err = ses.TxnBegin()
 			if err != nil {
@@ -2535,6 +2569,26 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.SavePoint:
+			err = ses.TxnSavePoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.RollbackToSavePoint:
+			err = ses.TxnRollbackToSavePoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.ReleaseSavePoint:
+			err = ses.TxnReleaseSavePoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		}
+
+		err = ses.TxnStatementSavePoint(stmt)
+		if err != nil {
+			goto handleFailed
 		}
 
 		switch st := stmt.(type) {
@@ -2547,7 +2601,8 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		selfHandle = false
 
 		switch st := stmt.(type) {
-		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
 			selfHandle = true
 		case *tree.SetRole:
 			selfHandle = true
@@ -2777,6 +2832,21 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleDropProcedure(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.CreateTrigger:
+			selfHandle = true
+			if err = mce.handleCreateTrigger(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropTrigger:
+			selfHandle = true
+			if err = mce.handleDropTrigger(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.RefreshMaterializedView:
+			selfHandle = true
+			if err = mce.handleRefreshMaterializedView(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		case *tree.CallStmt:
 			selfHandle = true
 			if err = mce.handleCallProcedure(requestCtx, st, proc, i, len(cws)); err != nil {
@@ -2920,7 +2990,17 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				mysql COM_QUERY response: End after the column has been sent.
 				send EOF packet
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
+			cursorStmt := ses.GetCursorStmt()
+			if cursorStmt != nil {
+				// the rows are saved in the cursor and sent by COM_STMT_FETCH.
+				// The EOF packet tells the client that the cursor is opened, it is
+				// sent even if the CLIENT_DEPRECATE_EOF is set.
+				cursorStmt.cursor = newStmtCursor(mrs, cursorStmt.cancelCursor)
+				ses.setCursor(cursorStmt.cursor)
+				err = proto.sendEOFPacket(0, SERVER_STATUS_CURSOR_EXISTS)
+			} else {
+				err = proto.SendEOFPacketIf(0, 0)
+			}
 			if err != nil {
 				goto handleFailed
 			}
@@ -2938,7 +3018,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 					goto handleFailed
 				}
 			}
-			if err = runner.Run(0); err != nil {
+			if cursorStmt != nil {
+				// the pipeline keeps running until the rows are fetched
+				cursorStmt.cursor.start(func() error {
+					return runner.Run(0)
+				})
+			} else if err = runner.Run(0); err != nil {
 				goto handleFailed
 			}
 
@@ -2971,10 +3056,14 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				Step 3: Say goodbye
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
+				The response of the execution with a cursor has ended with the
+				column definitions.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
-			if err != nil {
-				goto handleFailed
+			if cursorStmt == nil {
+				err = proto.sendEOFOrOkPacket(0, 0)
+				if err != nil {
+					goto handleFailed
+				}
 			}
 
 			/*
@@ -2990,6 +3079,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateSequence, *tree.DropSequence,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint,
 			*tree.SetVar,
 			*tree.Load,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
@@ -3145,10 +3235,32 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleSucceeded:
 		//load data handle txn failure internally
 		incStatementCounter(tenant, stmt)
-		txnErr = ses.TxnCommitSingleStatement(stmt)
-		if txnErr != nil {
-			logStatementStatus(requestCtx, ses, stmt, fail, txnErr)
-			return txnErr
+		if cursor := ses.getCursor(); cursor != nil && ses.GetCursorStmt() != nil {
+			// the pipeline of the cursor is running, the statement is finished
+			// after it ends.
+			cursor.setFinish(func(err error) error {
+				if err != nil {
+					if ses.TxnRollbackStatement(stmt) {
+						return nil
+					}
+					return ses.TxnRollbackSingleStatement(stmt)
+				}
+				if err = ses.TxnReleaseStatementSavePoint(stmt); err != nil {
+					return err
+				}
+				return ses.TxnCommitSingleStatement(stmt)
+			})
+		} else {
+			txnErr = ses.TxnReleaseStatementSavePoint(stmt)
+			if txnErr != nil {
+				logStatementStatus(requestCtx, ses, stmt, fail, txnErr)
+				return txnErr
+			}
+			txnErr = ses.TxnCommitSingleStatement(stmt)
+			if txnErr != nil {
+				logStatementStatus(requestCtx, ses, stmt, fail, txnErr)
+				return txnErr
+			}
 		}
 		switch stmt.(type) {
 		case *tree.Select:
@@ -3163,10 +3275,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount, *tree.AlterDataBaseConfig, *tree.CreatePublication, *tree.AlterPublication, *tree.DropPublication,
 			*tree.CreateFunction, *tree.DropFunction,
 			*tree.CreateProcedure, *tree.DropProcedure,
+			*tree.CreateTrigger, *tree.DropTrigger, *tree.RefreshMaterializedView, *tree.AnalyzeStmt,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 			*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
 			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete, *tree.TruncateTable, *tree.Use,
-			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
 			resp := mce.setResponse(i, len(cws), rspLen)
 			if _, ok := stmt.(*tree.Insert); ok {
 				resp.lastInsertId = proc.GetLastInsertID()
@@ -3191,6 +3305,25 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				deleteRecordToMoMysqlCompatbilityMode(requestCtx, ses, stmt)
 			}
 
+			switch cw.GetAst().(type) {
+			case *tree.DropTable, *tree.DropDatabase:
+				deleteTriggersOfDroppedObjects(requestCtx, ses, stmt)
+				deleteColumnStatsOfDroppedObjects(requestCtx, ses, stmt)
+			}
+
+			switch cw.GetAst().(type) {
+			case *tree.DropView, *tree.DropDatabase:
+				deleteMaterializedViewsOfDroppedObjects(requestCtx, ses, stmt)
+			}
+
+			// the results of the materialized view are computed once it is created
+			if st, ok := cw.GetAst().(*tree.CreateView); ok && st.Materialized {
+				if err2 = doCreateMaterializedView(requestCtx, ses, st); err2 != nil {
+					logStatementStatus(requestCtx, ses, stmt, fail, err2)
+					return err2
+				}
+			}
+
 			if err2 = mce.GetSession().GetMysqlProtocol().SendResponse(requestCtx, resp); err2 != nil {
 				retErr = moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err2)
 				logStatementStatus(requestCtx, ses, stmt, fail, retErr)
@@ -3243,6 +3376,17 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleFailed:
 		incStatementCounter(tenant, stmt)
 		incStatementErrorsCounter(tenant, stmt)
+		if cursor := ses.getCursor(); cursor != nil && ses.GetCursorStmt() != nil {
+			// the pipeline of the cursor may be running, it ends before the
+			// statement is rolled back.
+			_ = ses.closeCursor()
+		}
+		if ses.TxnRollbackStatement(stmt) {
+			//only the failed statement is rolled back, the transaction is still active
+			logError(ses.GetDebugString(), err.Error())
+			logStatementStatus(requestCtx, ses, stmt, fail, err)
+			return err
+		}
 		/*
 			Cases    | set Autocommit = 1/0 | BEGIN statement |
 			---------------------------------------------------
@@ -3325,6 +3469,7 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 	proc.Lim.Size = pu.SV.ProcessLimitationSize
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = ses.getQueryMemoryLimit()
 	proc.SessionInfo = process.SessionInfo{
 		User:          ses.GetUserName(),
 		Host:          pu.SV.Host,
@@ -3412,6 +3557,12 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 
 	var sql string
 	logDebugf(ses.GetDebugString(), "cmd %v", req.GetCmd())
+	// the pipeline of the cursor ends before the other commands.
+	if req.GetCmd() != COM_STMT_FETCH {
+		if err = ses.drainCursor(); err != nil {
+			logErrorf(ses.GetDebugString(), "drain the cursor failed. error:%v", err)
+		}
+	}
 	ses.SetCmd(req.GetCmd())
 	doComQuery := mce.GetDoQueryFunc()
 	switch req.GetCmd() {
@@ -3477,16 +3628,47 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 	case COM_STMT_EXECUTE:
 		ses.SetCmd(COM_STMT_EXECUTE)
 		data := req.GetData().([]byte)
-		sql, err = mce.parseStmtExecute(requestCtx, data)
+		var prepareStmt *PrepareStmt
+		sql, prepareStmt, err = mce.parseStmtExecute(requestCtx, data)
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, err), nil
 		}
-		err = doComQuery(requestCtx, sql)
+		execCtx := requestCtx
+		if prepareStmt.useCursor {
+			// the pipeline of the cursor outlives the request.
+			execCtx, prepareStmt.cancelCursor = newCursorContext(requestCtx)
+			ses.SetCursorStmt(prepareStmt)
+			defer ses.SetCursorStmt(nil)
+		}
+		err = doComQuery(execCtx, sql)
 		if err != nil {
+			prepareStmt.cursor = nil
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
 		}
+		if prepareStmt.cancelCursor != nil {
+			if prepareStmt.cursor == nil {
+				prepareStmt.cancelCursor()
+			}
+			prepareStmt.cancelCursor = nil
+		}
+		return resp, nil
+
+	case COM_STMT_FETCH:
+		data := req.GetData().([]byte)
+		if err = mce.handleStmtFetch(requestCtx, data); err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, err)
+		}
 		return resp, nil
 
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+		// there is no response to COM_STMT_SEND_LONG_DATA, the error is
+		// reported by the following COM_STMT_EXECUTE.
+		if err = mce.handleStmtSendLongData(requestCtx, data); err != nil {
+			logErrorf(ses.GetDebugString(), "send long data failed. error:%v", err)
+		}
+		return nil, nil
+
 	case COM_STMT_CLOSE:
 		data := req.GetData().([]byte)
 
@@ -3502,6 +3684,21 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		}
 		return resp, nil
 
+	case COM_RESET_CONNECTION:
+		if err = mce.handleResetConnection(requestCtx); err != nil {
+			resp = NewGeneralErrorResponse(COM_RESET_CONNECTION, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_RESET_CONNECTION)
+		}
+		return resp, nil
+
+	case COM_CHANGE_USER:
+		data := req.GetData().([]byte)
+		if err = mce.handleChangeUser(requestCtx, data); err != nil {
+			return nil, err
+		}
+		return NewGeneralOkResponse(COM_CHANGE_USER), nil
+
 	case COM_STMT_RESET:
 		data := req.GetData().([]byte)
 
@@ -3522,11 +3719,11 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 	return resp, nil
 }
 
-func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data []byte) (string, error) {
+func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data []byte) (string, *PrepareStmt, error) {
 	// see https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
 	pos := 0
 	if len(data) < 4 {
-		return "", moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+		return "", nil, moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
 	}
 	stmtID := binary.LittleEndian.Uint32(data[0:4])
 	pos += 4
@@ -3535,11 +3732,13 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 	ses := mce.GetSession()
 	preStmt, err := ses.GetPrepareStmt(stmtName)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
+	// the cursor opened by the last execution is closed.
+	preStmt.cursor = nil
 	names, vars, err := ses.GetMysqlProtocol().ParseExecuteData(requestCtx, preStmt, data, pos)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	sql := fmt.Sprintf("execute %s", stmtName)
 	varStrings := make([]string, len(names))
@@ -3549,12 +3748,111 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 			varStrings[i] = fmt.Sprintf("%v", vars[i])
 			err := ses.SetUserDefinedVar(names[i], vars[i])
 			if err != nil {
-				return "", err
+				return "", nil, err
 			}
 		}
 	}
 	logInfo(ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(sql), logutil.VarsField(strings.Join(varStrings, " , ")))
-	return sql, nil
+	return sql, preStmt, nil
+}
+
+// handleStmtFetch sends at most num_rows rows of the cursor opened by COM_STMT_EXECUTE.
+func (mce *MysqlCmdExecutor) handleStmtFetch(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+
+	ses := mce.GetSession()
+	preStmt, err := ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	if preStmt.cursor == nil {
+		return moerr.NewInvalidState(requestCtx, "the statement (%d) has no open cursor", stmtID)
+	}
+
+	proto := ses.GetMysqlProtocol()
+	cursor := preStmt.cursor
+	mrs, done, err := cursor.next(uint64(numRows))
+	if done {
+		// the cursor is closed after the last row has been sent, and the
+		// statement is finished as the pipeline has ended.
+		preStmt.cursor = nil
+		if ses.getCursor() == cursor {
+			ses.setCursor(nil)
+		}
+		if err2 := cursor.wait(); err == nil {
+			err = err2
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err = proto.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount()); err != nil {
+		return err
+	}
+	status := SERVER_STATUS_CURSOR_EXISTS
+	if done {
+		status = SERVER_STATUS_LAST_ROW_SENT
+	}
+	return proto.sendEOFOrOkPacket(0, status)
+}
+
+// handleStmtSendLongData saves the data of the parameter in the prepared statement.
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_send_long_data.html
+	if len(data) < 6 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	paramID := binary.LittleEndian.Uint16(data[4:6])
+
+	preStmt, err := mce.GetSession().GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	preStmt.appendLongData(paramID, data[6:])
+	return nil
+}
+
+// handleResetConnection resets the session for COM_RESET_CONNECTION. The
+// user is not changed, so the variables of the account are loaded again.
+func (mce *MysqlCmdExecutor) handleResetConnection(requestCtx context.Context) error {
+	ses := mce.GetSession()
+	if err := ses.ResetSession(); err != nil {
+		return err
+	}
+	if ses.GetParameterUnit().SV.SkipCheckUser {
+		return nil
+	}
+	return ses.InitGlobalSystemVariables()
+}
+
+// handleChangeUser resets the session for COM_CHANGE_USER, and authenticates
+// the new user like the handshake. If it fails, the error packet has been sent
+// and the connection is closed, since the session is not authenticated anymore.
+// The variables of the account of the new user are loaded after it.
+func (mce *MysqlCmdExecutor) handleChangeUser(requestCtx context.Context, data []byte) error {
+	ses := mce.GetSession()
+	if err := ses.ResetSession(); err != nil {
+		logErrorf(ses.GetDebugString(), "reset session failed. error:%v", err)
+	}
+	proto := ses.GetMysqlProtocol()
+	if err := proto.changeUser(requestCtx, data); err != nil {
+		if rt := ses.getRoutine(); rt != nil {
+			rt.killConnection(true)
+		}
+		return err
+	}
+	ses.SetDatabaseName(proto.GetDatabaseName())
+	ses.UpdateDebugString()
+	if ses.GetParameterUnit().SV.SkipCheckUser {
+		return nil
+	}
+	return ses.InitGlobalSystemVariables()
 }
 
 func (mce *MysqlCmdExecutor) SetCancelFunc(cancelFunc context.CancelFunc) {
@@ -3632,12 +3930,39 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	case types.T_Blockid:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_bit:
+		col.SetColumnType(defines.MYSQL_TYPE_BIT)
+		col.SetSigned(false)
+	case types.T_year:
+		col.SetColumnType(defines.MYSQL_TYPE_YEAR)
+		col.SetSigned(false)
+	case types.T_enum:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetFlag(col.Flag() | uint16(defines.ENUM_FLAG))
+	case types.T_set:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetFlag(col.Flag() | uint16(defines.SET_FLAG))
+	case types.T_array_float32:
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	default:
 		return moerr.NewInternalError(ctx, "RunWhileSend : unsupported type %d", engineType)
 	}
 	return nil
 }
 
+// setColEnumValues saves the members of the enum and set column into col.
+func setColEnumValues(col *MysqlColumn, typ *plan2.Type) error {
+	if typ.Id != int32(types.T_enum) && typ.Id != int32(types.T_set) || typ.Enumvalues == "" {
+		return nil
+	}
+	values, err := types.ParseEnumValues(typ.Enumvalues)
+	if err != nil {
+		return err
+	}
+	col.enumValues = values
+	return nil
+}
+
 func convertMysqlTextTypeToBlobType(col *MysqlColumn) {
 	if col.ColumnType() == defines.MYSQL_TYPE_TEXT {
 		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
@@ -641,6 +641,10 @@ func Test_typeconvert(t *testing.T) {
 			types.T_time,
 			types.T_datetime,
 			types.T_json,
+			types.T_bit,
+			types.T_year,
+			types.T_enum,
+			types.T_set,
 		}
 
 		type kase struct {
@@ -664,6 +668,10 @@ func Test_typeconvert(t *testing.T) {
 			{tp: defines.MYSQL_TYPE_TIME, signed: true},
 			{tp: defines.MYSQL_TYPE_DATETIME, signed: true},
 			{tp: defines.MYSQL_TYPE_JSON, signed: true},
+			{tp: defines.MYSQL_TYPE_BIT},
+			{tp: defines.MYSQL_TYPE_YEAR},
+			{tp: defines.MYSQL_TYPE_STRING, signed: true},
+			{tp: defines.MYSQL_TYPE_STRING, signed: true},
 		}
 
 		convey.So(len(input), convey.ShouldEqual, len(output))
@@ -1335,3 +1343,86 @@ func Test_RecordParseErrorStatement(t *testing.T) {
 	require.NotNil(t, si)
 
 }
+
+// changeUserProtocol logs in as the user of another account without
+// authenticating it.
+type changeUserProtocol struct {
+	*MysqlProtocolImpl
+	tenant *TenantInfo
+}
+
+func (mp *changeUserProtocol) changeUser(ctx context.Context, data []byte) error {
+	mp.GetSession().SetTenantInfo(mp.tenant)
+	mp.SetDatabaseName("db1")
+	return nil
+}
+
+func newMrsForGetVariables(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"variable_name", "variable_value"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_handleChangeUser(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+	require.NoError(t, err)
+	pu.SV.SkipCheckUser = false
+
+	proto := &changeUserProtocol{
+		MysqlProtocolImpl: NewMysqlClientProtocol(0, ioses, 1024, pu.SV),
+		tenant: &TenantInfo{
+			Tenant:   "acc1",
+			TenantID: 1,
+			User:     "user1",
+			UserID:   2,
+		},
+	}
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, true, nil)
+	ses.SetRequestContext(ctx)
+	ses.SetConnectContext(ctx)
+	ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, TenantID: sysAccountID, User: rootName})
+	proto.SetSession(ses)
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+	bh.sql2result[getSystemVariablesWithAccount(sysAccountID)] = newMrsForGetVariables([][]interface{}{
+		{"wait_timeout", "100"},
+	})
+	bh.sql2result[getSystemVariablesWithAccount(1)] = newMrsForGetVariables([][]interface{}{
+		{"wait_timeout", "200"},
+	})
+
+	require.NoError(t, ses.InitGlobalSystemVariables())
+	require.Equal(t, int64(100), ses.GetSysVar("wait_timeout"))
+
+	// the global variables of acc1 are loaded for the new user
+	require.NoError(t, mce.handleChangeUser(ctx, nil))
+	require.Equal(t, "acc1", ses.GetTenantInfo().GetTenant())
+	require.Equal(t, "db1", ses.GetDatabaseName())
+	require.Equal(t, int64(200), ses.GetSysVar("wait_timeout"))
+}
@@ -55,7 +55,9 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
 	CLIENT_DEPRECATE_EOF |
-	CLIENT_CONNECT_ATTRS
+	CLIENT_CONNECT_ATTRS |
+	CLIENT_COMPRESS |
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -184,6 +186,10 @@ type MysqlProtocol interface {
 	GetStats() string
 
 	ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error)
+
+	sendEOFPacket(warnings uint16, status uint16) error
+
+	changeUser(ctx context.Context, data []byte) error
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -293,6 +299,9 @@ type MysqlProtocolImpl struct {
 	// can pass to the server at connect time.
 	connectAttrs map[string]string
 
+	//the compression level of zstd asked by the client
+	zstdLevel uint8
+
 	//for debug
 	debugStats
 
@@ -401,6 +410,7 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	zstdLevel         uint8
 }
 
 // handshake response 320
@@ -502,11 +512,12 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 		err = moerr.NewInternalError(requestCtx, "malform packet")
 		return
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
+		// only CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY are supported
 		err = moerr.NewInvalidInput(requestCtx, "unsupported Prepare flag '%v'", flag)
 		return
 	}
+	stmt.useCursor = flag&CURSOR_TYPE_READ_ONLY != 0
 
 	// skip iteration-count, always 1
 	pos += 4
@@ -542,8 +553,15 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
+			// the params received via COM_STMT_SEND_LONG_DATA are not in the packet.
 			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if longData, ok := stmt.longData[uint16(i)]; ok {
+				vars[i] = longData
+				if (i<<1) < len(stmt.ParamTypes) && !isBlobParamType(defines.MysqlType(stmt.ParamTypes[i<<1])) {
+					vars[i] = string(longData)
+				}
+				continue
+			}
 
 			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
 				vars[i] = nil
@@ -712,10 +730,20 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			}
 		}
 	}
+	// the long data is only used by one execution
+	stmt.longData = nil
 
 	return
 }
 
+func isBlobParamType(tp defines.MysqlType) bool {
+	switch tp {
+	case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+		return true
+	}
+	return false
+}
+
 func (mp *MysqlProtocolImpl) readDate(data []byte, pos int) (int, string) {
 	year, pos, _ := mp.io.ReadUint16(data, pos)
 	month := data[pos]
@@ -1070,7 +1098,6 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		//TO Check password
 		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
 			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
-			ses.InitGlobalSystemVariables()
 		} else {
 			return moerr.NewInternalError(ctx, "check password failed")
 		}
@@ -1093,6 +1120,9 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 			}
 		}
 	}
+	if ses != nil {
+		ses.saveLoginTenantInfo()
+	}
 	mp.incDebugCount(1)
 	return nil
 }
@@ -1133,6 +1163,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
+		mp.zstdLevel = resp41.zstdLevel
 	} else {
 		var resp320 response320
 		var ok2 bool
@@ -1173,6 +1204,10 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 		}
 		return err
 	}
+	// the variables of COM_CHANGE_USER are loaded by handleChangeUser
+	if !mp.SV.SkipCheckUser {
+		mp.GetSession().InitGlobalSystemVariables()
+	}
 
 	mp.incDebugCount(2)
 	logInfof(mp.getDebugStringUnsafe(), "handle handshake end")
@@ -1182,9 +1217,38 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// the packets after the OK packet are compressed if the client asks.
+	mp.UseCompressedConn()
 	return nil
 }
 
+// GetCompression returns the compression algorithm negotiated with the client
+// and the compression level.
+func (mp *MysqlProtocolImpl) GetCompression() (CompressionAlgorithm, int) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	// zlib is preferred like the mysql server when both are set.
+	if mp.capability&CLIENT_COMPRESS != 0 {
+		return CompressionZlib, 0
+	}
+	if mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		return CompressionZstd, int(mp.zstdLevel)
+	}
+	return CompressionNone, 0
+}
+
+// UseCompressedConn switches the connection to the compressed protocol if it
+// is negotiated with the client. It must be called after the handshake is done.
+func (mp *MysqlProtocolImpl) UseCompressedConn() {
+	algorithm, level := mp.GetCompression()
+	if algorithm == CompressionNone {
+		return
+	}
+	tcpConn := mp.GetTcpConnection()
+	tcpConn.UseConn(NewCompressedConn(tcpConn.RawConn(), algorithm, level))
+	logDebugf(mp.getDebugStringUnsafe(), "use %s compressed protocol", algorithm)
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1380,9 +1444,235 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 	}
 
+	//int<1>             zstd compression level
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		info.zstdLevel, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
+		}
+	}
+
 	return true, info, nil
 }
 
+// the server analyses the payload of COM_CHANGE_USER from the client.
+// The capabilities are the ones negotiated in the handshake.
+// return response41 ; error
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, data []byte) (response41, error) {
+	var pos = 0
+	var ok bool
+	var info response41
+	info.capabilities = mp.GetCapability()
+	info.maxPacketSize = mp.maxClientPacketSize
+
+	//string[NUL]        username
+	info.username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	/*
+		if capabilities & CLIENT_SECURE_CONNECTION {
+			int<1>             length of auth-response
+			string[n]          auth-response
+		} else {
+			string[NUL]        auth-response
+		}
+	*/
+	if (info.capabilities & CLIENT_SECURE_CONNECTION) != 0 {
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get length of auth-response failed")
+		}
+		if l > 0 {
+			info.authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+			if !ok {
+				return info, moerr.NewInternalError(ctx, "get auth-response failed")
+			}
+		}
+	} else {
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		info.authResponse = []byte(auth)
+	}
+
+	//string[NUL]        schema-name
+	info.database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	// the rest fields are optional.
+	if pos == len(data) {
+		return info, nil
+	}
+
+	//int<2>             character set
+	var collationID uint16
+	collationID, pos, ok = mp.io.ReadUint16(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get character set failed")
+	}
+	info.collationID = uint8(collationID)
+
+	if (info.capabilities&CLIENT_PLUGIN_AUTH) != 0 && pos < len(data) {
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+
+	// client connection attributes
+	if info.capabilities&CLIENT_CONNECT_ATTRS != 0 && pos < len(data) {
+		var l uint64
+		l, pos, ok = mp.readIntLenEnc(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+		}
+		endPos := pos + int(l)
+		info.connectAttrs = make(map[string]string)
+		var key, value string
+		for pos < endPos {
+			key, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return info, moerr.NewInternalError(ctx, "get connect-attrs key failed")
+			}
+			value, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return info, moerr.NewInternalError(ctx, "get connect-attrs value failed")
+			}
+			info.connectAttrs[key] = value
+		}
+	}
+	return info, nil
+}
+
+// changeUser updates the login information with the payload of COM_CHANGE_USER,
+// and authenticates the new user with the salt of the handshake. The error
+// packet is sent to the client if it fails.
+func (mp *MysqlProtocolImpl) changeUser(ctx context.Context, data []byte) error {
+	if err := mp.authenticateChangeUser(ctx, data); err != nil {
+		logutil.Errorf("change user failed.error:%v", err)
+		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+		tipsFormat := "Access denied for user %s. %s"
+		msg := fmt.Sprintf(tipsFormat, mp.GetUserName(), err.Error())
+		err2 := mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+		if err2 != nil {
+			logutil.Errorf("send err packet failed.error:%v", err2)
+			return err2
+		}
+		return err
+	}
+	return nil
+}
+
+func (mp *MysqlProtocolImpl) authenticateChangeUser(ctx context.Context, data []byte) error {
+	info, err := mp.analyseChangeUser(ctx, data)
+	if err != nil {
+		return err
+	}
+	mp.SetUserName(info.username)
+
+	//to switch authenticate method
+	if info.clientPluginName != "" && info.clientPluginName != AuthNativePassword {
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+	}
+
+	if info.collationID != 0 {
+		nameAndCharset, ok := collationID2CharsetAndName[int(info.collationID)]
+		if !ok {
+			return moerr.NewInternalError(ctx, "get collationName and charset failed")
+		}
+		mp.collationID = int(info.collationID)
+		mp.collationName = nameAndCharset.collationName
+		mp.charset = nameAndCharset.charset
+	}
+
+	mp.authResponse = info.authResponse
+	mp.SetDatabaseName(info.database)
+	if info.connectAttrs != nil {
+		mp.connectAttrs = info.connectAttrs
+	}
+	return mp.authenticateUser(ctx, info.authResponse)
+}
+
+// MakeChangeUserHandshakeResp analyses the payload of COM_CHANGE_USER, and
+// makes the payload of handshake response41 which logs in as the new user.
+// The proxy uses it to rebuild the connections to CN servers after the user
+// of the client is changed. The compression is not used in the response.
+func (mp *MysqlProtocolImpl) MakeChangeUserHandshakeResp(ctx context.Context, data []byte) ([]byte, error) {
+	info, err := mp.analyseChangeUser(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	mp.SetUserName(info.username)
+	mp.SetDatabaseName(info.database)
+	if info.connectAttrs != nil {
+		mp.connectAttrs = info.connectAttrs
+	}
+	if info.collationID == 0 {
+		info.collationID = uint8(mp.collationID)
+	}
+	info.capabilities &^= CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM | CLIENT_SSL
+	if info.database != "" {
+		info.capabilities |= CLIENT_CONNECT_WITH_DB
+	}
+	if info.capabilities&CLIENT_PLUGIN_AUTH != 0 && info.clientPluginName == "" {
+		info.clientPluginName = AuthNativePassword
+	}
+	info.connectAttrs = mp.connectAttrs
+	return mp.makeHandshakeResponse41Payload(info), nil
+}
+
+// makeHandshakeResponse41Payload makes the payload of handshake response41
+// like the client does.
+func (mp *MysqlProtocolImpl) makeHandshakeResponse41Payload(info response41) []byte {
+	attrsSize := 0
+	for key, value := range info.connectAttrs {
+		attrsSize += 18 + len(key) + len(value)
+	}
+	data := make([]byte, 32+len(info.username)+1+9+len(info.authResponse)+
+		len(info.database)+1+len(info.clientPluginName)+1+9+attrsSize)
+	pos := 0
+	pos = mp.io.WriteUint32(data, pos, info.capabilities)
+	pos = mp.io.WriteUint32(data, pos, info.maxPacketSize)
+	pos = mp.io.WriteUint8(data, pos, info.collationID)
+	pos = mp.writeZeros(data, pos, 23)
+	pos = mp.writeStringNUL(data, pos, info.username)
+	if (info.capabilities & CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA) != 0 {
+		pos = mp.writeIntLenEnc(data, pos, uint64(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else if (info.capabilities & CLIENT_SECURE_CONNECTION) != 0 {
+		pos = mp.io.WriteUint8(data, pos, uint8(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else {
+		pos = mp.writeStringNUL(data, pos, string(info.authResponse))
+	}
+	if (info.capabilities & CLIENT_CONNECT_WITH_DB) != 0 {
+		pos = mp.writeStringNUL(data, pos, info.database)
+	}
+	if (info.capabilities & CLIENT_PLUGIN_AUTH) != 0 {
+		pos = mp.writeStringNUL(data, pos, info.clientPluginName)
+	}
+	if (info.capabilities & CLIENT_CONNECT_ATTRS) != 0 {
+		attrs := make([]byte, attrsSize)
+		l := 0
+		for key, value := range info.connectAttrs {
+			l = mp.writeStringLenEnc(attrs, l, key)
+			l = mp.writeStringLenEnc(attrs, l, value)
+		}
+		pos = mp.writeIntLenEnc(data, pos, uint64(l))
+		pos = mp.writeCountOfBytes(data, pos, attrs[:l])
+	}
+	return data[:pos]
+}
+
 /*
 //the server does something after receiving a handshake response41 from the client
 //like check user and password
@@ -1926,7 +2216,7 @@ func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResul
 
 		// Binary/varbinary will be sent out as varchar type.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_JSON:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
 			if value, err := mrs.GetString(ctx, rowIdx, i); err != nil {
 				return nil, err
 			} else {
@@ -2105,7 +2395,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 			}
 		// Binary/varbinary will be sent out as varchar type.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_BIT:
 			if value, err2 := mrs.GetString(ctx, r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -2172,8 +2462,8 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	var err error = nil
 
 	binary := false
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	if CommandType(cmd) == COM_STMT_EXECUTE {
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	if CommandType(cmd) == COM_STMT_EXECUTE || CommandType(cmd) == COM_STMT_FETCH {
 		binary = true
 	}
 
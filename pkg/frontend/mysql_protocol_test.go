@@ -2238,6 +2238,56 @@ func TestParseExecuteData(t *testing.T) {
 		convey.ShouldEqual(vars[0], 10)
 	})
 
+	convey.Convey("parseExecuteData with cursor and long data", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, 1")
+		stmts, err := mysql.Parse(ctx, st.Sql, 1)
+		if err != nil {
+			t.Error(err)
+		}
+		compCtx := plan.NewEmptyCompilerContext()
+		preparePlan, err := buildPlan(context.TODO(), nil, compCtx, st)
+		if err != nil {
+			t.Error(err)
+		}
+		prepareStmt := &PrepareStmt{
+			Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+			PreparePlan: preparePlan,
+			PrepareStmt: stmts[0],
+		}
+		prepareStmt.appendLongData(0, []byte("long data"))
+
+		var testData []byte
+		testData = append(testData, CURSOR_TYPE_READ_ONLY) //flag
+		testData = append(testData, 0, 0, 0, 0)            // skip iteration-count
+		testData = append(testData, 0)                     //nullBitmap
+		testData = append(testData, 1)                     // new param bound flag
+		testData = append(testData, uint8(defines.MYSQL_TYPE_BLOB))
+		testData = append(testData, 0)
+		// the value of the param is sent by COM_STMT_SEND_LONG_DATA
+
+		_, vars, err := proto.ParseExecuteData(ctx, prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(prepareStmt.useCursor, convey.ShouldBeTrue)
+		convey.So(vars[0], convey.ShouldResemble, []byte("long data"))
+		convey.So(prepareStmt.longData, convey.ShouldBeNil)
+
+		testData[0] = CURSOR_TYPE_FOR_UPDATE
+		_, _, err = proto.ParseExecuteData(ctx, prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
 }
 
 func Test_resultset(t *testing.T) {
@@ -2558,6 +2608,44 @@ func Test_analyse41resp(t *testing.T) {
 		convey.So(resp41.database, convey.ShouldEqual, dbName)
 	})
 
+	convey.Convey("analyse 41 resp with zstd compression level", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		var data []byte = nil
+		var cap uint32 = 0
+		cap |= CLIENT_PROTOCOL_41 | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+		var header [4]byte
+		proto.io.WriteUint32(header[:], 0, cap)
+		data = append(data, header[:]...)
+		data = append(data, 0xff, 0xff, 0xff, 0xff)
+		data = append(data, 0x1)
+		data = append(data, make([]byte, 23)...)
+		data = append(data, []byte("abc")...)
+		data = append(data, 0x0)
+		data = append(data, 0x0)
+		//int<1>             zstd compression level
+		data = append(data, 7)
+
+		ok, resp41, err := proto.analyseHandshakeResponse41(context.TODO(), data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(resp41.zstdLevel, convey.ShouldEqual, 7)
+
+		ok, _, err = proto.analyseHandshakeResponse41(context.TODO(), data[:len(data)-1])
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
 	convey.Convey("analyse 41 resp failed", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -2659,6 +2747,77 @@ func Test_analyse41resp(t *testing.T) {
 	})
 }
 
+func Test_analyseChangeUser(t *testing.T) {
+	convey.Convey("analyse change user succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.collationID = int(Utf8mb4CollationID)
+
+		var data []byte
+		//string[NUL]        username
+		data = append(data, []byte("acc1:user1")...)
+		data = append(data, 0x0)
+		//int<1>             length of auth-response
+		authResp := bytes.Repeat([]byte{0x1}, 20)
+		data = append(data, byte(len(authResp)))
+		data = append(data, authResp...)
+		//string[NUL]        schema-name
+		data = append(data, []byte("db1")...)
+		data = append(data, 0x0)
+
+		info, err := proto.analyseChangeUser(context.TODO(), data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(info.username, convey.ShouldEqual, "acc1:user1")
+		convey.So(bytes.Equal(info.authResponse, authResp), convey.ShouldBeTrue)
+		convey.So(info.database, convey.ShouldEqual, "db1")
+		convey.So(info.collationID, convey.ShouldEqual, 0)
+
+		//int<2>             character set
+		data = append(data, Utf8mb4CollationID, 0)
+		//string[NUL]        auth plugin name
+		data = append(data, []byte(AuthNativePassword)...)
+		data = append(data, 0x0)
+		//client connection attributes
+		attrs := []byte{1, 'k', 1, 'v'}
+		data = append(data, byte(len(attrs)))
+		data = append(data, attrs...)
+
+		info, err = proto.analyseChangeUser(context.TODO(), data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(info.collationID, convey.ShouldEqual, Utf8mb4CollationID)
+		convey.So(info.clientPluginName, convey.ShouldEqual, AuthNativePassword)
+		convey.So(info.connectAttrs, convey.ShouldResemble, map[string]string{"k": "v"})
+
+		_, err = proto.analyseChangeUser(context.TODO(), data[:12])
+		convey.So(err, convey.ShouldNotBeNil)
+
+		// the proxy logs in as the new user with the handshake response.
+		payload, err := proto.MakeChangeUserHandshakeResp(context.TODO(), data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(proto.GetUserName(), convey.ShouldEqual, "acc1:user1")
+		convey.So(proto.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		ok, resp41, err := proto.analyseHandshakeResponse41(context.TODO(), payload)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(resp41.capabilities&(CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM), convey.ShouldEqual, 0)
+		convey.So(resp41.username, convey.ShouldEqual, "acc1:user1")
+		convey.So(bytes.Equal(resp41.authResponse, authResp), convey.ShouldBeTrue)
+		convey.So(resp41.database, convey.ShouldEqual, "db1")
+		convey.So(resp41.collationID, convey.ShouldEqual, Utf8mb4CollationID)
+		convey.So(resp41.connectAttrs, convey.ShouldResemble, map[string]string{"k": "v"})
+	})
+}
+
 func Test_handleHandshake(t *testing.T) {
 	ctx := context.TODO()
 	convey.Convey("handleHandshake succ", t, func() {
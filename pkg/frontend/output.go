@@ -207,6 +207,8 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 		}
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 		row[i] = vec.GetBytesAt(rowIndex)
+	case types.T_array_float32:
+		row[i] = []byte(types.ArrayF32ToString(types.BytesToArrayF32(vec.GetBytesAt(rowIndex))))
 	case types.T_date:
 		row[i] = vector.GetFixedAt[types.Date](vec, rowIndex)
 	case types.T_datetime:
@@ -233,6 +235,30 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 		row[i] = vector.GetFixedAt[types.Blockid](vec, rowIndex)
 	case types.T_TS:
 		row[i] = vector.GetFixedAt[types.TS](vec, rowIndex)
+	case types.T_bit:
+		row[i] = types.BitToBytes(vector.GetFixedAt[uint64](vec, rowIndex), vec.GetType().Width)
+	case types.T_year:
+		row[i] = vector.GetFixedAt[uint16](vec, rowIndex)
+	case types.T_enum:
+		idx := vector.GetFixedAt[uint16](vec, rowIndex)
+		values := enumValuesOfColumn(ses, i)
+		if values == nil {
+			row[i] = []byte(strconv.FormatUint(uint64(idx), 10))
+			break
+		}
+		s, err := types.EnumToString(values, idx)
+		if err != nil {
+			return err
+		}
+		row[i] = []byte(s)
+	case types.T_set:
+		mask := vector.GetFixedAt[uint64](vec, rowIndex)
+		values := enumValuesOfColumn(ses, i)
+		if values == nil {
+			row[i] = []byte(strconv.FormatUint(mask, 10))
+			break
+		}
+		row[i] = []byte(types.SetToString(values, mask))
 	default:
 		logErrorf(ses.GetDebugString(), "extractRowFromVector : unsupported type %d", vec.GetType().Oid)
 		return moerr.NewInternalError(ses.requestCtx, "extractRowFromVector : unsupported type %d", vec.GetType().Oid)
@@ -240,6 +266,19 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 	return nil
 }
 
+// enumValuesOfColumn returns the members of the enum or set column i of the
+// result set, the values are sent to the client by their members.
+func enumValuesOfColumn(ses *Session, i int) []string {
+	mrs := ses.GetMysqlResultSet()
+	if mrs == nil || uint64(i) >= mrs.GetColumnCount() {
+		return nil
+	}
+	if col, ok := mrs.Columns[i].(*MysqlColumn); ok {
+		return col.enumValues
+	}
+	return nil
+}
+
 // fakeOutputQueue saves the data into the session.
 type fakeOutputQueue struct {
 	mrs *MysqlResultSet
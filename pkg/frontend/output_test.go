@@ -0,0 +1,59 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractEnumAndBitRow(t *testing.T) {
+	proc := testutil.NewProcess()
+	mrs := &MysqlResultSet{}
+	for _, typ := range []*plan.Type{
+		{Id: int32(types.T_enum), Enumvalues: "'x','y'"},
+		{Id: int32(types.T_set), Enumvalues: "'a','b','c'"},
+		{Id: int32(types.T_bit), Width: 12},
+		{Id: int32(types.T_year)},
+	} {
+		col := &MysqlColumn{}
+		require.NoError(t, convertEngineTypeToMysqlType(proc.Ctx, types.T(typ.Id), col))
+		require.NoError(t, setColEnumValues(col, typ))
+		mrs.AddColumn(col)
+	}
+	ses := &Session{mrs: mrs}
+
+	bat := batch.NewWithSize(4)
+	bat.Vecs[0] = vector.NewVec(types.T_enum.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_set.ToType())
+	bat.Vecs[2] = vector.NewVec(types.New(types.T_bit, 12, 0))
+	bat.Vecs[3] = vector.NewVec(types.T_year.ToType())
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], uint16(2), false, proc.Mp()))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], uint64(5), false, proc.Mp()))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], uint64(0x102), false, proc.Mp()))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[3], uint16(2023), false, proc.Mp()))
+	bat.InitZsOne(1)
+	defer bat.Clean(proc.Mp())
+
+	row, err := extractRowFromEveryVector(ses, bat, 0, newFakeOutputQueue(mrs))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]byte("y"), []byte("a,c"), []byte{1, 2}, uint16(2023)}, row)
+}
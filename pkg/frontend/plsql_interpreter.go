@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -48,6 +49,7 @@ type Interpreter struct {
 	argsAttr    map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
 	argsMap     map[string]tree.Expr         // used for argument to parameter mapping
 	outParamMap map[string]interface{}       // used for storing and updating OUT type arg
+	trigger     *plan.TriggerDef             // the trigger whose body is interpreted, nil for sp
 }
 
 func (interpreter *Interpreter) GetResult() []ExecResult {
@@ -197,6 +199,76 @@ func (interpreter *Interpreter) MatchExpr(expr tree.Expr) (tree.Expr, error) {
 	return nil, nil
 }
 
+// SetTriggerVar evaluates the expression by sending it to bh with a select and
+// assigns the value to the NEW value of the row, a local variable or a user
+// variable in trigger.
+func (interpreter *Interpreter) SetTriggerVar(assign *tree.VarAssignmentExpr) error {
+	name := strings.ToLower(assign.Name)
+	isRowVar := assign.System && (strings.HasPrefix(name, "new.") || strings.HasPrefix(name, "old."))
+	if isRowVar {
+		if strings.HasPrefix(name, "old.") {
+			return moerr.NewInvalidInput(interpreter.ctx, "updating of OLD row is not allowed in trigger")
+		}
+		if interpreter.trigger.Event == tree.TriggerDelete.String() {
+			return moerr.NewInvalidInput(interpreter.ctx, "there is no NEW row in on DELETE trigger")
+		}
+		if !interpreter.trigger.Before {
+			return moerr.NewInvalidInput(interpreter.ctx, "updating of NEW row is not allowed in after trigger")
+		}
+		if _, ok := (*interpreter.varScope)[0][name]; !ok {
+			return moerr.NewInvalidInput(interpreter.ctx, "unknown column '%s' in 'NEW'", strings.TrimPrefix(name, "new."))
+		}
+	}
+
+	interpreter.bh.ClearExecResultSet()
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, "select "+interpreter.GetString(assign.Value))
+	if err != nil {
+		return err
+	}
+	erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalError(interpreter.ctx, "no value for %s", name)
+	}
+	value, err := erArray[0].(*MysqlResultSet).GetValue(interpreter.ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		value = string(v)
+	case int8:
+		value = int64(v)
+	case int16:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case uint8:
+		value = uint64(v)
+	case uint16:
+		value = uint64(v)
+	case uint32:
+		value = uint64(v)
+	case float32:
+		value = float64(v)
+	case fmt.Stringer:
+		value = v.String()
+	}
+	if !assign.System {
+		return interpreter.ses.SetUserDefinedVar(name, value)
+	}
+	if isRowVar {
+		(*interpreter.varScope)[0][name] = value
+		return nil
+	}
+	return interpreter.SetSpVar(name, value)
+}
+
 // Evaluate condition by sending it to bh with a select
 func (interpreter *Interpreter) EvalCond(cond string) (int, error) {
 	interpreter.bh.ClearExecResultSet()
@@ -520,6 +592,14 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 		for _, assign := range st.Assignments {
 			name := assign.Name
 
+			// the values are evaluated with NEW and OLD rows in trigger
+			if interpreter.trigger != nil && !assign.Global {
+				if err := interpreter.SetTriggerVar(assign); err != nil {
+					return SpNotOk, err
+				}
+				continue
+			}
+
 			// if this is a system set, ignore if it's not a INOUT/OUT arg
 			if strings.Contains(interpreter.GetString(st), "@") {
 				str := interpreter.GetString(st)
@@ -546,6 +626,13 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 			}
 		}
 	default: // normal sql. Since we don't support SELECT INTO for now, we don't have to worry about updating variables
+		if interpreter.trigger != nil {
+			switch st.(type) {
+			case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
+				return SpNotOk, moerr.NewNotSupported(interpreter.ctx, "transaction statement in trigger")
+			}
+		}
 		str := interpreter.GetString(st)
 		interpreter.bh.ClearExecResultSet()
 		// For sp variable replacement
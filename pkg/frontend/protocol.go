@@ -495,6 +495,14 @@ func (fp *FakeProtocol) sendEOFOrOkPacket(warnings uint16, status uint16) error
 	return nil
 }
 
+func (fp *FakeProtocol) sendEOFPacket(warnings uint16, status uint16) error {
+	return nil
+}
+
+func (fp *FakeProtocol) changeUser(ctx context.Context, data []byte) error {
+	return nil
+}
+
 func (fp *FakeProtocol) ResetStatistics() {}
 
 func (fp *FakeProtocol) GetStats() string {
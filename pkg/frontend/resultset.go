@@ -136,6 +136,9 @@ type MysqlColumn struct {
 
 	//default value
 	defaultValue []byte
+
+	//the members of enum and set
+	enumValues []string
 }
 
 func (mc *MysqlColumn) DefaultValue() []byte {
@@ -102,6 +102,12 @@ type Session struct {
 
 	prepareStmts map[string]*PrepareStmt
 	lastStmtId   uint32
+	// cursorStmt is the prepared statement which is executed with a cursor
+	// by the current COM_STMT_EXECUTE.
+	cursorStmt *PrepareStmt
+	// cursor is opened by the last COM_STMT_EXECUTE with a cursor, its pipeline
+	// may be running, see stmtCursor.
+	cursor *stmtCursor
 
 	requestCtx context.Context
 	connectCtx context.Context
@@ -117,6 +123,9 @@ type Session struct {
 	resultBatches []*batch.Batch
 
 	tenant *TenantInfo
+	// loginTenant keeps the account, user and role at login. The role
+	// switched by SET ROLE is restored to it when the session is reset.
+	loginTenant *TenantInfo
 
 	uuid uuid.UUID
 
@@ -181,6 +190,10 @@ type Session struct {
 
 	sqlHelper *SqlHelper
 
+	// triggerDepth is the nesting level of the trigger whose body is run by
+	// the session, it is 0 for the sessions not running triggers.
+	triggerDepth int
+
 	rm *RoutineManager
 
 	rt *Routine
@@ -374,6 +387,9 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 }
 
 func (ses *Session) Close() {
+	if err := ses.closeCursor(); err != nil {
+		logErrorf(ses.GetDebugString(), "close the cursor failed. error:%v", err)
+	}
 	if ses.flag {
 		mp := ses.GetMemPool()
 		mpool.DeleteMPool(mp)
@@ -398,6 +414,7 @@ func (ses *Session) Close() {
 	ses.connectCtx = nil
 	ses.allResultSet = nil
 	ses.tenant = nil
+	ses.loginTenant = nil
 	ses.priv = nil
 	ses.errInfo = nil
 	ses.cache = nil
@@ -413,9 +430,64 @@ func (ses *Session) Close() {
 	ses.statsCache = nil
 	ses.seqCurValues = nil
 	ses.seqLastValue = ""
+	if ses.sqlHelper != nil {
+		ses.sqlHelper.closeTriggerHandlers()
+	}
 	ses.sqlHelper = nil
 }
 
+// ResetSession resets the state of the session for COM_RESET_CONNECTION and
+// COM_CHANGE_USER, so that the connection can be reused by another client of
+// the connection pool. The open transaction is rolled back. The prepared
+// statements and the temporary tables are dropped. The variables and the role
+// are restored to the ones at login.
+func (ses *Session) ResetSession() error {
+	err := ses.TxnRollback()
+
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.serverStatus = 0
+	ses.optionBits = OPTION_AUTOCOMMIT
+
+	for _, stmt := range ses.prepareStmts {
+		stmt.Close()
+	}
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.cursorStmt = nil
+
+	// the cn label is set by the proxy with the connection attributes,
+	// which are kept by the connection.
+	label, hasLabel := ses.sysVars["cn_label"]
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	if hasLabel {
+		ses.sysVars["cn_label"] = label
+	}
+	ses.userDefinedVars = make(map[string]interface{})
+	ses.timeZone = time.Local
+
+	// the temporary tables are dropped with the temporary engine.
+	ses.InitTempEngine = false
+	ses.tempTablestorage = nil
+	if ee, ok := ses.storage.(*engine.EntireEngine); ok {
+		ee.TempEngine = nil
+	}
+	if ses.txnHandler != nil {
+		ses.txnHandler.SetTempEngine(nil)
+	}
+
+	if ses.loginTenant != nil {
+		ses.tenant = ses.loginTenant.Copy()
+	}
+	ses.cache.invalidate()
+	ses.planCache.clean()
+	ses.lastInsertID = 0
+	ses.seqCurValues = make(map[uint64]string)
+	ses.seqLastValue = ""
+	ses.errInfo.codes = ses.errInfo.codes[:0]
+	ses.errInfo.msgs = ses.errInfo.msgs[:0]
+	return err
+}
+
 // BackgroundSession executing the sql in background
 type BackgroundSession struct {
 	*Session
@@ -434,6 +506,8 @@ func NewBackgroundSession(
 
 	ses := NewSession(&FakeProtocol{}, mp, PU, gSysVars, false, aicm)
 	ses.upstream = upstream
+	// the statements in background may fire triggers
+	ses.sqlHelper = &SqlHelper{ses: ses}
 	ses.SetOutputCallback(fakeDataSetFetcher)
 	if stmt := motrace.StatementFromContext(reqCtx); stmt != nil {
 		logutil.Infof("session uuid: %s -> background session uuid: %s", uuid.UUID(stmt.SessionID).String(), ses.uuid.String())
@@ -880,6 +954,15 @@ func (ses *Session) SetTenantInfo(ti *TenantInfo) {
 	ses.tenant = ti
 }
 
+// saveLoginTenantInfo saves the tenant info after the user is authenticated.
+func (ses *Session) saveLoginTenantInfo() {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.tenant != nil {
+		ses.loginTenant = ses.tenant.Copy()
+	}
+}
+
 func checkPlanIsInsertValues(p *plan.Plan) (bool, *batch.Batch) {
 	qry := p.GetQuery()
 	if qry != nil && qry.StmtType == plan.Query_INSERT {
@@ -944,6 +1027,51 @@ func (ses *Session) GetPrepareStmt(name string) (*PrepareStmt, error) {
 	return nil, moerr.NewInvalidState(ses.requestCtx, "prepared statement '%s' does not exist", name)
 }
 
+func (ses *Session) SetCursorStmt(stmt *PrepareStmt) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.cursorStmt = stmt
+}
+
+func (ses *Session) GetCursorStmt() *PrepareStmt {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursorStmt
+}
+
+func (ses *Session) setCursor(cursor *stmtCursor) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.cursor = cursor
+}
+
+func (ses *Session) getCursor() *stmtCursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursor
+}
+
+// drainCursor ends the pipeline of the cursor before the session runs another
+// command, the rest of the rows are saved in the cursor.
+func (ses *Session) drainCursor() error {
+	cursor := ses.getCursor()
+	if cursor == nil {
+		return nil
+	}
+	ses.setCursor(nil)
+	return cursor.drain()
+}
+
+// closeCursor cancels the pipeline of the cursor.
+func (ses *Session) closeCursor() error {
+	cursor := ses.getCursor()
+	if cursor == nil {
+		return nil
+	}
+	ses.setCursor(nil)
+	return cursor.close()
+}
+
 func (ses *Session) RemovePrepareStmt(name string) {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1717,6 +1845,12 @@ func (bh *BackgroundHandler) GetExecResultBatches() []*batch.Batch {
 
 type SqlHelper struct {
 	ses *Session
+
+	mu sync.Mutex
+	// triggerHandlers are the idle background handlers running the bodies of
+	// the triggers fired by the request triggerCtx.
+	triggerCtx      context.Context
+	triggerHandlers []*BackgroundHandler
 }
 
 // Made for sequence func. nextval, setval.
@@ -1784,6 +1918,31 @@ func (ses *Session) getLastCommitTS() timestamp.Timestamp {
 	return minTS
 }
 
+// getPinnedSnapshotTS returns the snapshot timestamp pinned by the session
+// variable snapshot_ts. The transactions of the session read the data at the
+// pinned snapshot and can not write anything.
+func (ses *Session) getPinnedSnapshotTS() (timestamp.Timestamp, bool, error) {
+	v, ok := ses.GetSysVar("snapshot_ts").(string)
+	if !ok || v == "" {
+		return timestamp.Timestamp{}, false, nil
+	}
+	ts, err := timestamp.ParseTimestamp(v)
+	if err != nil {
+		return timestamp.Timestamp{}, false, err
+	}
+	return ts, true, nil
+}
+
+// getQueryMemoryLimit returns the memory budget of a query, the hash join,
+// group by and order by spill to disk over it. The session variable
+// query_memory_limit overrides the configuration if it is not 0.
+func (ses *Session) getQueryMemoryLimit() int64 {
+	if v, ok := ses.GetSysVar("query_memory_limit").(int64); ok && v > 0 {
+		return v
+	}
+	return ses.GetParameterUnit().SV.QueryMemoryLimit
+}
+
 // getCNLabels parse the session variable and returns map[string]string.
 func (ses *Session) getCNLabels() map[string]string {
 	label, ok := ses.sysVars["cn_label"]
@@ -28,12 +28,14 @@ import (
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTxnHandler_NewTxn(t *testing.T) {
@@ -96,6 +98,71 @@ func TestTxnHandler_NewTxn(t *testing.T) {
 	})
 }
 
+func TestTxnHandler_NewTxnWithPinnedSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.TODO()
+	txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	var optionCount []int
+	txnClient.EXPECT().New(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ timestamp.Timestamp, options ...client.TxnOption) (client.TxnOperator, error) {
+			optionCount = append(optionCount, len(options))
+			return txnOperator, nil
+		}).AnyTimes()
+	txnClient.EXPECT().New(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ timestamp.Timestamp, options ...client.TxnOption) (client.TxnOperator, error) {
+			optionCount = append(optionCount, len(options))
+			return txnOperator, nil
+		}).AnyTimes()
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+	require.NoError(t, err)
+
+	th := InitTxnHandler(eng, txnClient)
+	th.ses = &Session{
+		requestCtx: ctx,
+		connectCtx: ctx,
+		pu:         pu,
+		sysVars:    map[string]interface{}{"snapshot_ts": ""},
+	}
+	_, _, err = th.NewTxnOperator()
+	require.NoError(t, err)
+
+	th.ses.sysVars["snapshot_ts"] = timestamp.Timestamp{PhysicalTime: 10, LogicalTime: 1}.DebugString()
+	ts, pinned, err := th.ses.getPinnedSnapshotTS()
+	require.NoError(t, err)
+	require.True(t, pinned)
+	require.Equal(t, timestamp.Timestamp{PhysicalTime: 10, LogicalTime: 1}, ts)
+	_, _, err = th.NewTxnOperator()
+	require.NoError(t, err)
+	// the snapshot and the isolation are added
+	require.Equal(t, 2, optionCount[1]-optionCount[0])
+
+	th.ses.sysVars["snapshot_ts"] = "abc"
+	_, _, err = th.NewTxnOperator()
+	require.Error(t, err)
+
+	require.True(t, IsWriteStatement(&tree.Insert{}))
+	require.True(t, IsWriteStatement(&tree.CreateTable{}))
+	require.False(t, IsWriteStatement(&tree.Select{}))
+	require.False(t, IsWriteStatement(&tree.SetVar{}))
+
+	// the prepared statement is checked for EXECUTE
+	th.ses.prepareStmts = map[string]*PrepareStmt{
+		"ins": {PrepareStmt: &tree.Insert{}},
+		"sel": {PrepareStmt: &tree.Select{}},
+	}
+	require.False(t, IsWriteStatement(&tree.Execute{Name: "ins"}))
+	require.True(t, isWriteStatementToExecute(th.ses, &tree.Execute{Name: "ins"}))
+	require.False(t, isWriteStatementToExecute(th.ses, &tree.Execute{Name: "sel"}))
+	require.False(t, isWriteStatementToExecute(th.ses, &tree.Execute{Name: "none"}))
+	require.True(t, isWriteStatementToExecute(th.ses, &tree.Insert{}))
+}
+
 func TestTxnHandler_CommitTxn(t *testing.T) {
 	convey.Convey("commit txn", t, func() {
 		ctrl := gomock.NewController(t)
@@ -277,6 +344,79 @@ func TestSession_TxnBegin(t *testing.T) {
 	})
 }
 
+func TestSession_TxnSavePoint(t *testing.T) {
+	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) (*Session, *mock_frontend.MockTxnOperator) {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New(gomock.Any(), gomock.Any()).Return(txnOperator, nil).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		hints := engine.Hints{CommitOrRollbackTimeout: time.Second * 10}
+		eng.EXPECT().Hints().Return(hints).AnyTimes()
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Commit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		session := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, eng, txnClient, nil), gSysVars, false, nil)
+		session.SetRequestContext(context.Background())
+		session.SetConnectContext(context.Background())
+		return session, txnOperator
+	}
+	convey.Convey("savepoint", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+
+		ses, txnOperator := genSession(ctrl, gSysVars)
+		// no transaction
+		err := ses.TxnSavePoint("a")
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnRollbackToSavePoint("a")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+
+		err = ses.TxnBegin()
+		convey.So(err, convey.ShouldBeNil)
+		txnOperator.EXPECT().SavePoint(gomock.Any(), "sp1").Return(nil)
+		err = ses.TxnSavePoint("SP1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.GetTxnHandler().HasSavePoint(), convey.ShouldBeTrue)
+
+		// the failed statement is rolled back alone
+		stmt := &tree.Insert{}
+		txnOperator.EXPECT().SavePoint(gomock.Any(), stmtSavePoint).Return(nil)
+		err = ses.TxnStatementSavePoint(stmt)
+		convey.So(err, convey.ShouldBeNil)
+		txnOperator.EXPECT().RollbackToSavePoint(gomock.Any(), stmtSavePoint).Return(nil)
+		txnOperator.EXPECT().ReleaseSavePoint(gomock.Any(), stmtSavePoint).Return(nil)
+		convey.So(ses.TxnRollbackStatement(stmt), convey.ShouldBeTrue)
+		// the failed savepoint statement does not abort the transaction
+		convey.So(ses.TxnRollbackStatement(&tree.RollbackToSavePoint{}), convey.ShouldBeTrue)
+		convey.So(ses.TxnRollbackStatement(&tree.CommitTransaction{}), convey.ShouldBeFalse)
+
+		txnOperator.EXPECT().RollbackToSavePoint(gomock.Any(), "sp1").Return(nil)
+		err = ses.TxnRollbackToSavePoint("sp1")
+		convey.So(err, convey.ShouldBeNil)
+		txnOperator.EXPECT().ReleaseSavePoint(gomock.Any(), "sp1").Return(nil)
+		err = ses.TxnReleaseSavePoint("Sp1")
+		convey.So(err, convey.ShouldBeNil)
+
+		err = ses.TxnCommit()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.GetTxnHandler().HasSavePoint(), convey.ShouldBeFalse)
+	})
+}
+
 func TestVariables(t *testing.T) {
 	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
 		ioses := mock_frontend.NewMockIOSession(ctrl)
@@ -725,3 +865,57 @@ func TestSetTempTableStorage(t *testing.T) {
 
 	assert.Equal(t, defines.TEMPORARY_TABLE_DN_ADDR, dnStore.TxnServiceAddress)
 }
+
+func TestSession_ResetSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	if err != nil {
+		t.Error(err)
+	}
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	eng := mock_frontend.NewMockEngine(ctrl)
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, eng, txnClient, nil)
+	gSysVars := &GlobalSystemVariables{}
+	InitGlobalSystemVariables(gSysVars)
+	ses := NewSession(proto, nil, pu, gSysVars, true, nil)
+	ses.SetRequestContext(context.Background())
+
+	ses.SetTenantInfo(&TenantInfo{Tenant: "acc1", User: "user1", DefaultRole: "role1", DefaultRoleID: 1})
+	ses.saveLoginTenantInfo()
+
+	// change the state of the session
+	assert.NoError(t, ses.SetUserDefinedVar("a", 1))
+	ses.SetSysVar("autocommit", int64(0))
+	ses.SetSysVar("cn_label", "k=v")
+	ses.SetOptionBits(OPTION_BEGIN)
+	ses.SetServerStatus(SERVER_STATUS_IN_TRANS)
+	ses.prepareStmts["stmt1"] = &PrepareStmt{}
+	ses.SetCursorStmt(ses.prepareStmts["stmt1"])
+	ses.EnableInitTempEngine()
+	ses.SetLastInsertID(10)
+	ses.GetTenantInfo().SetDefaultRole("role2")
+	ses.GetTenantInfo().SetDefaultRoleID(2)
+
+	assert.NoError(t, ses.ResetSession())
+	_, val, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+	assert.Equal(t, int64(1), ses.GetSysVar("autocommit"))
+	assert.Equal(t, "k=v", ses.GetSysVar("cn_label"))
+	assert.True(t, ses.OptionBitsIsSet(OPTION_AUTOCOMMIT))
+	assert.False(t, ses.OptionBitsIsSet(OPTION_BEGIN))
+	assert.False(t, ses.ServerStatusIsSet(SERVER_STATUS_IN_TRANS))
+	assert.Empty(t, ses.prepareStmts)
+	assert.Nil(t, ses.GetCursorStmt())
+	assert.False(t, ses.IfInitedTempEngine())
+	assert.Equal(t, uint64(0), ses.GetLastInsertID())
+	assert.Equal(t, "role1", ses.GetTenantInfo().GetDefaultRole())
+	assert.Equal(t, uint32(1), ses.GetTenantInfo().GetDefaultRoleID())
+}
@@ -61,6 +61,33 @@ func (rte *RollbackTxnExecutor) ExecuteImpl(ctx context.Context, ses *Session) e
 	return ses.TxnRollback()
 }
 
+type SavePointExecutor struct {
+	*statusStmtExecutor
+	sp *tree.SavePoint
+}
+
+func (spe *SavePointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnSavePoint(string(spe.sp.Name))
+}
+
+type RollbackToSavePointExecutor struct {
+	*statusStmtExecutor
+	rsp *tree.RollbackToSavePoint
+}
+
+func (rspe *RollbackToSavePointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnRollbackToSavePoint(string(rspe.rsp.Name))
+}
+
+type ReleaseSavePointExecutor struct {
+	*statusStmtExecutor
+	rsp *tree.ReleaseSavePoint
+}
+
+func (rspe *ReleaseSavePointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnReleaseSavePoint(string(rspe.rsp.Name))
+}
+
 type SetRoleExecutor struct {
 	*statusStmtExecutor
 	sr *tree.SetRole
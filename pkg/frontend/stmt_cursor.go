@@ -0,0 +1,240 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+)
+
+// cursorPrefetchRows is the count of the rows produced by the pipeline of a
+// cursor before the first COM_STMT_FETCH.
+const cursorPrefetchRows = 1024
+
+// stmtCursor keeps the result set of a prepared statement executed with
+// CURSOR_TYPE_READ_ONLY, and sends it to the client by COM_STMT_FETCH in chunks.
+//
+// The pipeline of the statement keeps running after COM_STMT_EXECUTE returns,
+// and it's blocked once the rows which have not been fetched are as many as the
+// rows of the last fetch, so the result set is not materialized. The transaction
+// of the statement is finished after the pipeline ends.
+//
+// The session runs one command at a time, so the pipeline has to end before the
+// session runs another command than COM_STMT_FETCH. Then the rest of the rows
+// are saved in the cursor, like the materialized cursor of mysql.
+//
+// The request context is canceled once COM_STMT_EXECUTE returns, so the
+// statement runs on a context made by newCursorContext, which is canceled by
+// closing the cursor or after the pipeline ends.
+type stmtCursor struct {
+	sync.Mutex
+	cond sync.Cond
+
+	columns    []Column
+	name2Index map[string]uint64
+	// rows are produced by the pipeline and have not been fetched.
+	rows [][]interface{}
+	// limit is the count of the rows of the last fetch, the pipeline is blocked
+	// when there are limit rows in the cursor. 0 means no limit.
+	limit uint64
+	// running is true until the pipeline ends, err is the error of the pipeline.
+	running bool
+	err     error
+	// closed is set when the rows are not wanted, the pipeline is canceled.
+	closed bool
+	done   chan struct{}
+	// finish finishes the statement after the pipeline ends.
+	finish func(err error) error
+	// cancel cancels the context of the pipeline.
+	cancel context.CancelFunc
+}
+
+func newStmtCursor(mrs *MysqlResultSet, cancel context.CancelFunc) *stmtCursor {
+	c := &stmtCursor{
+		// the columns of the session result set are reused by the next statement.
+		columns:    append([]Column(nil), mrs.Columns...),
+		name2Index: mrs.Name2Index,
+		limit:      cursorPrefetchRows,
+		cancel:     cancel,
+	}
+	c.cond.L = &c.Mutex
+	return c
+}
+
+// start runs the pipeline in the background, the rows are saved into the cursor
+// by fill.
+func (c *stmtCursor) start(run func() error) {
+	c.Lock()
+	c.running = true
+	c.done = make(chan struct{})
+	c.Unlock()
+	go func() {
+		err := run()
+		c.Lock()
+		c.running = false
+		c.err = err
+		c.cond.Broadcast()
+		c.Unlock()
+		close(c.done)
+	}()
+}
+
+// fill saves the rows of the batch from the pipeline into the cursor, it blocks
+// the pipeline until the rows are fetched if there are enough rows in the cursor.
+func (c *stmtCursor) fill(ses *Session, bat *batch.Batch) error {
+	mrs := &MysqlResultSet{Columns: c.columns, Name2Index: c.name2Index}
+	oq := newFakeOutputQueue(mrs)
+	for j := 0; j < bat.Vecs[0].Length(); j++ {
+		if bat.Zs[j] <= 0 {
+			continue
+		}
+		if _, err := extractRowFromEveryVector(ses, bat, j, oq); err != nil {
+			return err
+		}
+	}
+	// the bytes refer to the memory of the batch, which is reused by the pipeline.
+	for _, row := range mrs.Data {
+		for i, v := range row {
+			if b, ok := v.([]byte); ok {
+				row[i] = append([]byte(nil), b...)
+			}
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.rows = append(c.rows, mrs.Data...)
+	c.cond.Broadcast()
+	for !c.closed && c.limit > 0 && uint64(len(c.rows)) >= c.limit {
+		c.cond.Wait()
+	}
+	if c.closed {
+		return moerr.NewQueryInterrupted(ses.GetRequestContext())
+	}
+	return nil
+}
+
+// next returns a result set with at most n rows which have not been fetched,
+// and whether all the rows have been fetched. The error of the pipeline is
+// returned after the rows produced before the error.
+func (c *stmtCursor) next(n uint64) (*MysqlResultSet, bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	if n > 0 {
+		c.limit = n
+		c.cond.Broadcast()
+	}
+	for c.running && uint64(len(c.rows)) < n {
+		c.cond.Wait()
+	}
+	if !c.running && len(c.rows) == 0 && c.err != nil {
+		return nil, true, c.err
+	}
+	end := uint64(len(c.rows))
+	if n < end {
+		end = n
+	}
+	mrs := &MysqlResultSet{
+		Columns:    c.columns,
+		Name2Index: c.name2Index,
+		Data:       c.rows[:end:end],
+	}
+	c.rows = c.rows[end:]
+	return mrs, !c.running && len(c.rows) == 0 && c.err == nil, nil
+}
+
+// drain saves all the rest of the rows into the cursor, and finishes the statement.
+func (c *stmtCursor) drain() error {
+	c.Lock()
+	c.limit = 0
+	c.cond.Broadcast()
+	c.Unlock()
+	return c.wait()
+}
+
+// close cancels the pipeline, and finishes the statement.
+func (c *stmtCursor) close() error {
+	c.Lock()
+	c.closed = true
+	c.rows = nil
+	c.cond.Broadcast()
+	c.Unlock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	return c.wait()
+}
+
+// wait waits for the end of the pipeline, and finishes the statement once.
+func (c *stmtCursor) wait() error {
+	c.Lock()
+	done := c.done
+	c.Unlock()
+	if done != nil {
+		<-done
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.Lock()
+	finish := c.finish
+	c.finish = nil
+	err := c.err
+	c.Unlock()
+	if finish == nil {
+		return nil
+	}
+	return finish(err)
+}
+
+// setFinish sets the function to finish the statement after the pipeline ends.
+func (c *stmtCursor) setFinish(finish func(err error) error) {
+	c.Lock()
+	defer c.Unlock()
+	c.finish = finish
+}
+
+// newCursorContext returns a context for the statement executed with a cursor.
+// It keeps the values of the request context, but it is not canceled with the
+// request, as the pipeline keeps running after COM_STMT_EXECUTE returns.
+func newCursorContext(requestCtx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithCancel(detachedContext{parent: requestCtx})
+}
+
+// detachedContext keeps the values of the parent context, but it has no
+// deadline and it is never canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key any) any {
+	return c.parent.Value(key)
+}
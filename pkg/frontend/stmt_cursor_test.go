@@ -0,0 +1,246 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCursor(t *testing.T) {
+	proc := testutil.NewProcess()
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(&MysqlColumn{})
+	mrs.AddColumn(&MysqlColumn{})
+	cursor := newStmtCursor(mrs, nil)
+
+	const rows = 5
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(i), false, proc.Mp()))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte{'a' + byte(i)}, false, proc.Mp()))
+	}
+	bat.InitZsOne(rows)
+	// the row is duplicated
+	bat.Zs[1] = 2
+
+	require.NoError(t, cursor.fill(&Session{}, bat))
+	// the bytes are copied from the batch.
+	bat.Clean(proc.Mp())
+
+	res, done, err := cursor.next(4)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, uint64(4), res.GetRowCount())
+	require.Equal(t, []interface{}{int64(1), []byte("b")}, res.Data[1])
+	require.Equal(t, []interface{}{int64(1), []byte("b")}, res.Data[2])
+
+	res, done, err = cursor.next(4)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, uint64(2), res.GetRowCount())
+	require.Equal(t, []interface{}{int64(4), []byte("e")}, res.Data[1])
+
+	res, done, err = cursor.next(4)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, uint64(0), res.GetRowCount())
+}
+
+func TestStmtCursorBackpressure(t *testing.T) {
+	proc := testutil.NewProcess()
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(&MysqlColumn{})
+	cursor := newStmtCursor(mrs, nil)
+
+	const rows = 3 * cursorPrefetchRows
+	var produced atomic.Int64
+	cursor.start(func() error {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		defer bat.Clean(proc.Mp())
+		for i := 0; i < rows; i++ {
+			bat.CleanOnlyData()
+			if err := vector.AppendFixed(bat.Vecs[0], int64(i), false, proc.Mp()); err != nil {
+				return err
+			}
+			bat.InitZsOne(1)
+			if err := cursor.fill(&Session{}, bat); err != nil {
+				return err
+			}
+			produced.Add(1)
+		}
+		return moerr.NewInternalErrorNoCtx("pipeline failed")
+	})
+	var finished error
+	cursor.setFinish(func(err error) error {
+		finished = err
+		return nil
+	})
+
+	// the pipeline is blocked until the rows are fetched
+	res, done, err := cursor.next(10)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, []interface{}{int64(9)}, res.Data[9])
+	require.Less(t, produced.Load(), int64(rows))
+
+	// the rows are fetched in order
+	fetched := int64(10)
+	for fetched < rows {
+		res, done, err = cursor.next(100)
+		require.NoError(t, err)
+		require.LessOrEqual(t, produced.Load()-fetched, int64(cursorPrefetchRows))
+		require.Equal(t, []interface{}{fetched}, res.Data[0])
+		fetched += int64(res.GetRowCount())
+	}
+	require.False(t, done)
+
+	// the error of the pipeline is returned after the rows
+	_, done, err = cursor.next(100)
+	require.Error(t, err)
+	require.True(t, done)
+	require.NoError(t, cursor.wait())
+	require.Error(t, finished)
+}
+
+func TestStmtCursorClose(t *testing.T) {
+	proc := testutil.NewProcess()
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(&MysqlColumn{})
+	cursor := newStmtCursor(mrs, nil)
+	cursor.start(func() error {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		defer bat.Clean(proc.Mp())
+		for i := 0; ; i++ {
+			bat.CleanOnlyData()
+			if err := vector.AppendFixed(bat.Vecs[0], int64(i), false, proc.Mp()); err != nil {
+				return err
+			}
+			bat.InitZsOne(1)
+			if err := cursor.fill(&Session{}, bat); err != nil {
+				return err
+			}
+		}
+	})
+	var finished error
+	cursor.setFinish(func(err error) error {
+		finished = err
+		return nil
+	})
+	require.NoError(t, cursor.close())
+	require.True(t, moerr.IsMoErrCode(finished, moerr.ErrQueryInterrupted))
+}
+
+func TestStmtCursorFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil, nil)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, false, nil)
+	ses.SetRequestContext(context.TODO())
+	proto.ses = ses
+	mce := NewMysqlCmdExecutor()
+	mce.SetSession(ses)
+
+	preStmt := &PrepareStmt{}
+	ses.prepareStmts = map[string]*PrepareStmt{getPrepareStmtName(1): preStmt}
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(&MysqlColumn{})
+
+	// the statement runs on the context of the cursor, which is not canceled
+	// with the request of COM_STMT_EXECUTE.
+	requestCtx, cancelRequest := context.WithCancel(context.TODO())
+	execCtx, cancel := newCursorContext(requestCtx)
+	preStmt.cursor = newStmtCursor(mrs, cancel)
+	ses.setCursor(preStmt.cursor)
+
+	proc := testutil.NewProcess()
+	const rows = 3 * cursorPrefetchRows
+	var produced atomic.Int64
+	preStmt.cursor.start(func() error {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		defer bat.Clean(proc.Mp())
+		for i := 0; i < rows; i++ {
+			if err := execCtx.Err(); err != nil {
+				return err
+			}
+			bat.CleanOnlyData()
+			if err := vector.AppendFixed(bat.Vecs[0], int64(i), false, proc.Mp()); err != nil {
+				return err
+			}
+			bat.InitZsOne(1)
+			if err := getDataFromPipeline(ses, bat); err != nil {
+				return err
+			}
+			produced.Add(1)
+		}
+		return nil
+	})
+	var finished atomic.Bool
+	preStmt.cursor.setFinish(func(err error) error {
+		finished.Store(true)
+		return err
+	})
+	cancelRequest()
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint32(data[0:4], 1)
+	binary.LittleEndian.PutUint32(data[4:8], 100)
+	for i := 0; preStmt.cursor != nil; i++ {
+		require.LessOrEqual(t, i, rows/100+1)
+		require.NoError(t, mce.handleStmtFetch(context.TODO(), data))
+	}
+	require.Equal(t, int64(rows), produced.Load())
+	require.True(t, finished.Load())
+	require.Nil(t, ses.getCursor())
+	require.Error(t, execCtx.Err())
+}
+
+func TestPrepareStmtLongData(t *testing.T) {
+	stmt := &PrepareStmt{}
+	stmt.appendLongData(1, []byte("abc"))
+	stmt.appendLongData(1, []byte("def"))
+	require.Equal(t, []byte("abcdef"), stmt.longData[1])
+
+	stmt.cursor = &stmtCursor{}
+	stmt.Reset()
+	require.Nil(t, stmt.longData)
+	require.Nil(t, stmt.cursor)
+}
@@ -89,6 +89,29 @@ func IsCreateDropSequence(stmt tree.Statement) bool {
 	return false
 }
 
+// IsWriteStatement checks the statement changes the data or the metadata.
+func IsWriteStatement(stmt tree.Statement) bool {
+	switch stmt.GetQueryType() {
+	case tree.QueryTypeDML, tree.QueryTypeDDL, tree.QueryTypeDCL:
+		return true
+	}
+	return false
+}
+
+// isWriteStatementToExecute is IsWriteStatement, but it checks the prepared
+// statement of EXECUTE, including COM_STMT_EXECUTE, which is not classified as
+// a write statement itself. An unknown prepared statement fails later.
+func isWriteStatementToExecute(ses *Session, stmt tree.Statement) bool {
+	if st, ok := stmt.(*tree.Execute); ok {
+		prepareStmt, err := ses.GetPrepareStmt(string(st.Name))
+		if err != nil || prepareStmt.PrepareStmt == nil {
+			return false
+		}
+		stmt = prepareStmt.PrepareStmt
+	}
+	return IsWriteStatement(stmt)
+}
+
 /*
 NeedToBeCommittedInActiveTransaction checks the statement that need to be committed
 in an active transaction.
@@ -138,7 +161,8 @@ func statementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load, *tree.MoDump, *tree.ValuesStatement:
 		return true, nil
 		//transaction
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
 		return true, nil
 		//show
 	case *tree.ShowCreateTable,
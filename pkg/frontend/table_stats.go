@@ -0,0 +1,555 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"go.uber.org/zap"
+)
+
+const (
+	fetchAnalyzeColumnsFormat = `select attname, atttyp from mo_catalog.mo_columns where att_database = '%s' and att_relname = '%s' and att_is_hidden = 0 and attname != '%s' order by attnum;`
+
+	fetchMoColumnStatsFormat = `select column_name, table_rows, ndv, null_count, histogram, mcv from mo_catalog.mo_column_stats where db = '%s' and table_name = '%s';`
+
+	deleteMoColumnStatsOfColumnFormat = `delete from mo_catalog.mo_column_stats where db = '%s' and table_name = '%s' and column_name = '%s';`
+
+	insertMoColumnStatsFormat = `insert into mo_catalog.mo_column_stats(db, table_name, column_name, table_rows, ndv, null_count, histogram, mcv, analyzed_time) values ('%s', '%s', '%s', %d, %d, %d, '%s', '%s', '%s');`
+
+	deleteMoColumnStatsOfTableFormat = `delete from mo_catalog.mo_column_stats where db = '%s' and table_name = '%s';`
+
+	deleteMoColumnStatsOfDatabaseFormat = `delete from mo_catalog.mo_column_stats where db = '%s';`
+
+	fetchMostCommonValuesFormat = "select cast(`%s` as char), count(*) from `%s`.`%s` where `%s` is not null group by `%s` order by count(*) desc limit %d;"
+
+	fetchHistogramSampleFormat = "select cast(`%s` as char) from `%s`.`%s` where `%s` is not null%s;"
+)
+
+const (
+	// mostCommonValues is the max number of the most common values of a column
+	mostCommonValues = 10
+	// histogramSampleRows is about the number of the rows sampled to build a
+	// histogram
+	histogramSampleRows = 10000
+
+	// columnStatsReloadInterval is how long the statistics of a table loaded
+	// by a session are used before they are loaded again.
+	columnStatsReloadInterval = time.Minute
+
+	// a table is analyzed again in background once its rows have changed by
+	// autoAnalyzeRatio since it was analyzed
+	autoAnalyzeRatio   = 0.2
+	autoAnalyzeMinRows = 1000
+	autoAnalyzeTimeout = 10 * time.Minute
+)
+
+// autoAnalyzing are the tables being analyzed in background.
+var autoAnalyzing sync.Map
+
+// columnStatsCache are the statistics loaded from mo_column_stats by the
+// sessions of the CN, the key is columnStatsKey of the table.
+var columnStatsCache sync.Map
+
+// columnStatsEntry is the statistics of a table in columnStatsCache.
+type columnStatsEntry struct {
+	tableID  uint64
+	stats    map[string]*plan2.ColumnStats
+	loadTime time.Time
+}
+
+// columnStatsKey returns the key of the table in columnStatsCache,
+// autoAnalyzing and triggersCache, or the key of the trigger in triggerBodies.
+func columnStatsKey(accountID uint32, dbName, tableName string) string {
+	return fmt.Sprintf("%d/%s/%s", accountID, dbName, tableName)
+}
+
+// analyzedColumn is a column to analyze.
+type analyzedColumn struct {
+	name string
+	typ  types.Type
+}
+
+// hasMostCommonValues returns whether the most common values of the column
+// are collected, the large values like text are skipped.
+func (col *analyzedColumn) hasMostCommonValues() bool {
+	oid := col.typ.Oid
+	return oid.IsInteger() || oid.IsFloat() || oid.IsDecimal() || oid.IsDateRelate() ||
+		oid == types.T_bool || oid == types.T_char || oid == types.T_varchar
+}
+
+// hasHistogram returns whether the histogram of the column is built, the
+// planner estimates the ranges of numbers and dates only.
+func (col *analyzedColumn) hasHistogram() bool {
+	oid := col.typ.Oid
+	return oid.IsInteger() || oid.IsFloat() || oid == types.T_date
+}
+
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
+	ses := mce.GetSession()
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDB(requestCtx)
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	cols := make([]string, len(stmt.Cols))
+	for i, col := range stmt.Cols {
+		cols[i] = string(col)
+	}
+
+	bh := ses.GetBackgroundExec(requestCtx)
+	defer bh.Close()
+	if err := analyzeTable(requestCtx, bh, dbName, string(stmt.Table.ObjectName), cols); err != nil {
+		return err
+	}
+	// the statistics are loaded again by the next query of the session
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		columnStatsCache.Delete(columnStatsKey(tenant.GetTenantID(), dbName, string(stmt.Table.ObjectName)))
+	}
+	ses.statsCache = plan2.NewStatsCache()
+	return nil
+}
+
+// analyzeTable collects the statistics of the columns of the table and saves
+// them into mo_column_stats, all columns are analyzed if cols is empty. The
+// ndv and null count are computed from all rows, the most common values are
+// counted exactly and the histograms are built from a sample of the rows.
+func analyzeTable(ctx context.Context, bh BackgroundExec, dbName, tableName string, cols []string) error {
+	columns, err := getAnalyzedColumns(ctx, bh, dbName, tableName, cols)
+	if err != nil {
+		return err
+	}
+
+	// select count(*), count(a), approx_count_distinct(a), ... from db.t
+	var sb strings.Builder
+	sb.WriteString("select count(*)")
+	for _, col := range columns {
+		colName := sqlIdentEscaper.Replace(col.name)
+		fmt.Fprintf(&sb, ", count(`%s`), approx_count_distinct(`%s`)", colName, colName)
+	}
+	fmt.Fprintf(&sb, " from `%s`.`%s`;", sqlIdentEscaper.Replace(dbName), sqlIdentEscaper.Replace(tableName))
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sb.String()); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalError(ctx, "failed to analyze table '%s.%s'", dbName, tableName)
+	}
+	rows, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	stats := make([]*plan2.ColumnStats, len(columns))
+	for i, col := range columns {
+		notNull, err := erArray[0].GetInt64(ctx, 0, uint64(2*i+1))
+		if err != nil {
+			return err
+		}
+		ndv, err := erArray[0].GetUint64(ctx, 0, uint64(2*i+2))
+		if err != nil {
+			return err
+		}
+		cs := &plan2.ColumnStats{
+			Rows:      float64(rows),
+			NDV:       float64(ndv),
+			NullCount: float64(rows - notNull),
+		}
+		stats[i] = cs
+		if notNull == 0 {
+			continue
+		}
+		// the values are all distinct if the ndv is about the rows
+		if col.hasMostCommonValues() && cs.NDV < float64(notNull)*0.9 {
+			if cs.MCV, err = getMostCommonValues(ctx, bh, dbName, tableName, col.name, rows, notNull, cs.NDV); err != nil {
+				return err
+			}
+		}
+		if col.hasHistogram() {
+			if cs.Histogram, err = getHistogram(ctx, bh, dbName, tableName, col, notNull); err != nil {
+				return err
+			}
+		}
+	}
+	return saveColumnStats(ctx, bh, dbName, tableName, columns, stats)
+}
+
+// getAnalyzedColumns returns the columns to analyze, all visible columns of
+// the table if cols is empty.
+func getAnalyzedColumns(ctx context.Context, bh BackgroundExec, dbName, tableName string, cols []string) ([]analyzedColumn, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(fetchAnalyzeColumnsFormat,
+		sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(tableName), catalog.Row_ID))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewNoSuchTable(ctx, dbName, tableName)
+	}
+	all := make([]analyzedColumn, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		col := analyzedColumn{name: name}
+		if err = types.Decode([]byte(typ), &col.typ); err != nil {
+			return nil, err
+		}
+		all = append(all, col)
+	}
+	if len(cols) == 0 {
+		return all, nil
+	}
+
+	columns := make([]analyzedColumn, 0, len(cols))
+	for _, name := range cols {
+		found := false
+		for _, col := range all {
+			if strings.EqualFold(col.name, name) {
+				columns = append(columns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewBadFieldError(ctx, name, tableName)
+		}
+	}
+	return columns, nil
+}
+
+// getMostCommonValues returns the values of the column more common than the
+// average, at most mostCommonValues of them.
+func getMostCommonValues(ctx context.Context, bh BackgroundExec, dbName, tableName, colName string, rows, notNull int64, ndv float64) ([]plan2.MCV, error) {
+	col, db, tbl := sqlIdentEscaper.Replace(colName), sqlIdentEscaper.Replace(dbName), sqlIdentEscaper.Replace(tableName)
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(fetchMostCommonValuesFormat, col, db, tbl, col, col, mostCommonValues))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	var mcv []plan2.MCV
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		cnt, err := erArray[0].GetInt64(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		if cnt <= 1 || float64(cnt)*ndv <= float64(notNull) {
+			break
+		}
+		val, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		mcv = append(mcv, plan2.MCV{
+			Value: val,
+			Freq:  float64(cnt) / float64(rows),
+		})
+	}
+	return mcv, nil
+}
+
+// getHistogram builds the equi-depth histogram of the column from a sample of
+// about histogramSampleRows rows.
+func getHistogram(ctx context.Context, bh BackgroundExec, dbName, tableName string, col analyzedColumn, notNull int64) ([]float64, error) {
+	sample := ""
+	if notNull > histogramSampleRows {
+		sample = fmt.Sprintf(" and rand() < %v", float64(histogramSampleRows)/float64(notNull))
+	}
+	colName := sqlIdentEscaper.Replace(col.name)
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(fetchHistogramSampleFormat,
+		colName, sqlIdentEscaper.Replace(dbName), sqlIdentEscaper.Replace(tableName), colName, sample))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	vals := make([]float64, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		val, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		if f, ok := columnStatsValueFloat(val, col.typ.Oid); ok {
+			vals = append(vals, f)
+		}
+	}
+	return plan2.BuildHistogram(vals, plan2.HistogramBuckets), nil
+}
+
+// saveColumnStats replaces the statistics of the columns in mo_column_stats.
+func saveColumnStats(ctx context.Context, bh BackgroundExec, dbName, tableName string, columns []analyzedColumn, stats []*plan2.ColumnStats) error {
+	var err error
+	var histogram, mcv []byte
+	now := types.CurrentTimestamp().String2(time.UTC, 0)
+	db, tbl := sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(tableName)
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	for i, col := range columns {
+		cs := stats[i]
+		if histogram, err = json.Marshal(cs.Histogram); err != nil {
+			goto handleFailed
+		}
+		if mcv, err = json.Marshal(cs.MCV); err != nil {
+			goto handleFailed
+		}
+		colName := sqlStringEscaper.Replace(col.name)
+		err = bh.Exec(ctx, fmt.Sprintf(deleteMoColumnStatsOfColumnFormat, db, tbl, colName))
+		if err != nil {
+			goto handleFailed
+		}
+		err = bh.Exec(ctx, fmt.Sprintf(insertMoColumnStatsFormat,
+			db, tbl, colName, int64(cs.Rows), int64(cs.NDV), int64(cs.NullCount),
+			sqlStringEscaper.Replace(string(histogram)), sqlStringEscaper.Replace(string(mcv)), now))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// columnStatsValueFloat returns the value of the column on the axis of the
+// histograms, see plan.statsValueFloat.
+func columnStatsValueFloat(val string, oid types.T) (float64, bool) {
+	if oid == types.T_date {
+		d, err := types.ParseDateCast(val)
+		return float64(d), err == nil
+	}
+	f, err := strconv.ParseFloat(val, 64)
+	return f, err == nil
+}
+
+// fetchColumnStats returns the statistics of the analyzed columns of the table.
+func fetchColumnStats(ctx context.Context, ses *Session, dbName, tableName string) (map[string]*plan2.ColumnStats, error) {
+	erArray, err := executeSQLInBackgroundSession(ctx, ses, ses.GetMemPool(), ses.GetParameterUnit(),
+		fmt.Sprintf(fetchMoColumnStatsFormat, sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(tableName)))
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	stats := make(map[string]*plan2.ColumnStats, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		cs := &plan2.ColumnStats{}
+		rows, err := erArray[0].GetInt64(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		ndv, err := erArray[0].GetInt64(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		nulls, err := erArray[0].GetInt64(ctx, i, 3)
+		if err != nil {
+			return nil, err
+		}
+		cs.Rows, cs.NDV, cs.NullCount = float64(rows), float64(ndv), float64(nulls)
+		histogram, err := erArray[0].GetString(ctx, i, 4)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(histogram), &cs.Histogram); err != nil {
+			return nil, err
+		}
+		mcv, err := erArray[0].GetString(ctx, i, 5)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(mcv), &cs.MCV); err != nil {
+			return nil, err
+		}
+		stats[name] = cs
+	}
+	return stats, nil
+}
+
+// loadColumnStats loads the statistics collected by ANALYZE TABLE into the
+// stats cache entry s of the session. The statistics are shared by the
+// sessions of the CN in columnStatsCache, so mo_column_stats is queried by
+// the planner at most once in columnStatsReloadInterval for a table, they are
+// loaded again after it to see the tables analyzed by the other CNs. The table
+// is analyzed again in background if it has changed a lot.
+func (tcc *TxnCompilerContext) loadColumnStats(tableID uint64, dbName, tableName string, s *plan2.StatsInfoMap) {
+	if isBannedDatabase(dbName) {
+		return
+	}
+	if time.Since(s.LoadTime) < columnStatsReloadInterval {
+		s.ApplyColumnStats()
+		return
+	}
+	ses := tcc.GetSession()
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+	key := columnStatsKey(tenant.GetTenantID(), dbName, tableName)
+	if v, ok := columnStatsCache.Load(key); ok {
+		entry := v.(*columnStatsEntry)
+		// the table may be dropped and created again with the same name
+		if entry.tableID == tableID && time.Since(entry.loadTime) < columnStatsReloadInterval {
+			s.ColumnStats = entry.stats
+			s.LoadTime = entry.loadTime
+			s.ApplyColumnStats()
+			return
+		}
+	}
+
+	stats, err := fetchColumnStats(ses.GetRequestContext(), ses, dbName, tableName)
+	// the accounts created before the statistics are supported have no
+	// mo_column_stats
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+		logErrorf(ses.GetDebugString(), "failed to load the statistics of %s.%s: %v", dbName, tableName, err)
+	} else {
+		columnStatsCache.Store(key, &columnStatsEntry{
+			tableID:  tableID,
+			stats:    stats,
+			loadTime: time.Now(),
+		})
+	}
+	s.ColumnStats = stats
+	s.LoadTime = time.Now()
+	s.ApplyColumnStats()
+
+	if !ses.IsBackgroundSession() && needAutoAnalyze(s) {
+		cols := make([]string, 0, len(stats))
+		for name := range stats {
+			cols = append(cols, name)
+		}
+		autoAnalyzeTable(ses, dbName, tableName, cols)
+	}
+}
+
+// needAutoAnalyze returns whether the rows of the table have changed enough
+// since the columns were analyzed.
+func needAutoAnalyze(s *plan2.StatsInfoMap) bool {
+	for _, cs := range s.ColumnStats {
+		changed := math.Abs(s.TableCnt - cs.Rows)
+		if changed >= autoAnalyzeMinRows && changed >= cs.Rows*autoAnalyzeRatio {
+			return true
+		}
+	}
+	return false
+}
+
+// autoAnalyzeTable analyzes the columns of the table in background with the
+// account of the session, a table is analyzed by one task at a time.
+func autoAnalyzeTable(ses *Session, dbName, tableName string, cols []string) {
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+	key := columnStatsKey(tenant.GetTenantID(), dbName, tableName)
+	if _, loaded := autoAnalyzing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+	pu := ses.GetParameterUnit()
+	aicm := ses.GetAutoIncrCacheManager()
+	go func() {
+		defer autoAnalyzing.Delete(key)
+		// the task outlives the request and the connection
+		ctx, cancel := context.WithTimeout(context.Background(), autoAnalyzeTimeout)
+		defer cancel()
+		ctx = context.WithValue(ctx, config.ParameterUnitKey, pu)
+		ctx = context.WithValue(ctx, defines.TenantIDKey{}, tenant.GetTenantID())
+		ctx = context.WithValue(ctx, defines.UserIDKey{}, tenant.GetUserID())
+		ctx = context.WithValue(ctx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
+
+		mp, err := mpool.NewMPool("auto_analyze", 0, mpool.NoFixed)
+		if err != nil {
+			logutil.Error("failed to analyze table in background", zap.String("table", key), zap.Error(err))
+			return
+		}
+		defer mpool.DeleteMPool(mp)
+		upstream := &Session{connectCtx: ctx, autoIncrCacheManager: aicm}
+		bh := NewBackgroundHandler(ctx, upstream, mp, pu)
+		defer bh.Close()
+
+		logutil.Info("analyze table in background", zap.String("table", key))
+		if err = analyzeTable(ctx, bh, dbName, tableName, cols); err != nil {
+			logutil.Error("failed to analyze table in background", zap.String("table", key), zap.Error(err))
+			return
+		}
+		columnStatsCache.Delete(key)
+	}()
+}
+
+// deleteColumnStatsOfDroppedObjects removes the statistics of the tables
+// dropped by DROP TABLE or DROP DATABASE.
+func deleteColumnStatsOfDroppedObjects(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	return deleteRecordsOfDroppedObjects(ctx, ses, stmt, deleteMoColumnStatsOfTableFormat, deleteMoColumnStatsOfDatabaseFormat)
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedBackgroundExec struct {
+	backgroundExecTest
+	sqls []string
+}
+
+func (bh *recordedBackgroundExec) Exec(ctx context.Context, sql string) error {
+	bh.sqls = append(bh.sqls, sql)
+	return bh.backgroundExecTest.Exec(ctx, sql)
+}
+
+func newMrsForAnalyze(rows ...[]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for i := range rows[0] {
+		col := &MysqlColumn{}
+		col.SetName(fmt.Sprintf("c%d", i))
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_analyzeTable(t *testing.T) {
+	ctx := context.Background()
+	bh := &recordedBackgroundExec{}
+	bh.init()
+
+	typ := types.T_int64.ToType()
+	intTyp, err := types.Encode(&typ)
+	require.NoError(t, err)
+	typ = types.T_text.ToType()
+	textTyp, err := types.Encode(&typ)
+	require.NoError(t, err)
+	bh.sql2result[fmt.Sprintf(fetchAnalyzeColumnsFormat, "db", "t", catalog.Row_ID)] = newMrsForAnalyze(
+		[]interface{}{"a", string(intTyp)},
+		[]interface{}{"b", string(textTyp)},
+	)
+	bh.sql2result["select count(*), count(`a`), approx_count_distinct(`a`) from `db`.`t`;"] = newMrsForAnalyze(
+		[]interface{}{int64(100), int64(90), uint64(10)},
+	)
+	bh.sql2result[fmt.Sprintf(fetchMostCommonValuesFormat, "a", "db", "t", "a", "a", mostCommonValues)] = newMrsForAnalyze(
+		[]interface{}{"1", int64(50)},
+		[]interface{}{"2", int64(5)},
+	)
+	bh.sql2result[fmt.Sprintf(fetchHistogramSampleFormat, "a", "db", "t", "a", "")] = newMrsForAnalyze(
+		[]interface{}{"3"}, []interface{}{"1"}, []interface{}{"2"},
+	)
+
+	// the unknown column
+	require.Error(t, analyzeTable(ctx, bh, "db", "t", []string{"c"}))
+
+	bh.sqls = nil
+	require.NoError(t, analyzeTable(ctx, bh, "db", "t", []string{"A"}))
+	var insert string
+	for _, sql := range bh.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_column_stats") {
+			insert = sql
+		}
+	}
+	// only the values more common than the average are kept
+	require.Contains(t, insert, `('db', 't', 'a', 100, 10, 10, '[1,2,3]', '[{"value":"1","freq":0.5}]', `)
+	require.Equal(t, "commit;", bh.sqls[len(bh.sqls)-1])
+}
+
+func Test_analyzeTableEscape(t *testing.T) {
+	ctx := context.Background()
+	bh := &recordedBackgroundExec{}
+	bh.init()
+
+	typ := types.T_int64.ToType()
+	intTyp, err := types.Encode(&typ)
+	require.NoError(t, err)
+	bh.sql2result[fmt.Sprintf(fetchAnalyzeColumnsFormat, `d\'b`, "t`1", catalog.Row_ID)] = newMrsForAnalyze(
+		[]interface{}{"a`b", string(intTyp)},
+	)
+	bh.sql2result["select count(*), count(`a``b`), approx_count_distinct(`a``b`) from `d'b`.`t``1`;"] = newMrsForAnalyze(
+		[]interface{}{int64(10), int64(10), uint64(2)},
+	)
+	bh.sql2result[fmt.Sprintf(fetchMostCommonValuesFormat, "a``b", "d'b", "t``1", "a``b", "a``b", mostCommonValues)] = newMrsForAnalyze(
+		[]interface{}{"1", int64(5)},
+	)
+	bh.sql2result[fmt.Sprintf(fetchHistogramSampleFormat, "a``b", "d'b", "t``1", "a``b", "")] = newMrsForAnalyze(
+		[]interface{}{"1"}, []interface{}{"2"},
+	)
+
+	require.NoError(t, analyzeTable(ctx, bh, "d'b", "t`1", nil))
+	require.Contains(t, bh.sqls, fmt.Sprintf(deleteMoColumnStatsOfColumnFormat, `d\'b`, "t`1", "a`b"))
+	var insert string
+	for _, sql := range bh.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_column_stats") {
+			insert = sql
+		}
+	}
+	require.Contains(t, insert, `('d\'b', 't`+"`"+`1', 'a`+"`"+`b', 10, 2, 0, `)
+}
+
+func Test_needAutoAnalyze(t *testing.T) {
+	s := plan2.NewStatsInfoMap()
+	s.TableCnt = 10000
+	require.False(t, needAutoAnalyze(s))
+
+	s.ColumnStats = map[string]*plan2.ColumnStats{"a": {Rows: 9000}}
+	require.False(t, needAutoAnalyze(s))
+	s.ColumnStats["a"].Rows = 5000
+	require.True(t, needAutoAnalyze(s))
+	// the small tables are not analyzed again for a few rows
+	s.TableCnt = 500
+	s.ColumnStats["a"].Rows = 100
+	require.False(t, needAutoAnalyze(s))
+}
+
+func Test_columnStatsValueFloat(t *testing.T) {
+	f, ok := columnStatsValueFloat("1.5", types.T_float64)
+	require.True(t, ok)
+	require.Equal(t, 1.5, f)
+
+	d, err := types.ParseDateCast("2023-01-02")
+	require.NoError(t, err)
+	f, ok = columnStatsValueFloat("2023-01-02", types.T_date)
+	require.True(t, ok)
+	require.Equal(t, float64(d), f)
+
+	_, ok = columnStatsValueFloat("x", types.T_int32)
+	require.False(t, ok)
+}
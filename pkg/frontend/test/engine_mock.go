@@ -13,6 +13,7 @@ import (
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch"
 	types "github.com/matrixorigin/matrixone/pkg/container/types"
 	vector "github.com/matrixorigin/matrixone/pkg/container/vector"
+	api "github.com/matrixorigin/matrixone/pkg/pb/api"
 	plan "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	client "github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -221,6 +222,20 @@ func (mr *MockRelationMockRecorder) AddTableDef(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTableDef", reflect.TypeOf((*MockRelation)(nil).AddTableDef), arg0, arg1)
 }
 
+// AlterTable mocks base method.
+func (m *MockRelation) AlterTable(arg0 context.Context, arg1 []*api.AlterTableReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlterTable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AlterTable indicates an expected call of AlterTable.
+func (mr *MockRelationMockRecorder) AlterTable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlterTable", reflect.TypeOf((*MockRelation)(nil).AlterTable), arg0, arg1)
+}
+
 // DelTableDef mocks base method.
 func (m *MockRelation) DelTableDef(arg0 context.Context, arg1 engine.TableDef) error {
 	m.ctrl.T.Helper()
@@ -478,6 +493,21 @@ func (mr *MockRelationMockRecorder) GetMetadataScanInfoBytes(ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataScanInfoBytes", reflect.TypeOf((*MockRelation)(nil).GetMetadataScanInfoBytes), ctx)
 }
 
+// GetChanges mocks base method.
+func (m *MockRelation) GetChanges(ctx context.Context, from timestamp.Timestamp) ([]*batch.Batch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", ctx, from)
+	ret0, _ := ret[0].([]*batch.Batch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockRelationMockRecorder) GetChanges(ctx, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockRelation)(nil).GetChanges), ctx, from)
+}
+
 // Write indicates an expected call of Write.
 func (mr *MockRelationMockRecorder) Write(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -434,6 +434,20 @@ func (mr *MockTxnOperatorMockRecorder) Read(ctx, ops interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTxnOperator)(nil).Read), ctx, ops)
 }
 
+// ReleaseSavePoint mocks base method.
+func (m *MockTxnOperator) ReleaseSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavePoint indicates an expected call of ReleaseSavePoint.
+func (mr *MockTxnOperatorMockRecorder) ReleaseSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavePoint", reflect.TypeOf((*MockTxnOperator)(nil).ReleaseSavePoint), ctx, name)
+}
+
 // Rollback mocks base method.
 func (m *MockTxnOperator) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -448,6 +462,34 @@ func (mr *MockTxnOperatorMockRecorder) Rollback(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTxnOperator)(nil).Rollback), ctx)
 }
 
+// RollbackToSavePoint mocks base method.
+func (m *MockTxnOperator) RollbackToSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavePoint indicates an expected call of RollbackToSavePoint.
+func (mr *MockTxnOperatorMockRecorder) RollbackToSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavePoint", reflect.TypeOf((*MockTxnOperator)(nil).RollbackToSavePoint), ctx, name)
+}
+
+// SavePoint mocks base method.
+func (m *MockTxnOperator) SavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePoint indicates an expected call of SavePoint.
+func (mr *MockTxnOperatorMockRecorder) SavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePoint", reflect.TypeOf((*MockTxnOperator)(nil).SavePoint), ctx, name)
+}
+
 // Snapshot mocks base method.
 func (m *MockTxnOperator) Snapshot() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -642,6 +684,20 @@ func (mr *MockDebugableTxnOperatorMockRecorder) Read(ctx, ops interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDebugableTxnOperator)(nil).Read), ctx, ops)
 }
 
+// ReleaseSavePoint mocks base method.
+func (m *MockDebugableTxnOperator) ReleaseSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavePoint indicates an expected call of ReleaseSavePoint.
+func (mr *MockDebugableTxnOperatorMockRecorder) ReleaseSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavePoint", reflect.TypeOf((*MockDebugableTxnOperator)(nil).ReleaseSavePoint), ctx, name)
+}
+
 // Rollback mocks base method.
 func (m *MockDebugableTxnOperator) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -656,6 +712,34 @@ func (mr *MockDebugableTxnOperatorMockRecorder) Rollback(ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockDebugableTxnOperator)(nil).Rollback), ctx)
 }
 
+// RollbackToSavePoint mocks base method.
+func (m *MockDebugableTxnOperator) RollbackToSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavePoint indicates an expected call of RollbackToSavePoint.
+func (mr *MockDebugableTxnOperatorMockRecorder) RollbackToSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavePoint", reflect.TypeOf((*MockDebugableTxnOperator)(nil).RollbackToSavePoint), ctx, name)
+}
+
+// SavePoint mocks base method.
+func (m *MockDebugableTxnOperator) SavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePoint indicates an expected call of SavePoint.
+func (mr *MockDebugableTxnOperatorMockRecorder) SavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePoint", reflect.TypeOf((*MockDebugableTxnOperator)(nil).SavePoint), ctx, name)
+}
+
 // Snapshot mocks base method.
 func (m *MockDebugableTxnOperator) Snapshot() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -850,3 +934,45 @@ func NewMockWorkspace(ctrl *gomock.Controller) *MockWorkspace {
 func (m *MockWorkspace) EXPECT() *MockWorkspaceMockRecorder {
 	return m.recorder
 }
+
+// ReleaseSavePoint mocks base method.
+func (m *MockWorkspace) ReleaseSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavePoint indicates an expected call of ReleaseSavePoint.
+func (mr *MockWorkspaceMockRecorder) ReleaseSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavePoint", reflect.TypeOf((*MockWorkspace)(nil).ReleaseSavePoint), ctx, name)
+}
+
+// RollbackToSavePoint mocks base method.
+func (m *MockWorkspace) RollbackToSavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavePoint indicates an expected call of RollbackToSavePoint.
+func (mr *MockWorkspaceMockRecorder) RollbackToSavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavePoint", reflect.TypeOf((*MockWorkspace)(nil).RollbackToSavePoint), ctx, name)
+}
+
+// SavePoint mocks base method.
+func (m *MockWorkspace) SavePoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePoint indicates an expected call of SavePoint.
+func (mr *MockWorkspaceMockRecorder) SavePoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePoint", reflect.TypeOf((*MockWorkspace)(nil).SavePoint), ctx, name)
+}
@@ -0,0 +1,449 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// maxTriggerDepth limits the nesting of the triggers fired by the statements in
+// the bodies of triggers.
+const maxTriggerDepth = 16
+
+// triggersReloadInterval is how long the triggers of a table are cached. The
+// triggers created or dropped by the CN are seen at once, the ones created or
+// dropped by the other CNs are seen after it.
+const triggersReloadInterval = 10 * time.Second
+
+// triggersCache are the triggers loaded from mo_triggers by the sessions of the
+// CN, the key is columnStatsKey of the table.
+var triggersCache sync.Map
+
+// triggersEntry is the triggers of a table in triggersCache.
+type triggersEntry struct {
+	tableID  uint64
+	triggers []*plan.TriggerDef
+	loadTime time.Time
+}
+
+// triggerBodies are the parsed bodies of the triggers, the key is
+// columnStatsKey of the trigger. The body is parsed again if the trigger has
+// been created again with another body.
+var triggerBodies sync.Map
+
+type triggerBody struct {
+	body  string
+	stmts []tree.Statement
+}
+
+const (
+	insertMoTriggerFormat = `insert into mo_catalog.mo_triggers(name, db, table_name, timing, event, body, definer, created_time) values ('%s', '%s', '%s', '%s', '%s', '%s', '%s', '%s');`
+
+	checkTriggerExistenceFormat = `select trigger_id from mo_catalog.mo_triggers where db = '%s' and name = '%s';`
+
+	fetchTriggerFormat = `select trigger_id, table_name from mo_catalog.mo_triggers where db = '%s' and name = '%s';`
+
+	checkTableExistenceFormat = `select rel_id from mo_catalog.mo_tables where reldatabase = '%s' and relname = '%s';`
+
+	deleteMoTriggerFormat = `delete from mo_catalog.mo_triggers where trigger_id = %d;`
+
+	deleteMoTriggersOfTableFormat = `delete from mo_catalog.mo_triggers where db = '%s' and table_name = '%s';`
+
+	deleteMoTriggersOfDatabaseFormat = `delete from mo_catalog.mo_triggers where db = '%s';`
+
+	fetchTriggersOfTableFormat = `select name, timing, event, body from mo_catalog.mo_triggers where db = '%s' and table_name = '%s' order by trigger_id;`
+)
+
+// getTriggerDatabase returns the database of the trigger which is always the
+// database of its table.
+func getTriggerDatabase(ses *Session, name, table *tree.TableName) (string, error) {
+	dbName := string(table.SchemaName)
+	if dbName == "" {
+		dbName = string(name.SchemaName)
+	}
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	if name.SchemaName != "" && string(name.SchemaName) != dbName {
+		return "", moerr.NewInvalidInputNoCtx("trigger %s is not in the database of table %s", tree.String(name, dialect.MYSQL), tree.String(table, dialect.MYSQL))
+	}
+	return dbName, nil
+}
+
+func doCreateTrigger(ctx context.Context, ses *Session, ct *tree.CreateTrigger) error {
+	var err error
+	var sql string
+	var dbName string
+	var definer string
+	var erArray []ExecResult
+
+	dbName, err = getTriggerDatabase(ses, ct.Name, ct.Table)
+	if err != nil {
+		return err
+	}
+	if isBannedDatabase(dbName) {
+		return moerr.NewTriggerOnSystemTableNoCtx()
+	}
+
+	// the body is checked when the trigger is created rather than fired
+	if _, err = parsers.Parse(ctx, dialect.MYSQL, ct.Body, 1); err != nil {
+		return err
+	}
+
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		definer = tenant.GetUser()
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkTableExistenceFormat,
+		sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(string(ct.Table.ObjectName))))
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewNoSuchTable(ctx, dbName, string(ct.Table.ObjectName))
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkTriggerExistenceFormat,
+		sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(string(ct.Name.ObjectName))))
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if execResultArrayHasData(erArray) {
+		if ct.IfNotExists {
+			return nil
+		}
+		return moerr.NewTriggerAlreadyExistsNoCtx(string(ct.Name.ObjectName))
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	sql = fmt.Sprintf(insertMoTriggerFormat,
+		sqlStringEscaper.Replace(string(ct.Name.ObjectName)), sqlStringEscaper.Replace(dbName),
+		sqlStringEscaper.Replace(string(ct.Table.ObjectName)), ct.Time.String(), ct.Event.String(),
+		sqlStringEscaper.Replace(ct.Body), sqlStringEscaper.Replace(definer), types.CurrentTimestamp().String2(time.UTC, 0))
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	forgetTriggers(ses, dbName, string(ct.Table.ObjectName))
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func doDropTrigger(ctx context.Context, ses *Session, dt *tree.DropTrigger) error {
+	var err error
+	var dbName string
+	var triggerId int64
+	var tableName string
+	var erArray []ExecResult
+
+	dbName = string(dt.Name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(fetchTriggerFormat,
+		sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(string(dt.Name.ObjectName))))
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		if dt.IfExists {
+			return nil
+		}
+		return moerr.NewDropNonExistsTriggerNoCtx(string(dt.Name.ObjectName))
+	}
+	triggerId, err = erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	tableName, err = erArray[0].GetString(ctx, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, fmt.Sprintf(deleteMoTriggerFormat, triggerId))
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	forgetTriggers(ses, dbName, tableName)
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// forgetTriggers removes the cached triggers of the table after they are
+// changed by the session.
+func forgetTriggers(ses *Session, dbName, tableName string) {
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		triggersCache.Delete(columnStatsKey(tenant.GetTenantID(), dbName, tableName))
+	}
+}
+
+// deleteTriggersOfDroppedObjects removes the triggers of the tables dropped by
+// DROP TABLE or DROP DATABASE.
+func deleteTriggersOfDroppedObjects(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	return deleteRecordsOfDroppedObjects(ctx, ses, stmt, deleteMoTriggersOfTableFormat, deleteMoTriggersOfDatabaseFormat)
+}
+
+// ResolveTriggers returns the triggers of the table in the order of creation.
+// The triggers are shared by the sessions of the CN in triggersCache, so
+// mo_triggers is queried by the planner at most once in triggersReloadInterval
+// for a table.
+func (tcc *TxnCompilerContext) ResolveTriggers(tableID uint64, dbName string, tableName string) ([]*plan.TriggerDef, error) {
+	if isBannedDatabase(dbName) {
+		return nil, nil
+	}
+	ses := tcc.GetSession()
+	var key string
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		key = columnStatsKey(tenant.GetTenantID(), dbName, tableName)
+		if v, ok := triggersCache.Load(key); ok {
+			entry := v.(*triggersEntry)
+			// the table may be dropped and created again with the same name
+			if entry.tableID == tableID && time.Since(entry.loadTime) < triggersReloadInterval {
+				return entry.triggers, nil
+			}
+		}
+	}
+
+	triggers, err := fetchTriggers(ses.GetRequestContext(), ses, dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		triggersCache.Store(key, &triggersEntry{
+			tableID:  tableID,
+			triggers: triggers,
+			loadTime: time.Now(),
+		})
+	}
+	return triggers, nil
+}
+
+// fetchTriggers queries the triggers of the table in mo_triggers.
+func fetchTriggers(ctx context.Context, ses *Session, dbName string, tableName string) ([]*plan.TriggerDef, error) {
+	erArray, err := executeSQLInBackgroundSession(ctx, ses, ses.GetMemPool(), ses.GetParameterUnit(),
+		fmt.Sprintf(fetchTriggersOfTableFormat, sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(tableName)))
+	if err != nil {
+		// the accounts created before the triggers are supported have no mo_triggers
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+
+	triggers := make([]*plan.TriggerDef, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var values [4]string
+		for j := range values {
+			values[j], err = erArray[0].GetString(ctx, i, uint64(j))
+			if err != nil {
+				return nil, err
+			}
+		}
+		triggers = append(triggers, &plan.TriggerDef{
+			Name:   values[0],
+			DbName: dbName,
+			Before: values[1] == tree.TriggerBefore.String(),
+			Event:  values[2],
+			Body:   values[3],
+		})
+	}
+	return triggers, nil
+}
+
+// parseTriggerBody returns the statements of the body of the trigger, the body
+// is parsed once for the sessions of the CN.
+func parseTriggerBody(ctx context.Context, ses *Session, trigger *plan.TriggerDef) ([]tree.Statement, error) {
+	var key string
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		key = columnStatsKey(tenant.GetTenantID(), trigger.DbName, trigger.Name)
+		if v, ok := triggerBodies.Load(key); ok && v.(*triggerBody).body == trigger.Body {
+			return v.(*triggerBody).stmts, nil
+		}
+	}
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, trigger.Body, 1)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		triggerBodies.Store(key, &triggerBody{body: trigger.Body, stmts: stmts})
+	}
+	return stmts, nil
+}
+
+// ExecTrigger runs the body of the trigger for a row in the txn of the session.
+// The body runs in a background session sharing the txn, so the changes made by
+// the body are committed or rolled back with the statement firing the trigger.
+// The background sessions are reused by the rows of the request.
+func (sh *SqlHelper) ExecTrigger(trigger *plan.TriggerDef, vars map[string]interface{}) error {
+	ses := sh.ses
+	ctx := ses.GetRequestContext()
+	if ses.triggerDepth >= maxTriggerDepth {
+		return moerr.NewNotSupported(ctx, "triggers nested more than %d levels", maxTriggerDepth)
+	}
+
+	stmts, err := parseTriggerBody(ctx, ses, trigger)
+	if err != nil {
+		return err
+	}
+
+	bh := sh.getTriggerHandler(ctx)
+	defer sh.putTriggerHandler(ctx, bh)
+	bh.ses.SetDatabaseName(trigger.DbName)
+	bh.ses.GetTxnHandler().ShareTxn(ses.GetTxnHandler())
+	bh.ses.triggerDepth = ses.triggerDepth + 1
+	// the user variables set by the body are visible to the session
+	bh.ses.userDefinedVars = ses.userDefinedVars
+
+	varScope := []map[string]interface{}{vars}
+	interpreter := Interpreter{
+		ctx:         ctx,
+		ses:         ses,
+		bh:          bh,
+		varScope:    &varScope,
+		fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+		argsAttr:    make(map[string]tree.InOutArgType),
+		argsMap:     make(map[string]tree.Expr),
+		outParamMap: make(map[string]interface{}),
+		trigger:     trigger,
+	}
+	for _, stmt := range stmts {
+		if _, err = interpreter.interpret(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTriggerHandler returns an idle background handler of the request ctx, or
+// a new one. The idle handlers of the former requests are closed.
+func (sh *SqlHelper) getTriggerHandler(ctx context.Context) *BackgroundHandler {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.triggerCtx != ctx {
+		sh.closeTriggerHandlersLocked()
+		sh.triggerCtx = ctx
+	}
+	if n := len(sh.triggerHandlers); n > 0 {
+		bh := sh.triggerHandlers[n-1]
+		sh.triggerHandlers = sh.triggerHandlers[:n-1]
+		return bh
+	}
+	ses := sh.ses
+	return &BackgroundHandler{
+		mce: NewMysqlCmdExecutor(),
+		ses: NewBackgroundSession(ctx, ses, ses.GetMemPool(), ses.GetParameterUnit(), GSysVariables),
+	}
+}
+
+func (sh *SqlHelper) putTriggerHandler(ctx context.Context, bh *BackgroundHandler) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.triggerCtx != ctx {
+		bh.Close()
+		return
+	}
+	bh.ClearExecResultSet()
+	sh.triggerHandlers = append(sh.triggerHandlers, bh)
+}
+
+// closeTriggerHandlers closes the idle background handlers, it is called when
+// the session is closed.
+func (sh *SqlHelper) closeTriggerHandlers() {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.closeTriggerHandlersLocked()
+	sh.triggerCtx = nil
+}
+
+func (sh *SqlHelper) closeTriggerHandlersLocked() {
+	for _, bh := range sh.triggerHandlers {
+		bh.Close()
+	}
+	sh.triggerHandlers = nil
+}
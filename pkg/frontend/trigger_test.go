@@ -0,0 +1,94 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTriggerBody(t *testing.T) {
+	ctx := context.Background()
+	ses := &Session{tenant: &TenantInfo{TenantID: 1}}
+	trigger := &plan.TriggerDef{Name: "tr_parse", DbName: "db", Body: "set new.a = 1"}
+	stmts, err := parseTriggerBody(ctx, ses, trigger)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stmts))
+	defer triggerBodies.Delete(columnStatsKey(1, "db", "tr_parse"))
+
+	// the body is parsed once
+	again, err := parseTriggerBody(ctx, ses, trigger)
+	require.NoError(t, err)
+	require.Same(t, stmts[0], again[0])
+
+	// the trigger created again with another body
+	trigger = &plan.TriggerDef{Name: "tr_parse", DbName: "db", Body: "begin set new.a = 2; set new.b = 2; end"}
+	again, err = parseTriggerBody(ctx, ses, trigger)
+	require.NoError(t, err)
+	require.NotSame(t, stmts[0], again[0])
+
+	_, err = parseTriggerBody(ctx, ses, &plan.TriggerDef{Name: "tr_bad", DbName: "db", Body: "set"})
+	require.Error(t, err)
+}
+
+func Test_ResolveTriggersCache(t *testing.T) {
+	ses := &Session{tenant: &TenantInfo{TenantID: 1}}
+	tcc := &TxnCompilerContext{ses: ses}
+	key := columnStatsKey(1, "db", "t_cached")
+	triggers := []*plan.TriggerDef{{Name: "tr", DbName: "db", Event: "INSERT"}}
+	triggersCache.Store(key, &triggersEntry{tableID: 10, triggers: triggers, loadTime: time.Now()})
+	defer triggersCache.Delete(key)
+
+	// mo_triggers is not queried for the cached table
+	resolved, err := tcc.ResolveTriggers(10, "db", "t_cached")
+	require.NoError(t, err)
+	require.Equal(t, triggers, resolved)
+
+	forgetTriggers(ses, "db", "t_cached")
+	_, ok := triggersCache.Load(key)
+	require.False(t, ok)
+}
+
+func Test_fetchTriggersEscapesNames(t *testing.T) {
+	ses := &Session{tenant: &TenantInfo{TenantID: 1}}
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	sql := "select name, timing, event, body from mo_catalog.mo_triggers where db = 'd\\'b' and table_name = 'a\\'b' order by trigger_id;"
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"name", "timing", "event", "body"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"tr", "before", "insert", "set new.a = 1"})
+	bh.sql2result[sql] = mrs
+
+	triggers, err := fetchTriggers(context.Background(), ses, "d'b", "a'b")
+	require.NoError(t, err)
+	require.Equal(t, sql, bh.currentSql)
+	require.Equal(t, 1, len(triggers))
+	require.Equal(t, "d'b", triggers[0].DbName)
+	require.True(t, triggers[0].Before)
+}
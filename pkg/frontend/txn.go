@@ -16,16 +16,19 @@ package frontend
 
 import (
 	"context"
+	"strings"
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage"
 	"github.com/matrixorigin/matrixone/pkg/util/metric"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-	"sync"
 )
 
 type TxnHandler struct {
@@ -44,6 +47,13 @@ type TxnHandler struct {
 	txnCtxCancel context.CancelFunc
 	mu           sync.Mutex
 	entryMu      sync.Mutex
+	// hasSavePoint denotes a savepoint has been set in the transaction.
+	// the statements of the transaction are rolled back alone when they fail.
+	hasSavePoint bool
+	// shared denotes the txn is borrowed from another session, like the
+	// session running the body of a trigger. The txn is committed or rolled
+	// back by its owner only.
+	shared bool
 }
 
 func InitTxnHandler(storage engine.Engine, txnClient TxnClient) *TxnHandler {
@@ -118,6 +128,18 @@ func (th *TxnHandler) NewTxnOperator() (context.Context, TxnOperator, error) {
 		}
 	}
 
+	// the snapshot pinned by the session makes all the transactions
+	// read the same data until the session variable is cleared.
+	snapshotTS, pinned, err := th.ses.getPinnedSnapshotTS()
+	if err != nil {
+		return nil, nil, err
+	}
+	if pinned {
+		opts = append(opts[:len(opts):len(opts)],
+			client.WithSnapshotTS(snapshotTS),
+			client.WithTxnIsolation(txn.TxnIsolation_SI))
+	}
+
 	txnCtx := th.createTxnCtx()
 	if txnCtx == nil {
 		panic("context should not be nil")
@@ -185,6 +207,7 @@ func (th *TxnHandler) SetTxnOperatorInvalid() {
 	th.mu.Lock()
 	defer th.mu.Unlock()
 	th.txnOperator = nil
+	th.hasSavePoint = false
 	if th.txnCtxCancel != nil {
 		//fmt.Printf("**> %v\n", th.txnCtx)
 		th.txnCtxCancel()
@@ -193,6 +216,37 @@ func (th *TxnHandler) SetTxnOperatorInvalid() {
 	th.txnCtx = nil
 }
 
+func (th *TxnHandler) SetHasSavePoint() {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.hasSavePoint = true
+}
+
+func (th *TxnHandler) HasSavePoint() bool {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	return th.hasSavePoint
+}
+
+// ShareTxn makes the handler run in the active txn of another handler.
+func (th *TxnHandler) ShareTxn(from *TxnHandler) {
+	from.mu.Lock()
+	txnCtx, txnOp := from.txnCtx, from.txnOperator
+	from.mu.Unlock()
+
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.txnCtx = txnCtx
+	th.txnOperator = txnOp
+	th.shared = true
+}
+
+func (th *TxnHandler) isShared() bool {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	return th.shared
+}
+
 func (th *TxnHandler) GetTxnOperator() (context.Context, TxnOperator) {
 	th.mu.Lock()
 	defer th.mu.Unlock()
@@ -214,7 +268,7 @@ func (th *TxnHandler) GetSession() *Session {
 func (th *TxnHandler) CommitTxn() error {
 	th.entryMu.Lock()
 	defer th.entryMu.Unlock()
-	if !th.IsValidTxnOperator() {
+	if !th.IsValidTxnOperator() || th.isShared() {
 		return nil
 	}
 	ses := th.GetSession()
@@ -284,7 +338,7 @@ func (th *TxnHandler) CommitTxn() error {
 func (th *TxnHandler) RollbackTxn() error {
 	th.entryMu.Lock()
 	defer th.entryMu.Unlock()
-	if !th.IsValidTxnOperator() {
+	if !th.IsValidTxnOperator() || th.isShared() {
 		return nil
 	}
 	ses := th.GetSession()
@@ -527,6 +581,112 @@ func (ses *Session) TxnRollback() error {
 	return err
 }
 
+// stmtSavePoint is the name of the implicit savepoint set before every statement
+// of a transaction having savepoints. It can not be a name of the user savepoint.
+const stmtSavePoint = "\x00stmt"
+
+/*
+TxnSavePoint sets a savepoint of the current transaction.
+Like MySQL, it does nothing when it is not in multi-statement transaction mode.
+*/
+func (ses *Session) TxnSavePoint(name string) error {
+	if !ses.InMultiStmtTransactionMode() {
+		return nil
+	}
+	txnCtx, txnOp, err := ses.TxnCreate()
+	if err != nil {
+		return err
+	}
+	if err = txnOp.SavePoint(txnCtx, strings.ToLower(name)); err != nil {
+		return err
+	}
+	ses.GetTxnHandler().SetHasSavePoint()
+	return nil
+}
+
+// TxnRollbackToSavePoint rollbacks the current transaction to the savepoint.
+func (ses *Session) TxnRollbackToSavePoint(name string) error {
+	txnCtx, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if !ses.InActiveTransaction() || txnOp == nil {
+		return moerr.NewSavepointNotExist(ses.GetRequestContext(), name)
+	}
+	return txnOp.RollbackToSavePoint(txnCtx, strings.ToLower(name))
+}
+
+// TxnReleaseSavePoint removes the savepoint of the current transaction.
+func (ses *Session) TxnReleaseSavePoint(name string) error {
+	txnCtx, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if !ses.InActiveTransaction() || txnOp == nil {
+		return moerr.NewSavepointNotExist(ses.GetRequestContext(), name)
+	}
+	return txnOp.ReleaseSavePoint(txnCtx, strings.ToLower(name))
+}
+
+/*
+TxnStatementSavePoint sets the implicit savepoint before the statement, if
+the current transaction has savepoints. So that the statement can be rolled
+back alone when it fails and the savepoints are still available.
+*/
+func (ses *Session) TxnStatementSavePoint(stmt tree.Statement) error {
+	if !ses.InMultiStmtTransactionMode() ||
+		!ses.GetTxnHandler().HasSavePoint() ||
+		stmt.GetQueryType() == tree.QueryTypeTCL {
+		return nil
+	}
+	txnCtx, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil {
+		return nil
+	}
+	return txnOp.SavePoint(txnCtx, stmtSavePoint)
+}
+
+// TxnReleaseStatementSavePoint removes the implicit savepoint after the statement succeeds.
+func (ses *Session) TxnReleaseStatementSavePoint(stmt tree.Statement) error {
+	if !ses.InMultiStmtTransactionMode() ||
+		!ses.GetTxnHandler().HasSavePoint() ||
+		stmt.GetQueryType() == tree.QueryTypeTCL {
+		return nil
+	}
+	txnCtx, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil {
+		return nil
+	}
+	err := txnOp.ReleaseSavePoint(txnCtx, stmtSavePoint)
+	if moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist) {
+		return nil
+	}
+	return err
+}
+
+/*
+TxnRollbackStatement rollbacks the failed statement alone instead of the whole
+transaction, it returns false if the transaction needs to be rolled back.
+
+The failed savepoint statements do not change the transaction. The other
+statements are rolled back to the implicit savepoint set by TxnStatementSavePoint.
+*/
+func (ses *Session) TxnRollbackStatement(stmt tree.Statement) bool {
+	if !ses.InMultiStmtTransactionMode() {
+		return false
+	}
+	txnCtx, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil {
+		return false
+	}
+	switch stmt.(type) {
+	case *tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint:
+		return true
+	}
+	if !ses.GetTxnHandler().HasSavePoint() ||
+		stmt.GetQueryType() == tree.QueryTypeTCL {
+		return false
+	}
+	if err := txnOp.RollbackToSavePoint(txnCtx, stmtSavePoint); err != nil {
+		return false
+	}
+	return txnOp.ReleaseSavePoint(txnCtx, stmtSavePoint) == nil
+}
+
 /*
 TxnCommitSingleStatement commits the single statement transaction.
 
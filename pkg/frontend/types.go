@@ -95,6 +95,17 @@ type PrepareStmt struct {
 	InsertBat      *batch.Batch
 	emptyBatch     *batch.Batch                                        // use for expr eval
 	ufs            []func(*vector.Vector, *vector.Vector, int64) error // function pointers for type conversion
+
+	// longData keeps the parameters sent by COM_STMT_SEND_LONG_DATA, they are
+	// used by the next COM_STMT_EXECUTE.
+	longData map[uint16][]byte
+	// useCursor is set when COM_STMT_EXECUTE asks for a read only cursor.
+	useCursor bool
+	// cursor is opened by the last COM_STMT_EXECUTE with a cursor.
+	cursor *stmtCursor
+	// cancelCursor cancels the context of the COM_STMT_EXECUTE with a cursor,
+	// it is owned by the cursor once the cursor is opened.
+	cancelCursor context.CancelFunc
 }
 
 /*
@@ -191,4 +202,19 @@ func (prepareStmt *PrepareStmt) Close() {
 		prepareStmt.InsertBat.Clean(prepareStmt.mp)
 		prepareStmt.InsertBat = nil
 	}
+	prepareStmt.Reset()
+}
+
+// Reset clears the long data of the parameters and closes the cursor.
+func (prepareStmt *PrepareStmt) Reset() {
+	prepareStmt.longData = nil
+	prepareStmt.cursor = nil
+}
+
+// appendLongData appends the data of the parameter sent by COM_STMT_SEND_LONG_DATA.
+func (prepareStmt *PrepareStmt) appendLongData(paramID uint16, data []byte) {
+	if prepareStmt.longData == nil {
+		prepareStmt.longData = make(map[uint16][]byte)
+	}
+	prepareStmt.longData[paramID] = append(prepareStmt.longData[paramID], data...)
 }
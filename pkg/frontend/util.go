@@ -320,11 +320,11 @@ func getValueFromVector(vec *vector.Vector, ses *Session, expr *plan2.Expr) (int
 		return vector.MustFixedCol[int64](vec)[0], nil
 	case types.T_uint8:
 		return vector.MustFixedCol[uint8](vec)[0], nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_year:
 		return vector.MustFixedCol[uint16](vec)[0], nil
 	case types.T_uint32:
 		return vector.MustFixedCol[uint32](vec)[0], nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return vector.MustFixedCol[uint64](vec)[0], nil
 	case types.T_float32:
 		return vector.MustFixedCol[float32](vec)[0], nil
@@ -332,6 +332,8 @@ func getValueFromVector(vec *vector.Vector, ses *Session, expr *plan2.Expr) (int
 		return vector.MustFixedCol[float64](vec)[0], nil
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_text, types.T_blob:
 		return vec.GetStringAt(0), nil
+	case types.T_array_float32:
+		return types.ArrayF32ToString(types.BytesToArrayF32(vec.GetBytesAt(0))), nil
 	case types.T_decimal64:
 		val := vector.GetFixedAt[types.Decimal64](vec, 0)
 		return plan2.MakePlan2Decimal64ExprWithType(val, plan2.DeepCopyType(expr.Typ)), nil
@@ -601,3 +603,64 @@ func getVariableValue(varDefault interface{}) string {
 		return ""
 	}
 }
+
+var (
+	// sqlStringEscaper escapes the value quoted by ' in the sql
+	sqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	// sqlIdentEscaper escapes the name quoted by ` in the sql
+	sqlIdentEscaper = strings.NewReplacer("`", "``")
+)
+
+// deleteRecordsOfDroppedObjects removes the records in mo_catalog of the
+// tables or views dropped by DROP TABLE, DROP VIEW or DROP DATABASE.
+// objectFormat deletes the records of a table or view by the database name
+// and its name, databaseFormat deletes the records of a database by its name.
+func deleteRecordsOfDroppedObjects(ctx context.Context, ses *Session, stmt tree.Statement, objectFormat, databaseFormat string) error {
+	var names tree.TableNames
+	var sqls []string
+	switch st := stmt.(type) {
+	case *tree.DropTable:
+		names = st.Names
+	case *tree.DropView:
+		names = st.Names
+	case *tree.DropDatabase:
+		sqls = append(sqls, fmt.Sprintf(databaseFormat, sqlStringEscaper.Replace(string(st.Name))))
+	default:
+		return nil
+	}
+	for _, name := range names {
+		dbName := string(name.SchemaName)
+		if dbName == "" {
+			dbName = ses.GetDatabaseName()
+		}
+		sqls = append(sqls, fmt.Sprintf(objectFormat,
+			sqlStringEscaper.Replace(dbName), sqlStringEscaper.Replace(string(name.ObjectName))))
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err := bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	for _, sql := range sqls {
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
@@ -1299,6 +1299,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("sql_select_limit", 0, 18446744073709551615),
 		Default:           uint64(18446744073709551615),
 	},
+	"cte_max_recursion_depth": {
+		Name:              "cte_max_recursion_depth",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("cte_max_recursion_depth", 0, 4294967295),
+		Default:           uint64(1000),
+	},
+	"ivfflat_probes": {
+		Name:              "ivfflat_probes",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("ivfflat_probes", 1, 65536),
+		Default:           uint64(1),
+	},
 	"save_query_result": {
 		Name:              "save_query_result",
 		Scope:             ScopeBoth,
@@ -1340,6 +1356,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("cn_label"),
 		Default:           "",
 	},
+	"snapshot_ts": {
+		Name:              "snapshot_ts",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("snapshot_ts"),
+		Default:           "",
+	},
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	"syspublications": {
 		Name:              "syspublications",
 		Scope:             ScopeBoth,
@@ -0,0 +1,143 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+)
+
+// The parameters of BM25, the relevance of a document is the sum over the
+// words matched of
+//
+//	idf * tf * (K1 + 1) / (tf + K1 * (1 - B + B * len / avglen))
+//
+// where idf is ln(1 + (N - df + 0.5) / (df + 0.5)).
+const (
+	BM25K1 = 1.2
+	BM25B  = 0.75
+)
+
+type SearchMode int
+
+const (
+	NaturalLanguageMode SearchMode = iota
+	BooleanMode
+)
+
+type Op int
+
+const (
+	// OpOptional clauses contribute to the relevance only.
+	OpOptional Op = iota
+	// OpRequired clauses must be present in every document returned.
+	OpRequired
+	// OpExcluded clauses must not be present in any document returned.
+	OpExcluded
+)
+
+// Term is a word of the query, a prefix term matches all the words starting
+// with Word.
+type Term struct {
+	Word   string
+	Prefix bool
+}
+
+// Clause is present in a document if all its terms are.
+type Clause struct {
+	Op    Op
+	Terms []Term
+}
+
+// ParseQuery parses the search string of AGAINST. In natural language mode
+// every word is an optional clause. In boolean mode the words or the quoted
+// phrases can be prefixed by + or -, and a word ending with * is a prefix
+// term. The phrase is taken as all of its words, the positions of the words
+// are not checked.
+func ParseQuery(t *Tokenizer, query string, mode SearchMode) []Clause {
+	if mode == NaturalLanguageMode {
+		var clauses []Clause
+		seen := make(map[string]struct{})
+		for _, tok := range t.Tokenize(query) {
+			if _, ok := seen[tok]; ok {
+				continue
+			}
+			seen[tok] = struct{}{}
+			clauses = append(clauses, Clause{Terms: []Term{{Word: tok}}})
+		}
+		return clauses
+	}
+
+	var clauses []Clause
+	rs := []rune(query)
+	for i := 0; i < len(rs); {
+		if unicode.IsSpace(rs[i]) || rs[i] == '(' || rs[i] == ')' {
+			i++
+			continue
+		}
+		op := OpOptional
+		switch rs[i] {
+		case '+':
+			op = OpRequired
+			i++
+		case '-':
+			op = OpExcluded
+			i++
+		case '~', '<', '>':
+			// the weight operators are not supported, take it as optional.
+			i++
+		}
+		if i >= len(rs) {
+			break
+		}
+
+		var terms []Term
+		if rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+			for _, tok := range t.Tokenize(string(rs[i+1 : end])) {
+				terms = append(terms, Term{Word: tok})
+			}
+			i = end + 1
+		} else {
+			end := i
+			for end < len(rs) && !unicode.IsSpace(rs[end]) && !strings.ContainsRune("\"()+-~<>", rs[end]) {
+				end++
+			}
+			if end == i {
+				i++
+				continue
+			}
+			word := string(rs[i:end])
+			i = end
+			if strings.HasSuffix(word, "*") {
+				// the prefix is not checked by the length of the words.
+				for _, w := range splitWords(strings.TrimRight(word, "*")) {
+					terms = append(terms, Term{Word: string(w), Prefix: true})
+				}
+			} else {
+				for _, tok := range t.Tokenize(word) {
+					terms = append(terms, Term{Word: tok})
+				}
+			}
+		}
+		if len(terms) > 0 {
+			clauses = append(clauses, Clause{Op: op, Terms: terms})
+		}
+	}
+	return clauses
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// ParserNgram is the parser for the text without word delimiters, e.g.
+	// Chinese, Japanese and Korean. It splits the words into n-grams.
+	ParserNgram = "ngram"
+
+	// DefaultNgramSize is the n of the n-grams, same as ngram_token_size of mysql.
+	DefaultNgramSize = 2
+	// MinTokenLen and MaxTokenLen are the bounds of the word length in runes
+	// of the default parser, same as innodb_ft_min_token_size and
+	// innodb_ft_max_token_size of mysql.
+	MinTokenLen = 3
+	MaxTokenLen = 84
+)
+
+// Tokenizer splits the text into the words kept in the fulltext index.
+type Tokenizer struct {
+	ngram int
+}
+
+// NewTokenizer returns the tokenizer of the parser given by WITH PARSER, the
+// empty parser is the default one which splits the text by the non-word
+// characters.
+func NewTokenizer(parser string) (*Tokenizer, error) {
+	switch strings.ToLower(parser) {
+	case "":
+		return &Tokenizer{}, nil
+	case ParserNgram:
+		return &Tokenizer{ngram: DefaultNgramSize}, nil
+	}
+	return nil, moerr.NewNotSupportedNoCtx("fulltext parser '%s'", parser)
+}
+
+// Tokenize returns the words of text in lower case, in the order they appear.
+func (t *Tokenizer) Tokenize(text string) []string {
+	var tokens []string
+	for _, w := range splitWords(text) {
+		tokens = t.appendTokens(tokens, w)
+	}
+	return tokens
+}
+
+// Count returns the number of occurrences of each word in text, and the
+// number of words in text which is the length of the document for BM25.
+func (t *Tokenizer) Count(text string) (map[string]int64, int64) {
+	tokens := t.Tokenize(text)
+	counts := make(map[string]int64, len(tokens))
+	for _, tok := range tokens {
+		counts[tok]++
+	}
+	return counts, int64(len(tokens))
+}
+
+func (t *Tokenizer) appendTokens(tokens []string, word []rune) []string {
+	if t.ngram == 0 {
+		if len(word) < MinTokenLen || len(word) > MaxTokenLen {
+			return tokens
+		}
+		return append(tokens, string(word))
+	}
+	for i := 0; i+t.ngram <= len(word); i++ {
+		tokens = append(tokens, string(word[i:i+t.ngram]))
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// splitWords returns the lower-cased runs of word characters in text.
+func splitWords(text string) [][]rune {
+	var words [][]rune
+	var word []rune
+	for _, r := range text {
+		if isWordRune(r) {
+			word = append(word, unicode.ToLower(r))
+			continue
+		}
+		if len(word) > 0 {
+			words = append(words, word)
+			word = nil
+		}
+	}
+	if len(word) > 0 {
+		words = append(words, word)
+	}
+	return words
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tok, err := NewTokenizer("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"the", "quick", "brown_fox", "jumps", "over", "the", "dog"},
+		tok.Tokenize("The quick, brown_fox jumps over the a dog."))
+
+	counts, n := tok.Count("MySQL mysql database")
+	require.Equal(t, int64(3), n)
+	require.Equal(t, map[string]int64{"mysql": 2, "database": 1}, counts)
+
+	ngram, err := NewTokenizer("NGRAM")
+	require.NoError(t, err)
+	require.Equal(t, []string{"数据", "据库", "ab", "bc"}, ngram.Tokenize("数据库，abc x"))
+
+	_, err = NewTokenizer("mecab")
+	require.Error(t, err)
+}
+
+func TestParseQuery(t *testing.T) {
+	tok, err := NewTokenizer("")
+	require.NoError(t, err)
+	require.Equal(t, []Clause{
+		{Terms: []Term{{Word: "database"}}},
+		{Terms: []Term{{Word: "tutorial"}}},
+	}, ParseQuery(tok, "database tutorial DATABASE", NaturalLanguageMode))
+
+	require.Equal(t, []Clause{
+		{Op: OpRequired, Terms: []Term{{Word: "mysql"}}},
+		{Op: OpExcluded, Terms: []Term{{Word: "oracle"}}},
+		{Terms: []Term{{Word: "dat", Prefix: true}}},
+		{Op: OpRequired, Terms: []Term{{Word: "full"}, {Word: "text"}}},
+	}, ParseQuery(tok, `+MySQL -oracle dat* ++ +"full text"`, BooleanMode))
+
+	ngram, err := NewTokenizer(ParserNgram)
+	require.NoError(t, err)
+	require.Equal(t, []Clause{
+		{Op: OpRequired, Terms: []Term{{Word: "数据"}, {Word: "据库"}}},
+	}, ParseQuery(ngram, "+数据库", BooleanMode))
+}
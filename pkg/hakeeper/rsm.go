@@ -608,6 +608,7 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 			LogtailServerAddress: info.LogtailServerAddress,
 			LockServiceAddress:   info.LockServiceAddress,
 			CtlAddress:           info.CtlAddress,
+			GCRetention:          info.GCRetention,
 		}
 		cd.DNStores = append(cd.DNStores, n)
 	}
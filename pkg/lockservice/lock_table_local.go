@@ -79,7 +79,8 @@ func (l *localLockTable) lock(
 			w,
 			offset,
 			rows,
-			opts)
+			opts,
+			&result)
 		if err != nil {
 			logLocalLockFailed(l.bind.ServiceID, txn, table, rows, opts, err)
 			return result, err
@@ -182,7 +183,8 @@ func (l *localLockTable) doAcquireLock(
 	w *waiter,
 	offset int,
 	rows [][]byte,
-	opts LockOptions) (int, *waiter, timestamp.Timestamp, error) {
+	opts LockOptions,
+	result *pb.Result) (int, *waiter, timestamp.Timestamp, error) {
 	// The txn lock here to avoid dead lock between doAcquireLock and getLock.
 	// The doAcquireLock and getLock operations of the same transaction will be
 	// concurrent (deadlock detection), which may lead to a deadlock in mutex.
@@ -200,15 +202,13 @@ func (l *localLockTable) doAcquireLock(
 
 	switch opts.Granularity {
 	case pb.Granularity_Row:
-		idx, w, lockedTS := l.acquireRowLockLocked(txn, w, offset, rows, opts.Mode)
-		return idx, w, lockedTS, nil
+		return l.acquireRowLockLocked(txn, w, offset, rows, opts, result)
 	case pb.Granularity_Range:
 		if len(rows) == 0 ||
 			len(rows)%2 != 0 {
 			panic("invalid range lock")
 		}
-		idx, w, lockedTS := l.acquireRangeLockLocked(txn, w, offset, rows, opts.Mode)
-		return idx, w, lockedTS, nil
+		return l.acquireRangeLockLocked(txn, w, offset, rows, opts)
 	default:
 		panic(fmt.Sprintf("not support lock granularity %d", opts))
 	}
@@ -219,11 +219,12 @@ func (l *localLockTable) acquireRowLockLocked(
 	w *waiter,
 	offset int,
 	rows [][]byte,
-	mode pb.LockMode) (int, *waiter, timestamp.Timestamp) {
+	opts LockOptions,
+	result *pb.Result) (int, *waiter, timestamp.Timestamp, error) {
 	n := len(rows)
 	for idx := offset; idx < n; idx++ {
 		row := rows[idx]
-		logLocalLockRow(l.bind.ServiceID, txn, l.bind.Table, row, mode)
+		logLocalLockRow(l.bind.ServiceID, txn, l.bind.Table, row, opts.Mode)
 		key, lock, ok := l.mu.store.Seek(row)
 		if ok &&
 			(bytes.Equal(key, row) ||
@@ -235,16 +236,23 @@ func (l *localLockTable) acquireRowLockLocked(
 				}
 				continue
 			}
+			switch opts.Policy {
+			case pb.WaitPolicy_FastFail:
+				return idx, nil, timestamp.Timestamp{}, ErrLockConflict
+			case pb.WaitPolicy_SkipLocked:
+				result.Skipped = append(result.Skipped, int32(idx))
+				continue
+			}
 			w = getWaiter(l.bind.ServiceID, w, txn.txnID)
 			l.handleLockConflictLocked(txn, w, key, lock)
-			return idx, w, timestamp.Timestamp{}
+			return idx, w, timestamp.Timestamp{}, nil
 		}
-		l.addRowLockLocked(txn, row, getWaiter(l.bind.ServiceID, w, txn.txnID), mode)
+		l.addRowLockLocked(txn, row, getWaiter(l.bind.ServiceID, w, txn.txnID), opts.Mode)
 		// lock added, need create new waiter next time
 		w = nil
 	}
 	now, _ := l.clock.Now()
-	return 0, nil, now
+	return 0, nil, now, nil
 }
 
 func (l *localLockTable) acquireRangeLockLocked(
@@ -252,7 +260,7 @@ func (l *localLockTable) acquireRangeLockLocked(
 	w *waiter,
 	offset int,
 	rows [][]byte,
-	mode pb.LockMode) (int, *waiter, timestamp.Timestamp) {
+	opts LockOptions) (int, *waiter, timestamp.Timestamp, error) {
 	n := len(rows)
 	for i := offset; i < n; i += 2 {
 		start := rows[i]
@@ -262,21 +270,26 @@ func (l *localLockTable) acquireRangeLockLocked(
 				start, end))
 		}
 
-		logLocalLockRange(l.bind.ServiceID, txn, l.bind.Table, start, end, mode)
+		logLocalLockRange(l.bind.ServiceID, txn, l.bind.Table, start, end, opts.Mode)
 		w = getWaiter(l.bind.ServiceID, w, txn.txnID)
 
-		confilct, conflictWith := l.addRangeLockLocked(w, txn, start, end, mode)
+		confilct, conflictWith := l.addRangeLockLocked(w, txn, start, end, opts.Mode)
 		if len(confilct) > 0 {
+			// a range can not be skipped partially
+			if opts.Policy != pb.WaitPolicy_Wait {
+				w.unref(l.bind.ServiceID)
+				return i, nil, timestamp.Timestamp{}, ErrLockConflict
+			}
 			w = getWaiter(l.bind.ServiceID, w, txn.txnID)
 			l.handleLockConflictLocked(txn, w, confilct, conflictWith)
-			return i, w, timestamp.Timestamp{}
+			return i, w, timestamp.Timestamp{}, nil
 		}
 
 		// lock added, need create new waiter next time
 		w = nil
 	}
 	now, _ := l.clock.Now()
-	return 0, nil, now
+	return 0, nil, now, nil
 }
 
 func (l *localLockTable) addRowLockLocked(
@@ -110,7 +110,7 @@ func (l *remoteLockTable) unlock(
 		txn,
 		l.bind)
 	for {
-		err := l.doUnlock(txn, commitTS)
+		err := l.doUnlock(txn, commitTS, nil)
 		if err == nil {
 			return
 		}
@@ -132,6 +132,37 @@ func (l *remoteLockTable) unlock(
 	}
 }
 
+// unlockRows releases the locks in ls held by the txn on the remote lock service,
+// the other locks of the txn on the remote lock service are kept. It's used when
+// the txn rolls back to a savepoint.
+func (l *remoteLockTable) unlockRows(
+	txn *activeTxn,
+	ls *cowSlice) {
+	logUnlockTableOnRemote(
+		l.serviceID,
+		txn,
+		l.bind)
+	locks := ls.slice()
+	defer locks.unref()
+	rows := locks.all()
+	for {
+		err := l.doUnlock(txn, timestamp.Timestamp{}, rows)
+		if err == nil {
+			return
+		}
+
+		logUnlockTableOnRemoteFailed(
+			l.serviceID,
+			txn,
+			l.bind,
+			err)
+		// why use loop is similar to unlock
+		if err := l.handleError(txn.txnID, err); err == nil {
+			return
+		}
+	}
+}
+
 func (l *remoteLockTable) getLock(txnID, key []byte, fn func(Lock)) {
 	for {
 		lock, ok, err := l.doGetLock(txnID, key)
@@ -158,7 +189,8 @@ func (l *remoteLockTable) getLock(txnID, key []byte, fn func(Lock)) {
 
 func (l *remoteLockTable) doUnlock(
 	txn *activeTxn,
-	commitTS timestamp.Timestamp) error {
+	commitTS timestamp.Timestamp,
+	rows [][]byte) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultRPCTimeout)
 	defer cancel()
 
@@ -169,6 +201,7 @@ func (l *remoteLockTable) doUnlock(
 	req.LockTable = l.bind
 	req.Unlock.TxnID = txn.txnID
 	req.Unlock.CommitTS = commitTS
+	req.Unlock.Rows = rows
 
 	resp, err := l.client.Send(ctx, req)
 	if err == nil {
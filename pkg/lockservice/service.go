@@ -20,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/common/util"
@@ -106,6 +107,38 @@ func (s *service) Unlock(
 	return nil
 }
 
+func (s *service) SavePoint(
+	txnID []byte,
+	name string) error {
+	txn := s.activeTxnHolder.getActiveTxn(txnID, true, "")
+	txn.savePoint(name)
+	return nil
+}
+
+func (s *service) RollbackToSavePoint(
+	ctx context.Context,
+	txnID []byte,
+	name string) error {
+	_, span := trace.Debug(ctx, "lockservice.rollback-to-savepoint")
+	defer span.End()
+
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+	return txn.rollbackToSavePoint(s.cfg.ServiceID, txnID, name, s.getLockTable)
+}
+
+func (s *service) ReleaseSavePoint(
+	txnID []byte,
+	name string) error {
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+	return txn.releaseSavePoint(txnID, name)
+}
+
 func (s *service) GetConfig() Config {
 	return s.cfg
 }
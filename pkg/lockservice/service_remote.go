@@ -95,6 +95,13 @@ func (s *service) handleRemoteUnlock(
 		// table binding.
 		return err
 	}
+	if len(req.Unlock.Rows) > 0 {
+		// the txn rolls back to a savepoint, the other locks are kept.
+		if txn := s.activeTxnHolder.getActiveTxn(req.Unlock.TxnID, false, ""); txn != nil {
+			txn.unlockRows(s.cfg.ServiceID, req.Unlock.TxnID, l, req.Unlock.Rows)
+		}
+		return nil
+	}
 	return s.Unlock(ctx, req.Unlock.TxnID, req.Unlock.CommitTS)
 }
 
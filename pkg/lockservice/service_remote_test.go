@@ -91,6 +91,53 @@ func TestLockAndUnlockOnRemote(t *testing.T) {
 	)
 }
 
+func TestRollbackToSavePointOnRemote(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l1 := s[0]
+			l2 := s[1]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_FastFail,
+			}
+			txn1 := []byte{1}
+			txn2 := []byte{2}
+			txn3 := []byte{3}
+			table1 := uint64(1)
+
+			// table1 on l2, txn1 locks it on remote
+			mustAddTestLock(t, ctx, l2, table1, txn2, [][]byte{{0}}, pb.Granularity_Row)
+			mustAddTestLock(t, ctx, l1, table1, txn1, [][]byte{{1}}, pb.Granularity_Row)
+			require.NoError(t, l1.SavePoint(txn1, "a"))
+			mustAddTestLock(t, ctx, l1, table1, txn1, [][]byte{{2}}, pb.Granularity_Row)
+			mustAddTestLock(t, ctx, l1, table1, txn1, [][]byte{{3}}, pb.Granularity_Row)
+			checkTxnLocks(t, l2, txn1, table1, []byte{1}, []byte{2}, []byte{3})
+
+			// the locks after the savepoint are released on remote
+			require.NoError(t, l1.RollbackToSavePoint(ctx, txn1, "a"))
+			checkTxnLocks(t, l1, txn1, table1, []byte{1})
+			checkTxnLocks(t, l2, txn1, table1, []byte{1})
+			checkLockRemoved(t, l2, table1, [][]byte{{2}, {3}})
+			_, err := l2.Lock(ctx, table1, [][]byte{{2}}, txn3, option)
+			require.NoError(t, err)
+			_, err = l2.Lock(ctx, table1, [][]byte{{1}}, txn3, option)
+			require.Equal(t, ErrLockConflict, err)
+
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			checkTxnNotExist(t, l2, txn1)
+			checkLockRemoved(t, l2, table1, [][]byte{{1}})
+			require.NoError(t, l2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+			require.NoError(t, l2.Unlock(ctx, txn3, timestamp.Timestamp{}))
+		},
+	)
+}
+
 func TestUnlockAfterTimeoutOnRemote(t *testing.T) {
 	runLockServiceTestsWithAdjustConfig(
 		t,
@@ -159,6 +206,43 @@ func TestLockBlockedOnRemote(t *testing.T) {
 	)
 }
 
+func TestLockWithSkipLockedOnRemote(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l1 := s[0]
+			l2 := s[1]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+
+			// txn1 hold lock row1 on l1
+			mustAddTestLock(t, ctx, l1, 1, txn1, [][]byte{{1}}, pb.Granularity_Row)
+
+			// txn2 skip row1 on l2
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_SkipLocked,
+			}
+			result, err := l2.Lock(ctx, 1, [][]byte{{1}, {2}}, txn2, option)
+			require.NoError(t, err)
+			require.Equal(t, []int32{0}, result.Skipped)
+
+			// txn2 fail fast on row1 on l2
+			option.Policy = pb.WaitPolicy_FastFail
+			_, err = l2.Lock(ctx, 1, [][]byte{{1}}, txn2, option)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockConflict))
+
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			require.NoError(t, l2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+		},
+	)
+}
+
 func TestLockResultWithNoConfictOnRemote(t *testing.T) {
 	runLockServiceTests(
 		t,
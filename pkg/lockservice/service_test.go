@@ -333,6 +333,153 @@ func TestRangeLock(t *testing.T) {
 	)
 }
 
+func TestLockWithFastFail(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			mustAddTestLock(t, ctx, l, 0, []byte{1}, [][]byte{{2}}, pb.Granularity_Row)
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_FastFail,
+			}
+			_, err := l.Lock(ctx, 0, [][]byte{{1}, {2}, {3}}, []byte{2}, option)
+			require.Equal(t, ErrLockConflict, err)
+
+			option.Granularity = pb.Granularity_Range
+			_, err = l.Lock(ctx, 0, [][]byte{{2}, {4}}, []byte{3}, option)
+			require.Equal(t, ErrLockConflict, err)
+
+			require.NoError(t, l.Unlock(ctx, []byte{1}, timestamp.Timestamp{}))
+			_, err = l.Lock(ctx, 0, [][]byte{{2}, {4}}, []byte{3}, option)
+			require.NoError(t, err)
+			require.NoError(t, l.Unlock(ctx, []byte{2}, timestamp.Timestamp{}))
+			require.NoError(t, l.Unlock(ctx, []byte{3}, timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestRollbackToSavePoint(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte{1}
+			mustAddTestLock(t, ctx, l, 0, txn1, [][]byte{{1}}, pb.Granularity_Row)
+			require.NoError(t, l.SavePoint(txn1, "a"))
+			mustAddTestLock(t, ctx, l, 0, txn1, [][]byte{{2}}, pb.Granularity_Row)
+			mustAddTestLock(t, ctx, l, 1, txn1, [][]byte{{1}}, pb.Granularity_Row)
+			require.NoError(t, l.SavePoint(txn1, "b"))
+			mustAddTestLock(t, ctx, l, 0, txn1, [][]byte{{3}}, pb.Granularity_Row)
+
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_FastFail,
+			}
+			txn2 := []byte{2}
+			require.NoError(t, l.RollbackToSavePoint(ctx, txn1, "b"))
+			_, err := l.Lock(ctx, 0, [][]byte{{3}}, txn2, option)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 0, [][]byte{{2}}, txn2, option)
+			require.Equal(t, ErrLockConflict, err)
+
+			// the savepoint b is kept, a can still be rolled back to
+			require.NoError(t, l.RollbackToSavePoint(ctx, txn1, "b"))
+			require.NoError(t, l.RollbackToSavePoint(ctx, txn1, "a"))
+			_, err = l.Lock(ctx, 0, [][]byte{{2}}, txn2, option)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 1, [][]byte{{1}}, txn2, option)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 0, [][]byte{{1}}, txn2, option)
+			require.Equal(t, ErrLockConflict, err)
+
+			// b is removed by rolling back to a
+			err = l.RollbackToSavePoint(ctx, txn1, "b")
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+			require.NoError(t, l.ReleaseSavePoint(txn1, "a"))
+			err = l.RollbackToSavePoint(ctx, txn1, "a")
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+			require.NoError(t, l.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			_, err = l.Lock(ctx, 0, [][]byte{{1}}, txn2, option)
+			require.NoError(t, err)
+			require.NoError(t, l.Unlock(ctx, txn2, timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestSavePointWithoutLocks(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte{1}
+			err := l.RollbackToSavePoint(ctx, txn1, "a")
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+			require.NoError(t, l.SavePoint(txn1, "a"))
+			mustAddTestLock(t, ctx, l, 0, txn1, [][]byte{{1}}, pb.Granularity_Row)
+			require.NoError(t, l.RollbackToSavePoint(ctx, txn1, "a"))
+
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_FastFail,
+			}
+			_, err = l.Lock(ctx, 0, [][]byte{{1}}, []byte{2}, option)
+			require.NoError(t, err)
+			require.NoError(t, l.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			require.NoError(t, l.Unlock(ctx, []byte{2}, timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestLockWithSkipLocked(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			mustAddTestLock(t, ctx, l, 0, []byte{1}, [][]byte{{2}, {4}}, pb.Granularity_Row)
+			option := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_SkipLocked,
+			}
+			result, err := l.Lock(ctx, 0, [][]byte{{1}, {2}, {3}, {4}}, []byte{2}, option)
+			require.NoError(t, err)
+			require.Equal(t, []int32{1, 3}, result.Skipped)
+
+			// the rows not skipped are locked by txn2
+			result, err = l.Lock(ctx, 0, [][]byte{{1}, {3}, {5}}, []byte{3}, option)
+			require.NoError(t, err)
+			require.Equal(t, []int32{0, 1}, result.Skipped)
+
+			require.NoError(t, l.Unlock(ctx, []byte{1}, timestamp.Timestamp{}))
+			require.NoError(t, l.Unlock(ctx, []byte{2}, timestamp.Timestamp{}))
+			require.NoError(t, l.Unlock(ctx, []byte{3}, timestamp.Timestamp{}))
+		},
+	)
+}
+
 func TestRangeLockWithMany(t *testing.T) {
 	runLockServiceTests(
 		t,
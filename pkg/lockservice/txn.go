@@ -16,8 +16,10 @@ package lockservice
 
 import (
 	"bytes"
+	"math"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/util"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
@@ -38,6 +40,16 @@ type activeTxn struct {
 	blockedWaiter *waiter
 	holdLocks     map[uint64]*cowSlice
 	remoteService string
+	savepoints    []txnSavePoint
+}
+
+// txnSavePoint is a savepoint of the txn, holds is the number of the locks of
+// each table held by the txn when the savepoint is set. The locks are appended
+// to holdLocks in order, so the locks after holds[table] are acquired after the
+// savepoint.
+type txnSavePoint struct {
+	name  string
+	holds map[uint64]int
 }
 
 func newActiveTxn(
@@ -80,6 +92,13 @@ func (txn *activeTxn) lockRemoved(
 	})
 	v.close()
 	txn.holdLocks[table] = newV
+
+	// the locks are merged into a new lock appended to the end, which may cover
+	// the locks held before the savepoints. So the locks of the table can not be
+	// released by rolling back to the savepoints any more.
+	for _, sp := range txn.savepoints {
+		sp.holds[table] = math.MaxInt
+	}
 }
 
 func (txn *activeTxn) lockAdded(
@@ -158,10 +177,154 @@ func (txn *activeTxn) close(
 	txn.txnKey = ""
 	txn.blockedWaiter = nil
 	txn.remoteService = ""
+	txn.savepoints = txn.savepoints[:0]
 	txnPool.Put(txn)
 	return nil
 }
 
+func (txn *activeTxn) savePoint(name string) {
+	txn.Lock()
+	defer txn.Unlock()
+
+	if idx := txn.findSavePointLocked(name); idx >= 0 {
+		txn.savepoints = append(txn.savepoints[:idx], txn.savepoints[idx+1:]...)
+	}
+	holds := make(map[uint64]int, len(txn.holdLocks))
+	for table, cs := range txn.holdLocks {
+		s := cs.slice()
+		holds[table] = s.len()
+		s.unref()
+	}
+	txn.savepoints = append(txn.savepoints, txnSavePoint{name: name, holds: holds})
+}
+
+func (txn *activeTxn) rollbackToSavePoint(
+	serviceID string,
+	txnID []byte,
+	name string,
+	lockTableFunc func(uint64) (lockTable, error)) error {
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+
+	idx := txn.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+	sp := txn.savepoints[idx]
+	for table, cs := range txn.holdLocks {
+		l, err := lockTableFunc(table)
+		if err != nil {
+			return err
+		}
+		s := cs.slice()
+		n := sp.holds[table]
+		if n >= s.len() {
+			s.unref()
+			continue
+		}
+		locks := s.all()
+		kept := newCowSlice(txn.fsp, locks[:n])
+		released := newCowSlice(txn.fsp, locks[n:])
+		s.unref()
+
+		logTxnUnlockTable(serviceID, txn, table)
+		if remote, ok := l.(*remoteLockTable); ok {
+			// the remote lock table releases all the locks of the txn by unlock
+			remote.unlockRows(txn, released)
+		} else {
+			l.unlock(txn, released, timestamp.Timestamp{})
+		}
+		logTxnUnlockTableCompleted(serviceID, txn, table, released)
+		released.close()
+		cs.close()
+		if n == 0 {
+			kept.close()
+			delete(txn.holdLocks, table)
+			continue
+		}
+		txn.holdLocks[table] = kept
+	}
+	txn.savepoints = txn.savepoints[:idx+1]
+	return nil
+}
+
+// unlockRows releases the rows locked by the remote txn on the lock table, the
+// other locks of the txn are kept. The txn started on another lock service rolls
+// back to a savepoint by it.
+func (txn *activeTxn) unlockRows(
+	serviceID string,
+	txnID []byte,
+	l lockTable,
+	rows [][]byte) {
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return
+	}
+
+	table := l.getBind().Table
+	cs, ok := txn.holdLocks[table]
+	if !ok {
+		return
+	}
+	unlocked := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		unlocked[util.UnsafeBytesToString(row)] = struct{}{}
+	}
+	s := cs.slice()
+	locks := s.all()
+	kept := make([][]byte, 0, len(locks))
+	for _, lock := range locks {
+		if _, ok := unlocked[util.UnsafeBytesToString(lock)]; !ok {
+			kept = append(kept, lock)
+		}
+	}
+	released := newCowSlice(txn.fsp, rows)
+	var keptSlice *cowSlice
+	if len(kept) > 0 {
+		keptSlice = newCowSlice(txn.fsp, kept)
+	}
+	s.unref()
+
+	logTxnUnlockTable(serviceID, txn, table)
+	l.unlock(txn, released, timestamp.Timestamp{})
+	logTxnUnlockTableCompleted(serviceID, txn, table, released)
+	released.close()
+	cs.close()
+	if keptSlice == nil {
+		delete(txn.holdLocks, table)
+		return
+	}
+	txn.holdLocks[table] = keptSlice
+}
+
+func (txn *activeTxn) releaseSavePoint(txnID []byte, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+
+	idx := txn.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExistNoCtx(name)
+	}
+	txn.savepoints = txn.savepoints[:idx]
+	return nil
+}
+
+func (txn *activeTxn) findSavePointLocked(name string) int {
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if txn.savepoints[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (txn *activeTxn) abort(serviceID string, waitTxn pb.WaitTxn) {
 	txn.RLock()
 	defer txn.RUnlock()
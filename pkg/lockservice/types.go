@@ -32,6 +32,8 @@ var (
 	ErrLockTableBindChanged = moerr.NewLockTableBindChangedNoCtx()
 	// ErrLockTableNotFound lock table not found on remote lock service
 	ErrLockTableNotFound = moerr.NewLockTableNotFoundNoCtx()
+	// ErrLockConflict lock conflict encountered in FastFail wait policy
+	ErrLockConflict = moerr.NewLockConflictNoCtx()
 )
 
 // LockStorage the store that holds the locks, a storage instance is corresponding to
@@ -85,13 +87,26 @@ type LockService interface {
 	// If a conflict is encountered, the method will block until the conflicting lock is
 	// released and held by the current operation, or until it times out.
 	//
-	// Returns false if conflicts are encountered in FastFail wait policy and ErrDeadLockDetected
-	// returns if current operation was aborted by deadlock detection.
+	// ErrLockConflict returns if conflicts are encountered in FastFail wait policy and
+	// ErrDeadLockDetected returns if current operation was aborted by deadlock detection.
+	// In SkipLocked wait policy, the rows locked by other transactions are skipped, and
+	// their indexes are returned in Result.Skipped.
 	Lock(ctx context.Context, tableID uint64, rows [][]byte, txnID []byte, options LockOptions) (pb.Result, error)
 	// Unlock release all locks associated with the transaction. If commitTS is not empty, means
 	// the txn was committed.
 	Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp) error
 
+	// SavePoint records the locks held by the txn as a savepoint. If a savepoint with
+	// the same name exists, it is replaced.
+	SavePoint(txnID []byte, name string) error
+	// RollbackToSavePoint releases the locks acquired by the txn after the savepoint,
+	// including the locks on the remote lock tables, and removes the savepoints set
+	// after it.
+	RollbackToSavePoint(ctx context.Context, txnID []byte, name string) error
+	// ReleaseSavePoint removes the savepoint and the savepoints set after it, the locks
+	// are not changed.
+	ReleaseSavePoint(txnID []byte, name string) error
+
 	// Close close the lock service.
 	Close() error
 
@@ -32,8 +32,10 @@ const (
 	checkSumLen     = 4
 	zoneMapOff      = checkSumOff + checkSumLen
 	zoneMapLen      = 64
-	colMetaDummyOff = zoneMapOff + zoneMapLen
-	colMetaDummyLen = 32
+	encodingOff     = zoneMapOff + zoneMapLen
+	encodingLen     = 1
+	colMetaDummyOff = encodingOff + encodingLen
+	colMetaDummyLen = 31
 	colMetaLen      = colMetaDummyOff + colMetaDummyLen
 )
 
@@ -98,6 +100,14 @@ func (cm ColumnMeta) SetZoneMap(zm ZoneMap) {
 	copy(cm[zoneMapOff:zoneMapOff+zoneMapLen], zm)
 }
 
+func (cm ColumnMeta) Encoding() uint8 {
+	return types.DecodeUint8(cm[encodingOff : encodingOff+encodingLen])
+}
+
+func (cm ColumnMeta) setEncoding(encoding uint8) {
+	copy(cm[encodingOff:encodingOff+encodingLen], types.EncodeUint8(&encoding))
+}
+
 func (cm ColumnMeta) Checksum() uint32 {
 	return types.DecodeUint32(cm[checkSumOff : checkSumOff+checkSumLen])
 }
@@ -0,0 +1,539 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// Column encodings are applied to the column data before the compression.
+// The encoding of a column is chosen by its data when the block is written,
+// recorded in the column meta and in the column data entry.
+const (
+	EncodingPlain = iota
+	// EncodingDict replaces the values of a low cardinality varlen column
+	// with the codes of a dictionary
+	EncodingDict
+	// EncodingRLE stores the runs of repeated values of a fixed-size column
+	EncodingRLE
+	// EncodingDelta stores the differences between the adjacent values of
+	// an integer column, it fits sorted values
+	EncodingDelta
+	// EncodingFOR stores the offsets of the values of an integer column to
+	// the minimum value, it fits values in a narrow range
+	EncodingFOR
+)
+
+// an encoding is chosen only if it is smaller than this percentage of the
+// plain data
+const encodingSizeRatio = 75
+
+// the maximum number of the values in a dictionary
+const maxDictSize = 1 << 16
+
+// ColumnEncoding encodes the values of a flat vector. Nulls are kept out of
+// the encoding, the values of the null rows are encoded like the others.
+type ColumnEncoding struct {
+	// Applicable returns whether the encoding supports the type
+	Applicable func(typ types.Type) bool
+	// EstimateSize returns the size of the encoded values, or -1 if the
+	// vector isn't worth encoding
+	EstimateSize func(vec *vector.Vector) int
+	// Encode writes the encoded values of the vector
+	Encode func(vec *vector.Vector, buf *bytes.Buffer) error
+	// Decode returns the data and the area of the vector
+	Decode func(typ types.Type, length int, buf []byte) (data []byte, area []byte, err error)
+}
+
+var columnEncodings = map[uint8]ColumnEncoding{}
+
+func RegisterColumnEncoding(encoding uint8, ce ColumnEncoding) {
+	_, ok := columnEncodings[encoding]
+	if ok {
+		panic(fmt.Sprintf("duplicate column encoding found: %d", encoding))
+	}
+	columnEncodings[encoding] = ce
+}
+
+func init() {
+	RegisterColumnEncoding(EncodingDict, ColumnEncoding{
+		Applicable:   func(typ types.Type) bool { return typ.IsVarlen() },
+		EstimateSize: estimateDictSize,
+		Encode:       encodeDict,
+		Decode:       decodeDict,
+	})
+	RegisterColumnEncoding(EncodingRLE, ColumnEncoding{
+		Applicable:   func(typ types.Type) bool { return typ.IsFixedLen() },
+		EstimateSize: estimateRLESize,
+		Encode:       encodeRLE,
+		Decode:       decodeRLE,
+	})
+	RegisterColumnEncoding(EncodingDelta, ColumnEncoding{
+		Applicable:   isIntegerLike,
+		EstimateSize: estimateDeltaSize,
+		Encode:       encodeDelta,
+		Decode:       decodeDelta,
+	})
+	RegisterColumnEncoding(EncodingFOR, ColumnEncoding{
+		Applicable:   isIntegerLike,
+		EstimateSize: estimateFORSize,
+		Encode:       encodeFOR,
+		Decode:       decodeFOR,
+	})
+}
+
+// ChooseColumnEncoding returns the encoding producing the smallest data of
+// the vector, it returns EncodingPlain if no encoding saves enough space.
+func ChooseColumnEncoding(vec *vector.Vector) uint8 {
+	if vec.IsConst() || vec.Length() == 0 {
+		return EncodingPlain
+	}
+	typ := *vec.GetType()
+	if typ.Oid == types.T_any {
+		return EncodingPlain
+	}
+	plainSize := len(vec.UnsafeGetRawData()) + len(vec.GetArea())
+	best, bestSize := uint8(EncodingPlain), plainSize*encodingSizeRatio/100
+	// iterate in the order of the encodings to be deterministic
+	for encoding := uint8(EncodingDict); encoding <= EncodingFOR; encoding++ {
+		ce, ok := columnEncodings[encoding]
+		if !ok || !ce.Applicable(typ) {
+			continue
+		}
+		if size := ce.EstimateSize(vec); size >= 0 && size < bestSize {
+			best, bestSize = encoding, size
+		}
+	}
+	return best
+}
+
+// EncodeColumn writes the vector with the encoding.
+// The layout is | encoding | vector |, or for the encodings other than the
+// plain one | encoding | type | length | nspLen | nsp | encoded values |.
+func EncodeColumn(vec *vector.Vector, encoding uint8, buf *bytes.Buffer) error {
+	buf.WriteByte(encoding)
+	if encoding == EncodingPlain {
+		return vec.MarshalBinaryWithBuffer(buf)
+	}
+	ce, ok := columnEncodings[encoding]
+	if !ok || vec.IsConst() || !ce.Applicable(*vec.GetType()) {
+		return moerr.NewInternalErrorNoCtx("column encoding %d not applicable to %s", encoding, vec.GetType().String())
+	}
+	buf.Write(types.EncodeType(vec.GetType()))
+	length := uint32(vec.Length())
+	buf.Write(types.EncodeUint32(&length))
+	nspData, err := vec.GetNulls().Show()
+	if err != nil {
+		return err
+	}
+	nspLen := uint32(len(nspData))
+	buf.Write(types.EncodeUint32(&nspLen))
+	buf.Write(nspData)
+	return ce.Encode(vec, buf)
+}
+
+// DecodeColumn reads the vector written by EncodeColumn.
+func DecodeColumn(buf []byte) (*vector.Vector, error) {
+	if len(buf) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("bad column data")
+	}
+	encoding := buf[0]
+	buf = buf[1:]
+	vec := vector.NewVec(types.Type{})
+	if encoding == EncodingPlain {
+		if err := vec.UnmarshalBinary(buf); err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+	ce, ok := columnEncodings[encoding]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("unknown column encoding %d", encoding)
+	}
+	typ := types.DecodeType(buf[:types.TSize])
+	buf = buf[types.TSize:]
+	length := types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	nspLen := types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	nspData := buf[:nspLen]
+	buf = buf[nspLen:]
+	data, area, err := ce.Decode(typ, int(length), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// rebuild the vector by the plain layout
+	var plain bytes.Buffer
+	plain.Grow(len(data) + len(area) + len(nspData) + types.TSize + 17)
+	plain.WriteByte(uint8(vector.FLAT))
+	plain.Write(types.EncodeType(&typ))
+	plain.Write(types.EncodeUint32(&length))
+	dataLen := uint32(len(data))
+	plain.Write(types.EncodeUint32(&dataLen))
+	plain.Write(data)
+	areaLen := uint32(len(area))
+	plain.Write(types.EncodeUint32(&areaLen))
+	plain.Write(area)
+	plain.Write(types.EncodeUint32(&nspLen))
+	plain.Write(nspData)
+	if err = vec.UnmarshalBinary(plain.Bytes()); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+func isIntegerLike(typ types.Type) bool {
+	return typ.Oid.IsInteger() || typ.Oid.IsDateRelate()
+}
+
+// getIntegerAt returns the i-th value of the integer-like data as int64,
+// the unsigned values are zero extended.
+func getIntegerAt(data []byte, width int, signed bool, i int) int64 {
+	switch width {
+	case 1:
+		if signed {
+			return int64(int8(data[i]))
+		}
+		return int64(data[i])
+	case 2:
+		v := binary.LittleEndian.Uint16(data[i*2:])
+		if signed {
+			return int64(int16(v))
+		}
+		return int64(v)
+	case 4:
+		v := binary.LittleEndian.Uint32(data[i*4:])
+		if signed {
+			return int64(int32(v))
+		}
+		return int64(v)
+	default:
+		return int64(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+}
+
+func putIntegerAt(data []byte, width int, i int, v int64) {
+	switch width {
+	case 1:
+		data[i] = byte(v)
+	case 2:
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(data[i*4:], uint32(v))
+	default:
+		binary.LittleEndian.PutUint64(data[i*8:], uint64(v))
+	}
+}
+
+func isSigned(typ types.Type) bool {
+	return !typ.Oid.IsUnsignedInt()
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// byteWidth returns the number of bytes (1, 2, 4 or 8) to store v.
+func byteWidth(v uint64) int {
+	switch n := (bits.Len64(v) + 7) / 8; {
+	case n <= 1:
+		return 1
+	case n <= 2:
+		return 2
+	case n <= 4:
+		return 4
+	default:
+		return 8
+	}
+}
+
+func readUvarint(buf []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return 0, nil, moerr.NewInternalErrorNoCtx("bad encoded column data")
+	}
+	return v, buf[n:], nil
+}
+
+// dict: | dictLen | dictLen * (uvarint len | value) | codeWidth | codes |
+
+func estimateDictSize(vec *vector.Vector) int {
+	n := vec.Length()
+	dict := make(map[string]struct{})
+	size := 0
+	for i := 0; i < n; i++ {
+		v := vec.GetBytesAt(i)
+		if _, ok := dict[string(v)]; ok {
+			continue
+		}
+		dict[string(v)] = struct{}{}
+		if len(dict) > maxDictSize || len(dict) > n/2 {
+			return -1
+		}
+		size += binary.MaxVarintLen32 + len(v)
+	}
+	return 5 + size + n*byteWidth(uint64(len(dict)-1))
+}
+
+func encodeDict(vec *vector.Vector, buf *bytes.Buffer) error {
+	n := vec.Length()
+	dict := make(map[string]uint64)
+	values := make([][]byte, 0)
+	codes := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		v := vec.GetBytesAt(i)
+		code, ok := dict[string(v)]
+		if !ok {
+			code = uint64(len(values))
+			dict[string(v)] = code
+			values = append(values, v)
+		}
+		codes[i] = code
+	}
+	dictLen := uint32(len(values))
+	buf.Write(types.EncodeUint32(&dictLen))
+	var tmp [binary.MaxVarintLen64]byte
+	for _, v := range values {
+		buf.Write(tmp[:binary.PutUvarint(tmp[:], uint64(len(v)))])
+		buf.Write(v)
+	}
+	width := byteWidth(uint64(len(values) - 1))
+	buf.WriteByte(uint8(width))
+	data := make([]byte, n*width)
+	for i, code := range codes {
+		putIntegerAt(data, width, i, int64(code))
+	}
+	buf.Write(data)
+	return nil
+}
+
+func decodeDict(typ types.Type, length int, buf []byte) ([]byte, []byte, error) {
+	var err error
+	var l uint64
+	dictLen := types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	values := make([][]byte, dictLen)
+	for i := range values {
+		if l, buf, err = readUvarint(buf); err != nil {
+			return nil, nil, err
+		}
+		values[i] = buf[:l]
+		buf = buf[l:]
+	}
+	width := int(buf[0])
+	buf = buf[1:]
+
+	var area []byte
+	data := make([]byte, length*types.VarlenaSize)
+	vs := types.DecodeSlice[types.Varlena](data)
+	for i := 0; i < length; i++ {
+		code := getIntegerAt(buf, width, false, i)
+		if vs[i], area, err = types.BuildVarlena(values[code], area, nil); err != nil {
+			return nil, nil, err
+		}
+	}
+	return data, area, nil
+}
+
+// rle: | runs | runs * (uvarint run length | value) |
+
+func estimateRLESize(vec *vector.Vector) int {
+	width := vec.GetType().TypeSize()
+	data := vec.UnsafeGetRawData()
+	runs := 1
+	for i := width; i < len(data); i += width {
+		if !bytes.Equal(data[i:i+width], data[i-width:i]) {
+			runs++
+		}
+	}
+	return 4 + runs*(width+2)
+}
+
+func encodeRLE(vec *vector.Vector, buf *bytes.Buffer) error {
+	width := vec.GetType().TypeSize()
+	data := vec.UnsafeGetRawData()
+	var runs uint32
+	var body bytes.Buffer
+	var tmp [binary.MaxVarintLen64]byte
+	for start := 0; start < len(data); {
+		end := start + width
+		for end < len(data) && bytes.Equal(data[end:end+width], data[start:start+width]) {
+			end += width
+		}
+		body.Write(tmp[:binary.PutUvarint(tmp[:], uint64((end-start)/width))])
+		body.Write(data[start : start+width])
+		runs++
+		start = end
+	}
+	buf.Write(types.EncodeUint32(&runs))
+	buf.Write(body.Bytes())
+	return nil
+}
+
+func decodeRLE(typ types.Type, length int, buf []byte) ([]byte, []byte, error) {
+	var err error
+	var cnt uint64
+	width := typ.TypeSize()
+	runs := types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	data := make([]byte, 0, length*width)
+	for i := uint32(0); i < runs; i++ {
+		if cnt, buf, err = readUvarint(buf); err != nil {
+			return nil, nil, err
+		}
+		for j := uint64(0); j < cnt; j++ {
+			data = append(data, buf[:width]...)
+		}
+		buf = buf[width:]
+	}
+	if len(data) != length*width {
+		return nil, nil, moerr.NewInternalErrorNoCtx("bad rle column data")
+	}
+	return data, nil, nil
+}
+
+// delta: | first value | (length - 1) * uvarint zigzag delta |
+
+func estimateDeltaSize(vec *vector.Vector) int {
+	typ := *vec.GetType()
+	width, signed := typ.TypeSize(), isSigned(typ)
+	data := vec.UnsafeGetRawData()
+	size := 8
+	prev := getIntegerAt(data, width, signed, 0)
+	for i := 1; i < vec.Length(); i++ {
+		v := getIntegerAt(data, width, signed, i)
+		if n := (bits.Len64(zigzag(v-prev)) + 6) / 7; n > 1 {
+			size += n
+		} else {
+			size++
+		}
+		prev = v
+	}
+	return size
+}
+
+func encodeDelta(vec *vector.Vector, buf *bytes.Buffer) error {
+	typ := *vec.GetType()
+	width, signed := typ.TypeSize(), isSigned(typ)
+	data := vec.UnsafeGetRawData()
+	prev := getIntegerAt(data, width, signed, 0)
+	buf.Write(types.EncodeInt64(&prev))
+	var tmp [binary.MaxVarintLen64]byte
+	for i := 1; i < vec.Length(); i++ {
+		v := getIntegerAt(data, width, signed, i)
+		buf.Write(tmp[:binary.PutUvarint(tmp[:], zigzag(v-prev))])
+		prev = v
+	}
+	return nil
+}
+
+func decodeDelta(typ types.Type, length int, buf []byte) ([]byte, []byte, error) {
+	var err error
+	var delta uint64
+	width := typ.TypeSize()
+	data := make([]byte, length*width)
+	v := types.DecodeInt64(buf[:8])
+	buf = buf[8:]
+	putIntegerAt(data, width, 0, v)
+	for i := 1; i < length; i++ {
+		if delta, buf, err = readUvarint(buf); err != nil {
+			return nil, nil, err
+		}
+		v += unzigzag(delta)
+		putIntegerAt(data, width, i, v)
+	}
+	return data, nil, nil
+}
+
+// for: | minimum value | offset width | length * offset |
+
+func integerRange(vec *vector.Vector) (int64, uint64) {
+	typ := *vec.GetType()
+	width, signed := typ.TypeSize(), isSigned(typ)
+	data := vec.UnsafeGetRawData()
+	minv := getIntegerAt(data, width, signed, 0)
+	maxv := minv
+	for i := 1; i < vec.Length(); i++ {
+		v := getIntegerAt(data, width, signed, i)
+		if signed {
+			if v < minv {
+				minv = v
+			} else if v > maxv {
+				maxv = v
+			}
+		} else {
+			if uint64(v) < uint64(minv) {
+				minv = v
+			} else if uint64(v) > uint64(maxv) {
+				maxv = v
+			}
+		}
+	}
+	return minv, uint64(maxv) - uint64(minv)
+}
+
+func estimateFORSize(vec *vector.Vector) int {
+	_, r := integerRange(vec)
+	width := byteWidth(r)
+	if width >= vec.GetType().TypeSize() {
+		return -1
+	}
+	return 9 + vec.Length()*width
+}
+
+func encodeFOR(vec *vector.Vector, buf *bytes.Buffer) error {
+	typ := *vec.GetType()
+	width, signed := typ.TypeSize(), isSigned(typ)
+	data := vec.UnsafeGetRawData()
+	minv, r := integerRange(vec)
+	offWidth := byteWidth(r)
+	buf.Write(types.EncodeInt64(&minv))
+	buf.WriteByte(uint8(offWidth))
+	offsets := make([]byte, vec.Length()*offWidth)
+	for i := 0; i < vec.Length(); i++ {
+		v := getIntegerAt(data, width, signed, i)
+		putIntegerAt(offsets, offWidth, i, int64(uint64(v)-uint64(minv)))
+	}
+	buf.Write(offsets)
+	return nil
+}
+
+func decodeFOR(typ types.Type, length int, buf []byte) ([]byte, []byte, error) {
+	width := typ.TypeSize()
+	minv := types.DecodeInt64(buf[:8])
+	offWidth := int(buf[8])
+	buf = buf[9:]
+	if len(buf) < length*offWidth {
+		return nil, nil, moerr.NewInternalErrorNoCtx("bad for column data")
+	}
+	data := make([]byte, length*width)
+	for i := 0; i < length; i++ {
+		off := getIntegerAt(buf, offWidth, false, i)
+		putIntegerAt(data, width, i, int64(uint64(minv)+uint64(off)))
+	}
+	return data, nil, nil
+}
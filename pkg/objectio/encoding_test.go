@@ -0,0 +1,119 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnEncoding(t *testing.T) {
+	mp := mpool.MustNewZero()
+	const rows = 1000
+
+	// low cardinality strings with nulls and long values
+	strs := vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < rows; i++ {
+		v := []byte(fmt.Sprintf("level-%d", i%3))
+		if i%3 == 2 {
+			v = []byte(strings.Repeat("error", 10))
+		}
+		require.NoError(t, vector.AppendBytes(strs, v, i%10 == 0, mp))
+	}
+	// sorted timestamps
+	tss := vector.NewVec(types.T_timestamp.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(tss, types.Timestamp(1700000000000000+int64(i)*1000), false, mp))
+	}
+	// repeated values
+	flags := vector.NewVec(types.T_int32.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(flags, int32(i/100), false, mp))
+	}
+	// values in a narrow range
+	codes := vector.NewVec(types.T_uint64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(codes, uint64(math.MaxUint64-uint64(i*7919%200)), false, mp))
+	}
+	// random values
+	floats := vector.NewVec(types.T_float64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(floats, math.Sqrt(float64(i)), false, mp))
+	}
+
+	cases := []struct {
+		vec      *vector.Vector
+		encoding uint8
+	}{
+		{strs, EncodingDict},
+		{tss, EncodingDelta},
+		{flags, EncodingRLE},
+		{codes, EncodingFOR},
+		{floats, EncodingPlain},
+	}
+	for _, c := range cases {
+		vec := c.vec
+		encoding := ChooseColumnEncoding(vec)
+		assert.Equal(t, c.encoding, encoding, vec.GetType().String())
+
+		var plain, encoded bytes.Buffer
+		require.NoError(t, EncodeColumn(vec, EncodingPlain, &plain))
+		require.NoError(t, EncodeColumn(vec, encoding, &encoded))
+		if encoding != EncodingPlain {
+			assert.Less(t, encoded.Len(), plain.Len())
+		}
+
+		decoded, err := DecodeColumn(encoded.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, vec.Length(), decoded.Length())
+		require.Equal(t, *vec.GetType(), *decoded.GetType())
+		for i := 0; i < vec.Length(); i++ {
+			require.Equal(t, vec.GetNulls().Contains(uint64(i)), decoded.GetNulls().Contains(uint64(i)))
+			if vec.GetType().IsVarlen() {
+				require.Equal(t, vec.GetBytesAt(i), decoded.GetBytesAt(i))
+			}
+		}
+		if vec.GetType().IsFixedLen() {
+			require.Equal(t, vec.UnsafeGetRawData(), decoded.UnsafeGetRawData())
+		}
+		vec.Free(mp)
+	}
+
+	// the encodings not chosen by the data work as well
+	vec := vector.NewVec(types.T_int16.ToType())
+	for _, v := range []int16{-3, math.MaxInt16, math.MinInt16, 0, 0, 7} {
+		require.NoError(t, vector.AppendFixed(vec, v, false, mp))
+	}
+	for _, encoding := range []uint8{EncodingRLE, EncodingDelta, EncodingFOR} {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeColumn(vec, encoding, &buf))
+		decoded, err := DecodeColumn(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, vector.MustFixedCol[int16](vec), vector.MustFixedCol[int16](decoded))
+	}
+	var buf bytes.Buffer
+	assert.Error(t, EncodeColumn(vec, EncodingDict, &buf))
+	vec.Free(mp)
+	assert.Equal(t, int64(0), mp.CurrNB())
+}
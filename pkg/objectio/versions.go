@@ -15,6 +15,8 @@
 package objectio
 
 import (
+	"bytes"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
@@ -36,11 +38,12 @@ var (
 const (
 	IOET_ObjectMeta_V1  = 1
 	IOET_ColumnData_V1  = 1
+	IOET_ColumnData_V2  = 2
 	IOET_BloomFilter_V1 = 1
 	IOET_ZoneMap_V1     = 1
 
 	IOET_ObjectMeta_CurrVer  = IOET_ObjectMeta_V1
-	IOET_ColumnData_CurrVer  = IOET_ColumnData_V1
+	IOET_ColumnData_CurrVer  = IOET_ColumnData_V2
 	IOET_BloomFilter_CurrVer = IOET_BloomFilter_V1
 	IOET_ZoneMap_CurrVer     = IOET_ZoneMap_V1
 )
@@ -48,6 +51,7 @@ const (
 func init() {
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V1}, EncodeColumnDataV1, DecodeColumnDataV1)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V2}, EncodeColumnDataV2, DecodeColumnDataV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ZM, IOET_ZoneMap_V1}, nil, nil)
 }
@@ -63,3 +67,16 @@ func DecodeColumnDataV1(buf []byte) (ioe any, err error) {
 	}
 	return vec, err
 }
+
+func EncodeColumnDataV2(ioe any) (buf []byte, err error) {
+	vec := ioe.(*vector.Vector)
+	var w bytes.Buffer
+	if err = EncodeColumn(vec, ChooseColumnEncoding(vec), &w); err != nil {
+		return
+	}
+	return w.Bytes(), nil
+}
+
+func DecodeColumnDataV2(buf []byte) (ioe any, err error) {
+	return DecodeColumn(buf)
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -44,6 +43,9 @@ type objectWriterV1 struct {
 	name        ObjectName
 	compressBuf []byte
 	bloomFilter []byte
+
+	compressAlg   uint8
+	compressLevel int
 }
 
 type blockData struct {
@@ -53,6 +55,30 @@ type blockData struct {
 	bloomFilter []byte
 }
 
+// the compression used by the new writers, it is set by the configuration
+// of the service
+var defaultCompression = struct {
+	sync.RWMutex
+	alg   uint8
+	level int
+}{alg: compress.Lz4}
+
+// SetDefaultCompression sets the compression of the objects written later.
+// The algorithm is recorded in the extents, so the objects written by
+// different algorithms are all readable.
+func SetDefaultCompression(alg uint8, level int) {
+	defaultCompression.Lock()
+	defer defaultCompression.Unlock()
+	defaultCompression.alg = alg
+	defaultCompression.level = level
+}
+
+func GetDefaultCompression() (uint8, int) {
+	defaultCompression.RLock()
+	defer defaultCompression.RUnlock()
+	return defaultCompression.alg, defaultCompression.level
+}
+
 type WriterType int8
 
 const (
@@ -87,6 +113,7 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		blocks:   make([]blockData, 0),
 		lastId:   0,
 	}
+	writer.compressAlg, writer.compressLevel = GetDefaultCompression()
 	return writer, nil
 }
 
@@ -103,9 +130,17 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		blocks:    make([]blockData, 0),
 		lastId:    0,
 	}
+	writer.compressAlg, writer.compressLevel = GetDefaultCompression()
 	return writer, nil
 }
 
+// SetCompression sets the compression of the object, it must be called
+// before any data is written.
+func (w *objectWriterV1) SetCompression(alg uint8, level int) {
+	w.compressAlg = alg
+	w.compressLevel = level
+}
+
 func (w *objectWriterV1) GetSeqnums() []uint16 {
 	return w.seqnums.Seqs
 }
@@ -403,17 +438,23 @@ func (w *objectWriterV1) Sync(ctx context.Context, items ...WriteOptions) error
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
 	var tmpData []byte
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
+	if w.compressAlg == compress.None {
+		data = make([]byte, dataLen)
+		copy(data, buf)
+		extent = NewExtent(compress.None, offset, uint32(dataLen), uint32(dataLen))
+		return
+	}
+	compressBlockBound := compress.CompressBlockBound(dataLen, int(w.compressAlg))
 	if len(w.compressBuf) < compressBlockBound {
 		w.compressBuf = make([]byte, compressBlockBound)
 	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
+	if tmpData, err = compress.CompressWithLevel(buf, w.compressBuf[:compressBlockBound], int(w.compressAlg), w.compressLevel); err != nil {
 		return
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(w.compressAlg, offset, length, uint32(dataLen))
 	return
 }
 
@@ -439,7 +480,8 @@ func (w *objectWriterV1) AddBlock(blockMeta BlockObject, bat *batch.Batch, seqnu
 		buf.Reset()
 		h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
 		buf.Write(EncodeIOEntryHeader(&h))
-		err := vec.MarshalBinaryWithBuffer(&buf)
+		encoding := ChooseColumnEncoding(vec)
+		err := EncodeColumn(vec, encoding, &buf)
 		if err != nil {
 			return err
 		}
@@ -450,6 +492,7 @@ func (w *objectWriterV1) AddBlock(blockMeta BlockObject, bat *batch.Batch, seqnu
 		block.data = append(block.data, data)
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setLocation(ext)
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setDataType(uint8(vec.GetType().Oid))
+		blockMeta.ColumnMeta(seqnums.Seqs[i]).setEncoding(encoding)
 		if vec.GetType().Oid == types.T_any {
 			panic("any type batch")
 		}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -185,6 +186,45 @@ func TestNewObjectWriter(t *testing.T) {
 	assert.Equal(t, uint8(0xa), buf[63])
 }
 
+func TestObjectWriterCompression(t *testing.T) {
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	assert.Nil(t, err)
+
+	// the objects written by different algorithms are all readable
+	for i, alg := range []uint8{compress.Lz4, compress.Zstd, compress.None} {
+		name := fmt.Sprintf("%d.blk", i)
+		objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		assert.Nil(t, err)
+		objectWriter.SetCompression(alg, compress.MaxZstdLevel)
+		_, err = objectWriter.Write(bat)
+		assert.Nil(t, err)
+		blocks, err := objectWriter.WriteEnd(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, alg, blocks[0].BlockHeader().MetaLocation().Alg())
+
+		objectReader, err := NewObjectReaderWithStr(name, service)
+		assert.Nil(t, err)
+		meta, err := objectReader.ReadAllMeta(context.Background(), mp)
+		assert.Nil(t, err)
+		assert.Equal(t, alg, meta.GetBlockMeta(0).MustGetColumn(3).Location().Alg())
+		vec, err := objectReader.ReadOneBlock(context.Background(), []uint16{3}, nil, 0, mp)
+		assert.Nil(t, err)
+		obj, err := Decode(vec.Entries[0].ObjectBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, vector.MustFixedCol[int64](bat.Vecs[3]), vector.MustFixedCol[int64](obj.(*vector.Vector)))
+	}
+}
+
 func getObjectMeta(t *testing.B) ObjectMeta {
 	dir := InitTestEnv(ModuleName, t.Name())
 	dir = path.Join(dir, "/local")
@@ -66,6 +66,9 @@ const (
 	AlterKind_RenameTable      AlterKind = 3
 	AlterKind_UpdateComment    AlterKind = 4
 	AlterKind_UpdateConstraint AlterKind = 5
+	AlterKind_RenameColumn     AlterKind = 6
+	AlterKind_ModifyColumn     AlterKind = 7
+	AlterKind_UpdatePartition  AlterKind = 8
 )
 
 var AlterKind_name = map[int32]string{
@@ -75,6 +78,9 @@ var AlterKind_name = map[int32]string{
 	3: "RenameTable",
 	4: "UpdateComment",
 	5: "UpdateConstraint",
+	6: "RenameColumn",
+	7: "ModifyColumn",
+	8: "UpdatePartition",
 }
 
 var AlterKind_value = map[string]int32{
@@ -84,6 +90,9 @@ var AlterKind_value = map[string]int32{
 	"RenameTable":      3,
 	"UpdateComment":    4,
 	"UpdateConstraint": 5,
+	"RenameColumn":     6,
+	"ModifyColumn":     7,
+	"UpdatePartition":  8,
 }
 
 func (x AlterKind) String() string {
@@ -100,18 +109,22 @@ const (
 	Entry_Insert Entry_EntryType = 0
 	Entry_Delete Entry_EntryType = 1
 	Entry_Update Entry_EntryType = 2
+	// Alter carries the AlterTableReq on mo_tables
+	Entry_Alter Entry_EntryType = 3
 )
 
 var Entry_EntryType_name = map[int32]string{
 	0: "Insert",
 	1: "Delete",
 	2: "Update",
+	3: "Alter",
 }
 
 var Entry_EntryType_value = map[string]int32{
 	"Insert": 0,
 	"Delete": 1,
 	"Update": 2,
+	"Alter":  3,
 }
 
 func (x Entry_EntryType) String() string {
@@ -1077,6 +1090,181 @@ func (m *AlterTableDropColumn) GetSequenceNum() uint32 {
 	return 0
 }
 
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	SequenceNum          uint32   `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
+}
+func (m *AlterTableRenameColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
+
+func (m *AlterTableRenameColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetSequenceNum() uint32 {
+	if m != nil {
+		return m.SequenceNum
+	}
+	return 0
+}
+
+type AlterTableModifyColumn struct {
+	SequenceNum uint32 `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// the new definition of the column, the data of the column is kept as it is,
+	// so only the changes which don't touch the stored values are allowed.
+	Column               *plan.ColDef `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AlterTableModifyColumn) Reset()         { *m = AlterTableModifyColumn{} }
+func (m *AlterTableModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyColumn) ProtoMessage()    {}
+func (*AlterTableModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+}
+func (m *AlterTableModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyColumn.Merge(m, src)
+}
+func (m *AlterTableModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyColumn proto.InternalMessageInfo
+
+func (m *AlterTableModifyColumn) GetSequenceNum() uint32 {
+	if m != nil {
+		return m.SequenceNum
+	}
+	return 0
+}
+
+func (m *AlterTableModifyColumn) GetColumn() *plan.ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+type AlterTablePartition struct {
+	Partitioned          int32    `protobuf:"varint,1,opt,name=partitioned,proto3" json:"partitioned,omitempty"`
+	Partition            string   `protobuf:"bytes,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetPartitioned() int32 {
+	if m != nil {
+		return m.Partitioned
+	}
+	return 0
+}
+
+func (m *AlterTablePartition) GetPartition() string {
+	if m != nil {
+		return m.Partition
+	}
+	return ""
+}
+
 type AlterTableReq struct {
 	TableId uint64    `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	DbId    uint64    `protobuf:"varint,2,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
@@ -1087,6 +1275,9 @@ type AlterTableReq struct {
 	//	*AlterTableReq_RenameTable
 	//	*AlterTableReq_UpdateComment
 	//	*AlterTableReq_UpdateCstr
+	//	*AlterTableReq_RenameColumn
+	//	*AlterTableReq_ModifyColumn
+	//	*AlterTableReq_UpdatePartition
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1097,7 +1288,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,12 +1338,24 @@ type AlterTableReq_UpdateComment struct {
 type AlterTableReq_UpdateCstr struct {
 	UpdateCstr *AlterTableConstraint `protobuf:"bytes,8,opt,name=update_cstr,json=updateCstr,proto3,oneof" json:"update_cstr,omitempty"`
 }
+type AlterTableReq_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,9,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTableReq_ModifyColumn struct {
+	ModifyColumn *AlterTableModifyColumn `protobuf:"bytes,10,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTableReq_UpdatePartition struct {
+	UpdatePartition *AlterTablePartition `protobuf:"bytes,11,opt,name=update_partition,json=updatePartition,proto3,oneof" json:"update_partition,omitempty"`
+}
 
-func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()   {}
-func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation() {}
-func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()       {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation()   {}
+func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameColumn) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_ModifyColumn) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_UpdatePartition) isAlterTableReq_Operation() {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1217,6 +1420,27 @@ func (m *AlterTableReq) GetUpdateCstr() *AlterTableConstraint {
 	return nil
 }
 
+func (m *AlterTableReq) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetModifyColumn() *AlterTableModifyColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetUpdatePartition() *AlterTablePartition {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdatePartition); ok {
+		return x.UpdatePartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1225,6 +1449,9 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_RenameTable)(nil),
 		(*AlterTableReq_UpdateComment)(nil),
 		(*AlterTableReq_UpdateCstr)(nil),
+		(*AlterTableReq_RenameColumn)(nil),
+		(*AlterTableReq_ModifyColumn)(nil),
+		(*AlterTableReq_UpdatePartition)(nil),
 	}
 }
 
@@ -1244,7 +1471,7 @@ func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1540,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1368,6 +1595,9 @@ func init() {
 	proto.RegisterType((*AlterTableRenameTable)(nil), "api.AlterTableRenameTable")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "api.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTableModifyColumn)(nil), "api.AlterTableModifyColumn")
+	proto.RegisterType((*AlterTablePartition)(nil), "api.AlterTablePartition")
 	proto.RegisterType((*AlterTableReq)(nil), "api.AlterTableReq")
 	proto.RegisterType((*SchemaExtra)(nil), "api.SchemaExtra")
 	proto.RegisterType((*Int64Map)(nil), "api.Int64Map")
@@ -1377,91 +1607,100 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0x17, 0xf5, 0x5f, 0x43, 0x49, 0xa6, 0x37, 0x7e, 0x0f, 0x8a, 0xdf, 0x7b, 0x8e, 0x1e, 0xd3,
-	0xa6, 0x6e, 0xda, 0xd8, 0x80, 0x13, 0x14, 0x69, 0x11, 0x24, 0x88, 0xe5, 0xa0, 0x16, 0x1a, 0xc7,
-	0x01, 0xe3, 0x24, 0x40, 0x50, 0x80, 0x58, 0x91, 0x1b, 0x79, 0x21, 0x72, 0xb9, 0x26, 0x57, 0x8e,
-	0x75, 0x6f, 0xbf, 0x40, 0xcf, 0x3d, 0xf4, 0x5a, 0xf4, 0x8b, 0xf4, 0x52, 0xa0, 0x1f, 0xa1, 0x48,
-	0x2f, 0x6d, 0x3f, 0x45, 0xb1, 0xb3, 0xa4, 0xfe, 0xa4, 0x6d, 0xae, 0xb9, 0x08, 0x33, 0xbf, 0x99,
-	0x59, 0xcd, 0xce, 0xfc, 0x38, 0xb3, 0xd0, 0xa2, 0x92, 0xef, 0xc8, 0x34, 0x51, 0x09, 0xa9, 0x50,
-	0xc9, 0x37, 0x6f, 0x8c, 0xb9, 0x3a, 0x9d, 0x8e, 0x76, 0x82, 0x24, 0xde, 0x1d, 0x27, 0xe3, 0x64,
-	0x17, 0x6d, 0xa3, 0xe9, 0x4b, 0xd4, 0x50, 0x41, 0xc9, 0xc4, 0x6c, 0xae, 0x29, 0x1e, 0xb3, 0x4c,
-	0xd1, 0x58, 0xe6, 0x00, 0xc8, 0x88, 0x0a, 0x23, 0xbb, 0x3f, 0x58, 0x50, 0x7f, 0xc6, 0x02, 0x95,
-	0xa4, 0x84, 0x40, 0x35, 0xa4, 0x8a, 0xf6, 0xac, 0xbe, 0xb5, 0xdd, 0xf6, 0x50, 0x26, 0x5b, 0x50,
-	0x55, 0x33, 0xc9, 0x7a, 0xe5, 0xbe, 0xb5, 0x6d, 0xef, 0xc1, 0x0e, 0x46, 0x9e, 0xcc, 0x24, 0xf3,
-	0x10, 0x27, 0x9b, 0xd0, 0x14, 0xd3, 0x28, 0xa2, 0xa3, 0x88, 0xf5, 0x2a, 0x7d, 0x6b, 0xbb, 0xe9,
-	0xcd, 0x75, 0xe2, 0x40, 0x45, 0x64, 0xb2, 0x57, 0xc5, 0xe3, 0xb4, 0x48, 0x2e, 0x43, 0x93, 0x67,
-	0x7e, 0x90, 0x88, 0x4c, 0xf5, 0x6a, 0xe8, 0xdd, 0xe0, 0xd9, 0x40, 0xab, 0xda, 0x39, 0x62, 0xa2,
-	0x57, 0xef, 0x5b, 0xdb, 0x1d, 0x4f, 0x8b, 0x3a, 0x1d, 0x9a, 0x32, 0xda, 0x6b, 0x98, 0x74, 0xb4,
-	0xec, 0xde, 0x85, 0xda, 0x3e, 0x55, 0xc1, 0x29, 0xd9, 0x80, 0x1a, 0x55, 0x2a, 0xcd, 0x7a, 0x56,
-	0xbf, 0xb2, 0xdd, 0xf2, 0x8c, 0x42, 0xae, 0x40, 0xf5, 0x9c, 0x05, 0x59, 0xaf, 0xdc, 0xaf, 0x6c,
-	0xdb, 0x7b, 0xf6, 0x8e, 0xae, 0x9b, 0xb9, 0x9c, 0x87, 0x06, 0xf7, 0x19, 0x34, 0x4e, 0x74, 0x6e,
-	0xc3, 0x03, 0x72, 0x09, 0x6a, 0xe1, 0xc8, 0xe7, 0x21, 0x5e, 0xb7, 0xea, 0x55, 0xc3, 0xd1, 0x30,
-	0xd4, 0xa0, 0x42, 0xb0, 0x6c, 0x40, 0xa5, 0xc1, 0xff, 0x43, 0x5b, 0xd2, 0x54, 0x71, 0xc5, 0x13,
-	0xa1, 0x6d, 0x15, 0xb4, 0xd9, 0x73, 0x6c, 0x18, 0xba, 0xdf, 0x58, 0xd0, 0x7d, 0x32, 0x13, 0xc1,
-	0xc3, 0x64, 0x7c, 0x42, 0x79, 0xe4, 0xb1, 0x33, 0x72, 0x03, 0x1a, 0x81, 0xf0, 0x4f, 0xe9, 0x39,
-	0xc3, 0x7f, 0xb0, 0xf7, 0x36, 0x76, 0x16, 0x7d, 0x38, 0x29, 0x24, 0xaf, 0x1e, 0x88, 0x43, 0x7a,
-	0xce, 0x72, 0xf7, 0x57, 0x54, 0xa8, 0xbc, 0xd6, 0xff, 0xe8, 0xfe, 0x9c, 0x0a, 0x45, 0x5c, 0xa8,
-	0xa9, 0x79, 0xd1, 0xed, 0xbd, 0x36, 0x5e, 0x35, 0xbf, 0x9a, 0x67, 0x4c, 0xee, 0x97, 0xb0, 0xb6,
-	0x92, 0x53, 0x26, 0xf5, 0x55, 0x82, 0x89, 0xf4, 0xa3, 0x24, 0xa0, 0x3a, 0x73, 0xcc, 0xac, 0xe5,
-	0xd9, 0xc1, 0x44, 0x3e, 0xcc, 0x21, 0x72, 0x0d, 0x9a, 0x41, 0x12, 0xc7, 0x54, 0x84, 0x45, 0x1d,
-	0x01, 0x0f, 0x7f, 0x20, 0x54, 0x3a, 0xf3, 0xe6, 0x36, 0xf7, 0x2e, 0xac, 0x3f, 0x4e, 0x99, 0x56,
-	0xb9, 0x7a, 0x9e, 0x72, 0xc5, 0x06, 0x71, 0x48, 0x3e, 0x04, 0x60, 0xda, 0xcf, 0x8f, 0x78, 0xa6,
-	0xb0, 0x37, 0xab, 0xe1, 0x2d, 0xb4, 0x3e, 0xe4, 0x99, 0x72, 0x7f, 0x2a, 0x43, 0x0d, 0x41, 0x72,
-	0xb3, 0x08, 0x42, 0xa6, 0xe9, 0x94, 0xba, 0x7b, 0x1b, 0x8b, 0x20, 0xf3, 0x8b, 0x9c, 0x33, 0xe1,
-	0x5a, 0xd4, 0x54, 0xc2, 0x5b, 0x2e, 0x9a, 0xd5, 0x40, 0x7d, 0x18, 0x92, 0x2b, 0x60, 0x6b, 0xee,
-	0x8e, 0x68, 0xc6, 0x16, 0xed, 0x82, 0x02, 0x1a, 0x86, 0xe4, 0x7f, 0x00, 0x26, 0x56, 0xd0, 0x98,
-	0x21, 0x3f, 0x5b, 0x5e, 0x0b, 0x91, 0x47, 0x34, 0x66, 0xe4, 0x2a, 0x74, 0xe6, 0xf1, 0xe8, 0x51,
-	0x43, 0x8f, 0x76, 0x01, 0xa2, 0xd3, 0x7f, 0xa0, 0xf5, 0x92, 0x17, 0x47, 0xd4, 0xd1, 0xa1, 0xa9,
-	0x01, 0x34, 0xfe, 0x17, 0x2a, 0x23, 0xaa, 0x90, 0xb9, 0xc5, 0xfd, 0x91, 0xb6, 0x9e, 0x86, 0xc9,
-	0x55, 0xe8, 0xca, 0x89, 0x1f, 0x9c, 0xb2, 0x60, 0xe2, 0x8f, 0x66, 0x7e, 0x28, 0x7a, 0xcd, 0xbe,
-	0xb5, 0x5d, 0xf3, 0x6c, 0x39, 0x19, 0x68, 0x70, 0x7f, 0x76, 0x20, 0xdc, 0x5d, 0x68, 0xcd, 0xef,
-	0x4d, 0x00, 0xea, 0x43, 0x91, 0xb1, 0x54, 0x39, 0x25, 0x2d, 0x1f, 0xb0, 0x88, 0x29, 0xe6, 0x58,
-	0x5a, 0x7e, 0x2a, 0x43, 0xaa, 0x98, 0x53, 0x76, 0xbf, 0xb2, 0x00, 0x30, 0x5c, 0x26, 0x5c, 0x28,
-	0xf2, 0x11, 0xd4, 0x63, 0x2e, 0x7c, 0x95, 0xbd, 0x95, 0x7d, 0xb5, 0x98, 0x8b, 0x93, 0x0c, 0x9d,
-	0xe9, 0x85, 0x76, 0x2e, 0xbf, 0xd5, 0x99, 0x5e, 0x9c, 0x64, 0xc5, 0xe5, 0x2a, 0x7f, 0x7b, 0x39,
-	0x93, 0x06, 0x55, 0x34, 0x4a, 0xc6, 0x83, 0x89, 0x7c, 0x67, 0x69, 0x7c, 0x6d, 0x81, 0x7d, 0xc4,
-	0x14, 0xd5, 0x3d, 0x7b, 0x97, 0x79, 0xdc, 0x86, 0x8d, 0xfb, 0x91, 0x62, 0x29, 0x7e, 0x9a, 0x38,
-	0xe9, 0x52, 0xaa, 0xdb, 0xd3, 0x07, 0x3b, 0x98, 0x6b, 0x59, 0x3e, 0x72, 0x97, 0x21, 0xf7, 0x06,
-	0xac, 0x2f, 0x47, 0xc6, 0x31, 0x13, 0x8a, 0xf4, 0xa0, 0x11, 0x18, 0x31, 0xff, 0x74, 0x0b, 0xd5,
-	0x3d, 0x82, 0x7f, 0x2d, 0xdc, 0x3d, 0xa6, 0x69, 0x89, 0xa2, 0xfe, 0x50, 0x92, 0x28, 0x34, 0x3c,
-	0xcd, 0x63, 0x92, 0x28, 0x44, 0x9a, 0x5e, 0x86, 0xa6, 0x60, 0xaf, 0x8c, 0xa9, 0x6c, 0x4c, 0x82,
-	0xbd, 0xd2, 0x26, 0x37, 0x84, 0x4b, 0x8b, 0xe3, 0xee, 0x87, 0xe1, 0x20, 0x89, 0xa6, 0xb1, 0x20,
-	0xef, 0x41, 0x3d, 0x40, 0x29, 0x2f, 0x63, 0xdb, 0x2c, 0x84, 0x41, 0x12, 0x1d, 0xb0, 0x97, 0x5e,
-	0x6e, 0x23, 0x1f, 0xc0, 0x1a, 0x47, 0xba, 0xfa, 0x32, 0xc9, 0x70, 0x44, 0xe2, 0xf1, 0x35, 0xaf,
-	0x6b, 0xe0, 0xc7, 0x39, 0xea, 0xbe, 0x58, 0xae, 0xce, 0x41, 0x9a, 0xc8, 0xfc, 0x6f, 0xae, 0x80,
-	0x1d, 0x25, 0x63, 0x1e, 0xd0, 0xc8, 0xe7, 0xe1, 0x05, 0xfe, 0x57, 0xc7, 0x83, 0x1c, 0x1a, 0x86,
-	0x17, 0x7a, 0x8e, 0x65, 0xec, 0x6c, 0xca, 0x44, 0xc0, 0x7c, 0x31, 0x8d, 0xf1, 0xf8, 0x8e, 0x67,
-	0x17, 0xd8, 0xa3, 0x69, 0xec, 0x7e, 0x5f, 0x81, 0xce, 0x72, 0x45, 0xce, 0x56, 0x46, 0x86, 0xb5,
-	0x3a, 0x32, 0xe6, 0xcb, 0xa0, 0xbc, 0xb4, 0x0c, 0x5c, 0xa8, 0x4e, 0xb8, 0x30, 0x03, 0xa4, 0xbb,
-	0xd7, 0xc5, 0xd6, 0xe2, 0x89, 0x5f, 0x70, 0x11, 0x7a, 0x68, 0x23, 0x9f, 0x02, 0xd0, 0x30, 0xf4,
-	0xf3, 0xa2, 0x54, 0xb1, 0x28, 0xbd, 0x85, 0xe7, 0x6a, 0xf9, 0x0e, 0x4b, 0x5e, 0x8b, 0xce, 0x6b,
-	0x79, 0x07, 0xec, 0x30, 0x4d, 0x64, 0x11, 0x5b, 0xc3, 0xd8, 0xcb, 0x6f, 0xc4, 0x2e, 0x8a, 0x72,
-	0x58, 0xf2, 0x20, 0x5c, 0x94, 0xe8, 0x1e, 0xb4, 0x53, 0xec, 0xb2, 0x6f, 0xf6, 0x40, 0x1d, 0xc3,
-	0x37, 0xdf, 0x08, 0x5f, 0x22, 0xc2, 0x61, 0xc9, 0xb3, 0xd3, 0x25, 0x5e, 0xdc, 0x83, 0xee, 0x14,
-	0x67, 0x87, 0x5f, 0x30, 0xca, 0x8c, 0xab, 0x7f, 0xbf, 0x71, 0x44, 0x4e, 0xbd, 0xc3, 0x92, 0xd7,
-	0x31, 0xfe, 0x05, 0x17, 0xef, 0x80, 0x5d, 0x1c, 0x90, 0xa9, 0x14, 0x67, 0xd8, 0x5f, 0xf3, 0x5f,
-	0x50, 0x5e, 0xe7, 0x9f, 0x1f, 0x90, 0xa9, 0x74, 0xdf, 0x86, 0x56, 0x22, 0x59, 0x8a, 0x3b, 0xc7,
-	0xfd, 0xd6, 0x02, 0xfb, 0x49, 0x70, 0xca, 0x62, 0xfa, 0xe0, 0x42, 0xa5, 0x94, 0x5c, 0x83, 0x35,
-	0xc1, 0x2e, 0x94, 0x2e, 0x8d, 0x9f, 0xb1, 0x33, 0xdd, 0x61, 0xc3, 0x81, 0x8e, 0x86, 0x07, 0x49,
-	0xf4, 0x04, 0x41, 0x9c, 0xd4, 0x69, 0x22, 0x25, 0x0b, 0x7d, 0xf3, 0x1a, 0x28, 0xe3, 0x6b, 0xa0,
-	0x9d, 0x83, 0xf7, 0xf1, 0x51, 0xf0, 0x3e, 0x74, 0x4d, 0x89, 0xfd, 0xe0, 0x94, 0x8a, 0x31, 0x0b,
-	0xf3, 0x87, 0x4a, 0xc7, 0xa0, 0x03, 0x03, 0xae, 0x7c, 0x27, 0xd5, 0x95, 0xef, 0xc4, 0x0d, 0xa1,
-	0x39, 0x14, 0xea, 0x93, 0x5b, 0x47, 0x54, 0x12, 0x17, 0xac, 0x38, 0x5f, 0x6c, 0x66, 0x47, 0x15,
-	0x96, 0x9d, 0x23, 0xb3, 0xe2, 0xac, 0x78, 0xf3, 0x16, 0xd4, 0x8d, 0xa2, 0x5f, 0x35, 0x13, 0x36,
-	0xc3, 0xe4, 0x2b, 0x9e, 0x16, 0xf5, 0xc3, 0xe5, 0x9c, 0x46, 0x53, 0xf3, 0xc1, 0x55, 0x3c, 0xa3,
-	0x7c, 0x56, 0xbe, 0x6d, 0x5d, 0x3f, 0x80, 0xfa, 0xb1, 0x1c, 0x24, 0x21, 0x23, 0x0d, 0xa8, 0x3c,
-	0x4a, 0xa4, 0x53, 0x22, 0xeb, 0xd0, 0x3e, 0x96, 0x9f, 0x33, 0x95, 0xaf, 0x70, 0xe7, 0xb7, 0x06,
-	0x69, 0x43, 0xe3, 0x58, 0xe2, 0xbe, 0x75, 0x7e, 0x6f, 0x10, 0x07, 0xec, 0x63, 0xf9, 0x38, 0xc5,
-	0x9e, 0x70, 0xe5, 0xfc, 0xd1, 0xb8, 0x7e, 0x06, 0xad, 0x39, 0x47, 0x89, 0x0d, 0x8d, 0xa1, 0x38,
-	0xa7, 0x11, 0x0f, 0x9d, 0x12, 0xe9, 0x40, 0x6b, 0xce, 0x44, 0xc7, 0x22, 0x5d, 0x80, 0x05, 0xb9,
-	0x9c, 0x32, 0x59, 0x03, 0x7b, 0x89, 0x2d, 0x4e, 0x85, 0xac, 0x43, 0xe7, 0xe9, 0x72, 0xc3, 0x9d,
-	0x2a, 0xd9, 0x00, 0xa7, 0x80, 0x8a, 0xb6, 0x3a, 0xb5, 0xfd, 0xbb, 0x3f, 0xbe, 0xde, 0xb2, 0x7e,
-	0x7e, 0xbd, 0x65, 0xfd, 0xf2, 0x7a, 0xab, 0xf4, 0xdd, 0xaf, 0x5b, 0xd6, 0x8b, 0x8f, 0x97, 0x1e,
-	0xa8, 0x31, 0x55, 0x29, 0xbf, 0x48, 0x52, 0x3e, 0xe6, 0xa2, 0x50, 0x04, 0xdb, 0x95, 0x93, 0xf1,
-	0xae, 0x1c, 0xed, 0x52, 0xc9, 0x47, 0x75, 0x7c, 0x89, 0xde, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff,
-	0xd4, 0x85, 0xf2, 0xcf, 0xe7, 0x0a, 0x00, 0x00,
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x17, 0xf5, 0xcd, 0x47, 0x49, 0xa6, 0x27, 0x6e, 0xa0, 0x38, 0xa9, 0xa3, 0x32, 0x6d, 0xea,
+	0xa6, 0x8d, 0x0d, 0x38, 0x41, 0x91, 0x06, 0x45, 0x82, 0x58, 0x0e, 0x6a, 0xa1, 0x71, 0x6c, 0x30,
+	0x76, 0x02, 0x04, 0x05, 0x88, 0x11, 0x39, 0x96, 0x09, 0x91, 0xc3, 0x31, 0x39, 0x72, 0xac, 0x7b,
+	0xfb, 0x0f, 0xf4, 0xdc, 0x43, 0xcf, 0xbb, 0xff, 0xc8, 0x1e, 0xf7, 0xb8, 0xc7, 0x45, 0xf6, 0xb2,
+	0xbb, 0x7f, 0xc1, 0x1e, 0x17, 0xf3, 0xf8, 0x21, 0xfa, 0x63, 0x73, 0xd8, 0x4b, 0x2e, 0xc2, 0x7b,
+	0xbf, 0xf7, 0xc1, 0xf7, 0x66, 0x7e, 0xf3, 0x66, 0x04, 0x3a, 0x15, 0xfe, 0x86, 0x88, 0x23, 0x19,
+	0x91, 0x1a, 0x15, 0xfe, 0xea, 0xc3, 0x89, 0x2f, 0x4f, 0x66, 0xe3, 0x0d, 0x37, 0x0a, 0x37, 0x27,
+	0xd1, 0x24, 0xda, 0x44, 0xdb, 0x78, 0x76, 0x8c, 0x1a, 0x2a, 0x28, 0xa5, 0x31, 0xab, 0x4b, 0xd2,
+	0x0f, 0x59, 0x22, 0x69, 0x28, 0x32, 0x00, 0x44, 0x40, 0x79, 0x2a, 0x5b, 0x5f, 0x6a, 0xd0, 0x7c,
+	0xcb, 0x5c, 0x19, 0xc5, 0x84, 0x40, 0xdd, 0xa3, 0x92, 0xf6, 0xb5, 0x81, 0xb6, 0xde, 0xb1, 0x51,
+	0x26, 0x6b, 0x50, 0x97, 0x73, 0xc1, 0xfa, 0xd5, 0x81, 0xb6, 0x6e, 0x6c, 0xc1, 0x06, 0x46, 0x1e,
+	0xce, 0x05, 0xb3, 0x11, 0x27, 0xab, 0xd0, 0xe6, 0xb3, 0x20, 0xa0, 0xe3, 0x80, 0xf5, 0x6b, 0x03,
+	0x6d, 0xbd, 0x6d, 0x17, 0x3a, 0x31, 0xa1, 0xc6, 0x13, 0xd1, 0xaf, 0x63, 0x3a, 0x25, 0x92, 0x5b,
+	0xd0, 0xf6, 0x13, 0xc7, 0x8d, 0x78, 0x22, 0xfb, 0x0d, 0xf4, 0x6e, 0xf9, 0xc9, 0x50, 0xa9, 0xca,
+	0x39, 0x60, 0xbc, 0xdf, 0x1c, 0x68, 0xeb, 0x5d, 0x5b, 0x89, 0xaa, 0x1c, 0x1a, 0x33, 0xda, 0x6f,
+	0xa5, 0xe5, 0x28, 0xd9, 0x7a, 0x06, 0x8d, 0x6d, 0x2a, 0xdd, 0x13, 0xb2, 0x02, 0x0d, 0x2a, 0x65,
+	0x9c, 0xf4, 0xb5, 0x41, 0x6d, 0x5d, 0xb7, 0x53, 0x85, 0xdc, 0x85, 0xfa, 0x19, 0x73, 0x93, 0x7e,
+	0x75, 0x50, 0x5b, 0x37, 0xb6, 0x8c, 0x0d, 0xb5, 0x6e, 0x69, 0x73, 0x36, 0x1a, 0xac, 0xb7, 0xd0,
+	0x3a, 0x54, 0xb5, 0x8d, 0x76, 0xc8, 0x0d, 0x68, 0x78, 0x63, 0xc7, 0xf7, 0xb0, 0xdd, 0xba, 0x5d,
+	0xf7, 0xc6, 0x23, 0x4f, 0x81, 0x12, 0xc1, 0x6a, 0x0a, 0x4a, 0x05, 0xfe, 0x0e, 0x3a, 0x82, 0xc6,
+	0xd2, 0x97, 0x7e, 0xc4, 0x95, 0xad, 0x86, 0x36, 0xa3, 0xc0, 0x46, 0x9e, 0xf5, 0x5f, 0x0d, 0x7a,
+	0x6f, 0xe6, 0xdc, 0x7d, 0x15, 0x4d, 0x0e, 0xa9, 0x1f, 0xd8, 0xec, 0x94, 0x3c, 0x84, 0x96, 0xcb,
+	0x9d, 0x13, 0x7a, 0xc6, 0xf0, 0x0b, 0xc6, 0xd6, 0xca, 0xc6, 0x62, 0x1f, 0x0e, 0x73, 0xc9, 0x6e,
+	0xba, 0x7c, 0x97, 0x9e, 0xb1, 0xcc, 0xfd, 0x03, 0xe5, 0xb2, 0x5f, 0xfd, 0xb4, 0xfb, 0x3b, 0xca,
+	0x25, 0xb1, 0xa0, 0x21, 0x8b, 0x45, 0x37, 0xb6, 0x3a, 0xd8, 0x6a, 0xd6, 0x9a, 0x9d, 0x9a, 0xac,
+	0x7f, 0xc1, 0xd2, 0x85, 0x9a, 0x12, 0xa1, 0x5a, 0x71, 0xa7, 0xc2, 0x09, 0x22, 0x97, 0xaa, 0xca,
+	0xb1, 0x32, 0xdd, 0x36, 0xdc, 0xa9, 0x78, 0x95, 0x41, 0xe4, 0x3e, 0xb4, 0xdd, 0x28, 0x0c, 0x29,
+	0xf7, 0xf2, 0x75, 0x04, 0x4c, 0xfe, 0x92, 0xcb, 0x78, 0x6e, 0x17, 0x36, 0xeb, 0x19, 0x2c, 0x1f,
+	0xc4, 0x4c, 0xa9, 0xbe, 0x7c, 0x17, 0xfb, 0x92, 0x0d, 0x43, 0x8f, 0xfc, 0x09, 0x80, 0x29, 0x3f,
+	0x27, 0xf0, 0x13, 0xd9, 0xd7, 0xae, 0x84, 0xeb, 0x68, 0x7d, 0xe5, 0x27, 0xd2, 0xfa, 0xa6, 0x0a,
+	0x0d, 0x04, 0xc9, 0xa3, 0x3c, 0x08, 0x99, 0xa6, 0x4a, 0xea, 0x6d, 0xad, 0x2c, 0x82, 0xd2, 0x5f,
+	0xe4, 0x9c, 0xce, 0x72, 0x51, 0x51, 0x09, 0xbb, 0x5c, 0x6c, 0x56, 0x0b, 0xf5, 0x91, 0x47, 0xee,
+	0x82, 0xa1, 0xb8, 0x3b, 0xa6, 0x09, 0x5b, 0x6c, 0x17, 0xe4, 0xd0, 0xc8, 0x23, 0xbf, 0x05, 0x48,
+	0x63, 0x39, 0x0d, 0x19, 0xf2, 0x53, 0xb7, 0x75, 0x44, 0x5e, 0xd3, 0x90, 0x91, 0x7b, 0xd0, 0x2d,
+	0xe2, 0xd1, 0xa3, 0x81, 0x1e, 0x9d, 0x1c, 0x44, 0xa7, 0xdb, 0xa0, 0x1f, 0xfb, 0x79, 0x8a, 0x26,
+	0x3a, 0xb4, 0x15, 0x80, 0xc6, 0x3b, 0x50, 0x1b, 0x53, 0x89, 0xcc, 0xcd, 0xfb, 0x47, 0xda, 0xda,
+	0x0a, 0x26, 0xf7, 0xa0, 0x27, 0xa6, 0x8e, 0x7b, 0xc2, 0xdc, 0xa9, 0x33, 0x9e, 0x3b, 0x1e, 0xef,
+	0xb7, 0x07, 0xda, 0x7a, 0xc3, 0x36, 0xc4, 0x74, 0xa8, 0xc0, 0xed, 0xf9, 0x0e, 0xb7, 0x9e, 0x82,
+	0x5e, 0xf4, 0x4d, 0x00, 0x9a, 0x23, 0x9e, 0xb0, 0x58, 0x9a, 0x15, 0x25, 0xef, 0xb0, 0x80, 0x49,
+	0x66, 0x6a, 0x4a, 0x3e, 0x12, 0x1e, 0x95, 0xcc, 0xac, 0x12, 0x1d, 0x1a, 0x2f, 0x02, 0xc9, 0x62,
+	0xb3, 0x66, 0xfd, 0x5b, 0x03, 0xc0, 0x4c, 0x22, 0xf2, 0xb9, 0x24, 0x7f, 0x86, 0x66, 0xe8, 0x73,
+	0x47, 0x26, 0x9f, 0x24, 0x62, 0x23, 0xf4, 0xf9, 0x61, 0x82, 0xce, 0xf4, 0x5c, 0x39, 0x57, 0x3f,
+	0xe9, 0x4c, 0xcf, 0x0f, 0x93, 0xbc, 0xcf, 0xda, 0xb5, 0x7d, 0xa6, 0x65, 0x50, 0x49, 0x83, 0x68,
+	0x32, 0x9c, 0x8a, 0xcf, 0x56, 0xc6, 0x7f, 0x34, 0x30, 0xf6, 0x98, 0xa4, 0x6a, 0xfb, 0x3e, 0x67,
+	0x1d, 0x4f, 0x60, 0x05, 0x37, 0x08, 0x4f, 0x29, 0x0e, 0xbd, 0x98, 0xaa, 0xed, 0x19, 0x80, 0xe1,
+	0x16, 0x5a, 0x92, 0x4d, 0xdf, 0x32, 0x64, 0x3d, 0x84, 0xe5, 0x72, 0x64, 0x18, 0x32, 0x2e, 0x49,
+	0x1f, 0x5a, 0x6e, 0x2a, 0x66, 0xa7, 0x38, 0x57, 0xad, 0x3d, 0xf8, 0xcd, 0xc2, 0xdd, 0x66, 0x8a,
+	0xa1, 0x28, 0xaa, 0x33, 0x13, 0x05, 0x5e, 0x4a, 0xd9, 0x2c, 0x26, 0x0a, 0x3c, 0x64, 0xec, 0x2d,
+	0x68, 0x73, 0xf6, 0x21, 0x35, 0x55, 0x53, 0x13, 0x67, 0x1f, 0x94, 0xc9, 0xf2, 0xe0, 0xc6, 0x22,
+	0xdd, 0x0b, 0xcf, 0x1b, 0x46, 0xc1, 0x2c, 0xe4, 0xe4, 0xf7, 0xd0, 0x74, 0x51, 0xca, 0x96, 0xb1,
+	0x93, 0xde, 0x0d, 0xc3, 0x28, 0xd8, 0x61, 0xc7, 0x76, 0x66, 0x23, 0x7f, 0x84, 0x25, 0x1f, 0x99,
+	0xeb, 0x88, 0x28, 0xc1, 0x69, 0x89, 0xe9, 0x1b, 0x76, 0x2f, 0x85, 0x0f, 0x32, 0xd4, 0x7a, 0x5f,
+	0x5e, 0x9d, 0x9d, 0x38, 0x12, 0xd9, 0x67, 0xee, 0x82, 0x11, 0x44, 0x13, 0xdf, 0xa5, 0x81, 0xe3,
+	0x7b, 0xe7, 0xf8, 0xad, 0xae, 0x0d, 0x19, 0x34, 0xf2, 0xce, 0xd5, 0x48, 0x4b, 0xd8, 0xe9, 0x8c,
+	0x71, 0x97, 0x39, 0x7c, 0x16, 0x62, 0xfa, 0xae, 0x6d, 0xe4, 0xd8, 0xeb, 0x59, 0x68, 0x9d, 0xc2,
+	0xcd, 0xcb, 0x0b, 0x92, 0x65, 0xff, 0x55, 0x2b, 0x72, 0xe5, 0x93, 0xb5, 0xab, 0x9f, 0xa4, 0xe5,
+	0x4f, 0xee, 0x45, 0x9e, 0x7f, 0x3c, 0xcf, 0x3e, 0x79, 0x39, 0x58, 0xbb, 0x12, 0x5c, 0x5a, 0xda,
+	0xea, 0x2f, 0x2f, 0xad, 0x75, 0x54, 0xde, 0x97, 0x83, 0xfc, 0x32, 0x52, 0x74, 0x2a, 0x6e, 0x26,
+	0x96, 0xde, 0x6e, 0x0d, 0xbb, 0x0c, 0x91, 0x3b, 0xa0, 0x17, 0x6a, 0xd6, 0xda, 0x02, 0xb0, 0x7e,
+	0xaa, 0x43, 0xb7, 0xbc, 0x5a, 0xa7, 0x17, 0x46, 0xad, 0x76, 0x71, 0xd4, 0x16, 0x97, 0x68, 0xb5,
+	0x74, 0x89, 0x5a, 0x50, 0x9f, 0xfa, 0x3c, 0x1d, 0xbc, 0xbd, 0xad, 0x1e, 0x9e, 0x03, 0xcc, 0xf8,
+	0x4f, 0x9f, 0x7b, 0x36, 0xda, 0xc8, 0xdf, 0x00, 0xa8, 0xe7, 0x39, 0x59, 0x9b, 0x75, 0x6c, 0xb3,
+	0xbf, 0xf0, 0xbc, 0xc8, 0xb5, 0xdd, 0x8a, 0xad, 0xd3, 0x5c, 0x21, 0x7f, 0x07, 0xc3, 0x8b, 0x23,
+	0x91, 0xc7, 0x36, 0x30, 0xf6, 0xd6, 0xa5, 0xd8, 0x05, 0x83, 0x76, 0x2b, 0x36, 0x78, 0x85, 0x46,
+	0x9e, 0x43, 0x27, 0x46, 0x06, 0x38, 0xe9, 0xfd, 0xd9, 0xc4, 0xf0, 0xd5, 0x4b, 0xe1, 0xa5, 0x53,
+	0xb3, 0x5b, 0xb1, 0x8d, 0x78, 0xa1, 0x92, 0xe7, 0xd0, 0x9b, 0xe1, 0xcc, 0x75, 0xf2, 0xe3, 0x97,
+	0x8e, 0xf9, 0x9b, 0x97, 0x52, 0x64, 0xe7, 0x74, 0xb7, 0x62, 0x77, 0x53, 0xff, 0x0c, 0x50, 0xf5,
+	0xe7, 0x09, 0x12, 0x19, 0xf7, 0xdb, 0xd7, 0xd6, 0xbf, 0x98, 0x0f, 0xaa, 0xfe, 0x2c, 0x41, 0x22,
+	0x63, 0xb2, 0x0d, 0xdd, 0xac, 0xfe, 0xac, 0x7f, 0x1d, 0xe3, 0x6f, 0x5f, 0xdb, 0x40, 0xb1, 0x02,
+	0x9d, 0xb8, 0xa4, 0xab, 0x1c, 0x21, 0x52, 0x32, 0xcf, 0x01, 0xd7, 0xe6, 0x28, 0xd3, 0x56, 0xe5,
+	0x08, 0x4b, 0x3a, 0x79, 0x09, 0x66, 0xd6, 0xc5, 0x82, 0x4b, 0xc6, 0xb5, 0xdb, 0x58, 0x50, 0x73,
+	0xb7, 0x62, 0x2f, 0xa5, 0x31, 0x05, 0xb4, 0x6d, 0x80, 0x1e, 0x09, 0x16, 0xe3, 0xd3, 0xc3, 0xfa,
+	0x9f, 0x06, 0xc6, 0x1b, 0xf7, 0x84, 0x85, 0xf4, 0xe5, 0xb9, 0x8c, 0x29, 0xb9, 0x0f, 0x4b, 0x9c,
+	0x9d, 0x4b, 0x55, 0xa5, 0x93, 0xb0, 0xd3, 0xc5, 0x69, 0xe9, 0x2a, 0x78, 0x18, 0x05, 0x6f, 0x10,
+	0xc4, 0x0b, 0x3b, 0x8e, 0x84, 0x60, 0x9e, 0x93, 0x3e, 0x0a, 0xab, 0xf8, 0x28, 0xec, 0x64, 0xe0,
+	0x0b, 0x85, 0x91, 0x3f, 0x40, 0x2f, 0xed, 0xd6, 0x71, 0x4f, 0x28, 0x9f, 0x30, 0x2f, 0x7b, 0xaf,
+	0x76, 0x53, 0x74, 0x98, 0x82, 0x17, 0x26, 0x42, 0xfd, 0xc2, 0x44, 0xb0, 0x3c, 0x68, 0x8f, 0xb8,
+	0xfc, 0xeb, 0xe3, 0x3d, 0x2a, 0x88, 0x05, 0x5a, 0x98, 0xbd, 0x6f, 0xd2, 0xa7, 0x4a, 0x6e, 0xd9,
+	0xd8, 0x4b, 0x5f, 0x3a, 0x5a, 0xb8, 0xfa, 0x18, 0x9a, 0xa9, 0xa2, 0x1e, 0xb7, 0x53, 0x36, 0xc7,
+	0xe2, 0x6b, 0xb6, 0x12, 0xd5, 0xfb, 0xf5, 0x8c, 0x06, 0xb3, 0x74, 0xb4, 0xd4, 0xec, 0x54, 0x79,
+	0x5a, 0x7d, 0xa2, 0x3d, 0xd8, 0x81, 0xe6, 0xbe, 0x18, 0x46, 0x1e, 0x23, 0x2d, 0xa8, 0xbd, 0x8e,
+	0x84, 0x59, 0x21, 0xcb, 0xd0, 0xd9, 0x17, 0xff, 0x60, 0x32, 0x7b, 0xc9, 0x99, 0xdf, 0xb7, 0x48,
+	0x07, 0x5a, 0xfb, 0x02, 0x9f, 0x5d, 0xe6, 0x0f, 0x2d, 0x62, 0x82, 0xb1, 0x2f, 0x0e, 0x62, 0xa4,
+	0x98, 0x2f, 0xcd, 0x1f, 0x5b, 0x0f, 0xbe, 0xd0, 0x40, 0x2f, 0xce, 0x1c, 0x31, 0xa0, 0x35, 0xe2,
+	0x67, 0x34, 0xf0, 0x3d, 0xb3, 0x42, 0xba, 0xa0, 0x17, 0x27, 0xcb, 0xd4, 0x48, 0x0f, 0x60, 0x71,
+	0x58, 0xcc, 0x2a, 0x59, 0x02, 0xa3, 0xc4, 0x7e, 0xb3, 0x46, 0x96, 0xa1, 0x7b, 0x54, 0x26, 0xb0,
+	0x59, 0x27, 0x2b, 0x60, 0xe6, 0x50, 0x4e, 0x53, 0xb3, 0x41, 0x4c, 0xe8, 0x94, 0x69, 0x67, 0x36,
+	0x15, 0x52, 0x26, 0x91, 0xd9, 0x22, 0x37, 0x60, 0xe9, 0xe8, 0x22, 0x05, 0xcc, 0xf6, 0xf6, 0xb3,
+	0xaf, 0x3e, 0xae, 0x69, 0x5f, 0x7f, 0x5c, 0xd3, 0xbe, 0xfd, 0xb8, 0x56, 0xf9, 0xff, 0x77, 0x6b,
+	0xda, 0xfb, 0xbf, 0x94, 0xfe, 0xe1, 0x84, 0x54, 0xc6, 0xfe, 0x79, 0x14, 0xfb, 0x13, 0x9f, 0xe7,
+	0x0a, 0x67, 0x9b, 0x62, 0x3a, 0xd9, 0x14, 0xe3, 0x4d, 0x2a, 0xfc, 0x71, 0x13, 0xff, 0xca, 0x3c,
+	0xfa, 0x79, 0x00, 0x4a, 0x8e, 0x72, 0x72, 0x28, 0x0d, 0x00, 0x00,
 }
 
 func (m *Vector) Marshal() (dAtA []byte, err error) {
@@ -2252,6 +2491,135 @@ func (m *AlterTableDropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableModifyColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partition) > 0 {
+		i -= len(m.Partition)
+		copy(dAtA[i:], m.Partition)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Partition)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Partitioned != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Partitioned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableReq) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2408,14 +2776,77 @@ func (m *AlterTableReq_UpdateCstr) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableReq_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_UpdatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePartition != nil {
+		{
+			size, err := m.UpdatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *SchemaExtra) MarshalTo(dAtA []byte) (int, error) {
@@ -2866,6 +3297,67 @@ func (m *AlterTableDropColumn) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SequenceNum != 0 {
+		n += 1 + sovApi(uint64(m.SequenceNum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		n += 1 + sovApi(uint64(m.SequenceNum))
+	}
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partitioned != 0 {
+		n += 1 + sovApi(uint64(m.Partitioned))
+	}
+	l = len(m.Partition)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableReq) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2950,6 +3442,42 @@ func (m *AlterTableReq_UpdateCstr) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_UpdatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePartition != nil {
+		l = m.UpdatePartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5052,7 +5580,7 @@ func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,74 +5603,17 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
-			}
-			m.TableId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TableId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
-			}
-			m.DbId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DbId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			m.Kind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Kind |= AlterKind(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5152,32 +5623,29 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTableAddColumn{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Operation = &AlterTableReq_AddColumn{v}
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5187,32 +5655,29 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTableDropColumn{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Operation = &AlterTableReq_DropColumn{v}
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
 			}
-			var msglen int
+			m.SequenceNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5222,22 +5687,427 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SequenceNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApi
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTableRenameTable{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableModifyColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableModifyColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			}
+			m.SequenceNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Column == nil {
+				m.Column = &plan.ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitioned", wireType)
+			}
+			m.Partitioned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partitioned |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			}
+			m.DbId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AlterKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableAddColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_AddColumn{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableDropColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_DropColumn{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRenameTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5313,6 +6183,111 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_UpdateCstr{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_RenameColumn{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_ModifyColumn{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdatePartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -81,18 +81,21 @@ func (LockMode) EnumDescriptor() ([]byte, []int) {
 type WaitPolicy int32
 
 const (
-	WaitPolicy_Wait     WaitPolicy = 0
-	WaitPolicy_FastFail WaitPolicy = 1
+	WaitPolicy_Wait       WaitPolicy = 0
+	WaitPolicy_FastFail   WaitPolicy = 1
+	WaitPolicy_SkipLocked WaitPolicy = 2
 )
 
 var WaitPolicy_name = map[int32]string{
 	0: "Wait",
 	1: "FastFail",
+	2: "SkipLocked",
 }
 
 var WaitPolicy_value = map[string]int32{
-	"Wait":     0,
-	"FastFail": 1,
+	"Wait":       0,
+	"FastFail":   1,
+	"SkipLocked": 2,
 }
 
 func (x WaitPolicy) String() string {
@@ -937,6 +940,7 @@ type UnlockRequest struct {
 	// CommitTS is the commit timestamp of the current txn. Empty if txn is
 	// roll backed
 	CommitTS             timestamp.Timestamp `protobuf:"bytes,2,opt,name=CommitTS,proto3" json:"CommitTS"`
+	Rows                 [][]byte            `protobuf:"bytes,3,rep,name=Rows,proto3" json:"Rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1338,6 +1342,7 @@ type Result struct {
 	// under the RC isolation level, this timestamp ensures that the latest data
 	// is always read.
 	Timestamp            timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp"`
+	Skipped              []int32             `protobuf:"varint,5,rep,packed,name=Skipped,proto3" json:"Skipped,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1404,6 +1409,13 @@ func (m *Result) GetTimestamp() timestamp.Timestamp {
 	return timestamp.Timestamp{}
 }
 
+func (m *Result) GetSkipped() []int32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("lock.Granularity", Granularity_name, Granularity_value)
 	proto.RegisterEnum("lock.LockMode", LockMode_name, LockMode_value)
@@ -1434,76 +1446,77 @@ func init() {
 func init() { proto.RegisterFile("lock.proto", fileDescriptor_164ad2988c7acaf1) }
 
 var fileDescriptor_164ad2988c7acaf1 = []byte{
-	// 1100 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcd, 0x4f, 0x1b, 0x47,
-	0x14, 0xc0, 0x59, 0x7b, 0x6d, 0xaf, 0x9f, 0x8d, 0x59, 0xa6, 0x90, 0x6e, 0xd3, 0x88, 0xd0, 0x15,
-	0x91, 0xa8, 0xd3, 0x62, 0x01, 0xa5, 0x8a, 0x5a, 0x25, 0x95, 0x80, 0x40, 0x08, 0xa4, 0x8e, 0x06,
-	0x37, 0x95, 0x2a, 0xf5, 0xb0, 0xb6, 0x27, 0x66, 0x85, 0xbd, 0xe3, 0xee, 0x8e, 0xc1, 0xe9, 0x5f,
-	0xd0, 0x63, 0xff, 0xa1, 0x1e, 0x7a, 0xcb, 0x31, 0x97, 0x5e, 0xab, 0x96, 0xbf, 0xa4, 0x9a, 0x8f,
-	0xfd, 0x18, 0x7f, 0x80, 0xd4, 0xdb, 0xbc, 0xcf, 0x99, 0xb7, 0xf3, 0xdb, 0xf7, 0x06, 0xa0, 0x4f,
-	0x3b, 0x97, 0x5b, 0xc3, 0x90, 0x32, 0x8a, 0x4c, 0xbe, 0xbe, 0xff, 0x65, 0xcf, 0x67, 0x17, 0xa3,
-	0xf6, 0x56, 0x87, 0x0e, 0x1a, 0x3d, 0xda, 0xa3, 0x0d, 0x61, 0x6c, 0x8f, 0xde, 0x0a, 0x49, 0x08,
-	0x62, 0x25, 0x83, 0xee, 0x2f, 0x31, 0x7f, 0x40, 0x22, 0xe6, 0x0d, 0x86, 0x52, 0xe1, 0xfe, 0x6e,
-	0x40, 0xe5, 0x8c, 0x76, 0x2e, 0x9b, 0x43, 0xe6, 0xd3, 0x20, 0x42, 0xbb, 0x50, 0x39, 0x0e, 0xbd,
-	0x60, 0xd4, 0xf7, 0x42, 0x9f, 0xbd, 0x73, 0x8c, 0x75, 0x63, 0xb3, 0xb6, 0xb3, 0xbc, 0x25, 0xf6,
-	0xcd, 0x18, 0x70, 0xd6, 0x0b, 0xb9, 0x60, 0xbe, 0xa2, 0x5d, 0xe2, 0xe4, 0x84, 0x77, 0x4d, 0x7a,
-	0xf3, 0xac, 0x5c, 0x8b, 0x85, 0x0d, 0x6d, 0x42, 0x71, 0x48, 0xfb, 0x7e, 0xe7, 0x9d, 0x93, 0x17,
-	0x5e, 0xb6, 0xf4, 0xfa, 0xd1, 0xf3, 0xd9, 0x6b, 0xa1, 0xc7, 0xca, 0xee, 0x52, 0x28, 0xf3, 0xd8,
-	0x96, 0xd7, 0xee, 0x13, 0xb4, 0x02, 0x05, 0xb1, 0x10, 0x27, 0x31, 0xb1, 0x14, 0xd0, 0x03, 0x28,
-	0x9f, 0x93, 0xf0, 0xca, 0xef, 0x90, 0x93, 0x43, 0xb1, 0x6b, 0x19, 0xa7, 0x0a, 0xe4, 0x40, 0xe9,
-	0x0d, 0x09, 0x23, 0x9f, 0x06, 0x62, 0x2f, 0x13, 0xc7, 0x22, 0xcf, 0xf6, 0xc6, 0xeb, 0xfb, 0x5d,
-	0xc7, 0x5c, 0x37, 0x36, 0x2d, 0x2c, 0x05, 0xf7, 0x4f, 0x13, 0x4a, 0x98, 0xfc, 0x32, 0x22, 0x11,
-	0xe3, 0x99, 0xd5, 0xf2, 0xe4, 0x50, 0xed, 0x99, 0x2a, 0xd0, 0x6e, 0xe6, 0x68, 0x62, 0xdf, 0xca,
-	0xce, 0x52, 0x5a, 0xad, 0x50, 0xef, 0x9b, 0xef, 0xff, 0x7e, 0xb8, 0x80, 0x33, 0x25, 0x6c, 0x40,
-	0xf1, 0x15, 0x61, 0x17, 0xb4, 0xab, 0x2a, 0xaf, 0xca, 0x08, 0xa9, 0xc3, 0xca, 0x86, 0x1e, 0x83,
-	0xc9, 0x43, 0xc4, 0xc9, 0x2a, 0xf1, 0x17, 0xe7, 0x1a, 0xb5, 0xbb, 0xca, 0x2b, 0x9c, 0xd0, 0x36,
-	0x14, 0x7f, 0x08, 0xb8, 0x87, 0x53, 0x10, 0xee, 0x1f, 0x49, 0x77, 0xa9, 0xd3, 0x03, 0x94, 0x23,
-	0x7a, 0x0a, 0x70, 0x4c, 0x58, 0x6b, 0x1c, 0x88, 0x5d, 0x8a, 0x22, 0xec, 0x63, 0x75, 0xaf, 0x89,
-	0x5e, 0x0f, 0xcd, 0x04, 0xa0, 0x13, 0xa8, 0x1d, 0x13, 0xc6, 0x6f, 0xcb, 0x0f, 0x7a, 0x67, 0x7e,
-	0xc4, 0x9c, 0x92, 0x48, 0xf1, 0x69, 0x92, 0x22, 0x63, 0xd3, 0xd3, 0x4c, 0x04, 0xa2, 0xaf, 0xa0,
-	0x74, 0x4c, 0xd8, 0xbe, 0x1f, 0x74, 0x1d, 0x4b, 0xe4, 0x58, 0x49, 0x72, 0x70, 0xa5, 0x1e, 0x1c,
-	0xbb, 0x22, 0x0c, 0xcb, 0xa7, 0x84, 0x0c, 0xd3, 0xef, 0xcc, 0xe3, 0xcb, 0x22, 0x7e, 0x4d, 0xc6,
-	0x4f, 0x99, 0xf5, 0x4c, 0xd3, 0xe1, 0xbc, 0x28, 0xae, 0xc4, 0x64, 0x40, 0x19, 0x11, 0xdf, 0x05,
-	0xb2, 0x45, 0xe9, 0xb6, 0x89, 0xa2, 0x74, 0xa3, 0xfb, 0x97, 0x09, 0x16, 0x26, 0xd1, 0x90, 0x06,
-	0x11, 0xb9, 0x03, 0xa2, 0x94, 0x87, 0xdc, 0x2d, 0x3c, 0xac, 0x40, 0xe1, 0x79, 0x18, 0xd2, 0x50,
-	0x40, 0x53, 0xc5, 0x52, 0x40, 0x9f, 0x43, 0xe9, 0x7b, 0x72, 0x2d, 0x6a, 0x37, 0x67, 0xe2, 0x87,
-	0x63, 0x3b, 0xfa, 0x42, 0x01, 0x25, 0x09, 0x41, 0x59, 0xa0, 0xe4, 0x31, 0x35, 0xa2, 0x76, 0x12,
-	0xa2, 0x8a, 0xd9, 0x3b, 0x89, 0x89, 0xd2, 0x22, 0x62, 0xa4, 0x9e, 0x69, 0x48, 0x49, 0x1e, 0x9c,
-	0x69, 0xa4, 0xb4, 0xd8, 0x2c, 0x53, 0x2f, 0xa7, 0x98, 0x92, 0x3c, 0x3c, 0x98, 0xcd, 0x94, 0x96,
-	0x67, 0x12, 0xaa, 0xbd, 0x14, 0x2a, 0x09, 0xc5, 0xea, 0x04, 0x54, 0x5a, 0x74, 0x42, 0xd5, 0xf9,
-	0x2c, 0xaa, 0x24, 0x04, 0x0f, 0xe7, 0x52, 0xa5, 0xa5, 0x9a, 0x81, 0xd5, 0xcb, 0x29, 0xac, 0x2a,
-	0xd9, 0xba, 0x26, 0xb1, 0xd2, 0xeb, 0x9a, 0xe0, 0xea, 0x37, 0xd5, 0x9f, 0xe3, 0xfe, 0xc4, 0xfb,
-	0xe1, 0x38, 0x50, 0x58, 0x55, 0xb1, 0x14, 0xee, 0xe8, 0x87, 0x08, 0x4c, 0x4c, 0xaf, 0x23, 0x27,
-	0xbf, 0x9e, 0xdf, 0xac, 0x62, 0xb1, 0x46, 0xdb, 0x50, 0x52, 0x2d, 0x7f, 0xba, 0xe3, 0x28, 0x43,
-	0xfc, 0xad, 0x94, 0xe8, 0x7e, 0x03, 0xd5, 0xec, 0x81, 0x51, 0x1d, 0x8a, 0x98, 0x44, 0xa3, 0x3e,
-	0x13, 0x67, 0xa9, 0xc4, 0x1c, 0x4b, 0x5d, 0x8c, 0x8a, 0x94, 0xdc, 0x6f, 0x61, 0x79, 0xaa, 0xcb,
-	0xcc, 0xa9, 0xc5, 0x86, 0x3c, 0xa6, 0xd7, 0xa2, 0x8a, 0x2a, 0xe6, 0x4b, 0xf7, 0x0c, 0xd0, 0x34,
-	0x4f, 0xaa, 0x97, 0x8f, 0xe4, 0x64, 0x28, 0x60, 0x29, 0xa0, 0x75, 0xa8, 0x64, 0x81, 0xca, 0x89,
-	0x92, 0xb3, 0x2a, 0xf7, 0x19, 0xac, 0xce, 0xec, 0x56, 0xe8, 0x11, 0xe4, 0x5b, 0xe3, 0x40, 0x15,
-	0xb3, 0x98, 0x8e, 0xa7, 0xd6, 0x38, 0x50, 0xd5, 0x70, 0xbb, 0xdb, 0x84, 0x7b, 0xb3, 0xc9, 0x44,
-	0x7b, 0xfa, 0xde, 0xc6, 0x7a, 0x7e, 0x5e, 0x22, 0xed, 0x40, 0x4f, 0xa1, 0xa4, 0xac, 0xf3, 0x6f,
-	0xf7, 0x20, 0x24, 0x1e, 0x23, 0xdd, 0x66, 0x10, 0xdf, 0x6e, 0xa2, 0x70, 0x7f, 0x86, 0x45, 0xad,
-	0xef, 0xcf, 0x49, 0xf2, 0x35, 0x58, 0x07, 0x74, 0x30, 0xf0, 0x59, 0xeb, 0x5c, 0x4d, 0xae, 0x95,
-	0xad, 0xf4, 0x31, 0xd0, 0x8a, 0x57, 0xea, 0x80, 0x89, 0xaf, 0x6b, 0x43, 0x4d, 0x6f, 0x02, 0xee,
-	0xa1, 0xf8, 0x6d, 0x33, 0x0d, 0x56, 0xc7, 0xcf, 0x98, 0xc4, 0x2f, 0x19, 0xe1, 0xb9, 0xcc, 0x08,
-	0x77, 0x8f, 0x60, 0x69, 0xe2, 0xdf, 0xfc, 0x5f, 0xd3, 0xd5, 0x7d, 0x02, 0xce, 0xbc, 0xc6, 0x7f,
-	0xfb, 0xb9, 0xdc, 0xc7, 0xf0, 0xc9, 0xdc, 0x9f, 0x1b, 0xd5, 0x20, 0xd7, 0x3c, 0x15, 0x31, 0x16,
-	0xce, 0x35, 0x4f, 0xdd, 0x3d, 0x58, 0x9d, 0x39, 0x0e, 0xee, 0xd8, 0x63, 0x13, 0xee, 0xcd, 0xfe,
-	0xdd, 0xa7, 0x36, 0xf8, 0xc3, 0x88, 0x7f, 0x27, 0xb4, 0x0d, 0x16, 0x77, 0x15, 0xd7, 0x6d, 0xdc,
-	0xf6, 0x19, 0x12, 0x37, 0x8e, 0xfd, 0x0b, 0x2f, 0x3a, 0xa0, 0xc1, 0xdb, 0xbe, 0xdf, 0x61, 0xe2,
-	0xe3, 0x59, 0x38, 0xab, 0x42, 0x1b, 0xb0, 0xf8, 0xc2, 0x8b, 0x5e, 0x87, 0xe4, 0x4a, 0x5e, 0xad,
-	0x98, 0x2b, 0x16, 0xd6, 0x95, 0xe8, 0x09, 0x94, 0x13, 0x14, 0x54, 0x63, 0xb8, 0x0d, 0x93, 0xd4,
-	0xb9, 0xfe, 0x99, 0xf6, 0x70, 0x44, 0x25, 0xf1, 0x17, 0xdb, 0x0b, 0xa8, 0x0c, 0x05, 0xec, 0x05,
-	0x3d, 0x62, 0x1b, 0xf5, 0x47, 0xb2, 0x2e, 0xf1, 0x1c, 0x5c, 0x84, 0xf2, 0xf3, 0x71, 0xa7, 0x3f,
-	0x8a, 0xfc, 0x2b, 0x62, 0x2f, 0x20, 0x80, 0xe2, 0xf9, 0x85, 0x17, 0x92, 0xae, 0x6d, 0xd4, 0x37,
-	0x00, 0xd2, 0x57, 0x21, 0xb2, 0xc0, 0xe4, 0x92, 0xbd, 0x80, 0xaa, 0x60, 0x1d, 0x79, 0x11, 0x3b,
-	0xf2, 0xfc, 0xbe, 0x6d, 0xd4, 0x7f, 0x8d, 0xa7, 0x28, 0xf7, 0xe0, 0x69, 0x65, 0x16, 0xc9, 0xaa,
-	0x6d, 0xa0, 0x5a, 0x76, 0x38, 0xd9, 0x39, 0x84, 0x26, 0x87, 0x8d, 0x9d, 0xe7, 0x3a, 0xfd, 0x76,
-	0x6c, 0x13, 0x55, 0x92, 0x41, 0x62, 0x17, 0xd0, 0xea, 0x8c, 0xf1, 0x60, 0x17, 0xf7, 0xbf, 0xfb,
-	0xf0, 0xef, 0x9a, 0xf1, 0xfe, 0x66, 0xcd, 0xf8, 0x70, 0xb3, 0x66, 0xfc, 0x73, 0xb3, 0x66, 0xfc,
-	0x94, 0x7d, 0x86, 0x0f, 0x3c, 0x16, 0xfa, 0x63, 0x1a, 0xfa, 0x3d, 0x3f, 0x88, 0x85, 0x80, 0x34,
-	0x86, 0x97, 0xbd, 0xc6, 0xb0, 0xdd, 0xe0, 0xc7, 0x6b, 0x17, 0xc5, 0xe3, 0x7b, 0xf7, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x00, 0x69, 0xd9, 0x97, 0xd0, 0x0b, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x5b, 0x4f, 0x1b, 0x47,
+	0x14, 0x80, 0x59, 0x7b, 0x7d, 0x3b, 0x36, 0x66, 0x99, 0x42, 0xba, 0x4d, 0x23, 0xe2, 0xae, 0x12,
+	0xc9, 0x25, 0x2d, 0x08, 0x08, 0x55, 0xd4, 0x2a, 0xa9, 0x04, 0x04, 0x42, 0x20, 0x25, 0x1a, 0xdc,
+	0x54, 0xea, 0xdb, 0x62, 0x4f, 0xcc, 0x08, 0x7b, 0xc7, 0xd9, 0x1d, 0x83, 0xd3, 0x5f, 0xd0, 0xc7,
+	0xfe, 0xa5, 0xbe, 0xe5, 0x31, 0x2f, 0x55, 0xdf, 0xaa, 0x96, 0x5f, 0x52, 0xcd, 0x65, 0x2f, 0xe3,
+	0x0b, 0x48, 0x79, 0xdb, 0x73, 0x9d, 0x39, 0x33, 0xdf, 0x9e, 0x33, 0x00, 0x3d, 0xd6, 0xbe, 0x58,
+	0x1b, 0x84, 0x8c, 0x33, 0x64, 0x8b, 0xef, 0xbb, 0xdf, 0x76, 0x29, 0x3f, 0x1f, 0x9e, 0xad, 0xb5,
+	0x59, 0x7f, 0xbd, 0xcb, 0xba, 0x6c, 0x5d, 0x1a, 0xcf, 0x86, 0x6f, 0xa5, 0x24, 0x05, 0xf9, 0xa5,
+	0x82, 0xee, 0x2e, 0x70, 0xda, 0x27, 0x11, 0xf7, 0xfb, 0x03, 0xa5, 0xf0, 0xfe, 0xb0, 0xa0, 0x7a,
+	0xcc, 0xda, 0x17, 0x27, 0x03, 0x4e, 0x59, 0x10, 0xa1, 0x2d, 0xa8, 0x1e, 0x84, 0x7e, 0x30, 0xec,
+	0xf9, 0x21, 0xe5, 0xef, 0x5d, 0xab, 0x61, 0x35, 0xeb, 0x9b, 0x8b, 0x6b, 0x72, 0xdd, 0x8c, 0x01,
+	0x67, 0xbd, 0x90, 0x07, 0xf6, 0x2b, 0xd6, 0x21, 0x6e, 0x4e, 0x7a, 0xd7, 0x95, 0xb7, 0xc8, 0x2a,
+	0xb4, 0x58, 0xda, 0x50, 0x13, 0x8a, 0x03, 0xd6, 0xa3, 0xed, 0xf7, 0x6e, 0x5e, 0x7a, 0x39, 0xca,
+	0xeb, 0x17, 0x9f, 0xf2, 0xd7, 0x52, 0x8f, 0xb5, 0xdd, 0x63, 0x50, 0x11, 0xb1, 0x2d, 0xff, 0xac,
+	0x47, 0xd0, 0x12, 0x14, 0xe4, 0x87, 0xdc, 0x89, 0x8d, 0x95, 0x80, 0xee, 0x41, 0xe5, 0x94, 0x84,
+	0x97, 0xb4, 0x4d, 0x0e, 0xf7, 0xe4, 0xaa, 0x15, 0x9c, 0x2a, 0x90, 0x0b, 0xa5, 0x37, 0x24, 0x8c,
+	0x28, 0x0b, 0xe4, 0x5a, 0x36, 0x8e, 0x45, 0x91, 0xed, 0x8d, 0xdf, 0xa3, 0x1d, 0xd7, 0x6e, 0x58,
+	0xcd, 0x32, 0x56, 0x82, 0xf7, 0xa7, 0x0d, 0x25, 0x4c, 0xde, 0x0d, 0x49, 0xc4, 0x45, 0x66, 0xfd,
+	0x79, 0xb8, 0xa7, 0xd7, 0x4c, 0x15, 0x68, 0x2b, 0xb3, 0x35, 0xb9, 0x6e, 0x75, 0x73, 0x21, 0xad,
+	0x56, 0xaa, 0x77, 0xec, 0x0f, 0xff, 0xdc, 0x9f, 0xc3, 0x99, 0x12, 0x1e, 0x40, 0xf1, 0x15, 0xe1,
+	0xe7, 0xac, 0xa3, 0x2b, 0xaf, 0xa9, 0x08, 0xa5, 0xc3, 0xda, 0x86, 0x1e, 0x81, 0x2d, 0x42, 0xe4,
+	0xce, 0xaa, 0xf1, 0x89, 0x0b, 0x8d, 0x5e, 0x5d, 0xe7, 0x95, 0x4e, 0x68, 0x03, 0x8a, 0x3f, 0x07,
+	0xc2, 0xc3, 0x2d, 0x48, 0xf7, 0xcf, 0x94, 0xbb, 0xd2, 0x99, 0x01, 0xda, 0x11, 0x3d, 0x05, 0x38,
+	0x20, 0xbc, 0x35, 0x0a, 0xe4, 0x2a, 0x45, 0x19, 0xf6, 0xb9, 0xbe, 0xd7, 0x44, 0x6f, 0x86, 0x66,
+	0x02, 0xd0, 0x21, 0xd4, 0x0f, 0x08, 0x17, 0xb7, 0x45, 0x83, 0xee, 0x31, 0x8d, 0xb8, 0x5b, 0x92,
+	0x29, 0xbe, 0x4c, 0x52, 0x64, 0x6c, 0x66, 0x9a, 0xb1, 0x40, 0xf4, 0x18, 0x4a, 0x07, 0x84, 0xef,
+	0xd0, 0xa0, 0xe3, 0x96, 0x65, 0x8e, 0xa5, 0x24, 0x87, 0x50, 0x9a, 0xc1, 0xb1, 0x2b, 0xc2, 0xb0,
+	0x78, 0x44, 0xc8, 0x20, 0x3d, 0x67, 0x11, 0x5f, 0x91, 0xf1, 0x2b, 0x2a, 0x7e, 0xc2, 0x6c, 0x66,
+	0x9a, 0x0c, 0x17, 0x45, 0x09, 0x25, 0x26, 0x7d, 0xc6, 0x89, 0x3c, 0x17, 0xc8, 0x16, 0x65, 0xda,
+	0xc6, 0x8a, 0x32, 0x8d, 0xde, 0x5f, 0x36, 0x94, 0x31, 0x89, 0x06, 0x2c, 0x88, 0xc8, 0x2d, 0x10,
+	0xa5, 0x3c, 0xe4, 0x6e, 0xe0, 0x61, 0x09, 0x0a, 0xcf, 0xc3, 0x90, 0x85, 0x12, 0x9a, 0x1a, 0x56,
+	0x02, 0xfa, 0x1a, 0x4a, 0x3f, 0x91, 0x2b, 0x59, 0xbb, 0x3d, 0x15, 0x3f, 0x1c, 0xdb, 0xd1, 0x37,
+	0x1a, 0x28, 0x45, 0x08, 0xca, 0x02, 0xa5, 0xb6, 0x69, 0x10, 0xb5, 0x99, 0x10, 0x55, 0xcc, 0xde,
+	0x49, 0x4c, 0x94, 0x11, 0x11, 0x23, 0xf5, 0xcc, 0x40, 0x4a, 0xf1, 0xe0, 0x4e, 0x22, 0x65, 0xc4,
+	0x66, 0x99, 0x7a, 0x39, 0xc1, 0x94, 0xe2, 0xe1, 0xde, 0x74, 0xa6, 0x8c, 0x3c, 0xe3, 0x50, 0x6d,
+	0xa7, 0x50, 0x29, 0x28, 0x96, 0xc7, 0xa0, 0x32, 0xa2, 0x13, 0xaa, 0x4e, 0xa7, 0x51, 0xa5, 0x20,
+	0xb8, 0x3f, 0x93, 0x2a, 0x23, 0xd5, 0x14, 0xac, 0x5e, 0x4e, 0x60, 0x55, 0xcd, 0xd6, 0x35, 0x8e,
+	0x95, 0x59, 0xd7, 0x18, 0x57, 0xbf, 0xeb, 0xfe, 0x1c, 0xf7, 0x27, 0xd1, 0x0f, 0x47, 0x81, 0xc6,
+	0xaa, 0x86, 0x95, 0x70, 0x4b, 0x3f, 0x44, 0x60, 0x63, 0x76, 0x15, 0xb9, 0xf9, 0x46, 0xbe, 0x59,
+	0xc3, 0xf2, 0x1b, 0x6d, 0x40, 0x49, 0xb7, 0xfc, 0xc9, 0x8e, 0xa3, 0x0d, 0xf1, 0x59, 0x69, 0xd1,
+	0xfb, 0x1e, 0x6a, 0xd9, 0x0d, 0xa3, 0x55, 0x28, 0x62, 0x12, 0x0d, 0x7b, 0x5c, 0xee, 0xa5, 0x1a,
+	0x73, 0xac, 0x74, 0x31, 0x2a, 0x4a, 0xf2, 0x7e, 0x80, 0xc5, 0x89, 0x2e, 0x33, 0xa3, 0x16, 0x07,
+	0xf2, 0x98, 0x5d, 0xc9, 0x2a, 0x6a, 0x58, 0x7c, 0x7a, 0xc7, 0x80, 0x26, 0x79, 0xd2, 0xbd, 0x7c,
+	0xa8, 0x26, 0x43, 0x01, 0x2b, 0x01, 0x35, 0xa0, 0x9a, 0x05, 0x2a, 0x27, 0x4b, 0xce, 0xaa, 0xbc,
+	0x67, 0xb0, 0x3c, 0xb5, 0x5b, 0xa1, 0x87, 0x90, 0x6f, 0x8d, 0x02, 0x5d, 0xcc, 0x7c, 0x3a, 0x9e,
+	0x5a, 0xa3, 0x40, 0x57, 0x23, 0xec, 0xde, 0x09, 0xdc, 0x99, 0x4e, 0x26, 0xda, 0x36, 0xd7, 0xb6,
+	0x1a, 0xf9, 0x59, 0x89, 0x8c, 0x0d, 0x3d, 0x85, 0x92, 0xb6, 0xce, 0xbe, 0xdd, 0xdd, 0x90, 0xf8,
+	0x9c, 0x74, 0x4e, 0x82, 0xf8, 0x76, 0x13, 0x85, 0xf7, 0x0e, 0xe6, 0x8d, 0xbe, 0x3f, 0x23, 0xc9,
+	0x77, 0x50, 0xde, 0x65, 0xfd, 0x3e, 0xe5, 0xad, 0x53, 0x3d, 0xb9, 0x96, 0xd6, 0xd2, 0xc7, 0x40,
+	0x2b, 0xfe, 0xd2, 0x1b, 0x4c, 0x7c, 0xa7, 0xc1, 0xe3, 0x39, 0x50, 0x37, 0x1b, 0x83, 0xb7, 0x27,
+	0x7f, 0xe5, 0x4c, 0xd3, 0x35, 0x91, 0xb4, 0xc6, 0x91, 0x4c, 0xc6, 0x7a, 0x2e, 0x33, 0xd6, 0xbd,
+	0x7d, 0x58, 0x18, 0xfb, 0x5f, 0x3f, 0x69, 0xe2, 0x7a, 0x4f, 0xc0, 0x9d, 0x35, 0x0c, 0x6e, 0xde,
+	0x97, 0xf7, 0x08, 0xbe, 0x98, 0xf9, 0xc3, 0xa3, 0x3a, 0xe4, 0x4e, 0x8e, 0x64, 0x4c, 0x19, 0xe7,
+	0x4e, 0x8e, 0xbc, 0x6d, 0x58, 0x9e, 0x3a, 0x22, 0x6e, 0x59, 0xa3, 0x09, 0x77, 0xa6, 0xb7, 0x80,
+	0x89, 0x05, 0xfe, 0xb6, 0xe2, 0x5f, 0x0c, 0x6d, 0x40, 0x59, 0xb8, 0x4a, 0x04, 0xac, 0x9b, 0x8e,
+	0x21, 0x71, 0x13, 0xbf, 0xc2, 0x0b, 0x3f, 0xda, 0x65, 0xc1, 0xdb, 0x1e, 0x6d, 0x73, 0x79, 0x78,
+	0x65, 0x9c, 0x55, 0xa1, 0x07, 0x30, 0xff, 0xc2, 0x8f, 0x5e, 0x87, 0xe4, 0x52, 0x5d, 0xb7, 0x9c,
+	0x35, 0x65, 0x6c, 0x2a, 0xd1, 0x13, 0xa8, 0x24, 0x78, 0xb8, 0xf6, 0xad, 0xe8, 0xa4, 0xce, 0xe2,
+	0x21, 0x76, 0x7a, 0x41, 0x07, 0x03, 0xd2, 0x71, 0x0b, 0x8d, 0x7c, 0xb3, 0x80, 0x63, 0x71, 0xf5,
+	0x2b, 0xe3, 0x99, 0x89, 0x4a, 0xf2, 0x9f, 0x77, 0xe6, 0x50, 0x05, 0x0a, 0xd8, 0x0f, 0xba, 0xc4,
+	0xb1, 0x56, 0x1f, 0xaa, 0x8a, 0xe5, 0xe3, 0x71, 0x1e, 0x2a, 0xcf, 0x47, 0xed, 0xde, 0x30, 0xa2,
+	0x97, 0xc4, 0x99, 0x43, 0x00, 0xc5, 0xd3, 0x73, 0x3f, 0x24, 0x1d, 0xc7, 0x5a, 0x7d, 0x0c, 0x90,
+	0xbe, 0x21, 0x51, 0x19, 0x6c, 0x21, 0x39, 0x73, 0xa8, 0x06, 0xe5, 0x7d, 0x3f, 0xe2, 0xfb, 0x3e,
+	0xed, 0x39, 0x16, 0xaa, 0x03, 0x88, 0xa5, 0xd5, 0xd9, 0x38, 0xb9, 0xd5, 0xdf, 0xe2, 0x19, 0x2c,
+	0x22, 0x84, 0x56, 0x65, 0x55, 0x54, 0x2b, 0xff, 0xb4, 0xe5, 0x38, 0x39, 0x84, 0xc6, 0x47, 0x95,
+	0x93, 0x17, 0x3a, 0xf3, 0x1e, 0x1d, 0x1b, 0x55, 0x93, 0x31, 0xe4, 0x14, 0xd0, 0xf2, 0x94, 0xe1,
+	0xe2, 0x14, 0x77, 0x7e, 0xfc, 0xf8, 0xdf, 0x8a, 0xf5, 0xe1, 0x7a, 0xc5, 0xfa, 0x78, 0xbd, 0x62,
+	0xfd, 0x7b, 0xbd, 0x62, 0xfd, 0x9a, 0x7d, 0xc4, 0xf7, 0x7d, 0x1e, 0xd2, 0x11, 0x0b, 0x69, 0x97,
+	0x06, 0xb1, 0x10, 0x90, 0xf5, 0xc1, 0x45, 0x77, 0x7d, 0x70, 0xb6, 0x2e, 0xb6, 0x77, 0x56, 0x94,
+	0x4f, 0xf7, 0xad, 0xff, 0x07, 0x00, 0x96, 0xa6, 0xbd, 0xa6, 0x0e, 0x0c, 0x00, 0x00,
 }
 
 func (m *LockOptions) Marshal() (dAtA []byte, err error) {
@@ -2167,6 +2180,15 @@ func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rows[iNdEx])
+			copy(dAtA[i:], m.Rows[iNdEx])
+			i = encodeVarintLock(dAtA, i, uint64(len(m.Rows[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.CommitTS.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2456,6 +2478,25 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Skipped) > 0 {
+		dAtA23 := make([]byte, len(m.Skipped)*10)
+		var j22 int
+		for _, num1 := range m.Skipped {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintLock(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2777,6 +2818,12 @@ func (m *UnlockRequest) Size() (n int) {
 	}
 	l = m.CommitTS.Size()
 	n += 1 + l + sovLock(uint64(l))
+	if len(m.Rows) > 0 {
+		for _, b := range m.Rows {
+			l = len(b)
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2906,6 +2953,13 @@ func (m *Result) Size() (n int) {
 	}
 	l = m.Timestamp.Size()
 	n += 1 + l + sovLock(uint64(l))
+	if len(m.Skipped) > 0 {
+		l = 0
+		for _, e := range m.Skipped {
+			l += sovLock(uint64(e))
+		}
+		n += 1 + sovLock(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4779,6 +4833,38 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, make([]byte, postIndex-iNdEx))
+			copy(m.Rows[len(m.Rows)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -5481,6 +5567,82 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Skipped = append(m.Skipped, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLock
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLock
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Skipped) == 0 {
+					m.Skipped = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLock
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Skipped = append(m.Skipped, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -118,6 +118,7 @@ func (s *DNState) Update(hb DNStoreHeartbeat, tick uint64) {
 	storeInfo.LockServiceAddress = hb.LockServiceAddress
 	storeInfo.CtlAddress = hb.CtlAddress
 	storeInfo.TaskServiceCreated = hb.TaskServiceCreated
+	storeInfo.GCRetention = hb.GCRetention
 	s.Stores[hb.UUID] = storeInfo
 }
 
@@ -531,8 +531,10 @@ type DNStore struct {
 	// Server address for logtail push model
 	LogtailServerAddress string `protobuf:"bytes,6,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
 	// LockServiceAddress lock service address for lock table allocator
-	LockServiceAddress   string   `protobuf:"bytes,7,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
-	CtlAddress           string   `protobuf:"bytes,8,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	LockServiceAddress string `protobuf:"bytes,7,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
+	CtlAddress         string `protobuf:"bytes,8,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	// GCRetention is the nanoseconds the history versions are kept by the GC
+	GCRetention          int64    `protobuf:"varint,9,opt,name=GCRetention,proto3" json:"GCRetention,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -627,6 +629,13 @@ func (m *DNStore) GetCtlAddress() string {
 	return ""
 }
 
+func (m *DNStore) GetGCRetention() int64 {
+	if m != nil {
+		return m.GCRetention
+	}
+	return 0
+}
+
 type LogStore struct {
 	UUID                 string           `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
 	ServiceAddress       string           `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
@@ -1163,8 +1172,10 @@ type DNStoreHeartbeat struct {
 	// Server address for logtail push model
 	LogtailServerAddress string `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
 	// LockServiceAddress lock service address for lock table allocator
-	LockServiceAddress   string   `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
-	CtlAddress           string   `protobuf:"bytes,7,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	LockServiceAddress string `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
+	CtlAddress         string `protobuf:"bytes,7,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	// GCRetention is the nanoseconds the history versions are kept by the GC
+	GCRetention          int64    `protobuf:"varint,8,opt,name=GCRetention,proto3" json:"GCRetention,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1252,6 +1263,13 @@ func (m *DNStoreHeartbeat) GetCtlAddress() string {
 	return ""
 }
 
+func (m *DNStoreHeartbeat) GetGCRetention() int64 {
+	if m != nil {
+		return m.GCRetention
+	}
+	return 0
+}
+
 type RSMState struct {
 	Tso                  uint64            `protobuf:"varint,1,opt,name=Tso,proto3" json:"Tso,omitempty"`
 	Index                uint64            `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
@@ -2718,8 +2736,10 @@ type DNStoreInfo struct {
 	// Server address for logtail push model
 	LogtailServerAddress string `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
 	// LockServiceAddress lock service address for lock table allocator
-	LockServiceAddress   string   `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
-	CtlAddress           string   `protobuf:"bytes,7,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	LockServiceAddress string `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
+	CtlAddress         string `protobuf:"bytes,7,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
+	// GCRetention is the nanoseconds the history versions are kept by the GC
+	GCRetention          int64    `protobuf:"varint,8,opt,name=GCRetention,proto3" json:"GCRetention,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2807,6 +2827,13 @@ func (m *DNStoreInfo) GetCtlAddress() string {
 	return ""
 }
 
+func (m *DNStoreInfo) GetGCRetention() int64 {
+	if m != nil {
+		return m.GCRetention
+	}
+	return 0
+}
+
 // DNState contains all DN details known to the HAKeeper.
 type DNState struct {
 	// Stores is keyed by DN store UUID.
@@ -3649,194 +3676,195 @@ func init() {
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 2990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0xbb, 0xa4, 0xf8, 0xf1, 0x28, 0xc9, 0xab, 0x91, 0x64, 0x33, 0x4a, 0x2a, 0xab, 0x1b, 0x37,
-	0x70, 0x98, 0x86, 0x06, 0x24, 0x24, 0x48, 0x1a, 0xc5, 0x06, 0xc5, 0xa5, 0x2d, 0xc6, 0x34, 0xe5,
-	0x0c, 0xa9, 0x1e, 0x02, 0x04, 0xea, 0x8a, 0x1c, 0x53, 0xac, 0x48, 0x2e, 0xbb, 0xbb, 0x74, 0xac,
-	0x9e, 0x7a, 0x69, 0x81, 0xa2, 0xbd, 0x14, 0xcd, 0x21, 0x28, 0x8a, 0x1e, 0x7a, 0xe9, 0xa1, 0xd7,
-	0x1c, 0xda, 0x63, 0x0f, 0x05, 0x72, 0x29, 0x90, 0x1f, 0x50, 0x04, 0x6d, 0xae, 0x3d, 0xf4, 0xd6,
-	0x73, 0x31, 0x5f, 0xbb, 0x33, 0xe4, 0x52, 0xb6, 0x13, 0x37, 0x28, 0x92, 0x13, 0x77, 0xde, 0xd7,
-	0xbe, 0x79, 0xf3, 0xbe, 0xe6, 0x2d, 0xc1, 0x1a, 0x78, 0xbd, 0x80, 0xf8, 0x0f, 0xfb, 0x1d, 0x52,
-	0x1e, 0xfb, 0x5e, 0xe8, 0x21, 0x88, 0x21, 0x9b, 0xaf, 0xf6, 0xfa, 0xe1, 0xe9, 0xe4, 0xa4, 0xdc,
-	0xf1, 0x86, 0x37, 0x7a, 0x5e, 0xcf, 0xbb, 0xc1, 0x48, 0x4e, 0x26, 0x0f, 0xd8, 0x8a, 0x2d, 0xd8,
-	0x13, 0x67, 0xdd, 0x5c, 0x19, 0x92, 0xd0, 0xed, 0xba, 0xa1, 0xcb, 0xd7, 0xf6, 0x1f, 0x53, 0x90,
-	0xad, 0x36, 0x5b, 0xa1, 0xe7, 0x13, 0x84, 0x20, 0x7d, 0x74, 0x54, 0x77, 0x8a, 0xc6, 0xb6, 0x71,
-	0x3d, 0x8f, 0xd9, 0x33, 0x7a, 0x09, 0x56, 0x5a, 0xfc, 0x4d, 0x95, 0x6e, 0xd7, 0x27, 0x41, 0x50,
-	0x34, 0x19, 0x76, 0x0a, 0x8a, 0xb6, 0x00, 0x5a, 0xef, 0x36, 0x24, 0x4d, 0x8a, 0xd1, 0x28, 0x10,
-	0x54, 0x06, 0xd4, 0xf0, 0x3a, 0x67, 0x53, 0xb2, 0xd2, 0x8c, 0x2e, 0x01, 0x43, 0xe5, 0x55, 0xc3,
-	0x81, 0xa4, 0x5b, 0xe4, 0xf2, 0x62, 0x08, 0xba, 0x06, 0x69, 0xec, 0x0d, 0x48, 0x31, 0xb3, 0x6d,
-	0x5c, 0x5f, 0xd9, 0xb1, 0xca, 0xd1, 0xb6, 0xaa, 0x4d, 0x0a, 0xc7, 0x0c, 0x4b, 0x77, 0xd4, 0xee,
-	0x77, 0xce, 0x8a, 0xd9, 0x6d, 0xe3, 0x7a, 0x1a, 0xb3, 0x67, 0xf4, 0x0a, 0x2c, 0xb6, 0x42, 0x37,
-	0x24, 0xc5, 0x1c, 0x63, 0xdd, 0x28, 0x2b, 0xe6, 0x6d, 0x7a, 0x5d, 0xc2, 0x90, 0x98, 0xd3, 0xa0,
-	0xb7, 0x21, 0xd3, 0x70, 0x4f, 0xc8, 0x20, 0x28, 0xe6, 0xb7, 0x53, 0xd7, 0x0b, 0x3b, 0x57, 0x55,
-	0x6a, 0x61, 0xb7, 0x32, 0xa7, 0xa8, 0x8d, 0x42, 0xff, 0x7c, 0x3f, 0xfd, 0xc9, 0x67, 0x57, 0x17,
-	0xb0, 0x60, 0xda, 0x6c, 0x42, 0x41, 0x41, 0x22, 0x0b, 0x52, 0x67, 0xe4, 0x5c, 0xd8, 0x97, 0x3e,
-	0xa2, 0x97, 0x61, 0xf1, 0xa1, 0x3b, 0x98, 0x10, 0x66, 0xd5, 0xc2, 0xce, 0x5a, 0xbc, 0x0f, 0xc6,
-	0xd7, 0xe8, 0x07, 0x21, 0xe6, 0x14, 0xdf, 0x33, 0xdf, 0x30, 0xec, 0xbf, 0x98, 0x90, 0x75, 0x9e,
-	0xc1, 0x69, 0x49, 0xbb, 0xa4, 0x92, 0xec, 0x92, 0x7e, 0x02, 0xbb, 0xbc, 0x06, 0x99, 0xd6, 0xa9,
-	0xeb, 0x77, 0xe9, 0xd1, 0x50, 0xbb, 0x5c, 0x51, 0xa9, 0x9d, 0x26, 0xc3, 0xd5, 0x47, 0x0f, 0x3c,
-	0x69, 0x0f, 0x4e, 0x8c, 0x76, 0x60, 0xbd, 0xe1, 0xf5, 0x42, 0xb7, 0x3f, 0xa0, 0x0a, 0x11, 0x5f,
-	0x6a, 0x99, 0x61, 0x5a, 0x26, 0xe2, 0xe6, 0x78, 0x4e, 0xf6, 0x09, 0x3d, 0x27, 0x37, 0xed, 0x39,
-	0xf6, 0x5f, 0x0d, 0xc8, 0x35, 0xbc, 0xde, 0xff, 0x81, 0x11, 0xf7, 0x20, 0x87, 0xc9, 0x78, 0xd0,
-	0xef, 0xb8, 0xd2, 0x8c, 0x9b, 0x2a, 0x7d, 0xc3, 0xeb, 0x09, 0xb4, 0x62, 0xc9, 0x88, 0xc3, 0xfe,
-	0xb7, 0x01, 0x4b, 0x74, 0x1f, 0xd2, 0xd4, 0xa8, 0x08, 0x59, 0xbe, 0xe0, 0xdb, 0x49, 0x63, 0xb9,
-	0x44, 0xfb, 0xca, 0x8b, 0x4c, 0xf6, 0xa2, 0x97, 0xa6, 0x5e, 0x14, 0x49, 0x29, 0x4b, 0x42, 0xe6,
-	0xb1, 0xf1, 0xeb, 0xd0, 0x3a, 0x2c, 0xd6, 0xc6, 0x5e, 0xe7, 0x54, 0x6c, 0x97, 0x2f, 0xd0, 0x26,
-	0xe4, 0x1a, 0xc4, 0xed, 0x12, 0xbf, 0xee, 0xb0, 0x2d, 0xa7, 0x71, 0xb4, 0x66, 0xf6, 0x21, 0xfe,
-	0x90, 0x05, 0x2f, 0xb5, 0x0f, 0xf1, 0x87, 0x9b, 0x6f, 0xc1, 0xb2, 0xf6, 0x02, 0x35, 0x24, 0xd2,
-	0x3c, 0x24, 0xd6, 0xd5, 0x90, 0xc8, 0xab, 0xde, 0xff, 0x10, 0x56, 0x74, 0x9b, 0xa0, 0xdb, 0xba,
-	0x09, 0x98, 0x98, 0xc2, 0x4e, 0x71, 0xde, 0xe6, 0xf6, 0x73, 0xd4, 0x86, 0x9f, 0x7e, 0x76, 0xd5,
-	0xc0, 0xba, 0xe9, 0x5e, 0x80, 0xbc, 0x14, 0xeb, 0xb0, 0xf7, 0xa6, 0x71, 0x0c, 0xb0, 0x3f, 0x34,
-	0xc1, 0x12, 0xb1, 0x7e, 0x40, 0x5c, 0x3f, 0x3c, 0x21, 0x6e, 0xf8, 0x35, 0x4c, 0x96, 0x65, 0x40,
-	0x6d, 0x37, 0x90, 0xb2, 0xab, 0x3e, 0x71, 0x43, 0xd2, 0x65, 0x81, 0x96, 0xc3, 0x09, 0x18, 0xfb,
-	0x75, 0x58, 0xaa, 0x36, 0x2b, 0x83, 0x81, 0xd7, 0x71, 0x43, 0x52, 0x77, 0x12, 0xb2, 0xdb, 0x3a,
-	0x2c, 0xee, 0xbb, 0x61, 0xe7, 0x54, 0x98, 0x94, 0x2f, 0xec, 0x9f, 0x9a, 0xb0, 0x2a, 0x03, 0xf0,
-	0x62, 0x7b, 0x6e, 0x43, 0x01, 0xbb, 0x0f, 0x42, 0xdd, 0x98, 0x2a, 0x28, 0xc1, 0xe2, 0xa9, 0x44,
-	0x8b, 0x5f, 0x83, 0xe5, 0x3b, 0x5e, 0x10, 0xf4, 0xc7, 0xba, 0x31, 0x75, 0xe0, 0x97, 0x0b, 0xc8,
-	0x39, 0xf6, 0xcb, 0xcc, 0xb5, 0x5f, 0x0d, 0x0a, 0x4a, 0xa6, 0xbc, 0x20, 0x7c, 0x2f, 0xf6, 0xce,
-	0x3f, 0x9b, 0x60, 0x39, 0xcf, 0xd2, 0x3b, 0xe3, 0xdc, 0x9e, 0x7a, 0x9a, 0xdc, 0x9e, 0xbc, 0xfd,
-	0xf4, 0xbc, 0xed, 0xcf, 0xad, 0x05, 0x8b, 0x4f, 0x5d, 0x0b, 0x32, 0x4f, 0x18, 0x18, 0xd9, 0x99,
-	0x5a, 0xf0, 0x73, 0x13, 0x72, 0xb8, 0x75, 0x8f, 0xa7, 0x63, 0x0b, 0x52, 0xed, 0xc0, 0x93, 0xa9,
-	0xa8, 0x1d, 0x78, 0xd4, 0x7f, 0xeb, 0xa3, 0x2e, 0x79, 0x24, 0xfd, 0x97, 0x2d, 0xa8, 0x2f, 0x35,
-	0x88, 0x1b, 0x90, 0x03, 0x6f, 0xc0, 0x13, 0x1f, 0xcf, 0x88, 0x3a, 0x10, 0xd9, 0xb0, 0xd4, 0xf6,
-	0x27, 0x23, 0x1a, 0x1b, 0xdd, 0x46, 0x30, 0x12, 0xd9, 0x51, 0x83, 0xa1, 0x77, 0x60, 0x89, 0x33,
-	0xf5, 0x83, 0xd0, 0xf3, 0xcf, 0x85, 0xcf, 0x69, 0xb9, 0x59, 0x6a, 0x57, 0x56, 0x09, 0x79, 0x6e,
-	0xd6, 0x78, 0x37, 0x6f, 0xc1, 0xea, 0x0c, 0xc9, 0xe3, 0xb2, 0x6b, 0x5a, 0xcd, 0xae, 0xef, 0x43,
-	0x9e, 0x39, 0x78, 0xc7, 0xf3, 0xbb, 0x94, 0x91, 0x2a, 0x2d, 0x18, 0xa9, 0xae, 0x25, 0x48, 0xb7,
-	0xcf, 0xc7, 0x9c, 0x6f, 0x65, 0xe7, 0xb2, 0xa6, 0x23, 0xe3, 0xa1, 0x58, 0xcc, 0x68, 0xa8, 0xf7,
-	0x39, 0x6e, 0xe8, 0x32, 0xc3, 0x2c, 0x61, 0xf6, 0x6c, 0x7f, 0x64, 0x00, 0x30, 0xf9, 0x3f, 0x9a,
-	0x90, 0x80, 0x39, 0x68, 0xd3, 0x1d, 0x12, 0xe9, 0xa0, 0xf4, 0x59, 0x8d, 0x00, 0x53, 0x8f, 0x00,
-	0xa1, 0x4e, 0x2a, 0x56, 0xa7, 0x08, 0xd9, 0x7b, 0xee, 0xa3, 0x56, 0xff, 0xc7, 0x44, 0x58, 0x56,
-	0x2e, 0x69, 0xb4, 0x48, 0x27, 0x75, 0x44, 0xed, 0x89, 0x01, 0x4c, 0xb5, 0x66, 0xdd, 0x61, 0x3e,
-	0x93, 0xc6, 0xec, 0xd9, 0xb6, 0x01, 0xda, 0x81, 0x27, 0x35, 0x5b, 0x87, 0xc5, 0xaa, 0x37, 0x19,
-	0x85, 0x62, 0xf3, 0x7c, 0x61, 0xff, 0xcb, 0xa0, 0xd9, 0x8e, 0x45, 0x19, 0xeb, 0xcc, 0x12, 0x23,
-	0x6c, 0x17, 0xf2, 0x87, 0x63, 0xe2, 0xbb, 0x61, 0xdf, 0x1b, 0x09, 0x43, 0x6d, 0xe8, 0x0d, 0x23,
-	0xe3, 0x3d, 0x1c, 0xe3, 0x98, 0x0e, 0xed, 0x47, 0x2d, 0x26, 0x0f, 0xb7, 0x6b, 0x09, 0x2d, 0x26,
-	0x23, 0xf8, 0x0a, 0xfb, 0xcc, 0x5f, 0xa6, 0x21, 0x2b, 0xed, 0xc1, 0xb2, 0x0f, 0x7b, 0x8c, 0x32,
-	0x53, 0x0c, 0x40, 0x65, 0xc8, 0xdc, 0x23, 0xe1, 0xa9, 0xd7, 0x4d, 0x72, 0x0c, 0x8e, 0x61, 0x8e,
-	0x21, 0xa8, 0xd0, 0x9e, 0xea, 0x05, 0xec, 0x40, 0x0b, 0x3a, 0x4f, 0x8c, 0x15, 0x7b, 0x54, 0xbd,
-	0xa6, 0xc2, 0xea, 0x7d, 0x94, 0xe6, 0xd8, 0xd1, 0x17, 0x76, 0xbe, 0x35, 0x5d, 0xef, 0xb5, 0x5c,
-	0x88, 0x35, 0x16, 0x74, 0x13, 0x0a, 0xd5, 0x66, 0x2c, 0x61, 0x91, 0x49, 0x78, 0x21, 0xc1, 0xe6,
-	0xb1, 0x00, 0x95, 0x81, 0xf2, 0x3b, 0x0a, 0x7f, 0x66, 0x96, 0xdf, 0x99, 0xe1, 0x57, 0x18, 0xd0,
-	0xeb, 0xaa, 0xb3, 0xb1, 0x94, 0x34, 0x65, 0x80, 0x18, 0x8b, 0x55, 0xb7, 0xdc, 0xd3, 0xab, 0x2d,
-	0x6b, 0x6c, 0xa7, 0x5a, 0x1d, 0x15, 0x8f, 0xf5, 0xda, 0xbc, 0xa7, 0x7b, 0x6f, 0x31, 0x9f, 0xc4,
-	0x1d, 0xe3, 0xb1, 0x46, 0x6d, 0xb7, 0xa0, 0xc0, 0x0e, 0x21, 0x18, 0x7b, 0xa3, 0x80, 0x5c, 0x50,
-	0xa9, 0x44, 0x9c, 0x9a, 0x5a, 0x9c, 0x36, 0xdc, 0x20, 0x8c, 0xa3, 0x57, 0x2e, 0xed, 0x32, 0x20,
-	0x45, 0x5d, 0x45, 0xf6, 0xed, 0xbe, 0xaf, 0xf8, 0x9a, 0x5c, 0xda, 0xff, 0x49, 0xd3, 0xea, 0x2c,
-	0xc8, 0x9e, 0xad, 0x53, 0xbe, 0x00, 0xf9, 0x9a, 0xef, 0x7b, 0x7e, 0xd5, 0xeb, 0x12, 0xa6, 0xe6,
-	0x32, 0x8e, 0x01, 0x34, 0x93, 0xb3, 0xc5, 0x3d, 0x12, 0x04, 0x6e, 0x8f, 0x88, 0xd6, 0x41, 0x83,
-	0xd1, 0x42, 0x53, 0x0f, 0x0e, 0x2a, 0x77, 0x09, 0x19, 0x13, 0x9f, 0x39, 0x55, 0x0e, 0x2b, 0x10,
-	0x74, 0x4b, 0xb3, 0xa0, 0xf0, 0x9a, 0x2b, 0x33, 0x7e, 0xcf, 0xd1, 0xc2, 0xf1, 0x35, 0x9b, 0xd3,
-	0x03, 0xf4, 0x86, 0x43, 0x77, 0xd4, 0xe5, 0x1d, 0x55, 0x36, 0xe1, 0x00, 0x15, 0x3c, 0xd6, 0xa8,
-	0xd1, 0x9b, 0x50, 0x60, 0xae, 0x24, 0x5e, 0x9f, 0x9b, 0x7d, 0xbd, 0x82, 0xc6, 0x2a, 0x2d, 0xda,
-	0x87, 0x95, 0xea, 0x60, 0x12, 0x84, 0xc4, 0x77, 0x08, 0x2d, 0xc8, 0x81, 0xf0, 0x1d, 0xad, 0x33,
-	0xd2, 0x29, 0xf0, 0x14, 0x07, 0xba, 0x09, 0xf9, 0xb8, 0x47, 0x07, 0xc6, 0xbe, 0xad, 0xb2, 0x47,
-	0xc8, 0x77, 0x27, 0xc4, 0x3f, 0xc7, 0x24, 0x98, 0x0c, 0x42, 0x1c, 0xb3, 0xa0, 0x9b, 0x00, 0x8a,
-	0xe7, 0x17, 0x98, 0x80, 0x2d, 0x55, 0xc0, 0xac, 0x23, 0x61, 0x98, 0xf2, 0xfe, 0x53, 0xd2, 0x39,
-	0x23, 0x3e, 0xbf, 0x9c, 0x2d, 0x25, 0x18, 0x4f, 0xc1, 0x63, 0x8d, 0xda, 0x7e, 0x87, 0xb5, 0xab,
-	0xbc, 0xc8, 0x45, 0x66, 0x79, 0x8d, 0x26, 0x48, 0x0a, 0x09, 0x8a, 0x06, 0x4b, 0xdb, 0x1b, 0x33,
-	0x87, 0x49, 0xb1, 0xe2, 0x28, 0x25, 0xad, 0xfd, 0xa2, 0x76, 0x10, 0xb4, 0xd6, 0x7c, 0x9f, 0xa5,
-	0x65, 0x51, 0x6b, 0xd8, 0xc2, 0xbe, 0x03, 0xcb, 0xb4, 0x5f, 0x6a, 0xbb, 0x27, 0x03, 0x72, 0x14,
-	0x10, 0x9f, 0xde, 0xb2, 0xe8, 0xef, 0x28, 0x2e, 0x98, 0xd1, 0x9a, 0xe2, 0xee, 0xbb, 0x41, 0xf0,
-	0x81, 0xe7, 0x77, 0x45, 0x3f, 0x17, 0xad, 0xed, 0x5f, 0x18, 0x54, 0x4b, 0xd6, 0x28, 0x26, 0xd6,
-	0xab, 0xf9, 0x05, 0x57, 0x6b, 0x39, 0x53, 0x53, 0x2d, 0x67, 0x7c, 0x17, 0x4c, 0xab, 0x77, 0xc1,
-	0x2d, 0x96, 0xda, 0xf5, 0xca, 0xab, 0x40, 0xec, 0xdf, 0x98, 0xd4, 0x87, 0x47, 0x0f, 0xfa, 0xbd,
-	0xea, 0xa9, 0x3b, 0xea, 0x11, 0xb4, 0x1b, 0x69, 0x27, 0x2e, 0x6e, 0x6b, 0x7a, 0x57, 0xc1, 0x50,
-	0xb1, 0x05, 0xf9, 0x3e, 0xf6, 0x00, 0x38, 0xbb, 0xd2, 0x8d, 0xe8, 0xe9, 0x5b, 0x79, 0x05, 0x8b,
-	0x72, 0x85, 0x1e, 0xb5, 0x61, 0xa5, 0x3e, 0xea, 0x87, 0x7d, 0x77, 0x70, 0x8f, 0x0c, 0x4f, 0x88,
-	0x2f, 0x8b, 0xee, 0x77, 0xe7, 0x49, 0x28, 0xeb, 0xe4, 0xbc, 0xf3, 0x9a, 0x92, 0xb1, 0x59, 0x81,
-	0xb5, 0x04, 0xb2, 0xa7, 0xba, 0xdb, 0xbe, 0x0c, 0xcb, 0xad, 0xd3, 0x49, 0xd8, 0xf5, 0x3e, 0x18,
-	0xf1, 0xc9, 0x04, 0x3d, 0x1b, 0xfa, 0x10, 0x1d, 0x99, 0x5c, 0xda, 0xbf, 0x4e, 0xc1, 0xa5, 0x56,
-	0xe7, 0x94, 0x74, 0x27, 0x03, 0x22, 0xa2, 0x3c, 0xf1, 0x74, 0xaf, 0xc1, 0xf2, 0xbe, 0xe7, 0x85,
-	0x41, 0xe8, 0xbb, 0xe3, 0x71, 0x7f, 0xd4, 0x63, 0x2f, 0xcd, 0x61, 0x1d, 0x48, 0x53, 0x83, 0x68,
-	0x9a, 0x99, 0x41, 0x53, 0xcc, 0xa0, 0x5a, 0x6a, 0x50, 0xd0, 0x58, 0xa5, 0xe5, 0x39, 0x29, 0x36,
-	0x95, 0xa8, 0xc6, 0xc5, 0x79, 0xa6, 0xc4, 0xfa, 0xe9, 0xdf, 0x9a, 0xda, 0xb1, 0x28, 0xc5, 0xcf,
-	0xe9, 0x89, 0x41, 0x21, 0xc0, 0x53, 0x16, 0xba, 0x0b, 0xab, 0xfc, 0x2e, 0xa1, 0x5c, 0x2e, 0x44,
-	0x66, 0xd5, 0x3a, 0x82, 0x19, 0x22, 0x3c, 0xcb, 0x47, 0xb5, 0x71, 0xc8, 0x80, 0x84, 0x44, 0x14,
-	0x3e, 0x91, 0x60, 0x35, 0x6d, 0x34, 0x02, 0xac, 0xd3, 0xdb, 0x83, 0x04, 0x6d, 0xd0, 0x2e, 0xa4,
-	0x69, 0xa0, 0x0a, 0xf7, 0xd6, 0x84, 0x69, 0x11, 0x2e, 0x9c, 0x9c, 0x11, 0xb3, 0x9b, 0x83, 0x1b,
-	0x9c, 0xd1, 0xae, 0xf9, 0xc4, 0x0d, 0xa4, 0xaf, 0x68, 0x30, 0xea, 0x2e, 0xda, 0xeb, 0x2f, 0x70,
-	0x17, 0x57, 0xaf, 0x1c, 0xd1, 0x58, 0xc6, 0x88, 0xc7, 0x32, 0xe8, 0x6d, 0xc8, 0x09, 0x1a, 0x39,
-	0x20, 0x7a, 0x5e, 0x3b, 0x06, 0xdd, 0xdb, 0xe4, 0xcd, 0x57, 0xb2, 0xd8, 0xbf, 0x4f, 0xd1, 0xa6,
-	0x8a, 0xbf, 0x90, 0xe6, 0x6b, 0x39, 0x19, 0x33, 0x94, 0xc9, 0xd8, 0x37, 0x6a, 0x36, 0x82, 0x2a,
-	0x51, 0x53, 0x9f, 0x63, 0xe6, 0x7c, 0x31, 0xa1, 0xd3, 0x62, 0xe3, 0xb6, 0xaf, 0xae, 0xa7, 0xff,
-	0xad, 0xc1, 0x27, 0xfd, 0x62, 0xac, 0xcd, 0x54, 0x90, 0xc5, 0x6b, 0x66, 0xac, 0x4d, 0x6f, 0x9c,
-	0x9c, 0x42, 0x53, 0x8d, 0x83, 0x36, 0x31, 0x14, 0x14, 0x64, 0x82, 0x6a, 0xaf, 0xea, 0xaa, 0x5d,
-	0x99, 0xb3, 0x7b, 0x55, 0xbd, 0x8f, 0x4d, 0x36, 0x0e, 0x79, 0x26, 0x3e, 0xf4, 0x0d, 0x9a, 0x60,
-	0xd0, 0x53, 0x75, 0x9e, 0xe4, 0x54, 0x9d, 0xff, 0xed, 0xa9, 0x3a, 0xc9, 0xa7, 0xfa, 0x27, 0x63,
-	0xba, 0x7d, 0x44, 0xaf, 0x41, 0x4e, 0x10, 0x4b, 0x3d, 0xd7, 0x12, 0x04, 0xc9, 0x1c, 0x23, 0x49,
-	0x29, 0x5b, 0x55, 0xb2, 0x99, 0xb3, 0x6c, 0x55, 0x9d, 0x4d, 0x92, 0xa2, 0x37, 0xd8, 0x54, 0x43,
-	0xf0, 0x71, 0x6f, 0x58, 0x4f, 0xba, 0x2e, 0x0a, 0xc6, 0x98, 0xd8, 0xfe, 0x99, 0x01, 0x05, 0xa1,
-	0x3a, 0x73, 0xc8, 0x37, 0x99, 0xde, 0xdc, 0xad, 0x0c, 0xe1, 0x56, 0x51, 0xc4, 0x09, 0x8c, 0xd6,
-	0xf4, 0x45, 0xe4, 0x68, 0x8f, 0x2b, 0xc1, 0x79, 0xb9, 0xf2, 0x45, 0x25, 0x5a, 0x05, 0x4a, 0x63,
-	0x8e, 0x19, 0xec, 0x5f, 0x19, 0xb0, 0x21, 0xda, 0x0b, 0xa1, 0x8f, 0xbc, 0x13, 0xbe, 0x04, 0x2b,
-	0xcd, 0xc9, 0xf0, 0xf0, 0x41, 0x2c, 0x9c, 0x47, 0xcb, 0x14, 0x94, 0x76, 0x02, 0x0c, 0x12, 0xe9,
-	0xcf, 0xbb, 0x3d, 0x1d, 0x88, 0x4a, 0x60, 0x49, 0xbe, 0x68, 0x0a, 0xca, 0x5b, 0xbf, 0x19, 0xb8,
-	0xfd, 0x13, 0x93, 0x4f, 0xde, 0x2f, 0x0c, 0xd7, 0xaf, 0xf7, 0xf8, 0xf6, 0x63, 0x53, 0x7c, 0x47,
-	0xa2, 0xa1, 0x77, 0x33, 0xca, 0x38, 0xdc, 0x35, 0xb6, 0x67, 0x7c, 0x8c, 0xc5, 0x1e, 0x23, 0xd1,
-	0x63, 0x8f, 0xdb, 0xfe, 0x66, 0x14, 0xba, 0xe6, 0x45, 0xfc, 0x73, 0x63, 0xb7, 0x05, 0x05, 0x45,
-	0x78, 0x42, 0xe7, 0x59, 0xd6, 0x63, 0x77, 0xee, 0x27, 0x12, 0x25, 0x78, 0x99, 0xd0, 0x0b, 0x13,
-	0xc2, 0xe3, 0x84, 0x26, 0x65, 0x84, 0xbf, 0xa5, 0xf4, 0xcb, 0x58, 0xa2, 0xe7, 0xdc, 0xd2, 0x42,
-	0x2f, 0xb1, 0x8a, 0xc4, 0x68, 0x79, 0x5d, 0x56, 0x83, 0x75, 0x37, 0xca, 0x8a, 0x62, 0xc6, 0xb4,
-	0x96, 0x90, 0x0b, 0xe5, 0xd5, 0x42, 0xe6, 0xcf, 0xd7, 0xe3, 0x03, 0x15, 0xbd, 0xec, 0x7a, 0xd2,
-	0x31, 0x48, 0xcf, 0x89, 0x0e, 0x7f, 0x37, 0x2a, 0xac, 0xa2, 0x87, 0x5d, 0x4b, 0x28, 0xa7, 0xf2,
-	0x65, 0xb2, 0x04, 0xdf, 0x90, 0x5f, 0x0a, 0x79, 0xe3, 0xa1, 0xf5, 0x86, 0x72, 0x6c, 0xa0, 0x7d,
-	0x2d, 0x6c, 0x0a, 0xff, 0x14, 0xbd, 0x98, 0xb8, 0xca, 0x66, 0x19, 0xf7, 0xd6, 0x74, 0x67, 0xa9,
-	0x53, 0xe1, 0x04, 0x4e, 0x54, 0x9b, 0xba, 0x65, 0x8a, 0xa9, 0xc0, 0x63, 0x9b, 0x54, 0x9d, 0xcb,
-	0xfe, 0x7b, 0x16, 0x2c, 0xa9, 0x6f, 0x34, 0x4a, 0x4f, 0x3a, 0xd3, 0xcb, 0x90, 0x69, 0x92, 0x47,
-	0x61, 0x74, 0xd7, 0x14, 0x2b, 0x74, 0x08, 0x05, 0xfe, 0xb4, 0x7f, 0x7e, 0x97, 0x9c, 0x8b, 0x1c,
-	0xfd, 0x6a, 0x92, 0x39, 0xa2, 0x59, 0xb8, 0x42, 0xcf, 0x2f, 0x64, 0xaa, 0x84, 0xa8, 0xc1, 0x4d,
-	0x2b, 0x0d, 0x6e, 0x64, 0xed, 0xc5, 0x2f, 0x65, 0xed, 0xcc, 0x17, 0xb6, 0x76, 0x17, 0xac, 0xa9,
-	0x2e, 0x9a, 0x56, 0x73, 0xba, 0xd5, 0x9d, 0x0b, 0xb7, 0x3a, 0xcd, 0xa4, 0x06, 0xff, 0x8c, 0x44,
-	0x54, 0x57, 0x0b, 0x0d, 0xef, 0x3c, 0x5f, 0xb9, 0x50, 0x7c, 0x44, 0xcd, 0xed, 0x18, 0x73, 0xab,
-	0x4e, 0x9d, 0x7f, 0x62, 0xa7, 0x56, 0xc2, 0x0e, 0xbe, 0x50, 0xd8, 0x15, 0x9e, 0x22, 0xec, 0xa6,
-	0x92, 0xc4, 0xd2, 0x53, 0x27, 0x89, 0x99, 0x08, 0x58, 0xfe, 0x22, 0x11, 0xb0, 0x79, 0x13, 0xac,
-	0x69, 0x87, 0x4c, 0xfe, 0x16, 0x9a, 0xfc, 0xe1, 0x65, 0xf3, 0x7d, 0xd8, 0x48, 0x3c, 0xe5, 0xa7,
-	0x4c, 0xb8, 0xda, 0xf8, 0x4f, 0x11, 0xbf, 0xc7, 0xbe, 0x9a, 0xcf, 0xa9, 0x0e, 0x8f, 0x53, 0xce,
-	0xae, 0x43, 0x41, 0xfd, 0xe0, 0xfe, 0x25, 0xbe, 0x2b, 0xda, 0xbf, 0x33, 0x61, 0x3d, 0x69, 0xd2,
-	0x77, 0xc1, 0x3c, 0xf9, 0xfe, 0xcc, 0x1f, 0x17, 0xca, 0x8f, 0x9b, 0x1b, 0xea, 0x7f, 0x60, 0x98,
-	0xa9, 0xf2, 0xcf, 0xe6, 0x6f, 0x0c, 0xed, 0xc7, 0xff, 0x8d, 0xe1, 0xa2, 0x66, 0x59, 0xb1, 0xa8,
-	0x62, 0xeb, 0xd2, 0x0f, 0x00, 0x8e, 0xc6, 0x5d, 0x37, 0xe4, 0xd3, 0x95, 0x2b, 0xb0, 0xa6, 0x7d,
-	0x51, 0xe4, 0x28, 0x6b, 0x01, 0x6d, 0xc0, 0xaa, 0xfc, 0x8a, 0xd8, 0x68, 0x35, 0x05, 0xd8, 0x40,
-	0x6b, 0x70, 0x89, 0xba, 0x23, 0xd3, 0x47, 0x00, 0x4d, 0xb4, 0x0c, 0xf9, 0x76, 0xeb, 0x50, 0x2c,
-	0x53, 0xa5, 0x32, 0xe4, 0xa3, 0x7f, 0xa1, 0xa0, 0x4b, 0x50, 0x68, 0x7a, 0xfe, 0xd0, 0x1d, 0xb0,
-	0xa5, 0xb5, 0x80, 0x2c, 0x58, 0x6a, 0xf7, 0x87, 0xc4, 0x9b, 0x84, 0x1c, 0x62, 0x94, 0xfe, 0x60,
-	0x02, 0xc4, 0xf3, 0x72, 0xb4, 0x02, 0xd0, 0x6e, 0x1d, 0x1e, 0x1f, 0xdd, 0x77, 0x2a, 0xed, 0x9a,
-	0xb5, 0x80, 0x00, 0x32, 0x95, 0xfb, 0xf7, 0x6b, 0x4d, 0xc7, 0x32, 0x50, 0x0e, 0xd2, 0xb8, 0x56,
-	0x71, 0x2c, 0x13, 0x2d, 0x41, 0xae, 0x8d, 0x8f, 0x9a, 0x55, 0x4a, 0x93, 0xa2, 0x42, 0xef, 0xd4,
-	0xda, 0xc7, 0x11, 0x24, 0x8d, 0x0a, 0x90, 0xad, 0x1e, 0x36, 0x9b, 0xb5, 0x6a, 0xdb, 0x5a, 0xa4,
-	0x22, 0xc5, 0xe2, 0x18, 0x1f, 0x5a, 0x19, 0xb4, 0x0a, 0xcb, 0x8d, 0xc3, 0x3b, 0xc7, 0x07, 0xb5,
-	0x0a, 0x6e, 0xef, 0xd7, 0x2a, 0x6d, 0x2b, 0x4b, 0x25, 0x54, 0x9b, 0x0a, 0x24, 0x47, 0x21, 0x8e,
-	0x0a, 0xc9, 0x23, 0x04, 0x2b, 0xd5, 0x83, 0x5a, 0xf5, 0xee, 0xf1, 0x41, 0xe5, 0x6e, 0xad, 0x76,
-	0xbf, 0x86, 0x2d, 0xa0, 0x06, 0xa4, 0x6f, 0xae, 0x36, 0x8e, 0x5a, 0xed, 0x1a, 0x3e, 0x76, 0x6a,
-	0xed, 0x4a, 0xbd, 0xd1, 0xb2, 0x0a, 0x94, 0x98, 0x22, 0x5a, 0x07, 0x15, 0xec, 0x1c, 0xd7, 0x9b,
-	0xb7, 0x0f, 0xad, 0x25, 0x26, 0xa0, 0x79, 0x5c, 0x69, 0x34, 0x0e, 0xa9, 0x96, 0xc7, 0x75, 0xc7,
-	0x5a, 0xa6, 0x86, 0x56, 0x05, 0xb4, 0xda, 0x54, 0xff, 0x15, 0x66, 0x68, 0x66, 0x81, 0xe3, 0x6a,
-	0xf3, 0xb8, 0x51, 0xd9, 0xaf, 0x35, 0xac, 0x4b, 0xa5, 0x26, 0x40, 0xfc, 0x19, 0x94, 0xee, 0x8a,
-	0x9e, 0x05, 0x87, 0x58, 0x0b, 0xd4, 0x24, 0xf5, 0x51, 0x48, 0xfc, 0x91, 0x3b, 0xb0, 0x0c, 0x6a,
-	0x78, 0x76, 0xb2, 0xd1, 0x29, 0xad, 0x8a, 0x2f, 0xca, 0x98, 0xfc, 0x90, 0x74, 0x42, 0xd2, 0xb5,
-	0x52, 0xa5, 0x12, 0xe4, 0xa3, 0xaf, 0x85, 0x94, 0xbd, 0x45, 0x42, 0xb6, 0xb2, 0x16, 0x28, 0x3b,
-	0x9f, 0xfd, 0x70, 0x80, 0x51, 0xfa, 0xd0, 0x04, 0x24, 0x33, 0xbb, 0xe2, 0x40, 0xf4, 0xb4, 0xfa,
-	0x9d, 0x33, 0xd5, 0x6f, 0x94, 0x0f, 0x59, 0x91, 0xdf, 0x6c, 0xc0, 0xaa, 0x33, 0x03, 0x36, 0xd1,
-	0x65, 0x7a, 0x51, 0xed, 0x4d, 0xc3, 0x53, 0xf4, 0xed, 0x77, 0x48, 0x18, 0xb9, 0x63, 0x1a, 0x3d,
-	0x37, 0x93, 0xbe, 0x04, 0x6a, 0x91, 0x1a, 0xb5, 0x45, 0xb8, 0x33, 0x09, 0x58, 0x06, 0x15, 0x61,
-	0x5d, 0xbf, 0xcc, 0x08, 0x4c, 0x16, 0x5d, 0x85, 0xe7, 0x5b, 0x24, 0x9c, 0xad, 0x9d, 0x82, 0x20,
-	0x87, 0x36, 0xe1, 0xb2, 0x20, 0x88, 0x92, 0xaf, 0xc0, 0xe5, 0xa9, 0x09, 0xf9, 0xb3, 0xb0, 0x9a,
-	0x05, 0xa5, 0x8f, 0x0c, 0x58, 0xd6, 0x6a, 0x3b, 0x3d, 0x39, 0x09, 0x10, 0x5d, 0xbc, 0xb5, 0x40,
-	0xf5, 0x97, 0x40, 0x6d, 0x32, 0x6a, 0x19, 0xe8, 0x3b, 0xf0, 0xed, 0x19, 0x94, 0x4c, 0xd1, 0x98,
-	0x74, 0x48, 0xff, 0x21, 0xe9, 0x5a, 0x26, 0x7a, 0x1e, 0xae, 0xcc, 0x90, 0xdd, 0x76, 0xfb, 0x03,
-	0x7a, 0x90, 0xea, 0x3b, 0xf1, 0x64, 0x34, 0xa2, 0x82, 0xd3, 0xa5, 0x93, 0xa4, 0xee, 0x82, 0x9a,
-	0x46, 0x83, 0xc6, 0x3a, 0x4e, 0x63, 0xa4, 0x24, 0x63, 0x06, 0xd3, 0x0a, 0xbd, 0xf1, 0x98, 0x6a,
-	0x55, 0x3a, 0x05, 0x6b, 0x7a, 0x14, 0x4e, 0x5d, 0xa2, 0xd2, 0xed, 0x8a, 0xf4, 0x63, 0x2d, 0x50,
-	0xab, 0x61, 0x32, 0xf4, 0x1e, 0x12, 0x09, 0x32, 0x68, 0x6c, 0xb5, 0x42, 0xd7, 0x0f, 0x25, 0xc4,
-	0xa4, 0x27, 0x4e, 0xa5, 0x4a, 0x40, 0x8a, 0x4a, 0xb9, 0xdb, 0x1f, 0x0c, 0xde, 0xf3, 0x86, 0x27,
-	0x7d, 0x62, 0xa5, 0x4b, 0x6f, 0x69, 0x23, 0x64, 0x8a, 0xa6, 0x05, 0x87, 0x43, 0xac, 0x05, 0x9a,
-	0x83, 0x9c, 0xa6, 0x5c, 0x1a, 0x74, 0x59, 0x8d, 0x96, 0xe6, 0x7e, 0xed, 0xd3, 0x7f, 0x6e, 0x2d,
-	0x7c, 0xf2, 0xf9, 0x96, 0xf1, 0xe9, 0xe7, 0x5b, 0xc6, 0x3f, 0x3e, 0xdf, 0x32, 0xde, 0xdb, 0x55,
-	0xfe, 0xd1, 0x3a, 0x74, 0x43, 0xbf, 0xff, 0xc8, 0xf3, 0xfb, 0xbd, 0xfe, 0x48, 0x2e, 0x46, 0xe4,
-	0xc6, 0xf8, 0xac, 0x77, 0x63, 0x7c, 0x72, 0x23, 0x4e, 0xa9, 0x27, 0x19, 0xf6, 0x77, 0xd6, 0xdd,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x05, 0x97, 0x31, 0x4a, 0x2d, 0x2b, 0x00, 0x00,
+	// 3000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x55, 0xcb, 0x6f, 0x3e, 0x4a, 0xf2, 0x6a, 0x24, 0xdb, 0x8c, 0x92, 0xca, 0xea, 0xc6, 0x0d, 0x1c,
+	0xa5, 0xa1, 0x01, 0x19, 0x09, 0x92, 0xc6, 0xb1, 0x41, 0x71, 0x69, 0x8b, 0x31, 0xbd, 0x72, 0x86,
+	0x54, 0x0f, 0x01, 0x02, 0x75, 0x45, 0x8e, 0x29, 0x56, 0x24, 0x97, 0xdd, 0x5d, 0x3a, 0x56, 0x4f,
+	0xbd, 0xb4, 0x40, 0xd1, 0x5e, 0x8a, 0xe6, 0x10, 0x14, 0x45, 0x0f, 0xbd, 0xf4, 0xd0, 0x6b, 0x0f,
+	0xfd, 0x03, 0x05, 0x72, 0x29, 0x90, 0x53, 0x2f, 0x2d, 0x82, 0x36, 0x3d, 0xf6, 0xd0, 0x5b, 0xcf,
+	0xc5, 0x7c, 0xed, 0xce, 0x70, 0x57, 0xb2, 0x95, 0xb8, 0x41, 0x91, 0xf6, 0xc4, 0x9d, 0xf7, 0x35,
+	0x6f, 0xde, 0xd7, 0xbc, 0x99, 0x21, 0x98, 0x23, 0x6f, 0x10, 0x10, 0xff, 0xd1, 0xb0, 0x47, 0x6a,
+	0x53, 0xdf, 0x0b, 0x3d, 0x04, 0x31, 0x64, 0xfd, 0xd5, 0xc1, 0x30, 0x3c, 0x9a, 0x1d, 0xd6, 0x7a,
+	0xde, 0xf8, 0xfa, 0xc0, 0x1b, 0x78, 0xd7, 0x19, 0xc9, 0xe1, 0xec, 0x21, 0x1b, 0xb1, 0x01, 0xfb,
+	0xe2, 0xac, 0xeb, 0xcb, 0x63, 0x12, 0xba, 0x7d, 0x37, 0x74, 0xf9, 0xd8, 0xfa, 0x6d, 0x16, 0x8a,
+	0x0d, 0xa7, 0x13, 0x7a, 0x3e, 0x41, 0x08, 0x72, 0xfb, 0xfb, 0x2d, 0xbb, 0x6a, 0x6c, 0x1a, 0xd7,
+	0xca, 0x98, 0x7d, 0xa3, 0x97, 0x60, 0xb9, 0xc3, 0x67, 0xaa, 0xf7, 0xfb, 0x3e, 0x09, 0x82, 0x6a,
+	0x86, 0x61, 0xe7, 0xa0, 0x68, 0x03, 0xa0, 0xf3, 0x6e, 0x5b, 0xd2, 0x64, 0x19, 0x8d, 0x02, 0x41,
+	0x35, 0x40, 0x6d, 0xaf, 0x77, 0x3c, 0x27, 0x2b, 0xc7, 0xe8, 0x52, 0x30, 0x54, 0x5e, 0x23, 0x1c,
+	0x49, 0xba, 0x3c, 0x97, 0x17, 0x43, 0xd0, 0x55, 0xc8, 0x61, 0x6f, 0x44, 0xaa, 0x85, 0x4d, 0xe3,
+	0xda, 0xf2, 0xb6, 0x59, 0x8b, 0x96, 0xd5, 0x70, 0x28, 0x1c, 0x33, 0x2c, 0x5d, 0x51, 0x77, 0xd8,
+	0x3b, 0xae, 0x16, 0x37, 0x8d, 0x6b, 0x39, 0xcc, 0xbe, 0xd1, 0x2b, 0x90, 0xef, 0x84, 0x6e, 0x48,
+	0xaa, 0x25, 0xc6, 0x7a, 0xb1, 0xa6, 0x98, 0xd7, 0xf1, 0xfa, 0x84, 0x21, 0x31, 0xa7, 0x41, 0x6f,
+	0x43, 0xa1, 0xed, 0x1e, 0x92, 0x51, 0x50, 0x2d, 0x6f, 0x66, 0xaf, 0x55, 0xb6, 0xaf, 0xa8, 0xd4,
+	0xc2, 0x6e, 0x35, 0x4e, 0xd1, 0x9c, 0x84, 0xfe, 0xc9, 0x4e, 0xee, 0xe3, 0x4f, 0xaf, 0x2c, 0x60,
+	0xc1, 0xb4, 0xee, 0x40, 0x45, 0x41, 0x22, 0x13, 0xb2, 0xc7, 0xe4, 0x44, 0xd8, 0x97, 0x7e, 0xa2,
+	0x97, 0x21, 0xff, 0xc8, 0x1d, 0xcd, 0x08, 0xb3, 0x6a, 0x65, 0x7b, 0x35, 0x5e, 0x07, 0xe3, 0x6b,
+	0x0f, 0x83, 0x10, 0x73, 0x8a, 0x6f, 0x65, 0xde, 0x30, 0xac, 0xbf, 0x67, 0xa0, 0x68, 0x3f, 0x03,
+	0x6f, 0x49, 0xbb, 0x64, 0xd3, 0xec, 0x92, 0x7b, 0x0a, 0xbb, 0xbc, 0x06, 0x85, 0xce, 0x91, 0xeb,
+	0xf7, 0xa9, 0x6b, 0xa8, 0x5d, 0x2e, 0xab, 0xd4, 0xb6, 0xc3, 0x70, 0xad, 0xc9, 0x43, 0x4f, 0xda,
+	0x83, 0x13, 0xa3, 0x6d, 0x58, 0x6b, 0x7b, 0x83, 0xd0, 0x1d, 0x8e, 0xa8, 0x42, 0xc4, 0x97, 0x5a,
+	0x16, 0x98, 0x96, 0xa9, 0xb8, 0x53, 0x22, 0xa7, 0xf8, 0x94, 0x91, 0x53, 0x4a, 0x44, 0xce, 0x26,
+	0x54, 0xee, 0x36, 0x30, 0x09, 0xc9, 0x24, 0x1c, 0x7a, 0x93, 0x6a, 0x79, 0xd3, 0xb8, 0x96, 0xc5,
+	0x2a, 0xc8, 0xfa, 0x83, 0x01, 0xa5, 0xb6, 0x37, 0xf8, 0x2f, 0x30, 0xf3, 0x4d, 0x28, 0x61, 0x32,
+	0x1d, 0x0d, 0x7b, 0xae, 0x34, 0xf4, 0xba, 0x4a, 0xdf, 0xf6, 0x06, 0x02, 0xad, 0xd8, 0x3a, 0xe2,
+	0xb0, 0xfe, 0x69, 0xc0, 0x22, 0x5d, 0x87, 0x74, 0x06, 0xaa, 0x42, 0x91, 0x0f, 0xf8, 0x72, 0x72,
+	0x58, 0x0e, 0xd1, 0x8e, 0x32, 0x51, 0x86, 0x4d, 0xf4, 0xd2, 0xdc, 0x44, 0x91, 0x94, 0x9a, 0x24,
+	0x64, 0x31, 0x1d, 0x4f, 0x87, 0xd6, 0x20, 0xdf, 0x9c, 0x7a, 0xbd, 0x23, 0xb1, 0x5c, 0x3e, 0x40,
+	0xeb, 0x50, 0x6a, 0x13, 0xb7, 0x4f, 0xfc, 0x96, 0xcd, 0x96, 0x9c, 0xc3, 0xd1, 0x98, 0xd9, 0x87,
+	0xf8, 0xe3, 0x6a, 0x5e, 0xd8, 0x87, 0xf8, 0xe3, 0xf5, 0xb7, 0x60, 0x49, 0x9b, 0x40, 0x4d, 0x9a,
+	0x1c, 0x4f, 0x9a, 0x35, 0x35, 0x69, 0xca, 0x6a, 0x7e, 0x3c, 0x82, 0x65, 0xdd, 0x26, 0xe8, 0x8e,
+	0x6e, 0x02, 0x26, 0xa6, 0xb2, 0x5d, 0x3d, 0x6d, 0x71, 0x3b, 0x25, 0x6a, 0xc3, 0x4f, 0x3e, 0xbd,
+	0x62, 0x60, 0xdd, 0x74, 0x2f, 0x40, 0x59, 0x8a, 0xb5, 0xd9, 0xbc, 0x39, 0x1c, 0x03, 0xac, 0x0f,
+	0x33, 0x60, 0x8a, 0x6a, 0xb0, 0x4b, 0x5c, 0x3f, 0x3c, 0x24, 0x6e, 0xf8, 0x15, 0x2c, 0xa7, 0x35,
+	0x40, 0x5d, 0x37, 0x90, 0xb2, 0x1b, 0x3e, 0x71, 0x43, 0xd2, 0x67, 0xa9, 0x58, 0xc2, 0x29, 0x18,
+	0xeb, 0x75, 0x58, 0x6c, 0x38, 0xf5, 0xd1, 0xc8, 0xeb, 0xb9, 0x21, 0x69, 0xd9, 0x29, 0xf5, 0x6f,
+	0x0d, 0xf2, 0x3b, 0x6e, 0xd8, 0x3b, 0x12, 0x26, 0xe5, 0x03, 0xeb, 0x87, 0x19, 0x58, 0x91, 0x09,
+	0x78, 0xb6, 0x3d, 0x37, 0xa1, 0x82, 0xdd, 0x87, 0xa1, 0x6e, 0x4c, 0x15, 0x94, 0x62, 0xf1, 0x6c,
+	0xaa, 0xc5, 0xaf, 0xc2, 0xd2, 0x5d, 0x2f, 0x08, 0x86, 0x53, 0xdd, 0x98, 0x3a, 0xf0, 0x8b, 0x25,
+	0xe4, 0x29, 0xf6, 0x2b, 0x9c, 0x6a, 0xbf, 0x26, 0x54, 0x6c, 0xe7, 0x69, 0xd2, 0xf7, 0xec, 0xe8,
+	0xfc, 0x73, 0x06, 0x4c, 0xfb, 0x59, 0x46, 0x67, 0x5c, 0xfd, 0xb3, 0xe7, 0xa9, 0xfe, 0xe9, 0xcb,
+	0xcf, 0x9d, 0xb6, 0xfc, 0x53, 0x77, 0x8b, 0xfc, 0xb9, 0x77, 0x8b, 0xc2, 0x53, 0x26, 0x46, 0xf1,
+	0x49, 0xbb, 0x45, 0x29, 0xb9, 0x5b, 0xfc, 0x38, 0x03, 0x25, 0xdc, 0xb9, 0xcf, 0x0b, 0xb6, 0x09,
+	0xd9, 0x6e, 0xe0, 0xc9, 0x62, 0xd5, 0x0d, 0x3c, 0x1a, 0xe1, 0xad, 0x49, 0x9f, 0x3c, 0x96, 0x11,
+	0xce, 0x06, 0x34, 0xda, 0xda, 0xc4, 0x0d, 0xc8, 0xae, 0x37, 0xe2, 0xa5, 0x91, 0xd7, 0x4c, 0x1d,
+	0x88, 0x2c, 0x58, 0xec, 0xfa, 0xb3, 0x09, 0xcd, 0x9e, 0x7e, 0x3b, 0x98, 0x88, 0xfa, 0xa9, 0xc1,
+	0xd0, 0x3b, 0xb0, 0xc8, 0x99, 0x86, 0x41, 0xe8, 0xf9, 0x27, 0xd5, 0x7c, 0xb2, 0x7a, 0x4b, 0xed,
+	0x6a, 0x2a, 0x21, 0xaf, 0xde, 0x1a, 0xef, 0xfa, 0x6d, 0x58, 0x49, 0x90, 0x3c, 0xa9, 0xfe, 0xe6,
+	0xd4, 0xfa, 0xfb, 0x3e, 0x94, 0x59, 0x0a, 0xf4, 0x3c, 0xbf, 0x4f, 0x19, 0xa9, 0xd2, 0x82, 0x91,
+	0xea, 0xba, 0x05, 0xb9, 0xee, 0xc9, 0x94, 0xf3, 0x2d, 0x6f, 0x5f, 0xd2, 0x74, 0x64, 0x3c, 0x14,
+	0x8b, 0x19, 0x0d, 0x8d, 0x4f, 0xdb, 0x0d, 0x5d, 0x66, 0x98, 0x45, 0xcc, 0xbe, 0xad, 0x8f, 0x0c,
+	0x00, 0x26, 0xff, 0x7b, 0x33, 0x12, 0xb0, 0x10, 0x76, 0xdc, 0x31, 0x91, 0x21, 0x4c, 0xbf, 0xd5,
+	0x1c, 0xc9, 0xe8, 0x39, 0x22, 0xd4, 0xc9, 0xc6, 0xea, 0x54, 0xa1, 0x78, 0xdf, 0x7d, 0xdc, 0x19,
+	0x7e, 0x9f, 0x08, 0xcb, 0xca, 0x21, 0xcd, 0x27, 0x19, 0xc6, 0xb6, 0xd8, 0x9d, 0x62, 0x00, 0x53,
+	0xcd, 0x69, 0xd9, 0x2c, 0xaa, 0x72, 0x98, 0x7d, 0x5b, 0x16, 0x40, 0x37, 0xf0, 0xa4, 0x66, 0x6b,
+	0x90, 0x6f, 0x78, 0xb3, 0x49, 0x28, 0x16, 0xcf, 0x07, 0xd6, 0x3f, 0x0c, 0x5a, 0x0f, 0x59, 0x1e,
+	0xb2, 0xee, 0x2e, 0x35, 0x07, 0x6f, 0x40, 0x79, 0x6f, 0x4a, 0x7c, 0x97, 0x85, 0x5b, 0x26, 0xd9,
+	0x23, 0x34, 0x1c, 0xc6, 0xbb, 0x37, 0xc5, 0x31, 0x1d, 0xda, 0x89, 0xda, 0x54, 0x9e, 0x90, 0x57,
+	0x53, 0xda, 0x54, 0x46, 0xf0, 0x25, 0xf6, 0xaa, 0x3f, 0xcd, 0x41, 0x51, 0xda, 0x83, 0xd5, 0x27,
+	0xf6, 0x19, 0xd5, 0xae, 0x18, 0x80, 0x6a, 0x50, 0xb8, 0x4f, 0xc2, 0x23, 0xaf, 0x9f, 0x16, 0x18,
+	0x1c, 0xc3, 0x02, 0x43, 0x50, 0xa1, 0x9b, 0x6a, 0x14, 0x30, 0x87, 0x56, 0x74, 0x9e, 0x18, 0x2b,
+	0xd6, 0xa8, 0x46, 0x4d, 0x9d, 0x75, 0x04, 0x51, 0x21, 0x64, 0xae, 0xaf, 0x6c, 0x7f, 0x6d, 0x8e,
+	0x5f, 0xaf, 0x96, 0x58, 0x63, 0x41, 0xb7, 0xa0, 0xd2, 0x70, 0x62, 0x09, 0x79, 0x26, 0xe1, 0x85,
+	0x14, 0x9b, 0xc7, 0x02, 0x54, 0x06, 0xca, 0x6f, 0x2b, 0xfc, 0x85, 0x24, 0xbf, 0x9d, 0xe0, 0x57,
+	0x18, 0xd0, 0xeb, 0x6a, 0xb0, 0x55, 0x8b, 0x49, 0x03, 0xc4, 0x58, 0xac, 0x86, 0xe5, 0x4d, 0x7d,
+	0x3f, 0xae, 0x96, 0x92, 0xcd, 0x90, 0x8a, 0xc7, 0x1a, 0x35, 0xe7, 0x8e, 0x43, 0xa9, 0x5a, 0x4e,
+	0xe3, 0x8e, 0xf1, 0x58, 0xa3, 0xb6, 0x3a, 0x50, 0x61, 0x4e, 0x08, 0xa6, 0xde, 0x24, 0x20, 0x67,
+	0xec, 0x65, 0x22, 0x4f, 0x33, 0x5a, 0x9e, 0xb6, 0xdd, 0x20, 0x8c, 0xb3, 0x57, 0x0e, 0xad, 0x1a,
+	0x20, 0x45, 0x5d, 0x45, 0xf6, 0x9d, 0xa1, 0xaf, 0xc4, 0x9a, 0x1c, 0x5a, 0xff, 0xca, 0x41, 0x29,
+	0x22, 0x7b, 0xb6, 0x41, 0xf9, 0x02, 0x94, 0x9b, 0xbe, 0xef, 0xf9, 0x0d, 0xaf, 0x4f, 0x98, 0x9a,
+	0x4b, 0x38, 0x06, 0xd0, 0x4a, 0xce, 0x06, 0xf7, 0x49, 0x10, 0xb8, 0x03, 0x22, 0x9a, 0x0b, 0x0d,
+	0x46, 0xb7, 0xa2, 0x56, 0xb0, 0x5b, 0xbf, 0x47, 0xc8, 0x94, 0xf8, 0x2c, 0xa8, 0x4a, 0x58, 0x81,
+	0xa0, 0xdb, 0x9a, 0x05, 0x45, 0xd4, 0x5c, 0x4e, 0xc4, 0x3d, 0x47, 0x8b, 0xc0, 0xd7, 0x6c, 0x4e,
+	0x1d, 0xe8, 0x8d, 0xc7, 0xee, 0xa4, 0xcf, 0x7b, 0xae, 0x62, 0x8a, 0x03, 0x15, 0x3c, 0xd6, 0xa8,
+	0xd1, 0x9b, 0x50, 0x61, 0xa1, 0x24, 0xa6, 0x2f, 0x25, 0xa7, 0x57, 0xd0, 0x58, 0xa5, 0x45, 0x3b,
+	0xb0, 0xdc, 0x18, 0xcd, 0x82, 0x90, 0xf8, 0x36, 0xa1, 0x5b, 0x76, 0x20, 0x62, 0x47, 0xeb, 0x9d,
+	0x74, 0x0a, 0x3c, 0xc7, 0x81, 0x6e, 0x41, 0x39, 0xee, 0xe2, 0x81, 0xb1, 0x6f, 0xaa, 0xec, 0x11,
+	0xf2, 0xdd, 0x19, 0xf1, 0x4f, 0x30, 0x09, 0x66, 0xa3, 0x10, 0xc7, 0x2c, 0xe8, 0x16, 0x80, 0x12,
+	0xf9, 0x15, 0x26, 0x60, 0x43, 0x15, 0x90, 0x0c, 0x24, 0x0c, 0x73, 0xd1, 0x7f, 0x44, 0x7a, 0xc7,
+	0xc4, 0xe7, 0xc7, 0xb7, 0xc5, 0x14, 0xe3, 0x29, 0x78, 0xac, 0x51, 0x5b, 0xef, 0xb0, 0x86, 0x96,
+	0x6f, 0x72, 0x91, 0x59, 0x5e, 0x83, 0x22, 0x87, 0x04, 0x55, 0x83, 0x95, 0xed, 0x8b, 0x09, 0x67,
+	0x52, 0xac, 0x70, 0xa5, 0xa4, 0xb5, 0x5e, 0xd4, 0x1c, 0x41, 0xf7, 0x9a, 0x6f, 0xb3, 0xb2, 0x2c,
+	0xf6, 0x1a, 0x36, 0xb0, 0xee, 0xc2, 0x12, 0xed, 0xa8, 0xba, 0xee, 0xe1, 0x88, 0xec, 0x07, 0xc4,
+	0xa7, 0xe7, 0x30, 0xfa, 0x3b, 0x89, 0x37, 0xcc, 0x68, 0x4c, 0x71, 0x0f, 0xdc, 0x20, 0xf8, 0xc0,
+	0xf3, 0xfb, 0xa2, 0xe3, 0x8b, 0xc6, 0xd6, 0x4f, 0x0c, 0x28, 0x8a, 0x56, 0x32, 0x75, 0xbf, 0x3a,
+	0x7d, 0xc3, 0xd5, 0x9a, 0xd2, 0xec, 0x5c, 0x53, 0x1a, 0x9f, 0x16, 0x73, 0xea, 0x69, 0x71, 0x83,
+	0x95, 0x76, 0x7d, 0xe7, 0x55, 0x20, 0xd6, 0x2f, 0x32, 0x34, 0x86, 0x27, 0x0f, 0x87, 0x83, 0xc6,
+	0x91, 0x3b, 0x19, 0x10, 0x74, 0x23, 0xd2, 0x4e, 0x1c, 0xed, 0x56, 0xf5, 0xae, 0x82, 0xa1, 0x62,
+	0x0b, 0xf2, 0x75, 0xdc, 0x04, 0xe0, 0xec, 0x4a, 0x37, 0xa2, 0x97, 0x6f, 0x65, 0x0a, 0x96, 0xe5,
+	0x0a, 0x3d, 0xea, 0xc2, 0x72, 0x6b, 0x32, 0x0c, 0x87, 0xee, 0xe8, 0x3e, 0x19, 0x1f, 0x12, 0x5f,
+	0x6e, 0xba, 0xdf, 0x3c, 0x4d, 0x42, 0x4d, 0x27, 0xe7, 0x9d, 0xd7, 0x9c, 0x8c, 0xf5, 0x3a, 0xac,
+	0xa6, 0x90, 0x9d, 0xeb, 0xf4, 0xfb, 0x32, 0x2c, 0x75, 0x8e, 0x66, 0x61, 0xdf, 0xfb, 0x60, 0xc2,
+	0xef, 0x2e, 0xa8, 0x6f, 0xe8, 0x47, 0xe4, 0x32, 0x39, 0xb4, 0x7e, 0x9e, 0x85, 0x0b, 0x9d, 0xde,
+	0x11, 0xe9, 0xcf, 0x46, 0x44, 0x64, 0x79, 0xaa, 0x77, 0xaf, 0xc2, 0xd2, 0x8e, 0xe7, 0x85, 0x41,
+	0xe8, 0xbb, 0xd3, 0xe9, 0x70, 0x32, 0x60, 0x93, 0x96, 0xb0, 0x0e, 0xa4, 0xa5, 0x41, 0xb4, 0xd5,
+	0xcc, 0xa0, 0x59, 0x66, 0x50, 0xad, 0x34, 0x28, 0x68, 0xac, 0xd2, 0xf2, 0x9a, 0x14, 0x9b, 0xaa,
+	0x9a, 0x4b, 0x49, 0x2b, 0x05, 0x8f, 0x75, 0xef, 0xdf, 0x9e, 0x5b, 0xb1, 0xd8, 0x8a, 0x9f, 0xd3,
+	0x0b, 0x83, 0x42, 0x80, 0xe7, 0x2c, 0x74, 0x0f, 0x56, 0xf8, 0x69, 0x43, 0x39, 0x7e, 0x54, 0x0b,
+	0xc9, 0x8e, 0x20, 0x41, 0x84, 0x93, 0x7c, 0x54, 0x1b, 0x9b, 0x8c, 0x48, 0x48, 0xc4, 0xc6, 0x57,
+	0x2d, 0x26, 0xb5, 0xd1, 0x08, 0xb0, 0x4e, 0x6f, 0x8d, 0x52, 0xb4, 0x41, 0x37, 0x20, 0x47, 0x13,
+	0xb5, 0x6a, 0x24, 0x85, 0x69, 0x19, 0x2e, 0x82, 0x9c, 0x11, 0xb3, 0x93, 0x83, 0x1b, 0x1c, 0xd3,
+	0xae, 0xf9, 0xd0, 0x0d, 0x64, 0xac, 0x68, 0x30, 0x1a, 0x2e, 0xda, 0xf4, 0x67, 0x84, 0x8b, 0xab,
+	0xef, 0x1c, 0xd1, 0xc5, 0x8d, 0x11, 0x5f, 0xdc, 0xa0, 0xb7, 0xa1, 0x24, 0x68, 0xe4, 0x15, 0xd2,
+	0xf3, 0x9a, 0x1b, 0xf4, 0x68, 0x93, 0x67, 0x63, 0xc9, 0x62, 0xfd, 0x3a, 0x4b, 0x9b, 0x2a, 0x3e,
+	0x21, 0xad, 0xd7, 0xf2, 0xee, 0xcc, 0x50, 0xee, 0xce, 0xfe, 0xa7, 0x6e, 0x4f, 0x50, 0x3d, 0x6a,
+	0xea, 0x4b, 0xcc, 0x9c, 0x2f, 0xa6, 0x74, 0x5a, 0xec, 0x42, 0xee, 0xcb, 0xeb, 0xe9, 0x7f, 0x69,
+	0xf0, 0xd7, 0x02, 0x71, 0x35, 0xce, 0x54, 0x90, 0x9b, 0x57, 0xe2, 0x6a, 0x9c, 0x9e, 0x38, 0x39,
+	0x85, 0xa6, 0x1a, 0x07, 0xad, 0x63, 0xa8, 0x28, 0xc8, 0x14, 0xd5, 0x5e, 0xd5, 0x55, 0xbb, 0x7c,
+	0xca, 0xea, 0x55, 0xf5, 0xfe, 0x94, 0x61, 0x17, 0x26, 0xcf, 0x24, 0x86, 0xfe, 0x7f, 0xc7, 0x11,
+	0x83, 0x98, 0xdf, 0xed, 0xa7, 0xf1, 0xbb, 0xfd, 0x9f, 0xf5, 0xbb, 0x9d, 0xee, 0xf7, 0xdf, 0x1b,
+	0xf3, 0x0d, 0x26, 0x7a, 0x0d, 0x4a, 0xb6, 0xa3, 0xe9, 0xb9, 0x9a, 0x22, 0x48, 0x56, 0x21, 0x49,
+	0x4a, 0xd9, 0x1a, 0x92, 0x2d, 0x93, 0x64, 0x6b, 0xe8, 0x6c, 0x92, 0x14, 0xbd, 0xc1, 0xee, 0x3d,
+	0x04, 0x1f, 0x8f, 0x97, 0xb5, 0xb4, 0x03, 0xa5, 0x60, 0x8c, 0x89, 0xad, 0x1f, 0x19, 0x50, 0x11,
+	0xaa, 0xb3, 0x90, 0x7d, 0x93, 0xe9, 0xcd, 0x03, 0xcf, 0x10, 0x81, 0x17, 0xe5, 0xa4, 0xc0, 0x68,
+	0x6d, 0x61, 0x44, 0x8e, 0x6e, 0x72, 0x25, 0x38, 0x2f, 0x57, 0xbe, 0xaa, 0xe4, 0xb3, 0x37, 0x48,
+	0x32, 0xc7, 0x0c, 0xd6, 0xcf, 0x0c, 0xb8, 0x28, 0x1a, 0x10, 0xa1, 0x8f, 0x3c, 0x35, 0xbe, 0x04,
+	0xcb, 0xce, 0x6c, 0xbc, 0xf7, 0x30, 0x16, 0xce, 0xf3, 0x69, 0x0e, 0x4a, 0x7b, 0x05, 0x06, 0x89,
+	0xf4, 0xe7, 0xfd, 0xa0, 0x0e, 0x44, 0x5b, 0x60, 0x4a, 0xbe, 0xe8, 0x26, 0x95, 0x37, 0x87, 0x09,
+	0xb8, 0xf5, 0x83, 0x0c, 0x2c, 0x4a, 0x53, 0x9d, 0x9a, 0xd0, 0x5f, 0xed, 0x2b, 0xe0, 0xdf, 0x65,
+	0xc4, 0x5b, 0x14, 0x4d, 0xbd, 0x5b, 0x50, 0xd0, 0x42, 0x63, 0x33, 0x11, 0x63, 0x2c, 0xf7, 0x18,
+	0x89, 0x9e, 0x7b, 0xdc, 0xf6, 0xb7, 0xa2, 0xd4, 0xcd, 0x9c, 0xc5, 0x7f, 0x6a, 0xee, 0x76, 0xa0,
+	0xa2, 0x08, 0x4f, 0xe9, 0x4d, 0x6b, 0x7a, 0xee, 0x9e, 0xfa, 0xcc, 0xa2, 0x24, 0x2f, 0x13, 0x7a,
+	0x66, 0x41, 0x78, 0x92, 0xd0, 0xb4, 0x8a, 0xf0, 0xc7, 0xac, 0x7e, 0x5c, 0x4b, 0x8d, 0x9c, 0xdb,
+	0x5a, 0xea, 0xa5, 0xee, 0x33, 0x31, 0x5a, 0x1e, 0xa8, 0x15, 0x10, 0x3d, 0x7c, 0x88, 0x82, 0x27,
+	0x6e, 0xa1, 0x56, 0x53, 0x6a, 0xa1, 0x3c, 0x7c, 0x88, 0x21, 0x7a, 0x3d, 0x76, 0xa8, 0xe8, 0x76,
+	0xd7, 0xd2, 0xdc, 0x20, 0x23, 0x27, 0x72, 0xfe, 0x8d, 0x68, 0xeb, 0xad, 0xe6, 0x93, 0x93, 0x35,
+	0xf4, 0xc9, 0xc4, 0x10, 0x5d, 0x97, 0xaf, 0x8d, 0xbc, 0x35, 0xd1, 0xba, 0x47, 0x79, 0xb1, 0xa0,
+	0xbd, 0x38, 0x3a, 0x22, 0x3e, 0x45, 0xb7, 0xc6, 0x91, 0x6c, 0xcf, 0x58, 0xd6, 0x8f, 0xcb, 0x49,
+	0x2a, 0x9c, 0xc2, 0x89, 0x9a, 0x73, 0xe7, 0x50, 0x71, 0x6f, 0xf0, 0xc4, 0x36, 0x56, 0xe7, 0xb2,
+	0xfe, 0x52, 0x04, 0x53, 0xea, 0x1b, 0x5d, 0xb6, 0xa7, 0xf9, 0xf4, 0x12, 0x14, 0x1c, 0xf2, 0x38,
+	0x8c, 0x4e, 0xa3, 0x62, 0x84, 0xf6, 0xa0, 0xc2, 0xbf, 0x76, 0x4e, 0xee, 0x91, 0x13, 0x51, 0xa3,
+	0x5f, 0x4d, 0x33, 0x87, 0x14, 0x5f, 0x53, 0xe8, 0xf9, 0x91, 0x4d, 0x95, 0x10, 0xb5, 0xc0, 0x39,
+	0xa5, 0x05, 0x8e, 0xac, 0x9d, 0xff, 0x42, 0xd6, 0x2e, 0x7c, 0x6e, 0x6b, 0xf7, 0xc1, 0x9c, 0xeb,
+	0xb3, 0xe9, 0x7e, 0x4f, 0x97, 0xba, 0x7d, 0xe6, 0x52, 0xe7, 0x99, 0xd4, 0xe4, 0x4f, 0x48, 0x44,
+	0x2d, 0x75, 0xa3, 0xe1, 0xbd, 0xe9, 0x2b, 0x67, 0x8a, 0x8f, 0xa8, 0xb9, 0x1d, 0x63, 0x6e, 0x35,
+	0xa8, 0xcb, 0x4f, 0x1d, 0xd4, 0x4a, 0xda, 0xc1, 0xe7, 0x4a, 0xbb, 0xca, 0x39, 0xd2, 0x6e, 0xae,
+	0x48, 0x2c, 0x9e, 0xbb, 0x48, 0x24, 0x32, 0x60, 0xe9, 0xf3, 0x64, 0xc0, 0xfa, 0x2d, 0x30, 0xe7,
+	0x03, 0x32, 0xfd, 0x3d, 0x35, 0xfd, 0x69, 0x66, 0xfd, 0x7d, 0xb8, 0x98, 0xea, 0xe5, 0x73, 0x16,
+	0x5c, 0xed, 0x82, 0x50, 0x11, 0x7f, 0x13, 0x96, 0x23, 0xaf, 0x9e, 0x5b, 0x39, 0xab, 0x05, 0x15,
+	0xf5, 0xd1, 0xfe, 0x0b, 0xbc, 0x4d, 0x5a, 0xbf, 0xca, 0xc0, 0x5a, 0xda, 0x5d, 0xe0, 0x19, 0x37,
+	0xce, 0x0f, 0x12, 0x7f, 0x7e, 0xa8, 0x3d, 0xe9, 0x66, 0x51, 0xff, 0x13, 0x44, 0x62, 0x97, 0x7f,
+	0x36, 0x7f, 0x85, 0xe8, 0x3e, 0xf9, 0xaf, 0x10, 0x67, 0x35, 0xcb, 0x8a, 0x45, 0x15, 0x5b, 0x6f,
+	0x7d, 0x07, 0x60, 0x7f, 0xda, 0x77, 0x43, 0x7e, 0xff, 0x72, 0x19, 0x56, 0xb5, 0x37, 0x47, 0x8e,
+	0x32, 0x17, 0xd0, 0x45, 0x58, 0x91, 0xef, 0x8c, 0xed, 0x8e, 0x23, 0xc0, 0x06, 0x5a, 0x85, 0x0b,
+	0x34, 0x1c, 0x99, 0x3e, 0x02, 0x98, 0x41, 0x4b, 0x50, 0xee, 0x76, 0xf6, 0xc4, 0x30, 0xbb, 0x55,
+	0x83, 0x72, 0xf4, 0x4f, 0x16, 0x74, 0x01, 0x2a, 0x8e, 0xe7, 0x8f, 0xdd, 0x11, 0x1b, 0x9a, 0x0b,
+	0xc8, 0x84, 0xc5, 0xee, 0x70, 0x4c, 0xbc, 0x59, 0xc8, 0x21, 0xc6, 0xd6, 0x6f, 0x32, 0x00, 0xf1,
+	0x8d, 0x3a, 0x5a, 0x06, 0xe8, 0x76, 0xf6, 0x0e, 0xf6, 0x1f, 0xd8, 0xf5, 0x6e, 0xd3, 0x5c, 0x40,
+	0x00, 0x85, 0xfa, 0x83, 0x07, 0x4d, 0xc7, 0x36, 0x0d, 0x54, 0x82, 0x1c, 0x6e, 0xd6, 0x6d, 0x33,
+	0x83, 0x16, 0xa1, 0xd4, 0xc5, 0xfb, 0x4e, 0x83, 0xd2, 0x64, 0xa9, 0xd0, 0xbb, 0xcd, 0xee, 0x41,
+	0x04, 0xc9, 0xa1, 0x0a, 0x14, 0x1b, 0x7b, 0x8e, 0xd3, 0x6c, 0x74, 0xcd, 0x3c, 0x15, 0x29, 0x06,
+	0x07, 0x78, 0xcf, 0x2c, 0xa0, 0x15, 0x58, 0x6a, 0xef, 0xdd, 0x3d, 0xd8, 0x6d, 0xd6, 0x71, 0x77,
+	0xa7, 0x59, 0xef, 0x9a, 0x45, 0x2a, 0xa1, 0xe1, 0x28, 0x90, 0x12, 0x85, 0xd8, 0x2a, 0xa4, 0x8c,
+	0x10, 0x2c, 0x37, 0x76, 0x9b, 0x8d, 0x7b, 0x07, 0xbb, 0xf5, 0x7b, 0xcd, 0xe6, 0x83, 0x26, 0x36,
+	0x81, 0x1a, 0x90, 0xce, 0xdc, 0x68, 0xef, 0x77, 0xba, 0x4d, 0x7c, 0x60, 0x37, 0xbb, 0xf5, 0x56,
+	0xbb, 0x63, 0x56, 0x28, 0x31, 0x45, 0x74, 0x76, 0xeb, 0xd8, 0x3e, 0x68, 0x39, 0x77, 0xf6, 0xcc,
+	0x45, 0x26, 0xc0, 0x39, 0xa8, 0xb7, 0xdb, 0x7b, 0x54, 0xcb, 0x83, 0x96, 0x6d, 0x2e, 0x51, 0x43,
+	0xab, 0x02, 0x3a, 0x5d, 0xaa, 0xff, 0x32, 0x33, 0x34, 0xb3, 0xc0, 0x41, 0xc3, 0x39, 0x68, 0xd7,
+	0x77, 0x9a, 0x6d, 0xf3, 0xc2, 0x96, 0x03, 0x10, 0x3f, 0x94, 0xd2, 0x55, 0x51, 0x5f, 0x70, 0x88,
+	0xb9, 0x40, 0x4d, 0xd2, 0x9a, 0x84, 0xc4, 0x9f, 0xb8, 0x23, 0xd3, 0xa0, 0x86, 0x67, 0x9e, 0x8d,
+	0xbc, 0xb4, 0x22, 0xde, 0x9c, 0x31, 0xf9, 0x2e, 0xe9, 0x85, 0xa4, 0x6f, 0x66, 0xb7, 0xb6, 0xa0,
+	0x1c, 0xbd, 0x27, 0x52, 0xf6, 0x0e, 0x09, 0xd9, 0xc8, 0x5c, 0xa0, 0xec, 0xfc, 0x76, 0x88, 0x03,
+	0x8c, 0xad, 0x0f, 0x33, 0x80, 0x64, 0x65, 0x57, 0x02, 0x88, 0x7a, 0x6b, 0xd8, 0x3b, 0x56, 0xe3,
+	0x46, 0x79, 0xea, 0x8a, 0xe2, 0xe6, 0x22, 0xac, 0xd8, 0x09, 0x70, 0x06, 0x5d, 0x02, 0xa4, 0xbe,
+	0xac, 0xc9, 0x10, 0xa2, 0xb3, 0xdf, 0x25, 0x61, 0x14, 0x8e, 0x39, 0xf4, 0x5c, 0xa2, 0x7c, 0x09,
+	0x54, 0x9e, 0x1a, 0xb5, 0x43, 0x78, 0x30, 0x09, 0x58, 0x01, 0x55, 0x61, 0x4d, 0x3f, 0xcc, 0x08,
+	0x4c, 0x11, 0x5d, 0x81, 0xe7, 0x3b, 0x24, 0x4c, 0xee, 0x9d, 0x82, 0xa0, 0x84, 0xd6, 0xe1, 0x92,
+	0x20, 0x88, 0x8a, 0xaf, 0xc0, 0x95, 0xa9, 0x09, 0xf9, 0xb7, 0xb0, 0x9a, 0x09, 0x5b, 0x1f, 0x19,
+	0xb0, 0xa4, 0xed, 0xed, 0xd4, 0x73, 0x12, 0x20, 0xba, 0x78, 0x73, 0x81, 0xea, 0x2f, 0x81, 0xda,
+	0xdd, 0xa9, 0x69, 0xa0, 0x6f, 0xc0, 0xd7, 0x13, 0x28, 0x59, 0xa2, 0x31, 0xe9, 0x91, 0xe1, 0x23,
+	0xd2, 0x37, 0x33, 0xe8, 0x79, 0xb8, 0x9c, 0x20, 0xbb, 0xe3, 0x0e, 0x47, 0xd4, 0x91, 0xea, 0x9c,
+	0x78, 0x36, 0x99, 0x50, 0xc1, 0xb9, 0xad, 0xc3, 0xb4, 0xee, 0x82, 0x9a, 0x46, 0x83, 0xc6, 0x3a,
+	0xce, 0x63, 0xa4, 0x24, 0x23, 0x81, 0xe9, 0x84, 0xde, 0x74, 0x4a, 0xb5, 0xda, 0x3a, 0x02, 0x73,
+	0xfe, 0xb2, 0x9c, 0x86, 0x44, 0xbd, 0xdf, 0x17, 0xe5, 0xc7, 0x5c, 0xa0, 0x56, 0xc3, 0x64, 0xec,
+	0x3d, 0x22, 0x12, 0x64, 0xd0, 0xdc, 0xea, 0x84, 0xae, 0x1f, 0x4a, 0x48, 0x86, 0x7a, 0x9c, 0x4a,
+	0x95, 0x80, 0x2c, 0x95, 0x72, 0x6f, 0x38, 0x1a, 0xbd, 0xe7, 0x8d, 0x0f, 0x87, 0xc4, 0xcc, 0x6d,
+	0xbd, 0xa5, 0x5d, 0x32, 0x53, 0x34, 0xdd, 0x70, 0x38, 0xc4, 0x5c, 0xa0, 0x35, 0xc8, 0x76, 0xe4,
+	0xd0, 0xa0, 0xc3, 0x46, 0x34, 0xcc, 0xec, 0x34, 0x3f, 0xf9, 0xdb, 0xc6, 0xc2, 0xc7, 0x9f, 0x6d,
+	0x18, 0x9f, 0x7c, 0xb6, 0x61, 0xfc, 0xf5, 0xb3, 0x0d, 0xe3, 0xbd, 0x1b, 0xca, 0xff, 0x66, 0xc7,
+	0x6e, 0xe8, 0x0f, 0x1f, 0x7b, 0xfe, 0x70, 0x30, 0x9c, 0xc8, 0xc1, 0x84, 0x5c, 0x9f, 0x1e, 0x0f,
+	0xae, 0x4f, 0x0f, 0xaf, 0xc7, 0x25, 0xf5, 0xb0, 0xc0, 0xfe, 0x34, 0x7b, 0xe3, 0xdf, 0x03, 0x00,
+	0x14, 0xb1, 0x92, 0x9e, 0x93, 0x2b, 0x00, 0x00,
 }
 
 func (m *CNStore) Marshal() (dAtA []byte, err error) {
@@ -3964,6 +3992,11 @@ func (m *DNStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GCRetention != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.GCRetention))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.CtlAddress) > 0 {
 		i -= len(m.CtlAddress)
 		copy(dAtA[i:], m.CtlAddress)
@@ -4453,6 +4486,11 @@ func (m *DNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GCRetention != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.GCRetention))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.CtlAddress) > 0 {
 		i -= len(m.CtlAddress)
 		copy(dAtA[i:], m.CtlAddress)
@@ -5765,6 +5803,11 @@ func (m *DNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GCRetention != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.GCRetention))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.CtlAddress) > 0 {
 		i -= len(m.CtlAddress)
 		copy(dAtA[i:], m.CtlAddress)
@@ -6653,6 +6696,9 @@ func (m *DNStore) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if m.GCRetention != 0 {
+		n += 1 + sovLogservice(uint64(m.GCRetention))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6887,6 +6933,9 @@ func (m *DNStoreHeartbeat) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if m.GCRetention != 0 {
+		n += 1 + sovLogservice(uint64(m.GCRetention))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7471,6 +7520,9 @@ func (m *DNStoreInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if m.GCRetention != 0 {
+		n += 1 + sovLogservice(uint64(m.GCRetention))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8449,6 +8501,25 @@ func (m *DNStore) Unmarshal(dAtA []byte) error {
 			}
 			m.CtlAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCRetention", wireType)
+			}
+			m.GCRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GCRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -9918,6 +9989,25 @@ func (m *DNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 			}
 			m.CtlAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCRetention", wireType)
+			}
+			m.GCRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GCRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -13766,6 +13856,25 @@ func (m *DNStoreInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.CtlAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCRetention", wireType)
+			}
+			m.GCRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GCRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ package logservice
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/stretchr/testify/assert"
@@ -97,6 +98,7 @@ func TestDNStateUpdate(t *testing.T) {
 			{ShardID: 1, ReplicaID: 1},
 			{ShardID: 2, ReplicaID: 1}},
 		LogtailServerAddress: "addr-0",
+		GCRetention:          int64(time.Hour),
 	}
 	tick2 := uint64(200)
 
@@ -106,6 +108,7 @@ func TestDNStateUpdate(t *testing.T) {
 		ServiceAddress:       hb2.ServiceAddress,
 		Shards:               hb2.Shards,
 		LogtailServerAddress: "addr-0",
+		GCRetention:          int64(time.Hour),
 	})
 }
 
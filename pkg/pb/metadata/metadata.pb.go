@@ -604,10 +604,13 @@ type DNService struct {
 	// Shards DN shards on service
 	Shards []DNShard `protobuf:"bytes,6,rep,name=Shards,proto3" json:"Shards"`
 	// Labels labels on service
-	Labels               map[string]LabelList `protobuf:"bytes,7,rep,name=Labels,proto3" json:"Labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Labels map[string]LabelList `protobuf:"bytes,7,rep,name=Labels,proto3" json:"Labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// GCRetention is the nanoseconds the history versions are kept by the GC,
+	// the snapshots older than it can't be read.
+	GCRetention          int64    `protobuf:"varint,8,opt,name=GCRetention,proto3" json:"GCRetention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DNService) Reset()         { *m = DNService{} }
@@ -692,6 +695,13 @@ func (m *DNService) GetLabels() map[string]LabelList {
 	return nil
 }
 
+func (m *DNService) GetGCRetention() int64 {
+	if m != nil {
+		return m.GCRetention
+	}
+	return 0
+}
+
 // LabelList defines the labels on CN store.
 type LabelList struct {
 	Labels               []string `protobuf:"bytes,1,rep,name=Labels,proto3" json:"Labels,omitempty"`
@@ -760,48 +770,49 @@ func init() {
 func init() { proto.RegisterFile("metadata.proto", fileDescriptor_56d9f74966f40d04) }
 
 var fileDescriptor_56d9f74966f40d04 = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0x66, 0x63, 0xe3, 0xe0, 0x89, 0x7e, 0x64, 0xf6, 0x57, 0xa9, 0x85, 0xaa, 0x80, 0xdc, 0x1e,
-	0x52, 0xd4, 0xc6, 0x25, 0xad, 0xaa, 0xaa, 0x52, 0x0f, 0xe0, 0x54, 0x88, 0xca, 0x32, 0xa9, 0x09,
-	0x55, 0xdb, 0x9b, 0x9d, 0x2c, 0xc6, 0xc2, 0xc9, 0x5a, 0x8e, 0x83, 0xe0, 0x15, 0xfa, 0x0e, 0x7d,
-	0x1f, 0x8e, 0x3c, 0x01, 0xa2, 0x79, 0x92, 0xca, 0x6b, 0x6f, 0x6c, 0x9c, 0x04, 0xb8, 0xf5, 0x94,
-	0x9d, 0xf9, 0xc6, 0xdf, 0xcc, 0xf7, 0xcd, 0xae, 0x02, 0xab, 0x03, 0x12, 0x3b, 0x7d, 0x27, 0x76,
-	0x9a, 0x61, 0x44, 0x63, 0x8a, 0x57, 0x78, 0xbc, 0xf1, 0xda, 0xf3, 0xe3, 0xd3, 0xb1, 0xdb, 0xec,
-	0xd1, 0x81, 0xee, 0x51, 0x8f, 0xea, 0xac, 0xc0, 0x1d, 0x9f, 0xb0, 0x88, 0x05, 0xec, 0x94, 0x7e,
-	0xa8, 0x1d, 0xc0, 0x7f, 0x6d, 0xeb, 0xe8, 0xd4, 0x89, 0xfa, 0x36, 0xe9, 0xd1, 0xa8, 0x8f, 0x55,
-	0xa8, 0xb2, 0xf0, 0xa0, 0xad, 0xa2, 0x2d, 0xd4, 0x10, 0x6d, 0x1e, 0xe2, 0x3a, 0x80, 0x49, 0x3d,
-	0x0e, 0x56, 0x18, 0x58, 0xc8, 0x68, 0xbf, 0x10, 0x54, 0x33, 0x2e, 0xbc, 0x5f, 0xa2, 0x65, 0x5c,
-	0xb5, 0xd6, 0xd3, 0xe6, 0x74, 0xee, 0x3b, 0xf0, 0xde, 0xca, 0xd5, 0xcd, 0xe6, 0xd2, 0xf5, 0xcd,
-	0x26, 0xb2, 0x4b, 0xe3, 0x3c, 0x03, 0xd9, 0x26, 0x61, 0xe0, 0xf7, 0x9c, 0x69, 0xcf, 0x3c, 0x91,
-	0x0c, 0xbb, 0xdb, 0xef, 0x47, 0x64, 0x34, 0x52, 0x85, 0x2d, 0xd4, 0x90, 0x6d, 0x1e, 0x6a, 0xdf,
-	0x60, 0x95, 0x8f, 0xf6, 0xa0, 0xb0, 0x6d, 0x50, 0xac, 0xf1, 0xc0, 0x25, 0xd1, 0xe1, 0x49, 0x46,
-	0x3d, 0xca, 0x5a, 0xcd, 0xe4, 0xb5, 0x18, 0x56, 0x38, 0x2f, 0xfe, 0x52, 0xee, 0x91, 0xa9, 0x54,
-	0x73, 0x95, 0x77, 0xf1, 0x82, 0xcc, 0xf2, 0x74, 0xf7, 0xea, 0xd4, 0x2c, 0xe6, 0x6c, 0x4c, 0x23,
-	0x82, 0x31, 0x88, 0xc7, 0xc7, 0x99, 0x06, 0xd9, 0x66, 0x67, 0xac, 0x83, 0xc4, 0xb8, 0x92, 0xb1,
-	0x85, 0x46, 0xad, 0xb5, 0x36, 0x63, 0xf3, 0x9e, 0x98, 0x74, 0xb6, 0xb3, 0x32, 0xad, 0x93, 0xaa,
-	0x58, 0x48, 0xf8, 0xa6, 0x44, 0x88, 0x67, 0x15, 0x95, 0x18, 0x0d, 0xa8, 0x1a, 0xf7, 0x4c, 0xf8,
-	0x02, 0x44, 0x9b, 0x06, 0x84, 0x29, 0x5b, 0x6d, 0x29, 0x39, 0x9d, 0x61, 0x25, 0x79, 0x9b, 0xa1,
-	0xda, 0x6d, 0x05, 0x64, 0xc3, 0x3a, 0x22, 0xd1, 0xb9, 0xdf, 0x23, 0x89, 0x25, 0xd9, 0x71, 0x4a,
-	0x96, 0x27, 0x70, 0x13, 0xb0, 0x49, 0x7b, 0x67, 0x59, 0x82, 0xdf, 0x82, 0x0a, 0x2b, 0x9b, 0x83,
-	0xe0, 0xf7, 0xb0, 0xde, 0xf1, 0x43, 0x12, 0xf8, 0x43, 0x52, 0xfa, 0x26, 0xbd, 0x39, 0x0b, 0xd0,
-	0xe4, 0xd6, 0x1f, 0x7d, 0x35, 0x79, 0xad, 0xc8, 0x6a, 0x0b, 0x99, 0x04, 0x37, 0xe2, 0x80, 0xe3,
-	0xcb, 0x29, 0x9e, 0x67, 0xf0, 0x27, 0x90, 0x4c, 0xc7, 0x25, 0xc1, 0x48, 0x95, 0x98, 0x95, 0x9b,
-	0x45, 0xed, 0x59, 0xaf, 0x66, 0x5a, 0xf1, 0x79, 0x18, 0x47, 0x97, 0xdc, 0xd7, 0x34, 0xb5, 0x61,
-	0x41, 0xad, 0x00, 0x62, 0x05, 0x84, 0x33, 0x72, 0x99, 0xb9, 0x91, 0x1c, 0xf1, 0x4b, 0x58, 0x3e,
-	0x77, 0x82, 0x71, 0x6a, 0x6d, 0xad, 0xf5, 0x7f, 0x61, 0x53, 0xc9, 0x77, 0xa6, 0x3f, 0x8a, 0xed,
-	0xb4, 0xe2, 0x63, 0xe5, 0x03, 0xd2, 0x7e, 0x0b, 0x20, 0xb7, 0x1f, 0x69, 0xf1, 0x2b, 0x58, 0xeb,
-	0x5e, 0x0c, 0xe7, 0x3a, 0x3c, 0x0b, 0xe0, 0x77, 0xf0, 0xc4, 0xa4, 0x5e, 0xd7, 0xf1, 0x83, 0xb9,
-	0xfe, 0xce, 0x07, 0x17, 0xac, 0x51, 0x5c, 0xb8, 0xc6, 0x87, 0xec, 0xce, 0x9f, 0x82, 0xf4, 0xa8,
-	0xa7, 0x50, 0xd8, 0x4f, 0xb5, 0xbc, 0x9f, 0xf6, 0x3f, 0xd8, 0xcf, 0x73, 0x90, 0xa7, 0x79, 0xbc,
-	0x3e, 0x9d, 0x0d, 0x6d, 0x09, 0x0d, 0x99, 0x37, 0xdd, 0xde, 0x81, 0x5a, 0x36, 0x59, 0xf7, 0x32,
-	0x24, 0x58, 0x82, 0x8a, 0x61, 0x29, 0x4b, 0xc9, 0x6f, 0xdb, 0x52, 0x10, 0xae, 0x82, 0x60, 0x1e,
-	0xee, 0x2b, 0x15, 0x2c, 0xc3, 0x72, 0xc7, 0x3e, 0xfc, 0xfe, 0x43, 0x11, 0xb6, 0x55, 0x90, 0xd2,
-	0xa7, 0x96, 0x54, 0x75, 0x3b, 0x69, 0xf5, 0x6e, 0x47, 0x41, 0x7b, 0xc6, 0xf5, 0x9f, 0x3a, 0xba,
-	0x9a, 0xd4, 0xd1, 0xf5, 0xa4, 0x8e, 0x6e, 0x27, 0x75, 0xf4, 0x73, 0xa7, 0xf0, 0x17, 0x32, 0x70,
-	0xe2, 0xc8, 0xbf, 0xa0, 0x91, 0xef, 0xf9, 0x43, 0x1e, 0x0c, 0x89, 0x1e, 0x9e, 0x79, 0x7a, 0xe8,
-	0xea, 0x5c, 0x8b, 0x2b, 0xb1, 0x7f, 0x93, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xb9,
-	0x99, 0x4f, 0x98, 0x06, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0x66, 0xe3, 0xe0, 0xc4, 0x13, 0xfd, 0xc8, 0xec, 0xaf, 0x52, 0x0b, 0x55, 0x21, 0x72, 0x7b,
+	0x48, 0x51, 0x9b, 0x94, 0xb4, 0xaa, 0xaa, 0x4a, 0x3d, 0x80, 0x53, 0x21, 0x2a, 0xcb, 0xa4, 0x4b,
+	0xa8, 0xda, 0xde, 0x9c, 0x64, 0x31, 0x16, 0x8e, 0x37, 0x72, 0x1c, 0x04, 0xaf, 0xd0, 0x97, 0xea,
+	0x95, 0x23, 0x4f, 0x80, 0x28, 0x4f, 0x52, 0x79, 0xed, 0x8d, 0x8d, 0x93, 0x10, 0x0e, 0x3d, 0x79,
+	0x67, 0xbe, 0xd9, 0x6f, 0xe7, 0xfb, 0x66, 0x57, 0x86, 0xb5, 0x21, 0x0d, 0xed, 0x81, 0x1d, 0xda,
+	0x8d, 0x51, 0xc0, 0x42, 0x86, 0xcb, 0x22, 0xde, 0x7c, 0xed, 0xb8, 0xe1, 0xe9, 0xa4, 0xd7, 0xe8,
+	0xb3, 0x61, 0xd3, 0x61, 0x0e, 0x6b, 0xf2, 0x82, 0xde, 0xe4, 0x84, 0x47, 0x3c, 0xe0, 0xab, 0x78,
+	0xa3, 0x7e, 0x00, 0xff, 0xb5, 0xad, 0xa3, 0x53, 0x3b, 0x18, 0x10, 0xda, 0x67, 0xc1, 0x00, 0x6b,
+	0x50, 0xe2, 0xe1, 0x41, 0x5b, 0x43, 0x35, 0x54, 0x2f, 0x12, 0x11, 0xe2, 0x2a, 0x80, 0xc9, 0x1c,
+	0x01, 0x16, 0x38, 0x98, 0xc9, 0xe8, 0xbf, 0x10, 0x94, 0x12, 0x2e, 0xbc, 0x9f, 0xa3, 0xe5, 0x5c,
+	0x95, 0xd6, 0xd3, 0xc6, 0xb4, 0xef, 0x7b, 0xf0, 0x5e, 0xf9, 0xea, 0x66, 0x6b, 0xe5, 0xfa, 0x66,
+	0x0b, 0x91, 0x5c, 0x3b, 0xcf, 0x40, 0x21, 0x74, 0xe4, 0xb9, 0x7d, 0x7b, 0x7a, 0x66, 0x9a, 0x88,
+	0x9a, 0xdd, 0x1d, 0x0c, 0x02, 0x3a, 0x1e, 0x6b, 0x52, 0x0d, 0xd5, 0x15, 0x22, 0x42, 0xfd, 0x1b,
+	0xac, 0x89, 0xd6, 0x96, 0x0a, 0xdb, 0x06, 0xd5, 0x9a, 0x0c, 0x7b, 0x34, 0x38, 0x3c, 0x49, 0xa8,
+	0xc7, 0xc9, 0x51, 0x33, 0x79, 0x3d, 0x84, 0xb2, 0xe0, 0xc5, 0x5f, 0xf2, 0x67, 0x24, 0x2a, 0xb5,
+	0x54, 0xe5, 0x7d, 0x3c, 0x23, 0x33, 0xdf, 0xdd, 0x83, 0x3a, 0x75, 0x8b, 0x3b, 0x1b, 0xb2, 0x80,
+	0x62, 0x0c, 0xc5, 0xe3, 0xe3, 0x44, 0x83, 0x42, 0xf8, 0x1a, 0x37, 0x41, 0xe6, 0x5c, 0x51, 0xdb,
+	0x52, 0xbd, 0xd2, 0x5a, 0x9f, 0xb1, 0x79, 0xaf, 0x18, 0x9d, 0x4c, 0x92, 0x32, 0xbd, 0x13, 0xab,
+	0x58, 0x48, 0xf8, 0x26, 0x47, 0x88, 0x67, 0x15, 0xe5, 0x18, 0x0d, 0x28, 0x19, 0x0f, 0x74, 0xf8,
+	0x02, 0x8a, 0x84, 0x79, 0x94, 0x2b, 0x5b, 0x6b, 0xa9, 0x29, 0x9d, 0x61, 0x45, 0x79, 0xc2, 0x51,
+	0xfd, 0xb6, 0x00, 0x8a, 0x61, 0x1d, 0xd1, 0xe0, 0xdc, 0xed, 0xd3, 0xc8, 0x92, 0x64, 0x39, 0x25,
+	0x4b, 0x13, 0xb8, 0x01, 0xd8, 0x64, 0xfd, 0xb3, 0x24, 0x21, 0x6e, 0x41, 0x81, 0x97, 0xcd, 0x41,
+	0xf0, 0x7b, 0xd8, 0xe8, 0xb8, 0x23, 0xea, 0xb9, 0x3e, 0xcd, 0xed, 0x89, 0x6f, 0xce, 0x02, 0x34,
+	0xba, 0xf5, 0x47, 0x5f, 0x4d, 0x51, 0x5b, 0xe4, 0xb5, 0x99, 0x4c, 0x84, 0x1b, 0xa1, 0x27, 0xf0,
+	0xd5, 0x18, 0x4f, 0x33, 0xf8, 0x13, 0xc8, 0xa6, 0xdd, 0xa3, 0xde, 0x58, 0x93, 0xb9, 0x95, 0x5b,
+	0x59, 0xed, 0xc9, 0x59, 0x8d, 0xb8, 0xe2, 0xb3, 0x1f, 0x06, 0x97, 0xc2, 0xd7, 0x38, 0xb5, 0x69,
+	0x41, 0x25, 0x03, 0x62, 0x15, 0xa4, 0x33, 0x7a, 0x99, 0xb8, 0x11, 0x2d, 0xf1, 0x4b, 0x58, 0x3d,
+	0xb7, 0xbd, 0x49, 0x6c, 0x6d, 0xa5, 0xf5, 0x7f, 0x66, 0x52, 0xd1, 0x3e, 0xd3, 0x1d, 0x87, 0x24,
+	0xae, 0xf8, 0x58, 0xf8, 0x80, 0xf4, 0xdf, 0x12, 0x28, 0xed, 0x47, 0x5a, 0xfc, 0x0a, 0xd6, 0xbb,
+	0x17, 0xfe, 0x5c, 0x87, 0x67, 0x01, 0xfc, 0x0e, 0x9e, 0x98, 0xcc, 0xe9, 0xda, 0xae, 0x37, 0xd7,
+	0xdf, 0xf9, 0xe0, 0x82, 0x31, 0x16, 0x17, 0x8e, 0x71, 0x99, 0xdd, 0xe9, 0x53, 0x90, 0x1f, 0xf5,
+	0x14, 0x32, 0xf3, 0x29, 0xe5, 0xe7, 0xd3, 0x5e, 0x3e, 0x1f, 0x5c, 0x83, 0xca, 0xbe, 0x41, 0x68,
+	0x48, 0xfd, 0xd0, 0x65, 0xbe, 0x56, 0xae, 0xa1, 0xba, 0x44, 0xb2, 0xa9, 0x7f, 0x3e, 0xc1, 0xe7,
+	0xa0, 0x4c, 0xf3, 0x78, 0x63, 0xda, 0x3d, 0xaa, 0x49, 0x75, 0x45, 0xb4, 0xb5, 0xbd, 0x03, 0x95,
+	0xa4, 0xf7, 0xee, 0xe5, 0x88, 0x62, 0x19, 0x0a, 0x86, 0xa5, 0xae, 0x44, 0xdf, 0xb6, 0xa5, 0x22,
+	0x5c, 0x02, 0xc9, 0x3c, 0xdc, 0x57, 0x0b, 0x58, 0x81, 0xd5, 0x0e, 0x39, 0xfc, 0xfe, 0x43, 0x95,
+	0xb6, 0x35, 0x90, 0xe3, 0xc7, 0x18, 0x55, 0x75, 0x3b, 0x71, 0xf5, 0x6e, 0x47, 0x45, 0x7b, 0xc6,
+	0xf5, 0x9f, 0x2a, 0xba, 0xba, 0xab, 0xa2, 0xeb, 0xbb, 0x2a, 0xba, 0xbd, 0xab, 0xa2, 0x9f, 0x3b,
+	0x99, 0x9f, 0xcc, 0xd0, 0x0e, 0x03, 0xf7, 0x82, 0x05, 0xae, 0xe3, 0xfa, 0x22, 0xf0, 0x69, 0x73,
+	0x74, 0xe6, 0x34, 0x47, 0xbd, 0xa6, 0xd0, 0xd2, 0x93, 0xf9, 0xff, 0xe6, 0xed, 0xdf, 0x01, 0x00,
+	0xa5, 0xf6, 0x2f, 0xd9, 0xba, 0x06, 0x00, 0x00,
 }
 
 func (m *DNShardRecord) Marshal() (dAtA []byte, err error) {
@@ -1214,6 +1225,11 @@ func (m *DNService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GCRetention != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.GCRetention))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
 			v := m.Labels[k]
@@ -1556,6 +1572,9 @@ func (m *DNService) Size() (n int) {
 			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
 		}
 	}
+	if m.GCRetention != 0 {
+		n += 1 + sovMetadata(uint64(m.GCRetention))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3030,6 +3049,25 @@ func (m *DNService) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCRetention", wireType)
+			}
+			m.GCRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GCRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
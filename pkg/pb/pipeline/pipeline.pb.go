@@ -2210,6 +2210,7 @@ type HashBuild struct {
 	Nbucket              uint64       `protobuf:"varint,4,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
 	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
 	Conds                []*plan.Expr `protobuf:"bytes,6,rep,name=conds,proto3" json:"conds,omitempty"`
+	CanSpill             bool         `protobuf:"varint,7,opt,name=can_spill,json=canSpill,proto3" json:"can_spill,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2290,6 +2291,13 @@ func (m *HashBuild) GetConds() []*plan.Expr {
 	return nil
 }
 
+func (m *HashBuild) GetCanSpill() bool {
+	if m != nil {
+		return m.CanSpill
+	}
+	return false
+}
+
 type ExternalName2ColIndex struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -2534,13 +2542,15 @@ type Instruction struct {
 	// isFirst identifies whether it is the first instruction of analyzeInfo corresponding to idx
 	IsFirst bool `protobuf:"varint,26,opt,name=isFirst,proto3" json:"isFirst,omitempty"`
 	// isLast identifies whether it is the last instruction of analyzeInfo corresponding to idx
-	IsLast               bool           `protobuf:"varint,27,opt,name=isLast,proto3" json:"isLast,omitempty"`
-	RightJoin            *RightJoin     `protobuf:"bytes,28,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
-	RightSemiJoin        *RightSemiJoin `protobuf:"bytes,29,opt,name=right_semi_join,json=rightSemiJoin,proto3" json:"right_semi_join,omitempty"`
-	RightAntiJoin        *RightAntiJoin `protobuf:"bytes,30,opt,name=right_anti_join,json=rightAntiJoin,proto3" json:"right_anti_join,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	IsLast               bool               `protobuf:"varint,27,opt,name=isLast,proto3" json:"isLast,omitempty"`
+	RightJoin            *RightJoin         `protobuf:"bytes,28,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
+	RightSemiJoin        *RightSemiJoin     `protobuf:"bytes,29,opt,name=right_semi_join,json=rightSemiJoin,proto3" json:"right_semi_join,omitempty"`
+	RightAntiJoin        *RightAntiJoin     `protobuf:"bytes,30,opt,name=right_anti_join,json=rightAntiJoin,proto3" json:"right_anti_join,omitempty"`
+	WinSpec              *plan.WindowSpec   `protobuf:"bytes,31,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	LockTargets          []*plan.LockTarget `protobuf:"bytes,32,rep,name=lock_targets,json=lockTargets,proto3" json:"lock_targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Instruction) Reset()         { *m = Instruction{} }
@@ -2786,6 +2796,20 @@ func (m *Instruction) GetRightAntiJoin() *RightAntiJoin {
 	return nil
 }
 
+func (m *Instruction) GetWinSpec() *plan.WindowSpec {
+	if m != nil {
+		return m.WinSpec
+	}
+	return nil
+}
+
+func (m *Instruction) GetLockTargets() []*plan.LockTarget {
+	if m != nil {
+		return m.LockTargets
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -2843,6 +2867,7 @@ type Source struct {
 	Expr                 *plan.Expr           `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
 	TableDef             *plan.TableDef       `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ScanSnapshot         *timestamp.Timestamp `protobuf:"bytes,10,opt,name=scan_snapshot,json=scanSnapshot,proto3" json:"scan_snapshot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2944,6 +2969,13 @@ func (m *Source) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Source) GetScanSnapshot() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScanSnapshot
+	}
+	return nil
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -3021,6 +3053,7 @@ type ProcessLimitation struct {
 	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
 	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	SpillSize            int64    `protobuf:"varint,6,opt,name=spill_size,json=spillSize,proto3" json:"spill_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3094,6 +3127,13 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetSpillSize() int64 {
+	if m != nil {
+		return m.SpillSize
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Lim                  *ProcessLimitation `protobuf:"bytes,2,opt,name=lim,proto3" json:"lim,omitempty"`
@@ -3603,209 +3643,214 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 3226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x73, 0x1d, 0xc7,
-	0x5a, 0x39, 0xef, 0x99, 0xef, 0x9c, 0x23, 0xc9, 0x1d, 0x3f, 0x26, 0x72, 0x6c, 0x8b, 0x21, 0x26,
-	0x4a, 0x1c, 0xcb, 0x44, 0x60, 0x2a, 0x45, 0x5e, 0xc8, 0x92, 0x13, 0x0e, 0xf1, 0x43, 0xb4, 0x94,
-	0xa2, 0x48, 0x51, 0x4c, 0xb5, 0x66, 0xfa, 0x1c, 0x4d, 0x34, 0x67, 0x66, 0xdc, 0x33, 0xc7, 0x91,
-	0xbc, 0x62, 0xc5, 0x02, 0xc2, 0x82, 0xe2, 0x0f, 0xb0, 0x64, 0xc3, 0x8a, 0x35, 0x50, 0xec, 0x58,
-	0xc2, 0x9a, 0x05, 0x54, 0xd8, 0xde, 0xe5, 0x5d, 0xa6, 0x6e, 0xdd, 0xfa, 0xbe, 0xee, 0x79, 0x9c,
-	0x23, 0xc9, 0x76, 0x6e, 0xdd, 0xba, 0xbe, 0x55, 0x37, 0xbb, 0xfe, 0x1e, 0xfd, 0xf8, 0x1e, 0xfd,
-	0xf5, 0xd7, 0x5f, 0x37, 0x2c, 0xa5, 0x61, 0x2a, 0xa3, 0x30, 0x96, 0x1b, 0xa9, 0x4a, 0xf2, 0x84,
-	0x59, 0x05, 0xbc, 0x7a, 0x7b, 0x12, 0xe6, 0x87, 0xb3, 0x83, 0x0d, 0x3f, 0x99, 0xde, 0x99, 0x24,
-	0x93, 0xe4, 0x0e, 0x31, 0x1c, 0xcc, 0xc6, 0x04, 0x11, 0x40, 0x2d, 0xdd, 0x71, 0x15, 0xd2, 0x48,
-	0xc4, 0xa6, 0xbd, 0x9c, 0x87, 0x53, 0x99, 0xe5, 0x62, 0x9a, 0x6a, 0x84, 0xfb, 0x6d, 0x13, 0x7a,
-	0x0f, 0x65, 0x96, 0x89, 0x89, 0x64, 0x2b, 0xd0, 0xca, 0xc2, 0xc0, 0x69, 0xac, 0x35, 0xd6, 0xdb,
-	0x1c, 0x9b, 0x88, 0xf1, 0xa7, 0x81, 0xd3, 0xd4, 0x18, 0x7f, 0x4a, 0x18, 0xa9, 0x94, 0xd3, 0x5a,
-	0x6b, 0xac, 0x0f, 0x38, 0x36, 0x19, 0x83, 0x76, 0x20, 0x72, 0xe1, 0xb4, 0x09, 0x45, 0x6d, 0xf6,
-	0x16, 0x2c, 0xa5, 0x2a, 0xf1, 0xbd, 0x30, 0x1e, 0x27, 0x1e, 0x51, 0x3b, 0x44, 0x1d, 0x20, 0x76,
-	0x14, 0x8f, 0x93, 0x1d, 0xe4, 0x72, 0xa0, 0x27, 0x62, 0x11, 0x9d, 0x64, 0xd2, 0xe9, 0x12, 0xb9,
-	0x00, 0xd9, 0x12, 0x34, 0xc3, 0xc0, 0xe9, 0xd1, 0xb4, 0xcd, 0x30, 0xc0, 0x39, 0x66, 0xb3, 0x30,
-	0x70, 0x2c, 0x3d, 0x07, 0xb6, 0xd9, 0x55, 0xb0, 0x0f, 0x44, 0xee, 0x1f, 0x7a, 0x7e, 0x9c, 0x3b,
-	0x36, 0xb1, 0x5a, 0x84, 0xd8, 0x8e, 0x73, 0xb6, 0x0a, 0x96, 0x7f, 0x28, 0xfd, 0xa3, 0x6c, 0x36,
-	0x75, 0x60, 0xad, 0xb1, 0x3e, 0xe4, 0x25, 0x8c, 0xb4, 0x4c, 0x3e, 0x99, 0xc9, 0xd8, 0x97, 0x4e,
-	0x5f, 0xf7, 0x2b, 0x60, 0xf7, 0x4b, 0xb0, 0xb7, 0x93, 0x38, 0x96, 0x7e, 0x9e, 0x28, 0x76, 0x03,
-	0xfa, 0x85, 0xce, 0x3d, 0xa3, 0x97, 0x0e, 0x87, 0x02, 0x35, 0x0a, 0xd8, 0xdb, 0xb0, 0xec, 0x17,
-	0xdc, 0x5e, 0x18, 0x07, 0xf2, 0x98, 0x54, 0xd5, 0xe1, 0x4b, 0x25, 0x7a, 0x84, 0x58, 0xf7, 0x9f,
-	0x9a, 0x60, 0xed, 0x84, 0x59, 0x8a, 0xcb, 0x63, 0x57, 0xa0, 0x37, 0x9e, 0xc5, 0x7e, 0x35, 0x64,
-	0x17, 0xc1, 0x51, 0xc0, 0x3e, 0x82, 0xe5, 0x28, 0xf1, 0x45, 0xe4, 0x95, 0xbd, 0x9d, 0xe6, 0x5a,
-	0x6b, 0xbd, 0xbf, 0xf9, 0xfa, 0x46, 0xe9, 0x0b, 0xe5, 0xea, 0xf8, 0x12, 0xf1, 0x56, 0xab, 0xfd,
-	0x18, 0x56, 0x94, 0x9c, 0x26, 0xb9, 0xac, 0x75, 0x6f, 0x51, 0x77, 0x56, 0x75, 0xff, 0x33, 0x25,
-	0xd2, 0x47, 0x49, 0x20, 0xf9, 0xb2, 0xe6, 0xad, 0xba, 0xbf, 0x05, 0xc3, 0xbd, 0xc3, 0xd9, 0x78,
-	0x1c, 0xc9, 0xed, 0x24, 0x1a, 0x05, 0xc7, 0x64, 0xcf, 0x0e, 0x9f, 0x47, 0xb2, 0x0d, 0x60, 0x06,
-	0xc1, 0xe5, 0x64, 0x14, 0x1c, 0x3f, 0xc0, 0x35, 0x38, 0x9d, 0xb5, 0xd6, 0x7a, 0x87, 0x9f, 0x41,
-	0x61, 0xbf, 0x0b, 0xaf, 0xcf, 0x61, 0x39, 0xcd, 0xea, 0x74, 0xa9, 0xc3, 0x59, 0x24, 0xf7, 0x5f,
-	0x1a, 0x30, 0x7c, 0x38, 0x8b, 0xf2, 0x70, 0x4b, 0x4d, 0x66, 0x72, 0x1a, 0xe7, 0x68, 0xfc, 0x9d,
-	0x30, 0xcb, 0x49, 0x59, 0x16, 0xa7, 0x36, 0x5b, 0x07, 0xfb, 0x73, 0x95, 0xcc, 0xd2, 0xfb, 0xc7,
-	0x69, 0xa1, 0x24, 0xd8, 0x20, 0x3f, 0x47, 0x0c, 0xaf, 0x88, 0xec, 0x3d, 0xe8, 0x3f, 0x56, 0x81,
-	0x54, 0xf7, 0x4e, 0x88, 0xb7, 0x75, 0x8a, 0xb7, 0x4e, 0x66, 0x6f, 0x82, 0xbd, 0x27, 0x53, 0xa1,
-	0x04, 0x6a, 0x0f, 0x35, 0x60, 0xf3, 0x0a, 0x81, 0x0e, 0x4b, 0xcc, 0xa3, 0x80, 0xfc, 0xb9, 0xc3,
-	0x0b, 0xd0, 0x7d, 0x0c, 0xf6, 0xd6, 0x64, 0xa2, 0xe4, 0x44, 0xe4, 0xe4, 0xbd, 0x49, 0x6a, 0x6c,
-	0xdb, 0x4c, 0x52, 0xda, 0x21, 0x28, 0x40, 0x53, 0x0b, 0x80, 0x6d, 0x76, 0x1d, 0xda, 0x52, 0xaf,
-	0xa7, 0xb1, 0xb0, 0x1e, 0xc2, 0xbb, 0xdf, 0x37, 0xa0, 0x43, 0x42, 0xa0, 0x9f, 0xc7, 0x52, 0x06,
-	0x9e, 0x7c, 0x2a, 0x22, 0xa3, 0x03, 0x0b, 0x11, 0xf7, 0x9f, 0x8a, 0x08, 0x57, 0x14, 0x1e, 0xcc,
-	0xfc, 0x23, 0x99, 0x9b, 0x4d, 0x5a, 0x80, 0x48, 0x89, 0x0d, 0xa5, 0xa5, 0x29, 0x06, 0x64, 0x6b,
-	0xd0, 0xc1, 0x29, 0x32, 0xa7, 0x7d, 0x4a, 0x17, 0x9a, 0x80, 0x1c, 0xf9, 0x49, 0x2a, 0x33, 0x32,
-	0x6c, 0xc9, 0xb1, 0x7f, 0x92, 0x4a, 0xae, 0x09, 0xec, 0x6d, 0x68, 0x8b, 0xc9, 0x24, 0x23, 0x43,
-	0xce, 0xf9, 0x67, 0xa9, 0x05, 0x4e, 0x0c, 0xec, 0x2e, 0xd8, 0xda, 0x9a, 0xc8, 0xdd, 0x23, 0xee,
-	0x2b, 0x15, 0xf7, 0x9c, 0xa1, 0x79, 0xc5, 0xe9, 0xfe, 0x6f, 0x13, 0xba, 0xa3, 0x38, 0x93, 0x8a,
-	0xb6, 0xb2, 0x18, 0x8f, 0xa5, 0x9f, 0xcb, 0x22, 0x34, 0x95, 0x30, 0xd2, 0x46, 0x99, 0xf1, 0x29,
-	0xad, 0xdd, 0x12, 0x66, 0xbf, 0x05, 0x2d, 0x25, 0xc7, 0x46, 0xc1, 0xcb, 0x5a, 0x84, 0xc7, 0x07,
-	0x5f, 0x4b, 0x3f, 0xe7, 0x72, 0xcc, 0x91, 0xc6, 0x6e, 0x81, 0x9d, 0x8b, 0x83, 0x48, 0x7a, 0x81,
-	0x1c, 0x93, 0xb5, 0xfb, 0x9b, 0x4b, 0x46, 0x56, 0x44, 0xef, 0xc8, 0x31, 0xb7, 0x72, 0xd3, 0x62,
-	0x9f, 0x00, 0xa4, 0x42, 0xc9, 0x38, 0xf7, 0xc2, 0xe0, 0xd8, 0x68, 0xe6, 0x46, 0x25, 0x8a, 0x5e,
-	0xed, 0xc6, 0x2e, 0xb1, 0x8c, 0x82, 0xe3, 0xfb, 0x71, 0xae, 0x4e, 0xb8, 0x9d, 0x16, 0x30, 0xfb,
-	0x03, 0x18, 0x6c, 0x47, 0xb3, 0x2c, 0x97, 0x8a, 0x06, 0xa7, 0x90, 0x47, 0x7b, 0x13, 0xe7, 0xab,
-	0x53, 0xf8, 0x1c, 0x1f, 0x86, 0x8b, 0x30, 0x38, 0xa6, 0x49, 0x7b, 0xb4, 0x6d, 0xba, 0x61, 0x70,
-	0x3c, 0x0a, 0x8e, 0x57, 0x3f, 0x82, 0xa5, 0xf9, 0xd9, 0x30, 0x38, 0x1f, 0xc9, 0x13, 0xd2, 0x92,
-	0xcd, 0xb1, 0xc9, 0x2e, 0x42, 0xe7, 0xa9, 0x88, 0x66, 0xd2, 0xc4, 0x25, 0x0d, 0xfc, 0x61, 0xf3,
-	0x83, 0x86, 0x7b, 0x0d, 0x3a, 0x5b, 0x4a, 0x09, 0x62, 0x11, 0xd8, 0x70, 0x1a, 0x34, 0xba, 0x06,
-	0x5c, 0x1f, 0x5a, 0x0f, 0x45, 0xca, 0x6e, 0x42, 0x73, 0x9a, 0x12, 0xa5, 0xbf, 0x79, 0xa9, 0x66,
-	0x37, 0x91, 0x6e, 0x3c, 0x4c, 0xb5, 0x88, 0xcd, 0x69, 0xba, 0x7a, 0x17, 0x7a, 0x06, 0xfc, 0x41,
-	0x6b, 0xf8, 0xbb, 0x0e, 0x58, 0x3b, 0x32, 0x92, 0x79, 0x98, 0xc4, 0xb8, 0x6b, 0xf6, 0x33, 0x63,
-	0xe1, 0xe6, 0x7e, 0xc6, 0x5c, 0x18, 0x6c, 0x19, 0x3b, 0xf3, 0xe4, 0x9b, 0xcc, 0xf8, 0xf7, 0x1c,
-	0x0e, 0x79, 0xb4, 0xb5, 0x69, 0x14, 0x49, 0xc6, 0xb6, 0xf8, 0x1c, 0x0e, 0x37, 0xc2, 0xe8, 0x9e,
-	0xde, 0x08, 0x6d, 0x3a, 0x09, 0x0a, 0x10, 0x29, 0x8f, 0x0c, 0xa5, 0xa3, 0x29, 0x06, 0x64, 0x6b,
-	0xd0, 0xdf, 0x16, 0xf1, 0xbe, 0x9a, 0xc5, 0xbe, 0xc8, 0xb5, 0xa9, 0x2c, 0x5e, 0x47, 0xb1, 0xb7,
-	0xa1, 0xbb, 0x23, 0x23, 0x2e, 0xc7, 0xc6, 0xa9, 0x4f, 0x39, 0x98, 0x21, 0xb3, 0xcb, 0xd0, 0x1d,
-	0x91, 0xbd, 0x1c, 0x4b, 0x5b, 0x4f, 0x43, 0x18, 0x6f, 0x1f, 0xc7, 0x5c, 0x66, 0xb9, 0x0a, 0x7d,
-	0xb4, 0xa0, 0x63, 0x13, 0x79, 0x1e, 0x89, 0x02, 0x3e, 0x8e, 0xb7, 0x45, 0xe6, 0x8b, 0x40, 0x22,
-	0x13, 0x10, 0xd3, 0x1c, 0x8e, 0xdd, 0x02, 0xeb, 0x71, 0xbc, 0x27, 0x71, 0x56, 0xa7, 0x7f, 0xf6,
-	0x62, 0x4a, 0x06, 0xf6, 0xfb, 0x38, 0xed, 0x9e, 0xcc, 0x0b, 0x07, 0x77, 0x06, 0xd4, 0x63, 0xd1,
-	0xed, 0xe7, 0x99, 0xd8, 0x5d, 0x58, 0x22, 0xc4, 0x97, 0x69, 0x20, 0xf0, 0xd0, 0x88, 0x9c, 0x21,
-	0x75, 0x1b, 0xce, 0xb9, 0x04, 0x5f, 0x60, 0x2a, 0x57, 0x86, 0x2b, 0x5f, 0x2a, 0x56, 0x56, 0x46,
-	0x0a, 0xf4, 0x33, 0x5e, 0x32, 0xb0, 0x7b, 0x00, 0x7b, 0x72, 0x32, 0x95, 0x71, 0xfe, 0x50, 0xa4,
-	0xce, 0x32, 0xb1, 0xbb, 0x15, 0x7b, 0xe1, 0x27, 0x1b, 0x15, 0x93, 0xf6, 0xbf, 0x5a, 0xaf, 0xd5,
-	0x8f, 0x61, 0x79, 0x81, 0xfc, 0x83, 0xfc, 0xf1, 0xaf, 0x9a, 0x60, 0xef, 0x2a, 0x69, 0x02, 0xcf,
-	0x0d, 0xe8, 0x67, 0xfe, 0xa1, 0x9c, 0x0a, 0x2f, 0x16, 0x53, 0x69, 0x46, 0x00, 0x8d, 0x7a, 0x24,
-	0xa6, 0x72, 0x3e, 0x7c, 0x34, 0x5f, 0x10, 0x3e, 0xfe, 0x12, 0x2e, 0x55, 0xe1, 0xc3, 0x4b, 0x95,
-	0xf4, 0x42, 0x9a, 0xc6, 0x9c, 0x48, 0xb7, 0x2a, 0x49, 0xcb, 0x15, 0x54, 0xc1, 0xa4, 0x44, 0x69,
-	0x91, 0x59, 0x7a, 0x8a, 0xb0, 0x7a, 0x1f, 0xae, 0x9c, 0xc3, 0xfe, 0x83, 0x54, 0xf0, 0xdf, 0x4d,
-	0x34, 0xf5, 0xce, 0x2c, 0x8d, 0x42, 0xf4, 0xf3, 0x2f, 0xe4, 0xc9, 0x73, 0x03, 0xf0, 0x3a, 0xac,
-	0x24, 0xb1, 0x17, 0x14, 0xec, 0x14, 0xa5, 0x9a, 0xe4, 0xa3, 0x4b, 0x49, 0x35, 0x0a, 0x9a, 0xf7,
-	0xcf, 0xe1, 0xc2, 0x1c, 0xa7, 0xac, 0x4e, 0xe3, 0xdb, 0x95, 0xec, 0xf3, 0x53, 0xd7, 0x41, 0x3c,
-	0x9f, 0xb4, 0xf4, 0xcb, 0xc9, 0x3c, 0xb6, 0x88, 0xf4, 0xed, 0x97, 0x8d, 0xf4, 0x9d, 0xe7, 0x9b,
-	0x6a, 0xf5, 0x11, 0x5c, 0x3c, 0x6b, 0xe2, 0x33, 0xf4, 0xb8, 0x56, 0xd7, 0xe3, 0xc2, 0x51, 0x5a,
-	0xe9, 0xf4, 0xaf, 0x9b, 0xd0, 0xfe, 0x93, 0x24, 0x8c, 0xeb, 0xa7, 0x75, 0xe3, 0xdc, 0xd3, 0xba,
-	0x39, 0x7f, 0x5a, 0xbf, 0x01, 0x96, 0x92, 0x91, 0x17, 0x61, 0x02, 0xd1, 0x22, 0xcd, 0xf6, 0x94,
-	0x8c, 0x1e, 0x60, 0x0e, 0xf1, 0x06, 0x58, 0x7e, 0x62, 0x48, 0x6d, 0x4d, 0xf2, 0x13, 0x4d, 0x2a,
-	0xd2, 0x8b, 0xce, 0xd9, 0xe9, 0x45, 0x75, 0xc2, 0x77, 0xcf, 0x3f, 0xe1, 0xed, 0x48, 0x8e, 0x73,
-	0x4c, 0x26, 0x03, 0x13, 0xe3, 0xea, 0xc3, 0x58, 0x48, 0xdc, 0x4e, 0xe2, 0x80, 0xbd, 0x03, 0xa0,
-	0xc2, 0xc9, 0xa1, 0xe1, 0xb4, 0x4e, 0xe7, 0x62, 0x44, 0x45, 0x56, 0xf7, 0x27, 0x0d, 0xb0, 0xb6,
-	0xe2, 0x3c, 0xfc, 0x85, 0x95, 0x71, 0x19, 0xba, 0x4a, 0x66, 0xb3, 0xa8, 0x50, 0x85, 0x81, 0x4a,
-	0x71, 0xdb, 0x2f, 0x12, 0xb7, 0xf3, 0x52, 0xe2, 0x76, 0x5f, 0x5a, 0xdc, 0xde, 0xf3, 0xc4, 0xfd,
-	0xdb, 0x26, 0xd8, 0xa3, 0x38, 0x96, 0xea, 0x47, 0xe3, 0xc7, 0x81, 0xfb, 0x37, 0x4d, 0xb0, 0x1e,
-	0xc8, 0x71, 0xfe, 0xa3, 0x32, 0xe2, 0xc0, 0xfd, 0x8f, 0x26, 0xd8, 0x1c, 0xa1, 0x5f, 0x33, 0x6d,
-	0xbc, 0x03, 0x40, 0xb2, 0x9e, 0xa7, 0x12, 0xd2, 0xc4, 0x3e, 0xa9, 0xe5, 0x16, 0xf4, 0xb5, 0xb4,
-	0x9a, 0xb7, 0x77, 0x8a, 0x57, 0x2b, 0x63, 0xff, 0xb4, 0x0e, 0xad, 0x97, 0xd6, 0xa1, 0xfd, 0x3c,
-	0x1d, 0x7e, 0xdf, 0x80, 0x21, 0xe9, 0x70, 0x4f, 0x4e, 0x7f, 0xf5, 0x21, 0x65, 0x41, 0xfc, 0xce,
-	0xcb, 0x8b, 0xff, 0x4b, 0x8a, 0x2e, 0xa5, 0xf8, 0xaf, 0x24, 0xa2, 0xbe, 0x72, 0xf1, 0xf1, 0x2c,
-	0x79, 0x25, 0x86, 0x7f, 0x35, 0x67, 0xc9, 0xb7, 0x4d, 0x80, 0xbd, 0x30, 0x9e, 0x44, 0xf2, 0xc7,
-	0xf8, 0x19, 0x07, 0xee, 0xdf, 0x37, 0xc1, 0x7a, 0x28, 0xd4, 0xd1, 0x6f, 0x86, 0xf5, 0xd9, 0x6f,
-	0x43, 0x2f, 0x89, 0xb5, 0x79, 0x4e, 0xab, 0xa5, 0x9b, 0xc4, 0x68, 0x29, 0x57, 0x40, 0x6f, 0x57,
-	0x25, 0xc1, 0xcc, 0x9f, 0x37, 0x75, 0xe3, 0x7c, 0x53, 0x37, 0xe7, 0x4d, 0x5d, 0xca, 0xd6, 0x3a,
-	0x47, 0x36, 0xf7, 0x1f, 0x1a, 0x30, 0xa4, 0x84, 0xf9, 0xb3, 0x59, 0xec, 0xd3, 0xad, 0xfd, 0x22,
-	0x74, 0x44, 0x9e, 0xab, 0x8c, 0xa6, 0xb1, 0xb9, 0x06, 0xd8, 0x1a, 0xb4, 0x95, 0xcc, 0x33, 0x53,
-	0x99, 0x1b, 0x98, 0x1a, 0x47, 0x12, 0x61, 0x9e, 0x4d, 0x14, 0xd4, 0xb3, 0x50, 0x93, 0xec, 0x8c,
-	0x7a, 0x1c, 0xe1, 0xd1, 0x3e, 0xa9, 0x50, 0x62, 0x9a, 0x99, 0xba, 0xb2, 0x81, 0x18, 0x83, 0x36,
-	0xdd, 0xc6, 0x3a, 0x94, 0x84, 0x53, 0xdb, 0xfd, 0xd7, 0x06, 0xd8, 0x7f, 0x2c, 0xb2, 0xc3, 0x7b,
-	0xb3, 0x30, 0x0a, 0xaa, 0x7a, 0x19, 0x9a, 0xb1, 0x5e, 0x2f, 0x43, 0xf3, 0x15, 0xc4, 0x43, 0x91,
-	0x1d, 0x16, 0x15, 0x23, 0x44, 0x60, 0xf7, 0xba, 0x1f, 0xb5, 0xce, 0xf5, 0xa3, 0xf6, 0xa9, 0x62,
-	0xda, 0x0b, 0xfc, 0x61, 0x0d, 0x3a, 0x68, 0xe0, 0xec, 0x0c, 0x5f, 0xd0, 0x04, 0x77, 0x0b, 0x2e,
-	0xdd, 0x3f, 0xce, 0xa5, 0x8a, 0x45, 0x84, 0xf7, 0xca, 0xcd, 0xed, 0x24, 0xa2, 0xb2, 0x71, 0x29,
-	0x6c, 0xa3, 0x12, 0x16, 0x15, 0x5e, 0xaf, 0x34, 0x6b, 0xc0, 0xbd, 0x09, 0xfd, 0x71, 0x18, 0x49,
-	0x2f, 0x19, 0x8f, 0x33, 0xed, 0xdd, 0xba, 0x45, 0x66, 0x69, 0x71, 0x03, 0xb9, 0x3f, 0x6b, 0xc2,
-	0xa0, 0x98, 0x6a, 0xcf, 0x17, 0xe7, 0x99, 0xef, 0x2a, 0xd8, 0x34, 0x5a, 0x16, 0x3e, 0x93, 0x64,
-	0xc3, 0x16, 0xb7, 0x10, 0xb1, 0x17, 0x3e, 0x93, 0x6c, 0x0b, 0x2e, 0xd4, 0xa6, 0xf2, 0xf2, 0x24,
-	0x17, 0x91, 0x31, 0x63, 0xad, 0x42, 0x54, 0x63, 0xe1, 0xcb, 0x08, 0x3c, 0xa6, 0xf6, 0x3e, 0x72,
-	0xa3, 0x7b, 0xf8, 0x49, 0x54, 0x14, 0x20, 0x17, 0xdc, 0x03, 0x29, 0xec, 0x73, 0x58, 0x46, 0x69,
-	0x37, 0x3d, 0xf4, 0x55, 0x2d, 0xef, 0xa9, 0x8a, 0xdb, 0x99, 0x3a, 0xe3, 0xc3, 0x78, 0x4e, 0x85,
-	0xd7, 0x00, 0x7c, 0x25, 0xf1, 0xc2, 0x99, 0x3d, 0x89, 0xa8, 0x90, 0x63, 0x73, 0x5b, 0x63, 0xf6,
-	0x9e, 0x44, 0xa5, 0xa4, 0xb4, 0x1d, 0x7a, 0xa4, 0x03, 0x92, 0x94, 0xf6, 0xc3, 0x6d, 0xe8, 0x27,
-	0x2a, 0x9c, 0x84, 0xb1, 0x47, 0xab, 0xb5, 0xce, 0x58, 0x2d, 0x68, 0x86, 0x6d, 0x5c, 0xb3, 0x0b,
-	0xdd, 0x71, 0x18, 0xe5, 0x52, 0xd1, 0x6b, 0xc4, 0xc2, 0x1e, 0xd5, 0x14, 0xf7, 0xdf, 0x00, 0xfa,
-	0xa3, 0x38, 0xcb, 0xd5, 0xcc, 0x2f, 0x8a, 0x5e, 0x73, 0xa5, 0xe2, 0x15, 0x68, 0xe9, 0x2b, 0x34,
-	0x22, 0xb0, 0xc9, 0x7e, 0x07, 0xda, 0x22, 0xce, 0x43, 0x53, 0xc7, 0xac, 0x95, 0xf2, 0x8b, 0x63,
-	0x9f, 0x13, 0x9d, 0xdd, 0x86, 0x9e, 0xa9, 0xfb, 0x9b, 0xd8, 0x75, 0xe6, 0xa3, 0x41, 0xc1, 0xc3,
-	0x36, 0xc0, 0x0a, 0xcc, 0x83, 0x84, 0x09, 0xed, 0xb5, 0xa1, 0x8b, 0xa7, 0x0a, 0x5e, 0xf2, 0xe0,
-	0x1d, 0x5b, 0x4c, 0x26, 0xa6, 0x68, 0x59, 0xab, 0xe2, 0x50, 0x8d, 0x9a, 0x23, 0x8d, 0x6d, 0x02,
-	0x84, 0x78, 0xdb, 0xf1, 0xbe, 0x4e, 0xc2, 0x98, 0x1e, 0x6f, 0xe6, 0x16, 0x51, 0xde, 0x84, 0xb8,
-	0x1d, 0x96, 0x97, 0xa2, 0x3b, 0x26, 0x58, 0x52, 0x17, 0x6b, 0x71, 0x1d, 0xc5, 0x75, 0x41, 0x07,
-	0xcd, 0xa2, 0x43, 0x26, 0xa7, 0xa1, 0xee, 0x60, 0x2f, 0x76, 0x28, 0x12, 0x02, 0x6e, 0x65, 0x45,
-	0x6a, 0x70, 0x17, 0xfa, 0x19, 0x9d, 0x9b, 0xba, 0x0b, 0x50, 0x97, 0x8b, 0xb5, 0x2e, 0xe5, 0xa1,
-	0xca, 0x21, 0xab, 0x0e, 0xd8, 0x3b, 0x60, 0x4f, 0x85, 0x3a, 0xd2, 0x9d, 0xfa, 0x8b, 0xf3, 0x14,
-	0x47, 0x0f, 0xb7, 0xa6, 0xc5, 0x21, 0xe4, 0x42, 0x9b, 0x78, 0x07, 0x45, 0x71, 0xa1, 0xe0, 0xd5,
-	0x36, 0x42, 0x1a, 0xbb, 0x05, 0xbd, 0x54, 0x47, 0x68, 0x67, 0x48, 0x6c, 0x17, 0xea, 0x55, 0x1f,
-	0x22, 0xf0, 0x82, 0x83, 0x7d, 0x02, 0x4b, 0xba, 0x64, 0x31, 0x36, 0xb1, 0xd6, 0x59, 0xa2, 0x3e,
-	0xb5, 0xf2, 0xf9, 0x5c, 0x28, 0xe6, 0xc3, 0x7c, 0x2e, 0x32, 0x6f, 0x02, 0x60, 0x94, 0xf3, 0x0e,
-	0x30, 0x2a, 0x3a, 0xcb, 0x8b, 0xe6, 0x28, 0x03, 0x26, 0xb7, 0x0f, 0xcb, 0xd8, 0xf9, 0x21, 0x0c,
-	0xa5, 0xd9, 0x55, 0x5e, 0xe6, 0x8b, 0xd8, 0x59, 0xa1, 0x6e, 0x97, 0x4f, 0x6f, 0x3a, 0x8c, 0x1e,
-	0x7c, 0x20, 0xeb, 0xb1, 0x64, 0x1d, 0xba, 0xa6, 0xa4, 0x75, 0x81, 0x7a, 0xad, 0x2c, 0x16, 0xc7,
-	0xb9, 0xa1, 0xb3, 0x77, 0xa1, 0x1b, 0xe8, 0x82, 0x2d, 0x3b, 0xe5, 0x7a, 0xa6, 0xcc, 0xc7, 0x0d,
-	0x07, 0xbb, 0xb7, 0x50, 0x61, 0x3a, 0x92, 0x27, 0xce, 0xeb, 0xd4, 0xcb, 0x39, 0xaf, 0x6c, 0x34,
-	0x57, 0x7b, 0xfa, 0x42, 0x9e, 0xa0, 0x2a, 0x6a, 0x05, 0xb7, 0x8b, 0x8b, 0xaa, 0x28, 0xcb, 0x65,
-	0xdc, 0x4e, 0xcb, 0xea, 0xdf, 0x7b, 0x60, 0x25, 0x2a, 0x90, 0xca, 0x3b, 0x38, 0x71, 0x2e, 0xd1,
-	0xce, 0xbf, 0x60, 0x2a, 0x4b, 0xfa, 0xb9, 0x68, 0x2f, 0x95, 0x3e, 0xef, 0x25, 0x1a, 0x60, 0xb7,
-	0x61, 0x90, 0xaa, 0xe4, 0x6b, 0xe9, 0xe7, 0x3a, 0x94, 0x5c, 0x3e, 0xfd, 0xcc, 0x64, 0xe8, 0x14,
-	0x59, 0xaa, 0x50, 0x71, 0xe5, 0xbc, 0x50, 0x81, 0xa1, 0x39, 0x0a, 0xa7, 0x61, 0xee, 0x38, 0x74,
-	0xe2, 0x68, 0xa0, 0x16, 0xd9, 0xdf, 0x20, 0xb4, 0x81, 0xe8, 0xec, 0xca, 0x3e, 0x0b, 0x55, 0x96,
-	0x3b, 0xab, 0x74, 0xac, 0x15, 0x20, 0xf6, 0x08, 0xb3, 0x07, 0x22, 0xcb, 0x9d, 0xab, 0x44, 0x30,
-	0x10, 0x2a, 0x45, 0xa7, 0x1f, 0xe4, 0xb6, 0x6f, 0x2e, 0x2a, 0xa5, 0xbc, 0x9d, 0x9a, 0x3c, 0x84,
-	0x9c, 0xfc, 0x53, 0x58, 0xd6, 0x7d, 0xaa, 0x3d, 0x78, 0x6d, 0xd1, 0x29, 0xe7, 0xae, 0x64, 0x7c,
-	0xa8, 0xe6, 0x6e, 0x68, 0xe5, 0x00, 0x18, 0xb3, 0xf4, 0x00, 0xd7, 0xcf, 0x1c, 0xa0, 0x8c, 0x6e,
-	0x7a, 0x80, 0x02, 0x74, 0xef, 0xc2, 0x60, 0x8b, 0x1e, 0x89, 0xc3, 0x8c, 0x34, 0x79, 0x13, 0xda,
-	0x65, 0x96, 0x53, 0x9a, 0x88, 0x38, 0x9e, 0xc9, 0x51, 0x3c, 0x4e, 0x38, 0x91, 0xdd, 0x7f, 0x6f,
-	0x42, 0x77, 0x2f, 0x99, 0x29, 0x5f, 0xbe, 0xb8, 0xac, 0x7b, 0x0d, 0x40, 0x6f, 0x3c, 0xa2, 0x37,
-	0xf5, 0x91, 0x41, 0x18, 0x22, 0xd7, 0x13, 0xa8, 0x16, 0x9d, 0x18, 0x65, 0x02, 0x75, 0x11, 0x3a,
-	0x07, 0x51, 0xe2, 0x1f, 0x99, 0x97, 0x43, 0x0d, 0xd0, 0x33, 0xf2, 0x2c, 0x3b, 0x0c, 0x92, 0x6f,
-	0x62, 0x2f, 0xd4, 0x2f, 0x87, 0x6d, 0x0e, 0x05, 0x6a, 0x84, 0xd9, 0xdd, 0xb0, 0x64, 0x10, 0x41,
-	0xa0, 0xcc, 0x31, 0x35, 0x28, 0x90, 0x5b, 0x41, 0xa0, 0xca, 0xc4, 0xb4, 0x77, 0x4e, 0x62, 0xfa,
-	0x2e, 0x94, 0x05, 0x4c, 0x13, 0x48, 0xcf, 0xaf, 0x45, 0x6f, 0x82, 0x5d, 0xfe, 0x03, 0x30, 0x41,
-	0xf4, 0xe2, 0x46, 0xf5, 0x33, 0x60, 0xbf, 0x68, 0xf1, 0x8a, 0xcd, 0xfd, 0x0b, 0xb0, 0x1e, 0x25,
-	0x01, 0xe9, 0x14, 0xf3, 0x92, 0xa9, 0x9f, 0xce, 0xcc, 0xb9, 0x45, 0x6d, 0xf3, 0x64, 0xaf, 0xb5,
-	0x65, 0x9e, 0xec, 0x49, 0x96, 0x96, 0xce, 0x5d, 0xb0, 0x8d, 0x4e, 0x9a, 0x8a, 0x93, 0x28, 0x11,
-	0x01, 0x1d, 0xfd, 0x36, 0x2f, 0x40, 0xf7, 0x9f, 0x1b, 0x70, 0x61, 0x57, 0x25, 0xbe, 0xcc, 0xb2,
-	0x07, 0xe8, 0xe7, 0x82, 0x42, 0x18, 0x83, 0x36, 0xa5, 0x20, 0x38, 0x4f, 0x8b, 0x53, 0x1b, 0xad,
-	0xa3, 0x9f, 0xfd, 0x55, 0xf1, 0x28, 0xd4, 0xe2, 0xfa, 0x23, 0x00, 0xbd, 0x08, 0x95, 0x64, 0xea,
-	0xd8, 0xaa, 0x91, 0x29, 0x79, 0xb9, 0x09, 0x4b, 0xa9, 0x50, 0x79, 0x88, 0xc3, 0xeb, 0x11, 0xda,
-	0xc4, 0x32, 0x2c, 0xb1, 0x34, 0xca, 0x0d, 0xe8, 0x2b, 0x29, 0x70, 0xf7, 0xd3, 0x30, 0x1d, 0xe2,
-	0x01, 0x8d, 0xc2, 0x71, 0xf0, 0x3a, 0xd6, 0x37, 0xeb, 0x25, 0x8d, 0x68, 0xe9, 0x1b, 0xa5, 0xf4,
-	0xb7, 0xa1, 0x15, 0x85, 0x53, 0x53, 0x16, 0xbe, 0x3a, 0x17, 0xe5, 0xe7, 0x65, 0xe4, 0xc8, 0x87,
-	0x69, 0xc8, 0x2c, 0x0e, 0x8f, 0x3d, 0x54, 0xb7, 0x59, 0xb4, 0x85, 0x08, 0xb4, 0x04, 0x8a, 0x24,
-	0x7c, 0x3f, 0x99, 0xd1, 0xd3, 0x81, 0x79, 0xc3, 0xb2, 0x0d, 0x66, 0x44, 0x6f, 0xa0, 0x59, 0x2c,
-	0xd2, 0xec, 0x30, 0xc9, 0x4d, 0x56, 0x5c, 0xc2, 0xec, 0x03, 0x18, 0x64, 0x32, 0xcb, 0x50, 0xd8,
-	0x30, 0x1e, 0x27, 0xe6, 0xf8, 0xbe, 0x54, 0x3f, 0x30, 0x89, 0x4a, 0x3b, 0xa5, 0x9f, 0x55, 0x00,
-	0x7b, 0x0f, 0x98, 0x30, 0xfb, 0xcc, 0x8b, 0x93, 0xa0, 0x96, 0x21, 0x75, 0xf8, 0x4a, 0x41, 0x41,
-	0x87, 0xa0, 0xab, 0xc7, 0xff, 0x34, 0xa0, 0x5f, 0x1b, 0x8a, 0xfe, 0x6b, 0x64, 0x52, 0x15, 0x89,
-	0x2b, 0xb6, 0x11, 0x77, 0x98, 0x98, 0x57, 0x70, 0x9b, 0x53, 0x1b, 0x71, 0x2a, 0x89, 0x64, 0xe1,
-	0x24, 0xd8, 0xc6, 0xdd, 0x60, 0x92, 0x14, 0x5a, 0x76, 0x60, 0x32, 0xee, 0x41, 0x85, 0xd4, 0x42,
-	0x07, 0x22, 0x17, 0x07, 0x22, 0x2b, 0xae, 0x02, 0x25, 0x8c, 0x5e, 0xf6, 0x54, 0x2a, 0x5c, 0x8b,
-	0xd9, 0x48, 0x05, 0x88, 0x6a, 0x46, 0x0d, 0x7b, 0xcf, 0x92, 0x58, 0xd2, 0x46, 0x1a, 0x70, 0x0b,
-	0x11, 0x5f, 0x25, 0x31, 0x75, 0x33, 0x4a, 0xa5, 0xfd, 0x63, 0xf3, 0x02, 0x74, 0x7f, 0xda, 0x06,
-	0x6b, 0xd7, 0x68, 0x8c, 0xed, 0xc0, 0xb0, 0xfc, 0x14, 0x82, 0x09, 0x3e, 0xc9, 0xb8, 0x54, 0xcf,
-	0x4b, 0x77, 0x17, 0x1b, 0x74, 0x1b, 0x18, 0xa4, 0x35, 0x68, 0xf1, 0x6b, 0x49, 0xf3, 0xd4, 0xd7,
-	0x92, 0x37, 0xa1, 0xf5, 0x44, 0x9d, 0xcc, 0x7f, 0x0f, 0xd8, 0x8d, 0x44, 0xcc, 0x11, 0xcd, 0xde,
-	0x87, 0x3e, 0x8a, 0xeb, 0x65, 0x14, 0xd2, 0x4c, 0xc2, 0x57, 0x3b, 0x6f, 0x75, 0xa8, 0xe3, 0x80,
-	0x4c, 0x26, 0xec, 0x6d, 0x80, 0xe5, 0x1f, 0x86, 0x51, 0xa0, 0x64, 0x6c, 0x52, 0x69, 0x76, 0x7a,
-	0xc9, 0xbc, 0xe4, 0x61, 0x7f, 0x04, 0x2b, 0x61, 0x95, 0xa8, 0x6a, 0xf3, 0x77, 0x17, 0xb3, 0xfc,
-	0x5a, 0x2a, 0xcb, 0x97, 0x6b, 0xec, 0x14, 0x0d, 0x2f, 0xe1, 0xc1, 0xe3, 0xc9, 0x58, 0x7f, 0xe4,
-	0xb1, 0x78, 0x27, 0xcc, 0xee, 0xc7, 0x01, 0xbd, 0x67, 0x67, 0x55, 0xc2, 0x47, 0x07, 0x12, 0x9d,
-	0x0d, 0x9a, 0x40, 0xd1, 0xc1, 0x2e, 0x4f, 0xaa, 0x44, 0x04, 0x98, 0x02, 0xa3, 0x0b, 0x9a, 0xdc,
-	0xad, 0xb6, 0xec, 0x22, 0x20, 0x71, 0xa2, 0xd3, 0xaf, 0xa3, 0x59, 0x76, 0xe8, 0xe9, 0x48, 0x8b,
-	0xfe, 0xde, 0x27, 0xbd, 0x52, 0x20, 0xdd, 0xc1, 0x58, 0x8b, 0xbe, 0x79, 0x13, 0x96, 0x0a, 0x21,
-	0x3d, 0x6d, 0xee, 0x81, 0xfe, 0xe9, 0x52, 0x60, 0xb7, 0x11, 0xc9, 0x3e, 0x85, 0x95, 0xd9, 0x2c,
-	0x0c, 0x32, 0x2f, 0x4f, 0x3c, 0x25, 0x27, 0xf4, 0xb2, 0xa5, 0x1f, 0x3d, 0x6b, 0xd9, 0xd0, 0x97,
-	0xb3, 0x30, 0xd8, 0x4f, 0xcc, 0xff, 0x95, 0x21, 0xf1, 0x17, 0xa0, 0xfb, 0x29, 0x0c, 0xea, 0x0e,
-	0xc0, 0x6c, 0xe8, 0x3c, 0x94, 0x6a, 0x22, 0x57, 0x5e, 0x63, 0x00, 0xdd, 0x47, 0x89, 0x9a, 0x8a,
-	0x68, 0xa5, 0x81, 0x6d, 0xfd, 0x5c, 0xbd, 0xd2, 0x64, 0x03, 0xb0, 0x76, 0x85, 0x12, 0x51, 0x24,
-	0xa3, 0x95, 0x96, 0xfb, 0x21, 0x58, 0xc5, 0x77, 0x1d, 0xba, 0xb7, 0xe2, 0x2e, 0xa4, 0x90, 0xaa,
-	0x77, 0x95, 0x85, 0x08, 0x3a, 0x1a, 0x8a, 0xdf, 0x51, 0xcd, 0xea, 0x77, 0x94, 0xfb, 0xa7, 0x30,
-	0xa8, 0x2f, 0xae, 0xb8, 0x58, 0x34, 0xaa, 0x8b, 0xc5, 0x19, 0xbd, 0xe8, 0x3a, 0xa4, 0x92, 0xa9,
-	0x57, 0x8b, 0xdc, 0x16, 0x22, 0x70, 0x9a, 0x7b, 0xdb, 0xff, 0xf9, 0xdd, 0xf5, 0xc6, 0x7f, 0x7d,
-	0x77, 0xbd, 0xf1, 0x7f, 0xdf, 0x5d, 0x7f, 0xed, 0x1f, 0xff, 0xff, 0x7a, 0xe3, 0xab, 0xf7, 0x6b,
-	0x1f, 0xd1, 0xa6, 0x22, 0x57, 0xe1, 0xb1, 0xbe, 0x0e, 0x15, 0x40, 0x2c, 0xef, 0xa4, 0x47, 0x93,
-	0x3b, 0xe9, 0xc1, 0x9d, 0x42, 0x63, 0x07, 0x5d, 0xfa, 0x76, 0xf6, 0x7b, 0x3f, 0x0f, 0x00, 0x00,
-	0xff, 0xff, 0x69, 0xc2, 0x62, 0x46, 0xde, 0x26, 0x00, 0x00,
+	// 3307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x73, 0x1d, 0x47,
+	0x57, 0xdf, 0x7d, 0xcf, 0x9c, 0x7b, 0xaf, 0x24, 0x77, 0xfc, 0x98, 0xc8, 0xb1, 0x2d, 0x86, 0xcf,
+	0x44, 0xdf, 0xe7, 0x58, 0xe6, 0x53, 0x30, 0x15, 0xc8, 0x0b, 0x59, 0x72, 0xc2, 0x05, 0xcb, 0x16,
+	0x2d, 0xa5, 0x52, 0xa4, 0x28, 0xa6, 0x5a, 0x33, 0x7d, 0xaf, 0x26, 0x9a, 0xdb, 0x33, 0x9e, 0x99,
+	0x6b, 0x4b, 0x5e, 0xb1, 0x62, 0x01, 0x61, 0x41, 0xf1, 0x07, 0xb2, 0x64, 0xcf, 0x2f, 0x60, 0xc7,
+	0x82, 0x05, 0x6c, 0x61, 0x01, 0x15, 0xb6, 0x2c, 0x59, 0x51, 0x29, 0x8a, 0x3a, 0xa7, 0x7b, 0x1e,
+	0xf7, 0x4a, 0xb2, 0x1d, 0x8a, 0xc2, 0x54, 0x7d, 0xd9, 0xf5, 0x79, 0xf4, 0xe3, 0x3c, 0xfa, 0xf4,
+	0xe9, 0xd3, 0x0d, 0x4b, 0x49, 0x98, 0xc8, 0x28, 0x54, 0x72, 0x23, 0x49, 0xe3, 0x3c, 0x66, 0x56,
+	0x01, 0xaf, 0xde, 0x9d, 0x84, 0xf9, 0xd1, 0xec, 0x70, 0xc3, 0x8f, 0xa7, 0xf7, 0x26, 0xf1, 0x24,
+	0xbe, 0x47, 0x0c, 0x87, 0xb3, 0x31, 0x41, 0x04, 0x50, 0x4b, 0x77, 0x5c, 0x85, 0x24, 0x12, 0xca,
+	0xb4, 0x97, 0xf3, 0x70, 0x2a, 0xb3, 0x5c, 0x4c, 0x13, 0x8d, 0x70, 0xbf, 0x69, 0x42, 0x6f, 0x57,
+	0x66, 0x99, 0x98, 0x48, 0xb6, 0x02, 0xad, 0x2c, 0x0c, 0x9c, 0xc6, 0x5a, 0x63, 0xbd, 0xcd, 0xb1,
+	0x89, 0x18, 0x7f, 0x1a, 0x38, 0x4d, 0x8d, 0xf1, 0xa7, 0x84, 0x91, 0x69, 0xea, 0xb4, 0xd6, 0x1a,
+	0xeb, 0x03, 0x8e, 0x4d, 0xc6, 0xa0, 0x1d, 0x88, 0x5c, 0x38, 0x6d, 0x42, 0x51, 0x9b, 0xfd, 0x14,
+	0x96, 0x92, 0x34, 0xf6, 0xbd, 0x50, 0x8d, 0x63, 0x8f, 0xa8, 0x1d, 0xa2, 0x0e, 0x10, 0x3b, 0x52,
+	0xe3, 0x78, 0x07, 0xb9, 0x1c, 0xe8, 0x09, 0x25, 0xa2, 0xd3, 0x4c, 0x3a, 0x5d, 0x22, 0x17, 0x20,
+	0x5b, 0x82, 0x66, 0x18, 0x38, 0x3d, 0x9a, 0xb6, 0x19, 0x06, 0x38, 0xc7, 0x6c, 0x16, 0x06, 0x8e,
+	0xa5, 0xe7, 0xc0, 0x36, 0xbb, 0x0e, 0xf6, 0xa1, 0xc8, 0xfd, 0x23, 0xcf, 0x57, 0xb9, 0x63, 0x13,
+	0xab, 0x45, 0x88, 0x6d, 0x95, 0xb3, 0x55, 0xb0, 0xfc, 0x23, 0xe9, 0x1f, 0x67, 0xb3, 0xa9, 0x03,
+	0x6b, 0x8d, 0xf5, 0x21, 0x2f, 0x61, 0xa4, 0x65, 0xf2, 0xe9, 0x4c, 0x2a, 0x5f, 0x3a, 0x7d, 0xdd,
+	0xaf, 0x80, 0xdd, 0x2f, 0xc0, 0xde, 0x8e, 0x95, 0x92, 0x7e, 0x1e, 0xa7, 0xec, 0x16, 0xf4, 0x0b,
+	0x9d, 0x7b, 0x46, 0x2f, 0x1d, 0x0e, 0x05, 0x6a, 0x14, 0xb0, 0x77, 0x61, 0xd9, 0x2f, 0xb8, 0xbd,
+	0x50, 0x05, 0xf2, 0x84, 0x54, 0xd5, 0xe1, 0x4b, 0x25, 0x7a, 0x84, 0x58, 0xf7, 0xaf, 0x9b, 0x60,
+	0xed, 0x84, 0x59, 0x82, 0xcb, 0x63, 0xd7, 0xa0, 0x37, 0x9e, 0x29, 0xbf, 0x1a, 0xb2, 0x8b, 0xe0,
+	0x28, 0x60, 0x1f, 0xc1, 0x72, 0x14, 0xfb, 0x22, 0xf2, 0xca, 0xde, 0x4e, 0x73, 0xad, 0xb5, 0xde,
+	0xdf, 0x7c, 0x6b, 0xa3, 0xf4, 0x85, 0x72, 0x75, 0x7c, 0x89, 0x78, 0xab, 0xd5, 0x7e, 0x0c, 0x2b,
+	0xa9, 0x9c, 0xc6, 0xb9, 0xac, 0x75, 0x6f, 0x51, 0x77, 0x56, 0x75, 0xff, 0x32, 0x15, 0xc9, 0xe3,
+	0x38, 0x90, 0x7c, 0x59, 0xf3, 0x56, 0xdd, 0x7f, 0x0a, 0xc3, 0xfd, 0xa3, 0xd9, 0x78, 0x1c, 0xc9,
+	0xed, 0x38, 0x1a, 0x05, 0x27, 0x64, 0xcf, 0x0e, 0x9f, 0x47, 0xb2, 0x0d, 0x60, 0x06, 0xc1, 0xe5,
+	0x64, 0x14, 0x9c, 0x3c, 0xc2, 0x35, 0x38, 0x9d, 0xb5, 0xd6, 0x7a, 0x87, 0x9f, 0x43, 0x61, 0xbf,
+	0x0e, 0x6f, 0xcd, 0x61, 0x39, 0xcd, 0xea, 0x74, 0xa9, 0xc3, 0x79, 0x24, 0xf7, 0x6f, 0x1a, 0x30,
+	0xdc, 0x9d, 0x45, 0x79, 0xb8, 0x95, 0x4e, 0x66, 0x72, 0xaa, 0x72, 0x34, 0xfe, 0x4e, 0x98, 0xe5,
+	0xa4, 0x2c, 0x8b, 0x53, 0x9b, 0xad, 0x83, 0xfd, 0x79, 0x1a, 0xcf, 0x92, 0x87, 0x27, 0x49, 0xa1,
+	0x24, 0xd8, 0x20, 0x3f, 0x47, 0x0c, 0xaf, 0x88, 0xec, 0x3d, 0xe8, 0x3f, 0x49, 0x03, 0x99, 0x3e,
+	0x38, 0x25, 0xde, 0xd6, 0x19, 0xde, 0x3a, 0x99, 0xbd, 0x03, 0xf6, 0xbe, 0x4c, 0x44, 0x2a, 0x50,
+	0x7b, 0xa8, 0x01, 0x9b, 0x57, 0x08, 0x74, 0x58, 0x62, 0x1e, 0x05, 0xe4, 0xcf, 0x1d, 0x5e, 0x80,
+	0xee, 0x13, 0xb0, 0xb7, 0x26, 0x93, 0x54, 0x4e, 0x44, 0x4e, 0xde, 0x1b, 0x27, 0xc6, 0xb6, 0xcd,
+	0x38, 0xa1, 0x1d, 0x82, 0x02, 0x34, 0xb5, 0x00, 0xd8, 0x66, 0x37, 0xa1, 0x2d, 0xf5, 0x7a, 0x1a,
+	0x0b, 0xeb, 0x21, 0xbc, 0xfb, 0x7d, 0x03, 0x3a, 0x24, 0x04, 0xfa, 0xb9, 0x92, 0x32, 0xf0, 0xe4,
+	0x33, 0x11, 0x19, 0x1d, 0x58, 0x88, 0x78, 0xf8, 0x4c, 0x44, 0xb8, 0xa2, 0xf0, 0x70, 0xe6, 0x1f,
+	0xcb, 0xdc, 0x6c, 0xd2, 0x02, 0x44, 0x8a, 0x32, 0x94, 0x96, 0xa6, 0x18, 0x90, 0xad, 0x41, 0x07,
+	0xa7, 0xc8, 0x9c, 0xf6, 0x19, 0x5d, 0x68, 0x02, 0x72, 0xe4, 0xa7, 0x89, 0xcc, 0x9c, 0x4e, 0x9d,
+	0xe3, 0xe0, 0x34, 0x91, 0x5c, 0x13, 0xd8, 0xbb, 0xd0, 0x16, 0x93, 0x49, 0xe6, 0x74, 0x17, 0xfd,
+	0xb3, 0xd4, 0x02, 0x27, 0x06, 0x76, 0x1f, 0x6c, 0x6d, 0x4d, 0xe4, 0xee, 0x11, 0xf7, 0xb5, 0x8a,
+	0x7b, 0xce, 0xd0, 0xbc, 0xe2, 0x74, 0xff, 0xa5, 0x09, 0xdd, 0x91, 0xca, 0x64, 0x4a, 0x5b, 0x59,
+	0x8c, 0xc7, 0xd2, 0xcf, 0x65, 0x11, 0x9a, 0x4a, 0x18, 0x69, 0xa3, 0xcc, 0xf8, 0x94, 0xd6, 0x6e,
+	0x09, 0xb3, 0x5f, 0x81, 0x56, 0x2a, 0xc7, 0x46, 0xc1, 0xcb, 0x5a, 0x84, 0x27, 0x87, 0x5f, 0x4b,
+	0x3f, 0xe7, 0x72, 0xcc, 0x91, 0xc6, 0xee, 0x80, 0x9d, 0x8b, 0xc3, 0x48, 0x7a, 0x81, 0x1c, 0x93,
+	0xb5, 0xfb, 0x9b, 0x4b, 0x46, 0x56, 0x44, 0xef, 0xc8, 0x31, 0xb7, 0x72, 0xd3, 0x62, 0x9f, 0x00,
+	0x24, 0x22, 0x95, 0x2a, 0xf7, 0xc2, 0xe0, 0xc4, 0x68, 0xe6, 0x56, 0x25, 0x8a, 0x5e, 0xed, 0xc6,
+	0x1e, 0xb1, 0x8c, 0x82, 0x93, 0x87, 0x2a, 0x4f, 0x4f, 0xb9, 0x9d, 0x14, 0x30, 0xfb, 0x4d, 0x18,
+	0x6c, 0x47, 0xb3, 0x2c, 0x97, 0x29, 0x0d, 0x4e, 0x21, 0x8f, 0xf6, 0x26, 0xce, 0x57, 0xa7, 0xf0,
+	0x39, 0x3e, 0x0c, 0x17, 0x61, 0x70, 0x42, 0x93, 0xf6, 0x68, 0xdb, 0x74, 0xc3, 0xe0, 0x64, 0x14,
+	0x9c, 0xac, 0x7e, 0x04, 0x4b, 0xf3, 0xb3, 0x61, 0x70, 0x3e, 0x96, 0xa7, 0xa4, 0x25, 0x9b, 0x63,
+	0x93, 0x5d, 0x86, 0xce, 0x33, 0x11, 0xcd, 0xa4, 0x89, 0x4b, 0x1a, 0xf8, 0xed, 0xe6, 0x07, 0x0d,
+	0xf7, 0x06, 0x74, 0xb6, 0xd2, 0x54, 0x10, 0x8b, 0xc0, 0x86, 0xd3, 0xa0, 0xd1, 0x35, 0xe0, 0xfa,
+	0xd0, 0xda, 0x15, 0x09, 0xbb, 0x0d, 0xcd, 0x69, 0x42, 0x94, 0xfe, 0xe6, 0x95, 0x9a, 0xdd, 0x44,
+	0xb2, 0xb1, 0x9b, 0x68, 0x11, 0x9b, 0xd3, 0x64, 0xf5, 0x3e, 0xf4, 0x76, 0x93, 0x1f, 0xbe, 0x86,
+	0xbf, 0xe8, 0x80, 0xb5, 0x23, 0x23, 0x99, 0x87, 0xb1, 0xc2, 0x5d, 0x73, 0x90, 0x19, 0x0b, 0x37,
+	0x0f, 0x32, 0xe6, 0xc2, 0x60, 0xcb, 0xd8, 0x99, 0xc7, 0xcf, 0x33, 0xe3, 0xdf, 0x73, 0x38, 0xe4,
+	0xd1, 0xd6, 0xa6, 0x51, 0x24, 0x19, 0xdb, 0xe2, 0x73, 0x38, 0xdc, 0x08, 0xa3, 0x07, 0x7a, 0x23,
+	0xb4, 0xe9, 0x24, 0x28, 0x40, 0xa4, 0x3c, 0x36, 0x94, 0x8e, 0xa6, 0x18, 0x90, 0xad, 0x41, 0x7f,
+	0x5b, 0xa8, 0x83, 0x74, 0xa6, 0x7c, 0x91, 0x6b, 0x53, 0x59, 0xbc, 0x8e, 0x62, 0xef, 0x42, 0x77,
+	0x47, 0x46, 0x5c, 0x8e, 0x8d, 0x53, 0x9f, 0x71, 0x30, 0x43, 0x66, 0x57, 0xa1, 0x3b, 0x22, 0x7b,
+	0x39, 0x96, 0xb6, 0x9e, 0x86, 0x30, 0xde, 0x3e, 0x51, 0x5c, 0x66, 0x79, 0x1a, 0xfa, 0x68, 0x41,
+	0xc7, 0x26, 0xf2, 0x3c, 0x12, 0x05, 0x7c, 0xa2, 0xb6, 0x45, 0xe6, 0x8b, 0x40, 0x22, 0x13, 0x10,
+	0xd3, 0x1c, 0x8e, 0xdd, 0x01, 0xeb, 0x89, 0xda, 0x97, 0x38, 0xab, 0xd3, 0x3f, 0x7f, 0x31, 0x25,
+	0x03, 0xfb, 0x0d, 0x9c, 0x76, 0x5f, 0xe6, 0x85, 0x83, 0x3b, 0x83, 0xb5, 0xd6, 0x39, 0x6e, 0x3f,
+	0xcf, 0xc4, 0xee, 0xc3, 0x12, 0x21, 0xbe, 0x48, 0x02, 0x81, 0x87, 0x46, 0xe4, 0x0c, 0xa9, 0xdb,
+	0x70, 0xce, 0x25, 0xf8, 0x02, 0x53, 0xb9, 0x32, 0x5c, 0xf9, 0x52, 0xb1, 0xb2, 0x32, 0x52, 0xa0,
+	0x9f, 0xf1, 0x92, 0x81, 0x3d, 0x00, 0xd8, 0x97, 0x93, 0xa9, 0x54, 0xf9, 0xae, 0x48, 0x9c, 0x65,
+	0x62, 0x77, 0x2b, 0xf6, 0xc2, 0x4f, 0x36, 0x2a, 0x26, 0xed, 0x7f, 0xb5, 0x5e, 0xab, 0x1f, 0xc3,
+	0xf2, 0x02, 0xf9, 0x07, 0xf9, 0xe3, 0x9f, 0x34, 0xc1, 0xde, 0x4b, 0xa5, 0x09, 0x3c, 0xb7, 0xa0,
+	0x9f, 0xf9, 0x47, 0x72, 0x2a, 0x3c, 0x25, 0xa6, 0xd2, 0x8c, 0x00, 0x1a, 0xf5, 0x58, 0x4c, 0xe5,
+	0x7c, 0xf8, 0x68, 0xbe, 0x22, 0x7c, 0xfc, 0x31, 0x5c, 0xa9, 0xc2, 0x87, 0x97, 0xa4, 0xd2, 0x0b,
+	0x69, 0x1a, 0x73, 0x22, 0xdd, 0xa9, 0x24, 0x2d, 0x57, 0x50, 0x05, 0x93, 0x12, 0xa5, 0x45, 0x66,
+	0xc9, 0x19, 0xc2, 0xea, 0x43, 0xb8, 0x76, 0x01, 0xfb, 0x0f, 0x52, 0xc1, 0x3f, 0x36, 0xd1, 0xd4,
+	0x3b, 0xb3, 0x24, 0x0a, 0xd1, 0xcf, 0x7f, 0x5f, 0x9e, 0xbe, 0x34, 0x00, 0xaf, 0xc3, 0x4a, 0xac,
+	0xbc, 0xa0, 0x60, 0xa7, 0x28, 0xd5, 0x24, 0x1f, 0x5d, 0x8a, 0xab, 0x51, 0xd0, 0xbc, 0x7f, 0x08,
+	0x97, 0xe6, 0x38, 0x65, 0x75, 0x1a, 0xdf, 0xad, 0x64, 0x9f, 0x9f, 0xba, 0x0e, 0xe2, 0xf9, 0xa4,
+	0xa5, 0x5f, 0x8e, 0xe7, 0xb1, 0x45, 0xa4, 0x6f, 0xbf, 0x6e, 0xa4, 0xef, 0xbc, 0xdc, 0x54, 0xab,
+	0x8f, 0xe1, 0xf2, 0x79, 0x13, 0x9f, 0xa3, 0xc7, 0xb5, 0xba, 0x1e, 0x17, 0x8e, 0xd2, 0x4a, 0xa7,
+	0x7f, 0xda, 0x84, 0xf6, 0xef, 0xc5, 0xa1, 0xaa, 0x9f, 0xd6, 0x8d, 0x0b, 0x4f, 0xeb, 0xe6, 0xfc,
+	0x69, 0xfd, 0x36, 0x58, 0xa9, 0x8c, 0xbc, 0x08, 0x13, 0x88, 0x16, 0x69, 0xb6, 0x97, 0xca, 0xe8,
+	0x11, 0xe6, 0x10, 0x6f, 0x83, 0xe5, 0xc7, 0x86, 0xd4, 0xd6, 0x24, 0x3f, 0x8e, 0x1e, 0xd5, 0xd3,
+	0x8b, 0xce, 0xf9, 0xe9, 0x45, 0x75, 0xc2, 0x77, 0x2f, 0x3e, 0xe1, 0xed, 0x48, 0x8e, 0x73, 0x4c,
+	0x26, 0x03, 0xa7, 0x57, 0xe7, 0xa2, 0x61, 0x2c, 0x24, 0x6e, 0xc7, 0x2a, 0x60, 0x3f, 0x03, 0x48,
+	0xc3, 0xc9, 0x91, 0xe1, 0xb4, 0xce, 0xe6, 0x62, 0x44, 0x45, 0x56, 0xf7, 0xdf, 0x1b, 0x60, 0x6d,
+	0xa9, 0x3c, 0xfc, 0x1f, 0x2b, 0xe3, 0x2a, 0x74, 0x53, 0x99, 0xcd, 0xa2, 0x42, 0x15, 0x06, 0x2a,
+	0xc5, 0x6d, 0xbf, 0x4a, 0xdc, 0xce, 0x6b, 0x89, 0xdb, 0x7d, 0x6d, 0x71, 0x7b, 0x2f, 0x13, 0xf7,
+	0xcf, 0x9b, 0x60, 0x8f, 0x94, 0x92, 0xe9, 0x8f, 0xc6, 0x57, 0x81, 0xfb, 0x67, 0x4d, 0xb0, 0x1e,
+	0xc9, 0x71, 0xfe, 0xa3, 0x32, 0x54, 0xe0, 0xfe, 0x6d, 0x13, 0x6c, 0x8e, 0xd0, 0xff, 0x33, 0x6d,
+	0xfc, 0x0c, 0x80, 0x64, 0xbd, 0x48, 0x25, 0xa4, 0x89, 0x03, 0x52, 0xcb, 0x1d, 0xe8, 0x6b, 0x69,
+	0x35, 0x6f, 0xef, 0x0c, 0xaf, 0x56, 0xc6, 0xc1, 0x59, 0x1d, 0x5a, 0xaf, 0xad, 0x43, 0xfb, 0x65,
+	0x3a, 0xfc, 0xbe, 0x01, 0x43, 0xd2, 0xe1, 0xbe, 0x9c, 0xfe, 0xdf, 0x87, 0x94, 0x05, 0xf1, 0x3b,
+	0xaf, 0x2f, 0xfe, 0xff, 0x52, 0x74, 0x29, 0xc5, 0x7f, 0x23, 0x11, 0xf5, 0x8d, 0x8b, 0x8f, 0x67,
+	0xc9, 0x1b, 0x31, 0xfc, 0x9b, 0x39, 0x4b, 0xbe, 0x69, 0x02, 0xec, 0x87, 0x6a, 0x12, 0xc9, 0x1f,
+	0xe3, 0xa7, 0x0a, 0xdc, 0xbf, 0x6c, 0x82, 0xb5, 0x2b, 0xd2, 0xe3, 0x5f, 0x0e, 0xeb, 0xb3, 0x5f,
+	0x85, 0x5e, 0xac, 0xb4, 0x79, 0xce, 0xaa, 0xa5, 0x1b, 0x2b, 0xb4, 0x94, 0x2b, 0xa0, 0xb7, 0x97,
+	0xc6, 0xc1, 0xcc, 0x9f, 0x37, 0x75, 0xe3, 0x62, 0x53, 0x37, 0xe7, 0x4d, 0x5d, 0xca, 0xd6, 0xba,
+	0x40, 0x36, 0xf7, 0xaf, 0x1a, 0x30, 0xa4, 0x84, 0xf9, 0xb3, 0x99, 0xf2, 0xe9, 0xd6, 0x8e, 0xd5,
+	0x83, 0x3c, 0x4f, 0x33, 0x9a, 0xc6, 0xe6, 0x1a, 0x60, 0x6b, 0xd0, 0x4e, 0x65, 0x9e, 0x99, 0xca,
+	0xdc, 0xc0, 0xd4, 0x38, 0xe2, 0x08, 0xf3, 0x6c, 0xa2, 0xa0, 0x9e, 0x45, 0x3a, 0xc9, 0xce, 0xa9,
+	0xc7, 0x11, 0x1e, 0xed, 0x83, 0x55, 0xb7, 0x69, 0x66, 0xea, 0xca, 0x06, 0xc2, 0x5a, 0x1a, 0xdd,
+	0xc6, 0x3a, 0x94, 0x84, 0x53, 0xdb, 0xfd, 0xa7, 0x06, 0xd8, 0xbf, 0x2b, 0xb2, 0xa3, 0x07, 0xb3,
+	0x30, 0x0a, 0xaa, 0x7a, 0x19, 0x9a, 0xb1, 0x5e, 0x2f, 0x43, 0xf3, 0x15, 0xc4, 0x23, 0x91, 0x1d,
+	0x15, 0x15, 0x23, 0x44, 0x60, 0xf7, 0xba, 0x1f, 0xb5, 0x2e, 0xf4, 0xa3, 0xf6, 0x99, 0x62, 0xda,
+	0x2b, 0xfc, 0x61, 0x0d, 0x3a, 0x68, 0xe0, 0xec, 0x1c, 0x5f, 0xd0, 0x04, 0x5c, 0x94, 0x2f, 0x94,
+	0x97, 0x25, 0x61, 0x14, 0x51, 0xd1, 0xdb, 0xe2, 0x96, 0x2f, 0xd4, 0x3e, 0xc2, 0xee, 0x16, 0x5c,
+	0x79, 0x78, 0x92, 0xcb, 0x54, 0x89, 0x08, 0x2f, 0x9d, 0x9b, 0x58, 0x88, 0xc5, 0x9a, 0x72, 0xa9,
+	0x89, 0x46, 0xa5, 0x09, 0xb4, 0x46, 0xbd, 0x0c, 0xad, 0x01, 0xf7, 0x36, 0xf4, 0xc7, 0x61, 0x24,
+	0xbd, 0x78, 0x3c, 0xce, 0xb4, 0xeb, 0xeb, 0x16, 0xd9, 0xac, 0xc5, 0x0d, 0xe4, 0xfe, 0x57, 0x13,
+	0x06, 0xc5, 0x54, 0xfb, 0xbe, 0xb8, 0xc8, 0xb6, 0xd7, 0xc1, 0xa6, 0xd1, 0xb2, 0xf0, 0x85, 0x24,
+	0x03, 0xb7, 0xb8, 0x85, 0x88, 0xfd, 0xf0, 0x85, 0x64, 0x5b, 0x70, 0xa9, 0x36, 0x95, 0x97, 0xc7,
+	0xb9, 0x88, 0x9c, 0xd6, 0x62, 0xf9, 0xa8, 0xc6, 0xc2, 0x97, 0x11, 0x78, 0x42, 0xed, 0x03, 0xe4,
+	0x46, 0xdf, 0xf1, 0xe3, 0xa8, 0xa8, 0x4e, 0x2e, 0xf8, 0x0e, 0x52, 0xd8, 0xe7, 0xb0, 0x8c, 0xd2,
+	0x6e, 0x7a, 0xe8, 0xc8, 0x5a, 0xde, 0x33, 0xe5, 0xb8, 0x73, 0x75, 0xc6, 0x87, 0xaa, 0x0e, 0xb2,
+	0x1b, 0x00, 0x7e, 0x2a, 0xf1, 0x36, 0x9a, 0x3d, 0x8d, 0xa8, 0xca, 0x63, 0x73, 0x5b, 0x63, 0xf6,
+	0x9f, 0x46, 0xa5, 0xa4, 0xb4, 0x57, 0x7a, 0xa4, 0x03, 0x92, 0x94, 0x36, 0xcb, 0x5d, 0xe8, 0xc7,
+	0x69, 0x38, 0x09, 0x95, 0x47, 0xab, 0xb5, 0xce, 0x59, 0x2d, 0x68, 0x86, 0x6d, 0x5c, 0xb3, 0x0b,
+	0xdd, 0x71, 0x18, 0xe5, 0x32, 0xa5, 0xa7, 0x8a, 0x85, 0x0d, 0xac, 0x29, 0xee, 0xb7, 0x7d, 0xe8,
+	0x8f, 0x54, 0x96, 0xa7, 0x33, 0xbf, 0xa8, 0x88, 0xcd, 0xd5, 0x91, 0x57, 0xa0, 0xa5, 0xef, 0xd7,
+	0x88, 0xc0, 0x26, 0xfb, 0x35, 0x68, 0x0b, 0x95, 0x87, 0xa6, 0xc8, 0x59, 0xab, 0xf3, 0x17, 0x39,
+	0x01, 0x27, 0x3a, 0xbb, 0x0b, 0x3d, 0xf3, 0x28, 0x60, 0x02, 0xdb, 0xb9, 0x2f, 0x0a, 0x05, 0x0f,
+	0xdb, 0x00, 0x2b, 0x30, 0xaf, 0x15, 0x4e, 0x67, 0x71, 0xe8, 0xe2, 0x1d, 0x83, 0x97, 0x3c, 0x78,
+	0x01, 0x17, 0x93, 0x89, 0xa9, 0x68, 0xd6, 0x4a, 0x3c, 0x54, 0xc0, 0xe6, 0x48, 0x63, 0x9b, 0x00,
+	0xa1, 0x52, 0x32, 0xf5, 0xbe, 0x8e, 0x43, 0xe5, 0xf4, 0x16, 0x17, 0x51, 0x5e, 0x93, 0xb8, 0x1d,
+	0x16, 0x4d, 0x76, 0xcf, 0x44, 0x52, 0xea, 0x62, 0x2d, 0xae, 0xa3, 0xb8, 0x4b, 0xe8, 0x88, 0x5a,
+	0x74, 0xc8, 0xe4, 0x34, 0xd4, 0x1d, 0xec, 0xc5, 0x0e, 0x45, 0xb6, 0x80, 0xcf, 0x3d, 0xba, 0xc5,
+	0xee, 0x43, 0x3f, 0xa3, 0x43, 0x55, 0x77, 0x01, 0xea, 0x72, 0xb9, 0xd6, 0xa5, 0x3c, 0x71, 0x39,
+	0x64, 0x65, 0x1b, 0xe7, 0x99, 0x8a, 0xf4, 0x58, 0x77, 0xea, 0x2f, 0xce, 0x53, 0x9c, 0x4b, 0xdc,
+	0x9a, 0x9a, 0x16, 0x73, 0xa1, 0x4d, 0xbc, 0x83, 0xa2, 0xf2, 0x50, 0xf0, 0x6a, 0x1b, 0x21, 0x8d,
+	0xdd, 0x81, 0x5e, 0xa2, 0xc3, 0xb7, 0x33, 0x24, 0xb6, 0x4b, 0xf5, 0x92, 0x10, 0x11, 0x78, 0xc1,
+	0xc1, 0x3e, 0x81, 0x25, 0x5d, 0xcf, 0x18, 0x9b, 0x40, 0xec, 0x2c, 0xad, 0x35, 0xe6, 0x6b, 0xeb,
+	0x73, 0x71, 0x9a, 0x0f, 0xf3, 0x3a, 0x88, 0xe6, 0xc0, 0x10, 0xe8, 0x1d, 0x62, 0xc8, 0x74, 0x96,
+	0x17, 0xcd, 0x51, 0x46, 0x53, 0x6e, 0x1f, 0x15, 0x4d, 0xf6, 0x21, 0x0c, 0xa5, 0xd9, 0x55, 0x5e,
+	0xe6, 0x0b, 0xe5, 0xac, 0x50, 0xb7, 0xab, 0x67, 0x37, 0x1d, 0x46, 0x0f, 0x3e, 0x90, 0x35, 0x88,
+	0xad, 0x43, 0xd7, 0xd4, 0xbb, 0x2e, 0x51, 0xaf, 0x95, 0xc5, 0xca, 0x39, 0x37, 0x74, 0xf6, 0x73,
+	0xe8, 0x06, 0xba, 0x9a, 0xcb, 0xce, 0xb8, 0x9e, 0xa9, 0x01, 0x72, 0xc3, 0xc1, 0x1e, 0x2c, 0x94,
+	0x9f, 0xb0, 0x3c, 0xf3, 0x16, 0xf5, 0x72, 0x2e, 0xaa, 0x29, 0xcd, 0x15, 0xa6, 0xb0, 0xbc, 0xb5,
+	0x09, 0x50, 0xab, 0xc6, 0x5d, 0x5e, 0x54, 0x45, 0x59, 0x4b, 0xe3, 0x76, 0x52, 0x34, 0xd9, 0x7b,
+	0x60, 0xc5, 0xf8, 0xf2, 0xe3, 0x1d, 0x9e, 0x3a, 0x57, 0x68, 0xe7, 0x5f, 0x32, 0x65, 0x27, 0xfd,
+	0x96, 0xb4, 0x9f, 0x48, 0x9f, 0xf7, 0x62, 0x0d, 0xb0, 0xbb, 0x80, 0xef, 0x9e, 0x58, 0x8f, 0xd2,
+	0xa1, 0xe4, 0xea, 0xd9, 0x37, 0x28, 0x43, 0xa7, 0xc8, 0x52, 0x85, 0x8a, 0x6b, 0x17, 0x85, 0x0a,
+	0x0c, 0xcd, 0x51, 0x38, 0x0d, 0x73, 0xc7, 0xa1, 0xe3, 0x48, 0x03, 0xb5, 0xc8, 0xfe, 0x36, 0xa1,
+	0x0d, 0x44, 0x07, 0x5b, 0xf6, 0x59, 0x98, 0x66, 0xb9, 0xb3, 0x4a, 0xc7, 0x4b, 0x01, 0x62, 0x8f,
+	0x30, 0x7b, 0x24, 0xb2, 0xdc, 0xb9, 0x4e, 0x04, 0x03, 0xa1, 0x52, 0x74, 0x6e, 0x42, 0x6e, 0xfb,
+	0xce, 0xa2, 0x52, 0xca, 0xab, 0xab, 0x49, 0x52, 0xb0, 0xc9, 0x3e, 0x85, 0x65, 0xdd, 0xa7, 0xda,
+	0x83, 0x37, 0x16, 0x9d, 0x72, 0xee, 0xbe, 0xc6, 0x87, 0x69, 0x1d, 0xac, 0x06, 0xc0, 0x98, 0xa5,
+	0x07, 0xb8, 0x79, 0xee, 0x00, 0x65, 0x74, 0x1b, 0xa6, 0x75, 0x10, 0xeb, 0xcd, 0xcf, 0x43, 0x3c,
+	0x48, 0xa5, 0xef, 0xdc, 0x2a, 0xdc, 0x0c, 0x75, 0xf7, 0x65, 0xa8, 0x82, 0xf8, 0xb9, 0xb6, 0xca,
+	0xf3, 0x50, 0x61, 0x83, 0xbd, 0x0f, 0x83, 0x28, 0xf6, 0x8f, 0xbd, 0x5c, 0xa4, 0x13, 0xcc, 0x55,
+	0xd6, 0xd6, 0x5a, 0x55, 0x87, 0x47, 0xb1, 0x7f, 0x7c, 0x40, 0x04, 0xde, 0x8f, 0xca, 0x76, 0xe6,
+	0xde, 0x87, 0xc1, 0x16, 0xbd, 0x51, 0x87, 0x19, 0xd9, 0xea, 0x36, 0xb4, 0xcb, 0x24, 0xab, 0x74,
+	0x02, 0xe2, 0x78, 0x21, 0xf1, 0x9d, 0x9b, 0x13, 0xd9, 0xfd, 0xcf, 0x26, 0x74, 0xf7, 0xe3, 0x59,
+	0xea, 0xcb, 0x57, 0x57, 0x95, 0x6f, 0x00, 0xe8, 0xad, 0x4d, 0xf4, 0xa6, 0x3e, 0x94, 0x08, 0x43,
+	0xe4, 0x7a, 0xfe, 0xd6, 0xa2, 0x33, 0xa9, 0xcc, 0xdf, 0x2e, 0x43, 0xe7, 0x10, 0x17, 0x6b, 0x1e,
+	0x2e, 0x35, 0x80, 0x13, 0x26, 0xb3, 0xec, 0x28, 0x88, 0x9f, 0x2b, 0x7c, 0x72, 0xee, 0x90, 0x67,
+	0x40, 0x81, 0x1a, 0x61, 0x72, 0x39, 0x2c, 0x19, 0x44, 0x10, 0xa4, 0xe6, 0x20, 0x1c, 0x14, 0xc8,
+	0xad, 0x20, 0x48, 0xcb, 0xbc, 0xb8, 0x77, 0x41, 0x5e, 0xfc, 0x73, 0x28, 0xeb, 0xa7, 0x8e, 0xf5,
+	0xf2, 0xfa, 0x2a, 0xdb, 0x04, 0xbb, 0xfc, 0x86, 0x60, 0xc2, 0xf4, 0xe5, 0x8d, 0x12, 0xb3, 0x71,
+	0x50, 0xb4, 0x78, 0xc5, 0xc6, 0x7e, 0x0b, 0x86, 0x19, 0x25, 0x49, 0x4a, 0x24, 0xd9, 0x51, 0x9c,
+	0x97, 0xb1, 0xfa, 0xbc, 0x7e, 0x03, 0x64, 0xdd, 0x37, 0x9c, 0xee, 0x1f, 0x81, 0x85, 0x4f, 0xde,
+	0x68, 0x0e, 0x4c, 0x9a, 0xa6, 0x7e, 0x32, 0x33, 0x87, 0x2a, 0xb5, 0xcd, 0x67, 0x03, 0xad, 0x68,
+	0xf3, 0xd9, 0x80, 0xd4, 0xd0, 0x22, 0x0c, 0xb5, 0x71, 0x07, 0x25, 0xe2, 0x34, 0x8a, 0x45, 0x40,
+	0x79, 0x89, 0xcd, 0x0b, 0xd0, 0xfd, 0xfb, 0x06, 0x5c, 0xda, 0x4b, 0x63, 0x5f, 0x66, 0xd9, 0x23,
+	0xdc, 0x84, 0x82, 0xe2, 0x2b, 0x83, 0x36, 0xe5, 0x47, 0x38, 0x4f, 0x8b, 0x53, 0x1b, 0x0d, 0xab,
+	0x3f, 0x2c, 0xa4, 0xc5, 0x73, 0x56, 0x8b, 0xeb, 0x2f, 0x0c, 0xf4, 0x96, 0x55, 0x92, 0xa9, 0x63,
+	0xab, 0x46, 0xa6, 0xcc, 0xea, 0x36, 0x2c, 0x25, 0x22, 0xcd, 0x43, 0x1c, 0x5e, 0x8f, 0xd0, 0x26,
+	0x96, 0x61, 0x89, 0xa5, 0x51, 0x6e, 0x41, 0x3f, 0x95, 0x02, 0x43, 0x13, 0x0d, 0xd3, 0x21, 0x1e,
+	0xd0, 0xa8, 0x7d, 0xb3, 0x0a, 0x4a, 0x34, 0x35, 0xbd, 0xab, 0xa7, 0x21, 0x0c, 0x92, 0xf1, 0x9e,
+	0xd9, 0x37, 0xe2, 0x90, 0xc2, 0xb4, 0x72, 0x1a, 0xa5, 0x72, 0xee, 0x42, 0x2b, 0x0a, 0xa7, 0xa6,
+	0xde, 0x7d, 0x7d, 0xee, 0x84, 0x9a, 0x57, 0x01, 0x47, 0x3e, 0x4c, 0xa1, 0x66, 0x2a, 0x3c, 0xf1,
+	0xd0, 0x4a, 0x46, 0x26, 0x0b, 0x11, 0x68, 0x2b, 0x5c, 0x8a, 0xf0, 0xfd, 0x78, 0x46, 0x6f, 0x22,
+	0xe6, 0x71, 0xce, 0x36, 0x98, 0x11, 0x3d, 0xee, 0x96, 0xd6, 0xd6, 0xe9, 0x7e, 0x09, 0xb3, 0x0f,
+	0x60, 0x90, 0xc9, 0x2c, 0x43, 0x5d, 0x84, 0x6a, 0x1c, 0x9b, 0xd4, 0xe3, 0x4a, 0xfd, 0xb0, 0x27,
+	0x2a, 0xed, 0xc1, 0x7e, 0x56, 0x01, 0xec, 0x3d, 0x60, 0xc2, 0xec, 0x60, 0x4f, 0xc5, 0x41, 0x2d,
+	0xbb, 0xeb, 0xf0, 0x95, 0x82, 0x82, 0xfe, 0x42, 0x77, 0xaa, 0x7f, 0x6e, 0x40, 0xbf, 0x36, 0x14,
+	0x7d, 0x44, 0xc9, 0x64, 0x5a, 0x24, 0xdd, 0xd8, 0x46, 0xdc, 0x51, 0x6c, 0x9e, 0xf7, 0x6d, 0x4e,
+	0x6d, 0xc4, 0xa5, 0x71, 0x24, 0x0b, 0x1f, 0xc2, 0x36, 0xee, 0x33, 0x93, 0x60, 0xd1, 0xb2, 0x03,
+	0x73, 0x95, 0x18, 0x54, 0x48, 0x2d, 0x34, 0xfe, 0x97, 0x39, 0x14, 0x59, 0x71, 0xc7, 0x29, 0x61,
+	0x74, 0xc2, 0x67, 0x32, 0xc5, 0xb5, 0x98, 0x2d, 0x5a, 0x80, 0xa8, 0x66, 0xd4, 0xb0, 0xf7, 0x22,
+	0x56, 0x92, 0xb6, 0xe8, 0x80, 0x5b, 0x88, 0xf8, 0x2a, 0x56, 0xd4, 0xcd, 0x28, 0x95, 0x76, 0xa6,
+	0xcd, 0x0b, 0xd0, 0xfd, 0x8f, 0x36, 0x58, 0x7b, 0x46, 0x63, 0x6c, 0x07, 0x86, 0xe5, 0x6f, 0x17,
+	0xbc, 0xb9, 0x90, 0x8c, 0x4b, 0xf5, 0x9c, 0x7a, 0x6f, 0xb1, 0x41, 0xd7, 0x9c, 0x41, 0x52, 0x83,
+	0x16, 0xff, 0xcc, 0x34, 0xcf, 0xfc, 0x99, 0x79, 0x07, 0x5a, 0x4f, 0xd3, 0xd3, 0xf9, 0x7f, 0x0f,
+	0x7b, 0x91, 0x50, 0x1c, 0xd1, 0xec, 0x17, 0xd0, 0x47, 0x71, 0xbd, 0x8c, 0x82, 0xa5, 0xd3, 0x5e,
+	0xcc, 0x15, 0x74, 0x10, 0xe5, 0x80, 0x4c, 0xba, 0x8d, 0xc9, 0xaa, 0x7f, 0x14, 0x46, 0x41, 0x2a,
+	0x95, 0xb9, 0x06, 0xb0, 0xb3, 0x4b, 0xe6, 0x25, 0x0f, 0xfb, 0x1d, 0x58, 0x09, 0xab, 0x24, 0x5b,
+	0x9b, 0xbf, 0xbb, 0x78, 0x43, 0xa9, 0xa5, 0xe1, 0x7c, 0xb9, 0xc6, 0x4e, 0x71, 0xf6, 0x0a, 0x1e,
+	0x9a, 0x9e, 0x54, 0x81, 0xb9, 0xac, 0x75, 0xc2, 0xec, 0xa1, 0x0a, 0xe8, 0xa1, 0x3e, 0xab, 0x92,
+	0x55, 0x3a, 0x4c, 0xe9, 0x58, 0xd2, 0x04, 0x0a, 0x1e, 0x76, 0x79, 0xca, 0xc6, 0x22, 0xc0, 0xf4,
+	0x1d, 0x5d, 0xd0, 0xc4, 0xb2, 0xda, 0xb2, 0x8b, 0x78, 0xc5, 0x89, 0x4e, 0xdf, 0xa9, 0x66, 0xd9,
+	0x91, 0xa7, 0x63, 0x38, 0xfa, 0x7b, 0x9f, 0xf4, 0x4a, 0x21, 0x7a, 0x27, 0x7e, 0xae, 0x7d, 0xf3,
+	0x36, 0x2c, 0x15, 0x42, 0x7a, 0xda, 0xdc, 0x03, 0xe2, 0x1a, 0x16, 0xd8, 0x6d, 0x44, 0xb2, 0x4f,
+	0x61, 0x05, 0xff, 0x4f, 0x65, 0x5e, 0x1e, 0x7b, 0xa9, 0x9c, 0xd0, 0x93, 0x9d, 0x7e, 0xcd, 0xad,
+	0x65, 0x72, 0x5f, 0xcc, 0xc2, 0xe0, 0x20, 0x36, 0x1f, 0x73, 0x86, 0xc4, 0x5f, 0x80, 0xee, 0xa7,
+	0x30, 0xa8, 0x3b, 0x00, 0xb3, 0xa1, 0xb3, 0x2b, 0xd3, 0x89, 0x5c, 0xf9, 0x09, 0x03, 0xe8, 0x3e,
+	0x8e, 0xd3, 0xa9, 0x88, 0x56, 0x1a, 0xd8, 0xd6, 0xef, 0xf0, 0x2b, 0x4d, 0x36, 0x00, 0x6b, 0x4f,
+	0xa4, 0x22, 0x8a, 0x64, 0xb4, 0xd2, 0x72, 0x3f, 0x04, 0xab, 0xf8, 0x87, 0x44, 0x17, 0x72, 0xdc,
+	0x85, 0x14, 0x71, 0xf5, 0xae, 0xb2, 0x10, 0x41, 0x87, 0x4e, 0xf1, 0xed, 0xab, 0x59, 0x7d, 0xfb,
+	0x72, 0xff, 0x00, 0x06, 0xf5, 0xc5, 0x15, 0x97, 0xa2, 0x46, 0x75, 0x29, 0x3a, 0xa7, 0x17, 0x5d,
+	0xe5, 0xd2, 0x78, 0xea, 0xd5, 0x02, 0xbb, 0x85, 0x08, 0x9c, 0xe6, 0xc1, 0xf6, 0xdf, 0x7d, 0x77,
+	0xb3, 0xf1, 0x0f, 0xdf, 0xdd, 0x6c, 0xfc, 0xeb, 0x77, 0x37, 0x7f, 0xf2, 0xed, 0xbf, 0xdd, 0x6c,
+	0x7c, 0xf5, 0x8b, 0xda, 0x0f, 0xbb, 0xa9, 0xc8, 0xd3, 0xf0, 0x44, 0x5f, 0xe5, 0x0a, 0x40, 0xc9,
+	0x7b, 0xc9, 0xf1, 0xe4, 0x5e, 0x72, 0x78, 0xaf, 0xd0, 0xd8, 0x61, 0x97, 0xfe, 0xd3, 0xbd, 0xff,
+	0xdf, 0x03, 0x00, 0xa3, 0xa9, 0xf8, 0x71, 0xb7, 0x27, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -6257,6 +6302,16 @@ func (m *HashBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CanSpill {
+		i--
+		if m.CanSpill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Conds) > 0 {
 		for iNdEx := len(m.Conds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6566,6 +6621,36 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LockTargets) > 0 {
+		for iNdEx := len(m.LockTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.WinSpec != nil {
+		{
+			size, err := m.WinSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	if m.RightAntiJoin != nil {
 		{
 			size, err := m.RightAntiJoin.MarshalToSizedBuffer(dAtA[:i])
@@ -6996,6 +7081,18 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScanSnapshot != nil {
+		{
+			size, err := m.ScanSnapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -7156,6 +7253,11 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SpillSize != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.SpillSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -7209,21 +7311,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA100 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j99 int
+		dAtA102 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j101 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA102[j101] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j99++
+				j101++
 			}
-			dAtA100[j99] = uint8(num)
-			j99++
+			dAtA102[j101] = uint8(num)
+			j101++
 		}
-		i -= j99
-		copy(dAtA[i:], dAtA100[:j99])
-		i = encodeVarintPipeline(dAtA, i, uint64(j99))
+		i -= j101
+		copy(dAtA[i:], dAtA102[:j101])
+		i = encodeVarintPipeline(dAtA, i, uint64(j101))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -8686,6 +8788,9 @@ func (m *HashBuild) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if m.CanSpill {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8917,6 +9022,16 @@ func (m *Instruction) ProtoSize() (n int) {
 		l = m.RightAntiJoin.ProtoSize()
 		n += 2 + l + sovPipeline(uint64(l))
 	}
+	if m.WinSpec != nil {
+		l = m.WinSpec.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
+	if len(m.LockTargets) > 0 {
+		for _, e := range m.LockTargets {
+			l = e.ProtoSize()
+			n += 2 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8984,6 +9099,10 @@ func (m *Source) ProtoSize() (n int) {
 		l = m.Timestamp.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if m.ScanSnapshot != nil {
+		l = m.ScanSnapshot.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9040,6 +9159,9 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.SpillSize != 0 {
+		n += 1 + sovPipeline(uint64(m.SpillSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16578,6 +16700,26 @@ func (m *HashBuild) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSpill", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanSpill = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -18229,6 +18371,76 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinSpec == nil {
+				m.WinSpec = &plan.WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTargets = append(m.LockTargets, &plan.LockTarget{})
+			if err := m.LockTargets[len(m.LockTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -18652,6 +18864,42 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanSnapshot == nil {
+				m.ScanSnapshot = &timestamp.Timestamp{}
+			}
+			if err := m.ScanSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -18964,6 +19212,25 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillSize", wireType)
+			}
+			m.SpillSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
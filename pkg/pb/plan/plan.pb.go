@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -326,6 +327,65 @@ func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_UNBOUNDED_PRECEDING FrameBound_BoundType = 0
+	FrameBound_PRECEDING           FrameBound_BoundType = 1
+	FrameBound_CURRENT_ROW         FrameBound_BoundType = 2
+	FrameBound_FOLLOWING           FrameBound_BoundType = 3
+	FrameBound_UNBOUNDED_FOLLOWING FrameBound_BoundType = 4
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "UNBOUNDED_PRECEDING",
+	1: "PRECEDING",
+	2: "CURRENT_ROW",
+	3: "FOLLOWING",
+	4: "UNBOUNDED_FOLLOWING",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"UNBOUNDED_PRECEDING": 0,
+	"PRECEDING":           1,
+	"CURRENT_ROW":         2,
+	"FOLLOWING":           3,
+	"UNBOUNDED_FOLLOWING": 4,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":  0,
+	"RANGE": 1,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
+}
+
 type Node_NodeType int32
 
 const (
@@ -341,10 +401,11 @@ const (
 	// External function call (UDF)
 	Node_EXTERNAL_FUNCTION Node_NodeType = 11
 	// Material, CTE, etc.
-	Node_MATERIAL      Node_NodeType = 20
-	Node_RECURSIVE_CTE Node_NodeType = 21
-	Node_SINK          Node_NodeType = 22
-	Node_SINK_SCAN     Node_NodeType = 23
+	Node_MATERIAL       Node_NodeType = 20
+	Node_RECURSIVE_CTE  Node_NodeType = 21
+	Node_SINK           Node_NodeType = 22
+	Node_SINK_SCAN      Node_NodeType = 23
+	Node_RECURSIVE_SCAN Node_NodeType = 24
 	// Proper Relational Operators
 	Node_AGG       Node_NodeType = 30
 	Node_DISTINCT  Node_NodeType = 31
@@ -371,6 +432,8 @@ const (
 	Node_INTERSECT_ALL Node_NodeType = 55
 	Node_MINUS         Node_NodeType = 56
 	Node_MINUS_ALL     Node_NodeType = 57
+	// locking read of select
+	Node_LOCK_OP Node_NodeType = 58
 )
 
 var Node_NodeType_name = map[int32]string{
@@ -386,6 +449,7 @@ var Node_NodeType_name = map[int32]string{
 	21: "RECURSIVE_CTE",
 	22: "SINK",
 	23: "SINK_SCAN",
+	24: "RECURSIVE_SCAN",
 	30: "AGG",
 	31: "DISTINCT",
 	32: "FILTER",
@@ -407,6 +471,7 @@ var Node_NodeType_name = map[int32]string{
 	55: "INTERSECT_ALL",
 	56: "MINUS",
 	57: "MINUS_ALL",
+	58: "LOCK_OP",
 }
 
 var Node_NodeType_value = map[string]int32{
@@ -422,6 +487,7 @@ var Node_NodeType_value = map[string]int32{
 	"RECURSIVE_CTE":     21,
 	"SINK":              22,
 	"SINK_SCAN":         23,
+	"RECURSIVE_SCAN":    24,
 	"AGG":               30,
 	"DISTINCT":          31,
 	"FILTER":            32,
@@ -443,6 +509,7 @@ var Node_NodeType_value = map[string]int32{
 	"INTERSECT_ALL":     55,
 	"MINUS":             56,
 	"MINUS_ALL":         57,
+	"LOCK_OP":           58,
 }
 
 func (x Node_NodeType) String() string {
@@ -450,7 +517,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type Node_JoinType int32
@@ -496,7 +563,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 1}
 }
 
 type Node_AggMode int32
@@ -524,7 +591,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 2}
 }
 
 type Query_StatementType int32
@@ -561,7 +628,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
 }
 
 type TransationControl_TclType int32
@@ -589,7 +656,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -617,7 +684,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type DataControl_DclType int32
@@ -657,7 +724,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{61, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -775,7 +842,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -809,16 +876,52 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+}
+
+type AlterTablePartition_AlterPartitionType int32
+
+const (
+	AlterTablePartition_ADD        AlterTablePartition_AlterPartitionType = 0
+	AlterTablePartition_DROP       AlterTablePartition_AlterPartitionType = 1
+	AlterTablePartition_TRUNCATE   AlterTablePartition_AlterPartitionType = 2
+	AlterTablePartition_REORGANIZE AlterTablePartition_AlterPartitionType = 3
+	AlterTablePartition_EXCHANGE   AlterTablePartition_AlterPartitionType = 4
+)
+
+var AlterTablePartition_AlterPartitionType_name = map[int32]string{
+	0: "ADD",
+	1: "DROP",
+	2: "TRUNCATE",
+	3: "REORGANIZE",
+	4: "EXCHANGE",
+}
+
+var AlterTablePartition_AlterPartitionType_value = map[string]int32{
+	"ADD":        0,
+	"DROP":       1,
+	"TRUNCATE":   2,
+	"REORGANIZE": 3,
+	"EXCHANGE":   4,
+}
+
+func (x AlterTablePartition_AlterPartitionType) String() string {
+	return proto.EnumName(AlterTablePartition_AlterPartitionType_name, int32(x))
+}
+
+func (AlterTablePartition_AlterPartitionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{77, 0}
 }
 
 type Type struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NotNullable          bool     `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
-	AutoIncr             bool     `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
-	Width                int32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Scale                int32    `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
-	Table                string   `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotNullable bool   `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
+	AutoIncr    bool   `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
+	Width       int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Scale       int32  `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	Table       string `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	// enumvalues is the members of enum and set types, e.g. 'a','b'
+	Enumvalues           string   `protobuf:"bytes,7,opt,name=enumvalues,proto3" json:"enumvalues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -899,12 +1002,18 @@ func (m *Type) GetTable() string {
 	return ""
 }
 
+func (m *Type) GetEnumvalues() string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return ""
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
 	Isnull bool `protobuf:"varint,1,opt,name=isnull,proto3" json:"isnull,omitempty"`
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Const_I8Val
 	//	*Const_I16Val
 	//	*Const_I32Val
@@ -2037,7 +2146,6 @@ func (m *Function) GetArgs() []*Expr {
 type Expr struct {
 	Typ *Type `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ,omitempty"`
 	// Types that are valid to be assigned to Expr:
-	//
 	//	*Expr_C
 	//	*Expr_P
 	//	*Expr_V
@@ -2577,7 +2685,11 @@ type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
 	// XXX: Deprecated and to be removed soon.
-	NullAbility          bool     `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	NullAbility bool `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	// the value read from the rows written before the column is added, it's
+	// encoded by types.EncodeValue, and it's NULL if has_fill_value is false.
+	FillValue            []byte   `protobuf:"bytes,4,opt,name=fill_value,json=fillValue,proto3" json:"fill_value,omitempty"`
+	HasFillValue         bool     `protobuf:"varint,5,opt,name=has_fill_value,json=hasFillValue,proto3" json:"has_fill_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2637,6 +2749,20 @@ func (m *Default) GetNullAbility() bool {
 	return false
 }
 
+func (m *Default) GetFillValue() []byte {
+	if m != nil {
+		return m.FillValue
+	}
+	return nil
+}
+
+func (m *Default) GetHasFillValue() bool {
+	if m != nil {
+		return m.HasFillValue
+	}
+	return false
+}
+
 type OnUpdate struct {
 	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -2844,10 +2970,17 @@ type IndexDef struct {
 	Comment        string   `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	Visible        bool     `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
 	// currently not used
-	Option               *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Option *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
+	// the algorithm of the index table, e.g. fulltext, empty for the
+	// unique and secondary index
+	IndexAlgo string `protobuf:"bytes,10,opt,name=index_algo,json=indexAlgo,proto3" json:"index_algo,omitempty"`
+	// the parameters of the algorithm, e.g. the parser of fulltext index
+	IndexAlgoParams string `protobuf:"bytes,11,opt,name=index_algo_params,json=indexAlgoParams,proto3" json:"index_algo_params,omitempty"`
+	// the key of the expression index, bound over the columns of the table
+	IndexExpr            *Expr    `protobuf:"bytes,12,opt,name=index_expr,json=indexExpr,proto3" json:"index_expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexDef) Reset()         { *m = IndexDef{} }
@@ -2946,6 +3079,27 @@ func (m *IndexDef) GetOption() *IndexOption {
 	return nil
 }
 
+func (m *IndexDef) GetIndexAlgo() string {
+	if m != nil {
+		return m.IndexAlgo
+	}
+	return ""
+}
+
+func (m *IndexDef) GetIndexAlgoParams() string {
+	if m != nil {
+		return m.IndexAlgoParams
+	}
+	return ""
+}
+
+func (m *IndexDef) GetIndexExpr() *Expr {
+	if m != nil {
+		return m.IndexExpr
+	}
+	return nil
+}
+
 type ForeignKeyDef struct {
 	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Cols                 []uint64                `protobuf:"varint,2,rep,packed,name=cols,proto3" json:"cols,omitempty"`
@@ -3892,7 +4046,6 @@ func (m *TableDef) GetIsTemporary() bool {
 // XXX: Deprecated and to be removed soon.
 type TableDef_DefType struct {
 	// Types that are valid to be assigned to Def:
-	//
 	//	*TableDef_DefType_Properties
 	Def                  isTableDef_DefType_Def `protobuf_oneof:"def"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -4022,24 +4175,24 @@ func (m *TableFunction) GetParam() []byte {
 }
 
 type Stats struct {
-	// for scan, number of blocks to read from S3
-	// for other nodes, it's meaningless
+	//for scan, number of blocks to read from S3
+	//for other nodes, it's meaningless
 	BlockNum int32 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	// for scan, cost of reading from S3, basically the read lines
-	// for other nodes, it means the estimated cost of current node
+	//for scan, cost of reading from S3, basically the read lines
+	//for other nodes, it means the estimated cost of current node
 	Cost float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// number of output lines
+	//number of output lines
 	Outcnt float64 `protobuf:"fixed64,3,opt,name=outcnt,proto3" json:"outcnt,omitempty"`
 	// average size of one row, currently not used
 	Rowsize float64 `protobuf:"fixed64,4,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
 	// hashmap size for nodes which build a hashmap
-	// for other nodes, it's meaningless
+	//for other nodes, it's meaningless
 	HashmapSize float64 `protobuf:"fixed64,5,opt,name=hashmap_size,json=hashmapSize,proto3" json:"hashmap_size,omitempty"`
-	// for scan, this means total count of all table, before filtering
-	// for other nodes, this is meanlingless
+	//for scan, this means total count of all table, before filtering
+	//for other nodes, this is meanlingless
 	TableCnt float64 `protobuf:"fixed64,6,opt,name=table_cnt,json=tableCnt,proto3" json:"table_cnt,omitempty"`
-	// for scan, selectivity means outcnt divide total count
-	// for other node, currently be 0. will change in the future
+	//for scan, selectivity means outcnt divide total count
+	//for other node, currently be 0. will change in the future
 	Selectivity          float64  `protobuf:"fixed64,7,opt,name=selectivity,proto3" json:"selectivity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -4290,6 +4443,8 @@ type WindowSpec struct {
 	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
 	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	WindowFunc           *Expr          `protobuf:"bytes,5,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	Frame                *FrameClause   `protobuf:"bytes,6,opt,name=frame,proto3" json:"frame,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4356,6 +4511,138 @@ func (m *WindowSpec) GetLag() int32 {
 	return 0
 }
 
+func (m *WindowSpec) GetWindowFunc() *Expr {
+	if m != nil {
+		return m.WindowFunc
+	}
+	return nil
+}
+
+func (m *WindowSpec) GetFrame() *FrameClause {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+type FrameBound struct {
+	Type                 FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	Val                  *Expr                `protobuf:"bytes,2,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
+}
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
+}
+func (m *FrameBound) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
+
+func (m *FrameBound) GetType() FrameBound_BoundType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameBound_UNBOUNDED_PRECEDING
+}
+
+func (m *FrameBound) GetVal() *Expr {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
+}
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
+}
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (m *FrameClause) GetStart() *FrameBound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FrameClause) GetEnd() *FrameBound {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type InsertCtx struct {
 	Ref                  *ObjectRef       `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 	TableDef             *TableDef        `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -4374,7 +4661,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4460,23 +4747,28 @@ func (m *InsertCtx) GetClusterTable() *ClusterTable {
 }
 
 type UpdateCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	Idx                  []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
-	TableDefs            []*TableDef  `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
-	UpdateCol            []*ColPosMap `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []*IdList    `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnCascadeDef         []*TableDef  `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
-	OnCascadeUpdateCol   []*ColPosMap `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	ParentIdx            []*ColPosMap `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
+	Ref                []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	Idx                []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
+	TableDefs          []*TableDef  `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
+	UpdateCol          []*ColPosMap `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
+	IdxRef             []*ObjectRef `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx             []int32      `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef      []*ObjectRef `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx      []int32      `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef       []*ObjectRef `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx       []*IdList    `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnCascadeDef       []*TableDef  `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
+	OnCascadeUpdateCol []*ColPosMap `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
+	OnSetRef           []*ObjectRef `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetIdx           []*IdList    `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetDef           []*TableDef  `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetUpdateCol     []*ColPosMap `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	ParentIdx          []*ColPosMap `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
+	// the index tables keyed by the primary key, e.g. fulltext, their rows
+	// are joined by the primary key and a row of the table comes once for
+	// every row of its index tables.
+	PkIdxRef             []*ObjectRef `protobuf:"bytes,18,rep,name=pk_idx_ref,json=pkIdxRef,proto3" json:"pk_idx_ref,omitempty"`
+	PkIdxIdx             []int32      `protobuf:"varint,19,rep,packed,name=pk_idx_idx,json=pkIdxIdx,proto3" json:"pk_idx_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -4486,7 +4778,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4634,6 +4926,20 @@ func (m *UpdateCtx) GetParentIdx() []*ColPosMap {
 	return nil
 }
 
+func (m *UpdateCtx) GetPkIdxRef() []*ObjectRef {
+	if m != nil {
+		return m.PkIdxRef
+	}
+	return nil
+}
+
+func (m *UpdateCtx) GetPkIdxIdx() []int32 {
+	if m != nil {
+		return m.PkIdxIdx
+	}
+	return nil
+}
+
 type AnalyzeInfo struct {
 	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
 	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
@@ -4649,6 +4955,8 @@ type AnalyzeInfo struct {
 	NetworkIO            int64    `protobuf:"varint,12,opt,name=networkIO,proto3" json:"networkIO,omitempty"`
 	ScanTime             int64    `protobuf:"varint,13,opt,name=scanTime,proto3" json:"scanTime,omitempty"`
 	InsertTime           int64    `protobuf:"varint,14,opt,name=insertTime,proto3" json:"insertTime,omitempty"`
+	SpillCount           int64    `protobuf:"varint,15,opt,name=spill_count,json=spillCount,proto3" json:"spill_count,omitempty"`
+	SpillSize            int64    `protobuf:"varint,16,opt,name=spill_size,json=spillSize,proto3" json:"spill_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4658,7 +4966,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4785,6 +5093,20 @@ func (m *AnalyzeInfo) GetInsertTime() int64 {
 	return 0
 }
 
+func (m *AnalyzeInfo) GetSpillCount() int64 {
+	if m != nil {
+		return m.SpillCount
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetSpillSize() int64 {
+	if m != nil {
+		return m.SpillSize
+	}
+	return 0
+}
+
 type Node struct {
 	NodeType Node_NodeType `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
 	NodeId   int32         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -4829,17 +5151,28 @@ type Node struct {
 	CurrentStep int32 `protobuf:"varint,31,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
 	SourceStep  int32 `protobuf:"varint,32,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
 	// FILTER for block zonemap
-	BlockFilterList      []*Expr  `protobuf:"bytes,33,rep,name=block_filter_list,json=blockFilterList,proto3" json:"block_filter_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	BlockFilterList []*Expr `protobuf:"bytes,33,rep,name=block_filter_list,json=blockFilterList,proto3" json:"block_filter_list,omitempty"`
+	// the position of this window function in BindContext.windows
+	WindowIdx int32 `protobuf:"varint,34,opt,name=window_idx,json=windowIdx,proto3" json:"window_idx,omitempty"`
+	// RECURSIVE_CTE
+	UnionAll          bool  `protobuf:"varint,35,opt,name=union_all,json=unionAll,proto3" json:"union_all,omitempty"`
+	MaxRecursionDepth int64 `protobuf:"varint,36,opt,name=max_recursion_depth,json=maxRecursionDepth,proto3" json:"max_recursion_depth,omitempty"`
+	// LOCK_OP
+	LockTargets []*LockTarget `protobuf:"bytes,37,rep,name=lock_targets,json=lockTargets,proto3" json:"lock_targets,omitempty"`
+	// INSERT, UPDATE, DELETE
+	TriggerCtxs []*TriggerCtx `protobuf:"bytes,38,rep,name=trigger_ctxs,json=triggerCtxs,proto3" json:"trigger_ctxs,omitempty"`
+	// TABLE_SCAN, the snapshot read by AS OF TIMESTAMP
+	ScanSnapshot         *timestamp.Timestamp `protobuf:"bytes,39,opt,name=scan_snapshot,json=scanSnapshot,proto3" json:"scan_snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5099,6 +5432,48 @@ func (m *Node) GetBlockFilterList() []*Expr {
 	return nil
 }
 
+func (m *Node) GetWindowIdx() int32 {
+	if m != nil {
+		return m.WindowIdx
+	}
+	return 0
+}
+
+func (m *Node) GetUnionAll() bool {
+	if m != nil {
+		return m.UnionAll
+	}
+	return false
+}
+
+func (m *Node) GetMaxRecursionDepth() int64 {
+	if m != nil {
+		return m.MaxRecursionDepth
+	}
+	return 0
+}
+
+func (m *Node) GetLockTargets() []*LockTarget {
+	if m != nil {
+		return m.LockTargets
+	}
+	return nil
+}
+
+func (m *Node) GetTriggerCtxs() []*TriggerCtx {
+	if m != nil {
+		return m.TriggerCtxs
+	}
+	return nil
+}
+
+func (m *Node) GetScanSnapshot() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScanSnapshot
+	}
+	return nil
+}
+
 type IdList struct {
 	List                 []int64  `protobuf:"varint,1,rep,packed,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5110,7 +5485,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5157,7 +5532,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5194,19 +5569,22 @@ func (m *ColPosMap) GetMap() map[string]int32 {
 }
 
 type DeleteCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	Idx                  []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,3,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,4,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,5,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,6,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,7,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []int32      `protobuf:"varint,8,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,9,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,10,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,11,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,12,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	CanTruncate          bool         `protobuf:"varint,13,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
+	Ref            []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	Idx            []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
+	IdxRef         []*ObjectRef `protobuf:"bytes,3,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx         []int32      `protobuf:"varint,4,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef  []*ObjectRef `protobuf:"bytes,5,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx  []int32      `protobuf:"varint,6,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef   []*ObjectRef `protobuf:"bytes,7,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx   []int32      `protobuf:"varint,8,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnSetRef       []*ObjectRef `protobuf:"bytes,9,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetDef       []*TableDef  `protobuf:"bytes,10,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetIdx       []*IdList    `protobuf:"bytes,11,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetUpdateCol []*ColPosMap `protobuf:"bytes,12,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	CanTruncate    bool         `protobuf:"varint,13,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
+	// the index tables keyed by the primary key, see UpdateCtx
+	PkIdxRef             []*ObjectRef `protobuf:"bytes,14,rep,name=pk_idx_ref,json=pkIdxRef,proto3" json:"pk_idx_ref,omitempty"`
+	PkIdxIdx             []int32      `protobuf:"varint,15,rep,packed,name=pk_idx_idx,json=pkIdxIdx,proto3" json:"pk_idx_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -5216,7 +5594,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5336,6 +5714,315 @@ func (m *DeleteCtx) GetCanTruncate() bool {
 	return false
 }
 
+func (m *DeleteCtx) GetPkIdxRef() []*ObjectRef {
+	if m != nil {
+		return m.PkIdxRef
+	}
+	return nil
+}
+
+func (m *DeleteCtx) GetPkIdxIdx() []int32 {
+	if m != nil {
+		return m.PkIdxIdx
+	}
+	return nil
+}
+
+// LockTarget is a table whose rows are locked by LOCK_OP. The primary key
+// column is the column [primary_col_rel_pos, primary_col_idx_in_bat] of the
+// plan, and the column primary_col_idx_in_bat of the input batch after the
+// column refs are remapped.
+type LockTarget struct {
+	TableId            uint64 `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat int32  `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColRelPos   int32  `protobuf:"varint,3,opt,name=primary_col_rel_pos,json=primaryColRelPos,proto3" json:"primary_col_rel_pos,omitempty"`
+	PrimaryColTyp      *Type  `protobuf:"bytes,4,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ,omitempty"`
+	// -1 if there is no refresh timestamp column
+	RefreshTsIdxInBat int32 `protobuf:"varint,5,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	// lock in shared mode, exclusive mode otherwise
+	Shared bool `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
+	// fail instead of waiting for the rows locked by other txns
+	NoWait bool `protobuf:"varint,7,opt,name=no_wait,json=noWait,proto3" json:"no_wait,omitempty"`
+	// skip the rows locked by other txns
+	SkipLocked           bool     `protobuf:"varint,8,opt,name=skip_locked,json=skipLocked,proto3" json:"skip_locked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockTarget) Reset()         { *m = LockTarget{} }
+func (m *LockTarget) String() string { return proto.CompactTextString(m) }
+func (*LockTarget) ProtoMessage()    {}
+func (*LockTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
+}
+func (m *LockTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockTarget.Merge(m, src)
+}
+func (m *LockTarget) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LockTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockTarget proto.InternalMessageInfo
+
+func (m *LockTarget) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *LockTarget) GetPrimaryColIdxInBat() int32 {
+	if m != nil {
+		return m.PrimaryColIdxInBat
+	}
+	return 0
+}
+
+func (m *LockTarget) GetPrimaryColRelPos() int32 {
+	if m != nil {
+		return m.PrimaryColRelPos
+	}
+	return 0
+}
+
+func (m *LockTarget) GetPrimaryColTyp() *Type {
+	if m != nil {
+		return m.PrimaryColTyp
+	}
+	return nil
+}
+
+func (m *LockTarget) GetRefreshTsIdxInBat() int32 {
+	if m != nil {
+		return m.RefreshTsIdxInBat
+	}
+	return 0
+}
+
+func (m *LockTarget) GetShared() bool {
+	if m != nil {
+		return m.Shared
+	}
+	return false
+}
+
+func (m *LockTarget) GetNoWait() bool {
+	if m != nil {
+		return m.NoWait
+	}
+	return false
+}
+
+func (m *LockTarget) GetSkipLocked() bool {
+	if m != nil {
+		return m.SkipLocked
+	}
+	return false
+}
+
+// TriggerDef is a row-level trigger stored in mo_catalog.mo_triggers.
+type TriggerDef struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// the stored procedure block run for every row
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// BEFORE trigger, AFTER trigger otherwise
+	Before bool `protobuf:"varint,4,opt,name=before,proto3" json:"before,omitempty"`
+	// insert, update or delete
+	Event                string   `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetBefore() bool {
+	if m != nil {
+		return m.Before
+	}
+	return false
+}
+
+func (m *TriggerDef) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+// TriggerCtx is the triggers of a table fired by a DML statement. The NEW and
+// OLD values of the column col_names[i] are the columns new_idx[i] and
+// old_idx[i] of the input batch of the DML operator, new_idx is empty for
+// DELETE and old_idx is empty for INSERT.
+type TriggerCtx struct {
+	DbName    string        `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName string        `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Triggers  []*TriggerDef `protobuf:"bytes,3,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	ColNames  []string      `protobuf:"bytes,4,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
+	NewIdx    []int32       `protobuf:"varint,5,rep,packed,name=new_idx,json=newIdx,proto3" json:"new_idx,omitempty"`
+	OldIdx    []int32       `protobuf:"varint,6,rep,packed,name=old_idx,json=oldIdx,proto3" json:"old_idx,omitempty"`
+	// the NEW value can not be set by BEFORE triggers, because the index or
+	// foreign key values are computed from it before the triggers run
+	Readonly             []bool   `protobuf:"varint,7,rep,packed,name=readonly,proto3" json:"readonly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerCtx) Reset()         { *m = TriggerCtx{} }
+func (m *TriggerCtx) String() string { return proto.CompactTextString(m) }
+func (*TriggerCtx) ProtoMessage()    {}
+func (*TriggerCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
+}
+func (m *TriggerCtx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerCtx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCtx.Merge(m, src)
+}
+func (m *TriggerCtx) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerCtx) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCtx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCtx proto.InternalMessageInfo
+
+func (m *TriggerCtx) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TriggerCtx) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *TriggerCtx) GetTriggers() []*TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *TriggerCtx) GetColNames() []string {
+	if m != nil {
+		return m.ColNames
+	}
+	return nil
+}
+
+func (m *TriggerCtx) GetNewIdx() []int32 {
+	if m != nil {
+		return m.NewIdx
+	}
+	return nil
+}
+
+func (m *TriggerCtx) GetOldIdx() []int32 {
+	if m != nil {
+		return m.OldIdx
+	}
+	return nil
+}
+
+func (m *TriggerCtx) GetReadonly() []bool {
+	if m != nil {
+		return m.Readonly
+	}
+	return nil
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -5359,7 +6046,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5431,10 +6118,9 @@ func (m *Query) GetLoadTag() bool {
 }
 
 type TransationControl struct {
-	// TransationControl type
+	//TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
 	// Types that are valid to be assigned to Action:
-	//
 	//	*TransationControl_Begin
 	//	*TransationControl_Commit
 	//	*TransationControl_Rollback
@@ -5448,7 +6134,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5552,7 +6238,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5599,7 +6285,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5646,7 +6332,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5684,7 +6370,6 @@ func (m *TransationRollback) GetCompletionType() TransationCompletionType {
 
 type Plan struct {
 	// Types that are valid to be assigned to Plan:
-	//
 	//	*Plan_Query
 	//	*Plan_Tcl
 	//	*Plan_Ddl
@@ -5701,7 +6386,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5817,7 +6502,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5854,10 +6539,9 @@ func (m *Column) GetColumn() []*Expr {
 }
 
 type DataControl struct {
-	// DataDefinition type
+	//DataDefinition type
 	DclType DataControl_DclType `protobuf:"varint,1,opt,name=dcl_type,json=dclType,proto3,enum=plan.DataControl_DclType" json:"dcl_type,omitempty"`
 	// Types that are valid to be assigned to Control:
-	//
 	//	*DataControl_SetVariables
 	//	*DataControl_Prepare
 	//	*DataControl_Execute
@@ -5872,7 +6556,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5978,14 +6662,13 @@ func (*DataControl) XXX_OneofWrappers() []interface{} {
 }
 
 type DataDefinition struct {
-	// DataDefinition type
+	//DataDefinition type
 	DdlType DataDefinition_DdlType `protobuf:"varint,1,opt,name=ddl_type,json=ddlType,proto3,enum=plan.DataDefinition_DdlType" json:"ddl_type,omitempty"`
-	// other show statement we will rewrite to a select statement
-	// then we will get a Query
-	// eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
+	//other show statement we will rewrite to a select statement
+	//then we will get a Query
+	//eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
 	Query *Query `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	// Types that are valid to be assigned to Definition:
-	//
 	//	*DataDefinition_CreateDatabase
 	//	*DataDefinition_AlterDatabase
 	//	*DataDefinition_DropDatabase
@@ -6013,7 +6696,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6293,7 +6976,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6349,7 +7032,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6411,7 +7094,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6466,7 +7149,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6520,7 +7203,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6577,7 +7260,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6682,7 +7365,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6746,7 +7429,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6818,7 +7501,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6896,7 +7579,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6967,7 +7650,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7024,6 +7707,297 @@ func (m *AlterTableAlterIndex) GetVisible() bool {
 	return false
 }
 
+type AlterTableAddColumn struct {
+	Column *ColDef `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// the column is added at the first position if first is true, after
+	// after_column if it is not empty, or at the last position otherwise
+	First                bool     `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	AfterColumn          string   `protobuf:"bytes,3,opt,name=after_column,json=afterColumn,proto3" json:"after_column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
+func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddColumn) ProtoMessage()    {}
+func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
+}
+func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAddColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAddColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAddColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddColumn.Merge(m, src)
+}
+func (m *AlterTableAddColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAddColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAddColumn proto.InternalMessageInfo
+
+func (m *AlterTableAddColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *AlterTableAddColumn) GetFirst() bool {
+	if m != nil {
+		return m.First
+	}
+	return false
+}
+
+func (m *AlterTableAddColumn) GetAfterColumn() string {
+	if m != nil {
+		return m.AfterColumn
+	}
+	return ""
+}
+
+type AlterTableModifyColumn struct {
+	// old_name differs from the name of column for CHANGE COLUMN
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	Column               *ColDef  `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableModifyColumn) Reset()         { *m = AlterTableModifyColumn{} }
+func (m *AlterTableModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyColumn) ProtoMessage()    {}
+func (*AlterTableModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
+}
+func (m *AlterTableModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyColumn.Merge(m, src)
+}
+func (m *AlterTableModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyColumn proto.InternalMessageInfo
+
+func (m *AlterTableModifyColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableModifyColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
+}
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
+}
+func (m *AlterTableRenameColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
+
+func (m *AlterTableRenameColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+// AlterTablePartition is ADD, DROP, TRUNCATE, REORGANIZE or EXCHANGE PARTITION.
+type AlterTablePartition struct {
+	Typ AlterTablePartition_AlterPartitionType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterTablePartition_AlterPartitionType" json:"typ,omitempty"`
+	// the partition definition after the change
+	PartitionDef        *PartitionByDef `protobuf:"bytes,2,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	AddPartitionTables  []*TableDef     `protobuf:"bytes,3,rep,name=add_partition_tables,json=addPartitionTables,proto3" json:"add_partition_tables,omitempty"`
+	DropPartitionTables []string        `protobuf:"bytes,4,rep,name=drop_partition_tables,json=dropPartitionTables,proto3" json:"drop_partition_tables,omitempty"`
+	// the predicate of the rows in the partitions dropped, truncated or exchanged
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// the predicate of the rows out of the reorganized partitions
+	CheckFilter          string   `protobuf:"bytes,6,opt,name=check_filter,json=checkFilter,proto3" json:"check_filter,omitempty"`
+	ExchangeDatabase     string   `protobuf:"bytes,7,opt,name=exchange_database,json=exchangeDatabase,proto3" json:"exchange_database,omitempty"`
+	ExchangeTable        string   `protobuf:"bytes,8,opt,name=exchange_table,json=exchangeTable,proto3" json:"exchange_table,omitempty"`
+	WithoutValidation    bool     `protobuf:"varint,9,opt,name=without_validation,json=withoutValidation,proto3" json:"without_validation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetTyp() AlterTablePartition_AlterPartitionType {
+	if m != nil {
+		return m.Typ
+	}
+	return AlterTablePartition_ADD
+}
+
+func (m *AlterTablePartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetAddPartitionTables() []*TableDef {
+	if m != nil {
+		return m.AddPartitionTables
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetDropPartitionTables() []string {
+	if m != nil {
+		return m.DropPartitionTables
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetCheckFilter() string {
+	if m != nil {
+		return m.CheckFilter
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetExchangeDatabase() string {
+	if m != nil {
+		return m.ExchangeDatabase
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetExchangeTable() string {
+	if m != nil {
+		return m.ExchangeTable
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetWithoutValidation() bool {
+	if m != nil {
+		return m.WithoutValidation
+	}
+	return false
+}
+
 type AlterTable struct {
 	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	TableDef             *TableDef            `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -7038,7 +8012,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7097,11 +8071,14 @@ func (m *AlterTable) GetActions() []*AlterTable_Action {
 
 type AlterTable_Action struct {
 	// Types that are valid to be assigned to Action:
-	//
 	//	*AlterTable_Action_Drop
 	//	*AlterTable_Action_AddFk
 	//	*AlterTable_Action_AddIndex
 	//	*AlterTable_Action_AlterIndex
+	//	*AlterTable_Action_AddColumn
+	//	*AlterTable_Action_ModifyColumn
+	//	*AlterTable_Action_RenameColumn
+	//	*AlterTable_Action_AlterPartition
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -7112,7 +8089,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{78, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7159,11 +8136,27 @@ type AlterTable_Action_AddIndex struct {
 type AlterTable_Action_AlterIndex struct {
 	AlterIndex *AlterTableAlterIndex `protobuf:"bytes,4,opt,name=alter_index,json=alterIndex,proto3,oneof" json:"alter_index,omitempty"`
 }
+type AlterTable_Action_AddColumn struct {
+	AddColumn *AlterTableAddColumn `protobuf:"bytes,5,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTable_Action_ModifyColumn struct {
+	ModifyColumn *AlterTableModifyColumn `protobuf:"bytes,6,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTable_Action_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,7,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTable_Action_AlterPartition struct {
+	AlterPartition *AlterTablePartition `protobuf:"bytes,8,opt,name=alter_partition,json=alterPartition,proto3,oneof" json:"alter_partition,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()       {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()      {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()           {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_ModifyColumn) isAlterTable_Action_Action()   {}
+func (*AlterTable_Action_RenameColumn) isAlterTable_Action_Action()   {}
+func (*AlterTable_Action_AlterPartition) isAlterTable_Action_Action() {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -7200,6 +8193,34 @@ func (m *AlterTable_Action) GetAlterIndex() *AlterTableAlterIndex {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAddColumn() *AlterTableAddColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddColumn); ok {
+		return x.AddColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetModifyColumn() *AlterTableModifyColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetAlterPartition() *AlterTablePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterPartition); ok {
+		return x.AlterPartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -7207,6 +8228,10 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_AddFk)(nil),
 		(*AlterTable_Action_AddIndex)(nil),
 		(*AlterTable_Action_AlterIndex)(nil),
+		(*AlterTable_Action_AddColumn)(nil),
+		(*AlterTable_Action_ModifyColumn)(nil),
+		(*AlterTable_Action_RenameColumn)(nil),
+		(*AlterTable_Action_AlterPartition)(nil),
 	}
 }
 
@@ -7228,7 +8253,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7326,7 +8351,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7389,7 +8414,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7453,7 +8478,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7520,7 +8545,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7564,7 +8589,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7639,7 +8664,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7689,7 +8714,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7762,7 +8787,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7846,7 +8871,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7908,7 +8933,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7962,7 +8987,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8013,7 +9038,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8091,7 +9116,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8160,7 +9185,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8214,7 +9239,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8262,7 +9287,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8316,7 +9341,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8362,7 +9387,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8400,6 +9425,8 @@ func init() {
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinType", Node_JoinType_name, Node_JoinType_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
@@ -8409,6 +9436,7 @@ func init() {
 	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
 	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
 	proto.RegisterEnum("plan.AlterTableDrop_Typ", AlterTableDrop_Typ_name, AlterTableDrop_Typ_value)
+	proto.RegisterEnum("plan.AlterTablePartition_AlterPartitionType", AlterTablePartition_AlterPartitionType_name, AlterTablePartition_AlterPartitionType_value)
 	proto.RegisterType((*Type)(nil), "plan.Type")
 	proto.RegisterType((*Const)(nil), "plan.Const")
 	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
@@ -8453,6 +9481,8 @@ func init() {
 	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
 	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
 	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
 	proto.RegisterType((*InsertCtx)(nil), "plan.InsertCtx")
 	proto.RegisterMapType((map[string]*Expr)(nil), "plan.InsertCtx.OnDuplicateExprEntry")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.InsertCtx.ParentIdxEntry")
@@ -8463,6 +9493,9 @@ func init() {
 	proto.RegisterType((*ColPosMap)(nil), "plan.ColPosMap")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
 	proto.RegisterType((*DeleteCtx)(nil), "plan.DeleteCtx")
+	proto.RegisterType((*LockTarget)(nil), "plan.LockTarget")
+	proto.RegisterType((*TriggerDef)(nil), "plan.TriggerDef")
+	proto.RegisterType((*TriggerCtx)(nil), "plan.TriggerCtx")
 	proto.RegisterType((*Query)(nil), "plan.Query")
 	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
 	proto.RegisterType((*TransationBegin)(nil), "plan.TransationBegin")
@@ -8483,6 +9516,10 @@ func init() {
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
 	proto.RegisterType((*AlterTableDropIndex)(nil), "plan.AlterTableDropIndex")
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
+	proto.RegisterType((*AlterTableAddColumn)(nil), "plan.AlterTableAddColumn")
+	proto.RegisterType((*AlterTableModifyColumn)(nil), "plan.AlterTableModifyColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "plan.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTablePartition)(nil), "plan.AlterTablePartition")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
 	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
@@ -8509,476 +9546,541 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 7496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4b, 0x8c, 0x1b, 0x47,
-	0xda, 0xd8, 0xf0, 0x4d, 0x7e, 0x7c, 0x4c, 0xab, 0xf4, 0xa2, 0x64, 0x59, 0x1e, 0xb7, 0xb5, 0xb6,
-	0x2c, 0x7b, 0x65, 0x7b, 0xfc, 0x76, 0xbc, 0xd8, 0xe5, 0x70, 0xa8, 0x19, 0xda, 0x14, 0x39, 0x5b,
-	0xe4, 0x48, 0xeb, 0xfc, 0x08, 0x88, 0x26, 0xbb, 0x39, 0x6a, 0x4d, 0xb3, 0x9b, 0xee, 0x6e, 0x6a,
-	0x66, 0x16, 0xf8, 0x81, 0x3d, 0x25, 0xc8, 0x29, 0x87, 0x00, 0x49, 0x80, 0x0d, 0x90, 0x4d, 0x0e,
-	0x39, 0xfc, 0x97, 0x1c, 0x73, 0x4e, 0x72, 0x49, 0x80, 0x1c, 0x92, 0x43, 0x2e, 0xc9, 0x25, 0x71,
-	0x82, 0xff, 0x1e, 0xfc, 0x0b, 0xe4, 0x92, 0x43, 0xf0, 0x7d, 0x55, 0xdd, 0x5d, 0x4d, 0x52, 0x2b,
-	0x59, 0xeb, 0x5c, 0x66, 0xba, 0xbe, 0x47, 0xd5, 0x57, 0x55, 0x5f, 0x7d, 0x8f, 0x7a, 0x10, 0x60,
-	0xe1, 0x18, 0xee, 0xfd, 0x85, 0xef, 0x85, 0x1e, 0xcb, 0xe3, 0xf7, 0xcd, 0x9f, 0x9f, 0xd8, 0xe1,
-	0x93, 0xe5, 0xe4, 0xfe, 0xd4, 0x9b, 0x7f, 0x70, 0xe2, 0x9d, 0x78, 0x1f, 0x10, 0x72, 0xb2, 0x9c,
-	0x51, 0x89, 0x0a, 0xf4, 0x25, 0x98, 0xf4, 0x7f, 0x9c, 0x81, 0xfc, 0xe8, 0x62, 0x61, 0xb1, 0x06,
-	0x64, 0x6d, 0xb3, 0x99, 0xd9, 0xc9, 0xdc, 0x2d, 0xf0, 0xac, 0x6d, 0xb2, 0x1d, 0xa8, 0xba, 0x5e,
-	0xd8, 0x5f, 0x3a, 0x8e, 0x31, 0x71, 0xac, 0x66, 0x76, 0x27, 0x73, 0xb7, 0xcc, 0x55, 0x10, 0x7b,
-	0x0d, 0x2a, 0xc6, 0x32, 0xf4, 0xc6, 0xb6, 0x3b, 0xf5, 0x9b, 0x39, 0xc2, 0x97, 0x11, 0xd0, 0x75,
-	0xa7, 0x3e, 0xbb, 0x02, 0x85, 0x33, 0xdb, 0x0c, 0x9f, 0x34, 0xf3, 0x54, 0xa3, 0x28, 0x20, 0x34,
-	0x98, 0x1a, 0x8e, 0xd5, 0x2c, 0x08, 0x28, 0x15, 0x10, 0x1a, 0x52, 0x23, 0xc5, 0x9d, 0xcc, 0xdd,
-	0x0a, 0x17, 0x05, 0xfd, 0x3f, 0x17, 0xa0, 0xd0, 0xf6, 0xdc, 0x20, 0x64, 0xd7, 0xa0, 0x68, 0x07,
-	0xee, 0xd2, 0x71, 0x48, 0xbc, 0x32, 0x97, 0x25, 0x76, 0x0d, 0x0a, 0xf6, 0x17, 0xcf, 0x0c, 0x87,
-	0x84, 0x2b, 0x1c, 0x6e, 0x71, 0x51, 0x64, 0x4d, 0x28, 0xda, 0x1f, 0x7d, 0x86, 0x88, 0x9c, 0x44,
-	0xc8, 0x32, 0x61, 0x3e, 0xde, 0x45, 0x4c, 0x3e, 0xc6, 0x50, 0x99, 0x30, 0x9f, 0x7d, 0x82, 0x18,
-	0x14, 0x2d, 0x47, 0x18, 0x2a, 0x63, 0x2b, 0x4b, 0x6a, 0x05, 0xa5, 0xab, 0x63, 0x2b, 0xcb, 0xa8,
-	0x95, 0xa5, 0x68, 0xa5, 0x24, 0x11, 0xb2, 0x4c, 0x18, 0xd1, 0x4a, 0x39, 0xc6, 0xc4, 0xad, 0x2c,
-	0x45, 0x2b, 0x95, 0x9d, 0xcc, 0xdd, 0x3c, 0x61, 0x44, 0x2b, 0x57, 0x20, 0x6f, 0x22, 0x1c, 0x76,
-	0x32, 0x77, 0x33, 0x87, 0x5b, 0x9c, 0x4a, 0x08, 0x0d, 0x10, 0x5a, 0xc5, 0x81, 0x41, 0x68, 0x20,
-	0xa1, 0x13, 0x84, 0xd6, 0x70, 0x34, 0x10, 0x3a, 0x91, 0xd0, 0x19, 0x42, 0xeb, 0x3b, 0x99, 0xbb,
-	0x59, 0x84, 0x62, 0x89, 0xdd, 0x84, 0x92, 0x69, 0x84, 0x16, 0x22, 0x1a, 0xb2, 0xcb, 0x11, 0x00,
-	0x71, 0xa1, 0x3d, 0x27, 0xdc, 0xb6, 0xec, 0x74, 0x04, 0x60, 0x3a, 0x54, 0x91, 0x2c, 0xc2, 0x6b,
-	0x12, 0xaf, 0x02, 0xd9, 0xa7, 0x50, 0x33, 0xad, 0xa9, 0x3d, 0x37, 0x1c, 0xd1, 0xa7, 0x4b, 0x3b,
-	0x99, 0xbb, 0xd5, 0xdd, 0xed, 0xfb, 0xa4, 0x93, 0x31, 0xe6, 0x70, 0x8b, 0xa7, 0xc8, 0xd8, 0x17,
-	0x50, 0x97, 0xe5, 0x8f, 0x76, 0x69, 0x60, 0x19, 0xf1, 0x69, 0x29, 0xbe, 0x8f, 0x76, 0xbf, 0x38,
-	0xdc, 0xe2, 0x69, 0x42, 0x76, 0x07, 0x6a, 0xd8, 0x76, 0x10, 0x1a, 0xf3, 0x05, 0x32, 0x5e, 0x96,
-	0x52, 0xa5, 0xa0, 0xd8, 0xad, 0xa7, 0x81, 0xe7, 0x22, 0xc1, 0x15, 0x39, 0x6e, 0x11, 0x80, 0xed,
-	0x00, 0x98, 0xd6, 0xcc, 0x58, 0x3a, 0x21, 0xa2, 0xaf, 0xca, 0x01, 0x54, 0x60, 0xec, 0x36, 0x54,
-	0x96, 0x0b, 0xec, 0xe5, 0x23, 0xc3, 0x69, 0x5e, 0x93, 0x04, 0x09, 0x08, 0x95, 0xd5, 0x0e, 0xf6,
-	0x6c, 0xb7, 0x79, 0x9d, 0x74, 0x51, 0x14, 0xd8, 0x2d, 0xc8, 0x05, 0xfe, 0xb4, 0xd9, 0xa4, 0x9e,
-	0x80, 0xe8, 0x49, 0xe7, 0x7c, 0xe1, 0x73, 0x04, 0xef, 0x95, 0xa0, 0xf0, 0xcc, 0x70, 0x96, 0x96,
-	0x7e, 0x0b, 0xca, 0x47, 0x86, 0x6f, 0xcc, 0xb9, 0x35, 0x63, 0x1a, 0xe4, 0x16, 0x5e, 0x20, 0x57,
-	0x1c, 0x7e, 0xea, 0x3d, 0x28, 0x3e, 0x32, 0x7c, 0xc4, 0x31, 0xc8, 0xbb, 0xc6, 0xdc, 0x22, 0x64,
-	0x85, 0xd3, 0x37, 0xae, 0x82, 0xe0, 0x22, 0x08, 0xad, 0xb9, 0x5c, 0x8b, 0xb2, 0x84, 0xf0, 0x13,
-	0xc7, 0x9b, 0x48, 0x6d, 0x2f, 0x73, 0x59, 0xd2, 0xfb, 0x50, 0x6c, 0x7b, 0x0e, 0xd6, 0x76, 0x1d,
-	0x4a, 0xbe, 0xe5, 0x8c, 0x93, 0xd6, 0x8a, 0xbe, 0xe5, 0x1c, 0x79, 0x01, 0x22, 0xa6, 0x9e, 0x40,
-	0x64, 0x05, 0x62, 0xea, 0x11, 0x22, 0x6a, 0x3f, 0x97, 0xb4, 0xaf, 0x7f, 0x09, 0x15, 0x6e, 0x9c,
-	0xc9, 0x2a, 0xaf, 0x42, 0x31, 0x9c, 0x38, 0x63, 0x69, 0x31, 0xf2, 0xbc, 0x10, 0x4e, 0x9c, 0xae,
-	0x89, 0x60, 0xac, 0xd0, 0x36, 0xa9, 0xbe, 0x3c, 0x2f, 0x4c, 0x3d, 0xa7, 0x6b, 0xea, 0x23, 0x80,
-	0xb6, 0xe7, 0xfb, 0xaf, 0x2c, 0xce, 0x15, 0x28, 0x98, 0xd6, 0x22, 0x7c, 0x22, 0xd6, 0x33, 0x17,
-	0x05, 0xfd, 0x1e, 0x94, 0x71, 0x88, 0x7b, 0x76, 0x10, 0xb2, 0xdb, 0x90, 0x77, 0xec, 0x20, 0x6c,
-	0x66, 0x76, 0x72, 0x2b, 0x13, 0x40, 0x70, 0x7d, 0x07, 0xca, 0x0f, 0x8d, 0xf3, 0x47, 0x38, 0x09,
-	0x58, 0x1b, 0xcd, 0x86, 0x1c, 0x5d, 0x39, 0x35, 0xf7, 0x00, 0x46, 0x86, 0x7f, 0x62, 0x85, 0x64,
-	0x0d, 0x6f, 0x41, 0x2e, 0xbc, 0x58, 0x10, 0x45, 0x5c, 0x1d, 0x22, 0x38, 0x82, 0xf5, 0xbf, 0xc9,
-	0x40, 0x75, 0xb8, 0x9c, 0x7c, 0xbf, 0xb4, 0xfc, 0x0b, 0xec, 0xd1, 0xdd, 0x84, 0xba, 0xb1, 0x7b,
-	0x4d, 0x50, 0x2b, 0xf8, 0x84, 0x13, 0xbb, 0xe8, 0x7a, 0xa6, 0x15, 0x8d, 0x50, 0x81, 0x17, 0xb1,
-	0xd8, 0x35, 0xd1, 0xfc, 0x7a, 0x0b, 0x39, 0xde, 0x59, 0x6f, 0xc1, 0x76, 0xa0, 0x30, 0x7d, 0x62,
-	0x3b, 0x26, 0x19, 0xaa, 0x74, 0x8f, 0x04, 0x82, 0xdd, 0x80, 0xb2, 0xef, 0x9d, 0x8d, 0x03, 0xfb,
-	0xb7, 0x91, 0x39, 0x2d, 0xf9, 0xde, 0xd9, 0xd0, 0xfe, 0xad, 0xa5, 0x8f, 0xa4, 0x4d, 0x07, 0x28,
-	0x0e, 0xdb, 0xad, 0x5e, 0x8b, 0x6b, 0x5b, 0xf8, 0xdd, 0xf9, 0x4d, 0x77, 0x38, 0x1a, 0x6a, 0x19,
-	0xd6, 0x00, 0xe8, 0x0f, 0x46, 0x63, 0x59, 0xce, 0xb2, 0x22, 0x64, 0xbb, 0x7d, 0x2d, 0x87, 0x34,
-	0x08, 0xef, 0xf6, 0xb5, 0x3c, 0x2b, 0x41, 0xae, 0xd5, 0xff, 0x4e, 0x2b, 0xd0, 0x47, 0xaf, 0xa7,
-	0x15, 0xf5, 0x7f, 0x99, 0x85, 0xca, 0x60, 0xf2, 0xd4, 0x9a, 0x86, 0xd8, 0x67, 0x54, 0x47, 0xcb,
-	0x7f, 0x66, 0xf9, 0xd4, 0xed, 0x1c, 0x97, 0x25, 0xec, 0x88, 0x39, 0xa1, 0xce, 0xe5, 0x78, 0xd6,
-	0x9c, 0x10, 0xdd, 0xf4, 0x89, 0x35, 0x37, 0xa8, 0x73, 0x48, 0x47, 0x25, 0x54, 0x7f, 0x6f, 0xf2,
-	0x94, 0xba, 0x97, 0xe3, 0xf8, 0xc9, 0xde, 0x80, 0xaa, 0xa8, 0x63, 0x4c, 0xba, 0x57, 0xa0, 0xb1,
-	0x00, 0x01, 0xea, 0xe3, 0x0a, 0xb8, 0x0e, 0x25, 0x73, 0x22, 0x90, 0xc2, 0x53, 0x14, 0xcd, 0x09,
-	0x21, 0x90, 0x93, 0x6a, 0x15, 0xc8, 0x92, 0xe4, 0x24, 0x10, 0x11, 0xdc, 0x80, 0xb2, 0x37, 0x79,
-	0x2a, 0xb0, 0x65, 0xc2, 0x96, 0xbc, 0xc9, 0x53, 0x42, 0xbd, 0x07, 0x97, 0x82, 0xe5, 0x24, 0x98,
-	0xfa, 0xf6, 0x22, 0xb4, 0x3d, 0x57, 0xd0, 0x54, 0x88, 0x46, 0x53, 0x11, 0x44, 0x7c, 0x07, 0x1a,
-	0x8b, 0xe5, 0x64, 0x6c, 0x4c, 0xa7, 0xde, 0xd2, 0x0d, 0x71, 0x16, 0x81, 0x46, 0xbe, 0xb6, 0x58,
-	0x4e, 0x5a, 0x02, 0xd8, 0x35, 0xf5, 0x7f, 0x9a, 0x01, 0x6d, 0xa8, 0xb0, 0x3e, 0xb4, 0x42, 0x63,
-	0xe3, 0x92, 0x7e, 0x1d, 0x40, 0xa9, 0x4a, 0x28, 0x44, 0xc5, 0x88, 0xea, 0x51, 0xfb, 0x9b, 0x4b,
-	0xf5, 0xf7, 0x4d, 0xa8, 0x45, 0x7c, 0x84, 0xcd, 0x13, 0xb6, 0x2a, 0x61, 0x51, 0x8f, 0x83, 0xe5,
-	0x44, 0x1d, 0xc9, 0x52, 0xb0, 0x24, 0x6e, 0xfd, 0x7f, 0x67, 0xa0, 0xfc, 0x60, 0xe9, 0x4e, 0x51,
-	0x34, 0xf6, 0x16, 0xe4, 0x67, 0x4b, 0x77, 0x2a, 0x35, 0x5d, 0xda, 0xee, 0x78, 0x96, 0x39, 0x21,
-	0x71, 0x75, 0x19, 0xfe, 0x09, 0xae, 0xca, 0xb5, 0xd5, 0x85, 0x70, 0xfd, 0x9f, 0xc9, 0x1a, 0x1f,
-	0x38, 0xc6, 0x09, 0x2b, 0x43, 0xbe, 0x3f, 0xe8, 0x77, 0xb4, 0x2d, 0x56, 0x83, 0x72, 0xb7, 0x3f,
-	0xea, 0xf0, 0x7e, 0xab, 0xa7, 0x65, 0x48, 0x19, 0x47, 0xad, 0xbd, 0x5e, 0x47, 0xcb, 0x22, 0xe6,
-	0xd1, 0xa0, 0xd7, 0x1a, 0x75, 0x7b, 0x1d, 0x2d, 0x2f, 0x30, 0xbc, 0xdb, 0x1e, 0x69, 0x65, 0xa6,
-	0x41, 0xed, 0x88, 0x0f, 0xf6, 0x8f, 0xdb, 0x9d, 0x71, 0xff, 0xb8, 0xd7, 0xd3, 0x34, 0x76, 0x19,
-	0xb6, 0x63, 0xc8, 0x40, 0x00, 0x77, 0x90, 0xe5, 0x51, 0x8b, 0xb7, 0xf8, 0x81, 0xf6, 0x2b, 0x56,
-	0x86, 0x5c, 0xeb, 0xe0, 0x40, 0xfb, 0x5d, 0x06, 0xbf, 0x1e, 0x77, 0xfb, 0xda, 0xef, 0xb2, 0xac,
-	0x01, 0x95, 0x87, 0x83, 0xfe, 0x60, 0x34, 0xe8, 0x77, 0xdb, 0xda, 0xef, 0xf2, 0xfa, 0x1f, 0x73,
-	0x90, 0x47, 0x81, 0xff, 0xf4, 0xc2, 0x66, 0xaf, 0x41, 0x66, 0x4a, 0xf3, 0x50, 0xdd, 0xad, 0x0a,
-	0x1c, 0x45, 0x20, 0x87, 0x5b, 0x3c, 0x83, 0xa3, 0x90, 0x11, 0x2b, 0xb4, 0xba, 0xdb, 0x10, 0xc8,
-	0xc8, 0x96, 0x23, 0x7e, 0xc1, 0x6e, 0x41, 0xe6, 0x99, 0x5c, 0xae, 0x35, 0x81, 0x17, 0xd6, 0x1c,
-	0xb1, 0xcf, 0xd8, 0x0e, 0xe4, 0xa6, 0x9e, 0x88, 0x2e, 0x62, 0xbc, 0x30, 0x88, 0x87, 0x5b, 0x1c,
-	0x51, 0xec, 0x2d, 0xc8, 0xf9, 0xc6, 0x19, 0xa9, 0x76, 0x3c, 0x13, 0xb1, 0xc5, 0x45, 0x22, 0xdf,
-	0x38, 0x43, 0x21, 0x66, 0xa4, 0xe0, 0xb1, 0x10, 0xd1, 0x54, 0x62, 0x33, 0x33, 0xf6, 0x33, 0xc8,
-	0x05, 0xcb, 0x09, 0x29, 0x79, 0x75, 0xf7, 0xd2, 0x9a, 0x29, 0xc2, 0x6a, 0x82, 0xe5, 0x84, 0xbd,
-	0x0d, 0xf9, 0xa9, 0xe7, 0xfb, 0xa4, 0xe8, 0xb1, 0xeb, 0x4d, 0x6c, 0x34, 0x86, 0x0f, 0x88, 0x67,
-	0x3b, 0x90, 0x09, 0x49, 0xc7, 0x63, 0xa2, 0xc4, 0x48, 0x62, 0x83, 0x21, 0xbb, 0x23, 0x2d, 0x6f,
-	0x55, 0x95, 0x29, 0xb2, 0xcb, 0x58, 0x0f, 0x62, 0x99, 0x0e, 0xb9, 0xb9, 0x71, 0x4e, 0x11, 0x4b,
-	0x4c, 0x14, 0x19, 0x64, 0x94, 0x69, 0x6e, 0x9c, 0xa3, 0xf3, 0x30, 0x96, 0xe7, 0xb8, 0x12, 0xea,
-	0xc2, 0xcc, 0x1b, 0xcb, 0xf3, 0xae, 0x89, 0x86, 0xc2, 0x35, 0x9f, 0x51, 0xf4, 0x92, 0xe1, 0xf8,
-	0x89, 0xa1, 0x69, 0x60, 0x39, 0xd6, 0x34, 0xb4, 0x9f, 0xd9, 0xe1, 0x05, 0xc5, 0x2e, 0x19, 0xae,
-	0x82, 0xf6, 0x8a, 0x90, 0xb7, 0xce, 0x17, 0xbe, 0x7e, 0x03, 0x2a, 0x71, 0xe8, 0xc1, 0x6a, 0x90,
-	0x31, 0xa4, 0xb1, 0xca, 0x18, 0xfa, 0x5d, 0x8c, 0x04, 0xa2, 0xe0, 0x22, 0x8d, 0xc3, 0x52, 0x64,
-	0xc2, 0x32, 0x13, 0xfd, 0x6b, 0xa8, 0x71, 0x2b, 0x58, 0x3a, 0x61, 0xdb, 0x73, 0xf6, 0xad, 0x19,
-	0x7b, 0x1f, 0x20, 0x2e, 0x07, 0xd2, 0xe3, 0x24, 0x13, 0xba, 0x6f, 0xcd, 0xb8, 0x82, 0xd7, 0x7f,
-	0x9f, 0x23, 0x3f, 0xbc, 0x2f, 0x9c, 0xa6, 0xf4, 0x8e, 0x19, 0xc5, 0x3b, 0xc6, 0x96, 0x21, 0x9b,
-	0x76, 0xf6, 0x4f, 0x6c, 0xd3, 0xb4, 0xdc, 0xc8, 0xa9, 0x8b, 0x12, 0xbb, 0x03, 0x39, 0xc3, 0x39,
-	0x21, 0x2d, 0x6b, 0xec, 0xb2, 0xa8, 0xd1, 0xf9, 0xc2, 0xb7, 0x82, 0x40, 0xa8, 0xb1, 0xe1, 0x9c,
-	0x44, 0x4a, 0x5e, 0xd8, 0xac, 0xe4, 0x37, 0xa0, 0xec, 0x7a, 0xe1, 0x98, 0x02, 0xea, 0x22, 0xd5,
-	0x5e, 0x92, 0x61, 0x3d, 0x7b, 0x07, 0x4a, 0x32, 0x14, 0x92, 0x3a, 0x56, 0x17, 0xcc, 0xfb, 0x02,
-	0xc8, 0x23, 0x2c, 0x6b, 0xa2, 0xab, 0x9e, 0xcf, 0x2d, 0x37, 0x8c, 0xec, 0xa9, 0x2c, 0xb2, 0xf7,
-	0xa0, 0xe2, 0xb9, 0x63, 0x11, 0x2f, 0x49, 0xf5, 0x92, 0xf3, 0x3d, 0x70, 0x8f, 0x09, 0xca, 0xcb,
-	0x9e, 0xfc, 0x42, 0x51, 0x1c, 0xef, 0x6c, 0x3c, 0x35, 0x7c, 0x61, 0x49, 0xcb, 0xbc, 0xe4, 0x78,
-	0x67, 0x6d, 0xc3, 0x37, 0x85, 0x7f, 0xf9, 0xde, 0x5d, 0xce, 0x69, 0xe6, 0xeb, 0x5c, 0x96, 0xd8,
-	0x2d, 0xa8, 0x4c, 0x9d, 0x65, 0x10, 0x5a, 0xfe, 0xde, 0x05, 0x29, 0x5d, 0x99, 0x27, 0x00, 0x94,
-	0x6b, 0xe1, 0xdb, 0x73, 0xc3, 0xbf, 0x10, 0xd1, 0x31, 0x8f, 0x8a, 0xe8, 0xf5, 0x17, 0xa7, 0xb6,
-	0x79, 0x1e, 0x29, 0x17, 0x15, 0xf4, 0xef, 0xa1, 0x24, 0xfb, 0x86, 0x46, 0x0e, 0x75, 0x26, 0x6d,
-	0x1a, 0x84, 0x91, 0x43, 0x38, 0x7b, 0x0b, 0xea, 0x9e, 0x6f, 0x9f, 0xd8, 0xee, 0x38, 0x08, 0x7d,
-	0xdb, 0x3d, 0x91, 0xf3, 0x55, 0x13, 0xc0, 0x21, 0xc1, 0xd0, 0x32, 0xe3, 0xb8, 0x8e, 0x8d, 0x89,
-	0xed, 0xa0, 0x6e, 0xe6, 0x64, 0xda, 0xb4, 0x74, 0x9c, 0x96, 0x00, 0xe9, 0x03, 0x28, 0x47, 0x23,
-	0xf1, 0x93, 0xb4, 0xa9, 0xff, 0x2d, 0xa8, 0x76, 0x5d, 0xd3, 0x3a, 0x1f, 0x90, 0xb3, 0x61, 0xef,
-	0x03, 0x9b, 0xfa, 0x96, 0x11, 0x5a, 0x63, 0xeb, 0x3c, 0xf4, 0x8d, 0xb1, 0x48, 0xad, 0x44, 0xe6,
-	0xa4, 0x09, 0x4c, 0x07, 0x11, 0x23, 0xca, 0xb2, 0xfe, 0x6b, 0x06, 0xea, 0x47, 0x62, 0x88, 0xbe,
-	0xb5, 0x2e, 0xf6, 0x45, 0xec, 0x39, 0x8d, 0x14, 0x3b, 0xcf, 0xe9, 0x9b, 0xdd, 0x86, 0xea, 0xe2,
-	0xd4, 0xba, 0x18, 0xa7, 0x82, 0xbb, 0x0a, 0x82, 0xda, 0xa4, 0xc2, 0xef, 0x42, 0xd1, 0xa3, 0xd6,
-	0xa5, 0x7d, 0x94, 0x86, 0x47, 0x11, 0x8b, 0x4b, 0x02, 0xa6, 0x43, 0x3d, 0xae, 0x4a, 0x75, 0x5e,
-	0xb2, 0x32, 0x72, 0x5e, 0x57, 0xa0, 0x80, 0xa8, 0xa0, 0x59, 0xd8, 0xc9, 0x61, 0x84, 0x46, 0x05,
-	0xf6, 0x21, 0xd4, 0xa7, 0xde, 0x7c, 0x31, 0x8e, 0xd8, 0xa5, 0xa5, 0x4c, 0x2f, 0xbd, 0x2a, 0x92,
-	0x1c, 0x89, 0xba, 0xf4, 0x7f, 0x92, 0x85, 0x32, 0xc9, 0x20, 0x57, 0x9f, 0x6d, 0x9e, 0x47, 0xab,
-	0xaf, 0xc2, 0x0b, 0xb6, 0x89, 0xe6, 0xe5, 0x75, 0x00, 0x1b, 0x49, 0xc6, 0xca, 0x1a, 0xac, 0x10,
-	0x24, 0x12, 0x65, 0x61, 0xf8, 0x61, 0xd0, 0xcc, 0x09, 0x51, 0xa8, 0x80, 0xca, 0xb9, 0x74, 0xed,
-	0xef, 0x97, 0x42, 0xfa, 0x32, 0x97, 0x25, 0x76, 0x17, 0x34, 0x51, 0x19, 0x0d, 0xba, 0xea, 0x7d,
-	0x1b, 0x04, 0xa7, 0x31, 0x8f, 0x42, 0x16, 0x41, 0x63, 0x9d, 0xa3, 0xf5, 0x14, 0xeb, 0x10, 0x08,
-	0xd4, 0x41, 0x88, 0xba, 0xc2, 0x4a, 0xe9, 0x15, 0xd6, 0x84, 0xd2, 0x33, 0x3b, 0xb0, 0x71, 0x56,
-	0xcb, 0x42, 0xc7, 0x65, 0x51, 0x99, 0x86, 0xca, 0x0b, 0xa6, 0x41, 0xff, 0x0f, 0x59, 0xa8, 0x3f,
-	0xf0, 0x7c, 0xcb, 0x3e, 0x71, 0x93, 0x79, 0x5f, 0x0b, 0x50, 0x22, 0x5d, 0xc8, 0x2a, 0xba, 0xf0,
-	0x06, 0x54, 0x67, 0x82, 0x71, 0x1c, 0x4e, 0x44, 0xd2, 0x91, 0xe7, 0x20, 0x41, 0xa3, 0x89, 0x83,
-	0x6b, 0x20, 0x22, 0x20, 0xe6, 0x3c, 0x31, 0x47, 0x4c, 0x68, 0x14, 0xd9, 0x57, 0x64, 0x24, 0x4c,
-	0xcb, 0xb1, 0x42, 0x31, 0x40, 0x8d, 0xdd, 0xd7, 0xa5, 0x37, 0x53, 0x65, 0xba, 0xcf, 0xad, 0x59,
-	0x8b, 0x9c, 0x1b, 0xda, 0x8c, 0x7d, 0x22, 0x97, 0xbc, 0xd2, 0xc0, 0x14, 0x5f, 0x92, 0x57, 0xac,
-	0x37, 0x7d, 0x04, 0x95, 0x18, 0x8c, 0x41, 0x08, 0xef, 0xc8, 0xc0, 0x63, 0x8b, 0x55, 0xa1, 0xd4,
-	0x6e, 0x0d, 0xdb, 0xad, 0xfd, 0x8e, 0x96, 0x41, 0xd4, 0xb0, 0x33, 0x12, 0xc1, 0x46, 0x96, 0x6d,
-	0x43, 0x15, 0x4b, 0xfb, 0x9d, 0x07, 0xad, 0xe3, 0xde, 0x48, 0xcb, 0xb1, 0x3a, 0x54, 0xfa, 0x83,
-	0x71, 0xab, 0x3d, 0xea, 0x0e, 0xfa, 0x5a, 0x5e, 0xff, 0x15, 0x94, 0xdb, 0x4f, 0xac, 0xe9, 0xe9,
-	0xf3, 0x46, 0x91, 0x62, 0x79, 0x6b, 0x7a, 0x2a, 0x23, 0x8b, 0x95, 0x58, 0xde, 0x9a, 0x9e, 0xea,
-	0xfb, 0x50, 0x6b, 0x47, 0x36, 0x0c, 0x6b, 0xd9, 0x89, 0xb4, 0x6e, 0x3d, 0x9f, 0x91, 0x1a, 0xb8,
-	0xc1, 0x69, 0xe8, 0x9f, 0x42, 0xf5, 0xc8, 0xf7, 0x16, 0x96, 0x1f, 0x52, 0x25, 0x1a, 0xe4, 0x4e,
-	0xad, 0x0b, 0x29, 0x09, 0x7e, 0x26, 0x99, 0x4f, 0x56, 0xcd, 0x7c, 0x76, 0xa1, 0x1c, 0xb1, 0xbd,
-	0x34, 0xcf, 0x2f, 0xd1, 0x6a, 0x10, 0x8f, 0x6d, 0x05, 0xd8, 0xd8, 0x7d, 0x80, 0x45, 0x0c, 0x90,
-	0x62, 0x47, 0x51, 0x92, 0xac, 0x9c, 0x2b, 0x14, 0xfa, 0xdf, 0xe4, 0xa0, 0x71, 0x64, 0xf8, 0xa1,
-	0x8d, 0x53, 0x21, 0x3a, 0xfd, 0x0e, 0xe4, 0xc3, 0x8b, 0x85, 0x25, 0xd3, 0xa8, 0xcb, 0x71, 0x88,
-	0x25, 0x68, 0xc8, 0x7f, 0x11, 0x01, 0xfb, 0x0a, 0x1a, 0x8b, 0x08, 0x3c, 0x26, 0xfb, 0x29, 0x06,
-	0x76, 0x95, 0x85, 0xc6, 0xab, 0xbe, 0x50, 0x8b, 0xec, 0x17, 0x70, 0x25, 0xcd, 0x6b, 0x05, 0x41,
-	0x62, 0xb7, 0xd4, 0x81, 0xbe, 0x9c, 0x62, 0x14, 0x64, 0xac, 0x0d, 0x97, 0x12, 0xf6, 0xa9, 0xe7,
-	0x2c, 0xe7, 0x6e, 0x20, 0x63, 0xbe, 0x6b, 0x2b, 0xad, 0xb7, 0x05, 0x96, 0x6b, 0x8b, 0x15, 0x08,
-	0xd3, 0xa1, 0x16, 0xc3, 0xfa, 0xcb, 0x39, 0x2d, 0x80, 0x3c, 0x4f, 0xc1, 0xd8, 0xc7, 0x00, 0x71,
-	0x39, 0x68, 0x16, 0x69, 0x3c, 0x57, 0xfb, 0xd7, 0x0d, 0xad, 0x39, 0x57, 0xc8, 0xd0, 0x37, 0x1a,
-	0xce, 0x89, 0xe7, 0xdb, 0xe1, 0x93, 0x39, 0x59, 0x8d, 0x1c, 0x4f, 0x00, 0x64, 0x9c, 0x82, 0x31,
-	0x66, 0x05, 0x31, 0x8b, 0x34, 0x20, 0x0d, 0x3b, 0x18, 0x2e, 0x27, 0x71, 0xbd, 0xe8, 0x76, 0x92,
-	0x5e, 0xce, 0x83, 0x13, 0x99, 0x0f, 0x25, 0x12, 0x3e, 0x0c, 0x4e, 0xd8, 0x2e, 0x5c, 0x4d, 0x88,
-	0x12, 0x7b, 0x17, 0x34, 0x81, 0x2c, 0x65, 0x32, 0x7c, 0xb1, 0xd1, 0x0b, 0xf4, 0x6f, 0xa0, 0x9e,
-	0x9a, 0x9d, 0x17, 0x3a, 0xc0, 0x1b, 0x50, 0xc6, 0xff, 0xe8, 0xfe, 0xa4, 0x02, 0x96, 0xb0, 0x3c,
-	0x0c, 0x7d, 0xdd, 0x02, 0x6d, 0x75, 0xac, 0xd9, 0x1d, 0xda, 0x41, 0xa0, 0x49, 0x59, 0x5f, 0x39,
-	0x11, 0x0a, 0x53, 0xbe, 0xf5, 0x49, 0xcc, 0x92, 0xd4, 0x6b, 0x93, 0xa5, 0xff, 0xf3, 0xac, 0x22,
-	0x33, 0x8e, 0x38, 0xfb, 0x99, 0xaa, 0x7e, 0xca, 0x62, 0x4f, 0xc6, 0x8c, 0x2c, 0xfc, 0xbb, 0xa0,
-	0x79, 0xbe, 0x69, 0xbb, 0x06, 0xed, 0x68, 0x88, 0xe1, 0xce, 0x52, 0x28, 0xb3, 0x2d, 0xe1, 0x47,
-	0x12, 0x8c, 0x01, 0xad, 0x69, 0xc5, 0xe9, 0xa2, 0x4c, 0xf6, 0x54, 0x90, 0xea, 0x0d, 0xf2, 0x69,
-	0x6f, 0xf0, 0x0e, 0x54, 0x1c, 0x2b, 0x08, 0xc6, 0xe1, 0x13, 0xc3, 0x25, 0x7f, 0x99, 0xee, 0x74,
-	0x19, 0x91, 0xa3, 0x27, 0x86, 0x8b, 0x84, 0xb6, 0x3b, 0xa6, 0xe5, 0x1b, 0x29, 0x54, 0x8a, 0xd0,
-	0x76, 0x29, 0x1a, 0x47, 0x3f, 0x7b, 0x65, 0xd3, 0xc4, 0x4a, 0x37, 0xc4, 0xd6, 0xe7, 0x55, 0x7f,
-	0x1d, 0x4a, 0x8f, 0x6c, 0xeb, 0x4c, 0xda, 0xbf, 0x67, 0xb6, 0x75, 0x16, 0xd9, 0x3f, 0xfc, 0xd6,
-	0xff, 0x4f, 0x09, 0xca, 0x44, 0xbc, 0xff, 0xfc, 0x9d, 0xa3, 0x1f, 0x13, 0x04, 0xef, 0x48, 0xaf,
-	0x94, 0xdf, 0x10, 0x7a, 0x0b, 0x1f, 0xf5, 0x3a, 0x08, 0x57, 0x3a, 0x26, 0x83, 0x22, 0x3c, 0x70,
-	0x85, 0x20, 0x72, 0x77, 0xa7, 0x22, 0x02, 0xa1, 0xe0, 0x7b, 0x47, 0x6e, 0x25, 0x24, 0x00, 0x76,
-	0x1f, 0xca, 0x28, 0x21, 0xa5, 0xc5, 0x25, 0xd5, 0xb0, 0x50, 0x1f, 0xa2, 0x74, 0x8b, 0x97, 0xc2,
-	0x89, 0x83, 0x05, 0xf2, 0xc7, 0x96, 0x1f, 0x44, 0xcb, 0xa9, 0xce, 0xa3, 0x22, 0x5a, 0x34, 0x0c,
-	0x56, 0x64, 0x6e, 0x14, 0x2d, 0x5f, 0x35, 0xda, 0xe2, 0x44, 0xc0, 0xee, 0x42, 0x89, 0xe2, 0x03,
-	0x2b, 0x68, 0xd6, 0x54, 0xd3, 0x19, 0x05, 0x2f, 0x3c, 0x42, 0xb3, 0x77, 0xa1, 0x30, 0x3b, 0xb5,
-	0x2e, 0x82, 0x66, 0x5d, 0x35, 0x09, 0x29, 0xcf, 0xc7, 0x05, 0x05, 0xbb, 0x03, 0x0d, 0xdf, 0x9a,
-	0x8d, 0x69, 0xb7, 0x08, 0x5d, 0x75, 0xd0, 0x6c, 0x90, 0x27, 0xae, 0xf9, 0xd6, 0xac, 0x8d, 0xc0,
-	0xd1, 0xc4, 0x09, 0xd8, 0xdb, 0x50, 0x24, 0x1f, 0x14, 0x34, 0xb7, 0xd5, 0x96, 0x23, 0x87, 0xc6,
-	0x25, 0x96, 0xed, 0x42, 0x25, 0x31, 0x1b, 0x57, 0xa9, 0x43, 0x57, 0x56, 0xec, 0x11, 0x99, 0x71,
-	0x9e, 0x90, 0xb1, 0x8f, 0x00, 0x64, 0x68, 0x3e, 0x9e, 0x5c, 0xd0, 0x66, 0x6a, 0x35, 0x4e, 0x5a,
-	0x14, 0x77, 0xa7, 0x06, 0xf0, 0xef, 0x40, 0x01, 0xbd, 0x44, 0xd0, 0xbc, 0x4e, 0xd2, 0x5c, 0x4a,
-	0xbb, 0x10, 0xea, 0x1d, 0xe1, 0xd9, 0x5d, 0x28, 0xa3, 0x72, 0x8d, 0x71, 0x0a, 0x9b, 0x6a, 0xae,
-	0x22, 0x35, 0x11, 0xa3, 0x22, 0xeb, 0x6c, 0xf8, 0xbd, 0xc3, 0xee, 0x41, 0xde, 0xb4, 0x66, 0x41,
-	0xf3, 0x06, 0xd5, 0x78, 0x4d, 0x99, 0x4b, 0x0c, 0x13, 0xf6, 0xad, 0x99, 0x70, 0x2d, 0x48, 0xc3,
-	0x0e, 0xa1, 0x81, 0xaa, 0xb7, 0x4b, 0x81, 0x2e, 0x0e, 0x79, 0xf3, 0x26, 0x71, 0xbd, 0xb9, 0xc2,
-	0xd5, 0x97, 0x44, 0x34, 0x41, 0x1d, 0x37, 0xf4, 0x2f, 0x78, 0xdd, 0x55, 0x61, 0xec, 0x26, 0x94,
-	0xed, 0xa0, 0xe7, 0x4d, 0x4f, 0x2d, 0xb3, 0xf9, 0x9a, 0x38, 0x1c, 0x89, 0xca, 0xec, 0x4b, 0xa8,
-	0x93, 0x32, 0x62, 0x11, 0x1b, 0x6f, 0xde, 0x52, 0x5d, 0xde, 0x48, 0x45, 0xf1, 0x34, 0x25, 0x06,
-	0x57, 0x76, 0x30, 0x0e, 0xad, 0xf9, 0xc2, 0xf3, 0x31, 0xcb, 0x79, 0x5d, 0x24, 0x18, 0x76, 0x30,
-	0x8a, 0x40, 0x37, 0x0f, 0x28, 0xa7, 0x21, 0xea, 0x4f, 0x57, 0xbc, 0x72, 0x4a, 0x0d, 0x15, 0xf7,
-	0x7d, 0xb8, 0xa5, 0x3a, 0xe7, 0xbd, 0x02, 0xe4, 0x4c, 0x6b, 0x76, 0xf3, 0x57, 0xc0, 0xd6, 0xfb,
-	0xf9, 0xa2, 0x10, 0xa1, 0x20, 0x43, 0x84, 0xaf, 0xb2, 0x5f, 0x64, 0xf4, 0x2f, 0xa1, 0x9e, 0x5a,
-	0x34, 0x1b, 0xc3, 0x23, 0x11, 0x62, 0x1b, 0x62, 0x5f, 0xbb, 0xc6, 0x45, 0x41, 0xff, 0x8f, 0x19,
-	0x28, 0x0c, 0x43, 0x23, 0x0c, 0xd8, 0x6b, 0x50, 0x99, 0x38, 0xde, 0xf4, 0x74, 0x8c, 0xc9, 0xa0,
-	0xd8, 0x31, 0x2e, 0x13, 0x00, 0xfd, 0x24, 0x45, 0xa8, 0x41, 0x48, 0xbc, 0x19, 0x4e, 0xdf, 0x68,
-	0x37, 0xbc, 0x65, 0x38, 0x75, 0x43, 0xb2, 0x1b, 0x19, 0x2e, 0x4b, 0xb8, 0x50, 0x7d, 0xef, 0x8c,
-	0x36, 0x4c, 0xf3, 0x84, 0x88, 0x8a, 0x38, 0xaa, 0x4f, 0x8c, 0xe0, 0xc9, 0xdc, 0x58, 0x24, 0xfb,
-	0xa9, 0x19, 0x5e, 0x95, 0xb0, 0x21, 0x92, 0xbc, 0x06, 0xc2, 0x80, 0x8c, 0xb1, 0xde, 0x22, 0xe1,
-	0xcb, 0x04, 0x68, 0xbb, 0xe1, 0xea, 0x8e, 0x44, 0x69, 0x6d, 0x47, 0x42, 0x7f, 0x17, 0x4a, 0x68,
-	0xa1, 0x8c, 0xd0, 0x40, 0x9f, 0x67, 0x1a, 0xa1, 0xb1, 0x69, 0xaf, 0x1a, 0xe1, 0xfa, 0x07, 0x00,
-	0xdc, 0x3b, 0x0b, 0xac, 0x90, 0xa8, 0xdf, 0x54, 0xd2, 0xb1, 0x58, 0xc7, 0x65, 0x55, 0xc2, 0xda,
-	0xe9, 0xff, 0x2d, 0x03, 0xd5, 0x81, 0x6f, 0xe2, 0xfa, 0x19, 0x2e, 0xac, 0xe9, 0x0b, 0x9d, 0x2a,
-	0x9a, 0x3f, 0xcf, 0x71, 0x8c, 0xd8, 0x25, 0xa1, 0xf9, 0x8b, 0x00, 0xec, 0x23, 0xc8, 0xcf, 0x1c,
-	0xe3, 0x84, 0xc6, 0x2e, 0x0e, 0xad, 0x95, 0xea, 0xa3, 0xef, 0x07, 0x8e, 0x71, 0xc2, 0x89, 0x54,
-	0xff, 0x8b, 0xb8, 0x7d, 0xda, 0x01, 0x54, 0xf7, 0xfd, 0xb6, 0x68, 0xff, 0x78, 0xd8, 0xd6, 0x32,
-	0xac, 0x0c, 0xf9, 0xfd, 0xce, 0xb0, 0x2d, 0x02, 0x6a, 0x0c, 0xad, 0x87, 0xe3, 0x07, 0x5d, 0x3e,
-	0x1c, 0x69, 0x79, 0xda, 0x90, 0x26, 0x40, 0xaf, 0x35, 0x1c, 0x69, 0x65, 0x06, 0x50, 0x3c, 0xee,
-	0x77, 0x7f, 0x7d, 0xdc, 0xd1, 0x34, 0xfd, 0x1f, 0x64, 0x00, 0x1e, 0xdb, 0xae, 0xe9, 0x9d, 0x51,
-	0xe7, 0x7e, 0xae, 0x04, 0x4f, 0x68, 0x55, 0xd6, 0x47, 0xb1, 0xba, 0x48, 0x0c, 0x12, 0x7b, 0x1f,
-	0xca, 0x1e, 0x8a, 0x86, 0xa4, 0x59, 0xd5, 0xa4, 0x28, 0x3d, 0xe2, 0x25, 0x4f, 0x14, 0x50, 0x9b,
-	0x1c, 0xcb, 0x30, 0xe5, 0x39, 0x03, 0x7d, 0xa3, 0xbe, 0xe3, 0x70, 0x88, 0x73, 0x4c, 0xfc, 0xd4,
-	0xff, 0x90, 0x87, 0x4a, 0xd7, 0x0d, 0x2c, 0x3f, 0x6c, 0x87, 0xe7, 0xec, 0x4d, 0xc8, 0xf9, 0xd6,
-	0xec, 0x79, 0x1b, 0xa8, 0x88, 0x63, 0xef, 0x45, 0xba, 0x63, 0x5a, 0x33, 0x19, 0xab, 0x36, 0xd2,
-	0x06, 0x45, 0xea, 0xd2, 0x3e, 0x1d, 0x26, 0x68, 0x98, 0x1b, 0x2d, 0x17, 0x8e, 0x3d, 0xc5, 0x2c,
-	0xde, 0x36, 0xcf, 0x29, 0xf9, 0x2c, 0xf0, 0x86, 0xe7, 0xee, 0x47, 0xe0, 0xae, 0x79, 0xce, 0x8e,
-	0xe0, 0x52, 0x8a, 0x92, 0x26, 0x5d, 0x38, 0xc5, 0x3b, 0x91, 0xff, 0x90, 0x52, 0xde, 0x1f, 0x24,
-	0xac, 0x38, 0x48, 0xc2, 0x64, 0x6d, 0x7b, 0x69, 0x28, 0xf9, 0x21, 0xf3, 0x7c, 0x8c, 0xfd, 0x11,
-	0xa1, 0xc4, 0x5a, 0x7f, 0x30, 0x87, 0x96, 0x87, 0x38, 0x22, 0x9b, 0x3e, 0xa7, 0x58, 0xa2, 0x40,
-	0x08, 0x14, 0xea, 0x17, 0x14, 0xb8, 0x5a, 0xb4, 0xa5, 0x7d, 0xde, 0x2c, 0x51, 0x2d, 0xb7, 0x57,
-	0xa5, 0x39, 0x22, 0x8a, 0xae, 0x29, 0x4d, 0x67, 0x65, 0x11, 0x95, 0xd9, 0xe7, 0x50, 0x8f, 0x5c,
-	0x86, 0xd8, 0xb8, 0x28, 0x6f, 0xf0, 0x1a, 0x34, 0x6a, 0xbc, 0x36, 0x55, 0x4a, 0x37, 0xfb, 0x70,
-	0x65, 0x53, 0x1f, 0x37, 0x98, 0xab, 0x1d, 0xd5, 0x5c, 0xad, 0x24, 0x57, 0xb1, 0xe9, 0xba, 0xf9,
-	0x35, 0xe5, 0x27, 0x8a, 0x94, 0x3f, 0xca, 0xf0, 0xfd, 0x55, 0x11, 0x2a, 0x22, 0xe7, 0x4c, 0xa9,
-	0x48, 0xee, 0xb9, 0x2a, 0x72, 0x1b, 0x72, 0x38, 0x5e, 0x59, 0x35, 0xa4, 0xe9, 0x9a, 0x3d, 0x3b,
-	0x08, 0x39, 0x22, 0xd8, 0xfb, 0x52, 0x85, 0xf6, 0xd1, 0x93, 0xe5, 0x54, 0x4f, 0x1d, 0xab, 0x50,
-	0x42, 0x80, 0xd9, 0x98, 0x48, 0x90, 0x69, 0x9f, 0x24, 0xaf, 0xb6, 0xdb, 0xa6, 0x23, 0xb5, 0x87,
-	0xc6, 0x22, 0x3a, 0xd4, 0x6c, 0x7b, 0xce, 0x4f, 0x31, 0xef, 0x9f, 0xc3, 0xb6, 0xe7, 0x8e, 0x7d,
-	0x2b, 0x08, 0x7d, 0x7b, 0x1a, 0x52, 0x55, 0xa5, 0xcd, 0x55, 0xd5, 0x3d, 0x97, 0x4b, 0x32, 0xac,
-	0xf1, 0xed, 0x34, 0x23, 0xd6, 0x5c, 0xa6, 0x9a, 0x15, 0x3a, 0x6c, 0xe0, 0x53, 0x68, 0x60, 0xb8,
-	0x6e, 0x04, 0x53, 0xc3, 0xb4, 0xa8, 0xfe, 0xca, 0xe6, 0xfa, 0x6b, 0x9e, 0xdb, 0x16, 0x54, 0x58,
-	0xfd, 0x6e, 0x8a, 0x0d, 0x6b, 0x87, 0x0d, 0x63, 0x9c, 0xf0, 0x60, 0x53, 0x9f, 0xa4, 0x78, 0x70,
-	0xd1, 0x56, 0x37, 0x8e, 0x78, 0xc2, 0x85, 0x0b, 0x77, 0x0f, 0xae, 0x2a, 0x5c, 0xca, 0xf8, 0xd7,
-	0x36, 0x8f, 0x3f, 0x8b, 0xb9, 0x8f, 0xe3, 0x89, 0xf8, 0x39, 0x80, 0xe7, 0x8e, 0x03, 0x4b, 0x0c,
-	0x60, 0x7d, 0x73, 0x07, 0xcb, 0x9e, 0x3b, 0xb4, 0x68, 0xec, 0xee, 0xc5, 0xe4, 0xd8, 0xb1, 0xc6,
-	0x86, 0x8e, 0x09, 0xda, 0x2e, 0x69, 0x50, 0x44, 0x8b, 0x1d, 0xda, 0xde, 0xd8, 0x21, 0x41, 0x8d,
-	0x9d, 0xf9, 0x8a, 0x6c, 0x0b, 0x52, 0x2b, 0x1d, 0xd1, 0x36, 0x77, 0xa4, 0x41, 0x5c, 0x49, 0x27,
-	0xee, 0xa7, 0x4c, 0xc0, 0xa5, 0xe7, 0x68, 0x5f, 0xbc, 0xe6, 0xf5, 0xbf, 0xce, 0x41, 0xb5, 0xe5,
-	0x1a, 0xce, 0xc5, 0x6f, 0xad, 0xae, 0x3b, 0xf3, 0xc4, 0x96, 0xdc, 0x62, 0x19, 0x8e, 0xd1, 0x3d,
-	0xcb, 0x5d, 0xf9, 0x0a, 0x41, 0xd0, 0x2f, 0xb2, 0x37, 0xa0, 0xea, 0x2d, 0xc3, 0x18, 0x2f, 0xf6,
-	0xe9, 0x41, 0x80, 0x88, 0x20, 0xe6, 0x27, 0x5f, 0x9e, 0x53, 0xf8, 0xc9, 0x93, 0x27, 0xfc, 0x71,
-	0x28, 0x10, 0xf3, 0x13, 0xc1, 0x5b, 0x50, 0x0f, 0xed, 0x39, 0x76, 0xd9, 0x0d, 0x96, 0x73, 0xcb,
-	0x14, 0x57, 0x42, 0xc4, 0x2d, 0x83, 0xb6, 0x84, 0x61, 0x2d, 0x73, 0x6b, 0xee, 0xf9, 0x17, 0xa2,
-	0x96, 0xa2, 0xa8, 0x45, 0x80, 0xa8, 0x96, 0xf7, 0x81, 0x9d, 0x19, 0x76, 0x38, 0x4e, 0x57, 0x25,
-	0xb2, 0x72, 0x0d, 0x31, 0x23, 0xb5, 0xba, 0x6b, 0x50, 0x34, 0xed, 0xe0, 0xb4, 0x3b, 0x20, 0x83,
-	0x97, 0xe3, 0xb2, 0x84, 0x61, 0x47, 0xf0, 0x71, 0x77, 0x30, 0x9e, 0x5c, 0xc8, 0xed, 0xf4, 0x1c,
-	0x2f, 0x23, 0x60, 0xef, 0x22, 0xa4, 0xed, 0x46, 0x42, 0x8a, 0xde, 0xd2, 0xe1, 0x1f, 0x6d, 0xa3,
-	0xe7, 0x78, 0x03, 0xe1, 0x5d, 0x04, 0xb7, 0x11, 0xca, 0xee, 0xc1, 0x25, 0xa2, 0x94, 0x1d, 0x17,
-	0xa4, 0x55, 0x22, 0xdd, 0x46, 0xc4, 0x80, 0xe0, 0x82, 0xf6, 0x16, 0x54, 0x5c, 0x2b, 0x3c, 0xf3,
-	0x7c, 0x94, 0xa6, 0x26, 0x46, 0x2f, 0x06, 0x60, 0x5c, 0x1b, 0x4c, 0x0d, 0x17, 0x85, 0xa7, 0xad,
-	0x74, 0x94, 0x47, 0x96, 0xd9, 0x6d, 0x1c, 0x78, 0xb4, 0xf1, 0x84, 0x6d, 0x88, 0x21, 0x49, 0x20,
-	0xfa, 0x1f, 0xb7, 0x21, 0xdf, 0xf7, 0x4c, 0x8b, 0x7d, 0x08, 0x15, 0x3a, 0x06, 0x5f, 0xdf, 0xef,
-	0x41, 0x34, 0xfd, 0xa1, 0xe0, 0xb7, 0xec, 0xca, 0xaf, 0xe7, 0x1f, 0x9c, 0xbf, 0x09, 0x85, 0x00,
-	0xc3, 0x44, 0xb9, 0x83, 0x23, 0x8f, 0xed, 0x28, 0x72, 0xe4, 0x02, 0x83, 0x22, 0x53, 0x12, 0xe4,
-	0x5b, 0x2e, 0xd9, 0xc2, 0x02, 0x8f, 0xcb, 0x14, 0x4e, 0xf8, 0x1e, 0xae, 0xac, 0x31, 0x1d, 0x63,
-	0x15, 0x36, 0x84, 0x13, 0x02, 0x4f, 0xf7, 0x0c, 0x3e, 0x84, 0xca, 0x53, 0xcf, 0x76, 0x85, 0xe0,
-	0xc5, 0x35, 0xc1, 0xbf, 0xf1, 0x6c, 0xb1, 0x51, 0x55, 0x7e, 0x2a, 0xbf, 0xd8, 0x5b, 0x50, 0xf2,
-	0x5c, 0x51, 0x77, 0x69, 0xad, 0xee, 0xa2, 0xe7, 0xf6, 0xc4, 0xf1, 0x58, 0x7d, 0xb2, 0xc4, 0x34,
-	0x0d, 0x49, 0xad, 0x59, 0x28, 0xf7, 0x65, 0xaa, 0x04, 0x1c, 0xb8, 0x3d, 0x6b, 0x16, 0xb2, 0xf7,
-	0xa0, 0x3a, 0xb3, 0x1d, 0x74, 0x8c, 0x54, 0x59, 0x65, 0xad, 0x32, 0x10, 0x68, 0xaa, 0xf0, 0x67,
-	0x50, 0x3e, 0xf1, 0xbd, 0xe5, 0x02, 0xc3, 0x1e, 0x58, 0xdf, 0x09, 0x21, 0xdc, 0xde, 0x05, 0xf6,
-	0x9e, 0x3e, 0x6d, 0xf7, 0x04, 0xd7, 0xba, 0x34, 0x73, 0xa9, 0xde, 0x47, 0xf8, 0xa1, 0x45, 0xb5,
-	0x1a, 0x27, 0x27, 0xa2, 0xfd, 0xda, 0x7a, 0xad, 0xc6, 0xc9, 0x09, 0x35, 0xfe, 0x1e, 0x94, 0xcf,
-	0x6c, 0x77, 0x1c, 0x2c, 0xac, 0x29, 0xa9, 0x48, 0x7c, 0x76, 0x98, 0x84, 0x71, 0xbc, 0x74, 0x66,
-	0xbb, 0x14, 0xcf, 0xa9, 0x01, 0x5a, 0xe3, 0x85, 0x01, 0xda, 0x0e, 0x14, 0x1c, 0x7b, 0x6e, 0x87,
-	0x74, 0xe8, 0xb7, 0xe2, 0xbb, 0x09, 0xc1, 0x74, 0x28, 0x7a, 0xb3, 0x19, 0x76, 0x46, 0x5b, 0x23,
-	0x91, 0x18, 0xd5, 0x3d, 0x86, 0xe7, 0xe9, 0x6b, 0x4b, 0xb1, 0xd3, 0x8e, 0xdd, 0x63, 0x78, 0x9e,
-	0x8e, 0xdf, 0xd8, 0x0b, 0xe2, 0xb7, 0x5d, 0x99, 0xdc, 0x21, 0xf1, 0xf8, 0x99, 0x35, 0x6d, 0x5e,
-	0xde, 0x68, 0x6a, 0xab, 0x11, 0xc3, 0x23, 0x6b, 0x8a, 0xfe, 0xd7, 0x9b, 0x3c, 0x25, 0x9b, 0x7f,
-	0x65, 0x73, 0x1c, 0x59, 0xf4, 0x26, 0x4f, 0xd1, 0xe2, 0x7f, 0x04, 0x55, 0x9f, 0x92, 0x83, 0x31,
-	0xe5, 0x10, 0x57, 0xd5, 0xe1, 0x4d, 0xb2, 0x06, 0x0e, 0x7e, 0x92, 0x41, 0xbc, 0x05, 0x75, 0x71,
-	0x12, 0x24, 0xb6, 0xfe, 0x03, 0x4a, 0xc4, 0x2b, 0xbc, 0x46, 0x40, 0x71, 0x2c, 0x40, 0x11, 0x83,
-	0xd8, 0x8e, 0xa7, 0x21, 0xb9, 0xae, 0x0a, 0x21, 0xf6, 0xdd, 0x69, 0x48, 0xcc, 0xe8, 0x13, 0x33,
-	0xa6, 0x89, 0xed, 0x9a, 0xa8, 0x38, 0xa1, 0x71, 0x12, 0x34, 0x9b, 0xb4, 0xae, 0xaa, 0x12, 0x36,
-	0x32, 0x4e, 0x02, 0xf6, 0x09, 0xd4, 0x0c, 0x61, 0xd5, 0xc7, 0xb6, 0x3b, 0xf3, 0x9a, 0x37, 0xd4,
-	0x33, 0x09, 0xc5, 0xde, 0xf3, 0xaa, 0xa1, 0x18, 0xff, 0xcf, 0x81, 0x45, 0xbb, 0x2f, 0x14, 0xd0,
-	0x0a, 0x6d, 0xbb, 0xb9, 0xa6, 0x6d, 0xdb, 0x72, 0xfb, 0x25, 0xbe, 0x02, 0xb4, 0x03, 0x18, 0xf8,
-	0x1b, 0x8e, 0x63, 0x39, 0x76, 0x30, 0xa7, 0x9c, 0xbb, 0xc0, 0x55, 0xd0, 0x7a, 0x6c, 0x79, 0xeb,
-	0xe5, 0x62, 0x4b, 0x1c, 0x41, 0xd7, 0x0b, 0xc7, 0x53, 0x63, 0xfa, 0xc4, 0x22, 0x46, 0x91, 0x75,
-	0xd7, 0x5c, 0x2f, 0x6c, 0x47, 0x30, 0x1c, 0x41, 0x61, 0xea, 0x68, 0x04, 0x6f, 0xab, 0x23, 0x18,
-	0x07, 0xbe, 0xe8, 0x86, 0x92, 0xbc, 0xa1, 0x36, 0x5d, 0xfa, 0xe4, 0x26, 0x83, 0xd0, 0x5a, 0x34,
-	0xdf, 0x10, 0x02, 0x4b, 0xd8, 0x30, 0xb4, 0x16, 0x74, 0xaf, 0xc5, 0x5b, 0xfa, 0x53, 0x4b, 0x50,
-	0xec, 0x10, 0x05, 0x08, 0x10, 0x11, 0x7c, 0x06, 0x97, 0x44, 0x6a, 0xac, 0x5a, 0x86, 0x37, 0xd7,
-	0xc7, 0x8a, 0x88, 0x1e, 0xc4, 0xe6, 0x41, 0xff, 0x2f, 0x39, 0x28, 0x47, 0x46, 0x96, 0x55, 0xa1,
-	0x74, 0xdc, 0xff, 0xb6, 0x3f, 0x78, 0xdc, 0xd7, 0xb6, 0x30, 0x13, 0x7b, 0xd4, 0xea, 0x1d, 0x77,
-	0xc6, 0xc3, 0x76, 0xab, 0x2f, 0xae, 0x0a, 0xd1, 0xa5, 0x0d, 0x51, 0xce, 0xb2, 0x4b, 0x50, 0x7f,
-	0x70, 0xdc, 0xa7, 0x93, 0x0f, 0x01, 0xca, 0x21, 0xa8, 0xf3, 0x1b, 0x91, 0xee, 0x09, 0x50, 0x1e,
-	0x41, 0x0f, 0x5b, 0xa3, 0x0e, 0xef, 0x46, 0xa0, 0x02, 0xb6, 0x72, 0xc4, 0x07, 0xdf, 0x74, 0xda,
-	0x23, 0x0d, 0xd8, 0x55, 0xb8, 0x14, 0xb3, 0x44, 0xd5, 0x69, 0x55, 0x4c, 0x1c, 0x23, 0x36, 0xed,
-	0x0a, 0x56, 0xc2, 0x3b, 0xed, 0x63, 0x3e, 0xec, 0x3e, 0xea, 0x8c, 0xdb, 0xa3, 0x8e, 0x76, 0x15,
-	0x53, 0xc8, 0x61, 0xb7, 0xff, 0xad, 0x76, 0x8d, 0xd5, 0xa1, 0x82, 0x5f, 0xa2, 0xf6, 0xeb, 0x94,
-	0x64, 0x1e, 0x1c, 0x68, 0xb7, 0xb1, 0x8a, 0xfd, 0xee, 0x70, 0xd4, 0xed, 0xb7, 0x47, 0xda, 0x1b,
-	0x98, 0x47, 0x3e, 0xe8, 0xf6, 0x46, 0x1d, 0xae, 0xed, 0x20, 0xef, 0x37, 0x83, 0x6e, 0x5f, 0x7b,
-	0x93, 0xee, 0x9b, 0xb4, 0x1e, 0x1e, 0xf5, 0x3a, 0x9a, 0x4e, 0x35, 0x0e, 0xf8, 0x48, 0x7b, 0x8b,
-	0x55, 0xa0, 0x70, 0xdc, 0x47, 0x39, 0xee, 0x60, 0xe5, 0xf4, 0x39, 0x6e, 0xf5, 0x7a, 0xda, 0xcf,
-	0x94, 0x6c, 0xf4, 0x6d, 0xfc, 0x7e, 0xdc, 0xed, 0xef, 0x0f, 0x1e, 0x6b, 0xef, 0x20, 0xd9, 0x1e,
-	0x1f, 0xb4, 0xf6, 0xdb, 0x98, 0xb4, 0xde, 0xc5, 0x0a, 0x86, 0x47, 0xbd, 0xee, 0x48, 0x7b, 0x17,
-	0xa9, 0x0e, 0x5a, 0xa3, 0xc3, 0x0e, 0xd7, 0xee, 0xe1, 0x77, 0x6b, 0x38, 0xec, 0xf0, 0x91, 0xb6,
-	0x8b, 0xdf, 0xdd, 0x3e, 0x7d, 0x7f, 0x4c, 0xb5, 0x1e, 0xed, 0xb7, 0x46, 0x1d, 0xed, 0x13, 0xfc,
-	0xde, 0xef, 0xf4, 0x3a, 0xa3, 0x8e, 0xf6, 0x29, 0xd6, 0x4a, 0xd9, 0xf3, 0x10, 0x87, 0xea, 0x33,
-	0x1c, 0x85, 0xb8, 0x48, 0xf2, 0x7c, 0x8e, 0x0d, 0x3d, 0xec, 0xf6, 0x8f, 0x87, 0xda, 0x17, 0x48,
-	0x4c, 0x9f, 0x84, 0xf9, 0x52, 0x7f, 0x0a, 0xe5, 0xc8, 0x05, 0x21, 0x55, 0xb7, 0xdf, 0xef, 0x70,
-	0x6d, 0x0b, 0x3b, 0xd9, 0xeb, 0x3c, 0x18, 0x69, 0x19, 0x04, 0xf2, 0xee, 0xc1, 0xe1, 0x48, 0xcb,
-	0xe2, 0xe7, 0xe0, 0x18, 0x87, 0x26, 0x47, 0x83, 0xd0, 0x79, 0xd8, 0xd5, 0xf2, 0xf8, 0xd5, 0xea,
-	0x8f, 0xba, 0x5a, 0x81, 0x06, 0xa9, 0xdb, 0x3f, 0xe8, 0x75, 0xb4, 0x22, 0x42, 0x1f, 0xb6, 0xf8,
-	0xb7, 0x5a, 0x09, 0x99, 0x5a, 0x47, 0x47, 0xbd, 0xef, 0xb4, 0xb2, 0x7e, 0x17, 0x4a, 0xad, 0x93,
-	0x93, 0x87, 0xe8, 0xce, 0xcb, 0x90, 0x7f, 0x70, 0xdc, 0xeb, 0x89, 0x5b, 0x66, 0x7b, 0x83, 0xd1,
-	0x68, 0xf0, 0x50, 0xcb, 0xe0, 0x9c, 0x8c, 0x06, 0x47, 0x5a, 0x56, 0xbf, 0x05, 0x45, 0x11, 0x8d,
-	0x52, 0x7e, 0x1d, 0x5d, 0xd3, 0xcb, 0xc9, 0xab, 0x79, 0x1e, 0x54, 0xe2, 0xa8, 0x90, 0xdd, 0x83,
-	0xdc, 0xdc, 0x58, 0xc8, 0x4c, 0xa9, 0xb9, 0x12, 0x33, 0xde, 0x7f, 0x68, 0x2c, 0x44, 0xc2, 0x88,
-	0x44, 0x37, 0x3f, 0x83, 0x72, 0x04, 0xf8, 0x51, 0xb9, 0xd9, 0xbf, 0xce, 0x43, 0x65, 0x5f, 0x31,
-	0x64, 0x7f, 0x76, 0x6e, 0xa6, 0x64, 0x4f, 0xb9, 0x97, 0xce, 0x9e, 0xf2, 0x2f, 0xca, 0x9e, 0x0a,
-	0xaf, 0x9a, 0x3d, 0x15, 0x5f, 0x2e, 0x7b, 0x2a, 0xbd, 0x4c, 0xf6, 0x74, 0x67, 0x2d, 0x7b, 0x12,
-	0xb9, 0x59, 0x3a, 0x5f, 0x4a, 0x67, 0x2d, 0x95, 0x17, 0x65, 0x2d, 0xe9, 0x4c, 0x04, 0x5e, 0x90,
-	0x89, 0xa4, 0x73, 0x9c, 0xea, 0x9f, 0xcc, 0x71, 0x36, 0x66, 0x2d, 0xb5, 0x97, 0xcb, 0x5a, 0xd0,
-	0x1e, 0x1b, 0xee, 0x38, 0xf4, 0x97, 0xee, 0xd4, 0x08, 0x45, 0x70, 0x5b, 0xe6, 0x55, 0x8c, 0x6d,
-	0x25, 0x48, 0xff, 0xab, 0x2c, 0x14, 0x7e, 0xbd, 0xb4, 0xfc, 0x0b, 0xf6, 0x19, 0x54, 0x82, 0x70,
-	0x1e, 0xaa, 0x01, 0xec, 0x0d, 0xd1, 0x00, 0xe1, 0x29, 0xfe, 0xb4, 0xe6, 0x96, 0x1b, 0x8a, 0x68,
-	0x10, 0x69, 0x69, 0x51, 0x5e, 0xc1, 0x68, 0xd5, 0x5a, 0x88, 0xe3, 0xa6, 0x02, 0x17, 0x05, 0x8c,
-	0x6a, 0x30, 0x9a, 0x8d, 0x12, 0x7b, 0x48, 0x22, 0x4a, 0x2e, 0x10, 0x18, 0xd5, 0xd0, 0xb6, 0x68,
-	0x74, 0xe8, 0x91, 0x8a, 0x6a, 0x04, 0x06, 0xc3, 0xdc, 0x27, 0x96, 0x81, 0xee, 0x37, 0xba, 0x38,
-	0x11, 0x97, 0x59, 0x13, 0x4a, 0x8e, 0x67, 0x98, 0x23, 0xe3, 0x24, 0xba, 0xf2, 0x23, 0x8b, 0xfa,
-	0x63, 0xa8, 0xa7, 0x84, 0x4d, 0xbb, 0x03, 0xb4, 0x02, 0x9d, 0x1e, 0x5a, 0xa2, 0x8c, 0x62, 0xbc,
-	0xb2, 0x8a, 0xc1, 0xca, 0x29, 0x86, 0x2c, 0x4f, 0xa6, 0xa9, 0xc3, 0x0f, 0x3a, 0x5a, 0x41, 0xff,
-	0x17, 0x59, 0xb8, 0x34, 0xf2, 0x0d, 0x37, 0x30, 0xc4, 0x71, 0x9a, 0x1b, 0xfa, 0x9e, 0xc3, 0xbe,
-	0x82, 0x72, 0x38, 0x75, 0xd4, 0x71, 0x7b, 0x43, 0xce, 0xfc, 0x2a, 0xe9, 0xfd, 0xd1, 0xd4, 0xa1,
-	0xd1, 0x2b, 0x85, 0xe2, 0x83, 0xfd, 0x1c, 0x0a, 0x13, 0xeb, 0xc4, 0x76, 0xe5, 0xc6, 0xcd, 0xd5,
-	0x55, 0xc6, 0x3d, 0x44, 0x1e, 0x6e, 0x71, 0x41, 0xc5, 0x3e, 0x84, 0xe2, 0xd4, 0x9b, 0x63, 0xb0,
-	0x98, 0x53, 0x0f, 0x68, 0xd5, 0x86, 0x10, 0x7b, 0xb8, 0xc5, 0x25, 0x1d, 0xfb, 0x0c, 0xca, 0xbe,
-	0xe7, 0x38, 0x13, 0x63, 0x7a, 0x2a, 0x0f, 0x75, 0x9b, 0xab, 0x3c, 0x5c, 0xe2, 0x0f, 0xb7, 0x78,
-	0x4c, 0xab, 0xdf, 0x87, 0x92, 0x14, 0x16, 0x07, 0x60, 0xaf, 0x73, 0xd0, 0x95, 0x63, 0xd7, 0x1e,
-	0x3c, 0x7c, 0xd8, 0x1d, 0x89, 0x0b, 0x05, 0x7c, 0xd0, 0xeb, 0xed, 0xb5, 0xda, 0xdf, 0x6a, 0xd9,
-	0xbd, 0x32, 0x14, 0x0d, 0xda, 0x0f, 0xd7, 0xff, 0x6e, 0x06, 0xb6, 0x57, 0x3a, 0xc0, 0xbe, 0x80,
-	0xfc, 0xdc, 0x33, 0xa3, 0xe1, 0xb9, 0xb3, 0xb1, 0x97, 0x4a, 0x19, 0x2d, 0x30, 0x27, 0x0e, 0xfd,
-	0x4b, 0x68, 0xa4, 0xe1, 0xca, 0x65, 0xcc, 0x3a, 0x54, 0x78, 0xa7, 0xb5, 0x3f, 0x1e, 0xf4, 0x7b,
-	0xdf, 0x09, 0xbf, 0x4e, 0xc5, 0xc7, 0xbc, 0x3b, 0xea, 0x68, 0x59, 0xfd, 0x2f, 0x40, 0x5b, 0x1d,
-	0x18, 0x76, 0x00, 0xdb, 0x53, 0x6f, 0xbe, 0x70, 0x2c, 0x71, 0x12, 0x98, 0x4c, 0xd9, 0xed, 0x0d,
-	0x23, 0x29, 0xc9, 0x68, 0xc6, 0x1a, 0xd3, 0x54, 0x59, 0xff, 0x3b, 0xc0, 0xd6, 0x47, 0xf0, 0xa7,
-	0xab, 0xfe, 0x7f, 0x64, 0x20, 0x7f, 0xe4, 0x18, 0x2e, 0x7b, 0x0b, 0x0a, 0x74, 0xd1, 0x51, 0x6e,
-	0xc6, 0x56, 0x95, 0x15, 0x89, 0x6a, 0x41, 0x38, 0xf6, 0x1e, 0xe4, 0xc2, 0xa9, 0x23, 0x75, 0xe8,
-	0xfa, 0x73, 0x94, 0xef, 0x70, 0x8b, 0x23, 0x15, 0xbb, 0x0b, 0x39, 0xd3, 0x74, 0xa4, 0x02, 0xc9,
-	0xf3, 0x2e, 0x0c, 0xaa, 0xf7, 0xad, 0x99, 0xed, 0xda, 0xf2, 0xda, 0x25, 0x92, 0xb0, 0x9f, 0x41,
-	0xce, 0x9c, 0x3a, 0x52, 0x6d, 0x2e, 0x25, 0x94, 0x4a, 0x85, 0xe6, 0xd4, 0x61, 0xf7, 0xa1, 0xd6,
-	0x0a, 0x43, 0x0c, 0x1a, 0x4d, 0x14, 0x39, 0x7d, 0x47, 0x0f, 0x21, 0x3c, 0x85, 0xdf, 0x2b, 0x02,
-	0x3d, 0xeb, 0xd1, 0xdf, 0xa7, 0xbb, 0x83, 0xcb, 0xb9, 0x8b, 0xa6, 0x40, 0x7c, 0x6d, 0xd8, 0xfa,
-	0x96, 0x18, 0xfd, 0xff, 0x66, 0xa1, 0xaa, 0x34, 0xce, 0x3e, 0x81, 0xb2, 0x99, 0x5e, 0x75, 0x37,
-	0xd6, 0x24, 0xbc, 0xbf, 0x1f, 0xad, 0x37, 0x53, 0xea, 0xf2, 0x97, 0x50, 0x47, 0x53, 0xfa, 0xcc,
-	0xf0, 0x6d, 0x34, 0xcb, 0x81, 0x1c, 0x33, 0x19, 0x2f, 0x0f, 0xad, 0xf0, 0x51, 0x84, 0x39, 0xdc,
-	0xe2, 0xb5, 0x40, 0x29, 0xb3, 0x77, 0xa1, 0xb4, 0xf0, 0xad, 0x85, 0xe1, 0x5b, 0x72, 0xec, 0xea,
-	0xd1, 0xa9, 0x13, 0x01, 0x0f, 0xb7, 0x78, 0x84, 0x47, 0x52, 0xeb, 0xdc, 0x9a, 0x2e, 0x43, 0x4b,
-	0x0e, 0x5e, 0x3d, 0xea, 0x10, 0x01, 0x91, 0x54, 0xe2, 0xd9, 0x2e, 0x26, 0x29, 0x86, 0xe3, 0x78,
-	0x64, 0xa0, 0x0b, 0x6a, 0xee, 0xb3, 0x1f, 0xc3, 0xc5, 0x7b, 0x8e, 0xa8, 0xa4, 0x9f, 0x40, 0x49,
-	0x76, 0x0c, 0x43, 0xa9, 0x61, 0x67, 0x34, 0x7e, 0xd4, 0xe2, 0x5d, 0x0c, 0x69, 0x87, 0xda, 0x16,
-	0x2e, 0xd7, 0x03, 0xde, 0xea, 0x4b, 0xf3, 0xc6, 0x3b, 0x8f, 0x06, 0xdf, 0x76, 0xb4, 0xac, 0x38,
-	0xaa, 0xe8, 0x7f, 0xa7, 0xe5, 0x44, 0xd8, 0xda, 0x39, 0x6a, 0x71, 0xb4, 0x6e, 0x55, 0x28, 0x75,
-	0x7e, 0xd3, 0x69, 0x1f, 0x8f, 0x3a, 0x5a, 0x01, 0x57, 0xd0, 0x7e, 0xa7, 0xd5, 0xeb, 0x0d, 0xda,
-	0x68, 0xfa, 0x8a, 0x7b, 0x15, 0x28, 0x4d, 0xc5, 0x48, 0xea, 0xff, 0xa6, 0x0e, 0x8d, 0xb4, 0x96,
-	0xb0, 0xcf, 0xa1, 0x6c, 0x9a, 0xa9, 0x19, 0xb8, 0xb5, 0x49, 0x9b, 0xee, 0xef, 0x9b, 0xd1, 0x24,
-	0x88, 0x0f, 0xf6, 0x66, 0xa4, 0xd3, 0xd9, 0x35, 0x9d, 0x8e, 0x34, 0xfa, 0x97, 0xb0, 0x2d, 0x6f,
-	0xfc, 0x61, 0x4e, 0x38, 0x31, 0x02, 0x2b, 0xad, 0xb0, 0x6d, 0x42, 0xee, 0x4b, 0xdc, 0xe1, 0x16,
-	0x6f, 0x4c, 0x53, 0x10, 0xf6, 0x35, 0x34, 0x0c, 0xca, 0x1f, 0x62, 0xfe, 0xbc, 0x7a, 0x54, 0xd8,
-	0x42, 0x9c, 0xc2, 0x5e, 0x37, 0x54, 0x00, 0xaa, 0x89, 0xe9, 0x7b, 0x8b, 0x84, 0xb9, 0xa0, 0xaa,
-	0xc9, 0xbe, 0xef, 0x2d, 0x14, 0xde, 0x9a, 0xa9, 0x94, 0xd9, 0x67, 0x50, 0x93, 0x92, 0x27, 0x0f,
-	0xc0, 0xe2, 0xd5, 0x23, 0xc4, 0xa6, 0x88, 0xe0, 0x70, 0x8b, 0x57, 0xa7, 0x49, 0x91, 0x7d, 0x0c,
-	0x55, 0x21, 0xb0, 0x60, 0x2b, 0xa9, 0x9a, 0x40, 0xd2, 0x46, 0x5c, 0x60, 0xc4, 0x25, 0xf6, 0x21,
-	0x00, 0xc9, 0xa9, 0x9e, 0x2b, 0x6c, 0x27, 0x42, 0x46, 0x2c, 0x15, 0x33, 0x2a, 0x28, 0xe2, 0x89,
-	0xb3, 0xe0, 0xca, 0xba, 0x78, 0x74, 0x30, 0x9a, 0x88, 0x27, 0xce, 0x7e, 0x63, 0xf1, 0x04, 0x1b,
-	0xac, 0x89, 0x17, 0x71, 0x09, 0xf1, 0x04, 0x53, 0x24, 0x9e, 0xe0, 0xa9, 0xae, 0x8a, 0x17, 0xb1,
-	0x90, 0x78, 0x82, 0xe3, 0x6b, 0x68, 0x44, 0xd1, 0x8a, 0xec, 0x54, 0x2d, 0x75, 0x5d, 0x41, 0xe2,
-	0xa2, 0x8e, 0xd5, 0x43, 0x15, 0x80, 0xdc, 0xc1, 0x13, 0xef, 0x4c, 0x59, 0xde, 0x75, 0x95, 0x7b,
-	0xf8, 0xc4, 0x3b, 0x53, 0xd7, 0x77, 0x3d, 0x50, 0x01, 0x28, 0xad, 0xe8, 0x22, 0xdd, 0xf6, 0x68,
-	0xa8, 0xd2, 0x52, 0x0f, 0x1f, 0xd9, 0xd6, 0x19, 0x4a, 0x6b, 0x44, 0x05, 0x1c, 0x14, 0x4a, 0x55,
-	0x43, 0xd1, 0xd8, 0xb6, 0x3a, 0x28, 0x74, 0xbc, 0x1d, 0xb5, 0x04, 0x4e, 0x5c, 0x42, 0xdd, 0x5a,
-	0xba, 0x2a, 0x9b, 0xa6, 0xea, 0xd6, 0xb1, 0x9b, 0x62, 0xac, 0x09, 0x52, 0xc9, 0x9a, 0xac, 0x8a,
-	0xc0, 0xfa, 0x7e, 0x69, 0xb9, 0x53, 0x4b, 0xee, 0xf4, 0xa4, 0x56, 0xc5, 0x50, 0xe2, 0x92, 0x55,
-	0x11, 0x41, 0x62, 0xbd, 0x8e, 0xd9, 0xd9, 0xaa, 0x5e, 0x2b, 0xcc, 0xa4, 0xd7, 0x31, 0x6b, 0xbc,
-	0xa0, 0x62, 0xde, 0xcb, 0x6b, 0x0b, 0x4a, 0x61, 0x16, 0x0b, 0x2a, 0x02, 0xe8, 0x7f, 0xcc, 0x43,
-	0x49, 0xda, 0x01, 0x76, 0x19, 0xb6, 0xdb, 0xbc, 0xd3, 0x1a, 0x75, 0xc6, 0xfb, 0xad, 0x51, 0x6b,
-	0xaf, 0x35, 0x44, 0x5f, 0xce, 0xa0, 0xd1, 0xc2, 0xac, 0x36, 0x81, 0x65, 0xd0, 0xb8, 0xed, 0xf3,
-	0xc1, 0x51, 0x02, 0xca, 0x32, 0x0d, 0x6a, 0x92, 0x57, 0xbc, 0xbb, 0xc8, 0xb1, 0x6d, 0xa8, 0x0a,
-	0x46, 0x01, 0xa0, 0x83, 0x57, 0xe2, 0x12, 0xe5, 0x82, 0xc2, 0xd2, 0xed, 0xef, 0x77, 0x7e, 0xa3,
-	0x15, 0x13, 0x16, 0x01, 0x28, 0xc5, 0x2c, 0xa2, 0x5c, 0x46, 0x61, 0x46, 0xfc, 0xb8, 0xdf, 0x4e,
-	0xda, 0xa9, 0x20, 0x93, 0xac, 0xe6, 0x51, 0xb7, 0xf3, 0x58, 0x03, 0x64, 0x12, 0xb5, 0x50, 0xb9,
-	0x8a, 0xd1, 0x08, 0x55, 0x42, 0xc5, 0x1a, 0xbb, 0x0e, 0x97, 0x87, 0x87, 0x83, 0xc7, 0x63, 0xc1,
-	0x14, 0x77, 0xa1, 0xce, 0xae, 0x80, 0xa6, 0x20, 0x44, 0xf5, 0x0d, 0x6c, 0x92, 0xa0, 0x11, 0xe1,
-	0x50, 0xdb, 0xa6, 0x4b, 0x9a, 0x08, 0x1b, 0x09, 0xd3, 0xae, 0x61, 0x57, 0x04, 0xeb, 0xa0, 0x77,
-	0xfc, 0xb0, 0x3f, 0xd4, 0x2e, 0xa1, 0x10, 0x04, 0x11, 0x92, 0xb3, 0xb8, 0x9a, 0xc4, 0x21, 0x5c,
-	0x26, 0x1f, 0x81, 0xb0, 0xc7, 0x2d, 0xde, 0xef, 0xf6, 0x0f, 0x86, 0xda, 0x95, 0xb8, 0xe6, 0x0e,
-	0xe7, 0x03, 0x3e, 0xd4, 0xae, 0xc6, 0x80, 0xe1, 0xa8, 0x35, 0x3a, 0x1e, 0x6a, 0xd7, 0x62, 0x29,
-	0x8f, 0xf8, 0xa0, 0xdd, 0x19, 0x0e, 0x7b, 0xdd, 0xe1, 0x48, 0xbb, 0xce, 0xae, 0xc2, 0xa5, 0x44,
-	0xa2, 0x88, 0xb8, 0xa9, 0x08, 0xca, 0x0f, 0x3a, 0x23, 0xed, 0x46, 0x2c, 0x46, 0x7b, 0xd0, 0xeb,
-	0xb5, 0x68, 0x27, 0xe4, 0x26, 0x12, 0xf5, 0x06, 0xed, 0x6f, 0xa3, 0xde, 0xbc, 0x86, 0x72, 0x1d,
-	0xf7, 0x55, 0xd0, 0x2d, 0x45, 0x35, 0x86, 0x9d, 0x5f, 0x1f, 0x77, 0xfa, 0xed, 0x8e, 0xf6, 0x7a,
-	0xa2, 0x1a, 0x31, 0xec, 0x76, 0xac, 0x1a, 0x31, 0xe8, 0x8d, 0xb8, 0xcd, 0x08, 0x34, 0xd4, 0x76,
-	0xf6, 0x6a, 0xf4, 0x36, 0x52, 0x3a, 0x22, 0xfd, 0x1b, 0x60, 0xea, 0x1b, 0x26, 0x79, 0xb9, 0x9c,
-	0x41, 0x7e, 0xe6, 0x7b, 0xf3, 0xe8, 0xfe, 0x06, 0x7e, 0xd3, 0xc6, 0xdb, 0x72, 0x42, 0xe7, 0xae,
-	0xc9, 0x85, 0x02, 0x15, 0xa4, 0xff, 0x3e, 0x03, 0x8d, 0xb4, 0x13, 0x62, 0x3a, 0xd4, 0xed, 0xd9,
-	0xd8, 0xf5, 0x42, 0x71, 0x01, 0x3a, 0x90, 0x17, 0xd4, 0xab, 0xf6, 0xac, 0xef, 0x85, 0x74, 0x03,
-	0x9a, 0x12, 0x9a, 0xd8, 0xa7, 0x88, 0x5a, 0xe3, 0x32, 0xeb, 0xc2, 0xe5, 0xd4, 0xb3, 0xad, 0xd4,
-	0xf5, 0xf3, 0x66, 0xfc, 0xee, 0x65, 0x45, 0x7e, 0xce, 0x82, 0x35, 0x98, 0x7e, 0x08, 0xf5, 0x94,
-	0x87, 0x63, 0xaf, 0x41, 0xc5, 0x9e, 0xa5, 0xe5, 0x2a, 0xdb, 0xb3, 0x17, 0x0b, 0xa5, 0x1f, 0x40,
-	0x4d, 0x75, 0x77, 0xaf, 0x5e, 0xd1, 0x1b, 0x50, 0x79, 0x70, 0x1a, 0xdd, 0x86, 0x57, 0x2f, 0xe4,
-	0x57, 0xe4, 0x95, 0x8f, 0xff, 0x95, 0x85, 0xaa, 0xe2, 0x1f, 0x5f, 0x6a, 0x38, 0x6f, 0x41, 0x25,
-	0xb9, 0x37, 0x24, 0xde, 0x90, 0x26, 0x80, 0x94, 0x38, 0xb9, 0x95, 0xc1, 0x4e, 0xed, 0x7f, 0xe7,
-	0x5f, 0xb0, 0xff, 0xfd, 0x11, 0xd4, 0x94, 0x3b, 0xf0, 0x81, 0xdc, 0xc7, 0x58, 0xdb, 0xfe, 0x4e,
-	0xee, 0xc3, 0x07, 0xec, 0x2a, 0x14, 0x67, 0xa7, 0x63, 0x73, 0x22, 0xee, 0x25, 0x56, 0x78, 0x61,
-	0x76, 0xba, 0x3f, 0xa1, 0x8b, 0x3f, 0xb3, 0xd8, 0xf0, 0x97, 0x44, 0x46, 0x3b, 0x8b, 0xcc, 0xfb,
-	0x5d, 0x28, 0xcd, 0x4e, 0xc5, 0x05, 0xf3, 0xb2, 0x9a, 0xe0, 0xc7, 0xe3, 0xc6, 0x8b, 0xb3, 0x53,
-	0xba, 0x6c, 0xfe, 0x25, 0x68, 0x2b, 0xf7, 0x19, 0x03, 0xb9, 0x47, 0xb1, 0x2a, 0xd4, 0x76, 0xfa,
-	0x6e, 0x63, 0xa0, 0xff, 0xbb, 0x0c, 0x34, 0x92, 0x78, 0x02, 0xe7, 0x96, 0xdd, 0x53, 0xdf, 0x7a,
-	0x36, 0x57, 0x43, 0x0e, 0x24, 0xb9, 0x3f, 0xba, 0x58, 0x88, 0x97, 0x36, 0x9b, 0x2e, 0x35, 0x6e,
-	0x7a, 0x22, 0x90, 0xdb, 0xf4, 0x44, 0x40, 0x3f, 0x80, 0xdc, 0xe8, 0x62, 0x21, 0xd2, 0x48, 0x34,
-	0x61, 0x22, 0x5c, 0x15, 0xc6, 0x8b, 0x76, 0xd7, 0xbe, 0xed, 0x7c, 0x27, 0x2e, 0xd3, 0x1c, 0xf1,
-	0xee, 0xc3, 0x16, 0xff, 0x6e, 0x8c, 0x00, 0x32, 0xf2, 0x0f, 0x06, 0xbc, 0xd3, 0x3d, 0xe8, 0x13,
-	0x20, 0x4f, 0x49, 0x66, 0x22, 0x62, 0xcb, 0x34, 0x1f, 0x9c, 0xaa, 0x6f, 0x0b, 0x33, 0xa9, 0xb7,
-	0x85, 0xf1, 0xd5, 0x49, 0xf5, 0x3d, 0x44, 0x18, 0xbf, 0x5b, 0x88, 0x94, 0x31, 0x97, 0x28, 0x23,
-	0x7b, 0x07, 0xf2, 0xb3, 0x53, 0xeb, 0x22, 0x1d, 0x34, 0xa6, 0xaf, 0x24, 0x12, 0x81, 0xfe, 0x43,
-	0x06, 0x58, 0x4a, 0x10, 0x11, 0xc7, 0xbc, 0xaa, 0x2c, 0x9f, 0x43, 0x53, 0xbe, 0x8e, 0x11, 0x54,
-	0xf2, 0xa9, 0xcf, 0x18, 0x65, 0x11, 0x43, 0x7a, 0x55, 0xe0, 0xa9, 0xb9, 0xe4, 0xde, 0x25, 0xfb,
-	0x00, 0xc4, 0x0b, 0x8f, 0xae, 0x3b, 0xf3, 0xd2, 0x19, 0x9b, 0xb2, 0xa6, 0x78, 0x42, 0xc3, 0xee,
-	0xc1, 0x25, 0x75, 0xd2, 0xc4, 0x9b, 0x8d, 0x02, 0x2d, 0xa1, 0xed, 0x64, 0xd6, 0x68, 0x9d, 0xe9,
-	0xff, 0x28, 0x03, 0x97, 0xd3, 0x0a, 0xf1, 0xe7, 0xf5, 0x32, 0xfd, 0x40, 0x25, 0xb7, 0xfa, 0x40,
-	0x65, 0x93, 0x3e, 0xe5, 0x37, 0xea, 0xd3, 0xdf, 0xcb, 0xc0, 0x15, 0x65, 0xf4, 0x93, 0xc8, 0xf3,
-	0xff, 0x93, 0x64, 0xca, 0x3b, 0x95, 0x7c, 0xea, 0x9d, 0x8a, 0xfe, 0xfb, 0x1c, 0x40, 0x22, 0x49,
-	0xca, 0xf4, 0x64, 0xfe, 0x94, 0xe9, 0x79, 0x89, 0xab, 0x53, 0x76, 0x30, 0x4e, 0x9f, 0xf1, 0xe4,
-	0xa2, 0x1b, 0xee, 0xea, 0xf9, 0x0e, 0xfb, 0x08, 0x4a, 0x62, 0x07, 0x26, 0xda, 0x50, 0xbb, 0xbe,
-	0xba, 0x92, 0xef, 0xcb, 0xc7, 0x23, 0x11, 0xdd, 0xcd, 0xbf, 0xce, 0x40, 0x51, 0xbe, 0x1c, 0xb9,
-	0x07, 0x79, 0x0c, 0xf7, 0xe4, 0x36, 0xc3, 0x95, 0x4d, 0x46, 0x80, 0x7e, 0xc2, 0x01, 0xed, 0xc5,
-	0x7d, 0x28, 0x1a, 0xa6, 0x39, 0x9e, 0x9d, 0xa6, 0x77, 0xad, 0x56, 0xd6, 0xe3, 0xe1, 0x16, 0x2f,
-	0x18, 0xb4, 0x30, 0x3f, 0x87, 0x0a, 0xd2, 0x8b, 0x2c, 0x20, 0xe5, 0xce, 0xd6, 0x57, 0xce, 0xe1,
-	0x16, 0x2f, 0x1b, 0xd1, 0x2a, 0xfa, 0x45, 0x3a, 0xe9, 0x10, 0x6a, 0x7d, 0x73, 0x8d, 0xf5, 0x39,
-	0xe9, 0x87, 0xb2, 0x27, 0xf5, 0xaf, 0xb2, 0x50, 0x89, 0x13, 0xa2, 0x57, 0xf6, 0x61, 0xc9, 0xaf,
-	0x7a, 0xe4, 0x94, 0x5f, 0xf5, 0x58, 0x5d, 0x49, 0xe2, 0xc5, 0x40, 0x9e, 0x8c, 0xc9, 0x76, 0x5a,
-	0x5f, 0x83, 0xf5, 0xf3, 0xba, 0xc2, 0x4b, 0x9e, 0xd7, 0xdd, 0x00, 0xa1, 0x13, 0x63, 0xdb, 0xa4,
-	0x94, 0x32, 0xcf, 0x4b, 0x54, 0xee, 0x9a, 0xab, 0xaf, 0x97, 0x4a, 0x74, 0x23, 0x5a, 0x7d, 0xbd,
-	0xf4, 0xdc, 0x67, 0x0d, 0xe5, 0xe7, 0x3f, 0x6b, 0xf8, 0x1e, 0x2a, 0x71, 0xd2, 0xf3, 0xea, 0x03,
-	0xf6, 0x63, 0xbc, 0xac, 0xfe, 0x97, 0x51, 0x44, 0x15, 0xe7, 0x1c, 0x7f, 0x6e, 0x44, 0x95, 0x6a,
-	0x3e, 0xf7, 0x82, 0xe6, 0xcf, 0x45, 0xa4, 0x13, 0x37, 0xfe, 0x13, 0x6b, 0x89, 0x3a, 0x81, 0xf9,
-	0xd4, 0x04, 0xea, 0xdb, 0x32, 0x5a, 0x8b, 0xb3, 0xa5, 0x7f, 0x9b, 0x89, 0x42, 0xa1, 0xf8, 0xe2,
-	0xf5, 0x73, 0xad, 0x49, 0xdc, 0x5a, 0x56, 0x6d, 0xed, 0x95, 0xfd, 0xc8, 0x3b, 0x50, 0x50, 0x17,
-	0xdb, 0x06, 0x1f, 0x22, 0xf0, 0xab, 0xaf, 0xfd, 0x0a, 0xab, 0xaf, 0xfd, 0x74, 0x5d, 0x1a, 0x44,
-	0xd1, 0x85, 0x2b, 0x51, 0xbd, 0xd1, 0x4b, 0x45, 0x2c, 0xa0, 0x1b, 0xaf, 0x24, 0xee, 0xe4, 0xc7,
-	0x77, 0xf3, 0x27, 0x73, 0x24, 0x3f, 0x64, 0xa0, 0x9e, 0xda, 0x5c, 0x78, 0x05, 0x61, 0x36, 0xda,
-	0x81, 0xdc, 0x4b, 0xda, 0x81, 0xfc, 0x2b, 0xd8, 0x81, 0xc2, 0x9f, 0xb4, 0x03, 0xc5, 0x55, 0x3b,
-	0xa0, 0xff, 0xc3, 0x4c, 0xfc, 0x26, 0x4f, 0x54, 0xb6, 0xc9, 0xb9, 0x64, 0x36, 0x3a, 0x97, 0xdb,
-	0xf1, 0xcf, 0x3a, 0x74, 0xf7, 0xc5, 0x49, 0x4f, 0x9d, 0x2b, 0x10, 0xf6, 0x25, 0xdc, 0x10, 0xfb,
-	0xb4, 0xc2, 0x54, 0x8f, 0xbd, 0xd9, 0x38, 0xfe, 0xd1, 0x07, 0x79, 0xf5, 0xf8, 0x9a, 0x20, 0x10,
-	0x2f, 0x37, 0x67, 0xc9, 0x4f, 0x4b, 0x74, 0xa1, 0x9e, 0xda, 0x98, 0x51, 0x7e, 0xfd, 0x25, 0xa3,
-	0xfe, 0xfa, 0x0b, 0xdb, 0x81, 0xc2, 0xd9, 0x13, 0xcb, 0xb7, 0x36, 0xfc, 0x66, 0x83, 0x40, 0xe8,
-	0x5f, 0x43, 0x4d, 0xdd, 0xc2, 0x65, 0xef, 0x43, 0xc1, 0x0e, 0xad, 0x79, 0x74, 0xd3, 0xfc, 0xda,
-	0xfa, 0x2e, 0x2f, 0xbd, 0x37, 0x13, 0x44, 0xfa, 0x1f, 0x32, 0xa0, 0xad, 0xe2, 0x94, 0x9f, 0xa8,
-	0xc9, 0x3c, 0xe7, 0x27, 0x6a, 0xb2, 0x29, 0x21, 0x37, 0xfc, 0xcc, 0x4c, 0x72, 0x3b, 0x37, 0xff,
-	0x9c, 0xdb, 0xb9, 0xec, 0x6d, 0x28, 0xfb, 0x16, 0xfd, 0x2c, 0x88, 0x99, 0xde, 0x3e, 0x17, 0xef,
-	0x98, 0x22, 0x9c, 0xfe, 0xf7, 0x33, 0x50, 0x92, 0xfb, 0xcd, 0x1b, 0xdf, 0x1d, 0xbc, 0x0b, 0x25,
-	0xf1, 0x13, 0x21, 0xd1, 0x0f, 0x5b, 0xac, 0x1d, 0x59, 0x46, 0x78, 0x76, 0x5b, 0xec, 0xc2, 0xa7,
-	0x5f, 0x09, 0xd2, 0x6e, 0x3d, 0xc1, 0x51, 0x9b, 0xe8, 0x10, 0x8e, 0xf6, 0x77, 0x03, 0x79, 0xb6,
-	0x0b, 0x04, 0x1a, 0x21, 0x44, 0xff, 0x05, 0x94, 0xe4, 0x7e, 0xf6, 0x46, 0x51, 0x5e, 0xf4, 0x03,
-	0x1b, 0x3b, 0x00, 0xc9, 0x06, 0xf7, 0xa6, 0x1a, 0x74, 0x47, 0xbe, 0xb4, 0xe8, 0x79, 0xd3, 0x53,
-	0x0a, 0x59, 0x3f, 0x80, 0xb2, 0x13, 0x3d, 0x2f, 0xc9, 0x3c, 0xff, 0x79, 0x49, 0x4c, 0xc4, 0xee,
-	0x41, 0x6c, 0xde, 0x5f, 0x14, 0x68, 0xe9, 0x2d, 0x80, 0x64, 0xe7, 0x8d, 0x7d, 0x2c, 0xc3, 0x44,
-	0x04, 0x45, 0xea, 0xb3, 0xda, 0x18, 0x5d, 0xf4, 0x51, 0xc8, 0xf4, 0x06, 0xd4, 0xd4, 0xed, 0xbb,
-	0x7b, 0x6f, 0x42, 0x4d, 0xfd, 0x21, 0x03, 0x3a, 0xb9, 0xf2, 0x5c, 0x4b, 0x3c, 0x20, 0xe8, 0xfd,
-	0xf6, 0x13, 0x2d, 0x73, 0xef, 0x2f, 0x95, 0x97, 0x78, 0x44, 0x23, 0x73, 0x20, 0xba, 0xb5, 0xd2,
-	0xeb, 0xf6, 0x3b, 0x2d, 0x4e, 0x19, 0x0f, 0x3d, 0x35, 0x38, 0x6c, 0x0d, 0x0f, 0x45, 0x76, 0x24,
-	0x31, 0x04, 0xc8, 0xd1, 0x0d, 0x88, 0x56, 0xff, 0xa0, 0x23, 0x6e, 0xa9, 0xd0, 0x67, 0xbc, 0x45,
-	0x54, 0xa0, 0x9b, 0x12, 0xdd, 0xe1, 0x48, 0x2b, 0x32, 0x0d, 0x6a, 0xf8, 0x15, 0xe3, 0x4a, 0xf7,
-	0x7e, 0x05, 0xcd, 0xe7, 0x1d, 0x49, 0x61, 0xad, 0xed, 0xc3, 0x16, 0x1d, 0xfb, 0xd5, 0xa0, 0xdc,
-	0x1f, 0x8c, 0x45, 0x29, 0xc3, 0xaa, 0x50, 0xe2, 0x9d, 0x5e, 0x87, 0x36, 0xe4, 0xee, 0xfd, 0x2e,
-	0xa3, 0xcc, 0x52, 0x74, 0x24, 0x11, 0x03, 0x64, 0x77, 0x55, 0x10, 0xb7, 0x0c, 0x53, 0xcb, 0xb0,
-	0x6b, 0xc0, 0x52, 0xa0, 0x9e, 0x37, 0x35, 0x1c, 0x2d, 0x4b, 0x5b, 0x6f, 0x11, 0xfc, 0xb1, 0x6f,
-	0x87, 0x96, 0x96, 0x63, 0xaf, 0xc3, 0x8d, 0x18, 0xd6, 0xf3, 0xce, 0x8e, 0x7c, 0xdb, 0xf3, 0xed,
-	0xf0, 0x42, 0xa0, 0xf3, 0x7b, 0xbf, 0xfc, 0xf7, 0x3f, 0xdc, 0xce, 0xfc, 0xa7, 0x1f, 0x6e, 0x67,
-	0xfe, 0xfb, 0x0f, 0xb7, 0xb7, 0xfe, 0xf0, 0x3f, 0x6f, 0x67, 0xfe, 0xb6, 0xfa, 0x83, 0x71, 0x73,
-	0x23, 0xf4, 0xed, 0x73, 0xe1, 0xec, 0xa2, 0x82, 0x6b, 0x7d, 0xb0, 0x38, 0x3d, 0xf9, 0x60, 0x31,
-	0xf9, 0x00, 0x67, 0x74, 0x52, 0xa4, 0xdf, 0x8d, 0xfb, 0xf8, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x97, 0xf7, 0x71, 0x7a, 0x4e, 0x00, 0x00,
+	// 8542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4b, 0x8f, 0x23, 0x47,
+	0x9a, 0x58, 0xf3, 0x4d, 0x7e, 0x24, 0xab, 0xb2, 0xa3, 0x5f, 0xec, 0x56, 0xab, 0x55, 0x4a, 0xb5,
+	0xa4, 0x56, 0x8f, 0xd4, 0x92, 0x4a, 0x1a, 0xbd, 0x3c, 0xe3, 0x19, 0x16, 0xc9, 0xae, 0xa6, 0xc4,
+	0x26, 0x6b, 0x82, 0xac, 0x6e, 0x69, 0x17, 0x06, 0x91, 0x64, 0x26, 0xab, 0x52, 0x95, 0xcc, 0xa4,
+	0x32, 0x93, 0x5d, 0x55, 0x63, 0x2c, 0x30, 0x07, 0xc3, 0x86, 0x2f, 0xbe, 0x18, 0x30, 0x60, 0xac,
+	0x01, 0x8f, 0x7d, 0x18, 0x03, 0x86, 0x01, 0xdf, 0xec, 0xb3, 0xd7, 0x97, 0x35, 0xe0, 0x83, 0x7d,
+	0xb5, 0x61, 0xc0, 0x96, 0xed, 0x1f, 0x60, 0xef, 0xc2, 0x7b, 0xf1, 0xc1, 0xf8, 0xbe, 0x88, 0xcc,
+	0x8c, 0x24, 0x59, 0xd3, 0xad, 0x1e, 0xf9, 0x52, 0x15, 0xf1, 0x3d, 0xe2, 0x95, 0x11, 0xdf, 0x2b,
+	0xbe, 0x20, 0xc0, 0xc2, 0x31, 0xdc, 0x07, 0x0b, 0xdf, 0x0b, 0x3d, 0x96, 0xc7, 0xf2, 0xad, 0xf7,
+	0x8e, 0xec, 0xf0, 0x78, 0x39, 0x79, 0x30, 0xf5, 0xe6, 0xef, 0x1f, 0x79, 0x47, 0xde, 0xfb, 0x84,
+	0x9c, 0x2c, 0x67, 0x54, 0xa3, 0x0a, 0x95, 0x04, 0xd3, 0xad, 0xed, 0xd0, 0x9e, 0x5b, 0x41, 0x68,
+	0xcc, 0x17, 0x02, 0xa0, 0xff, 0xeb, 0x0c, 0xe4, 0x47, 0xe7, 0x0b, 0x8b, 0x6d, 0x41, 0xd6, 0x36,
+	0x1b, 0x99, 0x9d, 0xcc, 0xbd, 0x02, 0xcf, 0xda, 0x26, 0xdb, 0x81, 0xaa, 0xeb, 0x85, 0xfd, 0xa5,
+	0xe3, 0x18, 0x13, 0xc7, 0x6a, 0x64, 0x77, 0x32, 0xf7, 0xca, 0x5c, 0x05, 0xb1, 0x57, 0xa0, 0x62,
+	0x2c, 0x43, 0x6f, 0x6c, 0xbb, 0x53, 0xbf, 0x91, 0x23, 0x7c, 0x19, 0x01, 0x5d, 0x77, 0xea, 0xb3,
+	0xab, 0x50, 0x38, 0xb5, 0xcd, 0xf0, 0xb8, 0x91, 0xa7, 0x16, 0x45, 0x05, 0xa1, 0xc1, 0xd4, 0x70,
+	0xac, 0x46, 0x41, 0x40, 0xa9, 0x82, 0xd0, 0x90, 0x3a, 0x29, 0xee, 0x64, 0xee, 0x55, 0xb8, 0xa8,
+	0xb0, 0x3b, 0x00, 0x96, 0xbb, 0x9c, 0x3f, 0x33, 0x9c, 0xa5, 0x15, 0x34, 0x4a, 0x84, 0x52, 0x20,
+	0xfa, 0x7f, 0x2c, 0x40, 0xa1, 0xe5, 0xb9, 0x41, 0xc8, 0xae, 0x43, 0xd1, 0x0e, 0xdc, 0xa5, 0xe3,
+	0xd0, 0xf0, 0xcb, 0x5c, 0xd6, 0xd8, 0x75, 0x28, 0xd8, 0x9f, 0x3d, 0x33, 0x1c, 0x1a, 0x7c, 0xe1,
+	0xd1, 0x25, 0x2e, 0xaa, 0xac, 0x01, 0x45, 0xfb, 0xc3, 0x4f, 0x10, 0x91, 0x93, 0x08, 0x59, 0x27,
+	0xcc, 0x47, 0xbb, 0x88, 0xc9, 0xc7, 0x98, 0x8f, 0x76, 0x23, 0xcc, 0x27, 0x1f, 0x23, 0x06, 0x87,
+	0x9e, 0x23, 0x0c, 0xd5, 0xb1, 0x97, 0x25, 0xf5, 0x82, 0xa3, 0xaf, 0x63, 0x2f, 0xcb, 0xa8, 0x97,
+	0xa5, 0xe8, 0xa5, 0x24, 0x11, 0xb2, 0x4e, 0x18, 0xd1, 0x4b, 0x39, 0xc6, 0xc4, 0xbd, 0x2c, 0x45,
+	0x2f, 0x95, 0x9d, 0xcc, 0xbd, 0x3c, 0x61, 0x44, 0x2f, 0x57, 0x21, 0x6f, 0x22, 0x1c, 0x76, 0x32,
+	0xf7, 0x32, 0x8f, 0x2e, 0xf1, 0xbc, 0x29, 0xa1, 0x01, 0x42, 0xab, 0xb8, 0x3a, 0x08, 0x0d, 0x24,
+	0x74, 0x82, 0xd0, 0x1a, 0xae, 0x06, 0x42, 0x27, 0x12, 0x3a, 0x43, 0x68, 0x7d, 0x27, 0x73, 0x2f,
+	0x8b, 0x50, 0xac, 0xb1, 0x5b, 0x50, 0x32, 0x8d, 0xd0, 0x42, 0xc4, 0x96, 0x9c, 0x72, 0x04, 0x40,
+	0x1c, 0x6e, 0x17, 0xc4, 0x6d, 0xcb, 0x49, 0x47, 0x00, 0xa6, 0x43, 0x15, 0xc9, 0x22, 0xbc, 0x26,
+	0xf1, 0x2a, 0x90, 0xfd, 0x14, 0x6a, 0xa6, 0x35, 0xb5, 0xe7, 0x86, 0x23, 0xe6, 0x74, 0x79, 0x27,
+	0x73, 0xaf, 0xba, 0xbb, 0xfd, 0x80, 0x36, 0x71, 0x8c, 0x79, 0x74, 0x89, 0xa7, 0xc8, 0xd8, 0x67,
+	0x50, 0x97, 0xf5, 0x0f, 0x77, 0x69, 0x61, 0x19, 0xf1, 0x69, 0x29, 0xbe, 0x0f, 0x77, 0x3f, 0x7b,
+	0x74, 0x89, 0xa7, 0x09, 0xd9, 0x5d, 0xa8, 0xc5, 0xfb, 0x1b, 0x19, 0xaf, 0xc8, 0x51, 0xa5, 0xa0,
+	0x38, 0xad, 0x6f, 0x03, 0xcf, 0x45, 0x82, 0xab, 0x72, 0xdd, 0x22, 0x00, 0xdb, 0x01, 0x30, 0xad,
+	0x99, 0xb1, 0x74, 0x42, 0x44, 0x5f, 0x93, 0x0b, 0xa8, 0xc0, 0xd8, 0x1d, 0xa8, 0x2c, 0x17, 0x38,
+	0xcb, 0x27, 0x86, 0xd3, 0xb8, 0x2e, 0x09, 0x12, 0x10, 0x6e, 0x66, 0x3b, 0xd8, 0xb3, 0xdd, 0xc6,
+	0x0d, 0xc4, 0x71, 0x51, 0x61, 0xb7, 0x21, 0x17, 0xf8, 0xd3, 0x46, 0x83, 0x66, 0x02, 0x62, 0x26,
+	0x9d, 0xb3, 0x85, 0xcf, 0x11, 0xbc, 0x57, 0x82, 0x02, 0x6d, 0x6a, 0xfd, 0x36, 0x94, 0x0f, 0x0c,
+	0xdf, 0x98, 0x73, 0x6b, 0xc6, 0x34, 0xc8, 0x2d, 0xbc, 0x40, 0x9e, 0x48, 0x2c, 0xea, 0x3d, 0x28,
+	0x3e, 0x31, 0x7c, 0xc4, 0x31, 0xc8, 0xbb, 0xc6, 0xdc, 0x22, 0x64, 0x85, 0x53, 0x19, 0x4f, 0x41,
+	0x70, 0x1e, 0x84, 0xd6, 0x5c, 0x9e, 0x55, 0x59, 0x43, 0xf8, 0x91, 0xe3, 0x4d, 0xe4, 0x6e, 0x2f,
+	0x73, 0x59, 0xd3, 0xfb, 0x50, 0x6c, 0x79, 0x0e, 0xb6, 0x76, 0x03, 0x4a, 0xbe, 0xe5, 0x8c, 0x93,
+	0xde, 0x8a, 0xbe, 0xe5, 0x1c, 0x78, 0x01, 0x22, 0xa6, 0x9e, 0x40, 0x64, 0x05, 0x62, 0xea, 0x11,
+	0x22, 0xea, 0x3f, 0x97, 0xf4, 0xaf, 0x7f, 0x0e, 0x15, 0x6e, 0x9c, 0xca, 0x26, 0xaf, 0x41, 0x31,
+	0x9c, 0x38, 0x63, 0x29, 0x51, 0xf2, 0xbc, 0x10, 0x4e, 0x9c, 0xae, 0x89, 0x60, 0x6c, 0xd0, 0x36,
+	0xa9, 0xbd, 0x3c, 0x2f, 0x4c, 0x3d, 0xa7, 0x6b, 0xea, 0x23, 0x80, 0x96, 0xe7, 0xfb, 0x2f, 0x3d,
+	0x9c, 0xab, 0x50, 0x30, 0xad, 0x45, 0x78, 0x2c, 0xce, 0x33, 0x17, 0x15, 0xfd, 0x3e, 0x94, 0x71,
+	0x89, 0x7b, 0x76, 0x10, 0xb2, 0x3b, 0x90, 0x77, 0xec, 0x20, 0x6c, 0x64, 0x76, 0x72, 0x2b, 0x1f,
+	0x80, 0xe0, 0xfa, 0x0e, 0x94, 0x1f, 0x1b, 0x67, 0x4f, 0xf0, 0x23, 0xb0, 0xab, 0xf2, 0x6b, 0xc8,
+	0xd5, 0x95, 0x9f, 0xe6, 0x3e, 0xc0, 0xc8, 0xf0, 0x8f, 0xac, 0x90, 0xa4, 0xe5, 0x6d, 0xc8, 0x85,
+	0xe7, 0x0b, 0xa2, 0x88, 0x9b, 0x43, 0x04, 0x47, 0xb0, 0xfe, 0x17, 0x19, 0xa8, 0x0e, 0x97, 0x93,
+	0xef, 0x96, 0x96, 0x7f, 0x8e, 0x33, 0xba, 0x97, 0x50, 0x6f, 0xed, 0x5e, 0x17, 0xd4, 0x0a, 0x3e,
+	0xe1, 0xc4, 0x29, 0xba, 0x9e, 0x69, 0x45, 0x2b, 0x54, 0xe0, 0x45, 0xac, 0x76, 0x4d, 0x14, 0xcf,
+	0xde, 0x42, 0xae, 0x77, 0xd6, 0x5b, 0xb0, 0x1d, 0x28, 0x4c, 0x8f, 0x6d, 0xc7, 0x6c, 0xe4, 0xd5,
+	0x21, 0xd0, 0x8c, 0x04, 0x82, 0xdd, 0x84, 0xb2, 0xef, 0x9d, 0x8e, 0x03, 0xfb, 0xd7, 0x91, 0xb8,
+	0x2d, 0xf9, 0xde, 0xe9, 0xd0, 0xfe, 0xb5, 0xa5, 0x8f, 0xa4, 0xcc, 0x07, 0x28, 0x0e, 0x5b, 0xcd,
+	0x5e, 0x93, 0x6b, 0x97, 0xb0, 0xdc, 0xf9, 0xba, 0x3b, 0x1c, 0x0d, 0xb5, 0x0c, 0xdb, 0x02, 0xe8,
+	0x0f, 0x46, 0x63, 0x59, 0xcf, 0xb2, 0x22, 0x64, 0xbb, 0x7d, 0x2d, 0x87, 0x34, 0x08, 0xef, 0xf6,
+	0xb5, 0x3c, 0x2b, 0x41, 0xae, 0xd9, 0xff, 0x46, 0x2b, 0x50, 0xa1, 0xd7, 0xd3, 0x8a, 0xfa, 0xef,
+	0xb2, 0x50, 0x19, 0x4c, 0xbe, 0xb5, 0xa6, 0x21, 0xce, 0x19, 0xb7, 0xa3, 0xe5, 0x3f, 0xb3, 0x7c,
+	0x9a, 0x76, 0x8e, 0xcb, 0x1a, 0x4e, 0xc4, 0x9c, 0xd0, 0xe4, 0x72, 0x3c, 0x6b, 0x4e, 0x88, 0x6e,
+	0x7a, 0x6c, 0xcd, 0x8d, 0x46, 0x4e, 0xd2, 0x51, 0x0d, 0xb7, 0xbf, 0x37, 0xf9, 0x96, 0xa6, 0x97,
+	0xe3, 0x58, 0x64, 0xaf, 0x41, 0x55, 0xb4, 0x31, 0xa6, 0xbd, 0x57, 0x10, 0x1a, 0x41, 0x80, 0xfa,
+	0x78, 0x02, 0x6e, 0x40, 0xc9, 0x9c, 0x08, 0xa4, 0xd0, 0x24, 0x45, 0x73, 0x42, 0x08, 0xe4, 0xa4,
+	0x56, 0x05, 0x52, 0xea, 0x12, 0x01, 0x22, 0x82, 0x9b, 0x50, 0xf6, 0x26, 0xdf, 0x0a, 0x6c, 0x99,
+	0xb0, 0x25, 0x6f, 0xf2, 0x2d, 0xa1, 0x7e, 0x02, 0x97, 0x83, 0xe5, 0x24, 0x98, 0xfa, 0xf6, 0x22,
+	0xb4, 0x3d, 0x57, 0xd0, 0x54, 0x88, 0x46, 0x53, 0x11, 0x44, 0x7c, 0x17, 0xb6, 0x16, 0xcb, 0xc9,
+	0xd8, 0x98, 0x4e, 0xbd, 0xa5, 0x1b, 0xe2, 0x57, 0x04, 0x5a, 0xf9, 0xda, 0x62, 0x39, 0x69, 0x0a,
+	0x60, 0xd7, 0xd4, 0xff, 0x51, 0x06, 0xb4, 0xa1, 0xc2, 0xfa, 0xd8, 0x0a, 0x8d, 0x8d, 0x47, 0xfa,
+	0x55, 0x00, 0xa5, 0x29, 0xb1, 0x21, 0x2a, 0x46, 0xd4, 0x8e, 0x3a, 0xdf, 0x5c, 0x6a, 0xbe, 0xaf,
+	0x43, 0x2d, 0xe2, 0x23, 0x6c, 0x9e, 0xb0, 0x55, 0x09, 0x8b, 0x66, 0x1c, 0x2c, 0x27, 0xea, 0x4a,
+	0x96, 0x82, 0x25, 0x71, 0xeb, 0xff, 0x2b, 0x03, 0xe5, 0x87, 0x4b, 0x77, 0x8a, 0x43, 0x63, 0x6f,
+	0x40, 0x7e, 0xb6, 0x74, 0xa7, 0x8d, 0x8c, 0x2a, 0xbb, 0xe3, 0xaf, 0xcc, 0x09, 0x89, 0xa7, 0xcb,
+	0xf0, 0x8f, 0xf0, 0x54, 0xae, 0x9d, 0x2e, 0x84, 0xeb, 0xff, 0x58, 0xb6, 0xf8, 0xd0, 0x31, 0x8e,
+	0x58, 0x19, 0xf2, 0xfd, 0x41, 0xbf, 0xa3, 0x5d, 0x62, 0x35, 0x28, 0x77, 0xfb, 0xa3, 0x0e, 0xef,
+	0x37, 0x7b, 0x5a, 0x86, 0x36, 0xe3, 0xa8, 0xb9, 0xd7, 0xeb, 0x68, 0x59, 0xc4, 0x3c, 0x19, 0xf4,
+	0x9a, 0xa3, 0x6e, 0xaf, 0xa3, 0xe5, 0x05, 0x86, 0x77, 0x5b, 0x23, 0xad, 0xcc, 0x34, 0xa8, 0x1d,
+	0xf0, 0x41, 0xfb, 0xb0, 0xd5, 0x19, 0xf7, 0x0f, 0x7b, 0x3d, 0x4d, 0x63, 0x57, 0x60, 0x3b, 0x86,
+	0x0c, 0x04, 0x70, 0x07, 0x59, 0x9e, 0x34, 0x79, 0x93, 0xef, 0x6b, 0xbf, 0x64, 0x65, 0xc8, 0x35,
+	0xf7, 0xf7, 0xb5, 0xdf, 0x64, 0xb0, 0xf4, 0xb4, 0xdb, 0xd7, 0x7e, 0x93, 0x65, 0x5b, 0x50, 0x79,
+	0x3c, 0xe8, 0x0f, 0x46, 0x83, 0x7e, 0xb7, 0xa5, 0xfd, 0x26, 0xaf, 0xff, 0x65, 0x0e, 0xf2, 0x38,
+	0xe0, 0xdf, 0x7f, 0xb0, 0xd9, 0x2b, 0x90, 0x99, 0xd2, 0x77, 0xa8, 0xee, 0x56, 0x05, 0x8e, 0x2c,
+	0x90, 0x47, 0x97, 0x78, 0x06, 0x57, 0x21, 0x23, 0x4e, 0x68, 0x75, 0x77, 0x4b, 0x20, 0x23, 0x59,
+	0x8e, 0xf8, 0x05, 0xbb, 0x0d, 0x99, 0x67, 0xf2, 0xb8, 0xd6, 0x04, 0x5e, 0x48, 0x73, 0xc4, 0x3e,
+	0x63, 0x3b, 0x90, 0x9b, 0x7a, 0xc2, 0xba, 0x88, 0xf1, 0x42, 0x20, 0x3e, 0xba, 0xc4, 0x11, 0xc5,
+	0xde, 0x80, 0x9c, 0x6f, 0x9c, 0x36, 0x8a, 0xea, 0x97, 0x88, 0x25, 0x2e, 0x12, 0xf9, 0xc6, 0x29,
+	0x0e, 0x62, 0xd6, 0x28, 0xa9, 0x83, 0x88, 0x3e, 0x25, 0x76, 0x33, 0x63, 0x6f, 0x42, 0x2e, 0x58,
+	0x4e, 0x68, 0x93, 0x57, 0x77, 0x2f, 0xaf, 0x89, 0x22, 0x6c, 0x26, 0x58, 0x4e, 0xd8, 0x5b, 0x90,
+	0x9f, 0x7a, 0xbe, 0xdf, 0xa8, 0xa8, 0xaa, 0x37, 0x91, 0xd1, 0x68, 0x3e, 0x20, 0x9e, 0xed, 0x40,
+	0x26, 0x6c, 0x80, 0x4a, 0x94, 0x08, 0x49, 0xec, 0x30, 0x64, 0x77, 0xa5, 0xe4, 0xad, 0xaa, 0x63,
+	0x8a, 0xe4, 0x32, 0xb6, 0x83, 0x58, 0xa6, 0x43, 0x6e, 0x6e, 0x9c, 0x35, 0x6a, 0x2a, 0x51, 0x24,
+	0x90, 0x71, 0x4c, 0x73, 0xe3, 0x0c, 0x95, 0x87, 0xb1, 0x3c, 0xc3, 0x93, 0x50, 0x17, 0x62, 0xde,
+	0x58, 0x9e, 0x75, 0x4d, 0x14, 0x14, 0xae, 0xf9, 0x8c, 0xac, 0x97, 0x0c, 0xc7, 0x22, 0x9a, 0xae,
+	0x81, 0xe5, 0x58, 0xd3, 0xd0, 0x7e, 0x66, 0x87, 0xe7, 0x64, 0xbb, 0x64, 0xb8, 0x0a, 0xda, 0x2b,
+	0x42, 0xde, 0x3a, 0x5b, 0xf8, 0xfa, 0x4d, 0xa8, 0xc4, 0xa6, 0x07, 0xab, 0x41, 0xc6, 0x90, 0xc2,
+	0x2a, 0x63, 0xe8, 0xf7, 0x00, 0x24, 0xea, 0xc3, 0xdd, 0xcf, 0xd2, 0x38, 0xac, 0x45, 0x22, 0x2c,
+	0x33, 0xd1, 0x7f, 0x06, 0x35, 0x6e, 0x05, 0x4b, 0x27, 0x6c, 0x79, 0x4e, 0xdb, 0x9a, 0xb1, 0x77,
+	0x01, 0xe2, 0x7a, 0x20, 0x35, 0x4e, 0xf2, 0x41, 0xdb, 0xd6, 0x8c, 0x2b, 0x78, 0xfd, 0x4f, 0x73,
+	0x50, 0x94, 0x8c, 0x89, 0x76, 0xcc, 0x28, 0xda, 0x31, 0x96, 0x0c, 0xd9, 0xb4, 0xb2, 0x3f, 0xb6,
+	0x4d, 0xd3, 0x72, 0x23, 0xa5, 0x2e, 0x6a, 0xec, 0x2e, 0xe4, 0x0c, 0xe7, 0x88, 0x76, 0xd9, 0xd6,
+	0x2e, 0x8b, 0x3a, 0x9d, 0x2f, 0x7c, 0x2b, 0x08, 0xc4, 0x36, 0x36, 0x9c, 0xa3, 0x68, 0x93, 0x17,
+	0x36, 0x6f, 0xf2, 0x9b, 0x50, 0x76, 0xbd, 0x70, 0x4c, 0x06, 0x75, 0x91, 0x5a, 0x2f, 0x49, 0xb3,
+	0x9f, 0xbd, 0x0d, 0x25, 0x69, 0x0a, 0xc9, 0x3d, 0x56, 0x17, 0xcc, 0x6d, 0x01, 0xe4, 0x11, 0x96,
+	0x35, 0x50, 0x55, 0xcf, 0xe7, 0x96, 0x1b, 0x46, 0xf2, 0x54, 0x56, 0xd9, 0x4f, 0xa0, 0xe2, 0xb9,
+	0x63, 0x61, 0x2f, 0x35, 0x2a, 0xea, 0xf7, 0x1e, 0xb8, 0x87, 0x04, 0xe5, 0x65, 0x4f, 0x96, 0x70,
+	0x28, 0x8e, 0x77, 0x3a, 0x9e, 0x1a, 0xbe, 0x90, 0xa4, 0x65, 0x5e, 0x72, 0xbc, 0xd3, 0x96, 0xe1,
+	0x9b, 0x42, 0xbf, 0x7c, 0xe7, 0x2e, 0xe7, 0xf4, 0xe5, 0xeb, 0x5c, 0xd6, 0xd8, 0x6d, 0xa8, 0x4c,
+	0x9d, 0x65, 0x10, 0x5a, 0xfe, 0xde, 0x39, 0x6d, 0xba, 0x32, 0x4f, 0x00, 0x38, 0xae, 0x85, 0x6f,
+	0xcf, 0x0d, 0xff, 0x5c, 0x58, 0xc7, 0x3c, 0xaa, 0xa2, 0xd6, 0x5f, 0x9c, 0xd8, 0xe6, 0x59, 0xb4,
+	0xb9, 0xa8, 0xa2, 0xff, 0xab, 0x0c, 0x94, 0xe4, 0xe4, 0xd8, 0x1d, 0xb1, 0x69, 0xd2, 0xb2, 0x41,
+	0x48, 0x39, 0x84, 0xb3, 0x37, 0xa0, 0xee, 0xf9, 0xf6, 0x91, 0xed, 0x8e, 0x83, 0xd0, 0xb7, 0xdd,
+	0x23, 0xf9, 0xc1, 0x6a, 0x02, 0x38, 0x24, 0x18, 0x8a, 0x66, 0x5c, 0xd8, 0xb1, 0x31, 0xb1, 0x1d,
+	0xdc, 0x9c, 0x39, 0xe9, 0x57, 0x2d, 0x1d, 0xa7, 0x29, 0x40, 0x28, 0xf5, 0x67, 0xb6, 0xe3, 0x8c,
+	0x85, 0x11, 0x82, 0x9f, 0xb2, 0xc6, 0x2b, 0x08, 0x11, 0xe6, 0xc9, 0x5d, 0xd8, 0x3a, 0x36, 0x82,
+	0xb1, 0x42, 0x52, 0xa0, 0x36, 0x6a, 0xc7, 0x46, 0xf0, 0x30, 0xa2, 0xd2, 0x07, 0x50, 0x8e, 0xd6,
+	0xf3, 0x47, 0x19, 0xb8, 0xfe, 0xd7, 0xa0, 0xda, 0x75, 0x4d, 0xeb, 0x6c, 0x40, 0x2a, 0x8b, 0xbd,
+	0x0b, 0x6c, 0xea, 0x5b, 0x46, 0x68, 0x8d, 0xad, 0xb3, 0xd0, 0x37, 0xc6, 0xc2, 0x81, 0x13, 0xfe,
+	0x97, 0x26, 0x30, 0x1d, 0x44, 0x8c, 0x10, 0xae, 0xff, 0xa7, 0x0c, 0xd4, 0x0f, 0xc4, 0x42, 0x7f,
+	0x65, 0x9d, 0xb7, 0x85, 0x05, 0x3b, 0x8d, 0x8e, 0x47, 0x9e, 0x53, 0x99, 0xdd, 0x81, 0xea, 0xe2,
+	0xc4, 0x3a, 0x1f, 0xa7, 0x4c, 0xc4, 0x0a, 0x82, 0x5a, 0x74, 0x10, 0xde, 0x81, 0xa2, 0x47, 0xbd,
+	0x37, 0x72, 0xaa, 0xf8, 0x52, 0x86, 0xc5, 0x25, 0x01, 0xd3, 0xa1, 0x1e, 0x37, 0xa5, 0xaa, 0x40,
+	0xd9, 0x18, 0xa9, 0xc0, 0xab, 0x50, 0x40, 0x54, 0xd0, 0x28, 0xec, 0xe4, 0xd0, 0xce, 0xa3, 0x0a,
+	0xfb, 0x00, 0xea, 0x53, 0x6f, 0xbe, 0x18, 0x47, 0xec, 0x52, 0xde, 0xa6, 0x0f, 0x70, 0x15, 0x49,
+	0x0e, 0x44, 0x5b, 0xfa, 0xdf, 0xcb, 0x41, 0x99, 0xc6, 0x20, 0xcf, 0xb0, 0x6d, 0x9e, 0x45, 0x67,
+	0xb8, 0xc2, 0x0b, 0xb6, 0x89, 0x42, 0xea, 0x55, 0x00, 0x1b, 0x49, 0xc6, 0xca, 0x49, 0xae, 0x10,
+	0x24, 0x1a, 0xca, 0xc2, 0xf0, 0xc3, 0xa0, 0x91, 0x13, 0x43, 0xa1, 0x0a, 0x6e, 0xf1, 0xa5, 0x6b,
+	0x7f, 0x27, 0x37, 0x41, 0x99, 0xcb, 0x1a, 0xbb, 0x07, 0x9a, 0x68, 0x8c, 0x16, 0x5d, 0xd5, 0xe1,
+	0x5b, 0x04, 0xa7, 0x35, 0x8f, 0x0c, 0x1f, 0x41, 0x63, 0x9d, 0xa1, 0x0c, 0x16, 0xa7, 0x19, 0x08,
+	0xd4, 0x41, 0x88, 0x7a, 0x4e, 0x4b, 0xe9, 0x73, 0xda, 0x80, 0xd2, 0x33, 0x3b, 0xb0, 0xf1, 0xab,
+	0x96, 0xc5, 0x49, 0x91, 0x55, 0xe5, 0x33, 0x54, 0x9e, 0xf7, 0x19, 0xe2, 0x69, 0x1b, 0xce, 0x91,
+	0xd7, 0x00, 0x65, 0xda, 0x4d, 0xe7, 0xc8, 0x63, 0xf7, 0xe1, 0x72, 0x82, 0x1e, 0x2f, 0x50, 0x5b,
+	0x06, 0xc2, 0x97, 0xe5, 0xdb, 0x31, 0x15, 0x29, 0xd1, 0x80, 0xbd, 0x13, 0x35, 0x45, 0x5b, 0xb9,
+	0xb6, 0xb6, 0x95, 0x45, 0xb3, 0x58, 0xd4, 0xff, 0x5d, 0x16, 0xea, 0x0f, 0x3d, 0xdf, 0xb2, 0x8f,
+	0xdc, 0x64, 0xb7, 0xad, 0x19, 0x57, 0xd1, 0x0e, 0xcc, 0x2a, 0x3b, 0xf0, 0x35, 0xa8, 0xce, 0x04,
+	0xe3, 0x38, 0x9c, 0x08, 0x87, 0x29, 0xcf, 0x41, 0x82, 0x46, 0x13, 0x07, 0x8f, 0x6f, 0x44, 0x40,
+	0xcc, 0x79, 0x62, 0x8e, 0x98, 0x50, 0xa0, 0xb3, 0x2f, 0x48, 0xc0, 0x99, 0x96, 0x63, 0x85, 0xe2,
+	0xb3, 0x6c, 0xed, 0xbe, 0x2a, 0x35, 0xb1, 0x3a, 0xa6, 0x07, 0xdc, 0x9a, 0x35, 0x49, 0x31, 0xa3,
+	0xbc, 0x6b, 0x13, 0x39, 0xfb, 0x42, 0x15, 0x8e, 0xc5, 0x17, 0xe4, 0x15, 0xa7, 0x5c, 0x1f, 0x41,
+	0x25, 0x06, 0xa3, 0x01, 0xc5, 0x3b, 0xd2, 0x68, 0xba, 0xc4, 0xaa, 0x50, 0x6a, 0x35, 0x87, 0xad,
+	0x66, 0xbb, 0xa3, 0x65, 0x10, 0x35, 0xec, 0x8c, 0x84, 0xa1, 0x94, 0x65, 0xdb, 0x50, 0xc5, 0x5a,
+	0xbb, 0xf3, 0xb0, 0x79, 0xd8, 0x1b, 0x69, 0x39, 0x56, 0x87, 0x4a, 0x7f, 0x30, 0x6e, 0xb6, 0x46,
+	0xdd, 0x41, 0x5f, 0xcb, 0xeb, 0xbf, 0x84, 0x72, 0xeb, 0xd8, 0x9a, 0x9e, 0x5c, 0xb4, 0x8a, 0xe4,
+	0x87, 0x58, 0xd3, 0x93, 0x46, 0x76, 0xed, 0x8b, 0x08, 0x84, 0xde, 0x86, 0x5a, 0x2b, 0x92, 0xbf,
+	0xd8, 0xca, 0x4e, 0xb4, 0xd7, 0xd7, 0x7d, 0x31, 0x81, 0xd8, 0xa4, 0xf0, 0xf4, 0x9f, 0x42, 0xf5,
+	0xc0, 0xf7, 0x16, 0x96, 0x1f, 0x52, 0x23, 0x1a, 0xe4, 0x4e, 0xac, 0x73, 0x39, 0x12, 0x2c, 0x26,
+	0x5e, 0x5b, 0x56, 0xf5, 0xda, 0x76, 0xa1, 0x1c, 0xb1, 0xbd, 0x30, 0xcf, 0x2f, 0xa0, 0x2e, 0x79,
+	0x6c, 0x2b, 0xc0, 0xce, 0x1e, 0x00, 0x2c, 0x62, 0x80, 0x1c, 0x76, 0x64, 0xe1, 0xc9, 0xc6, 0xb9,
+	0x42, 0xa1, 0xff, 0x45, 0x0e, 0xb6, 0x0e, 0x0c, 0x3f, 0xb4, 0xf1, 0x53, 0x88, 0x49, 0xbf, 0x0d,
+	0xf9, 0xf0, 0x7c, 0x61, 0x49, 0x17, 0xf0, 0x4a, 0x6c, 0x1e, 0x0a, 0x1a, 0xd2, 0xbd, 0x44, 0xc0,
+	0xbe, 0x80, 0xad, 0x45, 0x04, 0x16, 0x5b, 0x5d, 0x2c, 0xec, 0x2a, 0x0b, 0xad, 0x57, 0x7d, 0xa1,
+	0x56, 0xd9, 0xcf, 0xe1, 0x6a, 0x9a, 0xd7, 0x0a, 0x82, 0x44, 0x5a, 0xaa, 0x0b, 0x7d, 0x25, 0xc5,
+	0x28, 0xc8, 0x58, 0x0b, 0x2e, 0x27, 0xec, 0x53, 0xcf, 0x59, 0xce, 0xdd, 0x40, 0xda, 0xab, 0xd7,
+	0x57, 0x7a, 0x6f, 0x09, 0x2c, 0xd7, 0x16, 0x2b, 0x10, 0xa6, 0x43, 0x2d, 0x86, 0xf5, 0x97, 0x73,
+	0x3a, 0x00, 0x79, 0x9e, 0x82, 0xb1, 0x8f, 0x00, 0xe2, 0x7a, 0xd0, 0x28, 0xee, 0xe4, 0x36, 0xcc,
+	0xaf, 0x1b, 0x5a, 0x73, 0xae, 0x90, 0xa1, 0x5e, 0x47, 0x29, 0xe1, 0xdb, 0xe1, 0xf1, 0x9c, 0x64,
+	0x55, 0x8e, 0x27, 0x00, 0x12, 0x89, 0xc1, 0x18, 0x3d, 0x9a, 0x98, 0x45, 0x8a, 0xad, 0x2d, 0x3b,
+	0x18, 0x2e, 0x27, 0x71, 0xbb, 0xa8, 0xec, 0x92, 0x59, 0xce, 0x83, 0x23, 0xe9, 0xcb, 0x25, 0x23,
+	0x7c, 0x1c, 0x1c, 0xb1, 0x5d, 0xb8, 0x96, 0x10, 0x25, 0x52, 0x36, 0x68, 0x00, 0xc9, 0xe7, 0x64,
+	0xf9, 0x62, 0x51, 0x1b, 0xe8, 0x5f, 0x42, 0x3d, 0xf5, 0x75, 0x9e, 0xab, 0x76, 0x6f, 0x42, 0x19,
+	0xff, 0xa3, 0xd2, 0x95, 0x1b, 0xb0, 0x84, 0xf5, 0x61, 0xe8, 0xeb, 0x16, 0x68, 0xab, 0x6b, 0xcd,
+	0xee, 0x52, 0xf4, 0x03, 0x8b, 0x1b, 0x4e, 0x4e, 0x84, 0x42, 0x77, 0x75, 0xfd, 0x23, 0x66, 0x69,
+	0xd4, 0x6b, 0x1f, 0x4b, 0xff, 0x27, 0x59, 0xa8, 0xa7, 0x56, 0x9c, 0xbd, 0xa9, 0x6e, 0x3f, 0xe5,
+	0xb0, 0x27, 0x6b, 0x46, 0x7a, 0xe5, 0x1d, 0xd0, 0x3c, 0xdf, 0xb4, 0x5d, 0x83, 0xa2, 0x31, 0x62,
+	0xb9, 0xb3, 0x64, 0x86, 0x6d, 0x4b, 0xf8, 0x81, 0x04, 0xa3, 0x31, 0x6e, 0x5a, 0xb1, 0xab, 0x2b,
+	0x1d, 0x55, 0x15, 0xa4, 0xea, 0xa0, 0x7c, 0x5a, 0x07, 0xbd, 0x0d, 0x15, 0xc7, 0x0a, 0x82, 0x71,
+	0x78, 0x6c, 0xb8, 0x8d, 0xc2, 0xda, 0xa4, 0xcb, 0x88, 0x1c, 0x1d, 0x1b, 0x2e, 0x12, 0xda, 0xee,
+	0x58, 0x86, 0x8a, 0x8b, 0xeb, 0x84, 0xb6, 0x4b, 0x56, 0x11, 0x6a, 0xf7, 0xab, 0x9b, 0x3e, 0xac,
+	0x54, 0x7e, 0x6c, 0xfd, 0xbb, 0xea, 0xaf, 0x42, 0xe9, 0x89, 0x6d, 0x9d, 0x4a, 0xf9, 0xf7, 0xcc,
+	0xb6, 0x4e, 0x23, 0xf9, 0x87, 0x65, 0xfd, 0xaf, 0x4a, 0x50, 0x26, 0xe2, 0xf6, 0xc5, 0x51, 0xaf,
+	0x1f, 0x62, 0xc0, 0xef, 0x40, 0x3e, 0x56, 0x2c, 0xab, 0x56, 0x07, 0x61, 0x50, 0xa7, 0x8a, 0x81,
+	0x93, 0x40, 0x11, 0x7a, 0xbf, 0x42, 0x10, 0x19, 0x99, 0xaa, 0x08, 0xf3, 0x2b, 0xf8, 0xce, 0x91,
+	0x61, 0x90, 0x04, 0xc0, 0x1e, 0x40, 0x19, 0x47, 0x48, 0x2e, 0x7d, 0x49, 0x15, 0x2c, 0x34, 0x87,
+	0xc8, 0x55, 0xe4, 0xa5, 0x70, 0xe2, 0x60, 0x85, 0xac, 0x00, 0xcb, 0x0f, 0xa2, 0xe3, 0x54, 0xe7,
+	0x51, 0x15, 0x25, 0x1a, 0x9a, 0x48, 0x8d, 0xaa, 0xda, 0x4a, 0xca, 0xc6, 0xe3, 0x44, 0xc0, 0xee,
+	0x41, 0x89, 0x54, 0xb3, 0x15, 0x34, 0x6a, 0xaa, 0xe8, 0x8c, 0x4c, 0x26, 0x1e, 0xa1, 0xd9, 0x3b,
+	0x50, 0x98, 0x9d, 0x58, 0xe7, 0x41, 0xa3, 0xae, 0x8a, 0x84, 0x94, 0xe6, 0xe3, 0x82, 0x02, 0x8d,
+	0x60, 0xdf, 0x9a, 0x8d, 0x29, 0xd2, 0x85, 0xaa, 0x3a, 0x68, 0x6c, 0x91, 0x26, 0xae, 0xf9, 0xd6,
+	0xac, 0x85, 0xc0, 0xd1, 0xc4, 0x09, 0xd8, 0x5b, 0x50, 0x24, 0x1d, 0x14, 0x34, 0xb6, 0xd5, 0x9e,
+	0x23, 0x85, 0xc6, 0x25, 0x96, 0xed, 0x42, 0x25, 0x11, 0x1b, 0xd7, 0x68, 0x42, 0x57, 0x57, 0xe4,
+	0x11, 0x89, 0x71, 0x9e, 0x90, 0xb1, 0x0f, 0x01, 0xa4, 0x5b, 0x31, 0x9e, 0x9c, 0x53, 0x20, 0xb8,
+	0x1a, 0x3b, 0x5c, 0x8a, 0xba, 0x53, 0x9d, 0x8f, 0xb7, 0xa1, 0x80, 0x5a, 0x22, 0x68, 0xdc, 0xd8,
+	0xc9, 0x25, 0x76, 0x93, 0xa2, 0xd6, 0xb8, 0xc0, 0xb3, 0x7b, 0x50, 0xc6, 0xcd, 0x35, 0xc6, 0x4f,
+	0xd8, 0x50, 0xfd, 0x2c, 0xb9, 0x13, 0xd1, 0x16, 0xb3, 0x4e, 0x87, 0xdf, 0x39, 0xec, 0x3e, 0xe4,
+	0x4d, 0x6b, 0x16, 0x34, 0x6e, 0xee, 0xe4, 0x12, 0x31, 0x1d, 0xed, 0x47, 0x74, 0xcb, 0x84, 0x6a,
+	0x41, 0x1a, 0xf6, 0x08, 0xb6, 0x70, 0xeb, 0xed, 0x92, 0x79, 0x8d, 0x4b, 0xde, 0xb8, 0x45, 0x5c,
+	0xaf, 0xaf, 0x70, 0xf5, 0x25, 0x11, 0x7d, 0xa0, 0x8e, 0x1b, 0xfa, 0xe7, 0xbc, 0xee, 0xaa, 0x30,
+	0x76, 0x0b, 0xca, 0x76, 0xd0, 0xf3, 0xa6, 0x27, 0x96, 0xd9, 0x78, 0x45, 0x5c, 0xfc, 0x44, 0x75,
+	0xf6, 0x39, 0xd4, 0x69, 0x33, 0x62, 0x15, 0x3b, 0x6f, 0xdc, 0x56, 0x55, 0xde, 0x48, 0x45, 0xf1,
+	0x34, 0x25, 0x1a, 0x57, 0x76, 0x30, 0x0e, 0xad, 0xf9, 0xc2, 0xf3, 0xd1, 0x43, 0x7b, 0x55, 0xf8,
+	0x46, 0x76, 0x30, 0x8a, 0x40, 0xb7, 0xf6, 0xc9, 0x1d, 0x23, 0xea, 0x9f, 0xae, 0x68, 0xe5, 0xd4,
+	0x36, 0x54, 0xd4, 0x37, 0xc6, 0xef, 0x13, 0xc2, 0xbd, 0x02, 0xe4, 0x4c, 0x6b, 0x76, 0xeb, 0x97,
+	0xc0, 0xd6, 0xe7, 0xf9, 0x3c, 0x13, 0xa1, 0x20, 0x4d, 0x84, 0x2f, 0xb2, 0x9f, 0x65, 0xf4, 0xcf,
+	0xa1, 0x9e, 0x3a, 0x34, 0x1b, 0xcd, 0x23, 0x61, 0xd8, 0x1b, 0x22, 0x26, 0x5f, 0xe3, 0xa2, 0xa2,
+	0xff, 0xfb, 0x0c, 0x14, 0x86, 0xa1, 0x11, 0x06, 0x78, 0x87, 0x36, 0x71, 0xbc, 0xe9, 0xc9, 0x18,
+	0x1d, 0x59, 0x11, 0xed, 0x2e, 0x13, 0x00, 0xf5, 0x24, 0x59, 0xa8, 0x41, 0x48, 0xbc, 0x19, 0x4e,
+	0x65, 0x94, 0x1b, 0xde, 0x32, 0x9c, 0xba, 0x21, 0xc9, 0x8d, 0x0c, 0x97, 0x35, 0x3c, 0xa8, 0xbe,
+	0x77, 0x4a, 0xc1, 0xde, 0x3c, 0x21, 0xa2, 0x2a, 0xae, 0xea, 0xb1, 0x11, 0x1c, 0xcf, 0x8d, 0x45,
+	0x12, 0x0b, 0xce, 0xf0, 0xaa, 0x84, 0x61, 0x3c, 0x18, 0x47, 0x21, 0x44, 0x0a, 0xb6, 0x5b, 0x24,
+	0x7c, 0x99, 0x00, 0x2d, 0x37, 0x5c, 0x8d, 0xa6, 0x94, 0xd6, 0xa2, 0x29, 0xfa, 0x3b, 0x50, 0x42,
+	0x09, 0x65, 0x84, 0x06, 0xea, 0x3c, 0xd3, 0x08, 0x8d, 0x4d, 0x71, 0x76, 0x84, 0xeb, 0xef, 0x03,
+	0x70, 0xef, 0x34, 0xb0, 0x42, 0xa2, 0x7e, 0x5d, 0x71, 0x02, 0xe3, 0x3d, 0x2e, 0x9b, 0x12, 0xd2,
+	0x4e, 0xff, 0xcf, 0x19, 0xa8, 0x0e, 0x7c, 0x13, 0xcf, 0xcf, 0x70, 0x61, 0x4d, 0x9f, 0xab, 0x54,
+	0x51, 0xfc, 0x79, 0x8e, 0x63, 0xc4, 0x2a, 0xa9, 0xc2, 0x13, 0x00, 0xfb, 0x10, 0xf2, 0x33, 0xc7,
+	0x38, 0x6a, 0xe4, 0x54, 0xd3, 0x5a, 0x69, 0x3e, 0x2a, 0x63, 0xa0, 0x92, 0x13, 0xa9, 0xfe, 0xc7,
+	0x50, 0x55, 0x80, 0xa9, 0x98, 0xe5, 0x25, 0x8a, 0x7d, 0x0f, 0x5b, 0x1a, 0x46, 0x16, 0xf3, 0xed,
+	0xce, 0xb0, 0x25, 0x0c, 0x6a, 0x34, 0xad, 0x87, 0xe3, 0x87, 0x5d, 0x3e, 0x1c, 0x69, 0x79, 0x0a,
+	0xa6, 0x13, 0xa0, 0xd7, 0x1c, 0x62, 0x04, 0x13, 0xa0, 0x78, 0xd8, 0xef, 0xfe, 0xea, 0xb0, 0xa3,
+	0x69, 0xfa, 0xff, 0xcc, 0x00, 0x3c, 0xb5, 0x5d, 0xd3, 0x3b, 0xa5, 0xc9, 0xbd, 0xa7, 0x18, 0x4f,
+	0x28, 0x55, 0xd6, 0x57, 0xb1, 0xba, 0x48, 0x04, 0x12, 0x7b, 0x17, 0xca, 0x1e, 0x0e, 0x0d, 0x49,
+	0xb3, 0xaa, 0x48, 0x51, 0x66, 0xc4, 0x4b, 0x9e, 0xa8, 0xe0, 0x6e, 0x72, 0x2c, 0xc3, 0x94, 0x77,
+	0x24, 0x54, 0xc6, 0xfd, 0x8e, 0xcb, 0x21, 0xee, 0x68, 0xb1, 0xc8, 0x7e, 0x02, 0xd5, 0x53, 0x1a,
+	0x90, 0xd0, 0x11, 0x85, 0xb5, 0x65, 0x06, 0x81, 0x26, 0xed, 0xf0, 0x36, 0x14, 0x66, 0x7e, 0x14,
+	0x6e, 0x8f, 0x7b, 0x7f, 0x88, 0xa0, 0x96, 0x63, 0x2c, 0x03, 0x8b, 0x0b, 0xbc, 0xfe, 0xe7, 0x19,
+	0x00, 0x02, 0xef, 0x79, 0x4b, 0xd7, 0x64, 0x0f, 0x52, 0xd6, 0xf0, 0x2d, 0x85, 0x8d, 0xf0, 0x0f,
+	0xe8, 0xaf, 0x62, 0x14, 0xdf, 0x86, 0x5c, 0x74, 0x8d, 0xbb, 0x72, 0x7b, 0xf6, 0xcc, 0x70, 0x74,
+	0x07, 0x2a, 0x31, 0x03, 0xbb, 0x01, 0x57, 0x0e, 0xfb, 0x7b, 0x83, 0xc3, 0x7e, 0xbb, 0xd3, 0x1e,
+	0x1f, 0xf0, 0x4e, 0xab, 0xd3, 0xee, 0xf6, 0xf7, 0xb5, 0x4b, 0xe8, 0xd7, 0x24, 0xd5, 0x0c, 0x7e,
+	0xa6, 0xd6, 0x21, 0xe7, 0x9d, 0xfe, 0x68, 0xcc, 0x07, 0x4f, 0xb5, 0x2c, 0xe2, 0x1f, 0x0e, 0x7a,
+	0xbd, 0xc1, 0x53, 0xc4, 0xe7, 0xd2, 0xed, 0x24, 0x88, 0xbc, 0xfe, 0x2f, 0x32, 0x50, 0x55, 0x66,
+	0xc8, 0xde, 0x4f, 0xcd, 0xe5, 0x95, 0xb5, 0x25, 0x10, 0x65, 0x65, 0x32, 0x6f, 0x41, 0x21, 0x08,
+	0x0d, 0x3f, 0x6c, 0x64, 0xd5, 0xb0, 0x69, 0x32, 0x7b, 0x2e, 0xd0, 0x18, 0x12, 0xb5, 0x5c, 0xb3,
+	0x91, 0xbb, 0x80, 0x0a, 0x91, 0xfa, 0x0e, 0x54, 0xe2, 0xe6, 0x71, 0x0f, 0xf2, 0xc1, 0xd3, 0xa1,
+	0x76, 0x89, 0x55, 0xa0, 0xc0, 0x9b, 0xfd, 0xfd, 0x8e, 0x96, 0xd1, 0x7f, 0x9b, 0x87, 0x4a, 0xd7,
+	0x0d, 0x2c, 0x3f, 0x6c, 0x85, 0x67, 0xec, 0x75, 0xc8, 0xf9, 0xd6, 0xec, 0xa2, 0x60, 0x3e, 0xe2,
+	0x30, 0x3e, 0x27, 0x64, 0x81, 0x69, 0xcd, 0xe4, 0x10, 0xb7, 0xd2, 0x0a, 0x42, 0xca, 0x86, 0x36,
+	0x5d, 0x6c, 0x69, 0xe8, 0xeb, 0x2e, 0x17, 0x8e, 0x3d, 0xc5, 0x58, 0x10, 0xc6, 0xcf, 0x30, 0x84,
+	0x51, 0xe0, 0x5b, 0x9e, 0xdb, 0x8e, 0xc0, 0x5d, 0xf3, 0x8c, 0x1d, 0xc0, 0xe5, 0x14, 0x25, 0x1d,
+	0x62, 0x61, 0xe4, 0xdc, 0x8d, 0xec, 0x01, 0x39, 0xca, 0x07, 0x83, 0x84, 0x15, 0xbf, 0xb2, 0x50,
+	0x41, 0xdb, 0x5e, 0x1a, 0x4a, 0x76, 0x85, 0x79, 0x36, 0xc6, 0xf9, 0x08, 0xd3, 0x70, 0x6d, 0x3e,
+	0x18, 0x89, 0x91, 0x17, 0x8a, 0x22, 0x26, 0x73, 0x46, 0xb6, 0x61, 0x81, 0x10, 0x38, 0xa8, 0x9f,
+	0x93, 0x23, 0x62, 0xd1, 0xf5, 0xca, 0x59, 0xa3, 0x44, 0xad, 0xdc, 0x59, 0x1d, 0xcd, 0x01, 0x51,
+	0x74, 0x4d, 0xa9, 0x0a, 0x2b, 0x8b, 0xa8, 0xce, 0x3e, 0x85, 0x7a, 0x64, 0x02, 0x88, 0xf0, 0x57,
+	0x79, 0x83, 0x15, 0x40, 0xab, 0xc6, 0x6b, 0x53, 0xa5, 0x76, 0xab, 0x0f, 0x57, 0x37, 0xcd, 0x71,
+	0x83, 0xfa, 0xd9, 0x51, 0xd5, 0xcf, 0x8a, 0xb3, 0x1c, 0xab, 0xa2, 0x5b, 0x3f, 0x23, 0x7f, 0x53,
+	0x19, 0xe5, 0x0f, 0x52, 0x64, 0x7f, 0x55, 0x84, 0x8a, 0x88, 0x21, 0xa4, 0xb6, 0x48, 0xee, 0xc2,
+	0x2d, 0x72, 0x07, 0x72, 0xb8, 0x5e, 0x59, 0xd5, 0x44, 0xed, 0x9a, 0x18, 0xcf, 0xe7, 0x88, 0x60,
+	0xef, 0xca, 0x2d, 0xd4, 0x46, 0xcb, 0x24, 0xa7, 0x5a, 0x5e, 0xf1, 0x16, 0x4a, 0x08, 0xd0, 0xbb,
+	0x16, 0x01, 0x0f, 0x8a, 0xb6, 0xe5, 0xd5, 0x7e, 0x5b, 0x74, 0xbd, 0xfb, 0xd8, 0x58, 0x44, 0x17,
+	0xec, 0x2d, 0xcf, 0xf9, 0x31, 0xbe, 0xfb, 0xa7, 0xb0, 0xed, 0xb9, 0x63, 0xdf, 0xc2, 0x70, 0xe7,
+	0x34, 0xa4, 0xa6, 0x4a, 0x9b, 0x9b, 0xaa, 0x7b, 0x2e, 0x97, 0x64, 0xd8, 0xe2, 0x5b, 0x69, 0x46,
+	0x6c, 0xb9, 0x4c, 0x2d, 0x2b, 0x74, 0xd8, 0xc1, 0x4f, 0x61, 0x0b, 0xdd, 0x2f, 0x23, 0x98, 0x1a,
+	0xa6, 0x45, 0xed, 0x57, 0x36, 0xb7, 0x5f, 0xf3, 0xdc, 0x96, 0xa0, 0xc2, 0xe6, 0x77, 0x53, 0x6c,
+	0xd8, 0x3a, 0x6c, 0x58, 0xe3, 0x84, 0x07, 0xbb, 0xfa, 0x38, 0xc5, 0x83, 0x87, 0xb6, 0xba, 0x71,
+	0xc5, 0x13, 0x2e, 0x3c, 0xb8, 0x7b, 0x70, 0x4d, 0xe1, 0x52, 0xd6, 0xbf, 0xb6, 0x79, 0xfd, 0x59,
+	0xcc, 0x7d, 0x18, 0x7f, 0x88, 0xf7, 0x00, 0x3c, 0x77, 0x1c, 0x58, 0x62, 0x01, 0xeb, 0x9b, 0x27,
+	0x58, 0xf6, 0xdc, 0xa1, 0x85, 0x25, 0x76, 0x3f, 0x26, 0xc7, 0x89, 0x6d, 0x6d, 0x98, 0x98, 0xa0,
+	0xed, 0xd2, 0x0e, 0x8a, 0x68, 0x71, 0x42, 0xdb, 0x1b, 0x27, 0x24, 0xa8, 0x71, 0x32, 0x5f, 0xc0,
+	0x65, 0x49, 0xad, 0x4c, 0x44, 0xdb, 0x3c, 0x91, 0x2d, 0xe2, 0x4a, 0x26, 0xf1, 0x20, 0x25, 0x02,
+	0x2e, 0x5f, 0xb0, 0xfb, 0x92, 0x33, 0xff, 0x1e, 0xc0, 0xe2, 0x64, 0x1c, 0x6d, 0x40, 0x76, 0xc1,
+	0xa4, 0x17, 0x27, 0x5d, 0xb1, 0x05, 0x6f, 0xc7, 0xe4, 0xd8, 0xfc, 0x15, 0xda, 0x2b, 0x02, 0xdb,
+	0x35, 0xcf, 0xf4, 0xdf, 0xe5, 0xa1, 0xda, 0x74, 0x0d, 0xe7, 0xfc, 0xd7, 0x56, 0xd7, 0x9d, 0x79,
+	0x22, 0x5c, 0xba, 0x58, 0x86, 0x63, 0xb4, 0xdd, 0xe4, 0x75, 0x53, 0x85, 0x20, 0x68, 0x34, 0x61,
+	0x74, 0xd2, 0x5b, 0x86, 0x31, 0x5e, 0x5c, 0x40, 0x81, 0x00, 0x11, 0x41, 0xcc, 0x4f, 0x86, 0x5e,
+	0x4e, 0xe1, 0x27, 0x33, 0x2f, 0xe1, 0x8f, 0xed, 0xc4, 0x98, 0x9f, 0x08, 0xde, 0x80, 0x3a, 0x66,
+	0xca, 0x8c, 0xa7, 0x9e, 0x1b, 0x2c, 0xe7, 0x96, 0x29, 0x72, 0x9d, 0x44, 0xfa, 0x4c, 0x4b, 0xc2,
+	0xb0, 0x95, 0xb9, 0x35, 0xf7, 0xfc, 0x73, 0xd1, 0x4a, 0x51, 0xb4, 0x22, 0x40, 0xd4, 0xca, 0xbb,
+	0xc0, 0x4e, 0x0d, 0x3b, 0x1c, 0xa7, 0x9b, 0x12, 0x21, 0x1b, 0x0d, 0x31, 0x23, 0xb5, 0xb9, 0xeb,
+	0x50, 0x34, 0xed, 0xe0, 0xa4, 0x3b, 0x20, 0xe9, 0x99, 0xe3, 0xb2, 0x86, 0x36, 0x69, 0xf0, 0x51,
+	0x77, 0x30, 0x9e, 0x9c, 0xcb, 0x7b, 0xa2, 0x1c, 0x2f, 0x23, 0x60, 0xef, 0x3c, 0xa4, 0x08, 0x38,
+	0x21, 0xc5, 0x6c, 0xe9, 0x56, 0x9b, 0xa2, 0xcb, 0x39, 0xbe, 0x85, 0xf0, 0x2e, 0x82, 0x5b, 0x08,
+	0xc5, 0x10, 0x33, 0x51, 0xca, 0x89, 0x0b, 0xd2, 0x2a, 0x91, 0x6e, 0x23, 0x62, 0xb0, 0x0c, 0x63,
+	0xda, 0xdb, 0x50, 0x71, 0xad, 0xf0, 0xd4, 0xf3, 0x71, 0x34, 0x35, 0xb1, 0x7a, 0x31, 0x00, 0x9d,
+	0x9e, 0x60, 0x6a, 0xb8, 0x38, 0xf8, 0x46, 0x5d, 0x8e, 0x47, 0xd6, 0x31, 0x57, 0xcd, 0x26, 0x85,
+	0x41, 0xd8, 0x2d, 0xb1, 0x24, 0x09, 0x84, 0x12, 0x10, 0x16, 0x78, 0x61, 0x23, 0xfa, 0xdf, 0x16,
+	0x04, 0x04, 0x12, 0x5d, 0xbf, 0x0a, 0xa2, 0x26, 0xd6, 0x54, 0x13, 0x7d, 0x13, 0x84, 0x12, 0x36,
+	0x7e, 0xc7, 0x20, 0xdf, 0xf7, 0x4c, 0x8b, 0x7d, 0x00, 0x15, 0xca, 0x0f, 0x59, 0x0f, 0x26, 0x22,
+	0x9a, 0xfe, 0x90, 0xa9, 0x51, 0x76, 0x65, 0xe9, 0xe2, 0x8c, 0x92, 0xd7, 0xc9, 0x0e, 0xa1, 0x3b,
+	0x07, 0xe5, 0x3e, 0x9b, 0xdc, 0x12, 0x2e, 0x30, 0x38, 0x65, 0xf2, 0xb0, 0x7d, 0xcb, 0x25, 0xc1,
+	0x5c, 0xe0, 0x71, 0x9d, 0x6c, 0x55, 0xdf, 0xc3, 0x1d, 0x3f, 0xa6, 0xfb, 0xdd, 0xc2, 0x06, 0x5b,
+	0x55, 0xe0, 0x29, 0x01, 0xe7, 0x03, 0xa8, 0x7c, 0xeb, 0xd9, 0xae, 0x18, 0x78, 0x71, 0x6d, 0xe0,
+	0x5f, 0x7a, 0xb6, 0x88, 0x82, 0x96, 0xbf, 0x95, 0x25, 0xf6, 0x06, 0x94, 0x3c, 0x57, 0xb4, 0x5d,
+	0x5a, 0x6b, 0xbb, 0xe8, 0xb9, 0x3d, 0x71, 0x6f, 0x5c, 0x9f, 0x2c, 0x31, 0x06, 0x80, 0xa4, 0xd6,
+	0x2c, 0x94, 0x41, 0xbf, 0x2a, 0x01, 0x07, 0x6e, 0xcf, 0x9a, 0xe1, 0x8d, 0x63, 0x75, 0x66, 0x3b,
+	0xa8, 0xa5, 0xa9, 0xb1, 0xca, 0x5a, 0x63, 0x20, 0xd0, 0xd4, 0xe0, 0x9b, 0x50, 0x3e, 0xf2, 0xbd,
+	0xe5, 0x02, 0x6d, 0x6a, 0x58, 0xa3, 0x2c, 0x11, 0x6e, 0xef, 0x1c, 0x67, 0x4f, 0x45, 0xdb, 0x3d,
+	0x42, 0xc1, 0xd3, 0xa8, 0xae, 0x91, 0x56, 0x23, 0xfc, 0xd0, 0xa2, 0x56, 0x8d, 0xa3, 0x23, 0xd1,
+	0x7f, 0x6d, 0xbd, 0x55, 0xe3, 0xe8, 0x88, 0x3a, 0xff, 0x09, 0x94, 0x4f, 0xf1, 0x16, 0x6e, 0x61,
+	0x4d, 0x1b, 0x75, 0xd5, 0xee, 0x4b, 0x7c, 0x04, 0x5e, 0x3a, 0xb5, 0x5d, 0x2c, 0xa4, 0xac, 0xff,
+	0xad, 0xe7, 0x5a, 0xff, 0x3b, 0x50, 0x70, 0xec, 0xb9, 0x2d, 0xf6, 0xde, 0x8a, 0x21, 0x41, 0x08,
+	0xa6, 0x43, 0xd1, 0x9b, 0xcd, 0x70, 0x32, 0xda, 0x1a, 0x89, 0xc4, 0xa8, 0xba, 0x3a, 0x3c, 0x4b,
+	0xe7, 0xf3, 0xc5, 0x16, 0x44, 0xac, 0xab, 0xc3, 0xb3, 0xb4, 0x31, 0xc9, 0x9e, 0x63, 0x4c, 0xee,
+	0x42, 0x3d, 0x26, 0x1e, 0x3f, 0xb3, 0xa6, 0x24, 0x2e, 0xd7, 0x19, 0xaa, 0x11, 0xc3, 0x13, 0x6b,
+	0x8a, 0xc6, 0x00, 0x26, 0xee, 0xa0, 0x2c, 0xbe, 0xba, 0xd9, 0xa8, 0x2d, 0x7a, 0x93, 0x6f, 0x51,
+	0x12, 0x7f, 0x08, 0x55, 0x9f, 0x3c, 0xcf, 0x31, 0x39, 0xa8, 0xd7, 0xd4, 0xe5, 0x4d, 0x5c, 0x52,
+	0x0e, 0x7e, 0x5c, 0x46, 0x71, 0x28, 0x2e, 0x37, 0xc5, 0x6d, 0x56, 0x40, 0x51, 0x9e, 0x0a, 0xaf,
+	0x11, 0x50, 0xdc, 0x74, 0x91, 0xf9, 0x22, 0xee, 0x7a, 0x68, 0x49, 0x6e, 0xa8, 0x83, 0x10, 0x97,
+	0x3a, 0xb4, 0x24, 0x66, 0x54, 0x44, 0x77, 0x7c, 0x62, 0xbb, 0x26, 0x6e, 0x9c, 0xd0, 0x38, 0x0a,
+	0x1a, 0x0d, 0x3a, 0x57, 0x55, 0x09, 0x1b, 0x19, 0x47, 0x01, 0xfb, 0x18, 0x6a, 0x86, 0xd0, 0x0a,
+	0x63, 0xdb, 0x9d, 0x79, 0x8d, 0x9b, 0xaa, 0x77, 0xa5, 0xe8, 0x0b, 0x5e, 0x35, 0x92, 0x0a, 0xfb,
+	0x14, 0x58, 0x14, 0xda, 0x23, 0xeb, 0x5a, 0xec, 0xb6, 0x5b, 0x6b, 0xbb, 0x6d, 0x5b, 0xc6, 0xf6,
+	0xe2, 0xdc, 0xb8, 0x1d, 0x40, 0xaf, 0xd2, 0x70, 0x1c, 0xcb, 0xb1, 0x83, 0x39, 0x05, 0x74, 0x0a,
+	0x5c, 0x05, 0xad, 0x1b, 0xba, 0xb7, 0x5f, 0xcc, 0xd0, 0xc5, 0x15, 0xc4, 0x54, 0x82, 0xa9, 0x31,
+	0x3d, 0xb6, 0x88, 0x51, 0x84, 0x74, 0x6a, 0xae, 0x17, 0xb6, 0x22, 0x18, 0xae, 0xa0, 0x10, 0x95,
+	0xb4, 0x82, 0x77, 0xd4, 0x15, 0x8c, 0xad, 0x70, 0x54, 0x63, 0x89, 0x13, 0x53, 0x9b, 0x2e, 0x7d,
+	0xd2, 0xd9, 0x41, 0x68, 0x2d, 0x1a, 0xaf, 0x89, 0x01, 0x4b, 0xd8, 0x30, 0xb4, 0x16, 0x24, 0x6f,
+	0xbd, 0xa5, 0x3f, 0xb5, 0x04, 0xc5, 0x0e, 0x51, 0x80, 0x00, 0x11, 0xc1, 0x27, 0x70, 0x59, 0xc4,
+	0x5d, 0x54, 0xc9, 0xf0, 0xfa, 0xfa, 0x5a, 0x11, 0xd1, 0xc3, 0x44, 0x3c, 0xbc, 0x0a, 0xd2, 0xff,
+	0x25, 0x7d, 0xae, 0x53, 0xbb, 0x15, 0x01, 0x41, 0xeb, 0xe0, 0x15, 0xa8, 0x2c, 0x5d, 0x74, 0xde,
+	0x0d, 0xc7, 0x69, 0xbc, 0x21, 0x22, 0x63, 0x04, 0x68, 0x3a, 0x68, 0x6a, 0x5c, 0x99, 0x1b, 0x68,
+	0x37, 0x4c, 0x97, 0x14, 0x42, 0x1d, 0x8b, 0x9c, 0xc5, 0xbb, 0x24, 0xec, 0x2f, 0xcf, 0x8d, 0x33,
+	0x1e, 0x61, 0xda, 0x88, 0x60, 0x1f, 0x41, 0x8d, 0x86, 0x18, 0x52, 0x46, 0x4d, 0xd0, 0x78, 0x73,
+	0x27, 0x97, 0x6c, 0x59, 0x0a, 0x9a, 0x11, 0x82, 0x57, 0x9d, 0xb8, 0x1c, 0x20, 0x53, 0xe8, 0xdb,
+	0x47, 0x47, 0x96, 0x8f, 0xab, 0x19, 0x34, 0xde, 0x52, 0x99, 0x46, 0x02, 0x83, 0xeb, 0x59, 0x0d,
+	0xe3, 0x72, 0x80, 0x31, 0x3b, 0x54, 0x65, 0xe3, 0xc0, 0x35, 0x16, 0xc1, 0xb1, 0x17, 0x36, 0xde,
+	0x96, 0x31, 0xd0, 0x24, 0x5b, 0x7c, 0x14, 0x95, 0x78, 0x0d, 0x49, 0x87, 0x92, 0x52, 0xff, 0x3f,
+	0x39, 0x28, 0x47, 0x5a, 0x07, 0xef, 0x19, 0x0f, 0xfb, 0x5f, 0xf5, 0x07, 0x4f, 0xfb, 0xda, 0x25,
+	0x8c, 0x7b, 0x3c, 0x69, 0xf6, 0x0e, 0x3b, 0xe3, 0x61, 0xab, 0xd9, 0x17, 0x49, 0x85, 0x94, 0xde,
+	0x25, 0xea, 0x59, 0x76, 0x19, 0xea, 0x0f, 0x0f, 0xfb, 0x74, 0xcf, 0x28, 0x40, 0x39, 0x04, 0x75,
+	0xbe, 0x16, 0xc1, 0x15, 0x01, 0xca, 0x23, 0xe8, 0x71, 0x73, 0xd4, 0xe1, 0xdd, 0x08, 0x54, 0xc0,
+	0x5e, 0x0e, 0xf8, 0xe0, 0xcb, 0x4e, 0x6b, 0xa4, 0x01, 0xbb, 0x06, 0x97, 0x63, 0x96, 0xa8, 0x39,
+	0xad, 0x8a, 0x61, 0x9a, 0x88, 0x4d, 0xbb, 0x8a, 0x8d, 0xf0, 0x4e, 0xeb, 0x90, 0x0f, 0xbb, 0x4f,
+	0x3a, 0xe3, 0xd6, 0xa8, 0xa3, 0x5d, 0x43, 0x67, 0x79, 0xd8, 0xed, 0x7f, 0xa5, 0x5d, 0x47, 0xc7,
+	0x1f, 0x4b, 0xa2, 0xf5, 0x1b, 0x8c, 0xc1, 0x56, 0x42, 0x4b, 0xb0, 0x06, 0x85, 0x79, 0xf6, 0xf7,
+	0xb5, 0x3b, 0xd8, 0x6c, 0xbb, 0x3b, 0x1c, 0x75, 0xfb, 0xad, 0x91, 0xf6, 0x1a, 0x46, 0x72, 0x1e,
+	0x76, 0x7b, 0xa3, 0x0e, 0xd7, 0x76, 0xb0, 0xbd, 0x2f, 0x07, 0xdd, 0xbe, 0xf6, 0x3a, 0x42, 0x87,
+	0xcd, 0xc7, 0x07, 0xbd, 0x8e, 0xa6, 0x53, 0x2f, 0x03, 0x3e, 0xd2, 0xde, 0x40, 0x97, 0xfc, 0xb0,
+	0x8f, 0x63, 0xbb, 0x8b, 0x1d, 0x52, 0x71, 0x8c, 0x69, 0x93, 0x6f, 0x2a, 0xf1, 0xa0, 0xb7, 0xb0,
+	0xfc, 0xb4, 0xdb, 0x6f, 0x0f, 0x9e, 0x6a, 0x6f, 0x23, 0xd9, 0x1e, 0x1f, 0x34, 0xdb, 0x2d, 0x0c,
+	0x1b, 0xdd, 0xc3, 0x06, 0x86, 0x07, 0xbd, 0xee, 0x48, 0x7b, 0x07, 0xa9, 0xf6, 0x9b, 0xa3, 0x47,
+	0x1d, 0xae, 0xdd, 0xc7, 0x72, 0x73, 0x38, 0xec, 0xf0, 0x91, 0xb6, 0x8b, 0xe5, 0x6e, 0x9f, 0xca,
+	0x1f, 0x51, 0xab, 0x07, 0xed, 0xe6, 0xa8, 0xa3, 0x7d, 0x8c, 0xe5, 0x76, 0xa7, 0xd7, 0x19, 0x75,
+	0xb4, 0x9f, 0x62, 0xab, 0x14, 0xbf, 0x1a, 0xe2, 0xf2, 0x7d, 0x82, 0x2b, 0x13, 0x57, 0x69, 0x3c,
+	0x9f, 0x62, 0x47, 0x8f, 0xbb, 0xfd, 0xc3, 0xa1, 0xf6, 0x19, 0x12, 0x53, 0x91, 0x30, 0x9f, 0xe3,
+	0xc2, 0xf7, 0x06, 0xad, 0xaf, 0xc6, 0x83, 0x03, 0xed, 0x0b, 0xfd, 0x5b, 0x28, 0x47, 0x4a, 0x1b,
+	0x59, 0xba, 0xfd, 0x7e, 0x07, 0xd3, 0x48, 0xcb, 0x90, 0xef, 0x75, 0x1e, 0x8e, 0xb4, 0x0c, 0x02,
+	0x79, 0x77, 0xff, 0xd1, 0x48, 0xcb, 0x62, 0x71, 0x70, 0x88, 0xeb, 0x94, 0xa3, 0x15, 0xe9, 0x3c,
+	0xee, 0x6a, 0x79, 0x2c, 0x35, 0xfb, 0xa3, 0xae, 0x56, 0xa0, 0x15, 0xeb, 0xf6, 0xf7, 0x7b, 0x1d,
+	0xad, 0x88, 0xd0, 0xc7, 0x4d, 0xfe, 0x95, 0x56, 0x42, 0xa6, 0xe6, 0xc1, 0x41, 0xef, 0x1b, 0xad,
+	0xac, 0xdf, 0x83, 0x52, 0xf3, 0xe8, 0xe8, 0x31, 0x1a, 0x40, 0x65, 0xc8, 0x3f, 0xc4, 0x9b, 0x6b,
+	0x4a, 0x58, 0xdd, 0x1b, 0x8c, 0x46, 0x83, 0xc7, 0x5a, 0x06, 0x3f, 0xd0, 0x68, 0x70, 0xa0, 0x65,
+	0xf5, 0xdb, 0x50, 0x14, 0xce, 0x04, 0x85, 0xbb, 0xa2, 0x8c, 0xdf, 0x9c, 0xcc, 0xf2, 0xf5, 0xa0,
+	0x12, 0x1b, 0xf5, 0xec, 0x3e, 0xa6, 0x9c, 0x2d, 0xa4, 0xa3, 0xdb, 0x58, 0x31, 0xf9, 0x1f, 0x3c,
+	0x36, 0x16, 0xc2, 0xdf, 0x47, 0xa2, 0x5b, 0x9f, 0x40, 0x39, 0x02, 0xfc, 0x20, 0xd7, 0xfa, 0x6f,
+	0x15, 0xa0, 0xd2, 0x56, 0x44, 0xff, 0x1f, 0xec, 0x5a, 0x2b, 0xce, 0x6f, 0xee, 0x85, 0x9d, 0xdf,
+	0xfc, 0xf3, 0x9c, 0xdf, 0xc2, 0xcb, 0x3a, 0xbf, 0xc5, 0x17, 0x73, 0x7e, 0x4b, 0x2f, 0xe2, 0xfc,
+	0xde, 0x5d, 0x73, 0x7e, 0x85, 0x6b, 0x9d, 0x76, 0x77, 0xd3, 0x4e, 0x67, 0xe5, 0x79, 0x4e, 0x67,
+	0xda, 0x91, 0x84, 0xe7, 0x38, 0x92, 0x69, 0x17, 0xb5, 0xfa, 0x7b, 0x5d, 0xd4, 0x8d, 0x4e, 0x67,
+	0xed, 0xc5, 0x9c, 0x4e, 0xd4, 0x60, 0x86, 0x3b, 0x0e, 0xfd, 0xa5, 0x8b, 0x01, 0x20, 0xb2, 0xf5,
+	0xca, 0xbc, 0x8a, 0xde, 0x84, 0x04, 0xad, 0xf8, 0x99, 0x5b, 0x3f, 0xcc, 0xcf, 0xdc, 0x5e, 0xf1,
+	0x33, 0xff, 0x2c, 0x0b, 0x90, 0x28, 0x0c, 0xbc, 0x78, 0x16, 0x86, 0x56, 0x7c, 0x51, 0x59, 0xa2,
+	0x7a, 0xd7, 0x64, 0xbb, 0x70, 0x5d, 0x26, 0xc4, 0xc9, 0x2c, 0xac, 0xb3, 0xb1, 0xed, 0x8e, 0x27,
+	0x46, 0x28, 0xf7, 0x36, 0x93, 0x58, 0x4a, 0xc8, 0x3a, 0xeb, 0xba, 0x7b, 0x46, 0xc8, 0xde, 0x83,
+	0x2b, 0x2a, 0x4f, 0x94, 0xbb, 0x2f, 0xe2, 0xcc, 0x5a, 0xc2, 0xc0, 0x45, 0x16, 0xff, 0x2e, 0x6c,
+	0xab, 0xe4, 0x98, 0x88, 0x98, 0x5f, 0x4b, 0x44, 0xac, 0x27, 0x6c, 0xa3, 0xf3, 0x05, 0xfb, 0x00,
+	0xae, 0xf9, 0xd6, 0xcc, 0xb7, 0x82, 0xe3, 0x71, 0x18, 0xa8, 0xa3, 0x12, 0x89, 0xed, 0x97, 0x25,
+	0x72, 0x14, 0xc4, 0x83, 0xc2, 0xf4, 0xc0, 0x63, 0xc3, 0xb7, 0x4c, 0x99, 0xf4, 0x24, 0x6b, 0xc2,
+	0x1d, 0x1a, 0xa3, 0x17, 0x4a, 0x1e, 0x69, 0x19, 0xdd, 0xa1, 0xa7, 0x86, 0x1d, 0x92, 0xc9, 0x70,
+	0x62, 0x2f, 0xc6, 0x8e, 0xb8, 0xd6, 0x12, 0x7e, 0x04, 0x20, 0x48, 0x5c, 0x6c, 0xe9, 0x7f, 0x13,
+	0x40, 0xea, 0xcf, 0x8b, 0x72, 0x61, 0x94, 0x7c, 0xec, 0x6c, 0x2a, 0x1f, 0x9b, 0x41, 0x7e, 0xe2,
+	0x99, 0xe7, 0xd1, 0x73, 0x09, 0x2c, 0xe3, 0x00, 0x27, 0x16, 0xe6, 0x0d, 0x45, 0xc9, 0x5d, 0xa2,
+	0x86, 0xc2, 0xc4, 0x7a, 0x66, 0xb9, 0x62, 0x6a, 0x15, 0x2e, 0x2a, 0xfa, 0x7f, 0xc9, 0xc4, 0xbd,
+	0xa3, 0x24, 0x51, 0x7a, 0xca, 0xa4, 0x7a, 0x8a, 0x2f, 0x87, 0xd5, 0x3c, 0xb3, 0x30, 0xce, 0x07,
+	0x7b, 0x17, 0xca, 0x52, 0xef, 0x47, 0x81, 0xb9, 0xb4, 0x65, 0x20, 0x0c, 0x72, 0x49, 0x81, 0xd6,
+	0x4c, 0x94, 0x3f, 0x27, 0x2e, 0xa4, 0x2b, 0xbc, 0x3c, 0x15, 0xc9, 0x73, 0xf4, 0x18, 0xc3, 0xb5,
+	0x84, 0x19, 0x54, 0x10, 0xf2, 0xc5, 0xb5, 0xc8, 0x06, 0xba, 0x01, 0x25, 0xcf, 0x31, 0xd5, 0xa8,
+	0x9b, 0xe7, 0x98, 0x88, 0xb8, 0x05, 0x65, 0xdf, 0x32, 0x4c, 0xcf, 0x75, 0xce, 0x49, 0x22, 0x94,
+	0x79, 0x5c, 0xd7, 0xff, 0x79, 0x16, 0x0a, 0xbf, 0xc2, 0x1c, 0x64, 0xf6, 0x09, 0x54, 0x82, 0x70,
+	0x1e, 0xaa, 0x1e, 0xee, 0x4d, 0x31, 0x46, 0xc2, 0x93, 0x83, 0x6a, 0x61, 0x76, 0x80, 0x70, 0x17,
+	0x91, 0x16, 0x4b, 0xf4, 0xb4, 0x2c, 0xb4, 0x16, 0x22, 0xd9, 0xa1, 0xc0, 0x45, 0x05, 0xdd, 0x1e,
+	0x74, 0x77, 0xa3, 0xd9, 0x42, 0xe2, 0x72, 0x72, 0x81, 0x40, 0xb7, 0x47, 0x26, 0x9e, 0xe5, 0xd7,
+	0xbd, 0x4c, 0x81, 0xc1, 0x91, 0x1f, 0x5b, 0x06, 0xda, 0xe7, 0x51, 0xb2, 0x60, 0x5c, 0xc7, 0x8b,
+	0x37, 0xc7, 0x33, 0xcc, 0x91, 0x71, 0x14, 0x25, 0xcb, 0xca, 0xaa, 0xfe, 0x14, 0xea, 0xa9, 0xc1,
+	0xa6, 0xcd, 0x23, 0x54, 0x7a, 0x9d, 0x1e, 0x6a, 0xe1, 0x8c, 0xa2, 0xb8, 0xb3, 0x8a, 0xb2, 0xce,
+	0x29, 0x4a, 0x3c, 0x4f, 0x6a, 0xb9, 0xc3, 0xf7, 0x3b, 0x5a, 0x41, 0xff, 0xa7, 0x59, 0xb8, 0x3c,
+	0xf2, 0x0d, 0x37, 0x30, 0x44, 0x32, 0x87, 0x1b, 0xfa, 0x9e, 0xc3, 0xbe, 0x80, 0x72, 0x38, 0x75,
+	0xd4, 0x75, 0x7b, 0x2d, 0xfa, 0xb6, 0x2b, 0xa4, 0x0f, 0x46, 0x53, 0x87, 0x56, 0xaf, 0x14, 0x8a,
+	0x02, 0x7b, 0x0f, 0x0a, 0x13, 0xeb, 0xc8, 0x76, 0x65, 0x98, 0xf9, 0xda, 0x2a, 0xe3, 0x1e, 0x22,
+	0xf1, 0x69, 0x1b, 0x51, 0xb1, 0x0f, 0x30, 0x51, 0x79, 0x8e, 0xde, 0x64, 0x4e, 0x4d, 0x0f, 0x52,
+	0x3b, 0x42, 0x2c, 0x3e, 0x5f, 0x13, 0x74, 0xec, 0x13, 0x7c, 0x8c, 0xe2, 0x38, 0x13, 0x63, 0x7a,
+	0x22, 0x4f, 0x7b, 0x63, 0x95, 0x87, 0x4b, 0xfc, 0xa3, 0x4b, 0x3c, 0xa6, 0xd5, 0x1f, 0x40, 0x49,
+	0x0e, 0x16, 0x17, 0x60, 0xaf, 0xb3, 0xdf, 0x95, 0x6b, 0xd7, 0x1a, 0x3c, 0x7e, 0xdc, 0x1d, 0x89,
+	0x74, 0x36, 0x3e, 0xe8, 0xf5, 0xf6, 0x9a, 0xad, 0xaf, 0xb4, 0xec, 0x5e, 0x19, 0x8a, 0x06, 0xdd,
+	0xc6, 0xea, 0x7f, 0x3b, 0x03, 0xdb, 0x2b, 0x13, 0x60, 0x9f, 0x41, 0x7e, 0xee, 0x99, 0xd1, 0xf2,
+	0xdc, 0xdd, 0x38, 0x4b, 0xa5, 0x8e, 0x06, 0x07, 0x27, 0x0e, 0xfd, 0x73, 0xd8, 0x4a, 0xc3, 0x95,
+	0x67, 0x0c, 0x75, 0xa8, 0xf0, 0x4e, 0xb3, 0x3d, 0x1e, 0xf4, 0x7b, 0xdf, 0x08, 0x3b, 0x97, 0xaa,
+	0x4f, 0x79, 0x77, 0xd4, 0xd1, 0xb2, 0xfa, 0x1f, 0x83, 0xb6, 0xba, 0x30, 0x6c, 0x1f, 0xb6, 0x31,
+	0x83, 0xd4, 0xb1, 0x44, 0x1e, 0x4a, 0xf2, 0xc9, 0xee, 0x6c, 0x58, 0x49, 0x49, 0x46, 0x5f, 0x6c,
+	0x6b, 0x9a, 0xaa, 0xeb, 0x7f, 0x03, 0xd8, 0xfa, 0x0a, 0xfe, 0x78, 0xcd, 0xff, 0xb7, 0x0c, 0xe4,
+	0x0f, 0x1c, 0x03, 0xb3, 0xa6, 0x0a, 0xf4, 0x44, 0xa0, 0x91, 0x51, 0x83, 0x45, 0x74, 0x22, 0x71,
+	0x5b, 0x10, 0x8e, 0xfd, 0x04, 0x72, 0xe1, 0x34, 0xba, 0xa6, 0xbb, 0x71, 0xc1, 0xe6, 0xc3, 0x6c,
+	0xfe, 0x70, 0x8a, 0x61, 0xfc, 0x9c, 0x69, 0x3a, 0x8d, 0x9c, 0x9a, 0x6d, 0x81, 0x5e, 0x77, 0xdb,
+	0x9a, 0xd9, 0xae, 0x2d, 0x1f, 0x2c, 0x20, 0x09, 0x3e, 0x59, 0x30, 0xa7, 0x4e, 0x23, 0xaf, 0x7a,
+	0xc1, 0x48, 0xa9, 0x34, 0x68, 0x4e, 0xd1, 0xbd, 0xaa, 0x35, 0xc3, 0x10, 0xbd, 0x4a, 0x13, 0x87,
+	0x9c, 0xbe, 0xba, 0x44, 0x08, 0x4f, 0xe1, 0xf1, 0x0d, 0x00, 0xa2, 0xf4, 0x77, 0x29, 0xeb, 0x7e,
+	0x39, 0xc7, 0xa4, 0x61, 0x59, 0xda, 0x70, 0xf1, 0x2a, 0x31, 0xfa, 0xff, 0xcd, 0x42, 0x55, 0xe9,
+	0x9c, 0x7d, 0x0c, 0x65, 0x73, 0xea, 0x6c, 0x90, 0x56, 0x0a, 0xd1, 0x83, 0x76, 0x74, 0xde, 0x4c,
+	0x51, 0x20, 0x87, 0xcb, 0x0a, 0xc7, 0xcf, 0x0c, 0xdf, 0x46, 0xd9, 0x1c, 0x34, 0xb2, 0xaa, 0x43,
+	0x3d, 0xb4, 0xc2, 0x27, 0x11, 0x06, 0x5f, 0x2f, 0x06, 0x4a, 0x9d, 0xbd, 0x83, 0x19, 0xec, 0xd6,
+	0xc2, 0xf0, 0x2d, 0xb9, 0x76, 0xf2, 0xda, 0xfc, 0x40, 0x00, 0xf1, 0x31, 0xa3, 0xc4, 0x23, 0xa9,
+	0x75, 0x66, 0x4d, 0x97, 0xa1, 0xd5, 0xc8, 0xab, 0xa4, 0x1d, 0x01, 0x44, 0x52, 0x89, 0x67, 0xbb,
+	0x18, 0xc5, 0x30, 0x1c, 0xc7, 0x23, 0x7b, 0xa4, 0xa0, 0x06, 0x47, 0xda, 0x31, 0x5c, 0xbc, 0x84,
+	0x8c, 0x6a, 0xfa, 0x11, 0x94, 0xe4, 0xc4, 0xd0, 0x8d, 0xc0, 0x2c, 0xd2, 0x27, 0x4d, 0xde, 0x45,
+	0x17, 0x4f, 0xde, 0x41, 0xee, 0xf3, 0x66, 0x5f, 0x8a, 0x37, 0xde, 0x79, 0x32, 0xf8, 0x0a, 0x5f,
+	0xf6, 0xd0, 0x45, 0x79, 0xff, 0x1b, 0x2d, 0x27, 0xdc, 0xb8, 0xce, 0x41, 0x93, 0xa3, 0x74, 0xab,
+	0x42, 0xa9, 0xf3, 0x75, 0xa7, 0x75, 0x38, 0xea, 0x68, 0x05, 0x3c, 0x41, 0xed, 0x4e, 0xb3, 0xd7,
+	0x1b, 0xb4, 0x50, 0xf4, 0x15, 0xf7, 0x2a, 0x98, 0x20, 0x46, 0x2b, 0xa9, 0xff, 0x9b, 0x3a, 0x6c,
+	0xa5, 0x77, 0x09, 0xfb, 0x14, 0xca, 0xa6, 0x99, 0xfa, 0x02, 0xb7, 0x37, 0xed, 0xa6, 0x07, 0x6d,
+	0x33, 0xfa, 0x08, 0xa2, 0x80, 0x01, 0x50, 0xb1, 0xa7, 0xb3, 0x6b, 0x7b, 0x3a, 0xda, 0xd1, 0xbf,
+	0x80, 0x6d, 0x99, 0xe5, 0x8e, 0x41, 0xa3, 0x89, 0x11, 0x58, 0xe9, 0x0d, 0xdb, 0x22, 0x64, 0x5b,
+	0xe2, 0x1e, 0x5d, 0xe2, 0x5b, 0xd3, 0x14, 0x84, 0xfd, 0x0c, 0xb6, 0x0c, 0x0a, 0x30, 0xc4, 0xfc,
+	0x79, 0x35, 0x51, 0xa5, 0x89, 0x38, 0x85, 0xbd, 0x6e, 0xa8, 0x00, 0xdc, 0x26, 0xa6, 0xef, 0x2d,
+	0x12, 0xe6, 0x82, 0xba, 0x4d, 0xda, 0xbe, 0xb7, 0x50, 0x78, 0x6b, 0xa6, 0x52, 0x67, 0x9f, 0x40,
+	0x4d, 0x8e, 0x3c, 0x79, 0x5a, 0x1d, 0x9f, 0x1e, 0x31, 0x6c, 0x32, 0x80, 0xf1, 0xcd, 0xee, 0x34,
+	0xa9, 0xb2, 0x8f, 0xa0, 0x2a, 0x06, 0x2c, 0xd8, 0x4a, 0xea, 0x4e, 0xa0, 0xd1, 0x46, 0x5c, 0x60,
+	0xc4, 0x35, 0xf6, 0x01, 0x00, 0x8d, 0x53, 0xbd, 0x05, 0xdd, 0x4e, 0x06, 0x19, 0xb1, 0x54, 0xcc,
+	0xa8, 0xa2, 0x0c, 0x4f, 0x64, 0x22, 0x55, 0xd6, 0x87, 0x47, 0x69, 0x39, 0xc9, 0xf0, 0xa8, 0x9a,
+	0x0c, 0x4f, 0xb0, 0xc1, 0xda, 0xf0, 0x22, 0x2e, 0x30, 0xe2, 0x5a, 0x3c, 0x3c, 0xc1, 0x53, 0x5d,
+	0x1d, 0x5e, 0xc4, 0x52, 0x31, 0xa3, 0x0a, 0x7e, 0xb6, 0xc8, 0x38, 0x97, 0x93, 0xaa, 0xa5, 0x92,
+	0xe5, 0x24, 0x2e, 0x9a, 0x58, 0x3d, 0x54, 0x01, 0xc8, 0x1d, 0x1c, 0x7b, 0xa7, 0xca, 0xf1, 0xae,
+	0xab, 0xdc, 0xc3, 0x63, 0xef, 0x54, 0x3d, 0xdf, 0xf5, 0x40, 0x05, 0xe0, 0x68, 0xc5, 0x14, 0x29,
+	0xd7, 0x70, 0x4b, 0x1d, 0x2d, 0xcd, 0x10, 0x73, 0xc0, 0x70, 0xb4, 0x46, 0x54, 0xc1, 0x45, 0x91,
+	0x81, 0x22, 0xea, 0x6c, 0x5b, 0x5d, 0x14, 0x61, 0xf6, 0xcb, 0x9e, 0xc0, 0x89, 0x6b, 0xb8, 0xb7,
+	0x96, 0xae, 0xca, 0xa6, 0xa9, 0x7b, 0xeb, 0xd0, 0x4d, 0x31, 0xd6, 0x04, 0xa9, 0x64, 0x4d, 0x4e,
+	0x45, 0x60, 0x7d, 0xb7, 0xb4, 0xdc, 0xa9, 0xd5, 0xb8, 0xbc, 0x7e, 0x2a, 0x86, 0x12, 0x97, 0x9c,
+	0x8a, 0x08, 0x12, 0xef, 0xeb, 0x98, 0x9d, 0xad, 0xee, 0x6b, 0x85, 0xb9, 0x66, 0x2a, 0xf5, 0xe4,
+	0x40, 0xc5, 0xbc, 0x57, 0xd6, 0x0e, 0x94, 0xc2, 0x5c, 0x37, 0x54, 0x80, 0xfe, 0x97, 0x79, 0x28,
+	0x49, 0x39, 0x80, 0xef, 0x06, 0x5b, 0xbc, 0xd3, 0x1c, 0x75, 0xc6, 0xed, 0xe6, 0xa8, 0xb9, 0xd7,
+	0x1c, 0xa2, 0x2e, 0x67, 0xb0, 0xd5, 0xc4, 0x88, 0x4e, 0x02, 0xcb, 0xa0, 0x70, 0x6b, 0xf3, 0xc1,
+	0x41, 0x02, 0xca, 0xe2, 0x2b, 0x44, 0xc9, 0x2b, 0x5e, 0x2c, 0xe6, 0x30, 0x9f, 0x44, 0x30, 0x0a,
+	0x00, 0xa5, 0xfd, 0x10, 0x97, 0xa8, 0x17, 0x14, 0x96, 0x6e, 0xbf, 0xdd, 0xf9, 0x5a, 0x2b, 0x26,
+	0x2c, 0x02, 0x50, 0x8a, 0x59, 0x44, 0xbd, 0x8c, 0x83, 0x19, 0xf1, 0xc3, 0x7e, 0x2b, 0xe9, 0xa7,
+	0x82, 0x4c, 0xb2, 0x99, 0x27, 0xdd, 0xce, 0x53, 0x0d, 0x90, 0x49, 0xb4, 0x42, 0xf5, 0x2a, 0x5a,
+	0x23, 0xd4, 0x08, 0x55, 0x6b, 0x98, 0xc7, 0x32, 0x7c, 0x34, 0x78, 0x3a, 0x16, 0x4c, 0xf1, 0x14,
+	0xea, 0xec, 0x2a, 0x68, 0x0a, 0x42, 0x34, 0xbf, 0x85, 0x5d, 0x12, 0x34, 0x22, 0x1c, 0x6a, 0xdb,
+	0xd8, 0x25, 0xc1, 0x46, 0x42, 0xb4, 0x6b, 0x38, 0x15, 0xc1, 0x3a, 0xe8, 0x1d, 0x3e, 0xee, 0x0f,
+	0xb5, 0xcb, 0x38, 0x08, 0x82, 0x88, 0x91, 0xb3, 0xb8, 0x99, 0x44, 0x21, 0x5c, 0x21, 0x1d, 0x81,
+	0xb0, 0xa7, 0x4d, 0xde, 0xef, 0xf6, 0xf7, 0x87, 0xda, 0xd5, 0xb8, 0xe5, 0x0e, 0xe7, 0x03, 0x3e,
+	0xd4, 0xae, 0xc5, 0x80, 0xe1, 0xa8, 0x39, 0x3a, 0x1c, 0x6a, 0xd7, 0xe3, 0x51, 0x1e, 0xf0, 0x41,
+	0xab, 0x33, 0x1c, 0xf6, 0xba, 0xc3, 0x91, 0x76, 0x03, 0x83, 0x7e, 0xc9, 0x88, 0x22, 0xe2, 0x86,
+	0x32, 0x50, 0xbe, 0xdf, 0x19, 0x69, 0x37, 0xe3, 0x61, 0xb4, 0x06, 0x3d, 0x7c, 0x4c, 0x3a, 0xe8,
+	0x6b, 0xb7, 0x90, 0x88, 0x82, 0x58, 0x72, 0x36, 0xaf, 0xe0, 0xb8, 0x0e, 0xfb, 0x2a, 0xe8, 0xb6,
+	0xb2, 0x35, 0x86, 0x9d, 0x5f, 0x1d, 0x76, 0xfa, 0xad, 0x8e, 0xf6, 0x6a, 0xb2, 0x35, 0x62, 0xd8,
+	0x9d, 0x78, 0x6b, 0xc4, 0xa0, 0xd7, 0xe2, 0x3e, 0x23, 0xd0, 0x50, 0xdb, 0xd9, 0xab, 0xd1, 0xaf,
+	0x0a, 0x48, 0x45, 0xa4, 0x7f, 0x09, 0x4c, 0x7d, 0xfd, 0x2b, 0x1f, 0x54, 0x31, 0xc8, 0xcf, 0x7c,
+	0x6f, 0x1e, 0x39, 0x94, 0x58, 0xa6, 0xc8, 0xfc, 0x72, 0x42, 0x59, 0x22, 0x49, 0x3a, 0x9b, 0x0a,
+	0xd2, 0xff, 0x34, 0x03, 0x5b, 0x69, 0x25, 0x84, 0x57, 0x62, 0xf6, 0x6c, 0x8c, 0x61, 0x77, 0x7a,
+	0xf4, 0x13, 0xc8, 0x47, 0x59, 0x55, 0x7b, 0xd6, 0xf7, 0x42, 0x7a, 0xf5, 0x43, 0x0e, 0x4d, 0xac,
+	0x53, 0x44, 0xab, 0x71, 0x9d, 0x75, 0xe1, 0x4a, 0xea, 0xc1, 0x73, 0xea, 0xc9, 0x55, 0x23, 0x7e,
+	0x31, 0xba, 0x32, 0x7e, 0xce, 0x82, 0x35, 0x98, 0xfe, 0x08, 0xea, 0x29, 0x0d, 0x87, 0x1e, 0xa5,
+	0x3d, 0x4b, 0x8f, 0xab, 0x6c, 0xcf, 0x9e, 0x3f, 0x28, 0x7d, 0x1f, 0x6a, 0xaa, 0xba, 0x7b, 0xf9,
+	0x86, 0x5e, 0x83, 0xca, 0xc3, 0x93, 0xe8, 0x05, 0x98, 0xfa, 0x08, 0xad, 0x22, 0x13, 0x0e, 0xff,
+	0x47, 0x16, 0xaa, 0x8a, 0x7e, 0x7c, 0xa1, 0xe5, 0xbc, 0x0d, 0x95, 0x24, 0x6b, 0x55, 0xfc, 0xfa,
+	0x42, 0x02, 0x48, 0x0d, 0x27, 0xb7, 0xb2, 0xd8, 0xa9, 0x0b, 0xb2, 0xfc, 0x73, 0x2e, 0xc8, 0x3e,
+	0x84, 0x9a, 0xf2, 0xee, 0x2b, 0x90, 0x61, 0xbb, 0x55, 0xfa, 0x6a, 0xf2, 0x06, 0x2c, 0xc0, 0x8c,
+	0xf4, 0xd9, 0xc9, 0xd8, 0x9c, 0x88, 0xac, 0xf8, 0x0a, 0xa6, 0x4f, 0xb7, 0x27, 0xe4, 0xd9, 0xcf,
+	0x62, 0xc1, 0x5f, 0x22, 0x4c, 0x79, 0x16, 0x89, 0xf7, 0x7b, 0x50, 0x9a, 0x9d, 0x88, 0xe7, 0x4d,
+	0x65, 0x35, 0xee, 0x14, 0xaf, 0x1b, 0x2f, 0xce, 0x4e, 0xe8, 0xa9, 0xd3, 0xe7, 0xa0, 0xad, 0x64,
+	0xd3, 0x07, 0x8d, 0xca, 0xc6, 0x41, 0x6d, 0xa7, 0x33, 0xeb, 0x03, 0xfd, 0xdf, 0x66, 0x60, 0x2b,
+	0xb1, 0x27, 0xf0, 0xdb, 0x62, 0x40, 0x36, 0xf9, 0x95, 0x84, 0xc6, 0xaa, 0xc9, 0x81, 0x24, 0x18,
+	0x1b, 0x12, 0x6f, 0x54, 0x37, 0xa5, 0xd4, 0x6f, 0x7a, 0x16, 0x97, 0xdb, 0xf4, 0x2c, 0x4e, 0xdf,
+	0x87, 0x1c, 0x46, 0x95, 0xc8, 0x8d, 0x44, 0x11, 0x26, 0xcc, 0x55, 0x21, 0xbc, 0x28, 0x98, 0xfc,
+	0x55, 0xe7, 0x1b, 0x91, 0xca, 0x79, 0xc0, 0xbb, 0x8f, 0x9b, 0xfc, 0x9b, 0x31, 0x02, 0x48, 0xc8,
+	0x3f, 0x1c, 0xf0, 0x4e, 0x77, 0xbf, 0x4f, 0x80, 0x3c, 0x39, 0x99, 0xc9, 0x10, 0x9b, 0xa6, 0xf9,
+	0xf0, 0xe4, 0xa5, 0x63, 0x33, 0xd1, 0x66, 0xcc, 0x25, 0x9b, 0x11, 0x93, 0xec, 0x31, 0xdf, 0x3d,
+	0x6d, 0x34, 0xa6, 0x13, 0xe2, 0x89, 0x40, 0xff, 0x3e, 0x03, 0x2c, 0x35, 0x10, 0x61, 0xc7, 0xbc,
+	0xec, 0x58, 0x3e, 0x85, 0x86, 0x7c, 0x11, 0x2a, 0xa8, 0xa2, 0x80, 0x1d, 0x8e, 0x45, 0x2c, 0xe9,
+	0x35, 0x81, 0xa7, 0xee, 0x92, 0xac, 0x7f, 0xf6, 0x3e, 0x88, 0x77, 0x78, 0x78, 0x23, 0x99, 0xf6,
+	0xd8, 0x94, 0x33, 0xc5, 0x13, 0x9a, 0xe4, 0x09, 0xa0, 0xfa, 0x4e, 0x51, 0x3c, 0x68, 0xdd, 0x4e,
+	0xbe, 0x1a, 0x9d, 0x33, 0xfd, 0x1f, 0x64, 0xe0, 0x4a, 0x7a, 0x43, 0xfc, 0x61, 0xb3, 0x4c, 0x3f,
+	0xca, 0xcc, 0xad, 0x3e, 0xca, 0xdc, 0xb4, 0x9f, 0xf2, 0x1b, 0xf7, 0xd3, 0xdf, 0xc9, 0xc0, 0x55,
+	0x65, 0xf5, 0x13, 0xcb, 0xf3, 0xff, 0xd3, 0xc8, 0x94, 0xb7, 0x99, 0xf9, 0xd4, 0xdb, 0x4c, 0x3d,
+	0x54, 0x57, 0xa8, 0x69, 0x9a, 0xe2, 0xa5, 0x0f, 0xbb, 0xab, 0x78, 0xb6, 0xeb, 0xaf, 0x59, 0x25,
+	0x0e, 0x43, 0x68, 0x33, 0xdb, 0x97, 0x09, 0xe7, 0x65, 0x2e, 0x2a, 0xf4, 0x63, 0x12, 0x33, 0xb4,
+	0xb8, 0x64, 0x0b, 0x62, 0x34, 0x55, 0x82, 0x89, 0xe6, 0xf5, 0x6f, 0xe0, 0x7a, 0xd2, 0xeb, 0x63,
+	0xcf, 0xb4, 0x67, 0xe7, 0xb2, 0x63, 0xfc, 0x61, 0x0d, 0xc7, 0x54, 0x57, 0x00, 0x83, 0x83, 0xf2,
+	0xb7, 0x32, 0xa2, 0x31, 0x65, 0x2f, 0x1e, 0x93, 0xde, 0x57, 0x9b, 0xe6, 0x16, 0x36, 0xf4, 0xfc,
+	0xa6, 0xf1, 0x05, 0xbb, 0x75, 0xaa, 0xae, 0x2d, 0xc6, 0x2a, 0xe9, 0x53, 0xfd, 0xc3, 0xbc, 0xba,
+	0x42, 0xc9, 0xb3, 0xb0, 0xbf, 0xae, 0x0a, 0x9f, 0x77, 0x57, 0x85, 0x4f, 0x4c, 0x27, 0x60, 0xe9,
+	0x87, 0x7b, 0xc8, 0x88, 0x26, 0x6d, 0x22, 0x0a, 0x93, 0xd4, 0xd9, 0xcd, 0xcf, 0x48, 0x92, 0x2c,
+	0x6f, 0x14, 0xeb, 0xbf, 0x84, 0xab, 0x86, 0x69, 0x8e, 0xd7, 0x24, 0xe9, 0xe6, 0xcc, 0x49, 0x66,
+	0x98, 0xe6, 0x41, 0x5a, 0x98, 0xe2, 0x73, 0x35, 0xb2, 0xa7, 0xd7, 0x9a, 0x10, 0x41, 0xdb, 0x2b,
+	0x88, 0x5c, 0xe5, 0xb9, 0x0e, 0x45, 0x71, 0xf7, 0x2d, 0x03, 0xcd, 0xb2, 0x46, 0x77, 0x13, 0xc7,
+	0x56, 0x7c, 0x33, 0x2e, 0x9f, 0x10, 0x55, 0x09, 0x26, 0x2e, 0xc2, 0xf1, 0x8d, 0x99, 0x75, 0x36,
+	0x3d, 0x36, 0xdc, 0x23, 0xc5, 0x2f, 0x16, 0x2f, 0xa8, 0xb4, 0x08, 0x11, 0x6b, 0xea, 0x37, 0x61,
+	0x2b, 0x26, 0x4e, 0xfc, 0xc3, 0x0a, 0xaf, 0x47, 0x50, 0xa1, 0x66, 0xdf, 0x03, 0x76, 0x6a, 0x87,
+	0xc7, 0xde, 0x12, 0xa3, 0x22, 0x8e, 0x6d, 0x1a, 0xf1, 0x03, 0xe3, 0x32, 0xbf, 0x2c, 0x31, 0x4f,
+	0x62, 0x84, 0x3e, 0x94, 0xe2, 0x2e, 0xf5, 0x25, 0xe8, 0x9e, 0xb6, 0xdd, 0x16, 0xb7, 0x90, 0x68,
+	0x97, 0x89, 0xf0, 0x60, 0x64, 0x43, 0x6b, 0x59, 0x11, 0x9b, 0x1b, 0xf0, 0xfd, 0x66, 0xbf, 0xfb,
+	0x47, 0x68, 0xb5, 0xd7, 0xa0, 0xdc, 0xf9, 0xba, 0xf5, 0x88, 0x72, 0xa5, 0xf3, 0xfa, 0x3f, 0x2b,
+	0x00, 0x24, 0xdf, 0x3c, 0xa5, 0xb7, 0x33, 0xbf, 0x4f, 0x6f, 0xbf, 0x40, 0x96, 0xb4, 0x1d, 0x8c,
+	0xd3, 0x19, 0x14, 0xb9, 0xe8, 0x71, 0xa2, 0x9a, 0x3d, 0xc1, 0x3e, 0x84, 0x92, 0x08, 0x5f, 0x46,
+	0xd1, 0xe8, 0x1b, 0xab, 0x3b, 0xf1, 0x81, 0x7c, 0xf7, 0x1b, 0xd1, 0xdd, 0xfa, 0xdf, 0x39, 0x28,
+	0x0a, 0x18, 0x3d, 0x06, 0xf2, 0xbd, 0xe8, 0xc7, 0x4b, 0xae, 0x6e, 0xd2, 0xa0, 0xf4, 0xcb, 0x61,
+	0xa8, 0x6c, 0x1f, 0x40, 0x11, 0x37, 0xdd, 0xec, 0x24, 0x1d, 0xf2, 0x5d, 0x51, 0x66, 0x18, 0xdb,
+	0x33, 0xb0, 0xc0, 0x3e, 0x85, 0x0a, 0xd2, 0x0b, 0x17, 0x3a, 0x65, 0x0b, 0xae, 0xab, 0x1d, 0x8c,
+	0xe0, 0x1a, 0xb2, 0xcc, 0x7e, 0x9e, 0xf6, 0xd8, 0x85, 0x4e, 0xb8, 0xb5, 0xc6, 0x7a, 0x91, 0xef,
+	0xfe, 0x05, 0x00, 0xf6, 0x2b, 0x25, 0x85, 0x88, 0x7f, 0xdc, 0xdc, 0xd0, 0xb1, 0x10, 0x0a, 0xe4,
+	0x17, 0x47, 0x15, 0xd6, 0x82, 0xfa, 0x9c, 0x84, 0x51, 0xc4, 0x2e, 0x82, 0x20, 0xb7, 0x57, 0xd9,
+	0x55, 0x89, 0x85, 0x0e, 0xe7, 0x5c, 0xa9, 0x63, 0x23, 0x3e, 0x89, 0x9d, 0xa8, 0x91, 0xd2, 0xe6,
+	0x46, 0x54, 0xd9, 0x84, 0x8d, 0xf8, 0x4a, 0x9d, 0xb5, 0x61, 0x5b, 0x2c, 0x42, 0xfa, 0x75, 0xea,
+	0x86, 0xa9, 0xc4, 0x1b, 0x1a, 0xdd, 0x66, 0x23, 0xb5, 0xc5, 0x95, 0xe0, 0xf6, 0xbf, 0xcc, 0x42,
+	0x25, 0x8e, 0xac, 0xbc, 0xb4, 0x31, 0x9c, 0xfc, 0xf0, 0x5e, 0x4e, 0xfd, 0xe1, 0xbd, 0x15, 0x95,
+	0xac, 0x5e, 0xff, 0x6c, 0xa7, 0x15, 0x5f, 0xb0, 0x9e, 0x19, 0x54, 0x78, 0xc1, 0xcc, 0x20, 0xf5,
+	0x0e, 0xb2, 0x98, 0xbe, 0x83, 0x5c, 0x79, 0x84, 0x5f, 0xda, 0xc9, 0xad, 0x3c, 0xc2, 0xbf, 0xf0,
+	0x75, 0x6e, 0xf9, 0xe2, 0xd7, 0xb9, 0xdf, 0x41, 0x25, 0x8e, 0x9e, 0xbc, 0xfc, 0x82, 0xfd, 0x10,
+	0x73, 0x5d, 0xff, 0x93, 0xc8, 0x35, 0x8b, 0x83, 0x17, 0x7f, 0xa8, 0x6b, 0x96, 0xea, 0x3e, 0xf7,
+	0x9c, 0xee, 0xcf, 0x84, 0xcb, 0x14, 0x77, 0xfe, 0x23, 0xef, 0x12, 0xf5, 0x03, 0xe6, 0x53, 0x1f,
+	0x50, 0xdf, 0x96, 0x6e, 0x5f, 0x1c, 0x76, 0xf9, 0xb3, 0x4c, 0xe4, 0x53, 0xc5, 0xef, 0x07, 0x2f,
+	0x94, 0xac, 0x71, 0x6f, 0x59, 0xb5, 0xb7, 0x97, 0x36, 0x48, 0xdf, 0x86, 0x82, 0x2a, 0x78, 0x36,
+	0x18, 0xa3, 0x02, 0xbf, 0xfa, 0x53, 0x19, 0x85, 0xd5, 0x9f, 0xca, 0xd0, 0x75, 0xa9, 0x1c, 0xc4,
+	0x14, 0xae, 0x46, 0xed, 0x46, 0x3f, 0xf3, 0x81, 0x15, 0xf4, 0x07, 0x2a, 0x89, 0x5d, 0xfa, 0xc3,
+	0xa7, 0xf9, 0xa3, 0x59, 0xa4, 0xdf, 0x67, 0xa0, 0x9e, 0x8a, 0x52, 0xbe, 0xc4, 0x60, 0x36, 0xca,
+	0x81, 0xdc, 0x0b, 0xca, 0x81, 0xfc, 0x4b, 0xc8, 0x81, 0xc2, 0xef, 0x95, 0x03, 0xc5, 0x55, 0x39,
+	0xa0, 0xff, 0xfd, 0x4c, 0xfc, 0xd3, 0x12, 0xa2, 0xb1, 0x4d, 0x8a, 0x36, 0xb3, 0x51, 0xd1, 0xde,
+	0x89, 0x7f, 0x59, 0xad, 0xdb, 0x16, 0x57, 0xc6, 0x75, 0xae, 0x40, 0xd8, 0xe7, 0x70, 0x53, 0x88,
+	0x7b, 0xa1, 0xb6, 0xc6, 0xde, 0x2c, 0xfa, 0x51, 0xb7, 0x6e, 0xf4, 0x82, 0xee, 0xba, 0x20, 0x10,
+	0x3f, 0x7b, 0x32, 0x4b, 0x7e, 0xdd, 0xad, 0x0b, 0xf5, 0x54, 0x84, 0x57, 0xf9, 0x01, 0xc6, 0x8c,
+	0xfa, 0x03, 0x8c, 0x78, 0x37, 0x7d, 0x7a, 0x6c, 0xf9, 0xd6, 0x86, 0x9f, 0x4d, 0x13, 0x08, 0xfc,
+	0x65, 0x29, 0xf5, 0x2e, 0x88, 0xbd, 0x0b, 0x05, 0x3b, 0xb4, 0xe6, 0xd1, 0x83, 0xc9, 0xeb, 0xeb,
+	0xd7, 0x45, 0xf4, 0xb3, 0x09, 0x82, 0x48, 0xff, 0x2d, 0xfe, 0xcc, 0xdc, 0x0a, 0x4e, 0xf9, 0x95,
+	0xc8, 0xcc, 0x05, 0xbf, 0x12, 0x99, 0x4d, 0x0d, 0x72, 0xc3, 0x2f, 0x3d, 0x26, 0x8f, 0x92, 0xf2,
+	0x17, 0x3c, 0x4a, 0x62, 0x6f, 0xe1, 0x55, 0x3f, 0xfd, 0x32, 0x9f, 0xb9, 0xe1, 0x09, 0x61, 0x8c,
+	0xd3, 0xff, 0x6e, 0x06, 0x4a, 0xf2, 0xe2, 0x6a, 0x63, 0x46, 0xc5, 0x3b, 0x50, 0x12, 0xbf, 0xd2,
+	0x17, 0xfd, 0xb6, 0xdc, 0x5a, 0x0a, 0x4c, 0x84, 0xc7, 0x87, 0xa1, 0x88, 0x4a, 0xff, 0xd8, 0x05,
+	0x5d, 0xfb, 0x11, 0x1c, 0x77, 0x13, 0xdd, 0xe6, 0xd3, 0x45, 0x51, 0x20, 0x73, 0xa2, 0x80, 0x40,
+	0x68, 0x3b, 0x06, 0xfa, 0xcf, 0xa1, 0x24, 0x2f, 0xc6, 0x36, 0x0e, 0xe5, 0x79, 0xbf, 0x71, 0xb7,
+	0x03, 0x90, 0xdc, 0x94, 0x6d, 0x6a, 0x41, 0x77, 0xe4, 0x83, 0x61, 0x8c, 0xac, 0x93, 0xef, 0xfb,
+	0x3e, 0xfe, 0xba, 0x95, 0x7c, 0x25, 0x9d, 0xb9, 0xf8, 0x95, 0x74, 0x4c, 0xc4, 0xee, 0x43, 0x2c,
+	0xde, 0x9f, 0x67, 0x74, 0xea, 0xcd, 0x28, 0xe5, 0x87, 0x76, 0xce, 0x47, 0xd2, 0xdf, 0x44, 0x50,
+	0xb4, 0x7d, 0x56, 0x3b, 0xc3, 0x31, 0x71, 0x85, 0x4c, 0xdf, 0x82, 0x9a, 0x7a, 0x0f, 0x70, 0xff,
+	0x75, 0xa8, 0xa9, 0xbf, 0x25, 0x46, 0x57, 0xe0, 0x9e, 0x6b, 0x89, 0x77, 0xb0, 0xbd, 0x5f, 0x7f,
+	0xac, 0x65, 0xee, 0xff, 0x89, 0xf2, 0x83, 0x12, 0x91, 0x49, 0x8e, 0xa1, 0x12, 0x4a, 0x07, 0xed,
+	0x75, 0xfb, 0x9d, 0x26, 0xa7, 0xd0, 0x09, 0xbd, 0x98, 0x7d, 0xd4, 0x1c, 0x3e, 0x12, 0x61, 0x16,
+	0x89, 0x21, 0x40, 0x2e, 0x79, 0xbe, 0x48, 0xe9, 0x9f, 0x54, 0x8c, 0x63, 0xcd, 0x05, 0x64, 0xa4,
+	0x30, 0x70, 0x11, 0xe3, 0xd0, 0x58, 0x8a, 0x71, 0xa5, 0xfb, 0xbf, 0x84, 0xc6, 0x45, 0x77, 0xdb,
+	0xd8, 0x6a, 0xeb, 0x51, 0x93, 0xf2, 0x07, 0x6a, 0x50, 0xee, 0x0f, 0xc6, 0xa2, 0x96, 0xc1, 0xbb,
+	0x47, 0xde, 0xe9, 0x75, 0x28, 0xb2, 0x7f, 0xff, 0x37, 0x19, 0xe5, 0x2b, 0x45, 0x77, 0x9b, 0x31,
+	0x40, 0x4e, 0x57, 0x05, 0x71, 0xcb, 0x30, 0xb5, 0x0c, 0xbb, 0x0e, 0x2c, 0x05, 0xea, 0x79, 0x53,
+	0xc3, 0xd1, 0xb2, 0x14, 0xc3, 0x8f, 0xe0, 0x4f, 0x7d, 0x3b, 0xb4, 0xb4, 0x1c, 0x7b, 0x15, 0x6e,
+	0xc6, 0xb0, 0x9e, 0x77, 0x7a, 0xe0, 0xdb, 0x9e, 0x6f, 0x87, 0xe7, 0x02, 0x9d, 0xdf, 0xfb, 0xc5,
+	0x9f, 0x7f, 0x7f, 0x27, 0xf3, 0x1f, 0xbe, 0xbf, 0x93, 0xf9, 0xaf, 0xdf, 0xdf, 0xb9, 0xf4, 0xdb,
+	0xff, 0x7e, 0x27, 0xf3, 0x47, 0xea, 0x8f, 0x3c, 0xcf, 0x8d, 0xd0, 0xb7, 0xcf, 0x84, 0xb2, 0x8b,
+	0x2a, 0xae, 0xf5, 0xfe, 0xe2, 0xe4, 0xe8, 0xfd, 0xc5, 0xe4, 0x7d, 0xfc, 0xa2, 0x93, 0x22, 0xfd,
+	0xb4, 0xf3, 0x47, 0xff, 0x6f, 0x00, 0xf8, 0x4c, 0xb1, 0x7f, 0x2e, 0x5a, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -9005,6 +10107,13 @@ func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Enumvalues) > 0 {
+		i -= len(m.Enumvalues)
+		copy(dAtA[i:], m.Enumvalues)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Table) > 0 {
 		i -= len(m.Table)
 		copy(dAtA[i:], m.Table)
@@ -10588,6 +11697,23 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HasFillValue {
+		i--
+		if m.HasFillValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FillValue) > 0 {
+		i -= len(m.FillValue)
+		copy(dAtA[i:], m.FillValue)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.FillValue)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NullAbility {
 		i--
 		if m.NullAbility {
@@ -10817,6 +11943,32 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IndexExpr != nil {
+		{
+			size, err := m.IndexExpr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.IndexAlgoParams) > 0 {
+		i -= len(m.IndexAlgoParams)
+		copy(dAtA[i:], m.IndexAlgoParams)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgoParams)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.IndexAlgo) > 0 {
+		i -= len(m.IndexAlgo)
+		copy(dAtA[i:], m.IndexAlgo)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgo)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Option != nil {
 		{
 			size, err := m.Option.MarshalToSizedBuffer(dAtA[:i])
@@ -10934,20 +12086,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA30 := make([]byte, len(m.ForeignCols)*10)
-		var j29 int
+		dAtA31 := make([]byte, len(m.ForeignCols)*10)
+		var j30 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j30++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPlan(dAtA, i, uint64(j29))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPlan(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10957,20 +12109,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA32 := make([]byte, len(m.Cols)*10)
-		var j31 int
+		dAtA33 := make([]byte, len(m.Cols)*10)
+		var j32 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j32++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPlan(dAtA, i, uint64(j31))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPlan(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -11700,20 +12852,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA42 := make([]byte, len(m.RefChildTbls)*10)
-		var j41 int
+		dAtA43 := make([]byte, len(m.RefChildTbls)*10)
+		var j42 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j42++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA43[j42] = uint8(num)
+			j42++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPlan(dAtA, i, uint64(j41))
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPlan(dAtA, i, uint64(j42))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -12150,6 +13302,30 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WindowFunc != nil {
+		{
+			size, err := m.WindowFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Lag != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
 		i--
@@ -12191,6 +13367,106 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InsertCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -12245,21 +13521,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA49 := make([]byte, len(m.IdxIdx)*10)
-		var j48 int
+		dAtA55 := make([]byte, len(m.IdxIdx)*10)
+		var j54 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j54++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintPlan(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12304,21 +13580,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA52 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j51 int
+		dAtA58 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j57 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j57++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintPlan(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12373,6 +13649,43 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PkIdxIdx) > 0 {
+		dAtA62 := make([]byte, len(m.PkIdxIdx)*10)
+		var j61 int
+		for _, num1 := range m.PkIdxIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j61++
+			}
+			dAtA62[j61] = uint8(num)
+			j61++
+		}
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintPlan(dAtA, i, uint64(j61))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.PkIdxRef) > 0 {
+		for iNdEx := len(m.PkIdxRef) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PkIdxRef[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.ParentIdx) > 0 {
 		for iNdEx := len(m.ParentIdx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12504,21 +13817,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA64 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j63 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j63++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPlan(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -12537,21 +13850,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA66 := make([]byte, len(m.IdxIdx)*10)
+		var j65 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j65++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA66[j65] = uint8(num)
+			j65++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintPlan(dAtA, i, uint64(j65))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12652,6 +13965,18 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SpillSize != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SpillSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SpillCount != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SpillCount))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.InsertTime != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.InsertTime))
 		i--
@@ -12749,6 +14074,78 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScanSnapshot != nil {
+		{
+			size, err := m.ScanSnapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.TriggerCtxs) > 0 {
+		for iNdEx := len(m.TriggerCtxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerCtxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.LockTargets) > 0 {
+		for iNdEx := len(m.LockTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.MaxRecursionDepth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxRecursionDepth))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.UnionAll {
+		i--
+		if m.UnionAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.WindowIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WindowIdx))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.BlockFilterList) > 0 {
 		for iNdEx := len(m.BlockFilterList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12857,21 +14254,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA63 := make([]byte, len(m.BindingTags)*10)
-		var j62 int
+		dAtA72 := make([]byte, len(m.BindingTags)*10)
+		var j71 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j71++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA72[j71] = uint8(num)
+			j71++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintPlan(dAtA, i, uint64(j71))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -13124,21 +14521,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA73 := make([]byte, len(m.Children)*10)
-		var j72 int
+		dAtA82 := make([]byte, len(m.Children)*10)
+		var j81 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j81++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA82[j81] = uint8(num)
+			j81++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j81
+		copy(dAtA[i:], dAtA82[:j81])
+		i = encodeVarintPlan(dAtA, i, uint64(j81))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13192,21 +14589,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA76 := make([]byte, len(m.List)*10)
-		var j75 int
+		dAtA85 := make([]byte, len(m.List)*10)
+		var j84 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA85[j84] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j84++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA85[j84] = uint8(num)
+			j84++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPlan(dAtA, i, uint64(j75))
+		i -= j84
+		copy(dAtA[i:], dAtA85[:j84])
+		i = encodeVarintPlan(dAtA, i, uint64(j84))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13281,6 +14678,39 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PkIdxIdx) > 0 {
+		dAtA87 := make([]byte, len(m.PkIdxIdx)*10)
+		var j86 int
+		for _, num1 := range m.PkIdxIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA87[j86] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j86++
+			}
+			dAtA87[j86] = uint8(num)
+			j86++
+		}
+		i -= j86
+		copy(dAtA[i:], dAtA87[:j86])
+		i = encodeVarintPlan(dAtA, i, uint64(j86))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.PkIdxRef) > 0 {
+		for iNdEx := len(m.PkIdxRef) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PkIdxRef[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.CanTruncate {
 		i--
 		if m.CanTruncate {
@@ -13348,21 +14778,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA78 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j77 int
+		dAtA89 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j88 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA89[j88] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j88++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA89[j88] = uint8(num)
+			j88++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPlan(dAtA, i, uint64(j77))
+		i -= j88
+		copy(dAtA[i:], dAtA89[:j88])
+		i = encodeVarintPlan(dAtA, i, uint64(j88))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13381,21 +14811,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA80 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j79 int
+		dAtA91 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j90 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j90++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA91[j90] = uint8(num)
+			j90++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPlan(dAtA, i, uint64(j79))
+		i -= j90
+		copy(dAtA[i:], dAtA91[:j90])
+		i = encodeVarintPlan(dAtA, i, uint64(j90))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13414,21 +14844,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA82 := make([]byte, len(m.IdxIdx)*10)
-		var j81 int
+		dAtA93 := make([]byte, len(m.IdxIdx)*10)
+		var j92 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j92++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA93[j92] = uint8(num)
+			j92++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintPlan(dAtA, i, uint64(j92))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13477,6 +14907,275 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockTarget) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkipLocked {
+		i--
+		if m.SkipLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NoWait {
+		i--
+		if m.NoWait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Shared {
+		i--
+		if m.Shared {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RefreshTsIdxInBat != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.RefreshTsIdxInBat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PrimaryColTyp != nil {
+		{
+			size, err := m.PrimaryColTyp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PrimaryColRelPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PrimaryColRelPos))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrimaryColIdxInBat != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PrimaryColIdxInBat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TableId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TableId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Before {
+		i--
+		if m.Before {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DbName) > 0 {
+		i -= len(m.DbName)
+		copy(dAtA[i:], m.DbName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.DbName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerCtx) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerCtx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Readonly) > 0 {
+		for iNdEx := len(m.Readonly) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Readonly[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Readonly)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OldIdx) > 0 {
+		dAtA96 := make([]byte, len(m.OldIdx)*10)
+		var j95 int
+		for _, num1 := range m.OldIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j95++
+			}
+			dAtA96[j95] = uint8(num)
+			j95++
+		}
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewIdx) > 0 {
+		dAtA98 := make([]byte, len(m.NewIdx)*10)
+		var j97 int
+		for _, num1 := range m.NewIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j97++
+			}
+			dAtA98[j97] = uint8(num)
+			j97++
+		}
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintPlan(dAtA, i, uint64(j97))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ColNames) > 0 {
+		for iNdEx := len(m.ColNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ColNames[iNdEx])
+			copy(dAtA[i:], m.ColNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ColNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DbName) > 0 {
+		i -= len(m.DbName)
+		copy(dAtA[i:], m.DbName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.DbName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Query) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13549,21 +15248,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA84 := make([]byte, len(m.Steps)*10)
-		var j83 int
+		dAtA100 := make([]byte, len(m.Steps)*10)
+		var j99 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j99++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA100[j99] = uint8(num)
+			j99++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j99
+		copy(dAtA[i:], dAtA100[:j99])
+		i = encodeVarintPlan(dAtA, i, uint64(j99))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15129,6 +16828,254 @@ func (m *AlterTableAlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableAddColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableAddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AfterColumn) > 0 {
+		i -= len(m.AfterColumn)
+		copy(dAtA[i:], m.AfterColumn)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.AfterColumn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.First {
+		i--
+		if m.First {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableModifyColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WithoutValidation {
+		i--
+		if m.WithoutValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExchangeTable) > 0 {
+		i -= len(m.ExchangeTable)
+		copy(dAtA[i:], m.ExchangeTable)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeTable)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExchangeDatabase) > 0 {
+		i -= len(m.ExchangeDatabase)
+		copy(dAtA[i:], m.ExchangeDatabase)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeDatabase)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CheckFilter) > 0 {
+		i -= len(m.CheckFilter)
+		copy(dAtA[i:], m.CheckFilter)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.CheckFilter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DropPartitionTables) > 0 {
+		for iNdEx := len(m.DropPartitionTables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DropPartitionTables[iNdEx])
+			copy(dAtA[i:], m.DropPartitionTables[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.DropPartitionTables[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddPartitionTables) > 0 {
+		for iNdEx := len(m.AddPartitionTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddPartitionTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15319,6 +17266,90 @@ func (m *AlterTable_Action_AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddColumn != nil {
+		{
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_AlterPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterPartition != nil {
+		{
+			size, err := m.AlterPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15353,20 +17384,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA125 := make([]byte, len(m.ForeignTbl)*10)
-		var j124 int
+		dAtA148 := make([]byte, len(m.ForeignTbl)*10)
+		var j147 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA148[j147] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j124++
+				j147++
 			}
-			dAtA125[j124] = uint8(num)
-			j124++
+			dAtA148[j147] = uint8(num)
+			j147++
 		}
-		i -= j124
-		copy(dAtA[i:], dAtA125[:j124])
-		i = encodeVarintPlan(dAtA, i, uint64(j124))
+		i -= j147
+		copy(dAtA[i:], dAtA148[:j147])
+		i = encodeVarintPlan(dAtA, i, uint64(j147))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -15802,20 +17833,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA131 := make([]byte, len(m.ForeignTbl)*10)
-		var j130 int
+		dAtA154 := make([]byte, len(m.ForeignTbl)*10)
+		var j153 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA154[j153] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j130++
+				j153++
 			}
-			dAtA131[j130] = uint8(num)
-			j130++
+			dAtA154[j153] = uint8(num)
+			j153++
 		}
-		i -= j130
-		copy(dAtA[i:], dAtA131[:j130])
-		i = encodeVarintPlan(dAtA, i, uint64(j130))
+		i -= j153
+		copy(dAtA[i:], dAtA154[:j153])
+		i = encodeVarintPlan(dAtA, i, uint64(j153))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -15892,20 +17923,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA134 := make([]byte, len(m.AccountIDs)*10)
-		var j133 int
+		dAtA157 := make([]byte, len(m.AccountIDs)*10)
+		var j156 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA134[j133] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA157[j156] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j133++
+				j156++
 			}
-			dAtA134[j133] = uint8(num)
-			j133++
+			dAtA157[j156] = uint8(num)
+			j156++
 		}
-		i -= j133
-		copy(dAtA[i:], dAtA134[:j133])
-		i = encodeVarintPlan(dAtA, i, uint64(j133))
+		i -= j156
+		copy(dAtA[i:], dAtA157[:j156])
+		i = encodeVarintPlan(dAtA, i, uint64(j156))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -16117,21 +18148,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA138 := make([]byte, len(m.ParamTypes)*10)
-		var j137 int
+		dAtA161 := make([]byte, len(m.ParamTypes)*10)
+		var j160 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA138[j137] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA161[j160] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j137++
+				j160++
 			}
-			dAtA138[j137] = uint8(num)
-			j137++
+			dAtA161[j160] = uint8(num)
+			j160++
 		}
-		i -= j137
-		copy(dAtA[i:], dAtA138[:j137])
-		i = encodeVarintPlan(dAtA, i, uint64(j137))
+		i -= j160
+		copy(dAtA[i:], dAtA161[:j160])
+		i = encodeVarintPlan(dAtA, i, uint64(j160))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -16401,6 +18432,10 @@ func (m *Type) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Enumvalues)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17193,6 +19228,13 @@ func (m *Default) ProtoSize() (n int) {
 	if m.NullAbility {
 		n += 2
 	}
+	l = len(m.FillValue)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.HasFillValue {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17315,6 +19357,18 @@ func (m *IndexDef) ProtoSize() (n int) {
 		l = m.Option.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.IndexAlgo)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.IndexAlgoParams)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.IndexExpr != nil {
+		l = m.IndexExpr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17891,6 +19945,56 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if m.Lag != 0 {
 		n += 1 + sovPlan(uint64(m.Lag))
 	}
+	if m.WindowFunc != nil {
+		l = m.WindowFunc.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18072,6 +20176,19 @@ func (m *UpdateCtx) ProtoSize() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.PkIdxRef) > 0 {
+		for _, e := range m.PkIdxRef {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.PkIdxIdx) > 0 {
+		l = 0
+		for _, e := range m.PkIdxIdx {
+			l += sovPlan(uint64(e))
+		}
+		n += 2 + sovPlan(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18126,6 +20243,12 @@ func (m *AnalyzeInfo) ProtoSize() (n int) {
 	if m.InsertTime != 0 {
 		n += 1 + sovPlan(uint64(m.InsertTime))
 	}
+	if m.SpillCount != 0 {
+		n += 1 + sovPlan(uint64(m.SpillCount))
+	}
+	if m.SpillSize != 0 {
+		n += 2 + sovPlan(uint64(m.SpillSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18288,6 +20411,31 @@ func (m *Node) ProtoSize() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.WindowIdx != 0 {
+		n += 2 + sovPlan(uint64(m.WindowIdx))
+	}
+	if m.UnionAll {
+		n += 3
+	}
+	if m.MaxRecursionDepth != 0 {
+		n += 2 + sovPlan(uint64(m.MaxRecursionDepth))
+	}
+	if len(m.LockTargets) > 0 {
+		for _, e := range m.LockTargets {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.TriggerCtxs) > 0 {
+		for _, e := range m.TriggerCtxs {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.ScanSnapshot != nil {
+		l = m.ScanSnapshot.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18417,6 +20565,136 @@ func (m *DeleteCtx) ProtoSize() (n int) {
 	if m.CanTruncate {
 		n += 2
 	}
+	if len(m.PkIdxRef) > 0 {
+		for _, e := range m.PkIdxRef {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.PkIdxIdx) > 0 {
+		l = 0
+		for _, e := range m.PkIdxIdx {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LockTarget) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableId != 0 {
+		n += 1 + sovPlan(uint64(m.TableId))
+	}
+	if m.PrimaryColIdxInBat != 0 {
+		n += 1 + sovPlan(uint64(m.PrimaryColIdxInBat))
+	}
+	if m.PrimaryColRelPos != 0 {
+		n += 1 + sovPlan(uint64(m.PrimaryColRelPos))
+	}
+	if m.PrimaryColTyp != nil {
+		l = m.PrimaryColTyp.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.RefreshTsIdxInBat != 0 {
+		n += 1 + sovPlan(uint64(m.RefreshTsIdxInBat))
+	}
+	if m.Shared {
+		n += 2
+	}
+	if m.NoWait {
+		n += 2
+	}
+	if m.SkipLocked {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Before {
+		n += 2
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerCtx) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.ColNames) > 0 {
+		for _, s := range m.ColNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.NewIdx) > 0 {
+		l = 0
+		for _, e := range m.NewIdx {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if len(m.OldIdx) > 0 {
+		l = 0
+		for _, e := range m.OldIdx {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if len(m.Readonly) > 0 {
+		n += 1 + sovPlan(uint64(len(m.Readonly))) + len(m.Readonly)*1
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -19236,6 +21514,119 @@ func (m *AlterTableAlterIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableAddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.First {
+		n += 2
+	}
+	l = len(m.AfterColumn)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPlan(uint64(m.Typ))
+	}
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.AddPartitionTables) > 0 {
+		for _, e := range m.AddPartitionTables {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.DropPartitionTables) > 0 {
+		for _, s := range m.DropPartitionTables {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.CheckFilter)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExchangeDatabase)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExchangeTable)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.WithoutValidation {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19328,6 +21719,54 @@ func (m *AlterTable_Action_AlterIndex) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_AlterPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterPartition != nil {
+		l = m.AlterPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19954,6 +22393,38 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 			}
 			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23402,6 +25873,60 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NullAbility = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FillValue = append(m.FillValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.FillValue == nil {
+				m.FillValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasFillValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasFillValue = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -24181,6 +26706,106 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgoParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexExpr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexExpr == nil {
+				m.IndexExpr = &Expr{}
+			}
+			if err := m.IndexExpr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -27584,60 +30209,380 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InsertCtx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InsertCtx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertCtx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowFunc == nil {
+				m.WindowFunc = &Expr{}
+			}
+			if err := m.WindowFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28884,6 +31829,116 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkIdxRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkIdxRef = append(m.PkIdxRef, &ObjectRef{})
+			if err := m.PkIdxRef[len(m.PkIdxRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PkIdxIdx = append(m.PkIdxIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PkIdxIdx) == 0 {
+					m.PkIdxIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PkIdxIdx = append(m.PkIdxIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkIdxIdx", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -29201,6 +32256,44 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillCount", wireType)
+			}
+			m.SpillCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillSize", wireType)
+			}
+			m.SpillSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -30362,187 +33455,349 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IdList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowIdx", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IdList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.WindowIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.List = append(m.List, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.List) == 0 {
-					m.List = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.List = append(m.List, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ColPosMap) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.UnionAll = bool(v != 0)
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecursionDepth", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxRecursionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecursionDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ColPosMap: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColPosMap: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTargets = append(m.LockTargets, &LockTarget{})
+			if err := m.LockTargets[len(m.LockTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerCtxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerCtxs = append(m.TriggerCtxs, &TriggerCtx{})
+			if err := m.TriggerCtxs[len(m.TriggerCtxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanSnapshot == nil {
+				m.ScanSnapshot = &timestamp.Timestamp{}
+			}
+			if err := m.ScanSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.List = append(m.List, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.List) == 0 {
+					m.List = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.List = append(m.List, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColPosMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColPosMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColPosMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31258,6 +34513,941 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CanTruncate = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkIdxRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkIdxRef = append(m.PkIdxRef, &ObjectRef{})
+			if err := m.PkIdxRef[len(m.PkIdxRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PkIdxIdx = append(m.PkIdxIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PkIdxIdx) == 0 {
+					m.PkIdxIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PkIdxIdx = append(m.PkIdxIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkIdxIdx", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryColIdxInBat", wireType)
+			}
+			m.PrimaryColIdxInBat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryColIdxInBat |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryColRelPos", wireType)
+			}
+			m.PrimaryColRelPos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryColRelPos |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryColTyp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimaryColTyp == nil {
+				m.PrimaryColTyp = &Type{}
+			}
+			if err := m.PrimaryColTyp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshTsIdxInBat", wireType)
+			}
+			m.RefreshTsIdxInBat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshTsIdxInBat |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shared = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoWait = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipLocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Before = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewIdx = append(m.NewIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewIdx) == 0 {
+					m.NewIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewIdx = append(m.NewIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIdx", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldIdx = append(m.OldIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldIdx) == 0 {
+					m.OldIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldIdx = append(m.OldIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIdx", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Readonly = append(m.Readonly, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Readonly) == 0 {
+					m.Readonly = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Readonly = append(m.Readonly, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34100,13 +38290,375 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
+			}
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34116,24 +38668,48 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			if m.IndexInfo == nil {
+				m.IndexInfo = &CreateTable{}
+			}
+			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexTableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34156,7 +38732,7 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34179,10 +38755,10 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34251,7 +38827,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34279,13 +38855,13 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34295,27 +38871,23 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
-			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34339,7 +38911,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34362,10 +38934,10 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34434,7 +39006,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34462,11 +39034,82 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Visible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34493,16 +39136,16 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IndexInfo == nil {
-				m.IndexInfo = &CreateTable{}
+			if m.Column == nil {
+				m.Column = &ColDef{}
 			}
-			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -34519,7 +39162,39 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IndexTableExist = bool(v != 0)
+			m.First = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34542,7 +39217,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableModifyColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34565,15 +39240,15 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableModifyColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34601,13 +39276,13 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34617,27 +39292,82 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34665,11 +39395,11 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34697,7 +39427,7 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34721,7 +39451,7 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34744,15 +39474,104 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTablePartition_AlterPartitionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddPartitionTables = append(m.AddPartitionTables, &TableDef{})
+			if err := m.AddPartitionTables[len(m.AddPartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartitionTables", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34780,11 +39599,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.DropPartitionTables = append(m.DropPartitionTables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34812,11 +39631,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.Filter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34844,11 +39663,75 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.CheckFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDatabase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeDatabase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutValidation", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -34865,7 +39748,7 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Visible = bool(v != 0)
+			m.WithoutValidation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35230,6 +40113,146 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AlterIndex{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableAddColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AddColumn{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_ModifyColumn{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_RenameColumn{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterPartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -242,6 +242,11 @@ func (c *clientConn) BuildConnWithServer(handshake bool) (ServerConn, error) {
 		c.log.Error("failed to connect to backend", zap.Error(err))
 		return nil, err
 	}
+	if handshake {
+		// Step 4, the OK packet has been sent to client, switch to the
+		// compressed protocol if it is negotiated with client.
+		c.mysqlProto.UseCompressedConn()
+	}
 	return conn, nil
 }
 
@@ -256,6 +261,10 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return c.handleSuspendAccount(ev)
 	case *dropAccountEvent:
 		return c.handleDropAccount(ev)
+	case *changeUserEvent:
+		return c.handleChangeUser(ev)
+	case *resetConnectionEvent:
+		return c.handleResetConnection(ev)
 	default:
 	}
 	return nil
@@ -385,6 +394,38 @@ func (c *clientConn) handleDropAccount(e *dropAccountEvent) error {
 	return c.handleSuspendAccount(se)
 }
 
+// handleChangeUser handles the change user event. The login packet is
+// rebuilt with the new user, so that the connection is transferred as the
+// new user. If the authentication fails, the CN server closes the connection.
+func (c *clientConn) handleChangeUser(e *changeUserEvent) error {
+	payload, err := c.mysqlProto.MakeChangeUserHandshakeResp(c.ctx, e.data)
+	if err != nil {
+		c.log.Error("failed to parse change user packet", zap.Error(err))
+		return err
+	}
+	var sequenceID int8
+	if c.handshakePack != nil {
+		sequenceID = c.handshakePack.SequenceID
+	}
+	c.handshakePack = &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: sequenceID,
+		Payload:    payload,
+	}
+	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
+		return err
+	}
+	c.clientInfo.labelInfo = newLabelInfo(c.clientInfo.Tenant, c.mysqlProto.GetConnectAttrs())
+	c.setVarStmts = nil
+	return nil
+}
+
+// handleResetConnection handles the reset connection event.
+func (c *clientConn) handleResetConnection(_ *resetConnectionEvent) error {
+	c.setVarStmts = nil
+	return nil
+}
+
 // Close implements the ClientConn interface.
 func (c *clientConn) Close() error {
 	return nil
@@ -521,3 +521,63 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	cc.SendErrToClient("err msg1")
 	wg.Wait()
 }
+
+func TestStripCompression(t *testing.T) {
+	makePack := func(capabilities uint32, tail ...byte) *frontend.Packet {
+		payload := make([]byte, 4)
+		binary.LittleEndian.PutUint32(payload, capabilities)
+		payload = append(payload, tail...)
+		return &frontend.Packet{Length: int32(len(payload)), SequenceID: 1, Payload: payload}
+	}
+
+	p := makePack(frontend.CLIENT_PROTOCOL_41, 'a')
+	require.Equal(t, p, stripCompression(p))
+
+	p = makePack(frontend.CLIENT_PROTOCOL_41|frontend.CLIENT_COMPRESS, 'a')
+	r := stripCompression(p)
+	require.Equal(t, frontend.CLIENT_PROTOCOL_41, binary.LittleEndian.Uint32(r.Payload))
+	require.Equal(t, int32(5), r.Length)
+	require.Equal(t, byte('a'), r.Payload[4])
+	// The original packet is not changed.
+	require.Equal(t, frontend.CLIENT_PROTOCOL_41|frontend.CLIENT_COMPRESS, binary.LittleEndian.Uint32(p.Payload))
+
+	p = makePack(frontend.CLIENT_PROTOCOL_41|frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM, 'a', 3)
+	r = stripCompression(p)
+	require.Equal(t, frontend.CLIENT_PROTOCOL_41, binary.LittleEndian.Uint32(r.Payload))
+	require.Equal(t, int32(5), r.Length)
+	require.Equal(t, []byte{'a'}, r.Payload[4:])
+}
+
+func TestClientConn_ChangeUser(t *testing.T) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	c.setVarStmts = []string{"set @a=1"}
+
+	var data []byte
+	data = append(data, []byte("tenant2:user2")...)
+	data = append(data, 0)
+	data = append(data, 20)
+	data = append(data, make([]byte, 20)...)
+	data = append(data, []byte("db2")...)
+	data = append(data, 0)
+	data = append(data, 45, 0)
+	data = append(data, []byte(frontend.AuthNativePassword)...)
+	data = append(data, 0)
+	require.NoError(t, c.HandleEvent(context.TODO(), makeChangeUserEvent(data), nil))
+
+	require.Equal(t, Tenant("tenant2"), c.GetTenant())
+	require.Equal(t, "user2", c.clientInfo.username)
+	require.Nil(t, c.setVarStmts)
+	// the connection is transferred as the new user.
+	require.NotNil(t, c.handshakePack)
+	require.Contains(t, string(c.handshakePack.Payload), "tenant2:user2")
+	require.Contains(t, string(c.handshakePack.Payload), "db2")
+
+	c.setVarStmts = []string{"set @a=1"}
+	require.NoError(t, c.HandleEvent(context.TODO(), makeResetConnectionEvent(), nil))
+	require.Nil(t, c.setVarStmts)
+
+	require.Error(t, c.HandleEvent(context.TODO(), makeChangeUserEvent([]byte("u")), nil))
+}
@@ -36,6 +36,10 @@ func (t eventType) String() string {
 		return "SuspendAccount"
 	case TypeDropAccount:
 		return "DropAccount"
+	case TypeChangeUser:
+		return "ChangeUser"
+	case TypeResetConnection:
+		return "ResetConnection"
 	}
 	return "Unknown"
 }
@@ -51,6 +55,10 @@ const (
 	TypeSuspendAccount eventType = 3
 	// TypeDropAccount indicates the drop account statement.
 	TypeDropAccount eventType = 4
+	// TypeChangeUser indicates the COM_CHANGE_USER command.
+	TypeChangeUser eventType = 5
+	// TypeResetConnection indicates the COM_RESET_CONNECTION command.
+	TypeResetConnection eventType = 6
 )
 
 var (
@@ -169,6 +177,14 @@ func makeEvent(req *eventReq) (IEvent, bool) {
 	if req == nil || len(req.msg) < preRecvLen {
 		return nil, false
 	}
+	if isSessionResetCmd(req.msg) {
+		// The commands are sent to the server, and the client connection
+		// keeps the new session information for connection migration.
+		if req.msg[4] == byte(cmdChangeUser) {
+			return makeChangeUserEvent(req.msg[preRecvLen:]), false
+		}
+		return makeResetConnectionEvent(), false
+	}
 	if req.msg[4] == byte(cmdQuery) {
 		stmt := getStatement(req.msg)
 		// Get the event type.
@@ -317,3 +333,43 @@ func makeDropAccountEvent(stmt string, reg *regexp.Regexp) IEvent {
 func (e *dropAccountEvent) eventType() eventType {
 	return TypeDropAccount
 }
+
+// changeUserEvent is the event that COM_CHANGE_USER command is captured.
+// The login information is changed to the new user, which is used to
+// rebuild the connection when it is transferred.
+type changeUserEvent struct {
+	baseEvent
+	// data is the payload of the command without the command byte.
+	data []byte
+}
+
+// makeChangeUserEvent creates an event with TypeChangeUser type.
+func makeChangeUserEvent(data []byte) IEvent {
+	e := &changeUserEvent{
+		// The message buffer is reused, so copy the data.
+		data: append([]byte(nil), data...),
+	}
+	e.typ = TypeChangeUser
+	return e
+}
+
+func (e *changeUserEvent) eventType() eventType {
+	return TypeChangeUser
+}
+
+// resetConnectionEvent is the event that COM_RESET_CONNECTION command is
+// captured. The variables set in the session are cleared.
+type resetConnectionEvent struct {
+	baseEvent
+}
+
+// makeResetConnectionEvent creates an event with TypeResetConnection type.
+func makeResetConnectionEvent() IEvent {
+	e := &resetConnectionEvent{}
+	e.typ = TypeResetConnection
+	return e
+}
+
+func (e *resetConnectionEvent) eventType() eventType {
+	return TypeResetConnection
+}
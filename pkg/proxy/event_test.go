@@ -156,6 +156,29 @@ func TestMakeEvent(t *testing.T) {
 		require.Nil(t, e)
 		require.False(t, r)
 	})
+
+	t.Run("change user and reset connection", func(t *testing.T) {
+		msg := makeSimplePacket("u1")
+		msg[4] = byte(cmdChangeUser)
+		e, r = makeEvent(&eventReq{msg: msg})
+		require.NotNil(t, e)
+		require.False(t, r)
+		require.Equal(t, TypeChangeUser, e.eventType())
+		require.Equal(t, []byte("u1"), e.(*changeUserEvent).data)
+
+		msg = makeSimplePacket("")
+		msg[4] = byte(cmdResetConnection)
+		e, r = makeEvent(&eventReq{msg: msg})
+		require.NotNil(t, e)
+		require.False(t, r)
+		require.Equal(t, TypeResetConnection, e.eventType())
+
+		// the packets from the server are not commands.
+		msg[3] = 1
+		e, r = makeEvent(&eventReq{msg: msg})
+		require.Nil(t, e)
+		require.True(t, r)
+	})
 }
 
 func TestKillQueryEvent(t *testing.T) {
@@ -651,4 +674,10 @@ func TestEventType_String(t *testing.T) {
 
 	e5 := dropAccountEvent{}
 	require.Equal(t, "DropAccount", e5.eventType().String())
+
+	e6 := changeUserEvent{}
+	require.Equal(t, "ChangeUser", e6.eventType().String())
+
+	e7 := resetConnectionEvent{}
+	require.Equal(t, "ResetConnection", e7.eventType().String())
 }
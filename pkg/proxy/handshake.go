@@ -39,9 +39,6 @@ func (c *clientConn) handleHandshakeResp() error {
 		return err
 	}
 	c.mysqlProto.AddSequenceId(1)
-	// Save the login packet in client connection, it will be used
-	// in the future.
-	c.handshakePack = pack
 
 	// Parse the login information and returns whether ssl is needed.
 	// Also, we can get connection attributes from client if it sets
@@ -59,6 +56,9 @@ func (c *clientConn) handleHandshakeResp() error {
 		}
 		return c.handleHandshakeResp()
 	}
+	// Save the login packet in client connection, it will be used
+	// in the future.
+	c.handshakePack = stripCompression(pack)
 
 	// parse tenant information from client login request.
 	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
@@ -69,6 +69,37 @@ func (c *clientConn) handleHandshakeResp() error {
 	return nil
 }
 
+// stripCompression clears the compression capabilities in the login packet
+// which is sent to CN servers. The proxy handles the compressed protocol with
+// the client itself, and keeps the connections to CN servers uncompressed, so
+// that the packets can be parsed in the tunnel.
+func stripCompression(pack *frontend.Packet) *frontend.Packet {
+	if len(pack.Payload) < 4 {
+		return pack
+	}
+	capabilities := binary.LittleEndian.Uint32(pack.Payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		// Only the lower 2 bytes are capabilities in the old protocol.
+		capabilities &= 0xFFFF
+	}
+	if capabilities&(frontend.CLIENT_COMPRESS|frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM) == 0 {
+		return pack
+	}
+	payload := append([]byte(nil), pack.Payload...)
+	payload[0] &^= byte(frontend.CLIENT_COMPRESS)
+	if capabilities&frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		binary.LittleEndian.PutUint32(payload,
+			binary.LittleEndian.Uint32(payload)&^frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+		// The zstd compression level is the last field of the packet.
+		payload = payload[:len(payload)-1]
+	}
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}
+}
+
 // upgradeToTLS upgrades the connection to TLS connection.
 func (c *clientConn) upgradeToTLS() error {
 	if c.tlsConfig == nil {
@@ -52,8 +52,14 @@ const (
 // MySQLCmd is the type indicate the cmd of statement.
 type MySQLCmd byte
 
-// cmdQuery is a query cmd.
-const cmdQuery MySQLCmd = 0x03
+const (
+	// cmdQuery is a query cmd.
+	cmdQuery MySQLCmd = 0x03
+	// cmdChangeUser is the cmd to change the user of the connection.
+	cmdChangeUser MySQLCmd = 0x11
+	// cmdResetConnection is the cmd to reset the session of the connection.
+	cmdResetConnection MySQLCmd = 0x1f
+)
 
 // MySQLConn contains a buffer to save data which may be only part
 // of a packet.
@@ -160,6 +166,9 @@ func (b *msgBuf) preRecv() (int, txnTag, error) {
 		} else if isStmtBegin(b.buf[b.begin+preRecvLen : b.end]) {
 			txnRet = txnBegin
 		}
+	} else if isSessionResetCmd(b.buf[b.begin:b.end]) {
+		// The transaction is rolled back when the session is reset.
+		txnRet = txnEnd
 	}
 
 	// Data length does not count header length, so header length is added to it.
@@ -123,6 +123,22 @@ func TestMySQLConnPreRecv(t *testing.T) {
 		require.Equal(t, txnEnd, txn)
 		require.Equal(t, 13, size)
 	})
+
+	t.Run("protocol_error/reset_connection", func(t *testing.T) {
+		data := makeSimplePacket("")
+		data[4] = byte(cmdResetConnection)
+		src, dst := net.Pipe()
+		go func() {
+			n, err := dst.Write(data[:])
+			require.NoError(t, err)
+			require.Equal(t, 5, n)
+		}()
+		sc := newMySQLConn("source", src, 20, nil, nil)
+		size, txn, err := sc.preRecv()
+		require.NoError(t, err)
+		require.Equal(t, txnEnd, txn)
+		require.Equal(t, 5, size)
+	})
 }
 
 func TestMySQLConnReceive(t *testing.T) {
@@ -74,6 +74,17 @@ func isErrPacket(p []byte) bool {
 	return false
 }
 
+// isSessionResetCmd returns true if []byte is a COM_CHANGE_USER or
+// COM_RESET_CONNECTION packet. The sequence ID of a command from the client
+// is always 0, which distinguishes it from the packets of the server.
+func isSessionResetCmd(p []byte) bool {
+	if len(p) > 4 && p[3] == 0 &&
+		(p[4] == byte(cmdChangeUser) || p[4] == byte(cmdResetConnection)) {
+		return true
+	}
+	return false
+}
+
 // packetToBytes convert Packet to bytes.
 func packetToBytes(p *frontend.Packet) []byte {
 	if p == nil || len(p.Payload) == 0 {
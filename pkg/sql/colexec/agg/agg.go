@@ -24,7 +24,7 @@ import (
 )
 
 func NewUnaryAgg[T1, T2 any](op int, priv AggStruct, isCount bool, ityp, otyp types.Type, grows func(int),
-	eval func([]T2) []T2, merge func(int64, int64, T2, T2, bool, bool, any) (T2, bool),
+	eval func([]T2) ([]T2, error), merge func(int64, int64, T2, T2, bool, bool, any) (T2, bool),
 	fill func(int64, T1, T2, int64, bool, bool) (T2, bool),
 	batchFill func(any, any, int64, int64, []uint64, []int64, *nulls.Nulls) error) Agg[*UnaryAgg[T1, T2]] {
 	return &UnaryAgg[T1, T2]{
@@ -267,18 +267,25 @@ func (a *UnaryAgg[T1, T2]) Eval(m *mpool.MPool) (*vector.Vector, error) {
 		}
 	}
 	if a.otyp.IsVarlen() {
+		vs, err := a.eval(a.vs)
+		if err != nil {
+			return nil, err
+		}
+		a.vs = vs
 		vec := vector.NewVec(a.otyp)
-		a.vs = a.eval(a.vs)
-		vs := (any)(a.vs).([][]byte)
-		if err := vector.AppendBytesList(vec, vs, nil, m); err != nil {
+		if err := vector.AppendBytesList(vec, (any)(a.vs).([][]byte), nil, m); err != nil {
 			vec.Free(m)
 			return nil, err
 		}
 		vec.SetNulls(nsp)
 		return vec, nil
 	}
+	vs, err := a.eval(a.vs)
+	if err != nil {
+		return nil, err
+	}
 	vec := vector.NewVec(a.otyp)
-	if err := vector.AppendFixedList(vec, a.eval(a.vs), nil, m); err != nil {
+	if err := vector.AppendFixedList(vec, vs, nil, m); err != nil {
 		vec.Free(m)
 		return nil, err
 	}
@@ -324,7 +331,7 @@ func (a *UnaryAgg[T1, T2]) MarshalBinary() ([]byte, error) {
 		IsCount:    a.isCount,
 	}
 	switch {
-	case a.otyp.Oid.IsMySQLString():
+	case a.otyp.IsVarlen():
 		source.Da = types.EncodeStringSlice(getUnaryAggStrVs(a))
 	default:
 		source.Da = a.da
@@ -367,7 +374,7 @@ func (a *UnaryAgg[T1, T2]) UnmarshalBinary(data []byte) error {
 
 func setAggValues[T1, T2 any](agg any, typ types.Type) {
 	switch {
-	case typ.Oid.IsMySQLString():
+	case typ.IsVarlen():
 		a := agg.(*UnaryAgg[[]byte, []byte])
 		values := types.DecodeStringSlice(a.da)
 		a.vs = make([][]byte, len(values))
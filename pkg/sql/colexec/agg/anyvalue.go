@@ -26,6 +26,7 @@ type Anyvalue[T any] struct {
 
 var AnyValueSupported = []types.T{
 	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_bit, types.T_year, types.T_enum, types.T_set,
 	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 	types.T_float32, types.T_float64,
 	types.T_date, types.T_datetime,
@@ -55,8 +56,8 @@ func (a *Anyvalue[T]) Grows(size int) {
 	}
 }
 
-func (a *Anyvalue[T]) Eval(vs []T) []T {
-	return vs
+func (a *Anyvalue[T]) Eval(vs []T) ([]T, error) {
+	return vs, nil
 }
 
 func (a *Anyvalue[T]) Fill(i int64, value T, ov T, z int64, isEmpty bool, isNull bool) (T, bool) {
@@ -104,8 +105,8 @@ func (a *StrAnyvalue) Grows(size int) {
 	}
 }
 
-func (a *StrAnyvalue) Eval(vs [][]byte) [][]byte {
-	return vs
+func (a *StrAnyvalue) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (a *StrAnyvalue) Fill(i int64, value []byte, ov []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool) {
@@ -43,12 +43,12 @@ func (a *ApproxCountDistic[T]) Grows(n int) {
 	}
 }
 
-func (a *ApproxCountDistic[T]) Eval(vs []uint64) []uint64 {
+func (a *ApproxCountDistic[T]) Eval(vs []uint64) ([]uint64, error) {
 	for i := range vs {
 		vs[i] = a.Sk[i].Estimate()
 	}
 
-	return vs
+	return vs, nil
 }
 
 func (a *ApproxCountDistic[T]) Fill(n int64, v1 T, v2 uint64, _ int64, isEmpty bool, isNull bool) (uint64, bool) {
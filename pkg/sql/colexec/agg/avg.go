@@ -86,14 +86,14 @@ func (a *Avg[T]) Grows(cnt int) {
 	}
 }
 
-func (a *Avg[T]) Eval(vs []float64) []float64 {
+func (a *Avg[T]) Eval(vs []float64) ([]float64, error) {
 	for i := range vs {
 		if a.Cnts[i] == 0 {
 			continue
 		}
 		vs[i] = vs[i] / float64(a.Cnts[i])
 	}
-	return vs
+	return vs, nil
 }
 
 func (a *Avg[T]) Fill(i int64, value T, ov float64, z int64, isEmpty bool, isNull bool) (float64, bool) {
@@ -139,14 +139,14 @@ func (a *Decimal64Avg) Grows(cnt int) {
 	}
 }
 
-func (a *Decimal64Avg) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (a *Decimal64Avg) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i := range vs {
 		if a.Cnts[i] == 0 {
 			continue
 		}
 		vs[i], _, _ = vs[i].Div(types.Decimal128{B0_63: uint64(a.Cnts[i]), B64_127: 0}, a.Typ.Scale, 0)
 	}
-	return vs
+	return vs, nil
 }
 
 func (a *Decimal64Avg) Fill(i int64, value types.Decimal64, ov types.Decimal128, z int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -220,14 +220,14 @@ func (a *Decimal128Avg) Grows(cnt int) {
 	}
 }
 
-func (a *Decimal128Avg) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (a *Decimal128Avg) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i := range vs {
 		if a.Cnts[i] == 0 {
 			continue
 		}
 		vs[i], _, _ = vs[i].Div(types.Decimal128{B0_63: uint64(a.Cnts[i]), B64_127: 0}, a.Typ.Scale, 0)
 	}
-	return vs
+	return vs, nil
 }
 
 func (a *Decimal128Avg) Fill(i int64, value types.Decimal128, ov types.Decimal128, z int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
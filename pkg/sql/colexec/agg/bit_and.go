@@ -45,8 +45,8 @@ func NewBitAnd[T1 types.Ints | types.UInts | types.Floats]() *BitAnd[T1] {
 func (ba *BitAnd[T1]) Grows(_ int) {
 }
 
-func (ba *BitAnd[T1]) Eval(vs []uint64) []uint64 {
-	return vs
+func (ba *BitAnd[T1]) Eval(vs []uint64) ([]uint64, error) {
+	return vs, nil
 }
 
 func (ba *BitAnd[T1]) Merge(groupIndex1, groupIndex2 int64, x, y uint64, isEmpty1 bool, isEmpty2 bool, agg any) (uint64, bool) {
@@ -94,8 +94,8 @@ func NewBitAndBinary() *BitAndBinary {
 func (bab *BitAndBinary) Grows(_ int) {
 }
 
-func (bab *BitAndBinary) Eval(vs [][]byte) [][]byte {
-	return vs
+func (bab *BitAndBinary) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (bab *BitAndBinary) Merge(gNum1, gNum2 int64, v1, v2 []byte, empty1, empty2 bool, _ any) ([]byte, bool) {
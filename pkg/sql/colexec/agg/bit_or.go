@@ -45,8 +45,8 @@ func NewBitOr[T1 types.Ints | types.UInts | types.Floats]() *BitOr[T1] {
 func (bo *BitOr[T1]) Grows(_ int) {
 }
 
-func (bo *BitOr[T1]) Eval(vs []uint64) []uint64 {
-	return vs
+func (bo *BitOr[T1]) Eval(vs []uint64) ([]uint64, error) {
+	return vs, nil
 }
 
 func (bo *BitOr[T1]) Merge(_, _ int64, x, y uint64, IsEmpty1 bool, IsEmpty2 bool, _ any) (uint64, bool) {
@@ -101,8 +101,8 @@ func NewBitOrBinary() *BitOrBinary {
 func (bab *BitOrBinary) Grows(_ int) {
 }
 
-func (bab *BitOrBinary) Eval(vs [][]byte) [][]byte {
-	return vs
+func (bab *BitOrBinary) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (bab *BitOrBinary) Merge(gNum1, gNum2 int64, v1, v2 []byte, empty1, empty2 bool, _ any) ([]byte, bool) {
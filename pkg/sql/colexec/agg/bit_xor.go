@@ -45,8 +45,8 @@ func NewBitXor[T1 types.Ints | types.UInts | types.Floats]() *BitXor[T1] {
 func (bx *BitXor[T1]) Grows(_ int) {
 }
 
-func (bx *BitXor[T1]) Eval(vs []uint64) []uint64 {
-	return vs
+func (bx *BitXor[T1]) Eval(vs []uint64) ([]uint64, error) {
+	return vs, nil
 }
 
 func (bx *BitXor[T1]) Merge(_, _ int64, x, y uint64, IsEmpty1 bool, IsEmpty2 bool, _ any) (uint64, bool) {
@@ -108,8 +108,8 @@ func NewBitXorBinary() *BitXorBinary {
 func (bab *BitXorBinary) Grows(_ int) {
 }
 
-func (bab *BitXorBinary) Eval(vs [][]byte) [][]byte {
-	return vs
+func (bab *BitXorBinary) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (bab *BitXorBinary) Merge(gNum1, gNum2 int64, v1, v2 []byte, empty1, empty2 bool, _ any) ([]byte, bool) {
@@ -39,8 +39,8 @@ func NewCount[T1 types.OrderedT | Decimal128AndString](isStar bool) *Count[T1] {
 func (c *Count[T1]) Grows(_ int) {
 }
 
-func (c *Count[T1]) Eval(vs []int64) []int64 {
-	return vs
+func (c *Count[T1]) Eval(vs []int64) ([]int64, error) {
+	return vs, nil
 }
 
 func (c *Count[T1]) Merge(_, _ int64, x, y int64, _ bool, _ bool, _ any) (int64, bool) {
@@ -25,7 +25,7 @@ import (
 )
 
 func NewUnaryDistAgg[T1, T2 any](op int, priv AggStruct, isCount bool, ityp, otyp types.Type, grows func(int),
-	eval func([]T2) []T2, merge func(int64, int64, T2, T2, bool, bool, any) (T2, bool),
+	eval func([]T2) ([]T2, error), merge func(int64, int64, T2, T2, bool, bool, any) (T2, bool),
 	fill func(int64, T1, T2, int64, bool, bool) (T2, bool)) Agg[*UnaryDistAgg[T1, T2]] {
 	return &UnaryDistAgg[T1, T2]{
 		op:      op,
@@ -334,18 +334,25 @@ func (a *UnaryDistAgg[T1, T2]) Eval(m *mpool.MPool) (*vector.Vector, error) {
 		}
 	}
 	if a.otyp.IsVarlen() {
+		vs, err := a.eval(a.vs)
+		if err != nil {
+			return nil, err
+		}
+		a.vs = vs
 		vec := vector.NewVec(a.otyp)
-		a.vs = a.eval(a.vs)
-		vs := (any)(a.vs).([][]byte)
-		if err := vector.AppendBytesList(vec, vs, nil, m); err != nil {
+		if err := vector.AppendBytesList(vec, (any)(a.vs).([][]byte), nil, m); err != nil {
 			vec.Free(m)
 			return nil, err
 		}
 		vec.SetNulls(nsp)
 		return vec, nil
 	}
+	vs, err := a.eval(a.vs)
+	if err != nil {
+		return nil, err
+	}
 	vec := vector.NewVec(a.otyp)
-	if err := vector.AppendFixedList(vec, a.eval(a.vs), nil, m); err != nil {
+	if err := vector.AppendFixedList(vec, vs, nil, m); err != nil {
 		vec.Free(m)
 		return nil, err
 	}
@@ -391,7 +398,7 @@ func (a *UnaryDistAgg[T1, T2]) MarshalBinary() ([]byte, error) {
 		Srcs:       a.srcs,
 	}
 	switch {
-	case a.otyp.Oid.IsMySQLString():
+	case a.otyp.IsVarlen():
 		source.Da = types.EncodeStringSlice(getDistAggStrVs(a))
 	default:
 		source.Da = a.da
@@ -449,7 +456,7 @@ func (a *UnaryDistAgg[T1, T2]) UnmarshalBinary(data []byte) error {
 
 func setDistAggValues[T1, T2 any](agg any, typ types.Type) {
 	switch {
-	case typ.Oid.IsMySQLString():
+	case typ.IsVarlen():
 		a := agg.(*UnaryDistAgg[[]byte, []byte])
 		values := types.DecodeStringSlice(a.da)
 		a.vs = make([][]byte, len(values))
@@ -0,0 +1,110 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// JsonAgg collects the json values of each group, the planner feeds
+// json_arrayagg(x) with json_array(x) and json_objectagg(k, v) with
+// json_object(k, v), so the values of a group are concatenated or merged
+// into the result when the agg is evaluated.
+type JsonAgg struct {
+	IsObject bool
+	Vals     [][][]byte
+}
+
+var JsonAggSupported = []types.T{
+	types.T_json,
+}
+
+func JsonAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+func NewJsonAgg(isObject bool) *JsonAgg {
+	return &JsonAgg{IsObject: isObject}
+}
+
+func (a *JsonAgg) Grows(cnt int) {
+	for i := 0; i < cnt; i++ {
+		a.Vals = append(a.Vals, nil)
+	}
+}
+
+func (a *JsonAgg) Eval(vs [][]byte) ([][]byte, error) {
+	for i := range vs {
+		if len(a.Vals[i]) == 0 {
+			continue
+		}
+		bjs := make([]bytejson.ByteJson, len(a.Vals[i]))
+		for j, v := range a.Vals[i] {
+			bjs[j] = types.DecodeJson(v)
+		}
+		var ret bytejson.ByteJson
+		var err error
+		if a.IsObject {
+			if ret, err = bytejson.MergeObjects(bjs); err != nil {
+				return nil, err
+			}
+		} else {
+			ret = bytejson.ConcatArrays(bjs)
+		}
+		if vs[i], err = ret.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+func (a *JsonAgg) Fill(i int64, value []byte, ov []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool) {
+	if isNull {
+		return ov, isEmpty
+	}
+	v := make([]byte, len(value))
+	copy(v, value)
+	for j := int64(0); j < z; j++ {
+		a.Vals[i] = append(a.Vals[i], v)
+	}
+	return ov, false
+}
+
+func (a *JsonAgg) Merge(xIndex int64, yIndex int64, x []byte, _ []byte, xEmpty bool, yEmpty bool, yAgg any) ([]byte, bool) {
+	if yEmpty {
+		return x, xEmpty
+	}
+	a.Vals[xIndex] = append(a.Vals[xIndex], yAgg.(*JsonAgg).Vals[yIndex]...)
+	return x, false
+}
+
+func (a *JsonAgg) MarshalBinary() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+func (a *JsonAgg) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, a)
+}
+
+func newJsonAgg(op int, typ types.Type, dist bool) Agg[any] {
+	aggPriv := NewJsonAgg(op == AggregateJsonObjectAgg)
+	if dist {
+		return NewUnaryDistAgg(op, aggPriv, false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+	}
+	return NewUnaryAgg(op, aggPriv, false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeJsonForAggTest(t *testing.T, text string) []byte {
+	bj, err := types.ParseStringToByteJson(text)
+	require.NoError(t, err)
+	dt, err := bj.Marshal()
+	require.NoError(t, err)
+	return dt
+}
+
+func TestJsonAgg(t *testing.T) {
+	x, y := NewJsonAgg(false), NewJsonAgg(false)
+	x.Grows(2)
+	y.Grows(1)
+	x.Fill(0, encodeJsonForAggTest(t, `[1]`), nil, 2, true, false)
+	x.Fill(0, nil, nil, 1, false, true)
+	y.Fill(0, encodeJsonForAggTest(t, `["a"]`), nil, 1, true, false)
+	_, empty := x.Merge(0, 0, nil, nil, false, false, y)
+	require.False(t, empty)
+
+	data, err := x.MarshalBinary()
+	require.NoError(t, err)
+	ret := NewJsonAgg(false)
+	require.NoError(t, ret.UnmarshalBinary(data))
+	vs, err := ret.Eval(make([][]byte, 2))
+	require.NoError(t, err)
+	require.Equal(t, `[1, 1, "a"]`, types.DecodeJson(vs[0]).String())
+	require.Nil(t, vs[1])
+
+	obj := NewJsonAgg(true)
+	obj.Grows(1)
+	obj.Fill(0, encodeJsonForAggTest(t, `{"a": 1}`), nil, 1, true, false)
+	obj.Fill(0, encodeJsonForAggTest(t, `{"b": 2, "a": 3}`), nil, 1, false, false)
+	vs, err = obj.Eval(make([][]byte, 1))
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 3, "b": 2}`, types.DecodeJson(vs[0]).String())
+
+	// the values of json_objectagg must be objects
+	obj = NewJsonAgg(true)
+	obj.Grows(1)
+	obj.Fill(0, encodeJsonForAggTest(t, `[1]`), nil, 1, true, false)
+	_, err = obj.Eval(make([][]byte, 1))
+	require.Error(t, err)
+}
@@ -39,6 +39,7 @@ type UuidMax struct {
 
 var MaxSupported = []types.T{
 	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_bit, types.T_year, types.T_enum, types.T_set,
 	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 	types.T_float32, types.T_float64,
 	types.T_date, types.T_datetime,
@@ -61,8 +62,8 @@ func NewMax[T Compare]() *Max[T] {
 func (m *Max[T]) Grows(_ int) {
 }
 
-func (m *Max[T]) Eval(vs []T) []T {
-	return vs
+func (m *Max[T]) Eval(vs []T) ([]T, error) {
+	return vs, nil
 }
 
 func (m *Max[T]) Fill(_ int64, value T, ov T, _ int64, isEmpty bool, isNull bool) (T, bool) {
@@ -99,8 +100,8 @@ func NewD64Max() *Decimal64Max {
 func (m *Decimal64Max) Grows(_ int) {
 }
 
-func (m *Decimal64Max) Eval(vs []types.Decimal64) []types.Decimal64 {
-	return vs
+func (m *Decimal64Max) Eval(vs []types.Decimal64) ([]types.Decimal64, error) {
+	return vs, nil
 }
 
 func (m *Decimal64Max) Fill(_ int64, value types.Decimal64, ov types.Decimal64, _ int64, isEmpty bool, isNull bool) (types.Decimal64, bool) {
@@ -137,8 +138,8 @@ func NewD128Max() *Decimal128Max {
 func (m *Decimal128Max) Grows(_ int) {
 }
 
-func (m *Decimal128Max) Eval(vs []types.Decimal128) []types.Decimal128 {
-	return vs
+func (m *Decimal128Max) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
+	return vs, nil
 }
 
 func (m *Decimal128Max) Fill(_ int64, value types.Decimal128, ov types.Decimal128, _ int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -175,8 +176,8 @@ func NewBoolMax() *BoolMax {
 func (m *BoolMax) Grows(_ int) {
 }
 
-func (m *BoolMax) Eval(vs []bool) []bool {
-	return vs
+func (m *BoolMax) Eval(vs []bool) ([]bool, error) {
+	return vs, nil
 }
 
 func (m *BoolMax) Fill(_ int64, value bool, ov bool, _ int64, isEmpty bool, isNull bool) (bool, bool) {
@@ -214,8 +215,8 @@ func NewStrMax() *StrMax {
 func (m *StrMax) Grows(_ int) {
 }
 
-func (m *StrMax) Eval(vs [][]byte) [][]byte {
-	return vs
+func (m *StrMax) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (m *StrMax) Fill(_ int64, value []byte, ov []byte, _ int64, isEmpty bool, isNull bool) ([]byte, bool) {
@@ -254,8 +255,8 @@ func NewUuidMax() *UuidMax {
 func (m *UuidMax) Grows(_ int) {
 }
 
-func (m *UuidMax) Eval(vs []types.Uuid) []types.Uuid {
-	return vs
+func (m *UuidMax) Eval(vs []types.Uuid) ([]types.Uuid, error) {
+	return vs, nil
 }
 
 func (m *UuidMax) Fill(_ int64, value types.Uuid, ov types.Uuid, _ int64, isEmpty bool, isNull bool) (types.Uuid, bool) {
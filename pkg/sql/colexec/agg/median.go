@@ -184,7 +184,7 @@ func (m *Median[T]) Grows(cnt int) {
 	}
 }
 
-func (m *Median[T]) Eval(vs []float64) []float64 {
+func (m *Median[T]) Eval(vs []float64) ([]float64, error) {
 	for i := range vs {
 		cnt := len(m.Vals[i])
 		if cnt == 0 {
@@ -199,7 +199,7 @@ func (m *Median[T]) Eval(vs []float64) []float64 {
 			vs[i] = float64(m.Vals[i][cnt>>1]+m.Vals[i][(cnt>>1)-1]) / 2
 		}
 	}
-	return vs
+	return vs, nil
 }
 
 func (m *Median[T]) Fill(i int64, value T, _ float64, z int64, isEmpty bool, isNull bool) (float64, bool) {
@@ -256,7 +256,7 @@ func (m *Decimal64Median) Grows(cnt int) {
 	}
 }
 
-func (m *Decimal64Median) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (m *Decimal64Median) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i := range vs {
 		cnt := len(m.Vals[i])
 		if cnt == 0 {
@@ -281,7 +281,7 @@ func (m *Decimal64Median) Eval(vs []types.Decimal128) []types.Decimal128 {
 			}
 		}
 	}
-	return vs
+	return vs, nil
 }
 
 func (m *Decimal64Median) Fill(i int64, value types.Decimal64, ov types.Decimal128, z int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -337,7 +337,7 @@ func (m *Decimal128Median) Grows(cnt int) {
 	}
 }
 
-func (m *Decimal128Median) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (m *Decimal128Median) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i := range vs {
 		cnt := len(m.Vals[i])
 		if cnt == 0 {
@@ -360,7 +360,7 @@ func (m *Decimal128Median) Eval(vs []types.Decimal128) []types.Decimal128 {
 			}
 		}
 	}
-	return vs
+	return vs, nil
 }
 
 func (m *Decimal128Median) Fill(i int64, value types.Decimal128, _ types.Decimal128, z int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -39,6 +39,7 @@ type UuidMin struct {
 
 var MinSupported = []types.T{
 	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_bit, types.T_year, types.T_enum, types.T_set,
 	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 	types.T_float32, types.T_float64,
 	types.T_date, types.T_datetime,
@@ -61,8 +62,8 @@ func NewMin[T Compare]() *Min[T] {
 func (m *Min[T]) Grows(_ int) {
 }
 
-func (m *Min[T]) Eval(vs []T) []T {
-	return vs
+func (m *Min[T]) Eval(vs []T) ([]T, error) {
+	return vs, nil
 }
 
 func (m *Min[T]) Fill(_ int64, value T, ov T, _ int64, isEmpty bool, isNull bool) (T, bool) {
@@ -99,8 +100,8 @@ func NewD64Min() *Decimal64Min {
 func (m *Decimal64Min) Grows(_ int) {
 }
 
-func (m *Decimal64Min) Eval(vs []types.Decimal64) []types.Decimal64 {
-	return vs
+func (m *Decimal64Min) Eval(vs []types.Decimal64) ([]types.Decimal64, error) {
+	return vs, nil
 }
 
 func (m *Decimal64Min) Fill(_ int64, value types.Decimal64, ov types.Decimal64, _ int64, isEmpty bool, isNull bool) (types.Decimal64, bool) {
@@ -137,8 +138,8 @@ func NewD128Min() *Decimal128Min {
 func (m *Decimal128Min) Grows(_ int) {
 }
 
-func (m *Decimal128Min) Eval(vs []types.Decimal128) []types.Decimal128 {
-	return vs
+func (m *Decimal128Min) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
+	return vs, nil
 }
 
 func (m *Decimal128Min) Fill(_ int64, value types.Decimal128, ov types.Decimal128, _ int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -175,8 +176,8 @@ func NewBoolMin() *BoolMin {
 func (m *BoolMin) Grows(_ int) {
 }
 
-func (m *BoolMin) Eval(vs []bool) []bool {
-	return vs
+func (m *BoolMin) Eval(vs []bool) ([]bool, error) {
+	return vs, nil
 }
 
 func (m *BoolMin) Fill(_ int64, value bool, ov bool, _ int64, isEmpty bool, isNull bool) (bool, bool) {
@@ -214,8 +215,8 @@ func NewStrMin() *StrMin {
 func (m *StrMin) Grows(_ int) {
 }
 
-func (m *StrMin) Eval(vs [][]byte) [][]byte {
-	return vs
+func (m *StrMin) Eval(vs [][]byte) ([][]byte, error) {
+	return vs, nil
 }
 
 func (m *StrMin) Fill(_ int64, value []byte, ov []byte, _ int64, isEmpty bool, isNull bool) ([]byte, bool) {
@@ -254,8 +255,8 @@ func NewUuidMin() *UuidMin {
 func (m *UuidMin) Grows(_ int) {
 }
 
-func (m *UuidMin) Eval(vs []types.Uuid) []types.Uuid {
-	return vs
+func (m *UuidMin) Eval(vs []types.Uuid) ([]types.Uuid, error) {
+	return vs, nil
 }
 
 func (m *UuidMin) Fill(_ int64, value types.Uuid, ov types.Uuid, _ int64, isEmpty bool, isNull bool) (types.Uuid, bool) {
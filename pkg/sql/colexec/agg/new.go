@@ -48,6 +48,8 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = StdDevPopReturnType([]types.Type{typ})
 	case AggregateMedian:
 		otyp = MedianReturnType([]types.Type{typ})
+	case AggregateJsonArrayAgg, AggregateJsonObjectAgg:
+		otyp = JsonAggReturnType([]types.Type{typ})
 	}
 	if otyp.Oid == types.T_any {
 		return typ, moerr.NewInternalErrorNoCtx("'%v' not support %s", typ, Names[op])
@@ -85,6 +87,8 @@ func New(op int, dist bool, typ types.Type) (Agg[any], error) {
 		return newAnyValue(typ, dist), nil
 	case AggregateMedian:
 		return newMedian(typ, dist), nil
+	case AggregateJsonArrayAgg, AggregateJsonObjectAgg:
+		return newJsonAgg(op, typ, dist), nil
 	}
 	panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -103,11 +107,11 @@ func newCount(typ types.Type, dist bool, isStar bool) Agg[any] {
 		return newGenericCount[int64](typ, dist, isStar)
 	case types.T_uint8:
 		return newGenericCount[uint8](typ, dist, isStar)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return newGenericCount[uint16](typ, dist, isStar)
 	case types.T_uint32:
 		return newGenericCount[uint32](typ, dist, isStar)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return newGenericCount[uint64](typ, dist, isStar)
 	case types.T_float32:
 		return newGenericCount[float32](typ, dist, isStar)
@@ -121,6 +125,8 @@ func newCount(typ types.Type, dist bool, isStar bool) Agg[any] {
 		return newGenericCount[[]byte](typ, dist, isStar)
 	case types.T_json:
 		return newGenericCount[[]byte](typ, dist, isStar)
+	case types.T_array_float32:
+		return newGenericCount[[]byte](typ, dist, isStar)
 	case types.T_text:
 		return newGenericCount[[]byte](typ, dist, isStar)
 	case types.T_binary:
@@ -159,11 +165,11 @@ func newAnyValue(typ types.Type, dist bool) Agg[any] {
 		return newGenericAnyValue[int64](typ, dist)
 	case types.T_uint8:
 		return newGenericAnyValue[uint8](typ, dist)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return newGenericAnyValue[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericAnyValue[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return newGenericAnyValue[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericAnyValue[float32](typ, dist)
@@ -293,11 +299,11 @@ func newMax(typ types.Type, dist bool) Agg[any] {
 		return newGenericMax[int64](typ, dist)
 	case types.T_uint8:
 		return newGenericMax[uint8](typ, dist)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return newGenericMax[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericMax[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return newGenericMax[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericMax[float32](typ, dist)
@@ -387,11 +393,11 @@ func newMin(typ types.Type, dist bool) Agg[any] {
 		return newGenericMin[int64](typ, dist)
 	case types.T_uint8:
 		return newGenericMin[uint8](typ, dist)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return newGenericMin[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericMin[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return newGenericMin[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericMin[float32](typ, dist)
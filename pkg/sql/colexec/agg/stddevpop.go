@@ -64,12 +64,14 @@ func (sdp *Stddevpop[T1]) Grows(sizes int) {
 	sdp.Variance.Grows(sizes)
 }
 
-func (sdp *Stddevpop[T1]) Eval(vs []float64) []float64 {
-	sdp.Variance.Eval(vs)
+func (sdp *Stddevpop[T1]) Eval(vs []float64) ([]float64, error) {
+	if _, err := sdp.Variance.Eval(vs); err != nil {
+		return nil, err
+	}
 	for i, v := range vs {
 		vs[i] = math.Sqrt(v)
 	}
-	return vs
+	return vs, nil
 }
 
 func (sdp *Stddevpop[T1]) Merge(groupIndex1, groupIndex2 int64, x, y float64, IsEmpty1 bool, IsEmpty2 bool, agg any) (float64, bool) {
@@ -101,14 +103,16 @@ func (s *StdD64) Grows(size int) {
 	s.Variance.Grows(size)
 }
 
-func (s *StdD64) Eval(vs []types.Decimal128) []types.Decimal128 {
-	s.Variance.Eval(vs)
+func (s *StdD64) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
+	if _, err := s.Variance.Eval(vs); err != nil {
+		return nil, err
+	}
 	for i, v := range vs {
 		tmp := math.Sqrt(types.Decimal128ToFloat64(v, s.Variance.ScaleDivMul))
 		d, _ := types.Decimal128FromFloat64(tmp, 38, s.Variance.ScaleDivMul)
 		vs[i] = d
 	}
-	return vs
+	return vs, nil
 }
 
 func (s *StdD64) Merge(groupIndex1, groupIndex2 int64, x, y types.Decimal128, IsEmpty1 bool, IsEmpty2 bool, agg any) (types.Decimal128, bool) {
@@ -140,14 +144,16 @@ func (s *StdD128) Grows(size int) {
 	s.Variance.Grows(size)
 }
 
-func (s *StdD128) Eval(vs []types.Decimal128) []types.Decimal128 {
-	s.Variance.Eval(vs)
+func (s *StdD128) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
+	if _, err := s.Variance.Eval(vs); err != nil {
+		return nil, err
+	}
 	for i, v := range vs {
 		tmp := math.Sqrt(types.Decimal128ToFloat64(v, s.Variance.ScaleDivMul))
 		d, _ := types.Decimal128FromFloat64(tmp, 38, s.Variance.ScaleDivMul)
 		vs[i] = d
 	}
-	return vs
+	return vs, nil
 }
 
 func (s *StdD128) Merge(groupIndex1, groupIndex2 int64, x, y types.Decimal128, IsEmpty1 bool, IsEmpty2 bool, agg any) (types.Decimal128, bool) {
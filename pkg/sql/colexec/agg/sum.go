@@ -67,8 +67,8 @@ func NewSum[T1 Numeric, T2 ReturnTyp]() *Sum[T1, T2] {
 func (s *Sum[T1, T2]) Grows(_ int) {
 }
 
-func (s *Sum[T1, T2]) Eval(vs []T2) []T2 {
-	return vs
+func (s *Sum[T1, T2]) Eval(vs []T2) ([]T2, error) {
+	return vs, nil
 }
 
 func (s *Sum[T1, T2]) Fill(_ int64, value T1, ov T2, z int64, isEmpty bool, isNull bool) (T2, bool) {
@@ -104,8 +104,8 @@ func NewD64Sum() *Decimal64Sum {
 func (s *Decimal64Sum) Grows(_ int) {
 }
 
-func (s *Decimal64Sum) Eval(vs []types.Decimal64) []types.Decimal64 {
-	return vs
+func (s *Decimal64Sum) Eval(vs []types.Decimal64) ([]types.Decimal64, error) {
+	return vs, nil
 }
 
 func (s *Decimal64Sum) Fill(_ int64, value types.Decimal64, ov types.Decimal64, z int64, isEmpty bool, isNull bool) (types.Decimal64, bool) {
@@ -144,8 +144,8 @@ func NewD128Sum() *Decimal128Sum {
 func (s *Decimal128Sum) Grows(_ int) {
 }
 
-func (s *Decimal128Sum) Eval(vs []types.Decimal128) []types.Decimal128 {
-	return vs
+func (s *Decimal128Sum) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
+	return vs, nil
 }
 
 func (s *Decimal128Sum) Fill(_ int64, value types.Decimal128, ov types.Decimal128, z int64, isEmpty bool, isNull bool) (types.Decimal128, bool) {
@@ -44,6 +44,8 @@ const (
 	AggregateAnyValue
 	AggregateMedian
 	AggregateGroupConcat
+	AggregateJsonArrayAgg
+	AggregateJsonObjectAgg
 )
 
 var Names = [...]string{
@@ -62,6 +64,8 @@ var Names = [...]string{
 	AggregateAnyValue:            "any",
 	AggregateMedian:              "median",
 	AggregateGroupConcat:         "group_concat",
+	AggregateJsonArrayAgg:        "json_arrayagg",
+	AggregateJsonObjectAgg:       "json_objectagg",
 }
 
 type Aggregate struct {
@@ -171,7 +175,7 @@ type UnaryAgg[T1, T2 any] struct {
 	// grows used for add groups
 	grows func(int)
 	// eval used to get final aggregated value
-	eval func([]T2) []T2
+	eval func([]T2) ([]T2, error)
 	// merge
 	// 	first argument is the group number to be merged
 	//  second argument is the group number used to merge
@@ -225,7 +229,7 @@ type UnaryDistAgg[T1, T2 any] struct {
 	// grows used for add groups
 	grows func(int)
 	// eval used to get final aggregated value
-	eval func([]T2) []T2
+	eval func([]T2) ([]T2, error)
 	// merge
 	// 	first argument is the group number to be merged
 	//  second argument is the group number used to merge
@@ -94,12 +94,12 @@ func (variance *Variance[T1]) Grows(count int) {
 	}
 }
 
-func (variance *Variance[T1]) Eval(vs []float64) []float64 {
+func (variance *Variance[T1]) Eval(vs []float64) ([]float64, error) {
 	for i, v := range vs {
 		avg := (variance.Sum[i]) / (variance.Counts[i])
 		vs[i] = (v)/(variance.Counts[i]) - math.Pow(avg, 2)
 	}
-	return vs
+	return vs, nil
 }
 
 func (variance *Variance[T1]) Merge(groupIndex1, groupIndex2 int64, x, y float64, IsEmpty1 bool, IsEmpty2 bool, agg any) (float64, bool) {
@@ -182,7 +182,7 @@ func (v *VD64) Grows(cnt int) {
 	}
 }
 
-func (v *VD64) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (v *VD64) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i, k := range vs {
 		if v.Counts[i] == 1 {
 			vs[i] = types.Decimal128{B0_63: 0, B64_127: 0}
@@ -193,7 +193,7 @@ func (v *VD64) Eval(vs []types.Decimal128) []types.Decimal128 {
 		d, _, _ := k.Div(types.Decimal128{B0_63: uint64(v.Counts[i]), B64_127: 0}, v.ScaleMul, 0)
 		vs[i], _, _ = d.Sub(a2, v.ScaleMulDiv, v.ScaleDivMul)
 	}
-	return vs
+	return vs, nil
 }
 
 func (v *VD64) Merge(xIndex, yIndex int64, x types.Decimal128, y types.Decimal128, xEmpty bool, yEmpty bool, agg any) (types.Decimal128, bool) {
@@ -278,7 +278,7 @@ func (v *VD128) Grows(cnt int) {
 	}
 }
 
-func (v *VD128) Eval(vs []types.Decimal128) []types.Decimal128 {
+func (v *VD128) Eval(vs []types.Decimal128) ([]types.Decimal128, error) {
 	for i, k := range vs {
 		if v.Counts[i] == 1 {
 			vs[i] = types.Decimal128{B0_63: 0, B64_127: 0}
@@ -289,7 +289,7 @@ func (v *VD128) Eval(vs []types.Decimal128) []types.Decimal128 {
 		d, _, _ := k.Div(types.Decimal128{B0_63: uint64(v.Counts[i]), B64_127: 0}, v.ScaleMul, 0)
 		vs[i], _, _ = d.Sub(a2, v.ScaleMulDiv, v.ScaleDivMul)
 	}
-	return vs
+	return vs, nil
 }
 
 func (v *VD128) Merge(xIndex, yIndex int64, x types.Decimal128, y types.Decimal128, xEmpty bool, yEmpty bool, agg any) (types.Decimal128, bool) {
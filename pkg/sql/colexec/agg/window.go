@@ -0,0 +1,63 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// special ids of the pure window functions.
+// aggregate functions used with an OVER clause keep their aggregate id.
+const (
+	WinRowNumber = iota
+	WinRank
+	WinDenseRank
+	WinPercentRank
+	WinCumeDist
+	WinNtile
+	WinLag
+	WinLead
+	WinFirstValue
+	WinLastValue
+	WinNthValue
+)
+
+var WinNames = [...]string{
+	WinRowNumber:   "row_number",
+	WinRank:        "rank",
+	WinDenseRank:   "dense_rank",
+	WinPercentRank: "percent_rank",
+	WinCumeDist:    "cume_dist",
+	WinNtile:       "ntile",
+	WinLag:         "lag",
+	WinLead:        "lead",
+	WinFirstValue:  "first_value",
+	WinLastValue:   "last_value",
+	WinNthValue:    "nth_value",
+}
+
+// WinRankReturnType is the return type of row_number, rank, dense_rank and ntile.
+func WinRankReturnType(_ []types.Type) types.Type {
+	return types.T_int64.ToType()
+}
+
+// WinDistReturnType is the return type of percent_rank and cume_dist.
+func WinDistReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+// WinValueReturnType is the return type of lag, lead, first_value, last_value and nth_value,
+// which is the type of their first argument.
+func WinValueReturnType(typs []types.Type) types.Type {
+	return typs[0]
+}
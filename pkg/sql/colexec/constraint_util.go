@@ -168,8 +168,88 @@ func WriteUniqueTable(s3Writers []*S3Writer, proc *process.Process, updateBatch
 	}()
 
 	uIdx := 0
+	writeIndexTable := func() error {
+		if s3Writers == nil {
+			rel := rels[uIdx]
+			err := rel.Write(proc.Ctx, ukBatch)
+			if err != nil {
+				return err
+			}
+			uIdx++
+		} else {
+			uIdx++
+			err := s3Writers[uIdx].WriteS3Batch(ukBatch, proc)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	for _, indexDef := range tableDef.Indexes {
-		if !indexDef.Unique {
+		if !util.HasIndexTable(indexDef) {
+			continue
+		}
+
+		if util.IsFullTextIndex(indexDef) {
+			if pkPos == -1 {
+				return moerr.NewInternalError(proc.Ctx, "fulltext index on table without primary key")
+			}
+			vs := make([]*vector.Vector, len(indexDef.Parts))
+			for i, column := range indexDef.Parts {
+				vs[i] = updateBatch.Vecs[updateNameToPos[column]]
+			}
+			if ukBatch != nil {
+				ukBatch.Clean(proc.Mp())
+			}
+			var err error
+			ukBatch, err = util.BuildFullTextIndexBatch(vs, updateBatch.Vecs[pkPos], indexDef.IndexAlgoParams, proc)
+			if err != nil {
+				return err
+			}
+			if err = writeIndexTable(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if util.IsIvfFlatIndex(indexDef) {
+			if pkPos == -1 {
+				return moerr.NewInternalError(proc.Ctx, "ivfflat index on table without primary key")
+			}
+			if ukBatch != nil {
+				ukBatch.Clean(proc.Mp())
+			}
+			// the new vectors are unassigned until the index is rebuilt
+			var err error
+			ukBatch, err = util.BuildIvfFlatIndexBatch(updateBatch.Vecs[updateNameToPos[indexDef.Parts[0]]], updateBatch.Vecs[pkPos], nil, proc)
+			if err != nil {
+				return err
+			}
+			if err = writeIndexTable(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if util.IsExpressionIndex(indexDef) {
+			if pkPos == -1 {
+				return moerr.NewInternalError(proc.Ctx, "expression index on table without primary key")
+			}
+			vec, err := EvalExpressionIndex(proc, indexDef, updateBatch, updateNameToPos)
+			if err != nil {
+				return err
+			}
+			if ukBatch != nil {
+				ukBatch.Clean(proc.Mp())
+			}
+			ukBatch, err = util.BuildExpressionIndexBatch(vec, updateBatch.Vecs[pkPos], proc)
+			vec.Free(proc.Mp())
+			if err != nil {
+				return err
+			}
+			if err = writeIndexTable(); err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -187,6 +267,9 @@ func WriteUniqueTable(s3Writers []*S3Writer, proc *process.Process, updateBatch
 			ukBatch = batch.New(true, []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName})
 		}
 
+		if ukBatch != nil {
+			ukBatch.Clean(proc.Mp())
+		}
 		var vec *vector.Vector
 		var bitMap *nulls.Nulls
 		if colCount == 1 {
@@ -208,25 +291,63 @@ func WriteUniqueTable(s3Writers []*S3Writer, proc *process.Process, updateBatch
 			ukBatch.SetVector(1, vec)
 		}
 
-		if s3Writers == nil {
-			rel := rels[uIdx]
-			err := rel.Write(proc.Ctx, ukBatch)
-			if err != nil {
-				return err
-			}
-			uIdx++
-		} else {
-			uIdx++
-			err := s3Writers[uIdx].WriteS3Batch(ukBatch, proc)
-			if err != nil {
-				return err
-			}
+		if err := writeIndexTable(); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// EvalExpressionIndex evaluates the expression of the expression index on the
+// rows of bat, the columns of the expression are found in bat by name.
+func EvalExpressionIndex(proc *process.Process, indexDef *plan.IndexDef, bat *batch.Batch, nameToPos map[string]int) (*vector.Vector, error) {
+	expr, err := remapColumnsByName(proc.Ctx, indexDef.IndexExpr, nameToPos)
+	if err != nil {
+		return nil, err
+	}
+	return EvalExpressionOnce(proc, expr, []*batch.Batch{bat})
+}
+
+// remapColumnsByName returns a copy of expr whose column references point to
+// the positions of the columns in nameToPos.
+func remapColumnsByName(ctx context.Context, expr *plan.Expr, nameToPos map[string]int) (*plan.Expr, error) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		pos, ok := nameToPos[e.Col.Name]
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "column '%s' of the index expression not found", e.Col.Name)
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(pos),
+					Name:   e.Col.Name,
+				},
+			},
+		}, nil
+	case *plan.Expr_F:
+		args := make([]*plan.Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			var err error
+			if args[i], err = remapColumnsByName(ctx, arg, nameToPos); err != nil {
+				return nil, err
+			}
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}, nil
+	}
+	return expr, nil
+}
+
 func filterRowIdForDel(proc *process.Process, bat *batch.Batch, idx int) *batch.Batch {
 	retVec := vector.NewVec(types.T_Rowid.ToType())
 	rowIdMap := make(map[types.Rowid]struct{})
@@ -62,7 +62,7 @@ func getShuffledSels(ap *Argument, bat *batch.Batch, lenRegs int) [][]int32 {
 			regIndex := v % int16(lenRegs)
 			sels[regIndex] = append(sels[regIndex], int32(row))
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		groupByCol := vector.MustFixedCol[uint64](groupByVec)
 		for row, v := range groupByCol {
 			regIndex := v % uint64(lenRegs)
@@ -74,7 +74,7 @@ func getShuffledSels(ap *Argument, bat *batch.Batch, lenRegs int) [][]int32 {
 			regIndex := v % uint32(lenRegs)
 			sels[regIndex] = append(sels[regIndex], int32(row))
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		groupByCol := vector.MustFixedCol[uint16](groupByVec)
 		for row, v := range groupByCol {
 			regIndex := v % uint16(lenRegs)
@@ -847,6 +847,8 @@ func ScanZonemapFile(ctx context.Context, param *ExternalParam, proc *process.Pr
 func ScanFileData(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	if strings.HasSuffix(param.Fileparam.Filepath, ".tae") || param.Extern.QueryResult {
 		return ScanZonemapFile(ctx, param, proc)
+	} else if param.Extern.Format == tree.PARQUET {
+		return ScanParquetFile(ctx, param, proc)
 	} else {
 		return ScanCsvFile(ctx, param, proc)
 	}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"os"
 	"path/filepath"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -853,3 +855,143 @@ func Test_fliterByAccountAndFilename(t *testing.T) {
 		})
 	}
 }
+
+func Test_ScanParquetFile(t *testing.T) {
+	// scan.parquet is written by TestWriteScanTestdata of the parquet package,
+	// scan_pyarrow.parquet has the same rows written by parquet/testdata/gen_fixtures.py
+	for _, name := range []string{"scan.parquet", "scan_pyarrow.parquet"} {
+		t.Run(name, func(t *testing.T) {
+			testScanParquetFile(t, name)
+		})
+	}
+}
+
+func testScanParquetFile(t *testing.T, name string) {
+	path, err := filepath.Abs(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	stat, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		t.Skipf("%s is not generated, run parquet/testdata/gen_fixtures.py", name)
+	}
+	require.NoError(t, err)
+
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int32)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 10}},
+		{Name: "c", Typ: &plan.Type{Id: int32(types.T_timestamp), Scale: 3}},
+		{Name: "d", Typ: &plan.Type{Id: int32(types.T_decimal128), Width: 20, Scale: 2}},
+		{Name: catalog.ExternalFilePath, Typ: &plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}},
+	}
+	newParam := func(proc *process.Process, filter *plan.Expr) *Argument {
+		attrs := make([]string, len(cols))
+		for i, col := range cols {
+			attrs[i] = col.Name
+		}
+		return &Argument{
+			Es: &ExternalParam{
+				ExParamConst: ExParamConst{
+					Attrs:    attrs,
+					Cols:     cols,
+					FileList: []string{path},
+					FileSize: []int64{stat.Size()},
+					Extern: &tree.ExternParam{
+						ExParamConst: tree.ExParamConst{
+							Filepath: path,
+							Format:   tree.PARQUET,
+							Tail:     &tree.TailParameter{},
+						},
+						ExParam: tree.ExParam{
+							FileService: proc.FileService,
+							Ctx:         context.Background(),
+						},
+					},
+				},
+				ExParam: ExParam{
+					Fileparam: &ExFileparam{},
+					Filter: &FilterParam{
+						FilterExpr: filter,
+					},
+				},
+			},
+		}
+	}
+	scan := func(proc *process.Process, arg *Argument) ([]int32, []string) {
+		require.NoError(t, Prepare(proc, arg))
+		var as []int32
+		var bs []string
+		for {
+			end, err := Call(0, proc, arg, false, false)
+			require.NoError(t, err)
+			if end {
+				break
+			}
+			bat := proc.InputBatch()
+			if bat == nil || bat.Length() == 0 {
+				continue
+			}
+			require.Equal(t, len(cols), len(bat.Vecs))
+			for i := 0; i < bat.Length(); i++ {
+				a := vector.GetFixedAt[int32](bat.Vecs[0], i)
+				as = append(as, a)
+				if bat.Vecs[1].GetNulls().Contains(uint64(i)) {
+					bs = append(bs, "NULL")
+				} else {
+					bs = append(bs, bat.Vecs[1].GetStringAt(i))
+				}
+				require.Equal(t, types.T_timestamp, bat.Vecs[2].GetType().Oid)
+				ts := vector.GetFixedAt[types.Timestamp](bat.Vecs[2], i)
+				require.Equal(t, types.UnixToTimestamp(int64(a)), ts)
+				require.Equal(t, types.T_decimal128, bat.Vecs[3].GetType().Oid)
+				d := vector.GetFixedAt[types.Decimal128](bat.Vecs[3], i)
+				require.Equal(t, types.Decimal128{B0_63: uint64(a)*100 + 1}, d)
+				require.Equal(t, path, bat.Vecs[4].GetStringAt(i))
+			}
+			bat.Clean(proc.Mp())
+		}
+		return as, bs
+	}
+
+	proc := testutil.NewProcess()
+	as, bs := scan(proc, newParam(proc, nil))
+	require.Equal(t, 20, len(as))
+	for i := range as {
+		require.Equal(t, int32(i), as[i])
+		if i%3 == 0 {
+			require.Equal(t, "NULL", bs[i])
+		} else {
+			require.Equal(t, fmt.Sprintf("s%d", i), bs[i])
+		}
+	}
+
+	// a > 15 skips the first row group
+	fn, err := function.GetFunctionByName(context.Background(), ">", []types.Type{types.T_int32.ToType(), types.T_int32.ToType()})
+	require.NoError(t, err)
+	filter := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fn.GetEncodedOverloadID(), ObjName: ">"},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int32)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "a"}},
+					},
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int32)},
+						Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I32Val{I32Val: 15}}},
+					},
+				},
+			},
+		},
+	}
+	as, _ = scan(proc, newParam(proc, filter))
+	require.Equal(t, 10, len(as))
+	require.Equal(t, int32(10), as[0])
+
+	// the column doesn't exist in the file
+	arg := newParam(proc, nil)
+	arg.Es.Attrs[0], arg.Es.Cols[0] = "e", &plan.ColDef{Name: "e", Typ: &plan.Type{Id: int32(types.T_int32)}}
+	require.NoError(t, Prepare(proc, arg))
+	_, err = Call(0, proc, arg, false, false)
+	require.Error(t, err)
+}
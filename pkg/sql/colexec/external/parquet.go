@@ -0,0 +1,659 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/parquet"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// the julian day of 1970-01-01, used by the INT96 timestamps
+	julianUnixEpoch = 2440588
+	microSecsPerDay = 86400 * 1000000
+)
+
+var unixEpochDate = types.DateFromCalendar(1970, 1, 1)
+
+// parquetFile reads the ranges of a file by the file service, only the footer
+// and the column chunks which are needed are read.
+type parquetFile struct {
+	ctx  context.Context
+	fs   fileservice.ETLFileService
+	path string
+}
+
+func (f *parquetFile) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	vec := fileservice.IOVector{
+		FilePath: f.path,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset: off,
+				Size:   int64(len(p)),
+				Data:   p,
+			},
+		},
+	}
+	if err := f.fs.Read(f.ctx, &vec); err != nil {
+		return 0, err
+	}
+	return copy(p, vec.Entries[0].Data), nil
+}
+
+func openParquetFile(param *ExternalParam, proc *process.Process) (*ParquetHandler, error) {
+	if param.Extern.Local {
+		return nil, moerr.NewNYI(proc.Ctx, "load local file with the parquet format")
+	}
+	fs, readPath, err := plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+	if err != nil {
+		return nil, err
+	}
+	var size int64
+	if param.Fileparam.FileIndex-1 < len(param.FileSize) {
+		size = param.FileSize[param.Fileparam.FileIndex-1]
+	} else {
+		e, err := fs.StatFile(param.Ctx, readPath)
+		if err != nil {
+			return nil, err
+		}
+		size = e.Size
+	}
+	file, err := parquet.Open(&parquetFile{ctx: param.Ctx, fs: fs, path: readPath}, size)
+	if err != nil {
+		return nil, err
+	}
+
+	name2Column := make(map[string]*parquet.Column, len(file.Columns))
+	for _, c := range file.Columns {
+		name2Column[strings.ToLower(c.Name())] = c
+	}
+	h := &ParquetHandler{
+		file:    file,
+		columns: make([]*parquet.Column, len(param.Attrs)),
+		types:   make([]types.Type, len(param.Attrs)),
+		casts:   make([]*plan.Expr, len(param.Attrs)),
+		readers: make([]*parquet.ColumnChunkReader, len(param.Attrs)),
+		values:  make([]parquet.Values, len(param.Attrs)),
+	}
+	for i, attr := range param.Attrs {
+		if catalog.ContainExternalHidenCol(attr) || param.Cols[i].Hidden {
+			continue
+		}
+		c, ok := name2Column[strings.ToLower(attr)]
+		if !ok {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "the column '%s' does not exist in the parquet file '%s'", attr, param.Fileparam.Filepath)
+		}
+		if c.IsNested() {
+			return nil, moerr.NewNYI(proc.Ctx, "nested parquet column '%s'", c.Name())
+		}
+		h.columns[i] = c
+		if h.types[i], err = parquetColumnType(proc.Ctx, c); err != nil {
+			return nil, err
+		}
+		target := makeType(param.Cols, i, false)
+		if !types.IsInPlaceWidening(h.types[i], target) {
+			if h.casts[i], err = makeParquetCastExpr(proc.Ctx, i, h.types[i], param.Cols[i].Typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return h, nil
+}
+
+// parquetColumnType maps the type of a parquet column to the type which holds
+// its values without any loss, the values are cast to the type of the table
+// column later.
+func parquetColumnType(ctx context.Context, c *parquet.Column) (types.Type, error) {
+	l := c.LogicalType()
+	kind := parquet.LogicalNone
+	if l != nil {
+		kind = l.Kind
+	}
+	if kind == parquet.LogicalDecimal {
+		if l.Precision <= 0 || l.Precision > 38 || l.Scale < 0 || l.Scale > l.Precision {
+			return types.Type{}, moerr.NewNYI(ctx, "parquet decimal(%d, %d) of column '%s'", l.Precision, l.Scale, c.Name())
+		}
+		if (c.Type == parquet.Int32 && l.Precision > 9) || (c.Type == parquet.Int64 && l.Precision > 18) {
+			return types.Type{}, moerr.NewInvalidInput(ctx, "invalid parquet decimal(%d, %d) of type %s", l.Precision, l.Scale, c.Type)
+		}
+		switch c.Type {
+		case parquet.Int32, parquet.Int64, parquet.ByteArray, parquet.FixedLenByteArray:
+			if l.Precision <= 18 {
+				return types.New(types.T_decimal64, l.Precision, l.Scale), nil
+			}
+			return types.New(types.T_decimal128, l.Precision, l.Scale), nil
+		}
+	}
+	switch c.Type {
+	case parquet.Boolean:
+		return types.T_bool.ToType(), nil
+	case parquet.Int32, parquet.Int64:
+		switch kind {
+		case parquet.LogicalInteger:
+			return parquetIntegerType(l.BitWidth, l.IsSigned), nil
+		case parquet.LogicalDate:
+			if c.Type == parquet.Int32 {
+				return types.T_date.ToType(), nil
+			}
+		case parquet.LogicalTime:
+			if c.Type == parquet.Int32 && l.Unit == parquet.Millis {
+				return types.New(types.T_time, 0, 3), nil
+			}
+			if c.Type == parquet.Int64 && l.Unit != parquet.Millis {
+				return types.New(types.T_time, 0, 6), nil
+			}
+		case parquet.LogicalTimestamp:
+			if c.Type == parquet.Int64 {
+				var scale int32 = 6
+				if l.Unit == parquet.Millis {
+					scale = 3
+				}
+				if l.IsAdjustedToUTC {
+					return types.New(types.T_timestamp, 0, scale), nil
+				}
+				return types.New(types.T_datetime, 0, scale), nil
+			}
+		}
+		if c.Type == parquet.Int32 {
+			return types.T_int32.ToType(), nil
+		}
+		return types.T_int64.ToType(), nil
+	case parquet.Int96:
+		return types.New(types.T_timestamp, 0, 6), nil
+	case parquet.Float:
+		return types.T_float32.ToType(), nil
+	case parquet.Double:
+		return types.T_float64.ToType(), nil
+	case parquet.ByteArray:
+		switch kind {
+		case parquet.LogicalString, parquet.LogicalEnum, parquet.LogicalJSON:
+			return types.T_text.ToType(), nil
+		}
+		return types.T_blob.ToType(), nil
+	case parquet.FixedLenByteArray:
+		switch {
+		case kind == parquet.LogicalUUID && c.TypeLength == 16:
+			return types.T_uuid.ToType(), nil
+		case kind == parquet.LogicalFloat16 && c.TypeLength == 2:
+			return types.T_float32.ToType(), nil
+		}
+		return types.T_blob.ToType(), nil
+	}
+	return types.Type{}, moerr.NewNYI(ctx, "parquet type %s of column '%s'", c.Type, c.Name())
+}
+
+func parquetIntegerType(bitWidth int8, signed bool) types.Type {
+	switch bitWidth {
+	case 8:
+		if signed {
+			return types.T_int8.ToType()
+		}
+		return types.T_uint8.ToType()
+	case 16:
+		if signed {
+			return types.T_int16.ToType()
+		}
+		return types.T_uint16.ToType()
+	case 32:
+		if signed {
+			return types.T_int32.ToType()
+		}
+		return types.T_uint32.ToType()
+	}
+	if signed {
+		return types.T_int64.ToType()
+	}
+	return types.T_uint64.ToType()
+}
+
+// makeParquetCastExpr casts the idx-th column of the batch from typ to the type
+// of the table column.
+func makeParquetCastExpr(ctx context.Context, idx int, typ types.Type, target *plan.Type) (*plan.Expr, error) {
+	fGet, err := function.GetFunctionByName(ctx, "cast", []types.Type{typ, types.New(types.T(target.Id), target.Width, target.Scale)})
+	if err != nil {
+		return nil, err
+	}
+	return &plan.Expr{
+		Typ: target,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fGet.GetEncodedOverloadID(), ObjName: "cast"},
+				Args: []*plan.Expr{
+					{
+						Typ: &plan.Type{
+							Id:    int32(typ.Oid),
+							Width: typ.Width,
+							Scale: typ.Scale,
+						},
+						Expr: &plan.Expr_Col{
+							Col: &plan.ColRef{
+								ColPos: int32(idx),
+							},
+						},
+					},
+					{
+						Typ: target,
+						Expr: &plan.Expr_T{
+							T: &plan.TargetType{
+								Typ: target,
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// nextRowGroup opens the column chunks of the next row group which may contain
+// the rows matching the filter, it returns false if there is no more row group.
+func (h *ParquetHandler) nextRowGroup(ctx context.Context, param *ExternalParam, proc *process.Process) (bool, error) {
+	for h.rowGroup < h.file.NumRowGroups() {
+		rg := h.rowGroup
+		h.rowGroup++
+		h.rows = h.file.RowGroup(rg).NumRows
+		if h.rows <= 0 || !h.needRead(ctx, param, proc, rg) {
+			continue
+		}
+		for i, c := range h.columns {
+			if c == nil {
+				continue
+			}
+			r, err := h.file.OpenColumnChunk(rg, c.Index)
+			if err != nil {
+				return false, err
+			}
+			h.readers[i] = r
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// needRead evaluates the filter by the zone maps built from the statistics of
+// the row group, the statistics are used only if the values keep their order
+// after being cast to the type of the table column.
+func (h *ParquetHandler) needRead(ctx context.Context, param *ExternalParam, proc *process.Process, rg int) bool {
+	_, span := trace.Start(ctx, "parquetNeedRead")
+	defer span.End()
+
+	expr := param.Filter.FilterExpr
+	if expr == nil || !param.Filter.exprMono {
+		return true
+	}
+	metas := make(parquetColumnMetas, len(param.Cols))
+	for i := range metas {
+		metas[i] = objectio.BuildColumnMeta()
+		c := h.columns[i]
+		if c == nil {
+			continue
+		}
+		target := makeType(param.Cols, i, false)
+		if !types.IsInPlaceWidening(h.types[i], target) &&
+			!(h.types[i].Oid.IsMySQLString() && target.Oid.IsMySQLString()) {
+			continue
+		}
+		if zm, ok := parquetZoneMap(c, h.types[i], h.file.RowGroup(rg).Columns[c.Index].Meta.Statistics, proc.Mp()); ok {
+			metas[i].SetZoneMap(zm)
+		}
+	}
+
+	cnt := plan2.AssignAuxIdForExpr(expr, 0)
+	zms := make([]objectio.ZoneMap, cnt)
+	vecs := make([]*vector.Vector, cnt)
+	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
+	return colexec.EvaluateFilterByZoneMap(
+		notReportErrCtx, proc, expr, metas, param.Filter.columnMap, zms, vecs)
+}
+
+// parquetColumnMetas implements objectio.ColumnMetaFetcher, it is indexed by
+// the position of the column in param.Cols.
+type parquetColumnMetas []objectio.ColumnMeta
+
+func (m parquetColumnMetas) MustGetColumn(idx uint16) objectio.ColumnMeta {
+	if int(idx) >= len(m) {
+		return objectio.BuildColumnMeta()
+	}
+	return m[idx]
+}
+
+func parquetZoneMap(c *parquet.Column, typ types.Type, s *parquet.Statistics, mp *mpool.MPool) (objectio.ZoneMap, bool) {
+	vs, ok := c.MinMaxValues(s)
+	if !ok {
+		return nil, false
+	}
+	vec := vector.NewVec(typ)
+	defer vec.Free(mp)
+	if err := appendParquetValues(vec, c, vs, mp); err != nil {
+		return nil, false
+	}
+	zm := index.NewZM(typ.Oid, typ.Scale)
+	for i := 0; i < vec.Length(); i++ {
+		if typ.IsVarlen() {
+			index.UpdateZM(zm, vec.GetBytesAt(i))
+		} else {
+			size := typ.TypeSize()
+			index.UpdateZM(zm, vec.UnsafeGetRawData()[i*size:(i+1)*size])
+		}
+	}
+	return zm, true
+}
+
+func getParquetBatch(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	h := param.pqh
+	n := ONE_BATCH_MAX_ROW
+	if h.rows < int64(n) {
+		n = int(h.rows)
+	}
+	bat := batch.NewWithSize(len(param.Attrs))
+	bat.SetAttributes(param.Attrs)
+	var err error
+	for i := range param.Attrs {
+		if h.columns[i] == nil {
+			if bat.Vecs[i], err = proc.AllocVectorOfRows(makeType(param.Cols, i, false), n, nil); err != nil {
+				bat.Clean(proc.Mp())
+				return nil, err
+			}
+			if param.Cols[i].Hidden {
+				nulls.AddRange(bat.Vecs[i].GetNulls(), 0, uint64(n))
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if err = vector.SetStringAt(bat.Vecs[i], j, param.Fileparam.Filepath, proc.Mp()); err != nil {
+					bat.Clean(proc.Mp())
+					return nil, err
+				}
+			}
+			continue
+		}
+		vs := &h.values[i]
+		vs.Reset()
+		if k, err := h.readers[i].Read(vs, n); err != nil || k != n {
+			bat.Clean(proc.Mp())
+			if err == nil {
+				err = moerr.NewInvalidInput(proc.Ctx, "the parquet column '%s' has less rows than the row group", h.columns[i].Name())
+			}
+			return nil, err
+		}
+		bat.Vecs[i] = proc.GetVector(h.types[i])
+		if err = appendParquetValues(bat.Vecs[i], h.columns[i], vs, proc.Mp()); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	bat.SetZs(n, proc.Mp())
+
+	for i, expr := range h.casts {
+		if expr == nil {
+			continue
+		}
+		vec, err := colexec.EvalExpressionOnce(proc, expr, []*batch.Batch{bat})
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		bat.Vecs[i].Free(proc.Mp())
+		bat.Vecs[i] = vec
+	}
+
+	h.rows -= int64(n)
+	if h.rows == 0 {
+		for i := range h.readers {
+			h.readers[i] = nil
+		}
+	}
+	return bat, nil
+}
+
+// ScanParquetFile reads a batch of rows from the parquet file, a file is read
+// row group by row group and the row groups are pruned by the filter.
+func ScanParquetFile(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	ctx, span := trace.Start(ctx, "ScanParquetFile")
+	defer span.End()
+	var err error
+	if param.pqh == nil {
+		param.pqh, err = openParquetFile(param, proc)
+		if err != nil {
+			return nil, err
+		}
+		// the file is being read, see Call
+		param.plh = &ParseLineHandler{}
+	}
+	h := param.pqh
+	ok := h.rows > 0
+	if !ok {
+		if ok, err = h.nextRowGroup(ctx, param, proc); err != nil {
+			return nil, err
+		}
+	}
+	var bat *batch.Batch
+	if ok {
+		if bat, err = getParquetBatch(ctx, param, proc); err != nil {
+			return nil, err
+		}
+		if h.rows == 0 {
+			if ok, err = h.nextRowGroup(ctx, param, proc); err != nil {
+				bat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	} else {
+		bat = makeBatch(param, 0, proc)
+	}
+	if !ok {
+		param.pqh = nil
+		param.plh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	return bat, nil
+}
+
+// appendParquetValues appends the values of column c to vec, the type of vec
+// must be the one returned by parquetColumnType.
+func appendParquetValues(vec *vector.Vector, c *parquet.Column, vs *parquet.Values, mp *mpool.MPool) error {
+	typ := vec.GetType()
+	unit := parquet.Micros
+	if l := c.LogicalType(); l != nil && (l.Kind == parquet.LogicalTime || l.Kind == parquet.LogicalTimestamp) {
+		unit = l.Unit
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.AppendFixedList(vec, vs.Booleans, vs.Nulls, mp)
+	case types.T_int8:
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) int8 { return int8(v) }, mp)
+	case types.T_int16:
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) int16 { return int16(v) }, mp)
+	case types.T_int32:
+		return vector.AppendFixedList(vec, vs.Int32s, vs.Nulls, mp)
+	case types.T_uint8:
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) uint8 { return uint8(v) }, mp)
+	case types.T_uint16:
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) uint16 { return uint16(v) }, mp)
+	case types.T_uint32:
+		if vs.Type == parquet.Int64 {
+			return appendConverted(vec, vs.Int64s, vs.Nulls, func(v int64) uint32 { return uint32(v) }, mp)
+		}
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) uint32 { return uint32(v) }, mp)
+	case types.T_int64:
+		if vs.Type == parquet.Int32 {
+			return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) int64 { return int64(v) }, mp)
+		}
+		return vector.AppendFixedList(vec, vs.Int64s, vs.Nulls, mp)
+	case types.T_uint64:
+		if vs.Type == parquet.Int32 {
+			return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) uint64 { return uint64(uint32(v)) }, mp)
+		}
+		return appendConverted(vec, vs.Int64s, vs.Nulls, func(v int64) uint64 { return uint64(v) }, mp)
+	case types.T_float32:
+		if vs.Type == parquet.FixedLenByteArray {
+			return appendConverted(vec, vs.Bytes, vs.Nulls, float16To32, mp)
+		}
+		return vector.AppendFixedList(vec, vs.Floats, vs.Nulls, mp)
+	case types.T_float64:
+		return vector.AppendFixedList(vec, vs.Doubles, vs.Nulls, mp)
+	case types.T_date:
+		return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) types.Date { return unixEpochDate + types.Date(v) }, mp)
+	case types.T_time:
+		if vs.Type == parquet.Int32 {
+			return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) types.Time { return types.Time(int64(v) * 1000) }, mp)
+		}
+		return appendConverted(vec, vs.Int64s, vs.Nulls, func(v int64) types.Time { return types.Time(toMicros(v, unit)) }, mp)
+	case types.T_timestamp, types.T_datetime:
+		var micros []int64
+		if vs.Type == parquet.Int96 {
+			micros = make([]int64, len(vs.Int96s))
+			for i, v := range vs.Int96s {
+				nanos := int64(binary.LittleEndian.Uint64(v[:8]))
+				days := int64(binary.LittleEndian.Uint32(v[8:]))
+				micros[i] = (days-julianUnixEpoch)*microSecsPerDay + nanos/1000
+			}
+		} else {
+			micros = make([]int64, len(vs.Int64s))
+			for i, v := range vs.Int64s {
+				micros[i] = toMicros(v, unit)
+			}
+		}
+		return appendTimeValues(vec, micros, vs.Nulls, mp)
+	case types.T_decimal64:
+		switch vs.Type {
+		case parquet.Int32:
+			return appendConverted(vec, vs.Int32s, vs.Nulls, func(v int32) types.Decimal64 { return types.Decimal64(int64(v)) }, mp)
+		case parquet.Int64:
+			return appendConverted(vec, vs.Int64s, vs.Nulls, func(v int64) types.Decimal64 { return types.Decimal64(v) }, mp)
+		}
+		return appendConverted(vec, vs.Bytes, vs.Nulls, func(b []byte) types.Decimal64 {
+			lo, _ := decodeBigEndianInt128(b)
+			return types.Decimal64(lo)
+		}, mp)
+	case types.T_decimal128:
+		if vs.Type == parquet.Int64 {
+			return appendConverted(vec, vs.Int64s, vs.Nulls, func(v int64) types.Decimal128 {
+				return types.Decimal128{B0_63: uint64(v), B64_127: uint64(v >> 63)}
+			}, mp)
+		}
+		for i, b := range vs.Bytes {
+			if len(b) > 16 && (vs.Nulls == nil || !vs.Nulls[i]) {
+				return moerr.NewInvalidInputNoCtx("the parquet decimal of %d bytes is out of range", len(b))
+			}
+		}
+		return appendConverted(vec, vs.Bytes, vs.Nulls, func(b []byte) types.Decimal128 {
+			lo, hi := decodeBigEndianInt128(b)
+			return types.Decimal128{B0_63: lo, B64_127: hi}
+		}, mp)
+	case types.T_uuid:
+		return appendConverted(vec, vs.Bytes, vs.Nulls, func(b []byte) types.Uuid {
+			var u types.Uuid
+			copy(u[:], b)
+			return u
+		}, mp)
+	case types.T_text, types.T_blob:
+		return vector.AppendBytesList(vec, vs.Bytes, vs.Nulls, mp)
+	}
+	return moerr.NewNYINoCtx("read parquet values as %s", typ.String())
+}
+
+func toMicros(v int64, unit parquet.TimeUnit) int64 {
+	switch unit {
+	case parquet.Millis:
+		return v * 1000
+	case parquet.Nanos:
+		if v < 0 && v%1000 != 0 {
+			return v/1000 - 1
+		}
+		return v / 1000
+	}
+	return v
+}
+
+func appendTimeValues(vec *vector.Vector, micros []int64, isNulls []bool, mp *mpool.MPool) error {
+	epoch := types.GetUnixEpochSecs()
+	if vec.GetType().Oid == types.T_timestamp {
+		return appendConverted(vec, micros, isNulls, func(v int64) types.Timestamp { return types.Timestamp(v + epoch) }, mp)
+	}
+	return appendConverted(vec, micros, isNulls, func(v int64) types.Datetime { return types.Datetime(v + epoch) }, mp)
+}
+
+func appendConverted[S, T any](vec *vector.Vector, src []S, isNulls []bool, f func(S) T, mp *mpool.MPool) error {
+	ws := make([]T, len(src))
+	for i, v := range src {
+		if isNulls == nil || !isNulls[i] {
+			ws[i] = f(v)
+		}
+	}
+	return vector.AppendFixedList(vec, ws, isNulls, mp)
+}
+
+// decodeBigEndianInt128 decodes the two's complement big-endian integer of at
+// most 16 bytes.
+func decodeBigEndianInt128(b []byte) (lo, hi uint64) {
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		lo, hi = math.MaxUint64, math.MaxUint64
+	}
+	for _, c := range b {
+		hi = hi<<8 | lo>>56
+		lo = lo<<8 | uint64(c)
+	}
+	return
+}
+
+func float16To32(b []byte) float32 {
+	h := uint32(binary.LittleEndian.Uint16(b))
+	sign := (h >> 15) << 31
+	exp := (h >> 10) & 0x1f
+	frac := h & 0x3ff
+	switch {
+	case exp == 0x1f:
+		// inf or nan
+		return math.Float32frombits(sign | 0xff<<23 | frac<<13)
+	case exp != 0:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | frac<<13)
+	case frac == 0:
+		return math.Float32frombits(sign)
+	}
+	// subnormal
+	e := uint32(127 - 15 + 1)
+	for frac&0x400 == 0 {
+		frac <<= 1
+		e--
+	}
+	return math.Float32frombits(sign | e<<23 | (frac&0x3ff)<<13)
+}
@@ -0,0 +1,399 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Int96Value is the legacy timestamp written by impala and spark, the first 8 bytes
+// are the nanoseconds of the day and the last 4 bytes are the julian day, both
+// are little endian.
+type Int96Value [12]byte
+
+// Values holds the values of a column. If Nulls is not nil, there is one slot
+// for every row and the slot of a null row holds the zero value, otherwise the
+// values are not null.
+type Values struct {
+	Type     Type
+	Nulls    []bool
+	Booleans []bool
+	Int32s   []int32
+	Int64s   []int64
+	Int96s   []Int96Value
+	Floats   []float32
+	Doubles  []float64
+	// Bytes holds the values of BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY, they may
+	// share the memory with the buffer of the column chunk.
+	Bytes [][]byte
+}
+
+func (v *Values) Len() int {
+	switch v.Type {
+	case Boolean:
+		return len(v.Booleans)
+	case Int32:
+		return len(v.Int32s)
+	case Int64:
+		return len(v.Int64s)
+	case Int96:
+		return len(v.Int96s)
+	case Float:
+		return len(v.Floats)
+	case Double:
+		return len(v.Doubles)
+	default:
+		return len(v.Bytes)
+	}
+}
+
+func (v *Values) IsNull(i int) bool {
+	return v.Nulls != nil && v.Nulls[i]
+}
+
+// Reset clears the values but keeps the memory.
+func (v *Values) Reset() {
+	v.Nulls = v.Nulls[:0]
+	v.Booleans = v.Booleans[:0]
+	v.Int32s = v.Int32s[:0]
+	v.Int64s = v.Int64s[:0]
+	v.Int96s = v.Int96s[:0]
+	v.Floats = v.Floats[:0]
+	v.Doubles = v.Doubles[:0]
+	v.Bytes = v.Bytes[:0]
+}
+
+// expand appends n rows to dst, a zero value is appended for every null row and
+// the values of src are consumed from the offset off. It returns the new offset.
+func expand[T any](dst *[]T, src []T, off int, n int, nulls []bool) int {
+	if nulls == nil {
+		*dst = append(*dst, src[off:off+n]...)
+		return off + n
+	}
+	var zero T
+	for _, null := range nulls {
+		if null {
+			*dst = append(*dst, zero)
+		} else {
+			*dst = append(*dst, src[off])
+			off++
+		}
+	}
+	return off
+}
+
+func gather[T any](dst *[]T, dict []T, idx []int32) error {
+	for _, i := range idx {
+		if i < 0 || int(i) >= len(dict) {
+			return errCorrupted("dictionary index")
+		}
+		*dst = append(*dst, dict[i])
+	}
+	return nil
+}
+
+// readBits reads width bits from the bit position pos of src, the bits are
+// packed from the least significant bit.
+func readBits(src []byte, pos int, width int) uint64 {
+	var v uint64
+	for read := 0; read < width; {
+		b := src[pos>>3] >> (pos & 7)
+		take := 8 - pos&7
+		if take > width-read {
+			take = width - read
+		}
+		v |= uint64(b&(1<<take-1)) << read
+		read += take
+		pos += take
+	}
+	return v
+}
+
+// decodeHybrid decodes len(dst) values of the RLE/bit-packing hybrid encoding,
+// it returns the bytes consumed.
+func decodeHybrid(data []byte, bitWidth int, dst []int32) (int, error) {
+	if bitWidth > 32 {
+		return 0, errCorrupted("rle data")
+	}
+	byteWidth := (bitWidth + 7) / 8
+	off := 0
+	for i := 0; i < len(dst); {
+		h, k := binary.Uvarint(data[off:])
+		if k <= 0 {
+			return 0, errCorrupted("rle data")
+		}
+		off += k
+		if h&1 == 0 {
+			cnt := h >> 1
+			if cnt == 0 || off+byteWidth > len(data) {
+				return 0, errCorrupted("rle data")
+			}
+			var v uint32
+			for b := 0; b < byteWidth; b++ {
+				v |= uint32(data[off+b]) << (8 * b)
+			}
+			off += byteWidth
+			for ; cnt > 0 && i < len(dst); cnt-- {
+				dst[i] = int32(v)
+				i++
+			}
+		} else {
+			groups := h >> 1
+			if groups == 0 || groups > uint64(len(data)) {
+				return 0, errCorrupted("rle data")
+			}
+			cnt := int(groups) * 8
+			nbytes := int(groups) * bitWidth
+			if off+nbytes > len(data) {
+				return 0, errCorrupted("rle data")
+			}
+			src := data[off : off+nbytes]
+			for j := 0; j < cnt && i < len(dst); j++ {
+				dst[i] = int32(readBits(src, j*bitWidth, bitWidth))
+				i++
+			}
+			off += nbytes
+		}
+	}
+	return off, nil
+}
+
+// decodeLevels decodes the definition levels of a data page v1, which are
+// prefixed by the 4 bytes length.
+func decodeLevels(data []byte, bitWidth int, dst []int32) (int, error) {
+	if len(data) < 4 {
+		return 0, errCorrupted("levels")
+	}
+	n := int(binary.LittleEndian.Uint32(data))
+	if n > len(data)-4 {
+		return 0, errCorrupted("levels")
+	}
+	if _, err := decodeHybrid(data[4:4+n], bitWidth, dst); err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+func decodePlain(typ Type, typeLength int, data []byte, n int, dst *Values) error {
+	var size int
+	switch typ {
+	case Boolean:
+		if (n+7)/8 > len(data) {
+			return errCorrupted("plain data")
+		}
+		for i := 0; i < n; i++ {
+			dst.Booleans = append(dst.Booleans, data[i>>3]>>(i&7)&1 == 1)
+		}
+		return nil
+	case Int32, Float:
+		size = 4
+	case Int64, Double:
+		size = 8
+	case Int96:
+		size = 12
+	case FixedLenByteArray:
+		size = typeLength
+	case ByteArray:
+		off := 0
+		for i := 0; i < n; i++ {
+			if off+4 > len(data) {
+				return errCorrupted("plain data")
+			}
+			l := int(binary.LittleEndian.Uint32(data[off:]))
+			off += 4
+			if l < 0 || l > len(data)-off {
+				return errCorrupted("plain data")
+			}
+			dst.Bytes = append(dst.Bytes, data[off:off+l:off+l])
+			off += l
+		}
+		return nil
+	}
+	if n*size > len(data) {
+		return errCorrupted("plain data")
+	}
+	for i := 0; i < n; i++ {
+		b := data[i*size : (i+1)*size : (i+1)*size]
+		switch typ {
+		case Int32:
+			dst.Int32s = append(dst.Int32s, int32(binary.LittleEndian.Uint32(b)))
+		case Float:
+			dst.Floats = append(dst.Floats, math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		case Int64:
+			dst.Int64s = append(dst.Int64s, int64(binary.LittleEndian.Uint64(b)))
+		case Double:
+			dst.Doubles = append(dst.Doubles, math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		case Int96:
+			dst.Int96s = append(dst.Int96s, Int96Value(b))
+		case FixedLenByteArray:
+			dst.Bytes = append(dst.Bytes, b)
+		}
+	}
+	return nil
+}
+
+// decodeDeltaBinaryPacked decodes the DELTA_BINARY_PACKED encoding, it returns
+// the values and the bytes consumed.
+func decodeDeltaBinaryPacked(data []byte) ([]int64, int, error) {
+	off := 0
+	uvarint := func() (uint64, error) {
+		v, k := binary.Uvarint(data[off:])
+		if k <= 0 {
+			return 0, errCorrupted("delta data")
+		}
+		off += k
+		return v, nil
+	}
+	varint := func() (int64, error) {
+		v, err := uvarint()
+		return int64(v>>1) ^ -int64(v&1), err
+	}
+	blockSize, err := uvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	miniBlocks, err := uvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := uvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	first, err := varint()
+	if err != nil {
+		return nil, 0, err
+	}
+	if blockSize == 0 || miniBlocks == 0 || blockSize%miniBlocks != 0 ||
+		blockSize/miniBlocks%8 != 0 || blockSize > math.MaxInt32 {
+		return nil, 0, errCorrupted("delta data")
+	}
+	if total == 0 {
+		return nil, off, nil
+	}
+	perMiniBlock := int(blockSize / miniBlocks)
+	capacity := total
+	if capacity > uint64(len(data))*8+1 {
+		capacity = uint64(len(data))*8 + 1
+	}
+	vs := make([]int64, 0, capacity)
+	vs = append(vs, first)
+	last := first
+	for uint64(len(vs)) < total {
+		minDelta, err := varint()
+		if err != nil {
+			return nil, 0, err
+		}
+		if off+int(miniBlocks) > len(data) {
+			return nil, 0, errCorrupted("delta data")
+		}
+		widths := data[off : off+int(miniBlocks)]
+		off += int(miniBlocks)
+		for _, w := range widths {
+			if uint64(len(vs)) >= total {
+				break
+			}
+			width := int(w)
+			nbytes := perMiniBlock * width / 8
+			if width > 64 || off+nbytes > len(data) {
+				return nil, 0, errCorrupted("delta data")
+			}
+			src := data[off : off+nbytes]
+			for j := 0; j < perMiniBlock && uint64(len(vs)) < total; j++ {
+				last = int64(uint64(last) + uint64(minDelta) + readBits(src, j*width, width))
+				vs = append(vs, last)
+			}
+			off += nbytes
+		}
+	}
+	return vs, off, nil
+}
+
+// decodeDeltaLengthByteArray decodes n values of the DELTA_LENGTH_BYTE_ARRAY
+// encoding, it returns the bytes consumed.
+func decodeDeltaLengthByteArray(data []byte, n int, dst *[][]byte) (int, error) {
+	lens, off, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return 0, err
+	}
+	if len(lens) < n {
+		return 0, errCorrupted("delta data")
+	}
+	for _, l := range lens[:n] {
+		if l < 0 || l > int64(len(data)-off) {
+			return 0, errCorrupted("delta data")
+		}
+		*dst = append(*dst, data[off:off+int(l):off+int(l)])
+		off += int(l)
+	}
+	return off, nil
+}
+
+// decodeDeltaByteArray decodes n values of the DELTA_BYTE_ARRAY encoding, the
+// values are prefixed by the bytes of the previous value.
+func decodeDeltaByteArray(data []byte, n int, dst *[][]byte) error {
+	prefixes, off, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return err
+	}
+	if len(prefixes) < n {
+		return errCorrupted("delta data")
+	}
+	suffixes := make([][]byte, 0, n)
+	if _, err = decodeDeltaLengthByteArray(data[off:], n, &suffixes); err != nil {
+		return err
+	}
+	var prev []byte
+	for i := 0; i < n; i++ {
+		if prefixes[i] < 0 || prefixes[i] > int64(len(prev)) {
+			return errCorrupted("delta data")
+		}
+		v := make([]byte, 0, int(prefixes[i])+len(suffixes[i]))
+		v = append(v, prev[:prefixes[i]]...)
+		v = append(v, suffixes[i]...)
+		*dst = append(*dst, v)
+		prev = v
+	}
+	return nil
+}
+
+// decodeByteStreamSplit decodes n values of the BYTE_STREAM_SPLIT encoding,
+// the k-th bytes of all the values are stored together.
+func decodeByteStreamSplit(typ Type, typeLength int, data []byte, n int, dst *Values) error {
+	var size int
+	switch typ {
+	case Int32, Float:
+		size = 4
+	case Int64, Double:
+		size = 8
+	case FixedLenByteArray:
+		size = typeLength
+	default:
+		return moerr.NewNYINoCtx("BYTE_STREAM_SPLIT encoding of parquet type %s", typ)
+	}
+	if n*size > len(data) {
+		return errCorrupted("byte stream split data")
+	}
+	plain := make([]byte, n*size)
+	for i := 0; i < n; i++ {
+		for k := 0; k < size; k++ {
+			plain[i*size+k] = data[k*n+i]
+		}
+	}
+	return decodePlain(typ, typeLength, plain, n, dst)
+}
@@ -0,0 +1,306 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquet implements a reader of the parquet files, see
+// https://parquet.apache.org/docs/file-format/.
+// Only the flat schemas are supported, i.e. the columns can't be nested or
+// repeated.
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	magic      = "PAR1"
+	footerSize = 8
+	// maxFooterLength limits the size of the metadata read from the footer.
+	maxFooterLength = 64 << 20
+)
+
+// Column is a leaf column of the schema.
+type Column struct {
+	*SchemaElement
+	// Path is the path from the root to the column, the root is not included
+	Path []string
+	// Index is the index of the column chunk in the row groups
+	Index              int
+	MaxDefinitionLevel int
+	MaxRepetitionLevel int
+}
+
+// Name returns the dot-separated path of the column.
+func (c *Column) Name() string {
+	return strings.Join(c.Path, ".")
+}
+
+// IsNested returns true if the column is in a group or it is repeated.
+func (c *Column) IsNested() bool {
+	return len(c.Path) > 1 || c.MaxRepetitionLevel > 0
+}
+
+// File is an opened parquet file, the column chunks are read on demand.
+type File struct {
+	r       io.ReaderAt
+	size    int64
+	Meta    *FileMetaData
+	Columns []*Column
+}
+
+// Open reads the footer of the parquet file of size bytes.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(len(magic)+footerSize) {
+		return nil, moerr.NewInvalidInputNoCtx("invalid parquet file: the file is too small")
+	}
+	var footer [footerSize]byte
+	if _, err := r.ReadAt(footer[:], size-footerSize); err != nil {
+		return nil, err
+	}
+	if string(footer[4:]) != magic {
+		return nil, moerr.NewInvalidInputNoCtx("invalid parquet file: bad magic number")
+	}
+	n := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if n > maxFooterLength || n > size-footerSize-int64(len(magic)) {
+		return nil, errCorrupted("footer")
+	}
+	buf := make([]byte, n)
+	if _, err := r.ReadAt(buf, size-footerSize-n); err != nil {
+		return nil, err
+	}
+	meta := &FileMetaData{}
+	if err := meta.read(&compactReader{buf: buf}); err != nil {
+		return nil, err
+	}
+	f := &File{
+		r:    r,
+		size: size,
+		Meta: meta,
+	}
+	if err := f.buildColumns(); err != nil {
+		return nil, err
+	}
+	for _, rg := range meta.RowGroups {
+		if len(rg.Columns) != len(f.Columns) {
+			return nil, errCorrupted("row group")
+		}
+		for _, c := range rg.Columns {
+			if c.Meta == nil {
+				return nil, errCorrupted("column chunk")
+			}
+			if c.FilePath != "" {
+				return nil, moerr.NewNYINoCtx("parquet column chunk in the external file '%s'", c.FilePath)
+			}
+		}
+	}
+	return f, nil
+}
+
+// buildColumns walks the schema tree in the depth-first order, which is the
+// order of the column chunks.
+func (f *File) buildColumns() error {
+	schema := f.Meta.Schema
+	if len(schema) == 0 {
+		return errCorrupted("schema")
+	}
+	pos := 1
+	var walk func(n int, path []string, def, rep int) error
+	walk = func(n int, path []string, def, rep int) error {
+		for i := 0; i < n; i++ {
+			if pos >= len(schema) {
+				return errCorrupted("schema")
+			}
+			e := schema[pos]
+			pos++
+			d, r := def, rep
+			switch e.Repetition {
+			case Optional:
+				d++
+			case Repeated:
+				d++
+				r++
+			}
+			p := append(path[:len(path):len(path)], e.Name)
+			if e.NumChildren > 0 {
+				if err := walk(int(e.NumChildren), p, d, r); err != nil {
+					return err
+				}
+				continue
+			}
+			f.Columns = append(f.Columns, &Column{
+				SchemaElement:      e,
+				Path:               p,
+				Index:              len(f.Columns),
+				MaxDefinitionLevel: d,
+				MaxRepetitionLevel: r,
+			})
+		}
+		return nil
+	}
+	if err := walk(int(schema[0].NumChildren), nil, 0, 0); err != nil {
+		return err
+	}
+	if pos != len(schema) {
+		return errCorrupted("schema")
+	}
+	return nil
+}
+
+// NumRows returns the number of rows of the file.
+func (f *File) NumRows() int64 {
+	return f.Meta.NumRows
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (f *File) NumRowGroups() int {
+	return len(f.Meta.RowGroups)
+}
+
+// RowGroup returns the metadata of the i-th row group.
+func (f *File) RowGroup(i int) *RowGroup {
+	return f.Meta.RowGroups[i]
+}
+
+// OpenColumnChunk reads the chunk of column col in row group rg, only the bytes
+// of the chunk are read from the file.
+func (f *File) OpenColumnChunk(rg int, col int) (*ColumnChunkReader, error) {
+	c := f.Columns[col]
+	if c.IsNested() {
+		return nil, moerr.NewNYINoCtx("nested parquet column '%s'", c.Name())
+	}
+	meta := f.Meta.RowGroups[rg].Columns[col].Meta
+	start := meta.DataPageOffset
+	if meta.DictionaryPageOffset > 0 && meta.DictionaryPageOffset < start {
+		start = meta.DictionaryPageOffset
+	}
+	size := meta.TotalCompressedSize
+	if start < int64(len(magic)) || size < 0 || start+size > f.size {
+		return nil, errCorrupted("column chunk")
+	}
+	buf := make([]byte, size)
+	if _, err := f.r.ReadAt(buf, start); err != nil {
+		return nil, err
+	}
+	return &ColumnChunkReader{
+		col:   c,
+		codec: meta.Codec,
+		data:  buf,
+	}, nil
+}
+
+// SortOrderSigned returns true if the values of the column are compared as
+// signed numbers, otherwise they are compared as unsigned numbers or bytes.
+func (c *Column) SortOrderSigned() bool {
+	if c.ConvertedType == ConvertedDecimal || (c.Logical != nil && c.Logical.Kind == LogicalDecimal) {
+		return true
+	}
+	switch c.Type {
+	case ByteArray, FixedLenByteArray, Int96:
+		return false
+	}
+	switch c.ConvertedType {
+	case ConvertedUint8, ConvertedUint16, ConvertedUint32, ConvertedUint64:
+		return false
+	}
+	return c.Logical == nil || c.Logical.Kind != LogicalInteger || c.Logical.IsSigned
+}
+
+// MinMax returns the PLAIN encoded min and max values of the statistics. The
+// deprecated min and max are used only if they were compared in the order of
+// the column.
+func (c *Column) MinMax(s *Statistics) (min, max []byte, ok bool) {
+	if s == nil {
+		return nil, nil, false
+	}
+	if s.MinValue != nil && s.MaxValue != nil {
+		return s.MinValue, s.MaxValue, true
+	}
+	if s.Min != nil && s.Max != nil && c.SortOrderSigned() && c.Type != ByteArray && c.Type != FixedLenByteArray {
+		return s.Min, s.Max, true
+	}
+	return nil, nil, false
+}
+
+// LogicalType returns the logical type of the column, it is derived from the
+// converted type if the writer didn't write the logical type.
+func (c *Column) LogicalType() *LogicalType {
+	if c.Logical != nil && c.Logical.Kind != LogicalNone {
+		return c.Logical
+	}
+	switch c.ConvertedType {
+	case ConvertedUTF8:
+		return &LogicalType{Kind: LogicalString}
+	case ConvertedEnum:
+		return &LogicalType{Kind: LogicalEnum}
+	case ConvertedJSON:
+		return &LogicalType{Kind: LogicalJSON}
+	case ConvertedBSON:
+		return &LogicalType{Kind: LogicalBSON}
+	case ConvertedDecimal:
+		return &LogicalType{Kind: LogicalDecimal, Scale: c.Scale, Precision: c.Precision}
+	case ConvertedDate:
+		return &LogicalType{Kind: LogicalDate}
+	case ConvertedTimeMillis:
+		return &LogicalType{Kind: LogicalTime, Unit: Millis, IsAdjustedToUTC: true}
+	case ConvertedTimeMicros:
+		return &LogicalType{Kind: LogicalTime, Unit: Micros, IsAdjustedToUTC: true}
+	case ConvertedTimestampMillis:
+		return &LogicalType{Kind: LogicalTimestamp, Unit: Millis, IsAdjustedToUTC: true}
+	case ConvertedTimestampMicros:
+		return &LogicalType{Kind: LogicalTimestamp, Unit: Micros, IsAdjustedToUTC: true}
+	case ConvertedUint8:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 8}
+	case ConvertedUint16:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 16}
+	case ConvertedUint32:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 32}
+	case ConvertedUint64:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 64}
+	case ConvertedInt8:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 8, IsSigned: true}
+	case ConvertedInt16:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 16, IsSigned: true}
+	case ConvertedInt32:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 32, IsSigned: true}
+	case ConvertedInt64:
+		return &LogicalType{Kind: LogicalInteger, BitWidth: 64, IsSigned: true}
+	}
+	return nil
+}
+
+// MinMaxValues decodes the min and max values of the statistics, the result
+// has two values, the first one is the min value.
+func (c *Column) MinMaxValues(s *Statistics) (*Values, bool) {
+	min, max, ok := c.MinMax(s)
+	if !ok {
+		return nil, false
+	}
+	vs := &Values{Type: c.Type}
+	if c.Type == ByteArray || c.Type == FixedLenByteArray {
+		if c.Type == FixedLenByteArray && (len(min) != int(c.TypeLength) || len(max) != int(c.TypeLength)) {
+			return nil, false
+		}
+		vs.Bytes = [][]byte{min, max}
+		return vs, true
+	}
+	for _, b := range [][]byte{min, max} {
+		if err := decodePlain(c.Type, int(c.TypeLength), b, 1, vs); err != nil {
+			return nil, false
+		}
+	}
+	return vs, true
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/require"
+)
+
+// openFixture opens the file of testdata written by testdata/gen_fixtures.py.
+func openFixture(t *testing.T, name string) *File {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if os.IsNotExist(err) {
+		t.Skipf("%s is not generated, run testdata/gen_fixtures.py", name)
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	stat, err := f.Stat()
+	require.NoError(t, err)
+	file, err := Open(f, stat.Size())
+	require.NoError(t, err)
+	return file
+}
+
+// readColumn reads all the rows of the column in small batches to cross the
+// pages and the row groups.
+func readColumn(t *testing.T, f *File, col int) *Values {
+	vs := &Values{}
+	for rg := 0; rg < f.NumRowGroups(); rg++ {
+		r, err := f.OpenColumnChunk(rg, col)
+		require.NoError(t, err)
+		for {
+			_, err = r.Read(vs, 70)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+	}
+	return vs
+}
+
+// fixtureValues are the rows of the flat files of gen_fixtures.py.
+func fixtureValues(i int) (i32 int32, i64 *int64, f64 float64, b *bool, str *string, ts int64) {
+	i32 = int32(i)
+	if i%7 != 0 {
+		v := int64(i)*1000003 - 500000
+		i64 = &v
+	}
+	f64 = float64(i) / 4
+	if i%5 != 0 {
+		v := i%2 == 0
+		b = &v
+	}
+	if i%3 != 0 {
+		v := fmt.Sprintf("s%d", i%50)
+		str = &v
+	}
+	ts = int64(i) * 1000000
+	return
+}
+
+func TestReadFixtures(t *testing.T) {
+	files := []struct {
+		name  string
+		codec CompressionCodec
+	}{
+		{"pyarrow_plain_snappy.parquet", Snappy},
+		{"pyarrow_dict_zstd.parquet", Zstd},
+		{"pyarrow_v2_gzip.parquet", Gzip},
+		{"pyarrow_v2_lz4raw.parquet", LZ4Raw},
+	}
+	for _, file := range files {
+		t.Run(file.name, func(t *testing.T) {
+			f := openFixture(t, file.name)
+			require.Equal(t, int64(1000), f.NumRows())
+			require.Equal(t, 2, f.NumRowGroups())
+			require.Equal(t, 6, len(f.Columns))
+			require.Equal(t, file.codec, f.RowGroup(0).Columns[0].Meta.Codec)
+			require.Equal(t, LogicalTimestamp, f.Columns[5].LogicalType().Kind)
+			require.Equal(t, Micros, f.Columns[5].LogicalType().Unit)
+
+			cols := make([]*Values, len(f.Columns))
+			for i := range cols {
+				cols[i] = readColumn(t, f, i)
+				require.Equal(t, 1000, cols[i].Len(), "column %s", f.Columns[i].Name())
+			}
+			for i := 0; i < 1000; i++ {
+				i32, i64, f64, b, str, ts := fixtureValues(i)
+				require.Equal(t, i32, cols[0].Int32s[i])
+				if i64 == nil {
+					require.True(t, cols[1].IsNull(i))
+				} else {
+					require.False(t, cols[1].IsNull(i))
+					require.Equal(t, *i64, cols[1].Int64s[i])
+				}
+				require.Equal(t, f64, cols[2].Doubles[i])
+				if b == nil {
+					require.True(t, cols[3].IsNull(i))
+				} else {
+					require.False(t, cols[3].IsNull(i))
+					require.Equal(t, *b, cols[3].Booleans[i])
+				}
+				if str == nil {
+					require.True(t, cols[4].IsNull(i))
+				} else {
+					require.False(t, cols[4].IsNull(i))
+					require.Equal(t, *str, string(cols[4].Bytes[i]))
+				}
+				require.Equal(t, ts, cols[5].Int64s[i])
+			}
+		})
+	}
+}
+
+func TestReadNestedFixture(t *testing.T) {
+	f := openFixture(t, "pyarrow_nested.parquet")
+	require.Equal(t, 2, len(f.Columns))
+	vs := readColumn(t, f, 0)
+	require.Equal(t, []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, vs.Int32s)
+	require.True(t, f.Columns[1].IsNested())
+	_, err := f.OpenColumnChunk(0, 1)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNYI))
+}
+
+// TestReadHadoopLZ4Fixture reads the file of parquet-testing written by
+// parquet-mr, the pages are compressed by the hadoop framed LZ4.
+func TestReadHadoopLZ4Fixture(t *testing.T) {
+	f := openFixture(t, "hadoop_lz4_compressed.parquet")
+	require.Equal(t, int64(4), f.NumRows())
+	require.Equal(t, LZ4, f.RowGroup(0).Columns[0].Meta.Codec)
+	names := make([]string, len(f.Columns))
+	for i, c := range f.Columns {
+		names[i] = c.Name()
+	}
+	require.Equal(t, []string{"c0", "c1", "v11"}, names)
+	require.Equal(t, []int64{1593604800, 1593604800, 1593604801, 1593604801}, readColumn(t, f, 0).Int64s)
+	var strs []string
+	for _, b := range readColumn(t, f, 1).Bytes {
+		strs = append(strs, string(b))
+	}
+	require.Equal(t, []string{"abc", "def", "abc", "def"}, strs)
+	require.Equal(t, []float64{42, 7.7, 42.125, 7.7}, readColumn(t, f, 2).Doubles)
+}
@@ -0,0 +1,585 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+// The structs in this file follow the definitions of parquet.thrift, see
+// https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+// only the fields used by the reader and the writer of the tests are kept.
+
+// Type is the physical type of a column.
+type Type int32
+
+const (
+	Boolean           Type = 0
+	Int32             Type = 1
+	Int64             Type = 2
+	Int96             Type = 3
+	Float             Type = 4
+	Double            Type = 5
+	ByteArray         Type = 6
+	FixedLenByteArray Type = 7
+)
+
+func (t Type) String() string {
+	switch t {
+	case Boolean:
+		return "BOOLEAN"
+	case Int32:
+		return "INT32"
+	case Int64:
+		return "INT64"
+	case Int96:
+		return "INT96"
+	case Float:
+		return "FLOAT"
+	case Double:
+		return "DOUBLE"
+	case ByteArray:
+		return "BYTE_ARRAY"
+	case FixedLenByteArray:
+		return "FIXED_LEN_BYTE_ARRAY"
+	}
+	return "UNKNOWN"
+}
+
+// ConvertedType is the legacy annotation of a column, it is superseded by
+// LogicalType but still written by many writers.
+type ConvertedType int32
+
+const (
+	ConvertedNone ConvertedType = -1
+
+	ConvertedUTF8            ConvertedType = 0
+	ConvertedMap             ConvertedType = 1
+	ConvertedMapKeyValue     ConvertedType = 2
+	ConvertedList            ConvertedType = 3
+	ConvertedEnum            ConvertedType = 4
+	ConvertedDecimal         ConvertedType = 5
+	ConvertedDate            ConvertedType = 6
+	ConvertedTimeMillis      ConvertedType = 7
+	ConvertedTimeMicros      ConvertedType = 8
+	ConvertedTimestampMillis ConvertedType = 9
+	ConvertedTimestampMicros ConvertedType = 10
+	ConvertedUint8           ConvertedType = 11
+	ConvertedUint16          ConvertedType = 12
+	ConvertedUint32          ConvertedType = 13
+	ConvertedUint64          ConvertedType = 14
+	ConvertedInt8            ConvertedType = 15
+	ConvertedInt16           ConvertedType = 16
+	ConvertedInt32           ConvertedType = 17
+	ConvertedInt64           ConvertedType = 18
+	ConvertedJSON            ConvertedType = 19
+	ConvertedBSON            ConvertedType = 20
+	ConvertedInterval        ConvertedType = 21
+)
+
+// LogicalKind is the kind of LogicalType, the values are the field ids of the
+// LogicalType union.
+type LogicalKind int16
+
+const (
+	LogicalNone      LogicalKind = 0
+	LogicalString    LogicalKind = 1
+	LogicalMap       LogicalKind = 2
+	LogicalList      LogicalKind = 3
+	LogicalEnum      LogicalKind = 4
+	LogicalDecimal   LogicalKind = 5
+	LogicalDate      LogicalKind = 6
+	LogicalTime      LogicalKind = 7
+	LogicalTimestamp LogicalKind = 8
+	LogicalInteger   LogicalKind = 10
+	LogicalUnknown   LogicalKind = 11
+	LogicalJSON      LogicalKind = 12
+	LogicalBSON      LogicalKind = 13
+	LogicalUUID      LogicalKind = 14
+	LogicalFloat16   LogicalKind = 15
+)
+
+type TimeUnit int16
+
+const (
+	Millis TimeUnit = 1
+	Micros TimeUnit = 2
+	Nanos  TimeUnit = 3
+)
+
+// LogicalType is the annotation of a column which tells how to interpret the
+// physical values.
+type LogicalType struct {
+	Kind LogicalKind
+	// for DECIMAL
+	Scale     int32
+	Precision int32
+	// for TIME and TIMESTAMP
+	Unit            TimeUnit
+	IsAdjustedToUTC bool
+	// for INTEGER
+	BitWidth int8
+	IsSigned bool
+}
+
+type Repetition int32
+
+const (
+	Required Repetition = 0
+	Optional Repetition = 1
+	Repeated Repetition = 2
+)
+
+type Encoding int32
+
+const (
+	EncodingPlain                Encoding = 0
+	EncodingPlainDictionary      Encoding = 2
+	EncodingRLE                  Encoding = 3
+	EncodingBitPacked            Encoding = 4
+	EncodingDeltaBinaryPacked    Encoding = 5
+	EncodingDeltaLengthByteArray Encoding = 6
+	EncodingDeltaByteArray       Encoding = 7
+	EncodingRLEDictionary        Encoding = 8
+	EncodingByteStreamSplit      Encoding = 9
+)
+
+type CompressionCodec int32
+
+const (
+	Uncompressed CompressionCodec = 0
+	Snappy       CompressionCodec = 1
+	Gzip         CompressionCodec = 2
+	LZO          CompressionCodec = 3
+	Brotli       CompressionCodec = 4
+	LZ4          CompressionCodec = 5
+	Zstd         CompressionCodec = 6
+	LZ4Raw       CompressionCodec = 7
+)
+
+func (c CompressionCodec) String() string {
+	switch c {
+	case Uncompressed:
+		return "UNCOMPRESSED"
+	case Snappy:
+		return "SNAPPY"
+	case Gzip:
+		return "GZIP"
+	case LZO:
+		return "LZO"
+	case Brotli:
+		return "BROTLI"
+	case LZ4:
+		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case LZ4Raw:
+		return "LZ4_RAW"
+	}
+	return "UNKNOWN"
+}
+
+type PageType int32
+
+const (
+	DataPage       PageType = 0
+	IndexPage      PageType = 1
+	DictionaryPage PageType = 2
+	DataPageV2     PageType = 3
+)
+
+type FileMetaData struct {
+	Version   int32
+	Schema    []*SchemaElement
+	NumRows   int64
+	RowGroups []*RowGroup
+	CreatedBy string
+}
+
+type SchemaElement struct {
+	// Type is valid only if the element is a leaf, i.e. NumChildren is 0
+	Type          Type
+	TypeLength    int32
+	Repetition    Repetition
+	Name          string
+	NumChildren   int32
+	ConvertedType ConvertedType
+	Scale         int32
+	Precision     int32
+	Logical       *LogicalType
+}
+
+type RowGroup struct {
+	Columns       []*ColumnChunk
+	TotalByteSize int64
+	NumRows       int64
+}
+
+type ColumnChunk struct {
+	FilePath   string
+	FileOffset int64
+	Meta       *ColumnMetaData
+}
+
+type ColumnMetaData struct {
+	Type                  Type
+	Encodings             []Encoding
+	PathInSchema          []string
+	Codec                 CompressionCodec
+	NumValues             int64
+	TotalUncompressedSize int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	// DictionaryPageOffset is 0 if the chunk has no dictionary page
+	DictionaryPageOffset int64
+	Statistics           *Statistics
+}
+
+// Statistics of a column chunk, the values are PLAIN encoded and the byte
+// arrays are not prefixed by the length. Min and Max are deprecated since they
+// are compared by the signed bytes, MinValue and MaxValue should be preferred.
+type Statistics struct {
+	Max           []byte
+	Min           []byte
+	NullCount     int64
+	HasNullCount  bool
+	DistinctCount int64
+	MaxValue      []byte
+	MinValue      []byte
+}
+
+type PageHeader struct {
+	Type                 PageType
+	UncompressedPageSize int32
+	CompressedPageSize   int32
+	DataPage             *DataPageHeader
+	DictionaryPage       *DictionaryPageHeader
+	DataPageV2           *DataPageHeaderV2
+}
+
+type DataPageHeader struct {
+	NumValues               int32
+	Encoding                Encoding
+	DefinitionLevelEncoding Encoding
+	RepetitionLevelEncoding Encoding
+}
+
+type DictionaryPageHeader struct {
+	NumValues int32
+	Encoding  Encoding
+	IsSorted  bool
+}
+
+type DataPageHeaderV2 struct {
+	NumValues                  int32
+	NumNulls                   int32
+	NumRows                    int32
+	Encoding                   Encoding
+	DefinitionLevelsByteLength int32
+	RepetitionLevelsByteLength int32
+	IsCompressed               bool
+}
+
+func (m *FileMetaData) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == ctI32:
+			m.Version, err = r.readI32()
+		case id == 2 && typ == ctList:
+			err = r.readList(func(typ byte) error {
+				if typ != ctStruct {
+					return errCorrupted("schema")
+				}
+				e := &SchemaElement{}
+				m.Schema = append(m.Schema, e)
+				return e.read(r)
+			})
+		case id == 3 && typ == ctI64:
+			m.NumRows, err = r.readI64()
+		case id == 4 && typ == ctList:
+			err = r.readList(func(typ byte) error {
+				if typ != ctStruct {
+					return errCorrupted("row group")
+				}
+				rg := &RowGroup{}
+				m.RowGroups = append(m.RowGroups, rg)
+				return rg.read(r)
+			})
+		case id == 6 && typ == ctBinary:
+			m.CreatedBy, err = r.readString()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (e *SchemaElement) read(r *compactReader) error {
+	e.ConvertedType = ConvertedNone
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			v, err = r.readI32()
+			e.Type = Type(v)
+		case id == 2 && typ == ctI32:
+			e.TypeLength, err = r.readI32()
+		case id == 3 && typ == ctI32:
+			v, err = r.readI32()
+			e.Repetition = Repetition(v)
+		case id == 4 && typ == ctBinary:
+			e.Name, err = r.readString()
+		case id == 5 && typ == ctI32:
+			e.NumChildren, err = r.readI32()
+		case id == 6 && typ == ctI32:
+			v, err = r.readI32()
+			e.ConvertedType = ConvertedType(v)
+		case id == 7 && typ == ctI32:
+			e.Scale, err = r.readI32()
+		case id == 8 && typ == ctI32:
+			e.Precision, err = r.readI32()
+		case id == 10 && typ == ctStruct:
+			e.Logical = &LogicalType{}
+			err = e.Logical.read(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (l *LogicalType) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		if typ != ctStruct {
+			return r.skip(typ)
+		}
+		l.Kind = LogicalKind(id)
+		return r.readStruct(func(fid int16, ftyp byte) (err error) {
+			switch {
+			case l.Kind == LogicalDecimal && fid == 1 && ftyp == ctI32:
+				l.Scale, err = r.readI32()
+			case l.Kind == LogicalDecimal && fid == 2 && ftyp == ctI32:
+				l.Precision, err = r.readI32()
+			case (l.Kind == LogicalTime || l.Kind == LogicalTimestamp) && fid == 1:
+				l.IsAdjustedToUTC, err = r.readBool(ftyp)
+			case (l.Kind == LogicalTime || l.Kind == LogicalTimestamp) && fid == 2 && ftyp == ctStruct:
+				// TimeUnit is a union of empty structs
+				err = r.readStruct(func(uid int16, utyp byte) error {
+					l.Unit = TimeUnit(uid)
+					return r.skip(utyp)
+				})
+			case l.Kind == LogicalInteger && fid == 1 && ftyp == ctByte:
+				var b byte
+				b, err = r.readByte()
+				l.BitWidth = int8(b)
+			case l.Kind == LogicalInteger && fid == 2:
+				l.IsSigned, err = r.readBool(ftyp)
+			default:
+				err = r.skip(ftyp)
+			}
+			return
+		})
+	})
+}
+
+func (rg *RowGroup) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == ctList:
+			err = r.readList(func(typ byte) error {
+				if typ != ctStruct {
+					return errCorrupted("column chunk")
+				}
+				c := &ColumnChunk{}
+				rg.Columns = append(rg.Columns, c)
+				return c.read(r)
+			})
+		case id == 2 && typ == ctI64:
+			rg.TotalByteSize, err = r.readI64()
+		case id == 3 && typ == ctI64:
+			rg.NumRows, err = r.readI64()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (c *ColumnChunk) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == ctBinary:
+			c.FilePath, err = r.readString()
+		case id == 2 && typ == ctI64:
+			c.FileOffset, err = r.readI64()
+		case id == 3 && typ == ctStruct:
+			c.Meta = &ColumnMetaData{}
+			err = c.Meta.read(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (m *ColumnMetaData) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			v, err = r.readI32()
+			m.Type = Type(v)
+		case id == 2 && typ == ctList:
+			var vs []int32
+			vs, err = r.readI32List()
+			for _, v := range vs {
+				m.Encodings = append(m.Encodings, Encoding(v))
+			}
+		case id == 3 && typ == ctList:
+			m.PathInSchema, err = r.readStringList()
+		case id == 4 && typ == ctI32:
+			v, err = r.readI32()
+			m.Codec = CompressionCodec(v)
+		case id == 5 && typ == ctI64:
+			m.NumValues, err = r.readI64()
+		case id == 6 && typ == ctI64:
+			m.TotalUncompressedSize, err = r.readI64()
+		case id == 7 && typ == ctI64:
+			m.TotalCompressedSize, err = r.readI64()
+		case id == 9 && typ == ctI64:
+			m.DataPageOffset, err = r.readI64()
+		case id == 11 && typ == ctI64:
+			m.DictionaryPageOffset, err = r.readI64()
+		case id == 12 && typ == ctStruct:
+			m.Statistics = &Statistics{}
+			err = m.Statistics.read(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (s *Statistics) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == ctBinary:
+			s.Max, err = r.readBinary()
+		case id == 2 && typ == ctBinary:
+			s.Min, err = r.readBinary()
+		case id == 3 && typ == ctI64:
+			s.NullCount, err = r.readI64()
+			s.HasNullCount = true
+		case id == 4 && typ == ctI64:
+			s.DistinctCount, err = r.readI64()
+		case id == 5 && typ == ctBinary:
+			s.MaxValue, err = r.readBinary()
+		case id == 6 && typ == ctBinary:
+			s.MinValue, err = r.readBinary()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (h *PageHeader) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			v, err = r.readI32()
+			h.Type = PageType(v)
+		case id == 2 && typ == ctI32:
+			h.UncompressedPageSize, err = r.readI32()
+		case id == 3 && typ == ctI32:
+			h.CompressedPageSize, err = r.readI32()
+		case id == 5 && typ == ctStruct:
+			h.DataPage = &DataPageHeader{}
+			err = h.DataPage.read(r)
+		case id == 7 && typ == ctStruct:
+			h.DictionaryPage = &DictionaryPageHeader{}
+			err = h.DictionaryPage.read(r)
+		case id == 8 && typ == ctStruct:
+			h.DataPageV2 = &DataPageHeaderV2{IsCompressed: true}
+			err = h.DataPageV2.read(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (p *DataPageHeader) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			p.NumValues, err = r.readI32()
+		case id == 2 && typ == ctI32:
+			v, err = r.readI32()
+			p.Encoding = Encoding(v)
+		case id == 3 && typ == ctI32:
+			v, err = r.readI32()
+			p.DefinitionLevelEncoding = Encoding(v)
+		case id == 4 && typ == ctI32:
+			v, err = r.readI32()
+			p.RepetitionLevelEncoding = Encoding(v)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (p *DictionaryPageHeader) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			p.NumValues, err = r.readI32()
+		case id == 2 && typ == ctI32:
+			v, err = r.readI32()
+			p.Encoding = Encoding(v)
+		case id == 3:
+			p.IsSorted, err = r.readBool(typ)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
+
+func (p *DataPageHeaderV2) read(r *compactReader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == ctI32:
+			p.NumValues, err = r.readI32()
+		case id == 2 && typ == ctI32:
+			p.NumNulls, err = r.readI32()
+		case id == 3 && typ == ctI32:
+			p.NumRows, err = r.readI32()
+		case id == 4 && typ == ctI32:
+			v, err = r.readI32()
+			p.Encoding = Encoding(v)
+		case id == 5 && typ == ctI32:
+			p.DefinitionLevelsByteLength, err = r.readI32()
+		case id == 6 && typ == ctI32:
+			p.RepetitionLevelsByteLength, err = r.readI32()
+		case id == 7:
+			p.IsCompressed, err = r.readBool(typ)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+}
@@ -0,0 +1,423 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/bits"
+	"testing"
+
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func testColumns() []*SchemaElement {
+	return []*SchemaElement{
+		{Name: "b", Type: Boolean, Repetition: Required, ConvertedType: ConvertedNone},
+		{Name: "i32", Type: Int32, Repetition: Optional, ConvertedType: ConvertedNone},
+		{Name: "i64", Type: Int64, Repetition: Required, ConvertedType: ConvertedNone},
+		{Name: "f", Type: Float, Repetition: Required, ConvertedType: ConvertedNone},
+		{Name: "d", Type: Double, Repetition: Optional, ConvertedType: ConvertedNone},
+		{Name: "s", Type: ByteArray, Repetition: Optional, ConvertedType: ConvertedUTF8,
+			Logical: &LogicalType{Kind: LogicalString}},
+		{Name: "u", Type: FixedLenByteArray, TypeLength: 16, Repetition: Required, ConvertedType: ConvertedNone,
+			Logical: &LogicalType{Kind: LogicalUUID}},
+		{Name: "ts", Type: Int64, Repetition: Required, ConvertedType: ConvertedTimestampMicros,
+			Logical: &LogicalType{Kind: LogicalTimestamp, Unit: Micros, IsAdjustedToUTC: true}},
+		{Name: "dec", Type: Int32, Repetition: Required, ConvertedType: ConvertedDecimal, Scale: 2, Precision: 9,
+			Logical: &LogicalType{Kind: LogicalDecimal, Scale: 2, Precision: 9}},
+		{Name: "u8", Type: Int32, Repetition: Required, ConvertedType: ConvertedUint8,
+			Logical: &LogicalType{Kind: LogicalInteger, BitWidth: 8}},
+	}
+}
+
+func testValues(rows int, offset int) []*Values {
+	vs := []*Values{
+		{Type: Boolean}, {Type: Int32, Nulls: []bool{}}, {Type: Int64}, {Type: Float},
+		{Type: Double, Nulls: []bool{}}, {Type: ByteArray, Nulls: []bool{}}, {Type: FixedLenByteArray},
+		{Type: Int64}, {Type: Int32}, {Type: Int32},
+	}
+	for i := offset; i < offset+rows; i++ {
+		vs[0].Booleans = append(vs[0].Booleans, i%3 == 0)
+		vs[1].Nulls = append(vs[1].Nulls, i%4 == 0)
+		vs[1].Int32s = append(vs[1].Int32s, int32(i*10))
+		vs[2].Int64s = append(vs[2].Int64s, int64(-i))
+		vs[3].Floats = append(vs[3].Floats, float32(i)/2)
+		vs[4].Nulls = append(vs[4].Nulls, true)
+		vs[4].Doubles = append(vs[4].Doubles, 0)
+		vs[5].Nulls = append(vs[5].Nulls, i%5 == 1)
+		vs[5].Bytes = append(vs[5].Bytes, []byte{'a' + byte(i%7), 'x'})
+		u := make([]byte, 16)
+		u[15] = byte(i)
+		vs[6].Bytes = append(vs[6].Bytes, u)
+		vs[7].Int64s = append(vs[7].Int64s, int64(i)*1000000)
+		vs[8].Int32s = append(vs[8].Int32s, int32(i*101))
+		vs[9].Int32s = append(vs[9].Int32s, int32(250+i%6))
+	}
+	for i := range vs[1].Int32s {
+		if vs[1].Nulls[i] {
+			vs[1].Int32s[i] = 0
+		}
+	}
+	for i := range vs[5].Bytes {
+		if vs[5].Nulls[i] {
+			vs[5].Bytes[i] = nil
+		}
+	}
+	return vs
+}
+
+func writeTestFile(t *testing.T, rowGroups []int, opts ...WriterOption) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testColumns(), opts...)
+	require.NoError(t, err)
+	offset := 0
+	for _, rows := range rowGroups {
+		require.NoError(t, w.WriteRowGroup(testValues(rows, offset)))
+		offset += rows
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestWriteAndRead(t *testing.T) {
+	optss := [][]WriterOption{
+		nil,
+		{WithDictionary()},
+		{WithDataPageV2()},
+		{WithCompression(Snappy), WithDictionary()},
+		{WithCompression(Gzip), WithDataPageV2(), WithDictionary()},
+		{WithCompression(Zstd), WithDictionary()},
+		{WithCompression(LZ4), WithDataPageV2()},
+	}
+	for _, opts := range optss {
+		data := writeTestFile(t, []int{100, 37}, opts...)
+		f, err := Open(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		require.Equal(t, int64(137), f.NumRows())
+		require.Equal(t, 2, f.NumRowGroups())
+		require.Equal(t, len(testColumns()), len(f.Columns))
+		require.Equal(t, "s", f.Columns[5].Name())
+		require.Equal(t, ConvertedUTF8, f.Columns[5].ConvertedType)
+		require.Equal(t, LogicalTimestamp, f.Columns[7].Logical.Kind)
+		require.Equal(t, Micros, f.Columns[7].Logical.Unit)
+		require.True(t, f.Columns[7].Logical.IsAdjustedToUTC)
+		require.Equal(t, int32(2), f.Columns[8].Logical.Scale)
+		require.Equal(t, int32(16), f.Columns[6].TypeLength)
+
+		offset := 0
+		for rg, rows := range []int{100, 37} {
+			expected := testValues(rows, offset)
+			for col := range f.Columns {
+				r, err := f.OpenColumnChunk(rg, col)
+				require.NoError(t, err)
+				// read in small batches to cross the pages
+				vs := &Values{}
+				for {
+					_, err = r.Read(vs, 30)
+					if err == io.EOF {
+						break
+					}
+					require.NoError(t, err)
+				}
+				if expected[col].Nulls == nil {
+					require.Nil(t, vs.Nulls)
+				}
+				require.Equal(t, expected[col], vs, "column %d", col)
+			}
+			offset += rows
+		}
+	}
+}
+
+func TestDecompressLZ4(t *testing.T) {
+	src := bytes.Repeat([]byte("matrixone"), 100)
+	// two frames of the hadoop framing
+	var framed []byte
+	for _, part := range [][]byte{src[:300], src[300:]} {
+		data, err := compress(LZ4, part)
+		require.NoError(t, err)
+		framed = append(framed, data...)
+	}
+	dst, err := decompress(LZ4, framed, len(src))
+	require.NoError(t, err)
+	require.Equal(t, src, dst)
+
+	// a raw block written by the old writers
+	raw := make([]byte, lz4.CompressBlockBound(len(src)))
+	n, err := lz4.CompressBlock(src, raw, nil)
+	require.NoError(t, err)
+	dst, err = decompress(LZ4, raw[:n], len(src))
+	require.NoError(t, err)
+	require.Equal(t, src, dst)
+
+	_, err = decompress(LZ4, framed[:len(framed)-1], len(src))
+	require.Error(t, err)
+}
+
+func TestStatistics(t *testing.T) {
+	data := writeTestFile(t, []int{10})
+	f, err := Open(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	rg := f.RowGroup(0)
+
+	stats := func(col int) (min, max []byte, ok bool) {
+		return f.Columns[col].MinMax(rg.Columns[col].Meta.Statistics)
+	}
+	min, max, ok := stats(1)
+	require.True(t, ok)
+	require.Equal(t, int32(10), int32(binary.LittleEndian.Uint32(min)))
+	require.Equal(t, int32(90), int32(binary.LittleEndian.Uint32(max)))
+	require.Equal(t, int64(3), rg.Columns[1].Meta.Statistics.NullCount)
+
+	min, max, ok = stats(2)
+	require.True(t, ok)
+	require.Equal(t, int64(-9), int64(binary.LittleEndian.Uint64(min)))
+	require.Equal(t, int64(0), int64(binary.LittleEndian.Uint64(max)))
+
+	// all null
+	_, _, ok = stats(4)
+	require.False(t, ok)
+	require.Equal(t, int64(10), rg.Columns[4].Meta.Statistics.NullCount)
+
+	min, max, ok = stats(5)
+	require.True(t, ok)
+	require.Equal(t, []byte("ax"), min)
+	require.Equal(t, []byte("fx"), max)
+
+	// the decimal of int32 is signed
+	require.True(t, f.Columns[8].SortOrderSigned())
+	require.False(t, f.Columns[9].SortOrderSigned())
+	require.False(t, f.Columns[5].SortOrderSigned())
+
+	// the deprecated statistics are not used by the byte arrays
+	_, _, ok = f.Columns[5].MinMax(&Statistics{Min: []byte("a"), Max: []byte("b")})
+	require.False(t, ok)
+	_, _, ok = f.Columns[2].MinMax(&Statistics{Min: []byte{1}, Max: []byte{2}})
+	require.True(t, ok)
+	_, _, ok = f.Columns[2].MinMax(nil)
+	require.False(t, ok)
+}
+
+func TestOpenInvalidFile(t *testing.T) {
+	_, err := Open(bytes.NewReader([]byte("PAR1")), 4)
+	require.Error(t, err)
+
+	data := writeTestFile(t, []int{3})
+	bad := append([]byte{}, data...)
+	copy(bad[len(bad)-4:], "PAR2")
+	_, err = Open(bytes.NewReader(bad), int64(len(bad)))
+	require.Error(t, err)
+
+	// the footer length is larger than the file
+	bad = append([]byte{}, data...)
+	binary.LittleEndian.PutUint32(bad[len(bad)-8:], uint32(len(bad)))
+	_, err = Open(bytes.NewReader(bad), int64(len(bad)))
+	require.Error(t, err)
+
+	// truncated metadata
+	n := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	bad = append([]byte{}, data[:len(data)-8-n/2]...)
+	bad = binary.LittleEndian.AppendUint32(bad, uint32(n/2))
+	bad = append(bad, magic...)
+	_, err = Open(bytes.NewReader(bad), int64(len(bad)))
+	require.Error(t, err)
+}
+
+func TestNestedColumn(t *testing.T) {
+	meta := &FileMetaData{
+		Version: 1,
+		Schema: []*SchemaElement{
+			{Name: "schema", NumChildren: 2, ConvertedType: ConvertedNone},
+			{Name: "a", Type: Int32, Repetition: Required, ConvertedType: ConvertedNone},
+			{Name: "g", Repetition: Optional, NumChildren: 1, ConvertedType: ConvertedNone},
+			{Name: "x", Type: Int64, Repetition: Repeated, ConvertedType: ConvertedNone},
+		},
+		RowGroups: []*RowGroup{{
+			Columns: []*ColumnChunk{
+				{Meta: &ColumnMetaData{Type: Int32, DataPageOffset: 4}},
+				{Meta: &ColumnMetaData{Type: Int64, DataPageOffset: 4}},
+			},
+		}},
+	}
+	w := &compactWriter{}
+	meta.write(w)
+	data := append([]byte(magic), w.buf...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(w.buf)))
+	data = append(data, magic...)
+
+	f, err := Open(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(f.Columns))
+	require.False(t, f.Columns[0].IsNested())
+	require.Equal(t, "g.x", f.Columns[1].Name())
+	require.Equal(t, 2, f.Columns[1].MaxDefinitionLevel)
+	require.Equal(t, 1, f.Columns[1].MaxRepetitionLevel)
+	_, err = f.OpenColumnChunk(0, 1)
+	require.Error(t, err)
+
+	_, err = NewWriter(io.Discard, meta.Schema[2:3])
+	require.Error(t, err)
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	// RLE run of 5 x 3, then bit-packed run of 8 values with bit width 3
+	data := []byte{5 << 1, 3, 1<<1 | 1}
+	packed := make([]byte, 3)
+	for i := 0; i < 8; i++ {
+		writeBits(packed, i*3, 3, uint64(i))
+	}
+	data = append(data, packed...)
+	dst := make([]int32, 12)
+	n, err := decodeHybrid(data, 3, dst)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, []int32{3, 3, 3, 3, 3, 0, 1, 2, 3, 4, 5, 6}, dst)
+
+	_, err = decodeHybrid(data[:4], 3, dst)
+	require.Error(t, err)
+	_, err = decodeHybrid([]byte{0}, 3, dst)
+	require.Error(t, err)
+
+	vs := []int32{1, 0, 1, 1, 0, 0, 1, 1, 1, 0}
+	dst = make([]int32, len(vs))
+	_, err = decodeHybrid(encodeHybrid(nil, 1, vs), 1, dst)
+	require.NoError(t, err)
+	require.Equal(t, vs, dst)
+}
+
+func TestDecodeDelta(t *testing.T) {
+	// examples of the DELTA_BINARY_PACKED encoding in the specification
+	vs, n, err := decodeDeltaBinaryPacked([]byte{0x80, 0x01, 0x04, 0x05, 0x02, 0x02, 0, 0, 0, 0})
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, vs)
+
+	data := []byte{0x80, 0x01, 0x04, 0x08, 0x0e, 0x03, 2, 0, 0, 0, 0xc0, 0x3f, 0, 0, 0, 0, 0, 0}
+	vs, n, err = decodeDeltaBinaryPacked(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, []int64{7, 5, 3, 1, 2, 3, 4, 5}, vs)
+
+	_, _, err = decodeDeltaBinaryPacked(data[:12])
+	require.Error(t, err)
+	_, _, err = decodeDeltaBinaryPacked([]byte{0x80, 0x01, 0x03, 0x08, 0x0e})
+	require.Error(t, err)
+
+	vs = []int64{-5, 1 << 40, 3, 3, 3, -1 << 62, 0}
+	for i := 0; i < 300; i++ {
+		vs = append(vs, int64(i*i))
+	}
+	data = encodeDeltaBinaryPacked(vs)
+	got, n, err := decodeDeltaBinaryPacked(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, vs, got)
+
+	strs := []string{"axis", "axle", "babble", "babyhood"}
+	var bs [][]byte
+	data = encodeDeltaBinaryPacked([]int64{4, 4, 6, 8})
+	data = append(data, "axisaxlebabblebabyhood"...)
+	n, err = decodeDeltaLengthByteArray(data, 4, &bs)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	for i, s := range strs {
+		require.Equal(t, s, string(bs[i]))
+	}
+
+	bs = nil
+	data = encodeDeltaBinaryPacked([]int64{0, 2, 0, 3})
+	data = append(data, encodeDeltaBinaryPacked([]int64{4, 2, 6, 5})...)
+	data = append(data, "axislebabbleyhood"...)
+	require.NoError(t, decodeDeltaByteArray(data, 4, &bs))
+	for i, s := range strs {
+		require.Equal(t, s, string(bs[i]))
+	}
+	bs = nil
+	data = encodeDeltaBinaryPacked([]int64{0, 5, 0, 3})
+	data = append(data, encodeDeltaBinaryPacked([]int64{4, 2, 6, 5})...)
+	data = append(data, "axislebabbleyhood"...)
+	require.Error(t, decodeDeltaByteArray(data, 4, &bs))
+}
+
+// encodeDeltaBinaryPacked encodes the values by the blocks of 128 values and
+// 4 miniblocks.
+func encodeDeltaBinaryPacked(vs []int64) []byte {
+	zigzag := func(v int64) uint64 {
+		return uint64(v<<1) ^ uint64(v>>63)
+	}
+	buf := binary.AppendUvarint(nil, 128)
+	buf = binary.AppendUvarint(buf, 4)
+	buf = binary.AppendUvarint(buf, uint64(len(vs)))
+	if len(vs) == 0 {
+		return binary.AppendUvarint(buf, 0)
+	}
+	buf = binary.AppendUvarint(buf, zigzag(vs[0]))
+	deltas := make([]int64, 0, len(vs))
+	for i := 1; i < len(vs); i++ {
+		deltas = append(deltas, int64(uint64(vs[i])-uint64(vs[i-1])))
+	}
+	for len(deltas) > 0 {
+		block := deltas
+		if len(block) > 128 {
+			block = block[:128]
+		}
+		deltas = deltas[len(block):]
+		min := block[0]
+		for _, d := range block {
+			if d < min {
+				min = d
+			}
+		}
+		buf = binary.AppendUvarint(buf, zigzag(min))
+		var widths [4]int
+		for j, d := range block {
+			if w := bits.Len64(uint64(d) - uint64(min)); w > widths[j/32] {
+				widths[j/32] = w
+			}
+		}
+		for _, w := range widths {
+			buf = append(buf, byte(w))
+		}
+		for m := 0; m*32 < len(block); m++ {
+			packed := make([]byte, 32*widths[m]/8)
+			for j := 0; j < 32 && m*32+j < len(block); j++ {
+				writeBits(packed, j*widths[m], widths[m], uint64(block[m*32+j])-uint64(min))
+			}
+			buf = append(buf, packed...)
+		}
+	}
+	return buf
+}
+
+func TestDecodeByteStreamSplit(t *testing.T) {
+	vs := &Values{Type: Int32, Int32s: []int32{0x01020304, 0x05060708}}
+	var plain []byte
+	for i := range vs.Int32s {
+		plain = encodePlain(plain, vs, i)
+	}
+	split := make([]byte, len(plain))
+	for i := 0; i < 2; i++ {
+		for k := 0; k < 4; k++ {
+			split[k*2+i] = plain[i*4+k]
+		}
+	}
+	dst := &Values{Type: Int32}
+	require.NoError(t, decodeByteStreamSplit(Int32, 0, split, 2, dst))
+	require.Equal(t, vs.Int32s, dst.Int32s)
+	require.Error(t, decodeByteStreamSplit(ByteArray, 0, split, 2, dst))
+}
@@ -0,0 +1,384 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"math/bits"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/pierrec/lz4/v4"
+)
+
+// ColumnChunkReader reads the rows of a column chunk, the pages are decoded one
+// by one.
+type ColumnChunkReader struct {
+	col   *Column
+	codec CompressionCodec
+	data  []byte
+	off   int
+	dict  *Values
+
+	// the decoded values of the current page, the null values are not included
+	page     Values
+	pageDefs []int32
+	pageRows int
+	pageRow  int
+	pageVal  int
+	nulls    []bool
+}
+
+// Read appends at most n rows to dst, it returns io.EOF if there is no more rows.
+func (c *ColumnChunkReader) Read(dst *Values, n int) (int, error) {
+	dst.Type = c.col.Type
+	read := 0
+	for read < n {
+		if c.pageRow == c.pageRows {
+			ok, err := c.nextPage()
+			if err != nil {
+				return read, err
+			}
+			if !ok {
+				break
+			}
+			continue
+		}
+		k := n - read
+		if k > c.pageRows-c.pageRow {
+			k = c.pageRows - c.pageRow
+		}
+		var nulls []bool
+		if c.pageDefs != nil {
+			c.nulls = c.nulls[:0]
+			for _, d := range c.pageDefs[c.pageRow : c.pageRow+k] {
+				c.nulls = append(c.nulls, int(d) != c.col.MaxDefinitionLevel)
+			}
+			nulls = c.nulls
+		}
+		if nulls != nil || dst.Nulls != nil {
+			// keep one slot for every row once there are null values
+			if dst.Nulls == nil {
+				dst.Nulls = make([]bool, dst.Len(), dst.Len()+k)
+			}
+			if nulls != nil {
+				dst.Nulls = append(dst.Nulls, nulls...)
+			} else {
+				dst.Nulls = append(dst.Nulls, make([]bool, k)...)
+			}
+		}
+		p := &c.page
+		switch c.col.Type {
+		case Boolean:
+			c.pageVal = expand(&dst.Booleans, p.Booleans, c.pageVal, k, nulls)
+		case Int32:
+			c.pageVal = expand(&dst.Int32s, p.Int32s, c.pageVal, k, nulls)
+		case Int64:
+			c.pageVal = expand(&dst.Int64s, p.Int64s, c.pageVal, k, nulls)
+		case Int96:
+			c.pageVal = expand(&dst.Int96s, p.Int96s, c.pageVal, k, nulls)
+		case Float:
+			c.pageVal = expand(&dst.Floats, p.Floats, c.pageVal, k, nulls)
+		case Double:
+			c.pageVal = expand(&dst.Doubles, p.Doubles, c.pageVal, k, nulls)
+		default:
+			c.pageVal = expand(&dst.Bytes, p.Bytes, c.pageVal, k, nulls)
+		}
+		c.pageRow += k
+		read += k
+	}
+	if read == 0 && n > 0 {
+		return 0, io.EOF
+	}
+	return read, nil
+}
+
+// nextPage decodes the next data page, the dictionary page is decoded on the way.
+func (c *ColumnChunkReader) nextPage() (bool, error) {
+	for c.off < len(c.data) {
+		r := &compactReader{buf: c.data[c.off:]}
+		h := &PageHeader{}
+		if err := h.read(r); err != nil {
+			return false, err
+		}
+		c.off += r.off
+		size := int(h.CompressedPageSize)
+		if size < 0 || size > len(c.data)-c.off || h.UncompressedPageSize < 0 {
+			return false, errCorrupted("page")
+		}
+		body := c.data[c.off : c.off+size]
+		c.off += size
+		switch h.Type {
+		case DictionaryPage:
+			if h.DictionaryPage == nil {
+				return false, errCorrupted("page")
+			}
+			if err := c.readDictionaryPage(h, body); err != nil {
+				return false, err
+			}
+		case DataPage:
+			if h.DataPage == nil {
+				return false, errCorrupted("page")
+			}
+			return true, c.readDataPage(h, body)
+		case DataPageV2:
+			if h.DataPageV2 == nil {
+				return false, errCorrupted("page")
+			}
+			return true, c.readDataPageV2(h, body)
+		}
+	}
+	return false, nil
+}
+
+func (c *ColumnChunkReader) readDictionaryPage(h *PageHeader, body []byte) error {
+	p := h.DictionaryPage
+	if p.Encoding != EncodingPlain && p.Encoding != EncodingPlainDictionary {
+		return moerr.NewNYINoCtx("parquet dictionary page encoding %d", p.Encoding)
+	}
+	data, err := decompress(c.codec, body, int(h.UncompressedPageSize))
+	if err != nil {
+		return err
+	}
+	c.dict = &Values{Type: c.col.Type}
+	return decodePlain(c.col.Type, int(c.col.TypeLength), data, int(p.NumValues), c.dict)
+}
+
+func (c *ColumnChunkReader) readDataPage(h *PageHeader, body []byte) error {
+	p := h.DataPage
+	data, err := decompress(c.codec, body, int(h.UncompressedPageSize))
+	if err != nil {
+		return err
+	}
+	c.resetPage(int(p.NumValues))
+	if c.pageDefs != nil {
+		if p.DefinitionLevelEncoding != EncodingRLE {
+			return moerr.NewNYINoCtx("parquet definition level encoding %d", p.DefinitionLevelEncoding)
+		}
+		n, err := decodeLevels(data, bits.Len(uint(c.col.MaxDefinitionLevel)), c.pageDefs)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return c.decodeValues(p.Encoding, data)
+}
+
+func (c *ColumnChunkReader) readDataPageV2(h *PageHeader, body []byte) error {
+	p := h.DataPageV2
+	rl, dl := int(p.RepetitionLevelsByteLength), int(p.DefinitionLevelsByteLength)
+	if rl < 0 || dl < 0 || rl+dl > len(body) {
+		return errCorrupted("page")
+	}
+	c.resetPage(int(p.NumValues))
+	if c.pageDefs != nil {
+		if _, err := decodeHybrid(body[rl:rl+dl], bits.Len(uint(c.col.MaxDefinitionLevel)), c.pageDefs); err != nil {
+			return err
+		}
+	}
+	data := body[rl+dl:]
+	if p.IsCompressed {
+		var err error
+		if data, err = decompress(c.codec, data, int(h.UncompressedPageSize)-rl-dl); err != nil {
+			return err
+		}
+	}
+	return c.decodeValues(p.Encoding, data)
+}
+
+func (c *ColumnChunkReader) resetPage(n int) {
+	c.page.Reset()
+	c.page.Type = c.col.Type
+	c.pageRows, c.pageRow, c.pageVal = n, 0, 0
+	c.pageDefs = nil
+	if c.col.MaxDefinitionLevel > 0 {
+		c.pageDefs = make([]int32, n)
+	}
+}
+
+// decodeValues decodes the values which are not null of the current page.
+func (c *ColumnChunkReader) decodeValues(enc Encoding, data []byte) error {
+	n := c.pageRows
+	if c.pageDefs != nil {
+		n = 0
+		for _, d := range c.pageDefs {
+			if int(d) == c.col.MaxDefinitionLevel {
+				n++
+			}
+		}
+	}
+	typ, typeLength := c.col.Type, int(c.col.TypeLength)
+	p := &c.page
+	switch enc {
+	case EncodingPlain:
+		return decodePlain(typ, typeLength, data, n, p)
+	case EncodingPlainDictionary, EncodingRLEDictionary:
+		if c.dict == nil {
+			return errCorrupted("page: missing dictionary")
+		}
+		if n == 0 {
+			return nil
+		}
+		if len(data) == 0 {
+			return errCorrupted("page")
+		}
+		idx := make([]int32, n)
+		if _, err := decodeHybrid(data[1:], int(data[0]), idx); err != nil {
+			return err
+		}
+		d := c.dict
+		switch typ {
+		case Boolean:
+			return gather(&p.Booleans, d.Booleans, idx)
+		case Int32:
+			return gather(&p.Int32s, d.Int32s, idx)
+		case Int64:
+			return gather(&p.Int64s, d.Int64s, idx)
+		case Int96:
+			return gather(&p.Int96s, d.Int96s, idx)
+		case Float:
+			return gather(&p.Floats, d.Floats, idx)
+		case Double:
+			return gather(&p.Doubles, d.Doubles, idx)
+		default:
+			return gather(&p.Bytes, d.Bytes, idx)
+		}
+	case EncodingRLE:
+		if typ != Boolean {
+			break
+		}
+		vs := make([]int32, n)
+		if _, err := decodeLevels(data, 1, vs); err != nil {
+			return err
+		}
+		for _, v := range vs {
+			p.Booleans = append(p.Booleans, v != 0)
+		}
+		return nil
+	case EncodingDeltaBinaryPacked:
+		if typ != Int32 && typ != Int64 {
+			break
+		}
+		vs, _, err := decodeDeltaBinaryPacked(data)
+		if err != nil {
+			return err
+		}
+		if len(vs) < n {
+			return errCorrupted("delta data")
+		}
+		for _, v := range vs[:n] {
+			if typ == Int32 {
+				p.Int32s = append(p.Int32s, int32(v))
+			} else {
+				p.Int64s = append(p.Int64s, v)
+			}
+		}
+		return nil
+	case EncodingDeltaLengthByteArray:
+		if typ != ByteArray {
+			break
+		}
+		_, err := decodeDeltaLengthByteArray(data, n, &p.Bytes)
+		return err
+	case EncodingDeltaByteArray:
+		if typ != ByteArray && typ != FixedLenByteArray {
+			break
+		}
+		return decodeDeltaByteArray(data, n, &p.Bytes)
+	case EncodingByteStreamSplit:
+		return decodeByteStreamSplit(typ, typeLength, data, n, p)
+	}
+	return moerr.NewNYINoCtx("parquet encoding %d of type %s", enc, typ)
+}
+
+func decompress(codec CompressionCodec, src []byte, size int) ([]byte, error) {
+	switch codec {
+	case Uncompressed:
+		return src, nil
+	case Snappy:
+		n, err := snappy.DecodedLen(src)
+		if err != nil || n != size {
+			return nil, errCorrupted("snappy page")
+		}
+		return snappy.Decode(make([]byte, size), src)
+	case Gzip:
+		r, err := gzip.NewReader(bytes.NewReader(src))
+		if err != nil {
+			return nil, err
+		}
+		dst := make([]byte, size)
+		if _, err = io.ReadFull(r, dst); err != nil {
+			return nil, errCorrupted("gzip page")
+		}
+		return dst, nil
+	case LZ4Raw:
+		dst := make([]byte, size)
+		n, err := lz4.UncompressBlock(src, dst)
+		if err != nil || n != size {
+			return nil, errCorrupted("lz4 page")
+		}
+		return dst, nil
+	case LZ4:
+		// the deprecated LZ4 codec is written with the hadoop framing by parquet-mr,
+		// and as a raw block by some old writers, try them in turn like arrow does
+		if dst, ok := decompressHadoopLZ4(src, size); ok {
+			return dst, nil
+		}
+		dst := make([]byte, size)
+		n, err := lz4.UncompressBlock(src, dst)
+		if err != nil || n != size {
+			return nil, errCorrupted("lz4 page")
+		}
+		return dst, nil
+	case Zstd:
+		dst, err := zstd.Decompress(make([]byte, size), src)
+		if err != nil || len(dst) != size {
+			return nil, errCorrupted("zstd page")
+		}
+		return dst, nil
+	}
+	return nil, moerr.NewNYINoCtx("parquet compression codec %s", codec)
+}
+
+// decompressHadoopLZ4 decodes the hadoop framing of lz4, which is a sequence of
+// frames of a big endian decompressed size, a big endian compressed size and a raw
+// lz4 block. ok is false if src is not in the framing.
+func decompressHadoopLZ4(src []byte, size int) ([]byte, bool) {
+	const headerSize = 8
+	dst := make([]byte, size)
+	off := 0
+	for len(src) > 0 {
+		if len(src) < headerSize {
+			return nil, false
+		}
+		rawSize := int(binary.BigEndian.Uint32(src))
+		compSize := int(binary.BigEndian.Uint32(src[4:]))
+		src = src[headerSize:]
+		if compSize > len(src) || rawSize > size-off {
+			return nil, false
+		}
+		n, err := lz4.UncompressBlock(src[:compSize], dst[off:off+rawSize])
+		if err != nil || n != rawSize {
+			return nil, false
+		}
+		src = src[compSize:]
+		off += rawSize
+	}
+	return dst, off == size
+}
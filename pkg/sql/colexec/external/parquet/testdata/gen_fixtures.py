@@ -0,0 +1,99 @@
+#!/usr/bin/env python3
+# Copyright 2023 Matrix Origin
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Writes the parquet files read by TestReadFixtures of the parquet package and
+# Test_ScanParquetFile of the external package, which check the reader against
+# the files written by other implementations. Run it with pyarrow installed:
+#
+#     python3 testdata/gen_fixtures.py
+#
+# The values must be kept in sync with fixtureValues in fixtures_test.go.
+
+import os
+import urllib.request
+from decimal import Decimal
+
+import pyarrow as pa
+import pyarrow.parquet as pq
+
+HERE = os.path.dirname(os.path.abspath(__file__))
+ROWS = 1000
+
+
+def flat_table():
+    return pa.table({
+        "i32": pa.array(range(ROWS), pa.int32()),
+        "i64": pa.array([None if i % 7 == 0 else i * 1000003 - 500000 for i in range(ROWS)], pa.int64()),
+        "f64": pa.array([i / 4 for i in range(ROWS)], pa.float64()),
+        "bool": pa.array([None if i % 5 == 0 else i % 2 == 0 for i in range(ROWS)], pa.bool_()),
+        "str": pa.array([None if i % 3 == 0 else "s%d" % (i % 50) for i in range(ROWS)], pa.string()),
+        "ts": pa.array([i * 1000000 for i in range(ROWS)], pa.timestamp("us", tz="UTC")),
+    }, schema=pa.schema([
+        pa.field("i32", pa.int32(), nullable=False),
+        pa.field("i64", pa.int64()),
+        pa.field("f64", pa.float64(), nullable=False),
+        pa.field("bool", pa.bool_()),
+        pa.field("str", pa.string()),
+        pa.field("ts", pa.timestamp("us", tz="UTC"), nullable=False),
+    ]))
+
+
+def write_flat(name, **kwargs):
+    # the small pages and row groups make the reader cross them
+    pq.write_table(flat_table(), os.path.join(HERE, name),
+                   row_group_size=600, data_page_size=512, **kwargs)
+
+
+def main():
+    write_flat("pyarrow_plain_snappy.parquet", use_dictionary=False,
+               compression="snappy", data_page_version="1.0")
+    write_flat("pyarrow_dict_zstd.parquet", use_dictionary=True,
+               compression="zstd", data_page_version="1.0")
+    write_flat("pyarrow_v2_gzip.parquet", use_dictionary=True,
+               compression="gzip", data_page_version="2.0")
+    write_flat("pyarrow_v2_lz4raw.parquet", use_dictionary=False,
+               compression="lz4", data_page_version="2.0")
+
+    # the repeated column isn't supported by the reader
+    nested = pa.table({
+        "i32": pa.array(range(10), pa.int32()),
+        "lst": pa.array([None if i % 3 == 0 else list(range(i)) for i in range(10)], pa.list_(pa.int32())),
+    })
+    pq.write_table(nested, os.path.join(HERE, "pyarrow_nested.parquet"))
+
+    # the same rows as ../../testdata/scan.parquet
+    scan = pa.table({
+        "a": pa.array(range(20), pa.int32()),
+        "B": pa.array([None if i % 3 == 0 else "s%d" % i for i in range(20)], pa.string()),
+        "c": pa.array([i * 1000 for i in range(20)], pa.timestamp("ms", tz="UTC")),
+        "d": pa.array([Decimal(i * 100 + 1).scaleb(-2) for i in range(20)], pa.decimal128(10, 2)),
+    }, schema=pa.schema([
+        pa.field("a", pa.int32(), nullable=False),
+        pa.field("B", pa.string()),
+        pa.field("c", pa.timestamp("ms", tz="UTC"), nullable=False),
+        pa.field("d", pa.decimal128(10, 2), nullable=False),
+    ]))
+    pq.write_table(scan, os.path.join(HERE, "..", "..", "testdata", "scan_pyarrow.parquet"),
+                   row_group_size=10, compression="snappy")
+
+    # written by parquet-mr with the hadoop framed LZ4, see
+    # https://github.com/apache/parquet-testing/tree/master/data
+    urllib.request.urlretrieve(
+        "https://github.com/apache/parquet-testing/raw/master/data/hadoop_lz4_compressed.parquet",
+        os.path.join(HERE, "hadoop_lz4_compressed.parquet"))
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,271 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// the element types of the thrift compact protocol
+const (
+	ctStop   byte = 0
+	ctTrue   byte = 1
+	ctFalse  byte = 2
+	ctByte   byte = 3
+	ctI16    byte = 4
+	ctI32    byte = 5
+	ctI64    byte = 6
+	ctDouble byte = 7
+	ctBinary byte = 8
+	ctList   byte = 9
+	ctSet    byte = 10
+	ctMap    byte = 11
+	ctStruct byte = 12
+)
+
+// thriftMaxDepth limits the nesting of the structs, it protects the reader
+// from the corrupted files.
+const thriftMaxDepth = 64
+
+func errCorrupted(what string) error {
+	return moerr.NewInvalidInputNoCtx("corrupted parquet %s", what)
+}
+
+// compactReader decodes the thrift compact protocol which is used by the
+// metadata of parquet files.
+type compactReader struct {
+	buf   []byte
+	off   int
+	depth int
+}
+
+func (r *compactReader) readByte() (byte, error) {
+	if r.off >= len(r.buf) {
+		return 0, errCorrupted("metadata")
+	}
+	b := r.buf[r.off]
+	r.off++
+	return b, nil
+}
+
+func (r *compactReader) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.off:])
+	if n <= 0 {
+		return 0, errCorrupted("metadata")
+	}
+	r.off += n
+	return v, nil
+}
+
+func (r *compactReader) readVarint() (int64, error) {
+	v, err := r.readUvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *compactReader) readI32() (int32, error) {
+	v, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, errCorrupted("metadata")
+	}
+	return int32(v), nil
+}
+
+func (r *compactReader) readI64() (int64, error) {
+	return r.readVarint()
+}
+
+func (r *compactReader) readDouble() (float64, error) {
+	if r.off+8 > len(r.buf) {
+		return 0, errCorrupted("metadata")
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf[r.off:]))
+	r.off += 8
+	return v, nil
+}
+
+// readBinary returns the bytes which are shared with the buffer of the reader.
+func (r *compactReader) readBinary() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.buf)-r.off) {
+		return nil, errCorrupted("metadata")
+	}
+	v := r.buf[r.off : r.off+int(n)]
+	r.off += int(n)
+	return v, nil
+}
+
+func (r *compactReader) readString() (string, error) {
+	v, err := r.readBinary()
+	return string(v), err
+}
+
+// readBool reads the boolean of a field, whose value is kept in the type of
+// the field header.
+func (r *compactReader) readBool(typ byte) (bool, error) {
+	switch typ {
+	case ctTrue:
+		return true, nil
+	case ctFalse:
+		return false, nil
+	}
+	return false, errCorrupted("metadata")
+}
+
+// readStruct reads the fields of a struct, fn is called for every field and it
+// should consume the value of the field or skip it.
+func (r *compactReader) readStruct(fn func(id int16, typ byte) error) error {
+	r.depth++
+	defer func() { r.depth-- }()
+	if r.depth > thriftMaxDepth {
+		return errCorrupted("metadata")
+	}
+	var id int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		typ := b & 0x0f
+		if typ == ctStop {
+			return nil
+		}
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := r.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		if err = fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+// readList reads the header of a list and calls fn for every element.
+func (r *compactReader) readList(fn func(typ byte) error) error {
+	b, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	typ := b & 0x0f
+	n := uint64(b >> 4)
+	if n == 15 {
+		if n, err = r.readUvarint(); err != nil {
+			return err
+		}
+	}
+	// every element takes one byte at least
+	if n > uint64(len(r.buf)-r.off) {
+		return errCorrupted("metadata")
+	}
+	for i := uint64(0); i < n; i++ {
+		if err = fn(typ); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *compactReader) readI32List() ([]int32, error) {
+	var vs []int32
+	err := r.readList(func(typ byte) error {
+		if typ != ctI32 {
+			return r.skip(typ)
+		}
+		v, err := r.readI32()
+		vs = append(vs, v)
+		return err
+	})
+	return vs, err
+}
+
+func (r *compactReader) readStringList() ([]string, error) {
+	var vs []string
+	err := r.readList(func(typ byte) error {
+		if typ != ctBinary {
+			return r.skip(typ)
+		}
+		v, err := r.readString()
+		vs = append(vs, v)
+		return err
+	})
+	return vs, err
+}
+
+// skip skips the value of type typ, the values in lists and sets are not
+// prefixed by the field header so the booleans of them take one byte.
+func (r *compactReader) skip(typ byte) error {
+	var err error
+	switch typ {
+	case ctTrue, ctFalse:
+	case ctByte:
+		_, err = r.readByte()
+	case ctI16, ctI32, ctI64:
+		_, err = r.readUvarint()
+	case ctDouble:
+		_, err = r.readDouble()
+	case ctBinary:
+		_, err = r.readBinary()
+	case ctList, ctSet:
+		err = r.readList(func(typ byte) error {
+			if typ == ctTrue || typ == ctFalse {
+				_, err := r.readByte()
+				return err
+			}
+			return r.skip(typ)
+		})
+	case ctMap:
+		var n uint64
+		if n, err = r.readUvarint(); err != nil || n == 0 {
+			return err
+		}
+		var b byte
+		if b, err = r.readByte(); err != nil {
+			return err
+		}
+		for i := uint64(0); i < n && err == nil; i++ {
+			if err = r.skipElem(b >> 4); err == nil {
+				err = r.skipElem(b & 0x0f)
+			}
+		}
+	case ctStruct:
+		err = r.readStruct(func(_ int16, typ byte) error {
+			return r.skip(typ)
+		})
+	default:
+		err = errCorrupted("metadata")
+	}
+	return err
+}
+
+func (r *compactReader) skipElem(typ byte) error {
+	if typ == ctTrue || typ == ctFalse {
+		_, err := r.readByte()
+		return err
+	}
+	return r.skip(typ)
+}
@@ -0,0 +1,255 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import "encoding/binary"
+
+// compactWriter encodes the thrift compact protocol.
+type compactWriter struct {
+	buf []byte
+	ids []int16
+}
+
+func (w *compactWriter) writeUvarint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func (w *compactWriter) writeVarint(v int64) {
+	w.writeUvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (w *compactWriter) fieldHeader(id int16, typ byte) {
+	last := w.ids[len(w.ids)-1]
+	if id > last && id-last <= 15 {
+		w.buf = append(w.buf, byte(id-last)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.writeVarint(int64(id))
+	}
+	w.ids[len(w.ids)-1] = id
+}
+
+func (w *compactWriter) structBegin() {
+	w.ids = append(w.ids, 0)
+}
+
+func (w *compactWriter) structEnd() {
+	w.buf = append(w.buf, ctStop)
+	w.ids = w.ids[:len(w.ids)-1]
+}
+
+func (w *compactWriter) fieldStruct(id int16) {
+	w.fieldHeader(id, ctStruct)
+	w.structBegin()
+}
+
+func (w *compactWriter) fieldBool(id int16, v bool) {
+	if v {
+		w.fieldHeader(id, ctTrue)
+	} else {
+		w.fieldHeader(id, ctFalse)
+	}
+}
+
+func (w *compactWriter) fieldI32(id int16, v int32) {
+	w.fieldHeader(id, ctI32)
+	w.writeVarint(int64(v))
+}
+
+func (w *compactWriter) fieldI64(id int16, v int64) {
+	w.fieldHeader(id, ctI64)
+	w.writeVarint(v)
+}
+
+func (w *compactWriter) fieldBinary(id int16, v []byte) {
+	w.fieldHeader(id, ctBinary)
+	w.writeBinary(v)
+}
+
+func (w *compactWriter) writeBinary(v []byte) {
+	w.writeUvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// fieldList writes the header of a list field, the caller writes the n
+// elements after it.
+func (w *compactWriter) fieldList(id int16, typ byte, n int) {
+	w.fieldHeader(id, ctList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|typ)
+	} else {
+		w.buf = append(w.buf, 0xf0|typ)
+		w.writeUvarint(uint64(n))
+	}
+}
+
+func (m *FileMetaData) write(w *compactWriter) {
+	w.structBegin()
+	w.fieldI32(1, m.Version)
+	w.fieldList(2, ctStruct, len(m.Schema))
+	for _, e := range m.Schema {
+		e.write(w)
+	}
+	w.fieldI64(3, m.NumRows)
+	w.fieldList(4, ctStruct, len(m.RowGroups))
+	for _, rg := range m.RowGroups {
+		rg.write(w)
+	}
+	if m.CreatedBy != "" {
+		w.fieldBinary(6, []byte(m.CreatedBy))
+	}
+	w.structEnd()
+}
+
+func (e *SchemaElement) write(w *compactWriter) {
+	w.structBegin()
+	if e.NumChildren == 0 {
+		w.fieldI32(1, int32(e.Type))
+		if e.Type == FixedLenByteArray {
+			w.fieldI32(2, e.TypeLength)
+		}
+	}
+	// the root has no repetition
+	if e.NumChildren == 0 || e.Repetition != Required {
+		w.fieldI32(3, int32(e.Repetition))
+	}
+	w.fieldBinary(4, []byte(e.Name))
+	if e.NumChildren > 0 {
+		w.fieldI32(5, e.NumChildren)
+	}
+	if e.ConvertedType != ConvertedNone {
+		w.fieldI32(6, int32(e.ConvertedType))
+	}
+	if e.ConvertedType == ConvertedDecimal {
+		w.fieldI32(7, e.Scale)
+		w.fieldI32(8, e.Precision)
+	}
+	if e.Logical != nil && e.Logical.Kind != LogicalNone {
+		w.fieldStruct(10)
+		e.Logical.write(w)
+		w.structEnd()
+	}
+	w.structEnd()
+}
+
+func (l *LogicalType) write(w *compactWriter) {
+	w.fieldStruct(int16(l.Kind))
+	switch l.Kind {
+	case LogicalDecimal:
+		w.fieldI32(1, l.Scale)
+		w.fieldI32(2, l.Precision)
+	case LogicalTime, LogicalTimestamp:
+		w.fieldBool(1, l.IsAdjustedToUTC)
+		w.fieldStruct(2)
+		w.fieldStruct(int16(l.Unit))
+		w.structEnd()
+		w.structEnd()
+	case LogicalInteger:
+		w.fieldHeader(1, ctByte)
+		w.buf = append(w.buf, byte(l.BitWidth))
+		w.fieldBool(2, l.IsSigned)
+	}
+	w.structEnd()
+}
+
+func (rg *RowGroup) write(w *compactWriter) {
+	w.structBegin()
+	w.fieldList(1, ctStruct, len(rg.Columns))
+	for _, c := range rg.Columns {
+		c.write(w)
+	}
+	w.fieldI64(2, rg.TotalByteSize)
+	w.fieldI64(3, rg.NumRows)
+	w.structEnd()
+}
+
+func (c *ColumnChunk) write(w *compactWriter) {
+	w.structBegin()
+	w.fieldI64(2, c.FileOffset)
+	w.fieldStruct(3)
+	c.Meta.write(w)
+	w.structEnd()
+}
+
+func (m *ColumnMetaData) write(w *compactWriter) {
+	w.fieldI32(1, int32(m.Type))
+	w.fieldList(2, ctI32, len(m.Encodings))
+	for _, e := range m.Encodings {
+		w.writeVarint(int64(e))
+	}
+	w.fieldList(3, ctBinary, len(m.PathInSchema))
+	for _, p := range m.PathInSchema {
+		w.writeBinary([]byte(p))
+	}
+	w.fieldI32(4, int32(m.Codec))
+	w.fieldI64(5, m.NumValues)
+	w.fieldI64(6, m.TotalUncompressedSize)
+	w.fieldI64(7, m.TotalCompressedSize)
+	w.fieldI64(9, m.DataPageOffset)
+	if m.DictionaryPageOffset > 0 {
+		w.fieldI64(11, m.DictionaryPageOffset)
+	}
+	if m.Statistics != nil {
+		w.fieldStruct(12)
+		m.Statistics.write(w)
+	}
+	w.structEnd()
+}
+
+func (s *Statistics) write(w *compactWriter) {
+	if s.HasNullCount {
+		w.fieldI64(3, s.NullCount)
+	}
+	if s.MaxValue != nil {
+		w.fieldBinary(5, s.MaxValue)
+	}
+	if s.MinValue != nil {
+		w.fieldBinary(6, s.MinValue)
+	}
+	w.structEnd()
+}
+
+func (h *PageHeader) write(w *compactWriter) {
+	w.structBegin()
+	w.fieldI32(1, int32(h.Type))
+	w.fieldI32(2, h.UncompressedPageSize)
+	w.fieldI32(3, h.CompressedPageSize)
+	if p := h.DataPage; p != nil {
+		w.fieldStruct(5)
+		w.fieldI32(1, p.NumValues)
+		w.fieldI32(2, int32(p.Encoding))
+		w.fieldI32(3, int32(p.DefinitionLevelEncoding))
+		w.fieldI32(4, int32(p.RepetitionLevelEncoding))
+		w.structEnd()
+	}
+	if p := h.DictionaryPage; p != nil {
+		w.fieldStruct(7)
+		w.fieldI32(1, p.NumValues)
+		w.fieldI32(2, int32(p.Encoding))
+		w.structEnd()
+	}
+	if p := h.DataPageV2; p != nil {
+		w.fieldStruct(8)
+		w.fieldI32(1, p.NumValues)
+		w.fieldI32(2, p.NumNulls)
+		w.fieldI32(3, p.NumRows)
+		w.fieldI32(4, int32(p.Encoding))
+		w.fieldI32(5, p.DefinitionLevelsByteLength)
+		w.fieldI32(6, p.RepetitionLevelsByteLength)
+		w.fieldBool(7, p.IsCompressed)
+		w.structEnd()
+	}
+	w.structEnd()
+}
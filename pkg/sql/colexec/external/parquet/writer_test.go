@@ -0,0 +1,503 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// Writer writes a parquet file of flat columns, every row group has one data
+// page for each column.
+type Writer struct {
+	w          io.Writer
+	off        int64
+	columns    []*Column
+	codec      CompressionCodec
+	dictionary bool
+	dataPageV2 bool
+	meta       *FileMetaData
+}
+
+type WriterOption func(*Writer)
+
+// WithCompression compresses the pages by the codec, the default is uncompressed.
+func WithCompression(codec CompressionCodec) WriterOption {
+	return func(w *Writer) {
+		w.codec = codec
+	}
+}
+
+// WithDictionary encodes the values by the dictionary, except the booleans.
+func WithDictionary() WriterOption {
+	return func(w *Writer) {
+		w.dictionary = true
+	}
+}
+
+// WithDataPageV2 writes the data pages of version 2.
+func WithDataPageV2() WriterOption {
+	return func(w *Writer) {
+		w.dataPageV2 = true
+	}
+}
+
+// NewWriter writes the header of the file, the columns should be leaves which
+// are required or optional.
+func NewWriter(w io.Writer, columns []*SchemaElement, opts ...WriterOption) (*Writer, error) {
+	pw := &Writer{
+		w: w,
+		meta: &FileMetaData{
+			Version:   1,
+			CreatedBy: "matrixone",
+		},
+	}
+	for _, opt := range opts {
+		opt(pw)
+	}
+	if _, err := compress(pw.codec, nil); err != nil {
+		return nil, err
+	}
+	pw.meta.Schema = append(pw.meta.Schema, &SchemaElement{
+		Name:          "schema",
+		NumChildren:   int32(len(columns)),
+		ConvertedType: ConvertedNone,
+	})
+	for i, e := range columns {
+		if e.NumChildren > 0 || e.Repetition == Repeated {
+			return nil, moerr.NewNYINoCtx("nested parquet column '%s'", e.Name)
+		}
+		pw.meta.Schema = append(pw.meta.Schema, e)
+		c := &Column{
+			SchemaElement: e,
+			Path:          []string{e.Name},
+			Index:         i,
+		}
+		if e.Repetition == Optional {
+			c.MaxDefinitionLevel = 1
+		}
+		pw.columns = append(pw.columns, c)
+	}
+	return pw, pw.write([]byte(magic))
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.w.Write(b)
+	w.off += int64(n)
+	return err
+}
+
+// WriteRowGroup writes a row group, cols holds the values of every column.
+func (w *Writer) WriteRowGroup(cols []*Values) error {
+	if len(cols) != len(w.columns) {
+		return moerr.NewInvalidInputNoCtx("parquet row group has %d columns, expected %d", len(cols), len(w.columns))
+	}
+	rg := &RowGroup{}
+	for i, vs := range cols {
+		rows := vs.Len()
+		if vs.Nulls != nil {
+			rows = len(vs.Nulls)
+		}
+		if i == 0 {
+			rg.NumRows = int64(rows)
+		} else if int64(rows) != rg.NumRows {
+			return moerr.NewInvalidInputNoCtx("parquet columns have different number of rows")
+		}
+		if vs.Type != w.columns[i].Type {
+			return moerr.NewInvalidInputNoCtx("parquet column '%s' is %s, but the values are %s", w.columns[i].Name(), w.columns[i].Type, vs.Type)
+		}
+		chunk, err := w.writeColumnChunk(w.columns[i], vs, rows)
+		if err != nil {
+			return err
+		}
+		rg.Columns = append(rg.Columns, chunk)
+		rg.TotalByteSize += chunk.Meta.TotalUncompressedSize
+	}
+	w.meta.RowGroups = append(w.meta.RowGroups, rg)
+	w.meta.NumRows += rg.NumRows
+	return nil
+}
+
+func (w *Writer) writeColumnChunk(c *Column, vs *Values, rows int) (*ColumnChunk, error) {
+	var defs []int32
+	sels := make([]int, 0, rows)
+	for i := 0; i < rows; i++ {
+		if !vs.IsNull(i) {
+			sels = append(sels, i)
+		} else if c.Repetition != Optional {
+			return nil, moerr.NewInvalidInputNoCtx("null value in required parquet column '%s'", c.Name())
+		}
+	}
+	if c.Repetition == Optional {
+		defs = make([]int32, rows)
+		for _, i := range sels {
+			defs[i] = 1
+		}
+	}
+	meta := &ColumnMetaData{
+		Type:         c.Type,
+		PathInSchema: c.Path,
+		Codec:        w.codec,
+		NumValues:    int64(rows),
+		Statistics:   statistics(c, vs, sels, rows),
+	}
+	start := w.off
+
+	var values []byte
+	enc := EncodingPlain
+	if w.dictionary && c.Type != Boolean {
+		dict := &Values{Type: c.Type}
+		keys := make(map[string]int32)
+		idx := make([]int32, len(sels))
+		for j, i := range sels {
+			key := string(encodePlain(nil, vs, i))
+			id, ok := keys[key]
+			if !ok {
+				id = int32(dict.Len())
+				keys[key] = id
+				appendValue(dict, vs, i)
+			}
+			idx[j] = id
+		}
+		var body []byte
+		for i := 0; i < dict.Len(); i++ {
+			body = encodePlain(body, dict, i)
+		}
+		meta.DictionaryPageOffset = start
+		n, err := w.writePage(&PageHeader{
+			Type: DictionaryPage,
+			DictionaryPage: &DictionaryPageHeader{
+				NumValues: int32(dict.Len()),
+				Encoding:  EncodingPlain,
+			},
+		}, body, nil)
+		if err != nil {
+			return nil, err
+		}
+		meta.TotalUncompressedSize += n
+		bitWidth := 0
+		if dict.Len() > 1 {
+			bitWidth = bits.Len(uint(dict.Len() - 1))
+		}
+		values = encodeHybrid([]byte{byte(bitWidth)}, bitWidth, idx)
+		enc = EncodingRLEDictionary
+		meta.Encodings = append(meta.Encodings, EncodingPlain)
+	} else if c.Type == Boolean {
+		values = make([]byte, (len(sels)+7)/8)
+		for j, i := range sels {
+			if vs.Booleans[i] {
+				values[j>>3] |= 1 << (j & 7)
+			}
+		}
+	} else {
+		for _, i := range sels {
+			values = encodePlain(values, vs, i)
+		}
+	}
+	meta.Encodings = append(meta.Encodings, EncodingRLE, enc)
+
+	meta.DataPageOffset = w.off
+	var levels []byte
+	if defs != nil {
+		levels = encodeHybrid(nil, 1, defs)
+	}
+	var n int64
+	var err error
+	if w.dataPageV2 {
+		n, err = w.writePage(&PageHeader{
+			Type: DataPageV2,
+			DataPageV2: &DataPageHeaderV2{
+				NumValues:                  int32(rows),
+				NumNulls:                   int32(rows - len(sels)),
+				NumRows:                    int32(rows),
+				Encoding:                   enc,
+				DefinitionLevelsByteLength: int32(len(levels)),
+				IsCompressed:               true,
+			},
+		}, values, levels)
+	} else {
+		var body []byte
+		if defs != nil {
+			body = binary.LittleEndian.AppendUint32(body, uint32(len(levels)))
+			body = append(body, levels...)
+		}
+		n, err = w.writePage(&PageHeader{
+			Type: DataPage,
+			DataPage: &DataPageHeader{
+				NumValues:               int32(rows),
+				Encoding:                enc,
+				DefinitionLevelEncoding: EncodingRLE,
+				RepetitionLevelEncoding: EncodingRLE,
+			},
+		}, append(body, values...), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	meta.TotalUncompressedSize += n
+	meta.TotalCompressedSize = w.off - start
+	return &ColumnChunk{
+		FileOffset: start,
+		Meta:       meta,
+	}, nil
+}
+
+// writePage compresses the body and writes the page, the levels of the data
+// page v2 are not compressed. It returns the uncompressed size of the page.
+func (w *Writer) writePage(h *PageHeader, body []byte, levels []byte) (int64, error) {
+	compressed, err := compress(w.codec, body)
+	if err != nil {
+		return 0, err
+	}
+	h.UncompressedPageSize = int32(len(levels) + len(body))
+	h.CompressedPageSize = int32(len(levels) + len(compressed))
+	hw := &compactWriter{}
+	h.write(hw)
+	for _, b := range [][]byte{hw.buf, levels, compressed} {
+		if err = w.write(b); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(hw.buf)) + int64(h.UncompressedPageSize), nil
+}
+
+// Close writes the footer of the file, the underlying writer is not closed.
+func (w *Writer) Close() error {
+	mw := &compactWriter{}
+	w.meta.write(mw)
+	buf := binary.LittleEndian.AppendUint32(mw.buf, uint32(len(mw.buf)))
+	return w.write(append(buf, magic...))
+}
+
+func appendValue(dst *Values, src *Values, i int) {
+	switch src.Type {
+	case Boolean:
+		dst.Booleans = append(dst.Booleans, src.Booleans[i])
+	case Int32:
+		dst.Int32s = append(dst.Int32s, src.Int32s[i])
+	case Int64:
+		dst.Int64s = append(dst.Int64s, src.Int64s[i])
+	case Int96:
+		dst.Int96s = append(dst.Int96s, src.Int96s[i])
+	case Float:
+		dst.Floats = append(dst.Floats, src.Floats[i])
+	case Double:
+		dst.Doubles = append(dst.Doubles, src.Doubles[i])
+	default:
+		dst.Bytes = append(dst.Bytes, src.Bytes[i])
+	}
+}
+
+// statistics computes the min and max values in the order of the column, the
+// INT96 and the decimals of byte arrays have the null count only.
+func statistics(c *Column, vs *Values, sels []int, rows int) *Statistics {
+	s := &Statistics{
+		NullCount:    int64(rows - len(sels)),
+		HasNullCount: true,
+	}
+	signed := c.SortOrderSigned()
+	less := func(i, j int) bool {
+		switch c.Type {
+		case Boolean:
+			return !vs.Booleans[i] && vs.Booleans[j]
+		case Int32:
+			if signed {
+				return vs.Int32s[i] < vs.Int32s[j]
+			}
+			return uint32(vs.Int32s[i]) < uint32(vs.Int32s[j])
+		case Int64:
+			if signed {
+				return vs.Int64s[i] < vs.Int64s[j]
+			}
+			return uint64(vs.Int64s[i]) < uint64(vs.Int64s[j])
+		case Float:
+			return vs.Floats[i] < vs.Floats[j]
+		case Double:
+			return vs.Doubles[i] < vs.Doubles[j]
+		default:
+			return bytes.Compare(vs.Bytes[i], vs.Bytes[j]) < 0
+		}
+	}
+	if c.Type == Int96 || ((c.Type == ByteArray || c.Type == FixedLenByteArray) && signed) {
+		return s
+	}
+	min, max := -1, -1
+	for _, i := range sels {
+		if (c.Type == Float && math.IsNaN(float64(vs.Floats[i]))) ||
+			(c.Type == Double && math.IsNaN(vs.Doubles[i])) {
+			continue
+		}
+		if min < 0 || less(i, min) {
+			min = i
+		}
+		if max < 0 || less(max, i) {
+			max = i
+		}
+	}
+	if min >= 0 {
+		s.MinValue, s.MaxValue = statValue(vs, min), statValue(vs, max)
+	}
+	return s
+}
+
+// statValue returns the PLAIN encoded value without the length prefix.
+func statValue(vs *Values, i int) []byte {
+	if vs.Type == ByteArray || vs.Type == FixedLenByteArray {
+		return append([]byte{}, vs.Bytes[i]...)
+	}
+	return encodePlain(nil, vs, i)
+}
+
+// writeBits is the reverse of readBits, dst should be zeroed.
+func writeBits(dst []byte, pos int, width int, v uint64) {
+	for written := 0; written < width; {
+		take := 8 - pos&7
+		if take > width-written {
+			take = width - written
+		}
+		dst[pos>>3] |= byte(v>>written&(1<<take-1)) << (pos & 7)
+		written += take
+		pos += take
+	}
+}
+
+// encodeHybrid encodes the values by the bit-packed runs of the RLE/bit-packing
+// hybrid encoding.
+func encodeHybrid(buf []byte, bitWidth int, vs []int32) []byte {
+	groups := (len(vs) + 7) / 8
+	if groups == 0 {
+		return buf
+	}
+	buf = binary.AppendUvarint(buf, uint64(groups)<<1|1)
+	packed := make([]byte, groups*bitWidth)
+	for i, v := range vs {
+		writeBits(packed, i*bitWidth, bitWidth, uint64(uint32(v)))
+	}
+	return append(buf, packed...)
+}
+
+// encodePlain appends the i-th value of vs to buf.
+func encodePlain(buf []byte, vs *Values, i int) []byte {
+	switch vs.Type {
+	case Boolean:
+		if vs.Booleans[i] {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case Int32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(vs.Int32s[i]))
+	case Int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(vs.Int64s[i]))
+	case Int96:
+		return append(buf, vs.Int96s[i][:]...)
+	case Float:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(vs.Floats[i]))
+	case Double:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(vs.Doubles[i]))
+	case ByteArray:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(vs.Bytes[i])))
+		return append(buf, vs.Bytes[i]...)
+	default:
+		return append(buf, vs.Bytes[i]...)
+	}
+}
+
+// compress is the reverse of decompress.
+func compress(codec CompressionCodec, src []byte) ([]byte, error) {
+	switch codec {
+	case Uncompressed:
+		return src, nil
+	case Snappy:
+		return snappy.Encode(nil, src), nil
+	case Gzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(src); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case LZ4:
+		// a single frame of the hadoop framing
+		dst := make([]byte, 8+lz4.CompressBlockBound(len(src)))
+		n, err := lz4.CompressBlock(src, dst[8:], nil)
+		if err != nil {
+			return nil, err
+		}
+		binary.BigEndian.PutUint32(dst, uint32(len(src)))
+		binary.BigEndian.PutUint32(dst[4:], uint32(n))
+		return dst[:8+n], nil
+	case Zstd:
+		return zstd.Compress(nil, src)
+	}
+	return nil, moerr.NewNYINoCtx("parquet compression codec %s", codec)
+}
+
+var update = flag.Bool("update", false, "update the parquet files of testdata")
+
+// TestWriteScanTestdata writes ../testdata/scan.parquet, which is read by the
+// tests of the external scan, run it with -update after changing the file.
+func TestWriteScanTestdata(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []*SchemaElement{
+		{Name: "a", Type: Int32, Repetition: Required, ConvertedType: ConvertedNone},
+		{Name: "B", Type: ByteArray, Repetition: Optional, ConvertedType: ConvertedUTF8},
+		{Name: "c", Type: Int64, Repetition: Required, ConvertedType: ConvertedNone,
+			Logical: &LogicalType{Kind: LogicalTimestamp, Unit: Millis, IsAdjustedToUTC: true}},
+		{Name: "d", Type: Int64, Repetition: Required, ConvertedType: ConvertedDecimal, Precision: 10, Scale: 2},
+	}, WithCompression(Snappy), WithDictionary())
+	require.NoError(t, err)
+	for rg := 0; rg < 2; rg++ {
+		a := &Values{Type: Int32}
+		b := &Values{Type: ByteArray}
+		c := &Values{Type: Int64}
+		d := &Values{Type: Int64}
+		for i := rg * 10; i < rg*10+10; i++ {
+			a.Int32s = append(a.Int32s, int32(i))
+			b.Bytes = append(b.Bytes, []byte(fmt.Sprintf("s%d", i)))
+			b.Nulls = append(b.Nulls, i%3 == 0)
+			c.Int64s = append(c.Int64s, int64(i)*1000)
+			d.Int64s = append(d.Int64s, int64(i)*100+1)
+		}
+		require.NoError(t, w.WriteRowGroup([]*Values{a, b, c, d}))
+	}
+	require.NoError(t, w.Close())
+
+	path := filepath.Join("..", "testdata", "scan.parquet")
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, buf.Bytes(), data, "run the test with -update")
+}
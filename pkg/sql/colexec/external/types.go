@@ -21,8 +21,10 @@ import (
 	"io"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/parquet"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
@@ -65,6 +67,7 @@ type ExParam struct {
 	prevStr   string
 	reader    io.ReadCloser
 	plh       *ParseLineHandler
+	pqh       *ParquetHandler
 	Fileparam *ExFileparam
 	Zoneparam *ZonemapFileparam
 	Filter    *FilterParam
@@ -111,6 +114,24 @@ type ParseLineHandler struct {
 	moCsvLineArray [][]string
 }
 
+// ParquetHandler keeps the state of reading a parquet file, only the column
+// chunks of the needed columns are read.
+type ParquetHandler struct {
+	file *parquet.File
+	// columns[i] is the parquet column of param.Attrs[i], it is nil for the
+	// hidden columns
+	columns []*parquet.Column
+	// types[i] is the type which the values of columns[i] are read as
+	types []types.Type
+	// casts[i] casts the values to the type of the table column if needed
+	casts    []*plan.Expr
+	readers  []*parquet.ColumnChunkReader
+	values   []parquet.Values
+	rowGroup int
+	// rows is the number of rows left in the current row group
+	rows int64
+}
+
 type LineOut struct {
 	Lines [][]string
 	Line  []string
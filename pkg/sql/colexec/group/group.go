@@ -339,9 +339,38 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	if err != nil {
 		return false, err
 	}
+	if !ap.NeedEval {
+		ctr.flush(proc, anal, isLast)
+	}
 	return false, err
 }
 
+// flush outputs the groups as a partial result and resets the state if the
+// query is over its memory budget, the partial results are merged by the
+// merge group.
+func (ctr *container) flush(proc *process.Process, anal process.Analyze, isLast bool) {
+	if ctr.spiller == nil {
+		ctr.spiller = colexec.NewSpiller(proc, anal)
+	}
+	size := int64(ctr.bat.Size())
+	if ctr.intHashMap != nil {
+		size += ctr.intHashMap.Size()
+	}
+	if ctr.strHashMap != nil {
+		size += ctr.strHashMap.Size()
+	}
+	if !ctr.spiller.Grow(size - ctr.size) {
+		ctr.size = size
+		return
+	}
+	anal.Output(ctr.bat, isLast)
+	proc.SetInputBatch(ctr.bat)
+	ctr.bat = nil
+	ctr.size = 0
+	ctr.cleanHashMap()
+	ctr.spiller.Release()
+}
+
 func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
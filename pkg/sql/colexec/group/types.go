@@ -67,6 +67,11 @@ type container struct {
 	bat *batch.Batch
 
 	alreadyGetAgg bool
+
+	// spiller and size record the memory of the groups in the budget of the
+	// query, the partial groups are output early when it is over the budget.
+	spiller *colexec.Spiller
+	size    int64
 }
 
 type Argument struct {
@@ -89,6 +94,9 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanAggVectors()
 		ctr.cleanGroupVectors()
 		ctr.cleanMultiAggVecs()
+		if ctr.spiller != nil {
+			ctr.spiller.Release()
+		}
 	}
 }
 
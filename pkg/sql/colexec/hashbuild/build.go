@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -47,15 +48,19 @@ func Prepare(proc *process.Process, arg any) (err error) {
 			}
 		}
 	}
-	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
-	ap.ctr.bat.Zs = proc.Mp().GetSels()
-	for i, typ := range ap.Typs {
-		ap.ctr.bat.Vecs[i] = vector.NewVec(typ)
-	}
-
+	ap.ctr.bat = newEmptyBatch(ap.Typs, proc)
 	return nil
 }
 
+func newEmptyBatch(typs []types.Type, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(len(typs))
+	bat.Zs = proc.Mp().GetSels()
+	for i, typ := range typs {
+		bat.Vecs[i] = vector.NewVec(typ)
+	}
+	return bat
+}
+
 func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool, error) {
 	anal := proc.GetAnalyze(idx)
 	anal.Start()
@@ -73,6 +78,15 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 			}
 			ctr.state = End
 		default:
+			if ctr.parts != nil {
+				// the join builds the hash maps of the partitions itself
+				ctr.bat.Ht = hashmap.NewSpilledJoinMap(ctr.parts)
+				proc.SetInputBatch(ctr.bat)
+				ctr.bat = nil
+				ctr.parts = nil
+				ctr.cleanHashMap()
+				return true, nil
+			}
 			if ctr.bat != nil {
 				if ap.NeedHashMap {
 					ctr.bat.Ht = hashmap.NewJoinMap(ctr.sels, nil, ctr.mp, ctr.hasNull)
@@ -92,6 +106,10 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 func (ctr *container) build(ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool) error {
 	var err error
 
+	if ap.CanSpill && ap.NeedHashMap {
+		ctr.spiller = colexec.NewSpiller(proc, anal)
+	}
+
 	for {
 		bat, _, err := ctr.ReceiveFromSingleReg(0, anal)
 		if err != nil {
@@ -110,8 +128,22 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 		if ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat); err != nil {
 			return err
 		}
+		if ctr.spiller != nil && ctr.spiller.Grow(int64(bat.Size())) {
+			if err = ctr.spill(ap, proc); err != nil {
+				bat.Clean(proc.Mp())
+				return err
+			}
+		}
 		bat.Clean(proc.Mp())
 	}
+	if ctr.parts != nil {
+		if ctr.bat.Length() > 0 {
+			if err = ctr.spill(ap, proc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if ctr.bat == nil || ctr.bat.Length() == 0 || !ap.NeedHashMap {
 		return nil
 	}
@@ -151,6 +183,32 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	return nil
 }
 
+// spill writes the rows built so far into the partitions by the hash of the
+// join keys, the keys are appended to the spilled batches as the last columns.
+func (ctr *container) spill(ap *Argument, proc *process.Process) error {
+	if err := ctr.evalJoinCondition(ctr.bat, proc); err != nil {
+		return err
+	}
+	bat := batch.NewWithSize(len(ctr.bat.Vecs) + len(ctr.vecs))
+	copy(bat.Vecs, ctr.bat.Vecs)
+	copy(bat.Vecs[len(ctr.bat.Vecs):], ctr.vecs)
+	bat.Zs = ctr.bat.Zs
+	bats, err := ctr.spiller.Partition(bat, ctr.vecs, colexec.SpillPartitions)
+	if err != nil {
+		return err
+	}
+	if ctr.parts == nil {
+		ctr.parts = new(colexec.SpilledPartitions)
+	}
+	if err = ctr.spiller.WritePartitions(bats, ctr.parts); err != nil {
+		return err
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.bat = newEmptyBatch(ap.Typs, proc)
+	ctr.spiller.Release()
+	return nil
+}
+
 func (ctr *container) evalJoinCondition(bat *batch.Batch, proc *process.Process) error {
 	for i := range ctr.evecs {
 		vec, err := ctr.evecs[i].executor.Eval(proc, []*batch.Batch{bat})
@@ -50,6 +50,9 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.StrHashMap
+
+	spiller *colexec.Spiller
+	parts   *colexec.SpilledPartitions
 }
 
 type Argument struct {
@@ -61,6 +64,9 @@ type Argument struct {
 	Nbucket     uint64
 	Typs        []types.Type
 	Conditions  []*plan.Expr
+	// CanSpill is true if the build side can be spilled to disk when the
+	// query is over its memory budget, only the inner join supports it.
+	CanSpill bool
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -72,6 +78,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		if !arg.NeedHashMap {
 			ctr.cleanHashMap()
 		}
+		ctr.cleanSpiller()
 		ctr.FreeAllReg()
 	}
 }
@@ -97,3 +104,15 @@ func (ctr *container) cleanHashMap() {
 		ctr.mp = nil
 	}
 }
+
+func (ctr *container) cleanSpiller() {
+	if ctr.parts != nil {
+		// the partitions are not handed over to the join
+		ctr.parts.Free()
+		ctr.parts = nil
+	}
+	if ctr.spiller != nil {
+		ctr.spiller.Release()
+		ctr.spiller = nil
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -71,6 +72,8 @@ const (
 	INDEX_TYPE_PRIMARY  = "PRIMARY"
 	INDEX_TYPE_UNIQUE   = "UNIQUE"
 	INDEX_TYPE_MULTIPLE = "MULTIPLE"
+	INDEX_TYPE_FULLTEXT = "FULLTEXT"
+	INDEX_TYPE_IVFFLAT  = "IVFFLAT"
 )
 
 // InsertIndexMetadata :Synchronize the index metadata information of the table to the index metadata table
@@ -254,6 +257,10 @@ func buildInsertIndexMetaBatch(tableId uint64, databaseId uint64, ct *engine.Con
 					}
 					if index.Unique {
 						err = vector.AppendBytes(vec_type, []byte(INDEX_TYPE_UNIQUE), false, proc.Mp())
+					} else if util.IsFullTextIndex(index) {
+						err = vector.AppendBytes(vec_type, []byte(INDEX_TYPE_FULLTEXT), false, proc.Mp())
+					} else if util.IsIvfFlatIndex(index) {
+						err = vector.AppendBytes(vec_type, []byte(INDEX_TYPE_IVFFLAT), false, proc.Mp())
 					} else {
 						err = vector.AppendBytes(vec_type, []byte(INDEX_TYPE_MULTIPLE), false, proc.Mp())
 					}
@@ -280,7 +287,11 @@ func buildInsertIndexMetaBatch(tableId uint64, databaseId uint64, ct *engine.Con
 					if err != nil {
 						return nil, err
 					}
-					err = vector.AppendBytes(vec_options, []byte(""), true, proc.Mp())
+					if index.IndexAlgoParams != "" {
+						err = vector.AppendBytes(vec_options, []byte(index.IndexAlgoParams), false, proc.Mp())
+					} else {
+						err = vector.AppendBytes(vec_options, []byte(""), true, proc.Mp())
+					}
 					if err != nil {
 						return nil, err
 					}
@@ -0,0 +1,128 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexdelete
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ any, buf *bytes.Buffer) {
+	buf.WriteString("delete index rows")
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.rows = make(map[string]struct{})
+	ap.ctr.idxRows = make([]map[types.Rowid]struct{}, len(ap.IdxIdx))
+	for i := range ap.ctr.idxRows {
+		ap.ctr.idxRows[i] = make(map[types.Rowid]struct{})
+	}
+	return nil
+}
+
+// Call deletes the rows of the index tables keyed by the primary key. They
+// are joined to the rows changed by the DML operator behind by the primary
+// key, so a changed row comes once for every row of its index tables, e.g.
+// once for every word of a fulltext index. Every changed row is passed once,
+// with the rows of its index tables deleted.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		bat.Clean(proc.Mp())
+		return false, nil
+	}
+	ap := arg.(*Argument)
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	anal.Input(bat, isFirst)
+
+	for i, pos := range ap.IdxIdx {
+		if err := deleteIndexRows(proc, ap, i, bat.Vecs[pos]); err != nil {
+			bat.Clean(proc.Mp())
+			return false, err
+		}
+	}
+
+	sels := proc.Mp().GetSels()
+	defer proc.Mp().PutSels(sels)
+	var key []byte
+	for row := 0; row < bat.Length(); row++ {
+		key = key[:0]
+		for _, pos := range ap.RowIdIdx {
+			vec := bat.Vecs[pos]
+			if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+				key = append(key, 0)
+				continue
+			}
+			rowid := vector.GetFixedAt[types.Rowid](vec, row)
+			key = append(key, 1)
+			key = append(key, rowid[:]...)
+		}
+		if _, ok := ap.ctr.rows[string(key)]; ok {
+			continue
+		}
+		ap.ctr.rows[string(key)] = struct{}{}
+		sels = append(sels, int64(row))
+	}
+	if len(sels) < bat.Length() {
+		bat.Shrink(sels)
+	}
+
+	anal.Output(bat, isLast)
+	proc.SetInputBatch(bat)
+	return false, nil
+}
+
+// deleteIndexRows deletes the rows of the i-th index table which are not
+// deleted yet, a row of the index table may be joined to several changed
+// rows.
+func deleteIndexRows(proc *process.Process, ap *Argument, i int, vec *vector.Vector) error {
+	deleted := ap.ctr.idxRows[i]
+	rowIdVec := vector.NewVec(types.T_Rowid.ToType())
+	for row := 0; row < vec.Length(); row++ {
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+			continue
+		}
+		rowid := vector.GetFixedAt[types.Rowid](vec, row)
+		if _, ok := deleted[rowid]; ok {
+			continue
+		}
+		deleted[rowid] = struct{}{}
+		if err := vector.AppendFixed(rowIdVec, rowid, false, proc.Mp()); err != nil {
+			rowIdVec.Free(proc.Mp())
+			return err
+		}
+	}
+	delBatch := batch.NewWithSize(1)
+	delBatch.SetAttributes([]string{catalog.Row_ID})
+	delBatch.SetVector(0, rowIdVec)
+	delBatch.SetZs(rowIdVec.Length(), proc.Mp())
+	defer delBatch.Clean(proc.Mp())
+	if delBatch.Length() == 0 {
+		return nil
+	}
+	return ap.IdxSource[i].Delete(proc.Ctx, delBatch, catalog.Row_ID)
+}
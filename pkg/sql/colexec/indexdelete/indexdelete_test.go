@@ -0,0 +1,103 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexdelete
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func rowid(n byte) types.Rowid {
+	var id types.Rowid
+	id[0] = n
+	return id
+}
+
+// newBatch returns the rows of a table joined to the rows of its fulltext
+// index table: the row_id and pk of the table, and the row_id of the index.
+func newBatch(proc *process.Process, rows []byte, pks []int64, idxRows []byte) *batch.Batch {
+	rowIds := make([]types.Rowid, len(rows))
+	idxRowIds := make([]types.Rowid, len(idxRows))
+	for i := range rows {
+		rowIds[i] = rowid(rows[i])
+		idxRowIds[i] = rowid(idxRows[i])
+	}
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewRowidVector(len(rows), types.T_Rowid.ToType(), proc.Mp(), false, rowIds),
+		testutil.NewInt64Vector(len(rows), types.T_int64.ToType(), proc.Mp(), false, pks),
+		testutil.NewRowidVector(len(rows), types.T_Rowid.ToType(), proc.Mp(), false, idxRowIds),
+	}, nil)
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, "delete index rows", buf.String())
+}
+
+func TestIndexDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+
+	var deleted []types.Rowid
+	rel := mock_frontend.NewMockRelation(ctrl)
+	rel.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, bat *batch.Batch, _ string) error {
+			deleted = append(deleted, vector.MustFixedCol[types.Rowid](bat.Vecs[0])...)
+			return nil
+		}).AnyTimes()
+
+	arg := &Argument{
+		RowIdIdx:  []int32{0},
+		IdxIdx:    []int32{2},
+		IdxSource: []engine.Relation{rel},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the row 1 has three words, and comes in both batches
+	var pks []int64
+	for _, bat := range []*batch.Batch{
+		newBatch(proc, []byte{1, 1, 2}, []int64{10, 10, 20}, []byte{11, 12, 21}),
+		newBatch(proc, []byte{1, 3}, []int64{10, 30}, []byte{13, 31}),
+	} {
+		proc.SetInputBatch(bat)
+		end, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		require.False(t, end)
+		pks = append(pks, vector.MustFixedCol[int64](proc.InputBatch().Vecs[1])...)
+		proc.InputBatch().Clean(proc.Mp())
+	}
+	require.Equal(t, []int64{10, 20, 30}, pks)
+	require.Equal(t, []types.Rowid{rowid(11), rowid(12), rowid(21), rowid(13), rowid(31)}, deleted)
+
+	proc.SetInputBatch(nil)
+	end, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+	arg.Free(proc, false)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
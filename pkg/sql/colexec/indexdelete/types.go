@@ -0,0 +1,44 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexdelete
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	// rows are the rows of the changed tables passed, keyed by their row_ids
+	rows map[string]struct{}
+	// idxRows are the rows of every index table deleted
+	idxRows []map[types.Rowid]struct{}
+}
+
+type Argument struct {
+	// RowIdIdx are the row_id columns of the tables changed by the DML
+	// operator, a row is passed once for them.
+	RowIdIdx []int32
+	// IdxIdx are the row_id columns of the index tables keyed by the primary
+	// key, and IdxSource are the index tables.
+	IdxIdx    []int32
+	IdxSource []engine.Relation
+
+	ctr *container
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	arg.ctr = nil
+}
@@ -60,7 +60,9 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			if err := ctr.build(proc, anal); err != nil {
 				return false, err
 			}
-			if ctr.mp == nil {
+			if ctr.spilledMap != nil {
+				ctr.state = Partition
+			} else if ctr.mp == nil {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				ctr.state = End
 			} else {
@@ -89,6 +91,38 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			}
 			return false, nil
 
+		case Partition:
+			bat, _, err := ctr.ReceiveFromSingleReg(0, anal)
+			if err != nil {
+				return false, err
+			}
+			if bat == nil {
+				ctr.state = JoinPartition
+				ctr.part = -1
+				continue
+			}
+			if bat.Length() == 0 {
+				bat.Clean(proc.Mp())
+				continue
+			}
+			if err := ctr.partition(bat, proc); err != nil {
+				return false, err
+			}
+
+		case JoinPartition:
+			bat, err := ctr.nextProbeBatch(ap, proc)
+			if err != nil {
+				return false, err
+			}
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc, anal, isFirst, isLast); err != nil {
+				return false, err
+			}
+			return false, nil
+
 		default:
 			proc.SetInputBatch(nil)
 			return true, nil
@@ -103,8 +137,17 @@ func (ctr *container) build(proc *process.Process, anal process.Analyze) error {
 	}
 
 	if bat != nil {
+		jm := bat.Ht.(*hashmap.JoinMap).Dup()
+		if spilled := jm.Spilled(); spilled != nil {
+			// the batch is empty, the rows are in the partitions
+			bat.Clean(proc.Mp())
+			ctr.spilledMap = jm
+			ctr.buildParts = spilled.(*colexec.SpilledPartitions)
+			ctr.spiller = colexec.NewSpiller(proc, anal)
+			return nil
+		}
 		ctr.bat = bat
-		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
+		ctr.mp = jm
 		anal.Alloc(ctr.mp.Map().Size())
 	}
 	return nil
@@ -112,6 +112,61 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestSpilledJoin(t *testing.T) {
+	join := func(spill bool) int {
+		tc := newTestCase([]bool{false}, []types.Type{types.T_int32.ToType()}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+			[][]*plan.Expr{
+				{
+					newExpr(0, types.T_int32.ToType()),
+				},
+				{
+					newExpr(0, types.T_int32.ToType()),
+				},
+			})
+		if spill {
+			tc.proc.Lim.SpillSize = 1
+			tc.barg.CanSpill = true
+		}
+		nb0 := tc.proc.Mp().CurrNB()
+		require.NoError(t, hashbuild.Prepare(tc.proc, tc.barg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		ok, err := hashbuild.Call(0, tc.proc, tc.barg, false, false)
+		require.NoError(t, err)
+		require.True(t, ok)
+		bat := tc.proc.Reg.InputBatch
+		jm := bat.Ht.(*hashmap.JoinMap)
+		require.Equal(t, spill, jm.Spilled() != nil)
+		jm.SetDupCount(int64(1))
+
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- bat
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows := 0
+		for {
+			if ok, err := Call(0, tc.proc, tc.arg, false, false); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			rows += tc.proc.Reg.InputBatch.Length()
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+		}
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, nb0, tc.proc.Mp().CurrNB())
+		return rows
+	}
+	rows := join(false)
+	require.Less(t, 0, rows)
+	require.Equal(t, rows, join(true))
+}
+
 /*
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
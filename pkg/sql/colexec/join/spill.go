@@ -0,0 +1,182 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// partition splits the probe batch by the hash of the join keys the same way
+// as the build side, the partitions are buffered in memory and written to
+// disk when the query is over its memory budget.
+func (ctr *container) partition(bat *batch.Batch, proc *process.Process) error {
+	defer proc.PutBatch(bat)
+	if err := ctr.evalJoinCondition(bat, proc); err != nil {
+		return err
+	}
+	bats, err := ctr.spiller.Partition(bat, ctr.vecs, colexec.SpillPartitions)
+	if err != nil {
+		return err
+	}
+	if ctr.probeBufs == nil {
+		ctr.probeBufs = make([]*batch.Batch, colexec.SpillPartitions)
+	}
+	size := int64(0)
+	for i, pbat := range bats {
+		if pbat == nil {
+			continue
+		}
+		size += int64(pbat.Size())
+		buf, err := ctr.probeBufs[i].Append(proc.Ctx, proc.Mp(), pbat)
+		if buf != pbat {
+			pbat.Clean(proc.Mp())
+		}
+		bats[i] = nil
+		if err != nil {
+			return err
+		}
+		ctr.probeBufs[i] = buf
+	}
+	if !ctr.spiller.Grow(size) {
+		return nil
+	}
+	if ctr.probeParts == nil {
+		ctr.probeParts = new(colexec.SpilledPartitions)
+	}
+	bufs := ctr.probeBufs
+	ctr.probeBufs = nil
+	if err = ctr.spiller.WritePartitions(bufs, ctr.probeParts); err != nil {
+		return err
+	}
+	ctr.spiller.Release()
+	return nil
+}
+
+// nextProbeBatch returns the next probe batch of the current partition, and
+// moves to the next partition with the build side loaded into the hash map
+// when the current one is done. It returns nil if all partitions are done.
+func (ctr *container) nextProbeBatch(ap *Argument, proc *process.Process) (*batch.Batch, error) {
+	for {
+		if ctr.part >= 0 {
+			bat, err := ctr.readProbeBatch(proc)
+			if bat != nil || err != nil {
+				return bat, err
+			}
+		}
+		ctr.cleanPartition(proc)
+		if ctr.part++; ctr.part >= colexec.SpillPartitions {
+			return nil, nil
+		}
+		ctr.file, ctr.idx = 0, 0
+		if err := ctr.buildPartition(ap, proc); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (ctr *container) readProbeBatch(proc *process.Process) (*batch.Batch, error) {
+	if ctr.bat == nil {
+		// the inner join of an empty build side is empty
+		return nil, nil
+	}
+	if ctr.probeParts != nil {
+		files := ctr.probeParts.Files[ctr.part]
+		for ctr.file < len(files) {
+			if ctr.idx < files[ctr.file].Len() {
+				ctr.idx++
+				return files[ctr.file].Read(proc, ctr.idx-1)
+			}
+			ctr.file++
+			ctr.idx = 0
+		}
+	}
+	if ctr.probeBufs != nil && ctr.probeBufs[ctr.part] != nil {
+		bat := ctr.probeBufs[ctr.part]
+		ctr.probeBufs[ctr.part] = nil
+		return bat, nil
+	}
+	return nil, nil
+}
+
+// buildPartition loads the build side of the current partition and builds
+// its hash map, the join keys are the last columns of the spilled batches.
+func (ctr *container) buildPartition(ap *Argument, proc *process.Process) (err error) {
+	for _, f := range ctr.buildParts.Files[ctr.part] {
+		for i := 0; i < f.Len(); i++ {
+			bat, err := f.Read(proc, i)
+			if err != nil {
+				return err
+			}
+			buf, err := ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+			if buf != bat {
+				bat.Clean(proc.Mp())
+			}
+			if err != nil {
+				return err
+			}
+			ctr.bat = buf
+		}
+	}
+	if ctr.bat == nil {
+		return nil
+	}
+	// the partition is joined in memory even if it is over the budget
+	ctr.spiller.Grow(int64(ctr.bat.Size()))
+
+	mp, err := hashmap.NewStrMap(false, 0, 0, proc.Mp())
+	if err != nil {
+		return err
+	}
+	keys := ctr.bat.Vecs[len(ctr.bat.Vecs)-len(ap.Conditions[1]):]
+	var sels [][]int32
+	itr := mp.NewIterator()
+	count := ctr.bat.Length()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := mp.GroupCount()
+		vals, zvals, err := itr.Insert(i, n, keys)
+		if err != nil {
+			mp.Free()
+			return err
+		}
+		for k, v := range vals[:n] {
+			if zvals[k] == 0 || v == 0 {
+				continue
+			}
+			if v > rows {
+				sels = append(sels, make([]int32, 0))
+			}
+			ai := int64(v) - 1
+			sels[ai] = append(sels[ai], int32(i+k))
+		}
+	}
+	ctr.mp = hashmap.NewJoinMap(sels, nil, mp, false)
+	return nil
+}
+
+func (ctr *container) cleanPartition(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = nil
+	}
+	ctr.cleanHashMap()
+	ctr.spiller.Release()
+}
@@ -28,6 +28,11 @@ import (
 const (
 	Build = iota
 	Probe
+	// Partition and JoinPartition are the states of the join with the build
+	// side spilled to disk, the probe side is partitioned by the join keys
+	// first, then each partition is joined in memory.
+	Partition
+	JoinPartition
 	End
 )
 
@@ -57,6 +62,15 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// the spilled build side and the partitions of the probe side
+	spilledMap *hashmap.JoinMap
+	buildParts *colexec.SpilledPartitions
+	probeParts *colexec.SpilledPartitions
+	probeBufs  []*batch.Batch
+	spiller    *colexec.Spiller
+	// position of the next probe batch to join in the spilled mode
+	part, file, idx int
 }
 
 type Argument struct {
@@ -76,6 +90,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanBatch(mp)
 		ctr.cleanEvalVectors()
 		ctr.cleanHashMap()
+		ctr.cleanSpilled(mp)
 		ctr.cleanExprExecutor()
 		ctr.FreeAllReg()
 	}
@@ -110,6 +125,23 @@ func (ctr *container) cleanHashMap() {
 	}
 }
 
+func (ctr *container) cleanSpilled(mp *mpool.MPool) {
+	for i, bat := range ctr.probeBufs {
+		if bat != nil {
+			bat.Clean(mp)
+			ctr.probeBufs[i] = nil
+		}
+	}
+	if ctr.spiller != nil {
+		ctr.spiller.Free()
+		ctr.spiller = nil
+	}
+	if ctr.spilledMap != nil {
+		ctr.spilledMap.Free()
+		ctr.spilledMap = nil
+	}
+}
+
 func (ctr *container) cleanEvalVectors() {
 	for i := range ctr.evecs {
 		ctr.evecs[i].executor.Free()
@@ -61,11 +61,11 @@ func GetFetchRowsFunc(t types.Type) FetchLockRowsFunc {
 		return fetchInt64Rows
 	case types.T_uint8:
 		return fetchUint8Rows
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return fetchUint16Rows
 	case types.T_uint32:
 		return fetchUint32Rows
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return fetchUint64Rows
 	case types.T_float32:
 		return fetchFloat32Rows
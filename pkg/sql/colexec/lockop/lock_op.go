@@ -18,12 +18,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -39,6 +42,9 @@ func String(v any, buf *bytes.Buffer) {
 			target.tableID,
 			target.primaryColumnIndexInBatch,
 			target.refreshTimestampIndexInBatch))
+		if target.mode != lock.LockMode_Exclusive || target.policy != lock.WaitPolicy_Wait {
+			buf.WriteString(fmt.Sprintf("-%s-%s", target.mode, target.policy))
+		}
 		if idx < n {
 			buf.WriteString(",")
 		}
@@ -62,6 +68,9 @@ func Prepare(proc *process.Process, v any) error {
 // concurrently modified by other transactions, a Timestamp column will be put on the output
 // vectors for querying the latest data, and subsequent op needs to check this column to check
 // whether the latest data needs to be read.
+//
+// The rows locked by other transactions are removed from the output if the wait policy of
+// the target is SkipLocked.
 func Call(
 	idx int,
 	proc *process.Process,
@@ -82,6 +91,9 @@ func Call(
 		getLogger().Fatal("invalid argument",
 			zap.Any("argument", arg))
 	}
+	if arg.limit > 0 {
+		return callWithLimit(proc, arg, bat)
+	}
 	txnFeature := proc.TxnClient.(client.TxnClientWithFeature)
 	txnOp := proc.TxnOperator
 	needRetry := false
@@ -96,7 +108,7 @@ func Call(
 		if target.filter != nil {
 			filterCols = vector.MustFixedCol[int](bat.GetVector(target.filterColIndexInBatch))
 		}
-		refreshTS, err := Lock(
+		refreshTS, skipped, err := doLock(
 			proc.Ctx,
 			target.tableID,
 			txnOp,
@@ -104,7 +116,8 @@ func Call(
 			priVec,
 			target.primaryColumnType,
 			DefaultLockOptions(arg.parker).
-				WithLockMode(lock.LockMode_Exclusive).
+				WithLockMode(target.mode).
+				WithWaitPolicy(target.policy).
 				WithFetchLockRowsFunc(target.fetcher).
 				WithMaxBytesPerLock(int(proc.LockService.GetConfig().MaxLockRowBytes)).
 				WithFilterRows(target.filter, filterCols),
@@ -120,6 +133,25 @@ func Call(
 			return false, err
 		}
 
+		if len(skipped) > 0 {
+			sels := make([]int64, len(skipped))
+			for i, row := range skipped {
+				sels[i] = int64(row)
+			}
+			bat.AntiShrink(sels)
+			if bat.Length() == 0 {
+				break
+			}
+		}
+
+		// no refresh timestamp column, e.g. the locking read of select
+		if target.refreshTimestampIndexInBatch < 0 {
+			if !needRetry && !refreshTS.IsEmpty() {
+				needRetry = true
+			}
+			continue
+		}
+
 		if txnFeature.RefreshExpressionEnabled() {
 			vec := bat.GetVector(target.refreshTimestampIndexInBatch)
 			ts := types.BuildTS(refreshTS.PhysicalTime, refreshTS.LogicalTime)
@@ -142,6 +174,75 @@ func Call(
 	return false, nil
 }
 
+// callWithLimit locks the rows in order until arg.limit rows are locked, the rows
+// skipped by SkipLocked are not counted. So the rows after the limit are never
+// locked, e.g. select ... order by ... limit n for update skip locked. The rows
+// are locked window by window, a window has as many rows as the rows still needed.
+func callWithLimit(
+	proc *process.Process,
+	arg *Argument,
+	bat *batch.Batch) (bool, error) {
+	if arg.locked >= arg.limit {
+		proc.SetInputBatch(nil)
+		bat.Clean(proc.Mp())
+		return true, nil
+	}
+
+	target := arg.targets[0]
+	priVec := bat.GetVector(target.primaryColumnIndexInBatch)
+	opts := DefaultLockOptions(arg.parker).
+		WithLockMode(target.mode).
+		WithWaitPolicy(target.policy).
+		WithFetchLockRowsFunc(target.fetcher).
+		WithMaxBytesPerLock(int(proc.LockService.GetConfig().MaxLockRowBytes))
+	needRetry := false
+	sels := make([]int64, 0, bat.Length())
+	start := 0
+	for start < bat.Length() && arg.locked < arg.limit {
+		end := start + int(arg.limit-arg.locked)
+		if end > bat.Length() {
+			end = bat.Length()
+		}
+		vec, err := priVec.Window(start, end)
+		if err != nil {
+			return false, err
+		}
+		refreshTS, skipped, err := doLock(
+			proc.Ctx,
+			target.tableID,
+			proc.TxnOperator,
+			proc.LockService,
+			vec,
+			target.primaryColumnType,
+			opts)
+		vec.Free(proc.Mp())
+		if err != nil {
+			return false, err
+		}
+		if !refreshTS.IsEmpty() {
+			needRetry = true
+		}
+
+		// the skipped rows are in ascending order
+		for row, i := start, 0; row < end; row++ {
+			if i < len(skipped) && int(skipped[i]) == row-start {
+				i++
+				continue
+			}
+			sels = append(sels, int64(row))
+		}
+		arg.locked += uint64(end - start - len(skipped))
+		start = end
+	}
+	if len(sels) < bat.Length() {
+		bat.Shrink(sels)
+	}
+	if needRetry {
+		return true, moerr.NewTxnNeedRetry(proc.Ctx)
+	}
+	return arg.locked >= arg.limit, nil
+}
+
 // Lock locks a set of data so that no other transaction can modify it.
 // The data is described by the primary key. When the returned timestamp.IsEmpty
 // is false, it means there is a conflict with other transactions and the data to
@@ -154,13 +255,31 @@ func Lock(
 	vec *vector.Vector,
 	pkType types.Type,
 	opts LockOptions) (timestamp.Timestamp, error) {
+	ts, _, err := doLock(ctx, tableID, txnOp, lockService, vec, pkType, opts)
+	return ts, err
+}
+
+// doLock is Lock which also returns the rows of vec skipped in SkipLocked wait
+// policy.
+func doLock(
+	ctx context.Context,
+	tableID uint64,
+	txnOp client.TxnOperator,
+	lockService lockservice.LockService,
+	vec *vector.Vector,
+	pkType types.Type,
+	opts LockOptions) (timestamp.Timestamp, []int32, error) {
 	if !txnOp.Txn().IsPessimistic() {
-		return timestamp.Timestamp{}, nil
+		return timestamp.Timestamp{}, nil, nil
 	}
 
 	if opts.maxBytesPerLock == 0 {
 		opts.maxBytesPerLock = int(lockService.GetConfig().MaxLockRowBytes)
 	}
+	// rows can only be skipped one by one, never lock a range
+	if opts.policy == lock.WaitPolicy_SkipLocked {
+		opts.maxBytesPerLock = math.MaxInt
+	}
 	fetchFunc := opts.fetchFunc
 	if fetchFunc == nil {
 		fetchFunc = GetFetchRowsFunc(pkType)
@@ -181,23 +300,23 @@ func Lock(
 		txnOp.Txn().ID,
 		lock.LockOptions{
 			Granularity: g,
-			Policy:      lock.WaitPolicy_Wait,
+			Policy:      opts.policy,
 			Mode:        opts.mode,
 		})
 	if err != nil {
-		return timestamp.Timestamp{}, err
+		return timestamp.Timestamp{}, nil, err
 	}
 
 	// add bind locks
 	if err := txnOp.AddLockTable(result.LockedOn); err != nil {
-		return timestamp.Timestamp{}, err
+		return timestamp.Timestamp{}, nil, err
 	}
 
 	// no conflict or has conflict, but all prev txn all aborted
 	// current txn can read and write normally
 	if !result.HasConflict ||
 		!result.HasPrevCommit {
-		return timestamp.Timestamp{}, nil
+		return timestamp.Timestamp{}, result.Skipped, nil
 	}
 
 	// Arriving here means that at least one of the conflicting
@@ -212,14 +331,14 @@ func Lock(
 	// is modified between [snapshotTS,prev.commits] and raise the SnapshotTS of
 	// the SI transaction to eliminate conflicts)
 	if !txnOp.Txn().IsRCIsolation() {
-		return timestamp.Timestamp{}, moerr.NewTxnWWConflict(ctx)
+		return timestamp.Timestamp{}, nil, moerr.NewTxnWWConflict(ctx)
 	}
 
 	// forward rc's snapshot ts
 	if err := txnOp.UpdateSnapshot(result.Timestamp); err != nil {
-		return timestamp.Timestamp{}, err
+		return timestamp.Timestamp{}, nil, err
 	}
-	return result.Timestamp.Next(), nil
+	return result.Timestamp.Next(), result.Skipped, nil
 }
 
 // DefaultLockOptions create a default lock operation. The parker is used to
@@ -227,6 +346,7 @@ func Lock(
 func DefaultLockOptions(parker *types.Packer) LockOptions {
 	return LockOptions{
 		mode:            lock.LockMode_Exclusive,
+		policy:          lock.WaitPolicy_Wait,
 		lockTable:       false,
 		maxBytesPerLock: 0,
 		parker:          parker,
@@ -239,6 +359,12 @@ func (opts LockOptions) WithLockMode(mode lock.LockMode) LockOptions {
 	return opts
 }
 
+// WithWaitPolicy set wait policy, Wait, FastFail or SkipLocked
+func (opts LockOptions) WithWaitPolicy(policy lock.WaitPolicy) LockOptions {
+	opts.policy = policy
+	return opts
+}
+
 // WithLockTable set lock all table
 func (opts LockOptions) WithLockTable() LockOptions {
 	opts.lockTable = true
@@ -281,8 +407,29 @@ func (arg *Argument) AddLockTarget(
 	primaryColumnIndexInBatch int32,
 	primaryColumnType types.Type,
 	refreshTimestampIndexInBatch int32) *Argument {
+	return arg.AddLockTargetWithMode(
+		tableID,
+		lock.LockMode_Exclusive,
+		lock.WaitPolicy_Wait,
+		primaryColumnIndexInBatch,
+		primaryColumnType,
+		refreshTimestampIndexInBatch)
+}
+
+// AddLockTargetWithMode add lock targets with lock mode and wait policy, used by
+// the locking read of select. refreshTimestampIndexInBatch is -1 if there is no
+// refresh timestamp column in the batch.
+func (arg *Argument) AddLockTargetWithMode(
+	tableID uint64,
+	mode lock.LockMode,
+	policy lock.WaitPolicy,
+	primaryColumnIndexInBatch int32,
+	primaryColumnType types.Type,
+	refreshTimestampIndexInBatch int32) *Argument {
 	arg.targets = append(arg.targets, lockTarget{
 		tableID:                      tableID,
+		mode:                         mode,
+		policy:                       policy,
 		primaryColumnIndexInBatch:    primaryColumnIndexInBatch,
 		primaryColumnType:            primaryColumnType,
 		refreshTimestampIndexInBatch: refreshTimestampIndexInBatch,
@@ -290,6 +437,19 @@ func (arg *Argument) AddLockTarget(
 	return arg
 }
 
+// WithLimit sets the count of the rows to lock, the lock op ends once limit rows
+// are locked. It's used by the locking read of a single table with LIMIT, so the
+// rows are locked for the first target only.
+func (arg *Argument) WithLimit(limit uint64) *Argument {
+	arg.limit = limit
+	return arg
+}
+
+// Limit returns the count of the rows to lock, 0 means no limit.
+func (arg *Argument) Limit() uint64 {
+	return arg.limit
+}
+
 // AddLockTargetWithPartition add lock targets for partition tables. Our partitioned table implementation
 // has each partition as a separate table. So when modifying data, these rows may belong to different
 // partitions. For lock op does not care about the logic of data and partition mapping calculation, the
@@ -330,6 +490,29 @@ func (arg *Argument) AddLockTargetWithPartition(
 	return arg
 }
 
+// CopyToPlanTargets returns the lock targets as plan lock targets, used to
+// duplicate the lock op or send it to the remote CN. The rows filter of the
+// partition tables are not copied.
+func (arg *Argument) CopyToPlanTargets() []*plan.LockTarget {
+	targets := make([]*plan.LockTarget, 0, len(arg.targets))
+	for _, target := range arg.targets {
+		targets = append(targets, &plan.LockTarget{
+			TableId:            target.tableID,
+			PrimaryColIdxInBat: target.primaryColumnIndexInBatch,
+			PrimaryColTyp: &plan.Type{
+				Id:    int32(target.primaryColumnType.Oid),
+				Width: target.primaryColumnType.Width,
+				Scale: target.primaryColumnType.Scale,
+			},
+			RefreshTsIdxInBat: target.refreshTimestampIndexInBatch,
+			Shared:            target.mode == lock.LockMode_Shared,
+			NoWait:            target.policy == lock.WaitPolicy_FastFail,
+			SkipLocked:        target.policy == lock.WaitPolicy_SkipLocked,
+		})
+	}
+	return targets
+}
+
 // Free free mem
 func (arg *Argument) Free(
 	proc *process.Process,
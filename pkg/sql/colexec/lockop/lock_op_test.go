@@ -132,6 +132,112 @@ func TestCallLockOpWithConflictWithRefreshNotEnabled(t *testing.T) {
 	)
 }
 
+func TestCallLockOpWithSkipLocked(t *testing.T) {
+	runLockOpTest(
+		t,
+		[]uint64{1},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *Argument) {
+			arg.targets[0].policy = lock.WaitPolicy_SkipLocked
+			arg.targets[0].refreshTimestampIndexInBatch = -1
+			require.NoError(t, Prepare(proc, arg))
+
+			arg.parker.Reset()
+			arg.parker.EncodeInt32(1)
+			conflictRow := arg.parker.Bytes()
+			_, err := proc.LockService.Lock(
+				proc.Ctx,
+				1,
+				[][]byte{conflictRow},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+
+			// no refresh timestamp column, the column is output as other columns
+			require.NoError(t, vector.AppendFixedList(proc.InputBatch().GetVector(1), make([]types.TS, 3), nil, proc.Mp()))
+			proc.InputBatch().InitZsOne(3)
+			_, err = Call(0, proc, arg, false, false)
+			require.NoError(t, err)
+			assert.Equal(t, 2, proc.InputBatch().Length())
+			assert.Equal(t, []int32{0, 2}, vector.MustFixedCol[int32](proc.InputBatch().GetVector(0)))
+			require.NoError(t, proc.LockService.Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestCallLockOpWithSkipLockedAndLimit(t *testing.T) {
+	runLockOpTest(
+		t,
+		[]uint64{1},
+		[][]int32{{0, 1, 2, 3}},
+		func(proc *process.Process, arg *Argument) {
+			arg.targets[0].policy = lock.WaitPolicy_SkipLocked
+			arg.targets[0].refreshTimestampIndexInBatch = -1
+			arg.WithLimit(2)
+			require.NoError(t, Prepare(proc, arg))
+
+			arg.parker.Reset()
+			arg.parker.EncodeInt32(1)
+			conflictRow := arg.parker.Bytes()
+			_, err := proc.LockService.Lock(
+				proc.Ctx,
+				1,
+				[][]byte{conflictRow},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+
+			require.NoError(t, vector.AppendFixedList(proc.InputBatch().GetVector(1), make([]types.TS, 4), nil, proc.Mp()))
+			proc.InputBatch().InitZsOne(4)
+			end, err := Call(0, proc, arg, false, false)
+			require.NoError(t, err)
+			require.True(t, end)
+			assert.Equal(t, []int32{0, 2}, vector.MustFixedCol[int32](proc.InputBatch().GetVector(0)))
+
+			// the row after the limit is not locked
+			arg.parker.Reset()
+			arg.parker.EncodeInt32(3)
+			_, err = proc.LockService.Lock(
+				proc.Ctx,
+				1,
+				[][]byte{arg.parker.Bytes()},
+				[]byte("txn02"),
+				lock.LockOptions{Policy: lock.WaitPolicy_FastFail})
+			require.NoError(t, err)
+			require.NoError(t, proc.LockService.Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+			require.NoError(t, proc.LockService.Unlock(proc.Ctx, []byte("txn02"), timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestCallLockOpWithNoWait(t *testing.T) {
+	runLockOpTest(
+		t,
+		[]uint64{1},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *Argument) {
+			arg.targets[0].policy = lock.WaitPolicy_FastFail
+			arg.targets[0].refreshTimestampIndexInBatch = -1
+			require.NoError(t, Prepare(proc, arg))
+
+			arg.parker.Reset()
+			arg.parker.EncodeInt32(1)
+			conflictRow := arg.parker.Bytes()
+			_, err := proc.LockService.Lock(
+				proc.Ctx,
+				1,
+				[][]byte{conflictRow},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+
+			_, err = Call(0, proc, arg, false, false)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockConflict))
+			require.NoError(t, proc.LockService.Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+		},
+	)
+}
+
 func runLockOpTest(
 	t *testing.T,
 	tables []uint64,
@@ -41,6 +41,7 @@ type FetchLockRowsFunc func(
 type LockOptions struct {
 	maxBytesPerLock int
 	mode            lock.LockMode
+	policy          lock.WaitPolicy
 	lockTable       bool
 	parker          *types.Packer
 	fetchFunc       FetchLockRowsFunc
@@ -52,10 +53,16 @@ type LockOptions struct {
 type Argument struct {
 	parker  *types.Packer
 	targets []lockTarget
+	// limit is the count of the rows to lock, 0 means no limit. The rows
+	// skipped by SkipLocked are not counted, see WithLimit.
+	limit  uint64
+	locked uint64
 }
 
 type lockTarget struct {
 	tableID                      uint64
+	mode                         lock.LockMode
+	policy                       lock.WaitPolicy
 	primaryColumnIndexInBatch    int32
 	refreshTimestampIndexInBatch int32
 	primaryColumnType            types.Type
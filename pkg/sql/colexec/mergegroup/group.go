@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -50,30 +51,41 @@ func Call(idx int, proc *process.Process, arg interface{}, isFirst bool, isLast
 			} else if end {
 				return true, nil
 			}
+			if ctr.parts != nil {
+				if ctr.bat != nil {
+					if err := ctr.spill(proc); err != nil {
+						return false, err
+					}
+				}
+				ctr.part = -1
+				ctr.state = EvalPartition
+				continue
+			}
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
-				if ap.NeedEval {
-					for i, agg := range ctr.bat.Aggs {
-						vec, err := agg.Eval(proc.Mp())
-						if err != nil {
-							ctr.state = End
-							return false, err
-						}
-						ctr.bat.Aggs[i] = nil
-						ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-						if vec != nil {
-							anal.Alloc(int64(vec.Size()))
-						}
-					}
-					ctr.bat.Aggs = nil
-					for i := range ctr.bat.Zs { // reset zs
-						ctr.bat.Zs[i] = 1
-					}
+				if err := ctr.eval(ap, proc, anal, isLast); err != nil {
+					ctr.state = End
+					return false, err
 				}
-				anal.Output(ctr.bat, isLast)
 			}
 			ctr.state = End
+		case EvalPartition:
+			if err := ctr.mergePartition(proc); err != nil {
+				ctr.state = End
+				return false, err
+			}
+			if ctr.bat == nil {
+				ctr.state = End
+				continue
+			}
+			if err := ctr.eval(ap, proc, anal, isLast); err != nil {
+				ctr.state = End
+				return false, err
+			}
+			proc.SetInputBatch(ctr.bat)
+			ctr.bat = nil
+			return false, nil
 		case End:
 			proc.SetInputBatch(ctr.bat)
 			ctr.bat = nil
@@ -95,11 +107,88 @@ func (ctr *container) build(proc *process.Process, anal process.Analyze, isFirst
 		}
 
 		anal.Input(bat, isFirst)
+		size := int64(bat.Size())
 		if err = ctr.process(bat, proc); err != nil {
 			bat.Clean(proc.Mp())
 			return false, err
 		}
+		if ctr.spiller == nil {
+			ctr.spiller = colexec.NewSpiller(proc, anal)
+		}
+		if ctr.spiller.Grow(size) && ctr.typ != H0 && colexec.CanSpillAggs(ctr.bat.Aggs) {
+			if err = ctr.spill(proc); err != nil {
+				return false, err
+			}
+		}
+	}
+}
+
+func (ctr *container) eval(ap *Argument, proc *process.Process, anal process.Analyze, isLast bool) error {
+	if ap.NeedEval {
+		for i, agg := range ctr.bat.Aggs {
+			vec, err := agg.Eval(proc.Mp())
+			if err != nil {
+				return err
+			}
+			ctr.bat.Aggs[i] = nil
+			ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+			if vec != nil {
+				anal.Alloc(int64(vec.Size()))
+			}
+		}
+		ctr.bat.Aggs = nil
+		for i := range ctr.bat.Zs { // reset zs
+			ctr.bat.Zs[i] = 1
+		}
+	}
+	anal.Output(ctr.bat, isLast)
+	return nil
+}
+
+// spill writes the groups merged so far into the partitions by the hash of
+// the group keys, and resets the state to merge the rest.
+func (ctr *container) spill(proc *process.Process) error {
+	bats, err := ctr.spiller.Partition(ctr.bat, ctr.bat.Vecs, colexec.SpillPartitions)
+	if err != nil {
+		return err
+	}
+	if ctr.parts == nil {
+		ctr.parts = new(colexec.SpilledPartitions)
+	}
+	if err = ctr.spiller.WritePartitions(bats, ctr.parts); err != nil {
+		return err
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	ctr.spiller.Release()
+	return nil
+}
+
+// mergePartition merges the groups of the next non-empty partition into
+// ctr.bat, ctr.bat is nil if all the partitions are done.
+func (ctr *container) mergePartition(proc *process.Process) error {
+	ctr.cleanHashMap()
+	ctr.spiller.Release()
+	for ctr.bat == nil {
+		if ctr.part++; ctr.part >= len(ctr.parts.Files) {
+			return nil
+		}
+		for _, f := range ctr.parts.Files[ctr.part] {
+			for i := 0; i < f.Len(); i++ {
+				bat, err := f.Read(proc, i)
+				if err != nil {
+					return err
+				}
+				// the partition is merged in memory even if it is over the budget
+				ctr.spiller.Grow(int64(bat.Size()))
+				if err = ctr.process(bat, proc); err != nil {
+					bat.Clean(proc.Mp())
+					return err
+				}
+			}
+		}
 	}
+	return nil
 }
 
 func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -127,6 +129,40 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestSpilledGroup(t *testing.T) {
+	tc := newTestCase([]bool{false}, true, []types.Type{types.T_int64.ToType()})
+	tc.proc.Lim.SpillSize = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	for i := 0; i < 2; i++ {
+		tc.proc.Reg.MergeReceivers[i].Ch <- newAggBatch(t, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[i].Ch <- newAggBatch(t, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	sums := make(map[int64]int64)
+	for {
+		ok, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		keys := vector.MustFixedCol[int64](bat.Vecs[0])
+		for j, sum := range vector.MustFixedCol[int64](bat.Vecs[1]) {
+			_, ok := sums[keys[j]]
+			require.False(t, ok)
+			sums[keys[j]] = sum
+		}
+		bat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, Rows, len(sums))
+	for _, sum := range sums {
+		require.Equal(t, int64(4), sum)
+	}
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
@@ -188,3 +224,23 @@ func newTestCase(flgs []bool, needEval bool, ts []types.Type) groupTestCase {
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+// create a batch of the partial sums of the groups, each group sums 1.
+func newAggBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	typ := types.T_int64.ToType()
+	bat := testutil.NewBatch([]types.Type{typ}, false, int(rows), proc.Mp())
+	vs := make([]int64, rows)
+	for i := range vs {
+		vs[i] = 1
+	}
+	ones := testutil.NewInt64Vector(int(rows), typ, proc.Mp(), false, vs)
+	defer ones.Free(proc.Mp())
+	ag, err := agg.New(agg.AggregateSum, false, typ)
+	require.NoError(t, err)
+	require.NoError(t, ag.Grows(int(rows), proc.Mp()))
+	for i := int64(0); i < rows; i++ {
+		require.NoError(t, ag.Fill(i, i, 1, []*vector.Vector{ones}))
+	}
+	bat.Aggs = []agg.Agg[any]{ag}
+	return bat
+}
@@ -25,6 +25,9 @@ import (
 const (
 	Build = iota
 	Eval
+	// EvalPartition is the state to merge and output the partitions of the
+	// groups spilled to disk one by one.
+	EvalPartition
 	End
 )
 
@@ -46,6 +49,10 @@ type container struct {
 	strHashMap *hashmap.StrHashMap
 
 	bat *batch.Batch
+
+	spiller *colexec.Spiller
+	parts   *colexec.SpilledPartitions
+	part    int
 }
 
 type Argument struct {
@@ -60,6 +67,9 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.FreeMergeTypeOperator(pipelineFailed)
 		ctr.cleanBatch(mp)
 		ctr.cleanHashMap()
+		if ctr.spiller != nil {
+			ctr.spiller.Free()
+		}
 	}
 }
 
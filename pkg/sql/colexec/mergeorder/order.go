@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -64,25 +65,58 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 	anal.Start()
 	defer anal.Stop()
 
+	if ctr.state == Merge {
+		return ctr.output(proc, ap, anal, isLast)
+	}
+	if ctr.spiller == nil {
+		ctr.spiller = colexec.NewSpiller(proc, anal)
+	}
+
 	// get batch from merge receivers and do merge sort.
 	// save the unordered result in ctr.bat.
 	// save the ordered index list in ctr.finalSelectList
 	for {
-		bat, end, err := ctr.ReceiveFromAllRegs(anal)
+		var bat *batch.Batch
+		var end bool
+		bat, end, err = ctr.ReceiveFromAllRegs(anal)
 		if err != nil || end {
 			break
 		}
 
 		anal.Input(bat, isFirst)
+		size := int64(bat.Size())
 		if err = mergeSort(proc, bat, ap, ctr, anal); err != nil {
 			break
 		}
+		if ctr.spiller.Grow(size) {
+			if err = ctr.spillRun(proc); err != nil {
+				break
+			}
+		}
 	}
 	if err != nil {
 		ap.Free(proc, true)
 		return false, err
 	}
 
+	// merge the sorted runs if any is spilled
+	if ctr.spiller.Spilled() {
+		if ctr.bat != nil {
+			if err = ctr.spillRun(proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+		for _, r := range ctr.runs {
+			if err = r.load(proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+		ctr.state = Merge
+		return ctr.output(proc, ap, anal, isLast)
+	}
+
 	// remove and clean unnecessary vector
 	// shuffle the ctr.bat
 	if ctr.bat != nil {
@@ -239,6 +273,118 @@ func (ctr *container) mergeSort2(bat2 *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// spillRun writes the rows sorted so far as a sorted run, with the order
+// columns kept for the merge.
+func (ctr *container) spillRun(proc *process.Process) error {
+	if err := ctr.bat.Shuffle(ctr.finalSelectList, proc.Mp()); err != nil {
+		return err
+	}
+	if ctr.typs == nil {
+		ctr.typs = make([]types.Type, ctr.n)
+		for i := range ctr.typs {
+			ctr.typs[i] = *ctr.bat.Vecs[i].GetType()
+		}
+	}
+	f, err := ctr.spiller.WriteRows(ctr.bat)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, &run{file: f})
+	ctr.cleanBatch(proc.Mp())
+	ctr.finalSelectList = nil
+	ctr.spiller.Release()
+	return nil
+}
+
+// output outputs the next batch merged from the sorted runs.
+func (ctr *container) output(proc *process.Process, ap *Argument, anal process.Analyze, isLast bool) (bool, error) {
+	bat, err := ctr.merge(proc)
+	if err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	if bat == nil {
+		proc.SetInputBatch(nil)
+		ap.Free(proc, false)
+		return true, nil
+	}
+	anal.Output(bat, isLast)
+	proc.SetInputBatch(bat)
+	return false, nil
+}
+
+// merge merges at most colexec.SpillBatchRows rows from the heads of the
+// runs, the runs are compared in order so that the merge is stable.
+func (ctr *container) merge(proc *process.Process) (*batch.Batch, error) {
+	mp := proc.Mp()
+	bat := batch.NewWithSize(ctr.n)
+	bat.Zs = mp.GetSels()
+	for i, typ := range ctr.typs {
+		bat.Vecs[i] = proc.GetVector(typ)
+	}
+	for bat.Length() < colexec.SpillBatchRows {
+		var min *run
+		for _, r := range ctr.runs {
+			if r.bat != nil && (min == nil || ctr.less(r, min)) {
+				min = r
+			}
+		}
+		if min == nil {
+			break
+		}
+		for i := range bat.Vecs {
+			if err := bat.Vecs[i].UnionOne(min.bat.Vecs[i], min.row, mp); err != nil {
+				bat.Clean(mp)
+				return nil, err
+			}
+		}
+		bat.Zs = append(bat.Zs, min.bat.Zs[min.row])
+		if min.row++; min.row < int64(min.bat.Length()) {
+			continue
+		}
+		min.bat.Clean(mp)
+		min.bat = nil
+		if err := min.load(proc); err != nil {
+			bat.Clean(mp)
+			return nil, err
+		}
+	}
+	if bat.Length() == 0 {
+		bat.Clean(mp)
+		return nil, nil
+	}
+	return bat, nil
+}
+
+func (ctr *container) less(r1, r2 *run) bool {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, r1.bat.GetVector(ctr.compare0Index[i]))
+		cmp.Set(1, r2.bat.GetVector(ctr.compare0Index[i]))
+		if result := cmp.Compare(0, 1, r1.row, r2.row); result != 0 {
+			return result < 0
+		}
+	}
+	return false
+}
+
+// load reads the next non-empty batch of the run, r.bat is nil if the run
+// is done.
+func (r *run) load(proc *process.Process) error {
+	for r.idx < r.file.Len() {
+		bat, err := r.file.Read(proc, r.idx)
+		if err != nil {
+			return err
+		}
+		r.idx++
+		if bat.Length() > 0 {
+			r.bat, r.row = bat, 0
+			return nil
+		}
+		bat.Clean(proc.Mp())
+	}
+	return nil
+}
+
 func generateSelectList(j int64) []int64 {
 	list := make([]int64, j)
 	var i int64
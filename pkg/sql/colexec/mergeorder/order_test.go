@@ -150,6 +150,36 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestSpilledOrder(t *testing.T) {
+	tc := newTestCase([]types.Type{types.T_int8.ToType(), types.T_int64.ToType()}, []*plan.OrderBySpec{{Expr: newExpression(1), Flag: 0}})
+	tc.proc.Lim.SpillSize = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	for i := 0; i < 2; i++ {
+		tc.proc.Reg.MergeReceivers[i].Ch <- newIntBatch(tc.types, tc.proc, Rows, tc.arg.Fs)
+		tc.proc.Reg.MergeReceivers[i].Ch <- newIntBatch(tc.types, tc.proc, Rows, tc.arg.Fs)
+		tc.proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	var values []int64
+	for {
+		ok, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, 2, len(bat.Vecs))
+		values = append(values, vector.MustFixedCol[int64](bat.Vecs[1])...)
+		bat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, 4*Rows, len(values))
+	for j := 1; j < len(values); j++ {
+		require.True(t, values[j] >= values[j-1], fmt.Sprintf("require asc, but get %v", values))
+	}
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
@@ -18,14 +18,22 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+const (
+	Build = iota
+	Merge
+)
+
 type container struct {
 	colexec.ReceiverOperator
 
+	state int
+
 	n     int               // result vector number
 	poses []int32           // sorted list of attributes
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
@@ -39,6 +47,20 @@ type container struct {
 
 	// executors for order column.
 	executorsForOrderList []colexec.ExpressionExecutor
+
+	// the sorted runs spilled to disk when the query is over its memory
+	// budget, they are merged into the result at the end.
+	spiller *colexec.Spiller
+	runs    []*run
+	typs    []types.Type
+}
+
+// run is a sorted run spilled to disk and its current row in the merge.
+type run struct {
+	file *colexec.SpillFile
+	idx  int // index of the next batch to read
+	bat  *batch.Batch
+	row  int64
 }
 
 type Argument struct {
@@ -51,6 +73,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	if ctr != nil {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
+		ctr.cleanRuns(mp)
 		ctr.cleanExecutors()
 		ctr.FreeMergeTypeOperator(pipelineFailed)
 	}
@@ -63,6 +86,19 @@ func (ctr *container) cleanBatch(mp *mpool.MPool) {
 	}
 }
 
+func (ctr *container) cleanRuns(mp *mpool.MPool) {
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			r.bat.Clean(mp)
+			r.bat = nil
+		}
+	}
+	ctr.runs = nil
+	if ctr.spiller != nil {
+		ctr.spiller.Free()
+	}
+}
+
 func (ctr *container) cleanExecutors() {
 	for i := range ctr.executorsForOrderList {
 		ctr.executorsForOrderList[i].Free()
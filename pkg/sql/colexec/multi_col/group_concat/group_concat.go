@@ -451,6 +451,8 @@ func VectorToString(vec *vector.Vector, rowIndex int) (string, error) {
 		val := vec.GetBytesAt(rowIndex)
 		byteJson := types.DecodeJson(val)
 		return byteJson.String(), nil
+	case types.T_array_float32:
+		return types.ArrayF32ToString(types.BytesToArrayF32(vec.GetBytesAt(rowIndex))), nil
 	case types.T_uuid:
 		val := vector.GetFixedAt[types.Uuid](vec, rowIndex)
 		return val.ToString(), nil
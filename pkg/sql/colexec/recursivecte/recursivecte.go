@@ -0,0 +1,214 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("recursive cte")
+	if ap.UnionAll {
+		buf.WriteString(" union all")
+	} else {
+		buf.WriteString(" union")
+	}
+}
+
+func Prepare(proc *process.Process, arg any) (err error) {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if !ap.UnionAll {
+		ap.ctr.hashTable, err = hashmap.NewStrMap(true, 0, 0, proc.Mp())
+	}
+	return err
+}
+
+// Call buffers all the rows of the anchor, and then runs the recursive member
+// repeatedly over the rows produced by the previous iteration until no new rows
+// are produced. All the rows are sent out once the recursion is finished.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		if err := ctr.recurse(ap, proc); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		if ctr.bat == nil {
+			proc.SetInputBatch(nil)
+			ap.Free(proc, false)
+			return true, nil
+		}
+		anal.Output(ctr.bat, isLast)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		ap.Free(proc, false)
+		return true, nil
+	}
+
+	if bat.Length() == 0 {
+		bat.Clean(proc.Mp())
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+	anal.Alloc(int64(bat.Size()))
+	proc.SetInputBatch(&batch.Batch{})
+	err := ctr.appendRows(ap, bat, proc)
+	proc.PutBatch(bat)
+	if err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	return false, nil
+}
+
+func (ctr *container) recurse(ap *Argument, proc *process.Process) error {
+	for i := int64(1); ctr.working != nil; i++ {
+		// the same as mysql, an iteration which produces no new rows is counted as well.
+		if i > ap.MaxRecursionDepth {
+			return moerr.NewInternalError(proc.Ctx, "recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value", i)
+		}
+		working := ctr.working
+		ctr.working = nil
+		bats, err := ap.Iterate(proc, working)
+		for _, bat := range bats {
+			if err == nil {
+				err = ctr.appendRows(ap, bat, proc)
+			}
+			bat.Clean(proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendRows appends the new rows of bat to both the result and the working table.
+func (ctr *container) appendRows(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	var rows *batch.Batch
+	var err error
+
+	if ap.UnionAll {
+		rows, err = copyRows(bat, proc)
+	} else {
+		rows, err = ctr.distinctRows(bat, proc)
+	}
+	if err != nil || rows == nil {
+		return err
+	}
+	if rows.Length() == 0 {
+		rows.Clean(proc.Mp())
+		return nil
+	}
+
+	if ctr.bat == nil {
+		ctr.bat, err = rows.Dup(proc.Mp())
+	} else {
+		ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), rows)
+	}
+	if err != nil {
+		rows.Clean(proc.Mp())
+		return err
+	}
+
+	if ctr.working == nil {
+		ctr.working = rows
+		return nil
+	}
+	ctr.working, err = ctr.working.Append(proc.Ctx, proc.Mp(), rows)
+	rows.Clean(proc.Mp())
+	return err
+}
+
+// distinctRows returns the rows of bat which were never seen before.
+func (ctr *container) distinctRows(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	inserted := make([]uint8, hashmap.UnitLimit)
+	restoreInserted := make([]uint8, hashmap.UnitLimit)
+
+	rows := newBatch(bat)
+	count := bat.Length()
+	itr := ctr.hashTable.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		oldHashGroup := ctr.hashTable.GroupCount()
+
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		vs, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			rows.Clean(proc.Mp())
+			return nil, err
+		}
+		copy(inserted[:n], restoreInserted[:n])
+		groups := oldHashGroup
+		for j, v := range vs {
+			if v > groups {
+				// ensure that the same value will only be inserted once.
+				groups++
+				inserted[j] = 1
+				rows.Zs = append(rows.Zs, 1)
+			}
+		}
+		if groups > oldHashGroup {
+			if err := unionRows(rows, bat, inserted[:n], i, int(groups-oldHashGroup), proc); err != nil {
+				rows.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+func copyRows(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rows := newBatch(bat)
+	if err := unionRows(rows, bat, nil, 0, bat.Length(), proc); err != nil {
+		rows.Clean(proc.Mp())
+		return nil, err
+	}
+	rows.Zs = append(rows.Zs, bat.Zs...)
+	return rows, nil
+}
+
+func unionRows(rows, bat *batch.Batch, flags []uint8, offset, count int, proc *process.Process) error {
+	for i, vec := range bat.Vecs {
+		if err := rows.Vecs[i].UnionBatch(vec, int64(offset), count, flags, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newBatch(bat *batch.Batch) *batch.Batch {
+	rows := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rows.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	return rows
+}
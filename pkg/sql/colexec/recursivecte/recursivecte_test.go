@@ -0,0 +1,137 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type recursiveCteTestCase struct {
+	arg      *Argument
+	anchor   []int64
+	expected []int64
+	hasErr   bool
+}
+
+func newTestCases() []recursiveCteTestCase {
+	return []recursiveCteTestCase{
+		// select 1 union all select n + 1 from c where n < 5
+		{
+			arg:      newArgument(true, 100, 5),
+			anchor:   []int64{1},
+			expected: []int64{1, 2, 3, 4, 5},
+		},
+		// select 1 union all select 3 union all select n + 1 from c where n < 5
+		{
+			arg:      newArgument(true, 100, 5),
+			anchor:   []int64{1, 3},
+			expected: []int64{1, 3, 2, 4, 3, 5, 4, 5},
+		},
+		// select 1 union select 3 union select n + 1 from c where n < 5
+		{
+			arg:      newArgument(false, 100, 5),
+			anchor:   []int64{1, 3, 3},
+			expected: []int64{1, 3, 2, 4, 5},
+		},
+		// the recursion can't finish within the max recursion depth
+		{
+			arg:    newArgument(true, 3, 5),
+			anchor: []int64{1},
+			hasErr: true,
+		},
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range newTestCases() {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	for _, tc := range newTestCases() {
+		err := Prepare(proc, tc.arg)
+		require.NoError(t, err)
+		tc.arg.Free(proc, false)
+	}
+}
+
+func TestRecursiveCte(t *testing.T) {
+	for _, tc := range newTestCases() {
+		proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+		require.NoError(t, Prepare(proc, tc.arg))
+
+		proc.Reg.InputBatch = newTestBatch(proc, tc.anchor)
+		end, err := Call(0, proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.False(t, end)
+		proc.Reg.InputBatch = nil
+		end, err = Call(0, proc, tc.arg, false, false)
+		if tc.hasErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.True(t, end)
+
+			bat := proc.Reg.InputBatch
+			require.Equal(t, tc.expected, vector.MustFixedCol[int64](bat.Vecs[0]))
+			bat.Clean(proc.Mp())
+		}
+		proc.FreeVectors()
+		require.Equal(t, int64(0), proc.Mp().CurrNB())
+	}
+}
+
+// newArgument returns an argument whose recursive member is
+//
+//	select n + 1 from c where n < limit
+func newArgument(unionAll bool, depth int64, limit int64) *Argument {
+	return &Argument{
+		UnionAll:          unionAll,
+		MaxRecursionDepth: depth,
+		Iterate: func(proc *process.Process, working *batch.Batch) ([]*batch.Batch, error) {
+			defer working.Clean(proc.Mp())
+			var vals []int64
+			for _, v := range vector.MustFixedCol[int64](working.Vecs[0]) {
+				if v < limit {
+					vals = append(vals, v+1)
+				}
+			}
+			if len(vals) == 0 {
+				return nil, nil
+			}
+			return []*batch.Batch{newTestBatch(proc, vals)}, nil
+		},
+	}
+}
+
+func newTestBatch(proc *process.Process, vals []int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.NewInt64Vector(len(vals), types.T_int64.ToType(), proc.Mp(), false, vals)
+	bat.InitZsOne(len(vals))
+	return bat
+}
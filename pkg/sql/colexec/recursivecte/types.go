@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	// bat stores all the rows of the recursive CTE.
+	bat *batch.Batch
+	// working stores the new rows of the last iteration, it is the
+	// input of the recursive member for the next iteration.
+	working *batch.Batch
+
+	// hashTable is used to discard the duplicate rows for UNION [DISTINCT].
+	hashTable *hashmap.StrHashMap
+}
+
+type Argument struct {
+	ctr *container
+
+	// UnionAll is false if the anchor and the recursive member are
+	// combined by UNION [DISTINCT].
+	UnionAll bool
+	// MaxRecursionDepth is the max number of the iterations.
+	MaxRecursionDepth int64
+	// Iterate runs the recursive member once over the working table and
+	// returns the rows it produced. Iterate takes over the working table.
+	Iterate func(proc *process.Process, working *batch.Batch) ([]*batch.Batch, error)
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanBatch(mp)
+		ctr.cleanHashMap()
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+	if ctr.working != nil {
+		ctr.working.Clean(mp)
+		ctr.working = nil
+	}
+}
+
+func (ctr *container) cleanHashMap() {
+	if ctr.hashTable != nil {
+		ctr.hashTable.Free()
+		ctr.hashTable = nil
+	}
+}
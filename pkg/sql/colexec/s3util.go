@@ -124,7 +124,7 @@ func (w *S3Writer) SetSortIdx(sortIdx int) {
 func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 	uniqueNums := 0
 	for _, idx := range tableDef.Indexes {
-		if idx.Unique {
+		if util.HasIndexTable(idx) {
 			uniqueNums++
 		}
 	}
@@ -185,7 +185,7 @@ func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 			}
 			continue
 		}
-		//handle for unique index table.
+		//handle for unique and fulltext index table.
 		writers[i].sortIndex = 0
 		writers[i].pk[catalog.IndexTableIndexColName] = struct{}{}
 	}
@@ -366,11 +366,11 @@ func (w *S3Writer) SortAndFlush(proc *process.Process) error {
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[int64](), getFixedCols[int64](w.Bats, pos), nulls)
 		case types.T_uint8:
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[uint8](), getFixedCols[uint8](w.Bats, pos), nulls)
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[uint16](), getFixedCols[uint16](w.Bats, pos), nulls)
 		case types.T_uint32:
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[uint32](), getFixedCols[uint32](w.Bats, pos), nulls)
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[uint64](), getFixedCols[uint64](w.Bats, pos), nulls)
 		case types.T_float32:
 			merge = NewMerge(len(w.Bats), sort.NewGenericCompLess[float32](), getFixedCols[float32](w.Bats, pos), nulls)
@@ -0,0 +1,360 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go.uber.org/zap"
+)
+
+const (
+	// SpillPartitions is the number of the partitions the hash join and the
+	// aggregation split their state into when spilling.
+	SpillPartitions = 16
+	// SpillBatchRows is the max rows of a batch written by the sort.
+	SpillBatchRows = 8192
+
+	spillDir = "spill"
+)
+
+// Spiller spills the state of an operator over the memory budget of the query
+// to the temporary files of the local fileservice.
+type Spiller struct {
+	proc *process.Process
+	anal process.Analyze
+	fs   fileservice.FileService
+	dir  string
+	// files are the paths of all the files written by the spiller
+	files []string
+	// held is the memory of the operator recorded in the budget
+	held int64
+
+	partitioner *hashmap.Partitioner
+}
+
+// SpillFile is a file of the spilled batches.
+type SpillFile struct {
+	fs      fileservice.FileService
+	path    string
+	extents [][2]int64 // offset and size of each batch
+	Rows    int
+}
+
+// SpilledPartitions are the partitions of the rows spilled by a Spiller,
+// each partition is a list of the files.
+type SpilledPartitions struct {
+	spiller *Spiller
+	Files   [][]*SpillFile
+}
+
+func NewSpiller(proc *process.Process, anal process.Analyze) *Spiller {
+	return &Spiller{
+		proc: proc,
+		anal: anal,
+	}
+}
+
+// Grow records that n more bytes are held by the operator, it returns true
+// if the query is over its memory budget and the operator should spill.
+func (s *Spiller) Grow(n int64) bool {
+	s.held += n
+	return !s.proc.GrowSpillMemory(n)
+}
+
+// Release releases all the memory recorded by Grow, it is called after the
+// state of the operator is spilled or freed.
+func (s *Spiller) Release() {
+	s.proc.ReleaseSpillMemory(s.held)
+	s.held = 0
+}
+
+// Spilled returns whether the spiller has written any file.
+func (s *Spiller) Spilled() bool {
+	return len(s.files) > 0
+}
+
+// Write writes the batches into a new file.
+func (s *Spiller) Write(bats ...*batch.Batch) (*SpillFile, error) {
+	if s.fs == nil {
+		fs, err := fileservice.Get[fileservice.FileService](s.proc.FileService, defines.LocalFileServiceName)
+		if err != nil {
+			return nil, err
+		}
+		s.fs = fs
+		s.dir = path.Join(spillDir, uuid.NewString())
+	}
+	f := &SpillFile{
+		fs:      s.fs,
+		path:    path.Join(s.dir, strconv.Itoa(len(s.files))),
+		extents: make([][2]int64, 0, len(bats)),
+	}
+	vec := fileservice.IOVector{
+		FilePath: f.path,
+		Entries:  make([]fileservice.IOEntry, 0, len(bats)),
+		NoCache:  true,
+	}
+	offset := int64(0)
+	for _, bat := range bats {
+		data, err := types.Encode(bat)
+		if err != nil {
+			return nil, err
+		}
+		size := int64(len(data))
+		vec.Entries = append(vec.Entries, fileservice.IOEntry{
+			Offset: offset,
+			Size:   size,
+			Data:   data,
+		})
+		f.extents = append(f.extents, [2]int64{offset, size})
+		f.Rows += bat.Length()
+		offset += size
+	}
+	if err := s.fs.Write(s.proc.Ctx, vec); err != nil {
+		return nil, err
+	}
+	s.files = append(s.files, f.path)
+	s.anal.Spill(offset)
+	return f, nil
+}
+
+// WriteRows writes the rows of the batch into a new file, split into the
+// batches of SpillBatchRows rows.
+func (s *Spiller) WriteRows(bat *batch.Batch) (*SpillFile, error) {
+	mp := s.proc.Mp()
+	count := bat.Length()
+	if count <= SpillBatchRows {
+		return s.Write(bat)
+	}
+	bats := make([]*batch.Batch, 0, (count+SpillBatchRows-1)/SpillBatchRows)
+	defer cleanBatches(bats, mp)
+	sels := make([]int32, 0, SpillBatchRows)
+	for start := 0; start < count; start += SpillBatchRows {
+		end := start + SpillBatchRows
+		if end > count {
+			end = count
+		}
+		sels = sels[:0]
+		for i := start; i < end; i++ {
+			sels = append(sels, int32(i))
+		}
+		rbat := batch.NewWithSize(len(bat.Vecs))
+		bats = append(bats, rbat)
+		for i, vec := range bat.Vecs {
+			rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+			if err := rbat.Vecs[i].Union(vec, sels, mp); err != nil {
+				return nil, err
+			}
+		}
+		rbat.Zs = append(mp.GetSels(), bat.Zs[start:end]...)
+	}
+	return s.Write(bats...)
+}
+
+// Partition splits the rows of the batch into n batches by the hash of the
+// keys, the i-th batch is nil if no row is in the i-th partition. The
+// aggregations of the batch are split too.
+func (s *Spiller) Partition(bat *batch.Batch, keys []*vector.Vector, n int) ([]*batch.Batch, error) {
+	if s.partitioner == nil {
+		s.partitioner = hashmap.NewPartitioner(uint64(n))
+	}
+	mp := s.proc.Mp()
+	count := bat.Length()
+	sels := make([][]int32, n)
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		m := count - i
+		if m > hashmap.UnitLimit {
+			m = hashmap.UnitLimit
+		}
+		for k, p := range s.partitioner.Partition(keys, i, m) {
+			sels[p] = append(sels[p], int32(i+k))
+		}
+	}
+
+	bats := make([]*batch.Batch, n)
+	for p := range bats {
+		if len(sels[p]) == 0 {
+			continue
+		}
+		pbat := batch.NewWithSize(len(bat.Vecs))
+		bats[p] = pbat
+		pbat.Zs = mp.GetSels()
+		for _, sel := range sels[p] {
+			pbat.Zs = append(pbat.Zs, bat.Zs[sel])
+		}
+		for i, vec := range bat.Vecs {
+			pbat.Vecs[i] = vector.NewVec(*vec.GetType())
+			if err := pbat.Vecs[i].Union(vec, sels[p], mp); err != nil {
+				cleanBatches(bats, mp)
+				return nil, err
+			}
+		}
+		if len(bat.Aggs) == 0 {
+			continue
+		}
+		// the groups of the partition are merged into new aggregations
+		pbat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+		os := make([]uint8, count)
+		vps := make([]uint64, count)
+		for i, sel := range sels[p] {
+			vps[sel] = uint64(i + 1)
+		}
+		for i, ag := range bat.Aggs {
+			pag, err := agg.New(ag.GetOperatorId(), ag.IsDistinct(), ag.GetInputTypes()[0])
+			if err != nil {
+				cleanBatches(bats, mp)
+				return nil, err
+			}
+			pbat.Aggs[i] = pag
+			if err = pag.Grows(len(sels[p]), mp); err != nil {
+				cleanBatches(bats, mp)
+				return nil, err
+			}
+			if err = pag.BatchMerge(ag, 0, os, vps); err != nil {
+				cleanBatches(bats, mp)
+				return nil, err
+			}
+		}
+	}
+	return bats, nil
+}
+
+// WritePartitions writes the non-empty partitions into the files of parts.
+func (s *Spiller) WritePartitions(bats []*batch.Batch, parts *SpilledPartitions) error {
+	mp := s.proc.Mp()
+	defer cleanBatches(bats, mp)
+	if parts.Files == nil {
+		parts.spiller = s
+		parts.Files = make([][]*SpillFile, len(bats))
+	}
+	for i, bat := range bats {
+		if bat == nil {
+			continue
+		}
+		f, err := s.Write(bat)
+		if err != nil {
+			return err
+		}
+		parts.Files[i] = append(parts.Files[i], f)
+	}
+	return nil
+}
+
+// Free deletes the files written by the spiller and releases its memory.
+func (s *Spiller) Free() {
+	s.Release()
+	if len(s.files) == 0 {
+		return
+	}
+	// the context of the process may be canceled already
+	if err := s.fs.Delete(context.Background(), s.files...); err != nil {
+		logutil.Error("failed to delete the spill files",
+			zap.String("dir", s.dir),
+			zap.Error(err))
+	}
+	s.files = nil
+}
+
+// Free deletes the files of the partitions.
+func (p *SpilledPartitions) Free() {
+	if p.spiller != nil {
+		p.spiller.Free()
+	}
+}
+
+// Len returns the number of the batches in the file.
+func (f *SpillFile) Len() int {
+	return len(f.extents)
+}
+
+// Read reads the i-th batch of the file, the memory of the batch is
+// allocated from the mpool of the process.
+func (f *SpillFile) Read(proc *process.Process, i int) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: f.path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: f.extents[i][0],
+				Size:   f.extents[i][1],
+			},
+		},
+		NoCache: true,
+	}
+	if err := f.fs.Read(proc.Ctx, vec); err != nil {
+		return nil, err
+	}
+	bat := new(batch.Batch)
+	if err := types.Decode(vec.Entries[0].Data, bat); err != nil {
+		return nil, err
+	}
+	mp := proc.Mp()
+	for i, vec := range bat.Vecs {
+		nvec, err := vec.Dup(mp)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				bat.Vecs[j].Free(mp)
+			}
+			return nil, err
+		}
+		bat.Vecs[i] = nvec
+	}
+	for i, ag := range bat.Aggs {
+		if err := ag.WildAggReAlloc(mp); err != nil {
+			for j := 0; j < i; j++ {
+				bat.Aggs[j].Free(mp)
+			}
+			for j := range bat.Vecs {
+				bat.Vecs[j].Free(mp)
+			}
+			return nil, err
+		}
+	}
+	zs := mp.GetSels()
+	bat.Zs = append(zs, bat.Zs...)
+	return bat, nil
+}
+
+// CanSpillAggs returns whether the aggregations can be spilled, they are
+// rebuilt by agg.New when read back.
+func CanSpillAggs(aggs []agg.Agg[any]) bool {
+	for _, ag := range aggs {
+		if ag.GetOperatorId() == agg.AggregateGroupConcat {
+			return false
+		}
+	}
+	return true
+}
+
+func cleanBatches(bats []*batch.Batch, mp *mpool.MPool) {
+	for i, bat := range bats {
+		if bat != nil {
+			bat.Clean(mp)
+			bats[i] = nil
+		}
+	}
+}
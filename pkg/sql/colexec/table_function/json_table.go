@@ -0,0 +1,391 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTablePath is a row path of json_table with the parsed paths.
+type jsonTablePath struct {
+	path    bytejson.Path
+	columns []*jsonTableColumn
+	nested  []*jsonTablePath
+}
+
+type jsonTableColumn struct {
+	plan2.JsonTableColumn
+	path bytejson.Path
+	// pos is the position of the column in the result batch, -1 if the
+	// column is not needed.
+	pos int
+	typ types.Type
+}
+
+// jsonTableCell is a value of a row, which is null if it is not set.
+type jsonTableCell struct {
+	val any
+	set bool
+}
+
+func jsonTablePrepare(proc *process.Process, arg *Argument) error {
+	root := &plan2.JsonTablePath{}
+	if err := json.Unmarshal(arg.Params, root); err != nil {
+		return err
+	}
+	attrPos := make(map[string]int, len(arg.Attrs))
+	for i, attr := range arg.Attrs {
+		attrPos[attr] = i
+	}
+	var err error
+	arg.ctr = new(container)
+	if arg.ctr.jsonTable, err = newJsonTablePath(root, attrPos, arg.retSchema); err != nil {
+		return err
+	}
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+func newJsonTablePath(p *plan2.JsonTablePath, attrPos map[string]int, retSchema []types.Type) (*jsonTablePath, error) {
+	var err error
+	ret := &jsonTablePath{}
+	if ret.path, err = types.ParseStringToPath(p.Path); err != nil {
+		return nil, err
+	}
+	for _, col := range p.Columns {
+		c := &jsonTableColumn{JsonTableColumn: col, pos: -1}
+		if col.Type != tree.JsonTableColumnOrdinality {
+			if c.path, err = types.ParseStringToPath(col.Path); err != nil {
+				return nil, err
+			}
+		}
+		if pos, ok := attrPos[col.Name]; ok {
+			c.pos = pos
+			c.typ = retSchema[pos]
+		}
+		ret.columns = append(ret.columns, c)
+	}
+	for _, n := range p.Nested {
+		nested, err := newJsonTablePath(n, attrPos, retSchema)
+		if err != nil {
+			return nil, err
+		}
+		ret.nested = append(ret.nested, nested)
+	}
+	return ret, nil
+}
+
+func jsonTableCall(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	var (
+		err     error
+		rbat    *batch.Batch
+		jsonVec *vector.Vector
+	)
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+		if jsonVec != nil {
+			jsonVec.Free(proc.Mp())
+		}
+	}()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	jsonVec, err = arg.ctr.executorsForArgs[0].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return false, err
+	}
+	var parse func(dt []byte) (bytejson.ByteJson, error)
+	switch jsonVec.GetType().Oid {
+	case types.T_json:
+		parse = parseJson
+	case types.T_char, types.T_varchar, types.T_text:
+		parse = parseStr
+	case types.T_any:
+		parse = nil
+	default:
+		err = moerr.NewInvalidInput(proc.Ctx, "json_table: the document must be json or string, but got %s", jsonVec.GetType().String())
+		return false, err
+	}
+
+	rbat = batch.New(false, arg.Attrs)
+	rbat.Cnt = 1
+	for i := range arg.retSchema {
+		rbat.Vecs[i] = vector.NewVec(arg.retSchema[i])
+	}
+	docCnt := jsonVec.Length()
+	if jsonVec.IsConst() {
+		docCnt = 1
+	}
+	rows := 0
+	for i := 0; i < docCnt && parse != nil; i++ {
+		if jsonVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		var doc bytejson.ByteJson
+		if doc, err = parse(jsonVec.GetBytesAt(i)); err != nil {
+			return false, err
+		}
+		var jrows [][]jsonTableCell
+		if jrows, err = arg.ctr.jsonTable.produce(proc, doc, len(arg.Attrs)); err != nil {
+			return false, err
+		}
+		for _, row := range jrows {
+			for j, cell := range row {
+				if err = vector.AppendAny(rbat.Vecs[j], cell.val, !cell.set, proc.Mp()); err != nil {
+					return false, err
+				}
+			}
+		}
+		rows += len(jrows)
+	}
+	rbat.InitZsOne(rows)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// produce returns the rows of the values matched by the path. The rows of
+// the sibling nested paths come one after another, and a value produces a
+// row whose nested columns are null if no nested path matches anything.
+func (p *jsonTablePath) produce(proc *process.Process, doc bytejson.ByteJson, width int) ([][]jsonTableCell, error) {
+	var rows [][]jsonTableCell
+	for i, val := range doc.Lookup(&p.path) {
+		base := make([]jsonTableCell, width)
+		for _, col := range p.columns {
+			if col.pos < 0 {
+				continue
+			}
+			cell, err := col.eval(proc, val, i+1)
+			if err != nil {
+				return nil, err
+			}
+			base[col.pos] = cell
+		}
+		var nestedRows [][]jsonTableCell
+		for _, nested := range p.nested {
+			rs, err := nested.produce(proc, val, width)
+			if err != nil {
+				return nil, err
+			}
+			nestedRows = append(nestedRows, rs...)
+		}
+		if len(nestedRows) == 0 {
+			rows = append(rows, base)
+			continue
+		}
+		for _, row := range nestedRows {
+			for j := range base {
+				if base[j].set {
+					row[j] = base[j]
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (c *jsonTableColumn) eval(proc *process.Process, val bytejson.ByteJson, ordinality int) (jsonTableCell, error) {
+	switch c.Type {
+	case tree.JsonTableColumnOrdinality:
+		return jsonTableCell{val: uint32(ordinality), set: true}, nil
+	case tree.JsonTableColumnExists:
+		exists := int64(0)
+		if len(val.Lookup(&c.path)) > 0 {
+			exists = 1
+		}
+		bj, _ := types.ParseStringToByteJson(strconv.FormatInt(exists, 10))
+		v, err := convertJsonTableValue(proc, bj, c.typ)
+		if err != nil {
+			return jsonTableCell{}, err
+		}
+		return jsonTableCell{val: v, set: true}, nil
+	}
+
+	vals := val.Lookup(&c.path)
+	if len(vals) == 0 {
+		return c.respond(proc, c.OnEmpty, moerr.NewInvalidInput(proc.Ctx, "missing value for json_table column '%s'", c.Name))
+	}
+	if len(vals) > 1 {
+		return c.respond(proc, c.OnError, moerr.NewInvalidInput(proc.Ctx, "more than one value for json_table column '%s'", c.Name))
+	}
+	if vals[0].IsNull() && c.typ.Oid != types.T_json {
+		return jsonTableCell{}, nil
+	}
+	v, err := convertJsonTableValue(proc, vals[0], c.typ)
+	if err != nil {
+		return c.respond(proc, c.OnError, err)
+	}
+	return jsonTableCell{val: v, set: true}, nil
+}
+
+// respond returns the value of the ON EMPTY or ON ERROR clause, which is
+// null by default.
+func (c *jsonTableColumn) respond(proc *process.Process, resp *tree.JsonTableResponse, err error) (jsonTableCell, error) {
+	if resp == nil {
+		return jsonTableCell{}, nil
+	}
+	switch resp.Type {
+	case tree.JsonTableResponseError:
+		return jsonTableCell{}, err
+	case tree.JsonTableResponseDefault:
+		bj, err := bytejson.ParseFromString(strconv.Quote(resp.Value))
+		if err != nil {
+			return jsonTableCell{}, err
+		}
+		if c.typ.Oid == types.T_json {
+			// the default value of a json column is a json text
+			if bj, err = bytejson.ParseFromString(resp.Value); err != nil {
+				return jsonTableCell{}, err
+			}
+		}
+		v, err := convertJsonTableValue(proc, bj, c.typ)
+		if err != nil {
+			return jsonTableCell{}, err
+		}
+		return jsonTableCell{val: v, set: true}, nil
+	}
+	return jsonTableCell{}, nil
+}
+
+// convertJsonTableValue converts the json value to the value of the column
+// type, which can be appended by vector.AppendAny.
+func convertJsonTableValue(proc *process.Process, bj bytejson.ByteJson, typ types.Type) (any, error) {
+	if typ.Oid == types.T_json {
+		return bj.Marshal()
+	}
+	if bj.Type == bytejson.TpCodeObject || bj.Type == bytejson.TpCodeArray {
+		if typ.Oid.IsMySQLString() {
+			return []byte(bj.String()), nil
+		}
+		return nil, moerr.NewInvalidInput(proc.Ctx, "can't convert json %s to %s", bj.String(), typ.String())
+	}
+	str := bj.String()
+	if bj.Type == bytejson.TpCodeString {
+		str = string(bj.GetString())
+	}
+	switch typ.Oid {
+	case types.T_char, types.T_varchar:
+		if utf8.RuneCountInString(str) > int(typ.Width) {
+			return nil, moerr.NewDataTruncated(proc.Ctx, "json_table", "value %s is too long for %s", str, typ.String())
+		}
+		return []byte(str), nil
+	case types.T_text:
+		return []byte(str), nil
+	case types.T_bool:
+		switch str {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "can't convert %s to bool", str)
+		}
+		return f != 0, nil
+	case types.T_int8:
+		v, err := parseJsonTableInt(proc, str, 8)
+		return int8(v), err
+	case types.T_int16:
+		v, err := parseJsonTableInt(proc, str, 16)
+		return int16(v), err
+	case types.T_int32:
+		v, err := parseJsonTableInt(proc, str, 32)
+		return int32(v), err
+	case types.T_int64:
+		return parseJsonTableInt(proc, str, 64)
+	case types.T_uint8:
+		v, err := parseJsonTableUint(proc, str, 8)
+		return uint8(v), err
+	case types.T_uint16:
+		v, err := parseJsonTableUint(proc, str, 16)
+		return uint16(v), err
+	case types.T_uint32:
+		v, err := parseJsonTableUint(proc, str, 32)
+		return uint32(v), err
+	case types.T_uint64:
+		return parseJsonTableUint(proc, str, 64)
+	case types.T_float32:
+		v, err := strconv.ParseFloat(str, 32)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "can't convert %s to float", str)
+		}
+		return float32(v), nil
+	case types.T_float64:
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "can't convert %s to double", str)
+		}
+		return v, nil
+	case types.T_decimal64:
+		return types.ParseDecimal64(str, typ.Width, typ.Scale)
+	case types.T_decimal128:
+		return types.ParseDecimal128(str, typ.Width, typ.Scale)
+	case types.T_date:
+		return types.ParseDateCast(str)
+	case types.T_datetime:
+		return types.ParseDatetime(str, typ.Scale)
+	case types.T_timestamp:
+		return types.ParseTimestamp(proc.SessionInfo.TimeZone, str, typ.Scale)
+	}
+	return nil, moerr.NewNotSupported(proc.Ctx, "json_table column of type %s", typ.String())
+}
+
+func parseJsonTableInt(proc *process.Process, str string, bitSize int) (int64, error) {
+	if v, err := strconv.ParseInt(str, 10, bitSize); err == nil {
+		return v, nil
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err == nil {
+		f = math.Round(f)
+		if f >= -math.Ldexp(1, bitSize-1) && f < math.Ldexp(1, bitSize-1) {
+			return int64(f), nil
+		}
+	}
+	return 0, moerr.NewOutOfRange(proc.Ctx, "int"+strconv.Itoa(bitSize), "value '%s'", str)
+}
+
+func parseJsonTableUint(proc *process.Process, str string, bitSize int) (uint64, error) {
+	if v, err := strconv.ParseUint(str, 10, bitSize); err == nil {
+		return v, nil
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err == nil {
+		f = math.Round(f)
+		if f >= 0 && f < math.Ldexp(1, bitSize) {
+			return uint64(f), nil
+		}
+	}
+	return 0, moerr.NewOutOfRange(proc.Ctx, "uint"+strconv.Itoa(bitSize), "value '%s'", str)
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newJsonTableArg(t *testing.T, root *plan2.JsonTablePath, cols []*plan.ColDef) *Argument {
+	param, err := json.Marshal(root)
+	require.NoError(t, err)
+	attrs := make([]string, len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+	}
+	return &Argument{
+		Name:   "json_table",
+		Attrs:  attrs,
+		Rets:   cols,
+		Params: param,
+		Args: []*plan.Expr{{
+			Typ:  &plan.Type{Id: int32(types.T_varchar), Width: 256},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		}},
+	}
+}
+
+func TestJsonTableCall(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	root := &plan2.JsonTablePath{
+		Path: "$",
+		Columns: []plan2.JsonTableColumn{
+			{Name: "ord", Type: tree.JsonTableColumnOrdinality},
+			{Name: "id", Type: tree.JsonTableColumnPath, Path: "$.id"},
+			{Name: "has_tags", Type: tree.JsonTableColumnExists, Path: "$.tags"},
+		},
+		Nested: []*plan2.JsonTablePath{
+			{
+				Path: "$.items[*]",
+				Columns: []plan2.JsonTableColumn{
+					{Name: "item_no", Type: tree.JsonTableColumnOrdinality},
+					{Name: "v", Type: tree.JsonTableColumnPath, Path: "$.v"},
+				},
+			},
+			{
+				Path: "$.tags[*]",
+				Columns: []plan2.JsonTableColumn{
+					{Name: "tag", Type: tree.JsonTableColumnPath, Path: "$",
+						OnError: &tree.JsonTableResponse{Type: tree.JsonTableResponseDefault, Value: "?"}},
+				},
+			},
+		},
+	}
+	cols := []*plan.ColDef{
+		{Name: "ord", Typ: &plan.Type{Id: int32(types.T_uint32)}},
+		{Name: "id", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "has_tags", Typ: &plan.Type{Id: int32(types.T_int32)}},
+		{Name: "item_no", Typ: &plan.Type{Id: int32(types.T_uint32)}},
+		{Name: "v", Typ: &plan.Type{Id: int32(types.T_float64)}},
+		{Name: "tag", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 2}},
+	}
+	arg := newJsonTableArg(t, root, cols)
+	require.NoError(t, Prepare(proc, arg))
+
+	inputBat, err := makeUnnestBatch([]string{
+		`{"id": 1, "items": [{"v": 1.5}, {"v": 2}], "tags": ["a", "long"]}`,
+		`{"id": "x", "items": []}`,
+	}, types.T_varchar, encodeStr, proc)
+	require.NoError(t, err)
+	proc.SetInputBatch(inputBat)
+	end, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.False(t, end)
+
+	rbat := proc.InputBatch()
+	require.Equal(t, 5, rbat.Length())
+	require.Equal(t, []uint32{1, 1, 1, 1, 1}, vector.MustFixedCol[uint32](rbat.Vecs[0]))
+	require.Equal(t, int64(1), vector.MustFixedCol[int64](rbat.Vecs[1])[0])
+	require.True(t, rbat.Vecs[1].GetNulls().Contains(4))
+	require.Equal(t, []int32{1, 1, 1, 1, 0}, vector.MustFixedCol[int32](rbat.Vecs[2]))
+	require.Equal(t, []uint32{1, 2}, vector.MustFixedCol[uint32](rbat.Vecs[3])[:2])
+	require.True(t, rbat.Vecs[3].GetNulls().Contains(2))
+	require.Equal(t, []float64{1.5, 2}, vector.MustFixedCol[float64](rbat.Vecs[4])[:2])
+	require.True(t, rbat.Vecs[4].GetNulls().Contains(4))
+	require.True(t, rbat.Vecs[5].GetNulls().Contains(0))
+	require.Equal(t, "a", rbat.Vecs[5].GetStringAt(2))
+	require.Equal(t, "?", rbat.Vecs[5].GetStringAt(3))
+	require.True(t, rbat.Vecs[5].GetNulls().Contains(4))
+
+	rbat.Clean(proc.Mp())
+	inputBat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
+
+func TestJsonTableResponse(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	root := &plan2.JsonTablePath{
+		Path: "$[*]",
+		Columns: []plan2.JsonTableColumn{
+			{Name: "a", Type: tree.JsonTableColumnPath, Path: "$.a",
+				OnEmpty: &tree.JsonTableResponse{Type: tree.JsonTableResponseDefault, Value: "7"}},
+			{Name: "b", Type: tree.JsonTableColumnPath, Path: "$.b",
+				OnEmpty: &tree.JsonTableResponse{Type: tree.JsonTableResponseError}},
+		},
+	}
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_json)}},
+	}
+
+	arg := newJsonTableArg(t, root, cols)
+	require.NoError(t, Prepare(proc, arg))
+	inputBat, err := makeUnnestBatch([]string{`[{"b": [1]}, {"a": 2, "b": null}]`}, types.T_varchar, encodeStr, proc)
+	require.NoError(t, err)
+	proc.SetInputBatch(inputBat)
+	_, err = Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	rbat := proc.InputBatch()
+	require.Equal(t, []int64{7, 2}, vector.MustFixedCol[int64](rbat.Vecs[0]))
+	require.Equal(t, "[1]", types.DecodeJson(rbat.Vecs[1].GetBytesAt(0)).String())
+	require.Equal(t, "null", types.DecodeJson(rbat.Vecs[1].GetBytesAt(1)).String())
+	rbat.Clean(proc.Mp())
+	inputBat.Clean(proc.Mp())
+	arg.Free(proc, false)
+
+	arg = newJsonTableArg(t, root, cols)
+	require.NoError(t, Prepare(proc, arg))
+	inputBat, err = makeUnnestBatch([]string{`[{"a": 1}]`}, types.T_varchar, encodeStr, proc)
+	require.NoError(t, err)
+	proc.SetInputBatch(inputBat)
+	_, err = Call(0, proc, arg, false, false)
+	require.Error(t, err)
+	inputBat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func tableChangesPrepare(proc *process.Process, arg *Argument) (err error) {
+	arg.ctr = new(container)
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+// tableChanges returns the rows inserted into the table after the timestamp,
+// the arguments are 'db.table' and the timestamp.
+func tableChanges(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+
+	source, err := arg.ctr.executorsForArgs[0].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return false, err
+	}
+	from, err := arg.ctr.executorsForArgs[1].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return false, err
+	}
+	if !source.IsConst() || !from.IsConst() {
+		return false, moerr.NewInvalidInput(proc.Ctx, "table_changes only support scalar")
+	}
+	dbName, tblName, ok := strings.Cut(source.GetStringAt(0), ".")
+	if !ok {
+		return false, moerr.NewInvalidInput(proc.Ctx, "the table of table_changes must be 'db.table'")
+	}
+	ts, err := timestamp.ParseTimestamp(from.GetStringAt(0))
+	if err != nil {
+		return false, moerr.NewInvalidInput(proc.Ctx, "invalid timestamp '%s'", from.GetStringAt(0))
+	}
+
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, dbName, proc.TxnOperator)
+	if err != nil {
+		return false, err
+	}
+	rel, err := db.Relation(proc.Ctx, tblName)
+	if err != nil {
+		return false, err
+	}
+	changes, err := rel.GetChanges(proc.Ctx, ts)
+	if err != nil {
+		return false, err
+	}
+
+	rbat, err := genTableChangesBatch(proc, arg, changes)
+	if err != nil {
+		return false, err
+	}
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// genTableChangesBatch copies the columns of the result from the changes.
+func genTableChangesBatch(proc *process.Process, arg *Argument, changes []*batch.Batch) (*batch.Batch, error) {
+	rbat := batch.New(false, arg.Attrs)
+	rbat.Cnt = 1
+	for i := range arg.Attrs {
+		rbat.Vecs[i] = vector.NewVec(arg.retSchema[i])
+	}
+	rows := 0
+	for _, change := range changes {
+		n := change.Length()
+		for i, attr := range arg.Attrs {
+			idx := -1
+			for j := range change.Attrs {
+				if strings.EqualFold(change.Attrs[j], attr) {
+					idx = j
+					break
+				}
+			}
+			if idx == -1 {
+				rbat.Clean(proc.Mp())
+				return nil, moerr.NewInternalError(proc.Ctx, "column %s not found in the changes", attr)
+			}
+			if err := rbat.Vecs[i].UnionBatch(change.Vecs[idx], 0, n, nil, proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+		rows += n
+	}
+	rbat.SetZs(rows, proc.Mp())
+	return rbat, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenTableChangesBatch(t *testing.T) {
+	proc := testutil.NewProc()
+	arg := &Argument{
+		Attrs:     []string{"b", "a"},
+		retSchema: []types.Type{types.T_varchar.ToType(), types.T_int64.ToType()},
+	}
+
+	newChange := func(a []int64, b []string) *batch.Batch {
+		bat := testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewInt64Vector(len(a), types.T_int64.ToType(), proc.Mp(), false, a),
+			testutil.NewStringVector(len(b), types.T_varchar.ToType(), proc.Mp(), false, b),
+		}, nil)
+		bat.Attrs = []string{"a", "b"}
+		return bat
+	}
+	changes := []*batch.Batch{
+		newChange([]int64{1, 2}, []string{"x", "y"}),
+		newChange([]int64{3}, []string{"z"}),
+	}
+
+	bat, err := genTableChangesBatch(proc, arg, changes)
+	require.NoError(t, err)
+	require.Equal(t, 3, bat.Length())
+	require.Equal(t, []string{"x", "y", "z"}, vector.MustStrCol(bat.Vecs[0]))
+	require.Equal(t, []int64{1, 2, 3}, vector.MustFixedCol[int64](bat.Vecs[1]))
+	bat.Clean(proc.Mp())
+
+	// the column is not in the changes
+	arg.Attrs = []string{"b", "c"}
+	_, err = genTableChangesBatch(proc, arg, changes)
+	require.Error(t, err)
+
+	for _, change := range changes {
+		change.Clean(proc.Mp())
+	}
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
@@ -42,6 +42,10 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		f, e = currentAccountCall(idx, proc, tblArg)
 	case "metadata_scan":
 		f, e = metadataScan(idx, proc, tblArg)
+	case "table_changes":
+		f, e = tableChanges(idx, proc, tblArg)
+	case "json_table":
+		f, e = jsonTableCall(idx, proc, tblArg)
 	default:
 		return true, moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
@@ -86,6 +90,10 @@ func Prepare(proc *process.Process, arg any) error {
 		return currentAccountPrepare(proc, tblArg)
 	case "metadata_scan":
 		return metadataScanPrepare(proc, tblArg)
+	case "table_changes":
+		return tableChangesPrepare(proc, tblArg)
+	case "json_table":
+		return jsonTablePrepare(proc, tblArg)
 	default:
 		return moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
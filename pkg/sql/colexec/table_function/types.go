@@ -34,6 +34,7 @@ type Argument struct {
 
 type container struct {
 	executorsForArgs []colexec.ExpressionExecutor
+	jsonTable        *jsonTablePath
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
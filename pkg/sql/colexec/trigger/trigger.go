@@ -0,0 +1,305 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	pbplan "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.Before {
+		buf.WriteString("before triggers")
+	} else {
+		buf.WriteString("after triggers")
+	}
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	return nil
+}
+
+// Call runs the row-level triggers for the rows of the batch. The BEFORE
+// triggers run before the rows are written and may change their NEW values.
+// The AFTER triggers of a batch run at the next call, after the DML operator
+// behind has written the batch.
+func Call(idx int, proc *process.Process, arg any, _ bool, _ bool) (bool, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	ap := arg.(*Argument)
+	bat := proc.InputBatch()
+	if !ap.Before {
+		if written := ap.ctr.written; written != nil {
+			ap.ctr.written = nil
+			err := runTriggers(proc, ap, written)
+			written.Clean(proc.Mp())
+			if err != nil {
+				return false, err
+			}
+		}
+		if bat == nil {
+			return true, nil
+		}
+		if bat.Length() == 0 {
+			return false, nil
+		}
+		written, err := bat.Dup(proc.Mp())
+		if err != nil {
+			return false, err
+		}
+		ap.ctr.written = written
+		return false, nil
+	}
+
+	if bat == nil {
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		return false, nil
+	}
+	return false, runTriggers(proc, ap, bat)
+}
+
+func runTriggers(proc *process.Process, ap *Argument, bat *batch.Batch) error {
+	if proc.SessionInfo.SqlHelper == nil {
+		return moerr.NewInternalError(proc.Ctx, "triggers can not run without session")
+	}
+	for _, tc := range ap.Ctxs {
+		var triggers []*pbplan.TriggerDef
+		for _, t := range tc.Triggers {
+			if t.Before == ap.Before {
+				triggers = append(triggers, t)
+			}
+		}
+		if len(triggers) == 0 {
+			continue
+		}
+
+		// the new values of the rows changed by the BEFORE triggers, by the
+		// position of the column in the batch
+		changed := make(map[int32]map[int]*vector.Vector)
+		newValues := make([]any, len(tc.ColNames))
+		for row := 0; row < bat.Length(); row++ {
+			vars := make(map[string]any, 2*len(tc.ColNames))
+			for i, name := range tc.ColNames {
+				name = strings.ToLower(name)
+				newValues[i] = nil
+				if pos := tc.NewIdx[i]; pos >= 0 {
+					if v, ok := getValue(proc, bat.Vecs[pos], row); ok {
+						vars["new."+name] = v
+						newValues[i] = v
+					}
+				}
+				if pos := tc.OldIdx[i]; pos >= 0 {
+					if v, ok := getValue(proc, bat.Vecs[pos], row); ok {
+						vars["old."+name] = v
+					}
+				}
+			}
+
+			for _, t := range triggers {
+				if err := proc.SessionInfo.SqlHelper.ExecTrigger(t, vars); err != nil {
+					freeChanged(proc, changed)
+					return err
+				}
+			}
+			if !ap.Before {
+				continue
+			}
+
+			for i, name := range tc.ColNames {
+				pos := tc.NewIdx[i]
+				if pos < 0 {
+					continue
+				}
+				v, ok := vars["new."+strings.ToLower(name)]
+				if !ok || reflect.DeepEqual(v, newValues[i]) {
+					continue
+				}
+				if tc.Readonly[i] {
+					freeChanged(proc, changed)
+					return moerr.NewNYI(proc.Ctx, "changing the key column %s of table %s in BEFORE trigger", name, tc.TableName)
+				}
+				vec, err := makeVector(proc, v, bat.Vecs[pos].GetType())
+				if err != nil {
+					freeChanged(proc, changed)
+					return err
+				}
+				if changed[pos] == nil {
+					changed[pos] = make(map[int]*vector.Vector)
+				}
+				changed[pos][row] = vec
+			}
+		}
+
+		for pos, rows := range changed {
+			if err := replaceRows(proc, bat, pos, rows); err != nil {
+				freeChanged(proc, changed)
+				return err
+			}
+		}
+		freeChanged(proc, changed)
+	}
+	return nil
+}
+
+// getValue returns the value of the row passed to triggers, false if the type
+// is not supported by triggers.
+func getValue(proc *process.Process, vec *vector.Vector, row int) (any, bool) {
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary,
+		types.T_json, types.T_date, types.T_datetime, types.T_time, types.T_timestamp, types.T_uuid:
+	default:
+		return nil, false
+	}
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return nil, true
+	}
+
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, row), true
+	case types.T_int8:
+		return int64(vector.GetFixedAt[int8](vec, row)), true
+	case types.T_int16:
+		return int64(vector.GetFixedAt[int16](vec, row)), true
+	case types.T_int32:
+		return int64(vector.GetFixedAt[int32](vec, row)), true
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, row), true
+	case types.T_uint8:
+		return uint64(vector.GetFixedAt[uint8](vec, row)), true
+	case types.T_uint16:
+		return uint64(vector.GetFixedAt[uint16](vec, row)), true
+	case types.T_uint32:
+		return uint64(vector.GetFixedAt[uint32](vec, row)), true
+	case types.T_uint64:
+		return vector.GetFixedAt[uint64](vec, row), true
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, row)), true
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, row), true
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, row).Format(typ.Scale), true
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, row).Format(typ.Scale), true
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(row)).String(), true
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, row).String(), true
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, row).String2(typ.Scale), true
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, row).String2(typ.Scale), true
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, row).String2(proc.SessionInfo.TimeZone, typ.Scale), true
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, row).ToString(), true
+	default:
+		return vec.GetStringAt(row), true
+	}
+}
+
+// makeVector converts the value set by a trigger to a constant vector of the
+// type of the column.
+func makeVector(proc *process.Process, v any, typ *types.Type) (*vector.Vector, error) {
+	mp := proc.Mp()
+	var src *vector.Vector
+	switch val := v.(type) {
+	case nil:
+		return vector.NewConstNull(*typ, 1, mp), nil
+	case bool:
+		src = vector.NewConstFixed(types.T_bool.ToType(), val, 1, mp)
+	case int64:
+		src = vector.NewConstFixed(types.T_int64.ToType(), val, 1, mp)
+	case uint64:
+		src = vector.NewConstFixed(types.T_uint64.ToType(), val, 1, mp)
+	case float64:
+		src = vector.NewConstFixed(types.T_float64.ToType(), val, 1, mp)
+	case string:
+		src = vector.NewConstBytes(types.T_varchar.ToType(), []byte(val), 1, mp)
+	default:
+		return nil, moerr.NewNYI(proc.Ctx, "value of type %T set by trigger", v)
+	}
+
+	srcTyp := src.GetType()
+	expr, err := plan.ForceCastExpr(proc.Ctx, &pbplan.Expr{
+		Typ: &pbplan.Type{Id: int32(srcTyp.Oid), Width: srcTyp.Width, Scale: srcTyp.Scale},
+		Expr: &pbplan.Expr_Col{
+			Col: &pbplan.ColRef{RelPos: 0, ColPos: 0},
+		},
+	}, &pbplan.Type{Id: int32(typ.Oid), Width: typ.Width, Scale: typ.Scale})
+	if err != nil {
+		src.Free(mp)
+		return nil, err
+	}
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = src
+	bat.InitZsOne(1)
+	vec, err := colexec.EvalExpressionOnce(proc, expr, []*batch.Batch{bat})
+	if vec != src {
+		src.Free(mp)
+	}
+	return vec, err
+}
+
+// replaceRows rebuilds the column at pos of the batch with the rows changed.
+func replaceRows(proc *process.Process, bat *batch.Batch, pos int32, rows map[int]*vector.Vector) error {
+	old := bat.Vecs[pos]
+	vec := vector.NewVec(*old.GetType())
+	for row := 0; row < bat.Length(); row++ {
+		var err error
+		if v, ok := rows[row]; ok {
+			err = vec.UnionOne(v, 0, proc.Mp())
+		} else {
+			err = vec.UnionOne(old, int64(row), proc.Mp())
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return err
+		}
+	}
+	old.Free(proc.Mp())
+	bat.Vecs[pos] = vec
+	return nil
+}
+
+func freeChanged(proc *process.Process, changed map[int32]map[int]*vector.Vector) {
+	for pos, rows := range changed {
+		for _, vec := range rows {
+			vec.Free(proc.Mp())
+		}
+		delete(changed, pos)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSqlHelper runs the triggers by the functions keyed by their names.
+type mockSqlHelper struct {
+	bodies map[string]func(vars map[string]interface{})
+	fired  []string
+}
+
+func (h *mockSqlHelper) ExecSql(string) ([]interface{}, error) {
+	return nil, nil
+}
+
+func (h *mockSqlHelper) ExecTrigger(t *plan.TriggerDef, vars map[string]interface{}) error {
+	h.fired = append(h.fired, t.Name)
+	if body, ok := h.bodies[t.Name]; ok {
+		body(vars)
+	}
+	return nil
+}
+
+func newTriggerCtx(triggers ...*plan.TriggerDef) *plan.TriggerCtx {
+	return &plan.TriggerCtx{
+		DbName:    "db",
+		TableName: "t",
+		Triggers:  triggers,
+		ColNames:  []string{"a", "b"},
+		NewIdx:    []int32{0, 1},
+		OldIdx:    []int32{-1, -1},
+		Readonly:  []bool{true, false},
+	}
+}
+
+func newTestProcess(h *mockSqlHelper) *process.Process {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.SessionInfo.SqlHelper = h
+	return proc
+}
+
+func newBatch(proc *process.Process) *batch.Batch {
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3}),
+		testutil.NewStringVector(3, types.T_varchar.ToType(), proc.Mp(), false, []string{"x", "y", "z"}),
+	}, nil)
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Before: true}, buf)
+	String(&Argument{}, buf)
+	require.Equal(t, "before triggersafter triggers", buf.String())
+}
+
+func TestBeforeTrigger(t *testing.T) {
+	h := &mockSqlHelper{
+		bodies: map[string]func(vars map[string]interface{}){
+			"t1": func(vars map[string]interface{}) {
+				if vars["new.a"].(int64) == 2 {
+					vars["new.b"] = vars["new.b"].(string) + "2"
+				}
+			},
+		},
+	}
+	proc := newTestProcess(h)
+	arg := &Argument{
+		Before: true,
+		Ctxs: []*plan.TriggerCtx{newTriggerCtx(
+			&plan.TriggerDef{Name: "t1", Before: true, Event: "insert"},
+			&plan.TriggerDef{Name: "t2", Before: false, Event: "insert"},
+		)},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	bat := newBatch(proc)
+	proc.SetInputBatch(bat)
+	end, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.False(t, end)
+	require.Equal(t, []string{"t1", "t1", "t1"}, h.fired)
+	require.Equal(t, "x", bat.Vecs[1].GetStringAt(0))
+	require.Equal(t, "y2", bat.Vecs[1].GetStringAt(1))
+	require.Equal(t, "z", bat.Vecs[1].GetStringAt(2))
+
+	// the readonly columns can not be changed
+	h.bodies["t1"] = func(vars map[string]interface{}) {
+		vars["new.a"] = int64(10)
+	}
+	_, err = Call(0, proc, arg, false, false)
+	require.Error(t, err)
+
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+	proc.FreeVectors()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestAfterTrigger(t *testing.T) {
+	var values []interface{}
+	h := &mockSqlHelper{
+		bodies: map[string]func(vars map[string]interface{}){
+			"t2": func(vars map[string]interface{}) {
+				values = append(values, vars["new.a"])
+			},
+		},
+	}
+	proc := newTestProcess(h)
+	arg := &Argument{
+		Ctxs: []*plan.TriggerCtx{newTriggerCtx(
+			&plan.TriggerDef{Name: "t1", Before: true, Event: "insert"},
+			&plan.TriggerDef{Name: "t2", Before: false, Event: "insert"},
+		)},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the AFTER triggers run when the batch has been written
+	bat := newBatch(proc)
+	proc.SetInputBatch(bat)
+	end, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.False(t, end)
+	require.Empty(t, h.fired)
+	bat.Clean(proc.Mp())
+
+	proc.SetInputBatch(nil)
+	end, err = Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Equal(t, []string{"t2", "t2", "t2"}, h.fired)
+	require.Equal(t, []interface{}{int64(1), int64(2), int64(3)}, values)
+
+	arg.Free(proc, false)
+	proc.FreeVectors()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
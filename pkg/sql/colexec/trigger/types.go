@@ -0,0 +1,44 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	// written is the batch passed to the DML operator after the trigger
+	// operator, its AFTER triggers are run at the next call when the rows
+	// have been written.
+	written *batch.Batch
+}
+
+type Argument struct {
+	// Before is true for the BEFORE triggers and false for the AFTER triggers.
+	Before bool
+	// Ctxs are the triggers of the tables changed by the DML operator.
+	Ctxs []*plan.TriggerCtx
+
+	ctr *container
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	if arg.ctr != nil && arg.ctr.written != nil {
+		arg.ctr.written.Clean(proc.Mp())
+		arg.ctr.written = nil
+	}
+}
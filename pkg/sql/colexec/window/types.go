@@ -0,0 +1,87 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	// bat stores all the input rows, which were already sorted by
+	// the partition keys and the order keys.
+	bat *batch.Batch
+
+	isAgg     bool       // the window function is an aggregate function
+	specialId int        // the aggregate id or the window function id
+	retType   types.Type // the result type of the window function
+
+	partitionExecutors []colexec.ExpressionExecutor
+	orderExecutors     []colexec.ExpressionExecutor
+	argExecutors       []colexec.ExpressionExecutor
+
+	// vectors expanded from the const results of the executors,
+	// they should be freed by the container.
+	expanded []*vector.Vector
+}
+
+type Argument struct {
+	ctr     *container
+	WinSpec *plan.WindowSpec
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanBatch(mp)
+		ctr.cleanExecutors()
+		ctr.cleanExpanded(mp)
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanExecutors() {
+	for i := range ctr.partitionExecutors {
+		ctr.partitionExecutors[i].Free()
+	}
+	for i := range ctr.orderExecutors {
+		ctr.orderExecutors[i].Free()
+	}
+	for i := range ctr.argExecutors {
+		ctr.argExecutors[i].Free()
+	}
+	ctr.partitionExecutors = nil
+	ctr.orderExecutors = nil
+	ctr.argExecutors = nil
+}
+
+func (ctr *container) cleanExpanded(mp *mpool.MPool) {
+	for _, vec := range ctr.expanded {
+		vec.Free(mp)
+	}
+	ctr.expanded = nil
+}
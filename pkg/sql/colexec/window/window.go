@@ -0,0 +1,667 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window(")
+	if f, ok := ap.WinSpec.WindowFunc.Expr.(*plan.Expr_F); ok {
+		buf.WriteString(f.F.Func.ObjName)
+	}
+	buf.WriteString(")")
+}
+
+func Prepare(proc *process.Process, arg any) (err error) {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ctr := ap.ctr
+
+	f, ok := ap.WinSpec.WindowFunc.Expr.(*plan.Expr_F)
+	if !ok {
+		return moerr.NewInternalError(proc.Ctx, "window function should be a function expression")
+	}
+	fun, err := function.GetFunctionById(proc.Ctx, int64(uint64(f.F.Func.Obj)&function.DistinctMask))
+	if err != nil {
+		return err
+	}
+	ctr.isAgg = fun.IsAgg()
+	ctr.specialId = fun.GetSpecialId()
+	typ := ap.WinSpec.WindowFunc.Typ
+	ctr.retType = types.New(types.T(typ.Id), typ.Width, typ.Scale)
+
+	ctr.partitionExecutors, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, ap.WinSpec.PartitionBy)
+	if err != nil {
+		return err
+	}
+	ctr.orderExecutors = make([]colexec.ExpressionExecutor, len(ap.WinSpec.OrderBy))
+	for i, o := range ap.WinSpec.OrderBy {
+		ctr.orderExecutors[i], err = colexec.NewExpressionExecutor(proc, o.Expr)
+		if err != nil {
+			return err
+		}
+	}
+	ctr.argExecutors, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, f.F.Args)
+	return err
+}
+
+// Call buffers all the input rows, and evaluates the window function over them
+// once the input is exhausted. The result vector is appended to the end of the input columns.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		if ctr.bat == nil {
+			proc.SetInputBatch(nil)
+			ap.Free(proc, false)
+			return true, nil
+		}
+		if err := ctr.eval(ap, proc, anal); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		anal.Output(ctr.bat, isLast)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		ap.Free(proc, false)
+		return true, nil
+	}
+
+	if bat.Length() == 0 {
+		bat.Clean(proc.Mp())
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+	proc.SetInputBatch(&batch.Batch{})
+	if err := ctr.appendBatch(bat, proc); err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	anal.Alloc(int64(bat.Size()))
+	return false, nil
+}
+
+func (ctr *container) appendBatch(bat *batch.Batch, proc *process.Process) (err error) {
+	defer proc.PutBatch(bat)
+	if ctr.bat == nil {
+		ctr.bat, err = bat.Dup(proc.Mp())
+		return err
+	}
+	ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+	return err
+}
+
+func (ctr *container) eval(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	if err := ctr.expandRows(proc); err != nil {
+		return err
+	}
+	n := ctr.bat.Length()
+
+	partitionVecs, err := ctr.evalVectors(proc, ctr.partitionExecutors, n)
+	if err != nil {
+		return err
+	}
+	orderVecs, err := ctr.evalVectors(proc, ctr.orderExecutors, n)
+	if err != nil {
+		return err
+	}
+	argVecs, err := ctr.evalVectors(proc, ctr.argExecutors, n)
+	if err != nil {
+		return err
+	}
+
+	parts, err := partitionBounds(proc, partitionVecs, n)
+	if err != nil {
+		return err
+	}
+	peerStarts, peerEnds, err := peerBounds(proc, orderVecs, parts)
+	if err != nil {
+		return err
+	}
+
+	var vec *vector.Vector
+	switch {
+	case ctr.isAgg:
+		starts, ends, err := frameBounds(ap.WinSpec, orderVecs, parts, peerStarts, peerEnds)
+		if err != nil {
+			return err
+		}
+		vec, err = ctr.evalAggregate(proc, argVecs, starts, ends)
+		if err != nil {
+			return err
+		}
+	case ctr.specialId == agg.WinFirstValue || ctr.specialId == agg.WinLastValue || ctr.specialId == agg.WinNthValue:
+		starts, ends, err := frameBounds(ap.WinSpec, orderVecs, parts, peerStarts, peerEnds)
+		if err != nil {
+			return err
+		}
+		vec, err = ctr.evalFrameValue(proc, argVecs, starts, ends)
+		if err != nil {
+			return err
+		}
+	case ctr.specialId == agg.WinLag || ctr.specialId == agg.WinLead:
+		vec, err = ctr.evalOffsetValue(proc, argVecs, parts)
+		if err != nil {
+			return err
+		}
+	default:
+		vec, err = ctr.evalRanking(proc, argVecs, parts, peerStarts, peerEnds)
+		if err != nil {
+			return err
+		}
+	}
+	anal.Alloc(int64(vec.Size()))
+	ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+	return nil
+}
+
+// expandRows makes sure that each row of the buffered batch stands for exactly one row,
+// rows whose count is larger than one will be copied.
+func (ctr *container) expandRows(proc *process.Process) error {
+	bat := ctr.bat
+	needExpand := false
+	for _, z := range bat.Zs {
+		if z != 1 {
+			needExpand = true
+			break
+		}
+	}
+	if !needExpand {
+		return nil
+	}
+	sels := make([]int64, 0, len(bat.Zs))
+	for i, z := range bat.Zs {
+		for j := int64(0); j < z; j++ {
+			sels = append(sels, int64(i))
+		}
+	}
+	if err := bat.Shuffle(sels, proc.Mp()); err != nil {
+		return err
+	}
+	bat.InitZsOne(len(sels))
+	return nil
+}
+
+// evalVectors evaluates the expressions over the buffered rows.
+// const results are expanded to make each row accessible by its index.
+func (ctr *container) evalVectors(proc *process.Process, executors []colexec.ExpressionExecutor, n int) ([]*vector.Vector, error) {
+	vecs := make([]*vector.Vector, len(executors))
+	for i := range executors {
+		vec, err := executors[i].Eval(proc, []*batch.Batch{ctr.bat})
+		if err != nil {
+			return nil, err
+		}
+		if vec.IsConst() {
+			nv := vector.NewVec(*vec.GetType())
+			ctr.expanded = append(ctr.expanded, nv)
+			if err = nv.UnionMulti(vec, 0, n, proc.Mp()); err != nil {
+				return nil, err
+			}
+			vec = nv
+		}
+		vecs[i] = vec
+	}
+	return vecs, nil
+}
+
+func newEqualCompares(proc *process.Process, vecs []*vector.Vector) ([]compare.Compare, error) {
+	cmps := make([]compare.Compare, len(vecs))
+	for i, vec := range vecs {
+		cmps[i] = compare.New(*vec.GetType(), false, false)
+		if cmps[i] == nil {
+			return nil, moerr.NewNYI(proc.Ctx, "window partition or order by type '%s'", vec.GetType())
+		}
+		cmps[i].Set(0, vec)
+	}
+	return cmps, nil
+}
+
+func rowsEqual(cmps []compare.Compare, i, j int) bool {
+	for _, cmp := range cmps {
+		if cmp.Compare(0, 0, int64(i), int64(j)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// partitionBounds returns the start row of each partition, followed by the row count.
+func partitionBounds(proc *process.Process, vecs []*vector.Vector, n int) ([]int, error) {
+	cmps, err := newEqualCompares(proc, vecs)
+	if err != nil {
+		return nil, err
+	}
+	parts := []int{0}
+	for i := 1; i < n; i++ {
+		if !rowsEqual(cmps, i-1, i) {
+			parts = append(parts, i)
+		}
+	}
+	return append(parts, n), nil
+}
+
+// peerBounds returns the first row and the end row (exclusive) of the peer group of each row.
+// rows are peers if they are in the same partition and equal on all the order keys.
+func peerBounds(proc *process.Process, vecs []*vector.Vector, parts []int) ([]int, []int, error) {
+	cmps, err := newEqualCompares(proc, vecs)
+	if err != nil {
+		return nil, nil, err
+	}
+	n := parts[len(parts)-1]
+	starts, ends := make([]int, n), make([]int, n)
+	for p := 0; p+1 < len(parts); p++ {
+		ps, pe := parts[p], parts[p+1]
+		s := ps
+		for i := ps; i < pe; i++ {
+			if i > ps && !rowsEqual(cmps, i-1, i) {
+				s = i
+			}
+			starts[i] = s
+		}
+		e := pe
+		for i := pe - 1; i >= ps; i-- {
+			ends[i] = e
+			if starts[i] == i {
+				e = i
+			}
+		}
+	}
+	return starts, ends, nil
+}
+
+// frameBounds returns the first row and the end row (exclusive) of the frame of each row.
+func frameBounds(spec *plan.WindowSpec, orderVecs []*vector.Vector, parts, peerStarts, peerEnds []int) ([]int, []int, error) {
+	frame := spec.Frame
+	n := parts[len(parts)-1]
+	starts, ends := make([]int, n), make([]int, n)
+
+	// for the RANGE frame with an offset, the keys are converted to float64,
+	// and negated for the descending order, to make them ascending in each partition.
+	var keys []float64
+	if frame.Type == plan.FrameClause_RANGE && (hasOffset(frame.Start) || hasOffset(frame.End)) {
+		var err error
+		if keys, err = rangeKeys(orderVecs[0]); err != nil {
+			return nil, nil, err
+		}
+		if spec.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0 {
+			for i := range keys {
+				keys[i] = -keys[i]
+			}
+		}
+	}
+
+	for p := 0; p+1 < len(parts); p++ {
+		b := boundContext{
+			frameType:  frame.Type,
+			keys:       keys,
+			peerStarts: peerStarts,
+			peerEnds:   peerEnds,
+			ps:         parts[p],
+			pe:         parts[p+1],
+		}
+		if keys != nil {
+			// null keys are contiguous at the head or the tail of the partition.
+			b.nulls = orderVecs[0].GetNulls()
+			b.nnStart, b.nnEnd = b.ps, b.pe
+			for b.nnStart < b.pe && b.nulls.Contains(uint64(b.nnStart)) {
+				b.nnStart++
+			}
+			for b.nnEnd > b.nnStart && b.nulls.Contains(uint64(b.nnEnd-1)) {
+				b.nnEnd--
+			}
+		}
+		for i := b.ps; i < b.pe; i++ {
+			starts[i] = b.bound(frame.Start, i, true)
+			ends[i] = b.bound(frame.End, i, false)
+			if ends[i] < starts[i] {
+				ends[i] = starts[i]
+			}
+		}
+	}
+	return starts, ends, nil
+}
+
+func hasOffset(b *plan.FrameBound) bool {
+	return b.Type == plan.FrameBound_PRECEDING || b.Type == plan.FrameBound_FOLLOWING
+}
+
+type boundContext struct {
+	frameType  plan.FrameClause_FrameType
+	peerStarts []int
+	peerEnds   []int
+	ps, pe     int // the partition is [ps, pe)
+
+	// used by the RANGE frame with an offset only.
+	keys           []float64
+	nulls          *nulls.Nulls
+	nnStart, nnEnd int // rows with a not-null key are [nnStart, nnEnd)
+}
+
+// bound returns the row position of the frame bound for row i.
+// the end bound is exclusive.
+func (b *boundContext) bound(fb *plan.FrameBound, i int, isStart bool) int {
+	switch fb.Type {
+	case plan.FrameBound_UNBOUNDED_PRECEDING:
+		return b.ps
+	case plan.FrameBound_UNBOUNDED_FOLLOWING:
+		return b.pe
+	case plan.FrameBound_CURRENT_ROW:
+		if b.frameType == plan.FrameClause_ROWS {
+			if isStart {
+				return i
+			}
+			return i + 1
+		}
+		if isStart {
+			return b.peerStarts[i]
+		}
+		return b.peerEnds[i]
+	}
+
+	c := fb.Val.Expr.(*plan.Expr_C).C
+	if b.frameType == plan.FrameClause_ROWS {
+		offset := c.Value.(*plan.Const_I64Val).I64Val
+		pos := int64(i)
+		if fb.Type == plan.FrameBound_PRECEDING {
+			pos -= offset
+		} else {
+			pos += offset
+		}
+		if !isStart {
+			pos++
+		}
+		if pos < int64(b.ps) {
+			return b.ps
+		}
+		if pos > int64(b.pe) {
+			return b.pe
+		}
+		return int(pos)
+	}
+
+	// rows with a null key are only peers of each other.
+	if b.nulls.Contains(uint64(i)) {
+		if isStart {
+			return b.peerStarts[i]
+		}
+		return b.peerEnds[i]
+	}
+	target := b.keys[i]
+	if fb.Type == plan.FrameBound_PRECEDING {
+		target -= c.Value.(*plan.Const_Dval).Dval
+	} else {
+		target += c.Value.(*plan.Const_Dval).Dval
+	}
+	keys := b.keys[b.nnStart:b.nnEnd]
+	if isStart {
+		return b.nnStart + sort.Search(len(keys), func(k int) bool { return keys[k] >= target })
+	}
+	return b.nnStart + sort.Search(len(keys), func(k int) bool { return keys[k] > target })
+}
+
+func rangeKeys(vec *vector.Vector) ([]float64, error) {
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		return toFloat64s(vector.MustFixedCol[int8](vec)), nil
+	case types.T_int16:
+		return toFloat64s(vector.MustFixedCol[int16](vec)), nil
+	case types.T_int32:
+		return toFloat64s(vector.MustFixedCol[int32](vec)), nil
+	case types.T_int64:
+		return toFloat64s(vector.MustFixedCol[int64](vec)), nil
+	case types.T_uint8:
+		return toFloat64s(vector.MustFixedCol[uint8](vec)), nil
+	case types.T_uint16:
+		return toFloat64s(vector.MustFixedCol[uint16](vec)), nil
+	case types.T_uint32:
+		return toFloat64s(vector.MustFixedCol[uint32](vec)), nil
+	case types.T_uint64:
+		return toFloat64s(vector.MustFixedCol[uint64](vec)), nil
+	case types.T_float32:
+		return toFloat64s(vector.MustFixedCol[float32](vec)), nil
+	case types.T_float64:
+		return toFloat64s(vector.MustFixedCol[float64](vec)), nil
+	case types.T_decimal64:
+		col := vector.MustFixedCol[types.Decimal64](vec)
+		keys := make([]float64, len(col))
+		for i := range col {
+			keys[i] = types.Decimal64ToFloat64(col[i], vec.GetType().Scale)
+		}
+		return keys, nil
+	case types.T_decimal128:
+		col := vector.MustFixedCol[types.Decimal128](vec)
+		keys := make([]float64, len(col))
+		for i := range col {
+			keys[i] = types.Decimal128ToFloat64(col[i], vec.GetType().Scale)
+		}
+		return keys, nil
+	}
+	return nil, moerr.NewNYINoCtx("RANGE frame offset with ORDER BY type '%s'", vec.GetType())
+}
+
+func toFloat64s[T types.Ints | types.UInts | types.Floats](col []T) []float64 {
+	keys := make([]float64, len(col))
+	for i := range col {
+		keys[i] = float64(col[i])
+	}
+	return keys
+}
+
+// evalAggregate evaluates the aggregate function over the frame of each row.
+// if the frame of a row shares the start row with the previous one and does not shrink,
+// the result of the previous row will be reused.
+func (ctr *container) evalAggregate(proc *process.Process, argVecs []*vector.Vector, starts, ends []int) (*vector.Vector, error) {
+	ag, err := agg.New(ctr.specialId, false, *argVecs[0].GetType())
+	if err != nil {
+		return nil, err
+	}
+	if err = ag.Grows(len(starts), proc.Mp()); err != nil {
+		ag.Free(proc.Mp())
+		return nil, err
+	}
+	for i := range starts {
+		from := starts[i]
+		if i > 0 && starts[i] == starts[i-1] && ends[i] >= ends[i-1] {
+			if err = ag.Merge(ag, int64(i), int64(i-1)); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+			from = ends[i-1]
+		}
+		for j := from; j < ends[i]; j++ {
+			if err = ag.Fill(int64(i), int64(j), 1, argVecs); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	return ag.Eval(proc.Mp())
+}
+
+// evalFrameValue evaluates first_value, last_value and nth_value.
+func (ctr *container) evalFrameValue(proc *process.Process, argVecs []*vector.Vector, starts, ends []int) (*vector.Vector, error) {
+	vec := vector.NewVec(ctr.retType)
+	nullVec := vector.NewConstNull(ctr.retType, 1, proc.Mp())
+	defer nullVec.Free(proc.Mp())
+
+	for i := range starts {
+		var pos int
+		switch ctr.specialId {
+		case agg.WinFirstValue:
+			pos = starts[i]
+		case agg.WinLastValue:
+			pos = ends[i] - 1
+		case agg.WinNthValue:
+			if argVecs[1].GetNulls().Contains(uint64(i)) {
+				vec.Free(proc.Mp())
+				return nil, moerr.NewInvalidArg(proc.Ctx, "nth_value", "null")
+			}
+			nth := vector.MustFixedCol[int64](argVecs[1])[i]
+			if nth <= 0 {
+				vec.Free(proc.Mp())
+				return nil, moerr.NewInvalidArg(proc.Ctx, "nth_value", nth)
+			}
+			pos = starts[i] + int(nth) - 1
+		}
+
+		var err error
+		if starts[i] < ends[i] && pos >= starts[i] && pos < ends[i] {
+			err = vec.UnionOne(argVecs[0], int64(pos), proc.Mp())
+		} else {
+			err = vec.UnionOne(nullVec, 0, proc.Mp())
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// evalOffsetValue evaluates lag and lead.
+func (ctr *container) evalOffsetValue(proc *process.Process, argVecs []*vector.Vector, parts []int) (*vector.Vector, error) {
+	vec := vector.NewVec(ctr.retType)
+	nullVec := vector.NewConstNull(ctr.retType, 1, proc.Mp())
+	defer nullVec.Free(proc.Mp())
+
+	name := agg.WinNames[ctr.specialId]
+	for p := 0; p+1 < len(parts); p++ {
+		ps, pe := parts[p], parts[p+1]
+		for i := ps; i < pe; i++ {
+			offset := int64(1)
+			if len(argVecs) > 1 {
+				if argVecs[1].GetNulls().Contains(uint64(i)) {
+					vec.Free(proc.Mp())
+					return nil, moerr.NewInvalidArg(proc.Ctx, name+" offset", "null")
+				}
+				offset = vector.MustFixedCol[int64](argVecs[1])[i]
+				if offset < 0 {
+					vec.Free(proc.Mp())
+					return nil, moerr.NewInvalidArg(proc.Ctx, name+" offset", offset)
+				}
+			}
+			pos := int64(i) - offset
+			if ctr.specialId == agg.WinLead {
+				pos = int64(i) + offset
+			}
+
+			var err error
+			switch {
+			case pos >= int64(ps) && pos < int64(pe):
+				err = vec.UnionOne(argVecs[0], pos, proc.Mp())
+			case len(argVecs) > 2:
+				err = vec.UnionOne(argVecs[2], int64(i), proc.Mp())
+			default:
+				err = vec.UnionOne(nullVec, 0, proc.Mp())
+			}
+			if err != nil {
+				vec.Free(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	return vec, nil
+}
+
+// evalRanking evaluates row_number, rank, dense_rank, percent_rank, cume_dist and ntile.
+func (ctr *container) evalRanking(proc *process.Process, argVecs []*vector.Vector, parts, peerStarts, peerEnds []int) (*vector.Vector, error) {
+	n := parts[len(parts)-1]
+	vec := vector.NewVec(ctr.retType)
+
+	if ctr.specialId == agg.WinPercentRank || ctr.specialId == agg.WinCumeDist {
+		rs := make([]float64, n)
+		for p := 0; p+1 < len(parts); p++ {
+			ps, pe := parts[p], parts[p+1]
+			for i := ps; i < pe; i++ {
+				if ctr.specialId == agg.WinCumeDist {
+					rs[i] = float64(peerEnds[i]-ps) / float64(pe-ps)
+				} else if pe-ps > 1 {
+					rs[i] = float64(peerStarts[i]-ps) / float64(pe-ps-1)
+				}
+			}
+		}
+		if err := vector.AppendFixedList(vec, rs, nil, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+		return vec, nil
+	}
+
+	rs := make([]int64, n)
+	for p := 0; p+1 < len(parts); p++ {
+		ps, pe := parts[p], parts[p+1]
+		denseRank := int64(0)
+		for i := ps; i < pe; i++ {
+			switch ctr.specialId {
+			case agg.WinRowNumber:
+				rs[i] = int64(i - ps + 1)
+			case agg.WinRank:
+				rs[i] = int64(peerStarts[i] - ps + 1)
+			case agg.WinDenseRank:
+				if peerStarts[i] == i {
+					denseRank++
+				}
+				rs[i] = denseRank
+			case agg.WinNtile:
+				if argVecs[0].GetNulls().Contains(uint64(i)) {
+					vec.Free(proc.Mp())
+					return nil, moerr.NewInvalidArg(proc.Ctx, "ntile", "null")
+				}
+				buckets := vector.MustFixedCol[int64](argVecs[0])[i]
+				if buckets <= 0 {
+					vec.Free(proc.Mp())
+					return nil, moerr.NewInvalidArg(proc.Ctx, "ntile", buckets)
+				}
+				rs[i] = ntile(int64(i-ps), int64(pe-ps), buckets)
+			}
+		}
+	}
+	if err := vector.AppendFixedList(vec, rs, nil, proc.Mp()); err != nil {
+		vec.Free(proc.Mp())
+		return nil, err
+	}
+	return vec, nil
+}
+
+// ntile returns the bucket number of the k-th row (0-based) in a partition with size rows.
+// the first size % buckets buckets have one more row than the others.
+func ntile(k, size, buckets int64) int64 {
+	base, rem := size/buckets, size%buckets
+	if k < rem*(base+1) {
+		return k/(base+1) + 1
+	}
+	return rem + (k-rem*(base+1))/base + 1
+}
@@ -0,0 +1,217 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// the input rows, already sorted by the partition key a and the order key b.
+var (
+	colA = []int64{1, 1, 1, 1, 2, 2}
+	colB = []int64{1, 2, 2, 3, 5, 7}
+)
+
+// add unit tests for cases
+type windowTestCase struct {
+	name     string
+	args     []*plan.Expr
+	frame    *plan.FrameClause
+	expected []int64
+	nulls    []uint64
+}
+
+var (
+	defaultFrame = &plan.FrameClause{
+		Type:  plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+		End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+	}
+
+	tcs = []windowTestCase{
+		{name: "row_number", frame: defaultFrame, expected: []int64{1, 2, 3, 4, 1, 2}},
+		{name: "rank", frame: defaultFrame, expected: []int64{1, 2, 2, 4, 1, 2}},
+		{name: "dense_rank", frame: defaultFrame, expected: []int64{1, 2, 2, 3, 1, 2}},
+		{name: "ntile", args: []*plan.Expr{newInt64Const(2)}, frame: defaultFrame, expected: []int64{1, 1, 2, 2, 1, 2}},
+		{name: "lag", args: []*plan.Expr{newColumn(1), newInt64Const(1), newInt64Const(0)}, frame: defaultFrame, expected: []int64{0, 1, 2, 2, 0, 5}},
+		{name: "lead", args: []*plan.Expr{newColumn(1)}, frame: defaultFrame, expected: []int64{2, 2, 3, 0, 7, 0}, nulls: []uint64{3, 5}},
+		{name: "sum", args: []*plan.Expr{newColumn(1)}, frame: defaultFrame, expected: []int64{1, 5, 5, 8, 5, 12}},
+		{name: "sum", args: []*plan.Expr{newColumn(1)}, frame: &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newInt64Const(1)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newInt64Const(1)},
+		}, expected: []int64{3, 5, 7, 5, 12, 12}},
+		{name: "sum", args: []*plan.Expr{newColumn(1)}, frame: &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newFloat64Const(1)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, expected: []int64{1, 5, 5, 7, 5, 7}},
+		{name: "first_value", args: []*plan.Expr{newColumn(1)}, frame: &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newInt64Const(1)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, expected: []int64{1, 1, 2, 2, 5, 5}},
+		{name: "last_value", args: []*plan.Expr{newColumn(1)}, frame: defaultFrame, expected: []int64{1, 2, 2, 3, 5, 7}},
+		{name: "nth_value", args: []*plan.Expr{newColumn(1), newInt64Const(2)}, frame: defaultFrame, expected: []int64{0, 2, 2, 2, 0, 7}, nulls: []uint64{0, 4}},
+	}
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(newArgument(t, tc), buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	for _, tc := range tcs {
+		err := Prepare(proc, newArgument(t, tc))
+		require.NoError(t, err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	for _, tc := range tcs {
+		proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+		arg := newArgument(t, tc)
+		require.NoError(t, Prepare(proc, arg))
+
+		proc.Reg.InputBatch = newBatch(proc, 0, 4)
+		end, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		require.False(t, end)
+		proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		proc.Reg.InputBatch = newBatch(proc, 4, 6)
+		_, err = Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		proc.Reg.InputBatch = nil
+		end, err = Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+
+		bat := proc.Reg.InputBatch
+		require.Equal(t, 3, len(bat.Vecs), tc.name)
+		vec := bat.Vecs[2]
+		require.Equal(t, len(tc.expected), vec.Length(), tc.name)
+		for i, v := range tc.expected {
+			if vec.GetNulls().Contains(uint64(i)) {
+				require.Contains(t, tc.nulls, uint64(i), tc.name)
+				continue
+			}
+			require.NotContains(t, tc.nulls, uint64(i), tc.name)
+			require.Equal(t, v, vector.MustFixedCol[int64](vec)[i], tc.name)
+		}
+		bat.Clean(proc.Mp())
+		proc.FreeVectors()
+		require.Equal(t, int64(0), proc.Mp().CurrNB(), tc.name)
+	}
+}
+
+func TestNtile(t *testing.T) {
+	// 7 rows into 3 buckets: 3, 2, 2
+	expected := []int64{1, 1, 1, 2, 2, 3, 3}
+	for k, v := range expected {
+		require.Equal(t, v, ntile(int64(k), 7, 3))
+	}
+	// more buckets than rows
+	for k := int64(0); k < 3; k++ {
+		require.Equal(t, k+1, ntile(k, 3, 5))
+	}
+}
+
+func newArgument(t *testing.T, tc windowTestCase) *Argument {
+	typs := make([]types.Type, len(tc.args))
+	for i, arg := range tc.args {
+		typs[i] = types.T(arg.Typ.Id).ToType()
+	}
+	fr, err := function.GetFunctionByName(context.TODO(), tc.name, typs)
+	require.NoError(t, err)
+	retType := fr.GetReturnType()
+	return &Argument{
+		WinSpec: &plan.WindowSpec{
+			WindowFunc: &plan.Expr{
+				Expr: &plan.Expr_F{
+					F: &plan.Function{
+						Func: &plan.ObjectRef{Obj: fr.GetEncodedOverloadID(), ObjName: tc.name},
+						Args: tc.args,
+					},
+				},
+				Typ: &plan.Type{Id: int32(retType.Oid)},
+			},
+			PartitionBy: []*plan.Expr{newColumn(0)},
+			OrderBy:     []*plan.OrderBySpec{{Expr: newColumn(1)}},
+			Frame:       tc.frame,
+		},
+	}
+}
+
+func newColumn(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+		Typ: &plan.Type{
+			Id: int32(types.T_int64),
+		},
+	}
+}
+
+func newInt64Const(v int64) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_I64Val{I64Val: v}},
+		},
+		Typ: &plan.Type{
+			Id: int32(types.T_int64),
+		},
+	}
+}
+
+func newFloat64Const(v float64) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_Dval{Dval: v}},
+		},
+		Typ: &plan.Type{
+			Id: int32(types.T_float64),
+		},
+	}
+}
+
+// create a new batch with the rows [start, end) of the test data
+func newBatch(proc *process.Process, start, end int) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = testutil.NewInt64Vector(end-start, types.T_int64.ToType(), proc.Mp(), false, colA[start:end])
+	bat.Vecs[1] = testutil.NewInt64Vector(end-start, types.T_int64.ToType(), proc.Mp(), false, colB[start:end])
+	bat.InitZsOne(end - start)
+	return bat
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
@@ -53,6 +54,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -136,6 +138,7 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, u any, fill func(a
 	// generate logic pipeline for query.
 	c.scope, err = c.compileScope(ctx, pn)
 	if err != nil {
+		c.closeSnapshotTxns()
 		return err
 	}
 	for _, s := range c.scope {
@@ -264,6 +267,7 @@ func (c *Compile) Run(_ uint64) error {
 	go func() {
 		wg.Wait()
 		c.scope = nil
+		c.closeSnapshotTxns()
 		close(errC)
 	}()
 	for e := range errC {
@@ -543,6 +547,36 @@ func IsSingleDelete(delCtx *deletion.DeleteCtx) bool {
 	return true
 }
 
+// appendIndexDeleteInstruction appends the operator deleting the rows of the
+// index tables keyed by the primary key of the rows deleted or updated by the
+// node, it goes first to pass every row once to the operators behind.
+func (c *Compile) appendIndexDeleteInstruction(rs *Scope, n *plan.Node) error {
+	arg, err := constructIndexDelete(n, c.e, c.proc)
+	if err != nil || arg == nil {
+		return err
+	}
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.IndexDelete,
+		Idx: c.anal.curr,
+		Arg: arg,
+	})
+	return nil
+}
+
+// appendTriggerInstructions appends the operators running the BEFORE and AFTER
+// triggers of the rows deleted or updated by the node.
+func (c *Compile) appendTriggerInstructions(rs *Scope, n *plan.Node) {
+	for _, before := range []bool{true, false} {
+		if arg := constructTrigger(n, before); arg != nil {
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.Trigger,
+				Idx: c.anal.curr,
+				Arg: arg,
+			})
+		}
+	}
+}
+
 func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 	var rs *Scope
 	switch qry.StmtType {
@@ -557,7 +591,8 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		if err != nil {
 			return nil, err
 		}
-		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) && IsSingleDelete(arg.DeleteCtx) && !arg.DeleteCtx.CanTruncate {
+		// the triggers run in the session, so the deletion can not be distributed
+		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) && IsSingleDelete(arg.DeleteCtx) && !arg.DeleteCtx.CanTruncate && len(deleteNode.TriggerCtxs) == 0 && len(deleteNode.DeleteCtx.PkIdxRef) == 0 {
 			rs = c.newDeleteMergeScope(arg, ss)
 			rs.Instructions = append(rs.Instructions, vm.Instruction{
 				Op: vm.MergeDelete,
@@ -574,6 +609,10 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 			if err != nil {
 				return nil, err
 			}
+			if err = c.appendIndexDeleteInstruction(rs, deleteNode); err != nil {
+				return nil, err
+			}
+			c.appendTriggerInstructions(rs, deleteNode)
 			rs.Instructions = append(rs.Instructions, vm.Instruction{
 				Op:  vm.Deletion,
 				Arg: arg,
@@ -594,7 +633,7 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		}
 		nodeStats := qry.Nodes[insertNode.Children[0]].Stats
 
-		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag {
+		if (nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag) && len(insertNode.TriggerCtxs) == 0 {
 			// use distributed-insert
 			arg.IsRemote = true
 			for _, scope := range ss {
@@ -627,17 +666,32 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 					Arg: onDuplicateKeyArg,
 				})
 			}
+			if beforeArg := constructTrigger(insertNode, true); beforeArg != nil {
+				rs.Instructions = append(rs.Instructions, vm.Instruction{
+					Op:  vm.Trigger,
+					Idx: c.anal.curr,
+					Arg: beforeArg,
+				})
+			}
 			rs.Instructions = append(rs.Instructions, vm.Instruction{
 				Op:  vm.PreInsert,
 				Arg: preArg,
 			})
+			if afterArg := constructTrigger(insertNode, false); afterArg != nil {
+				rs.Instructions = append(rs.Instructions, vm.Instruction{
+					Op:  vm.Trigger,
+					Idx: c.anal.curr,
+					Arg: afterArg,
+				})
+			}
 			rs.Instructions = append(rs.Instructions, vm.Instruction{
 				Op:  vm.Insert,
 				Arg: arg,
 			})
 		}
 	case plan.Query_UPDATE:
-		scp, err := constructUpdate(qry.Nodes[qry.Steps[0]], c.e, c.proc)
+		updateNode := qry.Nodes[qry.Steps[0]]
+		scp, err := constructUpdate(updateNode, c.e, c.proc)
 		if err != nil {
 			return nil, err
 		}
@@ -645,6 +699,10 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		updateScopesLastFlag([]*Scope{rs})
 		rs.Magic = Update
 		c.setAnalyzeCurrent([]*Scope{rs}, c.anal.curr)
+		if err = c.appendIndexDeleteInstruction(rs, updateNode); err != nil {
+			return nil, err
+		}
+		c.appendTriggerInstructions(rs, updateNode)
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  vm.Update,
 			Arg: scp,
@@ -732,6 +790,15 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 		}
 		c.setAnalyzeCurrent(ss, curr)
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_LOCK_OP:
+		curr := c.anal.curr
+		c.setAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.setAnalyzeCurrent(ss, curr)
+		return c.compileSort(n, c.compileProjection(n, c.compileLock(n, ss))), nil
 	case plan.Node_AGG:
 		curr := c.anal.curr
 		c.setAnalyzeCurrent(nil, int(n.Children[0]))
@@ -772,6 +839,37 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 		}
 		c.setAnalyzeCurrent(ss, curr)
 		return c.compileProjection(n, c.compileRestrict(n, c.compileSort(n, ss))), nil
+	case plan.Node_WINDOW:
+		curr := c.anal.curr
+		c.setAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.setAnalyzeCurrent(ss, curr)
+		return c.compileProjection(n, c.compileWindow(n, c.compileSort(n, ss))), nil
+	case plan.Node_RECURSIVE_CTE:
+		curr := c.anal.curr
+		c.setAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.setAnalyzeCurrent(ss, curr)
+		return c.compileRecursiveCte(ctx, step, n, ss, ns), nil
+	case plan.Node_RECURSIVE_SCAN:
+		bat, ok := c.workTables[n.NodeId]
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "no working table for recursive scan")
+		}
+		delete(c.workTables, n.NodeId)
+		ds := &Scope{
+			Magic:      Normal,
+			DataSource: &Source{Bat: bat},
+			NodeInfo:   engine.Node{Addr: c.addr, Mcpu: 1},
+			Proc:       process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes()),
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
 	case plan.Node_UNION:
 		curr := c.anal.curr
 		c.setAnalyzeCurrent(nil, int(n.Children[0]))
@@ -943,6 +1041,9 @@ func (c *Compile) compileExternScan(ctx context.Context, n *plan.Node) ([]*Scope
 		}
 	}
 
+	if param.Format == tree.PARQUET {
+		param.Parallel = false
+	}
 	param.FileService = c.proc.FileService
 	param.Ctx = c.ctx
 	var fileList []string
@@ -1103,8 +1204,12 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	for j, col := range n.TableDef.Cols {
 		attrs[j] = col.Name
 	}
-	if c.proc != nil && c.proc.TxnOperator != nil {
-		ts = c.proc.TxnOperator.Txn().SnapshotTS
+	txnOp, err := c.getScanTxnOperator(n)
+	if err != nil {
+		panic(err)
+	}
+	if txnOp != nil {
+		ts = txnOp.Txn().SnapshotTS
 	}
 	{
 		var cols []*plan.ColDef
@@ -1115,14 +1220,14 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 		if n.ObjRef.PubAccountId != -1 {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(n.ObjRef.PubAccountId))
 		}
-		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 		if err != nil {
 			panic(err)
 		}
 		rel, err = db.Relation(ctx, n.TableDef.Name)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				panic(e)
 			}
@@ -1145,10 +1250,11 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 					ColId: attr.Attr.ID,
 					Name:  attr.Attr.Name,
 					Typ: &plan.Type{
-						Id:       int32(attr.Attr.Type.Oid),
-						Width:    attr.Attr.Type.Width,
-						Scale:    attr.Attr.Type.Scale,
-						AutoIncr: attr.Attr.AutoIncrement,
+						Id:         int32(attr.Attr.Type.Oid),
+						Width:      attr.Attr.Type.Width,
+						Scale:      attr.Attr.Type.Scale,
+						AutoIncr:   attr.Attr.AutoIncrement,
+						Enumvalues: attr.Attr.EnumValues,
 					},
 					Primary:   attr.Attr.Primary,
 					Default:   attr.Attr.Default,
@@ -1172,7 +1278,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	// prcoess partitioned table
 	var partitionRelNames []string
 	if n.TableDef.Partition != nil {
-		partitionRelNames = append(partitionRelNames, n.TableDef.Partition.PartitionTableNames...)
+		partitionRelNames = append(partitionRelNames, c.prunePartitionTableNames(n)...)
 	}
 
 	s = &Scope{
@@ -1189,6 +1295,10 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 			Expr:                   colexec.RewriteFilterExprList(n.FilterList),
 		},
 	}
+	if n.ScanSnapshot != nil {
+		s.DataSource.ScanSnapshot = n.ScanSnapshot
+		s.DataSource.TxnOperator = txnOp
+	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
 	return s
 }
@@ -1210,6 +1320,43 @@ func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
+func (c *Compile) compileLock(n *plan.Node, ss []*Scope) []*Scope {
+	// the rows of a locking read with LIMIT are locked in order until there
+	// are limit+offset rows, the limit and offset are applied after the lock.
+	var limit uint64
+	if n.Limit != nil {
+		vec, err := colexec.EvalExpressionOnce(c.proc, n.Limit, []*batch.Batch{constBat})
+		if err != nil {
+			panic(err)
+		}
+		defer vec.Free(c.proc.Mp())
+		limit = uint64(vector.MustFixedCol[int64](vec)[0])
+		if n.Offset != nil {
+			vec, err := colexec.EvalExpressionOnce(c.proc, n.Offset, []*batch.Batch{constBat})
+			if err != nil {
+				panic(err)
+			}
+			defer vec.Free(c.proc.Mp())
+			limit += uint64(vector.MustFixedCol[int64](vec)[0])
+		}
+		if len(ss) > 1 {
+			ss = []*Scope{c.newMergeScope(ss)}
+		}
+	}
+
+	currentFirstFlag := c.anal.isFirst
+	for i := range ss {
+		ss[i].appendInstruction(vm.Instruction{
+			Op:      vm.LockOp,
+			Idx:     c.anal.curr,
+			IsFirst: currentFirstFlag,
+			Arg:     constructLockOp(n.LockTargets).WithLimit(limit),
+		})
+	}
+	c.anal.isFirst = false
+	return ss
+}
+
 func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -1224,6 +1371,105 @@ func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
+// compileRecursiveCte merges the scopes of the anchor and appends the operator which
+// iterates the recursive member. The scopes of the recursive member are compiled again
+// for every iteration, with the rows produced by the last iteration as the working table.
+func (c *Compile) compileRecursiveCte(ctx context.Context, step int32, n *plan.Node, ss []*Scope, ns []*plan.Node) []*Scope {
+	rs := c.newMergeScope(ss)
+	idx := c.anal.curr
+	memberID := n.Children[1]
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.RecursiveCte,
+		Idx: idx,
+		Arg: constructRecursiveCte(n, func(proc *process.Process, working *batch.Batch) ([]*batch.Batch, error) {
+			return c.runRecursiveMember(ctx, step, idx, memberID, ns, proc, working)
+		}),
+	})
+	return []*Scope{rs}
+}
+
+// runRecursiveMember runs the recursive member once over the working table,
+// and returns all the rows it produced.
+func (c *Compile) runRecursiveMember(ctx context.Context, step int32, idx int, nodeID int32, ns []*plan.Node,
+	proc *process.Process, working *batch.Batch) ([]*batch.Batch, error) {
+	rs, err := c.compileRecursiveMember(ctx, step, idx, nodeID, ns, proc, working)
+	if err != nil {
+		return nil, err
+	}
+
+	var bats []*batch.Batch
+	rs.appendInstruction(vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Func: func(_ any, bat *batch.Batch) error {
+				if bat == nil {
+					return nil
+				}
+				rbat, err := bat.Dup(proc.Mp())
+				if err != nil {
+					return err
+				}
+				bats = append(bats, rbat)
+				return nil
+			},
+		},
+	})
+	rs.Proc.ResetContextFromParent(proc.Ctx)
+	if err = rs.MergeRun(c); err != nil {
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+		return nil, err
+	}
+	return bats, nil
+}
+
+func (c *Compile) compileRecursiveMember(ctx context.Context, step int32, idx int, nodeID int32, ns []*plan.Node,
+	proc *process.Process, working *batch.Batch) (*Scope, error) {
+	c.recursiveLock.Lock()
+	defer c.recursiveLock.Unlock()
+
+	scanID := findRecursiveScan(ns, nodeID)
+	if scanID < 0 {
+		working.Clean(proc.Mp())
+		return nil, moerr.NewInternalError(ctx, "no recursive scan in the recursive member")
+	}
+	if c.workTables == nil {
+		c.workTables = make(map[int32]*batch.Batch)
+	}
+	c.workTables[scanID] = working
+
+	c.setAnalyzeCurrent(nil, int(nodeID))
+	ss, err := c.compilePlanScope(ctx, step, nodeID, ns)
+	if _, ok := c.workTables[scanID]; ok {
+		delete(c.workTables, scanID)
+		working.Clean(proc.Mp())
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.setAnalyzeCurrent(ss, idx)
+	return c.newMergeScope(ss), nil
+}
+
+// findRecursiveScan returns the id of the RECURSIVE_SCAN node of the recursive member,
+// the recursive members of the nested recursive CTEs are skipped.
+func findRecursiveScan(ns []*plan.Node, nodeID int32) int32 {
+	n := ns[nodeID]
+	if n.NodeType == plan.Node_RECURSIVE_SCAN {
+		return nodeID
+	}
+	for i, child := range n.Children {
+		if n.NodeType == plan.Node_RECURSIVE_CTE && i == 1 {
+			continue
+		}
+		if id := findRecursiveScan(ns, child); id >= 0 {
+			return id
+		}
+	}
+	return -1
+}
+
 func (c *Compile) compileUnion(n *plan.Node, ss []*Scope, children []*Scope) []*Scope {
 	ss = append(ss, children...)
 	rs := c.newScopeList(1, int(n.Stats.BlockNum))
@@ -1577,6 +1823,18 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileWindow merges all the scopes into one, because the window function
+// needs to see all the rows of a partition.
+func (c *Compile) compileWindow(n *plan.Node, ss []*Scope) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.Window,
+		Idx: c.anal.curr,
+		Arg: constructWindow(n),
+	})
+	return []*Scope{rs}
+}
+
 func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -2123,6 +2381,8 @@ func (c *Compile) fillAnalyzeInfo() {
 		c.anal.qry.Nodes[i].AnalyzeInfo.NetworkIO = atomic.LoadInt64(&anal.NetworkIO)
 		c.anal.qry.Nodes[i].AnalyzeInfo.ScanTime = atomic.LoadInt64(&anal.ScanTime)
 		c.anal.qry.Nodes[i].AnalyzeInfo.InsertTime = atomic.LoadInt64(&anal.InsertTime)
+		c.anal.qry.Nodes[i].AnalyzeInfo.SpillCount = atomic.LoadInt64(&anal.SpillCount)
+		c.anal.qry.Nodes[i].AnalyzeInfo.SpillSize = atomic.LoadInt64(&anal.SpillSize)
 	}
 }
 
@@ -2141,14 +2401,18 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if n.ObjRef.PubAccountId != -1 {
 		ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(n.ObjRef.PubAccountId))
 	}
-	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+	txnOp, err := c.getScanTxnOperator(n)
+	if err != nil {
+		return nil, err
+	}
+	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 	if err != nil {
 		return nil, err
 	}
 	rel, err = db.Relation(ctx, n.TableDef.Name)
 	if err != nil {
 		var e error // avoid contamination of error messages
-		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, txnOp)
 		if e != nil {
 			return nil, err
 		}
@@ -2167,18 +2431,20 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 		}
 	}
 
-	ranges, err = rel.Ranges(ctx, n.BlockFilterList...)
+	blockFilters := n.BlockFilterList
+	if n.TableDef.Partition != nil {
+		// the rows of a partitioned table are kept by the main table, prune its
+		// blocks by the filters of the partition keys.
+		blockFilters = append(plan2.PartitionBlockFilters(c.proc, n.TableDef, n.FilterList), n.BlockFilterList...)
+	}
+	ranges, err = rel.Ranges(ctx, blockFilters...)
 	if err != nil {
 		return nil, err
 	}
 
 	if n.TableDef.Partition != nil {
 		isPartitionTable = true
-		partitionInfo := n.TableDef.Partition
-		partitionNum := int(partitionInfo.PartitionNum)
-		partitionTableNames := partitionInfo.PartitionTableNames
-		for i := 0; i < partitionNum; i++ {
-			partTableName := partitionTableNames[i]
+		for _, partTableName := range c.prunePartitionTableNames(n) {
 			subrelation, err := db.Relation(ctx, partTableName)
 			if err != nil {
 				return nil, err
@@ -2235,6 +2501,91 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	return putBlocksInAverage(c, ranges, rel, n), nil
 }
 
+// prunePartitionTableNames returns the names of the partition tables which
+// may have the rows satisfying the filters of the table scan n. Only the
+// filters known at compile time prune the partitions, a scan on the probe
+// side of a join reads all the partitions whatever the build side has, see
+// plan2.PrunePartitions.
+func (c *Compile) prunePartitionTableNames(n *plan.Node) []string {
+	partitionInfo := n.TableDef.Partition
+	partitionTableNames := partitionInfo.PartitionTableNames[:partitionInfo.PartitionNum]
+	parts, ok := plan2.PrunePartitions(c.proc, n.TableDef, n.FilterList)
+	if !ok {
+		return partitionTableNames
+	}
+	names := make([]string, 0, len(parts))
+	for _, i := range parts {
+		names = append(names, partitionTableNames[i])
+	}
+	return names
+}
+
+// getScanTxnOperator returns the txn operator reading the table scanned by n.
+// The snapshot of AS OF TIMESTAMP is read by a read-only txn, which is shared
+// by the scans of the same snapshot.
+func (c *Compile) getScanTxnOperator(n *plan.Node) (TxnOperator, error) {
+	if n.ScanSnapshot == nil {
+		return c.proc.TxnOperator, nil
+	}
+	ts := *n.ScanSnapshot
+	if c.proc.TxnOperator != nil {
+		now := c.proc.TxnOperator.Txn().SnapshotTS
+		if now.Less(ts) {
+			return nil, moerr.NewInvalidInput(c.ctx, "the snapshot %s is after the snapshot of the transaction", ts.DebugString())
+		}
+		// the history versions older than the retention may be collected by GC.
+		if retention := c.e.Hints().SnapshotRetention; retention > 0 &&
+			ts.PhysicalTime < now.PhysicalTime-int64(retention) {
+			return nil, moerr.NewInvalidInput(c.ctx, "the snapshot %s is out of the retention %s", ts.DebugString(), retention)
+		}
+	}
+	return c.getSnapshotTxnOperator(ts)
+}
+
+// getSnapshotTxnOperator returns the read-only txn reading the snapshot ts,
+// the txn is created at the first call and rolled back by closeSnapshotTxns.
+func (c *Compile) getSnapshotTxnOperator(ts timestamp.Timestamp) (TxnOperator, error) {
+	c.snapshotTxns.Lock()
+	defer c.snapshotTxns.Unlock()
+	if op, ok := c.snapshotTxns.ops[ts.DebugString()]; ok {
+		return op, nil
+	}
+	op, err := c.proc.TxnClient.New(
+		c.ctx,
+		ts,
+		client.WithSnapshotTS(ts),
+		client.WithTxnReadyOnly(),
+		client.WithTxnIsolation(txn.TxnIsolation_SI))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.e.New(c.ctx, op); err != nil {
+		_ = op.Rollback(c.ctx)
+		return nil, err
+	}
+	if c.snapshotTxns.ops == nil {
+		c.snapshotTxns.ops = make(map[string]TxnOperator)
+	}
+	c.snapshotTxns.ops[ts.DebugString()] = op
+	return op, nil
+}
+
+func (c *Compile) closeSnapshotTxns() {
+	c.snapshotTxns.Lock()
+	defer c.snapshotTxns.Unlock()
+	for key, op := range c.snapshotTxns.ops {
+		ctx, cancel := context.WithTimeout(context.Background(), c.e.Hints().CommitOrRollbackTimeout)
+		if err := c.e.Rollback(ctx, op); err != nil {
+			logutil.Errorf("rollback the snapshot txn failed: %v", err)
+		}
+		if err := op.Rollback(ctx); err != nil {
+			logutil.Errorf("rollback the snapshot txn failed: %v", err)
+		}
+		cancel()
+		delete(c.snapshotTxns.ops, key)
+	}
+}
+
 func putBlocksInAverage(c *Compile, ranges [][]byte, rel engine.Relation, n *plan.Node) engine.Nodes {
 	var nodes engine.Nodes
 	step := (len(ranges) + len(c.cnList) - 1) / len(c.cnList)
@@ -2486,17 +2837,17 @@ func rowsetDataToVector(ctx context.Context, proc *process.Process, exprs []*pla
 			vector.AppendFixed(vec, vector.MustFixedCol[int64](tmp)[0], false, proc.Mp())
 		case types.T_uint8:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint8](tmp)[0], false, proc.Mp())
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint16](tmp)[0], false, proc.Mp())
 		case types.T_uint32:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint32](tmp)[0], false, proc.Mp())
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint64](tmp)[0], false, proc.Mp())
 		case types.T_float32:
 			vector.AppendFixed(vec, vector.MustFixedCol[float32](tmp)[0], false, proc.Mp())
 		case types.T_float64:
 			vector.AppendFixed(vec, vector.MustFixedCol[float64](tmp)[0], false, proc.Mp())
-		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 			vector.AppendBytes(vec, tmp.GetBytesAt(0), false, proc.Mp())
 		case types.T_date:
 			vector.AppendFixed(vec, vector.MustFixedCol[types.Date](tmp)[0], false, proc.Mp())
@@ -19,18 +19,29 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/testutil/testengine"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
@@ -64,6 +75,9 @@ func init() {
 		newTestCase("select * from R limit 10", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union select n + 1 from c where n < 5) select * from c", new(testing.T)),
+		newTestCase("with recursive c(n) as (select uid from R union all select n + 1 from c where n < 5) select * from c", new(testing.T)),
 		newTestCase("insert into R values('1', '2', '3')", new(testing.T)),
 		newTestCase("insert into R select * from R", new(testing.T)),
 		newTestCase(fmt.Sprintf("load data infile {\"filepath\"=\"%s/../../../test/distributed/resources/load_data/parallel.txt.gz\", \"compression\"=\"gzip\"} into table pressTbl FIELDS TERMINATED BY '|' OPTIONALLY ENCLOSED BY '\"' LINES TERMINATED BY '\n' parallel 'true';", GetFilePath()), new(testing.T)),
@@ -111,6 +125,116 @@ func TestCompileWithFaults(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetScanTxnOperator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := timestamp.Timestamp{PhysicalTime: int64(2 * time.Hour)}
+	txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOperator.EXPECT().Txn().Return(txn.TxnMeta{SnapshotTS: now}).AnyTimes()
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().Hints().Return(engine.Hints{SnapshotRetention: time.Hour}).AnyTimes()
+
+	proc := testutil.NewProcess()
+	proc.TxnOperator = txnOperator
+	c := New("test", "test", "", "", "", context.TODO(), eng, proc, nil, false, nil)
+
+	// the txn of the query is used without AS OF TIMESTAMP.
+	op, err := c.getScanTxnOperator(&plan.Node{})
+	require.NoError(t, err)
+	require.Equal(t, TxnOperator(txnOperator), op)
+
+	// the snapshot is after the snapshot of the txn.
+	_, err = c.getScanTxnOperator(&plan.Node{ScanSnapshot: &timestamp.Timestamp{PhysicalTime: now.PhysicalTime + 1}})
+	require.Error(t, err)
+
+	// the snapshot is out of the retention.
+	_, err = c.getScanTxnOperator(&plan.Node{ScanSnapshot: &timestamp.Timestamp{PhysicalTime: int64(time.Hour) - 1}})
+	require.Error(t, err)
+}
+
+func TestGenerateNodesPrunePartitionBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.TODO()
+	proc := testutil.NewProcess()
+
+	int64Type := types.T_int64.ToType()
+	typ := plan2.MakePlan2Type(&int64Type)
+	col := func(pos int32, name string) *plan.Expr {
+		return &plan.Expr{Typ: typ, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos, Name: name}}}
+	}
+	filter := func(op string, left *plan.Expr, v int64) *plan.Expr {
+		expr, err := plan2.BindFuncExprImplByPlanExpr(ctx, op, []*plan.Expr{left, plan2.MakePlan2Int64ConstExprWithType(v)})
+		require.NoError(t, err)
+		return expr
+	}
+	tableDef := &plan.TableDef{
+		Name:          "t",
+		Cols:          []*plan.ColDef{{Name: "a", Typ: typ}, {Name: "b", Typ: typ}},
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1},
+		Partition: &plan.PartitionByDef{
+			Type:                plan.PartitionType_HASH,
+			PartitionNum:        2,
+			PartitionExpression: col(0, "a"),
+			PartitionTableNames: []string{"%!%p0%!%t", "%!%p1%!%t"},
+		},
+	}
+	// no statistics, so there is no block filter of the planner
+	n := &plan.Node{
+		ObjRef:     &plan.ObjectRef{SchemaName: "db", PubAccountId: -1},
+		TableDef:   tableDef,
+		FilterList: []*plan.Expr{filter("=", col(0, "a"), 1), filter(">", col(1, "b"), 0)},
+		Stats:      &plan.Stats{},
+	}
+
+	var blockFilters []*plan.Expr
+	rel := mock_frontend.NewMockRelation(ctrl)
+	rel.EXPECT().Ranges(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, exprs []*plan.Expr) ([][]byte, error) {
+			blockFilters = exprs
+			return nil, nil
+		})
+	rel.EXPECT().GetTableID(gomock.Any()).Return(uint64(1)).AnyTimes()
+	partRel := mock_frontend.NewMockRelation(ctrl)
+	partRel.EXPECT().Ranges(gomock.Any(), gomock.Any()).Return([][]byte{{}}, nil)
+	db := mock_frontend.NewMockDatabase(ctrl)
+	db.EXPECT().Relation(gomock.Any(), "t").Return(rel, nil)
+	// only the partition of a = 1 is read
+	db.EXPECT().Relation(gomock.Any(), "%!%p1%!%t").Return(partRel, nil)
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().Database(gomock.Any(), "db", gomock.Any()).Return(db, nil)
+
+	c := New("test", "test", "", "", "", ctx, eng, proc, nil, false, nil)
+	_, err := c.generateNodes(n)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blockFilters))
+
+	// the rows are kept by the blocks of the main table, the block whose
+	// zonemap of a doesn't have 1 is skipped by the filter.
+	zm0 := index.NewZM(types.T_int64, 0)
+	zm0.Update(int64(2))
+	zm0.Update(int64(5))
+	zm1 := index.NewZM(types.T_int64, 0)
+	zm1.Update(int64(0))
+	zm1.Update(int64(1))
+	objMeta := objectio.BuildMetaData(2, 2)
+	objMeta.GetBlockMeta(0).MustGetColumn(0).SetZoneMap(zm0)
+	objMeta.GetBlockMeta(1).MustGetColumn(0).SetZoneMap(zm1)
+	expr := blockFilters[0]
+	cnt := plan2.AssignAuxIdForExpr(expr, 0)
+	columnMap := map[int]int{0: 0}
+	read := make([]bool, 2)
+	for i := range read {
+		zms := make([]objectio.ZoneMap, cnt)
+		vecs := make([]*vector.Vector, cnt)
+		read[i] = colexec.EvaluateFilterByZoneMap(ctx, proc, expr, objMeta.GetBlockMeta(uint32(i)), columnMap, zms, vecs)
+		for _, vec := range vecs {
+			if vec != nil {
+				vec.Free(proc.Mp())
+			}
+		}
+	}
+	require.Equal(t, []bool{false, true}, read)
+}
+
 func newTestCase(sql string, t *testing.T) compileTestCase {
 	proc := testutil.NewProcess()
 	e, _, compilerCtx := testengine.New(context.Background())
@@ -134,3 +258,31 @@ func GetFilePath() string {
 	dir, _ := os.Getwd()
 	return dir
 }
+
+// failedSQLExecutor fails to set up the txn executor.
+type failedSQLExecutor struct {
+	executor.SQLExecutor
+}
+
+func (e failedSQLExecutor) ExecTxn(ctx context.Context, execFunc func(executor.TxnExecutor) error, opts executor.Options) error {
+	return moerr.NewInternalErrorNoCtx("no txn executor")
+}
+
+func TestExecSqlInTxn(t *testing.T) {
+	if runtime.ProcessLevelRuntime() == nil {
+		runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	}
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.InternalSQLExecutor, failedSQLExecutor{})
+
+	proc := testutil.NewProcess()
+	c := New("test", "test", "", "", "", context.TODO(), nil, proc, nil, false, nil)
+	_, err := execSqlInTxn(c, "select 1")
+	require.Error(t, err)
+	_, err = countRowsInTxn(c, "select count(*) from t")
+	require.Error(t, err)
+}
+
+func TestQuoteIdent(t *testing.T) {
+	require.Equal(t, "`t1`", quoteIdent("t1"))
+	require.Equal(t, "`a``b`", quoteIdent("a`b"))
+}
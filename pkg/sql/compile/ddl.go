@@ -18,22 +18,29 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"golang.org/x/exp/constraints"
 )
@@ -198,6 +205,13 @@ func (s *Scope) AlterTable(c *Compile) error {
 	}
 
 	tblId := rel.GetTableID(c.ctx)
+	// the partition management is the only option of the statement
+	for _, action := range qry.Actions {
+		if act, ok := action.Action.(*plan.AlterTable_Action_AlterPartition); ok {
+			return alterTablePartition(c, dbSource, rel, qry, act.AlterPartition)
+		}
+	}
+
 	removeRefChildTbls := make(map[string]uint64)
 	var addRefChildTbls []uint64
 	var newFkeys []*plan.ForeignKeyDef
@@ -226,11 +240,11 @@ func (s *Scope) AlterTable(c *Compile) error {
 						dropIndex = indexdef
 						tableDef.Indexes = append(tableDef.Indexes[:i], tableDef.Indexes[i+1:]...)
 						// drop index table
-						if indexdef.TableExist {
-							if _, err = dbSource.Relation(c.ctx, indexdef.IndexTableName); err != nil {
+						for _, name := range util.IndexTableNames(indexdef) {
+							if _, err = dbSource.Relation(c.ctx, name); err != nil {
 								return err
 							}
-							if err = dbSource.Delete(c.ctx, indexdef.IndexTableName); err != nil {
+							if err = dbSource.Delete(c.ctx, name); err != nil {
 								return err
 							}
 						}
@@ -249,7 +263,27 @@ func (s *Scope) AlterTable(c *Compile) error {
 			if err != nil {
 				return err
 			}
-			if act.AddIndex.IndexTableExist {
+			if act.AddIndex.IndexTableExist && (util.IsFullTextIndex(indexDef) || util.IsIvfFlatIndex(indexDef) || util.IsExpressionIndex(indexDef)) {
+				for _, def := range act.AddIndex.IndexInfo.GetIndexTables() {
+					exeDefs, err := planDefsToExeDefs(def)
+					if err != nil {
+						return err
+					}
+					if err = dbSource.Create(c.ctx, def.Name, append(planColsToExeCols(def.GetCols()), exeDefs...)); err != nil {
+						return err
+					}
+				}
+				if util.IsFullTextIndex(indexDef) {
+					err = insertIntoFullTextIndexTable(c, dbSource, rel, indexDef, act.AddIndex.OriginTablePrimaryKey)
+				} else if util.IsExpressionIndex(indexDef) {
+					err = insertIntoExpressionIndexTable(c, dbSource, rel, indexDef, act.AddIndex.OriginTablePrimaryKey)
+				} else {
+					err = insertIntoIvfFlatIndexTable(c, dbSource, rel, indexDef, act.AddIndex.OriginTablePrimaryKey)
+				}
+				if err != nil {
+					return err
+				}
+			} else if act.AddIndex.IndexTableExist {
 				var sql string
 				def := act.AddIndex.IndexInfo.GetIndexTables()[0]
 				planCols := def.GetCols()
@@ -335,6 +369,10 @@ func (s *Scope) AlterTable(c *Compile) error {
 		}
 	}
 
+	if err = alterTableColumns(c, rel, tblName, qry.Actions); err != nil {
+		return err
+	}
+
 	// reset origin table's constraint
 	var oldCt *engine.ConstraintDef
 	newCt := &engine.ConstraintDef{
@@ -436,6 +474,343 @@ func (s *Scope) AlterTable(c *Compile) error {
 	return nil
 }
 
+// alterTableColumns changes the columns of the table in place. The schema of the table
+// is versioned by the storage, so the existing data are read through the new schema
+// without being rewritten. The existing rows read the added column as its default, which
+// is evaluated here and recorded in the schema, and read the widened column by a cast.
+func alterTableColumns(c *Compile, rel engine.Relation, tblName string, actions []*plan.AlterTable_Action) error {
+	attrs, err := rel.TableColumns(c.ctx)
+	if err != nil {
+		return err
+	}
+	// the columns in order of their logical index, which is changed by the former requests
+	cols := make([]engine.Attribute, len(attrs))
+	for i, attr := range attrs {
+		cols[i] = *attr
+	}
+	findCol := func(name string) int {
+		for i := range cols {
+			if cols[i].Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var reqs []*api.AlterTableReq
+	needEmpty := false
+	for _, action := range actions {
+		switch act := action.Action.(type) {
+		case *plan.AlterTable_Action_Drop:
+			if act.Drop.Typ != plan.AlterTableDrop_COLUMN {
+				continue
+			}
+			idx := findCol(act.Drop.Name)
+			if idx < 0 {
+				return moerr.NewBadFieldError(c.ctx, act.Drop.Name, tblName)
+			}
+			reqs = append(reqs, api.NewRemoveColumnReq(0, 0, uint32(idx), uint32(cols[idx].Seqnum)))
+			cols = append(cols[:idx], cols[idx+1:]...)
+		case *plan.AlterTable_Action_AddColumn:
+			col := act.AddColumn.Column
+			if err = setColumnFillValue(c, col); err != nil {
+				return err
+			}
+			// the existing rows can't read NULL from a not null column
+			if !col.Default.HasFillValue && !col.Default.NullAbility {
+				needEmpty = true
+			}
+			pos := -1
+			if act.AddColumn.First {
+				pos = 0
+			} else if len(act.AddColumn.AfterColumn) > 0 {
+				if pos = findCol(act.AddColumn.AfterColumn); pos < 0 {
+					return moerr.NewBadFieldError(c.ctx, act.AddColumn.AfterColumn, tblName)
+				}
+				pos++
+			}
+			reqs = append(reqs, &api.AlterTableReq{
+				Kind: api.AlterKind_AddColumn,
+				Operation: &api.AlterTableReq_AddColumn{
+					AddColumn: &api.AlterTableAddColumn{
+						Column:         col,
+						InsertPosition: int32(pos),
+					},
+				},
+			})
+			// the column is added before the rowid column if no position is given
+			if pos < 0 {
+				pos = len(cols)
+				if pos > 0 && cols[pos-1].Name == catalog.Row_ID {
+					pos--
+				}
+			}
+			cols = append(cols[:pos], append([]engine.Attribute{{Name: col.Name}}, cols[pos:]...)...)
+		case *plan.AlterTable_Action_ModifyColumn:
+			col := act.ModifyColumn.Column
+			idx := findCol(act.ModifyColumn.OldName)
+			if idx < 0 {
+				return moerr.NewBadFieldError(c.ctx, act.ModifyColumn.OldName, tblName)
+			}
+			seqnum := uint32(cols[idx].Seqnum)
+			// the existing rows may violate the new not null constraint
+			if (cols[idx].Default == nil || cols[idx].Default.NullAbility) && !col.Default.NullAbility {
+				needEmpty = true
+			}
+			// the rows written before the column is added still read the same value
+			if err = keepColumnFillValue(c, &cols[idx], col); err != nil {
+				return err
+			}
+			if act.ModifyColumn.OldName != col.Name {
+				reqs = append(reqs, api.NewRenameColumnReq(0, 0, act.ModifyColumn.OldName, col.Name, seqnum))
+				cols[idx].Name = col.Name
+			}
+			reqs = append(reqs, api.NewModifyColumnReq(0, 0, seqnum, col))
+		case *plan.AlterTable_Action_RenameColumn:
+			idx := findCol(act.RenameColumn.OldName)
+			if idx < 0 {
+				return moerr.NewBadFieldError(c.ctx, act.RenameColumn.OldName, tblName)
+			}
+			reqs = append(reqs, api.NewRenameColumnReq(0, 0, act.RenameColumn.OldName, act.RenameColumn.NewName, uint32(cols[idx].Seqnum)))
+			cols[idx].Name = act.RenameColumn.NewName
+		}
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	if needEmpty {
+		// load the blocks of the table before counting the rows
+		if _, err = rel.Ranges(c.ctx); err != nil {
+			return err
+		}
+		// the deleted rows of the persisted blocks are counted, so it's conservative
+		rows, err := rel.Rows(c.ctx)
+		if err != nil {
+			return err
+		}
+		if rows > 0 {
+			return moerr.NewNYI(c.ctx, "add a not null column without default value or set a column not null for non-empty table")
+		}
+	}
+	return rel.AlterTable(c.ctx, reqs)
+}
+
+// setColumnFillValue evaluates the default of the added column and records it in the
+// default as the value of the column for the rows written before the column is added.
+func setColumnFillValue(c *Compile, col *plan.ColDef) error {
+	if col.Default == nil {
+		col.Default = &plan.Default{NullAbility: true}
+	}
+	if col.Default.Expr == nil {
+		return nil
+	}
+	vec, err := colexec.EvalExpressionOnce(c.proc, col.Default.Expr, []*batch.Batch{constBat})
+	if err != nil {
+		return err
+	}
+	defer vec.Free(c.proc.Mp())
+	if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+		return nil
+	}
+	typ := vector.ProtoTypeToType(col.Typ)
+	if vec.GetType().Oid != typ.Oid {
+		return moerr.NewInternalError(c.ctx, "the default value of column %s is %s, not %s", col.Name, vec.GetType(), typ)
+	}
+	if typ.IsVarlen() {
+		col.Default.FillValue = append([]byte(nil), vec.GetBytesAt(0)...)
+	} else {
+		col.Default.FillValue = append([]byte(nil), vec.UnsafeGetRawData()[:typ.TypeSize()]...)
+	}
+	col.Default.HasFillValue = true
+	return nil
+}
+
+// keepColumnFillValue copies the value of the column for the rows written before the
+// column is added to the modified column, the value is widened with the column.
+func keepColumnFillValue(c *Compile, old *engine.Attribute, col *plan.ColDef) error {
+	if old.Default == nil || !old.Default.HasFillValue {
+		return nil
+	}
+	if col.Default == nil {
+		col.Default = &plan.Default{NullAbility: true}
+	}
+	typ := vector.ProtoTypeToType(col.Typ)
+	vec := containers.FillCNConstVector(1, old.Type, containers.DefaultFillValue(old.Default, old.Type), c.proc.Mp())
+	defer vec.Free(c.proc.Mp())
+	widened, err := containers.WidenCNVector(vec, typ, c.proc.Mp())
+	if err != nil {
+		return err
+	}
+	if widened != vec {
+		defer widened.Free(c.proc.Mp())
+	}
+	if typ.IsVarlen() {
+		col.Default.FillValue = append([]byte(nil), widened.GetBytesAt(0)...)
+	} else {
+		col.Default.FillValue = append([]byte(nil), widened.UnsafeGetRawData()[:typ.TypeSize()]...)
+	}
+	col.Default.HasFillValue = true
+	return nil
+}
+
+// alterTablePartition changes the partitions of the table. The rows of a partitioned
+// table are kept by the main table, so the rows of the partitions are deleted, checked
+// and exchanged by the filter on the partition expression in the txn of the statement,
+// and the partition tables are created or dropped as the partitions are changed.
+func alterTablePartition(c *Compile, dbSource engine.Database, rel engine.Relation, qry *plan.AlterTable, alter *plan.AlterTablePartition) error {
+	tblName := quoteIdent(qry.Database) + "." + quoteIdent(qry.TableDef.Name)
+	switch alter.Typ {
+	case plan.AlterTablePartition_DROP, plan.AlterTablePartition_TRUNCATE:
+		sql := "delete from " + tblName
+		if alter.Filter != "" {
+			sql += " where " + alter.Filter
+		}
+		if _, err := execSqlInTxn(c, sql); err != nil {
+			return err
+		}
+
+	case plan.AlterTablePartition_REORGANIZE:
+		cnt, err := countRowsInTxn(c, fmt.Sprintf("select count(*) from %s where %s", tblName, alter.CheckFilter))
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return moerr.NewInvalidInput(c.ctx, "table has no partition for %d rows of the reorganized partitions", cnt)
+		}
+
+	case plan.AlterTablePartition_EXCHANGE:
+		if err := exchangePartition(c, dbSource, rel, qry, alter); err != nil {
+			return err
+		}
+	}
+
+	for _, def := range alter.AddPartitionTables {
+		exeDefs, err := planDefsToExeDefs(def)
+		if err != nil {
+			return err
+		}
+		if err = dbSource.Create(c.ctx, def.Name, append(planColsToExeCols(def.Cols), exeDefs...)); err != nil {
+			return err
+		}
+	}
+	for _, name := range alter.DropPartitionTables {
+		if err := dbSource.Delete(c.ctx, name); err != nil {
+			return err
+		}
+	}
+	if alter.PartitionDef == nil {
+		return nil
+	}
+	bytes, err := alter.PartitionDef.MarshalPartitionInfo()
+	if err != nil {
+		return err
+	}
+	return rel.AlterTable(c.ctx, []*api.AlterTableReq{api.NewUpdatePartitionReq(0, 0, 1, string(bytes))})
+}
+
+// exchangePartition swaps the rows of the partition and the rows of the exchanged table
+// through a holding table, which is dropped before the statement ends.
+func exchangePartition(c *Compile, dbSource engine.Database, rel engine.Relation, qry *plan.AlterTable, alter *plan.AlterTablePartition) error {
+	tblName := quoteIdent(qry.Database) + "." + quoteIdent(qry.TableDef.Name)
+	exchangeName := quoteIdent(alter.ExchangeDatabase) + "." + quoteIdent(alter.ExchangeTable)
+	if !alter.WithoutValidation {
+		cnt, err := countRowsInTxn(c, fmt.Sprintf("select count(*) from %s where not (%s)", exchangeName, alter.Filter))
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return moerr.NewInvalidInput(c.ctx, "found a row that does not match the partition")
+		}
+	}
+
+	var cols []*plan.ColDef
+	var names []string
+	for _, col := range qry.TableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		col = plan2.DeepCopyColDef(col)
+		col.Primary = false
+		col.Typ.AutoIncr = false
+		col.Default = &plan.Default{NullAbility: true}
+		col.OnUpdate = nil
+		cols = append(cols, col)
+		names = append(names, quoteIdent(col.Name))
+	}
+	ok, holdName := util.MakeNameOfPartitionTable(fmt.Sprintf("exchange_%d", rel.GetTableID(c.ctx)), qry.TableDef.Name)
+	if !ok {
+		return moerr.NewInvalidInput(c.ctx, "invalid table name %s", qry.TableDef.Name)
+	}
+	holdDefs := append(planColsToExeCols(cols), &engine.PropertiesDef{
+		Properties: []engine.Property{
+			{
+				Key:   catalog.SystemRelAttr_Kind,
+				Value: catalog.SystemPartitionRel,
+			},
+		},
+	})
+	if err := dbSource.Create(c.ctx, holdName, holdDefs); err != nil {
+		return err
+	}
+	colList := strings.Join(names, ", ")
+	holdTblName := quoteIdent(qry.Database) + "." + quoteIdent(holdName)
+	sqls := []string{
+		fmt.Sprintf("insert into %s (%s) select %s from %s where %s", holdTblName, colList, colList, tblName, alter.Filter),
+		fmt.Sprintf("delete from %s where %s", tblName, alter.Filter),
+		fmt.Sprintf("insert into %s (%s) select %s from %s", tblName, colList, colList, exchangeName),
+		fmt.Sprintf("delete from %s", exchangeName),
+		fmt.Sprintf("insert into %s (%s) select %s from %s", exchangeName, colList, colList, holdTblName),
+	}
+	for _, sql := range sqls {
+		if _, err := execSqlInTxn(c, sql); err != nil {
+			return err
+		}
+	}
+	return dbSource.Delete(c.ctx, holdName)
+}
+
+// execSqlInTxn runs the sql in the txn of the statement, so the changes of the sql are
+// committed or rolled back with the statement.
+func execSqlInTxn(c *Compile, sql string) (executor.Result, error) {
+	v, ok := runtime.ProcessLevelRuntime().GetGlobalVariables(runtime.InternalSQLExecutor)
+	if !ok {
+		return executor.Result{}, moerr.NewInternalError(c.ctx, "the internal sql executor is not found")
+	}
+	var res executor.Result
+	var err error
+	opts := executor.Options{}.WithTxn(c.proc.TxnOperator).WithDatabase(c.db)
+	// the executor doesn't roll back the txn of the statement, so the error of the
+	// sql is returned by the closure.
+	execErr := v.(executor.SQLExecutor).ExecTxn(c.ctx, func(exec executor.TxnExecutor) error {
+		res, err = exec.Exec(sql)
+		return err
+	}, opts)
+	if err == nil && execErr != nil {
+		return executor.Result{}, execErr
+	}
+	return res, err
+}
+
+// quoteIdent quotes the name by backticks, the backticks in the name are doubled.
+func quoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func countRowsInTxn(c *Compile, sql string) (int64, error) {
+	res, err := execSqlInTxn(c, sql)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+	var cnt int64
+	res.ReadRows(func(cols []*vector.Vector) bool {
+		cnt += vector.MustFixedCol[int64](cols[0])[0]
+		return false
+	})
+	return cnt, nil
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -588,7 +963,16 @@ func (s *Scope) CreateTable(c *Compile) error {
 		}
 	}
 
-	return colexec.CreateAutoIncrCol(c.e, c.ctx, dbSource, c.proc, tableCols, dbName, tblName)
+	if err = colexec.CreateAutoIncrCol(c.e, c.ctx, dbSource, c.proc, tableCols, dbName, tblName); err != nil {
+		return err
+	}
+	// the hidden table of a materialized view has an auto increment primary key
+	for _, def := range qry.IndexTables {
+		if err = colexec.CreateAutoIncrCol(c.e, c.ctx, dbSource, c.proc, def.Cols, dbName, def.Name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func checkIndexInitializable(dbName string, tblName string) bool {
@@ -683,20 +1067,20 @@ func (s *Scope) CreateIndex(c *Compile) error {
 
 	// build and create index table
 	if qry.TableExist {
-		def := qry.GetIndex().GetIndexTables()[0]
-		planCols := def.GetCols()
-		exeCols := planColsToExeCols(planCols)
-		exeDefs, err := planDefsToExeDefs(def)
-		if err != nil {
-			return err
-		}
-		if _, err := d.Relation(c.ctx, def.Name); err == nil {
-			return moerr.NewTableAlreadyExists(c.ctx, def.Name)
-		}
-		if err := d.Create(c.ctx, def.Name, append(exeCols, exeDefs...)); err != nil {
-			return err
+		for _, def := range qry.GetIndex().GetIndexTables() {
+			planCols := def.GetCols()
+			exeCols := planColsToExeCols(planCols)
+			exeDefs, err := planDefsToExeDefs(def)
+			if err != nil {
+				return err
+			}
+			if _, err := d.Relation(c.ctx, def.Name); err == nil {
+				return moerr.NewTableAlreadyExists(c.ctx, def.Name)
+			}
+			if err := d.Create(c.ctx, def.Name, append(exeCols, exeDefs...)); err != nil {
+				return err
+			}
 		}
-
 	}
 	// build and update constraint def
 	defs, err := planDefsToExeDefs(qry.GetIndex().GetTableDef())
@@ -761,6 +1145,18 @@ func (s *Scope) CreateIndex(c *Compile) error {
 			indexBat.Clean(c.proc.Mp())
 		}
 		// other situation is not supported now and check in plan
+	} else if util.IsFullTextIndex(indexDef) {
+		if err = insertIntoFullTextIndexTable(c, d, r, indexDef, qry.OriginTablePrimaryKey); err != nil {
+			return err
+		}
+	} else if util.IsIvfFlatIndex(indexDef) {
+		if err = insertIntoIvfFlatIndexTable(c, d, r, indexDef, qry.OriginTablePrimaryKey); err != nil {
+			return err
+		}
+	} else if util.IsExpressionIndex(indexDef) {
+		if err = insertIntoExpressionIndexTable(c, d, r, indexDef, qry.OriginTablePrimaryKey); err != nil {
+			return err
+		}
 	}
 
 	err = colexec.InsertOneIndexMetadata(c.e, c.ctx, d, c.proc, qry.Table, indexDef)
@@ -770,6 +1166,160 @@ func (s *Scope) CreateIndex(c *Compile) error {
 	return nil
 }
 
+// insertIntoIvfFlatIndexTable builds the ivfflat index on the rows in the
+// origin table. The centroids are trained by k-means on a sample of the
+// vectors, then every vector is written into the index table with the list of
+// its nearest centroid.
+func insertIntoIvfFlatIndexTable(c *Compile, d engine.Database, r engine.Relation, indexDef *plan.IndexDef, pkeyName string) error {
+	lists, err := strconv.Atoi(indexDef.IndexAlgoParams)
+	if err != nil {
+		return err
+	}
+	attrs := []string{indexDef.Parts[0], pkeyName}
+
+	sampler := vectorindex.NewSampler(lists*vectorindex.SamplesPerList, 1)
+	if err = readOriginTable(c, r, attrs, func(bat *batch.Batch) error {
+		vec := bat.Vecs[0]
+		for i := 0; i < vec.Length(); i++ {
+			if !vec.IsConstNull() && !vec.GetNulls().Contains(uint64(i)) {
+				sampler.Add(types.BytesToArrayF32(vec.GetBytesAt(i)))
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	centroids := vectorindex.KMeans(sampler.Samples(), lists, vectorindex.MaxIterations, 1)
+
+	if len(centroids) > 0 {
+		centroidsR, err := d.Relation(c.ctx, util.IvfFlatCentroidsTableName(indexDef.IndexTableName))
+		if err != nil {
+			return err
+		}
+		defs, err := centroidsR.TableDefs(c.ctx)
+		if err != nil {
+			return err
+		}
+		var vecType types.Type
+		for _, def := range defs {
+			if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name == catalog.IndexTableVectorColName {
+				vecType = attr.Attr.Type
+			}
+		}
+		bat := batch.New(true, []string{catalog.IndexTableListColName, catalog.IndexTableVectorColName})
+		bat.SetVector(0, vector.NewVec(types.T_int32.ToType()))
+		bat.SetVector(1, vector.NewVec(vecType))
+		for i, centroid := range centroids {
+			if err = vector.AppendFixed(bat.Vecs[0], int32(i), false, c.proc.Mp()); err != nil {
+				bat.Clean(c.proc.Mp())
+				return err
+			}
+			if err = vector.AppendBytes(bat.Vecs[1], types.ArrayF32ToBytes(centroid), false, c.proc.Mp()); err != nil {
+				bat.Clean(c.proc.Mp())
+				return err
+			}
+		}
+		bat.SetZs(len(centroids), c.proc.Mp())
+		err = centroidsR.Write(c.ctx, bat)
+		bat.Clean(c.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+
+	return backfillIndexTable(c, d, r, indexDef, attrs, func(bat *batch.Batch) (*batch.Batch, error) {
+		return util.BuildIvfFlatIndexBatch(bat.Vecs[0], bat.Vecs[1], centroids, c.proc)
+	})
+}
+
+// insertIntoFullTextIndexTable writes the words of the rows in the origin table
+// into the index table of the fulltext index.
+func insertIntoFullTextIndexTable(c *Compile, d engine.Database, r engine.Relation, indexDef *plan.IndexDef, pkeyName string) error {
+	attrs, nameToPos := indexedAttrs(indexDef, pkeyName)
+	return backfillIndexTable(c, d, r, indexDef, attrs, func(bat *batch.Batch) (*batch.Batch, error) {
+		return util.BuildFullTextIndexBatch(bat.Vecs[:len(indexDef.Parts)], bat.Vecs[nameToPos[pkeyName]], indexDef.IndexAlgoParams, c.proc)
+	})
+}
+
+// insertIntoExpressionIndexTable writes the value of the indexed expression
+// of the rows in the origin table into the index table.
+func insertIntoExpressionIndexTable(c *Compile, d engine.Database, r engine.Relation, indexDef *plan.IndexDef, pkeyName string) error {
+	attrs, nameToPos := indexedAttrs(indexDef, pkeyName)
+	return backfillIndexTable(c, d, r, indexDef, attrs, func(bat *batch.Batch) (*batch.Batch, error) {
+		vec, err := colexec.EvalExpressionIndex(c.proc, indexDef, bat, nameToPos)
+		if err != nil {
+			return nil, err
+		}
+		defer vec.Free(c.proc.Mp())
+		return util.BuildExpressionIndexBatch(vec, bat.Vecs[nameToPos[pkeyName]], c.proc)
+	})
+}
+
+// indexedAttrs returns the columns of the origin table read to build the
+// index, the parts of the index and the primary key, and their positions.
+func indexedAttrs(indexDef *plan.IndexDef, pkeyName string) ([]string, map[string]int) {
+	attrs := append([]string{}, indexDef.Parts...)
+	nameToPos := make(map[string]int, len(attrs)+1)
+	for i, part := range attrs {
+		nameToPos[part] = i
+	}
+	if _, ok := nameToPos[pkeyName]; !ok {
+		nameToPos[pkeyName] = len(attrs)
+		attrs = append(attrs, pkeyName)
+	}
+	return attrs, nameToPos
+}
+
+// backfillIndexTable writes the index of the rows in the origin table into
+// the index table, build returns the rows of the index table for a batch of
+// the columns attrs of the origin table.
+func backfillIndexTable(c *Compile, d engine.Database, r engine.Relation, indexDef *plan.IndexDef, attrs []string,
+	build func(*batch.Batch) (*batch.Batch, error)) error {
+	indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
+	if err != nil {
+		return err
+	}
+	return readOriginTable(c, r, attrs, func(bat *batch.Batch) error {
+		indexBat, err := build(bat)
+		if err != nil {
+			return err
+		}
+		if indexBat.Length() > 0 {
+			err = indexR.Write(c.ctx, indexBat)
+		}
+		indexBat.Clean(c.proc.Mp())
+		return err
+	})
+}
+
+// readOriginTable calls fn with every batch of the columns attrs of the
+// origin table.
+func readOriginTable(c *Compile, r engine.Relation, attrs []string, fn func(*batch.Batch) error) error {
+	ret, err := r.Ranges(c.ctx, nil)
+	if err != nil {
+		return err
+	}
+	rds, err := r.NewReader(c.ctx, 1, nil, ret)
+	if err != nil {
+		return err
+	}
+	defer rds[0].Close()
+	for {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp(), nil)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		err = fn(bat)
+		bat.Clean(c.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (s *Scope) DropIndex(c *Compile) error {
 	errChan := make(chan error, len(s.PreScopes))
 	for i := range s.PreScopes {
@@ -808,6 +1358,10 @@ func (s *Scope) DropIndex(c *Compile) error {
 			break
 		}
 	}
+	indexTableNames := []string{qry.IndexTableName}
+	if indexDef := findIndexDef(oldCt, qry.GetIndexName()); indexDef != nil && util.IsIvfFlatIndex(indexDef) {
+		indexTableNames = util.IndexTableNames(indexDef)
+	}
 	newCt, err := makeNewDropConstraint(oldCt, qry.GetIndexName())
 	if err != nil {
 		return err
@@ -818,11 +1372,14 @@ func (s *Scope) DropIndex(c *Compile) error {
 	}
 
 	// drop index table
-	if qry.IndexTableName != "" {
-		if _, err = d.Relation(c.ctx, qry.IndexTableName); err != nil {
+	for _, name := range indexTableNames {
+		if name == "" {
+			continue
+		}
+		if _, err = d.Relation(c.ctx, name); err != nil {
 			return err
 		}
-		if err = d.Delete(c.ctx, qry.IndexTableName); err != nil {
+		if err = d.Delete(c.ctx, name); err != nil {
 			return err
 		}
 	}
@@ -834,6 +1391,22 @@ func (s *Scope) DropIndex(c *Compile) error {
 	return nil
 }
 
+func findIndexDef(ct *engine.ConstraintDef, name string) *plan.IndexDef {
+	if ct == nil {
+		return nil
+	}
+	for _, c := range ct.Cts {
+		if def, ok := c.(*engine.IndexDef); ok {
+			for _, index := range def.Indexes {
+				if index.IndexName == name {
+					return index
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func makeNewDropConstraint(oldCt *engine.ConstraintDef, dropName string) (*engine.ConstraintDef, error) {
 	// must fount dropName because of being checked in plan
 	for i, ct := range oldCt.Cts {
@@ -1203,6 +1776,17 @@ func (s *Scope) DropTable(c *Compile) error {
 			return err
 		}
 		for _, name := range qry.IndexTableNames {
+			// the hidden table of a materialized view has an auto increment
+			// primary key
+			if strings.HasPrefix(name, catalog.MaterializedViewTablePrefix) {
+				indexRel, err := dbSource.Relation(c.ctx, name)
+				if err != nil {
+					return err
+				}
+				if err := colexec.DeleteAutoIncrCol(c.e, c.ctx, dbSource, indexRel, c.proc, dbName, indexRel.GetTableID(c.ctx)); err != nil {
+					return err
+				}
+			}
 			if err := dbSource.Delete(c.ctx, name); err != nil {
 				return err
 			}
@@ -1329,6 +1913,7 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 				AutoIncrement: col.Typ.GetAutoIncr(),
 				IsHidden:      col.Hidden,
 				Seqnum:        uint16(col.Seqnum),
+				EnumValues:    colTyp.GetEnumvalues(),
 			},
 		}
 	}
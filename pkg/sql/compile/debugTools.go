@@ -61,6 +61,8 @@ var debugInstructionNames = map[vm.OpType]string{
 	vm.Insert:       "insert",
 	vm.PreInsert:    "pre insert",
 	vm.Update:       "update",
+	vm.Trigger:      "trigger",
+	vm.IndexDelete:  "index delete",
 	vm.External:     "external",
 	vm.Minus:        "minus",
 	vm.Intersect:    "intersect",
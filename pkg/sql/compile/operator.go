@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -36,12 +37,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/indexdelete"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersectall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
@@ -63,6 +66,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/rightanti"
@@ -71,10 +75,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/trigger"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -235,6 +242,9 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 		res.Arg = &restrict.Argument{
 			E: t.E,
 		}
+	case vm.LockOp:
+		t := sourceIns.Arg.(*lockop.Argument)
+		res.Arg = constructLockOp(t.CopyToPlanTargets()).WithLimit(t.Limit())
 	case vm.Semi:
 		t := sourceIns.Arg.(*semi.Argument)
 		res.Arg = &semi.Argument{
@@ -337,6 +347,7 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Nbucket:     t.Nbucket,
 			Typs:        t.Typs,
 			Conditions:  t.Conditions,
+			CanSpill:    t.CanSpill,
 		}
 	case vm.External:
 		t := sourceIns.Arg.(*external.Argument)
@@ -408,6 +419,19 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			TableDef:   t.TableDef,
 			ParentIdx:  t.ParentIdx,
 		}
+	case vm.Trigger:
+		t := sourceIns.Arg.(*trigger.Argument)
+		res.Arg = &trigger.Argument{
+			Before: t.Before,
+			Ctxs:   t.Ctxs,
+		}
+	case vm.IndexDelete:
+		t := sourceIns.Arg.(*indexdelete.Argument)
+		res.Arg = &indexdelete.Argument{
+			RowIdIdx:  t.RowIdIdx,
+			IdxIdx:    t.IdxIdx,
+			IdxSource: t.IdxSource,
+		}
 	case vm.Deletion:
 		t := sourceIns.Arg.(*deletion.Argument)
 		res.Arg = &deletion.Argument{
@@ -419,6 +443,13 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			RemoteDelete: t.RemoteDelete,
 			SegmentMap:   t.SegmentMap,
 		}
+	case vm.RecursiveCte:
+		t := sourceIns.Arg.(*recursivecte.Argument)
+		res.Arg = &recursivecte.Argument{
+			UnionAll:          t.UnionAll,
+			MaxRecursionDepth: t.MaxRecursionDepth,
+			Iterate:           t.Iterate,
+		}
 	default:
 		panic(fmt.Sprintf("unexpected instruction type '%d' to dup", sourceIns.Op))
 	}
@@ -431,6 +462,30 @@ func constructRestrict(n *plan.Node) *restrict.Argument {
 	}
 }
 
+func constructLockOp(targets []*plan.LockTarget) *lockop.Argument {
+	arg := lockop.NewArgument()
+	for _, target := range targets {
+		mode := lock.LockMode_Exclusive
+		if target.Shared {
+			mode = lock.LockMode_Shared
+		}
+		policy := lock.WaitPolicy_Wait
+		if target.NoWait {
+			policy = lock.WaitPolicy_FastFail
+		} else if target.SkipLocked {
+			policy = lock.WaitPolicy_SkipLocked
+		}
+		arg.AddLockTargetWithMode(
+			target.TableId,
+			mode,
+			policy,
+			target.PrimaryColIdxInBat,
+			dupType(target.PrimaryColTyp),
+			target.RefreshTsIdxInBat)
+	}
+	return arg
+}
+
 func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*deletion.Argument, error) {
 	oldCtx := n.DeleteCtx
 	delCtx := &deletion.DeleteCtx{
@@ -588,6 +643,57 @@ func constructInsert(n *plan.Node, eg engine.Engine, proc *process.Process) (*in
 	}, nil
 }
 
+// constructTrigger returns the argument to run the BEFORE or AFTER triggers of
+// the DML node, nil if there is no such trigger.
+func constructTrigger(n *plan.Node, before bool) *trigger.Argument {
+	for _, tc := range n.TriggerCtxs {
+		for _, t := range tc.Triggers {
+			if t.Before == before {
+				return &trigger.Argument{
+					Before: before,
+					Ctxs:   n.TriggerCtxs,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// constructIndexDelete returns the argument to delete the rows of the index
+// tables keyed by the primary key, which are joined to the rows deleted or
+// updated by the node, or nil if there is no such index table.
+func constructIndexDelete(n *plan.Node, eg engine.Engine, proc *process.Process) (*indexdelete.Argument, error) {
+	var refs []*plan.ObjectRef
+	arg := new(indexdelete.Argument)
+	if delCtx := n.DeleteCtx; delCtx != nil {
+		refs, arg.IdxIdx = delCtx.PkIdxRef, delCtx.PkIdxIdx
+		for _, list := range delCtx.Idx {
+			arg.RowIdIdx = append(arg.RowIdIdx, int32(list.List[0]))
+		}
+	} else if updateCtx := n.UpdateCtx; updateCtx != nil {
+		refs, arg.IdxIdx = updateCtx.PkIdxRef, updateCtx.PkIdxIdx
+		for i, list := range updateCtx.Idx {
+			for j, col := range updateCtx.TableDefs[i].Cols {
+				if col.Name == catalog.Row_ID {
+					arg.RowIdIdx = append(arg.RowIdIdx, int32(list.List[j]))
+				}
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	arg.IdxSource = make([]engine.Relation, len(refs))
+	for i, ref := range refs {
+		rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+		if err != nil {
+			return nil, err
+		}
+		arg.IdxSource[i] = rel
+	}
+	return arg, nil
+}
+
 func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*update.Argument, error) {
 	oldCtx := n.UpdateCtx
 	updateCtx := &update.UpdateCtx{
@@ -1206,6 +1312,20 @@ func constructMergeOrder(n *plan.Node) *mergeorder.Argument {
 	}
 }
 
+func constructWindow(n *plan.Node) *window.Argument {
+	return &window.Argument{
+		WinSpec: n.WinSpec,
+	}
+}
+
+func constructRecursiveCte(n *plan.Node, iterate func(*process.Process, *batch.Batch) ([]*batch.Batch, error)) *recursivecte.Argument {
+	return &recursivecte.Argument{
+		UnionAll:          n.UnionAll,
+		MaxRecursionDepth: n.MaxRecursionDepth,
+		Iterate:           iterate,
+	}
+}
+
 func constructLoopJoin(n *plan.Node, typs []types.Type, proc *process.Process) *loopjoin.Argument {
 	result := make([]colexec.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -1317,6 +1437,7 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 			NeedHashMap: true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
+			CanSpill:    true,
 		}
 	case vm.Left:
 		arg := in.Arg.(*left.Argument)
@@ -1542,7 +1663,7 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 		uniqueIndexTables = make([]engine.Relation, 0)
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if util.HasIndexTable(indexdef) {
 					var indexTable engine.Relation
 					if indexdef.TableExist {
 						if isTemp {
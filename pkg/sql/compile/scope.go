@@ -222,14 +222,23 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		if util.TableIsClusterTable(s.DataSource.TableDef.GetTableType()) {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, s.DataSource.SchemaName, s.Proc.TxnOperator)
+		txnOp := s.Proc.TxnOperator
+		if s.DataSource.TxnOperator != nil {
+			txnOp = s.DataSource.TxnOperator
+		} else if s.DataSource.ScanSnapshot != nil {
+			// the scope is sent from other CN, opens the snapshot txn here.
+			if txnOp, err = c.getSnapshotTxnOperator(*s.DataSource.ScanSnapshot); err != nil {
+				return err
+			}
+		}
+		db, err = c.e.Database(ctx, s.DataSource.SchemaName, txnOp)
 		if err != nil {
 			return err
 		}
 		rel, err = db.Relation(ctx, s.DataSource.RelationName)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, s.Proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				return e
 			}
@@ -47,6 +47,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
@@ -77,6 +78,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -150,6 +152,7 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 	case pipeline.PipelineMessage:
 		c := receiver.newCompile()
 		defer c.proc.FreeVectors()
+		defer c.closeSnapshotTxns()
 
 		// decode and rewrite the scope.
 		// insert operator needs to fill the engine info.
@@ -431,6 +434,7 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			Expr:         s.DataSource.Expr,
 			TableDef:     s.DataSource.TableDef,
 			Timestamp:    &s.DataSource.Timestamp,
+			ScanSnapshot: s.DataSource.ScanSnapshot,
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -539,6 +543,7 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			Expr:         dsc.Expr,
 			TableDef:     dsc.TableDef,
 			Timestamp:    *dsc.Timestamp,
+			ScanSnapshot: dsc.ScanSnapshot,
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -830,6 +835,9 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		in.ProjectList = t.Es
 	case *restrict.Argument:
 		in.Filter = t.E
+	case *lockop.Argument:
+		in.LockTargets = t.CopyToPlanTargets()
+		in.Limit = t.Limit()
 	case *semi.Argument:
 		in.SemiJoin = &pipeline.SemiJoin{
 			Ibucket:   t.Ibucket,
@@ -885,6 +893,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		in.OrderBy = t.Fs
 	case *mergeorder.Argument:
 		in.OrderBy = t.Fs
+	case *window.Argument:
+		in.WinSpec = t.WinSpec
 	case *connector.Argument:
 		idx, ctx0 := ctx.root.findRegister(t.Reg)
 		if ctx0.root.isRemote(ctx0, 0) && !ctx0.isDescendant(ctx) {
@@ -924,6 +934,7 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Nbucket:  t.Nbucket,
 			Types:    convertToPlanTypes(t.Typs),
 			Conds:    t.Conditions,
+			CanSpill: t.CanSpill,
 		}
 	case *external.Argument:
 		name2ColIndexSlice := make([]*pipeline.ExternalName2ColIndex, len(t.Es.Name2ColIndex))
@@ -1178,6 +1189,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 		v.Arg = &projection.Argument{Es: opr.ProjectList}
 	case vm.Restrict:
 		v.Arg = &restrict.Argument{E: opr.Filter}
+	case vm.LockOp:
+		v.Arg = constructLockOp(opr.LockTargets).WithLimit(opr.Limit)
 	case vm.Semi:
 		t := opr.GetSemiJoin()
 		v.Arg = &semi.Argument{
@@ -1261,6 +1274,10 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 		v.Arg = &mergeorder.Argument{
 			Fs: opr.OrderBy,
 		}
+	case vm.Window:
+		v.Arg = &window.Argument{
+			WinSpec: opr.WinSpec,
+		}
 	case vm.TableFunction:
 		v.Arg = &table_function.Argument{
 			Attrs:  opr.TableFunction.Attrs,
@@ -1278,6 +1295,7 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			NeedExpr:    t.NeedExpr,
 			Typs:        convertToTypes(t.Types),
 			Conditions:  t.Conds,
+			CanSpill:    t.CanSpill,
 		}
 	case vm.External:
 		t := opr.GetExternalScan()
@@ -1331,6 +1349,8 @@ func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
 		target.analInfos[i].NetworkIO += n.NetworkIO
 		target.analInfos[i].ScanTime += n.ScanTime
 		target.analInfos[i].InsertTime += n.InsertTime
+		target.analInfos[i].SpillCount += n.SpillCount
+		target.analInfos[i].SpillSize += n.SpillSize
 	}
 }
 
@@ -1438,6 +1458,7 @@ func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimita
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		SpillSize:     lim.SpillSize,
 	}
 }
 
@@ -1449,6 +1470,7 @@ func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitat
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		SpillSize:     lim.SpillSize,
 	}
 }
 
@@ -1488,6 +1510,8 @@ func convertToPlanAnalyzeInfo(info *process.AnalyzeInfo) *plan.AnalyzeInfo {
 		NetworkIO:        info.NetworkIO,
 		ScanTime:         info.ScanTime,
 		InsertTime:       info.InsertTime,
+		SpillCount:       info.SpillCount,
+		SpillSize:        info.SpillSize,
 	}
 }
 
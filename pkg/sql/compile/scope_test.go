@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -66,6 +67,29 @@ func TestScopeSerialization(t *testing.T) {
 
 }
 
+func TestScopeSerializationWithScanSnapshot(t *testing.T) {
+	snapshot := &timestamp.Timestamp{PhysicalTime: 100, LogicalTime: 1}
+	sourceScope := &Scope{
+		Magic: Normal,
+		DataSource: &Source{
+			SchemaName:   "db",
+			RelationName: "R",
+			Attributes:   []string{"uid"},
+			ScanSnapshot: snapshot,
+		},
+		Proc: testutil.NewProcess(),
+	}
+
+	data, err := encodeScope(sourceScope)
+	require.NoError(t, err)
+	targetScope, err := decodeScope(data, sourceScope.Proc, true)
+	require.NoError(t, err)
+	require.NotNil(t, targetScope.DataSource)
+	// the snapshot txn is opened by the remote CN.
+	require.Nil(t, targetScope.DataSource.TxnOperator)
+	require.Equal(t, *snapshot, *targetScope.DataSource.ScanSnapshot)
+}
+
 func generateScopeCases(t *testing.T, testCases []string) []*Scope {
 	// getScope method generate and return the scope of a SQL string.
 	getScope := func(t1 *testing.T, sql string) *Scope {
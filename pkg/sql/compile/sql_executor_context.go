@@ -69,6 +69,10 @@ func (c *compilerContext) ResolveUdf(name string, ast []*plan.Expr) (string, err
 	panic("not supported in internal sql executor")
 }
 
+func (c *compilerContext) ResolveTriggers(tableID uint64, dbName string, tableName string) ([]*plan.TriggerDef, error) {
+	return nil, nil
+}
+
 func (c *compilerContext) ResolveAccountIds(accountNames []string) ([]uint32, error) {
 	panic("not supported in internal sql executor")
 }
@@ -270,6 +274,7 @@ func (c *compilerContext) getTableDef(
 					AutoIncr:    attr.Attr.AutoIncrement,
 					Table:       tableName,
 					NotNullable: attr.Attr.Default != nil && !attr.Attr.Default.NullAbility,
+					Enumvalues:  attr.Attr.EnumValues,
 				},
 				Primary:   attr.Attr.Primary,
 				Default:   attr.Attr.Default,
@@ -16,6 +16,7 @@ package compile
 
 import (
 	"context"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -84,6 +85,12 @@ type Source struct {
 	TableDef               *plan.TableDef
 	Timestamp              timestamp.Timestamp
 	AccountId              int32
+	// ScanSnapshot is the snapshot of AS OF TIMESTAMP, it is sent to the
+	// remote CN to open the snapshot txn there.
+	ScanSnapshot *timestamp.Timestamp
+	// TxnOperator reads the snapshot of AS OF TIMESTAMP, the txn of the
+	// query is used if it is nil.
+	TxnOperator TxnOperator
 }
 
 // Col is the information of attribute
@@ -189,9 +196,23 @@ type Compile struct {
 
 	stepRegs map[int32][]*process.WaitRegister
 
+	// workTables stores the working tables of the recursive CTEs, which are
+	// taken by the RECURSIVE_SCAN nodes while compiling the recursive members.
+	workTables map[int32]*batch.Batch
+	// recursiveLock serializes the compilation of the recursive members, which
+	// happens while the query is running.
+	recursiveLock sync.Mutex
+
 	isInternal bool
 	// cnLabel is the CN labels which is parsed from session variable "cn_label".
 	cnLabel map[string]string
+
+	// snapshotTxns stores the read-only txns reading the snapshots of
+	// AS OF TIMESTAMP by the snapshot, they are rolled back after the query.
+	snapshotTxns struct {
+		sync.Mutex
+		ops map[string]TxnOperator
+	}
 }
 
 type RemoteReceivRegInfo struct {
@@ -23,6 +23,7 @@ func init() {
 		"accounts":                 ACCOUNTS,
 		"add":                      ADD,
 		"action":                   ACTION,
+		"after":                    AFTER,
 		"against":                  AGAINST,
 		"all":                      ALL,
 		"alter":                    ALTER,
@@ -39,7 +40,9 @@ func init() {
 		"avg_row_length":           AVG_ROW_LENGTH,
 		"avg":                      AVG,
 		"bsi":                      BSI,
-		"before":                   UNUSED,
+		"ivfflat":                  IVFFLAT,
+		"lists":                    LISTS,
+		"before":                   BEFORE,
 		"begin":                    BEGIN,
 		"between":                  BETWEEN,
 		"bigint":                   BIGINT,
@@ -59,7 +62,7 @@ func init() {
 		"cascade":                  CASCADE,
 		"case":                     CASE,
 		"cast":                     CAST,
-		"change":                   UNUSED,
+		"change":                   CHANGE,
 		"char":                     CHAR,
 		"character":                CHARACTER,
 		"charset":                  CHARSET,
@@ -132,7 +135,7 @@ func init() {
 		"dynamic":                  DYNAMIC,
 		"duplicate":                DUPLICATE,
 		"drainer":                  DRAINER,
-		"each":                     UNUSED,
+		"each":                     EACH,
 		"else":                     ELSE,
 		"elseif":                   ELSEIF,
 		"enclosed":                 ENCLOSED,
@@ -144,6 +147,7 @@ func init() {
 		"escape":                   ESCAPE,
 		"escaped":                  ESCAPED,
 		"exists":                   EXISTS,
+		"exchange":                 EXCHANGE,
 		"exit":                     UNUSED,
 		"explain":                  EXPLAIN,
 		"expansion":                EXPANSION,
@@ -214,7 +218,9 @@ func init() {
 		"iterate":                  ITERATE,
 		"join":                     JOIN,
 		"json":                     JSON,
+		"json_table":               JSON_TABLE,
 		"uuid":                     UUID,
+		"vector":                   VECTOR,
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
@@ -259,6 +265,10 @@ func init() {
 		"mod":                      MOD,
 		"month":                    MONTH,
 		"mode":                     MODE,
+		"nowait":                   NOWAIT,
+		"locked":                   LOCKED,
+		"of":                       OF,
+		"modify":                   MODIFY,
 		"memory":                   MEMORY,
 		"modifies":                 UNUSED,
 		"multilinestring":          MULTILINESTRING,
@@ -273,6 +283,7 @@ func init() {
 		"names":                    NAMES,
 		"natural":                  NATURAL,
 		"nchar":                    NCHAR,
+		"nested":                   NESTED,
 		"next":                     NEXT,
 		"never":                    NEVER,
 		"not":                      NOT,
@@ -290,6 +301,7 @@ func init() {
 		"optimizer_costs":          UNUSED,
 		"option":                   OPTION,
 		"optionally":               OPTIONALLY,
+		"ordinality":               ORDINALITY,
 		"open":                     OPEN,
 		"or":                       OR,
 		"order":                    ORDER,
@@ -309,6 +321,7 @@ func init() {
 		"partitions":               PARTITIONS,
 		"partial":                  PARTIAL,
 		"password":                 PASSWORD,
+		"path":                     PATH,
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
@@ -331,6 +344,7 @@ func init() {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
@@ -351,6 +365,7 @@ func init() {
 		"right":                    RIGHT,
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
+		"savepoint":                SAVEPOINT,
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
@@ -367,6 +382,7 @@ func init() {
 		"session":                  SESSION,
 		"set":                      SET,
 		"share":                    SHARE,
+		"skip":                     SKIP,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
 		"signal":                   UNUSED,
@@ -434,6 +450,7 @@ func init() {
 		"utc_date":                 UTC_DATE,
 		"utc_time":                 UTC_TIME,
 		"utc_timestamp":            UTC_TIMESTAMP,
+		"validation":               VALIDATION,
 		"values":                   VALUES,
 		"variables":                VARIABLES,
 		"varbinary":                VARBINARY,
@@ -448,6 +465,7 @@ func init() {
 		"where":                    WHERE,
 		"while":                    WHILE,
 		"with":                     WITH,
+		"without":                  WITHOUT,
 		"write":                    WRITE,
 		"warnings":                 WARNINGS,
 		"work":                     WORK,
@@ -466,6 +484,7 @@ func init() {
 		"extract":                  EXTRACT,
 		"max":                      MAX,
 		"median":                   MEDIAN,
+		"member":                   MEMBER,
 		"mid":                      MID,
 		"now":                      NOW,
 		"position":                 POSITION,
@@ -541,6 +560,9 @@ func init() {
 		"sequences":                SEQUENCES,
 		"sequence":                 SEQUENCE,
 		"increment":                INCREMENT,
+		"incremental":              INCREMENTAL,
+		"materialized":             MATERIALIZED,
+		"refresh":                  REFRESH,
 		"cycle":                    CYCLE,
 		"minvalue":                 MINVALUE,
 		"nextval":                  NEXTVAL,
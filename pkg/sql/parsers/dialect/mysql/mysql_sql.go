@@ -67,495 +67,520 @@ const NEXT = 57389
 const VALUE = 57390
 const SHARE = 57391
 const MODE = 57392
-const SQL_NO_CACHE = 57393
-const SQL_CACHE = 57394
-const JOIN = 57395
-const STRAIGHT_JOIN = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const INNER = 57399
-const OUTER = 57400
-const CROSS = 57401
-const NATURAL = 57402
-const USE = 57403
-const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const ELSEIF = 57442
-const LOWER_THAN_EQ = 57443
-const LE = 57444
-const GE = 57445
-const NE = 57446
-const NULL_SAFE_EQUAL = 57447
-const IS = 57448
-const LIKE = 57449
-const REGEXP = 57450
-const IN = 57451
-const ASSIGNMENT = 57452
-const ILIKE = 57453
-const SHIFT_LEFT = 57454
-const SHIFT_RIGHT = 57455
-const DIV = 57456
-const MOD = 57457
-const UNARY = 57458
-const COLLATE = 57459
-const BINARY = 57460
-const UNDERSCORE_BINARY = 57461
-const INTERVAL = 57462
-const OUT = 57463
-const INOUT = 57464
-const BEGIN = 57465
-const START = 57466
-const TRANSACTION = 57467
-const COMMIT = 57468
-const ROLLBACK = 57469
-const WORK = 57470
-const CONSISTENT = 57471
-const SNAPSHOT = 57472
-const CHAIN = 57473
-const NO = 57474
-const RELEASE = 57475
-const PRIORITY = 57476
-const QUICK = 57477
-const BIT = 57478
-const TINYINT = 57479
-const SMALLINT = 57480
-const MEDIUMINT = 57481
-const INT = 57482
-const INTEGER = 57483
-const BIGINT = 57484
-const INTNUM = 57485
-const REAL = 57486
-const DOUBLE = 57487
-const FLOAT_TYPE = 57488
-const DECIMAL = 57489
-const NUMERIC = 57490
-const DECIMAL_VALUE = 57491
-const TIME = 57492
-const TIMESTAMP = 57493
-const DATETIME = 57494
-const YEAR = 57495
-const CHAR = 57496
-const VARCHAR = 57497
-const BOOL = 57498
-const CHARACTER = 57499
-const VARBINARY = 57500
-const NCHAR = 57501
-const TEXT = 57502
-const TINYTEXT = 57503
-const MEDIUMTEXT = 57504
-const LONGTEXT = 57505
-const BLOB = 57506
-const TINYBLOB = 57507
-const MEDIUMBLOB = 57508
-const LONGBLOB = 57509
-const JSON = 57510
-const ENUM = 57511
-const UUID = 57512
-const GEOMETRY = 57513
-const POINT = 57514
-const LINESTRING = 57515
-const POLYGON = 57516
-const GEOMETRYCOLLECTION = 57517
-const MULTIPOINT = 57518
-const MULTILINESTRING = 57519
-const MULTIPOLYGON = 57520
-const INT1 = 57521
-const INT2 = 57522
-const INT3 = 57523
-const INT4 = 57524
-const INT8 = 57525
-const S3OPTION = 57526
-const SQL_SMALL_RESULT = 57527
-const SQL_BIG_RESULT = 57528
-const SQL_BUFFER_RESULT = 57529
-const LOW_PRIORITY = 57530
-const HIGH_PRIORITY = 57531
-const DELAYED = 57532
-const CREATE = 57533
-const ALTER = 57534
-const DROP = 57535
-const RENAME = 57536
-const ANALYZE = 57537
-const ADD = 57538
-const RETURNS = 57539
-const SCHEMA = 57540
-const TABLE = 57541
-const SEQUENCE = 57542
-const INDEX = 57543
-const VIEW = 57544
-const TO = 57545
-const IGNORE = 57546
-const IF = 57547
-const PRIMARY = 57548
-const COLUMN = 57549
-const CONSTRAINT = 57550
-const SPATIAL = 57551
-const FULLTEXT = 57552
-const FOREIGN = 57553
-const KEY_BLOCK_SIZE = 57554
-const SHOW = 57555
-const DESCRIBE = 57556
-const EXPLAIN = 57557
-const DATE = 57558
-const ESCAPE = 57559
-const REPAIR = 57560
-const OPTIMIZE = 57561
-const TRUNCATE = 57562
-const MAXVALUE = 57563
-const PARTITION = 57564
-const REORGANIZE = 57565
-const LESS = 57566
-const THAN = 57567
-const PROCEDURE = 57568
-const TRIGGER = 57569
-const STATUS = 57570
-const VARIABLES = 57571
-const ROLE = 57572
-const PROXY = 57573
-const AVG_ROW_LENGTH = 57574
-const STORAGE = 57575
-const DISK = 57576
-const MEMORY = 57577
-const CHECKSUM = 57578
-const COMPRESSION = 57579
-const DATA = 57580
-const DIRECTORY = 57581
-const DELAY_KEY_WRITE = 57582
-const ENCRYPTION = 57583
-const ENGINE = 57584
-const MAX_ROWS = 57585
-const MIN_ROWS = 57586
-const PACK_KEYS = 57587
-const ROW_FORMAT = 57588
-const STATS_AUTO_RECALC = 57589
-const STATS_PERSISTENT = 57590
-const STATS_SAMPLE_PAGES = 57591
-const DYNAMIC = 57592
-const COMPRESSED = 57593
-const REDUNDANT = 57594
-const COMPACT = 57595
-const FIXED = 57596
-const COLUMN_FORMAT = 57597
-const AUTO_RANDOM = 57598
-const RESTRICT = 57599
-const CASCADE = 57600
-const ACTION = 57601
-const PARTIAL = 57602
-const SIMPLE = 57603
-const CHECK = 57604
-const ENFORCED = 57605
-const RANGE = 57606
-const LIST = 57607
-const ALGORITHM = 57608
-const LINEAR = 57609
-const PARTITIONS = 57610
-const SUBPARTITION = 57611
-const SUBPARTITIONS = 57612
-const CLUSTER = 57613
-const TYPE = 57614
-const ANY = 57615
-const SOME = 57616
-const EXTERNAL = 57617
-const LOCALFILE = 57618
-const URL = 57619
-const PREPARE = 57620
-const DEALLOCATE = 57621
-const RESET = 57622
-const EXTENSION = 57623
-const INCREMENT = 57624
-const CYCLE = 57625
-const MINVALUE = 57626
-const PUBLICATION = 57627
-const SUBSCRIPTIONS = 57628
-const PUBLICATIONS = 57629
-const PROPERTIES = 57630
-const PARSER = 57631
-const VISIBLE = 57632
-const INVISIBLE = 57633
-const BTREE = 57634
-const HASH = 57635
-const RTREE = 57636
-const BSI = 57637
-const ZONEMAP = 57638
-const LEADING = 57639
-const BOTH = 57640
-const TRAILING = 57641
-const UNKNOWN = 57642
-const EXPIRE = 57643
-const ACCOUNT = 57644
-const ACCOUNTS = 57645
-const UNLOCK = 57646
-const DAY = 57647
-const NEVER = 57648
-const PUMP = 57649
-const MYSQL_COMPATIBILITY_MODE = 57650
-const SECOND = 57651
-const ASCII = 57652
-const COALESCE = 57653
-const COLLATION = 57654
-const HOUR = 57655
-const MICROSECOND = 57656
-const MINUTE = 57657
-const MONTH = 57658
-const QUARTER = 57659
-const REPEAT = 57660
-const REVERSE = 57661
-const ROW_COUNT = 57662
-const WEEK = 57663
-const REVOKE = 57664
-const FUNCTION = 57665
-const PRIVILEGES = 57666
-const TABLESPACE = 57667
-const EXECUTE = 57668
-const SUPER = 57669
-const GRANT = 57670
-const OPTION = 57671
-const REFERENCES = 57672
-const REPLICATION = 57673
-const SLAVE = 57674
-const CLIENT = 57675
-const USAGE = 57676
-const RELOAD = 57677
-const FILE = 57678
-const TEMPORARY = 57679
-const ROUTINE = 57680
-const EVENT = 57681
-const SHUTDOWN = 57682
-const NULLX = 57683
-const AUTO_INCREMENT = 57684
-const APPROXNUM = 57685
-const SIGNED = 57686
-const UNSIGNED = 57687
-const ZEROFILL = 57688
-const ENGINES = 57689
-const LOW_CARDINALITY = 57690
-const ADMIN_NAME = 57691
-const RANDOM = 57692
-const SUSPEND = 57693
-const ATTRIBUTE = 57694
-const HISTORY = 57695
-const REUSE = 57696
-const CURRENT = 57697
-const OPTIONAL = 57698
-const FAILED_LOGIN_ATTEMPTS = 57699
-const PASSWORD_LOCK_TIME = 57700
-const UNBOUNDED = 57701
-const SECONDARY = 57702
-const USER = 57703
-const IDENTIFIED = 57704
-const CIPHER = 57705
-const ISSUER = 57706
-const X509 = 57707
-const SUBJECT = 57708
-const SAN = 57709
-const REQUIRE = 57710
-const SSL = 57711
-const NONE = 57712
-const PASSWORD = 57713
-const MAX_QUERIES_PER_HOUR = 57714
-const MAX_UPDATES_PER_HOUR = 57715
-const MAX_CONNECTIONS_PER_HOUR = 57716
-const MAX_USER_CONNECTIONS = 57717
-const FORMAT = 57718
-const VERBOSE = 57719
-const CONNECTION = 57720
-const TRIGGERS = 57721
-const PROFILES = 57722
-const LOAD = 57723
-const INFILE = 57724
-const TERMINATED = 57725
-const OPTIONALLY = 57726
-const ENCLOSED = 57727
-const ESCAPED = 57728
-const STARTING = 57729
-const LINES = 57730
-const ROWS = 57731
-const IMPORT = 57732
-const MODUMP = 57733
-const OVER = 57734
-const PRECEDING = 57735
-const FOLLOWING = 57736
-const GROUPS = 57737
-const DATABASES = 57738
-const TABLES = 57739
-const SEQUENCES = 57740
-const EXTENDED = 57741
-const FULL = 57742
-const PROCESSLIST = 57743
-const FIELDS = 57744
-const COLUMNS = 57745
-const OPEN = 57746
-const ERRORS = 57747
-const WARNINGS = 57748
-const INDEXES = 57749
-const SCHEMAS = 57750
-const NODE = 57751
-const LOCKS = 57752
-const ROLES = 57753
-const TABLE_NUMBER = 57754
-const COLUMN_NUMBER = 57755
-const TABLE_VALUES = 57756
-const TABLE_SIZE = 57757
-const NAMES = 57758
-const GLOBAL = 57759
-const PERSIST = 57760
-const SESSION = 57761
-const ISOLATION = 57762
-const LEVEL = 57763
-const READ = 57764
-const WRITE = 57765
-const ONLY = 57766
-const REPEATABLE = 57767
-const COMMITTED = 57768
-const UNCOMMITTED = 57769
-const SERIALIZABLE = 57770
-const LOCAL = 57771
-const EVENTS = 57772
-const PLUGINS = 57773
-const CURRENT_TIMESTAMP = 57774
-const DATABASE = 57775
-const CURRENT_TIME = 57776
-const LOCALTIME = 57777
-const LOCALTIMESTAMP = 57778
-const UTC_DATE = 57779
-const UTC_TIME = 57780
-const UTC_TIMESTAMP = 57781
-const REPLACE = 57782
-const CONVERT = 57783
-const SEPARATOR = 57784
-const TIMESTAMPDIFF = 57785
-const CURRENT_DATE = 57786
-const CURRENT_USER = 57787
-const CURRENT_ROLE = 57788
-const SECOND_MICROSECOND = 57789
-const MINUTE_MICROSECOND = 57790
-const MINUTE_SECOND = 57791
-const HOUR_MICROSECOND = 57792
-const HOUR_SECOND = 57793
-const HOUR_MINUTE = 57794
-const DAY_MICROSECOND = 57795
-const DAY_SECOND = 57796
-const DAY_MINUTE = 57797
-const DAY_HOUR = 57798
-const YEAR_MONTH = 57799
-const SQL_TSI_HOUR = 57800
-const SQL_TSI_DAY = 57801
-const SQL_TSI_WEEK = 57802
-const SQL_TSI_MONTH = 57803
-const SQL_TSI_QUARTER = 57804
-const SQL_TSI_YEAR = 57805
-const SQL_TSI_SECOND = 57806
-const SQL_TSI_MINUTE = 57807
-const RECURSIVE = 57808
-const CONFIG = 57809
-const DRAINER = 57810
-const MATCH = 57811
-const AGAINST = 57812
-const BOOLEAN = 57813
-const LANGUAGE = 57814
-const WITH = 57815
-const QUERY = 57816
-const EXPANSION = 57817
-const ADDDATE = 57818
-const BIT_AND = 57819
-const BIT_OR = 57820
-const BIT_XOR = 57821
-const CAST = 57822
-const COUNT = 57823
-const APPROX_COUNT_DISTINCT = 57824
-const APPROX_PERCENTILE = 57825
-const CURDATE = 57826
-const CURTIME = 57827
-const DATE_ADD = 57828
-const DATE_SUB = 57829
-const EXTRACT = 57830
-const GROUP_CONCAT = 57831
-const MAX = 57832
-const MID = 57833
-const MIN = 57834
-const NOW = 57835
-const POSITION = 57836
-const SESSION_USER = 57837
-const STD = 57838
-const STDDEV = 57839
-const MEDIAN = 57840
-const STDDEV_POP = 57841
-const STDDEV_SAMP = 57842
-const SUBDATE = 57843
-const SUBSTR = 57844
-const SUBSTRING = 57845
-const SUM = 57846
-const SYSDATE = 57847
-const SYSTEM_USER = 57848
-const TRANSLATE = 57849
-const TRIM = 57850
-const VARIANCE = 57851
-const VAR_POP = 57852
-const VAR_SAMP = 57853
-const AVG = 57854
-const RANK = 57855
-const NEXTVAL = 57856
-const SETVAL = 57857
-const CURRVAL = 57858
-const LASTVAL = 57859
-const ARROW = 57860
-const ROW = 57861
-const OUTFILE = 57862
-const HEADER = 57863
-const MAX_FILE_SIZE = 57864
-const FORCE_QUOTE = 57865
-const PARALLEL = 57866
-const UNUSED = 57867
-const BINDINGS = 57868
-const DO = 57869
-const DECLARE = 57870
-const LOOP = 57871
-const WHILE = 57872
-const LEAVE = 57873
-const ITERATE = 57874
-const UNTIL = 57875
-const CALL = 57876
-const SPBEGIN = 57877
-const BACKEND = 57878
-const SERVERS = 57879
-const KILL = 57880
-const QUERY_RESULT = 57881
+const OF = 57393
+const NOWAIT = 57394
+const SKIP = 57395
+const LOCKED = 57396
+const SAVEPOINT = 57397
+const SQL_NO_CACHE = 57398
+const SQL_CACHE = 57399
+const JOIN = 57400
+const STRAIGHT_JOIN = 57401
+const LEFT = 57402
+const RIGHT = 57403
+const INNER = 57404
+const OUTER = 57405
+const CROSS = 57406
+const NATURAL = 57407
+const USE = 57408
+const FORCE = 57409
+const LOWER_THAN_ON = 57410
+const ON = 57411
+const USING = 57412
+const SUBQUERY_AS_EXPR = 57413
+const LOWER_THAN_STRING = 57414
+const ID = 57415
+const AT_ID = 57416
+const AT_AT_ID = 57417
+const STRING = 57418
+const VALUE_ARG = 57419
+const LIST_ARG = 57420
+const COMMENT = 57421
+const COMMENT_KEYWORD = 57422
+const QUOTE_ID = 57423
+const INTEGRAL = 57424
+const HEX = 57425
+const BIT_LITERAL = 57426
+const FLOAT = 57427
+const HEXNUM = 57428
+const NULL = 57429
+const TRUE = 57430
+const FALSE = 57431
+const LOWER_THAN_CHARSET = 57432
+const CHARSET = 57433
+const UNIQUE = 57434
+const KEY = 57435
+const OR = 57436
+const PIPE_CONCAT = 57437
+const XOR = 57438
+const AND = 57439
+const NOT = 57440
+const BETWEEN = 57441
+const CASE = 57442
+const WHEN = 57443
+const THEN = 57444
+const ELSE = 57445
+const END = 57446
+const ELSEIF = 57447
+const LOWER_THAN_EQ = 57448
+const LE = 57449
+const GE = 57450
+const NE = 57451
+const NULL_SAFE_EQUAL = 57452
+const IS = 57453
+const LIKE = 57454
+const REGEXP = 57455
+const IN = 57456
+const ASSIGNMENT = 57457
+const ILIKE = 57458
+const MEMBER = 57459
+const SHIFT_LEFT = 57460
+const SHIFT_RIGHT = 57461
+const DIV = 57462
+const MOD = 57463
+const UNARY = 57464
+const COLLATE = 57465
+const BINARY = 57466
+const UNDERSCORE_BINARY = 57467
+const INTERVAL = 57468
+const OUT = 57469
+const INOUT = 57470
+const BEGIN = 57471
+const START = 57472
+const TRANSACTION = 57473
+const COMMIT = 57474
+const ROLLBACK = 57475
+const WORK = 57476
+const CONSISTENT = 57477
+const SNAPSHOT = 57478
+const CHAIN = 57479
+const NO = 57480
+const RELEASE = 57481
+const PRIORITY = 57482
+const QUICK = 57483
+const BIT = 57484
+const TINYINT = 57485
+const SMALLINT = 57486
+const MEDIUMINT = 57487
+const INT = 57488
+const INTEGER = 57489
+const BIGINT = 57490
+const INTNUM = 57491
+const REAL = 57492
+const DOUBLE = 57493
+const FLOAT_TYPE = 57494
+const DECIMAL = 57495
+const NUMERIC = 57496
+const DECIMAL_VALUE = 57497
+const TIME = 57498
+const TIMESTAMP = 57499
+const DATETIME = 57500
+const YEAR = 57501
+const CHAR = 57502
+const VARCHAR = 57503
+const BOOL = 57504
+const CHARACTER = 57505
+const VARBINARY = 57506
+const NCHAR = 57507
+const TEXT = 57508
+const TINYTEXT = 57509
+const MEDIUMTEXT = 57510
+const LONGTEXT = 57511
+const BLOB = 57512
+const TINYBLOB = 57513
+const MEDIUMBLOB = 57514
+const LONGBLOB = 57515
+const JSON = 57516
+const ENUM = 57517
+const UUID = 57518
+const VECTOR = 57519
+const GEOMETRY = 57520
+const POINT = 57521
+const LINESTRING = 57522
+const POLYGON = 57523
+const GEOMETRYCOLLECTION = 57524
+const MULTIPOINT = 57525
+const MULTILINESTRING = 57526
+const MULTIPOLYGON = 57527
+const INT1 = 57528
+const INT2 = 57529
+const INT3 = 57530
+const INT4 = 57531
+const INT8 = 57532
+const S3OPTION = 57533
+const SQL_SMALL_RESULT = 57534
+const SQL_BIG_RESULT = 57535
+const SQL_BUFFER_RESULT = 57536
+const LOW_PRIORITY = 57537
+const HIGH_PRIORITY = 57538
+const DELAYED = 57539
+const CREATE = 57540
+const ALTER = 57541
+const DROP = 57542
+const RENAME = 57543
+const ANALYZE = 57544
+const ADD = 57545
+const RETURNS = 57546
+const MODIFY = 57547
+const CHANGE = 57548
+const AFTER = 57549
+const SCHEMA = 57550
+const TABLE = 57551
+const SEQUENCE = 57552
+const INDEX = 57553
+const VIEW = 57554
+const TO = 57555
+const IGNORE = 57556
+const IF = 57557
+const PRIMARY = 57558
+const COLUMN = 57559
+const CONSTRAINT = 57560
+const SPATIAL = 57561
+const FULLTEXT = 57562
+const FOREIGN = 57563
+const KEY_BLOCK_SIZE = 57564
+const SHOW = 57565
+const DESCRIBE = 57566
+const EXPLAIN = 57567
+const DATE = 57568
+const ESCAPE = 57569
+const REPAIR = 57570
+const OPTIMIZE = 57571
+const TRUNCATE = 57572
+const MAXVALUE = 57573
+const PARTITION = 57574
+const REORGANIZE = 57575
+const LESS = 57576
+const THAN = 57577
+const PROCEDURE = 57578
+const TRIGGER = 57579
+const BEFORE = 57580
+const EACH = 57581
+const EXCHANGE = 57582
+const VALIDATION = 57583
+const WITHOUT = 57584
+const MATERIALIZED = 57585
+const REFRESH = 57586
+const INCREMENTAL = 57587
+const STATUS = 57588
+const VARIABLES = 57589
+const ROLE = 57590
+const PROXY = 57591
+const AVG_ROW_LENGTH = 57592
+const STORAGE = 57593
+const DISK = 57594
+const MEMORY = 57595
+const CHECKSUM = 57596
+const COMPRESSION = 57597
+const DATA = 57598
+const DIRECTORY = 57599
+const DELAY_KEY_WRITE = 57600
+const ENCRYPTION = 57601
+const ENGINE = 57602
+const MAX_ROWS = 57603
+const MIN_ROWS = 57604
+const PACK_KEYS = 57605
+const ROW_FORMAT = 57606
+const STATS_AUTO_RECALC = 57607
+const STATS_PERSISTENT = 57608
+const STATS_SAMPLE_PAGES = 57609
+const DYNAMIC = 57610
+const COMPRESSED = 57611
+const REDUNDANT = 57612
+const COMPACT = 57613
+const FIXED = 57614
+const COLUMN_FORMAT = 57615
+const AUTO_RANDOM = 57616
+const RESTRICT = 57617
+const CASCADE = 57618
+const ACTION = 57619
+const PARTIAL = 57620
+const SIMPLE = 57621
+const CHECK = 57622
+const ENFORCED = 57623
+const RANGE = 57624
+const LIST = 57625
+const ALGORITHM = 57626
+const LINEAR = 57627
+const PARTITIONS = 57628
+const SUBPARTITION = 57629
+const SUBPARTITIONS = 57630
+const CLUSTER = 57631
+const TYPE = 57632
+const ANY = 57633
+const SOME = 57634
+const EXTERNAL = 57635
+const LOCALFILE = 57636
+const URL = 57637
+const PREPARE = 57638
+const DEALLOCATE = 57639
+const RESET = 57640
+const EXTENSION = 57641
+const INCREMENT = 57642
+const CYCLE = 57643
+const MINVALUE = 57644
+const PUBLICATION = 57645
+const SUBSCRIPTIONS = 57646
+const PUBLICATIONS = 57647
+const PROPERTIES = 57648
+const PARSER = 57649
+const VISIBLE = 57650
+const INVISIBLE = 57651
+const BTREE = 57652
+const HASH = 57653
+const RTREE = 57654
+const BSI = 57655
+const IVFFLAT = 57656
+const LISTS = 57657
+const ZONEMAP = 57658
+const LEADING = 57659
+const BOTH = 57660
+const TRAILING = 57661
+const UNKNOWN = 57662
+const EXPIRE = 57663
+const ACCOUNT = 57664
+const ACCOUNTS = 57665
+const UNLOCK = 57666
+const DAY = 57667
+const NEVER = 57668
+const PUMP = 57669
+const MYSQL_COMPATIBILITY_MODE = 57670
+const SECOND = 57671
+const ASCII = 57672
+const COALESCE = 57673
+const COLLATION = 57674
+const HOUR = 57675
+const MICROSECOND = 57676
+const MINUTE = 57677
+const MONTH = 57678
+const QUARTER = 57679
+const REPEAT = 57680
+const REVERSE = 57681
+const ROW_COUNT = 57682
+const WEEK = 57683
+const REVOKE = 57684
+const FUNCTION = 57685
+const PRIVILEGES = 57686
+const TABLESPACE = 57687
+const EXECUTE = 57688
+const SUPER = 57689
+const GRANT = 57690
+const OPTION = 57691
+const REFERENCES = 57692
+const REPLICATION = 57693
+const SLAVE = 57694
+const CLIENT = 57695
+const USAGE = 57696
+const RELOAD = 57697
+const FILE = 57698
+const TEMPORARY = 57699
+const ROUTINE = 57700
+const EVENT = 57701
+const SHUTDOWN = 57702
+const NULLX = 57703
+const AUTO_INCREMENT = 57704
+const APPROXNUM = 57705
+const SIGNED = 57706
+const UNSIGNED = 57707
+const ZEROFILL = 57708
+const ENGINES = 57709
+const LOW_CARDINALITY = 57710
+const ADMIN_NAME = 57711
+const RANDOM = 57712
+const SUSPEND = 57713
+const ATTRIBUTE = 57714
+const HISTORY = 57715
+const REUSE = 57716
+const CURRENT = 57717
+const OPTIONAL = 57718
+const FAILED_LOGIN_ATTEMPTS = 57719
+const PASSWORD_LOCK_TIME = 57720
+const UNBOUNDED = 57721
+const SECONDARY = 57722
+const USER = 57723
+const IDENTIFIED = 57724
+const CIPHER = 57725
+const ISSUER = 57726
+const X509 = 57727
+const SUBJECT = 57728
+const SAN = 57729
+const REQUIRE = 57730
+const SSL = 57731
+const NONE = 57732
+const PASSWORD = 57733
+const MAX_QUERIES_PER_HOUR = 57734
+const MAX_UPDATES_PER_HOUR = 57735
+const MAX_CONNECTIONS_PER_HOUR = 57736
+const MAX_USER_CONNECTIONS = 57737
+const FORMAT = 57738
+const VERBOSE = 57739
+const CONNECTION = 57740
+const TRIGGERS = 57741
+const PROFILES = 57742
+const LOAD = 57743
+const INFILE = 57744
+const TERMINATED = 57745
+const OPTIONALLY = 57746
+const ENCLOSED = 57747
+const ESCAPED = 57748
+const STARTING = 57749
+const LINES = 57750
+const ROWS = 57751
+const IMPORT = 57752
+const MODUMP = 57753
+const OVER = 57754
+const PRECEDING = 57755
+const FOLLOWING = 57756
+const GROUPS = 57757
+const DATABASES = 57758
+const TABLES = 57759
+const SEQUENCES = 57760
+const EXTENDED = 57761
+const FULL = 57762
+const PROCESSLIST = 57763
+const FIELDS = 57764
+const COLUMNS = 57765
+const OPEN = 57766
+const ERRORS = 57767
+const WARNINGS = 57768
+const INDEXES = 57769
+const SCHEMAS = 57770
+const NODE = 57771
+const LOCKS = 57772
+const ROLES = 57773
+const TABLE_NUMBER = 57774
+const COLUMN_NUMBER = 57775
+const TABLE_VALUES = 57776
+const TABLE_SIZE = 57777
+const NAMES = 57778
+const GLOBAL = 57779
+const PERSIST = 57780
+const SESSION = 57781
+const ISOLATION = 57782
+const LEVEL = 57783
+const READ = 57784
+const WRITE = 57785
+const ONLY = 57786
+const REPEATABLE = 57787
+const COMMITTED = 57788
+const UNCOMMITTED = 57789
+const SERIALIZABLE = 57790
+const LOCAL = 57791
+const EVENTS = 57792
+const PLUGINS = 57793
+const CURRENT_TIMESTAMP = 57794
+const DATABASE = 57795
+const CURRENT_TIME = 57796
+const LOCALTIME = 57797
+const LOCALTIMESTAMP = 57798
+const UTC_DATE = 57799
+const UTC_TIME = 57800
+const UTC_TIMESTAMP = 57801
+const REPLACE = 57802
+const CONVERT = 57803
+const SEPARATOR = 57804
+const TIMESTAMPDIFF = 57805
+const CURRENT_DATE = 57806
+const CURRENT_USER = 57807
+const CURRENT_ROLE = 57808
+const SECOND_MICROSECOND = 57809
+const MINUTE_MICROSECOND = 57810
+const MINUTE_SECOND = 57811
+const HOUR_MICROSECOND = 57812
+const HOUR_SECOND = 57813
+const HOUR_MINUTE = 57814
+const DAY_MICROSECOND = 57815
+const DAY_SECOND = 57816
+const DAY_MINUTE = 57817
+const DAY_HOUR = 57818
+const YEAR_MONTH = 57819
+const SQL_TSI_HOUR = 57820
+const SQL_TSI_DAY = 57821
+const SQL_TSI_WEEK = 57822
+const SQL_TSI_MONTH = 57823
+const SQL_TSI_QUARTER = 57824
+const SQL_TSI_YEAR = 57825
+const SQL_TSI_SECOND = 57826
+const SQL_TSI_MINUTE = 57827
+const RECURSIVE = 57828
+const CONFIG = 57829
+const DRAINER = 57830
+const MATCH = 57831
+const AGAINST = 57832
+const BOOLEAN = 57833
+const LANGUAGE = 57834
+const WITH = 57835
+const QUERY = 57836
+const EXPANSION = 57837
+const ADDDATE = 57838
+const BIT_AND = 57839
+const BIT_OR = 57840
+const BIT_XOR = 57841
+const CAST = 57842
+const COUNT = 57843
+const APPROX_COUNT_DISTINCT = 57844
+const APPROX_PERCENTILE = 57845
+const CURDATE = 57846
+const CURTIME = 57847
+const DATE_ADD = 57848
+const DATE_SUB = 57849
+const EXTRACT = 57850
+const GROUP_CONCAT = 57851
+const MAX = 57852
+const MID = 57853
+const MIN = 57854
+const NOW = 57855
+const POSITION = 57856
+const SESSION_USER = 57857
+const STD = 57858
+const STDDEV = 57859
+const MEDIAN = 57860
+const STDDEV_POP = 57861
+const STDDEV_SAMP = 57862
+const SUBDATE = 57863
+const SUBSTR = 57864
+const SUBSTRING = 57865
+const SUM = 57866
+const SYSDATE = 57867
+const SYSTEM_USER = 57868
+const TRANSLATE = 57869
+const TRIM = 57870
+const VARIANCE = 57871
+const VAR_POP = 57872
+const VAR_SAMP = 57873
+const AVG = 57874
+const RANK = 57875
+const NEXTVAL = 57876
+const SETVAL = 57877
+const CURRVAL = 57878
+const LASTVAL = 57879
+const ARROW = 57880
+const LONG_ARROW = 57881
+const JSON_TABLE = 57882
+const NESTED = 57883
+const ORDINALITY = 57884
+const PATH = 57885
+const ROW = 57886
+const OUTFILE = 57887
+const HEADER = 57888
+const MAX_FILE_SIZE = 57889
+const FORCE_QUOTE = 57890
+const PARALLEL = 57891
+const UNUSED = 57892
+const BINDINGS = 57893
+const DO = 57894
+const DECLARE = 57895
+const LOOP = 57896
+const WHILE = 57897
+const LEAVE = 57898
+const ITERATE = 57899
+const UNTIL = 57900
+const CALL = 57901
+const SPBEGIN = 57902
+const BACKEND = 57903
+const SERVERS = 57904
+const KILL = 57905
+const QUERY_RESULT = 57906
 
 var yyToknames = [...]string{
 	"$end",
@@ -608,6 +633,11 @@ var yyToknames = [...]string{
 	"VALUE",
 	"SHARE",
 	"MODE",
+	"OF",
+	"NOWAIT",
+	"SKIP",
+	"LOCKED",
+	"SAVEPOINT",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
 	"JOIN",
@@ -675,6 +705,7 @@ var yyToknames = [...]string{
 	"IN",
 	"ASSIGNMENT",
 	"ILIKE",
+	"MEMBER",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -745,6 +776,7 @@ var yyToknames = [...]string{
 	"JSON",
 	"ENUM",
 	"UUID",
+	"VECTOR",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -772,6 +804,9 @@ var yyToknames = [...]string{
 	"ANALYZE",
 	"ADD",
 	"RETURNS",
+	"MODIFY",
+	"CHANGE",
+	"AFTER",
 	"SCHEMA",
 	"TABLE",
 	"SEQUENCE",
@@ -802,6 +837,14 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"BEFORE",
+	"EACH",
+	"EXCHANGE",
+	"VALIDATION",
+	"WITHOUT",
+	"MATERIALIZED",
+	"REFRESH",
+	"INCREMENTAL",
 	"STATUS",
 	"VARIABLES",
 	"ROLE",
@@ -870,6 +913,8 @@ var yyToknames = [...]string{
 	"HASH",
 	"RTREE",
 	"BSI",
+	"IVFFLAT",
+	"LISTS",
 	"ZONEMAP",
 	"LEADING",
 	"BOTH",
@@ -1093,6 +1138,11 @@ var yyToknames = [...]string{
 	"CURRVAL",
 	"LASTVAL",
 	"ARROW",
+	"LONG_ARROW",
+	"JSON_TABLE",
+	"NESTED",
+	"ORDINALITY",
+	"PATH",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -1116,9 +1166,9 @@ var yyToknames = [...]string{
 	"QUERY_RESULT",
 	"';'",
 	"':'",
-	"'@'",
 	"'{'",
 	"'}'",
+	"'@'",
 }
 
 var yyStatenames = [...]string{}
@@ -1127,3890 +1177,4367 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:9411
+//line mysql_sql.y:9966
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 110,
-	21, 628,
-	-2, 609,
-	-1, 124,
-	218, 845,
-	-2, 916,
-	-1, 146,
-	42, 449,
-	218, 449,
-	245, 456,
-	246, 456,
-	424, 449,
-	-2, 482,
-	-1, 182,
-	558, 1576,
-	-2, 367,
-	-1, 500,
-	294, 130,
-	399, 130,
-	-2, 1489,
-	-1, 563,
-	67, 1295,
-	-2, 1630,
-	-1, 564,
-	67, 1313,
-	-2, 1601,
-	-1, 568,
-	67, 1314,
-	-2, 1629,
-	-1, 591,
-	67, 1225,
-	-2, 1691,
+	-1, 113,
+	21, 674,
+	-2, 646,
+	-1, 119,
+	230, 258,
+	-2, 263,
+	-1, 131,
+	228, 916,
+	-2, 989,
+	-1, 154,
+	42, 483,
+	228, 483,
+	263, 490,
+	264, 490,
+	444, 483,
+	-2, 516,
+	-1, 190,
+	583, 1673,
+	-2, 401,
+	-1, 528,
+	312, 134,
+	419, 134,
+	-2, 1576,
 	-1, 592,
-	67, 1226,
-	-2, 1690,
+	72, 1378,
+	-2, 1732,
 	-1, 593,
-	67, 1227,
-	-2, 1680,
-	-1, 594,
-	67, 1655,
-	-2, 1675,
-	-1, 595,
-	67, 1656,
-	-2, 1676,
-	-1, 596,
-	67, 1657,
-	-2, 1682,
+	72, 1396,
+	-2, 1700,
 	-1, 597,
-	67, 1658,
-	-2, 1665,
-	-1, 598,
-	67, 1659,
-	-2, 1673,
-	-1, 599,
-	67, 1660,
-	-2, 1683,
-	-1, 600,
-	67, 1661,
-	-2, 1684,
-	-1, 601,
-	67, 1662,
-	-2, 1689,
-	-1, 602,
-	67, 1663,
-	-2, 1694,
-	-1, 603,
-	67, 1664,
-	-2, 1695,
-	-1, 605,
-	67, 1292,
-	-2, 1481,
-	-1, 612,
-	67, 1301,
-	-2, 1507,
-	-1, 616,
-	67, 1305,
-	-2, 1547,
-	-1, 617,
-	67, 1306,
-	-2, 1625,
+	72, 1397,
+	-2, 1731,
+	-1, 620,
+	72, 1308,
+	-2, 1793,
+	-1, 621,
+	72, 1309,
+	-2, 1792,
+	-1, 622,
+	72, 1310,
+	-2, 1782,
+	-1, 623,
+	72, 1757,
+	-2, 1777,
+	-1, 624,
+	72, 1758,
+	-2, 1778,
 	-1, 625,
-	67, 1316,
-	-2, 1610,
+	72, 1759,
+	-2, 1784,
+	-1, 626,
+	72, 1760,
+	-2, 1767,
 	-1, 627,
-	67, 1318,
-	-2, 1620,
+	72, 1761,
+	-2, 1775,
 	-1, 628,
-	67, 1319,
-	-2, 1645,
-	-1, 639,
-	67, 1203,
-	-2, 1685,
-	-1, 640,
-	67, 1204,
-	-2, 1686,
+	72, 1762,
+	-2, 1785,
+	-1, 629,
+	72, 1763,
+	-2, 1786,
+	-1, 630,
+	72, 1764,
+	-2, 1791,
+	-1, 631,
+	72, 1765,
+	-2, 1796,
+	-1, 632,
+	72, 1766,
+	-2, 1797,
+	-1, 634,
+	72, 1375,
+	-2, 1568,
 	-1, 641,
-	67, 1205,
-	-2, 1687,
+	72, 1384,
+	-2, 1595,
 	-1, 645,
-	21, 629,
-	-2, 592,
-	-1, 715,
-	419, 482,
-	420, 482,
-	-2, 450,
-	-1, 757,
-	105, 1481,
-	116, 1481,
-	136, 1481,
-	-2, 1456,
-	-1, 857,
-	21, 629,
-	-2, 592,
-	-1, 956,
-	21, 628,
-	-2, 1108,
-	-1, 1298,
-	67, 1363,
-	-2, 1627,
-	-1, 1299,
-	67, 1364,
-	-2, 1628,
-	-1, 1431,
-	68, 770,
-	-2, 776,
-	-1, 1757,
-	68, 1442,
-	137, 1442,
-	-2, 1612,
-	-1, 1758,
-	68, 1442,
-	137, 1442,
-	-2, 1611,
-	-1, 1759,
-	68, 1420,
-	137, 1420,
-	-2, 1598,
-	-1, 1760,
-	68, 1421,
-	137, 1421,
-	-2, 1603,
-	-1, 1761,
-	68, 1422,
-	137, 1422,
-	-2, 1535,
-	-1, 1762,
-	68, 1423,
-	137, 1423,
-	-2, 1529,
-	-1, 1763,
-	68, 1424,
-	137, 1424,
-	-2, 1472,
-	-1, 1764,
-	68, 1425,
-	137, 1425,
+	72, 1388,
+	-2, 1637,
+	-1, 646,
+	72, 1389,
+	-2, 1727,
+	-1, 654,
+	72, 1399,
+	-2, 1709,
+	-1, 656,
+	72, 1401,
+	-2, 1722,
+	-1, 657,
+	72, 1402,
+	-2, 1747,
+	-1, 668,
+	72, 1281,
+	-2, 1787,
+	-1, 669,
+	72, 1282,
+	-2, 1788,
+	-1, 670,
+	72, 1283,
+	-2, 1789,
+	-1, 674,
+	21, 675,
+	-2, 629,
+	-1, 695,
+	230, 259,
+	-2, 264,
+	-1, 751,
+	439, 516,
+	440, 516,
+	-2, 484,
+	-1, 793,
+	110, 1568,
+	121, 1568,
+	142, 1568,
+	-2, 1541,
+	-1, 896,
+	21, 675,
+	-2, 629,
+	-1, 998,
+	21, 674,
+	-2, 1182,
+	-1, 1349,
+	72, 1447,
+	-2, 1729,
+	-1, 1350,
+	72, 1448,
+	-2, 1730,
+	-1, 1489,
+	73, 840,
+	-2, 846,
+	-1, 1838,
+	73, 1527,
+	143, 1527,
+	-2, 1711,
+	-1, 1839,
+	73, 1527,
+	143, 1527,
+	-2, 1710,
+	-1, 1840,
+	73, 1504,
+	143, 1504,
+	-2, 1697,
+	-1, 1841,
+	73, 1505,
+	143, 1505,
+	-2, 1702,
+	-1, 1842,
+	73, 1506,
+	143, 1506,
+	-2, 1624,
+	-1, 1843,
+	73, 1507,
+	143, 1507,
+	-2, 1617,
+	-1, 1844,
+	73, 1508,
+	143, 1508,
+	-2, 1558,
+	-1, 1845,
+	73, 1509,
+	143, 1509,
+	-2, 1699,
+	-1, 1846,
+	73, 1510,
+	143, 1510,
+	-2, 1622,
+	-1, 1847,
+	73, 1511,
+	143, 1511,
+	-2, 1616,
+	-1, 1848,
+	73, 1512,
+	143, 1512,
+	-2, 1609,
+	-1, 1850,
+	73, 1515,
+	143, 1515,
+	-2, 1747,
+	-1, 1852,
+	73, 1495,
+	143, 1495,
+	-2, 1732,
+	-1, 1853,
+	73, 1525,
+	143, 1525,
+	-2, 1700,
+	-1, 1854,
+	73, 1525,
+	143, 1525,
+	-2, 1731,
+	-1, 1855,
+	73, 1525,
+	143, 1525,
+	-2, 1577,
+	-1, 1856,
+	73, 1523,
+	143, 1523,
+	-2, 1722,
+	-1, 1857,
+	73, 1520,
+	143, 1520,
 	-2, 1600,
-	-1, 1765,
-	68, 1426,
-	137, 1426,
-	-2, 1533,
-	-1, 1766,
-	68, 1427,
-	137, 1427,
-	-2, 1528,
-	-1, 1767,
-	68, 1428,
-	137, 1428,
-	-2, 1521,
-	-1, 1769,
-	68, 1431,
-	137, 1431,
-	-2, 1645,
-	-1, 1770,
-	68, 1411,
-	137, 1411,
-	-2, 1630,
-	-1, 1771,
-	68, 1440,
-	137, 1440,
-	-2, 1601,
-	-1, 1772,
-	68, 1440,
-	137, 1440,
-	-2, 1629,
-	-1, 1773,
-	68, 1440,
-	137, 1440,
-	-2, 1490,
-	-1, 1774,
-	68, 1438,
-	137, 1438,
-	-2, 1620,
-	-1, 1775,
-	68, 1435,
-	137, 1435,
-	-2, 1512,
-	-1, 1776,
-	67, 1393,
-	68, 1393,
-	137, 1393,
-	361, 1393,
-	362, 1393,
-	363, 1393,
-	-2, 1471,
-	-1, 1777,
-	67, 1394,
-	68, 1394,
-	137, 1394,
-	361, 1394,
-	362, 1394,
-	363, 1394,
-	-2, 1473,
-	-1, 1778,
-	67, 1397,
-	68, 1397,
-	137, 1397,
-	361, 1397,
-	362, 1397,
-	363, 1397,
-	-2, 1602,
-	-1, 1779,
-	67, 1399,
-	68, 1399,
-	137, 1399,
-	361, 1399,
-	362, 1399,
-	363, 1399,
-	-2, 1585,
-	-1, 1780,
-	67, 1401,
-	68, 1401,
-	137, 1401,
-	361, 1401,
-	362, 1401,
-	363, 1401,
-	-2, 1534,
-	-1, 1781,
-	67, 1403,
-	68, 1403,
-	137, 1403,
-	361, 1403,
-	362, 1403,
-	363, 1403,
-	-2, 1517,
-	-1, 1782,
-	67, 1404,
-	68, 1404,
-	137, 1404,
-	361, 1404,
-	362, 1404,
-	363, 1404,
-	-2, 1518,
-	-1, 1783,
-	67, 1406,
-	68, 1406,
-	137, 1406,
-	361, 1406,
-	362, 1406,
-	363, 1406,
-	-2, 1470,
-	-1, 1784,
-	68, 1445,
-	137, 1445,
-	361, 1445,
-	362, 1445,
-	363, 1445,
-	-2, 1495,
-	-1, 1785,
-	68, 1445,
-	137, 1445,
-	361, 1445,
-	362, 1445,
-	363, 1445,
-	-2, 1508,
-	-1, 1786,
-	68, 1448,
-	137, 1448,
-	361, 1448,
-	362, 1448,
-	363, 1448,
-	-2, 1491,
-	-1, 1787,
-	68, 1445,
-	137, 1445,
-	361, 1445,
-	362, 1445,
-	363, 1445,
-	-2, 1570,
-	-1, 1800,
-	88, 880,
-	132, 880,
-	171, 880,
-	174, 880,
-	258, 880,
-	-2, 873,
-	-1, 1911,
-	21, 628,
-	-2, 720,
-	-1, 2090,
-	88, 880,
-	132, 880,
-	171, 880,
-	174, 880,
-	258, 880,
-	-2, 874,
-	-1, 2102,
-	65, 536,
-	137, 536,
-	-2, 1011,
-	-1, 2120,
-	279, 1076,
-	-2, 1055,
-	-1, 2381,
-	279, 1076,
-	-2, 1056,
-	-1, 2515,
-	88, 880,
-	132, 880,
-	171, 880,
-	174, 880,
-	-2, 959,
+	-1, 1858,
+	72, 1477,
+	73, 1477,
+	143, 1477,
+	381, 1477,
+	382, 1477,
+	383, 1477,
+	-2, 1557,
+	-1, 1859,
+	72, 1478,
+	73, 1478,
+	143, 1478,
+	381, 1478,
+	382, 1478,
+	383, 1478,
+	-2, 1559,
+	-1, 1860,
+	72, 1481,
+	73, 1481,
+	143, 1481,
+	381, 1481,
+	382, 1481,
+	383, 1481,
+	-2, 1701,
+	-1, 1861,
+	72, 1483,
+	73, 1483,
+	143, 1483,
+	381, 1483,
+	382, 1483,
+	383, 1483,
+	-2, 1684,
+	-1, 1862,
+	72, 1485,
+	73, 1485,
+	143, 1485,
+	381, 1485,
+	382, 1485,
+	383, 1485,
+	-2, 1623,
+	-1, 1863,
+	72, 1487,
+	73, 1487,
+	143, 1487,
+	381, 1487,
+	382, 1487,
+	383, 1487,
+	-2, 1605,
+	-1, 1864,
+	72, 1488,
+	73, 1488,
+	143, 1488,
+	381, 1488,
+	382, 1488,
+	383, 1488,
+	-2, 1606,
+	-1, 1865,
+	72, 1490,
+	73, 1490,
+	143, 1490,
+	381, 1490,
+	382, 1490,
+	383, 1490,
+	-2, 1556,
+	-1, 1866,
+	73, 1530,
+	143, 1530,
+	381, 1530,
+	382, 1530,
+	383, 1530,
+	-2, 1582,
+	-1, 1867,
+	73, 1530,
+	143, 1530,
+	381, 1530,
+	382, 1530,
+	383, 1530,
+	-2, 1596,
+	-1, 1868,
+	73, 1533,
+	143, 1533,
+	381, 1533,
+	382, 1533,
+	383, 1533,
+	-2, 1578,
+	-1, 1869,
+	73, 1530,
+	143, 1530,
+	381, 1530,
+	382, 1530,
+	383, 1530,
+	-2, 1664,
+	-1, 1882,
+	93, 953,
+	138, 953,
+	177, 953,
+	180, 953,
+	276, 953,
+	-2, 946,
+	-1, 2003,
+	21, 674,
+	-2, 766,
+	-1, 2199,
+	93, 953,
+	138, 953,
+	177, 953,
+	180, 953,
+	276, 953,
+	-2, 947,
+	-1, 2211,
+	70, 573,
+	143, 573,
+	-2, 1084,
+	-1, 2237,
+	297, 1150,
+	-2, 1129,
 	-1, 2518,
-	88, 880,
-	132, 880,
-	171, 880,
-	174, 880,
-	-2, 959,
-	-1, 2528,
-	65, 536,
-	137, 536,
-	-2, 1012,
-	-1, 2627,
-	88, 880,
-	132, 880,
-	171, 880,
-	174, 880,
-	-2, 960,
-	-1, 2917,
-	68, 931,
-	137, 931,
-	-2, 880,
-	-1, 2921,
-	68, 931,
-	137, 931,
-	-2, 880,
-	-1, 2935,
-	68, 935,
-	137, 935,
-	-2, 880,
-	-1, 2940,
-	68, 936,
-	137, 936,
-	-2, 880,
+	297, 1150,
+	-2, 1130,
+	-1, 2669,
+	93, 953,
+	138, 953,
+	177, 953,
+	180, 953,
+	-2, 1032,
+	-1, 2672,
+	93, 953,
+	138, 953,
+	177, 953,
+	180, 953,
+	-2, 1032,
+	-1, 2682,
+	70, 573,
+	143, 573,
+	-2, 1085,
+	-1, 2797,
+	93, 953,
+	138, 953,
+	177, 953,
+	180, 953,
+	-2, 1033,
+	-1, 2812,
+	73, 1004,
+	143, 1004,
+	-2, 953,
+	-1, 2900,
+	73, 1004,
+	143, 1004,
+	-2, 953,
+	-1, 3033,
+	73, 1008,
+	143, 1008,
+	-2, 953,
+	-1, 3084,
+	73, 1009,
+	143, 1009,
+	-2, 953,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 34934
+const yyLast = 39634
 
 var yyAct = [...]int{
-	530, 1217, 1494, 2920, 2921, 2621, 173, 2929, 509, 2900,
-	1279, 511, 2811, 2859, 2851, 532, 2594, 2829, 2599, 2393,
-	2687, 2770, 2659, 2771, 2738, 1735, 2470, 2754, 1091, 2758,
-	2620, 2681, 646, 2471, 2619, 987, 2703, 1208, 2597, 419,
-	2671, 1452, 2105, 2648, 560, 2626, 2538, 1282, 425, 2186,
-	430, 430, 2358, 2589, 1275, 1552, 430, 446, 453, 2187,
-	1142, 453, 2498, 2171, 158, 1838, 2182, 2405, 2179, 2382,
-	2468, 1995, 1645, 1549, 1614, 2456, 2208, 2185, 1755, 513,
-	851, 464, 2439, 2328, 1745, 1527, 2404, 2333, 1905, 1497,
-	1841, 1049, 2330, 1809, 1753, 1857, 1454, 2091, 458, 2356,
-	2238, 1994, 1535, 1133, 1641, 1566, 1199, 502, 2277, 503,
-	1623, 1622, 508, 53, 1615, 1945, 2221, 756, 1545, 1067,
-	1588, 1640, 1413, 1530, 762, 1528, 1906, 692, 748, 2069,
-	1894, 2122, 6, 1839, 2073, 1490, 1065, 169, 8, 1439,
-	1204, 1421, 168, 7, 1808, 1962, 1673, 806, 1642, 36,
-	419, 1209, 429, 429, 1273, 512, 1173, 424, 437, 1751,
-	1151, 109, 1793, 1278, 2037, 1464, 1328, 418, 501, 1264,
-	35, 520, 1312, 173, 14, 173, 1652, 797, 798, 26,
-	868, 1463, 1023, 1604, 1621, 760, 503, 1618, 1080, 1180,
-	1578, 1272, 747, 1913, 1438, 1099, 1481, 643, 439, 15,
-	1076, 442, 691, 13, 1334, 1333, 451, 466, 23, 16,
-	10, 1125, 159, 2036, 1047, 1092, 1100, 467, 1172, 152,
-	155, 710, 689, 988, 510, 2271, 452, 2271, 1659, 1997,
-	1649, 449, 2463, 1951, 1949, 645, 450, 793, 1948, 795,
-	1946, 1187, 1183, 794, 789, 790, 790, 157, 790, 461,
-	426, 1112, 1185, 2587, 2234, 2232, 447, 1593, 2677, 2672,
-	448, 2590, 2469, 1417, 722, 982, 2747, 766, 925, 926,
-	927, 924, 1617, 435, 925, 926, 927, 924, 644, 2802,
-	654, 1039, 2611, 2722, 156, 1216, 156, 456, 2612, 888,
-	788, 2713, 462, 156, 156, 8, 49, 148, 125, 156,
-	7, 49, 148, 125, 1231, 156, 1224, 156, 156, 49,
-	148, 125, 156, 1990, 2300, 763, 1982, 156, 1646, 1657,
-	1228, 1797, 1221, 680, 765, 1926, 922, 1354, 463, 2253,
-	1108, 736, 1040, 1109, 735, 2714, 1425, 1426, 1927, 903,
-	2071, 1230, 904, 1223, 2246, 915, 1368, 1963, 108, 1564,
-	153, 153, 2847, 108, 1088, 731, 153, 1249, 2845, 1477,
-	647, 1281, 153, 920, 153, 153, 759, 1095, 758, 153,
-	906, 1094, 1097, 1098, 153, 634, 655, 633, 635, 636,
-	2607, 637, 638, 1265, 1097, 1098, 1269, 2774, 2775, 2748,
-	2749, 1728, 2679, 2070, 2833, 2834, 772, 767, 771, 773,
-	925, 926, 927, 924, 2682, 2683, 2684, 2685, 2472, 2740,
-	1268, 2239, 2740, 2240, 2743, 2241, 1111, 740, 2675, 2472,
-	871, 1977, 862, 777, 2753, 2481, 1538, 770, 1284, 430,
-	1546, 2801, 2499, 1653, 737, 2344, 2506, 2076, 2334, 430,
-	861, 908, 901, 1260, 909, 860, 1884, 1792, 1601, 682,
-	2695, 677, 2400, 667, 2266, 453, 453, 2264, 430, 2061,
-	679, 678, 2617, 1186, 1184, 1193, 1192, 1987, 856, 858,
-	918, 919, 911, 917, 891, 775, 1542, 665, 2588, 1350,
-	2233, 671, 778, 1347, 2698, 2175, 1270, 1349, 1346, 1348,
-	1352, 1353, 124, 739, 154, 1351, 800, 1887, 1886, 768,
-	2614, 902, 2840, 2338, 2342, 2606, 2349, 1267, 1890, 2804,
-	2805, 2608, 855, 761, 146, 497, 958, 2355, 499, 871,
-	776, 2362, 676, 498, 2413, 2414, 675, 2773, 2710, 2849,
-	1086, 853, 664, 1283, 455, 2763, 670, 2098, 454, 913,
-	914, 859, 2559, 883, 907, 2649, 2650, 2651, 2653, 2652,
-	2759, 2914, 2930, 668, 1120, 1658, 2339, 2340, 769, 766,
-	879, 857, 861, 2868, 1110, 738, 2844, 992, 2729, 896,
-	2813, 2341, 898, 905, 666, 1290, 1293, 1294, 1867, 2875,
-	912, 2879, 1075, 1562, 1563, 2551, 1291, 1866, 683, 1662,
-	1664, 1665, 2420, 451, 451, 2546, 504, 2854, 2336, 2156,
-	899, 873, 872, 910, 2542, 2564, 2565, 763, 2085, 2086,
-	2087, 2088, 669, 2661, 2082, 1129, 765, 1266, 449, 449,
-	1128, 1090, 1089, 450, 450, 2014, 2809, 2810, 766, 2813,
-	774, 881, 1357, 1358, 1359, 1360, 1361, 1362, 1355, 1356,
-	2931, 991, 1647, 447, 447, 864, 865, 448, 448, 2485,
-	1073, 2270, 1072, 846, 843, 844, 845, 2901, 1071, 2019,
-	2704, 2018, 2017, 2015, 852, 1045, 425, 1048, 2316, 1647,
-	1844, 1647, 892, 2937, 2520, 1020, 763, 880, 1674, 2711,
-	2585, 876, 877, 866, 681, 765, 2925, 790, 790, 790,
-	1050, 692, 2803, 964, 790, 894, 462, 790, 2737, 790,
-	873, 872, 2712, 1126, 1097, 1098, 1983, 897, 900, 1916,
-	1097, 1098, 2210, 2212, 1650, 1660, 2855, 2075, 1648, 2750,
-	2751, 1947, 1856, 1847, 1055, 2016, 2269, 1188, 1059, 1096,
-	2335, 893, 2345, 888, 644, 1058, 1087, 430, 1093, 1122,
-	960, 961, 962, 963, 2696, 1547, 50, 1057, 2267, 2850,
-	419, 419, 419, 419, 50, 457, 1146, 1146, 2613, 430,
-	1051, 1052, 1053, 1054, 126, 1056, 126, 791, 792, 1060,
-	2079, 2080, 796, 126, 126, 2324, 453, 1048, 425, 126,
-	1176, 1176, 1539, 1991, 2078, 126, 2337, 126, 126, 1153,
-	882, 173, 126, 1000, 1001, 2618, 761, 126, 2660, 1261,
-	419, 732, 1661, 895, 1843, 1851, 2547, 2548, 1292, 1845,
-	1144, 1144, 2924, 686, 687, 688, 887, 1148, 1074, 732,
-	2279, 2278, 1663, 1046, 1062, 1084, 2157, 2159, 2160, 2161,
-	2158, 2544, 1541, 1102, 1103, 2543, 1105, 1106, 1107, 1118,
-	2060, 2852, 2853, 1194, 2936, 2353, 1741, 1740, 1215, 1739,
-	1218, 1848, 660, 2020, 2021, 1226, 1043, 1140, 1141, 1428,
-	1846, 1152, 1025, 1861, 781, 786, 787, 1429, 1027, 1041,
-	1042, 1738, 2211, 1427, 656, 1247, 1242, 1243, 1136, 1137,
-	1138, 1139, 684, 657, 734, 1232, 2634, 733, 1146, 645,
-	1146, 861, 1082, 1083, 648, 2898, 1280, 2880, 923, 1748,
-	2367, 1262, 734, 659, 1121, 733, 1705, 662, 661, 1704,
-	2436, 1455, 1455, 1064, 1197, 2943, 1200, 1201, 1077, 1081,
-	1081, 1081, 1749, 1750, 2942, 1113, 1114, 648, 1189, 925,
-	926, 927, 924, 1850, 1101, 1169, 2432, 1104, 1854, 1852,
-	2933, 1077, 1077, 1853, 2915, 2910, 2904, 1300, 1301, 1302,
-	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 888,
-	1127, 2903, 1078, 1323, 1324, 766, 1206, 1207, 1246, 766,
-	1332, 2354, 2104, 2884, 886, 2298, 1245, 2103, 923, 1371,
-	1372, 1373, 1154, 1381, 923, 1117, 435, 1119, 1965, 1123,
-	1124, 1168, 1387, 923, 1277, 1388, 1211, 2516, 1214, 1177,
-	2861, 1222, 1167, 1178, 2823, 1229, 1390, 1395, 1396, 2934,
-	1729, 2781, 923, 1655, 2911, 1655, 451, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1256, 2776, 2731, 1171, 2730,
-	1655, 741, 1904, 2436, 1258, 783, 784, 785, 2727, 2726,
-	1233, 449, 1655, 1295, 2725, 1021, 450, 2724, 2699, 1411,
-	430, 1274, 1437, 1146, 1441, 1255, 1443, 1444, 2566, 2422,
-	1581, 430, 1079, 2205, 692, 885, 447, 1453, 1238, 2862,
-	448, 1146, 1903, 2824, 1234, 1252, 1982, 1122, 1414, 1251,
-	2700, 645, 446, 854, 1254, 1253, 1250, 2042, 1998, 1980,
-	1974, 1795, 1904, 1380, 1271, 2700, 2732, 2066, 1813, 2104,
-	1972, 1476, 928, 2063, 1967, 1960, 1276, 2700, 2700, 1482,
-	1482, 957, 1122, 2700, 1122, 1122, 2700, 2700, 430, 966,
-	1437, 1437, 1314, 1480, 1146, 1525, 1537, 1928, 2423, 1436,
-	1263, 419, 1904, 1146, 533, 542, 1442, 1958, 886, 1970,
-	534, 971, 541, 535, 539, 538, 536, 537, 1956, 1445,
-	1446, 1447, 1434, 1954, 1812, 1928, 923, 923, 1813, 1968,
-	430, 1437, 1146, 1448, 1571, 430, 430, 1574, 1646, 1973,
-	1730, 1832, 1577, 1968, 1961, 1734, 1583, 1321, 1322, 1904,
-	1366, 1469, 1579, 173, 1709, 1733, 173, 173, 1794, 173,
-	1637, 1560, 1461, 1462, 1713, 543, 1475, 1520, 1521, 1478,
-	1479, 1063, 1484, 1326, 1130, 1712, 1959, 1392, 1418, 1471,
-	1472, 1543, 1703, 2863, 1557, 1558, 1440, 1955, 2531, 1412,
-	1488, 1694, 1955, 1813, 1381, 1381, 1625, 540, 1693, 1692,
-	1654, 1381, 1381, 2368, 1458, 1239, 1632, 1548, 1568, 1729,
-	1553, 1554, 1555, 1556, 1570, 1915, 2223, 1592, 2106, 1985,
-	1595, 1596, 888, 1598, 925, 926, 927, 924, 1363, 1364,
-	1453, 1367, 1567, 923, 1146, 1644, 1450, 1567, 1567, 1382,
-	1572, 1573, 1460, 1449, 923, 1984, 1465, 1485, 1467, 1468,
-	1466, 923, 1389, 1976, 1391, 1474, 1829, 1440, 1077, 2505,
-	923, 1473, 1700, 1456, 1457, 1486, 1487, 923, 923, 1655,
-	1732, 1638, 1470, 1626, 1240, 1685, 1483, 1636, 1586, 1433,
-	1235, 969, 874, 1081, 854, 854, 849, 1667, 1524, 1526,
-	847, 1559, 940, 1544, 2363, 1274, 2372, 2261, 1671, 1672,
-	1370, 1369, 1134, 658, 1620, 1068, 1565, 766, 2764, 1069,
-	2635, 1620, 2523, 1135, 766, 1078, 2521, 2893, 1132, 2881,
-	1393, 1394, 1684, 1569, 1397, 1398, 1399, 1400, 1402, 1403,
-	1404, 1405, 1406, 1407, 1408, 1409, 2461, 1858, 1587, 1589,
-	943, 944, 945, 946, 947, 940, 1175, 1175, 925, 926,
-	927, 924, 2765, 2364, 2636, 763, 2524, 1606, 2437, 451,
-	2522, 2427, 763, 2424, 765, 1710, 2272, 2177, 1971, 1918,
-	863, 765, 1717, 938, 948, 949, 941, 942, 943, 944,
-	945, 946, 947, 940, 449, 1630, 1629, 1631, 1627, 450,
-	1946, 1635, 2005, 1401, 1683, 1940, 1329, 2365, 1680, 766,
-	1131, 502, 1639, 861, 1788, 1181, 1590, 1590, 1756, 447,
-	1329, 1634, 2225, 448, 1435, 1079, 430, 430, 430, 663,
-	1810, 941, 942, 943, 944, 945, 946, 947, 940, 2554,
-	1817, 1122, 1320, 925, 926, 927, 924, 2798, 1666, 1675,
-	924, 1822, 927, 924, 2464, 2553, 2242, 763, 1317, 1319,
-	1316, 2134, 1318, 2133, 2128, 1122, 765, 2126, 1314, 1668,
-	2535, 2919, 861, 2907, 1679, 2869, 2864, 1837, 939, 938,
-	948, 949, 941, 942, 943, 944, 945, 946, 947, 940,
-	925, 926, 927, 924, 2814, 2789, 2766, 1707, 2715, 2462,
-	1285, 1286, 1287, 1288, 1289, 931, 932, 933, 934, 935,
-	936, 937, 929, 1908, 1908, 1537, 1908, 925, 926, 927,
-	924, 2180, 1669, 1670, 1833, 2878, 2007, 2615, 1802, 1803,
-	1804, 2503, 861, 925, 926, 927, 924, 992, 1819, 1820,
-	1146, 430, 1942, 1385, 1330, 1331, 2673, 2641, 1823, 1824,
-	1365, 1789, 2638, 1821, 1386, 1727, 861, 425, 1375, 2167,
-	1176, 1756, 1537, 2637, 2717, 1935, 2616, 1937, 2525, 2877,
-	2504, 173, 1859, 1825, 1862, 1863, 1864, 1865, 2502, 1742,
-	1868, 1869, 1870, 1871, 1872, 1873, 1874, 1875, 1876, 1877,
-	1878, 1879, 1880, 1881, 1924, 1860, 2343, 1796, 2166, 1415,
-	1912, 2257, 1910, 1419, 1914, 1831, 1422, 1919, 1920, 1921,
-	1922, 991, 925, 926, 927, 924, 2237, 1978, 2236, 2151,
-	1644, 1696, 1818, 1950, 2291, 2329, 2150, 1146, 766, 1146,
-	1830, 1146, 1736, 1737, 2165, 1941, 861, 925, 926, 927,
-	924, 1992, 2149, 1152, 2839, 2163, 1182, 1934, 2686, 1828,
-	1826, 2146, 2153, 1827, 2140, 1932, 925, 926, 927, 924,
-	925, 926, 927, 924, 1939, 1146, 2023, 1888, 1181, 2290,
-	2137, 2136, 497, 2164, 1695, 499, 763, 1081, 2029, 2768,
-	498, 2030, 1609, 1988, 2162, 765, 1146, 925, 926, 927,
-	924, 2152, 925, 926, 927, 924, 2032, 925, 926, 927,
-	924, 1925, 925, 926, 927, 924, 2757, 1608, 1607, 1603,
-	1415, 2601, 1602, 1236, 1931, 1930, 1933, 1415, 1415, 1144,
-	1038, 2595, 2835, 2034, 2799, 2022, 2735, 2697, 861, 925,
-	926, 927, 924, 2064, 925, 926, 927, 924, 2674, 2625,
-	1144, 2009, 2593, 2591, 2570, 2568, 2031, 2172, 2908, 1688,
-	2537, 2501, 1989, 2518, 925, 926, 927, 924, 1591, 2500,
-	2600, 1594, 2497, 2490, 1597, 2053, 2484, 1599, 1981, 2003,
-	1996, 2431, 2429, 1979, 2418, 1146, 2417, 2321, 2083, 2320,
-	2268, 1986, 1437, 925, 926, 927, 924, 2235, 2102, 2216,
-	1274, 2154, 2147, 2563, 2108, 2143, 1999, 2000, 939, 938,
-	948, 949, 941, 942, 943, 944, 945, 946, 947, 940,
-	2117, 2013, 2142, 2141, 1731, 2067, 925, 926, 927, 924,
-	590, 589, 2517, 2125, 2487, 925, 926, 927, 924, 2294,
-	1611, 2130, 2131, 2132, 2446, 1605, 1424, 2135, 1237, 999,
-	995, 994, 970, 850, 2515, 1201, 2489, 925, 926, 927,
-	924, 1908, 925, 926, 927, 924, 2057, 2054, 2476, 2093,
-	2467, 2168, 2466, 2455, 2454, 2099, 2373, 419, 2072, 2296,
-	2092, 1437, 861, 1537, 1537, 1537, 1537, 2188, 2109, 2002,
-	2289, 2281, 2276, 156, 861, 1537, 148, 125, 1908, 2188,
-	2100, 2220, 2065, 1206, 1207, 2062, 2120, 1146, 2111, 1957,
-	1953, 1952, 2113, 1677, 1718, 1708, 1681, 2038, 1706, 430,
-	430, 2123, 2043, 2124, 2081, 2123, 1702, 1211, 2110, 1214,
-	1701, 1699, 1690, 173, 2107, 1687, 2114, 2115, 173, 8,
-	1686, 1610, 2101, 2201, 7, 1410, 2138, 2139, 1440, 1384,
-	153, 2932, 2144, 2145, 2293, 1691, 1383, 1374, 2119, 1381,
-	1158, 1381, 2121, 1698, 2252, 1156, 2127, 2256, 156, 2892,
-	2174, 2886, 2876, 1146, 2558, 2292, 2263, 925, 926, 927,
-	924, 1711, 2873, 2871, 1714, 1715, 1716, 2148, 2788, 1719,
-	1720, 1721, 1722, 1723, 1724, 1725, 1726, 2226, 925, 926,
-	927, 924, 2230, 2173, 2733, 2176, 989, 1196, 2189, 2190,
-	2191, 2192, 1414, 2178, 2116, 2657, 2645, 2251, 2202, 2642,
-	2579, 2218, 2219, 2200, 2204, 153, 645, 2217, 2214, 2112,
-	2577, 2561, 2203, 2560, 2249, 2051, 649, 650, 651, 652,
-	2255, 2050, 1814, 2557, 2556, 2284, 2224, 2286, 2550, 648,
-	2228, 2510, 2265, 1205, 2227, 2260, 1198, 861, 925, 926,
-	927, 924, 1756, 2332, 925, 926, 927, 924, 1682, 2248,
-	2243, 766, 2250, 2347, 1066, 430, 2049, 2259, 766, 2245,
-	2169, 2129, 2096, 2095, 2094, 861, 861, 861, 1210, 2273,
-	1837, 1837, 1837, 1213, 1537, 1810, 1202, 2371, 1157, 925,
-	926, 927, 924, 2375, 2274, 2052, 1966, 2247, 1917, 1882,
-	2280, 2282, 2283, 2403, 2254, 2406, 1811, 2406, 2406, 2287,
-	2288, 1315, 153, 1575, 2411, 925, 926, 927, 924, 1146,
-	1146, 2048, 1432, 2323, 1431, 1259, 1225, 1203, 2322, 1022,
-	1415, 1415, 1415, 1415, 1019, 2317, 1018, 1017, 1016, 2285,
-	1015, 2325, 1014, 1013, 925, 926, 927, 924, 1012, 1011,
-	430, 1010, 1009, 1008, 1007, 2332, 1175, 1006, 1816, 2047,
-	2092, 2366, 766, 1005, 1437, 1437, 2401, 2350, 2351, 2369,
-	2402, 545, 110, 1144, 1144, 2359, 2360, 110, 2370, 2415,
-	2416, 2213, 925, 926, 927, 924, 1004, 2374, 1003, 1002,
-	2352, 2376, 2377, 2407, 2408, 2046, 998, 997, 2327, 996,
-	2409, 993, 986, 985, 2023, 983, 982, 981, 980, 979,
-	978, 977, 766, 2465, 976, 975, 2378, 974, 925, 926,
-	927, 924, 973, 972, 968, 436, 967, 890, 110, 2301,
-	2433, 2434, 848, 2302, 2303, 2304, 2305, 2425, 2306, 2307,
-	2308, 2309, 2310, 2311, 2312, 2313, 2430, 2426, 2379, 1799,
-	430, 878, 1567, 2819, 2444, 2421, 2817, 2006, 2440, 2441,
-	2428, 2772, 2443, 2435, 2448, 2024, 2025, 2084, 1929, 1613,
-	889, 2197, 2195, 2027, 2028, 2445, 2198, 2196, 2447, 2194,
-	2460, 2451, 2452, 2453, 2193, 2918, 2033, 939, 938, 948,
-	949, 941, 942, 943, 944, 945, 946, 947, 940, 1975,
-	427, 2477, 2199, 2890, 1900, 1901, 1415, 1969, 2478, 2055,
-	2056, 1422, 96, 2480, 2045, 1896, 1899, 1900, 1901, 1897,
-	2479, 1898, 1902, 2483, 52, 764, 51, 2491, 2059, 110,
-	1437, 2582, 1519, 2581, 2318, 2319, 2514, 925, 926, 927,
-	924, 2326, 1190, 1964, 110, 1993, 110, 1908, 1537, 2528,
-	2888, 431, 2482, 939, 938, 948, 949, 941, 942, 943,
-	944, 945, 946, 947, 940, 1790, 432, 2580, 2493, 1024,
-	1146, 1736, 1737, 1576, 2536, 1219, 884, 2752, 433, 2118,
-	434, 430, 2068, 2495, 1806, 1451, 2496, 1430, 1370, 1369,
-	2403, 1036, 1037, 2826, 2530, 2044, 2509, 2508, 1034, 1035,
-	939, 938, 948, 949, 941, 942, 943, 944, 945, 946,
-	947, 940, 1885, 1437, 1032, 1033, 1026, 861, 925, 926,
-	927, 924, 2188, 1523, 2539, 1030, 1031, 2401, 2511, 2512,
-	2513, 2534, 1116, 2527, 1115, 2526, 916, 2450, 1633, 2041,
-	2584, 1070, 2887, 173, 2807, 2795, 2529, 2793, 2573, 2760,
-	2745, 2744, 2532, 2742, 2040, 2533, 861, 2562, 2297, 2734,
-	2668, 2188, 925, 926, 927, 924, 2667, 2567, 2592, 2569,
-	2492, 2474, 2473, 2575, 2609, 2458, 2574, 925, 926, 927,
-	924, 1029, 2571, 2555, 648, 2457, 649, 650, 651, 652,
-	2572, 861, 1146, 1146, 2222, 1455, 2258, 861, 2628, 648,
-	1801, 2628, 1837, 2821, 2820, 2596, 2039, 2586, 939, 938,
-	948, 949, 941, 942, 943, 944, 945, 946, 947, 940,
-	1689, 875, 2820, 2229, 2821, 2231, 2552, 2610, 2475, 925,
-	926, 927, 924, 160, 3, 1085, 60, 861, 861, 2624,
-	2035, 861, 861, 1415, 2632, 2631, 1144, 2539, 1415, 2629,
-	2, 2530, 2623, 1561, 1150, 2026, 1, 1423, 1453, 653,
-	2665, 2206, 2004, 925, 926, 927, 924, 2207, 2669, 2670,
-	1325, 2646, 2647, 2449, 2209, 2655, 2656, 2643, 925, 926,
-	927, 924, 2662, 2654, 2275, 925, 926, 927, 924, 1651,
-	1883, 1791, 2694, 925, 926, 927, 924, 2346, 1891, 2663,
-	1061, 685, 1376, 2639, 2640, 1244, 2295, 780, 870, 1241,
-	2706, 869, 867, 1327, 547, 110, 110, 764, 1616, 2170,
-	2664, 1896, 1899, 1900, 1901, 1897, 861, 1898, 1902, 2825,
-	2858, 2692, 2787, 2828, 1257, 531, 2736, 2678, 861, 2701,
-	2791, 2680, 2598, 1656, 921, 2244, 706, 583, 558, 2708,
-	2707, 984, 2716, 1227, 1220, 2299, 782, 557, 2507, 2719,
-	2723, 2077, 2709, 674, 779, 707, 1600, 2676, 1191, 1212,
-	2001, 1195, 2728, 2633, 2519, 2361, 2097, 2928, 2917, 2899,
-	2885, 861, 2812, 2602, 2913, 2843, 956, 2874, 2761, 2746,
-	2605, 2603, 2741, 2739, 939, 938, 948, 949, 941, 942,
-	943, 944, 945, 946, 947, 940, 2410, 2756, 2604, 2867,
-	2808, 2755, 468, 2782, 2785, 1540, 417, 745, 2762, 2658,
-	1612, 469, 1815, 2800, 2644, 2767, 672, 1798, 951, 673,
-	955, 2786, 2777, 2778, 2779, 2780, 1517, 2090, 2089, 2794,
-	1296, 2796, 2797, 930, 2792, 2790, 952, 954, 950, 1313,
-	953, 939, 938, 948, 949, 941, 942, 943, 944, 945,
-	946, 947, 940, 2806, 694, 2314, 2315, 965, 507, 2832,
-	1519, 2815, 1678, 2818, 2816, 519, 2074, 2394, 2215, 59,
-	58, 2822, 57, 56, 2831, 1582, 181, 549, 861, 180,
-	2784, 2830, 2836, 2841, 529, 528, 527, 2922, 526, 2837,
-	525, 1895, 1893, 1892, 1532, 2857, 1531, 1499, 2846, 2848,
-	156, 1580, 49, 148, 125, 2412, 2856, 1855, 2860, 1028,
-	1849, 2865, 1489, 861, 2769, 2720, 732, 2721, 1280, 2549,
-	149, 2155, 2545, 2866, 2870, 2541, 2872, 141, 2419, 2627,
-	2380, 150, 2381, 2832, 2883, 2387, 108, 1805, 805, 801,
-	803, 804, 861, 802, 861, 2012, 2008, 1280, 2831, 1280,
-	2882, 97, 2889, 1676, 2891, 2894, 1834, 153, 2486, 1836,
-	1835, 2357, 2860, 861, 2895, 2488, 1747, 2838, 1280, 2909,
-	2902, 1746, 2912, 2906, 1744, 1743, 1044, 939, 938, 948,
-	949, 941, 942, 943, 944, 945, 946, 947, 940, 2693,
-	2923, 2916, 2494, 1754, 2927, 2926, 1752, 2442, 2438, 734,
-	2348, 2935, 733, 1624, 2938, 1420, 2058, 1533, 2923, 2941,
-	2940, 1529, 2939, 2927, 1889, 1800, 87, 86, 94, 137,
-	1354, 46, 165, 1155, 164, 167, 166, 163, 436, 1943,
-	112, 113, 1944, 114, 115, 162, 719, 1179, 1503, 925,
-	926, 927, 924, 161, 695, 2630, 642, 37, 33, 1507,
-	12, 11, 110, 948, 949, 941, 942, 943, 944, 945,
-	946, 947, 940, 34, 21, 22, 20, 1248, 19, 1496,
-	25, 697, 32, 1498, 1500, 1502, 31, 1504, 1505, 1506,
-	1508, 1509, 1510, 1512, 1513, 1514, 1515, 30, 103, 102,
-	29, 101, 100, 99, 98, 1415, 28, 18, 124, 147,
-	154, 41, 95, 40, 1415, 39, 9, 2576, 93, 91,
-	2578, 27, 92, 110, 89, 90, 88, 110, 1354, 71,
-	146, 140, 139, 70, 2583, 1518, 69, 55, 110, 84,
-	83, 718, 717, 82, 81, 80, 79, 110, 77, 78,
-	705, 68, 67, 66, 65, 64, 75, 85, 716, 76,
-	74, 73, 72, 63, 62, 61, 122, 693, 123, 121,
-	120, 119, 1516, 118, 117, 116, 42, 43, 696, 727,
-	44, 45, 1350, 133, 132, 134, 1347, 136, 138, 1495,
-	1349, 1346, 1348, 1352, 1353, 142, 143, 144, 1351, 135,
-	130, 128, 723, 131, 129, 127, 54, 17, 24, 4,
-	0, 0, 0, 0, 0, 0, 0, 0, 1511, 0,
-	0, 151, 0, 0, 0, 1501, 0, 0, 0, 0,
-	0, 0, 0, 0, 724, 728, 0, 0, 0, 104,
-	0, 0, 0, 145, 0, 105, 0, 0, 0, 0,
-	0, 713, 0, 711, 715, 731, 0, 0, 0, 712,
-	709, 708, 0, 714, 699, 700, 698, 701, 702, 703,
-	704, 0, 729, 0, 730, 0, 0, 0, 0, 0,
-	1350, 0, 2691, 0, 1347, 725, 726, 0, 1349, 1346,
-	1348, 1352, 1353, 0, 0, 0, 1351, 0, 106, 2702,
-	0, 0, 0, 0, 0, 0, 0, 0, 48, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2718,
-	0, 0, 721, 0, 1335, 1336, 1337, 1338, 1339, 1340,
-	1341, 1342, 1343, 1344, 1345, 1357, 1358, 1359, 1360, 1361,
-	1362, 1355, 1356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2385, 50, 0, 0,
-	0, 0, 0, 0, 0, 2691, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 720, 0, 2388, 0, 0, 0, 1536, 0, 0,
-	2383, 0, 0, 0, 0, 2398, 2399, 0, 0, 0,
-	0, 2384, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
-	1343, 1344, 1345, 1357, 1358, 1359, 1360, 1361, 1362, 1355,
-	1356, 0, 0, 0, 107, 38, 0, 0, 2389, 0,
-	0, 47, 5, 0, 110, 111, 0, 110, 110, 0,
-	110, 353, 565, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 316, 0, 0, 2691, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 521, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 764, 556, 0, 0, 345,
-	300, 0, 764, 0, 0, 613, 621, 0, 0, 0,
-	0, 110, 0, 0, 0, 0, 0, 514, 0, 0,
-	546, 590, 589, 533, 542, 0, 0, 243, 179, 534,
-	0, 541, 535, 539, 538, 536, 537, 0, 605, 2397,
-	0, 1842, 0, 0, 0, 505, 518, 2688, 522, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2897, 0,
-	0, 0, 0, 0, 0, 0, 2391, 0, 0, 0,
-	0, 0, 515, 516, 0, 0, 0, 0, 566, 0,
-	517, 0, 0, 561, 543, 544, 0, 956, 2390, 2392,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 540, 564, 568, 255,
-	627, 562, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 628, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2400, 0, 559, 0, 0, 0, 376,
-	0, 0, 611, 0, 0, 2386, 349, 0, 0, 285,
-	0, 2396, 0, 563, 0, 336, 318, 624, 506, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 609, 314, 0, 0, 623, 604, 606, 607,
-	610, 614, 615, 616, 617, 618, 620, 622, 626, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 1911, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 2689, 0, 0, 0, 2690, 0, 625, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 567, 304,
-	305, 306, 307, 612, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 1536, 0, 0, 0, 0, 0, 0,
-	0, 0, 110, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 821, 0, 634,
-	608, 633, 635, 636, 632, 637, 638, 619, 524, 0,
-	571, 630, 629, 631, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 597, 576, 577, 578, 523, 579, 574,
-	575, 598, 569, 594, 595, 548, 572, 580, 593, 581,
-	596, 599, 600, 639, 640, 587, 641, 584, 601, 592,
-	591, 582, 570, 602, 603, 555, 550, 585, 586, 573,
-	588, 551, 552, 553, 554, 0, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 0, 0, 0, 0,
-	809, 0, 821, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	829, 833, 835, 837, 839, 840, 842, 0, 846, 843,
-	844, 845, 0, 0, 824, 825, 826, 827, 807, 808,
-	830, 0, 810, 0, 811, 812, 813, 814, 815, 816,
-	817, 818, 819, 820, 822, 828, 0, 0, 0, 0,
-	0, 0, 0, 832, 834, 836, 838, 841, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	823, 0, 0, 0, 0, 809, 0, 0, 0, 799,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 829, 833, 835, 837, 839,
-	840, 842, 0, 846, 843, 844, 845, 0, 0, 824,
-	825, 826, 827, 807, 808, 830, 0, 810, 0, 811,
-	812, 813, 814, 815, 816, 817, 818, 819, 820, 822,
-	828, 0, 0, 0, 1536, 1536, 1536, 1536, 832, 834,
-	836, 838, 841, 0, 0, 0, 1536, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 823, 0, 0, 0, 0,
-	0, 0, 0, 0, 110, 0, 0, 0, 0, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 2010, 2011,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 110,
-	0, 0, 0, 0, 0, 0, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 353, 565, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	556, 0, 0, 345, 300, 0, 0, 0, 0, 613,
-	621, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 514, 0, 0, 546, 590, 589, 533, 542, 0,
-	110, 243, 179, 534, 0, 541, 535, 539, 538, 536,
-	537, 0, 605, 0, 0, 831, 0, 0, 0, 505,
-	518, 0, 522, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1536, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 515, 516, 0, 0,
-	110, 0, 566, 0, 517, 0, 0, 561, 543, 544,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	540, 564, 568, 255, 627, 562, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 628, 276, 324, 290, 325, 277, 302, 301, 303,
-	831, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 559,
-	0, 0, 0, 376, 0, 0, 611, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 563, 0, 336,
-	318, 624, 506, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 1378, 1377,
-	1379, 388, 268, 226, 227, 423, 609, 314, 0, 0,
-	623, 604, 606, 607, 610, 614, 615, 616, 617, 618,
-	620, 622, 626, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 1536,
-	0, 0, 625, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 567, 304, 305, 306, 307, 612, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 634, 608, 633, 635, 636, 632, 637,
-	638, 619, 524, 0, 571, 630, 629, 631, 0, 0,
-	0, 0, 0, 0, 110, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 597, 576, 577,
-	578, 523, 579, 574, 575, 598, 569, 594, 595, 548,
-	572, 580, 593, 581, 596, 599, 600, 639, 640, 587,
-	641, 584, 601, 592, 591, 582, 570, 602, 603, 555,
-	550, 585, 586, 573, 588, 551, 552, 553, 554, 353,
-	565, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 521, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 556, 0, 0, 345, 300, 0,
-	0, 0, 0, 613, 621, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 0, 0, 546, 590,
-	589, 533, 542, 0, 0, 243, 179, 534, 0, 541,
-	535, 539, 538, 536, 537, 0, 605, 0, 0, 0,
-	0, 0, 0, 505, 518, 0, 522, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	515, 516, 0, 0, 0, 0, 566, 0, 517, 0,
-	0, 561, 543, 544, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 540, 564, 568, 255, 627, 562,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 628, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 559, 0, 0, 0, 376, 0, 0,
-	611, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 563, 0, 336, 318, 624, 506, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	609, 314, 0, 0, 623, 604, 606, 607, 610, 614,
-	615, 616, 617, 618, 620, 622, 626, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	2689, 0, 0, 0, 2690, 0, 625, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 567, 304, 305, 306,
-	307, 612, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 634, 608, 633,
-	635, 636, 632, 637, 638, 619, 524, 0, 571, 630,
-	629, 631, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 597, 576, 577, 578, 523, 579, 574, 575, 598,
-	569, 594, 595, 548, 572, 580, 593, 581, 596, 599,
-	600, 639, 640, 587, 641, 584, 601, 592, 591, 582,
-	570, 602, 603, 555, 550, 585, 586, 573, 588, 551,
-	552, 553, 554, 353, 565, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 0, 0,
-	0, 261, 1416, 0, 286, 0, 0, 0, 556, 0,
-	0, 345, 300, 0, 0, 0, 0, 613, 621, 0,
-	0, 0, 0, 0, 0, 0, 1550, 0, 0, 514,
-	0, 0, 546, 590, 589, 533, 542, 0, 0, 243,
-	179, 534, 0, 541, 535, 539, 538, 536, 537, 0,
-	605, 0, 0, 0, 0, 0, 0, 505, 518, 0,
-	522, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 515, 516, 0, 0, 0, 0,
-	566, 0, 517, 0, 0, 1551, 543, 544, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 540, 564,
-	568, 255, 627, 562, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 628,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 559, 0, 0,
-	0, 376, 0, 0, 611, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 563, 0, 336, 318, 624,
-	506, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 609, 314, 0, 0, 623, 604,
-	606, 607, 610, 614, 615, 616, 617, 618, 620, 622,
-	626, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	625, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	567, 304, 305, 306, 307, 612, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 634, 608, 633, 635, 636, 632, 637, 638, 619,
-	524, 0, 571, 630, 629, 631, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 597, 576, 577, 578, 523,
-	579, 574, 575, 598, 569, 594, 595, 548, 572, 580,
-	593, 581, 596, 599, 600, 639, 640, 587, 641, 584,
-	601, 592, 591, 582, 570, 602, 603, 555, 550, 585,
-	586, 573, 588, 551, 552, 553, 554, 156, 353, 565,
-	384, 385, 386, 408, 370, 0, 420, 0, 0, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 521, 0, 0, 0, 261, 0, 0, 286,
-	0, 0, 0, 959, 0, 0, 345, 300, 0, 0,
-	0, 0, 613, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 514, 0, 0, 546, 590, 589,
-	533, 542, 0, 0, 243, 179, 534, 0, 541, 535,
-	539, 538, 536, 537, 0, 605, 0, 0, 0, 0,
-	0, 0, 505, 518, 0, 522, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 515,
-	516, 0, 0, 0, 0, 566, 0, 517, 0, 0,
-	561, 543, 544, 0, 0, 0, 0, 234, 350, 366,
-	244, 341, 379, 249, 348, 239, 315, 338, 0, 0,
-	236, 364, 347, 297, 280, 281, 235, 0, 333, 259,
-	272, 256, 313, 540, 564, 568, 255, 627, 562, 374,
-	238, 0, 373, 312, 360, 365, 298, 292, 237, 362,
-	296, 291, 284, 263, 628, 276, 324, 290, 325, 277,
-	302, 301, 303, 0, 0, 0, 0, 0, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 559, 0, 0, 0, 376, 0, 0, 611,
-	0, 0, 0, 349, 0, 0, 285, 0, 0, 0,
-	563, 0, 336, 318, 624, 506, 0, 334, 288, 361,
-	326, 367, 351, 375, 330, 327, 229, 352, 258, 299,
-	240, 242, 254, 260, 262, 264, 265, 308, 309, 321,
-	340, 354, 355, 356, 257, 250, 335, 251, 274, 252,
-	230, 342, 253, 232, 322, 359, 0, 270, 331, 295,
-	233, 294, 323, 358, 357, 241, 383, 389, 390, 395,
-	0, 396, 0, 0, 0, 404, 409, 410, 411, 413,
-	414, 415, 416, 0, 0, 0, 0, 398, 0, 0,
-	0, 0, 0, 0, 388, 268, 226, 227, 423, 609,
-	314, 0, 0, 623, 604, 606, 607, 610, 614, 615,
-	616, 617, 618, 620, 622, 626, 422, 0, 0, 0,
-	0, 0, 421, 320, 0, 339, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 346, 369,
-	381, 399, 402, 0, 0, 0, 231, 401, 0, 0,
-	0, 0, 0, 0, 0, 625, 0, 0, 0, 380,
-	0, 0, 0, 0, 0, 567, 304, 305, 306, 307,
-	612, 0, 248, 400, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 394, 267, 273, 412, 275, 247, 319, 269,
-	378, 282, 0, 405, 0, 406, 0, 0, 0, 0,
-	311, 278, 279, 343, 283, 289, 332, 377, 317, 337,
-	245, 368, 344, 293, 0, 0, 634, 608, 633, 635,
-	636, 632, 637, 638, 619, 524, 0, 571, 630, 629,
-	631, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 287, 126, 328, 266,
-	597, 576, 577, 578, 523, 579, 574, 575, 598, 569,
-	594, 595, 548, 572, 580, 593, 581, 596, 599, 600,
-	639, 640, 587, 641, 584, 601, 592, 591, 582, 570,
-	602, 603, 555, 550, 585, 586, 573, 588, 551, 552,
-	553, 554, 353, 565, 0, 384, 385, 386, 408, 370,
-	0, 420, 0, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 521, 0, 0, 0,
-	261, 2896, 0, 286, 0, 0, 0, 556, 0, 0,
-	345, 300, 0, 0, 0, 0, 613, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 0,
-	0, 546, 590, 589, 533, 542, 0, 0, 243, 179,
-	534, 0, 541, 535, 539, 538, 536, 537, 0, 605,
-	0, 0, 0, 0, 0, 0, 505, 518, 0, 522,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 515, 516, 0, 0, 0, 0, 566,
-	0, 517, 0, 0, 561, 543, 544, 0, 0, 0,
-	0, 234, 350, 366, 244, 341, 379, 249, 348, 239,
-	315, 338, 0, 0, 236, 364, 347, 297, 280, 281,
-	235, 0, 333, 259, 272, 256, 313, 540, 564, 568,
-	255, 627, 562, 374, 238, 0, 373, 312, 360, 365,
-	298, 292, 237, 362, 296, 291, 284, 263, 628, 276,
-	324, 290, 325, 277, 302, 301, 303, 0, 0, 0,
-	0, 0, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 0, 0,
-	376, 0, 0, 611, 0, 0, 0, 349, 0, 0,
-	285, 0, 0, 0, 563, 0, 336, 318, 624, 506,
-	0, 334, 288, 361, 326, 367, 351, 375, 330, 327,
-	229, 352, 258, 299, 240, 242, 254, 260, 262, 264,
-	265, 308, 309, 321, 340, 354, 355, 356, 257, 250,
-	335, 251, 274, 252, 230, 342, 253, 232, 322, 359,
-	0, 270, 331, 295, 233, 294, 323, 358, 357, 241,
-	383, 389, 390, 395, 0, 396, 0, 0, 0, 404,
-	409, 410, 411, 413, 414, 415, 416, 0, 0, 0,
-	0, 398, 0, 0, 0, 0, 0, 0, 388, 268,
-	226, 227, 423, 609, 314, 0, 0, 623, 604, 606,
-	607, 610, 614, 615, 616, 617, 618, 620, 622, 626,
-	422, 0, 0, 0, 0, 0, 421, 320, 0, 339,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 346, 369, 381, 399, 402, 0, 0, 0,
-	231, 401, 0, 0, 0, 0, 0, 0, 0, 625,
-	0, 0, 0, 380, 0, 0, 0, 0, 0, 567,
-	304, 305, 306, 307, 612, 0, 248, 400, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 394, 267, 273, 412,
-	275, 247, 319, 269, 378, 282, 0, 405, 0, 406,
-	0, 0, 0, 0, 311, 278, 279, 343, 283, 289,
-	332, 377, 317, 337, 245, 368, 344, 293, 0, 0,
-	634, 608, 633, 635, 636, 632, 637, 638, 619, 524,
-	0, 571, 630, 629, 631, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	287, 0, 328, 266, 597, 576, 577, 578, 523, 579,
-	574, 575, 598, 569, 594, 595, 548, 572, 580, 593,
-	581, 596, 599, 600, 639, 640, 587, 641, 584, 601,
-	592, 591, 582, 570, 602, 603, 555, 550, 585, 586,
-	573, 588, 551, 552, 553, 554, 353, 565, 0, 384,
-	385, 386, 408, 370, 0, 420, 0, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	521, 0, 0, 0, 261, 1416, 0, 286, 0, 0,
-	0, 556, 0, 0, 345, 300, 0, 0, 0, 0,
-	613, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 0, 0, 546, 590, 589, 533, 542,
-	0, 0, 243, 179, 534, 0, 541, 535, 539, 538,
-	536, 537, 0, 605, 0, 0, 0, 0, 0, 0,
-	505, 518, 0, 522, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 515, 516, 0,
-	0, 0, 0, 566, 0, 517, 0, 0, 561, 543,
-	544, 0, 0, 0, 0, 234, 350, 366, 244, 341,
-	379, 249, 348, 239, 315, 338, 0, 0, 236, 364,
-	347, 297, 280, 281, 235, 0, 333, 259, 272, 256,
-	313, 540, 564, 568, 255, 627, 562, 374, 238, 0,
-	373, 312, 360, 365, 298, 292, 237, 362, 296, 291,
-	284, 263, 628, 276, 324, 290, 325, 277, 302, 301,
-	303, 0, 0, 0, 0, 0, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	559, 0, 0, 0, 376, 0, 0, 611, 0, 0,
-	0, 349, 0, 0, 285, 0, 0, 0, 563, 0,
-	336, 318, 624, 506, 0, 334, 288, 361, 326, 367,
-	351, 375, 330, 327, 229, 352, 258, 299, 240, 242,
-	254, 260, 262, 264, 265, 308, 309, 321, 340, 354,
-	355, 356, 257, 250, 335, 251, 274, 252, 230, 342,
-	253, 232, 322, 359, 0, 270, 331, 295, 233, 294,
-	323, 358, 357, 241, 383, 389, 390, 395, 0, 396,
-	0, 0, 0, 404, 409, 410, 411, 413, 414, 415,
-	416, 0, 0, 0, 0, 398, 0, 0, 0, 0,
-	0, 0, 388, 268, 226, 227, 423, 609, 314, 0,
-	0, 623, 604, 606, 607, 610, 614, 615, 616, 617,
-	618, 620, 622, 626, 422, 0, 0, 0, 0, 0,
-	421, 320, 0, 339, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 346, 369, 381, 399,
-	402, 0, 0, 0, 231, 401, 0, 0, 0, 0,
-	0, 0, 0, 625, 0, 0, 0, 380, 0, 0,
-	0, 0, 0, 567, 304, 305, 306, 307, 612, 0,
-	248, 400, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	394, 267, 273, 412, 275, 247, 319, 269, 378, 282,
-	0, 405, 0, 406, 0, 0, 0, 0, 311, 278,
-	279, 343, 283, 289, 332, 377, 317, 337, 245, 368,
-	344, 293, 0, 0, 634, 608, 633, 635, 636, 632,
-	637, 638, 619, 524, 0, 571, 630, 629, 631, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 287, 0, 328, 266, 597, 576,
-	577, 578, 523, 579, 574, 575, 598, 569, 594, 595,
-	548, 572, 580, 593, 581, 596, 599, 600, 639, 640,
-	587, 641, 584, 601, 592, 591, 582, 570, 602, 603,
-	555, 550, 585, 586, 573, 588, 551, 552, 553, 554,
-	353, 565, 0, 384, 385, 386, 408, 370, 0, 420,
-	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 521, 0, 0, 0, 261, 0,
-	0, 286, 0, 0, 0, 556, 0, 0, 345, 300,
-	0, 0, 0, 0, 613, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 514, 0, 0, 546,
-	590, 589, 533, 542, 0, 0, 243, 179, 534, 0,
-	541, 535, 539, 538, 536, 537, 0, 605, 0, 0,
-	0, 0, 0, 0, 505, 518, 0, 522, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 515, 516, 1174, 0, 0, 0, 566, 0, 517,
-	0, 0, 561, 543, 544, 0, 0, 0, 0, 234,
-	350, 366, 244, 341, 379, 249, 348, 239, 315, 338,
-	0, 0, 236, 364, 347, 297, 280, 281, 235, 0,
-	333, 259, 272, 256, 313, 540, 564, 568, 255, 627,
-	562, 374, 238, 0, 373, 312, 360, 365, 298, 292,
-	237, 362, 296, 291, 284, 263, 628, 276, 324, 290,
-	325, 277, 302, 301, 303, 0, 0, 0, 0, 0,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 559, 0, 0, 0, 376, 0,
-	0, 611, 0, 0, 0, 349, 0, 0, 285, 0,
-	0, 0, 563, 0, 336, 318, 624, 506, 0, 334,
-	288, 361, 326, 367, 351, 375, 330, 327, 229, 352,
-	258, 299, 240, 242, 254, 260, 262, 264, 265, 308,
-	309, 321, 340, 354, 355, 356, 257, 250, 335, 251,
-	274, 252, 230, 342, 253, 232, 322, 359, 0, 270,
-	331, 295, 233, 294, 323, 358, 357, 241, 383, 389,
-	390, 395, 0, 396, 0, 0, 0, 404, 409, 410,
-	411, 413, 414, 415, 416, 0, 0, 0, 0, 398,
-	0, 0, 0, 0, 0, 0, 388, 268, 226, 227,
-	423, 609, 314, 0, 0, 623, 604, 606, 607, 610,
-	614, 615, 616, 617, 618, 620, 622, 626, 422, 0,
-	0, 0, 0, 0, 421, 320, 0, 339, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	346, 369, 381, 399, 402, 0, 0, 0, 231, 401,
-	0, 0, 0, 0, 0, 0, 0, 625, 0, 0,
-	0, 380, 0, 0, 0, 0, 0, 567, 304, 305,
-	306, 307, 612, 0, 248, 400, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 394, 267, 273, 412, 275, 247,
-	319, 269, 378, 282, 0, 405, 0, 406, 0, 0,
-	0, 0, 311, 278, 279, 343, 283, 289, 332, 377,
-	317, 337, 245, 368, 344, 293, 0, 0, 634, 608,
-	633, 635, 636, 632, 637, 638, 619, 524, 0, 571,
-	630, 629, 631, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 287, 0,
-	328, 266, 597, 576, 577, 578, 523, 579, 574, 575,
-	598, 569, 594, 595, 548, 572, 580, 593, 581, 596,
-	599, 600, 639, 640, 587, 641, 584, 601, 592, 591,
-	582, 570, 602, 603, 555, 550, 585, 586, 573, 588,
-	551, 552, 553, 554, 0, 0, 0, 384, 385, 386,
-	408, 370, 0, 420, 353, 565, 0, 0, 1697, 0,
-	0, 0, 0, 0, 0, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 521, 0,
-	0, 0, 261, 0, 0, 286, 0, 0, 0, 556,
-	0, 0, 345, 300, 0, 0, 0, 0, 613, 621,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 0, 0, 546, 590, 589, 533, 542, 0, 0,
-	243, 179, 534, 0, 541, 535, 539, 538, 536, 537,
-	0, 605, 0, 0, 0, 0, 0, 0, 505, 518,
-	0, 522, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 515, 516, 0, 0, 0,
-	0, 566, 0, 517, 0, 0, 561, 543, 544, 0,
-	0, 0, 0, 234, 350, 366, 244, 341, 379, 249,
-	348, 239, 315, 338, 0, 0, 236, 364, 347, 297,
-	280, 281, 235, 0, 333, 259, 272, 256, 313, 540,
-	564, 568, 255, 627, 562, 374, 238, 0, 373, 312,
-	360, 365, 298, 292, 237, 362, 296, 291, 284, 263,
-	628, 276, 324, 290, 325, 277, 302, 301, 303, 0,
-	0, 0, 0, 0, 403, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 559, 0,
-	0, 0, 376, 0, 0, 611, 0, 0, 0, 349,
-	0, 0, 285, 0, 0, 0, 563, 0, 336, 318,
-	624, 506, 0, 334, 288, 361, 326, 367, 351, 375,
-	330, 327, 229, 352, 258, 299, 240, 242, 254, 260,
-	262, 264, 265, 308, 309, 321, 340, 354, 355, 356,
-	257, 250, 335, 251, 274, 252, 230, 342, 253, 232,
-	322, 359, 0, 270, 331, 295, 233, 294, 323, 358,
-	357, 241, 383, 389, 390, 395, 0, 396, 0, 0,
-	0, 404, 409, 410, 411, 413, 414, 415, 416, 0,
-	0, 0, 0, 398, 0, 0, 0, 0, 0, 0,
-	388, 268, 226, 227, 423, 609, 314, 0, 0, 623,
-	604, 606, 607, 610, 614, 615, 616, 617, 618, 620,
-	622, 626, 422, 0, 0, 0, 0, 0, 421, 320,
-	0, 339, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 346, 369, 381, 399, 402, 0,
-	0, 0, 231, 401, 0, 0, 0, 0, 0, 0,
-	0, 625, 0, 0, 0, 380, 0, 0, 0, 0,
-	0, 567, 304, 305, 306, 307, 612, 0, 248, 400,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 394, 267,
-	273, 412, 275, 247, 319, 269, 378, 282, 0, 405,
-	0, 406, 0, 0, 0, 0, 311, 278, 279, 343,
-	283, 289, 332, 377, 317, 337, 245, 368, 344, 293,
-	0, 0, 634, 608, 633, 635, 636, 632, 637, 638,
-	619, 524, 0, 571, 630, 629, 631, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 287, 0, 328, 266, 597, 576, 577, 578,
-	523, 579, 574, 575, 598, 569, 594, 595, 548, 572,
-	580, 593, 581, 596, 599, 600, 639, 640, 587, 641,
-	584, 601, 592, 591, 582, 570, 602, 603, 555, 550,
-	585, 586, 573, 588, 551, 552, 553, 554, 353, 565,
-	0, 384, 385, 386, 408, 370, 0, 420, 0, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 521, 0, 0, 0, 261, 0, 0, 286,
-	0, 0, 0, 556, 0, 0, 345, 300, 0, 0,
-	0, 0, 613, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 514, 0, 0, 546, 590, 589,
-	533, 542, 0, 0, 243, 179, 534, 0, 541, 535,
-	539, 538, 536, 537, 0, 605, 0, 0, 0, 0,
-	0, 0, 505, 518, 0, 522, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 515,
-	516, 0, 0, 0, 0, 566, 0, 517, 0, 0,
-	561, 543, 544, 0, 0, 0, 0, 234, 350, 366,
-	244, 341, 379, 249, 348, 239, 315, 338, 0, 0,
-	236, 364, 347, 297, 280, 281, 235, 0, 333, 259,
-	272, 256, 313, 540, 564, 568, 255, 627, 562, 374,
-	238, 0, 373, 312, 360, 365, 298, 292, 237, 362,
-	296, 291, 284, 263, 628, 276, 324, 290, 325, 277,
-	302, 301, 303, 0, 0, 0, 0, 0, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 559, 0, 0, 0, 376, 0, 0, 611,
-	0, 0, 0, 349, 0, 0, 285, 0, 0, 0,
-	563, 0, 336, 318, 624, 506, 0, 334, 288, 361,
-	326, 367, 351, 375, 330, 327, 229, 352, 258, 299,
-	240, 242, 254, 260, 262, 264, 265, 308, 309, 321,
-	340, 354, 355, 356, 257, 250, 335, 251, 274, 252,
-	230, 342, 253, 232, 322, 359, 0, 270, 331, 295,
-	233, 294, 323, 358, 357, 241, 383, 389, 390, 395,
-	0, 396, 0, 0, 0, 404, 409, 410, 411, 413,
-	414, 415, 416, 0, 0, 0, 0, 398, 0, 0,
-	0, 0, 0, 0, 388, 268, 226, 227, 423, 609,
-	314, 0, 0, 623, 604, 606, 607, 610, 614, 615,
-	616, 617, 618, 620, 622, 626, 422, 0, 0, 0,
-	0, 0, 421, 320, 0, 339, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 346, 369,
-	381, 399, 402, 0, 0, 0, 231, 401, 0, 0,
-	0, 0, 0, 0, 0, 625, 0, 0, 0, 380,
-	0, 0, 0, 0, 0, 567, 304, 305, 306, 307,
-	612, 0, 248, 400, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 394, 267, 273, 412, 275, 247, 319, 269,
-	378, 282, 0, 405, 0, 406, 0, 0, 0, 0,
-	311, 278, 279, 343, 283, 289, 332, 377, 317, 337,
-	245, 368, 344, 293, 0, 0, 634, 608, 633, 635,
-	636, 632, 637, 638, 619, 524, 0, 571, 630, 629,
-	631, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 287, 0, 328, 266,
-	597, 576, 577, 578, 523, 579, 574, 575, 598, 569,
-	594, 595, 548, 572, 580, 593, 581, 596, 599, 600,
-	639, 640, 587, 641, 584, 601, 592, 591, 582, 570,
-	602, 603, 555, 550, 585, 586, 573, 588, 551, 552,
-	553, 554, 353, 565, 0, 384, 385, 386, 408, 370,
-	0, 420, 0, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 1297, 0, 0, 0, 521, 0, 0, 0,
-	261, 0, 0, 286, 0, 0, 0, 556, 0, 0,
-	345, 300, 0, 0, 0, 0, 613, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 0,
-	0, 546, 590, 589, 533, 542, 0, 0, 243, 179,
-	534, 0, 541, 535, 539, 538, 536, 537, 0, 605,
-	0, 0, 0, 0, 0, 0, 0, 518, 0, 522,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 515, 516, 0, 0, 0, 0, 566,
-	0, 517, 0, 0, 561, 543, 544, 0, 0, 0,
-	0, 234, 350, 366, 244, 341, 379, 249, 348, 239,
-	315, 338, 0, 0, 236, 364, 347, 297, 280, 281,
-	235, 0, 333, 259, 272, 256, 313, 540, 564, 568,
-	255, 627, 562, 374, 238, 0, 373, 312, 360, 365,
-	298, 292, 237, 362, 296, 291, 284, 263, 628, 276,
-	324, 290, 325, 277, 302, 301, 303, 0, 0, 0,
-	0, 0, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 0, 0,
-	376, 0, 0, 611, 0, 0, 0, 349, 0, 0,
-	285, 0, 0, 0, 563, 0, 336, 318, 624, 0,
-	0, 334, 288, 361, 326, 367, 351, 375, 330, 327,
-	229, 352, 258, 299, 240, 242, 254, 260, 262, 264,
-	265, 308, 309, 321, 340, 354, 355, 356, 257, 250,
-	335, 251, 274, 252, 230, 342, 253, 232, 322, 359,
-	0, 270, 331, 295, 233, 294, 323, 358, 357, 241,
-	383, 1298, 1299, 395, 0, 396, 0, 0, 0, 404,
-	409, 410, 411, 413, 414, 415, 416, 0, 0, 0,
-	0, 398, 0, 0, 0, 0, 0, 0, 388, 268,
-	226, 227, 423, 609, 314, 0, 0, 623, 604, 606,
-	607, 610, 614, 615, 616, 617, 618, 620, 622, 626,
-	422, 0, 0, 0, 0, 0, 421, 320, 0, 339,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 346, 369, 381, 399, 402, 0, 0, 0,
-	231, 401, 0, 0, 0, 0, 0, 0, 0, 625,
-	0, 0, 0, 380, 0, 0, 0, 0, 0, 567,
-	304, 305, 306, 307, 612, 0, 248, 400, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 394, 267, 273, 412,
-	275, 247, 319, 269, 378, 282, 0, 405, 0, 406,
-	0, 0, 0, 0, 311, 278, 279, 343, 283, 289,
-	332, 377, 317, 337, 245, 368, 344, 293, 0, 0,
-	634, 608, 633, 635, 636, 632, 637, 638, 619, 524,
-	0, 571, 630, 629, 631, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	287, 0, 328, 266, 597, 576, 577, 578, 523, 579,
-	574, 575, 598, 569, 594, 595, 548, 572, 580, 593,
-	581, 596, 599, 600, 639, 640, 587, 641, 584, 601,
-	592, 591, 582, 570, 602, 603, 555, 550, 585, 586,
-	573, 588, 551, 552, 553, 554, 353, 565, 0, 384,
-	385, 386, 408, 370, 0, 420, 0, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	521, 0, 0, 0, 261, 0, 0, 286, 0, 0,
-	0, 556, 0, 0, 345, 300, 0, 0, 0, 0,
-	613, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 546, 590, 589, 533, 542,
-	0, 0, 243, 179, 534, 0, 541, 535, 539, 538,
-	536, 537, 0, 605, 0, 0, 0, 0, 0, 0,
-	505, 518, 0, 522, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 515, 516, 0,
-	0, 0, 0, 566, 0, 517, 0, 0, 561, 543,
-	544, 0, 0, 0, 0, 234, 350, 366, 244, 341,
-	379, 249, 348, 239, 315, 338, 0, 0, 236, 364,
-	347, 297, 280, 281, 235, 0, 333, 259, 272, 256,
-	313, 540, 564, 568, 255, 627, 562, 374, 238, 0,
-	373, 312, 360, 365, 298, 292, 237, 362, 296, 291,
-	284, 263, 628, 276, 324, 290, 325, 277, 302, 301,
-	303, 0, 0, 0, 0, 0, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	559, 0, 0, 0, 376, 0, 0, 611, 0, 0,
-	0, 349, 0, 0, 285, 0, 0, 0, 563, 0,
-	336, 318, 624, 506, 0, 334, 288, 361, 326, 367,
-	351, 375, 330, 327, 229, 352, 258, 299, 240, 242,
-	254, 260, 262, 264, 265, 308, 309, 321, 340, 354,
-	355, 356, 257, 250, 335, 251, 274, 252, 230, 342,
-	253, 232, 322, 359, 0, 270, 331, 295, 233, 294,
-	323, 358, 357, 241, 383, 389, 390, 395, 0, 396,
-	0, 0, 0, 404, 409, 410, 411, 413, 414, 415,
-	416, 0, 0, 0, 0, 398, 0, 0, 0, 0,
-	0, 0, 388, 268, 226, 227, 423, 609, 314, 0,
-	0, 623, 604, 606, 607, 610, 614, 615, 616, 617,
-	618, 620, 622, 626, 422, 0, 0, 0, 0, 0,
-	421, 320, 0, 339, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 346, 369, 381, 399,
-	402, 0, 0, 0, 231, 401, 0, 0, 0, 0,
-	0, 0, 0, 625, 0, 0, 0, 380, 0, 0,
-	0, 0, 0, 567, 304, 305, 306, 307, 612, 0,
-	248, 400, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	394, 267, 273, 412, 275, 247, 319, 269, 378, 282,
-	0, 405, 0, 406, 0, 0, 0, 0, 311, 278,
-	279, 343, 283, 289, 332, 377, 317, 337, 245, 368,
-	344, 293, 0, 0, 634, 608, 633, 635, 636, 632,
-	637, 638, 619, 524, 0, 571, 630, 629, 631, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 287, 0, 328, 266, 597, 576,
-	577, 578, 523, 579, 574, 575, 598, 569, 594, 595,
-	548, 572, 580, 593, 581, 596, 599, 600, 639, 640,
-	587, 641, 584, 601, 592, 591, 582, 570, 602, 603,
-	555, 550, 585, 586, 573, 588, 551, 552, 553, 554,
-	353, 565, 0, 384, 385, 386, 408, 370, 0, 420,
-	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 521, 0, 0, 0, 261, 0,
-	0, 286, 0, 0, 0, 556, 0, 0, 345, 300,
-	0, 0, 0, 0, 613, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 514, 0, 0, 546,
-	590, 589, 533, 542, 0, 0, 243, 179, 534, 0,
-	541, 535, 539, 538, 536, 537, 0, 605, 0, 0,
-	0, 0, 0, 0, 0, 518, 0, 522, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 515, 516, 0, 0, 0, 0, 566, 0, 517,
-	0, 0, 561, 543, 544, 0, 0, 0, 0, 234,
-	350, 366, 244, 341, 379, 249, 348, 239, 315, 338,
-	0, 0, 236, 364, 347, 297, 280, 281, 235, 0,
-	333, 259, 272, 256, 313, 540, 564, 568, 255, 627,
-	562, 374, 238, 0, 373, 312, 360, 365, 298, 292,
-	237, 362, 296, 291, 284, 263, 628, 276, 324, 290,
-	325, 277, 302, 301, 303, 0, 0, 0, 0, 0,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 559, 0, 0, 0, 376, 0,
-	0, 611, 0, 0, 0, 349, 0, 0, 285, 0,
-	0, 0, 563, 0, 336, 318, 624, 0, 0, 334,
-	288, 361, 326, 367, 351, 375, 330, 327, 229, 352,
-	258, 299, 240, 242, 254, 260, 262, 264, 265, 308,
-	309, 321, 340, 354, 355, 356, 257, 250, 335, 251,
-	274, 252, 230, 342, 253, 232, 322, 359, 0, 270,
-	331, 295, 233, 294, 323, 358, 357, 241, 383, 389,
-	390, 395, 0, 396, 0, 0, 0, 404, 409, 410,
-	411, 413, 414, 415, 416, 0, 0, 0, 0, 398,
-	0, 0, 0, 0, 0, 0, 388, 268, 226, 227,
-	423, 609, 314, 0, 0, 623, 604, 606, 607, 610,
-	614, 615, 616, 617, 618, 620, 622, 626, 422, 0,
-	0, 0, 0, 0, 421, 320, 0, 339, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	346, 369, 381, 399, 402, 0, 0, 0, 231, 401,
-	0, 0, 0, 0, 0, 0, 0, 625, 0, 0,
-	0, 380, 0, 0, 0, 0, 0, 567, 304, 305,
-	306, 307, 612, 0, 248, 400, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 394, 267, 273, 412, 275, 247,
-	319, 269, 378, 282, 0, 405, 0, 406, 0, 0,
-	0, 0, 311, 278, 279, 343, 283, 289, 332, 377,
-	317, 337, 245, 368, 344, 293, 0, 0, 634, 608,
-	633, 635, 636, 632, 637, 638, 619, 524, 0, 571,
-	630, 629, 631, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 287, 0,
-	328, 266, 597, 576, 577, 578, 523, 579, 574, 575,
-	598, 569, 594, 595, 548, 572, 580, 593, 581, 596,
-	599, 600, 639, 640, 587, 641, 584, 601, 592, 591,
-	582, 570, 602, 603, 555, 550, 585, 586, 573, 588,
-	551, 552, 553, 554, 0, 0, 0, 384, 385, 386,
-	408, 370, 0, 420, 156, 353, 49, 148, 125, 0,
-	0, 0, 0, 0, 0, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 149, 0, 0, 0, 0, 0,
-	0, 141, 0, 261, 0, 150, 286, 0, 0, 0,
-	108, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 0,
-	0, 153, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 124, 147, 154, 0, 95, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 171, 0, 0, 0,
-	349, 0, 0, 285, 146, 140, 139, 392, 0, 336,
-	318, 55, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 142,
-	143, 144, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 371, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 174,
-	0, 0, 0, 182, 0, 0, 0, 145, 0, 183,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 106, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 48, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 50, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 126, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 0,
-	0, 0, 384, 385, 386, 408, 370, 353, 184, 38,
-	172, 175, 177, 176, 0, 47, 5, 0, 316, 111,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 990, 0, 0, 178, 0, 0, 533,
-	542, 0, 0, 243, 179, 534, 0, 541, 535, 539,
-	538, 536, 537, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	543, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 540, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 0, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 156, 353, 49, 148, 125, 0, 0, 0, 0,
-	0, 0, 0, 316, 440, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 0, 286, 0, 0, 0, 0, 0, 0,
-	345, 300, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 445, 0,
-	0, 178, 0, 0, 0, 0, 0, 0, 243, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 350, 366, 244, 341, 379, 249, 348, 239,
-	315, 338, 0, 0, 236, 364, 347, 297, 280, 281,
-	235, 0, 333, 259, 272, 256, 313, 0, 363, 391,
-	255, 382, 0, 374, 238, 0, 373, 312, 360, 365,
-	298, 292, 237, 362, 296, 291, 284, 263, 407, 276,
-	324, 290, 325, 277, 302, 301, 303, 0, 0, 0,
-	0, 0, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 349, 0, 0,
-	285, 0, 0, 0, 392, 0, 336, 318, 0, 0,
-	0, 334, 288, 361, 326, 367, 351, 375, 330, 327,
-	229, 352, 258, 299, 240, 242, 254, 260, 262, 264,
-	265, 308, 309, 321, 340, 354, 355, 356, 257, 250,
-	335, 251, 274, 252, 230, 342, 253, 232, 322, 359,
-	0, 270, 331, 295, 233, 294, 323, 358, 357, 241,
-	383, 389, 390, 395, 0, 396, 0, 0, 0, 404,
-	409, 410, 411, 413, 414, 415, 416, 0, 0, 0,
-	0, 398, 0, 0, 0, 0, 0, 0, 388, 268,
-	226, 227, 423, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 310, 387, 0, 0, 0, 0,
-	422, 0, 0, 0, 0, 0, 421, 320, 0, 339,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 346, 369, 381, 399, 402, 0, 0, 0,
-	231, 401, 0, 0, 0, 0, 0, 0, 0, 372,
-	0, 0, 0, 380, 0, 0, 0, 0, 0, 397,
-	304, 305, 306, 307, 441, 443, 248, 400, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 394, 267, 273, 412,
-	275, 247, 319, 269, 378, 282, 0, 405, 0, 406,
-	0, 0, 0, 0, 311, 278, 279, 343, 283, 289,
-	332, 377, 317, 337, 245, 368, 344, 293, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	287, 126, 328, 266, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 0, 222, 223, 224, 225, 353, 0, 0, 384,
-	385, 386, 408, 370, 0, 420, 0, 316, 0, 0,
-	0, 0, 0, 0, 0, 821, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 0, 286, 0, 0,
-	0, 0, 0, 0, 345, 300, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 243, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 809, 0,
-	0, 0, 0, 0, 0, 234, 350, 366, 244, 341,
-	379, 249, 348, 239, 315, 338, 0, 0, 1776, 1778,
-	1779, 1780, 1781, 1782, 1783, 0, 1787, 1784, 1785, 1786,
-	313, 0, 1771, 1772, 1773, 1774, 807, 1757, 1777, 0,
-	1758, 312, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766,
-	1767, 1768, 1769, 1775, 324, 290, 325, 277, 302, 301,
-	303, 832, 834, 836, 838, 841, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 285, 0, 0, 0, 1770, 0,
-	336, 318, 0, 0, 0, 334, 288, 361, 326, 367,
-	351, 375, 330, 327, 229, 352, 258, 299, 240, 242,
-	254, 260, 262, 264, 265, 308, 309, 321, 340, 354,
-	355, 356, 257, 250, 335, 251, 274, 252, 230, 342,
-	253, 232, 322, 359, 0, 270, 331, 295, 233, 294,
-	323, 358, 357, 241, 383, 389, 390, 395, 0, 396,
-	0, 0, 0, 404, 409, 410, 411, 413, 414, 415,
-	416, 0, 0, 0, 0, 398, 0, 0, 0, 0,
-	0, 0, 388, 268, 226, 227, 423, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 310, 387,
-	0, 0, 0, 0, 422, 0, 0, 0, 0, 0,
-	421, 320, 0, 339, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 346, 369, 381, 399,
-	402, 0, 0, 0, 231, 401, 0, 0, 0, 0,
-	0, 0, 0, 372, 0, 0, 0, 380, 0, 0,
-	0, 0, 0, 397, 304, 305, 306, 307, 271, 0,
-	248, 400, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	394, 267, 273, 412, 275, 247, 319, 269, 378, 282,
-	0, 405, 0, 406, 0, 0, 0, 0, 311, 278,
-	279, 343, 283, 289, 332, 377, 317, 337, 245, 368,
-	344, 293, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 831, 287, 0, 328, 266, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	559, 2993, 3147, 1330, 2510, 539, 181, 537, 3102, 561,
+	3027, 1561, 3059, 3087, 532, 2994, 2868, 2900, 1836, 2971,
+	2530, 2760, 2977, 2833, 1809, 2978, 1921, 2765, 2934, 2954,
+	2621, 2899, 2958, 2790, 2858, 1030, 2622, 2885, 2789, 1139,
+	446, 1268, 2763, 2848, 675, 1512, 1259, 2821, 589, 2796,
+	1514, 453, 2511, 458, 458, 2214, 2487, 2695, 1333, 458,
+	474, 481, 1326, 2755, 481, 2311, 1925, 2592, 1190, 1617,
+	1620, 2296, 2652, 166, 2542, 2312, 2514, 1922, 541, 2519,
+	1997, 2307, 2310, 2304, 2619, 2095, 1686, 1717, 2607, 2581,
+	2601, 2541, 2462, 2459, 1891, 2457, 2485, 792, 1564, 1834,
+	1822, 486, 2363, 1594, 2200, 2403, 1636, 1181, 890, 1255,
+	530, 2094, 2488, 1694, 1468, 56, 1249, 1695, 536, 1687,
+	2346, 696, 2044, 2339, 1613, 1660, 492, 36, 1998, 1712,
+	1597, 1113, 1713, 784, 728, 1986, 1602, 2177, 2239, 798,
+	2181, 1923, 1551, 6, 2515, 1148, 1595, 1245, 1267, 177,
+	8, 1890, 531, 1476, 1499, 1696, 2140, 844, 446, 176,
+	7, 2061, 1093, 538, 1324, 1949, 1745, 1714, 540, 1066,
+	1832, 1199, 1223, 445, 1875, 1524, 1943, 112, 1523, 529,
+	1382, 181, 452, 181, 1260, 835, 836, 479, 35, 457,
+	457, 1363, 907, 829, 830, 465, 1724, 1315, 834, 548,
+	1693, 26, 1633, 1690, 1329, 796, 1676, 15, 1650, 1230,
+	1323, 13, 783, 2005, 1128, 1541, 1498, 467, 14, 727,
+	1173, 470, 1124, 672, 1388, 1389, 495, 494, 1222, 23,
+	2139, 16, 10, 160, 531, 1140, 167, 1095, 1147, 725,
+	1031, 480, 1721, 964, 965, 966, 963, 163, 1731, 2097,
+	746, 2007, 758, 674, 831, 2396, 833, 2047, 2614, 2396,
+	2050, 478, 2048, 1237, 828, 2045, 1233, 475, 832, 827,
+	828, 476, 828, 165, 454, 1160, 1235, 802, 477, 2753,
+	964, 965, 966, 963, 964, 965, 966, 963, 2359, 2357,
+	463, 1665, 2854, 2849, 2756, 2620, 1472, 3097, 3052, 2951,
+	2946, 1025, 1115, 1689, 673, 484, 3127, 3095, 3017, 826,
+	996, 997, 3134, 2944, 3107, 8, 683, 164, 2920, 52,
+	156, 132, 2767, 164, 164, 7, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 2895, 799,
+	2866, 164, 164, 1282, 52, 156, 132, 2782, 927, 164,
+	801, 52, 156, 132, 2090, 2082, 164, 164, 164, 1279,
+	2378, 1275, 164, 1083, 3067, 2550, 2942, 859, 2864, 2781,
+	2124, 1718, 2910, 490, 491, 2427, 1879, 1272, 2020, 161,
+	1729, 961, 2021, 2896, 676, 1281, 161, 1156, 1481, 1482,
+	1157, 1632, 2371, 111, 111, 663, 3128, 662, 664, 665,
+	3079, 666, 667, 1274, 161, 2179, 942, 3099, 1136, 943,
+	1300, 161, 684, 2845, 1084, 1145, 1146, 2062, 161, 161,
+	161, 767, 1316, 772, 161, 1320, 771, 1143, 2981, 2982,
+	954, 1142, 1145, 1146, 1537, 1332, 959, 795, 794, 3077,
+	2947, 2948, 1802, 2856, 2776, 945, 3063, 3064, 2364, 1319,
+	2859, 2860, 2861, 2862, 2623, 2623, 2936, 458, 2178, 2936,
+	2365, 2939, 2366, 838, 2852, 3016, 1335, 458, 900, 910,
+	2077, 901, 2953, 2632, 1606, 1311, 847, 2653, 1409, 1614,
+	1725, 1159, 2473, 481, 481, 2660, 458, 2877, 1976, 1874,
+	2787, 1673, 1236, 1234, 899, 2164, 868, 872, 874, 876,
+	878, 879, 881, 2391, 885, 882, 883, 884, 895, 897,
+	863, 864, 865, 866, 845, 846, 869, 776, 848, 940,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
+	860, 861, 867, 1423, 2537, 1321, 773, 1243, 1242, 2389,
+	871, 873, 875, 877, 880, 797, 2184, 131, 967, 162,
+	930, 3019, 3020, 2471, 957, 958, 1318, 999, 3021, 2463,
+	956, 964, 965, 966, 963, 1008, 910, 1610, 894, 2919,
+	2980, 2775, 154, 1334, 2087, 2467, 2754, 2777, 941, 947,
+	862, 2300, 948, 1979, 1000, 2358, 1978, 2880, 1014, 802,
+	2784, 900, 2478, 892, 1134, 775, 1982, 3081, 3071, 479,
+	479, 2555, 2556, 898, 896, 2468, 2469, 2484, 2892, 2492,
+	2963, 525, 2207, 483, 527, 2959, 922, 1035, 950, 526,
+	2470, 482, 918, 1734, 1736, 1737, 1730, 2716, 3175, 3113,
+	2972, 1158, 2231, 935, 952, 953, 937, 3029, 1630, 1631,
+	3025, 3026, 1123, 3029, 1405, 1168, 3076, 2917, 1402, 2835,
+	944, 799, 1404, 1401, 1403, 1407, 1408, 3120, 802, 2708,
+	1406, 1959, 801, 912, 911, 3125, 1317, 774, 1958, 1034,
+	2562, 2190, 938, 478, 478, 1177, 2465, 2725, 2726, 475,
+	475, 1176, 920, 476, 476, 1341, 1344, 1345, 1813, 2699,
+	477, 477, 946, 903, 904, 3090, 1342, 2636, 1089, 1091,
+	453, 2395, 1096, 1138, 1137, 1121, 685, 1002, 1003, 1004,
+	1005, 1120, 2768, 2990, 919, 1719, 1063, 1119, 2111, 2112,
+	799, 905, 1719, 2703, 915, 916, 2989, 728, 951, 2874,
+	2973, 801, 2927, 828, 1830, 828, 3018, 828, 1719, 828,
+	2512, 828, 2886, 2904, 828, 891, 931, 2443, 1942, 1006,
+	3035, 949, 1720, 2046, 1732, 1941, 1940, 1238, 1746, 2674,
+	912, 911, 1946, 1944, 2894, 1829, 1214, 1145, 1146, 933,
+	2751, 2893, 1094, 458, 1144, 1170, 2594, 2596, 490, 1928,
+	3051, 936, 939, 2933, 53, 1141, 446, 446, 446, 446,
+	673, 2553, 1194, 1194, 2474, 458, 2878, 1412, 1413, 1414,
+	1415, 1416, 1417, 1410, 1411, 932, 1145, 1146, 1174, 1615,
+	1135, 2865, 2392, 481, 1096, 453, 53, 133, 1226, 1226,
+	3091, 2083, 3096, 133, 133, 2010, 1931, 1722, 768, 181,
+	3082, 1088, 1225, 1225, 1098, 1082, 1201, 2783, 446, 1043,
+	1044, 133, 133, 2788, 2091, 870, 2183, 2834, 797, 133,
+	1607, 1312, 927, 1948, 1192, 1192, 133, 133, 133, 1103,
+	2722, 1196, 133, 2232, 921, 1097, 2464, 1099, 1100, 1101,
+	1102, 1735, 1104, 2482, 2903, 1733, 1108, 934, 2466, 2394,
+	1107, 1106, 1092, 1125, 1129, 1129, 1129, 1266, 1105, 1269,
+	1244, 2235, 485, 2452, 1277, 2949, 2950, 1188, 1189, 2187,
+	2188, 2193, 2194, 2195, 2196, 2197, 1125, 1125, 2746, 1166,
+	1110, 1068, 770, 2186, 1298, 769, 2405, 2404, 1927, 1184,
+	1185, 1186, 1187, 1929, 1070, 720, 3034, 1194, 2163, 1194,
+	900, 1200, 1815, 1343, 2701, 1283, 1817, 1816, 2700, 926,
+	674, 2595, 1087, 1609, 1484, 2822, 2823, 2824, 2826, 2827,
+	1169, 2825, 2704, 2705, 1130, 1131, 1331, 3088, 3089, 1932,
+	1085, 1086, 1247, 1485, 1250, 1251, 1112, 722, 723, 724,
+	1814, 1239, 1483, 686, 1257, 1258, 687, 1336, 1337, 1338,
+	1339, 1340, 1930, 1161, 1162, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1218, 2804, 1149,
+	3130, 1374, 1152, 1376, 2212, 1175, 802, 1515, 1515, 1254,
+	802, 2483, 900, 1387, 962, 1386, 1825, 690, 1273, 2497,
+	690, 1313, 1280, 3178, 1384, 1385, 1436, 819, 824, 825,
+	2578, 1420, 2574, 479, 1126, 2549, 463, 1122, 1331, 1430,
+	1826, 1827, 1307, 927, 1132, 1202, 1995, 1227, 1445, 1293,
+	1294, 1217, 1150, 1151, 1216, 1153, 1154, 1155, 3176, 3173,
+	2064, 1328, 1426, 1427, 1428, 1228, 1284, 3171, 1262, 695,
+	1265, 2236, 689, 692, 691, 1442, 692, 691, 1443, 2281,
+	1470, 3162, 777, 458, 1474, 1936, 1309, 1477, 1346, 1486,
+	1450, 1451, 2213, 3048, 458, 925, 677, 1653, 458, 2026,
+	1497, 1194, 1501, 1466, 1503, 1504, 3161, 478, 3135, 458,
+	1807, 2670, 728, 475, 1877, 1513, 1306, 476, 1779, 1194,
+	1803, 1778, 1303, 2082, 477, 1170, 1302, 1289, 1727, 2689,
+	474, 1325, 2213, 1285, 1996, 2578, 1469, 3048, 674, 1127,
+	2072, 3122, 1297, 1435, 1305, 3104, 1304, 1301, 768, 1536,
+	1296, 1727, 2072, 1322, 1327, 1372, 1373, 1542, 1542, 1996,
+	1170, 893, 1170, 1170, 1418, 1419, 458, 1422, 1497, 1497,
+	3086, 3053, 1194, 1592, 1604, 1437, 1727, 3047, 1727, 446,
+	1540, 1194, 1496, 1529, 964, 965, 966, 963, 1444, 1365,
+	1446, 962, 3031, 1953, 2991, 1996, 1470, 2988, 1535, 924,
+	1936, 1538, 1539, 1470, 1470, 821, 822, 823, 458, 1497,
+	1194, 962, 1641, 458, 458, 3105, 1645, 1876, 1935, 1480,
+	962, 458, 1649, 1939, 1937, 1651, 1655, 2983, 1938, 1502,
+	1490, 1806, 770, 181, 1494, 769, 181, 181, 1473, 181,
+	962, 3054, 1421, 1314, 2921, 1508, 1663, 3048, 2918, 1666,
+	1587, 1588, 1669, 2173, 2166, 1671, 2069, 1718, 1625, 1626,
+	1914, 1495, 3032, 1808, 2992, 1125, 2915, 2882, 1505, 1506,
+	1507, 1447, 1757, 925, 1783, 1436, 1436, 1697, 2914, 1530,
+	1516, 1517, 1436, 1436, 1709, 2913, 1628, 1704, 1467, 677,
+	1129, 1638, 1621, 1622, 1623, 1624, 1111, 2882, 964, 965,
+	966, 963, 1548, 1380, 1611, 1500, 1664, 2912, 1178, 1667,
+	1668, 1513, 1670, 1616, 1895, 1194, 1716, 2659, 2689, 1642,
+	1643, 1544, 3158, 1518, 2282, 2284, 2285, 2286, 2283, 3106,
+	1509, 1510, 2881, 1935, 2727, 1545, 2882, 1640, 1939, 1937,
+	1546, 1547, 1520, 1938, 1637, 1756, 2688, 1526, 2882, 1637,
+	1637, 2564, 2330, 2685, 1933, 2882, 1710, 1648, 2145, 1534,
+	964, 965, 966, 963, 2098, 2080, 1698, 1525, 2498, 1527,
+	1528, 927, 2073, 1543, 2071, 2066, 1500, 2882, 1743, 1744,
+	2059, 2057, 1533, 964, 965, 966, 963, 1593, 1591, 2348,
+	802, 1612, 1692, 2215, 2085, 1750, 2055, 802, 1754, 1692,
+	2084, 2053, 2882, 1627, 2026, 2076, 979, 2599, 1739, 1911,
+	1894, 1804, 2502, 1064, 1325, 1662, 2689, 1787, 479, 1774,
+	1639, 2565, 1996, 1521, 1522, 1786, 1759, 2386, 962, 1777,
+	2425, 1768, 1708, 1767, 962, 1895, 1659, 1658, 1765, 1661,
+	1531, 1532, 2067, 1766, 2072, 2067, 1772, 1493, 1758, 2025,
+	2060, 2058, 799, 1726, 1286, 1703, 1012, 913, 893, 799,
+	1678, 888, 886, 801, 1785, 1290, 2054, 1788, 1789, 1790,
+	801, 2054, 1793, 1794, 1795, 1796, 1797, 1798, 1799, 1800,
+	1895, 1803, 802, 1701, 1707, 1699, 1702, 962, 1818, 530,
+	1784, 2008, 478, 900, 1870, 962, 458, 1791, 475, 962,
+	3144, 962, 476, 962, 2964, 2805, 458, 458, 458, 477,
+	1892, 1711, 1706, 962, 1126, 2493, 562, 571, 1727, 1837,
+	1899, 1170, 563, 1727, 570, 564, 568, 567, 565, 566,
+	1896, 1904, 1741, 1742, 1116, 1291, 1425, 1424, 1117, 3131,
+	1747, 688, 1950, 1182, 799, 1170, 1180, 1738, 1371, 2965,
+	2806, 2677, 2675, 900, 1183, 801, 2612, 2579, 2569, 1740,
+	2566, 893, 1752, 2397, 1368, 1370, 1367, 1365, 1369, 2302,
+	2070, 2012, 1901, 1902, 902, 1383, 1491, 2045, 572, 2494,
+	1448, 1449, 1905, 1906, 1452, 1453, 1454, 1455, 1457, 1458,
+	1459, 1460, 1461, 1462, 1463, 1464, 2678, 2676, 2106, 2039,
+	2000, 2000, 1604, 982, 983, 984, 985, 986, 979, 1916,
+	569, 980, 981, 982, 983, 984, 985, 986, 979, 1127,
+	900, 1383, 1801, 1753, 2495, 1231, 1871, 1662, 1194, 458,
+	2350, 3012, 1872, 1179, 963, 1456, 1470, 1470, 1470, 1470,
+	966, 963, 1884, 1885, 1886, 458, 1035, 900, 453, 2711,
+	2710, 2367, 1226, 2252, 1604, 2251, 2245, 2034, 2243, 2036,
+	2692, 693, 1952, 181, 2785, 1918, 1225, 1903, 1819, 964,
+	965, 966, 963, 1837, 964, 965, 966, 963, 2613, 3179,
+	1913, 2002, 2657, 2292, 1440, 2615, 1129, 3165, 2132, 3114,
+	2018, 2013, 2014, 2015, 2016, 1441, 3110, 1878, 1034, 1900,
+	3108, 2031, 3030, 3124, 3002, 2004, 2966, 2897, 2786, 2078,
+	2038, 2850, 1716, 964, 965, 966, 963, 802, 2290, 1194,
+	1912, 1194, 525, 1194, 2049, 527, 2658, 2291, 900, 1951,
+	526, 1954, 1955, 1956, 1957, 1945, 2040, 1960, 1961, 1962,
+	1963, 1964, 1965, 1966, 1967, 1968, 1969, 1970, 1971, 1972,
+	1973, 2028, 3123, 3166, 2092, 1910, 2814, 2033, 1194, 2808,
+	2125, 2807, 2289, 2103, 2305, 1200, 1980, 2679, 2107, 964,
+	965, 966, 963, 1908, 2110, 2133, 1909, 2127, 2128, 799,
+	1194, 1637, 2656, 2088, 2288, 2130, 2131, 2513, 2472, 2135,
+	801, 2278, 964, 965, 966, 963, 2382, 2362, 2136, 2361,
+	2019, 2108, 2458, 2022, 978, 977, 987, 988, 980, 981,
+	982, 983, 984, 985, 986, 979, 3169, 2029, 1470, 3152,
+	1192, 2158, 2159, 1477, 2032, 900, 2123, 2276, 2287, 2137,
+	2030, 964, 965, 966, 963, 2277, 1907, 2275, 1762, 2274,
+	2041, 2271, 1192, 2265, 964, 965, 966, 963, 2134, 2262,
+	2261, 2167, 2089, 1232, 1681, 2418, 2174, 2115, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 2104, 1680,
+	1679, 3126, 2075, 1675, 2156, 1674, 1287, 1194, 2079, 2081,
+	2191, 1081, 3098, 2086, 1497, 885, 882, 883, 884, 3070,
+	2211, 2120, 2761, 2119, 2118, 2116, 2217, 970, 971, 972,
+	973, 974, 975, 976, 968, 2417, 3065, 2208, 2099, 2100,
+	2102, 3014, 2226, 3013, 2114, 2931, 2879, 2126, 900, 964,
+	965, 966, 963, 1325, 2851, 2795, 2759, 2242, 964, 965,
+	966, 963, 2757, 2747, 1697, 2731, 2248, 2249, 2250, 2729,
+	2237, 1697, 1697, 2256, 900, 2297, 2258, 2259, 2694, 2260,
+	3153, 1770, 1810, 1811, 2220, 2655, 1251, 2654, 2222, 2651,
+	2642, 2117, 2160, 2000, 1257, 1258, 2202, 2157, 964, 965,
+	966, 963, 2635, 2293, 2096, 2573, 1231, 2571, 2560, 446,
+	2559, 2201, 2448, 1497, 900, 1604, 1604, 1604, 1604, 2447,
+	2393, 2240, 2360, 2337, 489, 2240, 900, 1604, 2279, 1254,
+	2000, 2272, 2268, 2267, 2176, 2266, 1805, 2000, 1683, 1769,
+	2313, 1194, 964, 965, 966, 963, 619, 618, 2141, 1677,
+	1479, 1378, 2313, 2146, 2334, 2218, 2209, 458, 458, 1377,
+	2233, 2189, 964, 965, 966, 963, 1288, 2216, 1042, 1038,
+	2180, 2210, 181, 8, 1037, 1013, 889, 181, 3093, 3143,
+	2221, 2905, 1262, 7, 1265, 164, 2257, 2228, 156, 132,
+	2354, 2326, 2356, 2234, 2238, 2867, 2863, 2244, 1436, 2975,
+	1436, 2672, 2671, 2377, 2669, 2641, 2627, 2381, 2618, 2617,
+	1470, 1500, 2600, 1194, 2598, 1470, 2388, 2331, 2241, 2247,
+	2503, 2273, 964, 965, 966, 963, 2253, 2255, 2423, 2121,
+	2122, 977, 987, 988, 980, 981, 982, 983, 984, 985,
+	986, 979, 2301, 2298, 2415, 2351, 2407, 161, 2263, 2264,
+	2355, 2303, 2402, 2401, 2269, 2270, 2345, 2327, 2329, 2328,
+	2325, 2314, 2315, 2316, 2317, 2172, 1469, 2338, 2165, 2056,
+	2335, 2376, 2299, 674, 2052, 2051, 2957, 2422, 2341, 2225,
+	964, 965, 966, 963, 1792, 2374, 1782, 2333, 1780, 2349,
+	1776, 2380, 1775, 2343, 2344, 2410, 2353, 2412, 2352, 964,
+	965, 966, 963, 2770, 900, 1773, 1764, 2390, 1761, 1760,
+	2370, 2461, 802, 2375, 2368, 2373, 2450, 1682, 1465, 802,
+	1439, 2476, 1438, 458, 2372, 1429, 964, 965, 966, 963,
+	1837, 2379, 1206, 2219, 900, 900, 900, 164, 1204, 2398,
+	2385, 2223, 2224, 1604, 1892, 2384, 2501, 3137, 2406, 3129,
+	2399, 3121, 2505, 2769, 3118, 1755, 3116, 2413, 2414, 2411,
+	900, 2720, 2408, 2409, 3001, 2974, 2929, 2516, 2928, 1032,
+	2540, 1246, 2543, 2840, 2543, 2543, 964, 965, 966, 963,
+	2831, 2818, 2548, 2639, 964, 965, 966, 963, 2421, 900,
+	2444, 2547, 2815, 2715, 1194, 1194, 2738, 2449, 2721, 161,
+	2504, 2718, 2717, 2453, 2506, 2507, 964, 965, 966, 963,
+	2714, 964, 965, 966, 963, 2713, 1916, 964, 965, 966,
+	963, 802, 2707, 2664, 2416, 458, 2420, 1256, 2201, 2481,
+	2461, 2489, 2490, 2455, 2480, 1248, 574, 113, 2500, 1497,
+	1497, 1114, 113, 2294, 2539, 2538, 2499, 2246, 2496, 964,
+	965, 966, 963, 2205, 2204, 2203, 1192, 1192, 2419, 2479,
+	1261, 1264, 2557, 2558, 2551, 2544, 2545, 678, 679, 680,
+	681, 458, 1918, 802, 1252, 2155, 2065, 2011, 2009, 2125,
+	677, 964, 965, 966, 963, 2509, 1974, 1893, 2616, 1748,
+	1366, 161, 2577, 464, 1646, 1492, 113, 1489, 1310, 2428,
+	1276, 2552, 1253, 2429, 2430, 2431, 2432, 2589, 2433, 2434,
+	2435, 2436, 2437, 2438, 2439, 2440, 1065, 1062, 1061, 1060,
+	2575, 2576, 1059, 2568, 2567, 458, 2572, 1058, 1057, 1056,
+	1055, 2563, 2400, 3168, 1205, 1054, 2586, 1053, 2546, 2570,
+	978, 977, 987, 988, 980, 981, 982, 983, 984, 985,
+	986, 979, 1052, 1051, 2637, 964, 965, 966, 963, 1781,
+	2154, 1637, 2605, 2638, 1050, 3041, 2153, 1049, 2611, 1048,
+	2640, 1047, 1046, 1045, 1041, 1040, 1039, 2604, 1036, 1029,
+	1028, 1026, 458, 964, 965, 966, 963, 1025, 2628, 964,
+	965, 966, 963, 3141, 1024, 2629, 1898, 2152, 800, 1023,
+	1022, 2648, 113, 2631, 1021, 1020, 1019, 1637, 2151, 1497,
+	1018, 1017, 2634, 2150, 1016, 2668, 2643, 113, 1015, 113,
+	964, 965, 966, 963, 1011, 1010, 1009, 2000, 1604, 2682,
+	929, 964, 965, 966, 963, 887, 964, 965, 966, 963,
+	2630, 2149, 1881, 2690, 978, 977, 987, 988, 980, 981,
+	982, 983, 984, 985, 986, 979, 917, 1194, 2508, 2645,
+	2693, 2633, 3039, 2649, 964, 965, 966, 963, 458, 808,
+	803, 807, 809, 2582, 2583, 2148, 2979, 2540, 2585, 2451,
+	2683, 2192, 2027, 1685, 2724, 928, 2686, 2684, 2322, 2687,
+	2663, 2662, 2324, 2323, 1992, 1993, 814, 815, 964, 965,
+	966, 963, 802, 810, 2588, 1497, 2320, 2680, 806, 900,
+	2587, 2321, 2319, 2147, 2650, 1470, 2318, 2144, 2646, 2696,
+	697, 2843, 2143, 2842, 1470, 2748, 2538, 2737, 2691, 99,
+	2739, 2681, 2597, 55, 455, 2313, 964, 965, 966, 963,
+	964, 965, 966, 963, 181, 964, 965, 966, 963, 54,
+	2142, 2719, 2734, 2749, 2602, 2603, 812, 900, 2340, 2723,
+	1375, 3011, 2841, 816, 2943, 2606, 2023, 2728, 2342, 2813,
+	2732, 2730, 3139, 964, 965, 966, 963, 2665, 2666, 2667,
+	2736, 2750, 804, 2313, 2735, 2162, 460, 2733, 459, 2740,
+	461, 2743, 2744, 2745, 2074, 900, 1194, 1194, 2445, 2446,
+	1634, 900, 2024, 813, 2712, 2068, 462, 1586, 2454, 1240,
+	2063, 2798, 2138, 2093, 2798, 1831, 2778, 2752, 1635, 1810,
+	1811, 2791, 2762, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 964, 965, 966, 963, 2779,
+	1067, 805, 1270, 1647, 900, 900, 923, 2952, 900, 900,
+	2456, 2332, 2802, 458, 2794, 2129, 2836, 2799, 1192, 2696,
+	2801, 2809, 2810, 2227, 2793, 2175, 1888, 1511, 2684, 1513,
+	2791, 2791, 2811, 1488, 2791, 2791, 1425, 1424, 964, 965,
+	966, 963, 2819, 2820, 2846, 2847, 2829, 2830, 2816, 1079,
+	1080, 2844, 2105, 3056, 2828, 1977, 1165, 1379, 1167, 1590,
+	1171, 1172, 1077, 1078, 2838, 1075, 1076, 1164, 113, 113,
+	800, 1163, 2876, 811, 2839, 964, 965, 966, 963, 2872,
+	964, 965, 966, 963, 1073, 1074, 955, 2780, 1207, 1208,
+	1209, 1210, 1211, 1212, 1213, 2742, 1215, 2884, 1705, 2888,
+	1220, 1221, 1118, 1069, 1988, 1991, 1992, 1993, 1989, 900,
+	1990, 1994, 3138, 2873, 2902, 3023, 678, 679, 680, 681,
+	2906, 3008, 900, 1983, 3006, 2960, 2941, 2940, 2883, 677,
+	2890, 2923, 2938, 2930, 2889, 2791, 2926, 2925, 2758, 998,
+	2644, 2625, 2907, 2624, 2898, 2609, 1072, 2911, 2791, 2837,
+	677, 1988, 1991, 1992, 1993, 1989, 2608, 1990, 1994, 2347,
+	2916, 2383, 2771, 1515, 2169, 2170, 2171, 3043, 3042, 3043,
+	1883, 730, 1763, 900, 2872, 914, 2945, 3042, 2709, 2626,
+	168, 3, 2937, 2935, 1133, 63, 694, 2, 1629, 2961,
+	1198, 1, 1478, 682, 2006, 2590, 2591, 2741, 2956, 2791,
+	2593, 1723, 2969, 1975, 1873, 2475, 2955, 1109, 721, 1431,
+	1295, 818, 909, 2962, 1292, 908, 906, 1381, 576, 2995,
+	2998, 1688, 2968, 2295, 2922, 3055, 3101, 3000, 2967, 3058,
+	1308, 560, 2984, 2985, 2986, 2987, 2932, 2855, 768, 3004,
+	2857, 2764, 2999, 1728, 1812, 960, 2369, 742, 612, 587,
+	3007, 1027, 3009, 3010, 3005, 3003, 1278, 1271, 2426, 820,
+	586, 2661, 2185, 3033, 2891, 710, 817, 743, 1672, 2853,
+	1241, 1263, 3022, 1071, 2803, 2673, 2491, 2206, 2970, 2812,
+	3136, 3028, 3174, 3045, 3075, 3119, 2774, 2772, 2773, 3037,
+	3112, 3040, 3062, 3038, 964, 965, 966, 963, 3024, 3044,
+	2766, 3061, 496, 1608, 3050, 444, 3036, 781, 2832, 1684,
+	497, 1897, 3015, 2817, 900, 708, 1880, 2872, 709, 3068,
+	3066, 2199, 770, 2198, 1347, 769, 969, 1364, 2441, 2442,
+	1007, 535, 1751, 547, 2182, 2902, 2531, 2336, 62, 2995,
+	3073, 3084, 3083, 3078, 3080, 3100, 61, 3092, 60, 59,
+	1654, 189, 3103, 578, 3094, 188, 2997, 3060, 558, 557,
+	755, 556, 3109, 555, 554, 900, 1987, 3085, 3111, 1985,
+	1984, 731, 1599, 1598, 1409, 3115, 1652, 3117, 2554, 1550,
+	1947, 1934, 1549, 2976, 2908, 2909, 2969, 2706, 2280, 2702,
+	2698, 1331, 2561, 2797, 1203, 2517, 3062, 3133, 733, 464,
+	2518, 2524, 1887, 843, 839, 3061, 841, 900, 3132, 900,
+	3140, 842, 3142, 840, 3148, 3146, 2113, 3151, 2109, 3145,
+	2995, 3154, 1920, 1919, 2486, 113, 3103, 1824, 1823, 3155,
+	1821, 1820, 3159, 1331, 900, 1331, 3157, 3164, 3151, 3160,
+	3167, 2168, 1828, 1090, 2995, 3170, 2875, 2647, 1835, 3172,
+	1833, 2584, 2580, 2477, 1475, 2161, 1600, 1596, 1981, 3177,
+	1331, 2424, 1882, 90, 89, 97, 754, 753, 144, 49,
+	173, 172, 3069, 175, 174, 171, 2042, 3072, 2043, 170,
+	1229, 169, 2800, 671, 38, 752, 37, 113, 33, 12,
+	3049, 113, 11, 34, 729, 21, 22, 859, 20, 1644,
+	1299, 19, 113, 25, 32, 732, 763, 31, 30, 106,
+	105, 113, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 29, 104, 103, 102, 101, 759,
+	28, 18, 44, 43, 42, 41, 40, 9, 2101, 96,
+	1405, 94, 27, 95, 1402, 92, 93, 91, 1404, 1401,
+	1403, 1407, 1408, 74, 73, 72, 1406, 87, 86, 85,
+	84, 760, 764, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 83, 82, 80, 749, 81,
+	747, 751, 767, 741, 71, 70, 748, 745, 744, 1749,
+	750, 735, 736, 734, 737, 738, 739, 740, 69, 765,
+	68, 766, 859, 67, 78, 88, 847, 79, 77, 76,
+	75, 66, 761, 762, 978, 977, 987, 988, 980, 981,
+	982, 983, 984, 985, 986, 979, 868, 872, 874, 876,
+	878, 879, 881, 65, 885, 882, 883, 884, 64, 130,
+	863, 864, 865, 866, 845, 846, 869, 128, 848, 757,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
+	860, 861, 867, 129, 127, 126, 125, 124, 123, 122,
+	871, 873, 875, 877, 880, 45, 46, 47, 48, 140,
+	139, 141, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397,
+	1398, 1399, 1400, 1412, 1413, 1414, 1415, 1416, 1417, 1410,
+	1411, 146, 143, 145, 142, 137, 135, 138, 136, 134,
+	862, 847, 57, 17, 24, 837, 4, 0, 0, 0,
+	0, 0, 0, 756, 0, 0, 0, 0, 0, 0,
+	0, 868, 872, 874, 876, 878, 879, 881, 0, 885,
+	882, 883, 884, 0, 0, 863, 864, 865, 866, 845,
+	846, 869, 0, 848, 0, 849, 850, 851, 852, 853,
+	854, 855, 856, 857, 858, 860, 861, 867, 0, 990,
+	1603, 995, 0, 0, 0, 871, 873, 875, 877, 880,
+	0, 0, 0, 0, 0, 0, 0, 991, 993, 989,
+	0, 992, 994, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 862, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 0, 113,
+	0, 0, 113, 113, 0, 113, 0, 0, 0, 0,
+	0, 0, 377, 594, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 549, 0, 0, 0,
+	274, 800, 0, 300, 0, 0, 0, 585, 800, 0,
+	368, 315, 0, 335, 370, 308, 365, 113, 0, 0,
+	0, 642, 650, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 542, 0, 0, 575, 619, 618, 562,
+	571, 0, 0, 256, 187, 563, 0, 570, 564, 568,
+	567, 565, 566, 0, 634, 0, 0, 0, 0, 0,
+	0, 533, 546, 2869, 550, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 543,
+	544, 0, 0, 998, 0, 595, 0, 545, 0, 0,
+	590, 572, 573, 0, 0, 870, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 569, 593, 597, 268, 656, 591, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 657, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 588, 0, 0, 0,
+	402, 0, 0, 640, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 592, 0, 358, 338, 653, 534,
+	870, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 1409, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 638, 333, 0, 0, 652, 633, 635,
+	636, 639, 643, 644, 645, 646, 647, 649, 651, 655,
+	450, 0, 0, 0, 0, 0, 448, 340, 2003, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 2870, 0, 0, 0, 2871, 0, 654,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 596,
+	320, 321, 322, 323, 641, 0, 261, 427, 349, 0,
+	1603, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 716, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	663, 637, 662, 664, 665, 661, 666, 667, 648, 552,
+	0, 600, 659, 658, 660, 0, 0, 0, 0, 0,
+	0, 0, 0, 1405, 0, 0, 0, 1402, 0, 0,
+	0, 1404, 1401, 1403, 1407, 1408, 0, 553, 240, 1406,
+	301, 0, 348, 279, 626, 605, 606, 607, 551, 608,
+	603, 604, 627, 598, 623, 624, 577, 601, 609, 622,
+	610, 625, 628, 629, 668, 669, 616, 670, 613, 630,
+	621, 620, 611, 599, 631, 632, 584, 579, 614, 615,
+	602, 617, 580, 581, 582, 583, 0, 0, 0, 329,
+	330, 331, 0, 0, 411, 412, 413, 435, 394, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 718, 0, 713, 0, 702, 0, 0, 0,
+	0, 0, 0, 715, 714, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	699, 700, 0, 0, 0, 0, 0, 703, 0, 0,
+	0, 0, 707, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1390, 1391, 1392, 1393, 1394,
+	1395, 1396, 1397, 1398, 1399, 1400, 1412, 1413, 1414, 1415,
+	1416, 1417, 1410, 1411, 0, 0, 0, 0, 0, 0,
+	113, 0, 0, 712, 0, 0, 0, 711, 0, 0,
+	0, 0, 0, 698, 0, 0, 0, 706, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 704, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 701, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1603, 1603, 1603, 1603, 705, 0, 0, 0, 0,
+	0, 0, 0, 1603, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 594, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 336, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 549, 0, 113, 0, 274, 0, 0, 300, 0,
+	0, 0, 585, 0, 0, 368, 315, 717, 335, 370,
+	308, 365, 0, 113, 0, 0, 642, 650, 0, 0,
+	113, 0, 0, 0, 0, 0, 0, 0, 542, 0,
+	0, 575, 619, 618, 562, 571, 0, 0, 256, 187,
+	563, 0, 570, 564, 568, 567, 565, 566, 0, 634,
+	0, 0, 0, 0, 0, 0, 533, 546, 0, 550,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
+	595, 0, 545, 0, 0, 590, 572, 573, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 113, 353, 272, 286, 269, 332, 569, 593,
+	597, 268, 656, 591, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 657,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 1603,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 316, 252,
+	239, 588, 0, 0, 0, 402, 0, 0, 640, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 592,
+	0, 358, 338, 653, 534, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	1433, 1432, 1434, 415, 282, 237, 238, 451, 638, 333,
+	0, 0, 652, 633, 635, 636, 639, 643, 644, 645,
+	646, 647, 649, 651, 655, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 654, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 596, 320, 321, 322, 323, 641,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 113, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 663, 637, 662, 664, 665,
+	661, 666, 667, 648, 552, 0, 600, 659, 658, 660,
+	0, 0, 0, 0, 1603, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 553, 240, 0, 301, 0, 348, 279, 626,
+	605, 606, 607, 551, 608, 603, 604, 627, 598, 623,
+	624, 577, 601, 609, 622, 610, 625, 628, 629, 668,
+	669, 616, 670, 613, 630, 621, 620, 611, 599, 631,
+	632, 584, 579, 614, 615, 602, 617, 580, 581, 582,
+	583, 0, 0, 0, 329, 330, 331, 0, 0, 411,
+	412, 413, 435, 394, 0, 447, 377, 594, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	113, 585, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 0, 0, 0, 0, 595,
+	0, 545, 0, 0, 590, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 2870, 0, 0,
+	0, 2871, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 0, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 377, 594, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 1471, 0, 300, 0, 0,
+	0, 585, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 1618, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 0, 0, 0, 0, 595,
+	0, 545, 0, 0, 1619, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 0, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 0, 0, 411, 412,
+	413, 435, 394, 0, 447, 164, 377, 594, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 1001, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 0, 0, 0, 0, 595,
+	0, 545, 0, 0, 590, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 133, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 377, 594, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 3156, 0, 300, 0, 0,
+	0, 585, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 0, 0, 0, 0, 595,
+	0, 545, 0, 0, 590, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 0, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 377, 594, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 1471, 0, 300, 0, 0,
+	0, 585, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 0, 0, 0, 0, 595,
+	0, 545, 0, 0, 590, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 0, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 377, 594, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 585, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 642, 650, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	575, 619, 618, 562, 571, 0, 0, 256, 187, 563,
+	0, 570, 564, 568, 567, 565, 566, 0, 634, 0,
+	0, 0, 0, 0, 0, 533, 546, 0, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 544, 1224, 0, 0, 0, 595,
+	0, 545, 0, 0, 590, 572, 573, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 569, 593, 597,
+	268, 656, 591, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 657, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	588, 0, 0, 0, 402, 0, 0, 640, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 592, 0,
+	358, 338, 653, 534, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 638, 333, 0,
+	0, 652, 633, 635, 636, 639, 643, 644, 645, 646,
+	647, 649, 651, 655, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 596, 320, 321, 322, 323, 641, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 663, 637, 662, 664, 665, 661,
+	666, 667, 648, 552, 0, 600, 659, 658, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 240, 0, 301, 0, 348, 279, 626, 605,
+	606, 607, 551, 608, 603, 604, 627, 598, 623, 624,
+	577, 601, 609, 622, 610, 625, 628, 629, 668, 669,
+	616, 670, 613, 630, 621, 620, 611, 599, 631, 632,
+	584, 579, 614, 615, 602, 617, 580, 581, 582, 583,
+	0, 0, 0, 329, 330, 331, 0, 0, 411, 412,
+	413, 435, 394, 0, 447, 377, 594, 0, 0, 1771,
+	0, 0, 0, 0, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	585, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 642, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 575,
+	619, 618, 562, 571, 0, 0, 256, 187, 563, 0,
+	570, 564, 568, 567, 565, 566, 0, 634, 0, 0,
+	0, 0, 0, 0, 533, 546, 0, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 595, 0,
+	545, 0, 0, 590, 572, 573, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 569, 593, 597, 268,
+	656, 591, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 657, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 588,
+	0, 0, 0, 402, 0, 0, 640, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 592, 0, 358,
+	338, 653, 534, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 638, 333, 0, 0,
+	652, 633, 635, 636, 639, 643, 644, 645, 646, 647,
+	649, 651, 655, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 596, 320, 321, 322, 323, 641, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 663, 637, 662, 664, 665, 661, 666,
+	667, 648, 552, 0, 600, 659, 658, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 240, 0, 301, 0, 348, 279, 626, 605, 606,
+	607, 551, 608, 603, 604, 627, 598, 623, 624, 577,
+	601, 609, 622, 610, 625, 628, 629, 668, 669, 616,
+	670, 613, 630, 621, 620, 611, 599, 631, 632, 584,
+	579, 614, 615, 602, 617, 580, 581, 582, 583, 0,
+	0, 0, 329, 330, 331, 377, 594, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	585, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 642, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 575,
+	619, 618, 562, 571, 0, 0, 256, 187, 563, 0,
+	570, 564, 568, 567, 565, 566, 0, 634, 0, 0,
+	0, 0, 0, 0, 533, 546, 0, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 595, 0,
+	545, 0, 0, 590, 572, 573, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 569, 593, 597, 268,
+	656, 591, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 657, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 588,
+	0, 0, 0, 402, 0, 0, 640, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 592, 0, 358,
+	338, 653, 534, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 638, 333, 0, 0,
+	652, 633, 635, 636, 639, 643, 644, 645, 646, 647,
+	649, 651, 655, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 596, 320, 321, 322, 323, 641, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 663, 637, 662, 664, 665, 661, 666,
+	667, 648, 552, 0, 600, 659, 658, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 240, 0, 301, 0, 348, 279, 626, 605, 606,
+	607, 551, 608, 603, 604, 627, 598, 623, 624, 577,
+	601, 609, 622, 610, 625, 628, 629, 668, 669, 616,
+	670, 613, 630, 621, 620, 611, 599, 631, 632, 584,
+	579, 614, 615, 602, 617, 580, 581, 582, 583, 0,
+	0, 0, 329, 330, 331, 377, 594, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 0, 1348, 0, 0, 0, 549,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	585, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 642, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 575,
+	619, 618, 562, 571, 0, 0, 256, 187, 563, 0,
+	570, 564, 568, 567, 565, 566, 0, 634, 0, 0,
+	0, 0, 0, 0, 0, 546, 0, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 595, 0,
+	545, 0, 0, 590, 572, 573, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 569, 593, 597, 268,
+	656, 591, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 657, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 588,
+	0, 0, 0, 402, 0, 0, 640, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 592, 0, 358,
+	338, 653, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 1349, 1350, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 638, 333, 0, 0,
+	652, 633, 635, 636, 639, 643, 644, 645, 646, 647,
+	649, 651, 655, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 596, 320, 321, 322, 323, 641, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 663, 637, 662, 664, 665, 661, 666,
+	667, 648, 552, 0, 600, 659, 658, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 240, 0, 301, 0, 348, 279, 626, 605, 606,
+	607, 551, 608, 603, 604, 627, 598, 623, 624, 577,
+	601, 609, 622, 610, 625, 628, 629, 668, 669, 616,
+	670, 613, 630, 621, 620, 611, 599, 631, 632, 584,
+	579, 614, 615, 602, 617, 580, 581, 582, 583, 0,
+	0, 0, 329, 330, 331, 377, 594, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	585, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 642, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 575,
+	619, 618, 562, 571, 0, 0, 256, 187, 563, 0,
+	570, 564, 568, 567, 565, 566, 0, 634, 0, 0,
+	0, 0, 0, 0, 533, 546, 0, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 595, 0,
+	545, 0, 0, 590, 572, 573, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 569, 593, 597, 268,
+	656, 591, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 657, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 588,
+	0, 0, 0, 402, 0, 0, 640, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 592, 0, 358,
+	338, 653, 534, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 638, 333, 0, 0,
+	652, 633, 635, 636, 639, 643, 644, 645, 646, 647,
+	649, 651, 655, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 596, 320, 321, 322, 323, 641, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 663, 637, 662, 664, 665, 661, 666,
+	667, 648, 552, 0, 600, 659, 658, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 240, 0, 301, 0, 348, 279, 626, 605, 606,
+	607, 551, 608, 603, 604, 627, 598, 623, 624, 577,
+	601, 609, 622, 610, 625, 628, 629, 668, 669, 616,
+	670, 613, 630, 621, 620, 611, 599, 631, 632, 584,
+	579, 614, 615, 602, 617, 580, 581, 582, 583, 0,
+	0, 0, 329, 330, 331, 377, 594, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	585, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 642, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 575,
+	619, 618, 562, 571, 0, 0, 256, 187, 563, 0,
+	570, 564, 568, 567, 565, 566, 0, 634, 0, 0,
+	0, 0, 0, 0, 0, 546, 0, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 595, 0,
+	545, 0, 0, 590, 572, 573, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 569, 593, 597, 268,
+	656, 591, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 657, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 588,
+	0, 0, 0, 402, 0, 0, 640, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 592, 0, 358,
+	338, 653, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 638, 333, 0, 0,
+	652, 633, 635, 636, 639, 643, 644, 645, 646, 647,
+	649, 651, 655, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 596, 320, 321, 322, 323, 641, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 663, 637, 662, 664, 665, 661, 666,
+	667, 648, 552, 0, 600, 659, 658, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 240, 0, 301, 0, 348, 279, 626, 605, 606,
+	607, 551, 608, 603, 604, 627, 598, 623, 624, 577,
+	601, 609, 622, 610, 625, 628, 629, 668, 669, 616,
+	670, 613, 630, 621, 620, 611, 599, 631, 632, 584,
+	579, 614, 615, 602, 617, 580, 581, 582, 583, 0,
+	0, 0, 329, 330, 331, 0, 0, 411, 412, 413,
+	435, 394, 0, 447, 164, 377, 52, 156, 132, 0,
+	0, 0, 0, 0, 0, 0, 336, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 149, 0, 274, 0, 158, 300, 0, 0, 0,
+	111, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	100, 0, 0, 0, 0, 0, 161, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 0, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 131,
+	155, 162, 0, 98, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 179, 0, 0, 0,
+	373, 0, 0, 299, 154, 148, 147, 419, 0, 358,
+	338, 58, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 193, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 150, 151, 152,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 395, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 182,
+	0, 0, 0, 190, 0, 0, 0, 153, 0, 191,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 424, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 109, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 51, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 53, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 133, 348, 279, 194, 195, 196,
 	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	353, 0, 0, 384, 385, 386, 408, 370, 0, 420,
-	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 286, 0, 0, 0, 0, 0, 0, 345, 300,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 0, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 1844, 1847,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	350, 366, 244, 341, 379, 249, 348, 239, 315, 338,
-	0, 0, 236, 364, 347, 297, 280, 281, 235, 0,
-	333, 259, 272, 256, 313, 0, 363, 391, 255, 382,
-	0, 374, 238, 0, 373, 312, 360, 365, 298, 292,
-	237, 362, 296, 291, 284, 263, 407, 276, 324, 290,
-	325, 277, 302, 301, 303, 0, 0, 0, 0, 0,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1848, 376, 0,
-	0, 0, 1843, 0, 1842, 349, 1840, 1845, 285, 0,
-	0, 0, 392, 0, 336, 318, 0, 0, 0, 334,
-	288, 361, 326, 367, 351, 375, 330, 327, 229, 352,
-	258, 299, 240, 242, 254, 260, 262, 264, 265, 308,
-	309, 321, 340, 354, 355, 356, 257, 250, 335, 251,
-	274, 252, 230, 342, 253, 232, 322, 359, 1846, 270,
-	331, 295, 233, 294, 323, 358, 357, 241, 383, 389,
-	390, 395, 0, 396, 0, 0, 0, 404, 409, 410,
-	411, 413, 414, 415, 416, 0, 0, 0, 0, 398,
-	0, 0, 0, 0, 0, 0, 388, 268, 226, 227,
-	423, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 310, 387, 0, 0, 0, 0, 422, 0,
-	0, 0, 0, 0, 421, 320, 0, 339, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	346, 369, 381, 399, 402, 0, 0, 0, 231, 401,
-	0, 0, 0, 0, 0, 0, 0, 372, 0, 0,
-	0, 380, 0, 0, 0, 0, 0, 397, 304, 305,
-	306, 307, 271, 0, 248, 400, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 394, 267, 273, 412, 275, 247,
-	319, 269, 378, 282, 0, 405, 0, 406, 0, 0,
-	0, 0, 311, 278, 279, 343, 283, 289, 332, 377,
-	317, 337, 245, 368, 344, 293, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 287, 0,
-	328, 266, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 353, 0, 0, 384, 385, 386,
-	408, 370, 0, 420, 0, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1584, 0, 0,
-	0, 0, 261, 0, 0, 286, 0, 0, 0, 0,
-	0, 0, 345, 300, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 1585, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 925, 926, 927, 924, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 350, 366, 244, 341, 379, 249,
-	348, 239, 315, 338, 0, 0, 236, 364, 347, 297,
-	280, 281, 235, 0, 333, 259, 272, 256, 313, 0,
-	363, 391, 255, 382, 0, 374, 238, 0, 373, 312,
-	360, 365, 298, 292, 237, 362, 296, 291, 284, 263,
-	407, 276, 324, 290, 325, 277, 302, 301, 303, 0,
-	0, 0, 0, 0, 403, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 285, 0, 0, 0, 392, 0, 336, 318,
-	0, 0, 0, 334, 288, 361, 326, 367, 351, 375,
-	330, 327, 229, 352, 258, 299, 240, 242, 254, 260,
-	262, 264, 265, 308, 309, 321, 340, 354, 355, 356,
-	257, 250, 335, 251, 274, 252, 230, 342, 253, 232,
-	322, 359, 0, 270, 331, 295, 233, 294, 323, 358,
-	357, 241, 383, 389, 390, 395, 0, 396, 0, 0,
-	0, 404, 409, 410, 411, 413, 414, 415, 416, 0,
-	0, 0, 0, 398, 0, 0, 0, 0, 0, 0,
-	388, 268, 226, 227, 423, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 310, 387, 0, 0,
-	0, 0, 422, 0, 0, 0, 0, 0, 421, 320,
-	0, 339, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 346, 369, 381, 399, 402, 0,
-	0, 0, 231, 401, 0, 0, 0, 0, 0, 0,
-	0, 372, 0, 0, 0, 380, 0, 0, 0, 0,
-	0, 397, 304, 305, 306, 307, 271, 0, 248, 400,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 394, 267,
-	273, 412, 275, 247, 319, 269, 378, 282, 0, 405,
-	0, 406, 0, 0, 0, 0, 311, 278, 279, 343,
-	283, 289, 332, 377, 317, 337, 245, 368, 344, 293,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 287, 0, 328, 266, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 0, 222, 223, 224, 225, 353, 0,
-	0, 384, 385, 386, 408, 370, 0, 420, 0, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 744, 0, 286,
-	0, 0, 0, 0, 0, 0, 345, 300, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 753, 754,
-	0, 0, 0, 0, 243, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 757, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 350, 366,
-	244, 341, 379, 249, 348, 239, 315, 338, 0, 0,
-	236, 364, 347, 297, 280, 281, 235, 0, 333, 259,
-	272, 256, 313, 0, 363, 391, 255, 382, 734, 374,
-	238, 733, 373, 312, 360, 365, 298, 292, 237, 362,
-	296, 291, 284, 263, 407, 276, 324, 290, 325, 277,
-	302, 301, 303, 0, 0, 0, 0, 0, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 285, 0, 0, 0,
-	392, 0, 336, 318, 0, 0, 0, 334, 288, 361,
-	326, 367, 351, 375, 742, 327, 229, 352, 258, 299,
-	240, 242, 254, 260, 262, 264, 265, 308, 309, 321,
-	340, 354, 355, 356, 257, 250, 335, 251, 274, 252,
-	230, 342, 253, 232, 322, 359, 0, 270, 331, 295,
-	233, 294, 323, 358, 357, 241, 383, 389, 390, 395,
-	0, 396, 0, 0, 0, 404, 409, 410, 411, 413,
-	414, 415, 416, 0, 0, 0, 0, 398, 0, 0,
-	0, 0, 0, 0, 388, 268, 226, 227, 423, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	310, 387, 0, 0, 0, 0, 422, 0, 0, 0,
-	0, 0, 421, 320, 0, 339, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 346, 369,
-	381, 399, 402, 0, 0, 0, 231, 401, 0, 0,
-	0, 0, 0, 0, 743, 372, 0, 0, 0, 380,
-	0, 0, 0, 0, 0, 746, 304, 305, 306, 307,
-	271, 0, 248, 400, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 394, 267, 273, 412, 275, 247, 319, 269,
-	378, 282, 0, 405, 0, 406, 0, 0, 0, 0,
-	755, 749, 750, 751, 283, 289, 332, 377, 317, 337,
-	245, 368, 344, 752, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 287, 0, 328, 266,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
-	224, 225, 156, 353, 0, 384, 385, 386, 408, 370,
-	0, 420, 0, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 108, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
-	1628, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 126, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 156, 353, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 0, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 0, 286,
-	0, 0, 0, 108, 0, 0, 345, 300, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 153, 1619, 0, 178, 0, 0,
-	0, 0, 0, 0, 243, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 350, 366,
-	244, 341, 379, 249, 348, 239, 315, 338, 0, 0,
-	236, 364, 347, 297, 280, 281, 235, 0, 333, 259,
-	272, 256, 313, 0, 363, 391, 255, 382, 0, 374,
-	238, 0, 373, 312, 360, 365, 298, 292, 237, 362,
-	296, 291, 284, 263, 407, 276, 324, 290, 325, 277,
-	302, 301, 303, 0, 0, 0, 0, 0, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 285, 0, 0, 0,
-	392, 0, 336, 318, 0, 0, 0, 334, 288, 361,
-	326, 367, 351, 375, 330, 327, 229, 352, 258, 299,
-	240, 242, 254, 260, 262, 264, 265, 308, 309, 321,
-	340, 354, 355, 356, 257, 250, 335, 251, 274, 252,
-	230, 342, 253, 232, 322, 359, 0, 270, 331, 295,
-	233, 294, 323, 358, 357, 241, 383, 389, 390, 395,
-	0, 396, 0, 0, 0, 404, 409, 410, 411, 413,
-	414, 415, 416, 0, 0, 0, 0, 398, 0, 0,
-	0, 0, 0, 0, 388, 268, 226, 227, 423, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	310, 387, 0, 0, 0, 0, 422, 0, 0, 0,
-	0, 0, 421, 320, 0, 339, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 346, 369,
-	381, 399, 402, 0, 0, 0, 231, 401, 0, 0,
-	0, 0, 0, 0, 0, 372, 0, 0, 0, 380,
-	0, 0, 0, 0, 0, 397, 304, 305, 306, 307,
-	271, 0, 248, 400, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 394, 267, 273, 412, 275, 247, 319, 269,
-	378, 282, 0, 405, 0, 406, 0, 0, 0, 0,
-	311, 278, 279, 343, 283, 289, 332, 377, 317, 337,
-	245, 368, 344, 293, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 287, 126, 328, 266,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
-	224, 225, 156, 353, 0, 384, 385, 386, 408, 370,
-	0, 420, 0, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 108, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1534,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 126, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 753, 754, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 757, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 734, 374, 238,
-	733, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 755,
-	749, 750, 751, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 752, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 2181, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	2184, 0, 0, 2183, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 1149, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 1147, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1145, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 1143, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 1147, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1145, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2827, 0, 178, 590, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 1147,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2540,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 1147, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1145, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1907, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 1909, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 1923, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 1147, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 377, 231, 232, 233, 234, 0,
+	0, 0, 329, 330, 331, 336, 0, 411, 412, 413,
+	435, 394, 0, 192, 39, 180, 183, 185, 184, 0,
+	50, 5, 274, 0, 114, 300, 0, 0, 0, 0,
+	0, 0, 368, 315, 0, 335, 370, 308, 365, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1033, 0, 0, 186, 0,
+	0, 562, 571, 0, 0, 256, 187, 563, 0, 570,
+	564, 568, 567, 565, 566, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 572, 0, 0, 0, 0, 0, 246,
+	374, 390, 257, 363, 406, 262, 372, 251, 334, 360,
+	0, 0, 248, 388, 371, 312, 294, 295, 247, 0,
+	353, 272, 286, 269, 332, 569, 387, 418, 268, 409,
+	0, 398, 250, 0, 397, 328, 384, 389, 313, 306,
+	249, 386, 311, 305, 298, 276, 434, 401, 290, 344,
+	304, 345, 291, 318, 317, 319, 0, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 252, 239, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 299, 0, 0, 0, 419, 0, 358, 338,
+	0, 0, 0, 354, 302, 385, 346, 391, 0, 0,
+	280, 399, 403, 355, 449, 356, 375, 400, 350, 347,
+	241, 376, 271, 314, 253, 255, 267, 273, 275, 277,
+	278, 324, 325, 341, 362, 378, 379, 380, 270, 263,
+	357, 264, 288, 265, 242, 364, 266, 244, 342, 383,
+	0, 284, 351, 310, 245, 309, 343, 382, 381, 254,
+	410, 416, 417, 422, 0, 423, 0, 0, 0, 431,
+	436, 437, 438, 440, 441, 442, 443, 0, 0, 0,
+	0, 425, 0, 0, 235, 236, 0, 0, 0, 0,
+	415, 282, 237, 238, 451, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 414, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 448, 340,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 393, 408, 426, 429, 0,
+	0, 0, 243, 428, 0, 0, 0, 0, 0, 0,
+	0, 396, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 424, 320, 321, 322, 323, 285, 0, 261, 427,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 420, 421, 281,
+	287, 439, 289, 260, 339, 283, 405, 296, 0, 432,
+	0, 433, 0, 0, 0, 0, 327, 292, 293, 366,
+	297, 303, 352, 404, 337, 359, 258, 392, 367, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 859,
+	240, 0, 301, 0, 348, 279, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 0, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 229, 377, 231, 232, 233, 234, 0, 0,
+	0, 329, 330, 331, 336, 0, 411, 412, 413, 435,
+	394, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 847, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 1928, 1931, 868, 872,
+	874, 876, 878, 879, 881, 0, 885, 882, 883, 884,
+	0, 0, 863, 864, 865, 866, 845, 846, 869, 0,
+	848, 0, 849, 850, 851, 852, 853, 854, 855, 856,
+	857, 858, 860, 861, 867, 0, 0, 0, 0, 0,
+	0, 0, 871, 873, 875, 877, 880, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 862, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	1932, 402, 0, 0, 0, 1927, 1917, 1926, 373, 1924,
+	1929, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 1915, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 1930,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 870, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 0, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 0, 0, 411, 412, 413, 435, 394,
+	0, 447, 164, 377, 52, 156, 132, 0, 0, 0,
+	0, 0, 0, 0, 336, 468, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 473, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 469, 471, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 53,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 133, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 377, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 336, 0, 411, 412, 413, 435, 394,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 1928, 1931, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 1932,
+	402, 0, 0, 0, 1927, 1917, 1926, 373, 1924, 1929,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 1930, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 0, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 0, 231, 232, 233, 234, 0, 0, 377, 329,
+	330, 331, 0, 0, 411, 412, 413, 435, 394, 336,
+	447, 0, 0, 0, 0, 0, 0, 859, 0, 0,
+	0, 0, 0, 0, 0, 0, 274, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 368, 315, 0, 335,
+	370, 308, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 186, 0, 0, 0, 0, 0, 0, 256,
+	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 847, 0, 0, 0,
+	0, 0, 0, 246, 374, 390, 257, 363, 406, 262,
+	372, 251, 334, 360, 0, 0, 1858, 1860, 1861, 1862,
+	1863, 1864, 1865, 0, 1869, 1866, 1867, 1868, 332, 0,
+	1853, 1854, 1855, 1856, 845, 1838, 1859, 0, 1839, 328,
+	1840, 1841, 1842, 1843, 1844, 1845, 1846, 1847, 1848, 1849,
+	1850, 1851, 1857, 344, 304, 345, 291, 318, 317, 319,
+	871, 873, 875, 877, 880, 430, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	252, 239, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 373, 0, 0, 299, 0, 0, 0,
+	1852, 0, 358, 338, 0, 0, 0, 354, 302, 385,
+	346, 391, 0, 0, 280, 399, 403, 355, 449, 356,
+	375, 400, 350, 347, 241, 376, 271, 314, 253, 255,
+	267, 273, 275, 277, 278, 324, 325, 341, 362, 378,
+	379, 380, 270, 263, 357, 264, 288, 265, 242, 364,
+	266, 244, 342, 383, 0, 284, 351, 310, 245, 309,
+	343, 382, 381, 254, 410, 416, 417, 422, 0, 423,
+	0, 0, 0, 431, 436, 437, 438, 440, 441, 442,
+	443, 0, 0, 0, 0, 425, 0, 0, 235, 236,
+	0, 0, 0, 0, 415, 282, 237, 238, 451, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 414, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 340, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 393,
+	408, 426, 429, 0, 0, 0, 243, 428, 0, 0,
+	0, 0, 0, 0, 0, 396, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 424, 320, 321, 322, 323,
+	285, 0, 261, 427, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 420, 421, 281, 287, 439, 289, 260, 339, 283,
+	405, 296, 0, 432, 0, 433, 0, 0, 0, 0,
+	327, 292, 293, 366, 297, 303, 352, 404, 337, 359,
+	258, 392, 367, 307, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 870, 301, 0, 348, 279,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2905, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 590, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 0, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 377, 231, 232,
+	233, 234, 0, 0, 0, 329, 330, 331, 336, 0,
+	411, 412, 413, 435, 394, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	1928, 1931, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 0, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 1932, 402, 0, 0, 0, 1927,
+	0, 1926, 373, 1924, 1929, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 1930, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 377, 231, 232, 233,
+	234, 0, 0, 0, 329, 330, 331, 336, 0, 411,
+	412, 413, 435, 394, 0, 447, 0, 0, 0, 1656,
+	0, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 0, 0, 1657, 0, 0, 0, 256, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 964, 965, 966, 963, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 0, 387, 418,
+	268, 409, 0, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 434, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 419, 0,
+	358, 338, 0, 0, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 414,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 424, 320, 321, 322, 323, 285, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 301, 0, 348, 279, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2842, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 2783, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2622, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	0, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 377, 231, 232, 233, 234,
+	0, 0, 0, 329, 330, 331, 336, 0, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 780, 0, 300, 0, 0, 0,
+	0, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	789, 790, 0, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 793, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 770, 398, 250, 769, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 419, 0, 358,
+	338, 0, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 778,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 779, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 782, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 791, 785, 786,
+	787, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	788, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 0, 348, 279, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 0, 231, 232, 233, 234, 0,
+	164, 377, 329, 330, 331, 0, 0, 411, 412, 413,
+	435, 394, 336, 447, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 0, 300, 0, 0, 0, 111, 0, 0, 368,
+	315, 0, 335, 370, 308, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1601, 0, 0, 186, 0, 0, 0, 0,
+	0, 0, 256, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 374, 390, 257,
+	363, 406, 262, 372, 251, 334, 360, 0, 0, 248,
+	388, 371, 312, 294, 295, 247, 0, 353, 272, 286,
+	269, 332, 0, 387, 418, 268, 409, 0, 398, 250,
+	0, 397, 328, 384, 389, 313, 306, 249, 386, 311,
+	305, 298, 276, 434, 401, 290, 344, 304, 345, 291,
+	318, 317, 319, 0, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 252, 239, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 373, 0, 0, 299,
+	0, 0, 0, 419, 0, 358, 338, 0, 0, 0,
+	354, 302, 385, 346, 391, 0, 0, 280, 399, 403,
+	355, 449, 356, 375, 400, 350, 347, 241, 376, 271,
+	314, 253, 255, 267, 273, 275, 277, 278, 324, 325,
+	341, 362, 378, 379, 380, 270, 263, 357, 264, 288,
+	265, 242, 364, 266, 244, 342, 383, 0, 284, 351,
+	310, 245, 309, 343, 382, 381, 254, 410, 416, 417,
+	422, 0, 423, 0, 0, 0, 431, 436, 437, 438,
+	440, 441, 442, 443, 0, 0, 0, 0, 425, 0,
+	0, 235, 236, 0, 0, 0, 0, 415, 282, 237,
+	238, 451, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 414, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 448, 340, 0, 361, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 369, 393, 408, 426, 429, 0, 0, 0, 243,
+	428, 0, 0, 0, 0, 0, 0, 0, 396, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 424, 320,
+	321, 322, 323, 285, 0, 261, 427, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 420, 421, 281, 287, 439, 289,
+	260, 339, 283, 405, 296, 0, 432, 0, 433, 0,
+	0, 0, 0, 327, 292, 293, 366, 297, 303, 352,
+	404, 337, 359, 258, 392, 367, 307, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 0, 301,
+	133, 348, 279, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 0, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	0, 231, 232, 233, 234, 0, 0, 1605, 329, 330,
+	331, 164, 377, 411, 412, 413, 435, 394, 0, 447,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 111, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 1700, 0, 186, 0, 0, 0,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 133, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 0, 231, 232, 233, 234, 0, 164, 377, 329,
+	330, 331, 0, 0, 411, 412, 413, 435, 394, 336,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 274, 0, 0, 300,
+	0, 0, 0, 111, 0, 0, 368, 315, 0, 335,
+	370, 308, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	1691, 0, 186, 0, 0, 0, 0, 0, 0, 256,
+	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 374, 390, 257, 363, 406, 262,
+	372, 251, 334, 360, 0, 0, 248, 388, 371, 312,
+	294, 295, 247, 0, 353, 272, 286, 269, 332, 0,
+	387, 418, 268, 409, 0, 398, 250, 0, 397, 328,
+	384, 389, 313, 306, 249, 386, 311, 305, 298, 276,
+	434, 401, 290, 344, 304, 345, 291, 318, 317, 319,
+	0, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	252, 239, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 373, 0, 0, 299, 0, 0, 0,
+	419, 0, 358, 338, 0, 0, 0, 354, 302, 385,
+	346, 391, 0, 0, 280, 399, 403, 355, 449, 356,
+	375, 400, 350, 347, 241, 376, 271, 314, 253, 255,
+	267, 273, 275, 277, 278, 324, 325, 341, 362, 378,
+	379, 380, 270, 263, 357, 264, 288, 265, 242, 364,
+	266, 244, 342, 383, 0, 284, 351, 310, 245, 309,
+	343, 382, 381, 254, 410, 416, 417, 422, 0, 423,
+	0, 0, 0, 431, 436, 437, 438, 440, 441, 442,
+	443, 0, 0, 0, 0, 425, 0, 0, 235, 236,
+	0, 0, 0, 0, 415, 282, 237, 238, 451, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 414, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 340, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 393,
+	408, 426, 429, 0, 0, 0, 243, 428, 0, 0,
+	0, 0, 0, 0, 0, 396, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 424, 320, 321, 322, 323,
+	285, 0, 261, 427, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 420, 421, 281, 287, 439, 289, 260, 339, 283,
+	405, 296, 0, 432, 0, 433, 0, 0, 0, 0,
+	327, 292, 293, 366, 297, 303, 352, 404, 337, 359,
+	258, 392, 367, 307, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 301, 133, 348, 279,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 2666, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 0, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 377, 231, 232,
+	233, 234, 0, 0, 0, 329, 330, 331, 336, 0,
+	411, 412, 413, 435, 394, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 789, 790, 0, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 793,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 770, 398, 250, 769, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 791,
+	785, 786, 787, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 788, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 377, 231, 232, 233,
+	234, 0, 0, 0, 329, 330, 331, 336, 0, 411,
+	412, 413, 435, 394, 0, 447, 2306, 0, 0, 0,
+	0, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 0, 0, 0, 0, 0, 0, 256, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 0, 387, 418,
+	268, 409, 0, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 434, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 2309, 0, 0, 2308, 0, 316, 252, 239,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 419, 0,
+	358, 338, 0, 0, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 414,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 424, 320, 321, 322, 323, 285, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 301, 0, 348, 279, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1534, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2459, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 2331, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	0, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 377, 231, 232, 233, 234,
+	0, 0, 0, 329, 330, 331, 336, 0, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 1197, 0, 300, 0, 0, 0,
+	0, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	0, 0, 1195, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1193, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 0, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 419, 0, 358,
+	338, 0, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 424, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 0, 348, 279, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 377, 231, 232, 233, 234, 0,
+	0, 0, 329, 330, 331, 336, 0, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 1191, 0, 300, 0, 0, 0, 0,
+	0, 0, 368, 315, 0, 335, 370, 308, 365, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
+	0, 1195, 0, 0, 0, 256, 187, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1193, 0, 0, 0, 0, 0, 0, 246,
+	374, 390, 257, 363, 406, 262, 372, 251, 334, 360,
+	0, 0, 248, 388, 371, 312, 294, 295, 247, 0,
+	353, 272, 286, 269, 332, 0, 387, 418, 268, 409,
+	0, 398, 250, 0, 397, 328, 384, 389, 313, 306,
+	249, 386, 311, 305, 298, 276, 434, 401, 290, 344,
+	304, 345, 291, 318, 317, 319, 0, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 252, 239, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 299, 0, 0, 0, 419, 0, 358, 338,
+	0, 0, 0, 354, 302, 385, 346, 391, 0, 0,
+	280, 399, 403, 355, 449, 356, 375, 400, 350, 347,
+	241, 376, 271, 314, 253, 255, 267, 273, 275, 277,
+	278, 324, 325, 341, 362, 378, 379, 380, 270, 263,
+	357, 264, 288, 265, 242, 364, 266, 244, 342, 383,
+	0, 284, 351, 310, 245, 309, 343, 382, 381, 254,
+	410, 416, 417, 422, 0, 423, 0, 0, 0, 431,
+	436, 437, 438, 440, 441, 442, 443, 0, 0, 0,
+	0, 425, 0, 0, 235, 236, 0, 0, 0, 0,
+	415, 282, 237, 238, 451, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 414, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 448, 340,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 393, 408, 426, 429, 0,
+	0, 0, 243, 428, 0, 0, 0, 0, 0, 0,
+	0, 396, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 424, 320, 321, 322, 323, 285, 0, 261, 427,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 420, 421, 281,
+	287, 439, 289, 260, 339, 283, 405, 296, 0, 432,
+	0, 433, 0, 0, 0, 0, 327, 292, 293, 366,
+	297, 303, 352, 404, 337, 359, 258, 392, 367, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 301, 0, 348, 279, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 0, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 229, 377, 231, 232, 233, 234, 0, 0,
+	0, 329, 330, 331, 336, 0, 411, 412, 413, 435,
+	394, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 3150, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 3149, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 377, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 336, 0, 411, 412, 413, 435, 394,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3057, 0, 186, 619, 0, 0,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 0, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 377, 231, 232, 233, 234, 0, 0, 0, 329,
+	330, 331, 336, 0, 411, 412, 413, 435, 394, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 0, 300, 0, 0, 0, 0, 0, 0, 368,
+	315, 0, 335, 370, 308, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 0, 0, 1195, 0,
+	0, 0, 256, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2697,
+	0, 0, 0, 0, 0, 0, 246, 374, 390, 257,
+	363, 406, 262, 372, 251, 334, 360, 0, 0, 248,
+	388, 371, 312, 294, 295, 247, 0, 353, 272, 286,
+	269, 332, 0, 387, 418, 268, 409, 0, 398, 250,
+	0, 397, 328, 384, 389, 313, 306, 249, 386, 311,
+	305, 298, 276, 434, 401, 290, 344, 304, 345, 291,
+	318, 317, 319, 0, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 252, 239, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 373, 0, 0, 299,
+	0, 0, 0, 419, 0, 358, 338, 0, 0, 0,
+	354, 302, 385, 346, 391, 0, 0, 280, 399, 403,
+	355, 449, 356, 375, 400, 350, 347, 241, 376, 271,
+	314, 253, 255, 267, 273, 275, 277, 278, 324, 325,
+	341, 362, 378, 379, 380, 270, 263, 357, 264, 288,
+	265, 242, 364, 266, 244, 342, 383, 0, 284, 351,
+	310, 245, 309, 343, 382, 381, 254, 410, 416, 417,
+	422, 0, 423, 0, 0, 0, 431, 436, 437, 438,
+	440, 441, 442, 443, 0, 0, 0, 0, 425, 0,
+	0, 235, 236, 0, 0, 0, 0, 415, 282, 237,
+	238, 451, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 414, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 448, 340, 0, 361, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 369, 393, 408, 426, 429, 0, 0, 0, 243,
+	428, 0, 0, 0, 0, 0, 0, 0, 396, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 424, 320,
+	321, 322, 323, 285, 0, 261, 427, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 420, 421, 281, 287, 439, 289,
+	260, 339, 283, 405, 296, 0, 432, 0, 433, 0,
+	0, 0, 0, 327, 292, 293, 366, 297, 303, 352,
+	404, 337, 359, 258, 392, 367, 307, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 0, 301,
+	0, 348, 279, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 0, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	377, 231, 232, 233, 234, 0, 0, 0, 329, 330,
+	331, 336, 0, 411, 412, 413, 435, 394, 0, 447,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 368, 315,
+	0, 335, 370, 308, 365, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 186, 0, 0, 1195, 0, 0,
+	0, 256, 187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 259, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1193, 0,
+	0, 0, 0, 0, 0, 246, 374, 390, 257, 363,
+	406, 262, 372, 251, 334, 360, 0, 0, 248, 388,
+	371, 312, 294, 295, 247, 0, 353, 272, 286, 269,
+	332, 0, 387, 418, 268, 409, 0, 398, 250, 0,
+	397, 328, 384, 389, 313, 306, 249, 386, 311, 305,
+	298, 276, 434, 401, 290, 344, 304, 345, 291, 318,
+	317, 319, 0, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 316, 252, 239, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 373, 0, 0, 299, 0,
+	0, 0, 419, 0, 358, 338, 0, 0, 0, 354,
+	302, 385, 346, 391, 0, 0, 280, 399, 403, 355,
+	449, 356, 375, 400, 350, 347, 241, 376, 271, 314,
+	253, 255, 267, 273, 275, 277, 278, 324, 325, 341,
+	362, 378, 379, 380, 270, 263, 357, 264, 288, 265,
+	242, 364, 266, 244, 342, 383, 0, 284, 351, 310,
+	245, 309, 343, 382, 381, 254, 410, 416, 417, 422,
+	0, 423, 0, 0, 0, 431, 436, 437, 438, 440,
+	441, 442, 443, 0, 0, 0, 0, 425, 0, 0,
+	235, 236, 0, 0, 0, 0, 415, 282, 237, 238,
+	451, 0, 333, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 414, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 448, 340, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	369, 393, 408, 426, 429, 0, 0, 0, 243, 428,
+	0, 0, 0, 0, 0, 0, 0, 396, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 424, 320, 321,
+	322, 323, 285, 0, 261, 427, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 420, 421, 281, 287, 439, 289, 260,
+	339, 283, 405, 296, 0, 432, 0, 433, 0, 0,
+	0, 0, 327, 292, 293, 366, 297, 303, 352, 404,
+	337, 359, 258, 392, 367, 307, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 301, 0,
+	348, 279, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 0, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228, 229, 377,
+	231, 232, 233, 234, 0, 0, 0, 329, 330, 331,
+	336, 0, 411, 412, 413, 435, 394, 0, 447, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	300, 0, 0, 0, 0, 0, 0, 368, 315, 0,
+	335, 370, 308, 365, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1601, 0, 0, 186, 0, 0, 0, 0, 0, 0,
+	256, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 246, 374, 390, 257, 363, 406,
+	262, 372, 251, 334, 360, 0, 0, 248, 388, 371,
+	312, 294, 295, 247, 0, 353, 272, 286, 269, 332,
+	0, 387, 418, 268, 409, 0, 398, 250, 0, 397,
+	328, 384, 389, 313, 306, 249, 386, 311, 305, 298,
+	276, 434, 401, 290, 344, 304, 345, 291, 318, 317,
+	319, 0, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 252, 239, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 373, 0, 0, 299, 0, 0,
+	0, 419, 0, 358, 338, 0, 0, 0, 354, 302,
+	385, 346, 391, 0, 0, 280, 399, 403, 355, 449,
+	356, 375, 400, 350, 347, 241, 376, 271, 314, 253,
+	255, 267, 273, 275, 277, 278, 324, 325, 341, 362,
+	378, 379, 380, 270, 263, 357, 264, 288, 265, 242,
+	364, 266, 244, 342, 383, 0, 284, 351, 310, 245,
+	309, 343, 382, 381, 254, 410, 416, 417, 422, 0,
+	423, 0, 0, 0, 431, 436, 437, 438, 440, 441,
+	442, 443, 0, 0, 0, 0, 425, 0, 0, 235,
+	236, 0, 0, 0, 0, 415, 282, 237, 238, 451,
+	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 414, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 448, 340, 0, 361, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 369,
+	393, 408, 426, 429, 0, 0, 0, 243, 428, 0,
+	0, 0, 0, 0, 0, 0, 396, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 424, 320, 321, 322,
+	323, 285, 0, 261, 427, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 420, 421, 281, 287, 439, 289, 260, 339,
+	283, 405, 296, 0, 432, 0, 433, 0, 0, 0,
+	0, 327, 292, 293, 366, 297, 303, 352, 404, 337,
+	359, 258, 392, 367, 307, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 301, 0, 348,
+	279, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 0, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 377, 231,
+	232, 233, 234, 0, 0, 1605, 329, 330, 331, 336,
+	0, 411, 412, 413, 435, 394, 0, 447, 0, 0,
+	0, 1999, 0, 0, 0, 0, 274, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 368, 315, 0, 335,
+	370, 308, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 186, 0, 0, 2001, 0, 0, 0, 256,
+	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 374, 390, 257, 363, 406, 262,
+	372, 251, 334, 360, 0, 0, 248, 388, 371, 312,
+	294, 295, 247, 0, 353, 272, 286, 269, 332, 0,
+	387, 418, 268, 409, 0, 398, 250, 0, 397, 328,
+	384, 389, 313, 306, 249, 386, 311, 305, 298, 276,
+	434, 401, 290, 344, 304, 345, 291, 318, 317, 319,
+	0, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	252, 239, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 373, 0, 0, 299, 0, 0, 0,
+	419, 0, 358, 338, 0, 0, 0, 354, 302, 385,
+	346, 391, 0, 0, 280, 399, 403, 355, 449, 356,
+	375, 400, 350, 347, 241, 376, 271, 314, 253, 255,
+	267, 273, 275, 277, 278, 324, 325, 341, 362, 378,
+	379, 380, 270, 263, 357, 264, 288, 265, 242, 364,
+	266, 244, 342, 383, 0, 284, 351, 310, 245, 309,
+	343, 382, 381, 254, 410, 416, 417, 422, 0, 423,
+	0, 0, 0, 431, 436, 437, 438, 440, 441, 442,
+	443, 0, 0, 0, 0, 425, 0, 0, 235, 236,
+	0, 0, 0, 0, 415, 282, 237, 238, 451, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 414, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 340, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 393,
+	408, 426, 429, 0, 0, 0, 243, 428, 0, 0,
+	0, 0, 0, 0, 0, 396, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 424, 320, 321, 322, 323,
+	285, 0, 261, 427, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 420, 421, 281, 287, 439, 289, 260, 339, 283,
+	405, 296, 0, 432, 0, 433, 0, 0, 0, 0,
+	327, 292, 293, 366, 297, 303, 352, 404, 337, 359,
+	258, 392, 367, 307, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 301, 0, 348, 279,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2262, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 1147,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 0, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 377, 231, 232,
+	233, 234, 0, 0, 0, 329, 330, 331, 336, 0,
+	411, 412, 413, 435, 394, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 2017, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 1195, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 0, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 377, 231, 232, 233,
+	234, 0, 0, 0, 329, 330, 331, 336, 0, 411,
+	412, 413, 435, 394, 0, 447, 0, 0, 0, 0,
+	0, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3163, 0,
+	186, 0, 0, 0, 0, 0, 0, 256, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 0, 387, 418,
+	268, 409, 0, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 434, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 419, 0,
+	358, 338, 0, 0, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 414,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 424, 320, 321, 322, 323, 285, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 301, 0, 348, 279, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 1909, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1643, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1938, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 1936, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 0, 0, 0,
-	384, 385, 386, 408, 370, 353, 420, 0, 0, 1807,
-	0, 0, 0, 0, 0, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 330, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 0, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 1522, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	0, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 377, 231, 232, 233, 234,
+	0, 0, 0, 329, 330, 331, 336, 0, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	0, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	619, 0, 0, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 0, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 419, 0, 358,
+	338, 0, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 424, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 0, 348, 279, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 377, 231, 232, 233, 234, 0,
+	0, 0, 329, 330, 331, 336, 0, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 368, 315, 0, 335, 370, 308, 365, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3074, 0, 0, 186, 0,
+	0, 0, 0, 0, 0, 256, 187, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	374, 390, 257, 363, 406, 262, 372, 251, 334, 360,
+	0, 0, 248, 388, 371, 312, 294, 295, 247, 0,
+	353, 272, 286, 269, 332, 0, 387, 418, 268, 409,
+	0, 398, 250, 0, 397, 328, 384, 389, 313, 306,
+	249, 386, 311, 305, 298, 276, 434, 401, 290, 344,
+	304, 345, 291, 318, 317, 319, 0, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 252, 239, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 299, 0, 0, 0, 419, 0, 358, 338,
+	0, 0, 0, 354, 302, 385, 346, 391, 0, 0,
+	280, 399, 403, 355, 449, 356, 375, 400, 350, 347,
+	241, 376, 271, 314, 253, 255, 267, 273, 275, 277,
+	278, 324, 325, 341, 362, 378, 379, 380, 270, 263,
+	357, 264, 288, 265, 242, 364, 266, 244, 342, 383,
+	0, 284, 351, 310, 245, 309, 343, 382, 381, 254,
+	410, 416, 417, 422, 0, 423, 0, 0, 0, 431,
+	436, 437, 438, 440, 441, 442, 443, 0, 0, 0,
+	0, 425, 0, 0, 235, 236, 0, 0, 0, 0,
+	415, 282, 237, 238, 451, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 414, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 448, 340,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 393, 408, 426, 429, 0,
+	0, 0, 243, 428, 0, 0, 0, 0, 0, 0,
+	0, 396, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 424, 320, 321, 322, 323, 285, 0, 261, 427,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 420, 421, 281,
+	287, 439, 289, 260, 339, 283, 405, 296, 0, 432,
+	0, 433, 0, 0, 0, 0, 327, 292, 293, 366,
+	297, 303, 352, 404, 337, 359, 258, 392, 367, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 301, 0, 348, 279, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 0, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 229, 377, 231, 232, 233, 234, 0, 0,
+	0, 329, 330, 331, 336, 0, 411, 412, 413, 435,
+	394, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 3046, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 377, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 336, 0, 411, 412, 413, 435, 394,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2792, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 0, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 377, 231, 232, 233, 234, 0, 0, 0, 329,
+	330, 331, 336, 0, 411, 412, 413, 435, 394, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 0, 300, 0, 0, 0, 0, 0, 0, 368,
+	315, 0, 335, 370, 308, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 0, 0, 0, 0,
+	0, 0, 256, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 374, 390, 257,
+	363, 406, 262, 372, 251, 334, 360, 0, 0, 248,
+	388, 371, 312, 294, 295, 247, 0, 353, 272, 286,
+	269, 332, 0, 387, 418, 268, 409, 0, 398, 250,
+	0, 397, 328, 384, 389, 313, 306, 249, 386, 311,
+	305, 298, 276, 434, 401, 290, 344, 304, 345, 291,
+	318, 317, 319, 0, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 252, 239, 0, 0, 0, 0, 402,
+	0, 0, 0, 2924, 0, 0, 373, 0, 0, 299,
+	0, 0, 0, 419, 0, 358, 338, 0, 0, 0,
+	354, 302, 385, 346, 391, 0, 0, 280, 399, 403,
+	355, 449, 356, 375, 400, 350, 347, 241, 376, 271,
+	314, 253, 255, 267, 273, 275, 277, 278, 324, 325,
+	341, 362, 378, 379, 380, 270, 263, 357, 264, 288,
+	265, 242, 364, 266, 244, 342, 383, 0, 284, 351,
+	310, 245, 309, 343, 382, 381, 254, 410, 416, 417,
+	422, 0, 423, 0, 0, 0, 431, 436, 437, 438,
+	440, 441, 442, 443, 0, 0, 0, 0, 425, 0,
+	0, 235, 236, 0, 0, 0, 0, 415, 282, 237,
+	238, 451, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 414, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 448, 340, 0, 361, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 369, 393, 408, 426, 429, 0, 0, 0, 243,
+	428, 0, 0, 0, 0, 0, 0, 0, 396, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 424, 320,
+	321, 322, 323, 285, 0, 261, 427, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 420, 421, 281, 287, 439, 289,
+	260, 339, 283, 405, 296, 0, 432, 0, 433, 0,
+	0, 0, 0, 327, 292, 293, 366, 297, 303, 352,
+	404, 337, 359, 258, 392, 367, 307, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 0, 301,
+	0, 348, 279, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 0, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	377, 231, 232, 233, 234, 0, 0, 0, 329, 330,
+	331, 336, 0, 411, 412, 413, 435, 394, 0, 447,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 368, 315,
+	0, 335, 370, 308, 365, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 186, 0, 0, 0, 0, 0,
+	0, 256, 187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 259, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2502,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 246, 374, 390, 257, 363,
+	406, 262, 372, 251, 334, 360, 0, 0, 248, 388,
+	371, 312, 294, 295, 247, 0, 353, 272, 286, 269,
+	332, 0, 387, 418, 268, 409, 0, 398, 250, 0,
+	397, 328, 384, 389, 313, 306, 249, 386, 311, 305,
+	298, 276, 434, 401, 290, 344, 304, 345, 291, 318,
+	317, 319, 0, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 316, 252, 239, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 373, 0, 0, 299, 0,
+	0, 0, 419, 0, 358, 338, 0, 0, 0, 354,
+	302, 385, 346, 391, 0, 0, 280, 399, 403, 355,
+	449, 356, 375, 400, 350, 347, 241, 376, 271, 314,
+	253, 255, 267, 273, 275, 277, 278, 324, 325, 341,
+	362, 378, 379, 380, 270, 263, 357, 264, 288, 265,
+	242, 364, 266, 244, 342, 383, 0, 284, 351, 310,
+	245, 309, 343, 382, 381, 254, 410, 416, 417, 422,
+	0, 423, 0, 0, 0, 431, 436, 437, 438, 440,
+	441, 442, 443, 0, 0, 0, 0, 425, 0, 0,
+	235, 236, 0, 0, 0, 0, 415, 282, 237, 238,
+	451, 0, 333, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 414, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 448, 340, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	369, 393, 408, 426, 429, 0, 0, 0, 243, 428,
+	0, 0, 0, 0, 0, 0, 0, 396, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 424, 320, 321,
+	322, 323, 285, 0, 261, 427, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 420, 421, 281, 287, 439, 289, 260,
+	339, 283, 405, 296, 0, 432, 0, 433, 0, 0,
+	0, 0, 327, 292, 293, 366, 297, 303, 352, 404,
+	337, 359, 258, 392, 367, 307, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 301, 0,
+	348, 279, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 0, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228, 229, 377,
+	231, 232, 233, 234, 0, 0, 0, 329, 330, 331,
+	336, 0, 411, 412, 413, 435, 394, 0, 447, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	300, 0, 0, 0, 0, 0, 0, 368, 315, 0,
+	335, 370, 308, 365, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 0, 0, 0, 0, 0, 0,
+	256, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2610, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 246, 374, 390, 257, 363, 406,
+	262, 372, 251, 334, 360, 0, 0, 248, 388, 371,
+	312, 294, 295, 247, 0, 353, 272, 286, 269, 332,
+	0, 387, 418, 268, 409, 0, 398, 250, 0, 397,
+	328, 384, 389, 313, 306, 249, 386, 311, 305, 298,
+	276, 434, 401, 290, 344, 304, 345, 291, 318, 317,
+	319, 0, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 252, 239, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 373, 0, 0, 299, 0, 0,
+	0, 419, 0, 358, 338, 0, 0, 0, 354, 302,
+	385, 346, 391, 0, 0, 280, 399, 403, 355, 449,
+	356, 375, 400, 350, 347, 241, 376, 271, 314, 253,
+	255, 267, 273, 275, 277, 278, 324, 325, 341, 362,
+	378, 379, 380, 270, 263, 357, 264, 288, 265, 242,
+	364, 266, 244, 342, 383, 0, 284, 351, 310, 245,
+	309, 343, 382, 381, 254, 410, 416, 417, 422, 0,
+	423, 0, 0, 0, 431, 436, 437, 438, 440, 441,
+	442, 443, 0, 0, 0, 0, 425, 0, 0, 235,
+	236, 0, 0, 0, 0, 415, 282, 237, 238, 451,
+	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 414, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 448, 340, 0, 361, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 369,
+	393, 408, 426, 429, 0, 0, 0, 243, 428, 0,
+	0, 0, 0, 0, 0, 0, 396, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 424, 320, 321, 322,
+	323, 285, 0, 261, 427, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 420, 421, 281, 287, 439, 289, 260, 339,
+	283, 405, 296, 0, 432, 0, 433, 0, 0, 0,
+	0, 327, 292, 293, 366, 297, 303, 352, 404, 337,
+	359, 258, 392, 367, 307, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 301, 0, 348,
+	279, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 0, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 377, 231,
+	232, 233, 234, 0, 0, 0, 329, 330, 331, 336,
+	0, 411, 412, 413, 435, 394, 0, 447, 0, 0,
+	0, 0, 0, 0, 0, 0, 274, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 368, 315, 0, 335,
+	370, 308, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 186, 0, 0, 2460, 0, 0, 0, 256,
+	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 374, 390, 257, 363, 406, 262,
+	372, 251, 334, 360, 0, 0, 248, 388, 371, 312,
+	294, 295, 247, 0, 353, 272, 286, 269, 332, 0,
+	387, 418, 268, 409, 0, 398, 250, 0, 397, 328,
+	384, 389, 313, 306, 249, 386, 311, 305, 298, 276,
+	434, 401, 290, 344, 304, 345, 291, 318, 317, 319,
+	0, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	252, 239, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 373, 0, 0, 299, 0, 0, 0,
+	419, 0, 358, 338, 0, 0, 0, 354, 302, 385,
+	346, 391, 0, 0, 280, 399, 403, 355, 449, 356,
+	375, 400, 350, 347, 241, 376, 271, 314, 253, 255,
+	267, 273, 275, 277, 278, 324, 325, 341, 362, 378,
+	379, 380, 270, 263, 357, 264, 288, 265, 242, 364,
+	266, 244, 342, 383, 0, 284, 351, 310, 245, 309,
+	343, 382, 381, 254, 410, 416, 417, 422, 0, 423,
+	0, 0, 0, 431, 436, 437, 438, 440, 441, 442,
+	443, 0, 0, 0, 0, 425, 0, 0, 235, 236,
+	0, 0, 0, 0, 415, 282, 237, 238, 451, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 414, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 340, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 393,
+	408, 426, 429, 0, 0, 0, 243, 428, 0, 0,
+	0, 0, 0, 0, 0, 396, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 424, 320, 321, 322, 323,
+	285, 0, 261, 427, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 420, 421, 281, 287, 439, 289, 260, 339, 283,
+	405, 296, 0, 432, 0, 433, 0, 0, 0, 0,
+	327, 292, 293, 366, 297, 303, 352, 404, 337, 359,
+	258, 392, 367, 307, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 301, 0, 348, 279,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 1147, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 1459,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 1170, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 0, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 377, 231, 232,
+	233, 234, 0, 0, 0, 329, 330, 331, 336, 0,
+	411, 412, 413, 435, 394, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2387, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 0, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 377, 231, 232, 233,
+	234, 0, 0, 0, 329, 330, 331, 336, 0, 411,
+	412, 413, 435, 394, 0, 447, 0, 0, 0, 0,
+	0, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 0, 0, 1195, 0, 0, 0, 256, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 0, 387, 418,
+	268, 409, 0, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 434, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 419, 0,
+	358, 338, 0, 0, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 414,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 424, 320, 321, 322, 323, 285, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 301, 0, 348, 279, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 254, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 0, 406, 0,
-	0, 0, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 690, 0, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 353, 0, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 286, 0, 0, 0,
-	0, 0, 0, 345, 300, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 350, 366, 244, 341, 379,
-	249, 348, 239, 315, 338, 0, 0, 236, 364, 347,
-	297, 280, 281, 235, 0, 333, 259, 272, 256, 313,
-	0, 363, 391, 255, 382, 0, 374, 238, 0, 373,
-	312, 360, 365, 298, 292, 237, 362, 296, 291, 284,
-	263, 407, 276, 324, 290, 325, 277, 302, 301, 303,
-	0, 0, 0, 0, 0, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 285, 0, 0, 0, 392, 0, 336,
-	318, 0, 0, 0, 334, 288, 361, 326, 367, 351,
-	375, 459, 327, 229, 352, 258, 299, 240, 242, 254,
-	260, 262, 264, 265, 308, 309, 321, 340, 354, 355,
-	356, 257, 250, 335, 251, 274, 252, 230, 342, 253,
-	232, 322, 359, 0, 270, 331, 295, 233, 294, 323,
-	358, 357, 241, 383, 389, 390, 395, 0, 396, 0,
-	0, 0, 404, 409, 410, 411, 413, 414, 415, 416,
-	0, 0, 0, 0, 398, 0, 0, 0, 0, 0,
-	0, 388, 268, 226, 227, 423, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 387, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 421,
-	320, 0, 339, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 346, 369, 381, 399, 402,
-	0, 0, 0, 231, 401, 0, 0, 0, 0, 0,
-	0, 460, 372, 0, 0, 0, 380, 0, 0, 0,
-	0, 0, 397, 304, 305, 306, 307, 271, 0, 248,
-	400, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 394,
-	267, 273, 412, 275, 247, 319, 269, 378, 282, 0,
-	405, 0, 406, 0, 0, 0, 0, 311, 278, 279,
-	343, 283, 289, 332, 377, 317, 337, 245, 368, 344,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 287, 0, 328, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 353,
-	0, 0, 384, 385, 386, 408, 370, 0, 420, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 345, 300, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 350,
-	366, 244, 341, 379, 249, 348, 239, 315, 338, 0,
-	0, 236, 364, 347, 297, 280, 281, 235, 0, 333,
-	259, 272, 256, 313, 0, 363, 391, 255, 382, 0,
-	374, 238, 0, 373, 312, 360, 365, 298, 292, 237,
-	362, 296, 291, 284, 263, 407, 276, 324, 290, 325,
-	277, 302, 301, 303, 0, 0, 0, 0, 0, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 438, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 285, 0, 0,
-	0, 392, 0, 336, 318, 0, 0, 0, 334, 288,
-	361, 326, 367, 351, 375, 330, 327, 229, 352, 258,
-	299, 240, 242, 254, 260, 262, 264, 265, 308, 309,
-	321, 340, 354, 355, 356, 257, 250, 335, 251, 274,
-	252, 230, 342, 253, 232, 322, 359, 0, 270, 331,
-	295, 233, 294, 323, 358, 357, 241, 383, 389, 390,
-	395, 0, 396, 0, 0, 0, 404, 409, 410, 411,
-	413, 414, 415, 416, 0, 0, 0, 0, 398, 0,
-	0, 0, 0, 0, 0, 388, 268, 226, 227, 423,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 387, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 421, 320, 0, 339, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
-	369, 381, 399, 402, 0, 0, 0, 231, 401, 0,
-	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
-	380, 0, 0, 0, 0, 0, 397, 304, 305, 306,
-	307, 271, 0, 248, 400, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 394, 267, 273, 412, 275, 247, 319,
-	269, 378, 282, 0, 405, 0, 406, 0, 0, 0,
-	0, 311, 278, 279, 343, 283, 289, 332, 377, 317,
-	337, 245, 368, 344, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 287, 0, 328,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	0, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 377, 231, 232, 233, 234,
+	0, 0, 0, 329, 330, 331, 336, 0, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	0, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	0, 0, 2001, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 0, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 419, 0, 358,
+	338, 0, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 424, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 0, 348, 279, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 377, 231, 232, 233, 234, 0,
+	0, 0, 329, 330, 331, 336, 0, 411, 412, 413,
+	435, 394, 0, 447, 2254, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 368, 315, 0, 335, 370, 308, 365, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
+	0, 0, 0, 0, 0, 256, 187, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	374, 390, 257, 363, 406, 262, 372, 251, 334, 360,
+	0, 0, 248, 388, 371, 312, 294, 295, 247, 0,
+	353, 272, 286, 269, 332, 0, 387, 418, 268, 409,
+	0, 398, 250, 0, 397, 328, 384, 389, 313, 306,
+	249, 386, 311, 305, 298, 276, 434, 401, 290, 344,
+	304, 345, 291, 318, 317, 319, 0, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 252, 239, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 299, 0, 0, 0, 419, 0, 358, 338,
+	0, 0, 0, 354, 302, 385, 346, 391, 0, 0,
+	280, 399, 403, 355, 449, 356, 375, 400, 350, 347,
+	241, 376, 271, 314, 253, 255, 267, 273, 275, 277,
+	278, 324, 325, 341, 362, 378, 379, 380, 270, 263,
+	357, 264, 288, 265, 242, 364, 266, 244, 342, 383,
+	0, 284, 351, 310, 245, 309, 343, 382, 381, 254,
+	410, 416, 417, 422, 0, 423, 0, 0, 0, 431,
+	436, 437, 438, 440, 441, 442, 443, 0, 0, 0,
+	0, 425, 0, 0, 235, 236, 0, 0, 0, 0,
+	415, 282, 237, 238, 451, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 414, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 448, 340,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 393, 408, 426, 429, 0,
+	0, 0, 243, 428, 0, 0, 0, 0, 0, 0,
+	0, 396, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 424, 320, 321, 322, 323, 285, 0, 261, 427,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 420, 421, 281,
+	287, 439, 289, 260, 339, 283, 405, 296, 0, 432,
+	0, 433, 0, 0, 0, 0, 327, 292, 293, 366,
+	297, 303, 352, 404, 337, 359, 258, 392, 367, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 301, 0, 348, 279, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 0, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 229, 377, 231, 232, 233, 234, 0, 0,
+	0, 329, 330, 331, 336, 0, 411, 412, 413, 435,
+	394, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1715, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 377, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 336, 0, 411, 412, 413, 435, 394,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2037, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 350, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 0, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 377, 231, 232, 233, 234, 0, 0, 0, 329,
+	330, 331, 336, 0, 411, 412, 413, 435, 394, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 0, 300, 0, 0, 0, 0, 0, 0, 368,
+	315, 0, 335, 370, 308, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 0, 0, 2035, 0,
+	0, 0, 256, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 374, 390, 257,
+	363, 406, 262, 372, 251, 334, 360, 0, 0, 248,
+	388, 371, 312, 294, 295, 247, 0, 353, 272, 286,
+	269, 332, 0, 387, 418, 268, 409, 0, 398, 250,
+	0, 397, 328, 384, 389, 313, 306, 249, 386, 311,
+	305, 298, 276, 434, 401, 290, 344, 304, 345, 291,
+	318, 317, 319, 0, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 252, 239, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 373, 0, 0, 299,
+	0, 0, 0, 419, 0, 358, 338, 0, 0, 0,
+	354, 302, 385, 346, 391, 0, 0, 280, 399, 403,
+	355, 449, 356, 375, 400, 350, 347, 241, 376, 271,
+	314, 253, 255, 267, 273, 275, 277, 278, 324, 325,
+	341, 362, 378, 379, 380, 270, 263, 357, 264, 288,
+	265, 242, 364, 266, 244, 342, 383, 0, 284, 351,
+	310, 245, 309, 343, 382, 381, 254, 410, 416, 417,
+	422, 0, 423, 0, 0, 0, 431, 436, 437, 438,
+	440, 441, 442, 443, 0, 0, 0, 0, 425, 0,
+	0, 235, 236, 0, 0, 0, 0, 415, 282, 237,
+	238, 451, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 414, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 448, 340, 0, 361, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 369, 393, 408, 426, 429, 0, 0, 0, 243,
+	428, 0, 0, 0, 0, 0, 0, 0, 396, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 424, 320,
+	321, 322, 323, 285, 0, 261, 427, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 420, 421, 281, 287, 439, 289,
+	260, 339, 283, 405, 296, 0, 432, 0, 433, 0,
+	0, 0, 0, 327, 292, 293, 366, 297, 303, 352,
+	404, 337, 359, 258, 392, 367, 307, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 0, 301,
+	0, 348, 279, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 0, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	0, 231, 232, 233, 234, 0, 0, 377, 329, 330,
+	331, 1889, 0, 411, 412, 413, 435, 394, 336, 447,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 0, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 0, 231, 232, 233,
+	234, 0, 0, 377, 329, 330, 331, 0, 0, 411,
+	412, 413, 435, 394, 336, 447, 0, 0, 0, 0,
+	0, 0, 1589, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 267, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 377, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 336, 0, 411, 412, 413, 435, 394,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	368, 315, 0, 335, 370, 308, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 186, 0, 0, 1195,
+	0, 0, 0, 256, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 374, 390,
+	257, 363, 406, 262, 372, 251, 334, 360, 0, 0,
+	248, 388, 371, 312, 294, 295, 247, 0, 353, 272,
+	286, 269, 332, 0, 387, 418, 268, 409, 0, 398,
+	250, 0, 397, 328, 384, 389, 313, 306, 249, 386,
+	311, 305, 298, 276, 434, 401, 290, 344, 304, 345,
+	291, 318, 317, 319, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 252, 239, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	299, 0, 0, 0, 419, 0, 358, 338, 0, 0,
+	0, 354, 302, 385, 346, 391, 0, 0, 280, 399,
+	403, 355, 449, 356, 375, 400, 1519, 347, 241, 376,
+	271, 314, 253, 255, 267, 273, 275, 277, 278, 324,
+	325, 341, 362, 378, 379, 380, 270, 263, 357, 264,
+	288, 265, 242, 364, 266, 244, 342, 383, 0, 284,
+	351, 310, 245, 309, 343, 382, 381, 254, 410, 416,
+	417, 422, 0, 423, 0, 0, 0, 431, 436, 437,
+	438, 440, 441, 442, 443, 0, 0, 0, 0, 425,
+	0, 0, 235, 236, 0, 0, 0, 0, 415, 282,
+	237, 238, 451, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 414, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 448, 340, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 393, 408, 426, 429, 0, 0, 0,
+	243, 428, 0, 0, 0, 0, 0, 0, 0, 396,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 424,
+	320, 321, 322, 323, 285, 0, 261, 427, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 420, 421, 281, 287, 439,
+	289, 260, 339, 283, 405, 296, 0, 432, 0, 433,
+	0, 0, 0, 0, 327, 292, 293, 366, 297, 303,
+	352, 404, 337, 359, 258, 392, 367, 307, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	301, 0, 348, 279, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 0, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 377, 231, 232, 233, 234, 0, 0, 0, 329,
+	330, 331, 336, 0, 411, 412, 413, 435, 394, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 0, 300, 0, 0, 0, 0, 0, 0, 368,
+	315, 0, 335, 370, 308, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 0, 0, 0, 0,
+	0, 0, 256, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 374, 390, 257,
+	363, 406, 262, 372, 251, 334, 360, 0, 0, 248,
+	388, 371, 312, 294, 295, 247, 0, 353, 272, 286,
+	269, 332, 0, 387, 418, 268, 409, 0, 398, 250,
+	0, 397, 328, 384, 389, 313, 306, 249, 386, 311,
+	305, 298, 276, 434, 401, 290, 344, 304, 345, 291,
+	318, 317, 319, 0, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 252, 239, 0, 0, 0, 0, 402,
+	0, 0, 1219, 0, 0, 0, 373, 0, 0, 299,
+	0, 0, 0, 419, 0, 358, 338, 0, 0, 0,
+	354, 302, 385, 346, 391, 0, 0, 280, 399, 403,
+	355, 449, 356, 375, 400, 350, 347, 241, 376, 271,
+	314, 253, 255, 267, 273, 275, 277, 278, 324, 325,
+	341, 362, 378, 379, 380, 270, 263, 357, 264, 288,
+	265, 242, 364, 266, 244, 342, 383, 0, 284, 351,
+	310, 245, 309, 343, 382, 381, 254, 410, 416, 417,
+	422, 0, 423, 0, 0, 0, 431, 436, 437, 438,
+	440, 441, 442, 443, 0, 0, 0, 0, 425, 0,
+	0, 235, 236, 0, 0, 0, 0, 415, 282, 237,
+	238, 451, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 414, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 448, 340, 0, 361, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 369, 393, 408, 426, 429, 0, 0, 0, 243,
+	428, 0, 0, 0, 0, 0, 0, 0, 396, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 424, 320,
+	321, 322, 323, 285, 0, 261, 427, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 420, 421, 281, 287, 439, 289,
+	260, 339, 283, 405, 296, 0, 432, 0, 433, 0,
+	0, 0, 0, 327, 292, 293, 366, 297, 303, 352,
+	404, 337, 359, 258, 392, 367, 307, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 0, 301,
+	0, 348, 279, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 0, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	377, 231, 232, 233, 234, 0, 0, 0, 329, 330,
+	331, 336, 0, 411, 412, 413, 435, 394, 0, 447,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 368, 315,
+	0, 335, 370, 308, 365, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 186, 0, 0, 0, 0, 0,
+	0, 256, 187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 259, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 246, 374, 390, 257, 363,
+	406, 262, 372, 251, 334, 360, 0, 0, 248, 388,
+	371, 312, 294, 295, 247, 0, 353, 272, 286, 269,
+	332, 0, 387, 418, 268, 409, 0, 398, 250, 0,
+	397, 328, 384, 389, 313, 306, 249, 386, 311, 305,
+	298, 276, 434, 401, 290, 344, 304, 345, 291, 318,
+	317, 319, 0, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 316, 252, 239, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 373, 0, 0, 299, 0,
+	0, 0, 419, 0, 358, 338, 0, 0, 0, 354,
+	302, 385, 346, 391, 0, 0, 280, 399, 403, 355,
+	449, 356, 375, 400, 350, 347, 241, 376, 271, 314,
+	253, 255, 267, 273, 275, 277, 278, 324, 325, 341,
+	362, 378, 379, 380, 270, 263, 357, 264, 288, 265,
+	242, 364, 266, 244, 342, 383, 0, 284, 351, 310,
+	245, 309, 343, 382, 381, 254, 410, 416, 417, 422,
+	0, 423, 0, 0, 0, 431, 436, 437, 438, 440,
+	441, 442, 443, 0, 0, 0, 0, 425, 0, 0,
+	235, 236, 0, 0, 0, 0, 415, 282, 237, 238,
+	451, 0, 333, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 414, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 448, 340, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	369, 393, 408, 426, 429, 0, 0, 0, 243, 428,
+	0, 0, 0, 0, 0, 0, 0, 396, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 424, 320, 321,
+	322, 323, 285, 0, 261, 427, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 420, 421, 281, 287, 439, 289, 260,
+	339, 283, 405, 296, 0, 432, 0, 433, 0, 0,
+	0, 0, 327, 292, 293, 366, 297, 303, 352, 404,
+	337, 359, 258, 392, 367, 307, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 726, 0, 0, 0, 240, 0, 301, 0,
+	348, 279, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 0, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228, 229, 377,
+	231, 232, 233, 234, 0, 0, 0, 329, 330, 331,
+	336, 0, 411, 412, 413, 435, 394, 0, 447, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	300, 0, 0, 0, 0, 0, 0, 368, 315, 0,
+	335, 370, 308, 365, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 0, 0, 0, 0, 0, 0,
+	256, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 246, 374, 390, 257, 363, 406,
+	262, 372, 251, 334, 360, 0, 0, 248, 388, 371,
+	312, 294, 295, 247, 0, 353, 272, 286, 269, 332,
+	0, 387, 418, 268, 409, 0, 398, 250, 0, 397,
+	328, 384, 389, 313, 306, 249, 386, 311, 305, 298,
+	276, 434, 401, 290, 344, 304, 345, 291, 318, 317,
+	319, 0, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 252, 239, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 373, 0, 0, 299, 0, 0,
+	0, 419, 0, 358, 338, 0, 0, 0, 354, 302,
+	385, 346, 391, 0, 0, 280, 399, 403, 355, 449,
+	356, 375, 400, 487, 347, 241, 376, 271, 314, 253,
+	255, 267, 273, 275, 277, 278, 324, 325, 341, 362,
+	378, 379, 380, 270, 263, 357, 264, 288, 265, 242,
+	364, 266, 244, 342, 383, 0, 284, 351, 310, 245,
+	309, 343, 382, 381, 254, 410, 416, 417, 422, 0,
+	423, 0, 0, 0, 431, 436, 437, 438, 440, 441,
+	442, 443, 0, 0, 0, 0, 425, 0, 0, 235,
+	236, 0, 0, 0, 0, 415, 282, 237, 238, 451,
+	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 414, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 448, 340, 0, 361, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 369,
+	393, 408, 426, 429, 0, 0, 0, 243, 428, 0,
+	0, 0, 0, 0, 0, 488, 396, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 424, 320, 321, 322,
+	323, 285, 0, 261, 427, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 420, 421, 281, 287, 439, 289, 260, 339,
+	283, 405, 296, 0, 432, 0, 433, 0, 0, 0,
+	0, 327, 292, 293, 366, 297, 303, 352, 404, 337,
+	359, 258, 392, 367, 307, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 301, 0, 348,
+	279, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 0, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 377, 231,
+	232, 233, 234, 0, 0, 0, 329, 330, 331, 336,
+	0, 411, 412, 413, 435, 394, 0, 447, 0, 0,
+	0, 0, 0, 0, 0, 0, 274, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 368, 315, 0, 335,
+	370, 308, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 186, 0, 0, 0, 0, 0, 0, 256,
+	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 374, 390, 257, 363, 406, 262,
+	372, 251, 334, 360, 0, 0, 248, 388, 371, 312,
+	294, 295, 247, 0, 353, 272, 286, 269, 332, 0,
+	387, 418, 268, 409, 0, 398, 250, 0, 397, 328,
+	384, 389, 313, 306, 249, 386, 311, 305, 298, 276,
+	434, 401, 290, 344, 304, 345, 291, 318, 317, 319,
+	0, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	252, 239, 0, 466, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 373, 0, 0, 299, 0, 0, 0,
+	419, 0, 358, 338, 0, 0, 0, 354, 302, 385,
+	346, 391, 0, 0, 280, 399, 403, 355, 449, 356,
+	375, 400, 350, 347, 241, 376, 271, 314, 253, 255,
+	267, 273, 275, 277, 278, 324, 325, 341, 362, 378,
+	379, 380, 270, 263, 357, 264, 288, 265, 242, 364,
+	266, 244, 342, 383, 0, 284, 351, 310, 245, 309,
+	343, 382, 381, 254, 410, 416, 417, 422, 0, 423,
+	0, 0, 0, 431, 436, 437, 438, 440, 441, 442,
+	443, 0, 0, 0, 0, 425, 0, 0, 235, 236,
+	0, 0, 0, 0, 415, 282, 237, 238, 451, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 414, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 340, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 393,
+	408, 426, 429, 0, 0, 0, 243, 428, 0, 0,
+	0, 0, 0, 0, 0, 396, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 424, 320, 321, 322, 323,
+	285, 0, 261, 427, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 420, 421, 281, 287, 439, 289, 260, 339, 283,
+	405, 296, 0, 432, 0, 433, 0, 0, 0, 0,
+	327, 292, 293, 366, 297, 303, 352, 404, 337, 359,
+	258, 392, 367, 307, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 301, 0, 348, 279,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 353, 0, 0, 384, 385, 386, 408,
-	370, 0, 420, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	428, 261, 0, 0, 286, 0, 0, 0, 0, 0,
-	0, 345, 300, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 350, 366, 244, 341, 379, 249, 348,
-	239, 315, 338, 0, 0, 236, 364, 347, 297, 280,
-	281, 235, 0, 333, 259, 272, 256, 313, 0, 363,
-	391, 255, 382, 0, 374, 238, 0, 373, 312, 360,
-	365, 298, 292, 237, 362, 296, 291, 284, 263, 407,
-	276, 324, 290, 325, 277, 302, 301, 303, 0, 0,
-	0, 0, 0, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 285, 0, 0, 0, 392, 0, 336, 318, 0,
-	0, 0, 334, 288, 361, 326, 367, 351, 375, 330,
-	327, 229, 352, 258, 299, 240, 242, 254, 260, 262,
-	264, 265, 308, 309, 321, 340, 354, 355, 356, 257,
-	250, 335, 251, 274, 252, 230, 342, 253, 232, 322,
-	359, 0, 270, 331, 295, 233, 294, 323, 358, 357,
-	241, 383, 389, 390, 395, 0, 396, 0, 0, 0,
-	404, 409, 410, 411, 413, 414, 415, 416, 0, 0,
-	0, 0, 398, 0, 0, 0, 0, 0, 0, 388,
-	268, 226, 227, 423, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 310, 387, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 421, 320, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 346, 369, 381, 399, 402, 0, 0,
-	0, 231, 401, 0, 0, 0, 0, 0, 0, 0,
-	372, 0, 0, 0, 380, 0, 0, 0, 0, 0,
-	397, 304, 305, 306, 307, 271, 0, 248, 400, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 394, 267, 273,
-	412, 275, 247, 319, 269, 378, 282, 0, 405, 0,
-	406, 0, 0, 0, 0, 311, 278, 279, 343, 283,
-	289, 332, 377, 317, 337, 245, 368, 344, 293, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 287, 0, 328, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 353, 0, 0,
-	384, 385, 386, 408, 370, 0, 420, 0, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 286, 0,
-	0, 0, 0, 0, 0, 345, 300, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 350, 366, 244,
-	341, 379, 249, 348, 239, 315, 338, 0, 0, 236,
-	364, 347, 297, 280, 281, 235, 0, 333, 259, 272,
-	256, 313, 0, 363, 391, 255, 382, 0, 374, 238,
-	0, 373, 312, 360, 365, 298, 292, 237, 362, 296,
-	291, 284, 263, 407, 276, 324, 290, 325, 277, 302,
-	301, 303, 0, 0, 0, 0, 0, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 285, 0, 0, 0, 392,
-	0, 336, 318, 0, 0, 0, 334, 288, 361, 326,
-	367, 351, 375, 330, 327, 229, 352, 258, 299, 240,
-	242, 254, 260, 262, 264, 265, 308, 309, 321, 340,
-	354, 355, 356, 257, 250, 335, 251, 274, 252, 230,
-	342, 253, 232, 322, 359, 0, 270, 331, 295, 233,
-	294, 323, 358, 357, 241, 383, 389, 390, 395, 0,
-	396, 0, 0, 0, 404, 409, 410, 411, 413, 414,
-	415, 416, 0, 0, 0, 0, 398, 0, 0, 0,
-	0, 0, 0, 388, 268, 226, 227, 423, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	387, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 421, 320, 0, 339, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 346, 369, 381,
-	399, 402, 0, 0, 0, 231, 401, 0, 0, 0,
-	0, 0, 0, 0, 372, 0, 0, 0, 380, 0,
-	0, 0, 0, 0, 397, 304, 305, 306, 307, 271,
-	0, 248, 400, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 267, 273, 412, 275, 247, 319, 269, 378,
-	282, 0, 405, 0, 406, 0, 0, 0, 0, 311,
-	278, 279, 343, 283, 289, 332, 377, 317, 337, 245,
-	368, 344, 293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 287, 0, 328, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 0, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 377, 231, 232,
+	233, 234, 0, 0, 0, 329, 330, 331, 336, 0,
+	411, 412, 413, 435, 394, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 456, 274, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 368, 315, 0, 335, 370,
+	308, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 0, 256, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 374, 390, 257, 363, 406, 262, 372,
+	251, 334, 360, 0, 0, 248, 388, 371, 312, 294,
+	295, 247, 0, 353, 272, 286, 269, 332, 0, 387,
+	418, 268, 409, 0, 398, 250, 0, 397, 328, 384,
+	389, 313, 306, 249, 386, 311, 305, 298, 276, 434,
+	401, 290, 344, 304, 345, 291, 318, 317, 319, 0,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 252,
+	239, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 373, 0, 0, 299, 0, 0, 0, 419,
+	0, 358, 338, 0, 0, 0, 354, 302, 385, 346,
+	391, 0, 0, 280, 399, 403, 355, 449, 356, 375,
+	400, 350, 347, 241, 376, 271, 314, 253, 255, 267,
+	273, 275, 277, 278, 324, 325, 341, 362, 378, 379,
+	380, 270, 263, 357, 264, 288, 265, 242, 364, 266,
+	244, 342, 383, 0, 284, 351, 310, 245, 309, 343,
+	382, 381, 254, 410, 416, 417, 422, 0, 423, 0,
+	0, 0, 431, 436, 437, 438, 440, 441, 442, 443,
+	0, 0, 0, 0, 425, 0, 0, 235, 236, 0,
+	0, 0, 0, 415, 282, 237, 238, 451, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	414, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 448, 340, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 369, 393, 408,
+	426, 429, 0, 0, 0, 243, 428, 0, 0, 0,
+	0, 0, 0, 0, 396, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 424, 320, 321, 322, 323, 285,
+	0, 261, 427, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	420, 421, 281, 287, 439, 289, 260, 339, 283, 405,
+	296, 0, 432, 0, 433, 0, 0, 0, 0, 327,
+	292, 293, 366, 297, 303, 352, 404, 337, 359, 258,
+	392, 367, 307, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 301, 0, 348, 279, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 0, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 377, 231, 232, 233,
+	234, 0, 0, 0, 329, 330, 331, 336, 0, 411,
+	412, 413, 435, 394, 0, 447, 0, 0, 0, 0,
+	0, 0, 0, 0, 274, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 368, 315, 0, 335, 370, 308,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 0, 0, 0, 0, 0, 0, 256, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 374, 390, 257, 363, 406, 262, 372, 251,
+	334, 360, 0, 0, 248, 388, 371, 312, 294, 295,
+	247, 0, 353, 272, 286, 269, 332, 0, 387, 418,
+	268, 409, 0, 398, 250, 0, 397, 328, 384, 389,
+	313, 306, 249, 386, 311, 305, 298, 276, 434, 401,
+	290, 344, 304, 345, 291, 318, 317, 319, 0, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 252, 239,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 373, 0, 0, 299, 0, 0, 0, 419, 0,
+	358, 338, 0, 0, 0, 354, 302, 385, 346, 391,
+	0, 0, 280, 399, 403, 355, 449, 356, 375, 400,
+	350, 347, 241, 376, 271, 314, 253, 255, 267, 273,
+	275, 277, 278, 324, 325, 341, 362, 378, 379, 380,
+	270, 263, 357, 264, 288, 265, 242, 364, 266, 244,
+	342, 383, 0, 284, 351, 310, 245, 309, 343, 382,
+	381, 254, 410, 416, 417, 422, 0, 423, 0, 0,
+	0, 431, 436, 437, 438, 440, 441, 442, 443, 0,
+	0, 0, 0, 425, 0, 0, 235, 236, 0, 0,
+	0, 0, 415, 282, 237, 238, 451, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 414,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	448, 340, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 369, 393, 408, 426,
+	429, 0, 0, 0, 243, 428, 0, 0, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 424, 320, 321, 322, 323, 285, 0,
+	261, 427, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 420,
+	421, 281, 287, 439, 289, 260, 339, 283, 405, 296,
+	0, 432, 0, 433, 0, 0, 0, 0, 327, 292,
+	293, 366, 297, 303, 352, 404, 337, 359, 258, 392,
+	367, 307, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 301, 0, 348, 279, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 353, 0, 0, 384, 385, 386, 408, 370, 0,
-	420, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 286, 0, 0, 0, 0, 0, 0, 345,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 350, 366, 244, 341, 379, 249, 348, 239, 315,
-	338, 0, 0, 236, 364, 347, 297, 280, 281, 235,
-	0, 333, 259, 272, 256, 313, 0, 363, 391, 255,
-	382, 0, 374, 238, 0, 373, 312, 360, 365, 298,
-	292, 237, 362, 296, 291, 284, 263, 407, 276, 324,
-	290, 325, 277, 302, 301, 303, 0, 0, 0, 0,
-	0, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 285,
-	0, 0, 0, 392, 0, 336, 318, 0, 0, 0,
-	334, 288, 361, 326, 367, 351, 375, 330, 327, 229,
-	352, 258, 299, 240, 242, 500, 260, 262, 264, 265,
-	308, 309, 321, 340, 354, 355, 356, 257, 250, 335,
-	251, 274, 252, 230, 342, 253, 232, 322, 359, 0,
-	270, 331, 295, 233, 294, 323, 358, 357, 241, 383,
-	389, 390, 395, 0, 396, 0, 0, 0, 404, 409,
-	410, 411, 413, 414, 415, 416, 0, 0, 0, 0,
-	398, 0, 0, 0, 0, 0, 0, 388, 268, 226,
-	227, 423, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 387, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 421, 320, 0, 339, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 346, 369, 381, 399, 402, 0, 0, 0, 231,
-	401, 0, 0, 0, 0, 0, 0, 0, 372, 0,
-	0, 0, 380, 0, 0, 0, 0, 0, 397, 304,
-	305, 306, 307, 271, 0, 248, 400, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 394, 267, 273, 412, 275,
-	247, 319, 269, 378, 282, 0, 405, 156, 406, 49,
-	148, 125, 0, 311, 278, 279, 343, 283, 289, 332,
-	377, 317, 337, 245, 368, 344, 293, 149, 821, 0,
-	0, 0, 0, 0, 141, 0, 0, 0, 150, 0,
-	221, 0, 0, 108, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 97, 0,
-	0, 0, 0, 0, 153, 0, 0, 228, 0, 287,
-	0, 328, 266, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 0, 1517, 0, 384, 385,
-	386, 408, 370, 0, 420, 0, 0, 0, 0, 0,
-	0, 809, 0, 0, 0, 0, 0, 112, 113, 0,
-	114, 115, 0, 0, 0, 0, 0, 0, 0, 0,
-	1519, 829, 833, 835, 837, 839, 840, 842, 0, 846,
-	843, 844, 845, 0, 0, 824, 825, 826, 827, 807,
-	808, 830, 0, 810, 1517, 811, 812, 813, 814, 815,
-	816, 817, 818, 819, 820, 822, 828, 1499, 0, 0,
-	0, 0, 0, 0, 832, 834, 836, 838, 841, 0,
-	0, 0, 0, 0, 0, 124, 147, 154, 1519, 95,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 146, 140, 139,
-	0, 823, 0, 0, 55, 0, 0, 0, 479, 0,
-	478, 485, 475, 0, 0, 1499, 0, 0, 0, 0,
-	0, 0, 482, 483, 0, 484, 488, 0, 0, 470,
-	0, 0, 0, 0, 0, 0, 1517, 0, 0, 493,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 143, 144, 0, 0, 0, 497, 0,
-	1519, 499, 0, 0, 0, 2705, 498, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 151, 1493,
-	1492, 0, 0, 1491, 0, 0, 0, 0, 1503, 0,
-	479, 0, 478, 485, 475, 0, 104, 1499, 0, 1507,
-	145, 0, 105, 0, 482, 483, 0, 484, 488, 0,
-	0, 470, 0, 0, 0, 0, 0, 0, 0, 1496,
-	0, 493, 0, 1498, 1500, 1502, 0, 1504, 1505, 1506,
-	1508, 1509, 1510, 1512, 1513, 1514, 1515, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1503, 0, 0, 0,
-	497, 0, 0, 499, 0, 106, 0, 1507, 498, 0,
-	0, 0, 0, 0, 0, 48, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1518, 0, 1496, 0, 0,
-	0, 1498, 1500, 1502, 0, 1504, 1505, 1506, 1508, 1509,
-	1510, 1512, 1513, 1514, 1515, 0, 471, 473, 472, 0,
-	0, 0, 0, 0, 0, 0, 477, 0, 0, 0,
-	0, 0, 1516, 0, 50, 0, 0, 0, 481, 0,
-	0, 0, 0, 0, 0, 496, 0, 0, 0, 1495,
-	0, 0, 474, 1518, 0, 0, 465, 0, 1503, 0,
-	0, 0, 0, 0, 0, 0, 831, 126, 0, 1507,
-	0, 0, 0, 0, 0, 0, 0, 0, 1511, 0,
-	0, 0, 0, 0, 0, 1501, 0, 0, 0, 1496,
-	1516, 0, 0, 1498, 1500, 1502, 0, 1504, 1505, 1506,
-	1508, 1509, 1510, 1512, 1513, 1514, 1515, 1495, 471, 473,
-	472, 0, 0, 0, 0, 0, 0, 0, 477, 0,
-	0, 107, 38, 0, 0, 0, 0, 0, 47, 0,
-	481, 0, 111, 0, 0, 0, 1511, 496, 0, 0,
-	0, 0, 0, 1501, 474, 1518, 0, 0, 0, 0,
-	0, 476, 480, 486, 0, 487, 489, 0, 0, 490,
-	491, 492, 0, 0, 494, 495, 479, 0, 478, 485,
-	475, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	482, 483, 1516, 484, 488, 0, 0, 470, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 493, 0, 1495,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1511, 0,
-	0, 0, 0, 0, 0, 1501, 0, 0, 0, 0,
-	0, 0, 0, 476, 480, 486, 0, 487, 489, 0,
-	0, 490, 491, 492, 0, 0, 494, 495, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 471, 473, 472, 0, 0, 0,
-	0, 0, 0, 0, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 481, 0, 0, 0,
-	0, 0, 0, 496, 0, 0, 0, 0, 0, 0,
-	474, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 476,
-	480, 486, 0, 487, 489, 0, 0, 490, 491, 492,
-	0, 0, 494, 495,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	0, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 377, 231, 232, 233, 234,
+	0, 0, 0, 329, 330, 331, 336, 0, 411, 412,
+	413, 435, 394, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 0, 300, 0, 0, 0,
+	0, 0, 0, 368, 315, 0, 335, 370, 308, 365,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 256, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 374, 390, 257, 363, 406, 262, 372, 251, 334,
+	360, 0, 0, 248, 388, 371, 312, 294, 295, 247,
+	0, 353, 272, 286, 269, 332, 0, 387, 418, 268,
+	409, 0, 398, 250, 0, 397, 328, 384, 389, 313,
+	306, 249, 386, 311, 305, 298, 276, 434, 401, 290,
+	344, 304, 345, 291, 318, 317, 319, 0, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 252, 239, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	373, 0, 0, 299, 0, 0, 0, 419, 0, 358,
+	338, 0, 0, 0, 354, 302, 385, 346, 391, 0,
+	0, 280, 399, 403, 355, 449, 356, 375, 400, 350,
+	347, 241, 376, 271, 314, 253, 255, 267, 273, 275,
+	277, 278, 324, 325, 341, 362, 378, 379, 380, 270,
+	263, 357, 264, 288, 265, 242, 364, 266, 244, 342,
+	383, 0, 284, 351, 310, 245, 309, 343, 382, 381,
+	254, 410, 416, 417, 422, 0, 423, 0, 0, 0,
+	431, 436, 437, 438, 440, 441, 442, 443, 0, 0,
+	0, 0, 425, 0, 0, 235, 236, 0, 0, 0,
+	0, 415, 282, 237, 238, 451, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 414, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 448,
+	340, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 369, 393, 408, 426, 429,
+	0, 0, 0, 243, 428, 0, 0, 0, 0, 0,
+	0, 0, 396, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 424, 320, 321, 322, 323, 285, 0, 261,
+	427, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 420, 421,
+	281, 287, 439, 289, 260, 339, 283, 405, 296, 0,
+	432, 0, 433, 0, 0, 0, 0, 327, 292, 293,
+	366, 297, 303, 352, 404, 337, 359, 258, 392, 367,
+	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 301, 0, 348, 279, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 377, 231, 232, 233, 234, 0,
+	0, 0, 2996, 330, 331, 336, 0, 411, 412, 413,
+	435, 394, 0, 447, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 368, 315, 0, 335, 370, 308, 1487, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
+	0, 0, 0, 0, 0, 256, 187, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	374, 390, 257, 363, 406, 262, 372, 251, 334, 360,
+	0, 0, 248, 388, 371, 312, 294, 295, 247, 0,
+	353, 272, 286, 269, 332, 0, 387, 418, 268, 409,
+	0, 398, 250, 0, 397, 328, 384, 389, 313, 306,
+	249, 386, 311, 305, 298, 276, 434, 401, 290, 344,
+	304, 345, 291, 318, 317, 319, 0, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 252, 239, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 299, 0, 0, 0, 419, 0, 358, 338,
+	0, 0, 0, 354, 302, 385, 346, 391, 0, 0,
+	280, 399, 403, 355, 449, 356, 375, 400, 350, 347,
+	241, 376, 271, 314, 253, 255, 267, 273, 275, 277,
+	278, 324, 325, 341, 362, 378, 379, 380, 270, 263,
+	357, 264, 288, 265, 242, 364, 266, 244, 342, 383,
+	0, 284, 351, 310, 245, 309, 343, 382, 381, 254,
+	410, 416, 417, 422, 0, 423, 0, 0, 0, 431,
+	436, 437, 438, 440, 441, 442, 443, 0, 0, 0,
+	0, 425, 0, 0, 235, 236, 0, 0, 0, 0,
+	415, 282, 237, 238, 451, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 414, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 448, 340,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 393, 408, 426, 429, 0,
+	0, 0, 243, 428, 0, 0, 0, 0, 0, 0,
+	0, 396, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 424, 320, 321, 322, 323, 285, 0, 261, 427,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 420, 421, 281,
+	287, 439, 289, 260, 339, 283, 405, 296, 0, 432,
+	0, 433, 0, 0, 0, 0, 327, 292, 293, 366,
+	297, 303, 352, 404, 337, 359, 258, 392, 367, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 301, 0, 348, 279, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 0, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 229, 377, 231, 232, 233, 234, 0, 0,
+	0, 329, 330, 331, 336, 0, 411, 412, 413, 435,
+	394, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 368, 315, 0, 335, 370, 308, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 256, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 374,
+	390, 257, 363, 406, 262, 372, 251, 334, 360, 0,
+	0, 248, 388, 371, 312, 294, 295, 247, 0, 353,
+	272, 286, 269, 332, 0, 387, 418, 268, 409, 0,
+	398, 250, 0, 397, 328, 384, 389, 313, 306, 249,
+	386, 311, 305, 298, 276, 434, 401, 290, 344, 304,
+	345, 291, 318, 317, 319, 0, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 252, 239, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 373, 0,
+	0, 299, 0, 0, 0, 419, 0, 358, 338, 0,
+	0, 0, 354, 302, 385, 346, 391, 0, 0, 280,
+	399, 403, 355, 449, 356, 375, 400, 350, 347, 241,
+	376, 271, 314, 253, 255, 528, 273, 275, 277, 278,
+	324, 325, 341, 362, 378, 379, 380, 270, 263, 357,
+	264, 288, 265, 242, 364, 266, 244, 342, 383, 0,
+	284, 351, 310, 245, 309, 343, 382, 381, 254, 410,
+	416, 417, 422, 0, 423, 0, 0, 0, 431, 436,
+	437, 438, 440, 441, 442, 443, 0, 0, 0, 0,
+	425, 0, 0, 235, 236, 0, 0, 0, 0, 415,
+	282, 237, 238, 451, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 414, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 448, 340, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 369, 393, 408, 426, 429, 0, 0,
+	0, 243, 428, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	424, 320, 321, 322, 323, 285, 0, 261, 427, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 420, 421, 281, 287,
+	439, 289, 260, 339, 283, 405, 296, 0, 432, 0,
+	433, 0, 0, 0, 0, 327, 292, 293, 366, 297,
+	303, 352, 404, 337, 359, 258, 392, 367, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 301, 0, 348, 279, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 0, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 229, 0, 231, 232, 233, 234, 0, 0, 0,
+	329, 330, 331, 0, 0, 411, 412, 413, 435, 394,
+	164, 447, 52, 156, 132, 2522, 0, 0, 0, 0,
+	0, 0, 164, 0, 52, 156, 132, 0, 0, 0,
+	157, 0, 0, 0, 1584, 0, 0, 149, 0, 0,
+	0, 158, 157, 0, 2532, 0, 111, 0, 0, 149,
+	0, 0, 0, 158, 0, 120, 0, 2525, 111, 0,
+	0, 0, 0, 0, 2520, 0, 100, 120, 1586, 2535,
+	2536, 0, 161, 0, 0, 2521, 0, 0, 100, 0,
+	0, 0, 0, 0, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1566, 0, 0, 2526, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 116, 117, 0, 118,
+	119, 0, 0, 0, 0, 0, 121, 0, 116, 117,
+	0, 118, 119, 0, 0, 0, 0, 0, 121, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1584, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2534, 0,
+	1926, 0, 0, 0, 0, 131, 155, 162, 1586, 98,
+	0, 0, 0, 0, 0, 0, 0, 131, 155, 162,
+	0, 98, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 148, 147, 2528, 1560, 1553, 1559, 58, 1552, 0,
+	1557, 1558, 154, 148, 147, 0, 1570, 0, 1584, 58,
+	1566, 115, 0, 0, 0, 2527, 2529, 1574, 0, 0,
+	0, 0, 0, 115, 0, 1554, 0, 0, 1555, 0,
+	0, 0, 0, 0, 0, 1556, 0, 0, 0, 0,
+	0, 0, 1586, 0, 0, 1563, 0, 0, 0, 1565,
+	1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577, 1579,
+	1580, 1581, 1582, 150, 151, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 2901, 150, 151, 152, 0, 0,
+	0, 0, 0, 1584, 1566, 0, 0, 0, 0, 0,
+	0, 159, 2537, 0, 0, 0, 0, 0, 0, 0,
+	0, 1585, 0, 159, 2523, 0, 0, 0, 0, 107,
+	2533, 0, 0, 153, 0, 108, 0, 1586, 1584, 0,
+	0, 107, 0, 0, 0, 153, 0, 108, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1583, 0, 0, 0, 1560, 2230, 1559, 0, 2229, 0,
+	1557, 1558, 1586, 0, 0, 0, 1570, 1562, 0, 1566,
+	0, 0, 0, 0, 0, 0, 0, 1574, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 51, 0,
+	109, 0, 0, 0, 0, 0, 1578, 0, 0, 0,
+	51, 0, 0, 1568, 1566, 1563, 0, 0, 0, 1565,
+	1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577, 1579,
+	1580, 1581, 1582, 0, 0, 0, 0, 0, 0, 0,
+	2887, 0, 0, 0, 0, 0, 0, 53, 0, 0,
+	1570, 507, 0, 506, 513, 503, 0, 0, 0, 53,
+	0, 1574, 0, 0, 0, 510, 511, 0, 512, 516,
+	0, 1585, 498, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 521, 0, 0, 0, 0, 0, 0, 1563,
+	0, 0, 133, 1565, 1567, 1569, 0, 1571, 1572, 1573,
+	1575, 1576, 1577, 1579, 1580, 1581, 1582, 0, 0, 0,
+	1583, 0, 0, 0, 0, 0, 525, 0, 0, 527,
+	0, 0, 0, 0, 526, 1570, 0, 1562, 0, 0,
+	0, 0, 0, 0, 0, 0, 1574, 0, 0, 110,
+	39, 0, 0, 0, 0, 1585, 50, 5, 0, 0,
+	114, 110, 39, 0, 0, 0, 1578, 0, 50, 0,
+	1570, 0, 114, 1568, 1563, 0, 0, 0, 1565, 1567,
+	1569, 1574, 1571, 1572, 1573, 1575, 1576, 1577, 1579, 1580,
+	1581, 1582, 0, 0, 1583, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1563,
+	0, 1562, 0, 1565, 1567, 1569, 0, 1571, 1572, 1573,
+	1575, 1576, 1577, 1579, 1580, 1581, 1582, 0, 0, 0,
+	1585, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1578, 507, 0, 506, 513, 503, 0, 1568, 0, 0,
+	0, 0, 0, 0, 0, 510, 511, 0, 512, 516,
+	0, 0, 498, 0, 0, 1585, 499, 501, 500, 1583,
+	0, 0, 521, 0, 0, 0, 0, 0, 0, 505,
+	0, 0, 0, 0, 0, 0, 1562, 0, 0, 0,
+	0, 509, 0, 0, 0, 0, 0, 0, 524, 0,
+	0, 0, 0, 0, 1583, 502, 525, 0, 0, 527,
+	0, 0, 0, 0, 526, 1578, 507, 493, 506, 513,
+	503, 1562, 1568, 0, 0, 0, 0, 0, 0, 0,
+	510, 511, 0, 512, 516, 0, 0, 498, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 521, 0, 0,
+	1578, 0, 0, 0, 0, 0, 0, 1568, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 504, 508, 514, 0, 515, 517,
+	0, 0, 518, 519, 520, 0, 0, 522, 523, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 499, 501, 500, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 505,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 509, 0, 0, 0, 0, 0, 0, 524, 0,
+	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 499, 501, 500, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 505, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 509, 0, 0, 0,
+	0, 0, 0, 524, 0, 0, 0, 0, 0, 0,
+	502, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 504, 508, 514, 0, 515, 517,
+	0, 0, 518, 519, 520, 0, 0, 522, 523, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 504,
+	508, 514, 0, 515, 517, 0, 0, 518, 519, 520,
+	0, 0, 522, 523,
 }
 
 var yyPact = [...]int{
-	2820, -1000, -1000, -1000, -310, 10494, -1000, -1000, -1000, -1000,
+	38530, -1000, -1000, -1000, -309, 10954, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 33056, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 33056, -306, 32532,
-	32532, -1000, -1000, 1893, -1000, 32008, 11561, 33056, 243, 239,
-	33056, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 36355,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	36355, -307, 35816, 35816, -1000, -1000, 2045, -1000, 35277, 12592,
+	36355, 308, 300, 36355, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 539, -1000, 31484, -1000, -1000,
-	-1000, -1000, -1000, -1000, 474, 34228, 33580, 8387, -258, -1000,
-	2500, -115, 729, 741, 758, 758, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 234, 608, 30960, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2758, 115, 608, 13657,
-	-46, -48, 2500, 302, 180, -1000, 833, 33997, 142, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 666, -1000,
+	34738, -1000, -1000, -1000, -1000, -1000, -1000, 546, 39001, 37972,
+	8744, -257, -1000, 2820, -99, 446, 822, 828, 921, 918,
+	36355, 2535, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 3897, 755, 34199, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	8387, 8387, 10494, -315, 10494, 8387, 33056, 33056, -1000, -1000,
-	-1000, -1000, 474, 34228, 8387, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2855, 197, 755, 15294, 4, 3,
+	2820, 348, 2313, -1000, 996, 38542, 162, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 8744, 8744,
+	10954, -315, 10954, 8744, 36355, 36355, -1000, -1000, -1000, -1000,
+	546, 39001, 8744, 446, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -5029,320 +5556,344 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -48, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 3, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3892, -1000, 1184,
-	-1000, -1000, -1000, -1000, 2185, 1180, 1790, 425, 33056, -1000,
-	1179, 425, -1000, -1000, -1000, 2500, 2500, -1000, 33056, 33056,
-	27, 1295, -1000, 297, 289, 307, 1176, -1000, -1000, -1000,
-	-1000, -1000, 2526, -1000, 33056, 33056, 2206, 33056, -1000, 1622,
-	384, 34320, 2361, 1001, 596, 2226, -1000, -1000, 2180, -1000,
-	133, 353, 123, 225, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 126, -1000, 2430, -1000, -1000, 127, -1000, -1000, 121,
-	-1000, -1000, -1000, -51, -1000, -1000, -1000, -1000, -1000, -1000,
-	-120, -1000, -1000, 761, 1585, 8387, -1000, 1420, -1000, 2653,
-	-1000, -1000, -1000, -1000, 5757, 9959, 9959, 9959, 9959, -1000,
-	-1000, 2065, 8387, 2179, 2177, -1000, -1000, -1000, -1000, -1000,
-	1175, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1789, 9435, -1000, 2176, 2175, 2170, 2168,
-	2167, 2164, 2163, 2162, 2161, 2160, 2159, 2158, 2156, 2155,
-	1949, 11026, 2154, 1788, 1787, 2152, 2150, 2149, 1786, 1949,
-	1949, 2142, 2141, 2139, 2116, 2110, 2107, 2106, 2105, 2104,
-	2102, 2101, 2096, 2095, 2093, 2091, 2090, 2089, 2087, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 3282, -1000, 1320, -1000, -1000, -1000,
+	-1000, -1000, 2433, 1319, 1968, 496, 36355, -1000, 1316, 496,
+	-1000, -1000, -1000, 2820, 2820, -1000, 36355, 36355, 56, 1464,
+	-1000, 339, 307, 347, 1315, -1000, -1000, -1000, -1000, -1000,
+	2870, -1000, 36355, 36355, 2456, 36355, -1000, 1647, 417, 39181,
+	2681, 1130, 709, 2486, -1000, -1000, 2428, -1000, 189, 407,
+	180, 353, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 201,
+	-1000, 2770, -1000, -1000, 194, -1000, -1000, 185, -1000, -1000,
+	-1000, 2, -1000, -1000, -1000, -1000, -1000, -1000, -85, -1000,
+	-1000, 871, 2054, 8744, -1000, 1787, -1000, 3379, -1000, -245,
+	-1000, -1000, 5985, 10394, 10394, 10394, 10394, -1000, -1000, 2289,
+	8744, 2424, 2423, 2422, -1000, -1000, -1000, -1000, -1000, 1314,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1967, 9844, -1000, 2416, 2412, 2409, 2408, 2404,
+	2403, 2402, 2398, 2397, 2392, 2385, 2379, 2378, 2377, 2167,
+	11493, 2376, 1966, 1961, 2374, 2373, 2372, 1960, 2167, 2167,
+	2371, 2370, 2369, 2367, 2365, 2362, 2351, 2350, 2335, 2333,
+	2328, 2327, 2326, 2325, 2320, 2317, 2316, 2315, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 908, -1000, 2082, 2351, 2415, 1968, 2481, 2414,
-	2403, 2387, 2380, 1661, -1000, -1000, -1000, -158, -1000, -1000,
-	721, -1000, 706, -1000, 33056, 33056, 33056, 468, 468, 468,
-	468, 468, 506, 468, 531, 519, 512, 468, -1000, -1000,
-	-1000, -1000, -1000, -1000, 672, -1000, -1000, -1000, -1000, 1064,
-	33056, -1000, 2017, 1222, 2438, 413, 407, 405, 1222, 300,
-	-1000, 1330, 1330, 1330, 1330, 1222, 312, 376, 2415, 2415,
-	-47, 1330, -62, 1222, 1222, -62, 1222, 1222, 1222, 114,
-	-303, -1000, -1000, -1000, 1330, 1330, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2424, 2422, 474, 33056, 70, 33056, 474,
-	474, 482, 1622, 373, 368, 1067, 1325, -1000, 1227, 33056,
-	33056, 33056, 33056, 1227, 1227, 17328, 16804, -1000, 33056, -1000,
-	2415, 1968, -1000, 1907, 2040, 1902, 1968, 474, 474, 474,
-	474, 474, 474, 474, 474, 33056, 33056, 30436, 474, 7329,
-	7329, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	10494, 1589, 1566, 140, -83, -309, 183, -1000, -1000, 33056,
-	2322, 104, -1000, -1000, -1000, 1950, -1000, 1999, 1999, 1999,
-	1999, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	2039, 2080, -1000, -1000, 1996, 1996, 1996, 1950, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2031, 2031, 2036, 2031, 33056, 8387, 33056,
-	2357, 276, 2079, -1000, 33056, 274, 2415, 2351, 2415, -1000,
-	-1000, 1174, 1654, 1785, -1000, -1000, 297, 1167, -1000, 791,
-	-1000, -1000, -1000, -1000, 33056, 284, -1000, -1000, 1769, 2078,
-	-1000, 412, 837, 1115, -1000, 167, 34576, 26236, 1622, 26236,
-	33056, -1000, -1000, -1000, -1000, -1000, -1000, -53, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 134, -1000, 8387, 8387, 8387, 8387, 8387, -1000, 491,
-	8911, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9959, 9959,
-	9959, 9959, 9959, 9959, 9959, 9959, 9959, 9959, 9959, 9959,
-	2064, 1365, 9959, 9959, 9959, 9959, 2040, 2522, 1066, 198,
-	-1000, -1000, -1000, -1000, -1000, 1341, 1585, 8387, 8387, 33056,
-	-1000, 2878, 8387, 8387, 2780, 8387, 2377, 8387, 8387, 8387,
-	1899, 4184, 33056, 8387, -1000, 1898, 1891, -1000, -1000, 1495,
-	8387, -1000, -1000, 8387, -1000, -1000, 8387, 9959, 8387, -1000,
-	-1000, -1000, 157, 2377, 2377, 8387, 8387, 2377, 2377, 2377,
-	1299, 2377, 2377, 2377, 2377, 2377, 2377, 2377, 2377, 1887,
-	2415, -258, 6805, -1000, -274, 2351, 8387, -1000, -1000, 8387,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1783, -104,
-	727, 710, 719, -1000, 2373, -1000, 2077, 2075, 1173, 33056,
-	1349, 33056, 26236, 33056, 1622, 33056, 33056, 468, 468, 468,
-	33056, 482, -1000, 33056, 1064, 2371, 33056, 2499, 9959, 9959,
-	29912, 1330, 1222, 1222, -1000, -1000, 33056, -1000, -1000, -1000,
-	1330, 33056, 1330, 1330, 2499, 1330, -1000, -1000, -1000, 1222,
-	1222, -1000, -1000, -1000, -1000, 1330, 1330, -1000, -1000, 2499,
-	33056, -55, 2499, 2499, -35, -1000, -1000, -1000, 33056, 33056,
-	468, 33056, -1000, 33056, 33056, -1000, -1000, 33056, 34110, 33056,
-	29388, 2413, -1000, 26236, 33056, 24140, -1000, -1000, 395, 445,
-	15756, 357, 26236, 5232, -1000, -1000, 1227, 1227, 1227, 1227,
-	5232, 5232, 1189, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1054, -1000, 144, 2351, -1000, -1000, -1000, -1000, -1000, 33056,
-	33056, 26236, 1622, 33056, 33056, 33056, 33056, -1000, 2066, -1000,
-	2358, 33056, 1045, -1000, -1000, 13133, 1172, 1045, -1000, 1336,
-	-1000, 8387, 10494, -289, 8387, 10494, 10494, 8387, 10494, -1000,
-	8387, 85, -1000, -1000, -1000, -1000, 1653, -1000, 1650, -1000,
-	-1000, -1000, 1782, 1782, -1000, 1649, -1000, -1000, -1000, -1000,
-	1648, -1000, -1000, 1623, -1000, -1000, 1883, 761, -1000, 1777,
-	2225, -264, -1000, 14707, 33056, 33056, -1000, -1000, -264, -1000,
-	14182, 33056, 2351, -1000, 2351, 33056, -1000, 2435, -1000, 297,
-	208, -1000, -1000, -1000, -1000, -1000, -1000, 1171, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1053, -1000, 33056,
-	-1000, -1000, 167, 26236, 27284, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 181, -1000, -1000, 159, -1000, 494, 54, 1162,
-	-1000, -1000, 98, 155, 601, 1585, -1000, 1379, 1379, 1376,
-	-1000, 505, -1000, -1000, -1000, -1000, 2065, -1000, -1000, -1000,
-	2863, 1284, -1000, 1246, 1246, 1193, 1193, 1193, 1193, 1193,
-	1329, 1329, -1000, -1000, -1000, 5757, 2064, 9959, 9959, 9959,
-	9959, 444, 444, 2189, 2789, -1000, 8387, 1327, -1000, 8387,
-	2044, 1287, 1169, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1270, -1000, 2314, 2672, 2792, 2197, 2836, 2763, 2744,
+	2741, 2728, 1797, -1000, -1000, 606, -1000, -96, -1000, -1000,
+	806, -1000, 786, -1000, 601, -1000, -1000, 36355, 36355, 36355,
+	540, 36355, 540, 605, 540, 540, 540, 540, 631, 540,
+	662, 655, 654, 540, -1000, -1000, -1000, -1000, -1000, -1000,
+	752, -1000, -1000, -1000, -1000, 1153, 36355, -1000, 2239, 1416,
+	2789, 454, 448, 442, 1416, 342, -1000, 1499, 1499, 1499,
+	1499, 1416, 366, 440, 2792, 2792, -7, 1499, -16, 1416,
+	1416, -16, 1416, 1416, 1416, 161, -304, -1000, -1000, -1000,
+	1499, 1499, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2751,
+	2747, 546, 36355, 141, 36355, 546, 546, 577, 1647, 416,
+	410, 1165, 1523, -1000, 1433, 36355, 36355, 36355, 36355, 1433,
+	1433, 19093, 18554, -1000, 36355, -1000, 2792, 2197, -1000, 2135,
+	2331, 2129, 2197, 546, 546, 546, 546, 546, 546, 546,
+	537, 546, 36355, 36355, 33660, 546, 546, 7635, 7635, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10954, 1872,
+	1748, 159, -79, -312, 188, -1000, -1000, 36355, 2639, 156,
+	-1000, -1000, -1000, 2169, -1000, 2233, 2233, 2233, 2233, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2272, 2300,
+	-1000, 2169, -1000, 2225, 2225, 2225, 2169, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2258, 2258, 2259, 2258, 36355, 8744, 36355, 2674,
+	331, 2298, -1000, 36355, 313, 2792, 2672, 2792, -1000, -1000,
+	1312, 1792, 1958, -1000, -1000, 339, 1392, -1000, 959, -1000,
+	-1000, -1000, -1000, 36355, 332, -1000, -1000, 1940, 2296, -1000,
+	444, 952, 1228, -1000, 196, 39256, 28795, 1647, 28795, 36355,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1882, 1877, 1754, 2525, 1874, 8387, -1000,
-	-1000, 1161, 1160, 1153, -1000, 1626, 7863, -1000, -1000, -1000,
-	1873, 1156, 1872, -1000, -1000, -1000, 1868, 1144, 841, 1860,
-	1380, 1857, 1047, 8387, 8387, 1137, 1126, 8387, 8387, 8387,
-	8387, 1856, 8387, 8387, 8387, 8387, 8387, 8387, 8387, 8387,
-	-18, -1000, -1000, 1102, -1000, 1585, -1000, 1761, -1000, 1163,
-	1038, -1000, 1616, -1000, -1000, -1000, -1000, 724, 699, 697,
-	33056, 784, 12085, 33056, 2017, 2350, 81, -1000, 1051, -1000,
-	54, -129, 822, 2204, 2505, 33056, 33056, 33056, 2370, 28864,
-	-1000, 2059, 1086, -1000, -1000, 8387, -1000, -1000, 2113, 33056,
-	33056, 2499, 2499, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	33056, 2499, 2499, 1222, 1330, -1000, -1000, 1330, -1000, -1000,
-	1330, -1000, 1150, -1000, 33056, -1000, -1000, -1000, 2017, 1034,
-	-1000, 12609, 715, 504, -1000, 1262, 1262, 731, 1262, 1262,
-	1262, 1262, 331, 322, 1262, 1262, 1262, 1262, 1262, 1262,
-	1262, 1262, 1262, 1262, 1262, 1262, 1262, 1262, 2052, -1000,
-	80, 2402, 173, 172, 1051, 189, 2588, 1042, -1000, -1000,
-	-1000, -1000, 19424, 19424, 15232, 19424, -1000, 1178, -1000, -1000,
-	489, -1000, -1000, 822, -1000, -1000, -1000, 2051, 1294, -1000,
-	-1000, 11026, -1000, 5232, 5232, 5232, 5232, -1000, -1000, 19948,
-	33056, -1000, -121, -1000, -102, -1000, 1018, -1000, -1000, 1031,
-	822, 2224, 1018, 1018, -1000, 12085, 33056, -1000, 2499, 7329,
-	-1000, 24140, -1000, -1000, 28332, -1000, 27808, 2499, 1323, -1000,
-	10494, 1462, 137, -1000, 177, -312, 132, 1541, 131, 1585,
-	-1000, -1000, 1853, 1852, 1085, -1000, 1080, 1851, 1069, 1037,
-	-1000, -72, -1000, 2324, 851, -1000, 2049, -1000, 1036, 2281,
-	-1000, 1002, -1000, 1293, 1032, -1000, 851, 1022, 2273, 1002,
-	-1000, -1000, 1147, 26, -1000, -1000, 33056, 1769, 1021, 27284,
-	939, -1000, 486, 1139, 1113, -1000, 26236, 122, 26236, -1000,
-	26236, -1000, -1000, 293, -1000, 33056, 2327, -1000, -1000, -1000,
-	1717, -331, -1000, -1000, -1000, -1000, -1000, 1020, -1000, 444,
-	444, 2189, 2596, -1000, 9959, -1000, 9959, 2514, 1320, -1000,
-	8387, 1446, 3787, 492, 18900, 33056, -1000, -1000, 8387, 8387,
-	-1000, 2507, -1000, -1000, -1000, -1000, 8387, 8387, 1683, -1000,
-	33056, -1000, -1000, -1000, -1000, 18900, -1000, 9959, -1000, 8387,
-	875, 2492, -18, -18, 2458, 2406, 2391, 1019, -18, 2347,
-	2266, 2147, 2111, 2073, 2018, 1983, 1977, -1000, 2048, 6805,
-	-1000, -72, 8387, 8387, 8387, 2305, -1000, -1000, -1000, -1000,
-	-1000, 690, 106, 1847, 966, -1000, -1000, 33056, -1000, -1000,
-	-1000, 1844, 960, -1000, -1000, -1000, 33998, 1999, 1999, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2039, -1000,
-	-1000, 1996, 1996, 1996, 1950, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2031, 2031, 2036, 2031, -1000, 2368,
-	-1000, -28, 1262, 396, 26236, 367, -1000, 33056, 2223, 299,
-	2312, 33056, 2027, 2026, 2025, 238, 33998, 33056, 962, -1000,
-	1112, 33997, -1000, 33056, 1585, -1000, 1622, -1000, 1222, -1000,
-	-1000, 2499, 947, -1000, -1000, 2499, 1222, 1222, 1330, 33056,
-	-1000, 2365, 34110, -1000, -1000, -1000, -1000, 33998, 581, -1000,
-	633, 468, 33056, 1397, 633, 1394, 2024, -1000, -1000, -1000,
-	33056, 33056, 33056, 1393, 1391, -1000, 33056, 1612, -1000, 1611,
-	1262, 1262, 1595, 1760, 1759, 1742, 1262, 1262, 1592, 1739,
-	26760, 1583, 1567, 1560, 1632, 1738, 559, 1625, 1614, 1539,
-	33056, 2023, 1694, -28, 1262, 160, 33056, 1292, 396, 1464,
-	16280, 33056, 24140, 24140, 24140, 24140, -1000, 2251, 2246, -1000,
-	2239, 2238, 2269, 33056, 24140, 2017, -1000, 26760, -1000, -1000,
-	-1000, 2040, 995, 2282, 651, 8387, 26236, 1736, 357, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 33056, 33056,
-	1843, -1000, 2497, -1000, 955, -1000, -1000, 1110, -1000, 2497,
-	1344, -311, 10494, 1335, 1317, -1000, 8387, 10494, 8387, -291,
-	145, -293, -1000, -1000, -1000, 1734, -1000, -1000, -1000, 1559,
-	-1000, 1557, 6, 13, 1386, -264, 6805, 298, 33056, -264,
-	33056, 6805, -1000, 33056, 283, -264, 33056, 1542, -1000, -1000,
-	-1000, 2501, 26236, 1622, 1203, 25712, -1000, 111, -1000, 179,
-	381, 1727, -1000, 510, 90, -1000, 1291, 1717, -1000, -1000,
-	-1000, 9959, -1000, -1000, -1000, -1000, 1585, 8387, 1834, -1000,
-	663, 663, 1833, -1000, 1999, 1999, -1000, 1950, 1996, 1950,
-	663, 663, 1832, -1000, 1621, 1917, -1000, 1896, 1781, 8387,
-	-1000, 1821, 2410, 838, -145, -18, -1000, -1000, -1000, -18,
-	-18, -18, -18, -1000, -18, -18, -18, -18, -18, -18,
-	-18, -18, 429, -1000, 6, 1585, 1585, -1000, -1000, 2310,
-	-1000, 1726, 1724, 784, 33998, 561, 12085, 2321, 295, 1568,
-	-1000, -1000, 25188, 361, -1000, -1000, -1000, 463, 186, 1537,
-	356, -1000, 33056, 187, 33056, -1000, -1000, -1000, -1000, -1000,
-	2312, -1000, 713, 215, 12609, 12609, 12609, 220, 1304, -1000,
-	425, 835, 1097, 24140, 33056, -1000, 23616, 1818, -1000, 822,
-	2499, -1000, 33056, -1000, 2499, 2499, 1222, -1000, 295, -1000,
-	3236, -1000, 33056, -1000, 33056, -1000, 33056, 33056, 468, 8387,
-	-1000, -1000, -1000, 33056, -1000, 217, -1000, -1000, 18900, 18900,
-	-1000, -1000, -1000, -1000, 1723, 1721, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 342, 33056,
-	991, -1000, 1288, 1568, 25188, 1286, 1227, 1719, 361, -1000,
-	1718, -1000, 799, 33056, 33056, -1000, 896, -1000, 1283, 2214,
-	2218, 2214, -1000, -1000, -1000, -1000, 2242, -1000, 1801, -1000,
-	-1000, 896, -1000, -1000, -1000, -1000, -1000, 651, -1000, 2434,
-	633, 633, 633, 1816, 939, 1815, -1000, -1000, -1000, -1000,
-	-1000, 2487, 2475, 24664, 2487, -1000, -311, 1264, -1000, 1419,
-	130, 1372, 33056, -1000, -1000, -1000, 1814, 1812, -276, 19,
-	2472, 2471, 2535, -1000, 1810, 873, -264, -1000, -1000, 851,
-	-1000, -1000, -1000, -264, -1000, 851, -1000, -1000, 1622, -1000,
-	152, -1000, -1000, -1000, -1000, -1000, -1000, 37, -1000, 33056,
-	-1000, 1717, 1713, 88, -1000, 1585, -1000, -1000, -1000, -1000,
+	154, -1000, 8744, 8744, 8744, 8744, 8744, -1000, 596, 9294,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 10394, 10394, 10394,
+	10394, 10394, 10394, 10394, 10394, 10394, 10394, 10394, 10394, 2288,
+	1446, 10394, 10394, 10394, 2579, 10394, 1951, 1943, 2331, 2694,
+	1160, 229, -1000, -1000, -1000, -1000, -1000, 1471, 2054, 8744,
+	8744, 36355, 36355, -1000, 2908, 8744, 8744, 3691, 8744, 2715,
+	8744, 8744, 8744, 2122, 4316, 36355, 8744, -1000, 2119, 2117,
+	-1000, -1000, 1611, 8744, -1000, -1000, 8744, -1000, -1000, 8744,
+	10394, 8744, -1000, -1000, -1000, 302, 2715, 2715, 8744, 8744,
+	2715, 2715, 2715, 1505, 2715, 2715, 2715, 2715, 2715, 2715,
+	2715, 2715, 2115, 2792, -257, 7085, -1000, -266, 2672, 8744,
+	-1000, -1000, 8744, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1942, 36355, -72, 820, 789, 809, -1000, 37433, -1000,
+	2709, -1000, 2295, 36355, 1476, 2293, 1305, 36355, 540, 36355,
+	28795, 36355, 1647, 36355, 36355, 540, 540, 540, 36355, 577,
+	-1000, 36355, 1153, 2703, 36355, 2857, 10394, 10394, 33121, 1499,
+	1416, 1416, -1000, -1000, 36355, -1000, -1000, -1000, 1499, 36355,
+	1499, 1499, 2857, 1499, -1000, -1000, -1000, 1416, 1416, -1000,
+	-1000, -1000, -1000, 1499, 1499, -1000, -1000, 2857, 36355, 0,
+	2857, 2857, -24, -1000, -1000, -1000, 36355, 36355, 540, 36355,
+	-1000, 36355, 36355, -1000, -1000, 36355, 38558, 36355, 32582, 2739,
+	-1000, 28795, 36355, 21788, -1000, -1000, 443, 536, 17476, 401,
+	28795, 5425, -1000, -1000, 1433, 1433, 1433, 1433, 5425, 5425,
+	1265, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1143, -1000,
+	179, 2647, -1000, -1000, -1000, -1000, -1000, 36355, 36355, 28795,
+	1647, 36355, 36355, 36355, 546, 36355, -1000, 2292, -1000, 2678,
+	36355, 36355, 1082, -1000, -1000, 14755, 1295, 1082, -1000, 1521,
+	-1000, 8744, 10954, -280, 8744, 10954, 10954, 8744, 10954, -1000,
+	8744, 108, -1000, -1000, -1000, -1000, 1791, -1000, 1789, -1000,
+	-1000, -1000, 1941, 1941, -1000, -1000, 1786, -1000, -1000, -1000,
+	-1000, 1785, -1000, -1000, 1770, -1000, -1000, 2114, 871, -1000,
+	1930, 2484, -258, -1000, 16937, 36355, 36355, -1000, -1000, -258,
+	-1000, 16391, 36355, 2647, -1000, 2672, 36355, -1000, 2785, -1000,
+	339, 250, -1000, -1000, -1000, -1000, -1000, -1000, 1290, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1141, -1000,
+	36355, -1000, -1000, 196, 28795, 30412, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 228, -1000, -1000, 166, -1000, 597, 81,
+	1380, -1000, -1000, 149, 170, 667, 2054, -1000, 1542, 1542,
+	1535, -1000, 534, -1000, -1000, -1000, -1000, 2289, -1000, -1000,
+	-1000, 1732, 1976, -1000, 1473, 1473, 1271, 1271, 1271, 1271,
+	1271, 1483, 1483, -1000, -1000, -1000, 5985, 2288, 10394, 10394,
+	10394, 10394, 514, 514, 3402, 2287, 3200, -1000, -1000, -1000,
+	8744, 1517, -1000, 8744, 2191, 1202, 1375, 1284, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 8387, -1000, -1000, -1000, 1776, -1000, -1000, 8387, 1798,
-	1710, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2485, -1000, 2470, -276, -1000, -1000,
-	-1000, -1000, -1000, -1000, 33998, -1000, 1061, -1000, -1000, 1709,
-	53, -1000, -1000, -1000, 1706, 1698, 1519, -1000, -1000, 1511,
-	1154, 64, -1000, -1000, -1000, -1000, -1000, -1000, 1464, 33056,
-	1994, -1000, 1262, 1262, 1262, 33056, 1796, 860, -1000, 1774,
-	1705, 436, 1267, 1263, -1000, 1509, 19424, 24140, 23616, 895,
-	-1000, 1082, -1000, -1000, -1000, 2499, -1000, -1000, 2499, -1000,
-	-1000, 3236, -1000, -1000, 1406, 9959, -1000, -1000, 1697, 18376,
-	564, 555, 1991, -1000, 306, 2533, -1000, 1385, 1369, -1000,
-	33056, -1000, 1987, -1000, 1986, 1919, 253, 1976, 1974, 33056,
-	1745, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	354, 990, -1000, 1694, 1692, -1000, 53, 1691, 5232, -1000,
-	-1000, -1000, 33056, 799, 799, 2485, 33056, 6805, -1000, -1000,
-	8387, 1973, -1000, 8387, -1000, -1000, -1000, -1000, -1000, 1963,
-	2334, -1000, -1000, -1000, -1000, -1000, -1000, 8387, 8387, -1000,
-	-1000, 458, 10494, -294, 143, -1000, -1000, -1000, -278, 1690,
-	-1000, -1000, 2468, 1689, 1668, 33056, -1000, 851, 851, 822,
-	-1000, -1000, -35, -1000, -1000, -1000, 1712, -1000, 1663, -18,
-	-1000, 99, 8387, -278, -207, -1000, -1000, -1000, -1000, 268,
-	-1000, -1000, 178, -1000, -1000, 1507, 422, -1000, -1000, 799,
-	22568, 18900, 18376, 1686, -1000, 34260, 12609, 134, 34260, 745,
-	1261, -1000, 1504, -1000, 1493, -1000, 2499, 895, 1082, -1000,
-	-1000, 1202, -1000, -1000, -1000, -1000, 2189, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1488, 1962, -74, -1000, -1000, 1959, 22568, 22568, 236, 236,
-	22568, 22568, 1958, 518, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2415, -1000, -1000, 1585, 33056, 1585, 23092,
-	-1000, 2466, 2460, 1585, 761, -1000, -311, 33056, 33056, -281,
-	1487, -1000, 1685, 16, -1000, -1000, 773, -283, -13, 4,
-	-1000, -1000, -1000, 1600, -1000, 3370, -1000, -1000, -1000, 761,
-	-281, 33056, 377, 1674, -1000, -1000, 162, -1000, -1000, 980,
-	-1000, 1950, 8387, -1000, -1000, -1000, 421, 34168, -1000, -1000,
-	-1000, -35, 421, 379, 212, -1000, 1439, -1000, -1000, 2485,
-	-1000, 1516, 8387, 1949, -203, 22568, 979, 976, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 971, 970, 22568, -1000, -1000,
-	-1000, 288, -1000, 961, 959, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1947, -1000, -1000, 2459, -1000, 1673, 477, 8,
-	4, -1000, 2453, 12, 2451, 2450, -1000, -1000, 4708, -270,
-	-21, 309, -1000, 2363, -1000, -1000, 36, -1000, -1000, -1000,
-	22568, 2355, 1658, 262, 2449, 34260, -1000, -1000, 262, -1000,
-	235, -1000, 1259, -1000, 1437, -1000, 2415, -1000, 1631, -1000,
-	2217, -1000, 110, 958, -1000, -1000, -1000, -1000, 943, -1000,
-	-1000, -1000, 22044, 33056, 1668, -1000, 1931, 1436, 19, 5,
-	2447, -1000, 1668, 2445, 1668, 1668, 1373, -1000, -1000, -1000,
-	-1000, -1000, 1671, -1000, 202, -1000, -1000, 2355, -1000, 2444,
-	345, -1000, -1000, -1000, -1000, 1435, -1000, -1000, 518, -1000,
-	2212, 2209, 2510, -1000, -1000, -1000, -1000, 202, 202, 202,
-	202, 105, -1000, -1000, 936, -1000, -1000, 2383, 17852, -11,
-	-1000, -1000, -1000, 1669, -1000, 1668, -1000, -1000, 4708, -1000,
-	-1000, 1262, 1591, 196, -1000, -1000, -1000, 21520, 281, 291,
-	285, -1000, 439, -1000, -1000, -1000, -1000, 2531, -1000, 2528,
-	567, 567, -1000, -1000, 33056, -1000, 33056, -1000, 932, -1000,
-	-1000, -1000, 1077, -1000, -1000, -1000, -1000, -1000, 1417, -1000,
-	33056, -1000, 33056, 277, 1416, 9959, 1926, 9959, 1925, 296,
-	1915, -1000, -1000, -1000, 1505, 305, -1000, -1000, 760, -1000,
-	1244, -1000, 20996, 33056, -1000, -1000, 905, 1914, 2442, -1000,
-	2302, 33056, 2255, 33056, 1912, 1242, 9959, -1000, -1000, -1000,
-	33056, 6281, -1000, 759, -1000, -1000, 418, 286, -1000, 893,
-	-1000, 878, 20472, 1414, 1700, -1000, -1000, 1585, 33056, 877,
-	-1000, 33056, 264, -1000, -1000, -1000, 876, -1000, -1000, -1000,
-	-1000, 418, 2259, -1000, 1412, -1000, -1000, 2750, 571, -1000,
-	-1000, 2750, 266, -1000, 398, 1894, -1000, -1000, 872, -1000,
-	33056, 606, 8387, -1000, 266, 34260, -1000, 8387, 856, -1000,
-	34260, 847, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2106, 2105,
+	1823, 2867, 2103, 8744, -1000, -1000, 1370, 1360, 1358, -1000,
+	1936, 8194, -1000, -1000, -1000, 2102, 1277, 2089, -1000, -1000,
+	-1000, 2087, 1356, 1048, 2085, 2286, 2083, 1131, 8744, 8744,
+	1352, 1344, 8744, 8744, 8744, 8744, 2081, 8744, 8744, 8744,
+	8744, 8744, 8744, 8744, 8744, 13, -1000, -1000, 1338, -1000,
+	2054, -1000, 1928, -1000, 1088, 1120, -1000, 1916, -1000, -1000,
+	426, -1000, -1000, 817, 776, 781, -1000, 36355, 36355, 896,
+	510, 2650, 13677, 36355, 2239, 36355, 103, -1000, 1074, -1000,
+	81, -94, 900, 2442, 2865, 36355, 36355, 36355, 2702, 32036,
+	-1000, 2285, 1337, -1000, -1000, 8744, -1000, -1000, 2396, 36355,
+	36355, 2857, 2857, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	36355, 2857, 2857, 1416, 1499, -1000, -1000, 1499, -1000, -1000,
+	1499, -1000, 1267, -1000, 36355, -1000, -1000, -1000, 2239, 1117,
+	-1000, -1000, 12032, 1105, 507, 506, 499, 529, 529, 528,
+	625, -1000, 1432, 1432, 1055, 1432, 1432, 1432, 1432, 394,
+	387, 1432, 1432, 1432, 1432, 1432, 1432, 1432, 1432, 1432,
+	1432, 1432, 1432, 1432, 1432, 2284, -1000, 102, 2735, 241,
+	238, 1074, 257, 2803, 1016, -1000, -1000, -1000, -1000, 22327,
+	22327, 15840, -333, -1000, 1419, 2276, -1000, -1000, 595, -1000,
+	-1000, 900, -1000, -1000, -1000, 2275, 1461, -1000, -1000, 11493,
+	-1000, 5425, 5425, 5425, 5425, -1000, -1000, 22866, 36355, -1000,
+	-88, -1000, -78, 2672, 2623, 1329, 956, -1000, -1000, 1114,
+	900, 2483, 956, 956, 36355, -1000, 13677, 36355, -1000, -1000,
+	2857, 7635, -1000, 21788, -1000, -1000, 31490, -1000, 30951, 2857,
+	1492, -1000, 10954, 1735, 157, -1000, 184, -318, 155, 1617,
+	153, 2054, -1000, -1000, 2072, 2071, 1328, -1000, 1323, 2066,
+	1308, 1307, -1000, -22, -1000, 2641, 917, -1000, 2274, -1000,
+	1302, 2629, -1000, 1113, -1000, 1460, 1301, -1000, 917, 1299,
+	2618, 1113, 2672, -1000, 1263, 55, -1000, -1000, 36355, 1940,
+	1292, 30412, 980, -1000, 591, 1258, 1252, -1000, 28795, 209,
+	28795, -1000, 28795, -1000, -1000, 334, -1000, 36355, 2645, -1000,
+	-1000, -1000, 1896, -335, -1000, -1000, -1000, -1000, -1000, 1291,
+	-1000, 514, 514, 3402, 3149, -1000, 10394, -1000, 8744, 10394,
+	2689, 1491, -1000, 8744, 1696, 337, 1718, 21249, -137, 36355,
+	13, -1000, 8744, 8744, -1000, 2652, -1000, -1000, -1000, -1000,
+	8744, 8744, 1673, -1000, 36355, -1000, -1000, -1000, -1000, 21249,
+	-1000, 10394, -1000, 8744, 1077, 2609, 13, 13, 2547, 2519,
+	2514, 1285, 13, 2510, 2472, 2438, 2410, 2405, 2394, 2363,
+	2357, -1000, 2273, 7085, -1000, -22, 8744, 8744, 8744, 2612,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 772, -1000, 122,
+	2065, 1111, -1000, -1000, 36355, -1000, -1000, -1000, 2862, -1000,
+	-1000, -1000, 2062, 1110, -1000, -1000, -1000, 11969, 2233, 2233,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2272,
+	-1000, 2169, -1000, 2225, 2225, 2225, 2169, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2258, 2258, 2259, 2258,
+	-1000, 2701, 2239, 17, 1432, 505, 28795, 406, -1000, 36355,
+	2482, 574, 2637, 36355, 2253, 2252, 2251, 295, 11969, 36355,
+	989, -1000, 1251, 38542, -1000, 36355, 2054, -1000, 1647, -1000,
+	1416, -1000, -1000, 2857, 1019, -1000, -1000, 2857, 1416, 1416,
+	1499, 36355, -1000, 2699, 38708, 560, -1000, 36355, 847, -1000,
+	-1000, 11969, 685, -1000, 731, 540, 36355, 1563, 731, 1561,
+	2245, -1000, -1000, 36355, -1000, 36355, 36355, 36355, 1560, 1558,
+	29873, 36355, 36355, 36355, -1000, 36355, 36355, -1000, 36355, 1766,
+	-1000, 1765, 1432, 1432, 1759, 1927, 1925, 1924, 1432, 1432,
+	1757, 1923, 29334, 1755, 1753, 1743, 1751, 1920, 1039, 1744,
+	1678, 1643, 36355, 2241, 1867, 17, 1432, 236, 36355, 1459,
+	505, 1682, 18015, 36355, 21788, 21788, 21788, 21788, -1000, 2528,
+	2524, -1000, 2518, 2500, 2504, 36355, 21788, 2239, -1000, 29334,
+	-1000, -1000, -1000, 2331, 1279, 2756, 22327, 2687, 8744, 8744,
+	28795, 1915, 401, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2577, 2577, 2589, 36355, 36355, 956, 2053,
+	-1000, 2852, -1000, 1052, -1000, -1000, 1247, -1000, 2852, 1527,
+	-310, 10954, 1309, 1469, -1000, 8744, 10954, 8744, -282, 230,
+	-284, -1000, -1000, -1000, 1914, -1000, -1000, -1000, 1715, -1000,
+	1713, 23, 40, 1556, -258, 7085, 346, 36355, -258, 36355,
+	7085, -1000, 36355, 314, -258, -1000, 36355, 1712, -1000, -1000,
+	-1000, 2856, 28795, 1647, 1297, 28256, -1000, 173, -1000, 212,
+	425, 1912, -1000, 653, 116, -1000, 1453, 1896, -1000, -1000,
+	-1000, 10394, -1000, 2329, -1000, -1000, -1000, 2054, 8744, 2049,
+	-1000, 753, 753, 2043, -1000, 2233, 2233, -1000, 2169, 2225,
+	2169, 753, 753, 2041, 2222, -1000, -1000, 1832, 2255, -1000,
+	2223, 2185, 8744, -1000, 2025, 3098, 1287, -104, 13, -1000,
+	-1000, -1000, 13, 13, 13, 13, -1000, 13, 13, 13,
+	13, 13, 13, 13, 13, 498, -1000, 23, 2054, 2054,
+	-1000, -1000, 2624, -1000, 1911, 1904, 896, 11969, 2480, -1000,
+	-1000, -1000, 672, 13677, 2638, 352, 2686, 1720, -1000, -1000,
+	27717, 477, -1000, -1000, -1000, 535, 215, 1704, 398, -1000,
+	36355, 253, 36355, -1000, -1000, -1000, -1000, -1000, -1000, 2637,
+	-1000, 735, 287, 14216, 14216, 14216, 290, 1495, -1000, 496,
+	949, 1226, 21788, 36355, -1000, 26639, 2017, -1000, 900, 2857,
+	-1000, 36355, -1000, 2857, 2857, 1416, -1000, 352, -1000, 13131,
+	990, 491, 1703, 847, -1000, -1000, 36355, 38505, -1000, 36355,
+	-1000, 36355, -1000, 36355, 36355, 540, 8744, 1009, -1000, -1000,
+	-1000, 36355, -1000, 1009, -1000, 997, -145, 847, 36355, 561,
+	276, -1000, -1000, 21249, 21249, -1000, -1000, -1000, -1000, 1902,
+	1900, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 402, 36355, 1278, -1000, 1450, 1720, 27717,
+	1448, 1433, 1899, 477, -1000, 1897, -1000, 889, 36355, 36355,
+	-1000, 992, -1000, 1447, 2474, 2479, 2474, -1000, -1000, -1000,
+	-1000, 2522, -1000, 2516, -1000, -1000, 992, -1000, -1000, -1000,
+	-1000, 710, 2551, 2011, 1264, 980, 2009, -1000, -1000, 2572,
+	36355, 2572, 2585, -1000, -1000, -1000, 2848, 2835, 27178, 2848,
+	-1000, -310, 1449, -1000, 1573, 151, 1578, 36355, -1000, -1000,
+	-1000, 2006, 2005, -268, 34, 2833, 2831, 2876, -1000, 2003,
+	977, -258, -1000, -1000, 917, -1000, -1000, -1000, -258, -1000,
+	917, -1000, -1000, 1647, -1000, 205, -1000, -1000, -1000, -1000,
+	-1000, -1000, 65, -1000, 36355, -1000, 1896, 1894, 112, -1000,
+	-1000, 2054, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10394, -1000, 8744, -1000,
+	-1000, -1000, 2180, -1000, -1000, 8744, 2002, 1882, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2841, -1000, 2830, -268, -1000, -1000, -1000, -1000, -1000,
+	-1000, 36355, 11969, -1000, 1438, -1000, 352, -1000, 1881, 78,
+	-1000, -1000, -1000, 1879, 1877, 1698, -1000, -1000, 1642, 1176,
+	93, -1000, -1000, -1000, -1000, -1000, -1000, 1682, 36355, 2221,
+	-1000, 1432, 1432, 1432, 36355, 2001, 968, -1000, -1000, 1999,
+	1998, 511, 1468, 1467, -1000, 1683, 22327, 21788, 26639, 991,
+	-1000, 1211, -1000, -1000, -1000, 2857, -1000, -1000, 2857, -1000,
+	1273, -1000, 36355, -1000, -1000, -1000, -1000, -1000, 38505, -1000,
+	-1000, 1571, 10394, -1000, -1000, 1870, 20710, 649, 683, 2220,
+	-1000, 362, 2875, -1000, 1555, 1554, -1000, 36355, -1000, 2213,
+	-1000, 2208, 2193, 320, 2200, 2199, 36355, 2158, -1000, 2196,
+	634, -1000, 847, 36355, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 408, 1261, -1000, 1867, 1861, -1000, 78, 1857,
+	5425, -1000, -1000, -1000, 36355, 889, 889, 2841, 36355, 7085,
+	-1000, -1000, 8744, 2194, -1000, 8744, -1000, -1000, -1000, -1000,
+	-1000, 710, -1000, 2782, 731, 731, 731, 734, -1000, 1855,
+	-1000, -1000, -1000, 2541, 956, -1000, -1000, -1000, 8744, 8744,
+	-1000, -1000, 538, 10954, -293, 221, -1000, -1000, -1000, -270,
+	1854, -1000, -1000, 2828, 1848, 1814, 36355, -1000, 917, 917,
+	900, -1000, -1000, -24, -1000, -1000, -1000, 202, 2150, -1000,
+	2100, 13, -1000, 145, 8744, -270, 2774, -140, -1000, -1000,
+	-1000, -1000, -1000, 327, -1000, -1000, 248, -1000, -1000, 1624,
+	450, -1000, -1000, 889, 25561, 21249, 20710, 1847, -1000, 38892,
+	14216, 154, 38892, 851, 1421, -1000, 1677, -1000, 1675, -1000,
+	2857, 991, 1211, -1000, -1000, 1282, -1000, -1000, -1000, 491,
+	2593, -1000, -1000, 3402, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1672, 2190, -74,
+	-1000, -1000, 2179, 25561, 25561, 618, 618, 25561, 25561, 2178,
+	549, 491, 36355, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2792, -1000, -1000, 2054, 36355, 2054,
+	-1000, 2171, 2574, -1000, -1000, -1000, 8744, -27, -1000, 2054,
+	871, -1000, -310, 36355, 36355, -272, 1627, -1000, 1846, 42,
+	-1000, -1000, 887, -274, 18, 30, 1993, 303, -171, -1000,
+	-1000, -1000, 1992, -1000, 3541, -1000, -1000, -1000, 871, -272,
+	473, 36355, 409, 1838, -1000, -1000, 245, -1000, -1000, 1259,
+	-1000, 2169, 8744, -1000, -1000, -1000, 493, 38857, -1000, -1000,
+	-1000, -24, 493, 453, 254, -1000, 1623, -1000, -1000, 2841,
+	-1000, -1000, 38782, 623, 1978, 8744, 2167, -134, 25561, 1234,
+	1212, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1205,
+	1193, 25561, -1000, -1000, -1000, 349, 1175, 59, -1000, 1171,
+	26100, -1000, 2827, 2826, 147, 2166, -1000, -1000, -1000, 2164,
+	-1000, -1000, 2823, -1000, 1837, 552, 35, 30, -1000, 2822,
+	39, 2817, 2816, -1000, -143, 2584, -199, -1000, -1000, 4875,
+	-261, 10, 465, -1000, -262, 2683, -1000, -1000, 64, -1000,
+	-1000, -1000, 25561, 2653, 2073, 309, 2815, 38892, -1000, -1000,
+	309, -1000, 292, -1000, 1420, -1000, 1622, -1000, 2792, -1000,
+	38782, 326, -1000, 478, 2163, -1000, 1996, -1000, 2477, -1000,
+	133, 1154, -1000, -1000, -1000, -1000, 1124, -1000, -1000, 468,
+	455, -1000, 1121, -1000, -1000, -1000, -1000, -1000, 36894, 36355,
+	1814, -1000, 2162, 1620, 34, 32, 2814, -1000, 1814, 2811,
+	1814, 1814, 2581, -1000, -1000, 1532, -1000, -1000, -1000, -1000,
+	-1000, 1835, 1833, -1000, 226, -1000, -1000, 2653, -1000, 2805,
+	341, -1000, -1000, -1000, -1000, 1618, -1000, -1000, -1000, -1000,
+	1119, -1000, 36355, 678, 8744, 549, -1000, 2463, 2366, 2864,
+	-1000, -1000, -1000, -1000, 226, 226, 226, 226, 167, -1000,
+	-1000, -1000, 25022, 1104, -1000, 3177, 220, 1098, -1000, -1000,
+	2733, 20171, 21, -1000, -1000, -1000, 1828, -1000, 1814, -1000,
+	-1000, -146, 4875, -1000, -1000, -1000, 1432, 1811, 274, -1000,
+	-1000, 1432, -1000, 24483, 343, 367, 328, -1000, 502, -1000,
+	-1000, -1000, 326, 38892, -1000, 8744, 1097, -1000, -1000, 2866,
+	-1000, 2873, 665, 665, -1000, -1000, -1000, 1975, 36894, -252,
+	262, 1804, -33, -1000, 36355, -1000, 36355, -1000, 1072, -1000,
+	-1000, -1000, 1187, -1000, -1000, -1000, -1000, -197, -1000, 1616,
+	-1000, 36355, 1612, -1000, 36355, 325, 1605, 10394, 2154, 10394,
+	2152, 356, 2149, -1000, 38892, 1068, -1000, -1000, -1000, -1000,
+	1663, 371, -1000, -1000, -1000, -1000, 1793, -254, -44, 2147,
+	-1000, 857, -1000, 1429, -1000, 23944, 36355, -200, -1000, -1000,
+	-1000, 1035, 2145, 2802, -1000, 2569, 36355, 2390, 36355, 1977,
+	1390, 10394, -1000, -1000, -1000, -1000, 19632, 1741, 1878, 36894,
+	36355, 6535, -1000, 1180, -1000, -1000, -1000, 491, 335, -1000,
+	1033, -1000, 1008, 23405, 1603, 1680, -1000, 19632, 2334, -1000,
+	1738, -1000, -1000, 36894, 994, -1000, -1000, 2054, 36355, 986,
+	323, -1000, -1000, -1000, 985, -1000, -1000, -1000, 36355, -1000,
+	950, -1000, -1000, -1000, -1000, 1595, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 132, 2553, 212, 142, 3129, 64, 210, 203, 199,
-	209, 3128, 3127, 2346, 2344, 3126, 3125, 3124, 3123, 3121,
-	3120, 3119, 3108, 3107, 3105, 3104, 3103, 3101, 3100, 3097,
-	3096, 3095, 3094, 3093, 3091, 3090, 3089, 3088, 3086, 208,
-	3085, 3084, 3083, 3082, 3081, 3080, 3079, 3077, 3076, 3075,
-	3074, 3073, 3072, 3071, 3070, 3069, 3068, 3066, 3065, 3064,
-	3063, 3060, 3059, 3056, 3053, 3049, 3046, 3045, 3044, 3042,
-	179, 3041, 2332, 3039, 3038, 3036, 3035, 3033, 3031, 3027,
-	201, 3026, 3024, 3023, 3022, 3021, 3020, 3019, 3018, 3017,
-	3006, 3002, 3000, 2998, 2997, 2996, 2995, 2994, 174, 2993,
-	137, 170, 2981, 2980, 2978, 2977, 2976, 197, 182, 47,
-	2975, 38, 2973, 189, 2967, 120, 2965, 115, 2962, 2959,
-	2957, 2956, 2955, 2954, 2952, 2951, 2949, 2948, 2947, 2946,
-	68, 2945, 2944, 149, 161, 219, 2191, 220, 218, 156,
-	144, 85, 2941, 2320, 2937, 125, 193, 123, 25, 2936,
-	141, 2935, 124, 32, 28, 215, 110, 41, 136, 80,
-	2933, 187, 66, 2930, 82, 2928, 2927, 214, 159, 2926,
-	94, 2923, 2922, 2919, 2906, 168, 157, 2905, 2904, 84,
-	2901, 2896, 99, 2891, 52, 2890, 133, 2889, 102, 93,
-	105, 2886, 2876, 2875, 78, 2873, 2871, 2870, 2869, 147,
-	2868, 2867, 108, 69, 2865, 2862, 2860, 45, 2859, 60,
-	2858, 46, 2855, 2852, 2851, 2849, 53, 2847, 2845, 14,
-	21, 23, 2844, 19, 2842, 135, 2840, 2837, 2835, 2,
-	2831, 190, 96, 75, 116, 2826, 360, 2824, 2823, 2822,
-	130, 2821, 346, 2820, 2818, 2816, 2815, 2814, 5, 2811,
-	183, 40, 2810, 71, 101, 117, 165, 181, 2809, 2807,
-	2806, 95, 88, 54, 0, 2805, 126, 2803, 2802, 2800,
-	226, 2799, 198, 188, 196, 119, 221, 195, 2798, 2797,
-	65, 2796, 134, 79, 112, 8, 2795, 224, 2792, 596,
-	146, 2788, 172, 2787, 285, 1, 114, 2786, 2785, 35,
-	223, 2769, 2763, 2760, 97, 2758, 2757, 91, 89, 2749,
-	2747, 2746, 34, 2744, 30, 27, 2743, 81, 2742, 217,
-	2741, 163, 104, 154, 139, 121, 191, 194, 59, 49,
-	2740, 1333, 118, 72, 22, 2739, 192, 2737, 128, 103,
-	2736, 73, 2735, 207, 328, 169, 2732, 148, 10, 36,
-	29, 2730, 12, 2729, 213, 164, 2728, 2711, 20, 2710,
-	18, 2707, 2705, 2704, 2702, 9, 2700, 2699, 2698, 4,
-	7, 2697, 3, 171, 2696, 2695, 2694, 2693, 37, 106,
-	2691, 140, 151, 2689, 2688, 70, 2687, 2686, 2685, 249,
-	2684, 2683, 2682, 2681, 2678, 2677, 2676, 2675, 2674, 2673,
-	74, 44, 2671, 2668, 2667, 2666, 55, 122, 2665, 2664,
-	2663, 2662, 31, 145, 2661, 16, 2660, 26, 24, 33,
-	2657, 100, 2656, 15, 155, 2655, 2654, 17, 2653, 2652,
-	11, 13, 2650, 2649, 86, 2640, 67, 43, 131, 90,
-	2639, 63, 184, 111, 2638, 2634, 205, 204, 166, 2633,
-	113, 202, 222, 2632, 180, 2631, 2629, 2628, 2627, 2625,
-	2622, 882, 2621, 2620, 211, 42, 77, 92, 2617, 2611,
-	2610, 62, 129, 83, 87, 176, 2609, 162, 2594, 2593,
-	76, 2587, 2581, 2579, 2577, 2576, 160, 2574, 2573, 2570,
-	2556, 200, 216, 2555,
+	0, 143, 2890, 236, 159, 3426, 73, 232, 211, 207,
+	231, 3424, 3423, 2619, 2603, 3422, 3419, 3418, 3417, 3416,
+	3415, 3414, 3413, 3412, 3411, 3391, 3390, 3389, 3388, 3387,
+	3386, 3385, 3379, 3378, 3377, 3376, 3375, 3374, 3373, 3357,
+	3349, 229, 3348, 3343, 3321, 3320, 3319, 3318, 3317, 3315,
+	3314, 3313, 3310, 3308, 3295, 3294, 3293, 3289, 3287, 3286,
+	3285, 3270, 3269, 3268, 3267, 3265, 3264, 3263, 3257, 3256,
+	3255, 3253, 201, 3252, 2599, 3251, 3249, 3247, 3246, 3245,
+	3244, 3243, 3242, 3241, 221, 3240, 3238, 3237, 3236, 3235,
+	3234, 3220, 3219, 3218, 3217, 3214, 3213, 3211, 3210, 3208,
+	3206, 3205, 218, 3203, 149, 188, 3202, 3199, 3198, 3196,
+	3194, 3193, 223, 169, 202, 90, 58, 3192, 42, 3191,
+	209, 3190, 125, 3189, 122, 3188, 3186, 3185, 3184, 3183,
+	3181, 3180, 3179, 3178, 3175, 3174, 3173, 83, 3172, 3168,
+	127, 177, 233, 2306, 247, 228, 172, 151, 103, 3167,
+	2604, 3166, 146, 213, 130, 24, 3165, 153, 3164, 139,
+	44, 39, 235, 117, 45, 131, 108, 155, 203, 81,
+	3163, 89, 3162, 3161, 237, 170, 3160, 99, 3158, 3157,
+	3156, 3153, 179, 182, 3152, 3151, 3141, 3140, 100, 3138,
+	3137, 96, 3134, 56, 3133, 141, 3132, 136, 94, 106,
+	123, 112, 144, 76, 3128, 3126, 15, 2, 3125, 1,
+	3124, 18, 3123, 3121, 3116, 3114, 157, 3113, 3112, 105,
+	79, 3111, 3110, 3105, 49, 3103, 68, 3102, 57, 3100,
+	3099, 3098, 3097, 63, 3095, 3094, 13, 22, 25, 3093,
+	20, 3092, 142, 3091, 3090, 3089, 3088, 11, 3086, 208,
+	50, 88, 120, 3083, 384, 3082, 3080, 3079, 135, 3076,
+	533, 3074, 3073, 3071, 3069, 3068, 26, 3067, 206, 43,
+	3066, 85, 111, 97, 175, 178, 3065, 3063, 3061, 165,
+	80, 62, 0, 3060, 128, 3059, 3058, 3056, 241, 3048,
+	217, 214, 215, 302, 250, 238, 3047, 3046, 77, 3044,
+	140, 78, 118, 7, 3043, 163, 3042, 14, 166, 3041,
+	191, 3040, 148, 41, 119, 3039, 3038, 35, 240, 3037,
+	3036, 3034, 104, 3033, 3031, 162, 98, 3028, 3026, 3025,
+	38, 3023, 33, 29, 3022, 126, 3021, 226, 3020, 204,
+	132, 164, 154, 129, 210, 216, 75, 65, 3019, 1541,
+	124, 87, 23, 3018, 212, 3017, 133, 107, 3015, 69,
+	3013, 227, 374, 197, 3012, 167, 3, 3010, 37, 32,
+	3008, 10, 3000, 230, 156, 2998, 2997, 16, 2996, 27,
+	2995, 2994, 2992, 2991, 52, 2990, 4, 2989, 17, 19,
+	2988, 31, 199, 2987, 2986, 2985, 2984, 46, 116, 147,
+	109, 184, 2981, 2980, 84, 2979, 2978, 2977, 1994, 2976,
+	2975, 2974, 2972, 2971, 2970, 2969, 2968, 2967, 2966, 86,
+	48, 2961, 2959, 2958, 2957, 70, 114, 2956, 2955, 2954,
+	2953, 2951, 34, 161, 2950, 21, 2949, 30, 28, 36,
+	2947, 102, 2946, 9, 168, 2941, 2940, 12, 2939, 2937,
+	5, 8, 2936, 2935, 91, 2934, 74, 47, 138, 66,
+	2933, 71, 200, 113, 2931, 2928, 224, 225, 180, 2927,
+	115, 219, 239, 2926, 192, 2925, 2924, 2922, 2921, 2920,
+	2919, 925, 2918, 2917, 220, 55, 82, 93, 2915, 2914,
+	2913, 72, 137, 95, 92, 196, 2911, 174, 2910, 2907,
+	67, 2906, 2905, 2904, 2903, 2902, 2901, 171, 2900, 2898,
+	2897, 2896, 2895, 176, 222, 145, 2894,
 }
 
-//line mysql_sql.y:9411
+//line mysql_sql.y:9966
 type yySymType struct {
 	union interface{}
 	id    int
@@ -5411,6 +5962,11 @@ func (st *yySymType) alterTableOptionsUnion() tree.AlterTableOptions {
 	return v
 }
 
+func (st *yySymType) asOfClauseUnion() *tree.AsOfClause {
+	v, _ := st.union.(*tree.AsOfClause)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -5456,6 +6012,11 @@ func (st *yySymType) columnAttributesUnion() []tree.ColumnAttribute {
 	return v
 }
 
+func (st *yySymType) columnPositionUnion() *tree.ColumnPosition {
+	v, _ := st.union.(*tree.ColumnPosition)
+	return v
+}
+
 func (st *yySymType) columnTableDefUnion() *tree.ColumnTableDef {
 	v, _ := st.union.(*tree.ColumnTableDef)
 	return v
@@ -5576,6 +6137,11 @@ func (st *yySymType) fullOptUnion() bool {
 	return v
 }
 
+func (st *yySymType) fullTextSearchTypeUnion() tree.FullTextSearchType {
+	v, _ := st.union.(tree.FullTextSearchType)
+	return v
+}
+
 func (st *yySymType) funcArgUnion() tree.FunctionArg {
 	v, _ := st.union.(tree.FunctionArg)
 	return v
@@ -5691,6 +6257,21 @@ func (st *yySymType) joinTableExprUnion() *tree.JoinTableExpr {
 	return v
 }
 
+func (st *yySymType) jsonTableColumnUnion() *tree.JsonTableColumn {
+	v, _ := st.union.(*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnsUnion() []*tree.JsonTableColumn {
+	v, _ := st.union.([]*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableResponseUnion() *tree.JsonTableResponse {
+	v, _ := st.union.(*tree.JsonTableResponse)
+	return v
+}
+
 func (st *yySymType) keyPartUnion() *tree.KeyPart {
 	v, _ := st.union.(*tree.KeyPart)
 	return v
@@ -5921,6 +6502,16 @@ func (st *yySymType) selectExprsUnion() tree.SelectExprs {
 	return v
 }
 
+func (st *yySymType) selectLockInfoUnion() *tree.SelectLockInfo {
+	v, _ := st.union.(*tree.SelectLockInfo)
+	return v
+}
+
+func (st *yySymType) selectLockWaitUnion() tree.SelectLockWait {
+	v, _ := st.union.(tree.SelectLockWait)
+	return v
+}
+
 func (st *yySymType) selectStatementUnion() tree.SelectStatement {
 	v, _ := st.union.(tree.SelectStatement)
 	return v
@@ -6056,6 +6647,16 @@ func (st *yySymType) tlsOptionsUnion() []tree.TlsOption {
 	return v
 }
 
+func (st *yySymType) triggerEventUnion() tree.TriggerEvent {
+	v, _ := st.union.(tree.TriggerEvent)
+	return v
+}
+
+func (st *yySymType) triggerTimeUnion() tree.TriggerTime {
+	v, _ := st.union.(tree.TriggerTime)
+	return v
+}
+
 func (st *yySymType) tupleUnion() *tree.Tuple {
 	v, _ := st.union.(*tree.Tuple)
 	return v
@@ -6207,177 +6808,187 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 485, 489, 489, 5, 5, 2, 6, 6, 3,
+	0, 506, 510, 510, 5, 5, 2, 6, 6, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 105, 483, 483, 483, 484, 484, 102, 122,
-	121, 124, 124, 123, 123, 120, 120, 116, 119, 119,
-	118, 118, 117, 112, 114, 114, 113, 115, 115, 103,
-	91, 104, 433, 433, 432, 432, 431, 431, 386, 386,
-	430, 430, 430, 429, 429, 429, 428, 428, 427, 427,
-	426, 426, 424, 424, 425, 423, 422, 422, 422, 420,
-	420, 420, 416, 416, 418, 417, 417, 419, 411, 411,
-	414, 414, 412, 412, 412, 412, 415, 410, 410, 410,
-	409, 409, 90, 90, 90, 333, 333, 89, 89, 347,
-	347, 347, 347, 347, 345, 345, 345, 345, 345, 345,
-	344, 344, 343, 343, 348, 348, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 81, 81, 81, 81,
-	84, 84, 84, 85, 342, 342, 342, 82, 83, 83,
-	332, 332, 337, 337, 336, 336, 336, 336, 336, 336,
-	336, 336, 336, 336, 336, 336, 336, 341, 341, 341,
-	339, 339, 338, 338, 340, 340, 75, 75, 75, 78,
-	77, 331, 331, 331, 331, 331, 331, 331, 331, 331,
-	76, 76, 76, 76, 76, 76, 71, 71, 71, 71,
-	71, 70, 70, 72, 72, 329, 329, 328, 86, 86,
-	87, 487, 487, 486, 488, 488, 488, 488, 88, 94,
-	94, 94, 94, 94, 94, 94, 93, 93, 96, 96,
-	95, 97, 80, 80, 80, 80, 80, 79, 79, 79,
-	79, 79, 79, 79, 79, 79, 457, 457, 457, 459,
-	459, 268, 269, 490, 271, 267, 267, 267, 453, 453,
-	454, 455, 456, 456, 456, 92, 11, 11, 11, 11,
-	11, 11, 69, 74, 224, 224, 225, 225, 225, 225,
-	226, 226, 226, 226, 226, 227, 228, 228, 67, 73,
-	73, 73, 470, 470, 68, 477, 477, 389, 389, 282,
-	282, 281, 281, 281, 281, 281, 281, 281, 281, 281,
-	281, 281, 281, 281, 281, 281, 281, 393, 394, 278,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 46, 45,
-	45, 45, 318, 318, 44, 491, 491, 257, 257, 56,
-	55, 48, 57, 58, 59, 60, 61, 62, 43, 54,
-	54, 54, 54, 54, 54, 54, 54, 65, 65, 405,
-	405, 493, 493, 493, 63, 64, 388, 388, 388, 53,
-	52, 51, 50, 49, 49, 42, 42, 41, 41, 47,
-	128, 129, 275, 275, 275, 277, 277, 273, 492, 492,
-	360, 360, 276, 276, 40, 40, 40, 40, 66, 274,
-	274, 256, 272, 272, 272, 12, 12, 10, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	23, 24, 26, 326, 326, 323, 25, 18, 17, 20,
-	16, 19, 21, 22, 22, 9, 9, 9, 9, 13,
-	13, 14, 140, 140, 189, 189, 465, 465, 461, 461,
-	462, 462, 462, 463, 463, 464, 464, 98, 399, 399,
-	399, 399, 399, 399, 8, 163, 163, 162, 162, 398,
-	398, 398, 398, 398, 398, 330, 330, 442, 442, 442,
-	443, 161, 161, 156, 156, 400, 400, 296, 444, 444,
-	408, 408, 407, 407, 406, 406, 159, 159, 160, 160,
-	143, 143, 108, 108, 413, 413, 413, 413, 421, 421,
-	385, 385, 216, 216, 251, 251, 252, 252, 133, 133,
-	134, 134, 134, 134, 134, 134, 450, 450, 452, 452,
-	451, 158, 158, 154, 154, 155, 155, 155, 153, 153,
-	152, 151, 151, 150, 148, 148, 148, 149, 149, 149,
-	136, 136, 136, 135, 135, 135, 135, 135, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	137, 137, 458, 458, 458, 390, 390, 390, 396, 396,
-	233, 233, 234, 234, 232, 232, 138, 138, 139, 139,
-	139, 139, 231, 231, 230, 141, 141, 147, 146, 146,
-	142, 142, 142, 142, 241, 241, 240, 240, 240, 240,
-	101, 106, 106, 107, 166, 166, 239, 238, 238, 238,
-	165, 165, 164, 164, 157, 157, 145, 145, 145, 145,
-	237, 144, 235, 482, 482, 481, 481, 480, 478, 478,
-	478, 479, 479, 479, 479, 435, 435, 435, 435, 435,
-	262, 262, 262, 266, 266, 265, 265, 265, 265, 265,
-	270, 7, 7, 7, 7, 7, 30, 30, 30, 30,
-	30, 30, 30, 30, 36, 174, 175, 37, 176, 176,
-	177, 177, 178, 178, 179, 180, 181, 181, 181, 181,
-	35, 167, 167, 168, 168, 169, 169, 170, 171, 171,
-	171, 173, 172, 34, 27, 466, 469, 467, 467, 471,
-	471, 471, 472, 472, 472, 473, 473, 28, 125, 130,
-	130, 127, 132, 132, 132, 132, 132, 126, 468, 474,
-	474, 474, 327, 327, 324, 325, 325, 322, 321, 321,
-	321, 476, 476, 475, 475, 475, 263, 263, 29, 317,
-	317, 319, 320, 320, 320, 311, 311, 311, 311, 33,
-	315, 315, 316, 316, 316, 316, 316, 312, 312, 314,
-	314, 310, 310, 310, 310, 310, 32, 131, 131, 309,
-	309, 307, 307, 305, 305, 306, 306, 304, 304, 304,
-	308, 308, 31, 31, 31, 110, 109, 109, 109, 254,
-	254, 253, 253, 111, 38, 201, 201, 374, 374, 374,
-	374, 374, 392, 392, 392, 375, 375, 375, 376, 376,
-	376, 377, 377, 377, 377, 377, 391, 391, 349, 349,
-	350, 350, 350, 353, 353, 366, 366, 367, 367, 365,
-	365, 372, 372, 371, 371, 370, 370, 369, 369, 368,
-	368, 368, 368, 363, 363, 362, 362, 351, 351, 351,
-	351, 351, 352, 352, 352, 361, 361, 364, 364, 207,
-	207, 208, 208, 208, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 440, 440, 441, 210, 210, 210, 214,
-	214, 214, 214, 214, 214, 209, 209, 211, 211, 190,
-	190, 188, 188, 182, 182, 183, 183, 184, 184, 184,
-	187, 187, 185, 185, 186, 186, 186, 186, 335, 335,
-	438, 438, 439, 439, 434, 434, 434, 437, 437, 437,
-	437, 437, 436, 436, 191, 249, 249, 249, 264, 264,
-	264, 264, 248, 248, 248, 206, 206, 205, 205, 203,
-	203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-	203, 203, 203, 203, 334, 334, 279, 279, 280, 280,
-	223, 222, 222, 222, 222, 222, 220, 221, 219, 219,
-	219, 219, 219, 218, 218, 217, 217, 217, 313, 313,
-	215, 215, 213, 213, 213, 212, 212, 212, 373, 285,
-	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
-	285, 285, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 247, 288, 288, 293, 293, 449, 449,
-	448, 192, 192, 192, 193, 193, 193, 193, 193, 193,
-	193, 193, 193, 202, 202, 202, 358, 358, 358, 358,
-	358, 359, 359, 359, 356, 356, 357, 357, 297, 298,
-	298, 397, 397, 354, 354, 355, 246, 246, 246, 246,
-	246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
-	246, 246, 246, 404, 404, 404, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 460, 460, 460, 445, 445, 445, 446, 446,
-	446, 446, 446, 446, 446, 446, 446, 446, 446, 446,
-	447, 447, 447, 447, 447, 447, 447, 447, 447, 447,
-	447, 447, 447, 447, 447, 447, 447, 245, 245, 245,
-	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
-	244, 244, 244, 244, 244, 299, 299, 300, 300, 401,
-	401, 401, 401, 401, 401, 402, 402, 403, 403, 403,
-	403, 395, 395, 395, 395, 395, 395, 395, 395, 395,
-	395, 395, 395, 395, 395, 395, 395, 395, 395, 395,
-	395, 395, 395, 395, 395, 395, 395, 395, 395, 395,
-	286, 242, 242, 242, 301, 294, 294, 295, 295, 289,
-	289, 289, 289, 289, 289, 289, 291, 291, 291, 291,
-	291, 291, 291, 291, 291, 291, 291, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 290, 290,
-	292, 292, 303, 303, 303, 302, 302, 302, 302, 302,
-	302, 302, 204, 204, 204, 204, 283, 283, 283, 283,
-	283, 283, 283, 283, 283, 283, 283, 194, 194, 194,
-	194, 198, 198, 200, 200, 200, 200, 200, 200, 200,
-	200, 200, 200, 200, 200, 200, 200, 199, 199, 199,
-	199, 197, 197, 197, 197, 197, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 99, 100, 100, 196, 250, 250, 378, 378,
-	381, 381, 379, 379, 380, 382, 382, 382, 383, 383,
-	383, 384, 384, 384, 387, 387, 255, 255, 255, 261,
-	261, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258,
+	4, 4, 4, 110, 429, 429, 109, 504, 504, 504,
+	505, 505, 106, 129, 128, 131, 131, 130, 130, 127,
+	127, 123, 126, 126, 125, 125, 124, 119, 121, 121,
+	120, 122, 122, 107, 95, 108, 453, 453, 452, 452,
+	451, 451, 405, 405, 450, 450, 450, 449, 449, 449,
+	448, 448, 447, 447, 446, 446, 444, 444, 445, 443,
+	442, 442, 442, 440, 440, 440, 436, 436, 438, 437,
+	437, 439, 431, 431, 434, 434, 432, 432, 432, 432,
+	435, 430, 430, 430, 428, 428, 94, 94, 94, 351,
+	351, 93, 93, 365, 365, 365, 365, 365, 363, 363,
+	363, 363, 363, 363, 362, 362, 361, 361, 366, 366,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	85, 85, 85, 85, 88, 88, 88, 89, 360, 360,
+	360, 86, 87, 87, 350, 350, 355, 355, 354, 354,
+	354, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 359, 359, 359, 357, 357, 356, 356, 358, 358,
+	77, 77, 77, 77, 77, 80, 80, 80, 511, 511,
+	81, 82, 79, 349, 349, 349, 349, 349, 349, 349,
+	349, 349, 78, 78, 78, 78, 78, 78, 73, 73,
+	73, 73, 73, 72, 72, 74, 74, 347, 347, 346,
+	90, 90, 91, 508, 508, 507, 509, 509, 509, 509,
+	92, 98, 98, 98, 98, 98, 98, 98, 97, 97,
+	100, 100, 99, 101, 84, 84, 84, 84, 84, 83,
+	83, 83, 83, 83, 83, 83, 83, 83, 477, 477,
+	477, 479, 479, 286, 287, 512, 289, 285, 285, 285,
+	473, 473, 474, 475, 476, 476, 476, 96, 96, 11,
+	11, 11, 11, 11, 11, 71, 76, 76, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 241, 241, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 513, 513,
+	203, 203, 202, 202, 243, 243, 243, 243, 243, 244,
+	246, 246, 69, 75, 75, 75, 490, 490, 70, 497,
+	497, 408, 408, 300, 300, 299, 299, 299, 299, 299,
+	299, 299, 299, 299, 299, 299, 299, 299, 299, 299,
+	299, 412, 413, 296, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 48, 47, 47, 47, 336, 336, 46, 514,
+	514, 275, 275, 58, 57, 50, 59, 60, 61, 62,
+	63, 64, 45, 56, 56, 56, 56, 56, 56, 56,
+	56, 67, 67, 424, 424, 516, 516, 516, 65, 66,
+	407, 407, 407, 55, 54, 53, 52, 51, 51, 44,
+	44, 43, 43, 49, 135, 136, 293, 293, 293, 295,
+	295, 291, 515, 515, 379, 379, 294, 294, 42, 42,
+	42, 42, 68, 292, 292, 274, 290, 290, 290, 12,
+	12, 10, 15, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 23, 25, 27, 344, 344,
+	341, 26, 18, 17, 20, 20, 16, 19, 21, 22,
+	22, 24, 9, 9, 9, 9, 13, 13, 14, 147,
+	147, 198, 198, 485, 485, 481, 481, 482, 482, 482,
+	483, 483, 484, 484, 102, 418, 418, 418, 418, 418,
+	418, 8, 170, 170, 169, 169, 417, 417, 417, 417,
+	417, 417, 348, 348, 462, 462, 462, 463, 168, 168,
+	163, 163, 419, 419, 314, 464, 464, 427, 427, 426,
+	426, 425, 425, 166, 166, 167, 167, 150, 150, 113,
+	113, 433, 433, 433, 433, 441, 441, 404, 404, 233,
+	233, 269, 269, 270, 270, 140, 140, 141, 141, 141,
+	141, 141, 141, 114, 114, 114, 114, 200, 200, 115,
+	115, 115, 470, 470, 472, 472, 471, 165, 165, 161,
+	161, 162, 162, 162, 160, 160, 159, 158, 158, 157,
+	155, 155, 155, 156, 156, 156, 143, 143, 143, 142,
+	142, 142, 142, 142, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 144, 144, 478, 478,
+	478, 409, 409, 409, 415, 415, 251, 251, 252, 252,
+	250, 250, 145, 145, 146, 146, 146, 146, 249, 249,
+	248, 148, 148, 154, 153, 153, 149, 149, 149, 149,
+	259, 259, 258, 258, 258, 258, 105, 111, 111, 112,
+	173, 173, 257, 256, 256, 256, 172, 172, 171, 171,
+	164, 164, 152, 152, 152, 152, 255, 151, 151, 209,
+	209, 206, 206, 206, 206, 206, 208, 208, 208, 207,
+	210, 210, 210, 253, 503, 503, 502, 502, 501, 501,
+	500, 498, 498, 498, 499, 499, 499, 499, 455, 455,
+	455, 455, 455, 280, 280, 280, 284, 284, 283, 283,
+	283, 283, 283, 288, 7, 7, 7, 7, 7, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 37, 181,
+	182, 38, 40, 184, 184, 185, 185, 185, 183, 183,
+	186, 186, 187, 187, 188, 189, 190, 190, 190, 190,
+	36, 174, 174, 175, 175, 176, 176, 177, 178, 178,
+	178, 180, 179, 35, 35, 28, 486, 489, 487, 487,
+	491, 491, 491, 492, 492, 492, 493, 493, 29, 132,
+	137, 137, 134, 139, 139, 139, 139, 139, 133, 488,
+	494, 494, 494, 345, 345, 342, 343, 343, 340, 339,
+	339, 339, 496, 496, 495, 495, 495, 281, 281, 30,
+	335, 335, 337, 338, 338, 338, 329, 329, 329, 329,
+	34, 333, 333, 334, 334, 334, 334, 334, 334, 330,
+	330, 332, 332, 328, 328, 328, 328, 328, 328, 33,
+	138, 138, 327, 327, 325, 325, 323, 323, 324, 324,
+	322, 322, 322, 326, 326, 32, 32, 32, 117, 116,
+	116, 116, 272, 272, 271, 271, 118, 39, 218, 218,
+	393, 393, 393, 393, 393, 411, 411, 411, 394, 394,
+	394, 395, 395, 395, 396, 396, 396, 396, 396, 410,
+	410, 368, 368, 369, 369, 369, 372, 372, 385, 385,
+	386, 386, 384, 384, 391, 391, 390, 390, 389, 389,
+	388, 388, 387, 387, 387, 387, 382, 382, 381, 381,
+	370, 370, 370, 370, 370, 371, 371, 371, 380, 380,
+	383, 383, 224, 224, 225, 225, 225, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 460, 460, 461, 227,
+	227, 227, 231, 231, 231, 231, 231, 231, 226, 226,
+	228, 228, 199, 199, 197, 197, 191, 191, 192, 192,
+	193, 193, 193, 196, 196, 194, 194, 195, 195, 195,
+	195, 353, 353, 458, 458, 459, 459, 454, 454, 454,
+	457, 457, 457, 457, 457, 457, 456, 456, 201, 267,
+	267, 267, 282, 282, 282, 282, 266, 266, 266, 223,
+	223, 222, 222, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 352, 352,
+	297, 297, 298, 298, 240, 239, 239, 239, 239, 239,
+	237, 238, 236, 236, 236, 236, 236, 235, 235, 234,
+	234, 234, 331, 331, 232, 232, 230, 230, 230, 229,
+	229, 229, 392, 303, 303, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 305, 305, 305, 305,
+	305, 305, 305, 305, 305, 305, 305, 305, 305, 305,
+	305, 305, 305, 305, 305, 305, 305, 305, 305, 305,
+	265, 265, 306, 306, 311, 311, 469, 469, 468, 204,
+	204, 204, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 219, 219, 219, 377, 377, 377, 377, 377, 378,
+	378, 378, 375, 375, 376, 376, 315, 316, 316, 416,
+	416, 373, 373, 374, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 423, 423, 423, 367, 367, 367, 367, 367, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 480, 480, 480, 465, 465,
+	465, 466, 466, 466, 466, 466, 466, 466, 466, 466,
+	466, 466, 466, 467, 467, 467, 467, 467, 467, 467,
+	467, 467, 467, 467, 467, 467, 467, 467, 467, 467,
+	263, 263, 263, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 317, 317,
+	318, 318, 420, 420, 420, 420, 420, 420, 421, 421,
+	422, 422, 422, 422, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 304, 260, 260, 260, 319, 312, 312,
+	313, 313, 307, 307, 307, 307, 307, 307, 307, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	302, 302, 302, 302, 302, 302, 302, 302, 302, 302,
+	302, 302, 308, 308, 310, 310, 321, 321, 321, 320,
+	320, 320, 320, 320, 320, 320, 221, 221, 221, 221,
+	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
+	301, 211, 211, 211, 211, 215, 215, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 216, 216, 216, 216, 214, 214, 214, 214, 214,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 103, 104, 104,
+	213, 268, 268, 397, 397, 400, 400, 398, 398, 399,
+	401, 401, 401, 402, 402, 402, 403, 403, 403, 406,
+	406, 273, 273, 273, 279, 279, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
 }
 
 var yyR2 = [...]int{
@@ -6386,147 +6997,158 @@ var yyR2 = [...]int{
 	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 0, 1, 1, 0, 1, 5, 2,
-	2, 6, 9, 6, 9, 4, 7, 8, 0, 1,
-	1, 2, 4, 6, 1, 2, 4, 0, 2, 10,
-	10, 2, 0, 2, 1, 3, 3, 3, 0, 2,
-	1, 3, 5, 0, 2, 3, 1, 3, 1, 1,
-	1, 3, 1, 1, 1, 1, 0, 3, 3, 0,
-	3, 3, 0, 1, 3, 0, 1, 3, 0, 2,
-	1, 2, 3, 4, 3, 3, 1, 0, 1, 1,
-	0, 1, 8, 5, 7, 0, 3, 8, 5, 1,
-	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 2, 2, 2,
-	1, 2, 1, 2, 2, 1, 2, 1, 1, 2,
-	2, 1, 1, 1, 3, 2, 2, 2, 2, 2,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	3, 4, 4, 6, 1, 1, 1, 2, 4, 6,
-	1, 4, 1, 3, 3, 4, 4, 4, 4, 3,
-	3, 2, 4, 4, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 2,
-	2, 0, 1, 4, 2, 4, 1, 5, 3, 2,
-	1, 2, 2, 4, 4, 5, 2, 1, 3, 4,
-	4, 1, 2, 9, 7, 1, 3, 3, 1, 1,
-	3, 1, 3, 2, 1, 2, 1, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 4, 4, 2, 4,
-	3, 3, 1, 1, 1, 1, 1, 2, 3, 4,
-	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	2, 1, 1, 1, 1, 6, 1, 1, 1, 1,
-	1, 1, 7, 4, 1, 3, 2, 2, 2, 1,
-	2, 2, 2, 3, 2, 3, 1, 1, 7, 7,
-	8, 8, 0, 4, 7, 0, 3, 0, 2, 0,
-	1, 1, 1, 1, 4, 2, 2, 3, 3, 4,
-	5, 3, 4, 4, 2, 2, 2, 3, 0, 1,
+	1, 1, 1, 5, 0, 1, 4, 0, 1, 1,
+	0, 1, 5, 2, 2, 6, 9, 6, 9, 4,
+	7, 8, 0, 1, 1, 2, 4, 6, 1, 2,
+	4, 0, 2, 10, 10, 2, 0, 2, 1, 3,
+	3, 3, 0, 2, 1, 3, 5, 0, 2, 3,
+	1, 3, 1, 1, 1, 3, 1, 1, 1, 1,
+	0, 3, 3, 0, 3, 3, 0, 1, 3, 0,
+	1, 3, 0, 2, 1, 2, 3, 4, 3, 3,
+	1, 0, 1, 1, 0, 1, 8, 5, 7, 0,
+	3, 8, 5, 1, 3, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 4, 1, 3,
+	1, 2, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 2, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 2, 2, 2, 1, 2, 1, 2, 2, 1,
+	2, 1, 1, 2, 2, 1, 1, 1, 3, 2,
+	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 3, 4, 4, 6, 1, 1,
+	1, 2, 4, 6, 1, 4, 1, 3, 3, 4,
+	4, 4, 4, 3, 3, 2, 4, 4, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 3, 1, 3,
+	1, 1, 1, 1, 1, 2, 4, 5, 0, 1,
+	2, 3, 2, 0, 1, 4, 2, 4, 1, 5,
+	3, 2, 1, 2, 2, 4, 4, 5, 2, 1,
+	3, 4, 4, 1, 2, 9, 7, 1, 3, 3,
+	1, 1, 3, 1, 3, 2, 1, 2, 1, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	2, 4, 3, 3, 1, 1, 1, 1, 1, 2,
+	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 2, 1, 1, 1, 1, 3, 6, 1,
+	1, 1, 1, 1, 1, 7, 4, 4, 5, 4,
+	3, 3, 3, 7, 6, 8, 8, 1, 3, 2,
+	4, 3, 4, 5, 5, 2, 2, 1, 0, 1,
+	0, 1, 1, 2, 2, 2, 2, 3, 2, 3,
+	1, 1, 7, 7, 8, 8, 0, 4, 7, 0,
+	3, 0, 2, 0, 1, 1, 1, 1, 4, 2,
+	2, 3, 3, 4, 5, 3, 4, 4, 2, 2,
+	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 2,
-	5, 5, 0, 2, 7, 0, 1, 0, 1, 5,
-	5, 3, 3, 2, 4, 4, 4, 4, 4, 1,
-	1, 1, 3, 3, 1, 1, 1, 6, 8, 0,
-	1, 1, 1, 1, 5, 5, 0, 1, 1, 3,
-	3, 3, 4, 6, 7, 4, 4, 7, 8, 3,
-	3, 3, 0, 2, 2, 0, 2, 2, 1, 1,
-	1, 1, 0, 1, 4, 4, 5, 4, 3, 1,
-	3, 1, 1, 3, 5, 2, 3, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	4, 4, 4, 1, 3, 1, 4, 6, 4, 4,
-	4, 3, 6, 3, 5, 1, 1, 2, 2, 11,
-	8, 9, 1, 3, 2, 4, 0, 2, 0, 1,
-	1, 1, 1, 0, 1, 0, 1, 4, 2, 1,
-	5, 4, 4, 2, 5, 0, 2, 1, 3, 2,
-	1, 5, 4, 4, 2, 0, 5, 0, 1, 3,
-	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
-	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
-	2, 1, 0, 8, 0, 4, 7, 4, 0, 2,
-	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
-	4, 3, 4, 5, 4, 5, 2, 3, 1, 3,
-	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
-	3, 1, 3, 3, 0, 1, 1, 0, 2, 2,
-	3, 3, 3, 1, 3, 3, 3, 3, 1, 2,
-	2, 1, 2, 2, 1, 2, 2, 1, 2, 2,
-	7, 7, 1, 1, 1, 0, 1, 1, 1, 1,
-	0, 2, 0, 3, 0, 2, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 3, 1, 1, 1,
-	4, 4, 4, 3, 2, 2, 2, 3, 2, 3,
-	4, 1, 3, 4, 0, 2, 1, 1, 2, 2,
-	0, 1, 2, 4, 1, 3, 1, 3, 2, 3,
-	1, 4, 3, 0, 1, 1, 2, 5, 2, 2,
-	2, 0, 2, 3, 3, 0, 1, 3, 1, 3,
-	0, 1, 2, 1, 1, 0, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 1, 7, 1, 3,
+	1, 1, 4, 2, 5, 5, 0, 2, 7, 0,
+	1, 0, 1, 5, 5, 3, 3, 2, 4, 4,
+	4, 4, 4, 1, 1, 1, 3, 3, 1, 1,
+	1, 6, 8, 0, 1, 1, 1, 1, 5, 5,
+	0, 1, 1, 3, 3, 3, 4, 6, 7, 4,
+	4, 7, 8, 3, 3, 3, 0, 2, 2, 0,
+	2, 2, 1, 1, 1, 1, 0, 1, 4, 4,
+	5, 4, 3, 1, 3, 1, 1, 3, 5, 2,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 4, 4, 4, 1, 3,
+	1, 4, 6, 4, 4, 5, 4, 3, 6, 3,
+	5, 4, 1, 1, 2, 2, 11, 8, 9, 1,
+	3, 2, 4, 0, 2, 0, 1, 1, 1, 1,
+	0, 1, 0, 1, 4, 2, 1, 5, 4, 4,
+	2, 5, 0, 2, 1, 3, 2, 1, 5, 4,
+	4, 2, 0, 5, 0, 1, 3, 3, 1, 3,
+	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
+	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
+	8, 0, 4, 7, 4, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 5, 3, 4,
+	6, 4, 5, 0, 4, 4, 4, 0, 2, 0,
+	1, 2, 2, 3, 1, 3, 6, 0, 3, 0,
+	1, 2, 4, 4, 0, 1, 3, 1, 3, 3,
+	0, 1, 1, 0, 2, 2, 3, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 7, 7, 1, 1,
+	1, 0, 1, 1, 1, 1, 0, 2, 0, 3,
+	0, 2, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 3, 1, 1, 1, 4, 4, 4, 3,
+	2, 2, 2, 3, 2, 3, 4, 1, 3, 4,
+	0, 2, 1, 1, 2, 2, 0, 1, 2, 4,
+	1, 3, 1, 3, 2, 3, 1, 4, 10, 1,
+	3, 3, 5, 5, 7, 6, 0, 1, 2, 3,
+	1, 2, 1, 4, 0, 6, 0, 1, 1, 2,
+	5, 2, 2, 2, 0, 2, 3, 3, 0, 1,
+	3, 1, 3, 0, 1, 2, 1, 1, 0, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 7, 1,
+	1, 7, 12, 1, 1, 1, 1, 1, 1, 3,
 	0, 1, 1, 3, 1, 3, 0, 1, 1, 1,
 	12, 1, 3, 0, 1, 1, 3, 1, 1, 2,
-	4, 1, 1, 7, 7, 1, 4, 1, 1, 3,
-	4, 3, 0, 1, 1, 0, 2, 7, 8, 0,
-	2, 6, 0, 2, 2, 3, 3, 4, 1, 0,
-	2, 2, 1, 3, 2, 1, 3, 2, 1, 3,
-	2, 0, 1, 3, 4, 3, 1, 1, 4, 1,
-	3, 1, 1, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 6, 0, 4, 1,
-	1, 0, 3, 0, 1, 1, 2, 4, 4, 4,
-	0, 1, 11, 9, 11, 2, 2, 4, 5, 1,
-	3, 0, 3, 5, 10, 0, 2, 0, 3, 2,
-	4, 3, 0, 2, 1, 0, 2, 3, 0, 2,
-	3, 0, 3, 2, 4, 3, 0, 1, 0, 6,
-	0, 3, 5, 0, 4, 0, 3, 1, 3, 4,
-	5, 0, 3, 1, 3, 2, 3, 1, 2, 0,
-	4, 6, 5, 0, 2, 0, 2, 4, 5, 4,
-	5, 1, 5, 6, 5, 0, 3, 0, 1, 0,
-	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	4, 1, 1, 7, 8, 7, 1, 4, 1, 1,
+	3, 4, 3, 0, 1, 1, 0, 2, 7, 8,
+	0, 2, 6, 0, 2, 2, 3, 3, 4, 1,
+	0, 2, 2, 1, 3, 2, 1, 3, 2, 1,
+	3, 2, 0, 1, 3, 4, 3, 1, 1, 4,
+	1, 3, 1, 1, 1, 1, 0, 1, 1, 1,
+	11, 0, 2, 3, 2, 3, 1, 1, 3, 1,
+	3, 3, 4, 0, 2, 2, 2, 2, 2, 6,
+	0, 4, 1, 1, 0, 3, 0, 1, 1, 2,
+	4, 4, 4, 0, 1, 11, 9, 11, 2, 2,
+	4, 5, 1, 3, 0, 3, 5, 10, 0, 2,
+	0, 3, 2, 4, 3, 0, 2, 1, 0, 2,
+	3, 0, 2, 3, 0, 3, 2, 4, 3, 0,
+	1, 0, 6, 0, 3, 5, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 4, 6, 5, 0, 2, 0, 2,
+	4, 5, 4, 5, 1, 5, 6, 5, 0, 3,
+	0, 1, 0, 1, 1, 3, 2, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
+	3, 3, 3, 4, 5, 4, 1, 3, 3, 0,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 3, 0, 1, 1, 3,
+	1, 1, 1, 7, 7, 2, 1, 7, 7, 8,
+	5, 0, 1, 0, 1, 1, 1, 1, 3, 3,
+	1, 1, 1, 1, 1, 1, 0, 1, 3, 1,
+	3, 5, 1, 1, 1, 1, 1, 3, 5, 0,
+	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
+	2, 2, 2, 1, 5, 6, 4, 1, 1, 2,
+	0, 1, 1, 2, 5, 0, 1, 1, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 1, 3, 3, 1,
+	1, 3, 5, 2, 2, 2, 2, 1, 1, 2,
+	5, 6, 6, 6, 9, 1, 1, 1, 1, 1,
+	4, 5, 0, 2, 0, 1, 1, 2, 4, 1,
+	2, 2, 1, 2, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 5, 0, 1, 3, 0, 1, 0,
+	2, 0, 1, 6, 8, 6, 5, 5, 6, 6,
+	6, 6, 5, 6, 6, 6, 6, 6, 6, 6,
+	6, 1, 1, 1, 0, 4, 7, 3, 3, 4,
+	4, 6, 8, 6, 4, 5, 4, 4, 4, 3,
+	4, 6, 6, 7, 4, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 0, 1, 1, 3, 1, 1, 1,
-	7, 7, 2, 1, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
-	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
-	5, 6, 4, 1, 1, 2, 0, 1, 1, 2,
-	5, 0, 1, 1, 2, 2, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
-	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
-	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
-	1, 1, 1, 4, 0, 2, 0, 1, 1, 2,
-	4, 1, 2, 2, 1, 2, 2, 1, 2, 2,
-	2, 2, 2, 0, 1, 1, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 5, 0, 1, 3, 0,
-	1, 0, 2, 0, 1, 6, 8, 6, 5, 5,
-	6, 6, 6, 6, 5, 6, 6, 6, 6, 6,
-	6, 6, 6, 1, 1, 1, 4, 4, 6, 8,
-	6, 4, 5, 4, 4, 4, 3, 4, 6, 6,
-	7, 4, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 8,
-	4, 2, 3, 2, 4, 2, 2, 4, 6, 2,
-	2, 4, 6, 4, 2, 0, 1, 2, 3, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
+	2, 2, 8, 4, 2, 3, 2, 4, 2, 2,
+	4, 6, 2, 2, 4, 6, 4, 2, 0, 1,
+	2, 3, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 1, 1, 3, 0, 1,
+	1, 3, 3, 3, 3, 3, 2, 1, 1, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 1,
+	3, 4, 4, 5, 4, 5, 3, 4, 6, 5,
+	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
+	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 4, 1, 2, 2, 3, 5,
+	1, 1, 3, 0, 1, 0, 3, 0, 3, 3,
+	0, 3, 5, 0, 3, 5, 0, 1, 1, 0,
+	1, 1, 2, 2, 0, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 0, 1, 1, 3, 0, 1, 1, 3, 3,
-	3, 3, 3, 2, 1, 1, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 1, 3, 4, 4,
-	5, 4, 5, 3, 4, 5, 6, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 3, 1, 1,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
-	2, 1, 2, 2, 2, 2, 2, 2, 2, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
-	4, 1, 2, 3, 5, 1, 1, 3, 0, 1,
-	0, 3, 0, 3, 3, 0, 3, 5, 0, 3,
-	5, 0, 1, 1, 0, 1, 1, 2, 2, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6551,619 +7173,664 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -485, -489, -2, -5, 552, -1, -4, -100, -75,
-	-7, -102, -103, -8, -98, -9, -10, -12, -79, -93,
-	-95, -97, -96, -39, -11, -92, -70, -71, -81, -86,
-	-89, -90, -91, -104, -99, -101, -133, -105, 545, -76,
-	-77, -78, -30, -29, -28, -27, -125, 551, 408, 12,
-	457, -13, -14, -450, -15, 237, -267, -268, -269, -271,
-	-490, -40, -41, -42, -49, -50, -51, -52, -53, -63,
-	-64, -65, -43, -44, -45, -48, -46, -56, -55, -57,
-	-58, -59, -60, -61, -62, -47, -128, -129, -66, -68,
-	-67, -73, -69, -74, -127, 212, -72, 61, -82, -83,
-	-84, -85, -87, -88, 339, 345, 398, 544, 46, -134,
-	-136, 555, 140, 141, 143, 144, -31, -32, -33, -34,
-	-35, -36, -38, -37, 208, 14, 490, -16, -19, -17,
-	-20, -18, -25, -26, -24, -21, -23, -126, -22, 232,
-	231, 37, 295, 296, 297, 343, 230, 209, 13, 30,
-	41, 321, -135, 67, 210, -137, 10, 557, -6, -3,
-	-2, -112, -116, -120, -123, -124, -121, -122, -4, -100,
-	98, 222, 546, -264, 335, 547, 549, 548, 70, 78,
-	-258, -260, 339, 345, 544, 493, 494, 495, 496, 497,
-	498, 499, 500, 501, 502, 503, 504, 505, 506, 507,
-	508, 509, 510, 511, 512, 513, 514, 516, 517, 518,
+	-1000, -506, -510, -2, -5, 577, -1, -4, -104, -77,
+	-7, -106, -107, -8, -102, -9, -10, -12, -83, -97,
+	-99, -101, -100, -41, -11, -96, -72, -73, -85, -90,
+	-93, -94, -95, -108, -103, -105, -140, -109, -110, 570,
+	-78, -79, -80, -81, -82, -31, -30, -29, -28, -132,
+	576, 428, 12, 477, -13, -14, -470, -15, 247, -285,
+	-286, -287, -289, -512, -42, -43, -44, -51, -52, -53,
+	-54, -55, -65, -66, -67, -45, -46, -47, -50, -48,
+	-58, -57, -59, -60, -61, -62, -63, -64, -49, -135,
+	-136, -68, -70, -69, -75, -71, -76, -134, 219, -74,
+	66, -86, -87, -88, -89, -91, -92, 359, 365, 418,
+	569, 46, -141, -143, 580, 261, 146, 147, 149, 150,
+	55, 156, -32, -33, -34, -35, -36, -37, -39, -38,
+	-40, 215, 14, 510, -16, -19, -17, -20, -18, -26,
+	-27, -25, -21, -23, -133, -22, -24, 242, 241, 37,
+	313, 314, 315, 363, 240, 216, 13, 30, 41, 341,
+	-142, 72, 217, -144, 10, 582, -6, -3, -2, -119,
+	-123, -127, -130, -131, -128, -129, -4, -104, 103, 232,
+	571, -282, 355, 572, 574, 573, 75, 83, -276, -278,
+	359, 365, 569, 261, 513, 514, 515, 516, 517, 518,
 	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	529, 460, 531, 532, 533, 534, 319, 320, 487, 249,
-	273, 369, 276, 283, 140, 159, 153, 181, 173, 148,
-	253, 288, 254, 77, 143, 443, 88, 420, 395, 146,
-	268, 270, 272, 275, 255, 169, 164, 267, 251, 162,
-	256, 39, 257, 186, 258, 259, 492, 416, 318, 422,
-	280, 393, 163, 417, 271, 419, 188, 192, 434, 435,
-	157, 158, 424, 437, 185, 229, 42, 489, 241, 438,
-	190, 184, 180, 446, 284, 282, 183, 156, 179, 252,
-	50, 194, 193, 195, 389, 390, 391, 392, 260, 261,
-	333, 433, 176, 165, 323, 149, 22, 441, 236, 421,
-	346, 262, 277, 285, 189, 191, 243, 248, 491, 397,
-	247, 281, 439, 161, 240, 269, 235, 442, 150, 348,
-	263, 144, 274, 436, 445, 49, 361, 155, 147, 226,
-	141, 245, 250, 11, 264, 265, 266, 287, 286, 278,
-	177, 242, 182, 167, 154, 178, 142, 244, 444, 362,
-	542, 321, 378, 175, 172, 246, 219, 440, 423, 145,
-	382, 363, 170, 289, 538, 539, 540, 334, 317, 290,
-	291, 168, 233, 414, 415, 292, 294, 388, 310, 364,
-	396, 370, 365, 201, 298, 426, 428, 187, 541, 299,
-	300, 301, 418, 302, 303, 304, 305, -340, -338, -264,
-	544, 345, 339, 321, -176, -264, 556, -143, 38, -188,
-	-264, -143, -72, -14, -13, -135, -136, -188, 216, -272,
-	23, 393, -80, 394, 212, 67, -264, -9, -8, -98,
-	-70, -133, -270, -264, 295, 295, -270, 216, -264, 247,
-	377, -389, 222, -344, -317, 248, -343, -319, -346, -320,
-	31, 208, 210, 209, 244, 14, 343, 218, 12, 10,
-	344, 230, 24, 25, 27, 13, 345, 347, 28, 348,
-	351, 352, 353, 41, 356, 357, 237, 70, 78, 73,
-	255, -175, -264, -295, -289, 95, 238, -291, -284, -285,
-	-287, -430, -424, -283, 67, 122, 123, 130, 96, -286,
-	-373, 35, 98, 497, 458, -243, -244, -245, -246, -247,
-	-264, -425, -423, 73, 79, 82, 85, 86, 84, 83,
-	166, 81, 74, 134, 135, -136, 70, -445, 505, -259,
-	526, 531, 532, 533, 534, 525, 46, -395, -403, 215,
-	-401, 133, 171, 233, 167, 12, 128, 388, 168, 502,
-	522, 460, 506, 529, 499, 500, 494, 495, 496, 498,
-	507, 509, 521, -404, 517, 527, 528, 515, 530, 72,
-	71, 520, 519, 508, 503, 504, 510, 493, 501, 511,
-	512, 518, 523, 524, 327, 88, 328, 329, 450, 322,
-	330, 222, 393, 55, 331, 332, 333, 334, 335, 457,
-	336, 56, 337, 326, 237, 378, 338, 170, 187, 462,
-	461, 463, 454, 451, 449, 452, 453, 455, 456, 513,
-	514, 516, -106, -107, 536, -152, -153, -236, 19, 6,
-	7, 8, 9, -483, 395, 491, 145, 142, -331, 145,
-	94, 150, 149, -331, 298, 243, 340, 219, 319, 378,
-	302, 247, -311, -309, -391, 292, 288, 217, 227, 226,
-	89, 450, 215, 354, -461, -462, 205, 206, 207, -452,
-	483, -451, -264, 329, 26, 216, 340, 243, 428, 426,
-	427, 429, 430, 431, 432, -54, -405, -388, 423, 422,
-	-276, 415, 421, 413, 425, 416, 320, 304, 303, 208,
-	553, 484, -255, 364, 396, 447, 448, 341, 397, 434,
-	436, 417, 88, 174, 171, 219, 216, 319, 450, 378,
-	302, -461, 247, 377, 40, -337, 388, -336, -338, 434,
-	435, 436, 446, 71, 72, 433, -255, 88, 414, 414,
-	-153, -236, -152, -134, -136, -101, -450, 217, 319, 378,
-	247, 218, 216, 219, 450, 295, 340, 243, 302, -390,
-	-458, 31, -396, 202, 203, 204, 32, 33, -1, 102,
-	557, -289, -289, -6, 558, -6, -289, -264, -264, 137,
-	-194, -198, -195, -197, -196, -200, -199, 171, 172, 133,
-	175, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 30, 187, 233, 167, 168, 169, 170, 188, 153,
-	173, 488, 196, 154, 197, 155, 198, 156, 199, 157,
-	158, 200, 159, 162, 163, 164, 161, 136, 67, 136,
-	73, -159, 239, -188, 136, -159, -153, -152, -153, -188,
-	-248, -264, 395, 105, -80, -80, 394, -453, -454, -455,
-	-457, 212, 394, 393, 136, 15, -270, -270, 65, -188,
-	-319, 247, -344, -317, 35, 64, 137, 220, 137, 64,
-	67, 341, 319, 378, 342, 450, 216, 354, 219, 247,
-	355, 319, 378, 216, 219, 450, 247, 319, 216, 219,
-	378, 247, 355, 413, 414, 219, 26, 346, 349, 350,
-	414, -409, 446, 137, 94, 91, 92, 93, -289, 112,
-	-302, 105, 106, 107, 108, 109, 110, 111, 119, 118,
-	129, 122, 123, 124, 125, 126, 127, 128, 120, 121,
-	115, 95, 113, 117, 114, 97, -136, -289, -295, 46,
-	-287, -287, -287, -287, -373, -293, -289, 67, 67, 136,
-	73, -289, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, -402, 67, 67, -299, -300, 67,
-	67, -283, -248, 67, 73, 73, 67, 67, 67, 73,
-	-300, -300, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	-153, 137, 67, -108, 38, -155, 21, -137, -136, 20,
-	31, 32, 31, 32, 31, 32, 31, 32, 79, 439,
-	490, 148, 149, 150, -174, -264, -176, -167, -264, -307,
-	222, -307, -307, -307, -307, 218, -307, 216, 216, 216,
-	-307, -463, 152, 137, -452, -158, 67, -275, 113, 117,
-	23, 245, 245, 245, -275, 282, -491, -492, 15, 115,
-	-273, -492, -273, -273, -275, -493, 218, 424, 42, 246,
-	245, -154, -155, -154, 418, 414, -360, 419, 420, -277,
-	-492, -276, -275, -275, -276, -275, -275, -275, 216, 219,
-	450, 302, 554, -491, -491, 30, 30, -389, -188, -389,
-	484, -256, -264, -389, -389, -464, 221, -319, 247, 247,
-	137, 105, 23, -339, 105, 116, -338, -338, -338, -338,
-	-339, -339, -209, 40, -263, 133, -264, 73, -209, 40,
-	-487, -486, -188, -154, -137, -136, 68, 68, 68, -389,
-	-389, -389, -389, -389, -389, -389, -389, -270, -167, -176,
-	222, -389, -138, -139, 124, -289, -264, -138, -3, -114,
-	-113, 99, 100, 102, 547, 335, 546, 550, 544, -338,
-	40, -384, 362, 361, -378, -380, 67, -379, 67, -379,
-	-379, -379, 67, 67, -381, 67, -381, -381, -378, -382,
-	67, -382, -383, 67, -382, -264, -294, -295, -264, 38,
-	-398, 46, -133, 67, 30, 67, -264, -399, 46, -133,
-	67, 30, -154, -108, -155, 136, 79, 73, -80, 68,
-	137, -456, 85, 86, -459, 185, 177, -264, -94, 73,
-	-7, -8, -9, -10, -39, -70, -133, -426, -424, 67,
-	31, 387, 64, 15, -345, 216, 450, 340, 243, 219,
-	319, -343, -326, -323, -321, -263, -319, -322, -321, -348,
-	-248, 414, -109, 399, 294, -289, -289, -289, -289, -289,
-	84, 95, 317, 85, 86, -284, -303, 31, 290, 291,
-	-285, -285, -285, -285, -285, -285, -285, -285, -285, -285,
-	-285, -285, -292, -301, -373, 67, 115, 113, 117, 114,
-	97, -287, -287, -285, -285, 68, 137, -449, -448, 99,
-	-289, -289, -264, -446, -447, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 331, 326, 332, 330,
-	322, 338, 333, 334, 170, 481, 482, 475, 476, 477,
-	478, 479, 480, -294, -294, -289, -446, -294, -242, 32,
-	31, -295, -295, -295, 68, -289, -460, 315, 314, 316,
-	-156, -264, -294, 68, 68, 68, 79, -295, -295, -294,
-	-285, -294, -447, -242, -242, -295, -295, -242, -242, -242,
-	-242, 124, -242, -242, -242, -242, -242, -242, -242, -242,
-	68, -154, -107, -407, -406, -289, 40, 537, -108, -289,
-	-151, -150, -289, -484, 73, 440, 441, 146, 149, 148,
-	34, 67, 67, 136, -188, 95, -466, -264, -327, -324,
-	-321, -264, -317, -264, -264, -307, -307, -307, -188, -464,
-	-451, 34, -157, -264, -232, 16, -287, -287, -321, 247,
-	-491, -275, -275, -257, -256, -277, -272, -277, -277, -232,
-	-492, -275, -275, -277, -273, -232, -264, 414, -232, -232,
-	-360, -274, -264, -274, -307, -256, -257, -257, -188, -224,
-	-225, 213, 210, 209, -229, 359, 249, -308, 253, 77,
-	254, 395, 255, 218, 257, 258, 259, 229, 260, 261,
-	262, 388, 263, 264, 265, 266, 342, 6, 305, 40,
-	-466, -466, 30, 30, -327, -264, -146, -141, -145, -142,
-	-147, -235, -237, -144, 67, -188, -136, -264, 31, 387,
-	-342, 387, 31, -317, -336, -332, 73, 388, -322, -341,
-	64, 133, -406, -339, -339, -339, -339, -341, -341, 132,
-	137, -488, 439, 440, 205, -108, -190, -188, -466, -326,
-	-317, -264, -190, -190, -264, 67, 35, -264, -231, 137,
-	-230, 15, -265, -264, 34, 73, 136, -231, -115, -113,
-	101, -289, -6, 546, -289, -6, -6, -289, -6, -289,
-	-387, 363, 79, 79, -250, 73, -250, 79, 79, 79,
-	68, 73, -330, 64, -400, -296, -444, 536, -161, 68,
-	-156, -442, -443, -156, -160, -264, -400, -161, 68, -442,
-	-108, -108, -264, 23, -80, -454, 136, 137, -157, -345,
-	-325, -322, -347, 124, -264, -333, 137, 490, 559, 71,
-	220, -476, -475, 379, 68, 137, -410, 221, 457, 73,
-	560, 201, 84, 317, 85, 86, -373, -295, -292, -287,
-	-287, -285, -285, -290, 234, -290, 94, -289, -288, -448,
-	101, -289, 34, 137, 65, 136, 68, 68, 15, 15,
-	68, -289, 68, 68, 68, 68, 15, 15, -289, 68,
-	136, 68, 68, 68, 68, 65, 68, 137, 68, 137,
-	-295, -289, 68, 68, -289, -289, -289, -295, 68, -289,
-	-289, -289, -289, -289, -289, -289, -289, -355, 409, 137,
-	68, 73, 137, 22, 137, -148, 36, 37, 147, 150,
-	150, 149, -175, -177, -178, -179, -180, -181, 115, 138,
-	139, -168, -169, -170, -171, -194, -248, 172, 175, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	233, 167, 168, 169, 170, 188, 153, 173, 154, 155,
-	156, 157, 158, 159, 162, 163, 164, 161, -264, -158,
-	35, -469, 366, -477, 137, 40, -475, 450, -310, 65,
-	-131, 15, -188, -188, -188, -201, 34, 15, -140, -189,
-	-264, 67, 68, 137, -289, -318, 65, -264, -257, -232,
-	-232, -188, -264, -232, -232, -275, -277, -277, -273, 136,
-	-256, -158, 137, -184, -191, -185, -187, -248, -280, -186,
-	227, -439, 225, 223, 89, 228, 279, 90, 218, -226,
-	218, 90, 224, 228, 223, -227, 218, -261, 105, -261,
-	-255, 132, -261, -261, -261, -261, 256, 256, -261, -261,
-	-261, -261, -261, -261, -261, -261, -261, -261, -261, -261,
-	-261, -261, 67, -470, 366, 30, 325, 325, -477, -132,
-	319, 30, -238, -239, -240, -241, 53, 57, 59, 54,
-	55, 56, 60, 30, 137, -262, -266, 34, -264, 73,
-	-262, -136, -141, -146, -262, 67, 220, 67, 105, -341,
-	-341, -341, -341, 40, -263, -486, 446, 440, 137, 64,
-	-168, -176, -232, -139, -141, -264, 73, -264, 124, -232,
-	102, -6, 100, -119, -118, -117, 103, 544, 550, 102,
-	102, 102, 68, 68, 68, 137, 68, 68, 68, 137,
-	68, 137, -413, 419, 39, 137, 67, 68, 137, 46,
-	137, 105, 68, 137, 68, 46, 136, 395, -264, -424,
-	68, -347, 137, 220, 136, 136, -323, 345, -263, -325,
-	20, 490, -248, 38, -254, -253, 73, 560, 68, -290,
-	-290, 94, -287, -284, 68, 102, -289, 100, -192, -194,
-	361, 362, -193, -199, 133, 171, 233, 170, 169, 167,
-	361, 362, -209, -264, -289, -289, 68, -289, -289, 15,
-	-264, -209, -285, -289, -153, 68, -354, -355, -354, 68,
-	68, 68, 68, -354, 68, 68, 68, 68, 68, 68,
-	68, 68, 67, -406, -413, -289, -289, -150, -149, 43,
-	150, 353, 68, 137, -248, 68, 137, -194, 34, -472,
-	421, 368, -261, -282, -281, 321, 41, -393, 388, 374,
-	375, -324, 247, -264, 64, 309, 310, 311, 312, -305,
-	-306, -304, -308, -466, 67, 67, 67, -374, 299, -194,
-	-188, -140, -264, 15, 137, -465, 136, -1, -264, -317,
-	-275, -232, -492, -232, -275, -275, -277, -264, 34, -225,
-	-194, -186, -438, -439, -307, -264, 90, -438, 90, 67,
-	-264, -264, -264, 90, 90, -264, 79, 79, -261, -261,
-	79, 73, 73, 73, -261, -261, 79, 73, -266, 79,
-	79, 79, 79, 40, 73, -214, 40, 267, 271, 268,
-	269, 270, 79, 40, 79, 40, 79, 40, -264, 67,
-	-440, -441, 73, -472, -261, 325, -338, 105, -282, -130,
-	77, 31, -162, 213, 210, -466, -329, -328, -248, -145,
-	-145, -145, -145, 53, 53, 53, 58, 53, 58, 53,
-	-240, -329, -147, -158, -266, 68, -482, -481, -480, -478,
-	61, 221, 62, -294, -325, -278, 73, -332, -188, -188,
-	68, -234, 17, 136, -234, 98, -6, -115, -117, -289,
-	-6, -289, 546, 335, 547, 73, 79, 79, -421, 405,
-	400, 402, 90, -296, -408, -407, 46, -133, -156, -400,
-	-443, -406, -264, 46, -133, -400, -264, 79, 15, -322,
-	-317, 124, 124, -264, 346, -333, 73, 367, 73, 216,
-	561, 137, 105, -254, -284, -289, 68, -202, 158, 157,
-	-202, 68, -379, -379, -378, -381, -378, -202, -202, 68,
-	68, 23, 68, 68, 68, -289, 68, 68, 137, -397,
-	459, -354, -354, -354, -354, -354, -354, -354, -354, -354,
-	-354, -354, -354, -354, -298, -297, 239, -421, 44, 45,
-	73, 73, -179, -194, 214, -170, 40, -133, -473, 77,
-	-467, 73, -264, -474, 77, 369, 135, 323, 40, 370,
-	371, 385, 318, 79, 79, 376, -468, -264, -163, 319,
-	-188, -304, -255, 132, 258, 302, -182, -183, -184, -182,
-	-182, -375, 301, 20, 79, 123, -159, 65, 136, -141,
-	-189, -264, 124, 68, -232, -264, -232, -232, -275, -133,
-	-206, -205, -203, 84, 95, 40, 359, -204, 77, 132,
-	272, 250, 273, -223, -279, 64, 365, 223, 89, 90,
-	347, -280, -436, -264, -434, -436, -264, -434, -434, -307,
-	-289, -264, -228, 307, 308, -209, -209, 73, 73, -210,
-	250, -190, 68, 137, 105, -473, -467, 105, -339, 73,
-	-474, 73, 137, -162, -162, -232, 137, 105, -165, -164,
-	64, 65, -166, 64, -164, 53, 53, -232, -480, -479,
-	23, -439, -439, -439, 68, 68, -233, 18, 20, 124,
-	-233, 102, 100, 102, 102, -264, 68, 68, -385, 538,
-	-417, -419, 400, 20, 20, 13, 68, -400, -400, -317,
-	-333, 388, -188, -253, 73, 561, -289, 68, -289, 68,
-	73, -153, 20, -385, -172, -194, -283, 73, -471, 379,
-	73, 73, 79, 40, 79, 135, 372, -394, -130, -162,
-	67, -261, -261, -261, -264, 68, 137, 68, 68, -376,
-	238, 79, 123, 79, 123, 79, -262, -141, -264, -232,
-	-465, 136, -232, -232, -203, 84, -285, 73, -211, -263,
-	133, -212, 40, 271, 267, -213, 40, 251, 252, -215,
-	67, 279, 13, 90, 90, -188, 67, 67, 65, 289,
-	67, 67, -436, 68, 251, 252, 68, -441, 73, -471,
-	73, -341, -466, -153, -328, -406, -289, 67, -289, 67,
-	53, 19, 17, -289, -295, 222, -6, 547, 335, -216,
-	539, 73, 20, 73, -415, 73, -329, -111, -411, -360,
-	68, 68, -354, -357, -356, -359, 406, 281, 412, -295,
-	-216, 489, 20, 490, 322, 40, 79, 40, 373, -312,
-	-314, -248, 67, -209, -211, 73, -207, -208, -229, -184,
-	-110, -109, -207, -377, 141, 79, 123, 79, 79, -232,
-	-232, 79, 67, -401, -313, 67, -312, -312, -437, 309,
-	310, 311, 313, 312, -437, -312, -312, 67, -335, -334,
-	280, 95, -154, -157, -435, -264, 223, 20, 20, -264,
-	-264, -251, 540, 79, 73, 402, -386, 541, -420, 405,
-	-414, -412, 400, 401, 402, 403, 68, -358, 97, 372,
-	376, -289, -251, -173, -264, 73, 367, 73, 322, 68,
-	137, -378, -289, -349, 239, 137, -229, -111, -349, -392,
-	149, 300, 490, 79, 123, 79, -153, 68, -289, -299,
-	-218, -217, 486, -312, 68, 68, 68, 68, -312, 280,
-	68, 68, 137, 67, 20, 73, -422, 221, -418, -419,
-	404, -412, 20, 402, 20, 20, -358, 536, 410, 411,
-	410, 411, 34, 388, -315, -314, -148, 68, -350, 288,
-	20, -229, -350, 300, 79, 123, 79, -154, 68, -222,
-	-220, -221, 64, 417, 277, 278, 68, -315, -315, -315,
-	-315, 68, -264, 223, -252, -264, -415, -429, 67, 79,
-	-417, -416, -418, 20, -415, 20, -415, -415, 94, 73,
-	-316, 229, 77, 490, 307, 308, -148, 20, -351, 281,
-	282, -352, -364, 284, 79, -334, -221, 64, -220, 64,
-	14, 13, -223, 68, 137, -433, 30, 68, -428, -427,
-	-249, -423, -264, 405, 406, 73, -415, -358, -261, 73,
-	306, -248, 67, -362, 285, 67, -360, 67, -360, 90,
-	310, -219, 274, 275, 30, 149, -219, -264, -432, -431,
-	-430, 68, 137, 136, 79, -264, -348, -353, 286, 79,
-	-285, 67, -285, 67, -361, 283, 67, 84, 40, 276,
-	137, 105, -427, -264, 68, -366, 67, 20, 68, -348,
-	68, -348, 67, 105, -285, -431, 40, -289, 136, -367,
-	-365, 239, -352, 68, 68, 68, -348, 79, 68, -264,
-	68, 137, -264, -363, 287, 68, -365, -368, 46, 79,
-	-372, -369, 67, -229, 241, 115, -372, -229, -371, -370,
-	286, 242, 67, 68, 137, -264, 238, 67, -295, -370,
-	-369, -295, 68, 68,
+	529, 530, 531, 532, 533, 534, 536, 537, 538, 539,
+	540, 541, 542, 543, 544, 545, 546, 547, 548, 549,
+	480, 551, 552, 553, 554, 331, 332, 339, 340, 224,
+	507, 267, 291, 389, 294, 301, 146, 165, 159, 187,
+	179, 154, 223, 271, 306, 272, 82, 149, 463, 93,
+	440, 415, 152, 286, 288, 290, 293, 273, 175, 170,
+	285, 269, 168, 274, 39, 275, 192, 276, 277, 512,
+	257, 436, 338, 442, 298, 413, 169, 437, 289, 439,
+	195, 199, 454, 455, 163, 164, 444, 457, 191, 239,
+	42, 509, 251, 458, 197, 190, 186, 466, 54, 302,
+	300, 189, 162, 185, 270, 50, 222, 201, 200, 202,
+	409, 410, 411, 412, 278, 279, 353, 453, 182, 558,
+	559, 560, 171, 343, 155, 52, 22, 461, 246, 441,
+	366, 280, 295, 303, 196, 198, 253, 266, 511, 417,
+	265, 299, 459, 167, 250, 260, 262, 287, 245, 462,
+	156, 368, 281, 150, 292, 55, 456, 465, 49, 381,
+	53, 161, 153, 236, 147, 263, 268, 11, 282, 283,
+	284, 305, 304, 296, 183, 252, 188, 173, 160, 184,
+	148, 254, 464, 382, 567, 341, 398, 181, 178, 258,
+	264, 194, 229, 259, 460, 443, 151, 402, 383, 176,
+	307, 563, 564, 565, 354, 337, 308, 309, 174, 243,
+	434, 435, 310, 312, 408, 328, 384, 416, 390, 385,
+	208, 316, 446, 448, 193, 566, 317, 318, 319, 438,
+	320, 321, 322, 323, -358, -356, -282, 569, 365, 261,
+	359, 341, -183, -282, 581, -150, 38, -197, -282, -150,
+	-74, -14, -13, -142, -143, -197, 226, -290, 23, 413,
+	-84, 414, 219, 72, -282, -9, -8, -102, -72, -140,
+	-288, -282, 313, 313, -288, 226, -282, 265, 397, -408,
+	232, -362, -335, 266, -361, -337, -364, -338, 31, 215,
+	217, 216, 254, 14, 363, 228, 12, 10, 364, 240,
+	24, 25, 27, 13, 365, 367, 28, 368, 371, 372,
+	373, 41, 376, 377, 247, 75, 83, 78, 273, -182,
+	-282, -313, -307, 100, 248, -309, -302, -303, -305, -450,
+	-444, -301, 72, 128, 129, 136, 101, -304, -392, 35,
+	103, 517, 478, 506, -261, -262, -263, -264, -265, -282,
+	-445, -443, 78, 84, 87, 90, 91, 89, 88, 172,
+	86, 79, 140, 141, -143, 75, -465, 525, -277, 546,
+	551, 552, 553, 554, 545, 46, -414, -422, 225, -420,
+	139, 177, 243, 173, 12, 134, 408, 174, 522, 542,
+	480, 526, 549, 519, 520, 514, 515, 516, 518, 527,
+	529, 541, -423, 537, 547, 548, 535, 550, 77, 76,
+	540, 539, 528, 523, 524, 530, 513, 521, 531, 532,
+	538, 543, 544, 347, 93, 348, 349, 470, 342, 350,
+	232, 413, 60, 351, 352, 353, 354, 355, 477, 356,
+	61, 357, 346, 247, 398, 358, 176, 193, 482, 481,
+	483, 474, 471, 469, 472, 473, 475, 476, 533, 534,
+	536, -111, -112, 561, -159, -160, -254, 19, 6, 7,
+	8, 9, -504, 415, 511, 260, 151, 148, -349, 151,
+	99, 156, 155, -349, -511, 151, -282, 55, 316, 253,
+	254, 360, 229, 260, 339, 398, 320, 265, -329, -327,
+	-410, 310, 306, 227, 237, 236, 94, 470, 225, 374,
+	-481, -482, 212, 213, 214, -472, 503, -471, -282, 349,
+	26, 226, 360, 253, 448, 446, 447, 449, 450, 451,
+	452, -56, -424, -407, 443, 442, -294, 435, 441, 433,
+	445, 436, 340, 322, 321, 215, 578, 504, -273, 384,
+	416, 467, 468, 361, 417, 454, 456, 437, 93, 180,
+	177, 229, 226, 339, 470, 398, 320, -481, 265, 397,
+	40, -355, 408, -354, -356, 454, 455, 456, 466, 76,
+	77, 453, -273, 93, 434, 434, -160, -254, -159, -141,
+	-143, -105, -470, 227, 339, 398, 265, 228, 226, 229,
+	260, 470, 313, 360, 253, 254, 320, -409, -478, 31,
+	-415, 209, 210, 211, 32, 33, -1, 107, 582, -307,
+	-307, -6, 583, -6, -307, -282, -282, 143, -211, -215,
+	-212, -214, -213, -217, -216, 177, 178, 139, 181, 183,
+	184, 185, 186, 187, 188, 189, 190, 191, 192, 30,
+	193, 194, 243, 173, 174, 175, 176, 195, 159, 179,
+	508, 203, 160, 204, 161, 205, 162, 206, 163, 164,
+	207, 165, 168, 169, 170, 167, 142, 72, 142, 78,
+	-166, 249, -197, 142, -166, -160, -159, -160, -197, -266,
+	-282, 415, 110, -84, -84, 414, -473, -474, -475, -477,
+	219, 414, 413, 142, 15, -288, -288, 70, -197, -337,
+	265, -362, -335, 35, 69, 143, 230, 143, 69, 72,
+	361, 339, 398, 362, 470, 226, 374, 229, 265, 375,
+	339, 398, 226, 229, 470, 265, 339, 226, 229, 398,
+	265, 375, 433, 434, 229, 26, 366, 369, 370, 434,
+	-428, 466, 143, 99, 96, 97, 98, -307, 117, -320,
+	110, 111, 112, 113, 114, 115, 116, 125, 124, 135,
+	128, 129, 130, 131, 132, 133, 134, 126, 127, 120,
+	100, 118, 122, 119, 123, 102, 555, 556, -143, -307,
+	-313, 46, -305, -305, -305, -305, -392, -311, -307, 72,
+	72, 72, 142, 78, -307, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, -421, 72, 72,
+	-317, -318, 72, 72, -301, -266, 72, 78, 78, 72,
+	72, 72, 78, -318, -318, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, -160, 143, 72, -113, 38, -162, 21,
+	-144, -143, 20, 31, 32, 31, 32, 31, 32, 31,
+	32, 84, 229, 459, 510, 154, 155, 156, 230, -282,
+	-181, -282, -183, -325, 232, -174, -282, -325, 229, -325,
+	-325, -325, -325, 228, -325, 226, 226, 226, -325, -483,
+	158, 143, -472, -165, 72, -293, 118, 122, 23, 263,
+	263, 263, -293, 300, -514, -515, 15, 120, -291, -515,
+	-291, -291, -293, -516, 228, 444, 42, 264, 263, -161,
+	-162, -161, 438, 434, -379, 439, 440, -295, -515, -294,
+	-293, -293, -294, -293, -293, -293, 226, 229, 470, 320,
+	579, -514, -514, 30, 30, -408, -197, -408, 504, -274,
+	-282, -408, -408, -484, 231, -337, 265, 265, 143, 110,
+	23, -357, 110, 121, -356, -356, -356, -356, -357, -357,
+	-226, 40, -281, 139, -282, 78, -226, 40, -508, -507,
+	-197, -161, -144, -143, 73, 73, 73, -408, -408, -408,
+	-408, -408, -408, -408, 229, -408, -288, -174, -183, 232,
+	-408, -408, -145, -146, 130, -307, -282, -145, -3, -121,
+	-120, 104, 105, 107, 572, 355, 571, 575, 569, -356,
+	40, -403, 382, 381, -397, -399, 72, -398, 72, -398,
+	-398, -398, 72, 72, -399, -400, 72, -400, -400, -397,
+	-401, 72, -401, -402, 72, -401, -282, -312, -313, -282,
+	38, -417, 46, -140, 72, 30, 72, -282, -418, 46,
+	-140, 72, 30, -161, -113, -162, 142, 84, 78, -84,
+	73, 143, -476, 90, 91, -479, 191, 183, -282, -98,
+	78, -7, -8, -9, -10, -41, -72, -140, -446, -444,
+	72, 31, 407, 69, 15, -363, 226, 470, 360, 253,
+	229, 339, -361, -344, -341, -339, -281, -337, -340, -339,
+	-366, -266, 434, -116, 419, 312, -307, -307, -307, -307,
+	-307, 89, 100, 337, 90, 91, -302, -321, 31, 308,
+	309, -303, -303, -303, -303, -303, -303, -303, -303, -303,
+	-303, -303, -303, -310, -319, -392, 72, 120, 118, 122,
+	119, 102, -305, -305, -303, 51, -303, 78, 78, 73,
+	143, -469, -468, 104, -307, -307, -366, -282, -466, -467,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 351, 346, 352, 350, 342, 358, 353, 354, 176,
+	501, 502, 495, 496, 497, 498, 499, 500, -312, -312,
+	-307, -466, -312, -260, 32, 31, -313, -313, -313, 73,
+	-307, -480, 335, 334, 336, -163, -282, -312, 73, 73,
+	73, 84, -313, -313, -312, -303, -312, -467, -260, -260,
+	-313, -313, -260, -260, -260, -260, 130, -260, -260, -260,
+	-260, -260, -260, -260, -260, 73, -161, -112, -426, -425,
+	-307, 40, 562, -113, -307, -158, -157, -307, -505, 78,
+	-197, 460, 461, 152, 155, 154, -282, 55, 34, 72,
+	-197, 100, 72, 142, -197, -325, -486, -282, -345, -342,
+	-339, -282, -335, -282, -282, -325, -325, -325, -197, -484,
+	-471, 34, -164, -282, -250, 16, -305, -305, -339, 265,
+	-514, -293, -293, -275, -274, -295, -290, -295, -295, -250,
+	-515, -293, -293, -295, -291, -250, -282, 434, -250, -250,
+	-379, -292, -282, -292, -325, -274, -275, -275, -197, -241,
+	-245, -242, 220, 217, 247, 250, 257, 222, 223, 218,
+	216, -247, 379, 267, -326, 271, 82, 272, 415, 273,
+	228, 275, 276, 277, 239, 278, 279, 280, 408, 281,
+	282, 283, 284, 362, 6, 323, 40, -486, -486, 30,
+	30, -345, -282, -153, -148, -152, -149, -154, -253, -255,
+	-151, 72, -197, -143, -282, 557, 31, 407, -360, 407,
+	31, -335, -354, -350, 78, 408, -340, -359, 69, 139,
+	-425, -357, -357, -357, -357, -359, -359, 138, 143, -509,
+	459, 460, 212, -114, 23, 41, -199, -197, -486, -344,
+	-335, -282, -199, -199, -408, -282, 72, 35, -197, -282,
+	-249, 143, -248, 15, -283, -282, 34, 78, 142, -249,
+	-122, -120, 106, -307, -6, 571, -307, -6, -6, -307,
+	-6, -307, -406, 383, 84, 84, -268, 78, -268, 84,
+	84, 84, 73, 78, -348, 69, -419, -314, -464, 561,
+	-168, 73, -163, -462, -463, -163, -167, -282, -419, -168,
+	73, -462, -114, -113, -282, 23, -84, -474, 142, 143,
+	-164, -363, -343, -340, -365, 130, -282, -351, 143, 510,
+	586, 76, 230, -496, -495, 399, 73, 143, -430, 231,
+	477, 78, 584, 208, 89, 337, 90, 91, -392, -313,
+	-310, -305, -305, -303, -303, -308, 244, -308, 72, 99,
+	-307, -306, -468, 106, -307, 34, 143, 70, 73, 142,
+	73, 73, 15, 15, 73, -307, 73, 73, 73, 73,
+	15, 15, -307, 73, 142, 73, 73, 73, 73, 70,
+	73, 143, 73, 143, -313, -307, 73, 73, -307, -307,
+	-307, -313, 73, -307, -307, -307, -307, -307, -307, -307,
+	-307, -374, 429, 143, 73, 78, 143, 22, 143, -155,
+	36, 37, -429, 262, 153, 156, 156, 155, -282, -182,
+	-186, -187, -188, -189, -190, 120, 144, 145, -184, 255,
+	224, 35, -175, -176, -177, -178, -211, -266, 178, 181,
+	183, 184, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 243, 173, 174, 175, 176, 195, 159, 179,
+	160, 161, 162, 163, 164, 165, 168, 169, 170, 167,
+	-282, -165, -197, -489, 386, -497, 143, 40, -495, 470,
+	-328, 70, -138, 15, -197, -197, -197, -218, 34, 15,
+	-147, -198, -282, 72, 73, 143, -307, -336, 70, -282,
+	-275, -250, -250, -197, -282, -250, -250, -293, -295, -295,
+	-291, 142, -274, -165, 143, 249, -193, 234, -201, -194,
+	-196, -266, -298, -195, 237, -459, 235, 233, 94, 238,
+	297, 95, 228, 249, -243, 228, 95, 234, 238, 233,
+	249, 249, 249, -513, 234, -513, 234, -244, 228, -279,
+	110, -279, -273, 138, -279, -279, -279, -279, 274, 274,
+	-279, -279, -279, -279, -279, -279, -279, -279, -279, -279,
+	-279, -279, -279, -279, 72, -490, 386, 30, 345, 345,
+	-497, -139, 339, 30, -256, -257, -258, -259, 58, 62,
+	64, 59, 60, 61, 65, 30, 143, -280, -284, 34,
+	-282, 78, -280, -143, -148, -153, -503, 584, 72, 72,
+	230, 72, 110, -359, -359, -359, -359, 40, -281, -507,
+	466, 460, -113, 13, 49, 120, 143, 69, -199, -175,
+	-183, -250, -146, -148, -282, 78, -282, 130, -250, 107,
+	-6, 105, -126, -125, -124, 108, 569, 575, 107, 107,
+	107, 73, 73, 73, 143, 73, 73, 73, 143, 73,
+	143, -433, 439, 39, 143, 72, 73, 143, 46, 143,
+	110, 73, 143, 73, 46, -113, 142, 415, -282, -444,
+	73, -365, 143, 230, 142, 142, -341, 365, -281, -343,
+	20, 510, -266, 38, -272, -271, 78, 584, 73, -308,
+	-308, 99, -305, -307, -302, 73, 107, -307, 105, -204,
+	-211, 381, 382, -205, -216, 139, 177, 243, 176, 175,
+	173, 381, 382, -226, 507, -282, -374, -307, -307, 73,
+	-307, -307, 15, -282, -226, -303, -307, -160, 73, -373,
+	-374, -373, 73, 73, 73, 73, -373, 73, 73, 73,
+	73, 73, 73, 73, 73, 72, -425, -433, -307, -307,
+	-157, -156, 43, 156, 373, 73, 143, -266, -185, 12,
+	13, 14, 73, 143, -211, 34, -165, -492, 441, 388,
+	-279, -300, -299, 341, 41, -412, 408, 394, 395, -342,
+	265, -282, 69, 327, 328, 329, 330, 331, -323, -324,
+	-322, -326, -486, 72, 72, 72, -393, 317, -211, -197,
+	-147, -282, 15, 143, -485, 142, -1, -282, -335, -293,
+	-250, -515, -250, -293, -293, -295, -282, 34, -242, 220,
+	217, 72, 303, -201, -202, 44, 224, -211, -195, -458,
+	-459, -325, -282, 95, -458, 95, 72, -167, -282, -282,
+	-282, 95, 95, -167, 31, -167, -282, -201, -282, -282,
+	-282, 84, 84, -279, -279, 84, 78, 78, 78, -279,
+	-279, 84, 78, -284, 84, 84, 84, 84, 40, 78,
+	-231, 40, 285, 289, 286, 287, 288, 84, 40, 84,
+	40, 84, 40, -282, 72, -460, -461, 78, -492, -279,
+	345, -356, 110, -300, -137, 82, 31, -169, 220, 217,
+	-486, -347, -346, -266, -152, -152, -152, -152, 58, 58,
+	58, 63, 58, 63, 58, -258, -347, -154, -165, -284,
+	73, -280, 34, -312, -307, -343, -296, 78, -350, -200,
+	51, -200, 49, -197, -197, 73, -252, 17, 142, -252,
+	103, -6, -122, -124, -307, -6, -307, 571, 355, 572,
+	78, 84, 84, -441, 425, 420, 422, 95, -314, -427,
+	-426, 46, -140, -163, -419, -463, -425, -282, 46, -140,
+	-419, -282, 84, 15, -340, -335, 130, 130, -282, 366,
+	-351, 78, 387, 78, 226, 585, 143, 110, -272, -302,
+	73, -307, 73, -219, 164, 163, -219, 73, -398, -398,
+	-397, -400, -397, -219, -219, 73, 72, 73, 23, 73,
+	73, 73, -307, 73, 73, 143, -416, 479, -373, -373,
+	-373, -373, -373, -373, -373, -373, -373, -373, -373, -373,
+	-373, -316, -315, 249, -441, 44, 45, 78, 78, -188,
+	-211, 69, 221, -177, 40, -140, 34, -493, 82, -487,
+	78, -282, -494, 82, 389, 141, 343, 40, 390, 391,
+	405, 338, 84, 84, 396, -488, -282, -170, 339, -197,
+	-322, -273, 138, 276, 320, -191, -192, -193, -201, -191,
+	-191, -394, 319, 20, 84, 129, -166, 70, 142, -148,
+	-198, -282, 130, 73, -250, -282, -250, -250, -293, -140,
+	-386, -384, 249, 84, -203, -202, -282, -223, -222, -220,
+	89, 100, 40, 379, -221, 82, 138, 290, 268, 291,
+	-240, -297, 69, 385, 233, 94, 95, 367, -298, -456,
+	-282, -454, -456, -282, -454, -454, -325, -307, -282, 38,
+	510, -203, -201, 230, -246, 325, 326, -226, -226, 78,
+	78, -227, 268, -199, 73, 143, 110, -493, -487, 110,
+	-357, 78, -494, 78, 143, -169, -169, -250, 143, 110,
+	-172, -171, 69, 70, -173, 69, -171, 58, 58, -250,
+	-502, -501, -500, -498, 66, 231, 67, 51, 73, 143,
+	73, -115, 52, 53, -199, -115, 50, -251, 18, 20,
+	130, -251, 107, 105, 107, 107, -282, 73, 73, -404,
+	563, -437, -439, 420, 20, 20, 13, 73, -419, -419,
+	-335, -351, 408, -197, -271, 78, 585, -303, -307, 73,
+	-307, 73, 78, -160, 20, -404, -197, -179, -211, -301,
+	-140, 78, -491, 399, 78, 78, 84, 40, 84, 141,
+	392, -413, -137, -169, 72, -279, -279, -279, -282, 73,
+	143, 73, 73, -395, 248, 84, 129, 84, 129, 84,
+	-280, -148, -282, -250, -485, 142, -250, -250, 73, 143,
+	-282, -220, 89, -303, 78, -228, -281, 139, -229, 40,
+	289, 285, -230, 40, 269, 270, -232, 72, 297, 13,
+	95, 95, -197, 72, 72, 70, 307, 72, 72, -456,
+	73, 72, 226, -203, -282, 269, 270, 73, -461, 78,
+	-491, 78, -359, -486, -160, -346, -425, -307, 72, -307,
+	-500, -499, 23, -459, -459, -459, 174, 78, 54, -307,
+	-313, 232, -6, 572, 355, -233, 564, 78, 20, 78,
+	-435, 78, -347, -118, -431, -379, -367, 120, 510, 73,
+	73, -373, -376, -375, -378, 426, 299, 432, -313, -233,
+	23, 509, 20, 510, 342, 40, 84, 40, 393, -330,
+	-332, -266, 72, -226, -228, 78, -224, -225, -247, -193,
+	-117, -116, -224, -396, 147, 84, 129, 84, 84, -250,
+	-250, -384, -387, 46, 84, 72, -420, -331, 72, -330,
+	-330, -457, 327, 328, 329, 333, 330, 331, -457, -330,
+	-330, 72, -353, -352, 298, 100, -386, -197, -161, -164,
+	72, 58, 19, 17, -307, 440, -282, -282, -269, 565,
+	84, 78, 422, -405, 566, -440, 425, -434, -432, 420,
+	421, 422, 423, 73, 65, 508, 511, 73, -377, 102,
+	392, 396, -307, -269, 256, -180, -282, 78, 387, 78,
+	342, 73, 143, -397, -307, -368, 249, 143, -247, -118,
+	-368, -411, 155, 318, 510, 84, 129, 84, -160, -391,
+	-388, 72, -247, 251, 120, 73, -307, -317, -235, -234,
+	506, -330, 73, 73, 73, 73, -330, 298, 73, 510,
+	259, 73, -455, -282, 233, 20, 20, 585, 72, 72,
+	20, 78, -442, 231, -438, -439, 424, -432, 20, 422,
+	20, 20, 509, 50, 512, -377, 561, 430, 431, 430,
+	431, 561, 34, 408, -333, -332, -155, 73, -369, 306,
+	20, -247, -369, 318, 84, 129, 84, -161, -391, -247,
+	-390, -389, 304, 252, 72, 73, -239, -237, -238, 69,
+	437, 295, 296, 73, -333, -333, -333, -333, 73, 258,
+	258, 73, 143, -209, -206, -282, 558, -270, -282, -435,
+	-449, 72, 84, -437, -436, -438, 20, -435, 20, -435,
+	-435, 50, 99, 78, 78, -334, 239, 82, 510, 325,
+	326, 332, -155, 20, -370, 299, 300, -371, -383, 302,
+	84, 73, 143, -282, 248, 72, -313, -352, -238, 69,
+	-237, 69, 14, 13, -240, -282, 233, 73, 143, 23,
+	-211, 560, 78, 73, 143, -453, 30, 73, -448, -447,
+	-267, -443, -282, 425, 426, 78, -435, 510, -377, -279,
+	78, 324, -279, -266, 72, -381, 303, 72, -379, 72,
+	-379, 95, 328, -389, -388, -313, 73, -236, 292, 293,
+	30, 155, -236, 73, -206, 559, 560, 35, 78, 440,
+	-282, -452, -451, -450, 73, 143, 142, 511, 84, -282,
+	84, -366, -372, 304, 84, -303, 72, -303, 72, -380,
+	301, 72, 73, 89, 40, 294, 78, 560, 440, 72,
+	143, 110, -447, -282, 512, 73, -385, 72, 20, 73,
+	-366, 73, -366, 72, 110, -303, -208, -207, -210, 89,
+	40, -282, 78, 72, -209, -451, 40, -307, 142, -386,
+	-371, 73, 73, 73, -366, 84, 73, -207, 69, 78,
+	-209, 73, -282, 73, -382, 305, 73, -282, 73, 84,
 }
 
 var yyDef = [...]int{
 	23, -2, 1, 2, 3, 19, 4, 20, 21, 22,
 	24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
 	34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
-	44, 45, 46, 47, 48, 49, 50, 51, 0, 246,
-	247, 248, 751, 752, 753, 754, 755, 0, 0, 0,
-	0, 525, 526, 0, 497, 0, 0, 0, 0, 0,
-	0, 390, 391, 392, 393, 394, 395, 396, 397, 398,
-	399, 400, 401, 402, 403, 404, 405, 406, 407, 408,
-	409, 410, 411, 412, 413, 414, 415, 416, 417, 336,
-	337, 338, 339, 340, 341, 0, 271, 267, 206, 207,
-	208, 209, 278, 279, 367, 0, 0, 0, 0, 608,
-	-2, 53, 260, 0, 251, 251, 756, 757, 758, 759,
-	760, 761, 762, 763, -2, 538, 0, 498, 499, 500,
-	501, 502, 503, 504, 505, 506, 507, 508, 509, 325,
-	326, 327, 321, 322, 324, 323, -2, 0, 538, 0,
-	0, 0, 628, 0, 0, 643, 665, 23, 0, 7,
-	9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
-	0, 0, 19, 0, 19, 0, 0, 0, 1048, 1049,
-	1050, 1051, -2, 1516, 1494, 1665, 1666, 1667, 1668, 1669,
-	1670, 1671, 1672, 1673, 1674, 1675, 1676, 1677, 1678, 1679,
-	1680, 1681, 1682, 1683, 1684, 1685, 1686, 1687, 1688, 1689,
-	1690, 1691, 1692, 1693, 1694, 1695, 1696, 1697, 1698, 1699,
-	1700, 1701, 1702, 1703, 1704, 1705, 1461, 1462, 1463, 1464,
-	1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474,
-	1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484,
-	1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1495,
-	1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505,
-	1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515,
-	1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526,
-	1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536,
-	1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546,
-	1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556,
-	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566,
-	1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1577,
-	1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587,
-	1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597,
-	1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606, 1607,
-	1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615, 1616, 1617,
-	1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625, 1626, 1627,
-	1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636, 1637,
-	1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646, 1647,
-	1648, 1649, 1650, 1651, 1652, 1653, 1654, 0, 244, 242,
-	1494, 1516, 1576, 1609, 0, 768, 0, 586, 0, 591,
-	1011, 586, 272, 527, 528, 628, 628, 495, 0, 307,
-	0, 1507, 311, 0, 0, 0, 492, 302, 303, 304,
-	305, 306, 0, 750, 0, 0, 298, 0, 266, 1567,
-	0, 0, 0, 0, 0, 0, 150, 839, 152, 841,
-	156, 163, 0, 0, 168, 169, 172, 173, 174, 175,
-	176, 0, 180, 0, 182, 185, 0, 187, 188, 0,
-	191, 192, 193, 0, 203, 204, 205, 842, 843, 844,
-	-2, 81, 766, 1432, 1327, 0, 1334, 1335, 1346, 1357,
-	1121, 1122, 1123, 1124, 0, 0, 0, 0, 0, 1131,
-	1132, 0, 1146, 1669, 0, 1138, 1139, 1140, 1141, 1142,
-	90, 102, 103, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
-	1383, 1384, 1385, 0, 1300, 1108, 1048, 0, 1677, 0,
-	1697, 1702, 1703, 1704, 1705, 1696, 0, 0, 1285, 0,
-	1275, 0, 0, -2, -2, 0, 0, 1635, -2, 1674,
-	1693, 1701, 1678, 1700, 1671, 1672, 1666, 1667, 1668, 1670,
-	1679, 1681, 1692, 0, 1688, 1698, 1699, 0, 0, 104,
-	105, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, 1291, -2, 1293, 1294, 1296, 1297,
-	1298, 1299, -2, 1302, 1303, 1304, -2, -2, 1307, 1308,
-	1309, 1310, 1311, 1312, 1315, -2, 1317, -2, -2, 1287,
-	1288, 1289, 1290, 1279, 1280, 1281, 1282, 1283, 1284, -2,
-	-2, -2, 628, 701, 0, -2, 0, 0, 0, 648,
-	651, 654, 657, 0, 54, 55, 261, 262, 250, 252,
-	0, 256, 0, 249, 0, 0, 0, 871, 871, 871,
-	871, 871, 0, 871, 0, 0, 0, 871, 846, 847,
-	848, 869, 870, 917, 543, 539, 540, 541, 542, 616,
-	0, 618, 621, 472, 419, 0, 0, 0, 472, 0,
-	433, 425, 0, 0, 0, 472, 0, 0, 623, 623,
-	0, 475, 482, 472, 472, -2, 472, 472, 472, 0,
-	0, 439, 440, 441, 425, 425, 444, 445, 446, 457,
-	458, 483, 1456, 0, 0, 367, 0, 367, 0, 367,
-	367, 545, 1567, 0, 0, 217, 1635, 222, 0, 1514,
-	1515, 1581, 1530, 0, 0, 1548, 0, -2, 0, 288,
-	623, 0, 629, 0, 628, 0, 0, 367, 367, 367,
-	367, 367, 367, 367, 367, 0, 0, 0, 367, 0,
-	0, 666, 667, 662, 663, 664, 668, 669, 5, 6,
-	19, 0, 0, 0, 0, 0, 0, 60, 59, 0,
-	1433, 1451, 1388, 1389, 1390, 1438, 1392, 1442, 1442, 1442,
-	1442, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428,
-	0, 0, 1431, 1411, 1440, 1440, 1440, 1438, 1435, 1393,
-	1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
-	1404, 1405, 1406, 1445, 1445, 1448, 1445, 0, 1325, 0,
-	0, 0, 0, 590, 0, 0, 623, -2, 0, 496,
-	308, 1052, 0, 0, 312, 313, 0, 0, 328, 0,
-	331, 316, 317, 318, 0, 0, 300, 301, 0, 0,
-	268, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 160, 157, 164, 167, 177, 184, 0, 196, 198,
-	201, 158, 165, 170, 171, 178, 199, 159, 161, 162,
-	166, 200, 202, 179, 183, 197, 181, 186, 189, 190,
-	195, 0, 131, 0, 0, 0, 0, 0, 1333, 0,
-	0, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, -2, 1327, 0, 0,
-	1127, 1128, 1129, 1130, 1133, 0, 1147, 0, 0, 0,
-	1386, 0, 1325, 1325, 0, 1325, 1321, 0, 0, 0,
-	0, 0, 0, 1325, 1261, 0, 0, 1263, 1276, 0,
-	0, 1265, 1266, 0, 1269, 1270, 1325, 0, 1325, 1274,
-	1257, 1258, 0, 1321, 1321, 0, 0, 1321, 1321, 1321,
-	1321, 1321, 1321, 1321, 1321, 1321, 1321, 1321, 1321, 0,
-	623, 0, 0, 611, 0, 592, 0, 645, 647, 0,
-	649, 650, 652, 653, 655, 656, 658, 659, 56, 0,
-	0, 254, 0, 259, 0, 765, 0, 0, 781, 0,
-	0, 0, 0, 0, 0, 0, 0, 871, 871, 871,
-	0, 545, 544, 0, 617, 0, 0, 674, 0, 0,
-	0, 425, 472, 472, 431, 432, 427, 426, 478, 479,
-	475, 0, 475, 475, 674, 0, 451, 452, 453, 472,
-	472, 459, 624, 460, 461, 475, 0, 480, 481, 674,
-	0, 0, 674, 674, 0, 469, 470, 471, 0, 0,
-	871, 0, 488, 427, 427, 1457, 1458, 0, 880, 0,
-	0, 0, 491, 0, 0, 0, 546, 210, 0, 0,
-	0, 0, 0, 0, 240, 241, 0, 0, 0, 0,
-	0, 0, 231, 234, 1005, 1006, 836, 837, 235, 236,
-	280, 281, 0, 592, 644, 646, 640, 641, 642, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 0, 523,
-	0, 0, 682, 676, 678, 745, 90, 682, 8, 77,
-	74, 0, 19, 0, 0, 19, 19, 0, 19, 245,
-	0, 1454, 1452, 1453, 1391, 1439, 0, 1416, 0, 1417,
-	1418, 1419, 0, 0, 1412, 0, 1413, 1414, 1415, 1407,
-	0, 1408, 1409, 0, 1410, 243, 0, 1326, 769, 0,
-	565, 578, 560, 0, 567, 0, 1012, 547, 578, 549,
-	0, 567, 592, 614, 592, 0, 309, 0, 314, 0,
-	0, 330, 332, 333, 334, 319, 320, 493, 296, 297,
-	289, 290, 291, 292, 293, 294, 295, 299, 100, 0,
-	269, 270, 0, 0, 0, 144, 145, 146, 147, 148,
-	149, 151, 135, 513, 515, 828, 840, 0, 831, 0,
-	154, 194, 127, 0, 0, 1328, 1329, 1330, 1331, 1332,
-	1336, 0, 1338, 1340, 1342, 1344, 0, 1362, -2, -2,
-	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
-	1119, 1120, 1347, 1360, 1361, 0, 0, 0, 0, 0,
-	0, 1358, 1358, 1353, 0, 1125, 0, 1144, 1148, 0,
-	0, 0, 91, 1320, 1228, 1229, 1230, 1231, 1232, 1233,
-	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
-	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
-	1254, 1255, 1256, 0, 0, 1327, 0, 0, 0, 1322,
-	1323, 0, 0, 0, 1216, 0, 0, 1222, 1223, 1224,
-	0, 573, 0, 1286, 1262, 1277, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 700, 702, 0, 582, 584, 585, 0, 612, 625,
-	630, 631, 634, 52, 57, 263, 264, 0, 0, 258,
-	0, -2, 783, 0, 621, 0, 0, 795, 365, 822,
-	0, 0, 838, 861, 867, 0, 0, 0, 895, 0,
-	619, 0, 0, 714, 418, 0, 473, 474, 422, 1567,
-	427, 674, 674, 434, 428, 435, 477, 436, 437, 438,
-	0, 674, 674, 472, 475, 462, 476, 475, 465, 466,
-	0, 484, 489, 485, 0, 487, 442, 443, 621, 343,
-	344, 0, 0, 0, 349, 1459, 1459, 0, 1459, 1459,
-	1459, 1459, 0, 0, 1459, 1459, 1459, 1459, 1459, 1459,
-	1459, 1459, 1459, 1459, 1459, 1459, 1459, 1459, 0, 881,
-	362, 0, 0, 0, 365, 812, 687, 0, 688, 689,
-	685, 716, 740, 740, 0, 740, 720, 1011, 211, 212,
-	0, 214, 215, 216, 223, 218, 220, 0, 0, 224,
-	237, 238, 239, 0, 0, 0, 0, 229, 230, 0,
-	0, 283, 284, 286, 0, 610, 510, 1009, 511, 512,
-	516, 0, 518, 519, 520, 783, 0, 817, 674, 0,
-	683, 0, 679, 746, 0, 748, 0, 674, 0, 75,
-	19, 0, 68, 65, 0, 0, 0, 0, 0, 1434,
-	1387, 1455, 0, 0, 0, 1436, 0, 0, 0, 0,
-	58, 594, 554, 0, 559, 575, 0, 579, 0, 0,
-	571, 564, 568, 0, 0, 588, 548, 0, 0, 553,
-	613, 615, 1053, 0, 315, 329, 0, 0, 0, 0,
-	138, 825, 0, 139, 143, 133, 0, 0, 0, 830,
-	0, 827, 832, 0, 153, 0, 0, 128, 129, 886,
-	891, 0, 1337, 1339, 1341, 1343, 1345, 0, 1348, 1358,
-	1358, 1354, 0, 1349, 0, 1351, 0, 1328, 0, 1149,
-	0, 0, 0, 0, 0, 0, 1206, 1207, 0, 0,
-	1211, 0, 1213, 1214, 1215, 1217, 0, 0, 0, 1221,
-	0, 1260, 1278, 1264, 1267, 0, 1271, 0, 1273, 0,
-	628, 0, 1183, 1183, 0, 0, 0, 0, 1183, 0,
-	0, 0, 0, 0, 0, 0, 0, 1143, 0, 0,
-	703, 594, 0, 0, 0, 637, 635, 636, 265, 253,
-	255, 0, 0, 0, 771, 772, 774, 0, 777, 778,
-	779, 0, 784, 785, 787, 788, 0, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, 1499, -2,
+	44, 45, 46, 47, 48, 49, 50, 51, 52, 0,
+	250, 251, 252, 253, 254, 814, 815, 816, 817, 818,
+	0, 0, 0, 0, 562, 563, 0, 531, 0, 0,
+	0, 0, 0, 0, 424, 425, 426, 427, 428, 429,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 439,
+	440, 441, 442, 443, 444, 445, 446, 447, 448, 449,
+	450, 451, 349, 350, 351, 352, 353, 354, 0, 283,
+	279, 210, 211, 212, 213, 290, 291, 401, 0, 0,
+	0, 0, 645, -2, 57, 0, 272, 0, 263, -2,
+	0, 0, 819, 820, 821, 822, 823, 824, 825, 826,
+	827, -2, 575, 0, 532, 533, 534, 535, 536, 537,
+	538, 539, 540, 541, 542, 543, 544, 337, 338, 339,
+	333, 334, 336, 335, -2, 0, 575, 0, 0, 0,
+	674, 0, 0, 689, 711, 23, 0, 7, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 0, 0,
+	19, 0, 19, 0, 0, 0, 1122, 1123, 1124, 1125,
+	-2, 1604, 1581, 1667, 1767, 1768, 1769, 1770, 1771, 1772,
+	1773, 1774, 1775, 1776, 1777, 1778, 1779, 1780, 1781, 1782,
+	1783, 1784, 1785, 1786, 1787, 1788, 1789, 1790, 1791, 1792,
+	1793, 1794, 1795, 1796, 1797, 1798, 1799, 1800, 1801, 1802,
+	1803, 1804, 1805, 1806, 1807, 1808, 1809, 1546, 1547, 1548,
+	1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568,
+	1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578,
+	1579, 1580, 1582, 1583, 1584, 1585, 1586, 1587, 1588, 1589,
+	1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597, 1598, 1599,
+	1600, 1601, 1602, 1603, 1605, 1606, 1607, 1608, 1609, 1610,
+	1611, 1612, 1613, 1614, 1615, 1616, 1617, 1618, 1619, 1620,
+	1621, 1622, 1623, 1624, 1625, 1626, 1627, 1628, 1629, 1630,
+	1631, 1632, 1633, 1634, 1635, 1636, 1637, 1638, 1639, 1640,
+	1641, 1642, 1643, 1644, 1645, 1646, 1647, 1648, 1649, 1650,
+	1651, 1652, 1653, 1654, 1655, 1656, 1657, 1658, 1659, 1660,
+	1661, 1662, 1663, 1664, 1665, 1666, 1668, 1669, 1670, 1671,
+	1672, 1674, 1675, 1676, 1677, 1678, 1679, 1680, 1681, 1682,
+	1683, 1684, 1685, 1686, 1687, 1688, 1689, 1690, 1691, 1692,
+	1693, 1694, 1695, 1696, 1697, 1698, 1699, 1700, 1701, 1702,
+	1703, 1704, 1705, 1706, 1707, 1708, 1709, 1710, 1711, 1712,
+	1713, 1714, 1715, 1716, 1717, 1718, 1719, 1720, 1721, 1722,
+	1723, 1724, 1725, 1726, 1727, 1728, 1729, 1730, 1731, 1732,
+	1733, 1734, 1735, 1736, 1737, 1738, 1739, 1740, 1741, 1742,
+	1743, 1744, 1745, 1746, 1747, 1748, 1749, 1750, 1751, 1752,
+	1753, 1754, 1755, 1756, 0, 248, 246, 1581, 1604, 1667,
+	1673, 1708, 0, 838, 0, 623, 0, 628, 1084, 623,
+	284, 564, 565, 674, 674, 529, 0, 319, 0, 1595,
+	323, 0, 0, 0, 526, 314, 315, 316, 317, 318,
+	0, 813, 0, 0, 310, 0, 278, 1661, 0, 0,
+	0, 0, 0, 0, 154, 910, 156, 912, 160, 167,
+	0, 0, 172, 173, 176, 177, 178, 179, 180, 0,
+	184, 0, 186, 189, 0, 191, 192, 0, 195, 196,
+	197, 0, 207, 208, 209, 913, 914, 915, -2, 85,
+	830, 1517, 1410, 0, 1417, 1418, 1429, 1441, 1195, 1196,
+	1199, 1200, 0, 0, 0, 0, 0, 1207, 1208, 0,
+	1224, 1771, 0, 0, 1215, 1216, 1217, 1218, 1219, 94,
+	106, 107, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467,
+	1468, 1469, 0, 1383, 1182, 1122, 0, 1779, 0, 1799,
+	1804, 1805, 1806, 1807, 1798, 0, 0, 1368, 0, 1358,
+	0, 0, -2, -2, 0, 0, 1737, -2, 1776, 1795,
+	1803, 1780, 1802, 1773, 1774, 1768, 1769, 1770, 1772, 1781,
+	1783, 1794, 0, 1790, 1800, 1801, 0, 0, 108, 109,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, 1374, -2, 1376, 1377, 1379, 1380, 1381,
+	1382, -2, 1385, 1386, 1387, -2, -2, 1390, 1391, 1392,
+	1393, 1394, 1395, 1398, -2, 1400, -2, -2, 1370, 1371,
+	1372, 1373, 1362, 1363, 1364, 1365, 1366, 1367, -2, -2,
+	-2, 674, 747, 0, -2, 0, 0, 0, 694, 697,
+	700, 703, 0, 58, 59, 0, 273, 274, 262, 264,
+	0, 268, 0, 255, 0, -2, 260, 0, 0, 0,
+	944, 0, 944, 0, 944, 944, 944, 944, 0, 944,
+	0, 0, 0, 944, 917, 918, 919, 942, 943, 990,
+	580, 576, 577, 578, 579, 662, 0, 664, 667, 506,
+	453, 0, 0, 0, 506, 0, 467, 459, 0, 0,
+	0, 506, 0, 0, 669, 669, 0, 509, 516, 506,
+	506, -2, 506, 506, 506, 0, 0, 473, 474, 475,
+	459, 459, 478, 479, 480, 491, 492, 517, 1541, 0,
+	0, 401, 0, 401, 0, 401, 401, 582, 1661, 0,
+	0, 221, 1737, 226, 0, 1602, 1603, 1679, 1618, 0,
+	0, 1638, 0, -2, 0, 300, 669, 0, 675, 0,
+	674, 0, 0, 401, 401, 401, 401, 401, 401, 401,
+	0, 401, 0, 0, 0, 401, 401, 0, 0, 712,
+	713, 708, 709, 710, 714, 715, 5, 6, 19, 0,
+	0, 0, 0, 0, 0, 64, 63, 0, 1518, 1536,
+	1472, 1473, 1474, 1523, 1476, 1527, 1527, 1527, 1527, 1504,
+	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 0, 0,
+	1515, 0, 1495, 1525, 1525, 1525, 1523, 1520, 1477, 1478,
+	1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488,
+	1489, 1490, 1530, 1530, 1533, 1530, 0, 1408, 0, 0,
+	0, 0, 627, 0, 0, 669, -2, 0, 530, 320,
+	1126, 0, 0, 324, 325, 0, 0, 340, 0, 343,
+	328, 329, 330, 0, 0, 312, 313, 0, 347, 280,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	164, 161, 168, 171, 181, 188, 0, 200, 202, 205,
+	162, 169, 174, 175, 182, 203, 163, 165, 166, 170,
+	204, 206, 183, 187, 201, 185, 190, 193, 194, 199,
+	0, 135, 0, 0, 0, 0, 0, 1416, 0, 0,
+	1449, 1450, 1451, 1452, 1453, 1454, 1455, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, -2, 1410,
+	0, 0, 1203, 1204, 1205, 1206, 1209, 0, 1225, 0,
+	0, 0, 0, 1470, 0, 1408, 1408, 0, 1408, 1404,
+	0, 0, 0, 0, 0, 0, 1408, 1344, 0, 0,
+	1346, 1359, 0, 0, 1348, 1349, 0, 1352, 1353, 1408,
+	0, 1408, 1357, 1340, 1341, 0, 1404, 1404, 0, 0,
+	1404, 1404, 1404, 1404, 1404, 1404, 1404, 1404, 1404, 1404,
+	1404, 1404, 0, 669, 0, 0, 648, 0, 629, 0,
+	691, 693, 0, 695, 696, 698, 699, 701, 702, 704,
+	705, 60, 0, 0, 0, 266, 0, 271, 0, 261,
+	0, 829, 0, 0, 0, 0, 851, 0, 944, 0,
+	0, 0, 0, 0, 0, 944, 944, 944, 0, 582,
+	581, 0, 663, 0, 0, 720, 0, 0, 0, 459,
+	506, 506, 465, 466, 461, 460, 512, 513, 509, 0,
+	509, 509, 720, 0, 485, 486, 487, 506, 506, 493,
+	670, 494, 495, 509, 0, 514, 515, 720, 0, 0,
+	720, 720, 0, 503, 504, 505, 0, 0, 944, 0,
+	522, 461, 461, 1542, 1543, 0, 953, 0, 0, 0,
+	525, 0, 0, 0, 583, 214, 0, 0, 0, 0,
+	0, 0, 244, 245, 0, 0, 0, 0, 0, 0,
+	235, 238, 1078, 1079, 907, 908, 239, 240, 292, 293,
+	0, 653, 690, 692, 686, 687, 688, 0, 0, 0,
+	0, 0, 0, 0, 401, 0, 557, 0, 559, 0,
+	0, 0, 728, 722, 724, 808, 94, 728, 8, 81,
+	78, 0, 19, 0, 0, 19, 19, 0, 19, 249,
+	0, 1539, 1537, 1538, 1475, 1524, 0, 1500, 0, 1501,
+	1502, 1503, 0, 0, 1516, 1496, 0, 1497, 1498, 1499,
+	1491, 0, 1492, 1493, 0, 1494, 247, 0, 1409, 839,
+	0, 602, 615, 597, 0, 604, 0, 1085, 584, 615,
+	586, 0, 604, 653, 651, 629, 0, 321, 0, 326,
+	0, 0, 342, 344, 345, 346, 331, 332, 527, 308,
+	309, 301, 302, 303, 304, 305, 306, 307, 311, 104,
+	0, 281, 282, 0, 0, 0, 148, 149, 150, 151,
+	152, 153, 155, 139, 548, 550, 899, 911, 0, 902,
+	0, 158, 198, 131, 0, 0, 1411, 1412, 1413, 1414,
+	1415, 1419, 0, 1421, 1423, 1425, 1427, 0, 1446, -2,
+	-2, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
+	1192, 1193, 1194, 1430, 1444, 1445, 0, 0, 0, 0,
+	0, 0, 1442, 1442, 1436, 0, 0, 1197, 1198, 1201,
+	0, 1222, 1226, 0, 0, 0, 0, 95, 1403, 1311,
+	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
+	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
+	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 0, 0,
+	1410, 0, 0, 0, 1405, 1406, 0, 0, 0, 1299,
+	0, 0, 1305, 1306, 1307, 0, 610, 0, 1369, 1345,
+	1360, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 746, 748, 0, 619,
+	621, 622, 0, 649, 671, 676, 677, 680, 56, 61,
+	54, 275, 276, 0, 0, 270, 256, 1678, 0, -2,
+	0, 0, 853, 0, 667, 0, 0, 866, 399, 893,
+	0, 0, 909, 933, 940, 0, 0, 0, 968, 0,
+	665, 0, 0, 760, 452, 0, 507, 508, 456, 1661,
+	461, 720, 720, 468, 462, 469, 511, 470, 471, 472,
+	0, 720, 720, 506, 509, 496, 510, 509, 499, 500,
+	0, 518, 523, 519, 0, 521, 476, 477, 667, 356,
+	357, 367, 0, 0, 0, 0, 0, 378, 378, 0,
+	0, 377, 1544, 1544, 0, 1544, 1544, 1544, 1544, 0,
+	0, 1544, 1544, 1544, 1544, 1544, 1544, 1544, 1544, 1544,
+	1544, 1544, 1544, 1544, 1544, 0, 954, 396, 0, 0,
+	0, 399, 883, 733, 0, 734, 735, 731, 762, 803,
+	803, 0, 784, 766, 1084, 0, 215, 216, 0, 218,
+	219, 220, 227, 222, 224, 0, 0, 228, 241, 242,
+	243, 0, 0, 0, 0, 233, 234, 0, 0, 295,
+	296, 298, 0, 629, 0, 0, 545, 1082, 546, 547,
+	551, 0, 553, 554, 0, 556, 853, 0, 561, 888,
+	720, 0, 729, 0, 725, 809, 0, 811, 0, 720,
+	0, 79, 19, 0, 72, 69, 0, 0, 0, 0,
+	0, 1519, 1471, 1540, 0, 0, 0, 1521, 0, 0,
+	0, 0, 62, 631, 591, 0, 596, 612, 0, 616,
+	0, 0, 608, 601, 605, 0, 0, 625, 585, 0,
+	0, 590, 629, 652, 1127, 0, 327, 341, 0, 0,
+	0, 0, 142, 896, 0, 143, 147, 137, 0, 0,
+	0, 901, 0, 898, 903, 0, 157, 0, 0, 132,
+	133, 959, 964, 0, 1420, 1422, 1424, 1426, 1428, 0,
+	1431, 1442, 1442, 1437, 0, 1432, 0, 1434, 0, 0,
+	1411, 0, 1227, 0, 0, 0, 0, 0, 0, 0,
+	1289, 1290, 0, 0, 1294, 0, 1296, 1297, 1298, 1300,
+	0, 0, 0, 1304, 0, 1343, 1361, 1347, 1350, 0,
+	1354, 0, 1356, 0, 674, 0, 1261, 1261, 0, 0,
+	0, 0, 1261, 0, 0, 0, 0, 0, 0, 0,
+	0, 1220, 0, 0, 749, 631, 0, 0, 0, 683,
+	681, 682, 53, 55, 277, 265, 267, 0, 257, 0,
+	0, 841, 842, 844, 0, 847, 848, 849, 0, 833,
+	834, 945, 0, 854, 855, 857, 858, 0, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, 1586,
+	-2, 1714, -2, -2, -2, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, 782, 0,
-	872, 802, 1459, 369, 0, 0, 824, 0, 0, 0,
-	-2, 0, 0, 0, 0, 897, 0, 0, 0, 532,
-	536, 23, 622, 0, 675, 420, 0, 421, 472, 429,
-	430, 674, 1011, 454, 455, 674, 472, 472, 475, 0,
-	486, 0, 880, 346, 1017, 1018, 1019, 0, 0, 1023,
-	1030, 871, 1078, 0, 1030, 0, 0, 1032, 1033, 347,
-	0, 0, 0, 0, 0, 348, 0, 0, 1460, 0,
-	1459, 1459, 0, 0, 0, 0, 1459, 1459, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 802, 1459, 0, 0, 0, 369, 809,
-	0, 0, 0, 0, 0, 0, 707, 0, 0, 706,
-	0, 0, 0, 0, 0, 621, 741, 0, 743, 744,
-	718, -2, 0, 687, 723, 1325, 0, 0, 0, 225,
-	226, 227, 228, 232, 233, 282, 285, 287, 0, 0,
-	0, 524, 672, 677, 684, 747, 749, 91, 680, 672,
-	0, 78, 19, 77, 69, 70, 0, 19, 0, 0,
-	0, 0, 1444, 1443, 1429, 0, 1430, 1441, 1446, 0,
-	1449, 0, 598, 0, 0, 578, 580, 0, 0, 578,
-	0, 0, 587, 0, 0, 578, 0, 0, 494, 101,
-	335, 0, 0, 0, 0, 0, 514, 0, 829, 135,
-	0, 0, 155, 0, 0, 889, 0, 891, 1324, 1350,
-	1352, 0, 1359, 1355, 1126, 1134, 1145, 0, 0, 1151,
-	1163, 1163, 0, 1154, 1442, 1442, 1157, 1438, 1440, 1438,
-	1163, 1163, 0, 92, 0, 0, 1212, 0, 0, 0,
-	574, 0, 0, 0, 1181, 1183, 1188, 1184, 1189, 1183,
-	1183, 1183, 1183, 1194, 1183, 1183, 1183, 1183, 1183, 1183,
-	1183, 1183, 1179, 583, 598, 626, 627, 632, 633, 0,
-	257, 0, 0, 776, 0, 0, 0, 789, 0, 805,
-	803, 804, 0, 819, 370, 371, 372, 373, 0, 0,
-	0, 823, 0, 555, 0, 862, 863, 864, 865, 866,
-	-2, 875, 0, 0, 1013, 1013, 1013, 905, 0, 896,
-	586, 0, -2, 0, 0, 534, 0, 0, 715, 423,
-	674, 447, 0, 463, 674, 674, 472, 490, 0, 345,
-	-2, 1022, 1042, 1031, 1042, 1079, 1042, 1042, 871, 0,
-	350, 351, 352, 0, 354, 0, 964, 965, 0, 0,
-	968, 969, 970, 971, 0, 0, 974, 975, 976, 977,
-	978, 979, 980, 981, 982, 983, 999, 1000, 1001, 1002,
-	1003, 1004, 984, 985, 986, 987, 988, 989, 996, 0,
-	0, 993, 0, 805, 0, 0, 0, 0, 819, 811,
-	0, 813, 814, 0, 0, 557, 674, 275, 0, 710,
-	704, 0, 693, 708, 709, 696, 0, 698, 0, 694,
-	695, 674, 686, 717, 742, 719, 722, 724, 725, 731,
-	0, 0, 0, 0, 213, 0, 389, 219, 1010, 517,
-	522, 670, 0, 0, 670, 73, 76, 0, 71, 0,
-	0, 0, 0, 63, 61, 1437, 0, 0, 600, 115,
-	0, 0, 0, 576, 0, 581, 578, 563, 572, 562,
-	569, 570, 589, 578, 552, 551, 1054, 310, 0, 826,
-	135, 140, 141, 142, 136, 134, 833, 0, 835, 0,
-	887, 891, 0, 0, 1356, 1150, 1135, 1152, 1164, 1165,
-	1153, 1136, 1155, 1156, 1158, 1159, 1160, 1161, 1162, 1137,
-	1208, 0, 1210, 1218, 1219, 0, 1268, 1272, 0, 0,
-	0, 1187, 1190, 1191, 1192, 1193, 1195, 1196, 1197, 1198,
-	1199, 1200, 1201, 1202, 628, 1180, 0, 600, 638, 639,
-	764, 767, 773, 775, 0, 786, 0, 793, 794, 0,
-	0, 797, 798, 807, 0, 0, 0, 375, 376, 0,
-	0, 0, 388, 384, 385, 386, 366, 818, 809, 0,
-	0, 876, 1459, 1459, 1459, 0, 0, 1014, 1015, 0,
-	0, 908, 0, 0, 899, 0, 740, 0, 0, 674,
-	533, 536, 537, 620, 424, 674, 467, 464, 674, 342,
-	1044, -2, 1057, 1059, 0, 0, 1062, 1063, 0, 0,
-	0, 0, 1100, 1069, 0, 0, 1073, 0, 1374, 1375,
-	0, 1077, 0, 1043, 0, 1034, 1043, 0, 0, 1042,
-	0, 353, 355, 356, 357, 966, 967, 972, 973, 990,
-	0, 0, 992, 0, 0, 358, 0, 0, 0, 359,
-	364, 810, 0, 815, 816, 628, 0, 0, 690, 711,
-	0, 0, 691, 0, 692, 697, 699, 274, 726, 0,
-	0, 728, 729, 730, 721, 221, 660, 0, 0, 681,
-	661, 0, 19, 0, 0, 66, 1447, 1450, 602, 0,
-	599, 116, 0, 0, 0, 0, 577, 561, 550, 137,
-	132, 834, 118, 890, 892, 888, 0, 1220, 0, 1183,
-	1182, 1176, 0, 602, 0, 792, 790, 806, 796, 0,
-	820, 821, 0, 377, 378, 0, 381, 387, 808, 556,
-	0, 0, 0, 0, 868, -2, 0, 0, -2, 911,
-	0, 906, 0, 898, 0, 901, 674, 674, -2, 530,
-	535, 0, 448, 468, 1058, 1060, 1061, 1064, 1065, 1007,
-	1008, 1066, 1105, 1106, 1107, 1067, 1102, 1103, 1104, 1068,
-	0, 0, 0, 1372, 1373, 1098, 0, 0, 0, 0,
-	0, 0, 0, 1028, 997, 998, 991, 994, 995, 363,
-	360, 361, 558, 623, 276, 277, 712, 0, 705, 735,
-	732, 0, 0, 671, 673, 67, 72, 0, 0, 604,
-	0, 601, 0, 595, 597, 126, 566, 88, 109, 0,
-	1209, 1259, 1186, 0, 1177, 0, 1171, 1172, 1173, 1178,
-	604, 0, 0, 0, 374, 379, 0, 382, 383, 0,
-	857, 1438, 0, 877, 878, 879, 918, -2, 961, 1016,
-	883, 118, 918, 902, 0, 909, 0, 907, 900, 628,
-	531, 0, 0, 1275, 1093, 0, 0, 0, 1035, 1037,
-	1038, 1039, 1040, 1041, 1036, 0, 0, 0, 1027, 1029,
-	1074, 0, 273, 0, 0, 736, 738, 733, 734, 62,
-	64, 79, 0, 603, 117, 0, 80, 0, 106, 0,
-	119, 120, 0, 0, 0, 0, 1185, 1174, 0, 0,
-	0, 0, 593, 0, 791, 799, 0, 801, 380, 850,
-	0, 634, 0, 920, 0, 880, 963, 885, 920, 894,
-	0, 904, 0, 913, 0, 910, 623, 1101, 0, 1072,
-	1081, 1094, 0, 0, 850, 850, 850, 850, 0, 1075,
-	713, 727, 0, 0, 0, 89, 93, 0, 115, 112,
-	0, 121, 0, 0, 0, 0, 0, 1166, 1167, 1169,
-	1168, 1170, 0, 800, 849, 858, 859, 634, 882, 0,
-	957, 962, 884, 903, 912, 0, 915, 529, 1070, 1080,
-	1082, 1083, 0, 1095, 1096, 1097, 1099, 1020, 1021, 1024,
-	1025, 0, 737, 739, 0, 606, 596, 82, 0, 0,
-	110, 111, 113, 0, 122, 0, 124, 125, 0, 780,
-	851, 1459, 0, 0, 855, 856, 860, 0, 945, 0,
-	0, 951, 0, 958, 914, 1071, 1084, 0, 1085, 0,
-	0, 0, 1026, 605, 0, 893, 0, 94, 0, 96,
-	98, 99, 1045, 107, 108, 114, 123, 1175, 0, 853,
-	0, 921, 0, 923, 0, 0, 0, 0, 0, 955,
-	0, 1086, 1088, 1089, 0, 0, 1087, 607, 83, 84,
-	0, 95, 0, 0, 852, 854, 0, 925, 0, 946,
-	0, 0, 0, 0, 0, 0, 0, 1090, 1092, 1091,
-	0, 0, 97, 1046, 922, 919, 0, 957, 947, 0,
-	949, 0, 0, 0, 0, 85, 86, 87, 0, 0,
-	927, 0, 943, 948, 950, 952, 0, 956, 954, 1047,
-	926, 0, 939, 924, 0, 953, 928, -2, 0, 944,
-	929, -2, 0, 937, 0, 0, 930, 938, 0, 933,
-	0, 0, 0, 932, 0, -2, 940, 0, 0, 934,
-	-2, 0, 942, 941,
+	852, 0, 667, 873, 1544, 403, 0, 0, 895, 0,
+	0, 0, -2, 0, 0, 0, 0, 970, 0, 0,
+	0, 569, 573, 23, 668, 0, 721, 454, 0, 455,
+	506, 463, 464, 720, 1084, 488, 489, 720, 506, 506,
+	509, 0, 520, 0, 953, 0, 369, 0, 1090, 1091,
+	1092, 0, 0, 1096, 1103, 944, 1152, 0, 1103, 0,
+	0, 1105, 1106, 0, 375, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 376, 0, 0,
+	1545, 0, 1544, 1544, 0, 0, 0, 0, 1544, 1544,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 873, 1544, 0, 0, 0,
+	403, 880, 0, 0, 0, 0, 0, 0, 753, 0,
+	0, 752, 0, 0, 0, 0, 0, 667, 804, 0,
+	806, 807, 764, -2, 0, 733, 803, 0, 1408, 0,
+	0, 0, 0, 229, 230, 231, 232, 236, 237, 294,
+	297, 299, 647, 657, 657, 0, 0, 0, 555, 0,
+	560, 718, 723, 730, 810, 812, 95, 726, 718, 0,
+	82, 19, 81, 73, 74, 0, 19, 0, 0, 0,
+	0, 1529, 1528, 1513, 0, 1514, 1526, 1531, 0, 1534,
+	0, 635, 0, 0, 615, 617, 0, 0, 615, 0,
+	0, 624, 0, 0, 615, 650, 0, 0, 528, 105,
+	348, 0, 0, 0, 0, 0, 549, 0, 900, 139,
+	0, 0, 159, 0, 0, 962, 0, 964, 1407, 1433,
+	1435, 0, 1443, 0, 1439, 1202, 1210, 1223, 0, 0,
+	1229, 1241, 1241, 0, 1232, 1527, 1527, 1235, 1523, 1525,
+	1523, 1241, 1241, 0, 0, 96, 1221, 0, 0, 1295,
+	0, 0, 0, 611, 0, 0, 0, 1259, 1261, 1266,
+	1262, 1267, 1261, 1261, 1261, 1261, 1272, 1261, 1261, 1261,
+	1261, 1261, 1261, 1261, 1261, 1257, 620, 635, 672, 673,
+	678, 679, 0, 269, 0, 0, 846, 0, 0, 835,
+	836, 837, 0, 0, 859, 0, 0, 876, 874, 875,
+	0, 890, 404, 405, 406, 407, 0, 0, 0, 894,
+	0, 592, 0, 934, 935, 936, 937, 938, 939, -2,
+	948, 0, 0, 1086, 1086, 1086, 978, 0, 969, 623,
+	0, -2, 0, 0, 571, 0, 0, 761, 457, 720,
+	481, 0, 497, 720, 720, 506, 524, 0, 368, 0,
+	0, 0, 0, 380, 371, 382, 0, -2, 1095, 1116,
+	1104, 1116, 1153, 1116, 1116, 944, 0, 360, 384, 385,
+	386, 0, 388, 361, 362, 0, 0, 380, 0, 0,
+	0, 1037, 1038, 0, 0, 1041, 1042, 1043, 1044, 0,
+	0, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1072, 1073, 1074, 1075, 1076, 1077, 1057, 1058, 1059,
+	1060, 1061, 1062, 1069, 0, 0, 1066, 0, 876, 0,
+	0, 0, 0, 890, 882, 0, 884, 885, 0, 0,
+	594, 720, 287, 0, 756, 750, 0, 739, 754, 755,
+	742, 0, 744, 0, 740, 741, 720, 732, 763, 805,
+	765, 786, 0, 0, 0, 217, 0, 423, 223, 659,
+	0, 659, 0, 1083, 552, 558, 716, 0, 0, 716,
+	77, 80, 0, 75, 0, 0, 0, 0, 67, 65,
+	1522, 0, 0, 637, 119, 0, 0, 0, 613, 0,
+	618, 615, 600, 609, 599, 606, 607, 626, 615, 589,
+	588, 1128, 322, 0, 897, 139, 144, 145, 146, 140,
+	138, 904, 0, 906, 0, 960, 964, 0, 0, 1440,
+	1438, 1228, 1211, 1230, 1242, 1243, 1231, 1212, 1233, 1234,
+	1236, 1237, 1238, 1239, 1240, 1213, 0, 1291, 0, 1293,
+	1301, 1302, 0, 1351, 1355, 0, 0, 0, 1265, 1268,
+	1269, 1270, 1271, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
+	1280, 674, 1258, 0, 637, 684, 685, 828, 831, 843,
+	845, 0, 0, 856, 0, 863, 0, 865, 0, 0,
+	868, 869, 878, 0, 0, 0, 409, 410, 0, 0,
+	0, 422, 418, 419, 420, 400, 889, 880, 0, 0,
+	949, 1544, 1544, 1544, 0, 0, 1087, 1088, 1090, 0,
+	0, 981, 0, 0, 972, 0, 803, 0, 0, 720,
+	570, 573, 574, 666, 458, 720, 501, 498, 720, 355,
+	0, 1000, 0, 359, 370, 381, 383, 1118, -2, 1131,
+	1133, 0, 0, 1136, 1137, 0, 0, 0, 0, 1174,
+	1143, 0, 0, 1147, 0, 1458, 1459, 0, 1151, 0,
+	1117, 0, 1107, 1117, 0, 0, 1116, 0, 387, 0,
+	0, 372, 380, 0, 389, 390, 391, 1039, 1040, 1045,
+	1046, 1063, 0, 0, 1065, 0, 0, 392, 0, 0,
+	0, 393, 398, 881, 0, 886, 887, 674, 0, 0,
+	736, 757, 0, 0, 737, 0, 738, 743, 745, 286,
+	783, 787, 788, 794, 0, 0, 0, 0, 767, 0,
+	225, 654, 660, 0, 658, 655, 656, 706, 0, 0,
+	727, 707, 0, 19, 0, 0, 70, 1532, 1535, 639,
+	0, 636, 120, 0, 0, 0, 0, 614, 598, 587,
+	141, 136, 905, 122, 963, 965, 961, 1284, 0, 1303,
+	0, 1261, 1260, 1254, 0, 639, 0, 0, 862, 860,
+	864, 877, 867, 0, 891, 892, 0, 411, 412, 0,
+	415, 421, 879, 593, 0, 0, 0, 0, 941, -2,
+	0, 0, -2, 984, 0, 979, 0, 971, 0, 974,
+	720, 720, -2, 567, 572, 0, 482, 502, 358, 0,
+	1012, 1132, 1134, 1135, 1138, 1139, 1080, 1081, 1140, 1179,
+	1180, 1181, 1141, 1176, 1177, 1178, 1142, 0, 0, 0,
+	1456, 1457, 1172, 0, 0, 0, 0, 0, 0, 0,
+	1101, 0, 0, 373, 374, 1070, 1071, 1064, 1067, 1068,
+	397, 394, 395, 595, 669, 288, 289, 758, 0, 751,
+	789, 0, 0, 791, 792, 793, 0, 0, 661, 717,
+	719, 71, 76, 0, 0, 641, 0, 638, 0, 632,
+	634, 130, 603, 92, 113, 0, 0, 0, 0, 1292,
+	1342, 1264, 0, 1255, 0, 1249, 1250, 1251, 1256, 641,
+	0, 0, 0, 0, 408, 413, 0, 416, 417, 0,
+	929, 1523, 0, 950, 951, 952, 991, -2, 1034, 1089,
+	956, 122, 991, 975, 0, 982, 0, 980, 973, 674,
+	568, 1001, -2, 0, 0, 0, 1358, 1167, 0, 0,
+	0, 1108, 1110, 1111, 1112, 1113, 1114, 1115, 1109, 0,
+	0, 0, 1100, 1102, 1148, 0, 0, 364, 285, 0,
+	798, 795, 0, 0, 0, 0, 66, 68, 83, 0,
+	640, 121, 0, 84, 0, 110, 0, 123, 124, 0,
+	0, 0, 0, 1214, 0, 0, 0, 1263, 1252, 0,
+	0, 0, 0, 630, 0, 0, 861, 870, 0, 872,
+	414, 921, 0, 680, 0, 993, 0, 953, 1036, 958,
+	993, 967, 0, 977, 0, 986, 0, 983, 669, 1002,
+	-2, 0, 1010, 0, 0, 1175, 0, 1146, 1155, 1168,
+	0, 0, 921, 921, 921, 921, 0, 1149, 363, 0,
+	0, 759, 0, 799, 801, 796, 797, 785, 0, 0,
+	0, 93, 97, 0, 119, 116, 0, 125, 0, 0,
+	0, 0, 0, 1287, 1288, 0, 1244, 1245, 1247, 1246,
+	1248, 0, 0, 871, 920, 930, 931, 680, 955, 0,
+	1030, 1035, 957, 976, 985, 0, 988, 566, 1003, 1011,
+	0, 1006, 0, 0, 0, 1144, 1154, 1156, 1157, 0,
+	1169, 1170, 1171, 1173, 1093, 1094, 1097, 1098, 0, 365,
+	366, 790, 0, 0, 769, 0, 1640, 0, 643, 633,
+	86, 0, 0, 114, 115, 117, 0, 126, 0, 128,
+	129, 1285, 0, 832, 850, 922, 1544, 0, 0, 926,
+	927, 1544, 932, 0, 1018, 0, 0, 1024, 0, 1031,
+	987, 1005, 0, -2, 1013, 0, 0, 1145, 1158, 0,
+	1159, 0, 0, 0, 1099, 800, 802, 0, 0, 0,
+	0, 0, 0, 642, 0, 966, 0, 98, 0, 100,
+	102, 103, 1119, 111, 112, 118, 127, 0, 1253, 0,
+	924, 0, 0, 994, 0, 996, 0, 0, 0, 0,
+	0, 1028, 0, 1007, -2, 0, 1015, 1160, 1162, 1163,
+	0, 0, 1161, 768, 770, 771, 0, 0, 0, 0,
+	644, 87, 88, 0, 99, 0, 0, 0, 923, 925,
+	928, 0, 998, 0, 1019, 0, 0, 0, 0, 0,
+	0, 0, 1014, 1164, 1166, 1165, 776, 0, 0, 0,
+	0, 0, 101, 1120, 1286, 995, 992, 0, 1030, 1020,
+	0, 1022, 0, 0, 0, 0, 772, 777, 0, 780,
+	0, 782, 773, 0, 0, 89, 90, 91, 0, 0,
+	1016, 1021, 1023, 1025, 0, 1029, 1027, 778, 0, 781,
+	0, 775, 1121, 999, 997, 0, 1026, 779, 774, 1017,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 127, 119, 3,
-	67, 68, 124, 122, 137, 123, 136, 125, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 558, 557,
-	106, 105, 107, 3, 559, 3, 3, 3, 3, 3,
+	3, 3, 3, 101, 3, 3, 3, 133, 125, 3,
+	72, 73, 130, 128, 143, 129, 142, 131, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 583, 582,
+	111, 110, 112, 3, 586, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 135, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 560, 118, 561, 130,
+	3, 3, 3, 584, 124, 585, 136,
 }
 
 var yyTok2 = [...]int{
@@ -7173,13 +7840,13 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 104,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	120, 121, 126, 128, 131, 132, 133, 134, 135, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	94, 95, 96, 97, 98, 99, 100, 102, 103, 104,
+	105, 106, 107, 108, 109, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 126, 127, 132, 134,
+	137, 138, 139, 140, 141, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
@@ -7252,7 +7919,12 @@ var yyTok3 = [...]int{
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
-	57880, 555, 57881, 556, 0,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	57890, 565, 57891, 566, 57892, 567, 57893, 568, 57894, 569,
+	57895, 570, 57896, 571, 57897, 572, 57898, 573, 57899, 574,
+	57900, 575, 57901, 576, 57902, 577, 57903, 578, 57904, 579,
+	57905, 580, 57906, 581, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7602,13 +8274,13 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:710
+//line mysql_sql.y:735
 		{
 			yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:717
+//line mysql_sql.y:742
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7616,7 +8288,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:748
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -7625,7 +8297,7 @@ yydefault:
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:731
+//line mysql_sql.y:756
 		{
 			yyLOCAL = tree.NewCompoundStmt(yyDollar[2].statementsUnion())
 		}
@@ -7633,7 +8305,7 @@ yydefault:
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:737
+//line mysql_sql.y:762
 		{
 			yyLOCAL = []tree.Statement{yyDollar[1].statementUnion()}
 		}
@@ -7641,7 +8313,7 @@ yydefault:
 	case 8:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:741
+//line mysql_sql.y:766
 		{
 			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[3].statementUnion())
 		}
@@ -7649,7 +8321,7 @@ yydefault:
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:756
+//line mysql_sql.y:781
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7657,7 +8329,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:760
+//line mysql_sql.y:785
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7665,7 +8337,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:766
+//line mysql_sql.y:791
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7673,7 +8345,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:771
+//line mysql_sql.y:796
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7681,7 +8353,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:775
+//line mysql_sql.y:800
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7689,15 +8361,42 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:807
+//line mysql_sql.y:832
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:840
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{
+				Name:        yyDollar[4].tableNameUnion(),
+				Incremental: yyDollar[5].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 54:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:848
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:852
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:814
+//line mysql_sql.y:858
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7717,20 +8416,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:834
+//line mysql_sql.y:878
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:840
+//line mysql_sql.y:884
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7738,10 +8437,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:847
+//line mysql_sql.y:891
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7749,20 +8448,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:855
+//line mysql_sql.y:899
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:861
+//line mysql_sql.y:905
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7770,10 +8469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 62:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:870
+//line mysql_sql.y:914
 		{
 			yyLOCAL = &tree.CallStmt{
 				Name: yyDollar[2].procNameUnion(),
@@ -7781,30 +8480,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:924
 		{
 			yyLOCAL = &tree.LeaveStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:888
+//line mysql_sql.y:932
 		{
 			yyLOCAL = &tree.IterateStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 65:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:896
+//line mysql_sql.y:940
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: "",
@@ -7813,10 +8512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 66:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:904
+//line mysql_sql.y:948
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7825,10 +8524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 67:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:914
+//line mysql_sql.y:958
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: "",
@@ -7837,10 +8536,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 68:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:922
+//line mysql_sql.y:966
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7849,10 +8548,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:932
+//line mysql_sql.y:976
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: "",
@@ -7860,10 +8559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:939
+//line mysql_sql.y:983
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7871,10 +8570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 71:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:948
+//line mysql_sql.y:992
 		{
 			yyLOCAL = &tree.IfStmt{
 				Cond:  yyDollar[2].exprUnion(),
@@ -7884,42 +8583,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:958
+//line mysql_sql.y:1002
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:962
+//line mysql_sql.y:1006
 		{
 			yyLOCAL = yyDollar[1].elseIfClauseListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:968
+//line mysql_sql.y:1012
 		{
 			yyLOCAL = []*tree.ElseIfStmt{yyDollar[1].elseIfClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:972
+//line mysql_sql.y:1016
 		{
 			yyLOCAL = append(yyDollar[1].elseIfClauseListUnion(), yyDollar[2].elseIfClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ElseIfStmt
-//line mysql_sql.y:978
+//line mysql_sql.y:1022
 		{
 			yyLOCAL = &tree.ElseIfStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7927,10 +8626,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 77:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:987
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = &tree.CaseStmt{
 				Expr:  yyDollar[2].exprUnion(),
@@ -7939,26 +8638,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:997
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = []*tree.WhenStmt{yyDollar[1].whenClause2Union()}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1001
+//line mysql_sql.y:1045
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseList2Union(), yyDollar[2].whenClause2Union())
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.WhenStmt
-//line mysql_sql.y:1007
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = &tree.WhenStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7966,26 +8665,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1016
+//line mysql_sql.y:1060
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1020
+//line mysql_sql.y:1064
 		{
 			yyLOCAL = yyDollar[2].statementsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 83:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1026
+//line mysql_sql.y:1070
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -8002,10 +8701,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 84:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1047
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -8017,52 +8716,52 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Parallel = yyDollar[10].unsignedOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1060
+//line mysql_sql.y:1104
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1067
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1071
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1077
+//line mysql_sql.y:1121
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1081
+//line mysql_sql.y:1125
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1087
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8070,10 +8769,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1094
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8081,18 +8780,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1102
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1106
+//line mysql_sql.y:1150
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8105,58 +8804,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1120
+//line mysql_sql.y:1164
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1124
+//line mysql_sql.y:1168
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1128
+//line mysql_sql.y:1172
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1133
+//line mysql_sql.y:1177
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1137
+//line mysql_sql.y:1181
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1141
+//line mysql_sql.y:1185
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1147
+//line mysql_sql.y:1191
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8166,10 +8865,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1156
+//line mysql_sql.y:1200
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8179,58 +8878,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1167
+//line mysql_sql.y:1211
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1171
+//line mysql_sql.y:1215
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1177
+//line mysql_sql.y:1221
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1181
+//line mysql_sql.y:1225
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1187
+//line mysql_sql.y:1231
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1191
+//line mysql_sql.y:1235
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1197
+//line mysql_sql.y:1241
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8253,10 +8952,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1221
+//line mysql_sql.y:1265
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -8275,42 +8974,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1240
+//line mysql_sql.y:1284
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1244
+//line mysql_sql.y:1288
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1248
+//line mysql_sql.y:1292
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 113:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1253
+//line mysql_sql.y:1297
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1257
+//line mysql_sql.y:1301
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -8318,10 +9017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1264
+//line mysql_sql.y:1308
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -8329,42 +9028,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1272
+//line mysql_sql.y:1316
 		{
 			yyVAL.str = ""
 		}
-	case 114:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1279
+//line mysql_sql.y:1323
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 115:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1284
+//line mysql_sql.y:1328
 		{
 			yyVAL.str = "\n"
 		}
-	case 117:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1291
+//line mysql_sql.y:1335
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 118:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1296
+//line mysql_sql.y:1340
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1300
+//line mysql_sql.y:1344
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -8387,36 +9086,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1324
+//line mysql_sql.y:1368
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1328
+//line mysql_sql.y:1372
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1334
+//line mysql_sql.y:1378
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1340
+//line mysql_sql.y:1384
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8435,10 +9134,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1358
+//line mysql_sql.y:1402
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8456,10 +9155,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1375
+//line mysql_sql.y:1419
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8477,50 +9176,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 131:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1398
+//line mysql_sql.y:1442
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1402
+//line mysql_sql.y:1446
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1406
+//line mysql_sql.y:1450
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1411
+//line mysql_sql.y:1455
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1415
+//line mysql_sql.y:1459
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 136:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1421
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -8534,10 +9233,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 137:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1478
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -8549,10 +9248,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 138:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -8565,26 +9264,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1458
+//line mysql_sql.y:1502
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1462
+//line mysql_sql.y:1506
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 141:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1472
+//line mysql_sql.y:1516
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -8598,10 +9297,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 142:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1485
+//line mysql_sql.y:1529
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -8613,30 +9312,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1498
+//line mysql_sql.y:1542
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1504
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1510
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -8644,10 +9343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1517
+//line mysql_sql.y:1561
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -8656,10 +9355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1525
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -8667,74 +9366,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1534
+//line mysql_sql.y:1578
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1538
+//line mysql_sql.y:1582
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1542
+//line mysql_sql.y:1586
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1546
+//line mysql_sql.y:1590
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1550
+//line mysql_sql.y:1594
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1554
+//line mysql_sql.y:1598
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1561
+//line mysql_sql.y:1605
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1565
+//line mysql_sql.y:1609
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1571
+//line mysql_sql.y:1615
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8742,10 +9441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1578
+//line mysql_sql.y:1622
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8753,426 +9452,426 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1587
+//line mysql_sql.y:1631
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1591
+//line mysql_sql.y:1635
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1597
+//line mysql_sql.y:1641
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1601
+//line mysql_sql.y:1645
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1605
+//line mysql_sql.y:1649
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1609
+//line mysql_sql.y:1653
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1613
+//line mysql_sql.y:1657
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1617
+//line mysql_sql.y:1661
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1621
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1625
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1629
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1633
+//line mysql_sql.y:1677
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1637
+//line mysql_sql.y:1681
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1641
+//line mysql_sql.y:1685
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1645
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1649
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1653
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1657
+//line mysql_sql.y:1701
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1661
+//line mysql_sql.y:1705
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1665
+//line mysql_sql.y:1709
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1669
+//line mysql_sql.y:1713
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1673
+//line mysql_sql.y:1717
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1677
+//line mysql_sql.y:1721
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1681
+//line mysql_sql.y:1725
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1685
+//line mysql_sql.y:1729
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1689
+//line mysql_sql.y:1733
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1693
+//line mysql_sql.y:1737
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1697
+//line mysql_sql.y:1741
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1701
+//line mysql_sql.y:1745
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1705
+//line mysql_sql.y:1749
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1709
+//line mysql_sql.y:1753
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1713
+//line mysql_sql.y:1757
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1717
+//line mysql_sql.y:1761
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1721
+//line mysql_sql.y:1765
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1725
+//line mysql_sql.y:1769
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1729
+//line mysql_sql.y:1773
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1733
+//line mysql_sql.y:1777
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1737
+//line mysql_sql.y:1781
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1741
+//line mysql_sql.y:1785
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1745
+//line mysql_sql.y:1789
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1749
+//line mysql_sql.y:1793
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1753
+//line mysql_sql.y:1797
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1757
+//line mysql_sql.y:1801
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1761
+//line mysql_sql.y:1805
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1765
+//line mysql_sql.y:1809
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1769
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1773
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1777
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1781
+//line mysql_sql.y:1825
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1785
+//line mysql_sql.y:1829
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1789
+//line mysql_sql.y:1833
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1793
+//line mysql_sql.y:1837
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1849
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -9180,10 +9879,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1856
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9191,10 +9890,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1819
+//line mysql_sql.y:1863
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9202,90 +9901,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 217:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1828
+//line mysql_sql.y:1872
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1858
+//line mysql_sql.y:1902
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1862
+//line mysql_sql.y:1906
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1866
+//line mysql_sql.y:1910
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1872
+//line mysql_sql.y:1916
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 222:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1922
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 223:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1926
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 225:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1889
+//line mysql_sql.y:1933
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 222:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1895
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1899
+//line mysql_sql.y:1943
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1905
+//line mysql_sql.y:1949
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9294,10 +9993,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 229:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1913
+//line mysql_sql.y:1957
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9307,10 +10006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1922
+//line mysql_sql.y:1966
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9320,10 +10019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1931
+//line mysql_sql.y:1975
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9332,10 +10031,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1939
+//line mysql_sql.y:1983
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9344,10 +10043,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1947
+//line mysql_sql.y:1991
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9371,10 +10070,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1970
+//line mysql_sql.y:2014
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9398,10 +10097,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1993
+//line mysql_sql.y:2037
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9409,10 +10108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2000
+//line mysql_sql.y:2044
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9420,10 +10119,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 237:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2007
+//line mysql_sql.y:2051
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -9432,10 +10131,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2015
+//line mysql_sql.y:2059
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9443,10 +10142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2022
+//line mysql_sql.y:2066
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9454,10 +10153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2029
+//line mysql_sql.y:2073
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9465,212 +10164,254 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2038
+//line mysql_sql.y:2082
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2042
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2046
+//line mysql_sql.y:2090
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2052
+//line mysql_sql.y:2096
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 241:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2056
+//line mysql_sql.y:2100
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 242:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2062
+//line mysql_sql.y:2106
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 243:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2066
+//line mysql_sql.y:2110
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
-	case 244:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2072
+//line mysql_sql.y:2116
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2076
+//line mysql_sql.y:2120
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2087
+//line mysql_sql.y:2133
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 256:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2137
+		{
+			yyLOCAL = tree.NewRollbackToSavePoint(tree.Identifier(yyDollar[4].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 257:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2141
+		{
+			yyLOCAL = tree.NewRollbackToSavePoint(tree.Identifier(yyDollar[5].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 258:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2146
+		{
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2148
+		{
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2152
+		{
+			yyLOCAL = tree.NewSavePoint(tree.Identifier(yyDollar[2].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2158
+		{
+			yyLOCAL = tree.NewReleaseSavePoint(tree.Identifier(yyDollar[3].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2093
+//line mysql_sql.y:2164
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2098
+//line mysql_sql.y:2169
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2102
+//line mysql_sql.y:2173
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2106
+//line mysql_sql.y:2177
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2110
+//line mysql_sql.y:2181
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 267:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2114
+//line mysql_sql.y:2185
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2118
+//line mysql_sql.y:2189
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 269:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2122
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2126
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2130
+//line mysql_sql.y:2201
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2136
+//line mysql_sql.y:2207
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2140
+//line mysql_sql.y:2211
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2144
+//line mysql_sql.y:2215
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2148
+//line mysql_sql.y:2219
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2153
+//line mysql_sql.y:2224
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 277:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2158
+//line mysql_sql.y:2229
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2164
+//line mysql_sql.y:2235
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9678,20 +10419,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2171
+//line mysql_sql.y:2242
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2177
+//line mysql_sql.y:2248
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9699,10 +10440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2184
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9710,10 +10451,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2191
+//line mysql_sql.y:2262
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9721,19 +10462,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2201
+//line mysql_sql.y:2272
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 285:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2208
+//line mysql_sql.y:2279
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -9745,10 +10486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 286:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2219
+//line mysql_sql.y:2290
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -9758,210 +10499,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2230
+//line mysql_sql.y:2301
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2234
+//line mysql_sql.y:2305
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:2240
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2250
+//line mysql_sql.y:2321
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2256
+//line mysql_sql.y:2327
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2260
+//line mysql_sql.y:2331
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2266
+//line mysql_sql.y:2337
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2272
+//line mysql_sql.y:2343
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2276
+//line mysql_sql.y:2347
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2280
+//line mysql_sql.y:2351
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2284
+//line mysql_sql.y:2355
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2290
+//line mysql_sql.y:2361
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2302
+//line mysql_sql.y:2373
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2308
+//line mysql_sql.y:2379
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2312
+//line mysql_sql.y:2383
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2318
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2322
+//line mysql_sql.y:2393
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2328
+//line mysql_sql.y:2399
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2334
+//line mysql_sql.y:2405
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2344
+//line mysql_sql.y:2415
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2350
+//line mysql_sql.y:2421
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2354
+//line mysql_sql.y:2425
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2358
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 322:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2362
+//line mysql_sql.y:2433
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2366
+//line mysql_sql.y:2437
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2370
+//line mysql_sql.y:2441
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -9970,10 +10711,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2378
+//line mysql_sql.y:2449
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9982,10 +10723,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2386
+//line mysql_sql.y:2457
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9996,10 +10737,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 327:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2396
+//line mysql_sql.y:2467
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -10012,66 +10753,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2437
+//line mysql_sql.y:2508
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2441
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2447
+//line mysql_sql.y:2518
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2453
+//line mysql_sql.y:2524
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2458
+//line mysql_sql.y:2529
 		{
 			yyVAL.str = "true"
 		}
-	case 333:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2459
+//line mysql_sql.y:2530
 		{
 			yyVAL.str = "false"
 		}
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2460
+//line mysql_sql.y:2531
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 335:
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2536
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 348:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2465
+//line mysql_sql.y:2540
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 355:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2480
+//line mysql_sql.y:2555
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -10081,10 +10830,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2491
+//line mysql_sql.y:2566
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -10092,26 +10841,146 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 357:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2573
+		{
+			yyLOCAL = &tree.AlterTable{
+				Table:   yyDollar[3].tableNameUnion(),
+				Options: []tree.AlterTableOption{yyDollar[4].alterTableOptionUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 358:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2582
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:        tree.AlterPartitionAdd,
+				Partitions: yyDollar[4].partitionsUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 359:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2589
+		{
+			res := yyDollar[4].item.(int64)
+			if res == 0 {
+				yylex.Error("partitions can not be 0")
+				return 1
+			}
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ: tree.AlterPartitionAdd,
+				Num: uint64(res),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 360:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2601
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionDrop,
+				Names: yyDollar[3].identifierListUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2608
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionTruncate,
+				Names: yyDollar[3].identifierListUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2615
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:           tree.AlterPartitionTruncate,
+				AllPartitions: true,
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 363:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2622
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:        tree.AlterPartitionReorganize,
+				Names:      yyDollar[3].identifierListUnion(),
+				Partitions: yyDollar[6].partitionsUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 364:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2630
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionExchange,
+				Names: tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table: yyDollar[6].tableNameUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 365:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2638
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionExchange,
+				Names: tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table: yyDollar[6].tableNameUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 366:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2646
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionPartition{
+				Typ:               tree.AlterPartitionExchange,
+				Names:             tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table:             yyDollar[6].tableNameUnion(),
+				WithoutValidation: true,
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2500
+//line mysql_sql.y:2657
 		{
 			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2504
+//line mysql_sql.y:2661
 		{
 			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2510
+//line mysql_sql.y:2667
 		{
 			opt := &tree.AlterOptionAdd{
 				Def: yyDollar[2].tableDefUnion(),
@@ -10119,34 +10988,139 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 370:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2674
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionAddColumn{
+				Column:   yyDollar[3].columnTableDefUnion(),
+				Position: yyDollar[4].columnPositionUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2681
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionAddColumn{
+				Column:   yyDollar[2].columnTableDefUnion(),
+				Position: yyDollar[3].columnPositionUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2688
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionModifyColumn{
+				Column:   yyDollar[3].columnTableDefUnion(),
+				Position: yyDollar[4].columnPositionUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2695
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionModifyColumn{
+				OldName:  tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Column:   yyDollar[4].columnTableDefUnion(),
+				Position: yyDollar[5].columnPositionUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2703
+		{
+			yyLOCAL = tree.AlterTableOption(&tree.AlterOptionRenameColumn{
+				OldName: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				NewName: tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2517
+//line mysql_sql.y:2710
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2521
+//line mysql_sql.y:2714
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2525
+//line mysql_sql.y:2718
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[1].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 378:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2723
+		{
+		}
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2725
+		{
+		}
+	case 380:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2728
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ: tree.ColumnPositionNone,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2734
+		{
+			yyLOCAL = yyDollar[1].columnPositionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2740
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ: tree.ColumnPositionFirst,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 383:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2746
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ:            tree.ColumnPositionAfter,
+				RelativeColumn: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2531
+//line mysql_sql.y:2755
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -10154,10 +11128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2538
+//line mysql_sql.y:2762
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -10165,10 +11139,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2545
+//line mysql_sql.y:2769
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -10176,10 +11150,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2552
+//line mysql_sql.y:2776
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -10187,20 +11161,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2559
+//line mysql_sql.y:2783
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2567
+//line mysql_sql.y:2791
 		{
 			yyLOCAL = &tree.AlterOptionAlterIndex{
 				Visibility: yyDollar[3].indexVisibilityUnion(),
@@ -10208,26 +11182,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2576
+//line mysql_sql.y:2800
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_VISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2580
+//line mysql_sql.y:2804
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_INVISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 392:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2587
+//line mysql_sql.y:2811
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -10238,10 +11212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 393:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2599
+//line mysql_sql.y:2823
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -10250,10 +11224,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 394:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2607
+//line mysql_sql.y:2831
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -10262,10 +11236,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 395:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2615
+//line mysql_sql.y:2839
 		{
 			assignments := []*tree.VarAssignmentExpr{
 				&tree.VarAssignmentExpr{
@@ -10278,20 +11252,20 @@ yydefault:
 			yyLOCAL = &tree.SetVar{Assignments: assignments}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2628
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2634
+//line mysql_sql.y:2858
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -10301,10 +11275,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 398:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2645
+//line mysql_sql.y:2869
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -10315,222 +11289,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2656
+//line mysql_sql.y:2880
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2660
+//line mysql_sql.y:2884
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2665
+//line mysql_sql.y:2889
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2669
+//line mysql_sql.y:2893
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2674
+//line mysql_sql.y:2898
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2678
+//line mysql_sql.y:2902
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2694
+//line mysql_sql.y:2918
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2698
+//line mysql_sql.y:2922
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2702
+//line mysql_sql.y:2926
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2706
+//line mysql_sql.y:2930
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2710
+//line mysql_sql.y:2934
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2714
+//line mysql_sql.y:2938
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2718
+//line mysql_sql.y:2942
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:2946
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2726
+//line mysql_sql.y:2950
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 414:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2730
+//line mysql_sql.y:2954
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2734
+//line mysql_sql.y:2958
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2738
+//line mysql_sql.y:2962
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2742
+//line mysql_sql.y:2966
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2746
+//line mysql_sql.y:2970
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2750
+//line mysql_sql.y:2974
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2754
+//line mysql_sql.y:2978
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2760
+//line mysql_sql.y:2984
 		{
 			yyVAL.item = nil
 		}
-	case 388:
+	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2765
+//line mysql_sql.y:2989
 		{
 			yyVAL.item = nil
 		}
-	case 418:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2804
+//line mysql_sql.y:3028
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2809
+//line mysql_sql.y:3033
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2813
+//line mysql_sql.y:3037
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2817
+//line mysql_sql.y:3041
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -10539,44 +11513,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2826
+//line mysql_sql.y:3050
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2830
+//line mysql_sql.y:3054
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 458:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2836
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 459:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2841
+//line mysql_sql.y:3065
 		{
 		}
-	case 427:
+	case 461:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2845
+//line mysql_sql.y:3069
 		{
 		}
-	case 429:
+	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2850
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -10585,10 +11559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2860
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -10597,68 +11571,68 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2870
+//line mysql_sql.y:3094
 		{
 			yyLOCAL = &tree.ShowRolesStmt{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2878
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2884
+//line mysql_sql.y:3108
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2890
+//line mysql_sql.y:3114
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2896
+//line mysql_sql.y:3120
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2902
+//line mysql_sql.y:3126
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2908
+//line mysql_sql.y:3132
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2914
+//line mysql_sql.y:3138
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -10666,74 +11640,74 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2923
+//line mysql_sql.y:3147
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2927
+//line mysql_sql.y:3151
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2931
+//line mysql_sql.y:3155
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2935
+//line mysql_sql.y:3159
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2939
+//line mysql_sql.y:3163
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2943
+//line mysql_sql.y:3167
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2947
+//line mysql_sql.y:3171
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2951
+//line mysql_sql.y:3175
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 481:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2957
+//line mysql_sql.y:3181
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -10741,10 +11715,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 482:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2964
+//line mysql_sql.y:3188
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -10754,20 +11728,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2974
+//line mysql_sql.y:3198
 		{
 		}
-	case 450:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2976
+//line mysql_sql.y:3200
 		{
 		}
-	case 454:
+	case 488:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2985
+//line mysql_sql.y:3209
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -10776,10 +11750,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 489:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2995
+//line mysql_sql.y:3219
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -10788,58 +11762,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3004
+//line mysql_sql.y:3228
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3008
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3012
+//line mysql_sql.y:3236
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3018
+//line mysql_sql.y:3242
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3024
+//line mysql_sql.y:3248
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3030
+//line mysql_sql.y:3254
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3036
+//line mysql_sql.y:3260
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -10847,10 +11821,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 497:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3045
+//line mysql_sql.y:3269
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -10861,10 +11835,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 498:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3055
+//line mysql_sql.y:3279
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -10875,26 +11849,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3067
+//line mysql_sql.y:3291
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 500:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3071
+//line mysql_sql.y:3295
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 501:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3077
+//line mysql_sql.y:3301
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -10907,10 +11881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 502:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3089
+//line mysql_sql.y:3313
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -10923,196 +11897,196 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3103
+//line mysql_sql.y:3327
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3109
+//line mysql_sql.y:3333
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3116
+//line mysql_sql.y:3340
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3121
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3125
+//line mysql_sql.y:3349
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3129
+//line mysql_sql.y:3353
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3134
+//line mysql_sql.y:3358
 		{
 			yyVAL.str = ""
 		}
-	case 476:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3138
+//line mysql_sql.y:3362
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 477:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3144
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3157
+//line mysql_sql.y:3381
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3161
+//line mysql_sql.y:3385
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 518:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3167
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 519:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3172
+//line mysql_sql.y:3396
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 520:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3176
+//line mysql_sql.y:3400
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3180
+//line mysql_sql.y:3404
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3186
+//line mysql_sql.y:3410
 		{
 			yyLOCAL = &tree.ShowBackendServers{}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3192
+//line mysql_sql.y:3416
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3196
+//line mysql_sql.y:3420
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3202
+//line mysql_sql.y:3426
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 492:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3208
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3212
+//line mysql_sql.y:3436
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 528:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3216
+//line mysql_sql.y:3440
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3222
+//line mysql_sql.y:3446
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3226
+//line mysql_sql.y:3450
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3249
+//line mysql_sql.y:3474
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11120,10 +12094,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3258
+//line mysql_sql.y:3483
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11131,10 +12105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3267
+//line mysql_sql.y:3492
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11142,26 +12116,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3276
+//line mysql_sql.y:3501
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3280
+//line mysql_sql.y:3505
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3286
+//line mysql_sql.y:3511
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -11169,10 +12143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3295
+//line mysql_sql.y:3520
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11180,10 +12154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 552:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3304
+//line mysql_sql.y:3529
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -11192,42 +12166,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 553:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3314
+//line mysql_sql.y:3539
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3320
+//line mysql_sql.y:3545
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 555:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3549
+		{
+			yyLOCAL = &tree.DropView{IfExists: yyDollar[4].boolValUnion(), Names: yyDollar[5].tableNamesUnion(), Materialized: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3326
+//line mysql_sql.y:3555
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3332
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 558:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3338
+//line mysql_sql.y:3567
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -11235,10 +12217,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3347
+//line mysql_sql.y:3576
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[3].procNameUnion(),
@@ -11246,10 +12228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 560:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3354
+//line mysql_sql.y:3583
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[5].procNameUnion(),
@@ -11257,28 +12239,39 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 561:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3592
+		{
+			yyLOCAL = &tree.DropTrigger{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     yyDollar[4].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3365
+//line mysql_sql.y:3603
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3370
+//line mysql_sql.y:3608
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 566:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3377
+//line mysql_sql.y:3615
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -11295,10 +12288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 567:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3393
+//line mysql_sql.y:3631
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11308,10 +12301,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 568:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3406
+//line mysql_sql.y:3644
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11321,69 +12314,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3417
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3421
+//line mysql_sql.y:3659
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3427
+//line mysql_sql.y:3665
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3432
+//line mysql_sql.y:3670
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3439
+//line mysql_sql.y:3677
 		{
 		}
-	case 537:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3441
+//line mysql_sql.y:3679
 		{
 		}
-	case 538:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3444
+//line mysql_sql.y:3682
 		{
 		}
-	case 543:
+	case 580:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3453
+//line mysql_sql.y:3691
 		{
 		}
-	case 545:
+	case 582:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3457
+//line mysql_sql.y:3695
 		{
 		}
-	case 547:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3462
+//line mysql_sql.y:3700
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -11391,10 +12384,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3471
+//line mysql_sql.y:3709
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11402,20 +12395,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3478
+//line mysql_sql.y:3716
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 587:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3484
+//line mysql_sql.y:3722
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11424,10 +12417,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3492
+//line mysql_sql.y:3730
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11435,10 +12428,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3499
+//line mysql_sql.y:3737
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11446,10 +12439,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3506
+//line mysql_sql.y:3744
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -11468,10 +12461,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 591:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3526
+//line mysql_sql.y:3764
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -11480,42 +12473,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 592:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3535
+//line mysql_sql.y:3773
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3539
+//line mysql_sql.y:3777
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3545
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3549
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3555
+//line mysql_sql.y:3793
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11523,20 +12516,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3562
+//line mysql_sql.y:3800
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 598:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3568
+//line mysql_sql.y:3806
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11545,10 +12538,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3576
+//line mysql_sql.y:3814
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11556,10 +12549,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3583
+//line mysql_sql.y:3821
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11567,10 +12560,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3590
+//line mysql_sql.y:3828
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -11589,50 +12582,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3609
+//line mysql_sql.y:3847
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 603:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3613
+//line mysql_sql.y:3851
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3618
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3622
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3626
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3632
+//line mysql_sql.y:3870
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -11640,155 +12633,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3641
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3645
+//line mysql_sql.y:3883
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3651
+//line mysql_sql.y:3889
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 574:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3655
+//line mysql_sql.y:3893
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 575:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3661
+//line mysql_sql.y:3899
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3665
+//line mysql_sql.y:3903
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3671
+//line mysql_sql.y:3909
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3676
+//line mysql_sql.y:3914
 		{
 		}
-	case 580:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3680
+//line mysql_sql.y:3918
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3687
+//line mysql_sql.y:3925
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3691
+//line mysql_sql.y:3929
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3698
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3703
+//line mysql_sql.y:3941
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 624:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3707
+//line mysql_sql.y:3945
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3713
+//line mysql_sql.y:3951
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3717
+//line mysql_sql.y:3955
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3723
+//line mysql_sql.y:3961
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3727
+//line mysql_sql.y:3965
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3732
+//line mysql_sql.y:3970
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 630:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3736
+//line mysql_sql.y:3974
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -11801,10 +12794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 631:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3749
+//line mysql_sql.y:3987
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -11812,10 +12805,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3756
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -11823,10 +12816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 633:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3763
+//line mysql_sql.y:4001
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -11845,10 +12838,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 634:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3781
+//line mysql_sql.y:4019
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -11867,38 +12860,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3800
+//line mysql_sql.y:4038
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3806
+//line mysql_sql.y:4044
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3813
+//line mysql_sql.y:4051
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3817
+//line mysql_sql.y:4055
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -11911,115 +12904,187 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3830
+//line mysql_sql.y:4068
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3834
+//line mysql_sql.y:4072
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 641:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3839
+//line mysql_sql.y:4077
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3843
+//line mysql_sql.y:4081
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3850
+//line mysql_sql.y:4088
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3855
+//line mysql_sql.y:4093
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3862
+//line mysql_sql.y:4100
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 647:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3868
+//line mysql_sql.y:4106
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Lock: yyDollar[4].selectLockInfoUnion(), Ep: yyDollar[5].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3872
+//line mysql_sql.y:4110
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 649:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3876
+//line mysql_sql.y:4114
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 650:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3880
+//line mysql_sql.y:4118
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Lock: yyDollar[5].selectLockInfoUnion(), Ep: yyDollar[6].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 651:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3884
+//line mysql_sql.y:4122
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 652:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3888
+//line mysql_sql.y:4126
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 653:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:4131
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 654:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:4135
+		{
+			yyLOCAL = &tree.SelectLockInfo{LockType: tree.SelectLockForUpdate, Tables: yyDollar[3].tableNamesUnion(), Wait: yyDollar[4].selectLockWaitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 655:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:4139
+		{
+			yyLOCAL = &tree.SelectLockInfo{LockType: tree.SelectLockForShare, Tables: yyDollar[3].tableNamesUnion(), Wait: yyDollar[4].selectLockWaitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 656:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:4143
+		{
+			yyLOCAL = &tree.SelectLockInfo{LockType: tree.SelectLockInShareMode}
+		}
+		yyVAL.union = yyLOCAL
+	case 657:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line mysql_sql.y:4148
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 658:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line mysql_sql.y:4152
+		{
+			yyLOCAL = yyDollar[2].tableNamesUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 659:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:4157
+		{
+			yyLOCAL = tree.SelectLockWaitDefault
+		}
+		yyVAL.union = yyLOCAL
+	case 660:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:4161
+		{
+			yyLOCAL = tree.SelectLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 661:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:4165
+		{
+			yyLOCAL = tree.SelectLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3894
+//line mysql_sql.y:4171
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -12027,10 +13092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3901
+//line mysql_sql.y:4178
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -12038,26 +13103,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3910
+//line mysql_sql.y:4187
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3914
+//line mysql_sql.y:4191
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 666:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3920
+//line mysql_sql.y:4197
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -12065,178 +13130,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3928
+//line mysql_sql.y:4205
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3932
+//line mysql_sql.y:4209
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3937
+//line mysql_sql.y:4214
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3941
+//line mysql_sql.y:4218
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3947
+//line mysql_sql.y:4224
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 672:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3951
+//line mysql_sql.y:4228
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 673:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3955
+//line mysql_sql.y:4232
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3960
+//line mysql_sql.y:4237
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3964
+//line mysql_sql.y:4241
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3970
+//line mysql_sql.y:4247
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3976
+//line mysql_sql.y:4253
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3980
+//line mysql_sql.y:4257
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3986
+//line mysql_sql.y:4263
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3991
+//line mysql_sql.y:4268
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3995
+//line mysql_sql.y:4272
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3999
+//line mysql_sql.y:4276
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4004
+//line mysql_sql.y:4281
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4008
+//line mysql_sql.y:4285
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4012
+//line mysql_sql.y:4289
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4018
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4022
+//line mysql_sql.y:4299
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4026
+//line mysql_sql.y:4303
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -12249,18 +13314,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4040
+//line mysql_sql.y:4317
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4044
+//line mysql_sql.y:4321
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12271,10 +13336,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4054
+//line mysql_sql.y:4331
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12285,10 +13350,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4064
+//line mysql_sql.y:4341
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12299,10 +13364,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4074
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12313,10 +13378,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4086
+//line mysql_sql.y:4363
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12325,10 +13390,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4094
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12337,10 +13402,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4102
+//line mysql_sql.y:4379
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12349,10 +13414,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4111
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12361,10 +13426,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4119
+//line mysql_sql.y:4396
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12373,10 +13438,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4127
+//line mysql_sql.y:4404
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12385,10 +13450,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4135
+//line mysql_sql.y:4412
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12397,10 +13462,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4143
+//line mysql_sql.y:4420
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12409,10 +13474,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4151
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12421,10 +13486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4159
+//line mysql_sql.y:4436
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12433,10 +13498,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4167
+//line mysql_sql.y:4444
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12445,10 +13510,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4175
+//line mysql_sql.y:4452
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12457,10 +13522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 706:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4185
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -12472,10 +13537,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 707:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4196
+//line mysql_sql.y:4473
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -12488,149 +13553,149 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4210
+//line mysql_sql.y:4487
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 663:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4214
+//line mysql_sql.y:4491
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 664:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4218
+//line mysql_sql.y:4495
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 665:
+	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4223
+//line mysql_sql.y:4500
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4227
+//line mysql_sql.y:4504
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4231
+//line mysql_sql.y:4508
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4240
+//line mysql_sql.y:4517
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4244
+//line mysql_sql.y:4521
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 718:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4249
+//line mysql_sql.y:4526
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4253
+//line mysql_sql.y:4530
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 720:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4258
+//line mysql_sql.y:4535
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4262
+//line mysql_sql.y:4539
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4268
+//line mysql_sql.y:4545
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4272
+//line mysql_sql.y:4549
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4278
+//line mysql_sql.y:4555
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4282
+//line mysql_sql.y:4559
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4287
+//line mysql_sql.y:4564
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 727:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4291
+//line mysql_sql.y:4568
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 728:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4296
+//line mysql_sql.y:4573
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -12639,28 +13704,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4304
+//line mysql_sql.y:4581
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4310
+//line mysql_sql.y:4587
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4318
+//line mysql_sql.y:4595
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -12669,26 +13734,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4326
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4336
+//line mysql_sql.y:4613
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 736:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4342
+//line mysql_sql.y:4619
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12698,10 +13763,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 737:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4351
+//line mysql_sql.y:4628
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12711,10 +13776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 738:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4360
+//line mysql_sql.y:4637
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12724,10 +13789,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4369
+//line mysql_sql.y:4646
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12736,15 +13801,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4379
+//line mysql_sql.y:4656
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 695:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4383
+//line mysql_sql.y:4660
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -12752,34 +13817,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 696:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4393
+//line mysql_sql.y:4670
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 697:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4397
+//line mysql_sql.y:4674
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 698:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4401
+//line mysql_sql.y:4678
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 699:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4405
+//line mysql_sql.y:4682
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 700:
+	case 746:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4411
+//line mysql_sql.y:4688
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -12788,130 +13853,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4421
+//line mysql_sql.y:4698
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4425
+//line mysql_sql.y:4702
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 749:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4431
+//line mysql_sql.y:4708
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 750:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4437
+//line mysql_sql.y:4714
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4441
+//line mysql_sql.y:4718
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4447
+//line mysql_sql.y:4724
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 707:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4453
+//line mysql_sql.y:4730
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 708:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4457
+//line mysql_sql.y:4734
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 709:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4461
+//line mysql_sql.y:4738
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 710:
+	case 756:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4467
+//line mysql_sql.y:4744
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4471
+//line mysql_sql.y:4748
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4477
+//line mysql_sql.y:4754
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 759:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4481
+//line mysql_sql.y:4758
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4487
+//line mysql_sql.y:4764
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4491
+//line mysql_sql.y:4768
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4497
+//line mysql_sql.y:4774
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4501
+//line mysql_sql.y:4778
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -12922,10 +13987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4511
+//line mysql_sql.y:4788
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -12939,26 +14004,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4524
+//line mysql_sql.y:4801
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4807
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4536
+//line mysql_sql.y:4813
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -12970,48 +14035,235 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 768:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:4824
+		{
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
+			yyLOCAL = &tree.TableFunction{
+				Func: &tree.FuncExpr{
+					Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_table")),
+					Exprs: tree.Exprs{yyDollar[3].exprUnion(), path},
+					Type:  tree.FUNC_TYPE_TABLE,
+				},
+				JsonColumns: yyDollar[8].jsonTableColumnsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:4838
+		{
+			yyLOCAL = []*tree.JsonTableColumn{yyDollar[1].jsonTableColumnUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:4842
+		{
+			yyLOCAL = append(yyDollar[1].jsonTableColumnsUnion(), yyDollar[3].jsonTableColumnUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4848
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type: tree.JsonTableColumnOrdinality,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4855
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JsonTableColumnPath,
+				Name:    tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				ColType: yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[4].str,
+				OnEmpty: yyDollar[5].jsonTableColumnUnion().OnEmpty,
+				OnError: yyDollar[5].jsonTableColumnUnion().OnError,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4866
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JsonTableColumnExists,
+				Name:    tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				ColType: yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4875
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JsonTableColumnNested,
+				Path:    yyDollar[3].str,
+				Columns: yyDollar[6].jsonTableColumnsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4883
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JsonTableColumnNested,
+				Path:    yyDollar[2].str,
+				Columns: yyDollar[5].jsonTableColumnsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4892
+		{
+			yyLOCAL = &tree.JsonTableColumn{}
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4896
+		{
+			yyLOCAL = yyDollar[1].jsonTableColumnUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4900
+		{
+			if yyDollar[1].jsonTableColumnUnion().OnEmpty == nil || yyDollar[2].jsonTableColumnUnion().OnError == nil {
+				yylex.Error("ON EMPTY must be followed by ON ERROR")
+				return 1
+			}
+			yyLOCAL = &tree.JsonTableColumn{
+				OnEmpty: yyDollar[1].jsonTableColumnUnion().OnEmpty,
+				OnError: yyDollar[2].jsonTableColumnUnion().OnError,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4913
+		{
+			switch strings.ToLower(yyDollar[3].cstrUnion().Origin()) {
+			case "empty":
+				yyLOCAL = &tree.JsonTableColumn{OnEmpty: yyDollar[1].jsonTableResponseUnion()}
+			case "error":
+				yyLOCAL = &tree.JsonTableColumn{OnError: yyDollar[1].jsonTableResponseUnion()}
+			default:
+				yylex.Error("expect ON EMPTY or ON ERROR")
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4927
+		{
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JsonTableResponseNull}
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4931
+		{
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JsonTableResponseDefault, Value: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4935
+		{
+			if strings.ToLower(yyDollar[1].cstrUnion().Origin()) != "error" {
+				yylex.Error("expect NULL, ERROR or DEFAULT")
+				return 1
+			}
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JsonTableResponseError}
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4549
+//line mysql_sql.y:4945
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
+				AsOf: yyDollar[2].asOfClauseUnion(),
 				As: tree.AliasClause{
-					Alias: tree.Identifier(yyDollar[2].str),
+					Alias: tree.Identifier(yyDollar[3].str),
 				},
-				IndexHints: yyDollar[3].indexHintListUnion(),
+				IndexHints: yyDollar[4].indexHintListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 784:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:4957
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:4961
+		{
+			yyLOCAL = &tree.AsOfClause{Expr: yyDollar[5].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4560
+//line mysql_sql.y:4966
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4567
+//line mysql_sql.y:4973
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4571
+//line mysql_sql.y:4977
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 790:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4577
+//line mysql_sql.y:4983
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -13020,176 +14272,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4587
+//line mysql_sql.y:4993
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4591
+//line mysql_sql.y:4997
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4595
+//line mysql_sql.y:5001
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 794:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4600
+//line mysql_sql.y:5006
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4604
+//line mysql_sql.y:5010
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4608
+//line mysql_sql.y:5014
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4612
+//line mysql_sql.y:5018
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 798:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4617
+//line mysql_sql.y:5023
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4621
+//line mysql_sql.y:5027
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 800:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4625
+//line mysql_sql.y:5031
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4629
+//line mysql_sql.y:5035
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 802:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4633
+//line mysql_sql.y:5039
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 803:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4638
+//line mysql_sql.y:5044
 		{
 			yyVAL.str = ""
 		}
-	case 741:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4642
+//line mysql_sql.y:5048
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 742:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4646
+//line mysql_sql.y:5052
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 743:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4652
+//line mysql_sql.y:5058
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 745:
+	case 808:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4658
+//line mysql_sql.y:5064
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4662
+//line mysql_sql.y:5068
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4666
+//line mysql_sql.y:5072
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4670
+//line mysql_sql.y:5076
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4674
+//line mysql_sql.y:5080
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4680
+//line mysql_sql.y:5086
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 764:
+	case 828:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4713
+//line mysql_sql.y:5120
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -13198,22 +14450,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4723
+//line mysql_sql.y:5130
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 766:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4729
+//line mysql_sql.y:5136
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 767:
+	case 831:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4735
+//line mysql_sql.y:5142
 		{
 			yyLOCAL = &tree.CreateProcedure{
 				Name: yyDollar[3].procNameUnion(),
@@ -13222,100 +14474,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 832:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5152
+		{
+			yyLOCAL = &tree.CreateTrigger{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        yyDollar[4].tableNameUnion(),
+				Time:        yyDollar[5].triggerTimeUnion(),
+				Event:       yyDollar[6].triggerEventUnion(),
+				Table:       yyDollar[8].tableNameUnion(),
+				Body:        yyDollar[12].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 833:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerTime
+//line mysql_sql.y:5165
+		{
+			yyLOCAL = tree.TriggerBefore
+		}
+		yyVAL.union = yyLOCAL
+	case 834:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerTime
+//line mysql_sql.y:5169
+		{
+			yyLOCAL = tree.TriggerAfter
+		}
+		yyVAL.union = yyLOCAL
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:5175
+		{
+			yyLOCAL = tree.TriggerInsert
+		}
+		yyVAL.union = yyLOCAL
+	case 836:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:5179
+		{
+			yyLOCAL = tree.TriggerUpdate
+		}
+		yyVAL.union = yyLOCAL
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:5183
+		{
+			yyLOCAL = tree.TriggerDelete
+		}
+		yyVAL.union = yyLOCAL
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4745
+//line mysql_sql.y:5189
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4750
+//line mysql_sql.y:5194
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().ToLower()), ExplicitSchema: true}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 840:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4756
+//line mysql_sql.y:5200
 		{
 			yyLOCAL = tree.ProcedureArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4763
+//line mysql_sql.y:5207
 		{
 			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 843:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4767
+//line mysql_sql.y:5211
 		{
 			yyLOCAL = append(yyDollar[1].procArgsUnion(), yyDollar[3].procArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArg
-//line mysql_sql.y:4773
+//line mysql_sql.y:5217
 		{
 			yyLOCAL = tree.ProcedureArg(yyDollar[1].procArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureArgDecl
-//line mysql_sql.y:4779
+//line mysql_sql.y:5223
 		{
 			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 846:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4784
+//line mysql_sql.y:5228
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4788
+//line mysql_sql.y:5232
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4792
+//line mysql_sql.y:5236
 		{
 			yyLOCAL = tree.TYPE_OUT
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4796
+//line mysql_sql.y:5240
 		{
 			yyLOCAL = tree.TYPE_INOUT
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 850:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4803
+//line mysql_sql.y:5247
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -13326,98 +14633,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4815
+//line mysql_sql.y:5259
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4820
+//line mysql_sql.y:5264
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 853:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4826
+//line mysql_sql.y:5270
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4833
+//line mysql_sql.y:5277
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4837
+//line mysql_sql.y:5281
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4843
+//line mysql_sql.y:5287
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4849
+//line mysql_sql.y:5293
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 859:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4853
+//line mysql_sql.y:5297
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 860:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4857
+//line mysql_sql.y:5301
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4863
+//line mysql_sql.y:5307
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 792:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4869
+//line mysql_sql.y:5313
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 863:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4875
+//line mysql_sql.y:5319
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -13427,10 +14734,24 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 864:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5328
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:         yyDollar[5].tableNameUnion(),
+				ColNames:     yyDollar[6].identifierListUnion(),
+				AsSource:     yyDollar[8].selectUnion(),
+				IfNotExists:  yyDollar[4].ifNotExistsUnion(),
+				Materialized: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 865:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4886
+//line mysql_sql.y:5340
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -13441,16 +14762,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4898
+//line mysql_sql.y:5352
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 796:
+	case 867:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4904
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -13459,22 +14780,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4914
+//line mysql_sql.y:5368
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 798:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4918
+//line mysql_sql.y:5372
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 799:
+	case 870:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4924
+//line mysql_sql.y:5378
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13482,20 +14803,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 871:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4931
+//line mysql_sql.y:5385
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4937
+//line mysql_sql.y:5391
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13503,20 +14824,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4945
+//line mysql_sql.y:5399
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4951
+//line mysql_sql.y:5405
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13524,10 +14845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4958
+//line mysql_sql.y:5412
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13535,20 +14856,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 876:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4966
+//line mysql_sql.y:5420
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4972
+//line mysql_sql.y:5426
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -13556,10 +14877,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 878:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4981
+//line mysql_sql.y:5435
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13570,10 +14891,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 879:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4993
+//line mysql_sql.y:5447
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13584,22 +14905,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 880:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5004
+//line mysql_sql.y:5458
 		{
 			yyVAL.str = ""
 		}
-	case 810:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5008
+//line mysql_sql.y:5462
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 811:
+	case 882:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5014
+//line mysql_sql.y:5468
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -13609,58 +14930,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 883:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5024
+//line mysql_sql.y:5478
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5028
+//line mysql_sql.y:5482
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5034
+//line mysql_sql.y:5488
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5040
+//line mysql_sql.y:5494
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5046
+//line mysql_sql.y:5500
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5055
+//line mysql_sql.y:5509
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -13668,26 +14989,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5064
+//line mysql_sql.y:5518
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 819:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5069
+//line mysql_sql.y:5523
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5075
+//line mysql_sql.y:5529
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13696,10 +15017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5083
+//line mysql_sql.y:5537
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13708,26 +15029,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5189
+//line mysql_sql.y:5643
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 894:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5193
+//line mysql_sql.y:5647
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5199
+//line mysql_sql.y:5653
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13736,26 +15057,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5209
+//line mysql_sql.y:5663
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5213
+//line mysql_sql.y:5667
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 898:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5219
+//line mysql_sql.y:5673
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13764,50 +15085,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5229
+//line mysql_sql.y:5683
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5233
+//line mysql_sql.y:5687
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 901:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5237
+//line mysql_sql.y:5691
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 902:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5242
+//line mysql_sql.y:5696
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5246
+//line mysql_sql.y:5700
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 904:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5252
+//line mysql_sql.y:5706
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13815,20 +15136,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 905:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5259
+//line mysql_sql.y:5713
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5265
+//line mysql_sql.y:5719
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13836,16 +15157,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5274
+//line mysql_sql.y:5728
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 838:
+	case 909:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5281
+//line mysql_sql.y:5735
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13853,90 +15174,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5290
+//line mysql_sql.y:5744
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 911:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5294
+//line mysql_sql.y:5748
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:5300
+//line mysql_sql.y:5754
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5314
+//line mysql_sql.y:5768
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5318
+//line mysql_sql.y:5772
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5322
+//line mysql_sql.y:5776
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5327
+//line mysql_sql.y:5781
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5331
+//line mysql_sql.y:5785
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5335
+//line mysql_sql.y:5789
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5339
+//line mysql_sql.y:5793
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 920:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5345
+//line mysql_sql.y:5799
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -13955,18 +15276,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 921:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5364
+//line mysql_sql.y:5818
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 922:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5368
+//line mysql_sql.y:5822
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -13982,128 +15303,146 @@ yydefault:
 					opt1.ParserName = opt2.ParserName
 				} else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
 					opt1.Visible = opt2.Visible
+				} else if opt2.Lists > 0 {
+					opt1.Lists = opt2.Lists
 				}
 				yyLOCAL = opt1
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5390
+//line mysql_sql.y:5846
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5394
+//line mysql_sql.y:5850
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5398
+//line mysql_sql.y:5854
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5402
+//line mysql_sql.y:5858
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5406
+//line mysql_sql.y:5862
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 928:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line mysql_sql.y:5866
+		{
+			yyLOCAL = &tree.IndexOption{Lists: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5412
+//line mysql_sql.y:5872
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5416
+//line mysql_sql.y:5876
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5422
+//line mysql_sql.y:5882
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 932:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5427
+//line mysql_sql.y:5887
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5432
+//line mysql_sql.y:5892
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5436
+//line mysql_sql.y:5896
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5440
+//line mysql_sql.y:5900
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5444
+//line mysql_sql.y:5904
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5448
+//line mysql_sql.y:5908
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 938:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line mysql_sql.y:5912
+		{
+			yyLOCAL = tree.INDEX_TYPE_IVFFLAT
+		}
+		yyVAL.union = yyLOCAL
+	case 939:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5454
+//line mysql_sql.y:5918
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -14113,114 +15452,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5465
+//line mysql_sql.y:5929
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 941:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5469
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5479
+//line mysql_sql.y:5943
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5483
+//line mysql_sql.y:5947
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5488
+//line mysql_sql.y:5952
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5492
+//line mysql_sql.y:5956
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5498
+//line mysql_sql.y:5962
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5502
+//line mysql_sql.y:5966
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 950:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5508
+//line mysql_sql.y:5972
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5512
+//line mysql_sql.y:5976
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5516
+//line mysql_sql.y:5980
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5521
+//line mysql_sql.y:5985
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5525
+//line mysql_sql.y:5989
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 955:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5531
+//line mysql_sql.y:5995
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -14233,10 +15572,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 956:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5543
+//line mysql_sql.y:6007
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -14246,10 +15585,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 957:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5552
+//line mysql_sql.y:6016
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -14262,19 +15601,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5565
+//line mysql_sql.y:6029
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5572
+//line mysql_sql.y:6036
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14285,10 +15624,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5582
+//line mysql_sql.y:6046
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14297,10 +15636,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 961:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5590
+//line mysql_sql.y:6054
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14310,43 +15649,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5601
+//line mysql_sql.y:6065
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5605
+//line mysql_sql.y:6069
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5610
+//line mysql_sql.y:6074
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5614
+//line mysql_sql.y:6078
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 966:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5621
+//line mysql_sql.y:6085
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -14357,10 +15696,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 967:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5632
+//line mysql_sql.y:6096
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14374,10 +15713,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5645
+//line mysql_sql.y:6109
 		{
 			locale := ""
 			fstr := "bigint"
@@ -14392,26 +15731,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 969:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5659
+//line mysql_sql.y:6123
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 970:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5663
+//line mysql_sql.y:6127
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5667
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14419,10 +15758,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 972:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5674
+//line mysql_sql.y:6138
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14430,10 +15769,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5681
+//line mysql_sql.y:6145
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14441,10 +15780,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5688
+//line mysql_sql.y:6152
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14452,42 +15791,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5695
+//line mysql_sql.y:6159
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 976:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5699
+//line mysql_sql.y:6163
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5703
+//line mysql_sql.y:6167
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5707
+//line mysql_sql.y:6171
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 979:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5711
+//line mysql_sql.y:6175
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -14495,10 +15834,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5718
+//line mysql_sql.y:6182
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -14506,18 +15845,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5725
+//line mysql_sql.y:6189
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5729
+//line mysql_sql.y:6193
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -14525,10 +15864,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 983:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5736
+//line mysql_sql.y:6200
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -14536,18 +15875,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 984:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5743
+//line mysql_sql.y:6207
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5747
+//line mysql_sql.y:6211
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14555,10 +15894,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 986:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5754
+//line mysql_sql.y:6218
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14566,10 +15905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 987:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5761
+//line mysql_sql.y:6225
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14577,10 +15916,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5768
+//line mysql_sql.y:6232
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14588,34 +15927,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 989:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5775
+//line mysql_sql.y:6239
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5779
+//line mysql_sql.y:6243
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 991:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5784
+//line mysql_sql.y:6248
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 992:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5788
+//line mysql_sql.y:6252
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -14625,18 +15964,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 993:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5798
+//line mysql_sql.y:6262
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5802
+//line mysql_sql.y:6266
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -14644,28 +15983,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 995:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5809
+//line mysql_sql.y:6273
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5816
+//line mysql_sql.y:6280
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 997:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5820
+//line mysql_sql.y:6284
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -14674,42 +16013,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 998:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5829
+//line mysql_sql.y:6293
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5833
+//line mysql_sql.y:6297
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5839
+//line mysql_sql.y:6303
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5843
+//line mysql_sql.y:6307
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 1002:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5849
+//line mysql_sql.y:6313
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14719,10 +16058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 1003:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5858
+//line mysql_sql.y:6322
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14732,42 +16071,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 1004:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5868
+//line mysql_sql.y:6332
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 1005:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5872
+//line mysql_sql.y:6336
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5878
+//line mysql_sql.y:6342
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 1007:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5882
+//line mysql_sql.y:6346
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5888
+//line mysql_sql.y:6352
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14775,10 +16114,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 1009:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5895
+//line mysql_sql.y:6359
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14786,67 +16125,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5904
+//line mysql_sql.y:6368
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 1011:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5908
+//line mysql_sql.y:6372
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1012:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5913
+//line mysql_sql.y:6377
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1013:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5917
+//line mysql_sql.y:6381
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1014:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5922
+//line mysql_sql.y:6386
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1015:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5926
+//line mysql_sql.y:6390
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5931
+//line mysql_sql.y:6395
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1017:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5935
+//line mysql_sql.y:6399
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14856,18 +16195,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1018:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5945
+//line mysql_sql.y:6409
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1019:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5949
+//line mysql_sql.y:6413
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14877,10 +16216,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1020:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5960
+//line mysql_sql.y:6424
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14889,10 +16228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1021:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5968
+//line mysql_sql.y:6432
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14901,10 +16240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1022:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5976
+//line mysql_sql.y:6440
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14913,10 +16252,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1023:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5984
+//line mysql_sql.y:6448
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14925,10 +16264,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1025:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5995
+//line mysql_sql.y:6459
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14938,10 +16277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1026:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6004
+//line mysql_sql.y:6468
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14952,10 +16291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1027:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6014
+//line mysql_sql.y:6478
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -14965,487 +16304,487 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6024
+//line mysql_sql.y:6488
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1029:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6028
+//line mysql_sql.y:6492
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1030:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6033
+//line mysql_sql.y:6497
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6037
+//line mysql_sql.y:6501
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6042
+//line mysql_sql.y:6506
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6046
+//line mysql_sql.y:6510
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6052
+//line mysql_sql.y:6516
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6056
+//line mysql_sql.y:6520
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1036:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6060
+//line mysql_sql.y:6524
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6066
+//line mysql_sql.y:6530
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6070
+//line mysql_sql.y:6534
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1039:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6074
+//line mysql_sql.y:6538
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1040:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6078
+//line mysql_sql.y:6542
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6082
+//line mysql_sql.y:6546
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6086
+//line mysql_sql.y:6550
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6091
+//line mysql_sql.y:6555
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6095
+//line mysql_sql.y:6559
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1045:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6099
+//line mysql_sql.y:6563
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1046:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6103
+//line mysql_sql.y:6567
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1047:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6107
+//line mysql_sql.y:6571
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1048:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6111
+//line mysql_sql.y:6575
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1049:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6115
+//line mysql_sql.y:6579
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1050:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6119
+//line mysql_sql.y:6583
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1051:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6123
+//line mysql_sql.y:6587
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1052:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6127
+//line mysql_sql.y:6591
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1053:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6131
+//line mysql_sql.y:6595
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1054:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6135
+//line mysql_sql.y:6599
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1055:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6139
+//line mysql_sql.y:6603
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1056:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6143
+//line mysql_sql.y:6607
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1057:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6147
+//line mysql_sql.y:6611
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1058:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6151
+//line mysql_sql.y:6615
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1059:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6155
+//line mysql_sql.y:6619
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1060:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6159
+//line mysql_sql.y:6623
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1061:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6163
+//line mysql_sql.y:6627
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1062:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6167
+//line mysql_sql.y:6631
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1063:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6171
+//line mysql_sql.y:6635
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1064:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6175
+//line mysql_sql.y:6639
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1065:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6179
+//line mysql_sql.y:6643
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1066:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6186
+//line mysql_sql.y:6650
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1067:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6190
+//line mysql_sql.y:6654
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1068:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:6196
+//line mysql_sql.y:6660
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1069:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6201
+//line mysql_sql.y:6665
 		{
 			yyVAL.str = ""
 		}
-	case 997:
+	case 1070:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6205
+//line mysql_sql.y:6669
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 998:
+	case 1071:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6209
+//line mysql_sql.y:6673
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 999:
+	case 1072:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6215
+//line mysql_sql.y:6679
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6219
+//line mysql_sql.y:6683
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1074:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6223
+//line mysql_sql.y:6687
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6227
+//line mysql_sql.y:6691
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6231
+//line mysql_sql.y:6695
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6235
+//line mysql_sql.y:6699
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1082:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6249
+//line mysql_sql.y:6713
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1083:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6253
+//line mysql_sql.y:6717
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1084:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6262
+//line mysql_sql.y:6726
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1085:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6267
+//line mysql_sql.y:6731
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1086:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6273
+//line mysql_sql.y:6737
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1088:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6280
+//line mysql_sql.y:6744
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1089:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6284
+//line mysql_sql.y:6748
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6290
+//line mysql_sql.y:6754
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1091:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6294
+//line mysql_sql.y:6758
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1092:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6298
+//line mysql_sql.y:6762
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1093:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6304
+//line mysql_sql.y:6768
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15455,10 +16794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1094:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6313
+//line mysql_sql.y:6777
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -15468,6 +16807,8 @@ yydefault:
 					keyTyp = tree.INDEX_TYPE_ZONEMAP
 				case "bsi":
 					keyTyp = tree.INDEX_TYPE_BSI
+				case "ivfflat":
+					keyTyp = tree.INDEX_TYPE_IVFFLAT
 				default:
 					yylex.Error("Invail the type of index")
 					return 1
@@ -15482,10 +16823,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1095:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6338
+//line mysql_sql.y:6804
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -15500,18 +16841,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1096:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6352
+//line mysql_sql.y:6818
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1097:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6358
+//line mysql_sql.y:6824
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15521,10 +16862,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1098:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6367
+//line mysql_sql.y:6833
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15534,10 +16875,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1099:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6376
+//line mysql_sql.y:6842
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15548,10 +16889,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1100:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6386
+//line mysql_sql.y:6852
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -15559,297 +16900,297 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1101:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6394
+//line mysql_sql.y:6860
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1103:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6400
+//line mysql_sql.y:6866
 		{
 			yyVAL.str = ""
 		}
-	case 1031:
+	case 1104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6404
+//line mysql_sql.y:6870
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1034:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6414
+//line mysql_sql.y:6880
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6420
+//line mysql_sql.y:6886
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1109:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6426
+//line mysql_sql.y:6892
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6440
+//line mysql_sql.y:6907
 		{
 			yyVAL.str = ""
 		}
-	case 1043:
+	case 1117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6444
+//line mysql_sql.y:6911
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 1044:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:6450
+//line mysql_sql.y:6917
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6456
+//line mysql_sql.y:6923
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1120:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6460
+//line mysql_sql.y:6927
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1121:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6464
+//line mysql_sql.y:6931
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6470
+//line mysql_sql.y:6937
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6474
+//line mysql_sql.y:6941
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6478
+//line mysql_sql.y:6945
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6482
+//line mysql_sql.y:6949
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6488
+//line mysql_sql.y:6955
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1127:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6492
+//line mysql_sql.y:6959
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1128:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6496
+//line mysql_sql.y:6963
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1129:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6501
+//line mysql_sql.y:6968
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6505
+//line mysql_sql.y:6972
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6511
+//line mysql_sql.y:6978
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1132:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6515
+//line mysql_sql.y:6982
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6521
+//line mysql_sql.y:6988
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6525
+//line mysql_sql.y:6992
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6529
+//line mysql_sql.y:6996
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6533
+//line mysql_sql.y:7000
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6537
+//line mysql_sql.y:7004
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1138:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6541
+//line mysql_sql.y:7008
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6546
+//line mysql_sql.y:7013
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6550
+//line mysql_sql.y:7017
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6554
+//line mysql_sql.y:7021
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1142:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6558
+//line mysql_sql.y:7025
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6562
+//line mysql_sql.y:7029
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1144:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6566
+//line mysql_sql.y:7033
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1145:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6570
+//line mysql_sql.y:7037
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1146:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6574
+//line mysql_sql.y:7041
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -15863,58 +17204,58 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6587
+//line mysql_sql.y:7054
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6593
+//line mysql_sql.y:7060
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6597
+//line mysql_sql.y:7064
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6602
+//line mysql_sql.y:7069
 		{
 			yyVAL.str = ""
 		}
-	case 1077:
+	case 1151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6606
+//line mysql_sql.y:7073
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1078:
+	case 1152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6612
+//line mysql_sql.y:7079
 		{
 			yyVAL.str = ""
 		}
-	case 1079:
+	case 1153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6616
+//line mysql_sql.y:7083
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1080:
+	case 1154:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6622
+//line mysql_sql.y:7089
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -15925,10 +17266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1155:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6634
+//line mysql_sql.y:7101
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15936,10 +17277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6641
+//line mysql_sql.y:7108
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15947,10 +17288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6648
+//line mysql_sql.y:7115
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15958,10 +17299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6655
+//line mysql_sql.y:7122
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15969,10 +17310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6662
+//line mysql_sql.y:7129
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -15980,339 +17321,365 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1160:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6671
+//line mysql_sql.y:7138
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1161:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6677
+//line mysql_sql.y:7144
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6683
+//line mysql_sql.y:7150
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6687
+//line mysql_sql.y:7154
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6691
+//line mysql_sql.y:7158
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6695
+//line mysql_sql.y:7162
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6699
+//line mysql_sql.y:7166
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1167:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6704
+//line mysql_sql.y:7171
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6711
+//line mysql_sql.y:7178
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6715
+//line mysql_sql.y:7182
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6719
+//line mysql_sql.y:7186
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1172:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6724
+//line mysql_sql.y:7191
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6728
+//line mysql_sql.y:7195
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6733
+//line mysql_sql.y:7200
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1175:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6737
+//line mysql_sql.y:7204
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6753
+//line mysql_sql.y:7220
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1183:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6759
+//line mysql_sql.y:7226
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6763
+//line mysql_sql.y:7230
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6767
+//line mysql_sql.y:7234
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1186:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6771
+//line mysql_sql.y:7238
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1187:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6775
+//line mysql_sql.y:7242
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1188:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6779
+//line mysql_sql.y:7246
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1189:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6783
+//line mysql_sql.y:7250
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6787
+//line mysql_sql.y:7254
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6791
+//line mysql_sql.y:7258
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1192:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6795
+//line mysql_sql.y:7262
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1193:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6799
+//line mysql_sql.y:7266
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1194:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6803
+//line mysql_sql.y:7270
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6807
+//line mysql_sql.y:7274
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6813
+//line mysql_sql.y:7280
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:7284
+		{
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_extract")),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:7291
+		{
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_extract")),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)},
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6817
+//line mysql_sql.y:7302
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6821
+//line mysql_sql.y:7306
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1201:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6825
+//line mysql_sql.y:7310
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1202:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6829
+//line mysql_sql.y:7314
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6833
+//line mysql_sql.y:7318
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6837
+//line mysql_sql.y:7322
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6841
+//line mysql_sql.y:7326
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6845
+//line mysql_sql.y:7330
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6849
+//line mysql_sql.y:7334
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1208:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6853
+//line mysql_sql.y:7338
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6857
+//line mysql_sql.y:7342
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1210:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6862
+//line mysql_sql.y:7347
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -16321,26 +17688,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1211:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6870
+//line mysql_sql.y:7355
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1212:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6875
+//line mysql_sql.y:7360
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1213:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6879
+//line mysql_sql.y:7364
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -16350,50 +17717,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1214:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:7373
+		{
+			yyLOCAL = tree.NewFullTextMatchExpr(yyDollar[3].unresolveNamesUnion(), yyDollar[7].exprUnion(), yyDollar[8].fullTextSearchTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1215:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6888
+//line mysql_sql.y:7377
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1139:
+	case 1216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6892
+//line mysql_sql.y:7381
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1140:
+	case 1217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6896
+//line mysql_sql.y:7385
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6900
+//line mysql_sql.y:7389
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6904
+//line mysql_sql.y:7393
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1220:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6910
+//line mysql_sql.y:7399
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16402,58 +17777,71 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1221:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:7407
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1222:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6919
+//line mysql_sql.y:7417
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1223:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6923
+//line mysql_sql.y:7421
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1224:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6928
+//line mysql_sql.y:7426
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6932
+//line mysql_sql.y:7430
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1148:
+	case 1226:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6938
+//line mysql_sql.y:7436
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1149:
+	case 1227:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6942
+//line mysql_sql.y:7440
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1150:
+	case 1228:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6948
+//line mysql_sql.y:7446
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -16461,9 +17849,9 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1151:
+	case 1229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6957
+//line mysql_sql.y:7455
 		{
 			t := yyVAL.columnTypeUnion()
 			str := strings.ToLower(t.InternalType.FamilyString)
@@ -16476,10 +17864,10 @@ yydefault:
 				}
 			}
 		}
-	case 1152:
+	case 1230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6969
+//line mysql_sql.y:7467
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16497,10 +17885,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1153:
+	case 1231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6986
+//line mysql_sql.y:7484
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16515,10 +17903,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1233:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7003
+//line mysql_sql.y:7501
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16532,10 +17920,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1234:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7016
+//line mysql_sql.y:7514
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16549,10 +17937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7029
+//line mysql_sql.y:7527
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16565,10 +17953,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1236:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7041
+//line mysql_sql.y:7539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16583,10 +17971,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7055
+//line mysql_sql.y:7553
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16602,10 +17990,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7070
+//line mysql_sql.y:7568
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16621,10 +18009,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1161:
+	case 1239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7085
+//line mysql_sql.y:7583
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16642,10 +18030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1162:
+	case 1240:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7102
+//line mysql_sql.y:7600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16660,83 +18048,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1163:
+	case 1241:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7117
+//line mysql_sql.y:7615
 		{
 		}
-	case 1166:
+	case 1244:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7123
+//line mysql_sql.y:7621
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1245:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7127
+//line mysql_sql.y:7625
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1246:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7131
+//line mysql_sql.y:7629
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1169:
+	case 1247:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7137
+//line mysql_sql.y:7635
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7141
+//line mysql_sql.y:7639
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1171:
+	case 1249:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7149
+//line mysql_sql.y:7647
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1172:
+	case 1250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7153
+//line mysql_sql.y:7651
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1173:
+	case 1251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7157
+//line mysql_sql.y:7655
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1174:
+	case 1252:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7163
+//line mysql_sql.y:7661
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16744,10 +18132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1175:
+	case 1253:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7170
+//line mysql_sql.y:7668
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16756,70 +18144,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1176:
+	case 1254:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7179
+//line mysql_sql.y:7677
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1177:
+	case 1255:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7183
+//line mysql_sql.y:7681
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1178:
+	case 1256:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7190
+//line mysql_sql.y:7688
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1179:
+	case 1257:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7195
+//line mysql_sql.y:7693
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1180:
+	case 1258:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7199
+//line mysql_sql.y:7697
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1181:
+	case 1259:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7204
+//line mysql_sql.y:7702
 		{
 			yyVAL.str = ","
 		}
-	case 1182:
+	case 1260:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7208
+//line mysql_sql.y:7706
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1183:
+	case 1261:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7213
+//line mysql_sql.y:7711
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1185:
+	case 1263:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7220
+//line mysql_sql.y:7718
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -16828,10 +18216,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1186:
+	case 1264:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7230
+//line mysql_sql.y:7728
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16843,10 +18231,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1187:
+	case 1265:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7241
+//line mysql_sql.y:7739
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16857,10 +18245,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1266:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7251
+//line mysql_sql.y:7749
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16870,10 +18258,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1267:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7260
+//line mysql_sql.y:7758
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16883,10 +18271,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1268:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7269
+//line mysql_sql.y:7767
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16897,10 +18285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1269:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7279
+//line mysql_sql.y:7777
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16911,10 +18299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1270:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7289
+//line mysql_sql.y:7787
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16925,10 +18313,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1271:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7299
+//line mysql_sql.y:7797
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16939,10 +18327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1272:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7309
+//line mysql_sql.y:7807
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -16953,10 +18341,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1273:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7319
+//line mysql_sql.y:7817
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16967,10 +18355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1274:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7329
+//line mysql_sql.y:7827
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16981,10 +18369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1275:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7339
+//line mysql_sql.y:7837
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16995,10 +18383,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1276:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7349
+//line mysql_sql.y:7847
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17009,10 +18397,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1277:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7359
+//line mysql_sql.y:7857
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17023,10 +18411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1200:
+	case 1278:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7369
+//line mysql_sql.y:7867
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17037,10 +18425,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1201:
+	case 1279:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7379
+//line mysql_sql.y:7877
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17051,10 +18439,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1202:
+	case 1280:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7389
+//line mysql_sql.y:7887
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17065,10 +18453,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1284:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7903
+		{
+			yyLOCAL = tree.FULLTEXT_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 1285:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7907
+		{
+			yyLOCAL = tree.FULLTEXT_NL
+		}
+		yyVAL.union = yyLOCAL
+	case 1286:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7911
+		{
+			yyLOCAL = tree.FULLTEXT_NL_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7915
+		{
+			yyLOCAL = tree.FULLTEXT_BOOLEAN
+		}
+		yyVAL.union = yyLOCAL
+	case 1288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7919
+		{
+			yyLOCAL = tree.FULLTEXT_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1289:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7406
+//line mysql_sql.y:7925
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17077,10 +18505,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1207:
+	case 1290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7414
+//line mysql_sql.y:7933
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17089,10 +18517,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1208:
+	case 1291:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7422
+//line mysql_sql.y:7941
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17101,10 +18529,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1209:
+	case 1292:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7430
+//line mysql_sql.y:7949
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17113,10 +18541,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1210:
+	case 1293:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7438
+//line mysql_sql.y:7957
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17127,10 +18555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1211:
+	case 1294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7448
+//line mysql_sql.y:7967
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17139,10 +18567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1212:
+	case 1295:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7456
+//line mysql_sql.y:7975
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17152,10 +18580,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7465
+//line mysql_sql.y:7984
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17164,10 +18592,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1214:
+	case 1297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7473
+//line mysql_sql.y:7992
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17176,10 +18604,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7481
+//line mysql_sql.y:8000
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17188,10 +18616,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7489
+//line mysql_sql.y:8008
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17200,10 +18628,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1300:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7497
+//line mysql_sql.y:8016
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -17215,10 +18643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1301:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7508
+//line mysql_sql.y:8027
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -17229,10 +18657,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1219:
+	case 1302:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7518
+//line mysql_sql.y:8037
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -17245,10 +18673,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1220:
+	case 1303:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7530
+//line mysql_sql.y:8049
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -17260,10 +18688,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1221:
+	case 1304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7541
+//line mysql_sql.y:8060
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -17273,16 +18701,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1228:
+	case 1311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7563
+//line mysql_sql.y:8082
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1257:
+	case 1340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7599
+//line mysql_sql.y:8118
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17295,10 +18723,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7611
+//line mysql_sql.y:8130
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17311,10 +18739,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1342:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7623
+//line mysql_sql.y:8142
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17325,10 +18753,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1343:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7634
+//line mysql_sql.y:8153
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17337,10 +18765,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1344:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7642
+//line mysql_sql.y:8161
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17348,10 +18776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7649
+//line mysql_sql.y:8168
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17359,10 +18787,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7656
+//line mysql_sql.y:8175
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17375,10 +18803,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7668
+//line mysql_sql.y:8187
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -17387,10 +18815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7676
+//line mysql_sql.y:8195
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17401,10 +18829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1349:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7686
+//line mysql_sql.y:8205
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17415,10 +18843,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1350:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7696
+//line mysql_sql.y:8215
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -17427,10 +18855,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1351:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7704
+//line mysql_sql.y:8223
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -17442,10 +18870,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7715
+//line mysql_sql.y:8234
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -17455,10 +18883,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7724
+//line mysql_sql.y:8243
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -17468,10 +18896,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1354:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7733
+//line mysql_sql.y:8252
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -17480,10 +18908,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1355:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7741
+//line mysql_sql.y:8260
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -17494,10 +18922,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7751
+//line mysql_sql.y:8270
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -17506,10 +18934,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1357:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7759
+//line mysql_sql.y:8278
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -17519,34 +18947,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7769
+//line mysql_sql.y:8288
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7773
+//line mysql_sql.y:8292
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1277:
+	case 1360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7779
+//line mysql_sql.y:8298
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1278:
+	case 1361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7783
+//line mysql_sql.y:8302
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -17557,20 +18985,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1285:
+	case 1368:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7802
+//line mysql_sql.y:8321
 		{
 		}
-	case 1286:
+	case 1369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7804
+//line mysql_sql.y:8323
 		{
 		}
-	case 1320:
+	case 1403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7845
+//line mysql_sql.y:8364
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -17581,90 +19009,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1404:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7856
+//line mysql_sql.y:8375
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7860
+//line mysql_sql.y:8379
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7864
+//line mysql_sql.y:8383
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7870
+//line mysql_sql.y:8389
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1408:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7875
+//line mysql_sql.y:8394
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7879
+//line mysql_sql.y:8398
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1410:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7885
+//line mysql_sql.y:8404
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7889
+//line mysql_sql.y:8408
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7896
+//line mysql_sql.y:8415
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7900
+//line mysql_sql.y:8419
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7904
+//line mysql_sql.y:8423
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -17673,355 +19101,363 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7912
+//line mysql_sql.y:8431
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1416:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7916
+//line mysql_sql.y:8435
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7920
+//line mysql_sql.y:8439
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7924
+//line mysql_sql.y:8443
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7930
+//line mysql_sql.y:8449
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1420:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7934
+//line mysql_sql.y:8453
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7938
+//line mysql_sql.y:8457
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1422:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7942
+//line mysql_sql.y:8461
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1423:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7946
+//line mysql_sql.y:8465
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1424:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7950
+//line mysql_sql.y:8469
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7954
+//line mysql_sql.y:8473
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1426:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7958
+//line mysql_sql.y:8477
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1344:
+	case 1427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7962
+//line mysql_sql.y:8481
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1428:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7966
+//line mysql_sql.y:8485
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7974
+//line mysql_sql.y:8493
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1431:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7978
+//line mysql_sql.y:8497
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1432:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7982
+//line mysql_sql.y:8501
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1433:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7986
+//line mysql_sql.y:8505
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1434:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7990
+//line mysql_sql.y:8509
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1435:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7994
+//line mysql_sql.y:8513
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7998
+//line mysql_sql.y:8517
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1354:
+	case 1437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8002
+//line mysql_sql.y:8521
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1355:
+	case 1438:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:8525
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.MEMBER_OF, yyDollar[1].exprUnion(), tree.NewParenExpr(yyDollar[5].exprUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 1439:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8006
+//line mysql_sql.y:8529
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1440:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8010
+//line mysql_sql.y:8533
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1442:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8016
+//line mysql_sql.y:8539
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1443:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8020
+//line mysql_sql.y:8543
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1360:
+	case 1444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8026
+//line mysql_sql.y:8549
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1361:
+	case 1445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8030
+//line mysql_sql.y:8553
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1362:
+	case 1446:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8037
+//line mysql_sql.y:8560
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1447:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8041
+//line mysql_sql.y:8564
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1448:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8045
+//line mysql_sql.y:8568
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8051
+//line mysql_sql.y:8574
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8055
+//line mysql_sql.y:8578
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1367:
+	case 1451:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8059
+//line mysql_sql.y:8582
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8063
+//line mysql_sql.y:8586
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8067
+//line mysql_sql.y:8590
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1454:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8071
+//line mysql_sql.y:8594
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8075
+//line mysql_sql.y:8598
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8081
+//line mysql_sql.y:8604
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8085
+//line mysql_sql.y:8608
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8089
+//line mysql_sql.y:8612
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8093
+//line mysql_sql.y:8616
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8099
+//line mysql_sql.y:8622
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8103
+//line mysql_sql.y:8626
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -18035,59 +19471,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8116
+//line mysql_sql.y:8639
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1379:
+	case 1463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8121
+//line mysql_sql.y:8644
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1380:
+	case 1464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8125
+//line mysql_sql.y:8648
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1381:
+	case 1465:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8129
+//line mysql_sql.y:8652
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1382:
+	case 1466:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8133
+//line mysql_sql.y:8656
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1383:
+	case 1467:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8137
+//line mysql_sql.y:8660
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1384:
+	case 1468:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8141
+//line mysql_sql.y:8664
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -18102,53 +19538,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1385:
+	case 1469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8155
+//line mysql_sql.y:8678
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1386:
+	case 1470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8159
+//line mysql_sql.y:8682
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1387:
+	case 1471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8166
+//line mysql_sql.y:8689
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1391:
+	case 1475:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8177
+//line mysql_sql.y:8700
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1392:
+	case 1476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8182
+//line mysql_sql.y:8705
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1393:
+	case 1477:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8188
+//line mysql_sql.y:8711
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18161,10 +19597,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1394:
+	case 1478:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8200
+//line mysql_sql.y:8723
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18177,10 +19613,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1395:
+	case 1479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8212
+//line mysql_sql.y:8735
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18193,10 +19629,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1396:
+	case 1480:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8224
+//line mysql_sql.y:8747
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18210,10 +19646,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1397:
+	case 1481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8237
+//line mysql_sql.y:8760
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18227,10 +19663,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1398:
+	case 1482:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8250
+//line mysql_sql.y:8773
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18244,10 +19680,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1399:
+	case 1483:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8263
+//line mysql_sql.y:8786
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18261,10 +19697,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1400:
+	case 1484:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8276
+//line mysql_sql.y:8799
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18278,10 +19714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1401:
+	case 1485:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8289
+//line mysql_sql.y:8812
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18295,10 +19731,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1402:
+	case 1486:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8302
+//line mysql_sql.y:8825
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18312,10 +19748,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1403:
+	case 1487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8315
+//line mysql_sql.y:8838
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18329,10 +19765,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1404:
+	case 1488:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8328
+//line mysql_sql.y:8851
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18346,10 +19782,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1405:
+	case 1489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8341
+//line mysql_sql.y:8864
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18363,10 +19799,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1406:
+	case 1490:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8354
+//line mysql_sql.y:8877
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18380,10 +19816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1407:
+	case 1491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8369
+//line mysql_sql.y:8892
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18411,10 +19847,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1408:
+	case 1492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8396
+//line mysql_sql.y:8919
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18456,10 +19892,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1409:
+	case 1493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8438
+//line mysql_sql.y:8961
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -18496,10 +19932,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1410:
+	case 1494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8486
+//line mysql_sql.y:9009
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18515,10 +19951,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1411:
+	case 1495:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8503
+//line mysql_sql.y:9026
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18531,10 +19967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1412:
+	case 1496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8515
+//line mysql_sql.y:9038
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18555,10 +19991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1413:
+	case 1497:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8535
+//line mysql_sql.y:9058
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18579,10 +20015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1414:
+	case 1498:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8555
+//line mysql_sql.y:9078
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18603,10 +20039,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1415:
+	case 1499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8575
+//line mysql_sql.y:9098
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18621,10 +20057,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1416:
+	case 1500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8591
+//line mysql_sql.y:9114
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18638,10 +20074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1417:
+	case 1501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8604
+//line mysql_sql.y:9127
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18655,10 +20091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1418:
+	case 1502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8617
+//line mysql_sql.y:9140
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18672,10 +20108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1419:
+	case 1503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8630
+//line mysql_sql.y:9153
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18689,10 +20125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1420:
+	case 1504:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8643
+//line mysql_sql.y:9166
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18705,10 +20141,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1421:
+	case 1505:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8655
+//line mysql_sql.y:9178
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18721,10 +20157,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1422:
+	case 1506:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8667
+//line mysql_sql.y:9190
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18737,10 +20173,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1423:
+	case 1507:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8679
+//line mysql_sql.y:9202
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18753,10 +20189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1424:
+	case 1508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8691
+//line mysql_sql.y:9214
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18769,10 +20205,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1425:
+	case 1509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8703
+//line mysql_sql.y:9226
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18785,10 +20221,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1426:
+	case 1510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8715
+//line mysql_sql.y:9238
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18801,10 +20237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1427:
+	case 1511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8727
+//line mysql_sql.y:9250
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18817,10 +20253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1428:
+	case 1512:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8739
+//line mysql_sql.y:9262
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18833,10 +20269,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1429:
+	case 1513:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8751
+//line mysql_sql.y:9274
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18850,10 +20286,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1430:
+	case 1514:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8764
+//line mysql_sql.y:9287
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18867,10 +20303,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1431:
+	case 1515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8777
+//line mysql_sql.y:9300
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18884,20 +20320,37 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1432:
+	case 1516:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:9313
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.VectorFamily,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_VECTOR),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8792
+//line mysql_sql.y:9328
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1433:
+	case 1518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8800
+//line mysql_sql.y:9336
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18906,10 +20359,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1434:
+	case 1519:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8809
+//line mysql_sql.y:9345
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18918,10 +20371,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1435:
+	case 1520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8819
+//line mysql_sql.y:9355
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18934,75 +20387,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1436:
+	case 1521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8842
+//line mysql_sql.y:9378
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1437:
+	case 1522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8847
+//line mysql_sql.y:9383
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1438:
+	case 1523:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8853
+//line mysql_sql.y:9389
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1440:
+	case 1525:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8860
+//line mysql_sql.y:9396
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1441:
+	case 1526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8864
+//line mysql_sql.y:9400
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1442:
+	case 1527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8869
+//line mysql_sql.y:9405
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1443:
+	case 1528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8873
+//line mysql_sql.y:9409
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1444:
+	case 1529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8879
+//line mysql_sql.y:9415
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1445:
+	case 1530:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8885
+//line mysql_sql.y:9421
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -19010,10 +20463,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1446:
+	case 1531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8892
+//line mysql_sql.y:9428
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -19021,10 +20474,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1447:
+	case 1532:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8899
+//line mysql_sql.y:9435
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -19032,10 +20485,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1448:
+	case 1533:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8908
+//line mysql_sql.y:9444
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -19043,10 +20496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1449:
+	case 1534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8915
+//line mysql_sql.y:9451
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -19054,10 +20507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1450:
+	case 1535:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8922
+//line mysql_sql.y:9458
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -19065,52 +20518,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1451:
+	case 1536:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8931
+//line mysql_sql.y:9467
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1452:
+	case 1537:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8935
+//line mysql_sql.y:9471
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1453:
+	case 1538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8939
+//line mysql_sql.y:9475
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1454:
+	case 1539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8945
+//line mysql_sql.y:9481
 		{
 		}
-	case 1455:
+	case 1540:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8947
+//line mysql_sql.y:9483
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1459:
+	case 1544:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8957
+//line mysql_sql.y:9493
 		{
 			yyVAL.str = ""
 		}
-	case 1460:
+	case 1545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8961
+//line mysql_sql.y:9497
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -45,6 +45,7 @@ import (
     tableName *tree.TableName
     tableNames tree.TableNames
     columnTableDef *tree.ColumnTableDef
+    columnPosition *tree.ColumnPosition
     tableOption tree.TableOption
     tableOptions []tree.TableOption
     tableExprs tree.TableExprs
@@ -67,6 +68,9 @@ import (
     procArgDecl *tree.ProcedureArgDecl
     procArgType tree.InOutArgType
 
+    triggerTime tree.TriggerTime
+    triggerEvent tree.TriggerEvent
+
     from *tree.From
     where *tree.Where
     groupBy tree.GroupBy
@@ -82,6 +86,9 @@ import (
     joinCond tree.JoinCond
 
     columnType *tree.T
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns []*tree.JsonTableColumn
+    jsonTableResponse *tree.JsonTableResponse
     unresolvedName *tree.UnresolvedName
     lengthScaleOpt tree.LengthScaleOpt
     tuple *tree.Tuple
@@ -108,6 +115,7 @@ import (
     createOptions []tree.CreateOption
     indexType tree.IndexType
     indexCategory tree.IndexCategory
+    fullTextSearchType tree.FullTextSearchType
     keyParts []*tree.KeyPart
     keyPart *tree.KeyPart
     indexOption *tree.IndexOption
@@ -180,6 +188,8 @@ import (
     properties []tree.Property
     property tree.Property
     exportParm *tree.ExportParam
+    selectLockInfo *tree.SelectLockInfo
+    selectLockWait tree.SelectLockWait
 
     epxlainOptions []tree.OptionElem
     epxlainOption tree.OptionElem
@@ -204,6 +214,7 @@ import (
     indexHintScope tree.IndexHintScope
     indexHint *tree.IndexHint
     indexHintList []*tree.IndexHint
+    asOfClause *tree.AsOfClause
     indexVisibility tree.VisibleType
 
     killOption tree.KillOption
@@ -236,6 +247,8 @@ import (
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
+%token <str> OF NOWAIT SKIP LOCKED
+%token <str> SAVEPOINT
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %nonassoc LOWER_THAN_ON
@@ -257,7 +270,7 @@ import (
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END ELSEIF
 %nonassoc LOWER_THAN_EQ
-%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT ILIKE
+%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT ILIKE MEMBER
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
@@ -282,7 +295,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECTOR
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION
 
@@ -291,10 +304,12 @@ import (
 %token <str> LOW_PRIORITY HIGH_PRIORITY DELAYED
 
 // Create Table
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS MODIFY CHANGE AFTER
 %token <str> SCHEMA TABLE SEQUENCE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER BEFORE EACH
+%token <str> EXCHANGE VALIDATION WITHOUT
+%token <str> MATERIALIZED REFRESH INCREMENTAL
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
@@ -315,7 +330,7 @@ import (
 %token <str> PROPERTIES
 
 // Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT LISTS
 %token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN
 
 // Alter
@@ -390,7 +405,7 @@ import (
 %token <str> NEXTVAL SETVAL CURRVAL LASTVAL
 
 //JSON function
-%token <str> ARROW
+%token <str> ARROW LONG_ARROW JSON_TABLE NESTED ORDINALITY PATH
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE PARALLEL
@@ -418,10 +433,10 @@ import (
 %type <statements> stmt_list stmt_list_return
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt
 %type <statement> delete_without_using_stmt delete_with_using_stmt
-%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt drop_function_stmt drop_procedure_stmt drop_sequence_stmt
+%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt drop_function_stmt drop_procedure_stmt drop_sequence_stmt drop_trigger_stmt
 %type <statement> drop_account_stmt drop_role_stmt drop_user_stmt
 %type <statement> create_account_stmt create_user_stmt create_role_stmt
-%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt create_function_stmt create_extension_stmt create_procedure_stmt create_sequence_stmt
+%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt create_function_stmt create_extension_stmt create_procedure_stmt create_sequence_stmt create_trigger_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt show_table_status_stmt show_grants_stmt show_collation_stmt show_accounts_stmt show_roles_stmt
 %type <statement> show_tables_stmt show_sequences_stmt show_process_stmt show_errors_stmt show_warnings_stmt show_target
 %type <statement> show_procedure_status_stmt show_function_status_stmt show_node_list_stmt show_locks_stmt
@@ -429,7 +444,7 @@ import (
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
 %type <statement> show_servers_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
-%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt savepoint_stmt release_savepoint_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
 %type <statement> lock_stmt lock_table_stmt unlock_table_stmt
@@ -444,10 +459,12 @@ import (
 %type <statement> call_stmt
 %type <statement> mo_dump_stmt
 %type <statement> load_extension_stmt
-%type <statement> kill_stmt
+%type <statement> kill_stmt refresh_stmt
 %type <rowsExprs> row_constructor_list
 %type <exprs>  row_constructor
 %type <exportParm> export_data_param_opt
+%type <selectLockInfo> select_lock_opt
+%type <selectLockWait> select_lock_wait_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
 
@@ -493,6 +510,8 @@ import (
 %type <str> func_lang extension_lang extension_name
 
 %type <procName> proc_name
+%type <triggerTime> trigger_time
+%type <triggerEvent> trigger_event
 %type <procArgs> proc_args_list_opt proc_args_list
 %type <procArg> proc_arg
 %type <procArgDecl> proc_arg_decl
@@ -501,9 +520,13 @@ import (
 %type <tableDefs> table_elem_list_opt table_elem_list
 %type <tableDef> table_elem constaint_def constraint_elem index_def
 %type <tableName> table_name table_name_opt_wild
-%type <tableNames> table_name_list
+%type <tableNames> table_name_list select_lock_tables_opt
 %type <columnTableDef> column_def
+%type <columnPosition> column_position column_position_opt
 %type <columnType> mo_cast_type mysql_cast_type
+%type <jsonTableColumn> json_table_column json_table_handler json_table_handler_list
+%type <jsonTableColumns> json_table_column_list
+%type <jsonTableResponse> json_table_response
 %type <columnType> column_type char_type spatial_type time_type numeric_type decimal_type int_type as_datatype_opt
 %type <str> integer_opt
 %type <columnAttribute> column_attribute_elem keys
@@ -517,7 +540,7 @@ import (
 %type <referenceOnRecord> on_delete_update_opt
 %type <attributeReference> references_def
 %type <alterTableOptions> alter_option_list
-%type <alterTableOption> alter_option alter_table_drop alter_table_alter
+%type <alterTableOption> alter_option alter_table_drop alter_table_alter alter_partition_option
 %type <indexVisibility> visibility
 
 %type <tableOption> table_option
@@ -601,6 +624,7 @@ import (
 %type <privilegeType> priv_type
 %type <privilegeLevel> priv_level
 %type <unresolveNames> column_name_list
+%type <fullTextSearchType> fulltext_search_opt
 %type <partitionOption> partition_by_opt
 %type <clusterByOption> cluster_by_opt
 %type <partitionBy> partition_method sub_partition_method sub_partition_opt
@@ -638,7 +662,7 @@ import (
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
-%type <boolVal> local_opt
+%type <boolVal> local_opt incremental_opt
 %type <duplicateKey> duplicate_opt
 %type <fields> load_fields field_item export_fields
 %type <fieldsList> field_item_list
@@ -688,6 +712,7 @@ import (
 %type <indexHintScope> index_hint_scope
 %type <indexHint> index_hint
 %type <indexHintList> index_hint_list index_hint_list_opt
+%type <asOfClause> as_of_opt
 
 %token <str> KILL
 %type <killOption> kill_opt
@@ -808,6 +833,25 @@ normal_stmt:
         $$ = $1
     }
 |   kill_stmt
+|   refresh_stmt
+
+refresh_stmt:
+    REFRESH MATERIALIZED VIEW table_name incremental_opt
+    {
+        $$ = &tree.RefreshMaterializedView{
+            Name: $4,
+            Incremental: $5,
+        }
+    }
+
+incremental_opt:
+    {
+        $$ = false
+    }
+|   INCREMENTAL
+    {
+        $$ = true
+    }
 
 kill_stmt:
     KILL kill_opt INTEGRAL statement_id_opt
@@ -2081,12 +2125,39 @@ transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK work_opt TO ident
+    {
+        $$ = tree.NewRollbackToSavePoint(tree.Identifier($4.Compare()))
+    }
+|   ROLLBACK work_opt TO SAVEPOINT ident
+    {
+        $$ = tree.NewRollbackToSavePoint(tree.Identifier($5.Compare()))
+    }
+
+work_opt:
+    {}
+|   WORK
+    {}
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = tree.NewSavePoint(tree.Identifier($2.Compare()))
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = tree.NewReleaseSavePoint(tree.Identifier($3.Compare()))
+    }
 
 commit_stmt:
     COMMIT completion_type
@@ -2461,7 +2532,11 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')'
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
@@ -2494,6 +2569,88 @@ alter_table_stmt:
             Options: $4,
         }
     }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = &tree.AlterTable{
+            Table: $3,
+            Options: []tree.AlterTableOption{$4},
+        }
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionAdd,
+            Partitions: $4,
+        })
+    }
+|   ADD PARTITION PARTITIONS INTEGRAL
+    {
+        res := $4.(int64)
+        if res == 0 {
+            yylex.Error("partitions can not be 0")
+            return 1
+        }
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionAdd,
+            Num: uint64(res),
+        })
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionDrop,
+            Names: $3,
+        })
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionTruncate,
+            Names: $3,
+        })
+    }
+|   TRUNCATE PARTITION ALL
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionTruncate,
+            AllPartitions: true,
+        })
+    }
+|   REORGANIZE PARTITION partition_id_list INTO '(' partition_list ')'
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionReorganize,
+            Names: $3,
+            Partitions: $6,
+        })
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionExchange,
+            Names: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+        })
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITH VALIDATION
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionExchange,
+            Names: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+        })
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITHOUT VALIDATION
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionExchange,
+            Names: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+            WithoutValidation: true,
+        })
+    }
 
 alter_option_list:
 alter_option
@@ -2513,6 +2670,42 @@ ADD table_elem
         }
         $$ = tree.AlterTableOption(opt)
     }
+|   ADD COLUMN column_def column_position_opt
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionAddColumn{
+            Column: $3,
+            Position: $4,
+        })
+    }
+|   ADD column_def column_position
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionAddColumn{
+            Column: $2,
+            Position: $3,
+        })
+    }
+|   MODIFY column_keyword_opt column_def column_position_opt
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionModifyColumn{
+            Column: $3,
+            Position: $4,
+        })
+    }
+|   CHANGE column_keyword_opt ident column_def column_position_opt
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionModifyColumn{
+            OldName: tree.Identifier($3.Compare()),
+            Column: $4,
+            Position: $5,
+        })
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = tree.AlterTableOption(&tree.AlterOptionRenameColumn{
+            OldName: tree.Identifier($3.Compare()),
+            NewName: tree.Identifier($5.Compare()),
+        })
+    }
 | DROP alter_table_drop
     {
         $$ = tree.AlterTableOption($2)
@@ -2526,6 +2719,37 @@ ADD table_elem
         $$ =  tree.AlterTableOption($1)
     }
 
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+column_position_opt:
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionNone,
+        }
+    }
+|   column_position
+    {
+        $$ = $1
+    }
+
+column_position:
+    FIRST
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionFirst,
+        }
+    }
+|   AFTER ident
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionAfter,
+            RelativeColumn: tree.Identifier($2.Compare()),
+        }
+    }
+
 alter_table_drop:
     INDEX ident 
     {
@@ -3243,6 +3467,7 @@ drop_ddl_stmt:
 |   drop_sequence_stmt
 |   drop_publication_stmt
 |   drop_procedure_stmt
+|   drop_trigger_stmt
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -3320,6 +3545,10 @@ drop_view_stmt:
     {
         $$ = &tree.DropView{IfExists: $3, Names: $4}
     }
+|   DROP MATERIALIZED VIEW exists_opt table_name_list
+    {
+        $$ = &tree.DropView{IfExists: $4, Names: $5, Materialized: true}
+    }
 
 drop_database_stmt:
     DROP DATABASE exists_opt ident
@@ -3358,6 +3587,15 @@ drop_procedure_stmt:
         }
     }
 
+drop_trigger_stmt:
+    DROP TRIGGER exists_opt table_name
+    {
+        $$ = &tree.DropTrigger{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
 delete_stmt:
     delete_without_using_stmt
 |    delete_with_using_stmt
@@ -3864,9 +4102,9 @@ select_stmt:
     }
 
 select_no_parens:
-    simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+    simple_select order_by_opt limit_opt select_lock_opt export_data_param_opt
     {
-        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Lock: $4, Ep: $5}
     }
 |   select_with_parens order_by_clause export_data_param_opt
     {
@@ -3876,9 +4114,9 @@ select_no_parens:
     {
         $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
     }
-|    with_clause simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+|    with_clause simple_select order_by_opt limit_opt select_lock_opt export_data_param_opt
     {
-        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
+        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Lock: $5, Ep: $6, With: $1}
     }
 |   with_clause select_with_parens order_by_clause export_data_param_opt
     {
@@ -3889,6 +4127,45 @@ select_no_parens:
         $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
     }
 
+select_lock_opt:
+    {
+        $$ = nil
+    }
+|   FOR UPDATE select_lock_tables_opt select_lock_wait_opt
+    {
+        $$ = &tree.SelectLockInfo{LockType: tree.SelectLockForUpdate, Tables: $3, Wait: $4}
+    }
+|   FOR SHARE select_lock_tables_opt select_lock_wait_opt
+    {
+        $$ = &tree.SelectLockInfo{LockType: tree.SelectLockForShare, Tables: $3, Wait: $4}
+    }
+|   LOCK IN SHARE MODE
+    {
+        $$ = &tree.SelectLockInfo{LockType: tree.SelectLockInShareMode}
+    }
+
+select_lock_tables_opt:
+    {
+        $$ = nil
+    }
+|   OF table_name_list
+    {
+        $$ = $2
+    }
+
+select_lock_wait_opt:
+    {
+        $$ = tree.SelectLockWaitDefault
+    }
+|   NOWAIT
+    {
+        $$ = tree.SelectLockNoWait
+    }
+|   SKIP LOCKED
+    {
+        $$ = tree.SelectLockSkipLocked
+    }
+
 with_clause:
     WITH cte_list
     {
@@ -4543,19 +4820,148 @@ table_function:
             },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING COLUMNS '(' json_table_column_list ')' ')'
+    {
+        path := tree.NewNumValWithType(constant.MakeString($5), $5, false, tree.P_char)
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_table")),
+                Exprs: tree.Exprs{$3, path},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonColumns: $8,
+        }
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JsonTableColumnOrdinality,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_handler_list
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JsonTableColumnPath,
+            Name: tree.Identifier($1.Compare()),
+            ColType: $2,
+            Path: $4,
+            OnEmpty: $5.OnEmpty,
+            OnError: $5.OnError,
+        }
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JsonTableColumnExists,
+            Name: tree.Identifier($1.Compare()),
+            ColType: $2,
+            Path: $5,
+        }
+    }
+|   NESTED PATH STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JsonTableColumnNested,
+            Path: $3,
+            Columns: $6,
+        }
+    }
+|   NESTED STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JsonTableColumnNested,
+            Path: $2,
+            Columns: $5,
+        }
+    }
+
+json_table_handler_list:
+    {
+        $$ = &tree.JsonTableColumn{}
+    }
+|   json_table_handler
+    {
+        $$ = $1
+    }
+|   json_table_handler json_table_handler
+    {
+        if $1.OnEmpty == nil || $2.OnError == nil {
+            yylex.Error("ON EMPTY must be followed by ON ERROR")
+            return 1
+        }
+        $$ = &tree.JsonTableColumn{
+            OnEmpty: $1.OnEmpty,
+            OnError: $2.OnError,
+        }
+    }
+
+json_table_handler:
+    json_table_response ON ident
+    {
+        switch strings.ToLower($3.Origin()) {
+        case "empty":
+            $$ = &tree.JsonTableColumn{OnEmpty: $1}
+        case "error":
+            $$ = &tree.JsonTableColumn{OnError: $1}
+        default:
+            yylex.Error("expect ON EMPTY or ON ERROR")
+            return 1
+        }
+    }
+
+json_table_response:
+    NULL
+    {
+        $$ = &tree.JsonTableResponse{Type: tree.JsonTableResponseNull}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableResponse{Type: tree.JsonTableResponseDefault, Value: $2}
+    }
+|   ident
+    {
+        if strings.ToLower($1.Origin()) != "error" {
+            yylex.Error("expect NULL, ERROR or DEFAULT")
+            return 1
+        }
+        $$ = &tree.JsonTableResponse{Type: tree.JsonTableResponseError}
+    }
 
 aliased_table_name:
-    table_name as_opt_id index_hint_list_opt
+    table_name as_of_opt as_opt_id index_hint_list_opt
     {
         $$ = &tree.AliasedTableExpr{
             Expr: $1,
+            AsOf: $2,
             As: tree.AliasClause{
-                Alias: tree.Identifier($2),
+                Alias: tree.Identifier($3),
             },
-            IndexHints: $3,
+            IndexHints: $4,
         }
     }
 
+as_of_opt:
+    {
+        $$ = nil
+    }
+|   '{' AS OF TIMESTAMP expression '}'
+    {
+        $$ = &tree.AsOfClause{Expr: $5}
+    }
+
 index_hint_list_opt:
 	{
 		$$ = nil
@@ -4707,6 +5113,7 @@ create_ddl_stmt:
 |   create_extension_stmt
 |   create_sequence_stmt
 |   create_procedure_stmt
+|   create_trigger_stmt
 
 create_extension_stmt:
     CREATE EXTENSION extension_lang AS extension_name FILE STRING
@@ -4740,6 +5147,43 @@ create_procedure_stmt:
         }
     }
 
+create_trigger_stmt:
+    CREATE TRIGGER not_exists_opt table_name trigger_time trigger_event ON table_name FOR EACH ROW STRING
+    {
+        $$ = &tree.CreateTrigger{
+            IfNotExists: $3,
+            Name: $4,
+            Time: $5,
+            Event: $6,
+            Table: $8,
+            Body: $12,
+        }
+    }
+
+trigger_time:
+    BEFORE
+    {
+        $$ = tree.TriggerBefore
+    }
+|   AFTER
+    {
+        $$ = tree.TriggerAfter
+    }
+
+trigger_event:
+    INSERT
+    {
+        $$ = tree.TriggerInsert
+    }
+|   UPDATE
+    {
+        $$ = tree.TriggerUpdate
+    }
+|   DELETE
+    {
+        $$ = tree.TriggerDelete
+    }
+
 proc_name:
     ident
     {
@@ -4880,6 +5324,16 @@ create_view_stmt:
             IfNotExists: $3,
         }
     }
+|   CREATE MATERIALIZED VIEW not_exists_opt table_name column_list_opt AS select_stmt
+    {
+        $$ = &tree.CreateView{
+            Name: $5,
+            ColNames: $6,
+            AsSource: $8,
+            IfNotExists: $4,
+            Materialized: true,
+        }
+    }
 
 create_account_stmt:
     CREATE ACCOUNT not_exists_opt account_name account_auth_option account_status_option account_comment_opt
@@ -5380,6 +5834,8 @@ index_option_list:
                 opt1.ParserName = opt2.ParserName
             } else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
                 opt1.Visible = opt2.Visible
+            } else if opt2.Lists > 0 {
+                opt1.Lists = opt2.Lists
             }
             $$ = opt1
         }
@@ -5406,6 +5862,10 @@ index_option:
     {
         $$ = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
     }
+|   LISTS equal_opt INTEGRAL
+    {
+        $$ = &tree.IndexOption{Lists: uint64($3.(int64))}
+    }
 
 index_column_list:
     index_column
@@ -5448,6 +5908,10 @@ using_opt:
     {
         $$ = tree.INDEX_TYPE_BSI
     }
+|    USING IVFFLAT
+    {
+        $$ = tree.INDEX_TYPE_IVFFLAT
+    }
 
 create_database_stmt:
     CREATE database_or_schema not_exists_opt ident subcription_opt create_option_list_opt
@@ -6319,6 +6783,8 @@ index_def:
                 keyTyp = tree.INDEX_TYPE_ZONEMAP
             case "bsi":
                 keyTyp = tree.INDEX_TYPE_BSI
+            case "ivfflat":
+                keyTyp = tree.INDEX_TYPE_IVFFLAT
             default:
                 yylex.Error("Invail the type of index")
                 return 1
@@ -6435,6 +6901,7 @@ index_type:
 |    RTREE
 |    ZONEMAP
 |    BSI
+|    IVFFLAT
 
 index_name:
     {
@@ -6813,6 +7280,24 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident ARROW STRING
+    {
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_extract")),
+            Exprs: tree.Exprs{$1, tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)},
+        }
+    }
+|   normal_ident LONG_ARROW STRING
+    {
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_extract")),
+            Exprs: tree.Exprs{$1, tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)},
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -6884,6 +7369,10 @@ simple_expr:
             Exprs: tree.Exprs{$3, es},
         }
     }
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
 |   function_call_generic
     {
         $$ = $1
@@ -6914,6 +7403,15 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|   ID '(' expression_list_opt ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 
 else_opt:
     {
@@ -7402,6 +7900,27 @@ std_dev_pop:
 |   STDDEV
 |   STDDEV_POP
 
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_DEFAULT
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NL
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NL_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
+
 function_call_generic:
     ID '(' expression_list_opt ')'
     {
@@ -8003,6 +8522,10 @@ predicate:
     {
         $$ = tree.NewComparisonExpr(tree.NOT_REG_MATCH, $1, $4)
     }
+|   bit_expr MEMBER OF '(' expression ')'
+    {
+        $$ = tree.NewComparisonExpr(tree.MEMBER_OF, $1, tree.NewParenExpr($5))
+    }
 |   bit_expr BETWEEN bit_expr AND predicate
     {
         $$ = tree.NewRangeCond(false, $1, $3, $5)
@@ -8787,6 +9310,19 @@ char_type:
     },
     }
 }
+|   VECTOR length
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.VectorFamily,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Locale: &locale,
+                Oid:    uint32(defines.MYSQL_TYPE_VECTOR),
+            },
+        }
+    }
 
 do_stmt:
     DO expression_list
@@ -9156,6 +9692,7 @@ equal_opt:
 non_reserved_keyword:
     ACCOUNT
 |   ACCOUNTS
+|   AFTER
 |   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
@@ -9168,6 +9705,7 @@ non_reserved_keyword:
 |   BLOB
 |   BOOL
 |   CHAIN
+|   CHANGE
 |   CHECKSUM
 |   CLUSTER
 |   COMPRESSION
@@ -9196,6 +9734,7 @@ non_reserved_keyword:
 |   ENCRYPTION
 |   ENGINE
 |   EXPANSION
+|   EXCHANGE
 |   EXTENDED
 |   EXPIRE
 |   ERRORS
@@ -9224,6 +9763,7 @@ non_reserved_keyword:
 |   LONGBLOB
 |   LONGTEXT
 |   LOCAL
+|   LOCKED
 |   LINEAR
 |   LIST
 |   MEDIUMBLOB
@@ -9231,6 +9771,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   MODIFY
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -9243,9 +9784,13 @@ non_reserved_keyword:
 |   MONTH
 |   NAMES
 |   NCHAR
+|   NESTED
+|   ORDINALITY
+|   PATH
 |   NUMERIC
 |   NEVER
 |   NO
+|   NOWAIT
 |   OFFSET
 |   ONLY
 |   OPTIMIZE
@@ -9265,6 +9810,9 @@ non_reserved_keyword:
 |   READ
 |   REAL
 |   REORGANIZE
+|   MATERIALIZED
+|   REFRESH
+|   INCREMENTAL
 |   REDUNDANT
 |   REPAIR
 |   REPEATABLE
@@ -9274,10 +9822,12 @@ non_reserved_keyword:
 |   ROW_FORMAT
 |   ROLLBACK
 |   RESTRICT
+|   SAVEPOINT
 |   SESSION
 |   SERIALIZABLE
 |   SHARE
 |   SIGNED
+|   SKIP
 |   SMALLINT
 |   SNAPSHOT
 |   SPATIAL
@@ -9306,8 +9856,11 @@ non_reserved_keyword:
 |   USER
 |   VARBINARY
 |   VARCHAR
+|   VALIDATION
 |   VARIABLES
+|   VECTOR
 |   VIEW
+|   WITHOUT
 |   WRITE
 |   WARNINGS
 |   WORK
@@ -9403,6 +9956,8 @@ not_keyword:
 |   SETVAL
 |   CURRVAL
 |   LASTVAL
+|   IVFFLAT
+|   LISTS
 
 //mo_keywords:
 //    PROPERTIES
@@ -134,6 +134,9 @@ var (
 		output: "select algo_alarm_record.* from algo_alarm_record inner join (select id from algo_alarm_record use index(algo_alarm_record_algo_id_first_id_created_at_index) where first_id = 0 and created_at >= 2022-09-18 00:00:00 and created_at <= 2022-10-18 00:00:00 and algo_id not in (9808, 9809) order by id desc limit 10 offset 0) as e on e.id = algo_alarm_record.id order by algo_alarm_record.id desc",
 	}, {
 		input: "select a from t1 use index(b)",
+	}, {
+		input:  "select a from t1 {AS OF TIMESTAMP '1686000000000000000-1'} as x join t2 {as of timestamp now() - interval 1 hour} on x.a = t2.a",
+		output: "select a from t1 {as of timestamp 1686000000000000000-1} as x inner join t2 {as of timestamp now() - interval(1, hour)} on x.a = t2.a",
 	}, {
 		input:  "SELECT   id,cid,status,ip,stream   FROM camera     WHERE (cid_type = ?)",
 		output: "select id, cid, status, ip, stream from camera where (cid_type = ?)",
@@ -546,6 +549,8 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input: "with recursive c(n) as (select 1 union all select n + 1 from c where n < 10) select * from c",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -587,6 +592,9 @@ var (
 		input: "create table t (a int, index idx1 using zonemap (a))",
 	}, {
 		input: "create table t (a int, index idx1 using bsi (a))",
+	}, {
+		input:  "create table t (a int primary key, b vector(3), index idx1 using ivfflat (b) lists = 10)",
+		output: "create table t (a int primary key, b vector(3), index idx1 using ivfflat (b) lists 10 )",
 	}, {
 		input:  "set @@sql_mode ='TRADITIONAL'",
 		output: "set sql_mode = TRADITIONAL",
@@ -607,6 +615,27 @@ var (
 		output: "show variables",
 	}, {
 		input: "create index idx1 using bsi on a (a) ",
+	}, {
+		input:  "create index idx1 using ivfflat on a (b) lists 100 comment 'x'",
+		output: "create index idx1 using ivfflat on a (b) comment x lists 100 ",
+	}, {
+		input:  "select a from t order by l2_distance(b, '[1, 2, 3]') limit 5",
+		output: "select a from t order by l2_distance(b, [1, 2, 3]) limit 5",
+	}, {
+		input: "select a from t where b = 1 for update",
+	}, {
+		input:  "select * from t1 join t2 on t1.a = t2.a order by t1.a limit 10 FOR UPDATE OF t1, t2 NOWAIT",
+		output: "select * from t1 inner join t2 on t1.a = t2.a order by t1.a limit 10 for update of t1, t2 nowait",
+	}, {
+		input: "select a from t for share skip locked",
+	}, {
+		input: "with c as (select a from t) select * from c for share of c",
+	}, {
+		input:  "select a from t lock in share mode",
+		output: "select a from t lock in share mode",
+	}, {
+		input:  "select skip, locked, nowait from t",
+		output: "select skip, locked, nowait from t",
 	}, {
 		input:  "INSERT INTO pet VALUES row('Sunsweet05','Dsant05','otter','f',30.11,2), row('Sunsweet06','Dsant06','otter','m',30.11,3);",
 		output: "insert into pet values (Sunsweet05, Dsant05, otter, f, 30.11, 2), (Sunsweet06, Dsant06, otter, m, 30.11, 3)",
@@ -662,6 +691,17 @@ var (
 		input: "create table t (a int, b char, index if not exists idx (a, b))",
 	}, {
 		input: "create table t (a int, b char, fulltext idx (a, b))",
+	}, {
+		input: "create table t (a int, b text, fulltext idx (b) with parser ngram )",
+	}, {
+		input:  "select a from t where match(b, t.c) against('abc' IN BOOLEAN MODE)",
+		output: "select a from t where match(b, t.c) against(abc in boolean mode)",
+	}, {
+		input:  "select match(b) against('abc' in natural language mode) as score from t order by match (b) against ('abc')",
+		output: "select match(b) against(abc in natural language mode) as score from t order by match(b) against(abc)",
+	}, {
+		input:  "select a from t where match(b) against('abc' with query expansion)",
+		output: "select a from t where match(b) against(abc with query expansion)",
 	}, {
 		input:  "create table t (a int, b char, constraint p1 primary key idx using hash (a, b))",
 		output: "create table t (a int, b char, constraint p1 primary key idx using none (a, b))",
@@ -1197,6 +1237,21 @@ var (
 		}, {
 			input:  "rollback and chain no release",
 			output: "rollback",
+		}, {
+			input: "savepoint a",
+		}, {
+			input:  "SAVEPOINT `Sp1`",
+			output: "savepoint sp1",
+		}, {
+			input: "rollback to savepoint a",
+		}, {
+			input:  "rollback work to a",
+			output: "rollback to savepoint a",
+		}, {
+			input:  "rollback to savepoint",
+			output: "rollback to savepoint savepoint",
+		}, {
+			input: "release savepoint a",
 		}, {
 			input:  "commit and chain no release",
 			output: "commit",
@@ -1334,6 +1389,8 @@ var (
 			output: "create index idx1 using btree on a (a) KEY_BLOCK_SIZE 10 with parser x comment x invisible",
 		}, {
 			input: "create index idx1 on a (a)",
+		}, {
+			input: "create fulltext index idx1 on a (b, c) with parser ngram ",
 		}, {
 			input: "create unique index idx1 using btree on a (a, b(10), (a + b), (a - b)) visible",
 		}, {
@@ -1473,6 +1530,8 @@ var (
 		}, {
 			input:  "analyze table part (a,b )",
 			output: "analyze table part(a, b)",
+		}, {
+			input: "analyze table db.part",
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test'",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -1716,6 +1775,21 @@ var (
 		}, {
 			input:  `select json_extract(a, '$.b') from t`,
 			output: `select json_extract(a, $.b) from t`,
+		}, {
+			input:  `select 17 member of('[23, "abc", 17, "ab", 10]')`,
+			output: `select 17 member of ([23, "abc", 17, "ab", 10])`,
+		}, {
+			input:  `select * from t where a member of(json_extract(b, '$.ids'))`,
+			output: `select * from t where a member of (json_extract(b, $.ids))`,
+		}, {
+			input:  `select doc->'$.a', t.doc->>'$.b' from t`,
+			output: `select json_extract(doc, $.a), json_unquote(json_extract(t.doc, $.b)) from t`,
+		}, {
+			input:  `create index idx on t ((cast(doc->>'$.user_id' as unsigned)))`,
+			output: `create index idx on t ((cast(json_unquote(json_extract(doc, $.user_id)) as unsigned)))`,
+		}, {
+			input:  `create table t (id int primary key, doc json, index idx ((ltrim(doc->>'$.name'))))`,
+			output: `create table t (id int primary key, doc json, index idx ((ltrim(json_unquote(json_extract(doc, $.name))))))`,
 		}, {
 			input: `create table t1 (a int, b uuid)`,
 		}, {
@@ -1748,6 +1822,12 @@ var (
 			output: `values row(5, 7, 9), row(1, 2, 3), row(9, 10, 11) order by column_1 limit 2`,
 		},
 		{
+			input:  "select * from json_table('[{\"a\": 1}]', '$[*]' columns (id for ordinality, a int path '$.a' default '0' on empty null on error, b json exists path '$.b')) as jt",
+			output: "select * from json_table([{\"a\": 1}], $[*] columns (id for ordinality, a int path $.a default 0 on empty null on error, b json exists path $.b)) as jt",
+		}, {
+			input:  "select jt.* from t, json_table(t.doc, '$' columns (x varchar(10) path '$.x' error on error, nested path '$.items[*]' columns (item_id for ordinality, v double path '$.v'), nested '$.tags[*]' columns (tag text path '$'))) jt",
+			output: "select jt.* from t cross join json_table(t.doc, $ columns (x varchar(10) path $.x error on error, nested path $.items[*] columns (item_id for ordinality, v double path $.v), nested path $.tags[*] columns (tag text path $))) as jt",
+		}, {
 			input:  `select * from unnest("a") as f`,
 			output: `select * from unnest(a) as f`,
 		},
@@ -1839,6 +1919,15 @@ var (
 		{
 			input: `select count(a) over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1`,
 		},
+		{
+			input: `select row_number() over (partition by col1 order by col2) from t1`,
+		},
+		{
+			input: `select lag(a, 1, 0) over (order by col1), lead(a) over (order by col1) from t1`,
+		},
+		{
+			input: `select ntile(4) over (order by col1 desc) from t1`,
+		},
 		{
 			input: "alter account if exists abc",
 		},
@@ -2056,6 +2145,53 @@ var (
 			input:  "alter table tbl1 checksum = 0, COMMENT = 'asdf'",
 			output: "alter table tbl1 checksum = 0, comment = asdf",
 		},
+		{
+			input:  "alter table t1 add column c int default 1 after a",
+			output: "alter table t1 add column c int default 1 after a",
+		},
+		{
+			input:  "alter table t1 add c varchar(10) first, add column d int",
+			output: "alter table t1 add column c varchar(10) first, add column d int",
+		},
+		{
+			input:  "alter table t1 modify c varchar(20) not null",
+			output: "alter table t1 modify column c varchar(20) not null",
+		},
+		{
+			input:  "alter table t1 change column c d varchar(20) after a",
+			output: "alter table t1 change column c d varchar(20) after a",
+		},
+		{
+			input: "alter table t1 rename column c to d",
+		},
+		{
+			input:  "alter table t1 add partition (partition p4 values less than (2024))",
+			output: "alter table t1 add partition (partition p4 values less than (2024))",
+		},
+		{
+			input:  "alter table t1 add partition partitions 2",
+			output: "alter table t1 add partition partitions 2",
+		},
+		{
+			input:  "alter table t1 drop partition p0, p1",
+			output: "alter table t1 drop partition p0, p1",
+		},
+		{
+			input:  "alter table t1 truncate partition all",
+			output: "alter table t1 truncate partition all",
+		},
+		{
+			input:  "alter table t1 reorganize partition p3 into (partition p3 values less than (2023), partition p4 values less than maxvalue)",
+			output: "alter table t1 reorganize partition p3 into (partition p3 values less than (2023), partition p4 values less than (MAXVALUE))",
+		},
+		{
+			input:  "alter table t1 exchange partition p0 with table db.t2 without validation",
+			output: "alter table t1 exchange partition p0 with table db.t2 without validation",
+		},
+		{
+			input:  "alter table t1 exchange partition p0 with table t2 with validation",
+			output: "alter table t1 exchange partition p0 with table t2",
+		},
 		{
 			input:  "alter table t1 alter index c visible",
 			output: "alter table t1 alter index c visible",
@@ -2200,6 +2336,42 @@ var (
 			input:  "drop procedure test1",
 			output: "drop procedure test1",
 		},
+		{
+			input:  "create trigger trg1 before insert on t1 for each row 'begin set new.a = new.a + 1; end'",
+			output: "create trigger trg1 before insert on t1 for each row 'begin set new.a = new.a + 1; end'",
+		},
+		{
+			input:  "create trigger if not exists db1.trg2 after delete on db1.t1 for each row 'begin insert into log values (old.a); end'",
+			output: "create trigger if not exists db1.trg2 after delete on db1.t1 for each row 'begin insert into log values (old.a); end'",
+		},
+		{
+			input:  "CREATE TRIGGER trg3 AFTER UPDATE ON t1 FOR EACH ROW 'begin end'",
+			output: "create trigger trg3 after update on t1 for each row 'begin end'",
+		},
+		{
+			input:  "drop trigger trg1",
+			output: "drop trigger trg1",
+		},
+		{
+			input:  "drop trigger if exists db1.trg2",
+			output: "drop trigger if exists db1.trg2",
+		},
+		{
+			input:  "create materialized view if not exists mv1 (a, cnt) as select a, count(*) from t1 where b > 1 group by a",
+			output: "create materialized view if not exists mv1 (a, cnt) as select a, count(*) from t1 where b > 1 group by a",
+		},
+		{
+			input:  "refresh materialized view db1.mv1",
+			output: "refresh materialized view db1.mv1",
+		},
+		{
+			input:  "REFRESH MATERIALIZED VIEW mv1 INCREMENTAL",
+			output: "refresh materialized view mv1 incremental",
+		},
+		{
+			input:  "drop materialized view if exists mv1, db1.mv2",
+			output: "drop materialized view if exists mv1, db1.mv2",
+		},
 		{
 			input:  "call test1()",
 			output: "call test1()",
@@ -230,6 +230,77 @@ func (node *AlterOptionAdd) Format(ctx *FmtCtx) {
 	node.Def.Format(ctx)
 }
 
+type ColumnPositionType int
+
+const (
+	ColumnPositionNone ColumnPositionType = iota
+	ColumnPositionFirst
+	ColumnPositionAfter
+)
+
+// ColumnPosition is the position of the added or modified column,
+// e.g. FIRST or AFTER col.
+type ColumnPosition struct {
+	Typ            ColumnPositionType
+	RelativeColumn Identifier
+}
+
+func (node *ColumnPosition) Format(ctx *FmtCtx) {
+	switch node.Typ {
+	case ColumnPositionFirst:
+		ctx.WriteString(" first")
+	case ColumnPositionAfter:
+		ctx.WriteString(" after ")
+		node.RelativeColumn.Format(ctx)
+	}
+}
+
+type AlterOptionAddColumn struct {
+	alterOptionImpl
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+	node.Position.Format(ctx)
+}
+
+// AlterOptionModifyColumn is MODIFY [COLUMN] col_def [FIRST | AFTER col],
+// or CHANGE [COLUMN] old_col col_def [FIRST | AFTER col] if OldName is not empty.
+type AlterOptionModifyColumn struct {
+	alterOptionImpl
+	OldName  Identifier
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionModifyColumn) Format(ctx *FmtCtx) {
+	if len(node.OldName) > 0 {
+		ctx.WriteString("change column ")
+		node.OldName.Format(ctx)
+		ctx.WriteByte(' ')
+	} else {
+		ctx.WriteString("modify column ")
+	}
+	node.Column.Format(ctx)
+	node.Position.Format(ctx)
+}
+
+type AlterOptionRenameColumn struct {
+	alterOptionImpl
+	OldName Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
 type AlterTableDropType int
 
 const (
@@ -266,6 +337,80 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	}
 }
 
+type AlterPartitionType int
+
+const (
+	AlterPartitionAdd AlterPartitionType = iota
+	AlterPartitionDrop
+	AlterPartitionTruncate
+	AlterPartitionReorganize
+	AlterPartitionExchange
+)
+
+// AlterOptionPartition is one of the partition management options, e.g.
+// ADD PARTITION (partition_definitions) or DROP PARTITION partition_names.
+type AlterOptionPartition struct {
+	alterOptionImpl
+	Typ AlterPartitionType
+	// the partitions dropped, truncated, reorganized or exchanged
+	Names IdentifierList
+	// TRUNCATE PARTITION ALL
+	AllPartitions bool
+	// the partitions defined by ADD PARTITION and REORGANIZE PARTITION
+	Partitions []*Partition
+	// ADD PARTITION PARTITIONS num of the HASH and KEY partitioned table
+	Num uint64
+	// the table exchanged with the partition
+	Table             *TableName
+	WithoutValidation bool
+}
+
+func (node *AlterOptionPartition) formatPartitions(ctx *FmtCtx) {
+	ctx.WriteString(" (")
+	for i, p := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		p.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func (node *AlterOptionPartition) Format(ctx *FmtCtx) {
+	switch node.Typ {
+	case AlterPartitionAdd:
+		ctx.WriteString("add partition")
+		if node.Num > 0 {
+			ctx.WriteString(fmt.Sprintf(" partitions %d", node.Num))
+		} else {
+			node.formatPartitions(ctx)
+		}
+	case AlterPartitionDrop:
+		ctx.WriteString("drop partition ")
+		node.Names.Format(ctx)
+	case AlterPartitionTruncate:
+		ctx.WriteString("truncate partition ")
+		if node.AllPartitions {
+			ctx.WriteString("all")
+		} else {
+			node.Names.Format(ctx)
+		}
+	case AlterPartitionReorganize:
+		ctx.WriteString("reorganize partition ")
+		node.Names.Format(ctx)
+		ctx.WriteString(" into")
+		node.formatPartitions(ctx)
+	case AlterPartitionExchange:
+		ctx.WriteString("exchange partition ")
+		node.Names.Format(ctx)
+		ctx.WriteString(" with table ")
+		node.Table.Format(ctx)
+		if node.WithoutValidation {
+			ctx.WriteString(" without validation")
+		}
+	}
+}
+
 type AccountsSetOption struct {
 	All          bool
 	SetAccounts  IdentifierList
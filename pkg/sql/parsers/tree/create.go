@@ -735,6 +735,8 @@ func (it IndexType) ToString() string {
 		return "bsi"
 	case INDEX_TYPE_ZONEMAP:
 		return "zonemap"
+	case INDEX_TYPE_IVFFLAT:
+		return "ivfflat"
 	default:
 		return "Unknown IndexType"
 	}
@@ -747,6 +749,7 @@ const (
 	INDEX_TYPE_RTREE
 	INDEX_TYPE_BSI
 	INDEX_TYPE_ZONEMAP
+	INDEX_TYPE_IVFFLAT
 )
 
 type VisibleType int
@@ -770,11 +773,13 @@ func (vt VisibleType) ToString() string {
 
 type IndexOption struct {
 	NodeFormatter
-	KeyBlockSize             uint64
-	IType                    IndexType
-	ParserName               string
-	Comment                  string
-	Visible                  VisibleType
+	KeyBlockSize uint64
+	IType        IndexType
+	ParserName   string
+	Comment      string
+	Visible      VisibleType
+	// Lists is the number of the lists of the ivfflat index.
+	Lists                    uint64
 	EngineAttribute          string
 	SecondaryEngineAttribute string
 }
@@ -796,6 +801,11 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.Comment)
 		ctx.WriteByte(' ')
 	}
+	if node.Lists != 0 {
+		ctx.WriteString("lists ")
+		ctx.WriteString(strconv.FormatUint(node.Lists, 10))
+		ctx.WriteByte(' ')
+	}
 	if node.Visible != VISIBLE_TYPE_INVALID {
 		ctx.WriteString(node.Visible.ToString())
 	}
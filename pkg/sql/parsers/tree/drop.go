@@ -74,10 +74,16 @@ type DropView struct {
 	statementImpl
 	IfExists bool
 	Names    TableNames
+	// Materialized is true for DROP MATERIALIZED VIEW
+	Materialized bool
 }
 
 func (node *DropView) Format(ctx *FmtCtx) {
-	ctx.WriteString("drop view")
+	ctx.WriteString("drop ")
+	if node.Materialized {
+		ctx.WriteString("materialized ")
+	}
+	ctx.WriteString("view")
 	if node.IfExists {
 		ctx.WriteString(" if exists")
 	}
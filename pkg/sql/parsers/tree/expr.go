@@ -198,6 +198,7 @@ const (
 	IS_DISTINCT_FROM
 	IS_NOT_DISTINCT_FROM
 	NULL_SAFE_EQUAL // <=>
+	MEMBER_OF       // MEMBER OF
 	//reference: https://dev.mysql.com/doc/refman/8.0/en/all-subqueries.html
 	//subquery with ANY,SOME,ALL
 	//operand comparison_operator [ANY | SOME | ALL] (subquery)
@@ -238,6 +239,8 @@ func (op ComparisonOp) ToString() string {
 		return "is not distinct from"
 	case NULL_SAFE_EQUAL:
 		return "<=>"
+	case MEMBER_OF:
+		return "member of"
 	case ANY:
 		return "any"
 	case SOME:
@@ -837,6 +840,64 @@ func NewCastExpr(e Expr, t ResolvableTypeReference) *CastExpr {
 	}
 }
 
+type FullTextSearchType int
+
+const (
+	FULLTEXT_DEFAULT FullTextSearchType = iota
+	FULLTEXT_NL
+	FULLTEXT_NL_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+func (t FullTextSearchType) ToString() string {
+	switch t {
+	case FULLTEXT_NL:
+		return "in natural language mode"
+	case FULLTEXT_NL_QUERY_EXPANSION:
+		return "in natural language mode with query expansion"
+	case FULLTEXT_BOOLEAN:
+		return "in boolean mode"
+	case FULLTEXT_QUERY_EXPANSION:
+		return "with query expansion"
+	default:
+		return ""
+	}
+}
+
+// the MATCH (col1, col2, ...) AGAINST (expr [search_modifier]) expression
+type FullTextMatchExpr struct {
+	exprImpl
+	KeyParts []*UnresolvedName
+	Pattern  Expr
+	Mode     FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	for i, k := range node.KeyParts {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		k.Format(ctx)
+	}
+	ctx.WriteString(") against(")
+	node.Pattern.Format(ctx)
+	if node.Mode != FULLTEXT_DEFAULT {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Mode.ToString())
+	}
+	ctx.WriteByte(')')
+}
+
+func NewFullTextMatchExpr(k []*UnresolvedName, p Expr, m FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		KeyParts: k,
+		Pattern:  p,
+		Mode:     m,
+	}
+}
+
 // the parenthesized list of expressions.
 type Tuple struct {
 	exprImpl
@@ -31,6 +31,7 @@ type Select struct {
 	Limit   *Limit
 	With    *With
 	Ep      *ExportParam
+	Lock    *SelectLockInfo
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
@@ -47,6 +48,10 @@ func (node *Select) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Limit.Format(ctx)
 	}
+	if node.Lock != nil {
+		ctx.WriteByte(' ')
+		node.Lock.Format(ctx)
+	}
 	if node.Ep != nil {
 		ctx.WriteByte(' ')
 		node.Ep.Format(ctx)
@@ -64,6 +69,73 @@ func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
 	}
 }
 
+// SelectLockType is the type of the locking read of a select.
+type SelectLockType int8
+
+const (
+	SelectLockForUpdate SelectLockType = iota
+	SelectLockForShare
+	SelectLockInShareMode
+)
+
+var selectLockTypeName = [...]string{
+	SelectLockForUpdate:   "for update",
+	SelectLockForShare:    "for share",
+	SelectLockInShareMode: "lock in share mode",
+}
+
+func (t SelectLockType) String() string {
+	if t < 0 || t > SelectLockType(len(selectLockTypeName)-1) {
+		return fmt.Sprintf("SelectLockType(%d)", t)
+	}
+	return selectLockTypeName[t]
+}
+
+// SelectLockWait is what a locking read does with the rows locked by other
+// transactions.
+type SelectLockWait int8
+
+const (
+	SelectLockWaitDefault SelectLockWait = iota
+	SelectLockNoWait
+	SelectLockSkipLocked
+)
+
+var selectLockWaitName = [...]string{
+	SelectLockWaitDefault: "",
+	SelectLockNoWait:      "nowait",
+	SelectLockSkipLocked:  "skip locked",
+}
+
+func (w SelectLockWait) String() string {
+	if w < 0 || w > SelectLockWait(len(selectLockWaitName)-1) {
+		return fmt.Sprintf("SelectLockWait(%d)", w)
+	}
+	return selectLockWaitName[w]
+}
+
+// SelectLockInfo represents the locking read clause of a select,
+// FOR UPDATE | FOR SHARE [OF tbl_name, ...] [NOWAIT | SKIP LOCKED] or
+// LOCK IN SHARE MODE.
+type SelectLockInfo struct {
+	LockType SelectLockType
+	// Tables are the tables locked, all the tables of the select if empty
+	Tables TableNames
+	Wait   SelectLockWait
+}
+
+func (node *SelectLockInfo) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.LockType.String())
+	if len(node.Tables) > 0 {
+		ctx.WriteString(" of ")
+		node.Tables.Format(ctx)
+	}
+	if node.Wait != SelectLockWaitDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Wait.String())
+	}
+}
+
 // OrderBy represents an ORDER BY clause.
 type OrderBy []*Order
 
@@ -447,16 +519,34 @@ func (node *AliasClause) Format(ctx *FmtCtx) {
 	}
 }
 
+// The snapshot of a table read by the time-travel query:
+// "{AS OF TIMESTAMP expr}".
+type AsOfClause struct {
+	NodeFormatter
+	Expr Expr
+}
+
+func (node *AsOfClause) Format(ctx *FmtCtx) {
+	ctx.WriteString("{as of timestamp ")
+	node.Expr.Format(ctx)
+	ctx.WriteByte('}')
+}
+
 // the table expression coupled with an optional alias.
 type AliasedTableExpr struct {
 	TableExpr
 	Expr       TableExpr
+	AsOf       *AsOfClause
 	As         AliasClause
 	IndexHints []*IndexHint
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOf != nil {
+		ctx.WriteByte(' ')
+		node.AsOf.Format(ctx)
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
@@ -18,9 +18,19 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// JsonColumns is the COLUMNS clause of json_table.
+	JsonColumns []*JsonTableColumn
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
+	if t.JsonColumns != nil {
+		ctx.WriteString("json_table(")
+		t.Func.Exprs.Format(ctx)
+		ctx.WriteString(" columns ")
+		formatJsonTableColumns(ctx, t.JsonColumns)
+		ctx.WriteByte(')')
+		return
+	}
 	if t.Func != nil {
 		t.Func.Format(ctx)
 	}
@@ -33,3 +43,96 @@ func (t TableFunction) Id() string {
 
 func (t *TableFunction) GetStatementType() string { return "Table Function" }
 func (t *TableFunction) GetQueryType() string     { return QueryTypeOth }
+
+type JsonTableColumnType int
+
+const (
+	// JsonTableColumnPath is 'name type PATH path'.
+	JsonTableColumnPath JsonTableColumnType = iota
+	// JsonTableColumnExists is 'name type EXISTS PATH path'.
+	JsonTableColumnExists
+	// JsonTableColumnOrdinality is 'name FOR ORDINALITY'.
+	JsonTableColumnOrdinality
+	// JsonTableColumnNested is 'NESTED PATH path COLUMNS (...)'.
+	JsonTableColumnNested
+)
+
+type JsonTableResponseType int
+
+const (
+	JsonTableResponseNull JsonTableResponseType = iota
+	JsonTableResponseError
+	JsonTableResponseDefault
+)
+
+// JsonTableResponse is the ON EMPTY or ON ERROR clause of a json_table column.
+type JsonTableResponse struct {
+	Type  JsonTableResponseType
+	Value string
+}
+
+func (node *JsonTableResponse) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JsonTableResponseNull:
+		ctx.WriteString("null")
+	case JsonTableResponseError:
+		ctx.WriteString("error")
+	case JsonTableResponseDefault:
+		ctx.WriteString("default ")
+		ctx.WriteValue(P_char, node.Value)
+	}
+}
+
+type JsonTableColumn struct {
+	Type    JsonTableColumnType
+	Name    Identifier
+	ColType ResolvableTypeReference
+	Path    string
+	OnEmpty *JsonTableResponse
+	OnError *JsonTableResponse
+	// Columns are the columns of the nested path.
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JsonTableColumnOrdinality:
+		node.Name.Format(ctx)
+		ctx.WriteString(" for ordinality")
+	case JsonTableColumnNested:
+		ctx.WriteString("nested path ")
+		ctx.WriteValue(P_char, node.Path)
+		ctx.WriteString(" columns ")
+		formatJsonTableColumns(ctx, node.Columns)
+	default:
+		node.Name.Format(ctx)
+		ctx.WriteByte(' ')
+		node.ColType.(*T).InternalType.Format(ctx)
+		if node.Type == JsonTableColumnExists {
+			ctx.WriteString(" exists")
+		}
+		ctx.WriteString(" path ")
+		ctx.WriteValue(P_char, node.Path)
+		if node.OnEmpty != nil {
+			ctx.WriteByte(' ')
+			node.OnEmpty.Format(ctx)
+			ctx.WriteString(" on empty")
+		}
+		if node.OnError != nil {
+			ctx.WriteByte(' ')
+			node.OnError.Format(ctx)
+			ctx.WriteString(" on error")
+		}
+	}
+}
+
+func formatJsonTableColumns(ctx *FmtCtx, cols []*JsonTableColumn) {
+	ctx.WriteByte('(')
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
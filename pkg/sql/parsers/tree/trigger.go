@@ -0,0 +1,97 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type TriggerTime int
+
+const (
+	TriggerBefore TriggerTime = iota
+	TriggerAfter
+)
+
+func (t TriggerTime) String() string {
+	if t == TriggerAfter {
+		return "after"
+	}
+	return "before"
+}
+
+type TriggerEvent int
+
+const (
+	TriggerInsert TriggerEvent = iota
+	TriggerUpdate
+	TriggerDelete
+)
+
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerUpdate:
+		return "update"
+	case TriggerDelete:
+		return "delete"
+	default:
+		return "insert"
+	}
+}
+
+// CreateTrigger is a row-level trigger, the body is a stored procedure body
+// which can read the row by NEW.col and OLD.col.
+type CreateTrigger struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	Time        TriggerTime
+	Event       TriggerEvent
+	Table       *TableName
+	Body        string
+}
+
+func (node *CreateTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("create trigger ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(node.Time.String())
+	ctx.WriteByte(' ')
+	ctx.WriteString(node.Event.String())
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for each row '")
+	ctx.WriteString(node.Body)
+	ctx.WriteString("'")
+}
+
+func (node *CreateTrigger) GetStatementType() string { return "Create Trigger" }
+func (node *CreateTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+type DropTrigger struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func (node *DropTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop trigger ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropTrigger) GetStatementType() string { return "Drop Trigger" }
+func (node *DropTrigger) GetQueryType() string     { return QueryTypeDDL }
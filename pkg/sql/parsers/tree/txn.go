@@ -103,3 +103,57 @@ func (node *RollbackTransaction) GetQueryType() string     { return QueryTypeTCL
 func NewRollbackTransaction(t CompletionType) *RollbackTransaction {
 	return &RollbackTransaction{Type: t}
 }
+
+// SavePoint statement
+type SavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *SavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *SavePoint) GetStatementType() string { return "Savepoint" }
+func (node *SavePoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewSavePoint(name Identifier) *SavePoint {
+	return &SavePoint{Name: name}
+}
+
+// RollbackToSavePoint statement
+type RollbackToSavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *RollbackToSavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollback to savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *RollbackToSavePoint) GetStatementType() string { return "Rollback To Savepoint" }
+func (node *RollbackToSavePoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewRollbackToSavePoint(name Identifier) *RollbackToSavePoint {
+	return &RollbackToSavePoint{Name: name}
+}
+
+// ReleaseSavePoint statement
+type ReleaseSavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *ReleaseSavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("release savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *ReleaseSavePoint) GetStatementType() string { return "Release Savepoint" }
+func (node *ReleaseSavePoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewReleaseSavePoint(name Identifier) *ReleaseSavePoint {
+	return &ReleaseSavePoint{Name: name}
+}
@@ -38,6 +38,7 @@ const (
 	EnumFamily
 	SetFamily
 	UuidFamily
+	VectorFamily
 
 	//test
 	BlobFamily
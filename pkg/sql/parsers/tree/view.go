@@ -20,11 +20,17 @@ type CreateView struct {
 	ColNames    IdentifierList
 	AsSource    *Select
 	IfNotExists bool
+	// Materialized is true for CREATE MATERIALIZED VIEW whose results are kept
+	// in a hidden table.
+	Materialized bool
 }
 
 func (node *CreateView) Format(ctx *FmtCtx) {
 	ctx.WriteString("create ")
 
+	if node.Materialized {
+		ctx.WriteString("materialized ")
+	}
 	ctx.WriteString("view ")
 
 	if node.IfNotExists {
@@ -43,3 +49,23 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 
 func (node *CreateView) GetStatementType() string { return "Create View" }
 func (node *CreateView) GetQueryType() string     { return QueryTypeDDL }
+
+// RefreshMaterializedView recomputes the results of a materialized view.
+type RefreshMaterializedView struct {
+	statementImpl
+	Name *TableName
+	// Incremental refreshes the view by the rows inserted into its base table
+	// since the last refresh.
+	Incremental bool
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+	if node.Incremental {
+		ctx.WriteString(" incremental")
+	}
+}
+
+func (node *RefreshMaterializedView) GetStatementType() string { return "Refresh Materialized View" }
+func (node *RefreshMaterializedView) GetQueryType() string     { return QueryTypeDML }
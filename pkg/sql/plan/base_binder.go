@@ -89,20 +89,28 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 	case *tree.RangeCond:
 		expr, err = b.bindRangeCond(exprImpl, depth, isRoot)
 
+	case *tree.FullTextMatchExpr:
+		expr, err = b.bindFullTextMatch(exprImpl, depth, isRoot)
+
 	case *tree.UnresolvedName:
 		// check existence
 		if b.GetContext() != nil && b.GetContext().Value(defines.InSp{}) != nil && b.GetContext().Value(defines.InSp{}).(bool) {
 			tmpScope := b.GetContext().Value(defines.VarScopeKey{}).(*[]map[string]interface{})
+			// the NEW and OLD values of the row are named as new.col and old.col in triggers
+			varName := exprImpl.Parts[0]
+			if exprImpl.NumParts == 2 {
+				varName = exprImpl.Parts[1] + "." + exprImpl.Parts[0]
+			}
 			for i := len(*tmpScope) - 1; i >= 0; i-- {
 				curScope := (*tmpScope)[i]
-				if _, ok := curScope[strings.ToLower(exprImpl.Parts[0])]; ok {
+				if _, ok := curScope[strings.ToLower(varName)]; ok {
 					expr = &Expr{
 						Typ: &plan.Type{
 							Id: int32(types.T_any),
 						},
 						Expr: &plan.Expr_V{
 							V: &plan.VarRef{
-								Name:   exprImpl.Parts[0],
+								Name:   varName,
 								System: false,
 								Global: false,
 							},
@@ -826,6 +834,8 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		op = "reg_match"
 	case tree.NOT_REG_MATCH:
 		op = "not_reg_match"
+	case tree.MEMBER_OF:
+		op = "member_of"
 	default:
 		return nil, moerr.NewNYI(b.GetContext(), "'%v'", astExpr)
 	}
@@ -883,7 +893,13 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
-	if function.GetFunctionIsAggregateByName(funcName) {
+	if astExpr.WindowSpec != nil {
+		// aggregate functions with an OVER clause are evaluated as window functions
+		if !function.GetFunctionIsAggregateByName(funcName) && !function.GetFunctionIsWinFunByName(funcName) {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "function %s is not a window function", funcName)
+		}
+		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	} else if function.GetFunctionIsAggregateByName(funcName) {
 		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	} else if function.GetFunctionIsWinFunByName(funcName) {
 		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
@@ -1291,6 +1307,24 @@ func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr)
 		}
 	}
 
+	// enum and set are compared with strings by their members.
+	switch name {
+	case "cast_index_to_value", "cast_value_to_enum", "cast_value_to_set":
+	default:
+		for _, arg := range args {
+			if types.T(arg.Typ.Id).IsMySQLString() {
+				for i := range args {
+					if isEnumType(args[i].Typ) {
+						if args[i], err = makePlan2CastExpr(ctx, args[i], &Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}); err != nil {
+							return nil, err
+						}
+					}
+				}
+				break
+			}
+		}
+	}
+
 	// get args(exprs) & types
 	argsLength := len(args)
 	argsType := make([]types.Type, argsLength)
@@ -1436,6 +1470,15 @@ func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr)
 	// return new expr
 	Typ := makePlan2Type(&returnType)
 	Typ.NotNullable = function.DeduceNotNullable(funcID, args)
+	// the enum and set values returned by max, min and so on keep the members.
+	if returnType.Oid == types.T_enum || returnType.Oid == types.T_set {
+		for _, arg := range args {
+			if arg.Typ.Id == Typ.Id {
+				Typ.Enumvalues = arg.Typ.Enumvalues
+				break
+			}
+		}
+	}
 	return &Expr{
 		Expr: &plan.Expr_F{
 			F: &plan.Function{
@@ -1595,6 +1638,9 @@ func (b *baseBinder) GetContext() context.Context { return b.sysCtx }
 
 func appendCastBeforeExpr(ctx context.Context, expr *Expr, toType *Type, isBin ...bool) (*Expr, error) {
 	toType.NotNullable = expr.Typ.NotNullable
+	if e, ok, err := makePlan2EnumCastExpr(ctx, expr, toType); ok || err != nil {
+		return e, err
+	}
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
 		makeTypeByPlan2Type(toType),
@@ -28,6 +28,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 	bc := &BindContext{
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
+		windowByAst:    make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
@@ -70,6 +71,16 @@ func (bc *BindContext) findCTE(name string) *CTERef {
 	return nil
 }
 
+func (bc *BindContext) findRecursiveCTE(name string) *recursiveCTE {
+	for ; bc != nil; bc = bc.parent {
+		if bc.recursiveCTE != nil && bc.recursiveCTE.name == name {
+			return bc.recursiveCTE
+		}
+	}
+
+	return nil
+}
+
 func (bc *BindContext) mergeContexts(ctx context.Context, left, right *BindContext) error {
 	left.parent = bc
 	right.parent = bc
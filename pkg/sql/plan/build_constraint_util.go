@@ -45,6 +45,9 @@ type dmlSelectInfo struct {
 	onIdx    []int32 //remove these row
 	onIdxTbl []*ObjectRef
 
+	onPkIdx    []int32 // remove these rows of the index tables keyed by pk
+	onPkIdxTbl []*ObjectRef
+
 	onRestrict    []int32 // check these, not all null then throw error
 	onRestrictTbl []*ObjectRef
 
@@ -178,7 +181,7 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 				newTblInfo.haveConstraint = true
 			} else {
 				for _, indexdef := range tblDef.Indexes {
-					if indexdef.Unique {
+					if util.HasIndexTable(indexdef) {
 						newTblInfo.haveConstraint = true
 						break
 					}
@@ -199,6 +202,9 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 	var tblName, dbName, alias string
 
 	if aliasTbl, ok := tbl.(*tree.AliasedTableExpr); ok {
+		if aliasTbl.AsOf != nil {
+			return moerr.NewInvalidInput(ctx.GetContext(), "cannot update/delete the snapshot of a table")
+		}
 		alias = string(aliasTbl.As.Alias)
 		tbl = aliasTbl.Expr
 	}
@@ -289,7 +295,7 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 			tblInfo.haveConstraint = true
 		} else {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if util.HasIndexTable(indexdef) {
 					tblInfo.haveConstraint = true
 					break
 				}
@@ -916,6 +922,9 @@ func forceCastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, er
 	if targetType.Id == 0 {
 		return expr, nil
 	}
+	if e, ok, err := makePlan2EnumCastExpr(ctx, expr, targetType); ok || err != nil {
+		return e, err
+	}
 	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if t1.Eq(t2) {
 		return expr, nil
@@ -1072,7 +1081,14 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 	if info.typ != "insert" || (info.typ == "insert" && len(info.onDuplicateIdx) > 0) {
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if util.HasIndexTable(indexdef) {
+					// the fulltext, ivfflat and expression index table are
+					// keyed by serial(x, pk), their rows are found by the
+					// primary key.
+					joinByPk := util.IsFullTextIndex(indexdef) || util.IsIvfFlatIndex(indexdef) || util.IsExpressionIndex(indexdef)
+					if joinByPk && info.typ == "insert" {
+						return moerr.NewNYI(builder.GetContext(), "insert on duplicate key update with %s index", indexdef.IndexAlgo)
+					}
 					idxRef := &plan.ObjectRef{
 						SchemaName: builder.compCtx.DefaultDatabase(),
 						ObjName:    indexdef.IndexTableName,
@@ -1099,12 +1115,15 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 
 					var rightRowIdPos int32 = -1
 					var rightIdxPos int32 = -1 //it's also a primary key.
+					var rightPriPos int32 = -1
 					// it's better to get pos from tableDef
 					for colIdx, col := range rightTableDef.Cols {
 						if col.Name == catalog.Row_ID {
 							rightRowIdPos = int32(colIdx)
 						} else if col.Name == catalog.IndexTableIndexColName {
 							rightIdxPos = int32(colIdx)
+						} else if col.Name == catalog.IndexTablePrimaryColName {
+							rightPriPos = int32(colIdx)
 						}
 					}
 
@@ -1120,7 +1139,11 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					})
 					// we only keep column index of row_id.
 					// primary key column index = column index of row_id + 1
-					info.onIdx = append(info.onIdx, info.idx)
+					if joinByPk {
+						info.onPkIdx = append(info.onPkIdx, info.idx)
+					} else {
+						info.onIdx = append(info.onIdx, info.idx)
+					}
 					info.idx = info.idx + 1
 					info.projectList = append(info.projectList, &plan.Expr{
 						Typ: rightTableDef.Cols[rightIdxPos].Typ,
@@ -1147,7 +1170,45 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					var joinConds []*Expr
 					var leftExpr *Expr
 					partsLength := len(indexdef.Parts)
-					if partsLength == 1 {
+					if joinByPk {
+						// the fulltext index table has rows of every word of
+						// the document, join them all by the primary key. A
+						// row of the table comes once for every index row, the
+						// index delete operator passes it once.
+						rightExpr = &plan.Expr{
+							Typ: rightTableDef.Cols[rightPriPos].Typ,
+							Expr: &plan.Expr_Col{
+								Col: &plan.ColRef{
+									RelPos: rightTag,
+									ColPos: rightPriPos,
+								},
+							},
+						}
+						pkNames := []string{tableDef.Pkey.PkeyColName}
+						if tableDef.Pkey.CompPkeyCol != nil {
+							pkNames = tableDef.Pkey.Names
+						}
+						args := make([]*Expr, len(pkNames))
+						for i, column := range pkNames {
+							args[i] = &plan.Expr{
+								Typ: typMap[column],
+								Expr: &plan.Expr_Col{
+									Col: &plan.ColRef{
+										RelPos: baseTag,
+										ColPos: int32(oldColPosMap[column]),
+									},
+								},
+							}
+						}
+						if len(args) == 1 {
+							leftExpr = args[0]
+						} else {
+							leftExpr, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", args)
+							if err != nil {
+								return err
+							}
+						}
+					} else if partsLength == 1 {
 						orginIndexColumnName := indexdef.Parts[0]
 						typ := typMap[orginIndexColumnName]
 						leftExpr = &Expr{
@@ -1198,7 +1259,11 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					}, joinCtx)
 					bindCtx.binder = NewTableBinder(builder, bindCtx)
 					info.rootId = newRootId
-					info.onIdxTbl = append(info.onIdxTbl, idxRef)
+					if joinByPk {
+						info.onPkIdxTbl = append(info.onPkIdxTbl, idxRef)
+					} else {
+						info.onIdxTbl = append(info.onIdxTbl, idxRef)
+					}
 				}
 			}
 		}
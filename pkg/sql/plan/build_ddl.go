@@ -27,19 +27,24 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex"
 )
 
-func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, error) {
+// genViewTableDef generates the table def of the view selecting stmt. If
+// mvName isn't empty, the view is the materialized view named mvName, whose
+// results are kept in the hidden table described by the returned data.
+func genViewTableDef(ctx CompilerContext, stmt *tree.Select, mvName string) (*plan.TableDef, *MaterializedViewData, error) {
 	var tableDef plan.TableDef
 
 	// check view statement
 	stmtPlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	query := stmtPlan.GetQuery()
@@ -69,12 +74,18 @@ func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, er
 		}
 	}
 
+	var mvData *MaterializedViewData
+	if mvName != "" {
+		mvData = buildMaterializedViewData(mvName, stmt, query)
+	}
+
 	viewData, err := json.Marshal(ViewData{
 		Stmt:            viewSql,
 		DefaultDatabase: ctx.DefaultDatabase(),
+		Materialized:    mvData,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	tableDef.ViewSql = &plan.ViewDef{
 		View: string(viewData),
@@ -97,7 +108,7 @@ func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, er
 		},
 	})
 
-	return &tableDef, nil
+	return &tableDef, mvData, nil
 }
 
 func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error) {
@@ -127,7 +138,11 @@ func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot create view in subscription database")
 	}
 
-	tableDef, err := genViewTableDef(ctx, stmt.AsSource)
+	mvName := ""
+	if stmt.Materialized {
+		mvName = string(viewName)
+	}
+	tableDef, mvData, err := genViewTableDef(ctx, stmt.AsSource, mvName)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +150,9 @@ func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error)
 	createTable.TableDef.Cols = tableDef.Cols
 	createTable.TableDef.ViewSql = tableDef.ViewSql
 	createTable.TableDef.Defs = tableDef.Defs
+	if mvData != nil {
+		buildMaterializedViewTable(createTable, tableDef.Cols, mvData)
+	}
 
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
@@ -504,24 +522,6 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		return moerr.NewInvalidInput(ctx, "invalid main table name %s", mainTableName)
 	}
 
-	//common properties
-	partitionProps := []*plan.Property{
-		{
-			Key:   catalog.SystemRelAttr_Kind,
-			Value: catalog.SystemPartitionRel,
-		},
-		{
-			Key:   catalog.SystemRelAttr_CreateSQL,
-			Value: "",
-		},
-	}
-	partitionPropsDef := &plan.TableDef_DefType{
-		Def: &plan.TableDef_DefType_Properties{
-			Properties: &plan.PropertiesDef{
-				Properties: partitionProps,
-			},
-		}}
-
 	partitionDef := createTable.TableDef.Partition
 	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
 
@@ -537,17 +537,40 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		part.PartitionTableName = partitionTableName
 		partitionTableNames[i] = partitionTableName
 
-		partitionTableDefs[i] = &TableDef{
-			Name: partitionTableName,
-			Cols: createTable.TableDef.Cols, //same as the main table's column defs
-		}
-		partitionTableDefs[i].Defs = append(partitionTableDefs[i].Defs, partitionPropsDef)
+		//same as the main table's column defs
+		partitionTableDefs[i] = makePartitionTableDef(partitionTableName, createTable.TableDef.Cols)
 	}
 	partitionDef.PartitionTableNames = partitionTableNames
 	createTable.PartitionTables = partitionTableDefs
 	return nil
 }
 
+// makePartitionTableDef constructs the table def of a partition table with the columns
+func makePartitionTableDef(partitionTableName string, cols []*ColDef) *TableDef {
+	//common properties
+	partitionProps := []*plan.Property{
+		{
+			Key:   catalog.SystemRelAttr_Kind,
+			Value: catalog.SystemPartitionRel,
+		},
+		{
+			Key:   catalog.SystemRelAttr_CreateSQL,
+			Value: "",
+		},
+	}
+	partitionPropsDef := &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: partitionProps,
+			},
+		}}
+	return &TableDef{
+		Name: partitionTableName,
+		Cols: cols,
+		Defs: []*plan.TableDef_DefType{partitionPropsDef},
+	}
+}
+
 // buildPartitionByClause build partition by clause info and semantic check.
 // Currently, sub partition and partition value verification are not supported
 func buildPartitionByClause(ctx context.Context, partitionBinder *PartitionBinder, stmt *tree.CreateTable, tableDef *TableDef) (err error) {
@@ -571,6 +594,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	colMap := make(map[string]*ColDef)
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	fullTextIndexInfos := make([]*tree.FullTextIndex, 0)
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -599,6 +623,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if colType.GetId() == int32(types.T_json) {
 						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", def.Name.Parts[0]))
 					}
+					if colType.GetId() == int32(types.T_array_float32) {
+						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", def.Name.Parts[0]))
+					}
 					pks = append(pks, def.Name.Parts[0])
 				case *tree.AttributeComment:
 					comment = attribute.CMT.String()
@@ -662,6 +689,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				return nil, moerr.NewInvalidInput(ctx.GetContext(), "more than one primary key defined")
 			}
 			pksMap := map[string]bool{}
+			if err := checkColumnKeyParts(ctx.GetContext(), def.KeyParts, "primary key"); err != nil {
+				return nil, err
+			}
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0] // name of primary key column
 				if _, ok := pksMap[name]; ok {
@@ -673,12 +703,19 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			}
 		case *tree.Index:
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
+			if isIvfFlatIndex(def) || isExpressionIndex(def) {
+				// the columns are checked when the index table is built
+				continue
+			}
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0]
 				indexs = append(indexs, name)
 			}
 
 		case *tree.UniqueIndex:
+			if err := checkColumnKeyParts(ctx.GetContext(), def.KeyParts, "unique index"); err != nil {
+				return nil, err
+			}
 			uniqueIndexInfos = append(uniqueIndexInfos, def)
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0]
@@ -697,7 +734,12 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			createTable.FkCols = append(createTable.FkCols, fkData.Cols)
 			createTable.TableDef.Fkeys = append(createTable.TableDef.Fkeys, fkData.Def)
 
-		case *tree.CheckIndex, *tree.FullTextIndex:
+		case *tree.FullTextIndex:
+			if createTable.Temporary {
+				return nil, moerr.NewNYI(ctx.GetContext(), "fulltext index for temporary table")
+			}
+			fullTextIndexInfos = append(fullTextIndexInfos, def)
+		case *tree.CheckIndex:
 			// unsupport in plan. will support in next version.
 			return nil, moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
 		default:
@@ -831,6 +873,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		if colMap[str].Typ.Id == int32(types.T_json) {
 			return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", str))
 		}
+		if colMap[str].Typ.Id == int32(types.T_array_float32) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", str))
+		}
 	}
 
 	// we must lazy apply unique index, because later may add fake pk
@@ -842,7 +887,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 
 		// check Constraint Name (include index/ unique)
-		err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, ctx.GetContext())
+		err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, fullTextIndexInfos, ctx.GetContext())
 		if err != nil {
 			return err
 		}
@@ -855,17 +900,34 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			}
 		}
 		if len(secondaryIndexInfos) != 0 {
+			var ivfFlatIndexInfos, expressionIndexInfos []*tree.Index
+			secondaryIndexInfos, ivfFlatIndexInfos = splitIvfFlatIndexes(secondaryIndexInfos)
+			secondaryIndexInfos, expressionIndexInfos = splitExpressionIndexes(secondaryIndexInfos)
 			err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, ctx)
 			if err != nil {
 				return err
 			}
+			err = buildIvfFlatIndexTable(createTable, ivfFlatIndexInfos, colMap, pkeyName, ctx)
+			if err != nil {
+				return err
+			}
+			err = buildExpressionIndexTable(createTable, expressionIndexInfos, createTable.TableDef, colMap, pkeyName, ctx)
+			if err != nil {
+				return err
+			}
+		}
+		if len(fullTextIndexInfos) != 0 {
+			err = buildFullTextIndexTable(createTable, fullTextIndexInfos, colMap, pkeyName, ctx)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	}, nil
 }
 
 // Check whether the name of the constraint(index,unqiue etc) is legal, and handle constraints without a name
-func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraints []*tree.Index, ctx context.Context) error {
+func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraints []*tree.Index, fullTextConstraints []*tree.FullTextIndex, ctx context.Context) error {
 	constrNames := map[string]bool{}
 	// Check not empty constraint name whether is duplicated.
 	for _, constr := range indexConstraints {
@@ -880,6 +942,12 @@ func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraint
 			return err
 		}
 	}
+	for _, constr := range fullTextConstraints {
+		err := checkDuplicateConstraint(constrNames, constr.Name, false, ctx)
+		if err != nil {
+			return err
+		}
+	}
 	// set empty constraint names(index and unique index)
 	for _, constr := range indexConstraints {
 		setEmptyIndexName(constrNames, constr)
@@ -887,6 +955,9 @@ func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraint
 	for _, constr := range uniqueConstraints {
 		setEmptyUniqueIndexName(constrNames, constr)
 	}
+	for _, constr := range fullTextConstraints {
+		setEmptyFullTextIndexName(constrNames, constr)
+	}
 	return nil
 }
 
@@ -911,7 +982,7 @@ func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueI
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
 		var colName string
 		if colName == "" {
-			colName = indexConstr.KeyParts[0].ColName.Parts[0]
+			colName = keyPartIndexName(indexConstr.KeyParts[0])
 		}
 		constrName := colName
 		i := 2
@@ -934,7 +1005,7 @@ func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
 		var colName string
 		if colName == "" {
-			colName = indexConstr.KeyParts[0].ColName.Parts[0]
+			colName = keyPartIndexName(indexConstr.KeyParts[0])
 		}
 		constrName := colName
 		i := 2
@@ -952,6 +1023,46 @@ func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	}
 }
 
+// Set name for fulltext index constraint with an empty name
+func setEmptyFullTextIndexName(namesMap map[string]bool, indexConstr *tree.FullTextIndex) {
+	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
+		colName := keyPartIndexName(indexConstr.KeyParts[0])
+		constrName := colName
+		i := 2
+		if strings.EqualFold(constrName, "PRIMARY") {
+			constrName = fmt.Sprintf("%s_%d", constrName, 2)
+			i = 3
+		}
+		for namesMap[constrName] {
+			//  loop forever until we find constrName that haven't been used.
+			constrName = fmt.Sprintf("%s_%d", colName, i)
+			i++
+		}
+		indexConstr.Name = constrName
+		namesMap[constrName] = true
+	}
+}
+
+// keyPartIndexName returns the name of an index named after its first key
+// part, the index on an expression is named functional_index like MySQL.
+func keyPartIndexName(keyPart *tree.KeyPart) string {
+	if keyPart.ColName == nil {
+		return "functional_index"
+	}
+	return keyPart.ColName.Parts[0]
+}
+
+// checkColumnKeyParts returns an error if a key part is an expression, only
+// the secondary index can be on expressions.
+func checkColumnKeyParts(ctx context.Context, keyParts []*tree.KeyPart, kind string) error {
+	for _, keyPart := range keyParts {
+		if keyPart.ColName == nil {
+			return moerr.NewNotSupported(ctx, "%s on expression", kind)
+		}
+	}
+	return nil
+}
+
 func getRefAction(typ tree.ReferenceOptionType) plan.ForeignKeyDef_RefAction {
 	switch typ {
 	case tree.REFERENCE_OPTION_CASCADE:
@@ -971,6 +1082,9 @@ func getRefAction(typ tree.ReferenceOptionType) plan.ForeignKeyDef_RefAction {
 
 func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.UniqueIndex, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
 	for _, indexInfo := range indexInfos {
+		if err := checkColumnKeyParts(ctx.GetContext(), indexInfo.KeyParts, "unique index"); err != nil {
+			return err
+		}
 		indexDef := &plan.IndexDef{}
 		indexDef.Unique = true
 
@@ -998,6 +1112,9 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if colMap[name].Typ.Id == int32(types.T_array_float32) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1073,6 +1190,327 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 	return nil
 }
 
+// buildFullTextIndexTable builds the index table of the fulltext index, which
+// keeps a row of serial(word, pk), word, pk and count for every word of the
+// documents. The words are split by the parser given by WITH PARSER.
+func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.FullTextIndex, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "fulltext index on table without primary key")
+	}
+	for _, indexInfo := range indexInfos {
+		if err := checkColumnKeyParts(ctx.GetContext(), indexInfo.KeyParts, "fulltext index"); err != nil {
+			return err
+		}
+		indexDef := &plan.IndexDef{}
+		indexDef.IndexAlgo = catalog.IndexAlgoFullText
+		if indexInfo.IndexOption != nil {
+			indexDef.IndexAlgoParams = strings.ToLower(indexInfo.IndexOption.ParserName)
+			indexDef.Comment = indexInfo.IndexOption.Comment
+		}
+		if _, err := fulltext.NewTokenizer(indexDef.IndexAlgoParams); err != nil {
+			return moerr.NewNotSupported(ctx.GetContext(), "fulltext parser '%s'", indexDef.IndexAlgoParams)
+		}
+
+		indexTableName, err := util.BuildAlgoIndexTableName(ctx.GetContext(), catalog.IndexAlgoFullText)
+		if err != nil {
+			return err
+		}
+
+		indexParts := make([]string, 0)
+		for _, keyPart := range indexInfo.KeyParts {
+			name := keyPart.ColName.Parts[0]
+			if _, ok := colMap[name]; !ok {
+				return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+			}
+			switch types.T(colMap[name].Typ.Id) {
+			case types.T_char, types.T_varchar, types.T_text:
+			default:
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("column '%s' cannot be part of FULLTEXT index", name))
+			}
+			indexParts = append(indexParts, name)
+		}
+
+		tableDef := &TableDef{
+			Name: indexTableName,
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableIndexColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    int32(types.T_varchar),
+						Width: types.MaxVarcharLen,
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name: catalog.IndexTableWordColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    int32(types.T_varchar),
+						Width: types.MaxVarcharLen,
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name:    catalog.IndexTablePrimaryColName,
+					Alg:     plan.CompressType_Lz4,
+					Typ:     colMap[pkeyName].Typ,
+					Default: &plan.Default{},
+				},
+				{
+					Name: catalog.IndexTableCountColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id: int32(types.T_int64),
+					},
+					Default: &plan.Default{},
+				},
+			},
+			Pkey: &PrimaryKeyDef{
+				Names:       []string{catalog.IndexTableIndexColName},
+				PkeyColName: catalog.IndexTableIndexColName,
+			},
+		}
+
+		indexDef.IndexName = indexInfo.Name
+		indexDef.IndexTableName = indexTableName
+		indexDef.Parts = indexParts
+		indexDef.TableExist = true
+		createTable.IndexTables = append(createTable.IndexTables, tableDef)
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
+// isIvfFlatIndex returns whether the index is defined USING IVFFLAT, the type
+// is KeyType in CREATE TABLE and IType of the option in CREATE INDEX.
+func isIvfFlatIndex(indexInfo *tree.Index) bool {
+	if indexInfo.KeyType == tree.INDEX_TYPE_IVFFLAT {
+		return true
+	}
+	return indexInfo.IndexOption != nil && indexInfo.IndexOption.IType == tree.INDEX_TYPE_IVFFLAT
+}
+
+func splitIvfFlatIndexes(indexInfos []*tree.Index) (secondary, ivfFlat []*tree.Index) {
+	for _, indexInfo := range indexInfos {
+		if isIvfFlatIndex(indexInfo) {
+			ivfFlat = append(ivfFlat, indexInfo)
+		} else {
+			secondary = append(secondary, indexInfo)
+		}
+	}
+	return
+}
+
+// buildIvfFlatIndexTable builds the tables of the ivfflat index on a vector
+// column. The index table keeps a row of serial(list, pk), list and pk for
+// every vector, the list is -1 until the vector is assigned to its nearest
+// centroid when the index is built. The centroids table keeps the centroid of
+// every list. The number of lists is given by LISTS.
+func buildIvfFlatIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if len(indexInfos) != 0 && pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "ivfflat index on table without primary key")
+	}
+	for _, indexInfo := range indexInfos {
+		if len(indexInfo.KeyParts) != 1 {
+			return moerr.NewNotSupported(ctx.GetContext(), "ivfflat index on more than one column")
+		}
+		if err := checkColumnKeyParts(ctx.GetContext(), indexInfo.KeyParts, "ivfflat index"); err != nil {
+			return err
+		}
+		name := indexInfo.KeyParts[0].ColName.Parts[0]
+		col, ok := colMap[name]
+		if !ok {
+			return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+		}
+		if col.Typ.Id != int32(types.T_array_float32) {
+			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("column '%s' cannot be part of IVFFLAT index", name))
+		}
+
+		lists := vectorindex.DefaultLists
+		indexDef := &plan.IndexDef{}
+		if indexInfo.IndexOption != nil {
+			if n := indexInfo.IndexOption.Lists; n != 0 {
+				if n > vectorindex.MaxLists {
+					return moerr.NewInvalidInput(ctx.GetContext(), "lists %d out of range [1, %d]", n, vectorindex.MaxLists)
+				}
+				lists = int(n)
+			}
+			indexDef.Comment = indexInfo.IndexOption.Comment
+		}
+		indexDef.IndexAlgo = catalog.IndexAlgoIvfFlat
+		indexDef.IndexAlgoParams = strconv.Itoa(lists)
+
+		indexTableName, err := util.BuildAlgoIndexTableName(ctx.GetContext(), catalog.IndexAlgoIvfFlat)
+		if err != nil {
+			return err
+		}
+		tableDef := &TableDef{
+			Name: indexTableName,
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableIndexColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    int32(types.T_varchar),
+						Width: types.MaxVarcharLen,
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name: catalog.IndexTableListColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id: int32(types.T_int32),
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name:    catalog.IndexTablePrimaryColName,
+					Alg:     plan.CompressType_Lz4,
+					Typ:     colMap[pkeyName].Typ,
+					Default: &plan.Default{},
+				},
+			},
+			Pkey: &PrimaryKeyDef{
+				Names:       []string{catalog.IndexTableIndexColName},
+				PkeyColName: catalog.IndexTableIndexColName,
+			},
+		}
+		centroidsDef := &TableDef{
+			Name: util.IvfFlatCentroidsTableName(indexTableName),
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableListColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id: int32(types.T_int32),
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name:    catalog.IndexTableVectorColName,
+					Alg:     plan.CompressType_Lz4,
+					Typ:     col.Typ,
+					Default: &plan.Default{},
+				},
+			},
+			Pkey: &PrimaryKeyDef{
+				Names:       []string{catalog.IndexTableListColName},
+				PkeyColName: catalog.IndexTableListColName,
+			},
+		}
+
+		indexDef.IndexName = indexInfo.Name
+		indexDef.IndexTableName = indexTableName
+		indexDef.Parts = []string{name}
+		indexDef.TableExist = true
+		createTable.IndexTables = append(createTable.IndexTables, tableDef, centroidsDef)
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
+// isExpressionIndex returns whether the index is on an expression, like
+// INDEX ((cast(doc->>'$.id' as unsigned))).
+func isExpressionIndex(indexInfo *tree.Index) bool {
+	for _, keyPart := range indexInfo.KeyParts {
+		if keyPart.Expr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func splitExpressionIndexes(indexInfos []*tree.Index) (secondary, expression []*tree.Index) {
+	for _, indexInfo := range indexInfos {
+		if isExpressionIndex(indexInfo) {
+			expression = append(expression, indexInfo)
+		} else {
+			secondary = append(secondary, indexInfo)
+		}
+	}
+	return
+}
+
+// buildExpressionIndexTable builds the table of the index on an expression of
+// the columns. The index table keeps a row of serial(value, pk), value and pk
+// for every row whose value of the expression isn't null. The expression is
+// bound over the columns of tableDef and kept in the index def, the columns
+// it references are the parts of the index.
+func buildExpressionIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Index, tableDef *TableDef, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if len(indexInfos) != 0 && pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "expression index on table without primary key")
+	}
+	for _, indexInfo := range indexInfos {
+		if len(indexInfo.KeyParts) != 1 {
+			return moerr.NewNotSupported(ctx.GetContext(), "expression index on more than one key part")
+		}
+		astExpr := indexInfo.KeyParts[0].Expr
+		expr, parts, err := bindExpressionIndexKey(ctx, tableDef, astExpr)
+		if err != nil {
+			return err
+		}
+
+		indexDef := &plan.IndexDef{}
+		if indexInfo.IndexOption != nil {
+			indexDef.Comment = indexInfo.IndexOption.Comment
+		}
+		indexDef.IndexAlgo = catalog.IndexAlgoExpression
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		astExpr.Format(fmtCtx)
+		indexDef.IndexAlgoParams = fmtCtx.String()
+		indexDef.IndexExpr = expr
+
+		indexTableName, err := util.BuildAlgoIndexTableName(ctx.GetContext(), catalog.IndexAlgoExpression)
+		if err != nil {
+			return err
+		}
+		indexTableDef := &TableDef{
+			Name: indexTableName,
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableIndexColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    int32(types.T_varchar),
+						Width: types.MaxVarcharLen,
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name: catalog.IndexTableValueColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    expr.Typ.Id,
+						Width: expr.Typ.Width,
+						Scale: expr.Typ.Scale,
+					},
+					Default: &plan.Default{},
+				},
+				{
+					Name:    catalog.IndexTablePrimaryColName,
+					Alg:     plan.CompressType_Lz4,
+					Typ:     colMap[pkeyName].Typ,
+					Default: &plan.Default{},
+				},
+			},
+			Pkey: &PrimaryKeyDef{
+				Names:       []string{catalog.IndexTableIndexColName},
+				PkeyColName: catalog.IndexTableIndexColName,
+			},
+		}
+
+		indexDef.IndexName = indexInfo.Name
+		indexDef.IndexTableName = indexTableName
+		indexDef.Parts = parts
+		indexDef.TableExist = true
+		createTable.IndexTables = append(createTable.IndexTables, indexTableDef)
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
 func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, ctx CompilerContext) error {
 	nameCount := make(map[string]int)
 
@@ -1096,6 +1534,9 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if colMap[name].Typ.Id == int32(types.T_array_float32) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1167,9 +1608,7 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 		truncateTable.IndexTableNames = make([]string, 0)
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.TableExist {
-					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
-				}
+				truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, util.IndexTableNames(indexdef)...)
 			}
 		}
 	}
@@ -1252,9 +1691,7 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 		dropTable.IndexTableNames = make([]string, 0)
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.TableExist {
-					dropTable.IndexTableNames = append(dropTable.IndexTableNames, indexdef.IndexTableName)
-				}
+				dropTable.IndexTableNames = append(dropTable.IndexTableNames, util.IndexTableNames(indexdef)...)
 			}
 		}
 
@@ -1335,6 +1772,17 @@ func buildDropView(stmt *tree.DropView, ctx CompilerContext) (*Plan, error) {
 		if obj.PubAccountId != -1 {
 			return nil, moerr.NewInternalError(ctx.GetContext(), "cannot drop view in subscription database")
 		}
+		mvData, err := getMaterializedViewData(tableDef)
+		if err != nil {
+			return nil, err
+		}
+		if stmt.Materialized && mvData == nil {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "'%s.%s' is not a materialized view", dropTable.Database, dropTable.Table)
+		}
+		// drop the hidden table keeping the results of the materialized view
+		if mvData != nil {
+			dropTable.IndexTableNames = append(dropTable.IndexTableNames, mvData.Table)
+		}
 	}
 
 	return &Plan{
@@ -1446,6 +1894,7 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	// build index
 	var uIdx *tree.UniqueIndex
 	var sIdx *tree.Index
+	var ftIdx *tree.FullTextIndex
 	switch stmt.IndexCat {
 	case tree.INDEX_CATEGORY_UNIQUE:
 		uIdx = &tree.UniqueIndex{
@@ -1459,6 +1908,12 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 			KeyParts:    stmt.KeyParts,
 			IndexOption: stmt.IndexOption,
 		}
+	case tree.INDEX_CATEGORY_FULLTEXT:
+		ftIdx = &tree.FullTextIndex{
+			Name:        indexName,
+			KeyParts:    stmt.KeyParts,
+			IndexOption: stmt.IndexOption,
+		}
 	default:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
@@ -1484,12 +1939,28 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 		}
 		createIndex.TableExist = true
 	}
-	if sIdx != nil {
+	if sIdx != nil && isIvfFlatIndex(sIdx) {
+		if err := buildIvfFlatIndexTable(index, []*tree.Index{sIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
+	} else if sIdx != nil && isExpressionIndex(sIdx) {
+		if err := buildExpressionIndexTable(index, []*tree.Index{sIdx}, tableDef, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
+	} else if sIdx != nil {
 		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, ctx); err != nil {
 			return nil, err
 		}
 		createIndex.TableExist = false
 	}
+	if ftIdx != nil {
+		if err := buildFullTextIndexTable(index, []*tree.FullTextIndex{ftIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
+	}
 	createIndex.Index = index
 	createIndex.Table = tableName
 
@@ -1597,6 +2068,11 @@ func buildAlterView(stmt *tree.AlterView, ctx CompilerContext) (*Plan, error) {
 				alterView.Database,
 				viewName)
 		}
+		if mvData, err := getMaterializedViewData(oldViewDef); err != nil {
+			return nil, err
+		} else if mvData != nil {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter materialized view")
+		}
 	}
 
 	//step 2: generate new view def
@@ -1605,7 +2081,7 @@ func buildAlterView(stmt *tree.AlterView, ctx CompilerContext) (*Plan, error) {
 	defer func() {
 		ctx.SetBuildingAlterView(false, "", "")
 	}()
-	tableDef, err := genViewTableDef(ctx, stmt.AsSource)
+	tableDef, _, err := genViewTableDef(ctx, stmt.AsSource, "")
 	if err != nil {
 		return nil, err
 	}
@@ -1665,6 +2141,16 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 
 	alterTable.TableDef = tableDef
 
+	// the visible columns after applying the former actions, and the columns added or
+	// changed by the statement, which can't be altered again in the same statement.
+	curCols := make(map[string]*ColDef)
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			curCols[col.Name] = col
+		}
+	}
+	alteredCols := make(map[string]bool)
+
 	for i, option := range stmt.Options {
 		switch opt := option.(type) {
 		case *tree.AlterOptionDrop:
@@ -1675,11 +2161,16 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 			switch opt.Typ {
 			case tree.AlterTableDropColumn:
 				alterTableDrop.Typ = plan.AlterTableDrop_COLUMN
-				for _, col := range tableDef.Cols {
-					if col.Name == constraintName {
-						name_not_found = false
-						break
+				if col, ok := curCols[constraintName]; ok {
+					name_not_found = false
+					if err := checkAlterTableColumn(ctx, tableDef, constraintName, col, alteredCols, true); err != nil {
+						return nil, err
 					}
+					if len(curCols) == 1 {
+						return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't remove all columns with ALTER TABLE; use DROP TABLE instead")
+					}
+					delete(curCols, constraintName)
+					alteredCols[constraintName] = true
 				}
 			case tree.AlterTableDropIndex:
 				alterTableDrop.Typ = plan.AlterTableDrop_INDEX
@@ -1724,6 +2215,12 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 
 		case *tree.AlterOptionAdd:
 			switch def := opt.Def.(type) {
+			case *tree.ColumnTableDef:
+				action, err := buildAlterTableAddColumn(ctx, tableDef, def, nil, curCols, alteredCols)
+				if err != nil {
+					return nil, err
+				}
+				alterTable.Actions[i] = action
 			case *tree.ForeignKey:
 				fkData, err := getForeignKeyData(ctx, tableDef, def)
 				if err != nil {
@@ -1796,7 +2293,15 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 				oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
 
 				indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
-				if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, ctx); err != nil {
+				indexTableExist := isIvfFlatIndex(def) || isExpressionIndex(def)
+				if isIvfFlatIndex(def) {
+					err = buildIvfFlatIndexTable(indexInfo, []*tree.Index{def}, colMap, oriPriKeyName, ctx)
+				} else if isExpressionIndex(def) {
+					err = buildExpressionIndexTable(indexInfo, []*tree.Index{def}, tableDef, colMap, oriPriKeyName, ctx)
+				} else {
+					err = buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, ctx)
+				}
+				if err != nil {
 					return nil, err
 				}
 
@@ -1807,11 +2312,93 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 							TableName:             tableName,
 							OriginTablePrimaryKey: oriPriKeyName,
 							IndexInfo:             indexInfo,
-							IndexTableExist:       false,
+							IndexTableExist:       indexTableExist,
 						},
 					},
 				}
+			case *tree.FullTextIndex:
+				constrNames := map[string]bool{}
+				// Check not empty constraint name whether is duplicated.
+				for _, idx := range tableDef.Indexes {
+					nameLower := strings.ToLower(idx.IndexName)
+					constrNames[nameLower] = true
+				}
+
+				err := checkDuplicateConstraint(constrNames, def.Name, false, ctx.GetContext())
+				if err != nil {
+					return nil, err
+				}
+				if len(def.Name) == 0 {
+					setEmptyFullTextIndexName(constrNames, def)
+				}
+
+				oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
+				indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
+				if err = buildFullTextIndexTable(indexInfo, []*tree.FullTextIndex{def}, colMap, oriPriKeyName, ctx); err != nil {
+					return nil, err
+				}
+
+				alterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddIndex{
+						AddIndex: &plan.AlterTableAddIndex{
+							DbName:                databaseName,
+							TableName:             tableName,
+							OriginTablePrimaryKey: oriPriKeyName,
+							IndexInfo:             indexInfo,
+							IndexTableExist:       true,
+						},
+					},
+				}
+			}
 
+		case *tree.AlterOptionAddColumn:
+			action, err := buildAlterTableAddColumn(ctx, tableDef, opt.Column, opt.Position, curCols, alteredCols)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = action
+
+		case *tree.AlterOptionModifyColumn:
+			action, err := buildAlterTableModifyColumn(ctx, tableDef, opt, curCols, alteredCols)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = action
+
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := string(opt.OldName), string(opt.NewName)
+			col, ok := curCols[oldName]
+			if !ok {
+				return nil, moerr.NewBadFieldError(ctx.GetContext(), oldName, tableName)
+			}
+			if err := checkAlterTableColumn(ctx, tableDef, oldName, col, alteredCols, false); err != nil {
+				return nil, err
+			}
+			if err := checkAlterTableColumnName(ctx, newName, curCols); err != nil {
+				return nil, err
+			}
+			delete(curCols, oldName)
+			curCols[newName] = col
+			alteredCols[oldName] = true
+			alteredCols[newName] = true
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RenameColumn{
+					RenameColumn: &plan.AlterTableRenameColumn{
+						OldName: oldName,
+						NewName: newName,
+					},
+				},
+			}
+
+		case *tree.AlterOptionPartition:
+			alterPartition, err := buildAlterTablePartition(ctx, tableDef, opt)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterPartition{
+					AlterPartition: alterPartition,
+				},
 			}
 
 		case *tree.AlterOptionAlterIndex:
@@ -1868,6 +2455,234 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}, nil
 }
 
+func buildAlterTableAddColumn(ctx CompilerContext, tableDef *TableDef, def *tree.ColumnTableDef, pos *tree.ColumnPosition,
+	curCols map[string]*ColDef, alteredCols map[string]bool) (*plan.AlterTable_Action, error) {
+	if tableDef.Partition != nil {
+		return nil, moerr.NewNYI(ctx.GetContext(), "add column to partitioned table")
+	}
+	col, err := buildAlterTableColumnDef(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkAlterTableColumnName(ctx, col.Name, curCols); err != nil {
+		return nil, err
+	}
+	if col.Typ.AutoIncr {
+		return nil, moerr.NewNYI(ctx.GetContext(), "add auto_increment column")
+	}
+	addColumn := &plan.AlterTableAddColumn{
+		Column: col,
+	}
+	if pos != nil && pos.Typ != tree.ColumnPositionNone {
+		// the column ids used by the foreign keys are changed if the column is not appended
+		if len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0 {
+			return nil, moerr.NewNYI(ctx.GetContext(), "add column with position to table with foreign keys")
+		}
+		if pos.Typ == tree.ColumnPositionFirst {
+			addColumn.First = true
+		} else {
+			after := string(pos.RelativeColumn)
+			if _, ok := curCols[after]; !ok {
+				return nil, moerr.NewBadFieldError(ctx.GetContext(), after, tableDef.Name)
+			}
+			if alteredCols[after] {
+				return nil, moerr.NewNYI(ctx.GetContext(), "alter column '%s' more than once in one statement", after)
+			}
+			addColumn.AfterColumn = after
+		}
+	}
+	curCols[col.Name] = col
+	alteredCols[col.Name] = true
+	return &plan.AlterTable_Action{
+		Action: &plan.AlterTable_Action_AddColumn{
+			AddColumn: addColumn,
+		},
+	}, nil
+}
+
+func buildAlterTableModifyColumn(ctx CompilerContext, tableDef *TableDef, opt *tree.AlterOptionModifyColumn,
+	curCols map[string]*ColDef, alteredCols map[string]bool) (*plan.AlterTable_Action, error) {
+	if opt.Position != nil && opt.Position.Typ != tree.ColumnPositionNone {
+		return nil, moerr.NewNYI(ctx.GetContext(), "change the position of column")
+	}
+	col, err := buildAlterTableColumnDef(ctx, opt.Column)
+	if err != nil {
+		return nil, err
+	}
+	oldName := string(opt.OldName)
+	if len(oldName) == 0 {
+		oldName = col.Name
+	}
+	oldCol, ok := curCols[oldName]
+	if !ok {
+		return nil, moerr.NewBadFieldError(ctx.GetContext(), oldName, tableDef.Name)
+	}
+	if oldName != col.Name {
+		if err = checkAlterTableColumn(ctx, tableDef, oldName, oldCol, alteredCols, false); err != nil {
+			return nil, err
+		}
+		if err = checkAlterTableColumnName(ctx, col.Name, curCols); err != nil {
+			return nil, err
+		}
+	} else if alteredCols[oldName] {
+		return nil, moerr.NewNYI(ctx.GetContext(), "alter column '%s' more than once in one statement", oldName)
+	}
+
+	oldTyp, newTyp := makeTypeByPlan2Type(oldCol.Typ), makeTypeByPlan2Type(col.Typ)
+	if !oldTyp.Eq(newTyp) {
+		if err = checkAlterTableColumn(ctx, tableDef, oldName, oldCol, alteredCols, false); err != nil {
+			return nil, err
+		}
+		// the stored data of the column is kept as it is and read as the new type
+		if !types.IsWidening(oldTyp, newTyp) {
+			return nil, moerr.NewNYI(ctx.GetContext(), "change the type of column '%s' from %s to %s", oldName, oldTyp.DescString(), newTyp.DescString())
+		}
+	}
+	if oldCol.Typ.AutoIncr != col.Typ.AutoIncr {
+		return nil, moerr.NewNYI(ctx.GetContext(), "change the auto_increment attribute of column '%s'", oldName)
+	}
+	isPk := oldCol.Primary
+	if tableDef.Pkey != nil {
+		for _, pk := range tableDef.Pkey.Names {
+			isPk = isPk || pk == oldName
+		}
+	}
+	if isPk {
+		// the primary key column is always not null
+		col.Default.NullAbility = false
+	}
+	col.Primary = oldCol.Primary
+	col.ClusterBy = oldCol.ClusterBy
+
+	delete(curCols, oldName)
+	curCols[col.Name] = col
+	alteredCols[oldName] = true
+	alteredCols[col.Name] = true
+	return &plan.AlterTable_Action{
+		Action: &plan.AlterTable_Action_ModifyColumn{
+			ModifyColumn: &plan.AlterTableModifyColumn{
+				OldName: oldName,
+				Column:  col,
+			},
+		},
+	}, nil
+}
+
+// buildAlterTableColumnDef builds the column definition of ADD/MODIFY/CHANGE COLUMN,
+// the column can't be declared as a key here.
+func buildAlterTableColumnDef(ctx CompilerContext, def *tree.ColumnTableDef) (*ColDef, error) {
+	name := def.Name.Parts[0]
+	colType, err := getTypeFromAst(ctx.GetContext(), def.Type)
+	if err != nil {
+		return nil, err
+	}
+	if colType.Id == int32(types.T_char) || colType.Id == int32(types.T_varchar) ||
+		colType.Id == int32(types.T_binary) || colType.Id == int32(types.T_varbinary) {
+		if colType.GetWidth() > types.MaxStringSize {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
+		}
+	}
+	var comment string
+	for _, attr := range def.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributePrimaryKey, *tree.AttributeKey, *tree.AttributeUnique, *tree.AttributeUniqueKey:
+			return nil, moerr.NewNYI(ctx.GetContext(), "declare column '%s' as a key in alter table", name)
+		case *tree.AttributeAutoIncrement:
+			colType.AutoIncr = true
+		case *tree.AttributeComment:
+			comment = attribute.CMT.String()
+			if getNumOfCharacters(comment) > maxLengthOfColumnComment {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "comment for column '%s' is too long", name)
+			}
+		}
+	}
+
+	defaultValue, err := buildDefaultExpr(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	onUpdateExpr, err := buildOnUpdate(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	if !checkTableColumnNameValid(name) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", name)
+	}
+	return &ColDef{
+		Name:     name,
+		Alg:      plan.CompressType_Lz4,
+		Typ:      colType,
+		Default:  defaultValue,
+		OnUpdate: onUpdateExpr,
+		Comment:  comment,
+	}, nil
+}
+
+func checkAlterTableColumnName(ctx CompilerContext, name string, curCols map[string]*ColDef) error {
+	if !checkTableColumnNameValid(name) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", name)
+	}
+	if _, ok := curCols[name]; ok {
+		return moerr.NewInvalidInput(ctx.GetContext(), "duplicate column name '%s'", name)
+	}
+	return nil
+}
+
+// checkAlterTableColumn returns an error if the column is used by the primary key,
+// cluster by, indexes, foreign keys or partition of the table, which can't be dropped,
+// renamed or retyped now. The ids of the columns are changed by drop column, so it's
+// also checked whether the table has foreign keys.
+func checkAlterTableColumn(ctx CompilerContext, tableDef *TableDef, name string, col *ColDef, alteredCols map[string]bool, drop bool) error {
+	if alteredCols[name] {
+		return moerr.NewNYI(ctx.GetContext(), "alter column '%s' more than once in one statement", name)
+	}
+	if col.Primary || col.Typ.AutoIncr {
+		return moerr.NewNYI(ctx.GetContext(), "alter primary key or auto_increment column '%s'", name)
+	}
+	if tableDef.Pkey != nil {
+		for _, pk := range tableDef.Pkey.Names {
+			if pk == col.Name {
+				return moerr.NewNYI(ctx.GetContext(), "alter primary key column '%s'", name)
+			}
+		}
+	}
+	if tableDef.ClusterBy != nil {
+		parts := []string{tableDef.ClusterBy.Name}
+		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			parts = util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name)
+		}
+		for _, part := range parts {
+			if part == col.Name {
+				return moerr.NewNYI(ctx.GetContext(), "alter cluster by column '%s'", name)
+			}
+		}
+	}
+	for _, index := range tableDef.Indexes {
+		for _, part := range index.Parts {
+			if part == col.Name {
+				return moerr.NewNYI(ctx.GetContext(), "alter column '%s' used by index '%s'", name, index.IndexName)
+			}
+		}
+	}
+	if drop && (len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0) {
+		return moerr.NewNYI(ctx.GetContext(), "drop column of table with foreign keys")
+	}
+	for _, fk := range tableDef.Fkeys {
+		for _, colId := range fk.Cols {
+			if colId == col.ColId {
+				return moerr.NewNYI(ctx.GetContext(), "alter column '%s' used by foreign key '%s'", name, fk.Name)
+			}
+		}
+	}
+	if len(tableDef.RefChildTbls) > 0 {
+		return moerr.NewNYI(ctx.GetContext(), "alter column of table referenced by foreign keys")
+	}
+	if tableDef.Partition != nil {
+		return moerr.NewNYI(ctx.GetContext(), "alter column of partitioned table")
+	}
+	return nil
+}
+
 func buildLockTables(stmt *tree.LockTableStmt, ctx CompilerContext) (*Plan, error) {
 	lockTables := make([]*plan.TableLockInfo, 0, len(stmt.TableLocks))
 	uniqueTableName := make(map[string]bool)
@@ -45,8 +45,8 @@ func TestBuildAlterView(t *testing.T) {
 	store := make(map[string]arg)
 
 	vData, err := json.Marshal(ViewData{
-		"create view v as select a from a",
-		"db",
+		Stmt:            "create view v as select a from a",
+		DefaultDatabase: "db",
 	})
 	assert.NoError(t, err)
 
@@ -59,8 +59,8 @@ func TestBuildAlterView(t *testing.T) {
 	}
 
 	vxData, err := json.Marshal(ViewData{
-		"create view vx as select a from v",
-		"db",
+		Stmt:            "create view vx as select a from v",
+		DefaultDatabase: "db",
 	})
 	assert.NoError(t, err)
 	store["db.vx"] = arg{&plan.ObjectRef{},
@@ -380,6 +380,35 @@ func TestBuildAlterTable(t *testing.T) {
 		"ALTER TABLE emp ADD INDEX idx1 (ename, sal);",
 		//"alter table emp drop foreign key fk1",
 		//"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
+		"alter table tpch.nation add column c int default 1 first",
+		"alter table tpch.nation add c varchar(10) after n_name, add column d int",
+		"alter table tpch.nation modify n_comment varchar(200) comment 'widen'",
+		"alter table tpch.nation change n_comment c varchar(152)",
+		"alter table tpch.nation rename column n_comment to c, drop column n_regionkey",
+		"alter table tpch.nation modify n_regionkey bigint",
+		"alter table emp modify sal decimal(10)",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqlerrs := []string{
+		"alter table tpch.nation add column n_name int",
+		"alter table tpch.nation add column c int after xyz",
+		"alter table tpch.nation add column c int auto_increment",
+		"alter table tpch.nation add column c int primary key",
+		"alter table tpch.nation modify xyz int",
+		"alter table tpch.nation modify n_comment varchar(100)",
+		"alter table tpch.nation modify n_comment int",
+		"alter table tpch.nation modify n_regionkey smallint",
+		"alter table tpch.nation modify n_comment varchar(200) first",
+		"alter table tpch.nation modify n_nationkey bigint",
+		"alter table tpch.nation rename column n_comment to n_name",
+		"alter table tpch.nation rename column n_nationkey to k",
+		"alter table tpch.nation rename column n_comment to c, drop column c",
+		"alter table tpch.nation add column c int, modify c bigint",
+		"alter table emp drop column sal",
+		"alter table emp rename column ename to name",
+		"alter table emp add column c int first",
+		"alter table products rename column pid to id",
+	}
+	runTestShouldError(mock, t, sqlerrs)
 }
@@ -37,8 +37,20 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		tblInfo: tblInfo,
 	}
 
+	// the rows are read as a derived table with all the columns for triggers
+	triggerCtxs := make([]*plan.TriggerCtx, len(tblInfo.tableDefs))
+	triggerColPos := make([][]int, len(tblInfo.tableDefs))
+	haveTrigger := false
+	for i, tableDef := range tblInfo.tableDefs {
+		triggerCtxs[i], triggerColPos[i], err = buildTriggerCtx(ctx, tblInfo.objRef[i], tableDef, "delete")
+		if err != nil {
+			return nil, err
+		}
+		haveTrigger = haveTrigger || triggerCtxs[i] != nil
+	}
+
 	canTruncate := false
-	if tblInfo.haveConstraint {
+	if tblInfo.haveConstraint || haveTrigger {
 		bindCtx.groupTag = builder.genNewTag()
 		bindCtx.aggregateTag = builder.genNewTag()
 		bindCtx.projectTag = builder.genNewTag()
@@ -56,6 +68,28 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 			}
 		}
 
+		// the OLD values are appended to the projection
+		derivedTag := builder.qry.Nodes[rewriteInfo.derivedTableId].BindingTags[0]
+		for i, triggerCtx := range triggerCtxs {
+			if triggerCtx == nil {
+				continue
+			}
+			for _, pos := range triggerColPos[i] {
+				col := tblInfo.tableDefs[i].Cols[pos]
+				triggerCtx.OldIdx = append(triggerCtx.OldIdx, int32(len(rewriteInfo.projectList)))
+				rewriteInfo.projectList = append(rewriteInfo.projectList, &plan.Expr{
+					Typ: col.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: derivedTag,
+							ColPos: int32(tblInfo.oldColPosMap[i][col.Name]),
+						},
+					},
+				})
+			}
+			triggerCtx.NewIdx = noTriggerIdx(len(triggerColPos[i]))
+		}
+
 		// append ProjectNode
 		rewriteInfo.rootId = builder.appendNode(&plan.Node{
 			NodeType:    plan.Node_PROJECT,
@@ -90,6 +124,9 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		IdxRef: rewriteInfo.onIdxTbl,
 		IdxIdx: rewriteInfo.onIdx,
 
+		PkIdxRef: rewriteInfo.onPkIdxTbl,
+		PkIdxIdx: rewriteInfo.onPkIdx,
+
 		OnRestrictRef: rewriteInfo.onRestrictTbl,
 		OnRestrictIdx: rewriteInfo.onRestrict,
 		OnCascadeRef:  rewriteInfo.onCascadeRef,
@@ -137,6 +174,11 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		NodeId:    int32(len(query.Nodes)),
 		DeleteCtx: deleteCtx,
 	}
+	for _, triggerCtx := range triggerCtxs {
+		if triggerCtx != nil {
+			node.TriggerCtxs = append(node.TriggerCtxs, triggerCtx)
+		}
+	}
 	query.Nodes = append(query.Nodes, node)
 	query.Steps[len(query.Steps)-1] = node.NodeId
 	query.StmtType = plan.Query_DELETE
@@ -0,0 +1,331 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+const (
+	exprIndexAliasPrefix = "__mo_expr_index_"
+	exprIndexDocColName  = "__mo_expr_index_doc"
+)
+
+// bindExpressionIndexKey binds the key of an expression index over the
+// columns of tableDef. The column references of the bound expression keep
+// the names of the columns, which are returned as the parts of the index, so
+// the expression can be evaluated on any batch of the table's columns.
+func bindExpressionIndexKey(ctx CompilerContext, tableDef *TableDef, astExpr tree.Expr) (*plan.Expr, []string, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    DeepCopyTableDef(tableDef),
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, nil, err
+	}
+	expr, err := NewWhereBinder(builder, bindContext).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := expr.Expr.(*plan.Expr_Col); ok {
+		return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "expression index on a column, use a regular index instead")
+	}
+	switch types.T(expr.Typ.Id) {
+	case types.T_blob, types.T_text, types.T_json, types.T_array_float32:
+		return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "expression index of type %s", types.T(expr.Typ.Id).String())
+	}
+
+	var parts []string
+	if err = nameExpressionIndexColumns(ctx, expr, tableDef, &parts); err != nil {
+		return nil, nil, err
+	}
+	return expr, parts, nil
+}
+
+// nameExpressionIndexColumns sets the names of the columns referenced by expr
+// and appends them to parts. Only the columns and deterministic scalar
+// functions of them are allowed.
+func nameExpressionIndexColumns(ctx CompilerContext, expr *plan.Expr, tableDef *TableDef, parts *[]string) error {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		name := tableDef.Cols[e.Col.ColPos].Name
+		e.Col.RelPos = 0
+		e.Col.Name = name
+		for _, part := range *parts {
+			if part == name {
+				return nil
+			}
+		}
+		*parts = append(*parts, name)
+	case *plan.Expr_F:
+		switch strings.ToLower(e.F.Func.ObjName) {
+		case "rand", "uuid", "now", "current_timestamp", "sysdate", "utc_timestamp", "current_date", "curdate", "unix_timestamp":
+			return moerr.NewNotSupported(ctx.GetContext(), "non-deterministic function %s in expression index", e.F.Func.ObjName)
+		}
+		for _, arg := range e.F.Args {
+			if err := nameExpressionIndexColumns(ctx, arg, tableDef, parts); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_C, *plan.Expr_T:
+	default:
+		return moerr.NewNotSupported(ctx.GetContext(), "expression index on %s", expr.String())
+	}
+	return nil
+}
+
+// exprIndexFilter is the filter of a conjunct in WHERE which can be evaluated
+// on the values kept by an expression index.
+type exprIndexFilter struct {
+	indexDef *plan.IndexDef
+	cond     tree.Expr
+}
+
+// buildExpressionIndexFrom rewrites the FROM clause of a select on a single
+// table whose WHERE compares an indexed expression with constants
+//
+//	select ... from t where cast(doc->>'$.id' as unsigned) = 10
+//
+// The rows are found by filtering the values in the index table, the index
+// table is sorted by serial(value, pk), so the values are clustered:
+//
+//	from t join (select pk from <index table> where value = 10) as __mo_expr_index_0
+//	on t.pk = __mo_expr_index_0.__mo_expr_index_doc
+//
+// The WHERE clause is not modified, so the rows are still filtered by it. If
+// no filter can use an expression index, from is returned unchanged.
+func (builder *QueryBuilder) buildExpressionIndexFrom(from tree.TableExprs, clause *tree.SelectClause, ctx *BindContext) (tree.TableExprs, []string, error) {
+	if len(from) != 1 || clause.Where == nil {
+		return from, nil, nil
+	}
+	tableExpr := from[0]
+	if join, ok := tableExpr.(*tree.JoinTableExpr); ok && join.Right == nil {
+		tableExpr = join.Left
+	}
+	if _, ok := tableExpr.(*tree.AliasedTableExpr); !ok {
+		return from, nil, nil
+	}
+	tables := builder.collectIndexedTables(tableExpr, ctx, nil)
+	if len(tables) != 1 {
+		return from, nil, nil
+	}
+	table := tables[0]
+
+	var indexDefs []*plan.IndexDef
+	var indexExprs []tree.Expr
+	for _, def := range table.tableDef.Indexes {
+		if !util.IsExpressionIndex(def) {
+			continue
+		}
+		stmts, err := mysql.Parse(builder.GetContext(), "select "+def.IndexAlgoParams, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		indexDefs = append(indexDefs, def)
+		indexExprs = append(indexExprs, stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr)
+	}
+	if len(indexDefs) == 0 {
+		return from, nil, nil
+	}
+
+	// the filters on the first index matched are pushed into its scan
+	var filters []exprIndexFilter
+	for _, conjunct := range splitAstConjunction(clause.Where.Expr) {
+		for i, indexExpr := range indexExprs {
+			cond, ok := rewriteExprIndexFilter(conjunct, indexExpr, table.alias)
+			if !ok || (len(filters) > 0 && filters[0].indexDef != indexDefs[i]) {
+				continue
+			}
+			filters = append(filters, exprIndexFilter{indexDef: indexDefs[i], cond: cond})
+			break
+		}
+	}
+	if len(filters) == 0 {
+		return from, nil, nil
+	}
+
+	cond := filters[0].cond
+	for _, filter := range filters[1:] {
+		cond = tree.NewAndExpr(cond, filter.cond)
+	}
+	alias := exprIndexAliasPrefix + "0"
+	indexTable := tree.NewTableName(tree.Identifier(filters[0].indexDef.IndexTableName), tree.ObjectNamePrefix{
+		SchemaName:     tree.Identifier(table.schema),
+		ExplicitSchema: true,
+	})
+	derived := &tree.AliasedTableExpr{
+		Expr: &tree.ParenTableExpr{
+			Expr: &tree.Select{
+				Select: &tree.SelectClause{
+					Exprs: tree.SelectExprs{{
+						Expr: tree.SetUnresolvedName(catalog.IndexTablePrimaryColName),
+						As:   tree.NewCStr(exprIndexDocColName, 1),
+					}},
+					From:  &tree.From{Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: indexTable}}},
+					Where: tree.NewWhere(cond),
+				},
+			},
+		},
+		As: tree.AliasClause{Alias: tree.Identifier(alias)},
+	}
+
+	var docExpr tree.Expr
+	pkey := table.tableDef.Pkey
+	if len(pkey.Names) > 1 {
+		args := make(tree.Exprs, len(pkey.Names))
+		for i, name := range pkey.Names {
+			args[i] = tree.SetUnresolvedName(table.alias, name)
+		}
+		docExpr = &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("serial")),
+			Exprs: args,
+		}
+	} else {
+		docExpr = tree.SetUnresolvedName(table.alias, pkey.PkeyColName)
+	}
+	return tree.TableExprs{&tree.JoinTableExpr{
+		JoinType: tree.JOIN_TYPE_INNER,
+		Left:     from[0],
+		Right:    derived,
+		Cond: &tree.OnJoinCond{
+			Expr: tree.NewComparisonExpr(tree.EQUAL, docExpr, tree.SetUnresolvedName(alias, exprIndexDocColName)),
+		},
+	}}, []string{alias}, nil
+}
+
+// rewriteExprIndexFilter returns the filter on the value column of the index
+// table if conjunct compares indexExpr with constants.
+func rewriteExprIndexFilter(conjunct tree.Expr, indexExpr tree.Expr, alias string) (tree.Expr, bool) {
+	value := tree.SetUnresolvedName(catalog.IndexTableValueColName)
+	switch exprImpl := conjunct.(type) {
+	case *tree.ComparisonExpr:
+		if exprImpl.SubOp != 0 || exprImpl.Escape != nil {
+			return nil, false
+		}
+		switch exprImpl.Op {
+		case tree.EQUAL, tree.LESS_THAN, tree.LESS_THAN_EQUAL, tree.GREAT_THAN, tree.GREAT_THAN_EQUAL:
+			if sameIndexExpr(exprImpl.Left, indexExpr, alias) && isExprIndexConstant(exprImpl.Right) {
+				return tree.NewComparisonExpr(exprImpl.Op, value, exprImpl.Right), true
+			}
+			if sameIndexExpr(exprImpl.Right, indexExpr, alias) && isExprIndexConstant(exprImpl.Left) {
+				return tree.NewComparisonExpr(exprImpl.Op, exprImpl.Left, value), true
+			}
+		case tree.IN:
+			tuple, ok := exprImpl.Right.(*tree.Tuple)
+			if !ok || !sameIndexExpr(exprImpl.Left, indexExpr, alias) {
+				return nil, false
+			}
+			for _, e := range tuple.Exprs {
+				if !isExprIndexConstant(e) {
+					return nil, false
+				}
+			}
+			return tree.NewComparisonExpr(tree.IN, value, exprImpl.Right), true
+		}
+	case *tree.RangeCond:
+		if !exprImpl.Not && sameIndexExpr(exprImpl.Left, indexExpr, alias) &&
+			isExprIndexConstant(exprImpl.From) && isExprIndexConstant(exprImpl.To) {
+			return tree.NewRangeCond(false, value, exprImpl.From, exprImpl.To), true
+		}
+	}
+	return nil, false
+}
+
+func isExprIndexConstant(astExpr tree.Expr) bool {
+	switch exprImpl := astExpr.(type) {
+	case *tree.NumVal, *tree.ParamExpr:
+		return true
+	case *tree.UnaryExpr:
+		return isExprIndexConstant(exprImpl.Expr)
+	case *tree.ParenExpr:
+		return isExprIndexConstant(exprImpl.Expr)
+	}
+	return false
+}
+
+// sameIndexExpr returns whether astExpr is the expression of an index, the
+// columns in astExpr may be qualified by the alias of the table.
+func sameIndexExpr(astExpr, indexExpr tree.Expr, alias string) bool {
+	if p, ok := astExpr.(*tree.ParenExpr); ok {
+		return sameIndexExpr(p.Expr, indexExpr, alias)
+	}
+	if p, ok := indexExpr.(*tree.ParenExpr); ok {
+		return sameIndexExpr(astExpr, p.Expr, alias)
+	}
+
+	switch exprImpl := astExpr.(type) {
+	case *tree.UnresolvedName:
+		other, ok := indexExpr.(*tree.UnresolvedName)
+		if !ok || exprImpl.Star || exprImpl.NumParts > 2 || other.NumParts != 1 {
+			return false
+		}
+		if exprImpl.NumParts == 2 && exprImpl.Parts[1] != alias {
+			return false
+		}
+		return strings.EqualFold(exprImpl.Parts[0], other.Parts[0])
+	case *tree.NumVal:
+		other, ok := indexExpr.(*tree.NumVal)
+		return ok && exprImpl.ValType == other.ValType && exprImpl.String() == other.String()
+	case *tree.FuncExpr:
+		other, ok := indexExpr.(*tree.FuncExpr)
+		if !ok || exprImpl.Type != other.Type || exprImpl.WindowSpec != nil || len(exprImpl.Exprs) != len(other.Exprs) {
+			return false
+		}
+		name, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName)
+		otherName, ok2 := other.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok || !ok2 || !strings.EqualFold(tree.String(name, dialect.MYSQL), tree.String(otherName, dialect.MYSQL)) {
+			return false
+		}
+		for i := range exprImpl.Exprs {
+			if !sameIndexExpr(exprImpl.Exprs[i], other.Exprs[i], alias) {
+				return false
+			}
+		}
+		return true
+	case *tree.CastExpr:
+		other, ok := indexExpr.(*tree.CastExpr)
+		return ok && castTypeString(exprImpl) == castTypeString(other) && sameIndexExpr(exprImpl.Expr, other.Expr, alias)
+	case *tree.BinaryExpr:
+		other, ok := indexExpr.(*tree.BinaryExpr)
+		return ok && exprImpl.Op == other.Op && sameIndexExpr(exprImpl.Left, other.Left, alias) && sameIndexExpr(exprImpl.Right, other.Right, alias)
+	case *tree.UnaryExpr:
+		other, ok := indexExpr.(*tree.UnaryExpr)
+		return ok && exprImpl.Op == other.Op && sameIndexExpr(exprImpl.Expr, other.Expr, alias)
+	}
+	return false
+}
+
+func castTypeString(expr *tree.CastExpr) string {
+	typ, ok := expr.Type.(*tree.T)
+	if !ok {
+		return fmt.Sprintf("%v", expr.Type)
+	}
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL)
+	typ.InternalType.Format(fmtCtx)
+	return fmtCtx.String()
+}
@@ -0,0 +1,309 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+const (
+	fullTextAliasPrefix  = "__mo_fulltext_"
+	fullTextDocColName   = "__mo_fulltext_doc"
+	fullTextScoreColName = "__mo_fulltext_score"
+)
+
+// buildFullTextFrom rewrites the FROM clause of a select which has MATCH ...
+// AGAINST. Each distinct MATCH is evaluated by scanning the hidden table of
+// its fulltext index, the relevance of the documents is computed there and
+// left joined with the FROM clause on the primary key:
+//
+//	from <tables> left join (<scan of the index table>) as __mo_fulltext_0
+//	on t.pk = __mo_fulltext_0.__mo_fulltext_doc
+//
+// and MATCH is bound to the score column of the joined table. The clause is
+// not modified, the rewritten table expressions are returned.
+func (builder *QueryBuilder) buildFullTextFrom(clause *tree.SelectClause, astOrderBy tree.OrderBy, ctx *BindContext) (tree.TableExprs, []string, error) {
+	var matches []*tree.FullTextMatchExpr
+	for _, selectExpr := range clause.Exprs {
+		matches = collectFullTextMatch(selectExpr.Expr, matches)
+	}
+	if clause.Where != nil {
+		matches = collectFullTextMatch(clause.Where.Expr, matches)
+	}
+	if clause.Having != nil {
+		matches = collectFullTextMatch(clause.Having.Expr, matches)
+	}
+	for _, order := range astOrderBy {
+		matches = collectFullTextMatch(order.Expr, matches)
+	}
+	if len(matches) == 0 {
+		return clause.From.Tables, nil, nil
+	}
+
+	tables := builder.collectIndexedTables(clause.From.Tables[0], ctx, nil)
+	from := clause.From.Tables[0]
+	var aliases []string
+	for _, match := range matches {
+		key := tree.String(match, dialect.MYSQL)
+		if _, ok := ctx.fullTextMatches[key]; ok {
+			continue
+		}
+
+		table, indexDef, err := builder.findFullTextIndex(match, tables)
+		if err != nil {
+			return nil, nil, err
+		}
+		alias := fmt.Sprintf("%s%d", fullTextAliasPrefix, len(aliases))
+		derived, err := builder.buildFullTextScan(match, table, indexDef, alias)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var docExpr tree.Expr
+		pkey := table.tableDef.Pkey
+		if len(pkey.Names) > 1 {
+			args := make(tree.Exprs, len(pkey.Names))
+			for i, name := range pkey.Names {
+				args[i] = tree.SetUnresolvedName(table.alias, name)
+			}
+			docExpr = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("serial")),
+				Exprs: args,
+			}
+		} else {
+			docExpr = tree.SetUnresolvedName(table.alias, pkey.PkeyColName)
+		}
+		from = &tree.JoinTableExpr{
+			JoinType: tree.JOIN_TYPE_LEFT,
+			Left:     from,
+			Right:    derived,
+			Cond: &tree.OnJoinCond{
+				Expr: tree.NewComparisonExpr(tree.EQUAL, docExpr, tree.SetUnresolvedName(alias, fullTextDocColName)),
+			},
+		}
+
+		if ctx.fullTextMatches == nil {
+			ctx.fullTextMatches = make(map[string]string)
+		}
+		ctx.fullTextMatches[key] = alias
+		aliases = append(aliases, alias)
+	}
+	return tree.TableExprs{from}, aliases, nil
+}
+
+// findFullTextIndex returns the table and its fulltext index whose columns
+// are the columns of MATCH.
+func (builder *QueryBuilder) findFullTextIndex(match *tree.FullTextMatchExpr, tables []*indexedTable) (*indexedTable, *plan.IndexDef, error) {
+	for _, table := range tables {
+		cols := make(map[string]struct{}, len(match.KeyParts))
+		for _, key := range match.KeyParts {
+			if key.NumParts > 1 && key.Parts[1] != table.alias {
+				break
+			}
+			cols[key.Parts[0]] = struct{}{}
+		}
+		if len(cols) != len(match.KeyParts) {
+			continue
+		}
+
+		for _, indexDef := range table.tableDef.Indexes {
+			if !util.IsFullTextIndex(indexDef) || len(indexDef.Parts) != len(cols) {
+				continue
+			}
+			found := true
+			for _, part := range indexDef.Parts {
+				if _, ok := cols[part]; !ok {
+					found = false
+					break
+				}
+			}
+			if found {
+				return table, indexDef, nil
+			}
+		}
+	}
+	return nil, nil, moerr.NewInvalidInput(builder.GetContext(), "can't find FULLTEXT index matching the column list")
+}
+
+// buildFullTextScan returns the derived table computing the BM25 relevance of
+// the documents matched. Each row of the index table is the count of a word
+// in a document, and the row of the empty word is the length of the document.
+func (builder *QueryBuilder) buildFullTextScan(match *tree.FullTextMatchExpr, table *indexedTable, indexDef *plan.IndexDef, alias string) (tree.TableExpr, error) {
+	pattern, ok := match.Pattern.(*tree.NumVal)
+	if !ok || pattern.ValType != tree.P_char {
+		return nil, moerr.NewNYI(builder.GetContext(), "non-constant search string of MATCH AGAINST")
+	}
+
+	mode := fulltext.NaturalLanguageMode
+	switch match.Mode {
+	case tree.FULLTEXT_BOOLEAN:
+		mode = fulltext.BooleanMode
+	case tree.FULLTEXT_NL_QUERY_EXPANSION, tree.FULLTEXT_QUERY_EXPANSION:
+		return nil, moerr.NewNYI(builder.GetContext(), "MATCH AGAINST %s", match.Mode.ToString())
+	}
+	tokenizer, err := fulltext.NewTokenizer(indexDef.IndexAlgoParams)
+	if err != nil {
+		return nil, err
+	}
+	clauses := fulltext.ParseQuery(tokenizer, pattern.String(), mode)
+
+	sql := buildFullTextScanSql(fmt.Sprintf("`%s`.`%s`", table.schema, indexDef.IndexTableName), clauses)
+	stmts, err := mysql.Parse(builder.GetContext(), sql, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &tree.AliasedTableExpr{
+		Expr: &tree.ParenTableExpr{Expr: stmts[0].(*tree.Select)},
+		As:   tree.AliasClause{Alias: tree.Identifier(alias)},
+	}, nil
+}
+
+func buildFullTextScanSql(indexTable string, clauses []fulltext.Clause) string {
+	wordCol := catalog.IndexTableWordColName
+	priCol := catalog.IndexTablePrimaryColName
+	countCol := catalog.IndexTableCountColName
+
+	termCond := func(qualifier string, term fulltext.Term) string {
+		if term.Prefix {
+			return fmt.Sprintf("startswith(%s%s, '%s')", qualifier, wordCol, term.Word)
+		}
+		return fmt.Sprintf("%s%s = '%s'", qualifier, wordCol, term.Word)
+	}
+	var conds, innerConds []string
+	for _, clause := range clauses {
+		for _, term := range clause.Terms {
+			conds = append(conds, termCond("t.", term))
+			innerConds = append(innerConds, termCond("", term))
+		}
+	}
+	if len(conds) == 0 {
+		conds = append(conds, "false")
+		innerConds = append(innerConds, "false")
+	}
+
+	var having []string
+	for _, clause := range clauses {
+		if clause.Op == fulltext.OpOptional {
+			continue
+		}
+		present := make([]string, len(clause.Terms))
+		for i, term := range clause.Terms {
+			present[i] = fmt.Sprintf("sum(case when %s then 1 else 0 end) > 0", termCond("t.", term))
+		}
+		if clause.Op == fulltext.OpRequired {
+			having = append(having, strings.Join(present, " and "))
+		} else {
+			having = append(having, fmt.Sprintf("not (%s)", strings.Join(present, " and ")))
+		}
+	}
+
+	k1 := strconv.FormatFloat(fulltext.BM25K1, 'f', -1, 64)
+	b := strconv.FormatFloat(fulltext.BM25B, 'f', -1, 64)
+	score := fmt.Sprintf("ln(1 + (g.n - f.df + 0.5) / (f.df + 0.5)) * t.%[1]s * (%[2]s + 1) / (t.%[1]s + %[2]s * (1 - %[3]s + %[3]s * d.%[1]s / g.avgdl))",
+		countCol, k1, b)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "select t.%s as %s, sum(%s) as %s from %s as t", priCol, fullTextDocColName, score, fullTextScoreColName, indexTable)
+	fmt.Fprintf(&sb, " join (select %s as w, count(*) as df from %s where %s group by %s) as f on t.%s = f.w",
+		wordCol, indexTable, strings.Join(innerConds, " or "), wordCol, wordCol)
+	fmt.Fprintf(&sb, " join %s as d on d.%s = t.%s and d.%s = ''", indexTable, priCol, priCol, wordCol)
+	fmt.Fprintf(&sb, " cross join (select count(*) as n, cast(avg(%s) as double) as avgdl from %s where %s = '') as g",
+		countCol, indexTable, wordCol)
+	fmt.Fprintf(&sb, " where %s group by t.%s", strings.Join(conds, " or "), priCol)
+	if len(having) > 0 {
+		fmt.Fprintf(&sb, " having %s", strings.Join(having, " and "))
+	}
+	return sb.String()
+}
+
+// collectFullTextMatch appends the MATCH expressions in astExpr to matches.
+func collectFullTextMatch(astExpr tree.Expr, matches []*tree.FullTextMatchExpr) []*tree.FullTextMatchExpr {
+	switch exprImpl := astExpr.(type) {
+	case *tree.FullTextMatchExpr:
+		matches = append(matches, exprImpl)
+	case *tree.ParenExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.NotExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.UnaryExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.IsNullExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.IsNotNullExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.CastExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+	case *tree.AndExpr:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.Right, matches)
+	case *tree.OrExpr:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.Right, matches)
+	case *tree.XorExpr:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.Right, matches)
+	case *tree.BinaryExpr:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.Right, matches)
+	case *tree.ComparisonExpr:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.Right, matches)
+	case *tree.RangeCond:
+		matches = collectFullTextMatch(exprImpl.Left, matches)
+		matches = collectFullTextMatch(exprImpl.From, matches)
+		matches = collectFullTextMatch(exprImpl.To, matches)
+	case *tree.FuncExpr:
+		for _, arg := range exprImpl.Exprs {
+			matches = collectFullTextMatch(arg, matches)
+		}
+	case *tree.CaseExpr:
+		matches = collectFullTextMatch(exprImpl.Expr, matches)
+		for _, when := range exprImpl.Whens {
+			matches = collectFullTextMatch(when.Cond, matches)
+			matches = collectFullTextMatch(when.Val, matches)
+		}
+		matches = collectFullTextMatch(exprImpl.Else, matches)
+	}
+	return matches
+}
+
+// bindFullTextMatch binds MATCH to the relevance computed by the fulltext
+// scan joined in buildFullTextFrom, the relevance of the documents not
+// matched is 0.
+func (b *baseBinder) bindFullTextMatch(astExpr *tree.FullTextMatchExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	alias, ok := b.ctx.fullTextMatches[tree.String(astExpr, dialect.MYSQL)]
+	if !ok {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "can't find FULLTEXT index matching the column list")
+	}
+	return b.impl.BindExpr(&tree.FuncExpr{
+		Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("coalesce")),
+		Exprs: tree.Exprs{
+			tree.SetUnresolvedName(alias, fullTextScoreColName),
+			tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64),
+		},
+	}, depth, isRoot)
+}
@@ -44,6 +44,14 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE ... for cluster table")
 	}
 
+	triggerCtx, colPos, err := buildTriggerCtx(ctx, tblInfo.objRef[0], tblDef, "insert")
+	if err != nil {
+		return nil, err
+	}
+	if triggerCtx != nil && len(stmt.OnDuplicateUpdate) > 0 {
+		return nil, moerr.NewNYI(ctx.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE ... on table with triggers")
+	}
+
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
 	bindCtx := NewBindContext(builder, nil)
 	bindCtx.groupTag = builder.genNewTag()
@@ -102,6 +110,14 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 		NodeId:    int32(len(query.Nodes)),
 		InsertCtx: insertCtx,
 	}
+	if triggerCtx != nil {
+		// the columns of the table are at the front of the projection
+		for _, pos := range colPos {
+			triggerCtx.NewIdx = append(triggerCtx.NewIdx, int32(pos))
+		}
+		triggerCtx.OldIdx = noTriggerIdx(len(colPos))
+		node.TriggerCtxs = []*plan.TriggerCtx{triggerCtx}
+	}
 	query.Nodes = append(query.Nodes, node)
 	query.Steps[len(query.Steps)-1] = node.NodeId
 	query.StmtType = plan.Query_INSERT
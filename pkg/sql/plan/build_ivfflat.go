@@ -0,0 +1,211 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+const (
+	ivfFlatAlias      = "__mo_ivfflat_0"
+	ivfFlatDocColName = "__mo_ivfflat_doc"
+)
+
+// defaultIvfFlatProbes is the number of lists searched by an ivfflat index if
+// ivfflat_probes can't be resolved.
+const defaultIvfFlatProbes = 1
+
+// ivfFlatOrders are the distance functions which can be ordered by an ivfflat
+// index, and the direction of the nearest vectors first.
+var ivfFlatOrders = map[string]tree.Direction{
+	"l2_distance":     tree.Ascending,
+	"cosine_distance": tree.Ascending,
+	"inner_product":   tree.Descending,
+}
+
+// buildIvfFlatFrom rewrites the FROM clause of a k nearest neighbours search
+//
+//	select ... from t [where ...] order by l2_distance(t.v, '[...]') limit k
+//
+// where t.v has an ivfflat index. Only the rows in the lists whose centroids
+// are the nearest to the query vector, and the rows not assigned to a list
+// yet, are candidates:
+//
+//	from t join (<candidates from the index table>) as __mo_ivfflat_0
+//	on t.pk = __mo_ivfflat_0.__mo_ivfflat_doc
+//
+// The number of lists searched is ivfflat_probes. The result is approximate,
+// the nearest rows in the other lists are missed. If the select isn't such a
+// search, from is returned unchanged.
+func (builder *QueryBuilder) buildIvfFlatFrom(from tree.TableExprs, clause *tree.SelectClause, astOrderBy tree.OrderBy, astLimit *tree.Limit, ctx *BindContext) (tree.TableExprs, []string, error) {
+	if len(from) != 1 || len(astOrderBy) != 1 || astLimit == nil || astLimit.Count == nil {
+		return from, nil, nil
+	}
+	if clause.Distinct || clause.GroupBy != nil || clause.Having != nil {
+		return from, nil, nil
+	}
+	tableExpr := from[0]
+	if join, ok := tableExpr.(*tree.JoinTableExpr); ok && join.Right == nil {
+		tableExpr = join.Left
+	}
+	if _, ok := tableExpr.(*tree.AliasedTableExpr); !ok {
+		return from, nil, nil
+	}
+	if count, ok := astLimit.Count.(*tree.NumVal); !ok || count.ValType != tree.P_int64 {
+		return from, nil, nil
+	}
+
+	tables := builder.collectIndexedTables(tableExpr, ctx, nil)
+	if len(tables) != 1 {
+		return from, nil, nil
+	}
+	table := tables[0]
+
+	fn, colName, query, ok := getIvfFlatOrder(astOrderBy[0], clause, table.alias)
+	if !ok {
+		return from, nil, nil
+	}
+	var indexDef *plan.IndexDef
+	for _, def := range table.tableDef.Indexes {
+		if util.IsIvfFlatIndex(def) && len(def.Parts) == 1 && def.Parts[0] == colName {
+			indexDef = def
+			break
+		}
+	}
+	if indexDef == nil {
+		return from, nil, nil
+	}
+	vec, err := types.ParseArrayF32(query, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sql := buildIvfFlatScanSql(
+		fmt.Sprintf("`%s`.`%s`", table.schema, indexDef.IndexTableName),
+		fmt.Sprintf("`%s`.`%s`", table.schema, util.IvfFlatCentroidsTableName(indexDef.IndexTableName)),
+		fn, types.ArrayF32ToString(vec), builder.getIvfFlatProbes())
+	stmts, err := mysql.Parse(builder.GetContext(), sql, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	derived := &tree.AliasedTableExpr{
+		Expr: &tree.ParenTableExpr{Expr: stmts[0].(*tree.Select)},
+		As:   tree.AliasClause{Alias: tree.Identifier(ivfFlatAlias)},
+	}
+
+	var docExpr tree.Expr
+	pkey := table.tableDef.Pkey
+	if len(pkey.Names) > 1 {
+		args := make(tree.Exprs, len(pkey.Names))
+		for i, name := range pkey.Names {
+			args[i] = tree.SetUnresolvedName(table.alias, name)
+		}
+		docExpr = &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("serial")),
+			Exprs: args,
+		}
+	} else {
+		docExpr = tree.SetUnresolvedName(table.alias, pkey.PkeyColName)
+	}
+	return tree.TableExprs{&tree.JoinTableExpr{
+		JoinType: tree.JOIN_TYPE_INNER,
+		Left:     from[0],
+		Right:    derived,
+		Cond: &tree.OnJoinCond{
+			Expr: tree.NewComparisonExpr(tree.EQUAL, docExpr, tree.SetUnresolvedName(ivfFlatAlias, ivfFlatDocColName)),
+		},
+	}}, []string{ivfFlatAlias}, nil
+}
+
+// getIvfFlatOrder returns the distance function, the column and the query
+// vector of the ORDER BY of a k nearest neighbours search.
+func getIvfFlatOrder(order *tree.Order, clause *tree.SelectClause, alias string) (string, string, string, bool) {
+	astExpr := order.Expr
+	if name, ok := astExpr.(*tree.UnresolvedName); ok && name.NumParts == 1 {
+		for _, selectExpr := range clause.Exprs {
+			if selectExpr.As != nil && selectExpr.As.Compare() == name.Parts[0] {
+				astExpr = selectExpr.Expr
+				break
+			}
+		}
+	}
+	funcExpr, ok := astExpr.(*tree.FuncExpr)
+	if !ok || len(funcExpr.Exprs) != 2 {
+		return "", "", "", false
+	}
+	funcRef, ok := funcExpr.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return "", "", "", false
+	}
+	fn := strings.ToLower(funcRef.Parts[0])
+	direction, ok := ivfFlatOrders[fn]
+	if !ok {
+		return "", "", "", false
+	}
+	if order.Direction != direction && !(order.Direction == tree.DefaultDirection && direction == tree.Ascending) {
+		return "", "", "", false
+	}
+
+	col, query := funcExpr.Exprs[0], funcExpr.Exprs[1]
+	if _, ok := col.(*tree.NumVal); ok {
+		col, query = query, col
+	}
+	name, ok := col.(*tree.UnresolvedName)
+	if !ok || name.NumParts > 2 || (name.NumParts == 2 && name.Parts[1] != alias) {
+		return "", "", "", false
+	}
+	literal, ok := query.(*tree.NumVal)
+	if !ok || literal.ValType != tree.P_char {
+		return "", "", "", false
+	}
+	return fn, name.Parts[0], literal.String(), true
+}
+
+// getIvfFlatProbes returns the number of lists searched by an ivfflat index.
+func (builder *QueryBuilder) getIvfFlatProbes() int64 {
+	val, err := builder.compCtx.ResolveVariable("ivfflat_probes", true, false)
+	if err == nil {
+		switch v := val.(type) {
+		case uint64:
+			return int64(v)
+		case int64:
+			return v
+		}
+	}
+	return defaultIvfFlatProbes
+}
+
+func buildIvfFlatScanSql(indexTable, centroidsTable, fn, query string, probes int64) string {
+	listCol := catalog.IndexTableListColName
+	direction := ""
+	if ivfFlatOrders[fn] == tree.Descending {
+		direction = " desc"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "select e.%s as %s from %s as e", catalog.IndexTablePrimaryColName, ivfFlatDocColName, indexTable)
+	fmt.Fprintf(&sb, " left join (select %s as l from %s order by %s(%s, '%s')%s limit %d) as c on e.%s = c.l",
+		listCol, centroidsTable, fn, catalog.IndexTableVectorColName, query, direction, probes, listCol)
+	fmt.Fprintf(&sb, " where c.l is not null or e.%s = -1", listCol)
+	return sb.String()
+}
@@ -36,6 +36,10 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext) (*Plan, error) {
 	if err != nil {
 		return nil, err
 	}
+	if stmt.Param.Format == tree.PARQUET {
+		// the row groups can't be split by lines
+		stmt.Param.Parallel = false
+	}
 
 	if err := InitNullMap(stmt.Param, ctx); err != nil {
 		return nil, err
@@ -0,0 +1,352 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// materializedViewMergeFuncs are the aggregates whose partial results can be
+// merged, and the functions merging them.
+var materializedViewMergeFuncs = map[string]string{
+	"count": "sum",
+	"sum":   "sum",
+	"min":   "min",
+	"max":   "max",
+}
+
+// getMaterializedViewData returns the data of the materialized view, or nil if
+// the view isn't materialized.
+func getMaterializedViewData(viewDef *TableDef) (*MaterializedViewData, error) {
+	if viewDef.ViewSql == nil || viewDef.ViewSql.View == "" {
+		return nil, nil
+	}
+	var viewData ViewData
+	if err := json.Unmarshal([]byte(viewDef.ViewSql.View), &viewData); err != nil {
+		return nil, err
+	}
+	return viewData.Materialized, nil
+}
+
+// buildMaterializedViewData decides how the materialized view selecting stmt
+// keeps its results, query is the plan of stmt. The view is refreshed
+// incrementally if it is
+//
+//	select <exprs> from t [where ...]
+//
+// or
+//
+//	select <group by exprs and count/sum/min/max> from t [where ...] group by ...
+//
+// over a single ordinary table, otherwise it can only be fully refreshed.
+func buildMaterializedViewData(viewName string, stmt *tree.Select, query *plan.Query) *MaterializedViewData {
+	data := &MaterializedViewData{
+		Table: util.BuildMaterializedViewTableName(viewName),
+	}
+
+	var scan *plan.Node
+	hasAgg := false
+	nodes := []int32{query.Steps[len(query.Steps)-1]}
+	for len(nodes) > 0 {
+		node := query.Nodes[nodes[0]]
+		nodes = append(nodes[1:], node.Children...)
+		switch node.NodeType {
+		case plan.Node_PROJECT, plan.Node_FILTER:
+		case plan.Node_AGG:
+			if hasAgg {
+				return data
+			}
+			hasAgg = true
+		case plan.Node_TABLE_SCAN:
+			if scan != nil {
+				return data
+			}
+			scan = node
+		default:
+			return data
+		}
+	}
+	if scan == nil || scan.TableDef.TableType != catalog.SystemOrdinaryRel || scan.TableDef.Partition != nil {
+		return data
+	}
+
+	if stmt.With != nil || stmt.OrderBy != nil || stmt.Limit != nil || stmt.Ep != nil {
+		return data
+	}
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok || clause.Distinct || clause.Having != nil {
+		return data
+	}
+	aliased := getMaterializedViewBaseTable(clause)
+	if aliased == nil {
+		return data
+	}
+	if name, ok := aliased.Expr.(*tree.TableName); !ok || !strings.EqualFold(string(name.ObjectName), scan.TableDef.Name) {
+		return data
+	}
+
+	if hasAgg {
+		mergeFuncs, ok := buildMaterializedViewMergeFuncs(clause)
+		if !ok {
+			return data
+		}
+		data.Aggregate = true
+		data.MergeFuncs = mergeFuncs
+	}
+	data.Incremental = true
+	data.BaseDatabase = scan.ObjRef.SchemaName
+	data.BaseTable = scan.TableDef.Name
+	return data
+}
+
+// getMaterializedViewBaseTable returns the single table the select reads from,
+// or nil if it reads from more tables.
+func getMaterializedViewBaseTable(clause *tree.SelectClause) *tree.AliasedTableExpr {
+	if clause.From == nil || len(clause.From.Tables) != 1 {
+		return nil
+	}
+	tbl := clause.From.Tables[0]
+	if join, ok := tbl.(*tree.JoinTableExpr); ok && join.Right == nil {
+		tbl = join.Left
+	}
+	aliased, _ := tbl.(*tree.AliasedTableExpr)
+	return aliased
+}
+
+// buildMaterializedViewMergeFuncs returns the functions merging the partial
+// results of every column of the aggregate view, the view must only select
+// the group by expressions and count/sum/min/max, and all the group by
+// expressions must be selected.
+func buildMaterializedViewMergeFuncs(clause *tree.SelectClause) ([]string, bool) {
+	groupBy := make(map[string]bool, len(clause.GroupBy))
+	for _, expr := range clause.GroupBy {
+		groupBy[tree.String(expr, dialect.MYSQL)] = false
+	}
+
+	mergeFuncs := make([]string, len(clause.Exprs))
+	for i, selectExpr := range clause.Exprs {
+		if expr, ok := selectExpr.Expr.(*tree.FuncExpr); ok {
+			if name, ok := expr.Func.FunctionReference.(*tree.UnresolvedName); ok {
+				if mergeFunc, ok := materializedViewMergeFuncs[strings.ToLower(name.Parts[0])]; ok {
+					if expr.Type == tree.FUNC_TYPE_DISTINCT || expr.WindowSpec != nil {
+						return nil, false
+					}
+					mergeFuncs[i] = mergeFunc
+					continue
+				}
+			}
+		}
+		key := tree.String(selectExpr.Expr, dialect.MYSQL)
+		if _, ok := groupBy[key]; !ok {
+			return nil, false
+		}
+		groupBy[key] = true
+	}
+	for _, selected := range groupBy {
+		if !selected {
+			return nil, false
+		}
+	}
+	return mergeFuncs, true
+}
+
+// buildMaterializedViewTable adds the hidden table keeping the results of the
+// materialized view whose columns are cols.
+func buildMaterializedViewTable(createTable *plan.CreateTable, cols []*ColDef, data *MaterializedViewData) {
+	tableDef := &TableDef{
+		Name: data.Table,
+		Cols: make([]*ColDef, 0, len(cols)+1),
+	}
+	for i, col := range cols {
+		typ := DeepCopyType(col.Typ)
+		typ.NotNullable = false
+		tableDef.Cols = append(tableDef.Cols, &ColDef{
+			ColId: uint64(i),
+			Name:  util.MaterializedViewColName(i),
+			Alg:   plan.CompressType_Lz4,
+			Typ:   typ,
+			Default: &plan.Default{
+				NullAbility: true,
+			},
+		})
+	}
+	tableDef.Cols = append(tableDef.Cols, &ColDef{
+		ColId:  uint64(len(cols)),
+		Name:   catalog.FakePrimaryKeyColName,
+		Hidden: true,
+		Typ: &Type{
+			Id:       int32(types.T_uint64),
+			AutoIncr: true,
+		},
+		Default: &plan.Default{},
+		NotNull: true,
+		Primary: true,
+	})
+	tableDef.Pkey = &PrimaryKeyDef{
+		Names:       []string{catalog.FakePrimaryKeyColName},
+		PkeyColName: catalog.FakePrimaryKeyColName,
+	}
+	createTable.IndexTables = append(createTable.IndexTables, tableDef)
+}
+
+// buildMaterializedViewSource returns the select reading the results of the
+// materialized view from its hidden table in database db, the partial results
+// of an incrementally refreshed aggregate view are merged by the group by
+// columns.
+func buildMaterializedViewSource(ctx context.Context, db string, viewDef *TableDef, data *MaterializedViewData) (*tree.Select, error) {
+	merge := data.Incremental && data.Aggregate
+	exprs := make([]string, 0, len(viewDef.Cols))
+	groupBy := make([]string, 0, len(viewDef.Cols))
+	for _, col := range viewDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		i := len(exprs)
+		name := util.MaterializedViewColName(i)
+		if merge && i < len(data.MergeFuncs) && data.MergeFuncs[i] != "" {
+			exprs = append(exprs, fmt.Sprintf("%s(`%s`) as `%s`", data.MergeFuncs[i], name, col.Name))
+			continue
+		}
+		exprs = append(exprs, fmt.Sprintf("`%s` as `%s`", name, col.Name))
+		groupBy = append(groupBy, fmt.Sprintf("`%s`", name))
+	}
+
+	sql := fmt.Sprintf("select %s from `%s`.`%s`", strings.Join(exprs, ", "), db, data.Table)
+	if merge && len(groupBy) > 0 {
+		sql += " group by " + strings.Join(groupBy, ", ")
+	}
+	stmt, err := mysql.ParseOne(ctx, sql, 1)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.(*tree.Select), nil
+}
+
+var (
+	// sqlStringEscaper escapes the value quoted by ' in the sql
+	sqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	// sqlIdentEscaper escapes the name quoted by ` in the sql
+	sqlIdentEscaper = strings.NewReplacer("`", "``")
+)
+
+// MaterializedViewRefreshSql returns the sql inserting the results of the
+// materialized view into its hidden table in database db. If from isn't empty,
+// only the rows inserted into the base table after the timestamp from are
+// selected, which is only allowed for an incremental view.
+func MaterializedViewRefreshSql(ctx context.Context, viewData *ViewData, db, from string) (string, error) {
+	data := viewData.Materialized
+	if data == nil {
+		return "", moerr.NewInternalError(ctx, "view is not materialized")
+	}
+	stmt, err := mysql.ParseOne(ctx, viewData.Stmt, 1)
+	if err != nil {
+		return "", err
+	}
+	view, ok := stmt.(*tree.CreateView)
+	if !ok {
+		return "", moerr.NewInternalError(ctx, "can not get view statement")
+	}
+
+	source := view.AsSource
+	if from != "" {
+		if !data.Incremental {
+			return "", moerr.NewInternalError(ctx, "materialized view %s can not be refreshed incrementally", view.Name.ObjectName)
+		}
+		// the incremental view selects from a single table, which is
+		// replaced by its changes keeping the name referred to by the columns
+		aliased := getMaterializedViewBaseTable(source.Select.(*tree.SelectClause))
+		alias := aliased.As.Alias
+		if alias == "" {
+			alias = aliased.Expr.(*tree.TableName).ObjectName
+		}
+		changes, err := mysql.ParseOne(ctx, fmt.Sprintf("select * from table_changes('%s.%s', '%s') as `%s`",
+			sqlStringEscaper.Replace(data.BaseDatabase), sqlStringEscaper.Replace(data.BaseTable), from,
+			sqlIdentEscaper.Replace(string(alias))), 1)
+		if err != nil {
+			return "", err
+		}
+		source.Select.(*tree.SelectClause).From = changes.(*tree.Select).Select.(*tree.SelectClause).From
+	}
+
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	source.Format(fmtCtx)
+	return fmt.Sprintf("insert into `%s`.`%s` %s",
+		sqlIdentEscaper.Replace(db), sqlIdentEscaper.Replace(data.Table), fmtCtx.String()), nil
+}
+
+// buildTableChanges builds the scan of table_changes('db.table', 'ts'), which
+// returns the rows inserted into the table after the timestamp ts. The table
+// is resolved when the query is built, so the first argument must be a
+// string.
+func (builder *QueryBuilder) buildTableChanges(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) != 2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "table_changes needs 2 arguments")
+	}
+	c, ok := exprs[0].Expr.(*plan.Expr_C)
+	if !ok {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "the table of table_changes must be a string")
+	}
+	sval, ok := c.C.Value.(*plan.Const_Sval)
+	if !ok {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "the table of table_changes must be a string")
+	}
+	dbName, tblName, ok := strings.Cut(sval.Sval, ".")
+	if !ok {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "the table of table_changes must be 'db.table'")
+	}
+	_, tableDef := builder.compCtx.Resolve(dbName, tblName)
+	if tableDef == nil || tableDef.TableType != catalog.SystemOrdinaryRel {
+		return 0, moerr.NewNoSuchTable(builder.GetContext(), dbName, tblName)
+	}
+
+	cols := make([]*ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		cols = append(cols, &ColDef{
+			Name: col.Name,
+			Typ:  DeepCopyType(col.Typ),
+		})
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "table_changes",
+			},
+			Cols: cols,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), nil
+}
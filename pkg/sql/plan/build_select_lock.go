@@ -0,0 +1,125 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildLockTargets returns the lock targets of the rows of the base tables read
+// by a locking read
+//
+//	select ... for update | for share [of t1, t2] [nowait | skip locked]
+//
+// If OF is given, only the rows of the listed tables are locked. The derived
+// tables and CTEs are never locked. The primary key of a target refers to the
+// column of the table scan.
+func (builder *QueryBuilder) buildLockTargets(lockInfo *tree.SelectLockInfo, ctx *BindContext) ([]*plan.LockTarget, error) {
+	var ofTables map[string]bool
+	if len(lockInfo.Tables) > 0 {
+		ofTables = make(map[string]bool, len(lockInfo.Tables))
+		for _, table := range lockInfo.Tables {
+			name := string(table.ObjectName)
+			binding, ok := ctx.bindingByTable[name]
+			if !ok {
+				return nil, moerr.NewInvalidInput(builder.GetContext(), "unresolved table name '%s' in locking clause", name)
+			}
+			if builder.qry.Nodes[binding.nodeId].NodeType != plan.Node_TABLE_SCAN {
+				return nil, moerr.NewNYI(builder.GetContext(), "locking clause on derived table '%s'", name)
+			}
+			ofTables[name] = true
+		}
+	}
+
+	var targets []*plan.LockTarget
+	for _, binding := range ctx.bindings {
+		scan := builder.qry.Nodes[binding.nodeId]
+		if scan.NodeType != plan.Node_TABLE_SCAN {
+			continue
+		}
+		if ofTables != nil && !ofTables[binding.table] {
+			continue
+		}
+		if scan.TableDef.Pkey == nil {
+			return nil, moerr.NewNYI(builder.GetContext(), "locking clause on table '%s' without primary key", binding.table)
+		}
+		colPos, ok := binding.colIdByName[scan.TableDef.Pkey.PkeyColName]
+		if !ok || colPos < 0 {
+			return nil, moerr.NewNYI(builder.GetContext(), "locking clause on table '%s' without primary key", binding.table)
+		}
+		targets = append(targets, &plan.LockTarget{
+			TableId:            binding.tableID,
+			PrimaryColIdxInBat: colPos,
+			PrimaryColRelPos:   binding.tag,
+			PrimaryColTyp:      DeepCopyType(binding.types[colPos]),
+			RefreshTsIdxInBat:  -1,
+			Shared:             lockInfo.LockType != tree.SelectLockForUpdate,
+			NoWait:             lockInfo.Wait == tree.SelectLockNoWait,
+			SkipLocked:         lockInfo.Wait == tree.SelectLockSkipLocked,
+		})
+	}
+	return targets, nil
+}
+
+// appendLockNode appends a LOCK_OP node which locks the rows of the targets.
+// The rows are locked after the WHERE filter, so only the matched rows are
+// locked.
+func (builder *QueryBuilder) appendLockNode(nodeID int32, targets []*plan.LockTarget, ctx *BindContext) int32 {
+	if len(targets) == 0 {
+		return nodeID
+	}
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_LOCK_OP,
+		Children:    []int32{nodeID},
+		LockTargets: targets,
+	}, ctx)
+}
+
+// lockBeforeLimit returns true if the rows of a locking read are locked after
+// ORDER BY and before LIMIT, so the rows after the limit are never locked, e.g.
+//
+//	select * from t order by id limit 10 for update skip locked
+//
+// It's the read of a single table whose rows are neither grouped nor distinct.
+// The lock op stops once the limit is met, the rows skipped by SKIP LOCKED are
+// not counted.
+func lockBeforeLimit(ctx *BindContext, targets []*plan.LockTarget, limitExpr *Expr) bool {
+	return limitExpr != nil && len(targets) == 1 && len(ctx.bindings) == 1 &&
+		len(ctx.groups) == 0 && len(ctx.aggregates) == 0 && len(ctx.windows) == 0 &&
+		!ctx.isDistinct
+}
+
+// projectLockTargets projects the primary keys of the targets, so they can be
+// locked after the PROJECT node. The columns are dropped by the result PROJECT.
+func (builder *QueryBuilder) projectLockTargets(targets []*plan.LockTarget, ctx *BindContext) {
+	for _, target := range targets {
+		binding := ctx.bindingByTag[target.PrimaryColRelPos]
+		name := binding.cols[target.PrimaryColIdxInBat]
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: DeepCopyType(target.PrimaryColTyp),
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: target.PrimaryColRelPos,
+					ColPos: target.PrimaryColIdxInBat,
+				},
+			},
+		})
+		target.PrimaryColRelPos = ctx.projectTag
+		target.PrimaryColIdxInBat = int32(len(ctx.projects) - 1)
+		builder.nameByColRef[[2]int32{ctx.projectTag, target.PrimaryColIdxInBat}] = name
+	}
+}
@@ -155,6 +155,12 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		if typ.Oid.IsFloat() && col.Typ.Scale != -1 {
 			typeStr += fmt.Sprintf("(%d,%d)", col.Typ.Width, col.Typ.Scale)
 		}
+		if typ.Oid == types.T_bit {
+			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
+		}
+		if typ.Oid == types.T_enum || typ.Oid == types.T_set {
+			typeStr += fmt.Sprintf("(%s)", col.Typ.Enumvalues)
+		}
 
 		updateOpt := ""
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
@@ -193,18 +199,34 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 			var indexStr string
 			if indexdef.Unique {
 				indexStr = "UNIQUE KEY "
+			} else if util.IsFullTextIndex(indexdef) {
+				indexStr = "FULLTEXT KEY "
 			} else {
 				indexStr = "KEY "
 			}
-			indexStr += fmt.Sprintf("`%s` (", indexdef.IndexName)
-			for num, part := range indexdef.Parts {
-				if num == len(indexdef.Parts)-1 {
-					indexStr += fmt.Sprintf("`%s`", part)
-				} else {
-					indexStr += fmt.Sprintf("`%s`,", part)
+			indexStr += fmt.Sprintf("`%s` ", indexdef.IndexName)
+			if util.IsIvfFlatIndex(indexdef) {
+				indexStr += "USING ivfflat "
+			}
+			indexStr += "("
+			if util.IsExpressionIndex(indexdef) {
+				indexStr += fmt.Sprintf("(%s)", indexdef.IndexAlgoParams)
+			} else {
+				for num, part := range indexdef.Parts {
+					if num == len(indexdef.Parts)-1 {
+						indexStr += fmt.Sprintf("`%s`", part)
+					} else {
+						indexStr += fmt.Sprintf("`%s`,", part)
+					}
 				}
 			}
 			indexStr += ")"
+			if indexdef.IndexAlgoParams != "" && util.IsFullTextIndex(indexdef) {
+				indexStr += fmt.Sprintf(" WITH PARSER %s", indexdef.IndexAlgoParams)
+			}
+			if util.IsIvfFlatIndex(indexdef) {
+				indexStr += fmt.Sprintf(" LISTS = %s", indexdef.IndexAlgoParams)
+			}
 			if indexdef.Comment != "" {
 				indexdef.Comment = strings.Replace(indexdef.Comment, "'", "\\'", -1)
 				indexStr += fmt.Sprintf(" COMMENT '%s'", indexdef.Comment)
@@ -0,0 +1,87 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildScanSnapshot sets the snapshot read by the table scan of
+// "{AS OF TIMESTAMP expr}". The timestamp is either a txn timestamp like the
+// result of current_snapshot(), or a datetime in the session time zone.
+func (builder *QueryBuilder) buildScanSnapshot(nodeID int32, asOf *tree.AsOfClause, ctx *BindContext) error {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType != plan.Node_TABLE_SCAN {
+		return moerr.NewNotSupported(builder.GetContext(), "AS OF TIMESTAMP on views, subqueries or external tables")
+	}
+
+	// the timestamp may be relative to now()
+	node.NotCacheable = true
+
+	ctx.binder = NewTableBinder(builder, ctx)
+	expr, err := ctx.binder.BindExpr(asOf.Expr, 0, false)
+	if err != nil {
+		return err
+	}
+	if expr.Typ.Id == int32(types.T_char) || expr.Typ.Id == int32(types.T_varchar) || expr.Typ.Id == int32(types.T_text) {
+		vec, err := builder.evalSnapshotExpr(expr)
+		if err != nil {
+			return err
+		}
+		value := vec.GetStringAt(0)
+		vec.Free(builder.compCtx.GetProcess().Mp())
+		if ts, err := timestamp.ParseTimestamp(value); err == nil {
+			node.ScanSnapshot = &ts
+			return nil
+		}
+	}
+
+	expr, err = appendCastBeforeExpr(builder.GetContext(), expr, &plan.Type{
+		Id:    int32(types.T_timestamp),
+		Scale: 6,
+	})
+	if err != nil {
+		return err
+	}
+	vec, err := builder.evalSnapshotExpr(expr)
+	if err != nil {
+		return err
+	}
+	micros, _ := vector.MustFixedCol[types.Timestamp](vec)[0].UnixToDecimal64()
+	vec.Free(builder.compCtx.GetProcess().Mp())
+	node.ScanSnapshot = &timestamp.Timestamp{PhysicalTime: int64(micros) * 1000}
+	return nil
+}
+
+func (builder *QueryBuilder) evalSnapshotExpr(expr *plan.Expr) (*vector.Vector, error) {
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpressionOnce(builder.compCtx.GetProcess(), expr, []*batch.Batch{bat})
+	if err != nil {
+		return nil, err
+	}
+	if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+		vec.Free(builder.compCtx.GetProcess().Mp())
+		return nil, moerr.NewInvalidInput(builder.GetContext(), "the timestamp of AS OF TIMESTAMP is null")
+	}
+	return vec, nil
+}
@@ -22,10 +22,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // only use in developing
@@ -594,6 +598,61 @@ func TestCTESqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+// test recursive CTE plan building
+func TestRecursiveCTESqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// should pass
+	sqls := []string{
+		"with recursive c(n) as (select 1 union all select n + 1 from c where n < 10) select * from c",
+		"with recursive c(n) as (select 1 union select n + 1 from c where n < 10) select n from c where n > 5",
+		"with recursive c as (select n_nationkey as k, n_name from nation where n_regionkey = 0 union all select n_nationkey, nation.n_name from nation join c on nation.n_regionkey = c.k) select * from c",
+		"with recursive c(n, s) as (select 1, 'a' union all select n + 1, concat(s, 'a') from c where n < 3) select s from c",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"with recursive c(n) as (select 1 union all select count(n) from c) select * from c",
+		"with recursive c(n) as (select 1 union all select a.n from c a, c b) select * from c",
+		"with recursive c(n) as (select n + 1 from c) select * from c",
+		"with recursive c(n) as (select n from c union all select 1) select * from c",
+		"with recursive c(n, m) as (select 1 union all select n + 1 from c where n < 10) select * from c",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+// test window function plan building
+func TestWindowSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// should pass
+	sqls := []string{
+		"select n_name, row_number() over (partition by n_regionkey order by n_nationkey) from nation",
+		"select n_name, rank() over (order by n_regionkey), dense_rank() over (order by n_regionkey desc) from nation",
+		"select n_name, percent_rank() over (order by n_nationkey), cume_dist() over (order by n_nationkey) from nation",
+		"select n_name, ntile(3) over (partition by n_regionkey order by n_nationkey) from nation",
+		"select lag(n_name, 2, 'none') over (order by n_nationkey), lead(n_name) over (order by n_nationkey) from nation",
+		"select first_value(n_name) over (order by n_nationkey), nth_value(n_name, 2) over (order by n_nationkey) from nation",
+		"select sum(n_nationkey) over (partition by n_regionkey) from nation",
+		"select count(*) over (order by n_nationkey rows between 1 preceding and 1 following) from nation",
+		"select avg(n_nationkey) over (order by n_nationkey range between 2 preceding and current row) from nation",
+		"select n_regionkey, sum(n_nationkey), rank() over (order by sum(n_nationkey)) from nation group by n_regionkey",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"select row_number() from nation",
+		"select abs(n_nationkey) over (order by n_nationkey) from nation",
+		"select sum(n_nationkey) over (range between 1 preceding and current row) from nation",
+		"select sum(n_nationkey) over (order by n_name range between 1 preceding and current row) from nation",
+		"select sum(n_nationkey) over (order by n_nationkey rows between current row and 1 preceding) from nation",
+		"select n_name from nation where rank() over (order by n_nationkey) > 1",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -620,6 +679,42 @@ func TestInsert(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+// sharedDefCompilerContext returns the table defs without copying them, like
+// the cached table defs of the CN.
+type sharedDefCompilerContext struct {
+	*MockCompilerContext
+}
+
+func (c sharedDefCompilerContext) Resolve(dbName string, tableName string) (*ObjectRef, *TableDef) {
+	name := strings.ToLower(tableName)
+	return c.objects[name], c.tables[name]
+}
+
+func TestInsertEnumKeepsTableDef(t *testing.T) {
+	mock := NewMockCompilerContext(false)
+	region := mock.tables["region"]
+	typ := &plan.Type{
+		Id:          int32(types.T_enum),
+		NotNullable: true,
+		Enumvalues:  types.FormatEnumValues([]string{"x", "y"}),
+	}
+	// replace the rowid column, which is removed by Resolve of the mock
+	region.Cols[len(region.Cols)-1] = &ColDef{
+		ColId:   uint64(len(region.Cols) - 1),
+		Typ:     typ,
+		Name:    "r_enum",
+		Default: &plan.Default{},
+	}
+	ctx := sharedDefCompilerContext{mock}
+
+	stmts, err := mysql.Parse(ctx.GetContext(), "insert into region(r_regionkey, r_name, r_comment, r_enum) select n_nationkey, n_name, n_comment, n_comment from nation", 1)
+	require.NoError(t, err)
+	_, err = BuildPlan(ctx, stmts[0])
+	require.NoError(t, err)
+	require.Same(t, typ, region.Cols[3].Typ)
+	require.True(t, typ.NotNullable)
+}
+
 func TestUpdate(t *testing.T) {
 	mock := NewMockOptimizer(true)
 	// should pass
@@ -663,6 +758,453 @@ func TestDelete(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestTriggers(t *testing.T) {
+	triggers := map[string][]*TriggerDef{
+		"tpch.nation": {
+			{Name: "t1", DbName: "tpch", Before: true, Event: "insert", Body: "set new.n_comment = 'c'"},
+			{Name: "t2", DbName: "tpch", Before: false, Event: "update", Body: "set @x = old.n_name"},
+			{Name: "t3", DbName: "tpch", Before: false, Event: "delete", Body: "set @x = old.n_name"},
+		},
+	}
+	insertMock := NewMockOptimizer(false)
+	insertMock.ctxt.triggers = triggers
+	mock := NewMockOptimizer(true)
+	mock.ctxt.triggers = triggers
+
+	for sql, event := range map[string]string{
+		"insert into nation values (1, 'NAME1', 21, 'COMMENT1')":  "insert",
+		"insert into nation select * from nation2":                "insert",
+		"update nation set n_name = 'U1' where n_nationkey > 10":  "update",
+		"delete from nation where n_nationkey > 10":               "delete",
+		"delete from nation":                                      "delete",
+		"update nation set n_regionkey = 2 where n_nationkey = 1": "update",
+	} {
+		opt := mock
+		if event == "insert" {
+			opt = insertMock
+		}
+		logicPlan, err := runOneStmt(opt, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		var triggerCtxs []*plan.TriggerCtx
+		for _, node := range logicPlan.GetQuery().Nodes {
+			triggerCtxs = append(triggerCtxs, node.TriggerCtxs...)
+			if node.NodeType == plan.Node_DELETE && node.DeleteCtx.CanTruncate {
+				t.Fatalf("the deletion firing triggers can not truncate the table, sql=%v", sql)
+			}
+		}
+		if len(triggerCtxs) != 1 {
+			t.Fatalf("expect 1 trigger ctx, got %d, sql=%v", len(triggerCtxs), sql)
+		}
+		tc := triggerCtxs[0]
+		if len(tc.Triggers) != 1 || tc.Triggers[0].Event != event {
+			t.Fatalf("expect the %s trigger, got %v, sql=%v", event, tc.Triggers, sql)
+		}
+		// the hidden row id is not visible to triggers
+		if len(tc.ColNames) != 4 || len(tc.NewIdx) != 4 || len(tc.OldIdx) != 4 || len(tc.Readonly) != 4 {
+			t.Fatalf("expect 4 columns in trigger ctx, got %v, sql=%v", tc.ColNames, sql)
+		}
+		for i := range tc.ColNames {
+			if (event == "delete") != (tc.NewIdx[i] < 0) {
+				t.Fatalf("unexpected NEW index %d of column %s, sql=%v", tc.NewIdx[i], tc.ColNames[i], sql)
+			}
+			if (event == "insert") != (tc.OldIdx[i] < 0) {
+				t.Fatalf("unexpected OLD index %d of column %s, sql=%v", tc.OldIdx[i], tc.ColNames[i], sql)
+			}
+		}
+	}
+
+	// should error
+	sqls := []string{
+		"insert into nation values (1, 'NAME1', 21, 'COMMENT1') on duplicate key update n_name = 'U1'", // not supported with triggers
+	}
+	runTestShouldError(insertMock, t, sqls)
+}
+
+func TestMaterializedView(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	for sql, expected := range map[string]MaterializedViewData{
+		"create materialized view mv2 as select n_regionkey, count(*), max(n_nationkey) from nation where n_nationkey > 1 group by n_regionkey": {
+			Table:        "__mo_mv_mv2",
+			BaseDatabase: "tpch",
+			BaseTable:    "nation",
+			Incremental:  true,
+			Aggregate:    true,
+			MergeFuncs:   []string{"", "sum", "max"},
+		},
+		"create materialized view mv2 as select n_name, n_nationkey + 1 from nation n where n.n_regionkey = 1": {
+			Table:        "__mo_mv_mv2",
+			BaseDatabase: "tpch",
+			BaseTable:    "nation",
+			Incremental:  true,
+		},
+		"create materialized view mv2 as select n_regionkey, avg(n_nationkey) from nation group by n_regionkey": {
+			Table: "__mo_mv_mv2",
+		},
+		"create materialized view mv2 as select n_regionkey, count(*) from nation group by n_regionkey, n_name": {
+			Table: "__mo_mv_mv2",
+		},
+		"create materialized view mv2 as select n_name from nation order by n_name limit 10": {
+			Table: "__mo_mv_mv2",
+		},
+		"create materialized view mv2 as select n_name, r_name from nation join region on n_regionkey = r_regionkey": {
+			Table: "__mo_mv_mv2",
+		},
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		createTable := logicPlan.GetDdl().GetCreateTable()
+		if len(createTable.IndexTables) != 1 || createTable.IndexTables[0].Name != expected.Table {
+			t.Fatalf("expect the hidden table %s, sql=%v", expected.Table, sql)
+		}
+		// the columns of the view and the hidden primary key
+		if len(createTable.IndexTables[0].Cols) != len(createTable.TableDef.Cols)+1 {
+			t.Fatalf("unexpected columns of the hidden table, sql=%v", sql)
+		}
+		data, err := getMaterializedViewData(createTable.TableDef)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		assert.Equal(t, expected, *data, sql)
+	}
+
+	// the partial results of the incremental view are merged
+	logicPlan, err := runOneStmt(mock, t, "select * from mv1 where cnt > 1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var scanned, merged bool
+	for _, node := range logicPlan.GetQuery().Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			scanned = node.TableDef.Name == "__mo_mv_mv1"
+		case plan.Node_AGG:
+			merged = true
+		}
+	}
+	if !scanned || !merged {
+		t.Fatalf("expect the hidden table is scanned and merged")
+	}
+
+	sql, err := MaterializedViewRefreshSql(context.TODO(), &ViewData{
+		Stmt:            "create materialized view mv2 as select n_regionkey, count(*) from nation n where n_nationkey > 1 group by n_regionkey",
+		DefaultDatabase: "tpch",
+		Materialized: &MaterializedViewData{
+			Table:        "__mo_mv_mv2",
+			BaseDatabase: "tpch",
+			BaseTable:    "nation",
+			Incremental:  true,
+			Aggregate:    true,
+			MergeFuncs:   []string{"", "sum"},
+		},
+	}, "db1", "100-1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := "insert into `db1`.`__mo_mv_mv2` select n_regionkey, count(\"*\") from table_changes(\"tpch.nation\", \"100-1\") as n where n_nationkey > 1 group by n_regionkey"
+	if sql != expected {
+		t.Fatalf("expect %s, got %s", expected, sql)
+	}
+	if _, err = mysql.ParseOne(context.TODO(), sql, 1); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// the names with backticks are escaped
+	sql, err = MaterializedViewRefreshSql(context.TODO(), &ViewData{
+		Stmt:            "create materialized view `m``v` as select n_regionkey from nation",
+		DefaultDatabase: "tpch",
+		Materialized: &MaterializedViewData{
+			Table:        "__mo_mv_m`v",
+			BaseDatabase: "tpch",
+			BaseTable:    "nation",
+		},
+	}, "d`b", "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected = "insert into `d``b`.`__mo_mv_m``v` select n_regionkey from nation"
+	if sql != expected {
+		t.Fatalf("expect %s, got %s", expected, sql)
+	}
+
+	sqls := []string{
+		"select * from table_changes('tpch.nation', '100-1') as n where n_nationkey > 1",
+		"drop materialized view mv1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"drop materialized view v1",                  // not a materialized view
+		"alter view mv1 as select * from nation",     // not supported
+		"select * from table_changes('nation', '1')", // no database
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestFullTextMatch(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select id, match(title, body) against('database') from articles",
+		"select * from articles where match(title, body) against('mysql tutorial' in natural language mode)",
+		"select id from articles where match(body, title) against('+mysql -yoursql' in boolean mode) order by match(body, title) against('+mysql -yoursql' in boolean mode) desc",
+		"select a.id, match(a.title, a.body) against('data*' in boolean mode) as score from articles a join emp on a.id = emp.empno",
+		`select count(*) from articles where match(title, body) against('"database systems"' in boolean mode)`,
+		"select id from articles where match(title, body) against('ab')",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"select id from articles where match(title) against('database')",                            // no fulltext index on title
+		"select empno from emp where match(ename) against('smith')",                                 // no fulltext index
+		"select id from articles where match(title, body) against('database' with query expansion)", // not supported
+		"select id from articles where match(title, body) against(concat('data', 'base'))",          // not constant
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestFullTextIndexDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	sqls := []string{
+		"delete from articles where id = 1",
+		"update articles set body = 'database systems' where id > 1",
+		"update articles set id = id + 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the rows of the fulltext index table are deleted by the primary key,
+	// apart from the rows of the unique and secondary index tables.
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		qry := logicPlan.GetQuery()
+		node := qry.Nodes[qry.Steps[0]]
+		var idxRef, pkIdxRef []*ObjectRef
+		if node.DeleteCtx != nil {
+			idxRef, pkIdxRef = node.DeleteCtx.IdxRef, node.DeleteCtx.PkIdxRef
+			assert.Equal(t, len(pkIdxRef), len(node.DeleteCtx.PkIdxIdx))
+		} else {
+			idxRef, pkIdxRef = node.UpdateCtx.IdxRef, node.UpdateCtx.PkIdxRef
+			assert.Equal(t, len(pkIdxRef), len(node.UpdateCtx.PkIdxIdx))
+		}
+		assert.Empty(t, idxRef, sql)
+		if !assert.Len(t, pkIdxRef, 1, sql) {
+			continue
+		}
+		assert.True(t, strings.HasPrefix(pkIdxRef[0].ObjName, catalog.IndexTableNamePrefix+"fulltext"), sql)
+	}
+}
+
+func TestAsOfTimestamp(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select * from emp {as of timestamp '1686000000000000000-1'}",
+		"select * from emp {as of timestamp '2023-06-01 10:00:00'} e join dept d on e.deptno = d.deptno",
+		"select * from emp {as of timestamp date_sub(now(), interval 1 hour)} where empno > 10",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	logicPlan, err := runOneStmt(mock, t, sqls[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var snapshots []*timestamp.Timestamp
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			snapshots = append(snapshots, node.ScanSnapshot)
+		}
+	}
+	assert.Equal(t, []*timestamp.Timestamp{{PhysicalTime: 1686000000000000000, LogicalTime: 1}}, snapshots)
+
+	// should error
+	sqls = []string{
+		"select * from emp {as of timestamp null}",                                           // null timestamp
+		"select * from emp {as of timestamp empno}",                                          // not constant
+		"update emp {as of timestamp '2023-06-01 10:00:00'} set ename = 'a' where empno = 1", // dml target
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestSelectForUpdate(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select * from emp for update",
+		"select * from emp where empno > 10 for share nowait",
+		"select * from emp lock in share mode",
+		"select e.empno, d.dname from emp e join dept d on e.deptno = d.deptno for update of e skip locked",
+		"select count(*) from emp where sal > 1000 for update",
+		"select * from (select * from emp) e for update",
+		"(select * from emp for update)",
+		"select empno from emp order by empno limit 5 for update skip locked",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the lock node locks the rows of the base tables
+	for sql, lockTargets := range map[string]int{
+		"select * from emp for update": 1,
+		"select e.empno, d.dname from emp e join dept d on e.deptno = d.deptno for update":             2,
+		"select e.empno, d.dname from emp e join dept d on e.deptno = d.deptno for update of d nowait": 1,
+		"select * from (select * from emp) e for update":                                               0,
+		"select * from emp": 0,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		n := 0
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				n += len(node.LockTargets)
+			}
+		}
+		if n != lockTargets {
+			t.Fatalf("expect %d lock targets, got %d, sql=%v", lockTargets, n, sql)
+		}
+	}
+
+	// the rows of a single table are locked after ORDER BY until the limit is met
+	for sql, afterSort := range map[string]bool{
+		"select ename from emp order by sal limit 5 for update skip locked":               true,
+		"select ename from emp order by sal limit 5 offset 2 for update":                  true,
+		"select ename from emp limit 5 for update":                                        true,
+		"select ename from emp order by sal for update":                                   false,
+		"select distinct ename from emp order by ename limit 5 for update":                false,
+		"select e.ename from emp e join dept d on e.deptno = d.deptno limit 5 for update": false,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		nodes := logicPlan.GetQuery().Nodes
+		found := false
+		for _, node := range nodes {
+			if node.NodeType != plan.Node_LOCK_OP {
+				continue
+			}
+			found = true
+			child := nodes[node.Children[0]]
+			if afterSort != (node.Limit != nil) {
+				t.Fatalf("expect the limit on the lock node: %v, sql=%v", afterSort, sql)
+			}
+			if afterSort && child.Limit != nil {
+				t.Fatalf("expect no limit below the lock node, sql=%v", sql)
+			}
+			if !afterSort && (child.NodeType == plan.Node_SORT || child.NodeType == plan.Node_PROJECT) {
+				t.Fatalf("expect the lock node below the projection, sql=%v", sql)
+			}
+		}
+		if !found {
+			t.Fatalf("expect a lock node, sql=%v", sql)
+		}
+	}
+
+	// should error
+	sqls = []string{
+		"select * from emp for update of dept",                           // not in from
+		"select * from (select * from emp) e for update of e",            // derived table
+		"select empno from emp union select deptno from dept for update", // union
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestIvfFlatIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select id from items order by l2_distance(embedding, '[1, 2, 3]') limit 5",
+		"select id, cosine_distance(i.embedding, '[1, 2, 3]') as d from items i where id > 10 order by d limit 5",
+		"select * from items order by inner_product('[1, 2, 3]', embedding) desc limit 5",
+		"select id from items order by inner_product(embedding, '[1, 2, 3]') limit 5",
+		"select id from items order by l2_distance(embedding, '[1, 2, 3]')",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the nearest neighbours search is rewritten to scan the index tables
+	for sql, useIndex := range map[string]bool{
+		"select id from items order by l2_distance(embedding, '[1, 2, 3]') limit 5":                        true,
+		"select * from items order by inner_product('[1, 2, 3]', embedding) desc limit 5":                  true,
+		"select id from items order by inner_product(embedding, '[1, 2, 3]') limit 5":                      false,
+		"select id from items order by l2_distance(embedding, '[1, 2, 3]')":                                false,
+		"select id from items group by id, embedding order by l2_distance(embedding, '[1, 2, 3]') limit 5": false,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		found := false
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.TableDef != nil && strings.HasSuffix(node.TableDef.Name, catalog.IvfFlatCentroidsTableSuffix) {
+				found = true
+			}
+		}
+		if found != useIndex {
+			t.Fatalf("expect index used %v, sql=%v", useIndex, sql)
+		}
+	}
+
+	// should error
+	sqls = []string{
+		"select id from items order by l2_distance(embedding, '[1, 2') limit 5", // malformed vector
+		"select id from items order by l2_distance(embedding, id) limit 5",      // not a vector
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestExpressionIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select id from docs where cast(doc->>'$.user_id' as unsigned) = 10",
+		"select d.id from docs d where cast(json_unquote(json_extract(d.doc, '$.user_id')) as unsigned) between 1 and 5 and id > 3",
+		"select id from docs where cast(doc->>'$.user_id' as unsigned) in (1, 2, 3)",
+		"select id from docs where cast(doc->>'$.user_id' as signed) = 10",
+		"insert into docs values (1, '{\"user_id\": 10}')",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	dmlMock := NewMockOptimizer(true)
+	sqls = []string{
+		"update docs set doc = '{\"user_id\": 11}' where id = 1",
+		"update docs set doc = null where cast(doc->>'$.user_id' as unsigned) = 10",
+		"delete from docs where cast(doc->>'$.user_id' as unsigned) = 10",
+	}
+	runTestShouldPass(dmlMock, t, sqls, false, false)
+
+	// the filters on the indexed expression are evaluated on the index table
+	for sql, useIndex := range map[string]bool{
+		"select id from docs where cast(doc->>'$.user_id' as unsigned) = 10":                                              true,
+		"select d.id from docs d where 5 < cast(json_unquote(json_extract(d.doc, '$.user_id')) as unsigned) and d.id > 3": true,
+		"select id from docs where cast(doc->>'$.user_id' as unsigned) in (1, 2, 3)":                                      true,
+		"select id from docs where cast(doc->>'$.user_id' as signed) = 10":                                                false,
+		"select id from docs where cast(doc->>'$.name' as unsigned) = 10":                                                 false,
+		"select id from docs where cast(doc->>'$.user_id' as unsigned) = 10 or id = 1":                                    false,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		found := false
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.TableDef != nil && strings.HasPrefix(node.TableDef.Name, catalog.IndexTableNamePrefix+"expression_") {
+				found = true
+			}
+		}
+		if found != useIndex {
+			t.Fatalf("expect index used %v, sql=%v", useIndex, sql)
+		}
+	}
+}
+
 func TestSubQuery(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -765,6 +1307,18 @@ func TestDdl(t *testing.T) {
 		"create view v_nation as select n_nationkey,n_name,n_regionkey,n_comment from nation",
 		"CREATE TABLE t1(id INT PRIMARY KEY,name VARCHAR(25),deptId INT,CONSTRAINT fk_t1 FOREIGN KEY(deptId) REFERENCES nation(n_nationkey)) COMMENT='xxxxx'",
 		"create table t2(empno int unsigned,ename varchar(15),job varchar(10)) cluster by(empno,ename)",
+		"create table t3(a enum('x','y','z') default 'y', b set('a','b','c'), c bit(8), d year)",
+		"create table t4(id int primary key, title varchar(200), body text, fulltext index ft(title, body))",
+		"create table t5(id int primary key, body text, fulltext(body) with parser ngram)",
+		"create table t6(id int, body text, fulltext(body))",
+		"create fulltext index ft_title on articles(title) with parser ngram",
+		"create table t7(id int primary key, v vector(3), index idx_v using ivfflat (v) lists = 4)",
+		"create index idx_v using ivfflat on items(embedding) lists 100",
+		"create table t8(id int primary key, doc json, index idx_user ((cast(doc->>'$.user_id' as unsigned))))",
+		"create table t8(id int primary key, a int, b int, index ((a + b)), index ((a * b)))",
+		"create table t8(a int, index ((a + 1)))",
+		"create index idx_name on docs ((ltrim(doc->>'$.name')))",
+		"alter table docs add index ((cast(doc->>'$.age' as signed)))",
 		"lock tables nation read",
 		"lock tables nation write, supplier read",
 		"unlock tables",
@@ -783,6 +1337,23 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
 		"create table t6(empno int unsigned,ename varchar(15) auto_increment) cluster by(empno,ename)",
+		"create table t7(a enum('x','X'))",  //duplicated members
+		"create table t7(a set('a,b','c'))", //comma in members
+		"create table t7(a bit(65))",        //bit too long
+		"create table t7(a enum('x') default 'y')",
+		"create table t8(id int primary key, n int, fulltext(n))",                                     //not a text column
+		"create table t8(id int primary key, body text, fulltext(body) with parser jieba)",            //unknown parser
+		"create table t9(id int primary key, n int, index idx_n using ivfflat (n))",                   //not a vector column
+		"create table t9(id int primary key, v vector(3), index idx_v using ivfflat (v) lists 70000)", //too many lists
+		"create table t9(v vector(3) primary key)",                                                    //vector in primary key
+		"create table t9(id int primary key, v vector(3), unique index idx_v(v))",                     //vector in unique index
+		"create table t9(id int primary key, v vector(3), index idx_v(v))",                            //vector in secondary index
+		"create table t9(id int primary key, doc json, unique index ((doc->>'$.id')))",                //unique index on expression
+		"create table t9(id int primary key, a int, index ((a)))",                                     //expression is a column
+		"create table t9(id int primary key, doc json, index ((doc->'$.id')))",                        //expression of json type
+		"create table t9(id int primary key, a int, index ((a + rand())))",                            //non-deterministic
+		"create table t9(id int primary key, a int, index ((a + 1), a))",                              //more than one key part
+		"create index idx on docs ((cast(nope->>'$.id' as unsigned)))",                                //column not exists
 		"lock tables t3 read",
 		"lock tables t1 read, t1 write",
 		"lock tables nation read, nation write",
@@ -981,6 +1552,27 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBuildJsonFunctions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select json_set('{"a": 1}', '$.b', n_nationkey, '$.c', n_name) from nation`,
+		`select json_object('k', n_name, 'v', json_array(n_nationkey, n_comment)) from nation`,
+		`select n_regionkey, json_arrayagg(n_name), json_objectagg(n_name, n_nationkey) from nation group by n_regionkey`,
+		`select n_regionkey from nation group by n_regionkey having json_length(json_arrayagg(n_name)) > 1`,
+		`select * from nation where n_nationkey member of('[1, 2, 3]')`,
+		`select * from json_table('[{"a": 1}]', '$[*]' columns (id for ordinality, a int path '$.a', nested path '$.b[*]' columns (b varchar(10) path '$'))) as jt`,
+		`select jt.a from nation, json_table(n_comment, '$' columns (a decimal(10, 2) path '$.a' default '0' on empty)) as jt`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		`select json_objectagg(n_name) from nation`,
+		`select json_set('{"a": 1}', '$.b') from nation`,
+		`select * from json_table('[]', '$[*]' columns (a int path '$.a', a int path '$.b')) as jt`,
+		`select * from json_table('[]', 'a.b' columns (a int path '$.a')) as jt`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > ? or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
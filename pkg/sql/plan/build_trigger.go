@@ -0,0 +1,88 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// buildTriggerCtx returns the context to run the row-level triggers of the
+// table fired by the event, nil if there is no such trigger. The visible
+// columns of the table are listed in the context, colPos is their positions in
+// tableDef.Cols. The NEW and OLD indexes are left to the caller.
+//
+// The values of the index, primary key and foreign key columns are used by the
+// plan before the triggers run, so these columns are readonly for the BEFORE
+// triggers.
+func buildTriggerCtx(ctx CompilerContext, objRef *ObjectRef, tableDef *TableDef, event string) (*plan.TriggerCtx, []int, error) {
+	triggers, err := ctx.ResolveTriggers(tableDef.TblId, objRef.SchemaName, tableDef.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	var fired []*TriggerDef
+	for _, trigger := range triggers {
+		if trigger.Event == event {
+			fired = append(fired, trigger)
+		}
+	}
+	if len(fired) == 0 {
+		return nil, nil, nil
+	}
+
+	readonly := make(map[string]bool)
+	for _, indexDef := range tableDef.Indexes {
+		for _, part := range indexDef.Parts {
+			readonly[part] = true
+		}
+	}
+	fkCols := make(map[uint64]bool)
+	for _, fk := range tableDef.Fkeys {
+		for _, colId := range fk.Cols {
+			fkCols[colId] = true
+		}
+	}
+	if event == "update" && tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			readonly[name] = true
+		}
+	}
+
+	triggerCtx := &plan.TriggerCtx{
+		DbName:    objRef.SchemaName,
+		TableName: tableDef.Name,
+		Triggers:  fired,
+	}
+	var colPos []int
+	for i, col := range tableDef.Cols {
+		if col.Hidden || col.Name == catalog.Row_ID {
+			continue
+		}
+		triggerCtx.ColNames = append(triggerCtx.ColNames, col.Name)
+		triggerCtx.Readonly = append(triggerCtx.Readonly, readonly[col.Name] || fkCols[col.ColId])
+		colPos = append(colPos, i)
+	}
+	return triggerCtx, colPos, nil
+}
+
+// noTriggerIdx returns the indexes of the values absent from the rows, like the
+// OLD values of the inserted rows.
+func noTriggerIdx(n int) []int32 {
+	idx := make([]int32, n)
+	for i := range idx {
+		idx[i] = -1
+	}
+	return idx
+}
@@ -49,6 +49,36 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		}
 	}
 
+	// the NEW values are the columns of the tables at the front of the
+	// projection, the OLD values are appended to the projection.
+	var triggerCtxs []*plan.TriggerCtx
+	derivedTag := builder.qry.Nodes[rewriteInfo.derivedTableId].BindingTags[0]
+	newIdx := 0
+	for i, tableDef := range tblInfo.tableDefs {
+		triggerCtx, colPos, err := buildTriggerCtx(ctx, tblInfo.objRef[i], tableDef, "update")
+		if err != nil {
+			return nil, err
+		}
+		if triggerCtx != nil {
+			for _, pos := range colPos {
+				col := tableDef.Cols[pos]
+				triggerCtx.NewIdx = append(triggerCtx.NewIdx, int32(newIdx+pos))
+				triggerCtx.OldIdx = append(triggerCtx.OldIdx, int32(len(rewriteInfo.projectList)))
+				rewriteInfo.projectList = append(rewriteInfo.projectList, &plan.Expr{
+					Typ: col.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: derivedTag,
+							ColPos: int32(tblInfo.oldColPosMap[i][col.Name]),
+						},
+					},
+				})
+			}
+			triggerCtxs = append(triggerCtxs, triggerCtx)
+		}
+		newIdx += len(tableDef.Cols)
+	}
+
 	// append ProjectNode
 	rewriteInfo.rootId = builder.appendNode(&plan.Node{
 		NodeType:    plan.Node_PROJECT,
@@ -74,6 +104,9 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		IdxRef: rewriteInfo.onIdxTbl,
 		IdxIdx: rewriteInfo.onIdx,
 
+		PkIdxRef: rewriteInfo.onPkIdxTbl,
+		PkIdxIdx: rewriteInfo.onPkIdx,
+
 		OnRestrictRef: rewriteInfo.onRestrictTbl,
 		OnRestrictIdx: rewriteInfo.onRestrict,
 
@@ -139,6 +172,8 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		Children:  []int32{query.Steps[len(query.Steps)-1]},
 		NodeId:    int32(len(query.Nodes)),
 		UpdateCtx: updateCtx,
+
+		TriggerCtxs: triggerCtxs,
 	}
 	query.Nodes = append(query.Nodes, node)
 	query.Steps[len(query.Steps)-1] = node.NodeId
@@ -161,6 +161,26 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 			return &plan.Type{Id: int32(types.T_blob)}, nil
 		case defines.MYSQL_TYPE_LONG_BLOB:
 			return &plan.Type{Id: int32(types.T_blob)}, nil
+		case defines.MYSQL_TYPE_BIT:
+			width := n.InternalType.DisplayWith
+			if width <= 0 {
+				// bit is bit(1)
+				width = 1
+			}
+			if width > types.MaxBitLen {
+				return nil, moerr.NewOutOfRange(ctx, "bit", " typeLen is over the MaxBitLen: %v", types.MaxBitLen)
+			}
+			return &plan.Type{Id: int32(types.T_bit), Width: width}, nil
+		case defines.MYSQL_TYPE_YEAR:
+			return &plan.Type{Id: int32(types.T_year), Width: 4}, nil
+		case defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET:
+			return getEnumTypeFromAst(ctx, n)
+		case defines.MYSQL_TYPE_VECTOR:
+			dim := n.InternalType.DisplayWith
+			if dim < 1 || dim > types.MaxArrayDimension {
+				return nil, moerr.NewOutOfRange(ctx, "vector", " dimension is out of range [1, %d]", types.MaxArrayDimension)
+			}
+			return &plan.Type{Id: int32(types.T_array_float32), Width: dim}, nil
 		default:
 			return nil, moerr.NewNYI(ctx, "data type: '%s'", tree.String(&n.InternalType, dialect.MYSQL))
 		}
@@ -168,6 +188,35 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 	return nil, moerr.NewInternalError(ctx, "unknown data type")
 }
 
+// getEnumTypeFromAst checks the members of enum and set, the trailing spaces
+// of the members are removed like mysql.
+func getEnumTypeFromAst(ctx context.Context, n *tree.T) (*plan.Type, error) {
+	typ := &plan.Type{Id: int32(types.T_enum)}
+	limit := types.MaxEnumLen
+	if defines.MysqlType(n.InternalType.Oid) == defines.MYSQL_TYPE_SET {
+		typ.Id = int32(types.T_set)
+		limit = types.MaxSetLen
+	}
+	values := make([]string, len(n.InternalType.EnumValues))
+	if len(values) > limit {
+		return nil, moerr.NewInvalidInput(ctx, "too many members in %s, the limit is %d", n.InternalType.FamilyString, limit)
+	}
+	for i, v := range n.InternalType.EnumValues {
+		v = strings.TrimRight(v, " ")
+		if typ.Id == int32(types.T_set) && strings.Contains(v, ",") {
+			return nil, moerr.NewInvalidInput(ctx, "illegal set '%s' value found during parsing", v)
+		}
+		for _, w := range values[:i] {
+			if strings.EqualFold(v, w) {
+				return nil, moerr.NewInvalidInput(ctx, "column has duplicated value '%s' in %s", v, n.InternalType.FamilyString)
+			}
+		}
+		values[i] = v
+	}
+	typ.Enumvalues = types.FormatEnumValues(values)
+	return typ, nil
+}
+
 func buildDefaultExpr(col *tree.ColumnTableDef, typ *plan.Type, proc *process.Process) (*plan.Default, error) {
 	nullAbility := true
 	var expr tree.Expr = nil
@@ -0,0 +1,300 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// HistogramBuckets is the number of the buckets of the histograms built by
+// ANALYZE TABLE.
+const HistogramBuckets = 64
+
+// ColumnStats are the statistics of a column collected by ANALYZE TABLE.
+type ColumnStats struct {
+	// Rows is the number of the rows of the table when it is analyzed
+	Rows      float64
+	NDV       float64
+	NullCount float64
+	// Histogram are the bounds of the equi-depth buckets of the non-null
+	// values, only the numeric and date columns have histograms.
+	Histogram []float64
+	// MCV are the most common values in the descending order of frequency.
+	MCV []MCV
+}
+
+// MCV is a most common value of a column, Freq is the fraction of the rows
+// of the table with the value.
+type MCV struct {
+	Value string  `json:"value"`
+	Freq  float64 `json:"freq"`
+}
+
+// BuildHistogram returns the bounds of the equi-depth histogram of the values,
+// the values are sorted in place.
+func BuildHistogram(vals []float64, buckets int) []float64 {
+	if len(vals) == 0 || buckets <= 0 {
+		return nil
+	}
+	sort.Float64s(vals)
+	if len(vals) <= buckets {
+		buckets = len(vals) - 1
+		if buckets == 0 {
+			return []float64{vals[0], vals[0]}
+		}
+	}
+	bounds := make([]float64, buckets+1)
+	for i := range bounds {
+		bounds[i] = vals[i*(len(vals)-1)/buckets]
+	}
+	return bounds
+}
+
+// StatsValueString returns the string a constant is compared with the most
+// common values as, it returns false if the constant can't be compared.
+func StatsValueString(c *plan.Const) (string, bool) {
+	switch v := c.Value.(type) {
+	case *plan.Const_I8Val:
+		return fmt.Sprint(v.I8Val), true
+	case *plan.Const_I16Val:
+		return fmt.Sprint(v.I16Val), true
+	case *plan.Const_I32Val:
+		return fmt.Sprint(v.I32Val), true
+	case *plan.Const_I64Val:
+		return fmt.Sprint(v.I64Val), true
+	case *plan.Const_U8Val:
+		return fmt.Sprint(v.U8Val), true
+	case *plan.Const_U16Val:
+		return fmt.Sprint(v.U16Val), true
+	case *plan.Const_U32Val:
+		return fmt.Sprint(v.U32Val), true
+	case *plan.Const_U64Val:
+		return fmt.Sprint(v.U64Val), true
+	case *plan.Const_Fval:
+		return fmt.Sprint(v.Fval), true
+	case *plan.Const_Dval:
+		return fmt.Sprint(v.Dval), true
+	case *plan.Const_Sval:
+		return v.Sval, true
+	case *plan.Const_Dateval:
+		return types.Date(v.Dateval).String(), true
+	}
+	return "", false
+}
+
+// statsValueFloat returns the value of a constant on the axis of histograms.
+func statsValueFloat(c *plan.Const) (float64, bool) {
+	switch v := c.Value.(type) {
+	case *plan.Const_I8Val:
+		return float64(v.I8Val), true
+	case *plan.Const_I16Val:
+		return float64(v.I16Val), true
+	case *plan.Const_I32Val:
+		return float64(v.I32Val), true
+	case *plan.Const_I64Val:
+		return float64(v.I64Val), true
+	case *plan.Const_U8Val:
+		return float64(v.U8Val), true
+	case *plan.Const_U16Val:
+		return float64(v.U16Val), true
+	case *plan.Const_U32Val:
+		return float64(v.U32Val), true
+	case *plan.Const_U64Val:
+		return float64(v.U64Val), true
+	case *plan.Const_Fval:
+		return float64(v.Fval), true
+	case *plan.Const_Dval:
+		return v.Dval, true
+	case *plan.Const_Dateval:
+		return float64(v.Dateval), true
+	}
+	return 0, false
+}
+
+func (cs *ColumnStats) nullFrac() float64 {
+	if cs.Rows <= 0 {
+		return 0
+	}
+	return math.Min(cs.NullCount/cs.Rows, 1)
+}
+
+// minSelectivity is the selectivity of a value not found in the table, it is
+// not 0 since the statistics may be stale.
+func (cs *ColumnStats) minSelectivity() float64 {
+	if cs.Rows <= 1 {
+		return 1
+	}
+	return 1 / cs.Rows
+}
+
+// equalSelectivity returns the fraction of the rows equal to the value, the
+// values other than the most common ones are assumed uniformly distributed.
+func (cs *ColumnStats) equalSelectivity(val string) float64 {
+	mcvFreq := 0.0
+	for _, mcv := range cs.MCV {
+		if mcv.Value == val {
+			return mcv.Freq
+		}
+		mcvFreq += mcv.Freq
+	}
+	rest := 1 - mcvFreq - cs.nullFrac()
+	ndv := cs.NDV - float64(len(cs.MCV))
+	if rest <= 0 || ndv < 1 {
+		return cs.minSelectivity()
+	}
+	return math.Max(rest/ndv, cs.minSelectivity())
+}
+
+// rangeSelectivity returns the fraction of the rows in the range by the
+// histogram, it returns false if the column has no histogram.
+func (cs *ColumnStats) rangeSelectivity(funcName string, val float64) (float64, bool) {
+	bounds := cs.Histogram
+	if len(bounds) < 2 {
+		return 0, false
+	}
+	var less float64 // the fraction of the non-null values less than val
+	buckets := float64(len(bounds) - 1)
+	switch {
+	case val <= bounds[0]:
+		less = 0
+	case val > bounds[len(bounds)-1]:
+		less = 1
+	default:
+		i := sort.SearchFloat64s(bounds, val)
+		lo, hi := bounds[i-1], bounds[i]
+		less = float64(i - 1)
+		if hi > lo {
+			less += (val - lo) / (hi - lo)
+		}
+		less /= buckets
+	}
+	var sel float64
+	switch funcName {
+	case "<", "<=":
+		sel = less
+	case ">", ">=":
+		sel = 1 - less
+	default:
+		return 0, false
+	}
+	return math.Max(sel*(1-cs.nullFrac()), cs.minSelectivity()), true
+}
+
+// estimateSelectivityByColumnStats estimates the selectivity of col=const and
+// col<const etc. by the statistics collected by ANALYZE TABLE.
+func estimateSelectivityByColumnStats(expr *plan.Expr, s *StatsInfoMap) (float64, bool) {
+	if len(s.ColumnStats) == 0 {
+		return 0, false
+	}
+	ret, col, constExpr, funcName := CheckStrictFilter(expr)
+	if !ret || constExpr.Isnull {
+		return 0, false
+	}
+	cs, ok := s.ColumnStats[col.Name]
+	if !ok {
+		return 0, false
+	}
+	switch funcName {
+	case "=":
+		if val, ok := StatsValueString(constExpr); ok {
+			return cs.equalSelectivity(val), true
+		}
+	case ">", "<", ">=", "<=":
+		if val, ok := statsValueFloat(constExpr); ok {
+			return cs.rangeSelectivity(funcName, val)
+		}
+	}
+	return 0, false
+}
+
+// ApplyColumnStats overrides the ndv estimated by the zonemaps with the ndv
+// collected by ANALYZE TABLE.
+func (sc *StatsInfoMap) ApplyColumnStats() {
+	for name, cs := range sc.ColumnStats {
+		if cs.NDV > 0 {
+			sc.NdvMap[name] = cs.NDV
+		}
+	}
+}
+
+// getColStats returns the statistics of the column of a table scanned in the
+// subtree of the node, it returns nil if the column is not analyzed.
+func getColStats(col *plan.ColRef, nodeID int32, builder *QueryBuilder) *ColumnStats {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		if len(node.BindingTags) == 0 || node.BindingTags[0] != col.RelPos || node.TableDef == nil {
+			return nil
+		}
+		if int(col.ColPos) >= len(node.TableDef.Cols) {
+			return nil
+		}
+		sc := builder.compCtx.GetStatsCache()
+		if sc == nil {
+			return nil
+		}
+		return sc.GetStatsInfoMap(node.TableDef.TblId).ColumnStats[node.TableDef.Cols[col.ColPos].Name]
+	}
+	for _, child := range node.Children {
+		if cs := getColStats(col, child, builder); cs != nil {
+			return cs
+		}
+	}
+	return nil
+}
+
+// estimateJoinNdv returns the ndv of the join keys of the equi join by the
+// statistics collected by ANALYZE TABLE, the ndv of a side is at most its
+// rows. It returns false unless both sides of a condition are analyzed.
+func estimateJoinNdv(node *plan.Node, builder *QueryBuilder, leftStats, rightStats *plan.Stats) (float64, float64, bool) {
+	var leftNdv, rightNdv float64
+	found := false
+	leftTags := make(map[int32]bool)
+	for _, tag := range builder.enumerateTags(node.Children[0]) {
+		leftTags[tag] = true
+	}
+	for _, expr := range node.OnList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.ObjName != "=" {
+			continue
+		}
+		c1, ok1 := f.F.Args[0].Expr.(*plan.Expr_Col)
+		c2, ok2 := f.F.Args[1].Expr.(*plan.Expr_Col)
+		if !ok1 || !ok2 {
+			continue
+		}
+		left, right := c1.Col, c2.Col
+		if !leftTags[left.RelPos] {
+			left, right = right, left
+		}
+		ls := getColStats(left, node.Children[0], builder)
+		rs := getColStats(right, node.Children[1], builder)
+		if ls == nil || rs == nil {
+			continue
+		}
+		l := math.Max(math.Min(ls.NDV, leftStats.Outcnt), 1)
+		r := math.Max(math.Min(rs.NDV, rightStats.Outcnt), 1)
+		// the most selective condition is used
+		if !found || math.Max(l, r) > math.Max(leftNdv, rightNdv) {
+			leftNdv, rightNdv = l, r
+		}
+		found = true
+	}
+	return leftNdv, rightNdv, found
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func makeColFilter(funcName string, colName string, val int64) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: funcName},
+				Args: []*plan.Expr{
+					{Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: colName}}},
+					{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: val}}}},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildHistogram(t *testing.T) {
+	vals := make([]float64, 0, 1000)
+	for i := 999; i >= 0; i-- {
+		vals = append(vals, float64(i))
+	}
+	bounds := BuildHistogram(vals, 10)
+	require.Equal(t, 11, len(bounds))
+	require.Equal(t, float64(0), bounds[0])
+	require.Equal(t, float64(999), bounds[10])
+	require.Equal(t, float64(499), bounds[5])
+
+	require.Equal(t, []float64{1, 2, 3}, BuildHistogram([]float64{3, 1, 2}, 10))
+	require.Equal(t, []float64{1, 1}, BuildHistogram([]float64{1}, 10))
+	require.Nil(t, BuildHistogram(nil, 10))
+}
+
+func TestColumnStatsSelectivity(t *testing.T) {
+	vals := make([]float64, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		vals = append(vals, float64(i))
+	}
+	s := NewStatsInfoMap()
+	s.TableCnt = 1000
+	s.NdvMap["a"] = 10
+	s.ColumnStats = map[string]*ColumnStats{
+		"a": {
+			Rows:      1000,
+			NDV:       101,
+			NullCount: 100,
+			Histogram: BuildHistogram(vals[:900], HistogramBuckets),
+			MCV:       []MCV{{Value: "7", Freq: 0.1}},
+		},
+	}
+	s.ApplyColumnStats()
+	require.Equal(t, float64(101), s.NdvMap["a"])
+
+	// the most common value
+	require.InDelta(t, 0.1, estimateExprSelectivity(makeColFilter("=", "a", 7), s), 1e-9)
+	// the other values share the rows not null and not the most common
+	require.InDelta(t, 0.8/100, estimateExprSelectivity(makeColFilter("=", "a", 8), s), 1e-9)
+	// the range is estimated by the histogram of the non-null values
+	require.InDelta(t, 0.45, estimateExprSelectivity(makeColFilter("<", "a", 450), s), 0.01)
+	require.InDelta(t, 0.9*0.9, estimateExprSelectivity(makeColFilter(">=", "a", 90), s), 0.01)
+	require.InDelta(t, 0.001, estimateExprSelectivity(makeColFilter(">", "a", 5000), s), 1e-9)
+	require.InDelta(t, 0.9, estimateExprSelectivity(makeColFilter(">", "a", -1), s), 1e-9)
+
+	// the columns not analyzed fall back to the zonemaps
+	s.NdvMap["b"] = 10
+	require.InDelta(t, 0.1, estimateExprSelectivity(makeColFilter("=", "b", 1), s), 1e-9)
+}
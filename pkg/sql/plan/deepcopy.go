@@ -41,6 +41,27 @@ func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	}
 }
 
+func DeepCopyFrameClause(frame *plan.FrameClause) *plan.FrameClause {
+	if frame == nil {
+		return nil
+	}
+	return &plan.FrameClause{
+		Type:  frame.Type,
+		Start: DeepCopyFrameBound(frame.Start),
+		End:   DeepCopyFrameBound(frame.End),
+	}
+}
+
+func DeepCopyFrameBound(bound *plan.FrameBound) *plan.FrameBound {
+	if bound == nil {
+		return nil
+	}
+	return &plan.FrameBound{
+		Type: bound.Type,
+		Val:  DeepCopyExpr(bound.Val),
+	}
+}
+
 func DeepCopyObjectRef(ref *plan.ObjectRef) *plan.ObjectRef {
 	if ref == nil {
 		return nil
@@ -108,6 +129,9 @@ func DeepCopyDeleteCtx(ctx *plan.DeleteCtx) *plan.DeleteCtx {
 		Ref:    make([]*plan.ObjectRef, len(ctx.Ref)),
 		IdxRef: make([]*plan.ObjectRef, len(ctx.IdxRef)),
 
+		PkIdxRef: make([]*plan.ObjectRef, len(ctx.PkIdxRef)),
+		PkIdxIdx: make([]int32, len(ctx.PkIdxIdx)),
+
 		OnRestrictRef: make([]*plan.ObjectRef, len(ctx.OnRestrictRef)),
 
 		OnCascadeRef: make([]*plan.ObjectRef, len(ctx.OnCascadeRef)),
@@ -129,6 +153,10 @@ func DeepCopyDeleteCtx(ctx *plan.DeleteCtx) *plan.DeleteCtx {
 	for i, ref := range ctx.IdxRef {
 		newCtx.IdxRef[i] = DeepCopyObjectRef(ref)
 	}
+	for i, ref := range ctx.PkIdxRef {
+		newCtx.PkIdxRef[i] = DeepCopyObjectRef(ref)
+	}
+	copy(newCtx.PkIdxIdx, ctx.PkIdxIdx)
 	for i, ref := range ctx.OnRestrictRef {
 		newCtx.OnRestrictRef[i] = DeepCopyObjectRef(ref)
 	}
@@ -180,6 +208,9 @@ func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 		IdxRef: make([]*plan.ObjectRef, len(ctx.IdxRef)),
 		IdxIdx: make([]int32, len(ctx.IdxIdx)),
 
+		PkIdxRef: make([]*plan.ObjectRef, len(ctx.PkIdxRef)),
+		PkIdxIdx: make([]int32, len(ctx.PkIdxIdx)),
+
 		OnRestrictRef: make([]*plan.ObjectRef, len(ctx.OnRestrictRef)),
 		OnRestrictIdx: make([]int32, len(ctx.OnRestrictIdx)),
 
@@ -223,6 +254,11 @@ func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 	}
 	copy(newCtx.IdxIdx, ctx.IdxIdx)
 
+	for i, ref := range ctx.PkIdxRef {
+		newCtx.PkIdxRef[i] = DeepCopyObjectRef(ref)
+	}
+	copy(newCtx.PkIdxIdx, ctx.PkIdxIdx)
+
 	for i, ref := range ctx.OnRestrictRef {
 		newCtx.OnRestrictRef[i] = DeepCopyObjectRef(ref)
 	}
@@ -310,11 +346,21 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		NotCacheable:    node.NotCacheable,
 		CurrentStep:     node.CurrentStep,
 		SourceStep:      node.SourceStep,
+		WindowIdx:       node.WindowIdx,
+		UnionAll:        node.UnionAll,
+
+		MaxRecursionDepth: node.MaxRecursionDepth,
+		LockTargets:       make([]*plan.LockTarget, len(node.LockTargets)),
 	}
 
 	copy(newNode.Children, node.Children)
 	copy(newNode.BindingTags, node.BindingTags)
 
+	if node.ScanSnapshot != nil {
+		snapshot := *node.ScanSnapshot
+		newNode.ScanSnapshot = &snapshot
+	}
+
 	for idx, expr := range node.ProjectList {
 		newNode.ProjectList[idx] = DeepCopyExpr(expr)
 	}
@@ -351,6 +397,10 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		newNode.TableDefVec[i] = DeepCopyTableDef(tbl)
 	}
 
+	for i, target := range node.LockTargets {
+		newNode.LockTargets[i] = DeepCopyLockTarget(target)
+	}
+
 	if node.Stats != nil {
 		newNode.Stats = &plan.Stats{
 			BlockNum:    node.Stats.BlockNum,
@@ -369,6 +419,8 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 			OrderBy:     make([]*plan.OrderBySpec, len(node.WinSpec.OrderBy)),
 			Lead:        node.WinSpec.Lead,
 			Lag:         node.WinSpec.Lag,
+			WindowFunc:  DeepCopyExpr(node.WinSpec.WindowFunc),
+			Frame:       DeepCopyFrameClause(node.WinSpec.Frame),
 		}
 		for idx, pb := range node.WinSpec.PartitionBy {
 			newNode.WinSpec.PartitionBy[idx] = DeepCopyExpr(pb)
@@ -398,6 +450,22 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 	return newNode
 }
 
+func DeepCopyLockTarget(target *plan.LockTarget) *plan.LockTarget {
+	if target == nil {
+		return nil
+	}
+	return &plan.LockTarget{
+		TableId:            target.TableId,
+		PrimaryColIdxInBat: target.PrimaryColIdxInBat,
+		PrimaryColRelPos:   target.PrimaryColRelPos,
+		PrimaryColTyp:      DeepCopyType(target.PrimaryColTyp),
+		RefreshTsIdxInBat:  target.RefreshTsIdxInBat,
+		Shared:             target.Shared,
+		NoWait:             target.NoWait,
+		SkipLocked:         target.SkipLocked,
+	}
+}
+
 func DeepCopyDefault(def *plan.Default) *plan.Default {
 	if def == nil {
 		return nil
@@ -406,6 +474,8 @@ func DeepCopyDefault(def *plan.Default) *plan.Default {
 		NullAbility:  def.NullAbility,
 		Expr:         DeepCopyExpr(def.Expr),
 		OriginString: def.OriginString,
+		FillValue:    append([]byte(nil), def.FillValue...),
+		HasFillValue: def.HasFillValue,
 	}
 }
 
@@ -419,6 +489,7 @@ func DeepCopyType(typ *plan.Type) *plan.Type {
 		Width:       typ.Width,
 		Scale:       typ.Scale,
 		AutoIncr:    typ.AutoIncr,
+		Enumvalues:  typ.Enumvalues,
 	}
 }
 
@@ -463,12 +534,15 @@ func DeepCopyIndexDef(indexDef *plan.IndexDef) *plan.IndexDef {
 		return nil
 	}
 	newindexDef := &plan.IndexDef{
-		IdxId:          indexDef.IdxId,
-		IndexName:      indexDef.IndexName,
-		Unique:         indexDef.Unique,
-		TableExist:     indexDef.TableExist,
-		IndexTableName: indexDef.IndexTableName,
-		Comment:        indexDef.Comment,
+		IdxId:           indexDef.IdxId,
+		IndexName:       indexDef.IndexName,
+		Unique:          indexDef.Unique,
+		TableExist:      indexDef.TableExist,
+		IndexTableName:  indexDef.IndexTableName,
+		Comment:         indexDef.Comment,
+		IndexAlgo:       indexDef.IndexAlgo,
+		IndexAlgoParams: indexDef.IndexAlgoParams,
+		IndexExpr:       DeepCopyExpr(indexDef.IndexExpr),
 	}
 
 	newParts := make([]string, len(indexDef.Parts))
@@ -757,6 +831,34 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 				AlterTable.Actions[i] = &plan.AlterTable_Action{
 					Action: AddFk,
 				}
+			case *plan.AlterTable_Action_AddColumn:
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddColumn{
+						AddColumn: &plan.AlterTableAddColumn{
+							Column:      DeepCopyColDef(act.AddColumn.Column),
+							First:       act.AddColumn.First,
+							AfterColumn: act.AddColumn.AfterColumn,
+						},
+					},
+				}
+			case *plan.AlterTable_Action_ModifyColumn:
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_ModifyColumn{
+						ModifyColumn: &plan.AlterTableModifyColumn{
+							OldName: act.ModifyColumn.OldName,
+							Column:  DeepCopyColDef(act.ModifyColumn.Column),
+						},
+					},
+				}
+			case *plan.AlterTable_Action_RenameColumn:
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_RenameColumn{
+						RenameColumn: &plan.AlterTableRenameColumn{
+							OldName: act.RenameColumn.OldName,
+							NewName: act.RenameColumn.NewName,
+						},
+					},
+				}
 			}
 		}
 
@@ -1045,6 +1147,8 @@ func DeepCopyAnalyzeInfo(analyzeinfo *plan.AnalyzeInfo) *plan.AnalyzeInfo {
 		NetworkIO:        analyzeinfo.GetNetworkIO(),
 		ScanTime:         analyzeinfo.GetScanTime(),
 		InsertTime:       analyzeinfo.GetInsertTime(),
+		SpillCount:       analyzeinfo.GetSpillCount(),
+		SpillSize:        analyzeinfo.GetSpillSize(),
 	}
 }
 
@@ -70,6 +70,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 		pname = "Sink"
 	case plan.Node_SINK_SCAN:
 		pname = "Sink Scan"
+	case plan.Node_RECURSIVE_SCAN:
+		pname = "Recursive Scan"
 	case plan.Node_AGG:
 		pname = "Aggregate"
 	case plan.Node_DISTINCT:
@@ -112,6 +114,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 		pname = "Minus"
 	case plan.Node_MINUS_ALL:
 		pname = "Minus All"
+	case plan.Node_LOCK_OP:
+		pname = "Lock"
 	case plan.Node_FUNCTION_SCAN:
 		pname = "Table Function"
 	default:
@@ -124,7 +128,7 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 		switch ndesc.Node.NodeType {
 		case plan.Node_VALUE_SCAN:
 			buf.WriteString(" \"*VALUES*\" ")
-		case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT:
+		case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_RECURSIVE_SCAN, plan.Node_INSERT:
 			buf.WriteString(" on ")
 			if ndesc.Node.ObjRef != nil {
 				buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName())
@@ -269,6 +273,17 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window function info
+	if ndesc.Node.NodeType == plan.Node_WINDOW && ndesc.Node.WinSpec != nil {
+		buf := bytes.NewBuffer(make([]byte, 0, 300))
+		winSpecDescImpl := &WinSpecDescribeImpl{WinSpec: ndesc.Node.WinSpec}
+		err := winSpecDescImpl.GetDescription(ctx, options, buf)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, buf.String())
+	}
+
 	// Get Filter list info
 	if len(ndesc.Node.FilterList) > 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(ctx, options)
@@ -510,6 +525,17 @@ func (a AnalyzeInfoDescribeImpl) GetDescription(ctx context.Context, options *Ex
 		fmt.Fprintf(buf, " MemorySize=%dgb", a.AnalyzeInfo.MemorySize/GB)
 	}
 
+	if a.AnalyzeInfo.SpillCount > 0 {
+		fmt.Fprintf(buf, " spillCount=%d", a.AnalyzeInfo.SpillCount)
+		if a.AnalyzeInfo.SpillSize < MB {
+			fmt.Fprintf(buf, " spillSize=%dbytes", a.AnalyzeInfo.SpillSize)
+		} else if a.AnalyzeInfo.SpillSize < 10*GB {
+			fmt.Fprintf(buf, " spillSize=%dmb", a.AnalyzeInfo.SpillSize/MB)
+		} else {
+			fmt.Fprintf(buf, " spillSize=%dgb", a.AnalyzeInfo.SpillSize/GB)
+		}
+	}
+
 	return nil
 }
 
@@ -609,8 +635,62 @@ type WinSpecDescribeImpl struct {
 }
 
 func (w *WinSpecDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions, buf *bytes.Buffer) error {
-	// TODO implement me
-	panic("implement me")
+	if options.Format == EXPLAIN_FORMAT_JSON {
+		return moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return moerr.NewNYI(ctx, "explain format dot")
+	}
+
+	buf.WriteString("Window Function: ")
+	err := describeExpr(ctx, w.WinSpec.WindowFunc, options, buf)
+	if err != nil {
+		return err
+	}
+
+	if len(w.WinSpec.PartitionBy) > 0 {
+		buf.WriteString(", Partition By: ")
+		err = NewExprListDescribeImpl(w.WinSpec.PartitionBy).GetDescription(ctx, options, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(w.WinSpec.OrderBy) > 0 {
+		buf.WriteString(", Order By: ")
+		err = NewOrderByDescribeImpl(w.WinSpec.OrderBy).GetDescription(ctx, options, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	if frame := w.WinSpec.Frame; frame != nil {
+		buf.WriteString(", Frame: " + frame.Type.String() + " BETWEEN ")
+		if err = describeFrameBound(ctx, frame.Start, options, buf); err != nil {
+			return err
+		}
+		buf.WriteString(" AND ")
+		if err = describeFrameBound(ctx, frame.End, options, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func describeFrameBound(ctx context.Context, bound *plan.FrameBound, options *ExplainOptions, buf *bytes.Buffer) error {
+	switch bound.Type {
+	case plan.FrameBound_UNBOUNDED_PRECEDING:
+		buf.WriteString("UNBOUNDED PRECEDING")
+	case plan.FrameBound_UNBOUNDED_FOLLOWING:
+		buf.WriteString("UNBOUNDED FOLLOWING")
+	case plan.FrameBound_CURRENT_ROW:
+		buf.WriteString("CURRENT ROW")
+	default:
+		if err := describeExpr(ctx, bound.Val, options, buf); err != nil {
+			return err
+		}
+		buf.WriteString(" " + bound.Type.String())
+	}
+	return nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -78,6 +78,7 @@ func TestSingleTableQuery(t *testing.T) {
 		"explain SELECT * FROM NATION",                                                                             //test star
 		"explain verbose SELECT a.* FROM NATION a",                                                                 //test star
 		"explain SELECT a.* FROM NATION a",                                                                         //test star
+		"explain verbose SELECT * FROM NATION WHERE N_REGIONKEY > 0 FOR UPDATE SKIP LOCKED",                        //test locking read
 		"explain verbose SELECT count(*) FROM NATION",                                                              //test star
 		"explain SELECT count(*) FROM NATION",                                                                      //test star
 		"explain verbose SELECT count(*) FROM NATION group by N_NAME",                                              //test star
@@ -201,6 +202,18 @@ func TestCollectionQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+// Window function query
+func TestWindowQuery(t *testing.T) {
+	sqls := []string{
+		"explain verbose select n_name, row_number() over (partition by n_regionkey order by n_nationkey) from nation",
+		"explain verbose select n_name, lag(n_name, 1, 'none') over (order by n_nationkey desc) from nation",
+		"explain verbose select sum(n_nationkey) over (order by n_nationkey rows between 1 preceding and 1 following) from nation",
+		"explain verbose select avg(n_nationkey) over (order by n_nationkey range between 2 preceding and current row) from nation",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
 func TestDMLInsert(t *testing.T) {
 	sqls := []string{
 		//"explain INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
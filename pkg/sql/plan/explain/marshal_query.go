@@ -121,6 +121,8 @@ func (m MarshalNodeImpl) GetNodeName(ctx context.Context) (string, error) {
 		name = "Sink"
 	case plan.Node_SINK_SCAN:
 		name = "Sink Scan"
+	case plan.Node_RECURSIVE_SCAN:
+		name = "Recursive Scan"
 	case plan.Node_AGG:
 		name = "Aggregate"
 	case plan.Node_DISTINCT:
@@ -163,6 +165,8 @@ func (m MarshalNodeImpl) GetNodeName(ctx context.Context) (string, error) {
 		name = "Minus"
 	case plan.Node_MINUS_ALL:
 		name = "Minus All"
+	case plan.Node_LOCK_OP:
+		name = "Lock"
 	default:
 		return name, moerr.NewInternalError(ctx, "Unsupported node type when plan is serialized to json")
 	}
@@ -592,6 +596,8 @@ const S3IOByte = "S3 IO Byte"
 const S3IOInputCount = "S3 IO Input Count"
 const S3IOOutputCount = "S3 IO Output Count"
 const Network = "Network"
+const SpillCount = "Spill Count"
+const SpillSize = "Spill Size"
 
 func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOptions) Statistics {
 	statistics := NewStatistics()
@@ -648,6 +654,16 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 				Value: analyzeInfo.MemorySize,
 				Unit:  "byte",
 			},
+			{
+				Name:  SpillCount,
+				Value: analyzeInfo.SpillCount,
+				Unit:  "count",
+			},
+			{
+				Name:  SpillSize,
+				Value: analyzeInfo.SpillSize,
+				Unit:  "byte",
+			},
 		}
 
 		io := []StatisticValue{
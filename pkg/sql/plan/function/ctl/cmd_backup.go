@@ -0,0 +1,48 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// handleBackup copies the data of the DN to the target in parameter, which
+// is a local dir or an ETL path like "s3-opts,...:/prefix".
+func handleBackup() handleFunc {
+	return getDNHandlerFunc(
+		pb.CmdMethod_Backup,
+		func(_ string) ([]uint64, error) {
+			return nil, nil
+		},
+		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
+			if parameter == "" {
+				return nil, moerr.NewInvalidInput(proc.Ctx, "backup target is required")
+			}
+			return types.Encode(&db.Backup{
+				Target: parameter,
+			})
+		},
+		func(data []byte) (interface{}, error) {
+			resp := &db.BackupResp{}
+			if err := types.Decode(data, resp); err != nil {
+				return nil, err
+			}
+			return resp, nil
+		})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/functionUtil"
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/datediff"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/floor"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/format"
@@ -2634,3 +2635,42 @@ func SplitSingle(str, sep string, cnt uint32) (string, bool) {
 	}
 	return strSlice[cnt-1], false
 }
+
+func L2Distance(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(ivecs, result, proc, length, vectorindex.L2Distance)
+}
+
+func CosineDistance(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(ivecs, result, proc, length, vectorindex.CosineDistance)
+}
+
+func InnerProduct(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(ivecs, result, proc, length, vectorindex.InnerProduct)
+}
+
+// vectorDistance computes the distance between the vectors of the same
+// dimension. The result is null if the distance is undefined, e.g. the cosine
+// distance to a zero vector.
+func vectorDistance(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, fn vectorindex.DistanceFunc) error {
+	p1 := vector.GenerateFunctionStrParameter(ivecs[0])
+	p2 := vector.GenerateFunctionStrParameter(ivecs[1])
+	rs := vector.MustFunctionResult[float64](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v1, null1 := p1.GetStrValue(i)
+		v2, null2 := p2.GetStrValue(i)
+		if null1 || null2 {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(v1) != len(v2) {
+			return moerr.NewInvalidInput(proc.Ctx, "different vector dimensions %d and %d", len(v1)/4, len(v2)/4)
+		}
+		d := fn(types.BytesToArrayF32(v1), types.BytesToArrayF32(v2))
+		if err := rs.Append(d, math.IsNaN(d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
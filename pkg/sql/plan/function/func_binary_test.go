@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -2691,3 +2692,54 @@ func TestSplitPart(t *testing.T) {
 		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
 	}
 }
+
+func TestVectorDistance(t *testing.T) {
+	vec := func(fs ...float32) string {
+		return string(types.ArrayF32ToBytes(fs))
+	}
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_array_float32.ToType(),
+			[]string{vec(1, 2, 3), vec(1, 0), vec(0, 0), ""}, []bool{false, false, false, true}),
+		testutil.NewFunctionTestInput(types.T_array_float32.ToType(),
+			[]string{vec(4, 6, 3), vec(0, 2), vec(1, 1), vec(1, 1)}, []bool{false, false, false, false}),
+	}
+	testCases := []struct {
+		info   string
+		fn     func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error
+		expect testutil.FunctionTestResult
+	}{
+		{
+			info: "l2_distance",
+			fn:   L2Distance,
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{5, math.Sqrt(5), math.Sqrt(2), 0}, []bool{false, false, false, true}),
+		},
+		{
+			info: "cosine_distance",
+			fn:   CosineDistance,
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{1 - 25/(math.Sqrt(14)*math.Sqrt(61)), 1, 0, 0}, []bool{false, false, true, true}),
+		},
+		{
+			info: "inner_product",
+			fn:   InnerProduct,
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{25, 0, 0, 0}, []bool{false, false, false, true}),
+		},
+	}
+
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := testutil.NewFunctionTestCase(proc, inputs, tc.expect, tc.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+
+	// different dimensions
+	fcTC := testutil.NewFunctionTestCase(proc, []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_array_float32.ToType(), []string{vec(1, 2)}, []bool{false}),
+		testutil.NewFunctionTestInput(types.T_array_float32.ToType(), []string{vec(1)}, []bool{false}),
+	}, testutil.NewFunctionTestResult(types.T_float64.ToType(), true, []float64{0}, []bool{false}), L2Distance)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+}
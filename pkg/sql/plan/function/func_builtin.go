@@ -131,6 +131,10 @@ func builtInMoShowVisibleBin(parameters []*vector.Vector, result vector.Function
 			if err != nil {
 				return nil, err
 			}
+			// the members of enum and set are not in the type.
+			if typ.Oid == types.T_enum || typ.Oid == types.T_set {
+				return functionUtil.QuickStrToBytes(typ.String()), nil
+			}
 			ret := fmt.Sprintf("%s(%d)", typ.String(), typ.Width)
 			return functionUtil.QuickStrToBytes(ret), nil
 		}
@@ -457,6 +461,22 @@ func builtInCurrentAccountName(_ []*vector.Vector, result vector.FunctionResultW
 	return nil
 }
 
+// builtInCurrentSnapshot returns the snapshot timestamp of the current
+// transaction, which can be used as the session variable snapshot_ts.
+func builtInCurrentSnapshot(_ []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	if proc.TxnOperator == nil {
+		return moerr.NewInternalError(proc.Ctx, "current_snapshot: txn operator is nil")
+	}
+	snapshot := []byte(proc.TxnOperator.Txn().SnapshotTS.DebugString())
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		if err := rs.AppendBytes(snapshot, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func builtInCurrentRoleID(_ []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
 	rs := vector.MustFunctionResult[uint32](result)
 	for i := uint64(0); i < uint64(length); i++ {
@@ -0,0 +1,726 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the kinds of the arguments of the json functions.
+type jsonArgKind int
+
+const (
+	// a json document, which is a json or a string holding a json text.
+	jsonArgDoc jsonArgKind = iota
+	// a string, such as a path or a member name.
+	jsonArgString
+	// a sql value which is converted to a json value.
+	jsonArgValue
+)
+
+// jsonArgsCheck casts the arguments to the types the json functions read,
+// kindOf tells the kind of the i-th argument.
+func jsonArgsCheck(inputs []types.Type, kindOf func(i int) jsonArgKind) checkResult {
+	ts := make([]types.Type, len(inputs))
+	needCast := false
+	for i, input := range inputs {
+		ts[i] = input
+		target := types.T_varchar
+		switch kindOf(i) {
+		case jsonArgDoc:
+			if input.Oid == types.T_json || input.Oid.IsMySQLString() {
+				continue
+			}
+		case jsonArgString:
+			if input.Oid.IsMySQLString() {
+				continue
+			}
+		case jsonArgValue:
+			switch input.Oid {
+			case types.T_json, types.T_char, types.T_varchar, types.T_text, types.T_bool,
+				types.T_int64, types.T_uint64, types.T_float64:
+				continue
+			case types.T_int8, types.T_int16, types.T_int32:
+				target = types.T_int64
+			case types.T_uint8, types.T_uint16, types.T_uint32:
+				target = types.T_uint64
+			case types.T_float32, types.T_decimal64, types.T_decimal128:
+				target = types.T_float64
+			}
+		}
+		if input.Oid != types.T_any && target == types.T_varchar {
+			if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); !canCast {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+		}
+		ts[i] = target.ToType()
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, ts)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+func jsonObjectCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs)%2 != 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i%2 == 0 {
+			return jsonArgString
+		}
+		return jsonArgValue
+	})
+}
+
+func jsonArrayCheckFn(_ []overload, inputs []types.Type) checkResult {
+	return jsonArgsCheck(inputs, func(int) jsonArgKind {
+		return jsonArgValue
+	})
+}
+
+// json_set(doc, path, val[, path, val] ...)
+func jsonModifyCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 || len(inputs)%2 == 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		if i%2 == 1 {
+			return jsonArgString
+		}
+		return jsonArgValue
+	})
+}
+
+// json_remove(doc, path[, path] ...)
+func jsonRemoveCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		return jsonArgString
+	})
+}
+
+// json_merge_patch(doc, doc[, doc] ...)
+func jsonMergePatchCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(int) jsonArgKind {
+		return jsonArgDoc
+	})
+}
+
+// json_contains(target, candidate[, path])
+func jsonContainsCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 && len(inputs) != 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i < 2 {
+			return jsonArgDoc
+		}
+		return jsonArgString
+	})
+}
+
+// json_contains_path(doc, 'one' | 'all', path[, path] ...)
+func jsonContainsPathCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		return jsonArgString
+	})
+}
+
+// value member of(doc)
+func jsonMemberOfCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i == 0 {
+			return jsonArgValue
+		}
+		return jsonArgDoc
+	})
+}
+
+// json_keys(doc[, path]) and json_length(doc[, path])
+func jsonInspectCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 && len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) jsonArgKind {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		return jsonArgString
+	})
+}
+
+// json_type(doc) and json_valid(doc)
+func jsonUnaryCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(int) jsonArgKind {
+		return jsonArgDoc
+	})
+}
+
+// jsonGetter returns the i-th row of a parameter as a json value, and whether
+// it's null.
+type jsonGetter func(i uint64) (bytejson.ByteJson, bool, error)
+
+// newJsonDocGetter reads a json document, a string is parsed as a json text.
+func newJsonDocGetter(vec *vector.Vector) jsonGetter {
+	p := vector.GenerateFunctionStrParameter(vec)
+	isJson := vec.GetType().Oid == types.T_json
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := p.GetStrValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		if isJson {
+			return types.DecodeJson(v), false, nil
+		}
+		bj, err := types.ParseSliceToByteJson(v)
+		return bj, false, err
+	}
+}
+
+// newJsonValueGetter converts a sql value to a json value, a string becomes a
+// json string and a null becomes the json null.
+func newJsonValueGetter(vec *vector.Vector) jsonGetter {
+	switch vec.GetType().Oid {
+	case types.T_json:
+		return newJsonDocGetter(vec)
+	case types.T_bool:
+		return newJsonFixedGetter[bool](vec)
+	case types.T_int64:
+		return newJsonFixedGetter[int64](vec)
+	case types.T_uint64:
+		return newJsonFixedGetter[uint64](vec)
+	case types.T_float64:
+		return newJsonFixedGetter[float64](vec)
+	}
+	p := vector.GenerateFunctionStrParameter(vec)
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := p.GetStrValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		var bj bytejson.ByteJson
+		err := bj.UnmarshalObject(string(v))
+		return bj, false, err
+	}
+}
+
+func newJsonFixedGetter[T bool | int64 | uint64 | float64](vec *vector.Vector) jsonGetter {
+	p := vector.GenerateFunctionFixedTypeParameter[T](vec)
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := p.GetValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		var bj bytejson.ByteJson
+		err := bj.UnmarshalObject(v)
+		return bj, false, err
+	}
+}
+
+// jsonPathGetter returns the i-th row of a parameter as a json path.
+type jsonPathGetter func(i uint64) (*bytejson.Path, bool, error)
+
+func newJsonPathGetter(vec *vector.Vector) jsonPathGetter {
+	p := vector.GenerateFunctionStrParameter(vec)
+	return func(i uint64) (*bytejson.Path, bool, error) {
+		v, null := p.GetStrValue(i)
+		if null {
+			return nil, true, nil
+		}
+		path, err := types.ParseStringToPath(string(v))
+		return &path, false, err
+	}
+}
+
+func appendJsonResult(rs *vector.FunctionResult[types.Varlena], bj bytejson.ByteJson, null bool) error {
+	if null {
+		return rs.AppendBytes(nil, true)
+	}
+	dt, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	return rs.AppendBytes(dt, false)
+}
+
+// JSON_OBJECT
+
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	keyParams := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters)/2)
+	valGetters := make([]jsonGetter, len(parameters)/2)
+	for i := range keyParams {
+		keyParams[i] = vector.GenerateFunctionStrParameter(parameters[2*i])
+		valGetters[i] = newJsonValueGetter(parameters[2*i+1])
+	}
+	keys := make([]string, len(keyParams))
+	vals := make([]bytejson.ByteJson, len(keyParams))
+	for i := uint64(0); i < uint64(length); i++ {
+		for j := range keyParams {
+			key, null := keyParams[j].GetStrValue(i)
+			if null {
+				return moerr.NewInvalidInput(proc.Ctx, "json documents may not contain null member names")
+			}
+			val, _, err := valGetters[j](i)
+			if err != nil {
+				return err
+			}
+			keys[j], vals[j] = string(key), val
+		}
+		bj, err := bytejson.CreateObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = appendJsonResult(rs, bj, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_ARRAY
+
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	getters := make([]jsonGetter, len(parameters))
+	for i := range parameters {
+		getters[i] = newJsonValueGetter(parameters[i])
+	}
+	elems := make([]bytejson.ByteJson, len(getters))
+	for i := uint64(0); i < uint64(length); i++ {
+		for j := range getters {
+			elem, _, err := getters[j](i)
+			if err != nil {
+				return err
+			}
+			elems[j] = elem
+		}
+		if err := appendJsonResult(rs, bytejson.CreateArray(elems), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_SET, JSON_INSERT and JSON_REPLACE
+
+func JsonSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, length, bytejson.ModifySet)
+}
+
+func JsonInsert(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, length, bytejson.ModifyInsert)
+}
+
+func JsonReplace(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, length, bytejson.ModifyReplace)
+}
+
+func jsonModify(parameters []*vector.Vector, result vector.FunctionResultWrapper, length int, tp bytejson.ModifyType) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	doc := newJsonDocGetter(parameters[0])
+	pathGetters := make([]jsonPathGetter, len(parameters)/2)
+	valGetters := make([]jsonGetter, len(parameters)/2)
+	for i := range pathGetters {
+		pathGetters[i] = newJsonPathGetter(parameters[2*i+1])
+		valGetters[i] = newJsonValueGetter(parameters[2*i+2])
+	}
+	paths := make([]*bytejson.Path, len(pathGetters))
+	vals := make([]bytejson.ByteJson, len(valGetters))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := doc(i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < len(pathGetters) && !null; j++ {
+			if paths[j], null, err = pathGetters[j](i); err != nil {
+				return err
+			}
+			if vals[j], _, err = valGetters[j](i); err != nil {
+				return err
+			}
+		}
+		if !null {
+			if bj, err = bj.Modify(paths, vals, tp); err != nil {
+				return err
+			}
+		}
+		if err = appendJsonResult(rs, bj, null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_REMOVE
+
+func JsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	doc := newJsonDocGetter(parameters[0])
+	pathGetters := make([]jsonPathGetter, len(parameters)-1)
+	for i := range pathGetters {
+		pathGetters[i] = newJsonPathGetter(parameters[i+1])
+	}
+	paths := make([]*bytejson.Path, len(pathGetters))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := doc(i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < len(pathGetters) && !null; j++ {
+			if paths[j], null, err = pathGetters[j](i); err != nil {
+				return err
+			}
+		}
+		if !null {
+			if bj, err = bj.Remove(paths); err != nil {
+				return err
+			}
+		}
+		if err = appendJsonResult(rs, bj, null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_MERGE_PATCH
+
+func JsonMergePatch(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	docs := make([]jsonGetter, len(parameters))
+	for i := range parameters {
+		docs[i] = newJsonDocGetter(parameters[i])
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := docs[0](i)
+		if err != nil {
+			return err
+		}
+		for j := 1; j < len(docs) && !null; j++ {
+			var patch bytejson.ByteJson
+			if patch, null, err = docs[j](i); err != nil {
+				return err
+			}
+			if !null {
+				if bj, err = bj.MergePatch(patch); err != nil {
+					return err
+				}
+			}
+		}
+		if err = appendJsonResult(rs, bj, null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_CONTAINS
+
+func JsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	target := newJsonDocGetter(parameters[0])
+	candidate := newJsonDocGetter(parameters[1])
+	var pathGetter jsonPathGetter
+	if len(parameters) == 3 {
+		pathGetter = newJsonPathGetter(parameters[2])
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		t, null1, err := target(i)
+		if err != nil {
+			return err
+		}
+		c, null2, err := candidate(i)
+		if err != nil {
+			return err
+		}
+		if null1 || null2 {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if pathGetter != nil {
+			path, null, err := pathGetter(i)
+			if err != nil {
+				return err
+			}
+			var vals []bytejson.ByteJson
+			if !null {
+				vals = t.Lookup(path)
+			}
+			if len(vals) == 0 {
+				if err = rs.Append(false, true); err != nil {
+					return err
+				}
+				continue
+			}
+			t = vals[0]
+		}
+		if err = rs.Append(t.Contains(c), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_CONTAINS_PATH
+
+func JsonContainsPath(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	doc := newJsonDocGetter(parameters[0])
+	modeParam := vector.GenerateFunctionStrParameter(parameters[1])
+	pathGetters := make([]jsonPathGetter, len(parameters)-2)
+	for i := range pathGetters {
+		pathGetters[i] = newJsonPathGetter(parameters[i+2])
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := doc(i)
+		if err != nil {
+			return err
+		}
+		mode, modeNull := modeParam.GetStrValue(i)
+		if null || modeNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		var one bool
+		switch strings.ToLower(string(mode)) {
+		case "one":
+			one = true
+		case "all":
+		default:
+			return moerr.NewInvalidInput(proc.Ctx, "the oneOrAll argument to json_contains_path may take these values: 'one' or 'all'")
+		}
+		found := !one
+		for j := range pathGetters {
+			path, pathNull, err := pathGetters[j](i)
+			if err != nil {
+				return err
+			}
+			if pathNull {
+				null = true
+				break
+			}
+			exists := len(bj.Lookup(path)) > 0
+			if one && exists {
+				found = true
+				break
+			}
+			if !one && !exists {
+				found = false
+				break
+			}
+		}
+		if err = rs.Append(found, null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MEMBER OF
+
+func JsonMemberOf(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	value := newJsonValueGetter(parameters[0])
+	doc := newJsonDocGetter(parameters[1])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null1, err := value(i)
+		if err != nil {
+			return err
+		}
+		bj, null2, err := doc(i)
+		if err != nil {
+			return err
+		}
+		if null1 || null2 {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		member := false
+		if bj.Type == bytejson.TpCodeArray {
+			for _, elem := range bj.Lookup(jsonAllElemsPath) {
+				if elem.Equal(v) {
+					member = true
+					break
+				}
+			}
+		} else {
+			member = bj.Equal(v)
+		}
+		if err = rs.Append(member, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonAllElemsPath is '$[*]', which matches all the elements of an array.
+var jsonAllElemsPath = func() *bytejson.Path {
+	p, _ := types.ParseStringToPath("$[*]")
+	return &p
+}()
+
+// JSON_KEYS and JSON_LENGTH
+
+// lookupJsonDoc returns the i-th document, or the value at the path in it if
+// there is a path argument. It's null if anything is null or nothing matches.
+func lookupJsonDoc(doc jsonGetter, pathGetter jsonPathGetter, i uint64) (bytejson.ByteJson, bool, error) {
+	bj, null, err := doc(i)
+	if err != nil || null || pathGetter == nil {
+		return bj, null, err
+	}
+	path, null, err := pathGetter(i)
+	if err != nil || null {
+		return bj, null, err
+	}
+	vals := bj.Lookup(path)
+	if len(vals) == 0 {
+		return bj, true, nil
+	}
+	return vals[0], false, nil
+}
+
+func JsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	doc := newJsonDocGetter(parameters[0])
+	var pathGetter jsonPathGetter
+	if len(parameters) == 2 {
+		pathGetter = newJsonPathGetter(parameters[1])
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := lookupJsonDoc(doc, pathGetter, i)
+		if err != nil {
+			return err
+		}
+		if null || bj.Type != bytejson.TpCodeObject {
+			err = rs.AppendBytes(nil, true)
+		} else {
+			err = appendJsonResult(rs, bj.Keys(), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[int64](result)
+	doc := newJsonDocGetter(parameters[0])
+	var pathGetter jsonPathGetter
+	if len(parameters) == 2 {
+		pathGetter = newJsonPathGetter(parameters[1])
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := lookupJsonDoc(doc, pathGetter, i)
+		if err != nil {
+			return err
+		}
+		if null {
+			err = rs.Append(0, true)
+		} else {
+			err = rs.Append(int64(bj.Length()), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_TYPE
+
+func JsonType(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	doc := newJsonDocGetter(parameters[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, null, err := doc(i)
+		if err != nil {
+			return err
+		}
+		if null {
+			err = rs.AppendBytes(nil, true)
+		} else {
+			err = rs.AppendBytes([]byte(bj.TypeName()), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_VALID
+
+func JsonValid(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	p := vector.GenerateFunctionStrParameter(parameters[0])
+	isJson := parameters[0].GetType().Oid == types.T_json
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetStrValue(i)
+		if null {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		valid := isJson
+		if !valid {
+			_, err := types.ParseSliceToByteJson(v)
+			valid = err == nil
+		}
+		if err := rs.Append(valid, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeJsonForTest returns the storage form of the json texts.
+func encodeJsonForTest(t *testing.T, texts ...string) []string {
+	ret := make([]string, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		bj, err := types.ParseStringToByteJson(text)
+		require.NoError(t, err)
+		dt, err := bj.Marshal()
+		require.NoError(t, err)
+		ret[i] = string(dt)
+	}
+	return ret
+}
+
+func runJsonTestCases(t *testing.T, proc *process.Process, cases []tcTemp,
+	fn func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error) {
+	for _, tc := range cases {
+		fcTC := testutil.NewFunctionTestCase(proc, tc.inputs, tc.expect, fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonObjectAndArray(t *testing.T) {
+	proc := testutil.NewProcess()
+	cases := []tcTemp{
+		{
+			info: "json_object",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "b"}, nil),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 0}, []bool{false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"c", "c"}, nil),
+				testutil.NewFunctionTestInput(types.T_json.ToType(), encodeJsonForTest(t, `[1, "x"]`, `{}`), nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				encodeJsonForTest(t, `{"a": 1, "c": [1, "x"]}`, `{"b": null, "c": {}}`), nil),
+		},
+		{
+			info: "json_object with a null key",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonObject)
+
+	cases = []tcTemp{
+		{
+			info: "json_array",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", ""}, []bool{false, true}),
+				testutil.NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 2}, nil),
+				testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				encodeJsonForTest(t, `["a", 1.5, true]`, `[null, 2.0, false]`), nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonArray)
+}
+
+func TestJsonModify(t *testing.T) {
+	proc := testutil.NewProcess()
+	doc := `{"a": 1, "b": [2, 3]}`
+	inputs := func(path string, val int64) []testutil.FunctionTestInput {
+		return []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc}, []bool{false, true}),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{path, path}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{val, val}, nil),
+		}
+	}
+	result := func(want string) testutil.FunctionTestResult {
+		return testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+			encodeJsonForTest(t, want, ""), []bool{false, true})
+	}
+	runJsonTestCases(t, proc, []tcTemp{{info: "json_set", inputs: inputs("$.c", 4), expect: result(`{"a": 1, "b": [2, 3], "c": 4}`)}}, JsonSet)
+	runJsonTestCases(t, proc, []tcTemp{{info: "json_insert", inputs: inputs("$.a", 4), expect: result(doc)}}, JsonInsert)
+	runJsonTestCases(t, proc, []tcTemp{{info: "json_replace", inputs: inputs("$.b[0]", 4), expect: result(`{"a": 1, "b": [4, 3]}`)}}, JsonReplace)
+
+	cases := []tcTemp{
+		{
+			info: "json_remove",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b[1]", "$.x"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				encodeJsonForTest(t, `{"a": 1, "b": [2]}`, doc), nil),
+		},
+		{
+			info: "json_remove the root",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonRemove)
+
+	cases = []tcTemp{
+		{
+			info: "json_merge_patch",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": null, "c": 1}`, ""}, []bool{false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				encodeJsonForTest(t, `{"b": [2, 3], "c": 1}`, ""), []bool{false, true}),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonMergePatch)
+}
+
+func TestJsonPredicates(t *testing.T) {
+	proc := testutil.NewProcess()
+	doc := `{"a": 1, "b": [2, 3], "c": {"d": 4}}`
+	cases := []tcTemp{
+		{
+			info: "json_contains",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc, doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"c": {"d": 4}}`, `[3]`, `5`}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false, false}, nil),
+		},
+		{
+			info: "json_contains with a path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[3]`, `1`}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b", "$.x"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false}, []bool{false, true}),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonContains)
+
+	cases = []tcTemp{
+		{
+			info: "json_contains_path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc, doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"one", "ALL"}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.x", "$.x"}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.c.d", "$.c.d"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false}, nil),
+		},
+		{
+			info: "json_contains_path with a wrong mode",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{doc}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"some"}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), true, []bool{false}, nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonContainsPath)
+
+	cases = []tcTemp{
+		{
+			info: "member of",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{17, 18, 3, 1}, []bool{false, false, false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{`[23, "abc", 17.0]`, `[23, "abc", 17]`, `3`, `[1]`}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, false, true, false}, []bool{false, false, false, true}),
+		},
+		{
+			info: "member of with a string",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"abc", "17"}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[23, "abc", 17]`, `[23, "abc", 17]`}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false}, nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonMemberOf)
+}
+
+func TestJsonInspect(t *testing.T) {
+	proc := testutil.NewProcess()
+	docs := []string{`{"b": 1, "a": [1, 2, 3]}`, `[1, 2]`, `"x"`}
+	cases := []tcTemp{
+		{
+			info: "json_keys",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				encodeJsonForTest(t, `["a", "b"]`, "", ""), []bool{false, true, true}),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonKeys)
+
+	cases = []tcTemp{
+		{
+			info: "json_length",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false, []int64{2, 2, 1}, nil),
+		},
+		{
+			info: "json_length with a path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$.a", "$"}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{3, 0, 1}, []bool{false, true, false}),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonLength)
+
+	cases = []tcTemp{
+		{
+			info: "json_type",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"OBJECT", "ARRAY", "STRING"}, nil),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonType)
+
+	cases = []tcTemp{
+		{
+			info: "json_valid",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{`{"a": 1}`, `{"a": 1`, `hello`, ``}, []bool{false, false, false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, false, false, false}, []bool{false, false, false, true}),
+		},
+	}
+	runJsonTestCases(t, proc, cases, JsonValid)
+}
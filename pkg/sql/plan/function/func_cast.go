@@ -45,6 +45,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_decimal64, types.T_decimal128,
 		types.T_date, types.T_datetime,
 		types.T_time, types.T_timestamp,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
+		types.T_array_float32,
 	},
 
 	types.T_bool: {
@@ -64,6 +66,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_int16: {
@@ -75,6 +78,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_int32: {
@@ -86,6 +90,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_int64: {
@@ -97,6 +102,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_uint8: {
@@ -108,6 +114,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_uint16: {
@@ -119,6 +126,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_uint32: {
@@ -130,6 +138,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_uint64: {
@@ -141,6 +150,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_float32: {
@@ -230,6 +240,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_array_float32,
 	},
 
 	types.T_varchar: {
@@ -244,6 +256,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_array_float32,
 	},
 
 	types.T_binary: {
@@ -257,6 +271,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_varbinary, types.T_binary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_varbinary: {
@@ -270,6 +285,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_blob: {
@@ -284,6 +300,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
 	},
 
 	types.T_text: {
@@ -298,17 +315,55 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_array_float32,
 	},
 
 	types.T_json: {
 		types.T_char, types.T_varchar, types.T_text,
 	},
 
+	types.T_array_float32: {
+		types.T_char, types.T_varchar, types.T_text,
+		types.T_array_float32,
+	},
+
 	types.T_uuid: {
 		types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text,
 	},
 
+	types.T_bit: {
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+		types.T_bit,
+	},
+
+	types.T_year: {
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_year,
+	},
+
+	// enum and set are cast from and to their members by cast_value_to_enum,
+	// cast_value_to_set and cast_index_to_value, which know the members.
+	types.T_enum: {
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_enum,
+	},
+
+	types.T_set: {
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_set,
+	},
+
 	types.T_TS: {
 		types.T_TS,
 	},
@@ -408,6 +463,21 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_json:
 		s := vector.GenerateFunctionStrParameter(from)
 		err = jsonToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_array_float32:
+		s := vector.GenerateFunctionStrParameter(from)
+		err = arrayF32ToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_bit:
+		s := vector.GenerateFunctionFixedTypeParameter[uint64](from)
+		err = bitToOthers(proc.Ctx, s, *fromType, *toType, result, length)
+	case types.T_year:
+		s := vector.GenerateFunctionFixedTypeParameter[uint16](from)
+		err = yearToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_enum:
+		s := vector.GenerateFunctionFixedTypeParameter[uint16](from)
+		err = enumToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_set:
+		s := vector.GenerateFunctionFixedTypeParameter[uint64](from)
+		err = setToOthers(proc.Ctx, s, *toType, result, length)
 	default:
 		// XXX we set the function here to adapt to the BVT cases.
 		err = formatCastError(proc.Ctx, from, *toType, "")
@@ -437,7 +507,8 @@ func scalarNullToOthers(ctx context.Context,
 	case types.T_uint64:
 		return appendNulls[uint64](result, length)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_text, types.T_json:
+		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
+		types.T_array_float32:
 		return appendNulls[types.Varlena](result, length)
 	case types.T_float32:
 		return appendNulls[float32](result, length)
@@ -455,6 +526,10 @@ func scalarNullToOthers(ctx context.Context,
 		return appendNulls[types.Time](result, length)
 	case types.T_timestamp:
 		return appendNulls[types.Timestamp](result, length)
+	case types.T_year, types.T_enum:
+		return appendNulls[uint16](result, length)
+	case types.T_bit, types.T_set:
+		return appendNulls[uint64](result, length)
 	}
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from NULL to %s", totype))
 }
@@ -545,6 +620,12 @@ func int8ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return signedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		// string type.
@@ -603,6 +684,12 @@ func int16ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return signedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		// string type.
@@ -661,6 +748,12 @@ func int32ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return signedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		// string type.
@@ -719,6 +812,12 @@ func int64ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return signedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text:
 		// string type.
@@ -777,6 +876,12 @@ func uint8ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return unsignedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -834,6 +939,12 @@ func uint16ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return unsignedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -891,6 +1002,12 @@ func uint32ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return unsignedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -948,6 +1065,12 @@ func uint64ToOthers(ctx context.Context,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return unsignedToDecimal128(source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return integerToYear(ctx, source, rs, length)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -1411,6 +1534,15 @@ func strTypeToOthers(proc *process.Process,
 			zone = proc.SessionInfo.TimeZone
 		}
 		return strToTimestamp(source, rs, zone, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return strToBit(source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return strToYear(source, rs, length)
+	case types.T_array_float32:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToArrayF32(source, rs, length)
 	case types.T_char, types.T_varchar, types.T_text,
 		types.T_binary, types.T_varbinary, types.T_blob:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -1472,6 +1604,231 @@ func jsonToOthers(ctx context.Context,
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from json to %s", toType))
 }
 
+func arrayF32ToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[types.Varlena],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_char, types.T_varchar, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return arrayF32ToStr(source, rs, length)
+	case types.T_array_float32:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return arrayF32ToArrayF32(source, rs, length)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from vector to %s", toType))
+}
+
+func bitToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint64],
+	fromType, toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_int8:
+		rs := vector.MustFunctionResult[int8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint8:
+		rs := vector.MustFunctionResult[uint8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float32:
+		rs := vector.MustFunctionResult[float32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		if fromType.Width <= toType.Width {
+			return rs.DupFromParameter(source, length)
+		}
+		return integerToBit(ctx, source, rs, length)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return bitToStr(source, rs, length, fromType, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from bit to %s", toType))
+}
+
+func yearToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint16],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_int8:
+		rs := vector.MustFunctionResult[int8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint8:
+		rs := vector.MustFunctionResult[uint8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float32:
+		rs := vector.MustFunctionResult[float32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[uint16](result)
+		return rs.DupFromParameter(source, length)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return yearToStr(source, rs, length, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from year to %s", toType))
+}
+
+// enumToOthers casts the index of enum to integers, the members are
+// returned by cast_index_to_value.
+func enumToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint16],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_int8:
+		rs := vector.MustFunctionResult[int8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint8:
+		rs := vector.MustFunctionResult[uint8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16, types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return rs.DupFromParameter(source, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from enum to %s", toType))
+}
+
+// setToOthers casts the bitmask of set to integers, the members are
+// returned by cast_index_to_value.
+func setToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint64],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_int8:
+		rs := vector.MustFunctionResult[int8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint8:
+		rs := vector.MustFunctionResult[uint8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64, types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return rs.DupFromParameter(source, length)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from set to %s", toType))
+}
+
+func integerToBit[T constraints.Integer](
+	ctx context.Context,
+	from vector.FunctionParameterWrapper[T], to *vector.FunctionResult[uint64], length int) error {
+	width := to.GetType().Width
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if v < 0 {
+			return moerr.NewOutOfRange(ctx, "bit", "value %d", v)
+		}
+		r, err := types.ParseBit(uint64(v), width)
+		if err != nil {
+			return err
+		}
+		if err = to.Append(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func integerToYear[T constraints.Integer](
+	ctx context.Context,
+	from vector.FunctionParameterWrapper[T], to *vector.FunctionResult[uint16], length int) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if uint64(v) > math.MaxInt64 && v > 0 {
+			return moerr.NewOutOfRange(ctx, "year", "value %d", v)
+		}
+		r, err := types.ParseYear(int64(v))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func integerToFixFloat[T1, T2 constraints.Integer | constraints.Float](
 	ctx context.Context,
 	from vector.FunctionParameterWrapper[T1], to *vector.FunctionResult[T2], length uint64) error {
@@ -3758,6 +4115,100 @@ func strToStr(
 	return nil
 }
 
+func strToBit(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[uint64], length int) error {
+	width := to.GetType().Width
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := types.ParseBitBytes(v, width)
+		if err != nil {
+			return err
+		}
+		if err = to.Append(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func strToYear(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[uint16], length int) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := types.ParseYearString(string(v))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bitToStr(
+	from vector.FunctionParameterWrapper[uint64],
+	to *vector.FunctionResult[types.Varlena], length int, fromType, toType types.Type) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		result := types.BitToBytes(v, fromType.Width)
+		if toType.Oid != types.T_text && toType.Oid != types.T_blob && int32(len(result)) > toType.Width {
+			return moerr.NewDataTruncatedNoCtx("bit", "truncated for %s", toType.Oid)
+		}
+		if toType.Oid == types.T_binary && len(result) < int(toType.Width) {
+			result = append(result, make([]byte, int(toType.Width)-len(result))...)
+		}
+		if err := to.AppendBytes(result, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func yearToStr(
+	from vector.FunctionParameterWrapper[uint16],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		result := types.YearToString(v)
+		if toType.Oid == types.T_char || toType.Oid == types.T_varchar {
+			if int32(len(result)) > toType.Width {
+				return moerr.NewDataTruncatedNoCtx("year", "truncated for char/varchar")
+			}
+		}
+		if err := to.AppendBytes([]byte(result), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func uuidToStr(
 	from vector.FunctionParameterWrapper[types.Uuid],
 	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
@@ -3830,6 +4281,79 @@ func jsonToStr(
 	return nil
 }
 
+// vectorDimension returns the dimension the vector type requires, 0 if any
+// dimension is allowed.
+func vectorDimension(typ types.Type) int32 {
+	if typ.Width >= types.MaxArrayDimension {
+		return 0
+	}
+	return typ.Width
+}
+
+func strToArrayF32(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int) error {
+	dim := vectorDimension(to.GetType())
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		arr, err := types.ParseArrayF32(convertByteSliceToString(v), dim)
+		if err != nil {
+			return err
+		}
+		if err = to.AppendBytes(types.ArrayF32ToBytes(arr), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func arrayF32ToStr(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		s := types.ArrayF32ToString(types.BytesToArrayF32(v))
+		if err := to.AppendBytes([]byte(s), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func arrayF32ToArrayF32(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int) error {
+	dim := vectorDimension(to.GetType())
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if n := int32(len(v) / 4); dim > 0 && n != dim {
+			return moerr.NewInvalidInputNoCtx("expected vector dimension %d, got %d", dim, n)
+		}
+		if err := to.AppendBytes(v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func overflowForNumericToNumeric[T1, T2 constraints.Integer | constraints.Float](ctx context.Context, xs []T1) error {
 	if len(xs) == 0 {
 		return nil
@@ -4272,3 +4796,183 @@ func floatToBytes(v float64, bitSize int) []byte {
 		return []byte(strconv.FormatFloat(float64(v), 'f', -1, bitSize))
 	}
 }
+
+// castEnumParameterCheck checks the parameters of cast_value_to_enum and
+// cast_value_to_set, the value is cast by its member name if it is a string,
+// otherwise by its index (enum) or bitmask (set).
+func castEnumParameterCheck(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 || !inputs[0].Oid.IsMySQLString() {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	if inputs[1].Oid.IsMySQLString() {
+		return newCheckResultWithSuccess(0)
+	}
+	if inputs[1].Oid == types.T_uint64 {
+		return newCheckResultWithSuccess(1)
+	}
+	if inputs[1].Oid == types.T_any || inputs[1].Oid.IsInteger() ||
+		inputs[1].Oid == types.T_enum || inputs[1].Oid == types.T_set {
+		return newCheckResultWithCast(1, []types.Type{inputs[0], types.T_uint64.ToType()})
+	}
+	return newCheckResultWithFailure(failedFunctionParametersWrong)
+}
+
+// opCastEnum is the executor of cast_value_to_enum, cast_value_to_set and
+// cast_index_to_value, the first parameter is the members of the enum or
+// set column formatted by types.FormatEnumValues.
+type opCastEnum struct {
+	values []string
+}
+
+func newOpCastEnum() *opCastEnum {
+	return &opCastEnum{}
+}
+
+func (op *opCastEnum) members(proc *process.Process, v *vector.Vector) ([]string, error) {
+	if op.values != nil {
+		return op.values, nil
+	}
+	if !v.IsConst() || v.IsConstNull() {
+		return nil, moerr.NewInvalidArg(proc.Ctx, "members of enum", "not constant")
+	}
+	values, err := types.ParseEnumValues(v.GetStringAt(0))
+	if err != nil {
+		return nil, err
+	}
+	op.values = values
+	return values, nil
+}
+
+func (op *opCastEnum) castValueToEnum(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := op.members(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	rs := vector.MustFunctionResult[uint16](result)
+	if parameters[1].GetType().Oid.IsMySQLString() {
+		p := vector.GenerateFunctionStrParameter(parameters[1])
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null := p.GetStrValue(i)
+			if null {
+				if err = rs.Append(0, true); err != nil {
+					return err
+				}
+				continue
+			}
+			idx, err := types.ParseEnum(values, string(v))
+			if err != nil {
+				return err
+			}
+			if err = rs.Append(idx, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	p := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[1])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetValue(i)
+		if null {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		idx, err := types.ParseEnumIndex(values, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(idx, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opCastEnum) castValueToSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := op.members(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	rs := vector.MustFunctionResult[uint64](result)
+	if parameters[1].GetType().Oid.IsMySQLString() {
+		p := vector.GenerateFunctionStrParameter(parameters[1])
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null := p.GetStrValue(i)
+			if null {
+				if err = rs.Append(0, true); err != nil {
+					return err
+				}
+				continue
+			}
+			mask, err := types.ParseSet(values, string(v))
+			if err != nil {
+				return err
+			}
+			if err = rs.Append(mask, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	p := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[1])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetValue(i)
+		if null {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		mask, err := types.ParseSetMask(values, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(mask, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opCastEnum) castIndexToValue(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := op.members(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	if parameters[1].GetType().Oid == types.T_enum {
+		p := vector.GenerateFunctionFixedTypeParameter[uint16](parameters[1])
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null := p.GetValue(i)
+			if null {
+				if err = rs.AppendBytes(nil, true); err != nil {
+					return err
+				}
+				continue
+			}
+			s, err := types.EnumToString(values, v)
+			if err != nil {
+				return err
+			}
+			if err = rs.AppendBytes([]byte(s), false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	p := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[1])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetValue(i)
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.AppendBytes([]byte(types.SetToString(values, v)), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
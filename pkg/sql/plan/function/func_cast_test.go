@@ -20,7 +20,9 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1465,6 +1467,133 @@ func initCastTestCase() []tcTemp {
 		},
 	}
 
+	castBitAndYear := []tcTemp{
+		{
+			info: "int64 to bit",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{5, 255, 0}, []bool{false, false, true}),
+				testutil.NewFunctionTestInput(types.New(types.T_bit, 8, 0), []uint64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.New(types.T_bit, 8, 0), false,
+				[]uint64{5, 255, 0}, []bool{false, false, true}),
+		},
+		{
+			info: "int64 to bit out of range",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{256}, []bool{false}),
+				testutil.NewFunctionTestInput(types.New(types.T_bit, 8, 0), []uint64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.New(types.T_bit, 8, 0), true,
+				[]uint64{0}, []bool{false}),
+		},
+		{
+			info: "bit to varchar",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.New(types.T_bit, 16, 0),
+					[]uint64{0x4142}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"AB"}, []bool{false}),
+		},
+		{
+			info: "int64 to year",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{0, 23, 99, 2023}, []bool{false, false, false, false}),
+				testutil.NewFunctionTestInput(types.T_year.ToType(), []uint16{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_year.ToType(), false,
+				[]uint16{0, 2023, 1999, 2023}, []bool{false, false, false, false}),
+		},
+		{
+			info: "varchar to year",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"0", "2155"}, []bool{false, false}),
+				testutil.NewFunctionTestInput(types.T_year.ToType(), []uint16{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_year.ToType(), false,
+				[]uint16{2000, 2155}, []bool{false, false}),
+		},
+		{
+			info: "year to varchar",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_year.ToType(),
+					[]uint16{0, 1999}, []bool{false, false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"0000", "1999"}, []bool{false, false}),
+		},
+		{
+			info: "enum to int64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_enum.ToType(),
+					[]uint16{1, 3}, []bool{false, false}),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{1, 3}, []bool{false, false}),
+		},
+	}
+
+	vec123 := string(types.ArrayF32ToBytes([]float32{1, 2.5, -3}))
+	castVector := []tcTemp{
+		{
+			info: "varchar to vector",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2.5,-3]", ""}, []bool{false, true}),
+				testutil.NewFunctionTestInput(types.New(types.T_array_float32, 3, 0), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.New(types.T_array_float32, 3, 0), false,
+				[]string{vec123, ""}, []bool{false, true}),
+		},
+		{
+			info: "varchar to vector of wrong dimension",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2]"}, []bool{false}),
+				testutil.NewFunctionTestInput(types.New(types.T_array_float32, 3, 0), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.New(types.T_array_float32, 3, 0), true,
+				[]string{""}, []bool{false}),
+		},
+		{
+			info: "malformed vector",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"1, 2"}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_array_float32.ToType(), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_array_float32.ToType(), true,
+				[]string{""}, []bool{false}),
+		},
+		{
+			info: "vector to varchar",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.New(types.T_array_float32, 3, 0),
+					[]string{vec123}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"[1, 2.5, -3]"}, []bool{false}),
+		},
+		{
+			info: "vector to vector of wrong dimension",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.New(types.T_array_float32, 3, 0),
+					[]string{vec123}, []bool{false}),
+				testutil.NewFunctionTestInput(types.New(types.T_array_float32, 2, 0), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.New(types.T_array_float32, 2, 0), true,
+				[]string{""}, []bool{false}),
+		},
+	}
+
 	// init the testCases
 	testCases = append(testCases, castToSameTypeCases...)
 	testCases = append(testCases, castInt8ToOthers...)
@@ -1480,6 +1609,8 @@ func initCastTestCase() []tcTemp {
 	testCases = append(testCases, castStrToOthers...)
 	testCases = append(testCases, castDecToOthers...)
 	testCases = append(testCases, castTimestampToOthers...)
+	testCases = append(testCases, castBitAndYear...)
+	testCases = append(testCases, castVector...)
 
 	return testCases
 }
@@ -1509,3 +1640,80 @@ func BenchmarkCast(b *testing.B) {
 	}
 	b.StopTimer()
 }
+
+func TestCastEnum(t *testing.T) {
+	members := testutil.NewFunctionTestConstInput(types.T_varchar.ToType(),
+		[]string{"'x','y','z'"}, []bool{false})
+	testCases := []struct {
+		info   string
+		inputs []testutil.FunctionTestInput
+		expect testutil.FunctionTestResult
+		fn     func(*opCastEnum, []*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error
+	}{
+		{
+			info: "cast_value_to_enum from string",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"y", "Z", ""}, []bool{false, false, true})},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), false,
+				[]uint16{2, 3, 0}, []bool{false, false, true}),
+			fn: (*opCastEnum).castValueToEnum,
+		},
+		{
+			info: "cast_value_to_enum from index",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_uint64.ToType(),
+					[]uint64{1, 3}, []bool{false, false})},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), false,
+				[]uint16{1, 3}, []bool{false, false}),
+			fn: (*opCastEnum).castValueToEnum,
+		},
+		{
+			info: "cast_value_to_enum not a member",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"w"}, []bool{false})},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), true,
+				[]uint16{0}, []bool{false}),
+			fn: (*opCastEnum).castValueToEnum,
+		},
+		{
+			info: "cast_value_to_set from string",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"z,x", ""}, []bool{false, false})},
+			expect: testutil.NewFunctionTestResult(types.T_set.ToType(), false,
+				[]uint64{5, 0}, []bool{false, false}),
+			fn: (*opCastEnum).castValueToSet,
+		},
+		{
+			info: "cast_index_to_value of enum",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_enum.ToType(),
+					[]uint16{3, 0, 1}, []bool{false, false, true})},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"z", "", ""}, []bool{false, false, true}),
+			fn: (*opCastEnum).castIndexToValue,
+		},
+		{
+			info: "cast_index_to_value of set",
+			inputs: []testutil.FunctionTestInput{members,
+				testutil.NewFunctionTestInput(types.T_set.ToType(),
+					[]uint64{6}, []bool{false})},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"y,z"}, []bool{false}),
+			fn: (*opCastEnum).castIndexToValue,
+		},
+	}
+
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		op, fn := newOpCastEnum(), tc.fn
+		fcTC := testutil.NewFunctionTestCase(proc,
+			tc.inputs, tc.expect, func(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+				return fn(op, parameters, result, proc, length)
+			})
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
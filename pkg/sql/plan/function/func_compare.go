@@ -30,6 +30,7 @@ func otherCompareOperatorSupports(typ1, typ2 types.Type) bool {
 	switch typ1.Oid {
 	case types.T_bool:
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_bit, types.T_year, types.T_enum, types.T_set:
 	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
 	case types.T_float32, types.T_float64:
 	case types.T_decimal64, types.T_decimal128:
@@ -52,6 +53,7 @@ func equalAndNotEqualOperatorSupports(typ1, typ2 types.Type) bool {
 	switch typ1.Oid {
 	case types.T_bool:
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_bit, types.T_year, types.T_enum, types.T_set:
 	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
 	case types.T_float32, types.T_float64:
 	case types.T_decimal64, types.T_decimal128:
@@ -97,7 +99,7 @@ func equalFn(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a == b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a == b
 		})
@@ -105,7 +107,7 @@ func equalFn(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a == b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a == b
 		})
@@ -533,7 +535,7 @@ func greatThanFn(parameters []*vector.Vector, result vector.FunctionResultWrappe
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a > b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a > b
 		})
@@ -541,7 +543,7 @@ func greatThanFn(parameters []*vector.Vector, result vector.FunctionResultWrappe
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a > b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a > b
 		})
@@ -621,7 +623,7 @@ func greatEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrapp
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a >= b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a >= b
 		})
@@ -629,7 +631,7 @@ func greatEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrapp
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a >= b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a >= b
 		})
@@ -709,7 +711,7 @@ func notEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrapper
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a != b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a != b
 		})
@@ -717,7 +719,7 @@ func notEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrapper
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a != b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a != b
 		})
@@ -797,7 +799,7 @@ func lessThanFn(parameters []*vector.Vector, result vector.FunctionResultWrapper
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a < b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a < b
 		})
@@ -805,7 +807,7 @@ func lessThanFn(parameters []*vector.Vector, result vector.FunctionResultWrapper
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a < b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a < b
 		})
@@ -885,7 +887,7 @@ func lessEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrappe
 		return opBinaryFixedFixedToFixed[uint8, uint8, bool](parameters, rs, proc, length, func(a, b uint8) bool {
 			return a <= b
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return opBinaryFixedFixedToFixed[uint16, uint16, bool](parameters, rs, proc, length, func(a, b uint16) bool {
 			return a <= b
 		})
@@ -893,7 +895,7 @@ func lessEqualFn(parameters []*vector.Vector, result vector.FunctionResultWrappe
 		return opBinaryFixedFixedToFixed[uint32, uint32, bool](parameters, rs, proc, length, func(a, b uint32) bool {
 			return a <= b
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return opBinaryFixedFixedToFixed[uint64, uint64, bool](parameters, rs, proc, length, func(a, b uint64) bool {
 			return a <= b
 		})
@@ -318,6 +318,9 @@ var (
 		"mo_role_privs":               0,
 		"mo_user_defined_function":    0,
 		"mo_stored_procedure":         0,
+		"mo_triggers":                 0,
+		"mo_mviews":                   0,
+		"mo_column_stats":             0,
 		"mo_mysql_compatibility_mode": 0,
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
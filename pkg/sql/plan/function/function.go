@@ -49,6 +49,9 @@ func initAllSupportedFunctions() {
 	for _, fn := range supportedAggregateFunctions {
 		allSupportedFunctions[fn.functionId] = fn
 	}
+	for _, fn := range supportedWindowFunctions {
+		allSupportedFunctions[fn.functionId] = fn
+	}
 }
 
 func GetFunctionIsAggregateByName(name string) bool {
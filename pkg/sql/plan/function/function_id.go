@@ -312,6 +312,39 @@ const (
 	CURRVAL
 	LASTVAL
 
+	// cast between the members and the values of enum and set
+	CAST_VALUE_TO_ENUM
+	CAST_VALUE_TO_SET
+	CAST_INDEX_TO_VALUE
+
+	// distances between the vectors
+	L2_DISTANCE
+	COSINE_DISTANCE
+	INNER_PRODUCT
+
+	// the snapshot timestamp of the current transaction
+	CURRENT_SNAPSHOT
+
+	// json builders, modifiers, predicates and inspectors
+	JSON_OBJECT
+	JSON_ARRAY
+	JSON_SET
+	JSON_INSERT
+	JSON_REPLACE
+	JSON_REMOVE
+	JSON_MERGE_PATCH
+	JSON_CONTAINS
+	JSON_CONTAINS_PATH
+	JSON_MEMBER_OF
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_TYPE
+	JSON_VALID
+
+	// json aggregates
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -386,6 +419,20 @@ var functionIdRegister = map[string]int32{
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
+	"json_arrayagg":         JSON_ARRAYAGG,
+	"json_objectagg":        JSON_OBJECTAGG,
+	// window
+	"row_number":   ROW_NUMBER,
+	"rank":         RANK,
+	"dense_rank":   DENSE_RANK,
+	"percent_rank": PERCENT_RANK,
+	"cume_dist":    CUME_DIST,
+	"ntile":        NTILE,
+	"lag":          LAG,
+	"lead":         LEAD,
+	"first_value":  FIRST_VALUE,
+	"last_value":   LAST_VALUE,
+	"nth_value":    NTH_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -486,6 +533,20 @@ var functionIdRegister = map[string]int32{
 	"collation":                      COLLATION,
 	"json_extract":                   JSON_EXTRACT,
 	"json_quote":                     JSON_QUOTE,
+	"json_object":                    JSON_OBJECT,
+	"json_array":                     JSON_ARRAY,
+	"json_set":                       JSON_SET,
+	"json_insert":                    JSON_INSERT,
+	"json_replace":                   JSON_REPLACE,
+	"json_remove":                    JSON_REMOVE,
+	"json_merge_patch":               JSON_MERGE_PATCH,
+	"json_contains":                  JSON_CONTAINS,
+	"json_contains_path":             JSON_CONTAINS_PATH,
+	"member_of":                      JSON_MEMBER_OF,
+	"json_keys":                      JSON_KEYS,
+	"json_length":                    JSON_LENGTH,
+	"json_type":                      JSON_TYPE,
+	"json_valid":                     JSON_VALID,
 	"enable_fault_injection":         ENABLE_FAULT_INJECTION,
 	"disable_fault_injection":        DISABLE_FAULT_INJECTION,
 	"add_fault_point":                ADD_FAULT_POINT,
@@ -543,4 +604,11 @@ var functionIdRegister = map[string]int32{
 	"setval":                         SETVAL,
 	"currval":                        CURRVAL,
 	"lastval":                        LASTVAL,
+	"cast_value_to_enum":             CAST_VALUE_TO_ENUM,
+	"cast_value_to_set":              CAST_VALUE_TO_SET,
+	"cast_index_to_value":            CAST_INDEX_TO_VALUE,
+	"l2_distance":                    L2_DISTANCE,
+	"cosine_distance":                COSINE_DISTANCE,
+	"inner_product":                  INNER_PRODUCT,
+	"current_snapshot":               CURRENT_SNAPSHOT,
 }
@@ -315,4 +315,40 @@ var supportedAggregateFunctions = []FuncNew{
 			},
 		},
 	},
+
+	{
+		functionId: JSON_ARRAYAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return fixedUnaryAggTypeCheck(inputs, agg.JsonAggSupported)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    agg.JsonAggReturnType,
+				specialId:  agg.AggregateJsonArrayAgg,
+			},
+		},
+	},
+
+	{
+		functionId: JSON_OBJECTAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return fixedUnaryAggTypeCheck(inputs, agg.JsonAggSupported)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    agg.JsonAggReturnType,
+				specialId:  agg.AggregateJsonObjectAgg,
+			},
+		},
+	},
 }
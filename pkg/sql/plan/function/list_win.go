@@ -13,3 +13,202 @@
 // limitations under the License.
 
 package function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+)
+
+var supportedWindowFunctions = []FuncNew{
+	{
+		functionId: ROW_NUMBER,
+		class:      plan.Function_WIN | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinNoParameterTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinRankReturnType,
+				specialId:  agg.WinRowNumber,
+			},
+		},
+	},
+
+	{
+		functionId: RANK,
+		class:      plan.Function_WIN | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinNoParameterTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinRankReturnType,
+				specialId:  agg.WinRank,
+			},
+		},
+	},
+
+	{
+		functionId: DENSE_RANK,
+		class:      plan.Function_WIN | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinNoParameterTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinRankReturnType,
+				specialId:  agg.WinDenseRank,
+			},
+		},
+	},
+
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinNoParameterTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinDistReturnType,
+				specialId:  agg.WinPercentRank,
+			},
+		},
+	},
+
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinNoParameterTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinDistReturnType,
+				specialId:  agg.WinCumeDist,
+			},
+		},
+	},
+
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				if inputs[0].Oid == types.T_int64 {
+					return newCheckResultWithSuccess(0)
+				}
+				if inputs[0].Oid.IsInteger() {
+					return newCheckResultWithCast(0, []types.Type{types.T_int64.ToType()})
+				}
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinRankReturnType,
+				specialId:  agg.WinNtile,
+			},
+		},
+	},
+
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinOffsetTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinValueReturnType,
+				specialId:  agg.WinLag,
+			},
+		},
+	},
+
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinOffsetTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinValueReturnType,
+				specialId:  agg.WinLead,
+			},
+		},
+	},
+
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinValueTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinValueReturnType,
+				specialId:  agg.WinFirstValue,
+			},
+		},
+	},
+
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedWinValueTypeCheck,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinValueReturnType,
+				specialId:  agg.WinLastValue,
+			},
+		},
+	},
+
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 2 && inputs[1].Oid.IsInteger() {
+				return fixedWinArgumentsTypeCheck(inputs)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    agg.WinValueReturnType,
+				specialId:  agg.WinNthValue,
+			},
+		},
+	},
+}
@@ -224,6 +224,59 @@ func fixedUnaryAggTypeCheck(inputs []types.Type, supported []types.T) checkResul
 	return newCheckResultWithFailure(failedAggParametersWrong)
 }
 
+// fixedWinNoParameterTypeCheck checks window functions without any argument,
+// such as row_number() and rank().
+func fixedWinNoParameterTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) == 0 {
+		return newCheckResultWithSuccess(0)
+	}
+	return newCheckResultWithFailure(failedFunctionParametersWrong)
+}
+
+// fixedWinValueTypeCheck checks window functions which take a value expression only,
+// such as first_value(expr).
+func fixedWinValueTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) == 1 {
+		return fixedWinArgumentsTypeCheck(inputs)
+	}
+	return newCheckResultWithFailure(failedFunctionParametersWrong)
+}
+
+// fixedWinOffsetTypeCheck checks lag(expr [, offset [, default]]) and lead(expr [, offset [, default]]).
+func fixedWinOffsetTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	if len(inputs) > 1 && !inputs[1].Oid.IsInteger() && inputs[1].Oid != types.T_any {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return fixedWinArgumentsTypeCheck(inputs)
+}
+
+// fixedWinArgumentsTypeCheck casts the second argument of a window function to int64,
+// and the third one to the type of the first one.
+func fixedWinArgumentsTypeCheck(inputs []types.Type) checkResult {
+	needCast := false
+	targets := make([]types.Type, len(inputs))
+	copy(targets, inputs)
+	if targets[0].Oid == types.T_any {
+		targets[0] = types.T_int64.ToType()
+		needCast = true
+	}
+	if len(targets) > 1 && targets[1].Oid != types.T_int64 {
+		targets[1] = types.T_int64.ToType()
+		needCast = true
+	}
+	if len(targets) > 2 && !targets[2].Eq(targets[0]) {
+		targets[2] = targets[0]
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, targets)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
 var fixedBinaryCastRule1 [300][300]tarTypes
 var fixedBinaryCastRule2 [300][300]tarTypes
 var fixedCanImplicitCastRule [300]implicitTypeCastRule
@@ -881,6 +934,118 @@ func initFixed1() {
 		{types.T_text, types.T_binary, types.T_binary, types.T_binary},
 		{types.T_text, types.T_varbinary, types.T_varbinary, types.T_varbinary},
 		{types.T_text, types.T_blob, types.T_blob, types.T_blob},
+		// bit, year, enum and set are compared with numbers as integers, enum and
+		// set are compared with strings by their members, see the binder.
+		{types.T_any, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_any, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_int8, types.T_int64, types.T_int64},
+		{types.T_int8, types.T_bit, types.T_int64, types.T_int64},
+		{types.T_bit, types.T_int16, types.T_int64, types.T_int64},
+		{types.T_int16, types.T_bit, types.T_int64, types.T_int64},
+		{types.T_bit, types.T_int32, types.T_int64, types.T_int64},
+		{types.T_int32, types.T_bit, types.T_int64, types.T_int64},
+		{types.T_bit, types.T_int64, types.T_int64, types.T_int64},
+		{types.T_int64, types.T_bit, types.T_int64, types.T_int64},
+		{types.T_bit, types.T_uint8, types.T_uint64, types.T_uint64},
+		{types.T_uint8, types.T_bit, types.T_uint64, types.T_uint64},
+		{types.T_bit, types.T_uint16, types.T_uint64, types.T_uint64},
+		{types.T_uint16, types.T_bit, types.T_uint64, types.T_uint64},
+		{types.T_bit, types.T_uint32, types.T_uint64, types.T_uint64},
+		{types.T_uint32, types.T_bit, types.T_uint64, types.T_uint64},
+		{types.T_bit, types.T_uint64, types.T_uint64, types.T_uint64},
+		{types.T_uint64, types.T_bit, types.T_uint64, types.T_uint64},
+		{types.T_bit, types.T_float32, types.T_float64, types.T_float64},
+		{types.T_float32, types.T_bit, types.T_float64, types.T_float64},
+		{types.T_bit, types.T_float64, types.T_float64, types.T_float64},
+		{types.T_float64, types.T_bit, types.T_float64, types.T_float64},
+		{types.T_bit, types.T_decimal64, types.T_float64, types.T_float64},
+		{types.T_decimal64, types.T_bit, types.T_float64, types.T_float64},
+		{types.T_bit, types.T_decimal128, types.T_float64, types.T_float64},
+		{types.T_decimal128, types.T_bit, types.T_float64, types.T_float64},
+		{types.T_any, types.T_year, types.T_year, types.T_year},
+		{types.T_year, types.T_any, types.T_year, types.T_year},
+		{types.T_year, types.T_int8, types.T_int64, types.T_int64},
+		{types.T_int8, types.T_year, types.T_int64, types.T_int64},
+		{types.T_year, types.T_int16, types.T_int64, types.T_int64},
+		{types.T_int16, types.T_year, types.T_int64, types.T_int64},
+		{types.T_year, types.T_int32, types.T_int64, types.T_int64},
+		{types.T_int32, types.T_year, types.T_int64, types.T_int64},
+		{types.T_year, types.T_int64, types.T_int64, types.T_int64},
+		{types.T_int64, types.T_year, types.T_int64, types.T_int64},
+		{types.T_year, types.T_uint8, types.T_uint64, types.T_uint64},
+		{types.T_uint8, types.T_year, types.T_uint64, types.T_uint64},
+		{types.T_year, types.T_uint16, types.T_uint64, types.T_uint64},
+		{types.T_uint16, types.T_year, types.T_uint64, types.T_uint64},
+		{types.T_year, types.T_uint32, types.T_uint64, types.T_uint64},
+		{types.T_uint32, types.T_year, types.T_uint64, types.T_uint64},
+		{types.T_year, types.T_uint64, types.T_uint64, types.T_uint64},
+		{types.T_uint64, types.T_year, types.T_uint64, types.T_uint64},
+		{types.T_year, types.T_float32, types.T_float64, types.T_float64},
+		{types.T_float32, types.T_year, types.T_float64, types.T_float64},
+		{types.T_year, types.T_float64, types.T_float64, types.T_float64},
+		{types.T_float64, types.T_year, types.T_float64, types.T_float64},
+		{types.T_year, types.T_decimal64, types.T_float64, types.T_float64},
+		{types.T_decimal64, types.T_year, types.T_float64, types.T_float64},
+		{types.T_year, types.T_decimal128, types.T_float64, types.T_float64},
+		{types.T_decimal128, types.T_year, types.T_float64, types.T_float64},
+		{types.T_any, types.T_enum, types.T_enum, types.T_enum},
+		{types.T_enum, types.T_any, types.T_enum, types.T_enum},
+		{types.T_enum, types.T_int8, types.T_int64, types.T_int64},
+		{types.T_int8, types.T_enum, types.T_int64, types.T_int64},
+		{types.T_enum, types.T_int16, types.T_int64, types.T_int64},
+		{types.T_int16, types.T_enum, types.T_int64, types.T_int64},
+		{types.T_enum, types.T_int32, types.T_int64, types.T_int64},
+		{types.T_int32, types.T_enum, types.T_int64, types.T_int64},
+		{types.T_enum, types.T_int64, types.T_int64, types.T_int64},
+		{types.T_int64, types.T_enum, types.T_int64, types.T_int64},
+		{types.T_enum, types.T_uint8, types.T_uint64, types.T_uint64},
+		{types.T_uint8, types.T_enum, types.T_uint64, types.T_uint64},
+		{types.T_enum, types.T_uint16, types.T_uint64, types.T_uint64},
+		{types.T_uint16, types.T_enum, types.T_uint64, types.T_uint64},
+		{types.T_enum, types.T_uint32, types.T_uint64, types.T_uint64},
+		{types.T_uint32, types.T_enum, types.T_uint64, types.T_uint64},
+		{types.T_enum, types.T_uint64, types.T_uint64, types.T_uint64},
+		{types.T_uint64, types.T_enum, types.T_uint64, types.T_uint64},
+		{types.T_any, types.T_set, types.T_set, types.T_set},
+		{types.T_set, types.T_any, types.T_set, types.T_set},
+		{types.T_set, types.T_int8, types.T_int64, types.T_int64},
+		{types.T_int8, types.T_set, types.T_int64, types.T_int64},
+		{types.T_set, types.T_int16, types.T_int64, types.T_int64},
+		{types.T_int16, types.T_set, types.T_int64, types.T_int64},
+		{types.T_set, types.T_int32, types.T_int64, types.T_int64},
+		{types.T_int32, types.T_set, types.T_int64, types.T_int64},
+		{types.T_set, types.T_int64, types.T_int64, types.T_int64},
+		{types.T_int64, types.T_set, types.T_int64, types.T_int64},
+		{types.T_set, types.T_uint8, types.T_uint64, types.T_uint64},
+		{types.T_uint8, types.T_set, types.T_uint64, types.T_uint64},
+		{types.T_set, types.T_uint16, types.T_uint64, types.T_uint64},
+		{types.T_uint16, types.T_set, types.T_uint64, types.T_uint64},
+		{types.T_set, types.T_uint32, types.T_uint64, types.T_uint64},
+		{types.T_uint32, types.T_set, types.T_uint64, types.T_uint64},
+		{types.T_set, types.T_uint64, types.T_uint64, types.T_uint64},
+		{types.T_uint64, types.T_set, types.T_uint64, types.T_uint64},
+		{types.T_bit, types.T_char, types.T_bit, types.T_bit},
+		{types.T_char, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_varchar, types.T_bit, types.T_bit},
+		{types.T_varchar, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_binary, types.T_bit, types.T_bit},
+		{types.T_binary, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_varbinary, types.T_bit, types.T_bit},
+		{types.T_varbinary, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_blob, types.T_bit, types.T_bit},
+		{types.T_blob, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_bit, types.T_text, types.T_bit, types.T_bit},
+		{types.T_text, types.T_bit, types.T_bit, types.T_bit},
+		{types.T_year, types.T_char, types.T_year, types.T_year},
+		{types.T_char, types.T_year, types.T_year, types.T_year},
+		{types.T_year, types.T_varchar, types.T_year, types.T_year},
+		{types.T_varchar, types.T_year, types.T_year, types.T_year},
+		{types.T_year, types.T_blob, types.T_year, types.T_year},
+		{types.T_blob, types.T_year, types.T_year, types.T_year},
+		{types.T_year, types.T_text, types.T_year, types.T_year},
+		{types.T_text, types.T_year, types.T_year, types.T_year},
+		{types.T_bit, types.T_year, types.T_int64, types.T_int64},
+		{types.T_year, types.T_bit, types.T_int64, types.T_int64},
 	}
 
 	for _, r := range ru {
@@ -1733,6 +1898,7 @@ func initFixed3() {
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
 				{toType: types.T_text, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
 			},
 		},
 
@@ -1759,6 +1925,7 @@ func initFixed3() {
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
 				{toType: types.T_text, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
 			},
 		},
 
@@ -1875,6 +2042,43 @@ func initFixed3() {
 				{toType: types.T_binary, preferLevel: 2},
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
+			},
+		},
+
+		{
+			from: types.T_bit,
+			toList: []toRule{
+				{toType: types.T_uint64, preferLevel: 1},
+				{toType: types.T_int64, preferLevel: 2},
+				{toType: types.T_float64, preferLevel: 2},
+				{toType: types.T_varchar, preferLevel: 2},
+			},
+		},
+
+		{
+			from: types.T_year,
+			toList: []toRule{
+				{toType: types.T_int64, preferLevel: 1},
+				{toType: types.T_uint64, preferLevel: 2},
+				{toType: types.T_float64, preferLevel: 2},
+				{toType: types.T_varchar, preferLevel: 2},
+			},
+		},
+
+		{
+			from: types.T_enum,
+			toList: []toRule{
+				{toType: types.T_int64, preferLevel: 1},
+				{toType: types.T_uint64, preferLevel: 2},
+			},
+		},
+
+		{
+			from: types.T_set,
+			toList: []toRule{
+				{toType: types.T_uint64, preferLevel: 1},
+				{toType: types.T_int64, preferLevel: 2},
 			},
 		},
 	}
@@ -115,7 +115,24 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 	}
 
 	b.insideAgg = true
-	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	args := astExpr.Exprs
+	switch funcName {
+	case "json_arrayagg", "json_objectagg":
+		// each row is wrapped into an array or an object, the agg concatenates
+		// the arrays or merges the objects of a group.
+		wrapName, argCnt := "json_array", 1
+		if funcName == "json_objectagg" {
+			wrapName, argCnt = "json_object", 2
+		}
+		if len(astExpr.Exprs) != argCnt {
+			return nil, moerr.NewInvalidArg(b.GetContext(), funcName+" function have invalid input args length", len(astExpr.Exprs))
+		}
+		args = tree.Exprs{&tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName(wrapName)),
+			Exprs: astExpr.Exprs,
+		}}
+	}
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, args, depth)
 	if err != nil {
 		return nil, err
 	}
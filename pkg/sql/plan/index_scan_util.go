@@ -0,0 +1,81 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// indexedTable is a base table in the FROM clause, whose secondary indexes may
+// be scanned instead of the table, such as the fulltext, ivfflat and expression
+// indexes.
+type indexedTable struct {
+	alias    string
+	schema   string
+	tableDef *TableDef
+}
+
+// hideIndexScanColumns hides the columns of the joined index scans from *.
+func (builder *QueryBuilder) hideIndexScanColumns(aliases []string, ctx *BindContext) {
+	for _, alias := range aliases {
+		if binding, ok := ctx.bindingByTable[alias]; ok {
+			for i := range binding.colIsHidden {
+				binding.colIsHidden[i] = true
+			}
+		}
+	}
+}
+
+// collectIndexedTables returns the base tables of the FROM clause.
+func (builder *QueryBuilder) collectIndexedTables(tableExpr tree.TableExpr, ctx *BindContext, tables []*indexedTable) []*indexedTable {
+	switch tbl := tableExpr.(type) {
+	case *tree.JoinTableExpr:
+		tables = builder.collectIndexedTables(tbl.Left, ctx, tables)
+		if tbl.Right != nil {
+			tables = builder.collectIndexedTables(tbl.Right, ctx, tables)
+		}
+
+	case *tree.ParenTableExpr:
+		tables = builder.collectIndexedTables(tbl.Expr, ctx, tables)
+
+	case *tree.AliasedTableExpr:
+		name, ok := tbl.Expr.(*tree.TableName)
+		if !ok {
+			break
+		}
+		schema := string(name.SchemaName)
+		table := string(name.ObjectName)
+		if len(schema) == 0 {
+			if ctx.findRecursiveCTE(table) != nil || ctx.findCTE(table) != nil {
+				break
+			}
+			schema = ctx.defaultDatabase
+		}
+		obj, tableDef := builder.compCtx.Resolve(schema, table)
+		if tableDef == nil || tableDef.Pkey == nil {
+			break
+		}
+		alias := string(tbl.As.Alias)
+		if len(alias) == 0 {
+			alias = table
+		}
+		tables = append(tables, &indexedTable{
+			alias:    alias,
+			schema:   obj.SchemaName,
+			tableDef: tableDef,
+		})
+	}
+	return tables
+}
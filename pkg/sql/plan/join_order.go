@@ -545,7 +545,7 @@ func (builder *QueryBuilder) enumerateTags(nodeID int32) []int32 {
 	node := builder.qry.Nodes[nodeID]
 	if len(node.BindingTags) > 0 {
 		tags = append(tags, node.BindingTags...)
-		if node.NodeType != plan.Node_JOIN {
+		if node.NodeType != plan.Node_JOIN && node.NodeType != plan.Node_WINDOW {
 			return tags
 		}
 	}
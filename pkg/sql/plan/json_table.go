@@ -0,0 +1,154 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// JsonTablePath is a row path of json_table, which produces a row for each
+// value it matches. The nested paths are evaluated against the matched value.
+type JsonTablePath struct {
+	Path    string            `json:"path"`
+	Columns []JsonTableColumn `json:"columns"`
+	Nested  []*JsonTablePath  `json:"nested"`
+}
+
+// JsonTableColumn is a column of json_table, the type of the column is kept
+// in the table def of the function scan node.
+type JsonTableColumn struct {
+	Name    string                   `json:"name"`
+	Type    tree.JsonTableColumnType `json:"type"`
+	Path    string                   `json:"path"`
+	OnEmpty *tree.JsonTableResponse  `json:"onEmpty"`
+	OnError *tree.JsonTableResponse  `json:"onError"`
+}
+
+var jsonTableSupportedTypes = map[types.T]bool{
+	types.T_bool:       true,
+	types.T_int8:       true,
+	types.T_int16:      true,
+	types.T_int32:      true,
+	types.T_int64:      true,
+	types.T_uint8:      true,
+	types.T_uint16:     true,
+	types.T_uint32:     true,
+	types.T_uint64:     true,
+	types.T_float32:    true,
+	types.T_float64:    true,
+	types.T_decimal64:  true,
+	types.T_decimal128: true,
+	types.T_date:       true,
+	types.T_datetime:   true,
+	types.T_timestamp:  true,
+	types.T_char:       true,
+	types.T_varchar:    true,
+	types.T_text:       true,
+	types.T_json:       true,
+}
+
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) != 2 || tbl.JsonColumns == nil {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table needs a document, a path and the columns")
+	}
+	path, _ := tbl.Func.Exprs[1].(*tree.NumVal)
+	if path == nil {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "the path of json_table must be a string")
+	}
+	root := &JsonTablePath{Path: path.String()}
+	cols := make([]*ColDef, 0, len(tbl.JsonColumns))
+	if err := builder.buildJsonTablePath(root, tbl.JsonColumns, &cols); err != nil {
+		return 0, err
+	}
+	param, err := json.Marshal(root)
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  "json_table",
+				Param: param,
+			},
+			Cols: cols,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs[:1],
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// buildJsonTablePath fills the columns and the nested paths of p, the columns
+// are appended to cols in the order they are declared.
+func (builder *QueryBuilder) buildJsonTablePath(p *JsonTablePath, columns []*tree.JsonTableColumn, cols *[]*ColDef) error {
+	if _, err := types.ParseStringToPath(p.Path); err != nil {
+		return err
+	}
+	for _, col := range columns {
+		if col.Type == tree.JsonTableColumnNested {
+			nested := &JsonTablePath{Path: col.Path}
+			if err := builder.buildJsonTablePath(nested, col.Columns, cols); err != nil {
+				return err
+			}
+			p.Nested = append(p.Nested, nested)
+			continue
+		}
+
+		name := string(col.Name)
+		for _, c := range *cols {
+			if c.Name == name {
+				return moerr.NewInvalidInput(builder.GetContext(), "duplicate column name '%s' in json_table", name)
+			}
+		}
+		var typ *plan.Type
+		if col.Type == tree.JsonTableColumnOrdinality {
+			ordinalityTyp := types.T_uint32.ToType()
+			typ = makePlan2Type(&ordinalityTyp)
+		} else {
+			var err error
+			if typ, err = getTypeFromAst(builder.GetContext(), col.ColType); err != nil {
+				return err
+			}
+			if !jsonTableSupportedTypes[types.T(typ.Id)] {
+				return moerr.NewNotSupported(builder.GetContext(), "json_table column '%s' of type %s", name, types.T(typ.Id).String())
+			}
+			if _, err = types.ParseStringToPath(col.Path); err != nil {
+				return err
+			}
+		}
+		*cols = append(*cols, &ColDef{
+			Name: name,
+			Typ:  typ,
+		})
+		p.Columns = append(p.Columns, JsonTableColumn{
+			Name:    name,
+			Type:    col.Type,
+			Path:    col.Path,
+			OnEmpty: col.OnEmpty,
+			OnError: col.OnError,
+		})
+	}
+	return nil
+}
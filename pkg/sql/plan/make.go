@@ -253,6 +253,9 @@ func MakePlan2NullTextConstExprWithType(v string) *plan.Expr {
 }
 
 func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, error) {
+	if e, ok, err := makePlan2EnumCastExpr(ctx, expr, targetType); ok || err != nil {
+		return e, err
+	}
 	if isSameColumnType(expr.Typ, targetType) {
 		return expr, nil
 	}
@@ -285,6 +288,66 @@ func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr
 	}, nil
 }
 
+func isEnumType(typ *Type) bool {
+	return (typ.Id == int32(types.T_enum) || typ.Id == int32(types.T_set)) && typ.Enumvalues != ""
+}
+
+// makePlan2EnumCastExpr makes the cast between the members and the values of
+// enum and set, which is done by cast_value_to_enum, cast_value_to_set and
+// cast_index_to_value with the members of the column. ok is false if expr
+// needn't be cast in this way.
+func makePlan2EnumCastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, bool, error) {
+	var err error
+	if isEnumType(targetType) {
+		if expr.Typ.Id == targetType.Id && expr.Typ.Enumvalues == targetType.Enumvalues {
+			return expr, true, nil
+		}
+		if isEnumType(expr.Typ) {
+			if expr, err = bindFuncExprImplByPlanExpr(ctx, "cast_index_to_value", []*Expr{
+				makePlan2StringConstExprWithType(expr.Typ.Enumvalues), expr}); err != nil {
+				return nil, true, err
+			}
+		}
+		switch typ := types.T(expr.Typ.Id); {
+		case typ.IsMySQLString(), typ.IsInteger(), typ == types.T_any:
+		case typ.IsFloat(), typ.IsDecimal(), typ == types.T_bool, typ == types.T_bit, typ == types.T_year:
+			if expr, err = appendCastBeforeExpr(ctx, expr, &Type{Id: int32(types.T_int64)}); err != nil {
+				return nil, true, err
+			}
+		default:
+			if expr, err = appendCastBeforeExpr(ctx, expr, &Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}); err != nil {
+				return nil, true, err
+			}
+		}
+		name := "cast_value_to_enum"
+		if targetType.Id == int32(types.T_set) {
+			name = "cast_value_to_set"
+		}
+		notNullable := expr.Typ.NotNullable
+		if expr, err = bindFuncExprImplByPlanExpr(ctx, name, []*Expr{
+			makePlan2StringConstExprWithType(targetType.Enumvalues), expr}); err != nil {
+			return nil, true, err
+		}
+		// targetType may be the type of a column in the table def
+		typ := DeepCopyType(targetType)
+		typ.NotNullable = notNullable
+		expr.Typ = typ
+		return expr, true, nil
+	}
+	if isEnumType(expr.Typ) && types.T(targetType.Id).IsMySQLString() {
+		if expr, err = bindFuncExprImplByPlanExpr(ctx, "cast_index_to_value", []*Expr{
+			makePlan2StringConstExprWithType(expr.Typ.Enumvalues), expr}); err != nil {
+			return nil, true, err
+		}
+		if targetType.Id == int32(types.T_varchar) && targetType.Width >= types.MaxVarcharLen {
+			return expr, true, nil
+		}
+		expr, err = makePlan2CastExpr(ctx, expr, targetType)
+		return expr, true, err
+	}
+	return nil, false, nil
+}
+
 // if typ is decimal128 and decimal64 without scalar and width
 // set a default value for it.
 func rewriteDecimalTypeIfNecessary(typ *plan.Type) *plan.Type {
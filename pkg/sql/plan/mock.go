@@ -36,6 +36,8 @@ type MockCompilerContext struct {
 	id2name         map[uint64]string
 	isDml           bool
 	mysqlCompatible bool
+	// triggers of the tables, the key is db.table
+	triggers map[string][]*TriggerDef
 
 	// ctx default: nil
 	ctx context.Context
@@ -50,6 +52,10 @@ func (m *MockCompilerContext) ResolveUdf(name string, ast []*plan.Expr) (string,
 	return "", nil
 }
 
+func (m *MockCompilerContext) ResolveTriggers(tableID uint64, dbName string, tableName string) ([]*TriggerDef, error) {
+	return m.triggers[dbName+"."+tableName], nil
+}
+
 func (m *MockCompilerContext) ResolveAccountIds(accountNames []string) ([]uint32, error) {
 	return []uint32{catalog.System_Account}, nil
 }
@@ -91,6 +97,8 @@ type index struct {
 	parts      []string
 	cols       []col
 	tableExist bool
+	algo       string
+	algoParams string
 }
 
 // NewEmptyCompilerContext for test create/drop statement
@@ -267,6 +275,20 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"n_name", types.T_varchar, false, 50, 0},
 		},
 	}
+	// it's a materialized view and its hidden table
+	tpchSchema["mv1"] = &Schema{
+		cols: []col{
+			{"n_regionkey", types.T_int32, false, 0, 0},
+			{"cnt", types.T_int64, false, 0, 0},
+		},
+	}
+	tpchSchema["__mo_mv_mv1"] = &Schema{
+		cols: []col{
+			{"__mo_mv_col_0", types.T_int32, true, 0, 0},
+			{"__mo_mv_col_1", types.T_int64, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+	}
 
 	moSchema["mo_database"] = &Schema{
 		cols: []col{
@@ -570,6 +592,142 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		outcnt: 12,
 	}
 
+	/*
+		create table articles(
+			id int primary key,
+			title varchar(200),
+			body text,
+			fulltext index ft(title, body)
+		);
+	*/
+	constraintTestSchema["articles"] = &Schema{
+		cols: []col{
+			{"id", types.T_int32, false, 32, 0},
+			{"title", types.T_varchar, true, 200, 0},
+			{"body", types.T_text, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "ft",
+				tableName: catalog.IndexTableNamePrefix + "fulltext_0b7b6c1e-2c5a-11ee-8f0b-000c29847904",
+				parts:     []string{"title", "body"},
+				cols: []col{
+					{"__mo_index_idx_col", types.T_varchar, true, 65535, 0},
+				},
+				tableExist: true,
+				algo:       catalog.IndexAlgoFullText,
+			},
+		},
+		outcnt: 100,
+	}
+
+	// fulltext index table
+	constraintTestSchema[catalog.IndexTableNamePrefix+"fulltext_0b7b6c1e-2c5a-11ee-8f0b-000c29847904"] = &Schema{
+		cols: []col{
+			{"__mo_index_idx_col", types.T_varchar, false, 65535, 0},
+			{"__mo_index_word", types.T_varchar, false, 65535, 0},
+			{"__mo_index_pri_col", types.T_int32, false, 32, 0},
+			{"__mo_index_count", types.T_int64, false, 64, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 1000,
+	}
+
+	/*
+		create table items(
+			id int primary key,
+			embedding vector(3),
+			index idx_emb using ivfflat (embedding) lists = 10
+		);
+	*/
+	constraintTestSchema["items"] = &Schema{
+		cols: []col{
+			{"id", types.T_int32, false, 32, 0},
+			{"embedding", types.T_array_float32, true, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "idx_emb",
+				tableName: catalog.IndexTableNamePrefix + "ivfflat_2d7b1c3a-5f1e-11ee-8c99-0242ac120002",
+				parts:     []string{"embedding"},
+				cols: []col{
+					{"__mo_index_idx_col", types.T_varchar, true, 65535, 0},
+				},
+				tableExist: true,
+				algo:       catalog.IndexAlgoIvfFlat,
+			},
+		},
+		outcnt: 100,
+	}
+
+	// ivfflat index table and its centroids table
+	constraintTestSchema[catalog.IndexTableNamePrefix+"ivfflat_2d7b1c3a-5f1e-11ee-8c99-0242ac120002"] = &Schema{
+		cols: []col{
+			{"__mo_index_idx_col", types.T_varchar, false, 65535, 0},
+			{"__mo_index_list", types.T_int32, false, 32, 0},
+			{"__mo_index_pri_col", types.T_int32, false, 32, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100,
+	}
+	constraintTestSchema[catalog.IndexTableNamePrefix+"ivfflat_2d7b1c3a-5f1e-11ee-8c99-0242ac120002_centroids"] = &Schema{
+		cols: []col{
+			{"__mo_index_list", types.T_int32, false, 32, 0},
+			{"__mo_index_vector", types.T_array_float32, false, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 10,
+	}
+
+	/*
+		create table docs(
+			id int primary key,
+			doc json,
+			index idx_user ((cast(doc->>'$.user_id' as unsigned)))
+		);
+	*/
+	constraintTestSchema["docs"] = &Schema{
+		cols: []col{
+			{"id", types.T_int32, false, 32, 0},
+			{"doc", types.T_json, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "idx_user",
+				tableName: catalog.IndexTableNamePrefix + "expression_5c1f3e2a-6b7d-11ee-9a4b-0242ac120002",
+				parts:     []string{"doc"},
+				cols: []col{
+					{"__mo_index_idx_col", types.T_varchar, true, 65535, 0},
+				},
+				tableExist: true,
+				algo:       catalog.IndexAlgoExpression,
+				algoParams: "cast(json_unquote(json_extract(doc, '$.user_id')) as unsigned)",
+			},
+		},
+		outcnt: 100,
+	}
+
+	// expression index table
+	constraintTestSchema[catalog.IndexTableNamePrefix+"expression_5c1f3e2a-6b7d-11ee-9a4b-0242ac120002"] = &Schema{
+		cols: []col{
+			{"__mo_index_idx_col", types.T_varchar, false, 65535, 0},
+			{"__mo_index_value", types.T_uint64, false, 64, 0},
+			{"__mo_index_pri_col", types.T_int32, false, 32, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100,
+	}
+
 	objects := make(map[string]*ObjectRef)
 	tables := make(map[string]*TableDef)
 	stats := make(map[string]*Stats)
@@ -619,14 +777,23 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				Indexes:   make([]*IndexDef, len(table.idxs)),
 			}
 
+			if len(table.pks) == 1 {
+				tableDef.Pkey = &plan.PrimaryKeyDef{
+					PkeyColName: table.cols[table.pks[0]].Name,
+					Names:       []string{table.cols[table.pks[0]].Name},
+				}
+			}
+
 			if table.idxs != nil {
 				for i, idx := range table.idxs {
 					indexdef := &plan.IndexDef{
-						IndexName:      idx.indexName,
-						Parts:          idx.parts,
-						Unique:         true,
-						IndexTableName: idx.tableName,
-						TableExist:     true,
+						IndexName:       idx.indexName,
+						Parts:           idx.parts,
+						Unique:          idx.algo == "",
+						IndexTableName:  idx.tableName,
+						TableExist:      true,
+						IndexAlgo:       idx.algo,
+						IndexAlgoParams: idx.algoParams,
 					}
 					tableDef.Indexes[i] = indexdef
 				}
@@ -642,7 +809,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				}
 			}
 
-			if tableName != "v1" {
+			if tableName != "v1" && tableName != "mv1" {
 				properties := []*plan.Property{
 					{
 						Key:   catalog.SystemRelAttr_Kind,
@@ -699,6 +866,25 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				})
 			}
 
+			if tableName == "mv1" {
+				tableDef.TableType = catalog.SystemViewRel
+				viewData, _ := json.Marshal(ViewData{
+					Stmt:            "create materialized view mv1 as select n_regionkey, count(*) as cnt from nation group by n_regionkey",
+					DefaultDatabase: "tpch",
+					Materialized: &MaterializedViewData{
+						Table:        "__mo_mv_mv1",
+						BaseDatabase: "tpch",
+						BaseTable:    "nation",
+						Incremental:  true,
+						Aggregate:    true,
+						MergeFuncs:   []string{"", "sum"},
+					},
+				})
+				tableDef.ViewSql = &plan.ViewDef{
+					View: string(viewData),
+				}
+			}
+
 			tables[tableName] = tableDef
 			id2name[tableDef.TblId] = tableName
 			tableIdx++
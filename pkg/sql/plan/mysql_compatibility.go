@@ -19,7 +19,7 @@ import "github.com/matrixorigin/matrixone/pkg/pb/plan"
 func (builder *QueryBuilder) wrapBareColRefsInAnyValue(expr *plan.Expr, ctx *BindContext) *plan.Expr {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_Col:
-		if exprImpl.Col.RelPos == ctx.groupTag || exprImpl.Col.RelPos == ctx.aggregateTag {
+		if exprImpl.Col.RelPos == ctx.groupTag || exprImpl.Col.RelPos == ctx.aggregateTag || exprImpl.Col.RelPos == ctx.windowTag {
 			return expr
 		}
 		newExpr, _ := bindFuncExprImplByPlanExpr(builder.compCtx.GetContext(), "any_value", []*plan.Expr{expr})
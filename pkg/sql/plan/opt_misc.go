@@ -30,6 +30,16 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 	for i := range node.OrderBy {
 		increaseRefCnt(node.OrderBy[i].Expr, colRefCnt)
 	}
+	if node.WinSpec != nil {
+		increaseRefCnt(node.WinSpec.WindowFunc, colRefCnt)
+		increaseRefCntForExprList(node.WinSpec.PartitionBy, colRefCnt)
+		for i := range node.WinSpec.OrderBy {
+			increaseRefCnt(node.WinSpec.OrderBy[i].Expr, colRefCnt)
+		}
+	}
+	for _, target := range node.LockTargets {
+		colRefCnt[[2]int32{target.PrimaryColRelPos, target.PrimaryColIdxInBat}]++
+	}
 
 	switch node.NodeType {
 	case plan.Node_JOIN:
@@ -76,6 +86,21 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 	for i := range node.OrderBy {
 		node.OrderBy[i].Expr = removeProjectionsForExpr(node.OrderBy[i].Expr, projMap)
 	}
+	if node.WinSpec != nil {
+		node.WinSpec.WindowFunc = removeProjectionsForExpr(node.WinSpec.WindowFunc, projMap)
+		removeProjectionsForExprList(node.WinSpec.PartitionBy, projMap)
+		for i := range node.WinSpec.OrderBy {
+			node.WinSpec.OrderBy[i].Expr = removeProjectionsForExpr(node.WinSpec.OrderBy[i].Expr, projMap)
+		}
+	}
+	for _, target := range node.LockTargets {
+		if projExpr, ok := projMap[[2]int32{target.PrimaryColRelPos, target.PrimaryColIdxInBat}]; ok {
+			if col, ok := projExpr.Expr.(*plan.Expr_Col); ok {
+				target.PrimaryColRelPos = col.Col.RelPos
+				target.PrimaryColIdxInBat = col.Col.ColPos
+			}
+		}
+	}
 
 	if builder.canRemoveProject(parentType, node) {
 		allColRef := true
@@ -131,6 +156,9 @@ func (builder *QueryBuilder) canRemoveProject(parentType plan.Node_NodeType, nod
 	if parentType == plan.Node_FUNCTION_SCAN || parentType == plan.Node_EXTERNAL_FUNCTION {
 		return false
 	}
+	if parentType == plan.Node_RECURSIVE_CTE {
+		return false
+	}
 
 	childType := builder.qry.Nodes[node.Children[0]].NodeType
 	if childType == plan.Node_VALUE_SCAN || childType == plan.Node_EXTERNAL_SCAN {
@@ -200,6 +228,42 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 
 		node.Children[0] = childID
 
+	case plan.Node_WINDOW:
+		// filters above a window function can't be pushed down, since they
+		// change the rows of the partitions
+		cantPushdown = filters
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], nil, separateNonEquiConds)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
+	case plan.Node_RECURSIVE_CTE:
+		// filters above a recursive CTE can't be pushed down, since the
+		// recursive member depends on all the rows of the last iteration
+		cantPushdown = filters
+
+		for i, childID := range node.Children {
+			newChildID, cantPushdownChild := builder.pushdownFilters(childID, nil, separateNonEquiConds)
+
+			if len(cantPushdownChild) > 0 {
+				newChildID = builder.appendNode(&plan.Node{
+					NodeType:   plan.Node_FILTER,
+					Children:   []int32{childID},
+					FilterList: cantPushdownChild,
+				}, nil)
+			}
+
+			node.Children[i] = newChildID
+		}
+
 	case plan.Node_FILTER:
 		canPushdown = filters
 		for _, filter := range node.FilterList {
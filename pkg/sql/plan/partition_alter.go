@@ -0,0 +1,407 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// buildAlterTablePartition builds ADD, DROP, TRUNCATE, REORGANIZE and EXCHANGE PARTITION.
+// The partitions are changed on the syntax of the partition option kept by PartitionMsg,
+// then the partition definition is built again as CREATE TABLE does.
+// The rows of the partitions dropped, truncated or exchanged are selected by the filter
+// on the partition expression, which evaluates the index of the partition of a row.
+func buildAlterTablePartition(ctx CompilerContext, tableDef *TableDef, opt *tree.AlterOptionPartition) (*plan.AlterTablePartition, error) {
+	partitionDef := tableDef.Partition
+	if partitionDef == nil {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "partition management on a not partitioned table is not possible")
+	}
+	partitionOp, err := parsePartitionMsg(ctx, partitionDef.PartitionMsg)
+	if err != nil {
+		return nil, err
+	}
+	indexExpr, err := buildPartitionIndexAst(ctx, partitionOp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the partitions of HASH and KEY may be decided by the number only
+	parts := partitionOp.Partitions
+	if len(parts) == 0 {
+		for _, item := range partitionDef.Partitions {
+			parts = append(parts, &tree.Partition{Name: tree.Identifier(item.PartitionName)})
+		}
+	}
+	findParts := func(names tree.IdentifierList, op string) ([]int, error) {
+		idxs := make([]int, 0, len(names))
+		for _, name := range names {
+			found := false
+			for i, part := range parts {
+				if strings.EqualFold(string(part.Name), string(name)) {
+					idxs = append(idxs, i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "error in list of partitions to %s", op)
+			}
+		}
+		sort.Ints(idxs)
+		return idxs, nil
+	}
+	filterOf := func(idxs []int) string {
+		vals := make([]string, len(idxs))
+		for i, idx := range idxs {
+			vals[i] = fmt.Sprintf("%d", idx)
+		}
+		return fmt.Sprintf("(%s) in (%s)", tree.String(indexExpr, dialect.MYSQL), strings.Join(vals, ", "))
+	}
+	isRangeOrList := false
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS, plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		isRangeOrList = true
+	}
+
+	alterPartition := &plan.AlterTablePartition{}
+	var newParts []*tree.Partition
+	switch opt.Typ {
+	case tree.AlterPartitionAdd:
+		alterPartition.Typ = plan.AlterTablePartition_ADD
+		newParts = append(newParts, parts...)
+		if opt.Num > 0 {
+			if isRangeOrList {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "each partition must be defined for RANGE and LIST partitions")
+			}
+			for i := len(parts); uint64(len(newParts)-len(parts)) < opt.Num; i++ {
+				name := tree.Identifier(fmt.Sprintf("p%d", i))
+				if _, err := findParts(tree.IdentifierList{name}, "add"); err == nil {
+					continue
+				}
+				newParts = append(newParts, &tree.Partition{Name: name})
+			}
+		} else {
+			newParts = append(newParts, opt.Partitions...)
+		}
+
+	case tree.AlterPartitionDrop:
+		alterPartition.Typ = plan.AlterTablePartition_DROP
+		if !isRangeOrList {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "DROP PARTITION can only be used on RANGE and LIST partitions")
+		}
+		idxs, err := findParts(opt.Names, "drop")
+		if err != nil {
+			return nil, err
+		}
+		for i, part := range parts {
+			if !containsIndex(idxs, i) {
+				newParts = append(newParts, part)
+			}
+		}
+		if len(newParts) == 0 {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "cannot remove all partitions, use DROP TABLE instead")
+		}
+		alterPartition.Filter = filterOf(idxs)
+
+	case tree.AlterPartitionTruncate:
+		alterPartition.Typ = plan.AlterTablePartition_TRUNCATE
+		if !opt.AllPartitions {
+			idxs, err := findParts(opt.Names, "truncate")
+			if err != nil {
+				return nil, err
+			}
+			alterPartition.Filter = filterOf(idxs)
+		}
+
+	case tree.AlterPartitionReorganize:
+		alterPartition.Typ = plan.AlterTablePartition_REORGANIZE
+		if !isRangeOrList {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "reorganize the partitions of HASH or KEY partitioned table")
+		}
+		idxs, err := findParts(opt.Names, "reorganize")
+		if err != nil {
+			return nil, err
+		}
+		if partitionDef.Type == plan.PartitionType_RANGE || partitionDef.Type == plan.PartitionType_RANGE_COLUMNS {
+			for i := range idxs {
+				if idxs[i] != idxs[0]+i {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "the reorganized partitions must be in consecutive order")
+				}
+			}
+		}
+		// the new partitions take the place of the first reorganized one
+		for i, part := range parts {
+			if i == idxs[0] {
+				newParts = append(newParts, opt.Partitions...)
+			} else if !containsIndex(idxs, i) {
+				newParts = append(newParts, part)
+			}
+		}
+
+	case tree.AlterPartitionExchange:
+		alterPartition.Typ = plan.AlterTablePartition_EXCHANGE
+		idxs, err := findParts(opt.Names, "exchange")
+		if err != nil {
+			return nil, err
+		}
+		alterPartition.Filter = filterOf(idxs)
+		if err = checkExchangeTable(ctx, tableDef, opt.Table, alterPartition); err != nil {
+			return nil, err
+		}
+		alterPartition.WithoutValidation = opt.WithoutValidation
+	}
+
+	if newParts == nil {
+		return alterPartition, nil
+	}
+	newPartitionOp := &tree.PartitionOption{
+		PartBy:     partitionOp.PartBy,
+		SubPartBy:  partitionOp.SubPartBy,
+		Partitions: newParts,
+	}
+	if newPartitionOp.PartBy.Num != 0 {
+		newPartitionOp.PartBy.Num = uint64(len(newParts))
+	}
+	newDef, err := buildNewPartitionDef(ctx, tableDef, newPartitionOp)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkRangePartitionIncreasing(ctx, newDef); err != nil {
+		return nil, err
+	}
+	if alterPartition.Typ == plan.AlterTablePartition_REORGANIZE {
+		newIndexExpr, err := buildPartitionIndexAst(ctx, newPartitionOp)
+		if err != nil {
+			return nil, err
+		}
+		// the rows of the reorganized partitions must be kept by the new partitions
+		alterPartition.CheckFilter = fmt.Sprintf("(%s) = -1 and (%s) != -1",
+			tree.String(newIndexExpr, dialect.MYSQL), tree.String(indexExpr, dialect.MYSQL))
+	}
+
+	// the partition tables of the partitions kept by name are not changed
+	oldTableNames := make(map[string]string)
+	for _, item := range partitionDef.Partitions {
+		oldTableNames[item.PartitionName] = item.PartitionTableName
+	}
+	cols := make([]*ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			cols = append(cols, col)
+		}
+	}
+	newDef.PartitionTableNames = make([]string, len(newDef.Partitions))
+	for i, item := range newDef.Partitions {
+		if name, ok := oldTableNames[item.PartitionName]; ok {
+			item.PartitionTableName = name
+			delete(oldTableNames, item.PartitionName)
+		} else {
+			ok, name := util.MakeNameOfPartitionTable(item.PartitionName, tableDef.Name)
+			if !ok {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid partition table name %s", name)
+			}
+			item.PartitionTableName = name
+			alterPartition.AddPartitionTables = append(alterPartition.AddPartitionTables, makePartitionTableDef(name, cols))
+		}
+		newDef.PartitionTableNames[i] = item.PartitionTableName
+	}
+	for _, item := range partitionDef.Partitions {
+		if name, ok := oldTableNames[item.PartitionName]; ok {
+			alterPartition.DropPartitionTables = append(alterPartition.DropPartitionTables, name)
+		}
+	}
+	alterPartition.PartitionDef = newDef
+	return alterPartition, nil
+}
+
+// parsePartitionMsg parses the partition option kept by PartitionMsg
+func parsePartitionMsg(ctx CompilerContext, partitionMsg string) (*tree.PartitionOption, error) {
+	stmt, err := parsers.ParseOne(ctx.GetContext(), dialect.MYSQL, "create table t (a int) "+partitionMsg, 1)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*tree.CreateTable)
+	if !ok || createTable.PartitionOption == nil {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "invalid partition definition %s", partitionMsg)
+	}
+	return createTable.PartitionOption, nil
+}
+
+// buildPartitionIndexAst returns the ast of the expression evaluating the index of the
+// partition of a row, which is the same as the PartitionExpression of the partition builders.
+func buildPartitionIndexAst(ctx CompilerContext, partitionOp *tree.PartitionOption) (tree.Expr, error) {
+	switch partitionType := partitionOp.PartBy.PType.(type) {
+	case *tree.HashType:
+		return genPartitionAst(tree.Exprs{partitionType.Expr}, int64(partitionNumOf(partitionOp))), nil
+	case *tree.KeyType:
+		exprs := make(tree.Exprs, len(partitionType.ColumnList))
+		for i, col := range partitionType.ColumnList {
+			exprs[i] = col
+		}
+		return genPartitionAst(exprs, int64(partitionNumOf(partitionOp))), nil
+	case *tree.RangeType:
+		if partitionType.ColumnList == nil {
+			return buildRangeCaseWhenExpr(partitionType.Expr, partitionOp.Partitions)
+		}
+		return buildRangeColumnsCaseWhenExpr(partitionType.ColumnList, partitionOp.Partitions)
+	case *tree.ListType:
+		if partitionType.ColumnList == nil {
+			return buildListCaseWhenExpr(partitionType.Expr, partitionOp.Partitions)
+		}
+		return buildListColumnsCaseWhenExpr(partitionType.ColumnList, partitionOp.Partitions)
+	}
+	return nil, moerr.NewNotSupported(ctx.GetContext(), "partition type %s", tree.String(partitionOp.PartBy.PType, dialect.MYSQL))
+}
+
+func partitionNumOf(partitionOp *tree.PartitionOption) uint64 {
+	if len(partitionOp.Partitions) > 0 {
+		return uint64(len(partitionOp.Partitions))
+	}
+	return partitionOp.PartBy.Num
+}
+
+// buildNewPartitionDef builds the partition definition of the table from the partition option
+func buildNewPartitionDef(ctx CompilerContext, tableDef *TableDef, partitionOp *tree.PartitionOption) (*plan.PartitionByDef, error) {
+	newTableDef := DeepCopyTableDef(tableDef)
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    newTableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, err
+	}
+	partitionBinder := NewPartitionBinder(builder, bindContext)
+	stmt := &tree.CreateTable{PartitionOption: partitionOp}
+	if err := buildPartitionByClause(ctx.GetContext(), partitionBinder, stmt, newTableDef); err != nil {
+		return nil, err
+	}
+	return newTableDef.Partition, nil
+}
+
+// checkExchangeTable checks the table exchanged with the partition has the same columns
+// as the partitioned table.
+func checkExchangeTable(ctx CompilerContext, tableDef *TableDef, table *tree.TableName, alterPartition *plan.AlterTablePartition) error {
+	dbName := string(table.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	tblName := string(table.ObjectName)
+	obj, exchangeDef := ctx.Resolve(dbName, tblName)
+	if exchangeDef == nil {
+		return moerr.NewNoSuchTable(ctx.GetContext(), dbName, tblName)
+	}
+	if exchangeDef.TblId == tableDef.TblId {
+		return moerr.NewInvalidInput(ctx.GetContext(), "can't exchange the partition with the table itself")
+	}
+	if exchangeDef.ViewSql != nil || obj.PubAccountId != -1 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "can't exchange the partition with %s", tblName)
+	}
+	if exchangeDef.Partition != nil {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table to exchange with partition is partitioned: '%s'", tblName)
+	}
+	visibleCols := func(def *TableDef) []*ColDef {
+		var cols []*ColDef
+		for _, col := range def.Cols {
+			if !col.Hidden {
+				cols = append(cols, col)
+			}
+		}
+		return cols
+	}
+	cols, exchangeCols := visibleCols(tableDef), visibleCols(exchangeDef)
+	if len(cols) != len(exchangeCols) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+	}
+	for i := range cols {
+		if cols[i].Name != exchangeCols[i].Name || !isSameColumnType(cols[i].Typ, exchangeCols[i].Typ) {
+			return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+		}
+	}
+	alterPartition.ExchangeDatabase = dbName
+	alterPartition.ExchangeTable = tblName
+	return nil
+}
+
+// checkRangePartitionIncreasing checks VALUES LESS THAN of the RANGE partitions is
+// strictly increasing, otherwise the added or reorganized partition can't have any row.
+func checkRangePartitionIncreasing(ctx CompilerContext, partitionDef *plan.PartitionByDef) error {
+	if partitionDef.Type != plan.PartitionType_RANGE {
+		return nil
+	}
+	var prev int64
+	for i, item := range partitionDef.Partitions {
+		if len(item.LessThan) != 1 {
+			continue
+		}
+		if _, ok := item.LessThan[0].Expr.(*plan.Expr_Max); ok {
+			if i != len(partitionDef.Partitions)-1 {
+				return moerr.NewInvalidInput(ctx.GetContext(), "MAXVALUE can only be used in last partition definition")
+			}
+			continue
+		}
+		expr, err := EvalPlanExpr(ctx.GetContext(), item.LessThan[0], ctx.GetProcess())
+		if err != nil {
+			return err
+		}
+		var val int64
+		switch v := expr.GetC().GetValue().(type) {
+		case *plan.Const_I8Val:
+			val = int64(v.I8Val)
+		case *plan.Const_I16Val:
+			val = int64(v.I16Val)
+		case *plan.Const_I32Val:
+			val = int64(v.I32Val)
+		case *plan.Const_I64Val:
+			val = v.I64Val
+		case *plan.Const_U8Val:
+			val = int64(v.U8Val)
+		case *plan.Const_U16Val:
+			val = int64(v.U16Val)
+		case *plan.Const_U32Val:
+			val = int64(v.U32Val)
+		case *plan.Const_U64Val:
+			val = int64(v.U64Val)
+		default:
+			continue
+		}
+		if i > 0 && val <= prev {
+			return moerr.NewInvalidInput(ctx.GetContext(), "VALUES LESS THAN value must be strictly increasing for each partition")
+		}
+		prev = val
+	}
+	return nil
+}
+
+func containsIndex(idxs []int, idx int) bool {
+	for _, i := range idxs {
+		if i == idx {
+			return true
+		}
+	}
+	return false
+}
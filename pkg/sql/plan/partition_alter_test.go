@@ -0,0 +1,115 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func buildAlterPartitionForTest(t *testing.T, tableDef *TableDef, sql string) (*plan.AlterTablePartition, error) {
+	stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+	opt := stmt.(*tree.AlterTable).Options[0].(*tree.AlterOptionPartition)
+	return buildAlterTablePartition(NewMockOptimizer(false).CurrentContext(), tableDef, opt)
+}
+
+func TestAlterTableRangePartition(t *testing.T) {
+	tableDef := buildPartitionedTableDef(t, `CREATE TABLE event_log (
+				id INT NOT NULL,
+				year_col INT NOT NULL
+			)
+			PARTITION BY RANGE (year_col) (
+				PARTITION p0 VALUES LESS THAN (2020),
+				PARTITION p1 VALUES LESS THAN (2021),
+				PARTITION p2 VALUES LESS THAN (2022)
+			);`)
+
+	alter, err := buildAlterPartitionForTest(t, tableDef, "alter table event_log add partition (partition p3 values less than (2023))")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), alter.PartitionDef.PartitionNum)
+	require.Equal(t, 1, len(alter.AddPartitionTables))
+	require.Equal(t, "%!%p3%!%event_log", alter.AddPartitionTables[0].Name)
+	// the partition tables of the existing partitions are kept
+	require.Equal(t, tableDef.Partition.PartitionTableNames, alter.PartitionDef.PartitionTableNames[:3])
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log add partition (partition p3 values less than (2021))")
+	require.Error(t, err)
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log add partition (partition p1 values less than (2023))")
+	require.Error(t, err)
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log add partition partitions 2")
+	require.Error(t, err)
+
+	alter, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log drop partition p0, p2")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), alter.PartitionDef.PartitionNum)
+	require.Equal(t, []string{"%!%p0%!%event_log", "%!%p2%!%event_log"}, alter.DropPartitionTables)
+	require.Contains(t, alter.Filter, ") in (0, 2)")
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log drop partition p0, p1, p2")
+	require.Error(t, err)
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log drop partition p5")
+	require.Error(t, err)
+
+	alter, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log truncate partition p1")
+	require.NoError(t, err)
+	require.Nil(t, alter.PartitionDef)
+	require.Contains(t, alter.Filter, ") in (1)")
+	alter, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log truncate partition all")
+	require.NoError(t, err)
+	require.Equal(t, "", alter.Filter)
+
+	alter, err = buildAlterPartitionForTest(t, tableDef, `alter table event_log reorganize partition p1, p2 into (
+				partition p1 values less than (2021),
+				partition p2a values less than (2021),
+				partition p2b values less than (2022))`)
+	require.Error(t, err)
+	alter, err = buildAlterPartitionForTest(t, tableDef, `alter table event_log reorganize partition p1, p2 into (
+				partition p1 values less than (2021),
+				partition p2a values less than (2022),
+				partition p3 values less than maxvalue)`)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), alter.PartitionDef.PartitionNum)
+	require.Equal(t, 2, len(alter.AddPartitionTables))
+	require.Equal(t, []string{"%!%p2%!%event_log"}, alter.DropPartitionTables)
+	require.NotEmpty(t, alter.CheckFilter)
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log reorganize partition p0, p2 into (partition p0 values less than (2022))")
+	require.Error(t, err)
+
+	// the table exchanged with the partition must exist
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table event_log exchange partition p0 with table not_exists")
+	require.Error(t, err)
+}
+
+func TestAlterTableHashPartition(t *testing.T) {
+	tableDef := buildPartitionedTableDef(t, `CREATE TABLE t1 (a INT, b INT) PARTITION BY HASH(a) PARTITIONS 2;`)
+	alter, err := buildAlterPartitionForTest(t, tableDef, "alter table t1 add partition partitions 2")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), alter.PartitionDef.PartitionNum)
+	require.Equal(t, "p3", alter.PartitionDef.Partitions[3].PartitionName)
+	require.Equal(t, 2, len(alter.AddPartitionTables))
+
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table t1 drop partition p0")
+	require.Error(t, err)
+	_, err = buildAlterPartitionForTest(t, tableDef, "alter table t1 reorganize partition p0 into (partition p0)")
+	require.Error(t, err)
+	alter, err = buildAlterPartitionForTest(t, tableDef, "alter table t1 truncate partition p1")
+	require.NoError(t, err)
+	require.Contains(t, alter.Filter, "% 2) in (1)")
+}
@@ -0,0 +1,495 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// maxPruneCombinations is the max number of the combinations of the values of
+// the partition keys the partition expression is evaluated with for a filter.
+const maxPruneCombinations = 256
+
+// partitionSet is the set of the partitions which may have the rows satisfying
+// a filter, nil means all the partitions.
+type partitionSet []bool
+
+func (s partitionSet) intersect(o partitionSet) partitionSet {
+	if s == nil {
+		return o
+	}
+	if o == nil {
+		return s
+	}
+	for i := range s {
+		s[i] = s[i] && o[i]
+	}
+	return s
+}
+
+func (s partitionSet) union(o partitionSet) partitionSet {
+	if s == nil || o == nil {
+		return nil
+	}
+	for i := range s {
+		s[i] = s[i] || o[i]
+	}
+	return s
+}
+
+type partitionPruner struct {
+	proc     *process.Process
+	tableDef *TableDef
+	def      *plan.PartitionByDef
+	// keys are the positions of the partition keys in the columns of the table
+	keys map[int32]bool
+	bat  *batch.Batch
+}
+
+// PrunePartitions returns the indexes of the partitions which may have the rows
+// satisfying the filters of a table scan. The filters are evaluated at compile
+// time, so the values of the parameters of the prepared statements and the
+// variables are used to prune the partitions too. It returns false if none of
+// the partitions can be pruned.
+//
+// The values produced at runtime don't prune the partitions: there are no
+// runtime filters to pass the keys of the build side of a join to the scan of
+// the probe side, so such a scan reads all the partitions.
+//
+// The rows of a partitioned table are kept by the main table, so the pruned
+// partitions only save the ranges of the partition tables, the blocks of the
+// main table are pruned by the filters of PartitionBlockFilters.
+func PrunePartitions(proc *process.Process, tableDef *TableDef, filters []*plan.Expr) ([]int, bool) {
+	if len(filters) == 0 {
+		return nil, false
+	}
+	p, ok := newPartitionPruner(proc, tableDef)
+	if !ok {
+		return nil, false
+	}
+	conds, ok := p.foldFilters(filters)
+	if !ok {
+		return nil, false
+	}
+	set := p.pruneAnd(conds)
+	if set == nil {
+		return nil, false
+	}
+	parts := make([]int, 0, len(set))
+	for i, ok := range set {
+		if ok {
+			parts = append(parts, i)
+		}
+	}
+	return parts, true
+}
+
+// PartitionBlockFilters returns the filters of a table scan which only refer
+// to the partition keys, folded with the values of the parameters and the
+// variables. The blocks of the main table of a partitioned table are pruned by
+// the zonemaps of the partition keys with them, whether or not the statistics
+// of the table take them as selective block filters.
+func PartitionBlockFilters(proc *process.Process, tableDef *TableDef, filters []*plan.Expr) []*plan.Expr {
+	if len(filters) == 0 {
+		return nil
+	}
+	p, ok := newPartitionPruner(proc, tableDef)
+	if !ok {
+		return nil
+	}
+	conds, ok := p.foldFilters(filters)
+	if !ok {
+		return nil
+	}
+	var blockFilters []*plan.Expr
+	for _, cond := range conds {
+		if p.onlyKeys(cond) {
+			blockFilters = append(blockFilters, cond)
+		}
+	}
+	return blockFilters
+}
+
+func newPartitionPruner(proc *process.Process, tableDef *TableDef) (*partitionPruner, bool) {
+	def := tableDef.GetPartition()
+	if proc == nil || def == nil || def.PartitionExpression == nil || len(tableDef.Name2ColIndex) == 0 {
+		return nil, false
+	}
+	p := &partitionPruner{
+		proc:     proc,
+		tableDef: tableDef,
+		def:      def,
+		keys:     make(map[int32]bool),
+	}
+	p.bat = batch.NewWithSize(0)
+	p.bat.Zs = []int64{1}
+	collectPartitionKeys(def.PartitionExpression, p.keys)
+	if len(p.keys) == 0 {
+		return nil, false
+	}
+	return p, true
+}
+
+// foldFilters folds the copies of the filters.
+func (p *partitionPruner) foldFilters(filters []*plan.Expr) ([]*plan.Expr, bool) {
+	conds := make([]*plan.Expr, len(filters))
+	for i, filter := range filters {
+		expr, err := ConstantFold(p.bat, DeepCopyExpr(filter), p.proc)
+		if err != nil {
+			return nil, false
+		}
+		conds[i] = expr
+	}
+	return conds, true
+}
+
+// onlyKeys checks whether the expression refers to the partition keys and no
+// other column.
+func (p *partitionPruner) onlyKeys(expr *plan.Expr) bool {
+	hasKey := false
+	var visit func(e *plan.Expr) bool
+	visit = func(e *plan.Expr) bool {
+		switch ex := e.Expr.(type) {
+		case *plan.Expr_Col:
+			if _, ok := p.keyOf(e); !ok {
+				return false
+			}
+			hasKey = true
+		case *plan.Expr_F:
+			for _, arg := range ex.F.Args {
+				if !visit(arg) {
+					return false
+				}
+			}
+		case *plan.Expr_List:
+			for _, arg := range ex.List.List {
+				if !visit(arg) {
+					return false
+				}
+			}
+		case *plan.Expr_C, *plan.Expr_T:
+		default:
+			return false
+		}
+		return true
+	}
+	return visit(expr) && hasKey
+}
+
+func collectPartitionKeys(expr *plan.Expr, keys map[int32]bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		keys[e.Col.ColPos] = true
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			collectPartitionKeys(arg, keys)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			collectPartitionKeys(arg, keys)
+		}
+	}
+}
+
+func (p *partitionPruner) emptySet() partitionSet {
+	return make(partitionSet, p.def.PartitionNum)
+}
+
+// keyOf returns the partition key the column of the filter is, and false if
+// the column is not a partition key.
+func (p *partitionPruner) keyOf(e *plan.Expr) (int32, bool) {
+	col, ok := e.Expr.(*plan.Expr_Col)
+	if !ok || int(col.Col.ColPos) >= len(p.tableDef.Cols) {
+		return 0, false
+	}
+	pos, ok := p.tableDef.Name2ColIndex[p.tableDef.Cols[col.Col.ColPos].Name]
+	if !ok || !p.keys[pos] {
+		return 0, false
+	}
+	return pos, true
+}
+
+// pruneAnd returns the partitions which may have the rows satisfying all the
+// conditions.
+func (p *partitionPruner) pruneAnd(conds []*plan.Expr) partitionSet {
+	var set partitionSet
+	// the candidate values of the partition keys by the equality and IN conditions
+	values := make(map[int32][]*plan.Expr)
+	for _, cond := range conds {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok {
+			continue
+		}
+		args := f.F.Args
+		switch name := f.F.Func.ObjName; name {
+		case "and":
+			set = set.intersect(p.pruneAnd(args))
+		case "or":
+			set = set.intersect(p.pruneAnd(args[:1]).union(p.pruneAnd(args[1:])))
+		case "=":
+			if key, val, ok := p.keyAndValue(args[0], args[1]); ok {
+				values[key] = p.narrow(values[key], []*plan.Expr{val})
+			} else if key, val, ok = p.keyAndValue(args[1], args[0]); ok {
+				values[key] = p.narrow(values[key], []*plan.Expr{val})
+			}
+		case "in":
+			list, ok := args[1].Expr.(*plan.Expr_List)
+			if !ok {
+				continue
+			}
+			key, ok := p.keyOf(args[0])
+			if !ok {
+				continue
+			}
+			vals := make([]*plan.Expr, 0, len(list.List.List))
+			for _, arg := range list.List.List {
+				if c, ok := arg.Expr.(*plan.Expr_C); ok && !c.C.Isnull {
+					vals = append(vals, p.castToKey(arg, args[0].Typ))
+				} else {
+					vals = nil
+					break
+				}
+			}
+			if vals != nil {
+				values[key] = p.narrow(values[key], vals)
+			}
+		case "<", "<=", ">", ">=":
+			if key, val, ok := p.keyAndValue(args[0], args[1]); ok {
+				set = set.intersect(p.pruneRange(key, name, val))
+			} else if key, val, ok = p.keyAndValue(args[1], args[0]); ok {
+				set = set.intersect(p.pruneRange(key, reverseCompare(name), val))
+			}
+		}
+	}
+	if len(values) == len(p.keys) {
+		set = set.intersect(p.pruneValues(values))
+	}
+	return set
+}
+
+// keyAndValue returns the partition key and the constant compared with it.
+func (p *partitionPruner) keyAndValue(left, right *plan.Expr) (int32, *plan.Expr, bool) {
+	key, ok := p.keyOf(left)
+	if !ok {
+		return 0, nil, false
+	}
+	c, ok := right.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, nil, false
+	}
+	return key, p.castToKey(right, left.Typ), true
+}
+
+// castToKey casts the constant to the type of the partition key, so the
+// partition expression is evaluated as it is when the row is inserted.
+func (p *partitionPruner) castToKey(val *plan.Expr, typ *plan.Type) *plan.Expr {
+	if val.Typ.Id == typ.Id {
+		return val
+	}
+	targetType := *typ
+	expr, err := makePlan2CastExpr(p.proc.Ctx, DeepCopyExpr(val), &targetType)
+	if err != nil {
+		return val
+	}
+	return expr
+}
+
+// narrow keeps the fewer candidate values of a key, the values of the both
+// conditions are not intersected since the constants are not compared here.
+func (p *partitionPruner) narrow(old, vals []*plan.Expr) []*plan.Expr {
+	if old != nil && len(old) <= len(vals) {
+		return old
+	}
+	return vals
+}
+
+// pruneValues evaluates the partition expression with all the combinations of
+// the candidate values of the partition keys.
+func (p *partitionPruner) pruneValues(values map[int32][]*plan.Expr) partitionSet {
+	keys := make([]int32, 0, len(values))
+	combinations := 1
+	for key, vals := range values {
+		keys = append(keys, key)
+		combinations *= len(vals)
+		if combinations > maxPruneCombinations {
+			return nil
+		}
+	}
+	set := p.emptySet()
+	row := make(map[int32]*plan.Expr, len(keys))
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		if i == len(keys) {
+			idx, ok := p.locate(row)
+			if !ok {
+				return false
+			}
+			if idx >= 0 && idx < len(set) {
+				set[idx] = true
+			}
+			return true
+		}
+		for _, val := range values[keys[i]] {
+			row[keys[i]] = val
+			if !visit(i + 1) {
+				return false
+			}
+		}
+		return true
+	}
+	if !visit(0) {
+		return nil
+	}
+	return set
+}
+
+// pruneRange returns the partitions of a range partitioned table which may
+// have the rows of the key in the range. The partition of a value is not less
+// than the partition of a less value, since the partitioning function is
+// increasing.
+func (p *partitionPruner) pruneRange(key int32, op string, val *plan.Expr) partitionSet {
+	if !p.isIncreasing() {
+		return nil
+	}
+	idx, ok := p.locate(map[int32]*plan.Expr{key: val})
+	if !ok {
+		return nil
+	}
+	set := p.emptySet()
+	lo, hi := 0, len(set)-1
+	switch op {
+	case ">", ">=":
+		if idx < 0 {
+			// the value is greater than all the partitions
+			return set
+		}
+		lo = idx
+	case "<", "<=":
+		if idx >= 0 {
+			hi = idx
+		}
+	}
+	for i := lo; i <= hi && i < len(set); i++ {
+		set[i] = true
+	}
+	return set
+}
+
+func (p *partitionPruner) isIncreasing() bool {
+	switch p.def.Type {
+	case plan.PartitionType_RANGE:
+		return p.def.PartitionExpr != nil && isIncreasingExpr(p.def.PartitionExpr.Expr)
+	case plan.PartitionType_RANGE_COLUMNS:
+		return len(p.keys) == 1
+	}
+	return false
+}
+
+// isIncreasingExpr checks whether the expression of a column never decreases
+// as the column increases.
+func isIncreasingExpr(expr *plan.Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return true
+	case *plan.Expr_F:
+		args := e.F.Args
+		switch e.F.Func.ObjName {
+		case "+":
+			if _, ok := args[0].Expr.(*plan.Expr_C); ok {
+				return isIncreasingExpr(args[1])
+			}
+			_, ok := args[1].Expr.(*plan.Expr_C)
+			return ok && isIncreasingExpr(args[0])
+		case "-":
+			_, ok := args[1].Expr.(*plan.Expr_C)
+			return ok && isIncreasingExpr(args[0])
+		case "cast", "year", "to_days", "to_seconds", "unix_timestamp":
+			return isIncreasingExpr(args[0])
+		}
+	}
+	return false
+}
+
+// locate evaluates the partition expression with the values of the partition
+// keys, it returns -1 if no partition has the row.
+func (p *partitionPruner) locate(row map[int32]*plan.Expr) (int, bool) {
+	expr, ok := replacePartitionKeys(DeepCopyExpr(p.def.PartitionExpression), row)
+	if !ok {
+		return 0, false
+	}
+	expr, err := ConstantFold(p.bat, expr, p.proc)
+	if err != nil {
+		return 0, false
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_I64Val:
+		return int(v.I64Val), true
+	case *plan.Const_I32Val:
+		return int(v.I32Val), true
+	case *plan.Const_U64Val:
+		return int(v.U64Val), true
+	case *plan.Const_U32Val:
+		return int(v.U32Val), true
+	}
+	return 0, false
+}
+
+func replacePartitionKeys(expr *plan.Expr, row map[int32]*plan.Expr) (*plan.Expr, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		val, ok := row[e.Col.ColPos]
+		if !ok {
+			return nil, false
+		}
+		return DeepCopyExpr(val), true
+	case *plan.Expr_F:
+		for i, arg := range e.F.Args {
+			newArg, ok := replacePartitionKeys(arg, row)
+			if !ok {
+				return nil, false
+			}
+			e.F.Args[i] = newArg
+		}
+	case *plan.Expr_List:
+		for i, arg := range e.List.List {
+			newArg, ok := replacePartitionKeys(arg, row)
+			if !ok {
+				return nil, false
+			}
+			e.List.List[i] = newArg
+		}
+	}
+	return expr, true
+}
+
+func reverseCompare(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func buildPartitionedTableDef(t *testing.T, sql string) *TableDef {
+	logicPlan, err := buildSingleStmt(NewMockOptimizer(false), t, sql)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	tableDef.Name2ColIndex = make(map[string]int32)
+	for i, col := range tableDef.Cols {
+		tableDef.Name2ColIndex[col.Name] = int32(i)
+	}
+	return tableDef
+}
+
+func makePruneFilter(t *testing.T, tableDef *TableDef, op string, colName string, vals ...int64) *plan.Expr {
+	pos := tableDef.Name2ColIndex[colName]
+	col := &plan.Expr{
+		Typ:  tableDef.Cols[pos].Typ,
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos, Name: colName}},
+	}
+	args := []*plan.Expr{col}
+	if op == "in" {
+		list := make([]*plan.Expr, len(vals))
+		for i, v := range vals {
+			list[i] = makePlan2Int64ConstExprWithType(v)
+		}
+		args = append(args, &plan.Expr{
+			Typ:  &plan.Type{Id: int32(types.T_tuple)},
+			Expr: &plan.Expr_List{List: &plan.ExprList{List: list}},
+		})
+	} else {
+		args = append(args, makePlan2Int64ConstExprWithType(vals[0]))
+	}
+	expr, err := bindFuncExprImplByPlanExpr(context.TODO(), op, args)
+	require.NoError(t, err)
+	return expr
+}
+
+func TestPrunePartitions(t *testing.T) {
+	proc := testutil.NewProc()
+	tableDef := buildPartitionedTableDef(t, `CREATE TABLE employees (
+				id INT NOT NULL,
+				job_code INT NOT NULL,
+				store_id INT NOT NULL
+			)
+			PARTITION BY RANGE (store_id) (
+				PARTITION p0 VALUES LESS THAN (6),
+				PARTITION p1 VALUES LESS THAN (11),
+				PARTITION p2 VALUES LESS THAN (16),
+				PARTITION p3 VALUES LESS THAN (21)
+			);`)
+
+	prune := func(filters ...*plan.Expr) []int {
+		parts, ok := PrunePartitions(proc, tableDef, filters)
+		if !ok {
+			return nil
+		}
+		return parts
+	}
+	require.Equal(t, []int{1}, prune(makePruneFilter(t, tableDef, "=", "store_id", 7)))
+	require.Equal(t, []int{0, 2}, prune(makePruneFilter(t, tableDef, "in", "store_id", 1, 12)))
+	require.Equal(t, []int{2, 3}, prune(makePruneFilter(t, tableDef, ">=", "store_id", 11)))
+	require.Equal(t, []int{}, prune(makePruneFilter(t, tableDef, ">", "store_id", 30)))
+	require.Equal(t, []int{1, 2}, prune(
+		makePruneFilter(t, tableDef, ">", "store_id", 8),
+		makePruneFilter(t, tableDef, "<", "store_id", 12)))
+	require.Equal(t, []int{1}, prune(
+		makePruneFilter(t, tableDef, "in", "store_id", 7, 17),
+		makePruneFilter(t, tableDef, "<", "store_id", 10)))
+
+	or, err := bindFuncExprImplByPlanExpr(context.TODO(), "or", []*plan.Expr{
+		makePruneFilter(t, tableDef, "=", "store_id", 1),
+		makePruneFilter(t, tableDef, "=", "store_id", 17),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 3}, prune(or))
+
+	// the filters on the other columns can't prune the partitions
+	_, ok := PrunePartitions(proc, tableDef, []*plan.Expr{makePruneFilter(t, tableDef, "=", "id", 1)})
+	require.False(t, ok)
+	// nor the values only known at runtime
+	byColumn, err := bindFuncExprImplByPlanExpr(context.TODO(), "=", []*plan.Expr{
+		{
+			Typ:  tableDef.Cols[2].Typ,
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 2, Name: "store_id"}},
+		},
+		{
+			Typ:  tableDef.Cols[0].Typ,
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "id"}},
+		},
+	})
+	require.NoError(t, err)
+	_, ok = PrunePartitions(proc, tableDef, []*plan.Expr{byColumn})
+	require.False(t, ok)
+	or, err = bindFuncExprImplByPlanExpr(context.TODO(), "or", []*plan.Expr{
+		makePruneFilter(t, tableDef, "=", "store_id", 1),
+		makePruneFilter(t, tableDef, "=", "id", 17),
+	})
+	require.NoError(t, err)
+	_, ok = PrunePartitions(proc, tableDef, []*plan.Expr{or})
+	require.False(t, ok)
+}
+
+func TestPruneHashAndListPartitions(t *testing.T) {
+	proc := testutil.NewProc()
+	tableDef := buildPartitionedTableDef(t, `CREATE TABLE t1 (a INT, b INT) PARTITION BY HASH(a) PARTITIONS 4;`)
+	parts, ok := PrunePartitions(proc, tableDef, []*plan.Expr{makePruneFilter(t, tableDef, "=", "a", 5)})
+	require.True(t, ok)
+	require.Equal(t, 1, len(parts))
+	// the range of the hash partitioned table can't prune the partitions
+	_, ok = PrunePartitions(proc, tableDef, []*plan.Expr{makePruneFilter(t, tableDef, ">", "a", 5)})
+	require.False(t, ok)
+
+	tableDef = buildPartitionedTableDef(t, `CREATE TABLE t2 (a INT, b INT)
+			PARTITION BY LIST (a) (
+				PARTITION p0 VALUES IN (1, 3, 5),
+				PARTITION p1 VALUES IN (2, 4, 6),
+				PARTITION p2 VALUES IN (7, 8)
+			);`)
+	parts, ok = PrunePartitions(proc, tableDef, []*plan.Expr{makePruneFilter(t, tableDef, "in", "a", 4, 8, 9)})
+	require.True(t, ok)
+	require.Equal(t, []int{1, 2}, parts)
+
+	tableDef = buildPartitionedTableDef(t, `CREATE TABLE t3 (a INT, b INT)
+			PARTITION BY LIST COLUMNS (a, b) (
+				PARTITION p0 VALUES IN ((1, 1), (2, 2)),
+				PARTITION p1 VALUES IN ((1, 2), (2, 1))
+			);`)
+	// all the columns of the key are needed
+	_, ok = PrunePartitions(proc, tableDef, []*plan.Expr{makePruneFilter(t, tableDef, "=", "a", 1)})
+	require.False(t, ok)
+	parts, ok = PrunePartitions(proc, tableDef, []*plan.Expr{
+		makePruneFilter(t, tableDef, "=", "a", 1),
+		makePruneFilter(t, tableDef, "=", "b", 2),
+	})
+	require.True(t, ok)
+	require.Equal(t, []int{1}, parts)
+}
+
+func TestPartitionBlockFilters(t *testing.T) {
+	proc := testutil.NewProc()
+	tableDef := buildPartitionedTableDef(t, `CREATE TABLE t1 (a INT, b INT) PARTITION BY HASH(a) PARTITIONS 4;`)
+
+	onKey := makePruneFilter(t, tableDef, "=", "a", 5)
+	inKey := makePruneFilter(t, tableDef, "in", "a", 1, 2)
+	onOther := makePruneFilter(t, tableDef, "=", "b", 5)
+	orKeys, err := bindFuncExprImplByPlanExpr(context.TODO(), "or", []*plan.Expr{
+		makePruneFilter(t, tableDef, "<", "a", 1),
+		makePruneFilter(t, tableDef, ">", "a", 9),
+	})
+	require.NoError(t, err)
+	orMixed, err := bindFuncExprImplByPlanExpr(context.TODO(), "or", []*plan.Expr{
+		makePruneFilter(t, tableDef, "=", "a", 1),
+		makePruneFilter(t, tableDef, "=", "b", 1),
+	})
+	require.NoError(t, err)
+
+	filters := PartitionBlockFilters(proc, tableDef, []*plan.Expr{onKey, onOther, inKey, orMixed, orKeys})
+	require.Equal(t, 3, len(filters))
+	require.Equal(t, "=", filters[0].GetF().Func.ObjName)
+	require.Equal(t, "in", filters[1].GetF().Func.ObjName)
+	require.Equal(t, "or", filters[2].GetF().Func.ObjName)
+	// the filters are copies
+	require.NotSame(t, onKey, filters[0])
+
+	require.Empty(t, PartitionBlockFilters(proc, tableDef, []*plan.Expr{onOther, orMixed}))
+	tableDef.Partition = nil
+	require.Empty(t, PartitionBlockFilters(proc, tableDef, []*plan.Expr{onKey}))
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -72,9 +73,236 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, moerr.NewNYI(b.GetContext(), "window functions")
+	if astExpr.WindowSpec == nil {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s requires an OVER clause", funcName)
+	}
+	if b.insideWin {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s calls cannot be nested", funcName)
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, moerr.NewNYI(b.GetContext(), "DISTINCT in window function %s", funcName)
+	}
+	if funcName == "group_concat" {
+		return nil, moerr.NewNYI(b.GetContext(), "window function %s", funcName)
+	}
+
+	astStr := tree.String(astExpr, dialect.MYSQL)
+	if colPos, ok := b.ctx.windowByAst[astStr]; ok {
+		return &plan.Expr{
+			Typ: b.ctx.windows[colPos].WindowFunc.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: b.ctx.windowTag,
+					ColPos: colPos,
+				},
+			},
+		}, nil
+	}
+
+	b.insideWin = true
+	defer func() {
+		b.insideWin = false
+	}()
+
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &plan.WindowSpec{
+		WindowFunc:  expr,
+		PartitionBy: make([]*plan.Expr, 0, len(astExpr.WindowSpec.PartitionBy)),
+		OrderBy:     make([]*plan.OrderBySpec, 0, len(astExpr.WindowSpec.OrderBy)),
+	}
+
+	for _, partition := range astExpr.WindowSpec.PartitionBy {
+		partExpr, err := b.BindExpr(partition, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, partExpr)
+	}
+
+	for _, order := range astExpr.WindowSpec.OrderBy {
+		orderExpr, err := b.BindExpr(order.Expr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: orderExpr,
+			Flag: plan.OrderBySpec_INTERNAL,
+		}
+
+		switch order.Direction {
+		case tree.Ascending:
+			orderBy.Flag |= plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag |= plan.OrderBySpec_DESC
+		}
+
+		switch order.NullsPosition {
+		case tree.NullsFirst:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_FIRST
+		case tree.NullsLast:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_LAST
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	spec.Frame, err = b.bindWinFrame(astExpr.WindowSpec.WindowFrame, spec.OrderBy, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	colPos := int32(len(b.ctx.windows))
+	b.ctx.windowByAst[astStr] = colPos
+	b.ctx.windows = append(b.ctx.windows, spec)
+
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.windowTag,
+				ColPos: colPos,
+			},
+		},
+	}, nil
+}
+
+// bindWinFrame binds the frame clause of a window, and fills the default frame if it is missing.
+// Without ORDER BY the default frame is the whole partition, otherwise it is
+// RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW.
+func (b *ProjectionBinder) bindWinFrame(astFrame *tree.WindowFrame, orderBy []*plan.OrderBySpec, depth int32) (*plan.FrameClause, error) {
+	if astFrame == nil {
+		if len(orderBy) == 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+				End:   &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_FOLLOWING},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, nil
+	}
+
+	frame := &plan.FrameClause{}
+	switch astFrame.Unit {
+	case tree.WIN_FRAME_UNIT_ROWS:
+		frame.Type = plan.FrameClause_ROWS
+	case tree.WIN_FRAME_UNIT_RANGE:
+		frame.Type = plan.FrameClause_RANGE
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "GROUPS frame unit")
+	}
+
+	var err error
+	if frame.Start, err = b.bindWinFrameBound(astFrame.StartBound, frame.Type, orderBy, depth); err != nil {
+		return nil, err
+	}
+	if astFrame.EndBound == nil {
+		frame.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+	} else if frame.End, err = b.bindWinFrameBound(astFrame.EndBound, frame.Type, orderBy, depth); err != nil {
+		return nil, err
+	}
+
+	if frame.Start.Type == plan.FrameBound_UNBOUNDED_FOLLOWING ||
+		frame.End.Type == plan.FrameBound_UNBOUNDED_PRECEDING ||
+		frame.Start.Type > frame.End.Type {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame '%s' is illegal", tree.String(astFrame.StartBound, dialect.MYSQL))
+	}
+
+	return frame, nil
+}
+
+func (b *ProjectionBinder) bindWinFrameBound(astBound tree.WindowFrameBound, frameType plan.FrameClause_FrameType, orderBy []*plan.OrderBySpec, depth int32) (*plan.FrameBound, error) {
+	var astVal tree.Expr
+	bound := &plan.FrameBound{}
+
+	switch ab := astBound.(type) {
+	case *tree.WindowFrameBoundCurrentRow:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+		return bound, nil
+	case *tree.WindowFrameBoundPreceding:
+		if ab.Expr == nil {
+			bound.Type = plan.FrameBound_UNBOUNDED_PRECEDING
+			return bound, nil
+		}
+		bound.Type = plan.FrameBound_PRECEDING
+		astVal = ab.Expr
+	case *tree.WindowFrameBoundFollowing:
+		if ab.Expr == nil {
+			bound.Type = plan.FrameBound_UNBOUNDED_FOLLOWING
+			return bound, nil
+		}
+		bound.Type = plan.FrameBound_FOLLOWING
+		astVal = ab.Expr
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "window frame bound '%v'", astBound)
+	}
+
+	val, err := b.BindExpr(astVal, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := val.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset must be a non-negative constant")
+	}
+
+	if frameType == plan.FrameClause_ROWS {
+		var offset int64
+		switch v := c.C.Value.(type) {
+		case *plan.Const_I64Val:
+			offset = v.I64Val
+		case *plan.Const_U64Val:
+			offset = int64(v.U64Val)
+		default:
+			return nil, moerr.NewSyntaxError(b.GetContext(), "ROWS frame offset must be a non-negative integer")
+		}
+		if offset < 0 {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "ROWS frame offset must be a non-negative integer")
+		}
+		bound.Val = makePlan2Int64ConstExprWithType(offset)
+		return bound, nil
+	}
+
+	if len(orderBy) != 1 || !isWinRangeKeyType(types.T(orderBy[0].Expr.Typ.Id)) {
+		return nil, moerr.NewNYI(b.GetContext(), "RANGE frame offset without a single numeric ORDER BY expression")
+	}
+	var offset float64
+	switch v := c.C.Value.(type) {
+	case *plan.Const_I64Val:
+		offset = float64(v.I64Val)
+	case *plan.Const_U64Val:
+		offset = float64(v.U64Val)
+	case *plan.Const_Fval:
+		offset = float64(v.Fval)
+	case *plan.Const_Dval:
+		offset = v.Dval
+	case *plan.Const_Decimal64Val:
+		offset = types.Decimal64ToFloat64(types.Decimal64(v.Decimal64Val.A), val.Typ.Scale)
+	case *plan.Const_Decimal128Val:
+		offset = types.Decimal128ToFloat64(types.Decimal128{B0_63: uint64(v.Decimal128Val.A), B64_127: uint64(v.Decimal128Val.B)}, val.Typ.Scale)
+	default:
+		return nil, moerr.NewSyntaxError(b.GetContext(), "RANGE frame offset must be a non-negative number")
+	}
+	if offset < 0 {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "RANGE frame offset must be a non-negative number")
+	}
+	bound.Val = makePlan2Float64ConstExprWithType(offset)
+	return bound, nil
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
 	return b.baseBindSubquery(astExpr, isRoot)
 }
+
+// isWinRangeKeyType reports whether a RANGE frame with offsets can be computed on the ORDER BY key.
+func isWinRangeKeyType(t types.T) bool {
+	return t.IsInteger() || t.IsFloat() || t.IsDecimal()
+}
@@ -327,7 +327,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL,
 		plan.Node_UNION, plan.Node_UNION_ALL,
-		plan.Node_MINUS, plan.Node_MINUS_ALL:
+		plan.Node_MINUS, plan.Node_MINUS_ALL,
+		plan.Node_RECURSIVE_CTE:
 
 		thisTag := node.BindingTags[0]
 		leftID := node.Children[0]
@@ -587,6 +588,77 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+	case plan.Node_WINDOW:
+		spec := node.WinSpec
+		increaseRefCnt(spec.WindowFunc, colRefCnt)
+		for _, expr := range spec.PartitionBy {
+			increaseRefCnt(expr, colRefCnt)
+		}
+		for _, orderBy := range spec.OrderBy {
+			increaseRefCnt(orderBy.Expr, colRefCnt)
+		}
+		for _, orderBy := range node.OrderBy {
+			increaseRefCnt(orderBy.Expr, colRefCnt)
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		exprs := []*plan.Expr{spec.WindowFunc}
+		exprs = append(exprs, spec.PartitionBy...)
+		for _, orderBy := range spec.OrderBy {
+			exprs = append(exprs, orderBy.Expr)
+		}
+		for _, orderBy := range node.OrderBy {
+			exprs = append(exprs, orderBy.Expr)
+		}
+		for _, expr := range exprs {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapColRefForExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// the result of the window function is appended after the columns of the input
+		globalRef := [2]int32{node.BindingTags[0], node.WindowIdx}
+		if colRefCnt[globalRef] > 0 || len(node.ProjectList) == 0 {
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: spec.WindowFunc.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: int32(len(childProjList)),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_SORT:
 		for _, orderBy := range node.OrderBy {
 			increaseRefCnt(orderBy.Expr, colRefCnt)
@@ -695,6 +767,63 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
+	case plan.Node_LOCK_OP:
+		for _, target := range node.LockTargets {
+			colRefCnt[[2]int32{target.PrimaryColRelPos, target.PrimaryColIdxInBat}]++
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range node.LockTargets {
+			globalRef := [2]int32{target.PrimaryColRelPos, target.PrimaryColIdxInBat}
+			colRefCnt[globalRef]--
+			localRef, ok := childRemapping.globalToLocal[globalRef]
+			if !ok {
+				return nil, moerr.NewParseError(builder.GetContext(), "can't find column %v in context's map %v", globalRef, childRemapping.globalToLocal)
+			}
+			target.PrimaryColRelPos = 0
+			target.PrimaryColIdxInBat = localRef[1]
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		if len(node.ProjectList) == 0 {
+			if len(childRemapping.localToGlobal) > 0 {
+				remapping.addColRef(childRemapping.localToGlobal[0])
+			}
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: 0,
+					},
+				},
+			})
+		}
+
 	case plan.Node_PROJECT, plan.Node_MATERIAL:
 		projectTag := node.BindingTags[0]
 
@@ -814,6 +943,45 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+	case plan.Node_RECURSIVE_SCAN:
+		tag := node.BindingTags[0]
+		for i, col := range node.TableDef.Cols {
+			globalRef := [2]int32{tag, int32(i)}
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// keep one column at least, the rows of the working table are still needed
+		if len(node.ProjectList) == 0 {
+			globalRef := [2]int32{tag, 0}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.TableDef.Cols[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: 0,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	default:
 		return nil, moerr.NewInternalError(builder.GetContext(), "unsupport node type")
 	}
@@ -1220,26 +1388,33 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				isRecursive: stmt.With.IsRecursive && isRecursiveCTE(name, cte.Stmt),
+				ast:         cte,
+				maskedCTEs:  maskedCTEs,
 			}
 		}
 
 		// Try to do binding for CTE at declaration
 		for _, cte := range stmt.With.CTEs {
+			name := string(cte.Name.Alias)
+			cteRef := ctx.cteByName[name]
 			subCtx := NewBindContext(builder, ctx)
-			subCtx.maskedCTEs = ctx.cteByName[string(cte.Name.Alias)].maskedCTEs
+			subCtx.maskedCTEs = cteRef.maskedCTEs
 
 			var err error
-			switch stmt := cte.Stmt.(type) {
-			case *tree.Select:
-				_, err = builder.buildSelect(stmt, subCtx, false)
+			if cteRef.isRecursive {
+				_, err = builder.buildRecursiveCTE(name, cteRef, subCtx)
+			} else {
+				switch stmt := cte.Stmt.(type) {
+				case *tree.Select:
+					_, err = builder.buildSelect(stmt, subCtx, false)
 
-			case *tree.ParenSelect:
-				_, err = builder.buildSelect(stmt.Select, subCtx, false)
+				case *tree.ParenSelect:
+					_, err = builder.buildSelect(stmt.Select, subCtx, false)
 
-			default:
-				err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+				default:
+					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+				}
 			}
 
 			if err != nil {
@@ -1254,6 +1429,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	var err error
 	astOrderBy := stmt.OrderBy
 	astLimit := stmt.Limit
+	lockInfo := stmt.Lock
 
 	// strip parentheses
 	// ((select a from t1)) order by b  [ is equal ] select a from t1 order by b
@@ -1275,6 +1451,12 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 				}
 				astLimit = selectClause.Select.Limit
 			}
+			if selectClause.Select.Lock != nil {
+				if lockInfo != nil {
+					return 0, moerr.NewSyntaxError(builder.GetContext(), "multiple locking clauses not allowed")
+				}
+				lockInfo = selectClause.Select.Lock
+			}
 			stmt = selectClause.Select
 		} else {
 			break
@@ -1285,8 +1467,14 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	case *tree.SelectClause:
 		clause = selectClause
 	case *tree.UnionClause:
+		if lockInfo != nil {
+			return 0, moerr.NewNYI(builder.GetContext(), "locking clause with UNION")
+		}
 		return builder.buildUnion(selectClause, astOrderBy, astLimit, ctx, isRoot)
 	case *tree.ValuesClause:
+		if lockInfo != nil {
+			return 0, moerr.NewNYI(builder.GetContext(), "locking clause with VALUES")
+		}
 		valuesClause = selectClause
 	default:
 		return 0, moerr.NewNYI(builder.GetContext(), "statement '%s'", tree.String(stmt, dialect.MYSQL))
@@ -1391,11 +1579,28 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			return 0, err
 		}
 	} else {
-		// build FROM clause
-		nodeID, err = builder.buildFrom(clause.From.Tables, ctx)
+		// build FROM clause, with the fulltext scans of MATCH joined
+		fromTables, fullTextAliases, err := builder.buildFullTextFrom(clause, astOrderBy, ctx)
+		if err != nil {
+			return 0, err
+		}
+		// and the candidates of the ivfflat index of a nearest neighbours search
+		fromTables, ivfFlatAliases, err := builder.buildIvfFlatFrom(fromTables, clause, astOrderBy, astLimit, ctx)
+		if err != nil {
+			return 0, err
+		}
+		// and the rows found by an expression index for the filters
+		fromTables, exprIndexAliases, err := builder.buildExpressionIndexFrom(fromTables, clause, ctx)
 		if err != nil {
 			return 0, err
 		}
+		nodeID, err = builder.buildFrom(fromTables, ctx)
+		if err != nil {
+			return 0, err
+		}
+		builder.hideIndexScanColumns(fullTextAliases, ctx)
+		builder.hideIndexScanColumns(ivfFlatAliases, ctx)
+		builder.hideIndexScanColumns(exprIndexAliases, ctx)
 
 		ctx.binder = NewWhereBinder(builder, ctx)
 		// unfold stars and generate headings
@@ -1512,6 +1717,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 		ctx.groupTag = builder.genNewTag()
 		ctx.aggregateTag = builder.genNewTag()
+		ctx.windowTag = builder.genNewTag()
 		ctx.projectTag = builder.genNewTag()
 
 		// bind GROUP BY clause
@@ -1653,6 +1859,15 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		for i, proj := range ctx.projects {
 			ctx.projects[i] = builder.wrapBareColRefsInAnyValue(proj, ctx)
 		}
+		for _, spec := range ctx.windows {
+			spec.WindowFunc = builder.wrapBareColRefsInAnyValue(spec.WindowFunc, ctx)
+			for i, expr := range spec.PartitionBy {
+				spec.PartitionBy[i] = builder.wrapBareColRefsInAnyValue(expr, ctx)
+			}
+			for _, orderBy := range spec.OrderBy {
+				orderBy.Expr = builder.wrapBareColRefsInAnyValue(orderBy.Expr, ctx)
+			}
+		}
 	}
 
 	// FIXME: delete this when SINGLE join is ready
@@ -1660,6 +1875,21 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		ctx.hasSingleRow = true
 	}
 
+	// lock the rows of a locking read
+	var lockTargets []*plan.LockTarget
+	if lockInfo != nil {
+		lockTargets, err = builder.buildLockTargets(lockInfo, ctx)
+		if err != nil {
+			return 0, err
+		}
+		if lockBeforeLimit(ctx, lockTargets, limitExpr) {
+			builder.projectLockTargets(lockTargets, ctx)
+		} else {
+			nodeID = builder.appendLockNode(nodeID, lockTargets, ctx)
+			lockTargets = nil
+		}
+	}
+
 	// append AGG node
 	if len(ctx.groups) > 0 || len(ctx.aggregates) > 0 {
 		nodeID = builder.appendNode(&plan.Node{
@@ -1699,6 +1929,14 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	// append WINDOW node
+	if len(ctx.windows) > 0 {
+		nodeID, err = builder.appendWindowNodes(nodeID, ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -1733,7 +1971,16 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}, ctx)
 	}
 
-	if limitExpr != nil || offsetExpr != nil {
+	if len(lockTargets) > 0 {
+		// the sorted rows are locked until the limit is met
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_LOCK_OP,
+			Children:    []int32{nodeID},
+			LockTargets: lockTargets,
+			Limit:       limitExpr,
+			Offset:      offsetExpr,
+		}, ctx)
+	} else if limitExpr != nil || offsetExpr != nil {
 		node := builder.qry.Nodes[nodeID]
 
 		node.Limit = limitExpr
@@ -1773,6 +2020,59 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	return nodeID, nil
 }
 
+// appendWindowNodes appends a WINDOW node for each window function of the select list.
+// The input of a WINDOW node is sorted by its partition and order keys, and the node
+// outputs all the input columns followed by the result of the window function.
+func (builder *QueryBuilder) appendWindowNodes(nodeID int32, ctx *BindContext) (int32, error) {
+	var err error
+
+	for i, spec := range ctx.windows {
+		nodeID, spec.WindowFunc, err = builder.flattenSubqueries(nodeID, spec.WindowFunc, ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		orderBys := make([]*plan.OrderBySpec, 0, len(spec.PartitionBy)+len(spec.OrderBy))
+		for j, expr := range spec.PartitionBy {
+			nodeID, spec.PartitionBy[j], err = builder.flattenSubqueries(nodeID, expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			orderBys = append(orderBys, &plan.OrderBySpec{
+				Expr: DeepCopyExpr(spec.PartitionBy[j]),
+				Flag: plan.OrderBySpec_INTERNAL,
+			})
+		}
+		for _, orderBy := range spec.OrderBy {
+			nodeID, orderBy.Expr, err = builder.flattenSubqueries(nodeID, orderBy.Expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			orderBys = append(orderBys, &plan.OrderBySpec{
+				Expr: DeepCopyExpr(orderBy.Expr),
+				Flag: orderBy.Flag,
+			})
+		}
+
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_WINDOW,
+			Children:    []int32{nodeID},
+			WinSpec:     spec,
+			WindowIdx:   int32(i),
+			OrderBy:     orderBys,
+			BindingTags: []int32{ctx.windowTag},
+		}, ctx)
+	}
+
+	for name, id := range ctx.windowByAst {
+		builder.nameByColRef[[2]int32{ctx.windowTag, id}] = name
+	}
+
+	return nodeID, nil
+}
+
 func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32 {
 	nodeID := int32(len(builder.qry.Nodes))
 	node.NodeId = nodeID
@@ -1867,6 +2167,12 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 		}
 
 		if len(schema) == 0 {
+			// the recursive member of a recursive CTE reads its working table
+			if workTable := ctx.findRecursiveCTE(table); workTable != nil {
+				nodeID, err = builder.buildRecursiveScan(workTable, ctx)
+				break
+			}
+
 			cteRef := ctx.findCTE(table)
 			if cteRef != nil {
 				subCtx := NewBindContext(builder, ctx)
@@ -1877,15 +2183,19 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					subCtx.defaultDatabase = cteRef.defaultDatabase
 				}
 
-				switch stmt := cteRef.ast.Stmt.(type) {
-				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
+				if cteRef.isRecursive {
+					nodeID, err = builder.buildRecursiveCTE(table, cteRef, subCtx)
+				} else {
+					switch stmt := cteRef.ast.Stmt.(type) {
+					case *tree.Select:
+						nodeID, err = builder.buildSelect(stmt, subCtx, false)
 
-				case *tree.ParenSelect:
-					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
+					case *tree.ParenSelect:
+						nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
 
-				default:
-					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+					default:
+						err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+					}
 				}
 
 				if err != nil {
@@ -1974,6 +2284,18 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					viewStmt.AsSource = alterstmt.AsSource
 				}
 
+				// the materialized view reads its results from the hidden table
+				if viewData.Materialized != nil {
+					viewDb := schema
+					if viewDb == "" {
+						viewDb = builder.compCtx.DefaultDatabase()
+					}
+					viewStmt.AsSource, err = buildMaterializedViewSource(builder.GetContext(), viewDb, tableDef, viewData.Materialized)
+					if err != nil {
+						return 0, err
+					}
+				}
+
 				viewName := viewStmt.Name.ObjectName
 				var maskedCTEs map[string]any
 				if len(ctx.cteByName) > 0 {
@@ -2043,6 +2365,12 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 			return
 		}
 
+		if tbl.AsOf != nil {
+			if err = builder.buildScanSnapshot(nodeID, tbl.AsOf, ctx); err != nil {
+				return
+			}
+		}
+
 		err = builder.addBinding(nodeID, tbl.As, ctx)
 
 		//tableDef := builder.qry.Nodes[nodeID].GetTableDef()
@@ -2134,7 +2462,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 	var binding *Binding
 	var table string
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN || node.NodeType == plan.Node_RECURSIVE_SCAN {
 		if node.NodeType == plan.Node_VALUE_SCAN && node.TableDef == nil {
 			return nil
 		}
@@ -2378,6 +2706,10 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildCurrentAccount(tbl, ctx, exprs, childId)
 	case "metadata_scan":
 		nodeId = builder.buildMetadataScan(tbl, ctx, exprs, childId)
+	case "table_changes":
+		nodeId, err = builder.buildTableChanges(tbl, ctx, exprs, childId)
+	case "json_table":
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupported(builder.GetContext(), "table function '%s' not supported", id)
 	}
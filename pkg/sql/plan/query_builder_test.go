@@ -52,8 +52,8 @@ func TestBuildTable_AlterView(t *testing.T) {
 		}}
 
 	vData, err := json.Marshal(ViewData{
-		"create view v as select a from a",
-		"db",
+		Stmt:            "create view v as select a from a",
+		DefaultDatabase: "db",
 	})
 	assert.NoError(t, err)
 
@@ -0,0 +1,251 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// defaultMaxRecursionDepth is used if the session variable
+// cte_max_recursion_depth can't be resolved.
+const defaultMaxRecursionDepth = 1000
+
+// isRecursiveCTE returns true if the CTE references itself in the FROM clause
+// of one of its query blocks.
+func isRecursiveCTE(name string, stmt tree.Statement) bool {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		return isRecursiveCTE(name, stmt.Select)
+	case *tree.ParenSelect:
+		return isRecursiveCTE(name, stmt.Select)
+	case *tree.UnionClause:
+		return isRecursiveCTE(name, stmt.Left) || isRecursiveCTE(name, stmt.Right)
+	case *tree.SelectClause:
+		if stmt.From == nil {
+			return false
+		}
+		for _, tbl := range stmt.From.Tables {
+			if tableExprReferences(name, tbl) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tableExprReferences returns true if the table expression references the table
+// name directly, the derived tables are not taken into account.
+func tableExprReferences(name string, tbl tree.TableExpr) bool {
+	switch tbl := tbl.(type) {
+	case *tree.TableName:
+		return len(tbl.SchemaName) == 0 && string(tbl.ObjectName) == name
+	case *tree.AliasedTableExpr:
+		return tableExprReferences(name, tbl.Expr)
+	case *tree.ParenTableExpr:
+		return tableExprReferences(name, tbl.Expr)
+	case *tree.JoinTableExpr:
+		return tableExprReferences(name, tbl.Left) || tableExprReferences(name, tbl.Right)
+	}
+	return false
+}
+
+// buildRecursiveCTE builds a recursive CTE of the form
+//
+//	anchor UNION [ALL | DISTINCT] recursive member
+//
+// into a RECURSIVE_CTE node. The columns of the CTE are decided by the anchor, and the
+// recursive member reads the rows produced by the previous iteration through a
+// RECURSIVE_SCAN node.
+func (builder *QueryBuilder) buildRecursiveCTE(name string, cteRef *CTERef, ctx *BindContext) (int32, error) {
+	var stmt *tree.Select
+	switch s := cteRef.ast.Stmt.(type) {
+	case *tree.Select:
+		stmt = s
+	case *tree.ParenSelect:
+		stmt = s.Select
+	default:
+		return 0, moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(s, dialect.MYSQL))
+	}
+
+	union, ok := stmt.Select.(*tree.UnionClause)
+	if !ok || union.Type != tree.UNION {
+		return 0, moerr.NewParseError(builder.GetContext(), "recursive Common Table Expression %q should contain a UNION", name)
+	}
+	if isRecursiveCTE(name, union.Left) || !isRecursiveCTE(name, union.Right) {
+		return 0, moerr.NewParseError(builder.GetContext(), "recursive Common Table Expression %q should have one or more non-recursive query blocks followed by one recursive query block", name)
+	}
+	if stmt.With != nil || stmt.OrderBy != nil || stmt.Limit != nil {
+		return 0, moerr.NewNYI(builder.GetContext(), "WITH, ORDER BY or LIMIT over the query blocks of recursive Common Table Expression")
+	}
+	if paren, ok := union.Right.(*tree.ParenSelect); ok && (paren.Select.OrderBy != nil || paren.Select.Limit != nil) {
+		return 0, moerr.NewNYI(builder.GetContext(), "ORDER BY or LIMIT in recursive query block of Common Table Expression")
+	}
+
+	// the anchor can't see the CTE itself
+	ctx.cteName = name
+
+	anchorCtx := NewBindContext(builder, ctx)
+	anchorID, err := builder.buildSelect(&tree.Select{Select: union.Left}, anchorCtx, false)
+	if err != nil {
+		return 0, err
+	}
+	anchorNode := builder.qry.Nodes[anchorID]
+
+	cols := make([]string, len(anchorCtx.headings))
+	for i, heading := range anchorCtx.headings {
+		cols[i] = strings.ToLower(heading)
+	}
+	if len(cteRef.ast.Name.Cols) > len(cols) {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "table %q has %d columns available but %d columns specified", name, len(cols), len(cteRef.ast.Name.Cols))
+	}
+	for i, col := range cteRef.ast.Name.Cols {
+		cols[i] = string(col)
+	}
+
+	typs := make([]*plan.Type, len(anchorNode.ProjectList))
+	for i, expr := range anchorNode.ProjectList {
+		typs[i] = DeepCopyType(expr.Typ)
+		typs[i].NotNullable = false
+	}
+
+	memberCtx := NewBindContext(builder, ctx)
+	memberCtx.recursiveCTE = &recursiveCTE{
+		name:  name,
+		cols:  cols,
+		types: typs,
+	}
+	memberID, err := builder.buildSelect(&tree.Select{Select: union.Right}, memberCtx, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(memberCtx.aggregates) > 0 || len(memberCtx.windows) > 0 || len(memberCtx.groups) > 0 {
+		return 0, moerr.NewParseError(builder.GetContext(), "recursive Common Table Expression %q can contain neither aggregation nor window functions in recursive query block", name)
+	}
+	if memberCtx.isDistinct {
+		return 0, moerr.NewNYI(builder.GetContext(), "SELECT DISTINCT in recursive query block of Common Table Expression")
+	}
+
+	// the rows of the recursive member are cast to the types of the anchor
+	memberNode := builder.qry.Nodes[memberID]
+	if len(memberNode.ProjectList) != len(typs) {
+		return 0, moerr.NewParseError(builder.GetContext(), "SELECT statements have different number of columns")
+	}
+	for i, expr := range memberNode.ProjectList {
+		if !makeTypeByPlan2Expr(expr).Eq(makeTypeByPlan2Type(typs[i])) {
+			memberNode.ProjectList[i], err = appendCastBeforeExpr(builder.GetContext(), expr, typs[i])
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	ctx.headings = append(ctx.headings, anchorCtx.headings...)
+
+	tag := builder.genNewTag()
+	anchorTag := anchorNode.BindingTags[0]
+	projectList := make([]*plan.Expr, len(typs))
+	for i, typ := range typs {
+		projectList[i] = &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: anchorTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		builder.nameByColRef[[2]int32{tag, int32(i)}] = ctx.headings[i]
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:          plan.Node_RECURSIVE_CTE,
+		Children:          []int32{anchorID, memberID},
+		BindingTags:       []int32{tag},
+		ProjectList:       projectList,
+		UnionAll:          union.All,
+		MaxRecursionDepth: builder.getMaxRecursionDepth(),
+	}, ctx)
+
+	// set ctx the same as the UNION does
+	ctx.groupTag = builder.genNewTag()
+	ctx.aggregateTag = builder.genNewTag()
+	ctx.projectTag = builder.genNewTag()
+	for i, v := range ctx.headings {
+		ctx.aliasMap[v] = int32(i)
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = v
+	}
+	for i, typ := range typs {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx), nil
+}
+
+// buildRecursiveScan builds the RECURSIVE_SCAN node which reads the working table
+// of the recursive CTE.
+func (builder *QueryBuilder) buildRecursiveScan(workTable *recursiveCTE, ctx *BindContext) (int32, error) {
+	if workTable.refCnt > 0 {
+		return 0, moerr.NewParseError(builder.GetContext(), "in recursive query block of recursive Common Table Expression %q, the recursive table must be referenced only once", workTable.name)
+	}
+	workTable.refCnt++
+
+	cols := make([]*plan.ColDef, len(workTable.cols))
+	for i, col := range workTable.cols {
+		cols[i] = &plan.ColDef{
+			Name: col,
+			Typ:  DeepCopyType(workTable.types[i]),
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_RECURSIVE_SCAN,
+		TableDef: &plan.TableDef{
+			Name: workTable.name,
+			Cols: cols,
+		},
+		BindingTags: []int32{builder.genNewTag()},
+	}, ctx), nil
+}
+
+func (builder *QueryBuilder) getMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err == nil {
+		switch v := val.(type) {
+		case uint64:
+			return int64(v)
+		case int64:
+			return v
+		}
+	}
+	return defaultMaxRecursionDepth
+}
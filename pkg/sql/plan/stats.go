@@ -19,6 +19,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -52,6 +53,11 @@ type StatsInfoMap struct {
 	BlockNumber int //detect if block number changes , update stats info map
 	TableCnt    float64
 	tableName   string
+
+	// ColumnStats are the statistics of the columns collected by ANALYZE
+	// TABLE, LoadTime is when they were loaded.
+	ColumnStats map[string]*ColumnStats
+	LoadTime    time.Time
 }
 
 func NewStatsInfoMap() *StatsInfoMap {
@@ -132,13 +138,13 @@ func UpdateStatsInfoMap(info *InfoFromZoneMap, blockNumTotal int, tableDef *plan
 		case types.T_uint8:
 			s.MinValMap[colName] = float64(types.DecodeUint8(info.ColumnZMs[i].GetMinBuf()))
 			s.MaxValMap[colName] = float64(types.DecodeUint8(info.ColumnZMs[i].GetMaxBuf()))
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			s.MinValMap[colName] = float64(types.DecodeUint16(info.ColumnZMs[i].GetMinBuf()))
 			s.MaxValMap[colName] = float64(types.DecodeUint16(info.ColumnZMs[i].GetMaxBuf()))
 		case types.T_uint32:
 			s.MinValMap[colName] = float64(types.DecodeUint32(info.ColumnZMs[i].GetMinBuf()))
 			s.MaxValMap[colName] = float64(types.DecodeUint32(info.ColumnZMs[i].GetMaxBuf()))
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			s.MinValMap[colName] = float64(types.DecodeUint64(info.ColumnZMs[i].GetMinBuf()))
 			s.MaxValMap[colName] = float64(types.DecodeUint64(info.ColumnZMs[i].GetMaxBuf()))
 		case types.T_date:
@@ -281,10 +287,16 @@ func estimateExprSelectivity(expr *plan.Expr, s *StatsInfoMap) float64 {
 		funcName := exprImpl.F.Func.ObjName
 		switch funcName {
 		case "=":
+			if sel, ok := estimateSelectivityByColumnStats(expr, s); ok {
+				return sel
+			}
 			return estimateEqualitySelectivity(expr, s.NdvMap)
 		case "!=", "<>":
 			return 0.9
 		case ">", "<", ">=", "<=":
+			if sel, ok := estimateSelectivityByColumnStats(expr, s); ok {
+				return sel
+			}
 			return estimateNonEqualitySelectivity(expr, funcName, s)
 		case "and":
 			sel1 := estimateExprSelectivity(exprImpl.F.Args[0], s)
@@ -436,10 +448,15 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 		selectivity := math.Pow(rightStats.Selectivity, math.Pow(leftStats.Selectivity, 0.5))
 		selectivity_out := math.Min(math.Pow(leftStats.Selectivity, math.Pow(rightStats.Selectivity, 0.5)), selectivity)
 
+		// the ndv of the join keys analyzed by ANALYZE TABLE
+		leftNdv, rightNdv, analyzed := estimateJoinNdv(node, builder, leftStats, rightStats)
+
 		switch node.JoinType {
 		case plan.Node_INNER:
 			outcnt := leftStats.Outcnt * rightStats.Outcnt / ndv
-			if !isCrossJoin {
+			if analyzed {
+				outcnt = leftStats.Outcnt * rightStats.Outcnt / math.Max(leftNdv, rightNdv)
+			} else if !isCrossJoin {
 				outcnt *= selectivity
 			}
 			node.Stats = &plan.Stats{
@@ -489,8 +506,12 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 			}
 
 		case plan.Node_SEMI:
+			outcnt := leftStats.Outcnt * selectivity
+			if analyzed {
+				outcnt = leftStats.Outcnt * math.Min(rightNdv/leftNdv, 1)
+			}
 			node.Stats = &plan.Stats{
-				Outcnt:      leftStats.Outcnt * selectivity,
+				Outcnt:      outcnt,
 				Cost:        leftStats.Cost + rightStats.Cost,
 				HashmapSize: rightStats.Outcnt,
 				Selectivity: selectivity_out,
@@ -553,6 +574,13 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 			Cost:        leftStats.Outcnt + rightStats.Outcnt,
 			Selectivity: 1,
 		}
+	case plan.Node_RECURSIVE_CTE:
+		// the number of iterations is unknown, assume one iteration
+		node.Stats = &plan.Stats{
+			Outcnt:      leftStats.Outcnt + rightStats.Outcnt,
+			Cost:        leftStats.Outcnt + rightStats.Outcnt,
+			Selectivity: 1,
+		}
 	case plan.Node_INTERSECT:
 		node.Stats = &plan.Stats{
 			Outcnt:      math.Min(leftStats.Outcnt, rightStats.Outcnt) * 0.5,
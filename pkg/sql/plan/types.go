@@ -61,6 +61,8 @@ type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
 type SubscriptionMeta = plan.SubscriptionMeta
+type TriggerDef = plan.TriggerDef
+type TriggerCtx = plan.TriggerCtx
 
 type CompilerContext interface {
 	// Default database/schema in context
@@ -77,6 +79,8 @@ type CompilerContext interface {
 	ResolveAccountIds(accountNames []string) ([]uint32, error)
 	// get the relevant information of udf
 	ResolveUdf(name string, args []*Expr) (string, error)
+	// get the row-level triggers of the table
+	ResolveTriggers(tableID uint64, dbName string, tableName string) ([]*TriggerDef, error)
 	// get the definition of primary key
 	GetPrimaryKeyDef(dbName string, tableName string) []*ColDef
 	// get needed info for stats by table
@@ -126,6 +130,29 @@ type BaseOptimizer struct {
 type ViewData struct {
 	Stmt            string
 	DefaultDatabase string
+	// Materialized is set for a materialized view
+	Materialized *MaterializedViewData `json:",omitempty"`
+}
+
+// MaterializedViewData describes how the results of a materialized view are
+// kept in its hidden table.
+type MaterializedViewData struct {
+	// Table is the hidden table in the database of the view
+	Table string
+	// BaseDatabase and BaseTable are the table the view selects from, they
+	// are set only if the view can be refreshed incrementally.
+	BaseDatabase string
+	BaseTable    string
+	// Incremental is true if the view is a filter or a simple aggregate over
+	// a single table, so that the rows inserted since the last refresh can be
+	// appended to the hidden table.
+	Incremental bool
+	// Aggregate is true if the view has aggregates, the rows of the hidden
+	// table are then partial results merged by MergeFuncs when it is read.
+	Aggregate bool
+	// MergeFuncs is the function merging the partial results of every column,
+	// it is empty for the group by columns.
+	MergeFuncs []string
 }
 
 type ExecType int
@@ -160,10 +187,20 @@ type QueryBuilder struct {
 
 type CTERef struct {
 	defaultDatabase string
+	isRecursive     bool
 	ast             *tree.CTE
 	maskedCTEs      map[string]any
 }
 
+// recursiveCTE is the working table of a recursive CTE, which is visible
+// to the recursive member of the CTE only.
+type recursiveCTE struct {
+	name   string
+	cols   []string
+	types  []*plan.Type
+	refCnt int
+}
+
 type BindContext struct {
 	binder Binder
 
@@ -173,22 +210,30 @@ type BindContext struct {
 	cteName  string
 	headings []string
 
+	recursiveCTE *recursiveCTE
+
 	groupTag     int32
 	aggregateTag int32
+	windowTag    int32
 	projectTag   int32
 	resultTag    int32
 
 	groups     []*plan.Expr
 	aggregates []*plan.Expr
+	windows    []*plan.WindowSpec
 	projects   []*plan.Expr
 	results    []*plan.Expr
 
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
+	windowByAst    map[string]int32
 	projectByExpr  map[string]int32
 
 	aliasMap map[string]int32
 
+	// the MATCH expressions and the aliases of the fulltext scans joined
+	fullTextMatches map[string]string
+
 	bindings       []*Binding
 	bindingByTag   map[int32]*Binding //rel_pos
 	bindingByTable map[string]*Binding
@@ -270,6 +315,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
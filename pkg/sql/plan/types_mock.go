@@ -327,6 +327,21 @@ func (mr *MockCompilerContext2MockRecorder) ResolveById(tableId interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveById", reflect.TypeOf((*MockCompilerContext2)(nil).ResolveById), tableId)
 }
 
+// ResolveTriggers mocks base method.
+func (m *MockCompilerContext2) ResolveTriggers(tableID uint64, dbName, tableName string) ([]*TriggerDef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTriggers", tableID, dbName, tableName)
+	ret0, _ := ret[0].([]*TriggerDef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTriggers indicates an expected call of ResolveTriggers.
+func (mr *MockCompilerContext2MockRecorder) ResolveTriggers(tableID, dbName, tableName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTriggers", reflect.TypeOf((*MockCompilerContext2)(nil).ResolveTriggers), tableID, dbName, tableName)
+}
+
 // ResolveUdf mocks base method.
 func (m *MockCompilerContext2) ResolveUdf(name string, args []*Expr) (string, error) {
 	m.ctrl.T.Helper()
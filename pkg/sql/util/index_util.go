@@ -16,6 +16,7 @@ package util
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -24,6 +25,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -32,19 +36,217 @@ var CompactSingleIndexCol = compactSingleIndexCol
 var CompactPrimaryCol = compactPrimaryCol
 
 func BuildIndexTableName(ctx context.Context, unique bool) (string, error) {
-	var name string
-	name = catalog.PrefixIndexTableName
 	if unique {
-		name += "unique_"
-	} else {
-		name += "secondary_"
+		return buildIndexTableName(ctx, "unique")
 	}
+	return buildIndexTableName(ctx, "secondary")
+}
+
+// BuildAlgoIndexTableName returns a new name of the index table of the index
+// built by the algo, like fulltext, ivfflat and expression.
+func BuildAlgoIndexTableName(ctx context.Context, algo string) (string, error) {
+	return buildIndexTableName(ctx, algo)
+}
+
+// buildIndexTableName returns a new name of the index table of the kind of
+// index, the name is unique by an uuid.
+func buildIndexTableName(ctx context.Context, kind string) (string, error) {
 	id, err := uuid.NewUUID()
 	if err != nil {
 		return "", moerr.NewInternalError(ctx, "newuuid failed")
 	}
-	name += id.String()
-	return name, nil
+	return catalog.PrefixIndexTableName + kind + "_" + id.String(), nil
+}
+
+// BuildMaterializedViewTableName returns the name of the hidden table keeping
+// the results of the materialized view.
+func BuildMaterializedViewTableName(viewName string) string {
+	return catalog.MaterializedViewTablePrefix + viewName
+}
+
+// MaterializedViewColName returns the name of the idx-th column of the hidden
+// table of a materialized view.
+func MaterializedViewColName(idx int) string {
+	return fmt.Sprintf("%s%d", catalog.MaterializedViewColNamePrefix, idx)
+}
+
+// IvfFlatCentroidsTableName returns the name of the table keeping the
+// centroids of the ivfflat index whose index table is indexTableName.
+func IvfFlatCentroidsTableName(indexTableName string) string {
+	return indexTableName + catalog.IvfFlatCentroidsTableSuffix
+}
+
+// IsIvfFlatIndex returns whether the index is an ivfflat index, whose index
+// table keeps the list of every vector.
+func IsIvfFlatIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.IndexAlgoIvfFlat
+}
+
+// IndexTableNames returns the names of all the tables of the index, the
+// ivfflat index has a centroids table besides the index table.
+func IndexTableNames(indexDef *plan.IndexDef) []string {
+	if !indexDef.TableExist {
+		return nil
+	}
+	if IsIvfFlatIndex(indexDef) {
+		return []string{indexDef.IndexTableName, IvfFlatCentroidsTableName(indexDef.IndexTableName)}
+	}
+	return []string{indexDef.IndexTableName}
+}
+
+// IsFullTextIndex returns whether the index is a fulltext index, whose index
+// table keeps the inverted index of the documents.
+func IsFullTextIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.IndexAlgoFullText
+}
+
+// IsExpressionIndex returns whether the index is on an expression, whose
+// index table keeps the value of the expression of every row.
+func IsExpressionIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.IndexAlgoExpression
+}
+
+// HasIndexTable returns whether the index table should be written with the
+// origin table, the unique, fulltext, ivfflat and expression index have one.
+func HasIndexTable(indexDef *plan.IndexDef) bool {
+	return indexDef.Unique || IsFullTextIndex(indexDef) || IsIvfFlatIndex(indexDef) || IsExpressionIndex(indexDef)
+}
+
+// BuildFullTextIndexBatch builds the rows of the fulltext index table for the
+// documents, the text of a document is the columns in vs joined by space.
+// Every word of a document has a row with its count, keyed by serial(word, pk).
+// The row of the empty word keeps the number of the words in the document.
+func BuildFullTextIndexBatch(vs []*vector.Vector, pkVec *vector.Vector, parser string, proc *process.Process) (*batch.Batch, error) {
+	tok, err := fulltext.NewTokenizer(parser)
+	if err != nil {
+		return nil, err
+	}
+	wordVec := vector.NewVec(types.T_varchar.ToType())
+	priVec := vector.NewVec(*pkVec.GetType())
+	cntVec := vector.NewVec(types.T_int64.ToType())
+	cleanup := func() {
+		wordVec.Free(proc.Mp())
+		priVec.Free(proc.Mp())
+		cntVec.Free(proc.Mp())
+	}
+
+	var text []byte
+	for i := 0; i < pkVec.Length(); i++ {
+		if pkVec.IsConstNull() || pkVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		text = text[:0]
+		for _, v := range vs {
+			if v.IsConstNull() || v.GetNulls().Contains(uint64(i)) {
+				continue
+			}
+			text = append(text, ' ')
+			text = append(text, v.GetBytesAt(i)...)
+		}
+		counts, length := tok.Count(string(text))
+		counts[""] = length
+		for word, cnt := range counts {
+			if err = vector.AppendBytes(wordVec, []byte(word), false, proc.Mp()); err != nil {
+				cleanup()
+				return nil, err
+			}
+			if err = priVec.UnionOne(pkVec, int64(i), proc.Mp()); err != nil {
+				cleanup()
+				return nil, err
+			}
+			if err = vector.AppendFixed(cntVec, cnt, false, proc.Mp()); err != nil {
+				cleanup()
+				return nil, err
+			}
+		}
+	}
+
+	return newIndexTableBatch([]string{
+		catalog.IndexTableWordColName,
+		catalog.IndexTablePrimaryColName,
+		catalog.IndexTableCountColName,
+	}, []*vector.Vector{wordVec, priVec, cntVec}, proc), nil
+}
+
+// BuildIvfFlatIndexBatch builds the rows of the ivfflat index table for the
+// vectors, keyed by serial(list, pk). The list of a vector is the position of
+// its nearest centroid, or -1 if there is no centroid, which means the vector
+// is unassigned and always scanned by the queries. The null vectors are not
+// indexed.
+func BuildIvfFlatIndexBatch(vec *vector.Vector, pkVec *vector.Vector, centroids [][]float32, proc *process.Process) (*batch.Batch, error) {
+	listVec := vector.NewVec(types.T_int32.ToType())
+	priVec := vector.NewVec(*pkVec.GetType())
+	cleanup := func() {
+		listVec.Free(proc.Mp())
+		priVec.Free(proc.Mp())
+	}
+
+	for i := 0; i < pkVec.Length(); i++ {
+		if pkVec.IsConstNull() || pkVec.GetNulls().Contains(uint64(i)) ||
+			vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		list := int32(vectorindex.Nearest(centroids, types.BytesToArrayF32(vec.GetBytesAt(i)), vectorindex.L2Distance))
+		if err := vector.AppendFixed(listVec, list, false, proc.Mp()); err != nil {
+			cleanup()
+			return nil, err
+		}
+		if err := priVec.UnionOne(pkVec, int64(i), proc.Mp()); err != nil {
+			cleanup()
+			return nil, err
+		}
+	}
+
+	return newIndexTableBatch([]string{
+		catalog.IndexTableListColName,
+		catalog.IndexTablePrimaryColName,
+	}, []*vector.Vector{listVec, priVec}, proc), nil
+}
+
+// BuildExpressionIndexBatch builds the rows of the expression index table
+// for the values of the expression, keyed by serial(value, pk). The null
+// values are not indexed.
+func BuildExpressionIndexBatch(vec *vector.Vector, pkVec *vector.Vector, proc *process.Process) (*batch.Batch, error) {
+	valVec := vector.NewVec(*vec.GetType())
+	priVec := vector.NewVec(*pkVec.GetType())
+	cleanup := func() {
+		valVec.Free(proc.Mp())
+		priVec.Free(proc.Mp())
+	}
+
+	for i := 0; i < pkVec.Length(); i++ {
+		if pkVec.IsConstNull() || pkVec.GetNulls().Contains(uint64(i)) ||
+			vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		if err := valVec.UnionOne(vec, int64(i), proc.Mp()); err != nil {
+			cleanup()
+			return nil, err
+		}
+		if err := priVec.UnionOne(pkVec, int64(i), proc.Mp()); err != nil {
+			cleanup()
+			return nil, err
+		}
+	}
+
+	return newIndexTableBatch([]string{
+		catalog.IndexTableValueColName,
+		catalog.IndexTablePrimaryColName,
+	}, []*vector.Vector{valVec, priVec}, proc), nil
+}
+
+// newIndexTableBatch returns the batch of the index table with the columns
+// vecs named attrs, the index column keyed by serial(vecs[0], vecs[1]) goes
+// first, the second one of vecs is the primary key of the origin table.
+func newIndexTableBatch(attrs []string, vecs []*vector.Vector, proc *process.Process) *batch.Batch {
+	b := batch.New(true, append([]string{catalog.IndexTableIndexColName}, attrs...))
+	keyVec, _ := serialWithCompacted(vecs[:2], proc)
+	b.SetVector(0, keyVec)
+	for i, vec := range vecs {
+		b.SetVector(int32(i+1), vec)
+	}
+	b.SetZs(vecs[0].Length(), proc.Mp())
+	return b
 }
 
 func BuildUniqueKeyBatch(vecs []*vector.Vector, attrs []string, parts []string, originTablePrimaryKey string, proc *process.Process) (*batch.Batch, int) {
@@ -184,7 +386,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint8(b)
 				}
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_year, types.T_enum:
 			s := vector.MustFixedCol[uint16](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -202,7 +404,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint32(b)
 				}
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit, types.T_set:
 			s := vector.MustFixedCol[uint64](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -372,7 +574,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -392,7 +594,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -560,7 +762,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0)
 		for i, b := range s {
@@ -580,7 +782,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0)
 		for i, b := range s {
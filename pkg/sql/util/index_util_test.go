@@ -17,6 +17,7 @@ package util
 import (
 	"context"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -53,6 +54,10 @@ func TestBuildIndexTableName(t *testing.T) {
 		require.Equal(t, indexTableName[:len(test.indexTableName)], test.indexTableName)
 		require.Equal(t, err, nil)
 	}
+
+	indexTableName, err := BuildAlgoIndexTableName(context.TODO(), catalog.IndexAlgoFullText)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(indexTableName, catalog.PrefixIndexTableName+"fulltext_"))
 }
 
 func TestBuildUniqueKeyBatch(t *testing.T) {
@@ -139,3 +144,71 @@ func TestCompactUniqueKeyBatch(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildFullTextIndexBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	pkVec := testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+	title := testutil.NewVector(3, types.T_varchar.ToType(), proc.Mp(), false, []string{"MySQL database", "", "Tutorial"})
+	body := testutil.NewVector(3, types.T_text.ToType(), proc.Mp(), false, []string{"the database", "abc", "x"})
+	nulls.Add(body.GetNulls(), 2)
+
+	bat, err := BuildFullTextIndexBatch([]*vector.Vector{title, body}, pkVec, "", proc)
+	require.NoError(t, err)
+	defer bat.Clean(proc.Mp())
+	require.Equal(t, []string{
+		catalog.IndexTableIndexColName,
+		catalog.IndexTableWordColName,
+		catalog.IndexTablePrimaryColName,
+		catalog.IndexTableCountColName,
+	}, bat.Attrs)
+
+	rows := make(map[[2]any]int64)
+	pks := vector.MustFixedCol[int64](bat.Vecs[2])
+	cnts := vector.MustFixedCol[int64](bat.Vecs[3])
+	for i := 0; i < bat.Length(); i++ {
+		rows[[2]any{bat.Vecs[1].GetStringAt(i), pks[i]}] = cnts[i]
+	}
+	require.Equal(t, map[[2]any]int64{
+		{"mysql", int64(1)}:    1,
+		{"database", int64(1)}: 2,
+		{"the", int64(1)}:      1,
+		{"", int64(1)}:         4,
+		{"abc", int64(2)}:      1,
+		{"", int64(2)}:         1,
+		{"tutorial", int64(3)}: 1,
+		{"", int64(3)}:         1,
+	}, rows)
+
+	_, err = BuildFullTextIndexBatch([]*vector.Vector{title}, pkVec, "mecab", proc)
+	require.Error(t, err)
+}
+
+func TestBuildIvfFlatIndexBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	pkVec := testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+	vec := testutil.NewVector(3, types.T_array_float32.ToType(), proc.Mp(), false, []string{
+		string(types.ArrayF32ToBytes([]float32{9, 9})),
+		string(types.ArrayF32ToBytes([]float32{1, 0})),
+		"",
+	})
+	nulls.Add(vec.GetNulls(), 2)
+
+	centroids := [][]float32{{0, 0}, {10, 10}}
+	bat, err := BuildIvfFlatIndexBatch(vec, pkVec, centroids, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		catalog.IndexTableIndexColName,
+		catalog.IndexTableListColName,
+		catalog.IndexTablePrimaryColName,
+	}, bat.Attrs)
+	require.Equal(t, []int32{1, 0}, vector.MustFixedCol[int32](bat.Vecs[1]))
+	require.Equal(t, []int64{1, 2}, vector.MustFixedCol[int64](bat.Vecs[2]))
+	require.Equal(t, 2, bat.Vecs[0].Length())
+	bat.Clean(proc.Mp())
+
+	// unassigned without centroids
+	bat, err = BuildIvfFlatIndexBatch(vec, pkVec, nil, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int32{-1, -1}, vector.MustFixedCol[int32](bat.Vecs[1]))
+	bat.Clean(proc.Mp())
+}
@@ -169,8 +169,11 @@ func BuildMoColumnsFilter(curAccountId uint64) tree.Expr {
 	mo_indexes := tree.NewNumValWithType(constant.MakeString("mo_indexes"), "mo_indexes", false, tree.P_char)
 	mo_pubs := tree.NewNumValWithType(constant.MakeString("mo_pubs"), "mo_pubs", false, tree.P_char)
 	mo_stored_procedure := tree.NewNumValWithType(constant.MakeString("mo_stored_procedure"), "mo_stored_procedure", false, tree.P_char)
+	mo_triggers := tree.NewNumValWithType(constant.MakeString("mo_triggers"), "mo_triggers", false, tree.P_char)
+	mo_mviews := tree.NewNumValWithType(constant.MakeString("mo_mviews"), "mo_mviews", false, tree.P_char)
+	mo_column_stats := tree.NewNumValWithType(constant.MakeString("mo_column_stats"), "mo_column_stats", false, tree.P_char)
 
-	notInValues := tree.NewTuple(tree.Exprs{mo_databaseConst, mo_tablesConst, mo_columnsConst, mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst, mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_pubs, mo_stored_procedure})
+	notInValues := tree.NewTuple(tree.Exprs{mo_databaseConst, mo_tablesConst, mo_columnsConst, mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst, mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_pubs, mo_stored_procedure, mo_triggers, mo_mviews, mo_column_stats})
 	notInexpr := tree.NewComparisonExpr(tree.NOT_IN, att_relnameColName, notInValues)
 
 	dbNameEqualAst := makeStringEqualAst(catalog.SystemColAttr_DBName, "mo_catalog")
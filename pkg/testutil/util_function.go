@@ -303,7 +303,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		r := vector.GenerateFunctionFixedTypeParameter[uint16](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint16](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -345,7 +345,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		r := vector.GenerateFunctionFixedTypeParameter[uint64](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint64](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -534,7 +534,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_char, types.T_varchar,
+	case types.T_char, types.T_varchar, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		r := vector.GenerateFunctionStrParameter(v)
 		s := vector.GenerateFunctionStrParameter(vExpected)
@@ -710,13 +710,13 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_uint8:
 		values := val.([]uint8)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		values := val.([]uint16)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_uint32:
 		values := val.([]uint32)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		values := val.([]uint64)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_float32:
@@ -743,7 +743,8 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_timestamp:
 		values := val.([]types.Timestamp)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
+		types.T_array_float32:
 		values := val.([]string)
 		vector.AppendStringList(vec, values, nil, mp)
 	case types.T_uuid:
@@ -154,7 +154,7 @@ func NewVector(n int, typ types.Type, m *mpool.MPool, random bool, Values interf
 			return NewUInt8Vector(n, typ, m, random, vs)
 		}
 		return NewUInt8Vector(n, typ, m, random, nil)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		if vs, ok := Values.([]uint16); ok {
 			return NewUInt16Vector(n, typ, m, random, vs)
 		}
@@ -164,7 +164,7 @@ func NewVector(n int, typ types.Type, m *mpool.MPool, random bool, Values interf
 			return NewUInt32Vector(n, typ, m, random, vs)
 		}
 		return NewUInt32Vector(n, typ, m, random, nil)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		if vs, ok := Values.([]uint64); ok {
 			return NewUInt64Vector(n, typ, m, random, vs)
 		}
@@ -210,7 +210,7 @@ func NewVector(n int, typ types.Type, m *mpool.MPool, random bool, Values interf
 		}
 		return NewDecimal128Vector(n, typ, m, random, nil)
 	case types.T_char, types.T_varchar,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_array_float32:
 		if vs, ok := Values.([]string); ok {
 			return NewStringVector(n, typ, m, random, vs)
 		}
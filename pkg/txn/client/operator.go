@@ -162,6 +162,7 @@ type txnOperator struct {
 		txn          txn.TxnMeta
 		cachedWrites map[uint64][]txn.TxnRequest
 		lockTables   []lock.LockTable
+		savepoints   []string
 	}
 	workspace Workspace
 }
@@ -364,14 +365,14 @@ func (tc *txnOperator) Rollback(ctx context.Context) error {
 		tc.mu.Unlock()
 	}()
 
-	if len(tc.mu.txn.DNShards) == 0 {
-		return nil
-	}
-
 	if tc.needUnlockLocked() {
 		defer tc.unlock(ctx)
 	}
 
+	if len(tc.mu.txn.DNShards) == 0 {
+		return nil
+	}
+
 	result, err := tc.handleError(tc.doSend(ctx, []txn.TxnRequest{{
 		Method:          txn.TxnMethod_Rollback,
 		RollbackRequest: &txn.TxnRollbackRequest{},
@@ -388,6 +389,89 @@ func (tc *txnOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (tc *txnOperator) SavePoint(ctx context.Context, name string) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if err := tc.checkStatus(true); err != nil {
+		return err
+	}
+
+	if tc.workspace != nil {
+		if err := tc.workspace.SavePoint(ctx, name); err != nil {
+			return err
+		}
+	}
+	if tc.needUnlockLocked() {
+		if err := tc.option.lockService.SavePoint(tc.txnID, name); err != nil {
+			return err
+		}
+	}
+	if idx := tc.findSavePointLocked(name); idx >= 0 {
+		tc.mu.savepoints = append(tc.mu.savepoints[:idx], tc.mu.savepoints[idx+1:]...)
+	}
+	tc.mu.savepoints = append(tc.mu.savepoints, name)
+	return nil
+}
+
+func (tc *txnOperator) RollbackToSavePoint(ctx context.Context, name string) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if err := tc.checkStatus(true); err != nil {
+		return err
+	}
+
+	idx := tc.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	if tc.workspace != nil {
+		if err := tc.workspace.RollbackToSavePoint(ctx, name); err != nil {
+			return err
+		}
+	}
+	if tc.needUnlockLocked() {
+		if err := tc.option.lockService.RollbackToSavePoint(ctx, tc.txnID, name); err != nil {
+			return err
+		}
+	}
+	tc.mu.savepoints = tc.mu.savepoints[:idx+1]
+	return nil
+}
+
+func (tc *txnOperator) ReleaseSavePoint(ctx context.Context, name string) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if err := tc.checkStatus(true); err != nil {
+		return err
+	}
+
+	idx := tc.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	if tc.workspace != nil {
+		if err := tc.workspace.ReleaseSavePoint(ctx, name); err != nil {
+			return err
+		}
+	}
+	if tc.needUnlockLocked() {
+		if err := tc.option.lockService.ReleaseSavePoint(tc.txnID, name); err != nil {
+			return err
+		}
+	}
+	tc.mu.savepoints = tc.mu.savepoints[:idx]
+	return nil
+}
+
+func (tc *txnOperator) findSavePointLocked(name string) int {
+	for i := len(tc.mu.savepoints) - 1; i >= 0; i-- {
+		if tc.mu.savepoints[i] == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (tc *txnOperator) AddLockTable(value lock.LockTable) error {
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
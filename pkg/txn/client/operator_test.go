@@ -422,6 +422,51 @@ func TestAddLockTable(t *testing.T) {
 	})
 }
 
+func TestSavePoint(t *testing.T) {
+	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, _ *testTxnSender) {
+		ws := &testWorkspace{}
+		tc.AddWorkspace(ws)
+
+		assert.NoError(t, tc.SavePoint(ctx, "a"))
+		assert.NoError(t, tc.SavePoint(ctx, "b"))
+		assert.NoError(t, tc.SavePoint(ctx, "c"))
+		// same name replaces the old savepoint
+		assert.NoError(t, tc.SavePoint(ctx, "a"))
+		assert.Equal(t, []string{"b", "c", "a"}, tc.mu.savepoints)
+
+		assert.NoError(t, tc.RollbackToSavePoint(ctx, "c"))
+		assert.Equal(t, []string{"b", "c"}, tc.mu.savepoints)
+		assert.NoError(t, tc.ReleaseSavePoint(ctx, "b"))
+		assert.Empty(t, tc.mu.savepoints)
+
+		err := tc.RollbackToSavePoint(ctx, "a")
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+		err = tc.ReleaseSavePoint(ctx, "a")
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+		assert.Equal(t, []string{"save a", "save b", "save c", "save a", "rollback c", "release b"}, ws.calls)
+	})
+}
+
+type testWorkspace struct {
+	calls []string
+}
+
+func (ws *testWorkspace) SavePoint(ctx context.Context, name string) error {
+	ws.calls = append(ws.calls, "save "+name)
+	return nil
+}
+
+func (ws *testWorkspace) RollbackToSavePoint(ctx context.Context, name string) error {
+	ws.calls = append(ws.calls, "rollback "+name)
+	return nil
+}
+
+func (ws *testWorkspace) ReleaseSavePoint(ctx context.Context, name string) error {
+	ws.calls = append(ws.calls, "release "+name)
+	return nil
+}
+
 func runOperatorTests(t *testing.T, tc func(context.Context, *txnOperator, *testTxnSender), options ...TxnOption) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
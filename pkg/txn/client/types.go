@@ -114,6 +114,16 @@ type TxnOperator interface {
 	// the transaction will be rolled back.
 	AddLockTable(locktable lock.LockTable) error
 
+	// SavePoint sets a named savepoint of the transaction. A savepoint with the same
+	// name is replaced.
+	SavePoint(ctx context.Context, name string) error
+	// RollbackToSavePoint rolls back the workspace and the locks of the transaction
+	// to the named savepoint without aborting the transaction. The savepoints set
+	// after the named savepoint are removed.
+	RollbackToSavePoint(ctx context.Context, name string) error
+	// ReleaseSavePoint removes the named savepoint and the savepoints set after it.
+	ReleaseSavePoint(ctx context.Context, name string) error
+
 	// AddWorkspace for the transaction
 	AddWorkspace(workspace Workspace)
 	// GetWorkspace from the transaction
@@ -160,5 +170,13 @@ type TimestampWaiter interface {
 	Close()
 }
 
+// Workspace is the txn workspace of the storage engine, which caches the writes
+// of the transaction until commit.
 type Workspace interface {
+	// SavePoint takes a checkpoint of the workspace
+	SavePoint(ctx context.Context, name string) error
+	// RollbackToSavePoint discards the writes after the named checkpoint
+	RollbackToSavePoint(ctx context.Context, name string) error
+	// ReleaseSavePoint removes the named checkpoint
+	ReleaseSavePoint(ctx context.Context, name string) error
 }
@@ -172,11 +172,11 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.(int64)
 	case types.T_uint8:
 		_, ok = v.(uint8)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		_, ok = v.(uint16)
 	case types.T_uint32:
 		_, ok = v.(uint32)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		_, ok = v.(uint64)
 	case types.T_float32:
 		_, ok = v.(float32)
@@ -206,6 +206,8 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.([]byte)
 	case types.T_json:
 		_, ok = v.([]byte)
+	case types.T_array_float32:
+		_, ok = v.([]byte)
 	case types.T_blob:
 		_, ok = v.([]byte)
 	case types.T_text:
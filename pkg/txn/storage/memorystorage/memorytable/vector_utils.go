@@ -47,13 +47,13 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 	case types.T_uint8:
 		return vectorAtFixed[uint8](vec, i)
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return vectorAtFixed[uint16](vec, i)
 
 	case types.T_uint32:
 		return vectorAtFixed[uint32](vec, i)
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return vectorAtFixed[uint64](vec, i)
 
 	case types.T_float32:
@@ -93,7 +93,7 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 	case types.T_uuid:
 		return vectorAtFixed[types.Uuid](vec, i)
 
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
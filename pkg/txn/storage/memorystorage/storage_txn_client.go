@@ -226,6 +226,18 @@ func (s *StorageTxnOperator) AddLockTable(lock.LockTable) error {
 	panic("should not call")
 }
 
+func (s *StorageTxnOperator) SavePoint(ctx context.Context, name string) error {
+	panic("should not call")
+}
+
+func (s *StorageTxnOperator) RollbackToSavePoint(ctx context.Context, name string) error {
+	panic("should not call")
+}
+
+func (s *StorageTxnOperator) ReleaseSavePoint(ctx context.Context, name string) error {
+	panic("should not call")
+}
+
 func (s *StorageTxnOperator) UpdateSnapshot(ts timestamp.Timestamp) error {
 	panic("should not call")
 }
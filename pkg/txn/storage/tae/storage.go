@@ -52,14 +52,18 @@ func NewTAEStorage(
 	logtailServerAddr string,
 	logtailServerCfg *options.LogtailServerCfg,
 	logStore options.LogstoreType,
+	compressionCfg *options.CompressionCfg,
+	gcCfg *options.GCCfg,
 ) (*taeStorage, error) {
 	opt := &options.Options{
-		Clock:         rt.Clock(),
-		Fs:            fs,
-		Lc:            logservicedriver.LogServiceClientFactory(factory),
-		Shard:         shard,
-		CheckpointCfg: ckpCfg,
-		LogStoreT:     logStore,
+		Clock:          rt.Clock(),
+		Fs:             fs,
+		Lc:             logservicedriver.LogServiceClientFactory(factory),
+		Shard:          shard,
+		CheckpointCfg:  ckpCfg,
+		CompressionCfg: compressionCfg,
+		GCCfg:          gcCfg,
+		LogStoreT:      logStore,
 	}
 
 	taeHandler := rpc.NewTAEHandle(dataDir, opt)
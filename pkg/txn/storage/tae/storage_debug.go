@@ -63,6 +63,17 @@ func (s *taeStorage) Debug(ctx context.Context,
 		})
 		return resp, err
 
+	case uint32(ctl.CmdMethod_Backup):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleBackup,
+		)
+		if err != nil {
+			resp := protoc.MustMarshal(&ctl.DNStringResponse{
+				ReturnStr: "Failed",
+			})
+			return resp, err
+		}
+		return resp.Read()
 	case uint32(ctl.CmdMethod_Inspect):
 		resp, err := handleRead(
 			ctx, s, txnMeta, data, s.taeHandler.HandleInspectDN,
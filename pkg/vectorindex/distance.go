@@ -0,0 +1,62 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import "math"
+
+// DistanceFunc returns the distance between two vectors of the same
+// dimension, the smaller the closer.
+type DistanceFunc func(a, b []float32) float64
+
+// L2Distance returns the euclidean distance of a and b.
+func L2Distance(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// InnerProduct returns the dot product of a and b.
+func InnerProduct(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
+
+// CosineDistance returns 1 - cosine similarity of a and b. The distance is
+// NaN if any of them is the zero vector.
+func CosineDistance(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return math.NaN()
+	}
+	sim := dot / math.Sqrt(na*nb)
+	// the rounding error may make it out of [-1, 1]
+	if sim > 1 {
+		sim = 1
+	} else if sim < -1 {
+		sim = -1
+	}
+	return 1 - sim
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	// DefaultLists is the number of the lists of an ivfflat index if LISTS is
+	// not given, same as pgvector.
+	DefaultLists = 100
+	// MaxLists is the max number of the lists of an ivfflat index.
+	MaxLists = 65536
+	// SamplesPerList is the number of the vectors sampled for each list to
+	// train the centroids.
+	SamplesPerList = 50
+	// MaxIterations is the max rounds of k-means.
+	MaxIterations = 20
+)
+
+// Sampler keeps a uniform sample of the vectors added by reservoir sampling.
+type Sampler struct {
+	size    int
+	seen    int64
+	rand    *rand.Rand
+	samples [][]float32
+}
+
+func NewSampler(size int, seed int64) *Sampler {
+	return &Sampler{
+		size: size,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Add adds v to the sample, v is copied if it is kept.
+func (s *Sampler) Add(v []float32) {
+	s.seen++
+	if len(s.samples) < s.size {
+		s.samples = append(s.samples, append([]float32{}, v...))
+		return
+	}
+	if i := s.rand.Int63n(s.seen); i < int64(s.size) {
+		s.samples[i] = append(s.samples[i][:0], v...)
+	}
+}
+
+func (s *Sampler) Samples() [][]float32 {
+	return s.samples
+}
+
+// KMeans clusters the vectors into at most k clusters by the euclidean
+// distance and returns the centroids. The initial centroids are chosen by
+// k-means++, then they are refined by Lloyd's algorithm until no vector
+// changes its cluster or maxIter rounds.
+func KMeans(vecs [][]float32, k int, maxIter int, seed int64) [][]float32 {
+	if k > len(vecs) {
+		k = len(vecs)
+	}
+	if k == 0 {
+		return nil
+	}
+	dim := len(vecs[0])
+	r := rand.New(rand.NewSource(seed))
+
+	centroids := make([][]float32, 0, k)
+	centroids = append(centroids, append([]float32{}, vecs[r.Intn(len(vecs))]...))
+	dists := make([]float64, len(vecs))
+	for i := range dists {
+		dists[i] = math.MaxFloat64
+	}
+	for len(centroids) < k {
+		var sum float64
+		last := centroids[len(centroids)-1]
+		for i, v := range vecs {
+			d := L2Distance(v, last)
+			d *= d
+			if d < dists[i] {
+				dists[i] = d
+			}
+			sum += dists[i]
+		}
+		next := 0
+		if sum > 0 {
+			target := r.Float64() * sum
+			for next = 0; next < len(vecs)-1; next++ {
+				target -= dists[next]
+				if target <= 0 {
+					break
+				}
+			}
+		} else {
+			// all the vectors are the centroids already
+			break
+		}
+		centroids = append(centroids, append([]float32{}, vecs[next]...))
+	}
+
+	assign := make([]int, len(vecs))
+	for i := range assign {
+		assign[i] = -1
+	}
+	sums := make([][]float64, len(centroids))
+	counts := make([]int, len(centroids))
+	for i := range sums {
+		sums[i] = make([]float64, dim)
+	}
+	for iter := 0; iter < maxIter; iter++ {
+		changed := false
+		for i, v := range vecs {
+			c := Nearest(centroids, v, L2Distance)
+			if c != assign[i] {
+				assign[i] = c
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		for i := range sums {
+			for j := range sums[i] {
+				sums[i][j] = 0
+			}
+			counts[i] = 0
+		}
+		for i, v := range vecs {
+			c := assign[i]
+			counts[c]++
+			for j, f := range v {
+				sums[c][j] += float64(f)
+			}
+		}
+		for i := range centroids {
+			// the empty cluster keeps its centroid
+			if counts[i] == 0 {
+				continue
+			}
+			for j := range centroids[i] {
+				centroids[i][j] = float32(sums[i][j] / float64(counts[i]))
+			}
+		}
+	}
+	return centroids
+}
+
+// Nearest returns the position of the centroid closest to v, or -1 if there
+// is no centroid.
+func Nearest(centroids [][]float32, v []float32, dist DistanceFunc) int {
+	pos := -1
+	min := math.Inf(1)
+	for i, c := range centroids {
+		if d := dist(c, v); pos == -1 || d < min {
+			pos, min = i, d
+		}
+	}
+	return pos
+}
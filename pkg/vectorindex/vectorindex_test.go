@@ -0,0 +1,68 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistance(t *testing.T) {
+	a := []float32{1, 2, 3}
+	b := []float32{4, 6, 3}
+	require.Equal(t, 5.0, L2Distance(a, b))
+	require.Equal(t, 0.0, L2Distance(a, a))
+	require.Equal(t, 25.0, InnerProduct(a, b))
+	require.InDelta(t, 0.0, CosineDistance(a, []float32{2, 4, 6}), 1e-9)
+	require.InDelta(t, 1.0, CosineDistance([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	require.InDelta(t, 2.0, CosineDistance([]float32{1, 0}, []float32{-1, 0}), 1e-9)
+	require.True(t, math.IsNaN(CosineDistance(a, []float32{0, 0, 0})))
+}
+
+func TestKMeans(t *testing.T) {
+	var vecs [][]float32
+	for i := 0; i < 50; i++ {
+		f := float32(i%5) * 0.01
+		vecs = append(vecs, []float32{f, f}, []float32{10 + f, 10 + f}, []float32{-10 - f, 10 + f})
+	}
+	centroids := KMeans(vecs, 3, MaxIterations, 1)
+	require.Equal(t, 3, len(centroids))
+	for _, v := range [][]float32{{0, 0}, {10, 10}, {-10, 10}} {
+		c := centroids[Nearest(centroids, v, L2Distance)]
+		require.Less(t, L2Distance(c, v), 0.1)
+	}
+
+	// fewer vectors than k
+	require.Equal(t, 2, len(KMeans(vecs[:2], 3, MaxIterations, 1)))
+	// duplicated vectors
+	require.Equal(t, 1, len(KMeans([][]float32{{1, 1}, {1, 1}}, 2, MaxIterations, 1)))
+	require.Nil(t, KMeans(nil, 3, MaxIterations, 1))
+	require.Equal(t, -1, Nearest(nil, []float32{1}, L2Distance))
+}
+
+func TestSampler(t *testing.T) {
+	s := NewSampler(10, 1)
+	for i := 0; i < 1000; i++ {
+		s.Add([]float32{float32(i)})
+	}
+	require.Equal(t, 10, len(s.Samples()))
+	seen := make(map[float32]bool)
+	for _, v := range s.Samples() {
+		require.False(t, seen[v[0]])
+		seen[v[0]] = true
+	}
+}
@@ -143,6 +143,7 @@ type column struct {
 	updateExpr      []byte
 	isClusterBy     int8
 	seqnum          uint16
+	enumValues      string
 	rowid           types.Rowid //rowid for a column in mo_columns
 }
 
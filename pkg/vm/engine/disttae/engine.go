@@ -40,6 +40,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/cache"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -435,9 +436,23 @@ func (e *Engine) Nodes(isInternal bool, tenant string, cnLabel map[string]string
 
 func (e *Engine) Hints() (h engine.Hints) {
 	h.CommitOrRollbackTimeout = time.Minute * 5
+	h.SnapshotRetention = e.getSnapshotRetention()
 	return
 }
 
+// getSnapshotRetention returns the GC retention reported by DN to HAKeeper,
+// the snapshot older than it can not be read. The default retention of DN is
+// used before DN is known.
+func (e *Engine) getSnapshotRetention() time.Duration {
+	retention := options.DefaultGCTTL
+	for _, dn := range e.getDNServices() {
+		if dn.GCRetention > 0 {
+			retention = time.Duration(dn.GCRetention)
+		}
+	}
+	return retention
+}
+
 func (e *Engine) NewBlockReader(ctx context.Context, num int, ts timestamp.Timestamp,
 	expr *plan.Expr, ranges [][]byte, tblDef *plan.TableDef) ([]engine.Reader, error) {
 	rds := make([]engine.Reader, num)
@@ -482,6 +497,14 @@ func (e *Engine) getTransaction(op client.TxnOperator) *Transaction {
 }
 
 func (e *Engine) delTransaction(txn *Transaction) {
+	if len(txn.savepoints) > 0 {
+		var entries []Entry
+		for _, sp := range txn.savepoints {
+			entries = append(entries, sp.writes...)
+		}
+		txn.savepoints = nil
+		txn.cleanUnreferencedBatchesLocked(entries)
+	}
 	for i := range txn.writes {
 		if txn.writes[i].bat == nil {
 			continue
@@ -14,6 +14,35 @@
 
 package disttae
 
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSnapshotRetention(t *testing.T) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	setDNServices := func(dn ...metadata.DNService) {
+		cluster := clusterservice.NewMOCluster(nil, 0,
+			clusterservice.WithDisableRefresh(),
+			clusterservice.WithServices(nil, dn))
+		runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.ClusterService, cluster)
+	}
+	e := &Engine{}
+
+	// the default retention before DN is known
+	setDNServices()
+	require.Equal(t, options.DefaultGCTTL, e.Hints().SnapshotRetention)
+
+	setDNServices(metadata.DNService{ServiceID: "dn", GCRetention: int64(10 * time.Minute)})
+	require.Equal(t, 10*time.Minute, e.Hints().SnapshotRetention)
+}
+
 /*
 type testTxnOperator struct {
 	meta txn.TxnMeta
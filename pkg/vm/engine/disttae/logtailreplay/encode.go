@@ -78,7 +78,7 @@ func EncodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		s := vector.MustFixedCol[uint16](vec)
 		for _, v := range s {
 			packer.EncodeUint16(v)
@@ -94,7 +94,7 @@ func EncodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		s := vector.MustFixedCol[uint64](vec)
 		for _, v := range s {
 			packer.EncodeUint64(v)
@@ -174,7 +174,7 @@ func EncodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_json, types.T_char, types.T_varchar,
+	case types.T_json, types.T_array_float32, types.T_char, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_Rowid:
 		for i := 0; i < vec.Length(); i++ {
 			packer.EncodeStringType(vec.GetBytesAt(i))
@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
 
 type PartitionReader struct {
@@ -52,6 +53,11 @@ type PartitionReader struct {
 	blockBatch      *BlockBatch
 	currentFileName string
 	deletedBlocks   *deletedBlocks
+
+	// the values of the columns added after the rows are written
+	fillsMap map[string]any
+	// the vectors written before the types of the columns are widened
+	widened map[*vector.Vector]*vector.Vector
 }
 
 // BlockBatch is used to record the metaLoc info
@@ -86,9 +92,32 @@ var _ engine.Reader = new(PartitionReader)
 
 func (p *PartitionReader) Close() error {
 	p.iter.Close()
+	for _, vec := range p.widened {
+		vec.Free(p.procMPool)
+	}
+	p.widened = nil
 	return nil
 }
 
+// widen returns the values of vec read as the type typ, see containers.WidenCNVector
+func (p *PartitionReader) widen(vec *vector.Vector, typ types.Type) (*vector.Vector, error) {
+	if vec.GetType().Oid == typ.Oid || !types.IsWidening(*vec.GetType(), typ) {
+		return vec, nil
+	}
+	if res, ok := p.widened[vec]; ok {
+		return res, nil
+	}
+	res, err := containers.WidenCNVector(vec, typ, p.procMPool)
+	if err != nil {
+		return nil, err
+	}
+	if p.widened == nil {
+		p.widened = make(map[*vector.Vector]*vector.Vector)
+	}
+	p.widened[vec] = res
+	return res, nil
+}
+
 func (p *PartitionReader) getSeqnums(colNames []string) (res []uint16) {
 	for _, str := range colNames {
 		if str == catalog.Row_ID {
@@ -278,13 +307,18 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 			} else {
 				idx := 2 /*rowid and commits*/ + p.seqnumMp[name]
 				if idx >= len(entry.Batch.Vecs) /*add column*/ || entry.Batch.Attrs[idx] == "" /*drop column*/ {
-					if err := vector.AppendAny(b.Vecs[i], nil, true, mp); err != nil {
+					fill := p.fillsMap[name]
+					if err := vector.AppendAny(b.Vecs[i], fill, fill == nil, mp); err != nil {
 						return nil, err
 					}
 				} else {
+					src, err := p.widen(entry.Batch.Vecs[idx], p.typsMap[name])
+					if err != nil {
+						return nil, err
+					}
 					appendFuncs[i](
 						b.Vecs[i],
-						entry.Batch.Vecs[2 /*rowid and commits*/ +p.seqnumMp[name]],
+						src,
 						entry.Offset,
 					)
 				}
@@ -29,8 +29,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
 
 func (r *emptyReader) Close() error {
@@ -63,6 +65,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 			r.prefetchColIdxs = make([]uint16, 0)
 			r.seqnums = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
+			r.colFills = make([]any, len(cols))
 			r.colNulls = make([]bool, len(cols))
 			r.pkidxInColIdxs = -1
 			for i, column := range cols {
@@ -80,7 +83,8 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 						r.pkidxInColIdxs = i
 						r.pkName = column
 					}
-					r.colTypes[i] = types.T(colDef.Typ.Id).ToType()
+					r.colTypes[i] = vector.ProtoTypeToType(colDef.Typ)
+					r.colFills[i] = containers.DefaultFillValue(colDef.Default, r.colTypes[i])
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
@@ -99,7 +103,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 	}
 
 	logutil.Debugf("read %v with %v", cols, r.seqnums)
-	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.ts, r.fs, mp, vp)
+	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.colFills, r.ts, r.fs, mp, vp)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +152,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 		if len(r.seqnums) == 0 {
 			r.seqnums = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
+			r.colFills = make([]any, len(cols))
 			r.colNulls = make([]bool, len(cols))
 			for i, column := range cols {
 				// sometimes Name2ColIndex have no row_id， sometimes have one
@@ -160,7 +165,8 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 					logicalIdx := r.tableDef.Name2ColIndex[column]
 					colDef := r.tableDef.Cols[logicalIdx]
 					r.seqnums[i] = uint16(colDef.Seqnum)
-					r.colTypes[i] = types.T(colDef.Typ.Id).ToType()
+					r.colTypes[i] = vector.ProtoTypeToType(colDef.Typ)
+					r.colFills[i] = containers.DefaultFillValue(colDef.Default, r.colTypes[i])
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
@@ -172,7 +178,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 	}
 
 	logutil.Debugf("read %v with %v", cols, r.seqnums)
-	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.ts, r.fs, mp, vp)
+	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.colFills, r.ts, r.fs, mp, vp)
 	if err != nil {
 		return nil, err
 	}
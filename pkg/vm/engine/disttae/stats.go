@@ -42,11 +42,11 @@ func calcNdvUsingZonemap(zm objectio.ZoneMap, t *types.Type) float64 {
 		return float64(types.DecodeFixed[int64](zm.GetMaxBuf())) - float64(types.DecodeFixed[int64](zm.GetMinBuf())) + 1
 	case types.T_uint8:
 		return float64(types.DecodeFixed[uint8](zm.GetMaxBuf())) - float64(types.DecodeFixed[uint8](zm.GetMinBuf())) + 1
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return float64(types.DecodeFixed[uint16](zm.GetMaxBuf())) - float64(types.DecodeFixed[uint16](zm.GetMinBuf())) + 1
 	case types.T_uint32:
 		return float64(types.DecodeFixed[uint32](zm.GetMaxBuf())) - float64(types.DecodeFixed[uint32](zm.GetMinBuf())) + 1
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return float64(types.DecodeFixed[uint64](zm.GetMaxBuf())) - float64(types.DecodeFixed[uint64](zm.GetMinBuf())) + 1
 	case types.T_decimal64:
 		return types.Decimal64ToFloat64(types.DecodeFixed[types.Decimal64](zm.GetMaxBuf()), t.Scale) -
@@ -148,6 +148,46 @@ func genTableConstraintTuple(tblId, dbId uint64, tblName, dbName string, constra
 	return bat, nil
 }
 
+// genTableAlterTuple yields a batch which carries the marshaled alter table
+// requests, one request per row.
+func genTableAlterTuple(tblId, dbId uint64, tblName, dbName string, reqs [][]byte,
+	m *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(5)
+	bat.Attrs = append(bat.Attrs, catalog.MoTablesSchema[:4]...)
+	bat.Attrs = append(bat.Attrs, catalog.SystemRelAttr_Constraint)
+	bat.SetZs(len(reqs), m)
+
+	idx := catalog.MO_TABLES_REL_ID_IDX
+	bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_id
+	idx = catalog.MO_TABLES_REL_NAME_IDX
+	bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // relname
+	idx = catalog.MO_TABLES_RELDATABASE_IDX
+	bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase
+	idx = catalog.MO_TABLES_RELDATABASE_ID_IDX
+	bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase_id
+	idx = catalog.MO_TABLES_ALTER_TABLE
+	bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_CONSTRAINT_IDX]) // alter table req
+
+	for _, req := range reqs {
+		if err := vector.AppendFixed(bat.Vecs[catalog.MO_TABLES_REL_ID_IDX], tblId, false, m); err != nil {
+			return nil, err
+		}
+		if err := vector.AppendBytes(bat.Vecs[catalog.MO_TABLES_REL_NAME_IDX], []byte(tblName), false, m); err != nil {
+			return nil, err
+		}
+		if err := vector.AppendBytes(bat.Vecs[catalog.MO_TABLES_RELDATABASE_IDX], []byte(dbName), false, m); err != nil {
+			return nil, err
+		}
+		if err := vector.AppendFixed(bat.Vecs[catalog.MO_TABLES_RELDATABASE_ID_IDX], dbId, false, m); err != nil {
+			return nil, err
+		}
+		if err := vector.AppendBytes(bat.Vecs[catalog.MO_TABLES_ALTER_TABLE], req, false, m); err != nil {
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
 // genCreateTableTuple yields a batch for insertion into mo_tables.
 // rowid: rowid of the row.
 // needRowid: true -- there is a rowid vector in position 0 of the batch.
@@ -380,6 +420,11 @@ func genCreateColumnTuple(col column, rowid types.Rowid, needRowid bool, m *mpoo
 		if err := vector.AppendFixed(bat.Vecs[idx], col.seqnum, false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_COLUMNS_ATT_ENUM_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_enum
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(col.enumValues), false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	if needRowid {
@@ -800,6 +845,8 @@ func toPBEntry(e Entry) (*api.Entry, error) {
 		typ = api.Entry_Delete
 	} else if e.typ == UPDATE {
 		typ = api.Entry_Update
+	} else if e.typ == ALTER {
+		typ = api.Entry_Alter
 	}
 	bat, err := toPBBatch(ebat)
 	if err != nil {
@@ -950,6 +997,7 @@ func genColumns(accountId uint32, tableName, databaseName string,
 			num:          num,
 			comment:      attrDef.Attr.Comment,
 			seqnum:       uint16(num - 1),
+			enumValues:   attrDef.Attr.EnumValues,
 		}
 		attrDef.Attr.ID = uint64(num)
 		attrDef.Attr.Seqnum = uint16(num - 1)
@@ -1177,11 +1225,11 @@ func transferIval[T int32 | int64](v T, oid types.T) (bool, any) {
 		return true, int64(v)
 	case types.T_uint8:
 		return true, uint8(v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return true, uint16(v)
 	case types.T_uint32:
 		return true, uint32(v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return true, uint64(v)
 	case types.T_float32:
 		return true, float32(v)
@@ -1204,11 +1252,11 @@ func transferUval[T uint32 | uint64](v T, oid types.T) (bool, any) {
 		return true, int64(v)
 	case types.T_uint8:
 		return true, uint8(v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return true, uint16(v)
 	case types.T_uint32:
 		return true, uint32(v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return true, uint64(v)
 	case types.T_float32:
 		return true, float32(v)
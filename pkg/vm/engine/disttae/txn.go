@@ -176,7 +176,12 @@ func (txn *Transaction) dumpWrites(offset int) (size uint64, mp map[[2]string][]
 	txn.Lock()
 	defer txn.Unlock()
 
-	for i := offset; i < len(txn.writes); i++ {
+	// the batches shared with the savepoints can't be dumped
+	start := offset
+	if n := txn.protectedWritesLocked(); start < n {
+		start = n
+	}
+	for i := start; i < len(txn.writes); i++ {
 		if txn.writes[i].bat == nil {
 			continue
 		}
@@ -189,7 +194,7 @@ func (txn *Transaction) dumpWrites(offset int) (size uint64, mp map[[2]string][]
 	}
 
 	mp = make(map[[2]string][]*batch.Batch)
-	for i := offset; i < len(txn.writes); i++ {
+	for i := start; i < len(txn.writes); i++ {
 		// TODO: after shrink, we should update workspace size
 		if txn.writes[i].bat == nil || txn.writes[i].bat.Length() == 0 {
 			continue
@@ -302,7 +307,7 @@ func (txn *Transaction) WriteFile(typ int, databaseId, tableId uint64,
 }
 
 func (txn *Transaction) deleteBatch(bat *batch.Batch,
-	databaseId, tableId uint64) *batch.Batch {
+	databaseId, tableId uint64) (*batch.Batch, error) {
 	mp := make(map[types.Rowid]uint8)
 	deleteBlkId := make(map[types.Blockid]bool)
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(0))
@@ -333,10 +338,13 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 	// cn rowId antiShrink
 	bat.AntiShrink(cnRowIdOffsets)
 	if bat.Length() == 0 {
-		return bat
+		return bat, nil
 	}
 	sels := txn.proc.Mp().GetSels()
-	txn.deleteTableWrites(databaseId, tableId, sels, deleteBlkId, min1, max1, mp)
+	if err := txn.deleteTableWrites(databaseId, tableId, sels, deleteBlkId, min1, max1, mp); err != nil {
+		txn.proc.Mp().PutSels(sels)
+		return nil, err
+	}
 	sels = sels[:0]
 	for k, rowid := range rowids {
 		if mp[rowid] == 0 {
@@ -345,7 +353,7 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 	}
 	bat.Shrink(sels)
 	txn.proc.Mp().PutSels(sels)
-	return bat
+	return bat, nil
 }
 
 func (txn *Transaction) deleteTableWrites(
@@ -355,7 +363,7 @@ func (txn *Transaction) deleteTableWrites(
 	deleteBlkId map[types.Blockid]bool,
 	min, max uint32,
 	mp map[types.Rowid]uint8,
-) {
+) error {
 	txn.Lock()
 	defer txn.Unlock()
 
@@ -363,7 +371,7 @@ func (txn *Transaction) deleteTableWrites(
 	// 1.RawBatch 2.DN Block RowId(mixed rowid from different block)
 	// 3.CN block Meta batch(record block meta generated by cn insert write s3)
 	// 4.DN delete Block Meta batch(record block meta generated by cn delete write s3)
-	for _, e := range txn.writes {
+	for i, e := range txn.writes {
 		// nil batch will generated by comapction or dumpBatch
 		if e.bat == nil {
 			continue
@@ -399,6 +407,10 @@ func (txn *Transaction) deleteTableWrites(
 				}
 			}
 			if len(sels) != len(vs) {
+				if err := txn.protectEntryLocked(i); err != nil {
+					return err
+				}
+				e.bat = txn.writes[i].bat
 				e.bat.Shrink(sels)
 				rowIds := vector.MustFixedCol[types.Rowid](e.bat.GetVector(0))
 				for i := range rowIds {
@@ -407,6 +419,7 @@ func (txn *Transaction) deleteTableWrites(
 			}
 		}
 	}
+	return nil
 }
 
 func (txn *Transaction) allocateID(ctx context.Context) (uint64, error) {
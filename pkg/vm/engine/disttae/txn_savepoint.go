@@ -0,0 +1,274 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+)
+
+// savePoint is a checkpoint of the txn workspace. The entries of the workspace
+// are shared with the savepoint, so the batches of the entries before the latest
+// savepoint are never modified in place, they are copied before modification,
+// see protectEntryLocked.
+type savePoint struct {
+	name          string
+	writes        []Entry
+	workspaceSize uint64
+	readOnly      bool
+
+	deletedOffsets    map[types.Blockid][]int64
+	cnBlkIdPos        map[types.Blockid]Pos
+	rawBatches        map[types.Blockid]*batch.Batch
+	dnDeleteMetaLocs  map[types.Blockid][]*batch.Batch
+	tableLocalStates  map[*txnTable]*logtailreplay.PartitionState
+	tableWritesOffset map[*txnTable]int
+}
+
+// SavePoint takes a checkpoint of the workspace which can be rolled back to
+// without aborting the transaction.
+func (txn *Transaction) SavePoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	sp := &savePoint{
+		name:              name,
+		writes:            append([]Entry(nil), txn.writes...),
+		workspaceSize:     txn.workspaceSize,
+		readOnly:          txn.readOnly,
+		cnBlkIdPos:        make(map[types.Blockid]Pos, len(txn.cnBlkId_Pos)),
+		rawBatches:        make(map[types.Blockid]*batch.Batch, len(txn.blockId_raw_batch)),
+		dnDeleteMetaLocs:  make(map[types.Blockid][]*batch.Batch, len(txn.blockId_dn_delete_metaLoc_batch)),
+		tableLocalStates:  make(map[*txnTable]*logtailreplay.PartitionState),
+		tableWritesOffset: make(map[*txnTable]int),
+	}
+	txn.deletedBlocks.RLock()
+	sp.deletedOffsets = make(map[types.Blockid][]int64, len(txn.deletedBlocks.offsets))
+	for id, offsets := range txn.deletedBlocks.offsets {
+		sp.deletedOffsets[id] = append([]int64(nil), offsets...)
+	}
+	txn.deletedBlocks.RUnlock()
+	for id, pos := range txn.cnBlkId_Pos {
+		sp.cnBlkIdPos[id] = pos
+	}
+	for id, bat := range txn.blockId_raw_batch {
+		sp.rawBatches[id] = bat
+	}
+	for id, bats := range txn.blockId_dn_delete_metaLoc_batch {
+		sp.dnDeleteMetaLocs[id] = append([]*batch.Batch(nil), bats...)
+	}
+	txn.tableMap.Range(func(_, value any) bool {
+		tbl := value.(*txnTable)
+		if tbl.localState != nil {
+			sp.tableLocalStates[tbl] = tbl.localState.Copy()
+		} else {
+			sp.tableLocalStates[tbl] = nil
+		}
+		sp.tableWritesOffset[tbl] = tbl.writesOffset
+		return true
+	})
+
+	if idx := txn.findSavePointLocked(name); idx >= 0 {
+		old := txn.savepoints[idx]
+		txn.savepoints = append(txn.savepoints[:idx], txn.savepoints[idx+1:]...)
+		txn.cleanUnreferencedBatchesLocked(old.writes)
+	}
+	txn.savepoints = append(txn.savepoints, sp)
+	return nil
+}
+
+// RollbackToSavePoint restores the workspace to the named checkpoint. The
+// checkpoint is kept and the checkpoints taken after it are removed.
+func (txn *Transaction) RollbackToSavePoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	idx := txn.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	sp := txn.savepoints[idx]
+	for _, e := range txn.writes[len(sp.writes):] {
+		if e.databaseId == catalog.MO_CATALOG_ID &&
+			(e.tableId == catalog.MO_DATABASE_ID ||
+				e.tableId == catalog.MO_TABLES_ID ||
+				e.tableId == catalog.MO_COLUMNS_ID) {
+			return moerr.NewNYI(ctx, "rollback to savepoint across DDL statements")
+		}
+	}
+
+	discarded := txn.writes
+	removed := txn.savepoints[idx+1:]
+	txn.savepoints = txn.savepoints[:idx+1]
+
+	txn.writes = append(txn.writes[:0:0], sp.writes...)
+	txn.workspaceSize = sp.workspaceSize
+	txn.readOnly = sp.readOnly
+	txn.deletedBlocks.Lock()
+	txn.deletedBlocks.offsets = make(map[types.Blockid][]int64, len(sp.deletedOffsets))
+	for id, offsets := range sp.deletedOffsets {
+		txn.deletedBlocks.offsets[id] = append([]int64(nil), offsets...)
+	}
+	txn.deletedBlocks.Unlock()
+	txn.cnBlkId_Pos = make(map[types.Blockid]Pos, len(sp.cnBlkIdPos))
+	for id, pos := range sp.cnBlkIdPos {
+		txn.cnBlkId_Pos[id] = pos
+	}
+	txn.blockId_raw_batch = make(map[types.Blockid]*batch.Batch, len(sp.rawBatches))
+	for id, bat := range sp.rawBatches {
+		txn.blockId_raw_batch[id] = bat
+	}
+	txn.blockId_dn_delete_metaLoc_batch = make(map[types.Blockid][]*batch.Batch, len(sp.dnDeleteMetaLocs))
+	for id, bats := range sp.dnDeleteMetaLocs {
+		txn.blockId_dn_delete_metaLoc_batch[id] = append([]*batch.Batch(nil), bats...)
+	}
+	txn.tableMap.Range(func(_, value any) bool {
+		tbl := value.(*txnTable)
+		// the snapshot of the table writes is rebuilt by the next Ranges
+		tbl.writes = tbl.writes[:0]
+		if state, ok := sp.tableLocalStates[tbl]; ok {
+			if state != nil {
+				state = state.Copy()
+			}
+			tbl.localState = state
+			tbl.writesOffset = sp.tableWritesOffset[tbl]
+		} else {
+			// the table is opened after the savepoint, so all its local
+			// data is written after the savepoint
+			tbl.localState = logtailreplay.NewPartitionState(true)
+			tbl.writesOffset = len(txn.writes)
+		}
+		return true
+	})
+
+	for _, v := range removed {
+		discarded = append(discarded, v.writes...)
+	}
+	txn.cleanUnreferencedBatchesLocked(discarded)
+	return nil
+}
+
+// ReleaseSavePoint removes the named checkpoint and the checkpoints taken after
+// it, the workspace is not changed.
+func (txn *Transaction) ReleaseSavePoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	idx := txn.findSavePointLocked(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	var discarded []Entry
+	for _, v := range txn.savepoints[idx:] {
+		discarded = append(discarded, v.writes...)
+	}
+	txn.savepoints = txn.savepoints[:idx]
+	txn.cleanUnreferencedBatchesLocked(discarded)
+	return nil
+}
+
+func (txn *Transaction) findSavePointLocked(name string) int {
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if txn.savepoints[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// protectedWritesLocked returns the number of the entries shared with the
+// savepoints, the batches of these entries must not be modified in place.
+func (txn *Transaction) protectedWritesLocked() int {
+	if len(txn.savepoints) == 0 {
+		return 0
+	}
+	return len(txn.savepoints[len(txn.savepoints)-1].writes)
+}
+
+// protectEntryLocked makes the batch of the entry at idx private to the
+// workspace before it is modified in place, if it is shared with a savepoint.
+func (txn *Transaction) protectEntryLocked(idx int) error {
+	if idx >= txn.protectedWritesLocked() {
+		return nil
+	}
+	old := txn.writes[idx].bat
+	if old == nil {
+		return nil
+	}
+	for _, sp := range txn.savepoints {
+		if idx < len(sp.writes) && sp.writes[idx].bat == old {
+			bat, err := old.Dup(txn.proc.Mp())
+			if err != nil {
+				return err
+			}
+			bat.Cnt = 1
+			txn.writes[idx].bat = bat
+			for id, v := range txn.blockId_raw_batch {
+				if v == old {
+					txn.blockId_raw_batch[id] = bat
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// protectRawBatchLocked is similar to protectEntryLocked, but finds the entry
+// by its batch.
+func (txn *Transaction) protectRawBatchLocked(bat *batch.Batch) (*batch.Batch, error) {
+	n := txn.protectedWritesLocked()
+	for i := 0; i < n; i++ {
+		if txn.writes[i].bat == bat {
+			if err := txn.protectEntryLocked(i); err != nil {
+				return nil, err
+			}
+			return txn.writes[i].bat, nil
+		}
+	}
+	return bat, nil
+}
+
+// cleanUnreferencedBatchesLocked frees the batches of the entries which are
+// neither in the workspace nor in any savepoint.
+func (txn *Transaction) cleanUnreferencedBatchesLocked(entries []Entry) {
+	if len(entries) == 0 {
+		return
+	}
+	referenced := make(map[*batch.Batch]struct{}, len(txn.writes))
+	for _, e := range txn.writes {
+		referenced[e.bat] = struct{}{}
+	}
+	for _, sp := range txn.savepoints {
+		for _, e := range sp.writes {
+			referenced[e.bat] = struct{}{}
+		}
+	}
+	for _, e := range entries {
+		if e.bat == nil {
+			continue
+		}
+		if _, ok := referenced[e.bat]; ok {
+			continue
+		}
+		referenced[e.bat] = struct{}{}
+		e.bat.Clean(txn.proc.Mp())
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -718,10 +719,11 @@ func (tbl *txnTable) getTableDef() *plan.TableDef {
 					Name:  attr.Attr.Name,
 					ColId: attr.Attr.ID,
 					Typ: &plan.Type{
-						Id:       int32(attr.Attr.Type.Oid),
-						Width:    attr.Attr.Type.Width,
-						Scale:    attr.Attr.Type.Scale,
-						AutoIncr: attr.Attr.AutoIncrement,
+						Id:         int32(attr.Attr.Type.Oid),
+						Width:      attr.Attr.Type.Width,
+						Scale:      attr.Attr.Type.Scale,
+						AutoIncr:   attr.Attr.AutoIncrement,
+						Enumvalues: attr.Attr.EnumValues,
 					},
 					Primary:   attr.Attr.Primary,
 					Default:   attr.Attr.Default,
@@ -814,6 +816,26 @@ func (tbl *txnTable) UpdateConstraint(ctx context.Context, c *engine.ConstraintD
 	return nil
 }
 
+func (tbl *txnTable) AlterTable(ctx context.Context, reqs []*api.AlterTableReq) error {
+	payload := make([][]byte, len(reqs))
+	for i, req := range reqs {
+		// the ids of the request are decided by the table
+		req.DbId = tbl.db.databaseId
+		req.TableId = tbl.tableId
+		data, err := req.Marshal()
+		if err != nil {
+			return err
+		}
+		payload[i] = data
+	}
+	bat, err := genTableAlterTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName, payload, tbl.db.txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	return tbl.db.txn.WriteBatch(ALTER, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+		catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1, false, false)
+}
+
 func (tbl *txnTable) TableColumns(ctx context.Context) ([]*engine.Attribute, error) {
 	var attrs []*engine.Attribute
 	for _, def := range tbl.defs {
@@ -929,7 +951,12 @@ func (tbl *txnTable) EnhanceDelete(bat *batch.Batch, name string) error {
 		tbl.writeDnPartition(tbl.db.txn.proc.Ctx, bat)
 	case deletion.RawBatchOffset:
 		vs := vector.MustFixedCol[int64](bat.GetVector(0))
-		entry_bat := tbl.db.txn.blockId_raw_batch[*blkId]
+		tbl.db.txn.Lock()
+		entry_bat, err := tbl.db.txn.protectRawBatchLocked(tbl.db.txn.blockId_raw_batch[*blkId])
+		tbl.db.txn.Unlock()
+		if err != nil {
+			return err
+		}
 		entry_bat.AntiShrink(vs)
 		// reset rowId offset
 		rowIds := vector.MustFixedCol[types.Rowid](entry_bat.GetVector(0))
@@ -1025,6 +1052,9 @@ func (tbl *txnTable) compaction() error {
 	remove_batch := make(map[*batch.Batch]bool)
 	// delete old block info
 	for idx, offsets := range mp {
+		if err := tbl.db.txn.protectEntryLocked(idx); err != nil {
+			return err
+		}
 		bat := tbl.db.txn.writes[idx].bat
 		bat.AntiShrink(offsets)
 		// update txn.cnBlkId_Pos
@@ -1072,7 +1102,10 @@ func (tbl *txnTable) Delete(ctx context.Context, bat *batch.Batch, name string)
 	if err := tbl.updateLocalState(ctx, DELETE, bat, packer); err != nil {
 		return err
 	}
-	bat = tbl.db.txn.deleteBatch(bat, tbl.db.databaseId, tbl.tableId)
+	bat, err := tbl.db.txn.deleteBatch(bat, tbl.db.databaseId, tbl.tableId)
+	if err != nil {
+		return err
+	}
 	if bat.Length() == 0 {
 		return nil
 	}
@@ -1303,6 +1336,7 @@ func (tbl *txnTable) newReader(
 	}
 
 	mp := make(map[string]types.Type)
+	fillsMap := make(map[string]any)
 	mp[catalog.Row_ID] = types.New(types.T_Rowid, 0, 0)
 	for _, def := range tbl.defs {
 		attr, ok := def.(*engine.AttributeDef)
@@ -1310,6 +1344,9 @@ func (tbl *txnTable) newReader(
 			continue
 		}
 		mp[attr.Attr.Name] = attr.Attr.Type
+		if fill := containers.DefaultFillValue(attr.Attr.Default, attr.Attr.Type); fill != nil {
+			fillsMap[attr.Attr.Name] = fill
+		}
 	}
 
 	parts, err := tbl.getParts(ctx)
@@ -1337,6 +1374,7 @@ func (tbl *txnTable) newReader(
 		deletes:         deletes,
 		iter:            iter,
 		seqnumMp:        seqnumMp,
+		fillsMap:        fillsMap,
 		extendId2s3File: make(map[string]int),
 		s3FileService:   fs,
 		procMPool:       txn.proc.GetMPool(),
@@ -1595,3 +1633,69 @@ func (tbl *txnTable) updateLogtail(ctx context.Context) (err error) {
 	tbl.logtailUpdated = true
 	return nil
 }
+
+// GetChanges returns the rows inserted into the table after the timestamp from
+// until the snapshot of the transaction, which are pulled from the logtail of
+// the dn stores. The changes are unavailable if the logtail doesn't keep the
+// rows, that is they have been checkpointed or written in blocks, or if any
+// row has been deleted.
+func (tbl *txnTable) GetChanges(ctx context.Context, from timestamp.Timestamp) ([]*batch.Batch, error) {
+	to := tbl.db.txn.op.Txn().SnapshotTS
+	if !from.Less(to) {
+		return nil, nil
+	}
+	unavailable := func() error {
+		return moerr.NewTableChangesUnavailable(ctx, tbl.tableName, from.DebugString())
+	}
+
+	var bats []*batch.Batch
+	for _, dn := range tbl.db.txn.dnStores {
+		reqs, err := genLogTailReq(dn, genSyncLogTailReq(from, to, tbl.db.databaseId, tbl.tableId))
+		if err != nil {
+			return nil, err
+		}
+		logTails, err := getLogTail(ctx, tbl.db.txn.op, reqs)
+		if err != nil {
+			return nil, err
+		}
+		for _, logTail := range logTails {
+			if logTail.CkpLocation != "" {
+				return nil, unavailable()
+			}
+			for _, e := range logTail.Commands {
+				if logtailreplay.IsMetaTable(e.TableName) {
+					// the rows of appendable blocks are still in the logtail
+					// until the blocks are flushed
+					if e.EntryType == api.Entry_Insert && logtailreplay.IsBlkTable(e.TableName) {
+						entryStates, err := vector.ProtoVectorToVector(e.Bat.Vecs[3])
+						if err != nil {
+							return nil, err
+						}
+						metaLocs, err := vector.ProtoVectorToVector(e.Bat.Vecs[5])
+						if err != nil {
+							return nil, err
+						}
+						for i, appendable := range vector.MustFixedCol[bool](entryStates) {
+							if !appendable || !objectio.Location(metaLocs.GetBytesAt(i)).IsEmpty() {
+								return nil, unavailable()
+							}
+						}
+					}
+					continue
+				}
+				if e.EntryType != api.Entry_Insert {
+					return nil, unavailable()
+				}
+				bat, err := batch.ProtoBatchToBatch(e.Bat)
+				if err != nil {
+					return nil, err
+				}
+				// skip the row id and the commit time
+				bat.Attrs = e.Bat.Attrs[2:]
+				bat.Vecs = bat.Vecs[2:]
+				bats = append(bats, bat)
+			}
+		}
+	}
+	return bats, nil
+}
@@ -16,6 +16,7 @@ package disttae
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -162,3 +163,87 @@ func BenchmarkTxnTableInsert(b *testing.B) {
 		assert.Nil(b, err)
 	}
 }
+
+func TestRollbackToSavePoint(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+
+	table := newTxnTableForTest(mp)
+	txn := table.db.txn
+	txn.readOnly = true
+	txn.tableMap = new(sync.Map)
+	txn.tableMap.Store(genTableKey(ctx, "t", 0), table)
+	txn.deletedBlocks = &deletedBlocks{
+		offsets: map[types.Blockid][]int64{},
+	}
+	txn.cnBlkId_Pos = map[types.Blockid]Pos{}
+	txn.blockId_raw_batch = make(map[types.Blockid]*batch.Batch)
+	txn.blockId_dn_delete_metaLoc_batch = make(map[types.Blockid][]*batch.Batch)
+
+	getRowIDsBatch := func(values ...int64) *batch.Batch {
+		bat := batch.New(false, []string{catalog.Row_ID})
+		vec := vector.NewVec(types.T_Rowid.ToType())
+		for _, e := range txn.writes {
+			if e.bat == nil {
+				continue
+			}
+			rowIDs := vector.MustFixedCol[types.Rowid](e.bat.GetVector(0))
+			for i, v := range vector.MustFixedCol[int64](e.bat.GetVector(1)) {
+				for _, value := range values {
+					if v == value {
+						vector.AppendFixed(vec, rowIDs[i], false, mp)
+					}
+				}
+			}
+		}
+		bat.SetVector(0, vec)
+		bat.SetZs(vec.Length(), mp)
+		return bat
+	}
+
+	assert.NoError(t, table.Write(ctx, makeBatchForTest(mp, 1, 2)))
+	assert.NoError(t, txn.SavePoint(ctx, "a"))
+	assert.NoError(t, table.Write(ctx, makeBatchForTest(mp, 3)))
+	assert.Equal(t, 2, len(txn.writes))
+
+	// rollback discards the writes and the local state after the savepoint
+	assert.NoError(t, txn.RollbackToSavePoint(ctx, "a"))
+	assert.Equal(t, 1, len(txn.writes))
+	assert.False(t, txn.ReadOnly())
+	assert.NoError(t, table.Write(ctx, makeBatchForTest(mp, 3)))
+	err := table.Write(ctx, makeBatchForTest(mp, 1))
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+
+	// the deleted rows of the batches written before the savepoint come back
+	assert.NoError(t, txn.SavePoint(ctx, "b"))
+	n := len(txn.writes)
+	first := txn.writes[0].bat
+	assert.NoError(t, table.Delete(ctx, getRowIDsBatch(1), catalog.Row_ID))
+	assert.Equal(t, 2, first.Length())
+	assert.Equal(t, 1, txn.writes[0].bat.Length())
+	assert.NoError(t, table.Write(ctx, makeBatchForTest(mp, 1)))
+	assert.NoError(t, txn.RollbackToSavePoint(ctx, "b"))
+	assert.Equal(t, n, len(txn.writes))
+	assert.Equal(t, 2, txn.writes[0].bat.Length())
+	err = table.Write(ctx, makeBatchForTest(mp, 1))
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+
+	// rollback to a removes b
+	assert.NoError(t, txn.RollbackToSavePoint(ctx, "a"))
+	err = txn.RollbackToSavePoint(ctx, "b")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+	// can not rollback DDL
+	txn.writes = append(txn.writes, Entry{
+		typ:        INSERT,
+		databaseId: catalog.MO_CATALOG_ID,
+		tableId:    catalog.MO_TABLES_ID,
+	})
+	err = txn.RollbackToSavePoint(ctx, "a")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNYI))
+
+	assert.NoError(t, txn.ReleaseSavePoint(ctx, "a"))
+	err = txn.RollbackToSavePoint(ctx, "a")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+	assert.Equal(t, 0, len(txn.savepoints))
+}
@@ -47,6 +47,7 @@ const (
 	DELETE
 	COMPACTION_CN
 	UPDATE
+	ALTER
 )
 
 const (
@@ -142,6 +143,9 @@ type Transaction struct {
 	cnBlkId_Pos                     map[types.Blockid]Pos
 	blockId_raw_batch               map[types.Blockid]*batch.Batch
 	blockId_dn_delete_metaLoc_batch map[types.Blockid][]*batch.Batch
+
+	// savepoints of the workspace, see txn_savepoint.go
+	savepoints []*savePoint
 }
 
 type Pos struct {
@@ -323,6 +327,7 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	seqnum          uint16
+	enumValues      string
 }
 
 type blockReader struct {
@@ -343,6 +348,7 @@ type blockReader struct {
 	// cached meta data.
 	seqnums        []uint16
 	colTypes       []types.Type
+	colFills       []any
 	colNulls       []bool
 	pkidxInColIdxs int
 	pkName         string
@@ -363,6 +369,7 @@ type blockMergeReader struct {
 	// cached meta data.
 	seqnums  []uint16
 	colTypes []types.Type
+	colFills []any
 	colNulls []bool
 }
 
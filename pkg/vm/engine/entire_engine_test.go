@@ -360,6 +360,18 @@ func (o *testOperator) AddLockTable(lock.LockTable) error {
 	return nil
 }
 
+func (o *testOperator) SavePoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (o *testOperator) RollbackToSavePoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (o *testOperator) ReleaseSavePoint(ctx context.Context, name string) error {
+	return nil
+}
+
 func (o *testOperator) UpdateSnapshot(ts timestamp.Timestamp) error {
 	panic("should not call")
 }
@@ -73,6 +73,10 @@ func (c *CompilerContext) ResolveUdf(name string, ast []*plan.Expr) (string, err
 	return "", nil
 }
 
+func (c *CompilerContext) ResolveTriggers(tableID uint64, dbName string, tableName string) ([]*plan.TriggerDef, error) {
+	return nil, nil
+}
+
 func (c *CompilerContext) ResolveAccountIds(accountNames []string) ([]uint32, error) {
 	return []uint32{catalog.System_Account}, nil
 }
@@ -253,6 +257,7 @@ func engineAttrToPlanColDef(idx int, attr *engine.Attribute) *plan.ColDef {
 			NotNullable: attr.Default != nil && !(attr.Default.NullAbility),
 			Width:       attr.Type.Width,
 			Scale:       attr.Type.Scale,
+			Enumvalues:  attr.EnumValues,
 		},
 		Default:   attr.Default,
 		Primary:   attr.Primary,
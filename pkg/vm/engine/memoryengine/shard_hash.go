@@ -380,7 +380,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
@@ -408,7 +408,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
@@ -464,7 +464,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
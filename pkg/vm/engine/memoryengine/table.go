@@ -17,7 +17,10 @@ package memoryengine
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -262,6 +265,10 @@ func (t *Table) UpdateConstraint(context.Context, *engine.ConstraintDef) error {
 	return nil
 }
 
+func (t *Table) AlterTable(ctx context.Context, _ []*api.AlterTableReq) error {
+	return moerr.NewNYI(ctx, "alter table in memory engine")
+}
+
 func (t *Table) Update(ctx context.Context, data *batch.Batch) error {
 	data.InitZsOne(data.Length())
 	shards, err := t.engine.shardPolicy.Batch(
@@ -369,3 +376,7 @@ func (t *Table) MaxAndMinValues(ctx context.Context) ([][2]any, []uint8, error)
 func (t *Table) GetMetadataScanInfoBytes(ctx context.Context, name string) ([][]byte, error) {
 	return nil, nil
 }
+
+func (t *Table) GetChanges(ctx context.Context, from timestamp.Timestamp) ([]*batch.Batch, error) {
+	return nil, moerr.NewTableChangesUnavailable(ctx, t.tableName, from.DebugString())
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
@@ -32,6 +34,20 @@ func LoadColumns(ctx context.Context,
 	fs fileservice.FileService,
 	location objectio.Location,
 	m *mpool.MPool) (bat *batch.Batch, err error) {
+	return LoadColumnsWithFills(ctx, cols, typs, nil, fs, location, m)
+}
+
+// LoadColumnsWithFills loads the columns like LoadColumns. The column not written
+// in the block is filled by fills[i], which is the value of the column for the rows
+// written before the column is added, see containers.DefaultFillValue. And the
+// column written in a narrower type is widened to typs[i].
+func LoadColumnsWithFills(ctx context.Context,
+	cols []uint16,
+	typs []types.Type,
+	fills []any,
+	fs fileservice.FileService,
+	location objectio.Location,
+	m *mpool.MPool) (bat *batch.Batch, err error) {
 	name := location.Name()
 	var meta objectio.ObjectMeta
 	var ioVectors *fileservice.IOVector
@@ -41,14 +57,31 @@ func LoadColumns(ctx context.Context,
 	if ioVectors, err = objectio.ReadOneBlock(ctx, &meta, name.String(), location.ID(), cols, typs, m, fs); err != nil {
 		return
 	}
+	blkmeta := meta.GetBlockMeta(uint32(location.ID()))
+	alloc := m
+	if alloc == nil {
+		alloc = common.DefaultAllocator
+	}
 	bat = batch.NewWithSize(len(cols))
 	var obj any
-	for i := range cols {
+	for i, seqnum := range cols {
 		obj, err = objectio.Decode(ioVectors.Entries[i].ObjectBytes)
 		if err != nil {
 			return
 		}
-		bat.Vecs[i] = obj.(*vector.Vector)
+		vec := obj.(*vector.Vector)
+		if i < len(typs) && seqnum < objectio.SEQNUM_UPPER {
+			if seqnum > blkmeta.GetMaxSeqnum() || blkmeta.ColumnMeta(seqnum).DataType() == 0 {
+				if i < len(fills) && fills[i] != nil {
+					vec = containers.FillCNConstVector(int(blkmeta.GetRows()), typs[i], fills[i], alloc)
+				}
+			} else if types.IsWidening(*vec.GetType(), typs[i]) {
+				if vec, err = containers.WidenCNVector(vec, typs[i], alloc); err != nil {
+					return
+				}
+			}
+		}
+		bat.Vecs[i] = vec
 	}
 	return
 }
@@ -34,12 +34,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
-// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty
+// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty.
+// The columns not written in the block are filled by fills, see LoadColumnsWithFills.
 func BlockRead(
 	ctx context.Context,
 	info *pkgcatalog.BlockInfo,
 	seqnums []uint16,
 	colTypes []types.Type,
+	fills []any,
 	ts timestamp.Timestamp,
 	fs fileservice.FileService,
 	mp *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
@@ -47,7 +49,7 @@ func BlockRead(
 		logutil.Debugf("read block %s, seqnums %v, typs %v", info.BlockID.String(), seqnums, colTypes)
 	}
 	columnBatch, err := BlockReadInner(
-		ctx, info, seqnums, colTypes,
+		ctx, info, seqnums, colTypes, fills,
 		types.TimestampToTS(ts), fs, mp, vp,
 	)
 	if err != nil {
@@ -95,6 +97,7 @@ func BlockReadInner(
 	info *pkgcatalog.BlockInfo,
 	seqnums []uint16,
 	colTypes []types.Type,
+	fills []any,
 	ts types.TS,
 	fs fileservice.FileService,
 	mp *mpool.MPool,
@@ -108,7 +111,7 @@ func BlockReadInner(
 
 	// read block data from storage
 	if loaded, rowid, deletedRows, err = readBlockData(
-		ctx, seqnums, colTypes, info, ts, fs, mp,
+		ctx, seqnums, colTypes, fills, info, ts, fs, mp,
 	); err != nil {
 		return
 	}
@@ -159,7 +162,7 @@ func BlockReadInner(
 	return
 }
 
-func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, []uint16, []types.Type) {
+func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type, colFills []any) (bool, []uint16, []types.Type, []any) {
 	found := false
 	idx := 0
 	for i, typ := range colTypes {
@@ -170,7 +173,7 @@ func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, []uint16,
 		}
 	}
 	if !found {
-		return found, colIndexes, colTypes
+		return found, colIndexes, colTypes, colFills
 	}
 	idxes := make([]uint16, 0, len(colTypes)-1)
 	typs := make([]types.Type, 0, len(colTypes)-1)
@@ -178,20 +181,27 @@ func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, []uint16,
 	idxes = append(idxes, colIndexes[idx+1:]...)
 	typs = append(typs, colTypes[:idx]...)
 	typs = append(typs, colTypes[idx+1:]...)
-	return found, idxes, typs
+	var fills []any
+	if len(colFills) > 0 {
+		fills = make([]any, 0, len(colFills)-1)
+		fills = append(fills, colFills[:idx]...)
+		fills = append(fills, colFills[idx+1:]...)
+	}
+	return found, idxes, typs, fills
 }
 
 func readBlockData(
 	ctx context.Context,
 	colIndexes []uint16,
 	colTypes []types.Type,
+	colFills []any,
 	info *pkgcatalog.BlockInfo,
 	ts types.TS,
 	fs fileservice.FileService,
 	m *mpool.MPool,
 ) (bat *batch.Batch, rowid *vector.Vector, deletedRows []int64, err error) {
 
-	hasRowId, idxes, typs := getRowsIdIndex(colIndexes, colTypes)
+	hasRowId, idxes, typs, fills := getRowsIdIndex(colIndexes, colTypes, colFills)
 	if hasRowId {
 		// generate rowid
 		if rowid, err = objectio.ConstructRowidColumn(
@@ -218,7 +228,7 @@ func readBlockData(
 			return
 		}
 
-		if loaded, err = LoadColumns(ctx, cols, typs, fs, info.MetaLocation(), m); err != nil {
+		if loaded, err = LoadColumnsWithFills(ctx, cols, typs, fills, fs, info.MetaLocation(), m); err != nil {
 			return
 		}
 
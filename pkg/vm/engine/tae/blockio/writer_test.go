@@ -189,6 +189,48 @@ func TestWriter_WriteBlockAfterAlter(t *testing.T) {
 	require.False(t, zm.Contains(int32(80000)))
 }
 
+func TestLoadColumnsAfterModify(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	assert.Nil(t, err)
+
+	// the column 12 is varchar(100) and the column 13 is char(100)
+	schema := catalog.MockSchemaAll(14, 2)
+	bat := catalog.MockBatch(schema, 100)
+	defer bat.Close()
+	writer, _ := NewBlockWriterNew(service, name, 0, nil)
+	_, err = writer.WriteBatch(containers.ToCNBatch(bat))
+	require.NoError(t, err)
+	blocks, _, err := writer.Sync(context.Background())
+	require.NoError(t, err)
+	metaloc := EncodeLocation(writer.GetName(), blocks[0].GetExtent(), 100, blocks[0].GetID())
+
+	// the columns are read after they are modified to wider types
+	typs := []types.Type{
+		types.New(types.T_text, 0, 0),
+		types.New(types.T_varchar, 200, 0),
+	}
+	mp := mpool.MustNewZero()
+	res, err := LoadColumns(context.Background(), []uint16{12, 13}, typs, service, metaloc, mp)
+	require.NoError(t, err)
+	for i, idx := range []int{12, 13} {
+		require.Equal(t, typs[i], *res.Vecs[i].GetType())
+		require.Equal(t, 100, res.Vecs[i].Length())
+		expected := bat.Vecs[idx].GetDownstreamVector()
+		for row := 0; row < 100; row++ {
+			require.Equal(t, expected.GetBytesAt(row), res.Vecs[i].GetBytesAt(row))
+		}
+	}
+}
+
 func TestMergeDeleteRows(t *testing.T) {
 	require.Equal(t, mergeDeleteRows([]int64{1, 2, 3}, nil), []int64{1, 2, 3})
 	require.Equal(t, mergeDeleteRows(nil, []int64{1, 2, 3}), []int64{1, 2, 3})
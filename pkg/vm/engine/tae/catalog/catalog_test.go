@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -414,3 +415,59 @@ func TestAlterSchema(t *testing.T) {
 	require.Equal(t, 5, schema.GetSingleSortKeyIdx())
 
 }
+
+func TestAlterSchemaColumn(t *testing.T) {
+	schema := MockSchema(3, 0)
+	typ := types.NewProtoType(types.T_varchar)
+	typ.Width = 10
+	require.NoError(t, schema.ApplyAlterTable(api.NewAddColumnReq(0, 0, "v", typ, -1)))
+	require.Equal(t, 3, schema.GetColIdx("v"))
+	require.Equal(t, uint16(3), schema.GetSeqnum("v"))
+	schema.Extra.ColumnChanged = false
+
+	// rename column
+	require.Error(t, schema.ApplyAlterTable(api.NewRenameColumnReq(0, 0, "mock_1", "a", 2)))
+	require.Error(t, schema.ApplyAlterTable(api.NewRenameColumnReq(0, 0, "mock_1", "mock_2", 1)))
+	require.Error(t, schema.ApplyAlterTable(api.NewRenameColumnReq(0, 0, "xyz", "a", 1)))
+	require.NoError(t, schema.ApplyAlterTable(api.NewRenameColumnReq(0, 0, "mock_1", "a", 1)))
+	require.Equal(t, 1, schema.GetColIdx("a"))
+	require.Equal(t, uint16(1), schema.GetSeqnum("a"))
+	_, ok := schema.NameMap["mock_1"]
+	require.False(t, ok)
+	require.Equal(t, []string{"mock_1"}, schema.Extra.DroppedAttrs)
+	require.False(t, schema.Extra.ColumnChanged)
+
+	// modify column
+	col := &plan.ColDef{Name: "v", Typ: types.NewProtoType(types.T_varchar), Comment: "widen"}
+	col.Typ.Width = 20
+	require.NoError(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, 3, col)))
+	require.Equal(t, types.New(types.T_varchar, 20, 0), schema.ColDefs[3].Type)
+	require.Equal(t, "widen", schema.ColDefs[3].Comment)
+	require.True(t, schema.Extra.ColumnChanged)
+
+	col.Typ.Width = 5
+	require.Error(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, 3, col)))
+	require.Error(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, 3, &plan.ColDef{Name: "v", Typ: types.NewProtoType(types.T_int32)})))
+	require.Error(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, 0, &plan.ColDef{Name: "mock_0", Typ: types.NewProtoType(types.T_int64)})))
+	require.NoError(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, 2, &plan.ColDef{Name: "mock_2", Typ: types.NewProtoType(types.T_int64)})))
+	require.Equal(t, types.T_int64.ToType(), schema.ColDefs[2].Type)
+
+	// the existing rows read the default of the added column
+	require.Nil(t, schema.ColDefs[3].FillValue())
+	col = &plan.ColDef{Name: "d", Typ: types.NewProtoType(types.T_int32), Default: &plan.Default{
+		NullAbility:  true,
+		FillValue:    types.EncodeValue(int32(7), types.T_int32),
+		HasFillValue: true,
+	}}
+	req := api.NewAddColumnReq(0, 0, col.Name, col.Typ, -1)
+	req.GetAddColumn().Column = col
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Equal(t, int32(7), schema.ColDefs[schema.GetColIdx("d")].FillValue())
+}
+
+func TestAlterSchemaPartition(t *testing.T) {
+	schema := MockSchema(3, 0)
+	require.NoError(t, schema.ApplyAlterTable(api.NewUpdatePartitionReq(0, 0, 1, "partition by hash (mock_0) partitions 2")))
+	require.Equal(t, int8(1), schema.Partitioned)
+	require.Equal(t, "partition by hash (mock_0) partitions 2", schema.Partition)
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"time"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
@@ -61,6 +62,7 @@ type ColDef struct {
 	FakePK        bool // TODO: use column.flag instead of column.fakepk
 	Default       []byte
 	OnUpdate      []byte
+	EnumValues    string
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -75,6 +77,19 @@ func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
 func (def *ColDef) IsClusterBy() bool     { return def.ClusterBy }
 
+// FillValue returns the value of the column for the rows written before the column
+// is added, see containers.DefaultFillValue.
+func (def *ColDef) FillValue() any {
+	if len(def.Default) == 0 {
+		return nil
+	}
+	dft := &plan.Default{}
+	if err := types.Decode(def.Default, dft); err != nil {
+		return nil
+	}
+	return containers.DefaultFillValue(dft, def.Type)
+}
+
 type SortKey struct {
 	Defs      []*ColDef
 	search    map[int]int
@@ -164,6 +179,9 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
+	case apipb.AlterKind_UpdatePartition:
+		s.Partitioned = int8(req.GetUpdatePartition().GetPartitioned())
+		s.Partition = req.GetUpdatePartition().GetPartition()
 	case apipb.AlterKind_AddColumn:
 		add := req.GetAddColumn()
 		logutil.Infof("[Alter] add column %s(%s)@%d", add.Column.Name, types.T(add.Column.Typ.Id), add.InsertPosition)
@@ -214,6 +232,63 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, coldef.Name)
 		s.Extra.ColumnChanged = true
 		return s.Finalize(true)
+	case apipb.AlterKind_RenameColumn:
+		rename := req.GetRenameColumn()
+		idx, ok := s.NameMap[rename.OldName]
+		if !ok {
+			return moerr.NewInternalErrorNoCtx("column %s not found", rename.OldName)
+		}
+		coldef := s.ColDefs[idx]
+		if coldef.SeqNum != uint16(rename.SequenceNum) {
+			return moerr.NewInternalErrorNoCtx("unmatched name and seqnumn")
+		}
+		if coldef.IsPhyAddr() {
+			return moerr.NewInternalErrorNoCtx("rename a hidden column")
+		}
+		if _, ok := s.NameMap[rename.NewName]; ok {
+			return moerr.NewInternalErrorNoCtx("duplicate column name %s", rename.NewName)
+		}
+		logutil.Infof("[Alter] rename column %s to %s %d", rename.OldName, rename.NewName, coldef.SeqNum)
+		delete(s.NameMap, coldef.Name)
+		coldef.Name = rename.NewName
+		s.NameMap[coldef.Name] = coldef.Idx
+		// the column is still stored with the same seqnum, only mo_columns needs to know
+		// that the row of the old name is gone.
+		s.removeDroppedName(rename.NewName)
+		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, rename.OldName)
+		return s.Finalize(true)
+	case apipb.AlterKind_ModifyColumn:
+		modify := req.GetModifyColumn()
+		idx, ok := s.SeqnumMap[uint16(modify.SequenceNum)]
+		if !ok {
+			return moerr.NewInternalErrorNoCtx("column with seqnum %d not found", modify.SequenceNum)
+		}
+		coldef := s.ColDefs[idx]
+		if coldef.IsPhyAddr() {
+			return moerr.NewInternalErrorNoCtx("modify a hidden column")
+		}
+		newType := vector.ProtoTypeToType(modify.Column.Typ)
+		if !types.IsWidening(coldef.Type, newType) {
+			return moerr.NewInternalErrorNoCtx("can't change column %s from %s to %s in place", coldef.Name, coldef.Type, newType)
+		}
+		if (coldef.IsAutoIncrement() || coldef.IsClusterBy() || coldef.IsPrimary()) && coldef.Type != newType {
+			return moerr.NewInternalErrorNoCtx("modify the type of a column with constraint")
+		}
+		// the stored indexes of enum and set are kept only if the members are appended
+		if !strings.HasPrefix(modify.Column.Typ.Enumvalues, coldef.EnumValues) {
+			return moerr.NewInternalErrorNoCtx("can't change the members of column %s in place", coldef.Name)
+		}
+		logutil.Infof("[Alter] modify column %s %d %s -> %s", coldef.Name, coldef.SeqNum, coldef.Type, newType)
+		newcol := colDefFromPlan(modify.Column, coldef.Idx, coldef.SeqNum)
+		coldef.Type = newType
+		coldef.NullAbility = newcol.NullAbility
+		coldef.Default = newcol.Default
+		coldef.OnUpdate = newcol.OnUpdate
+		coldef.Comment = newcol.Comment
+		coldef.EnumValues = newcol.EnumValues
+		// It is not wanted that a block spans across different column types
+		s.Extra.ColumnChanged = true
+		return s.Finalize(true)
 	case apipb.AlterKind_RenameTable:
 		rename := req.GetRenameTable()
 		if rename.OldName != s.Name {
@@ -413,6 +488,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if def.EnumValues, sn, err = objectio.ReadString(r); err != nil {
+			return
+		}
+		n += sn
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
@@ -509,6 +588,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = objectio.WriteBytes(def.OnUpdate, &w); err != nil {
 			return
 		}
+		if _, err = objectio.WriteString(def.EnumValues, &w); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -545,6 +627,7 @@ func (s *Schema) ReadFromBatch(bat *containers.Batch, offset int, targetTid uint
 		def.Default = bat.GetVectorByName((pkgcatalog.SystemColAttr_DefaultExpr)).Get(offset).([]byte)
 		def.Idx = int(bat.GetVectorByName((pkgcatalog.SystemColAttr_Num)).Get(offset).(int32)) - 1
 		def.SeqNum = bat.GetVectorByName(pkgcatalog.SystemColAttr_Seqnum).Get(offset).(uint16)
+		def.EnumValues = string(bat.GetVectorByName(pkgcatalog.SystemColAttr_EnumValues).Get(offset).([]byte))
 		s.NameMap[def.Name] = def.Idx
 		s.ColDefs = append(s.ColDefs, def)
 		if def.Name == PhyAddrColumnName {
@@ -653,6 +736,7 @@ func colDefFromPlan(col *plan.ColDef, idx int, seqnum uint16) *ColDef {
 		// SortKey false
 		Comment: col.Comment,
 		// ClusterBy false
+		EnumValues: col.Typ.Enumvalues,
 	}
 	if col.Default != nil {
 		newcol.NullAbility = col.Default.NullAbility
@@ -677,6 +761,7 @@ func ColDefFromAttribute(attr engine.Attribute) (*ColDef, error) {
 		ClusterBy:     attr.ClusterBy,
 		Default:       []byte(""),
 		OnUpdate:      []byte(""),
+		EnumValues:    attr.EnumValues,
 	}
 	if attr.Default != nil {
 		def.NullAbility = attr.Default.NullAbility
@@ -102,7 +102,8 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 	switch t.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32,
 		types.T_int64, types.T_uint8, types.T_uint16, types.T_uint32,
-		types.T_uint64, types.T_float32, types.T_float64:
+		types.T_uint64, types.T_float32, types.T_float64,
+		types.T_bit, types.T_year, types.T_enum, types.T_set:
 		return fmt.Sprintf("%v", v)
 	case types.T_char, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_text, types.T_blob:
@@ -146,6 +147,8 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 		val := v.([]byte)
 		j := types.DecodeJson(val)
 		return j.String()
+	case types.T_array_float32:
+		return types.ArrayF32ToString(types.BytesToArrayF32(v.([]byte)))
 	case types.T_uuid:
 		val := v.(types.Uuid)
 		return val.ToString()
@@ -200,11 +203,11 @@ func moVec2String(v *vector.Vector, printN int) string {
 		return vec2Str(vector.MustFixedCol[int64](v)[:printN], v)
 	case types.T_uint8:
 		return vec2Str(vector.MustFixedCol[uint8](v)[:printN], v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return vec2Str(vector.MustFixedCol[uint16](v)[:printN], v)
 	case types.T_uint32:
 		return vec2Str(vector.MustFixedCol[uint32](v)[:printN], v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return vec2Str(vector.MustFixedCol[uint64](v)[:printN], v)
 	case types.T_float32:
 		return vec2Str(vector.MustFixedCol[float32](v)[:printN], v)
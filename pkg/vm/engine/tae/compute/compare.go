@@ -67,11 +67,11 @@ func Compare(a, b []byte, t types.T, scale1, scale2 int32) int64 {
 		return CompareOrdered(types.DecodeInt64(a), types.DecodeInt64(b))
 	case types.T_uint8:
 		return CompareOrdered(types.DecodeUint8(a), types.DecodeUint8(b))
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return CompareOrdered(types.DecodeUint16(a), types.DecodeUint16(b))
 	case types.T_uint32:
 		return CompareOrdered(types.DecodeUint32(a), types.DecodeUint32(b))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return CompareOrdered(types.DecodeUint64(a), types.DecodeUint64(b))
 	case types.T_decimal64:
 		return types.CompareDecimal64WithScale(types.DecodeDecimal64(a), types.DecodeDecimal64(b), scale1, scale2)
@@ -98,7 +98,7 @@ func Compare(a, b []byte, t types.T, scale1, scale2 int32) int64 {
 	case types.T_uuid:
 		return types.CompareUuid(types.DecodeUuid(a), types.DecodeUuid(b))
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_text:
 		return CompareBytes(a, b)
 	case types.T_any:
 		return 0
@@ -121,11 +121,11 @@ func CompareGeneric(a, b any, t types.T) int64 {
 		return CompareOrdered(a.(int64), b.(int64))
 	case types.T_uint8:
 		return CompareOrdered(a.(uint8), b.(uint8))
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return CompareOrdered(a.(uint16), b.(uint16))
 	case types.T_uint32:
 		return CompareOrdered(a.(uint32), b.(uint32))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return CompareOrdered(a.(uint64), b.(uint64))
 	case types.T_decimal64:
 		return int64(a.(types.Decimal64).Compare(b.(types.Decimal64)))
@@ -154,7 +154,7 @@ func CompareGeneric(a, b any, t types.T) int64 {
 	case types.T_uuid:
 		return types.CompareUuid(a.(types.Uuid), b.(types.Uuid))
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_text:
 		return CompareBytes(a.([]byte), b.([]byte))
 	case types.T_any:
 		return 0
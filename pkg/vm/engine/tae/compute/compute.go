@@ -169,13 +169,13 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 	case types.T_uint8:
 		vs := vector.MustFixedCol[uint8](vec)
 		return GetOffsetOfOrdered(vs, v.(uint8), skipmask)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		vs := vector.MustFixedCol[uint16](vec)
 		return GetOffsetOfOrdered(vs, v.(uint16), skipmask)
 	case types.T_uint32:
 		vs := vector.MustFixedCol[uint32](vec)
 		return GetOffsetOfOrdered(vs, v.(uint32), skipmask)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		vs := vector.MustFixedCol[uint64](vec)
 		return GetOffsetOfOrdered(vs, v.(uint64), skipmask)
 	case types.T_float32:
@@ -235,7 +235,7 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 			types.CompareUuid,
 			skipmask)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_text:
 		val := v.([]byte)
 		start, end := 0, data.Length()-1
 		var mid int
@@ -126,7 +126,7 @@ func MockVector(t types.Type, rows int, unique bool, provider Vector) (vec Vecto
 				vec.Append(uint8(ival), false)
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint16(i), false)
@@ -148,7 +148,7 @@ func MockVector(t types.Type, rows int, unique bool, provider Vector) (vec Vecto
 				vec.Append(uint32(ival), false)
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint64(i), false)
@@ -258,7 +258,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint8(i+offset), false)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint16(i+offset), false)
 		}
@@ -266,7 +266,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint32(i+offset), false)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint64(i+offset), false)
 		}
@@ -24,18 +24,72 @@ import (
 	cnNulls "github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
 func FillConstVector(length int, typ types.Type, defautV any) Vector {
-	// TODO(aptend): use default value
-	vec := movec.NewConstNull(typ, length, common.DefaultAllocator)
-	return ToDNVector(vec)
+	return ToDNVector(FillCNConstVector(length, typ, defautV, common.DefaultAllocator))
 }
 
+// FillCNConstVector returns a const vector of defautV, which is the value of a column
+// for the rows written before the column is added, nil is NULL. See DefaultFillValue.
 func FillCNConstVector(length int, typ types.Type, defautV any, m *mpool.MPool) *movec.Vector {
-	// TODO(aptend): use default value
-	return movec.NewConstNull(typ, length, m)
+	if defautV == nil {
+		return movec.NewConstNull(typ, length, m)
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		return movec.NewConstFixed(typ, defautV.(bool), length, m)
+	case types.T_int8:
+		return movec.NewConstFixed(typ, defautV.(int8), length, m)
+	case types.T_int16:
+		return movec.NewConstFixed(typ, defautV.(int16), length, m)
+	case types.T_int32:
+		return movec.NewConstFixed(typ, defautV.(int32), length, m)
+	case types.T_int64:
+		return movec.NewConstFixed(typ, defautV.(int64), length, m)
+	case types.T_uint8:
+		return movec.NewConstFixed(typ, defautV.(uint8), length, m)
+	case types.T_uint16, types.T_year, types.T_enum:
+		return movec.NewConstFixed(typ, defautV.(uint16), length, m)
+	case types.T_uint32:
+		return movec.NewConstFixed(typ, defautV.(uint32), length, m)
+	case types.T_uint64, types.T_bit, types.T_set:
+		return movec.NewConstFixed(typ, defautV.(uint64), length, m)
+	case types.T_float32:
+		return movec.NewConstFixed(typ, defautV.(float32), length, m)
+	case types.T_float64:
+		return movec.NewConstFixed(typ, defautV.(float64), length, m)
+	case types.T_date:
+		return movec.NewConstFixed(typ, defautV.(types.Date), length, m)
+	case types.T_time:
+		return movec.NewConstFixed(typ, defautV.(types.Time), length, m)
+	case types.T_datetime:
+		return movec.NewConstFixed(typ, defautV.(types.Datetime), length, m)
+	case types.T_timestamp:
+		return movec.NewConstFixed(typ, defautV.(types.Timestamp), length, m)
+	case types.T_decimal64:
+		return movec.NewConstFixed(typ, defautV.(types.Decimal64), length, m)
+	case types.T_decimal128:
+		return movec.NewConstFixed(typ, defautV.(types.Decimal128), length, m)
+	case types.T_uuid:
+		return movec.NewConstFixed(typ, defautV.(types.Uuid), length, m)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_array_float32,
+		types.T_text, types.T_binary, types.T_varbinary:
+		return movec.NewConstBytes(typ, defautV.([]byte), length, m)
+	}
+	panic(fmt.Sprintf("unsupported default value of type %s", typ))
+}
+
+// DefaultFillValue returns the value of a column for the rows written before the
+// column is added, it's recorded in the default of the column by the alter table.
+// It returns nil if the value is NULL.
+func DefaultFillValue(def *plan.Default, typ types.Type) any {
+	if def == nil || !def.HasFillValue {
+		return nil
+	}
+	return types.DecodeValue(def.FillValue, typ.Oid)
 }
 
 // ### Shallow copy Functions
@@ -109,11 +163,11 @@ func getNonNullValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[int64](col, int(row))
 	case types.T_uint8:
 		return movec.GetFixedAt[uint8](col, int(row))
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return movec.GetFixedAt[uint16](col, int(row))
 	case types.T_uint32:
 		return movec.GetFixedAt[uint32](col, int(row))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return movec.GetFixedAt[uint64](col, int(row))
 	case types.T_decimal64:
 		return movec.GetFixedAt[types.Decimal64](col, int(row))
@@ -139,7 +193,7 @@ func getNonNullValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[types.Rowid](col, int(row))
 	case types.T_Blockid:
 		return movec.GetFixedAt[types.Blockid](col, int(row))
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_array_float32, types.T_blob, types.T_text:
 		return col.GetBytesAt(int(row))
 	default:
 		//return vector.ErrVecTypeNotSupport
@@ -193,11 +247,11 @@ func UpdateValue(col *movec.Vector, row uint32, val any, isNull bool) {
 		GenericUpdateFixedValue[int64](col, row, val, isNull)
 	case types.T_uint8:
 		GenericUpdateFixedValue[uint8](col, row, val, isNull)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		GenericUpdateFixedValue[uint16](col, row, val, isNull)
 	case types.T_uint32:
 		GenericUpdateFixedValue[uint32](col, row, val, isNull)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		GenericUpdateFixedValue[uint64](col, row, val, isNull)
 	case types.T_decimal64:
 		GenericUpdateFixedValue[types.Decimal64](col, row, val, isNull)
@@ -223,7 +277,7 @@ func UpdateValue(col *movec.Vector, row uint32, val any, isNull bool) {
 		GenericUpdateFixedValue[types.Rowid](col, row, val, isNull)
 	case types.T_Blockid:
 		GenericUpdateFixedValue[types.Blockid](col, row, val, isNull)
-	case types.T_varchar, types.T_char, types.T_json,
+	case types.T_varchar, types.T_char, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		GenericUpdateBytes(col, row, val, isNull)
 	default:
@@ -388,7 +442,7 @@ func ForeachVectorWindow(
 			op,
 			op2,
 			sel)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		var op func(uint16, bool, int) error
 		if op1 != nil {
 			op = op1.(func(uint16, bool, int) error)
@@ -412,7 +466,7 @@ func ForeachVectorWindow(
 			op,
 			op2,
 			sel)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		var op func(uint64, bool, int) error
 		if op1 != nil {
 			op = op1.(func(uint64, bool, int) error)
@@ -786,13 +840,13 @@ func MakeForeachVectorOp(t types.T, overloads map[types.T]any, args ...any) any
 	case types.T_uint8:
 		overload := overloads[t].(func(...any) func(uint8, bool, int) error)
 		return overload(args...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		overload := overloads[t].(func(...any) func(uint16, bool, int) error)
 		return overload(args...)
 	case types.T_uint32:
 		overload := overloads[t].(func(...any) func(uint32, bool, int) error)
 		return overload(args...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		overload := overloads[t].(func(...any) func(uint64, bool, int) error)
 		return overload(args...)
 	case types.T_float32:
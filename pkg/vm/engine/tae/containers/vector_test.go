@@ -666,3 +666,77 @@ func TestForeachSelectBitmap(t *testing.T) {
 		return
 	}, nil)
 }
+
+func TestFillCNConstVector(t *testing.T) {
+	m := mpool.MustNewZero()
+	vec := FillCNConstVector(3, types.T_int32.ToType(), nil, m)
+	require.True(t, vec.IsConstNull())
+	vec.Free(m)
+
+	vec = FillCNConstVector(3, types.T_int32.ToType(), int32(7), m)
+	require.True(t, vec.IsConst())
+	require.Equal(t, 3, vec.Length())
+	require.Equal(t, int32(7), movec.GetFixedAt[int32](vec, 2))
+	vec.Free(m)
+
+	vec = FillCNConstVector(2, types.T_varchar.ToType(), []byte("abc"), m)
+	require.Equal(t, "abc", vec.GetStringAt(1))
+	vec.Free(m)
+	require.Equal(t, int64(0), m.CurrNB())
+}
+
+func TestWidenCNVector(t *testing.T) {
+	m := mpool.MustNewZero()
+	vec := movec.NewVec(types.T_int32.ToType())
+	require.NoError(t, movec.AppendFixedList(vec, []int32{-1, 0, 5}, []bool{false, true, false}, m))
+	res, err := WidenCNVector(vec, types.T_int64.ToType(), m)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-1, 0, 5}, movec.MustFixedCol[int64](res))
+	require.True(t, res.GetNulls().Contains(1))
+	res.Free(m)
+
+	res, err = WidenCNVector(vec, types.T_int32.ToType(), m)
+	require.NoError(t, err)
+	require.True(t, res == vec)
+	_, err = WidenCNVector(vec, types.T_varchar.ToType(), m)
+	require.Error(t, err)
+	vec.Free(m)
+
+	d64 := types.New(types.T_decimal64, 10, 2)
+	d128 := types.New(types.T_decimal128, 20, 2)
+	x, err := types.Decimal64FromFloat64(-12.5, 10, 2)
+	require.NoError(t, err)
+	vec = movec.NewConstFixed(d64, x, 4, m)
+	res, err = WidenCNVector(vec, d128, m)
+	require.NoError(t, err)
+	require.True(t, res.IsConst())
+	require.Equal(t, "-12.50", movec.GetFixedAt[types.Decimal128](res, 0).Format(2))
+	res.Free(m)
+	vec.Free(m)
+
+	// the strings are read as they are
+	vec = movec.NewVec(types.New(types.T_char, 3, 0))
+	require.NoError(t, movec.AppendStringList(vec, []string{"a", "", "abc"}, []bool{false, true, false}, m))
+	for _, typ := range []types.Type{
+		types.New(types.T_varchar, 10, 0),
+		types.New(types.T_text, 0, 0),
+	} {
+		res, err = WidenCNVector(vec, typ, m)
+		require.NoError(t, err)
+		require.Equal(t, typ, *res.GetType())
+		require.Equal(t, "abc", res.GetStringAt(2))
+		require.True(t, res.GetNulls().Contains(1))
+		res.Free(m)
+	}
+	require.Equal(t, types.T_char, vec.GetType().Oid)
+	vec.Free(m)
+
+	vec = movec.NewConstBytes(types.New(types.T_binary, 2, 0), []byte("ab"), 3, m)
+	res, err = WidenCNVector(vec, types.New(types.T_blob, 0, 0), m)
+	require.NoError(t, err)
+	require.True(t, res.IsConst())
+	require.Equal(t, []byte("ab"), res.GetBytesAt(2))
+	res.Free(m)
+	vec.Free(m)
+	require.Equal(t, int64(0), m.CurrNB())
+}
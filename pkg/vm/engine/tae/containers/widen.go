@@ -0,0 +1,128 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containers
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"golang.org/x/exp/constraints"
+)
+
+// WidenCNVector returns the values of vec read as type typ. The type of a column
+// may be widened by the alter table after vec is written, see types.IsWidening,
+// the values are converted if it's not an in-place widening, otherwise the
+// returned vector shares the values of vec with the type typ.
+func WidenCNVector(vec *movec.Vector, typ types.Type, m *mpool.MPool) (*movec.Vector, error) {
+	from := *vec.GetType()
+	if from.Oid == typ.Oid || from.Oid == types.T_any {
+		return vec, nil
+	}
+	if types.IsInPlaceWidening(from, typ) {
+		return retypeCNVector(vec, typ, m)
+	}
+	switch from.Oid {
+	case types.T_int8:
+		return widenInteger[int8](vec, typ, m)
+	case types.T_int16:
+		return widenInteger[int16](vec, typ, m)
+	case types.T_int32:
+		return widenInteger[int32](vec, typ, m)
+	case types.T_uint8:
+		return widenInteger[uint8](vec, typ, m)
+	case types.T_uint16:
+		return widenInteger[uint16](vec, typ, m)
+	case types.T_uint32:
+		return widenInteger[uint32](vec, typ, m)
+	case types.T_float32:
+		if typ.Oid == types.T_float64 {
+			return widenFixed(vec, typ, func(v float32) float64 { return float64(v) }, m)
+		}
+	case types.T_decimal64:
+		if typ.Oid == types.T_decimal128 {
+			return widenFixed(vec, typ, func(v types.Decimal64) types.Decimal128 {
+				x := types.Decimal128{B0_63: uint64(v)}
+				if v.Sign() {
+					x.B64_127 = ^x.B64_127
+				}
+				return x
+			}, m)
+		}
+	}
+	return nil, moerr.NewInternalErrorNoCtx("can't read %s as %s", from, typ)
+}
+
+// retypeCNVector returns a vector with the values of vec and the type typ, e.g.
+// char to varchar. The values of vec are not copied unless vec is a const vector.
+func retypeCNVector(vec *movec.Vector, typ types.Type, m *mpool.MPool) (*movec.Vector, error) {
+	var res *movec.Vector
+	var err error
+	if vec.IsConst() {
+		res, err = vec.Dup(m)
+	} else {
+		res, err = vec.Window(0, vec.Length())
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.SetType(typ)
+	return res, nil
+}
+
+func widenInteger[F constraints.Integer](vec *movec.Vector, typ types.Type, m *mpool.MPool) (*movec.Vector, error) {
+	switch typ.Oid {
+	case types.T_int16:
+		return widenFixed(vec, typ, func(v F) int16 { return int16(v) }, m)
+	case types.T_int32:
+		return widenFixed(vec, typ, func(v F) int32 { return int32(v) }, m)
+	case types.T_int64:
+		return widenFixed(vec, typ, func(v F) int64 { return int64(v) }, m)
+	case types.T_uint16:
+		return widenFixed(vec, typ, func(v F) uint16 { return uint16(v) }, m)
+	case types.T_uint32:
+		return widenFixed(vec, typ, func(v F) uint32 { return uint32(v) }, m)
+	case types.T_uint64:
+		return widenFixed(vec, typ, func(v F) uint64 { return uint64(v) }, m)
+	}
+	return nil, moerr.NewInternalErrorNoCtx("can't read %s as %s", vec.GetType(), typ)
+}
+
+func widenFixed[F, T any](vec *movec.Vector, typ types.Type, conv func(F) T, m *mpool.MPool) (*movec.Vector, error) {
+	if vec.IsConstNull() {
+		return movec.NewConstNull(typ, vec.Length(), m), nil
+	}
+	cols := movec.MustFixedCol[F](vec)
+	if vec.IsConst() {
+		return movec.NewConstFixed(typ, conv(cols[0]), vec.Length(), m), nil
+	}
+	vals := make([]T, len(cols))
+	for i, v := range cols {
+		vals[i] = conv(v)
+	}
+	var isNulls []bool
+	if vec.GetNulls().Any() {
+		isNulls = make([]bool, len(cols))
+		for i := range isNulls {
+			isNulls[i] = vec.GetNulls().Contains(uint64(i))
+		}
+	}
+	res := movec.NewVec(typ)
+	if err := movec.AppendFixedList(res, vals, isNulls, m); err != nil {
+		res.Free(m)
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,488 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"go.uber.org/zap"
+)
+
+const (
+	BackupVersion = 1
+
+	backupMetaFile    = "backup_meta"
+	backupLogDir      = "backup_log"
+	backupLogReadSize = 64 * 1024 * 1024
+	backupRetryTimes  = 3
+	backupLogTimeout  = time.Minute
+)
+
+type BackupCheckpoint struct {
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	Location    []byte `json:"location"`
+	Incremental bool   `json:"incremental"`
+}
+
+// BackupMeta is saved in the backup target. The backup can be restored to
+// any timestamp between StartTS and EndTS.
+type BackupMeta struct {
+	Version      int                `json:"version"`
+	StartTS      string             `json:"start_ts"`
+	EndTS        string             `json:"end_ts"`
+	Checkpoints  []BackupCheckpoint `json:"checkpoints"`
+	Objects      []string           `json:"objects"`
+	TruncatedLsn uint64             `json:"truncated_lsn"`
+	LogFiles     []string           `json:"log_files"`
+	LogRecords   int                `json:"log_records"`
+}
+
+// GetBackupFS returns the file service of the backup target and the dir of
+// the backup in it. The target is a local dir or an ETL path.
+func GetBackupFS(target string) (fileservice.FileService, string, error) {
+	if target == "" {
+		return nil, "", moerr.NewInvalidInputNoCtx("empty backup target")
+	}
+	fs, dir, err := fileservice.GetForETL(nil, strings.TrimSuffix(target, "/")+"/")
+	if err != nil {
+		return nil, "", err
+	}
+	return fs, dir, nil
+}
+
+// Backup copies the latest checkpoints, the objects referenced by the
+// catalog and the log entries after the checkpoints to dir of fs. The GC
+// of the objects is blocked while copying. The objects and the log entries
+// are streamed to fs, at most one read of the log service is held in memory.
+func (db *DB) Backup(ctx context.Context, fs fileservice.FileService, dir string) (meta *BackupMeta, err error) {
+	if db.Opts.LogStoreT != options.LogstoreLogservice {
+		return nil, moerr.NewNotSupported(ctx, "backup with logstore %s", db.Opts.LogStoreT)
+	}
+	db.DiskCleaner.DisableGC()
+	defer db.DiskCleaner.EnableGC()
+
+	client, err := db.Opts.Lc()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	for i := 0; ; i++ {
+		meta, err = db.collectBackup(ctx, client, fs, dir)
+		if err == nil || i == backupRetryTimes {
+			break
+		}
+		logutil.Warn("backup-tae", zap.Int("retry", i), zap.Error(err))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	objects := db.collectObjects(types.StringToTS(meta.StartTS))
+	for _, ckp := range meta.Checkpoints {
+		objects[objectio.Location(ckp.Location).Name().String()] = true
+	}
+	for name := range objects {
+		meta.Objects = append(meta.Objects, name)
+	}
+	sort.Strings(meta.Objects)
+	copied := meta.Objects[:0]
+	for _, name := range meta.Objects {
+		if err = copyFile(ctx, db.Fs.Service, name, fs, path.Join(dir, name)); err != nil {
+			if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) || objects[name] {
+				return nil, err
+			}
+			// only the blocks dropped before the checkpoints read the
+			// object, it was collected before the GC was disabled
+			logutil.Warn("backup-tae", zap.String("skip", name), zap.Error(err))
+			continue
+		}
+		copied = append(copied, name)
+	}
+	meta.Objects = copied
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeFile(ctx, fs, path.Join(dir, backupMetaFile), data); err != nil {
+		return nil, err
+	}
+	logutil.Info("backup-tae",
+		zap.String("start", meta.StartTS),
+		zap.String("end", meta.EndTS),
+		zap.Int("objects", len(meta.Objects)),
+		zap.Int("log records", meta.LogRecords))
+	return meta, nil
+}
+
+// collectBackup copies the log entries that are not covered by the
+// checkpoints to dir of fs. The truncated lsn is got before the
+// checkpoints, so the truncated entries are always covered by them. The
+// log files written are removed if it fails.
+func (db *DB) collectBackup(
+	ctx context.Context,
+	client logservice.Client,
+	fs fileservice.FileService,
+	dir string) (meta *BackupMeta, err error) {
+	// the requests to the log service require a deadline
+	logCtx, cancel := context.WithTimeout(ctx, backupLogTimeout)
+	truncated, err := client.GetTruncatedLsn(logCtx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	entries := db.BGCheckpointRunner.GetCheckpointsForBackup()
+	if len(entries) == 0 {
+		return nil, moerr.NewInternalError(ctx, "no checkpoint to backup")
+	}
+	// all the txns committed before endTS have been written to the log
+	endTS := *db.TxnMgr.MaxCommittedTS.Load()
+
+	meta = &BackupMeta{
+		Version:      BackupVersion,
+		TruncatedLsn: truncated,
+	}
+	var startTS types.TS
+	for _, entry := range entries {
+		meta.Checkpoints = append(meta.Checkpoints, BackupCheckpoint{
+			Start:       entry.GetStart().ToString(),
+			End:         entry.GetEnd().ToString(),
+			Location:    entry.GetLocation(),
+			Incremental: entry.IsIncremental(),
+		})
+		if entry.GetEnd().Greater(startTS) {
+			startTS = entry.GetEnd()
+		}
+	}
+	if endTS.Less(startTS) {
+		endTS = startTS
+	}
+	meta.StartTS, meta.EndTS = startTS.ToString(), endTS.ToString()
+
+	defer func() {
+		if err != nil && len(meta.LogFiles) > 0 {
+			names := make([]string, 0, len(meta.LogFiles))
+			for _, name := range meta.LogFiles {
+				names = append(names, path.Join(dir, name))
+			}
+			if delErr := fs.Delete(ctx, names...); delErr != nil {
+				logutil.Warn("backup-tae", zap.Strings("leak", names), zap.Error(delErr))
+			}
+		}
+	}()
+	lsn := truncated + 1
+	for {
+		logCtx, cancel = context.WithTimeout(ctx, backupLogTimeout)
+		records, next, err := client.Read(logCtx, lsn, backupLogReadSize)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if err = writeBackupLog(ctx, fs, dir, meta, records); err != nil {
+			return nil, err
+		}
+		if next == lsn {
+			break
+		}
+		lsn = next
+	}
+	return meta, nil
+}
+
+// collectObjects returns the objects referenced by the catalog. An object
+// is required unless all the blocks reading it have been dropped at or
+// before ts, such an object is not read by restoring from the checkpoints
+// ending at ts, and the GC may have collected it.
+func (db *DB) collectObjects(ts types.TS) map[string]bool {
+	objects := make(map[string]bool)
+	addLocation := func(loc objectio.Location, required bool) {
+		if !loc.IsEmpty() {
+			name := loc.Name().String()
+			objects[name] = objects[name] || required
+		}
+	}
+	processor := new(catalog.LoopProcessor)
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		required := !droppedBefore(entry, ts)
+		addLocation(entry.GetMetaLoc(), required)
+		entry.RLock()
+		defer entry.RUnlock()
+		entry.LoopChain(func(node *catalog.MVCCNode[*catalog.MetadataMVCCNode]) bool {
+			addLocation(node.BaseNode.MetaLoc, required)
+			addLocation(node.BaseNode.DeltaLoc, required)
+			return true
+		})
+		return nil
+	}
+	if err := db.Catalog.RecurLoop(processor); err != nil {
+		panic(err)
+	}
+	return objects
+}
+
+// droppedBefore returns whether the block, its segment, table or database
+// has been dropped at or before ts.
+func droppedBefore(block *catalog.BlockEntry, ts types.TS) bool {
+	ts = ts.Next()
+	segment := block.GetSegment()
+	table := segment.GetTable()
+	entries := []interface {
+		RLock()
+		RUnlock()
+		DeleteBefore(types.TS) bool
+	}{block, segment, table, table.GetDB()}
+	for _, entry := range entries {
+		entry.RLock()
+		dropped := entry.DeleteBefore(ts)
+		entry.RUnlock()
+		if dropped {
+			return true
+		}
+	}
+	return false
+}
+
+// Restore rebuilds the DB in dataDir and opts.Fs from the backup in dir of
+// fs. The txns committed after ts are dropped, and the restored data are
+// checkpointed, so the DB can be opened with an empty log service.
+func Restore(
+	ctx context.Context,
+	fs fileservice.FileService,
+	dir string,
+	dataDir string,
+	opts *options.Options,
+	ts types.TS) (err error) {
+	data, err := readFile(ctx, fs, path.Join(dir, backupMetaFile))
+	if err != nil {
+		return err
+	}
+	meta := &BackupMeta{}
+	if err = json.Unmarshal(data, meta); err != nil {
+		return err
+	}
+	if meta.Version != BackupVersion {
+		return moerr.NewNotSupported(ctx, "backup version %d", meta.Version)
+	}
+	startTS, endTS := types.StringToTS(meta.StartTS), types.StringToTS(meta.EndTS)
+	if ts.IsEmpty() {
+		ts = endTS
+	}
+	if ts.Less(startTS) {
+		return moerr.NewInvalidInput(ctx, "restore timestamp %s is before the backup start %s",
+			ts.ToString(), meta.StartTS)
+	}
+	if ts.Greater(endTS) {
+		logutil.Warn("restore-tae", zap.String("ts", ts.ToString()), zap.String("backup end", meta.EndTS))
+	}
+
+	dirs, err := opts.Fs.List(ctx, checkpoint.CheckpointDir)
+	if err != nil {
+		return err
+	}
+	if len(dirs) > 0 {
+		return moerr.NewInternalError(ctx, "restore to a file service with checkpoints")
+	}
+	for _, name := range meta.Objects {
+		err = copyFile(ctx, fs, path.Join(dir, name), opts.Fs, name)
+		if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+			return err
+		}
+	}
+	entries := make([]*checkpoint.CheckpointEntry, 0, len(meta.Checkpoints))
+	for _, ckp := range meta.Checkpoints {
+		typ := checkpoint.ET_Global
+		if ckp.Incremental {
+			typ = checkpoint.ET_Incremental
+		}
+		entry := checkpoint.NewCheckpointEntry(types.StringToTS(ckp.Start), types.StringToTS(ckp.End), typ)
+		entry.SetLocation(ckp.Location)
+		entry.SetState(checkpoint.ST_Finished)
+		entries = append(entries, entry)
+	}
+	if err = checkpoint.SaveCheckpointMetadata(ctx, opts.Fs, entries); err != nil {
+		return err
+	}
+
+	client, err := newBackupLogClient(ctx, fs, dir, meta)
+	if err != nil {
+		return err
+	}
+	opts.LogStoreT = options.LogstoreLogservice
+	opts.Lc = func() (logservice.Client, error) {
+		return client, nil
+	}
+	opts.RestoreTS = ts
+	db, err := Open(dataDir, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	// the log entries are dropped after restoring, so everything replayed
+	// must be checkpointed. The flush commits after the ts of the first
+	// checkpoint, it is covered by the second one.
+	for i := 0; i < 2; i++ {
+		if err = db.ForceCheckpoint(ctx, db.TxnMgr.Now(), 0); err != nil {
+			return err
+		}
+	}
+	logutil.Info("restore-tae",
+		zap.String("ts", ts.ToString()),
+		zap.Int("objects", len(meta.Objects)),
+		zap.Int("log records", meta.LogRecords))
+	return nil
+}
+
+// writeBackupLog writes the records of a read from the log service as a
+// file of length prefixed records, named by the lsn of the first record.
+func writeBackupLog(
+	ctx context.Context,
+	fs fileservice.FileService,
+	dir string,
+	meta *BackupMeta,
+	records []logservice.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var buf []byte
+	for i := range records {
+		data, err := records[i].Marshal()
+		if err != nil {
+			return err
+		}
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(data)))
+		buf = append(buf, data...)
+	}
+	name := path.Join(backupLogDir, fmt.Sprintf("%020d", records[0].Lsn))
+	if err := writeFile(ctx, fs, path.Join(dir, name), buf); err != nil {
+		return err
+	}
+	meta.LogFiles = append(meta.LogFiles, name)
+	meta.LogRecords += len(records)
+	return nil
+}
+
+// readBackupLog reads the records of a file written by writeBackupLog.
+func readBackupLog(
+	ctx context.Context,
+	fs fileservice.FileService,
+	name string) ([]logservice.LogRecord, error) {
+	data, err := readFile(ctx, fs, name)
+	if err != nil {
+		return nil, err
+	}
+	var records []logservice.LogRecord
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, moerr.NewInternalError(ctx, "corrupted backup log %s", name)
+		}
+		size := int(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		if len(data) < size {
+			return nil, moerr.NewInternalError(ctx, "corrupted backup log %s", name)
+		}
+		var record logservice.LogRecord
+		if err = record.Unmarshal(data[:size]); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+		data = data[size:]
+	}
+	if len(records) == 0 {
+		return nil, moerr.NewInternalError(ctx, "empty backup log %s", name)
+	}
+	return records, nil
+}
+
+// copyFile streams the file from src to dst.
+func copyFile(ctx context.Context, src fileservice.FileService, srcName string,
+	dst fileservice.FileService, dstName string) error {
+	var reader io.ReadCloser
+	err := src.Read(ctx, &fileservice.IOVector{
+		FilePath: srcName,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset:            0,
+				Size:              -1,
+				ReadCloserForRead: &reader,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return dst.Write(ctx, fileservice.IOVector{
+		FilePath: dstName,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset:         0,
+				Size:           -1,
+				ReaderForWrite: reader,
+			},
+		},
+	})
+}
+
+func readFile(ctx context.Context, fs fileservice.FileService, name string) ([]byte, error) {
+	vec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	if err := fs.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	return vec.Entries[0].Data, nil
+}
+
+func writeFile(ctx context.Context, fs fileservice.FileService, name string, data []byte) error {
+	return fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(data)),
+				Data:   data,
+			},
+		},
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// backupLogClient serves the log entries of a backup to the WAL driver in
+// restoring. The files of the backup are loaded one at a time, the entries
+// appended in restoring are kept in memory only.
+type backupLogClient struct {
+	sync.Mutex
+	fs        fileservice.FileService
+	dir       string
+	truncated logservice.Lsn
+	files     []backupLogFile
+	// records are the entries of files[loaded]
+	loaded  int
+	records []logservice.LogRecord
+	// end is the lsn after the entries of the backup
+	end      logservice.Lsn
+	appended []logservice.LogRecord
+}
+
+type backupLogFile struct {
+	name     string
+	firstLsn logservice.Lsn
+}
+
+var _ logservice.Client = (*backupLogClient)(nil)
+
+func newBackupLogClient(
+	ctx context.Context,
+	fs fileservice.FileService,
+	dir string,
+	meta *BackupMeta) (*backupLogClient, error) {
+	c := &backupLogClient{
+		fs:        fs,
+		dir:       dir,
+		truncated: meta.TruncatedLsn,
+		loaded:    -1,
+		end:       meta.TruncatedLsn + 1,
+	}
+	for _, name := range meta.LogFiles {
+		lsn, err := strconv.ParseUint(path.Base(name), 10, 64)
+		if err != nil {
+			return nil, moerr.NewInternalError(ctx, "invalid backup log %s", name)
+		}
+		c.files = append(c.files, backupLogFile{name: name, firstLsn: lsn})
+	}
+	if len(c.files) > 0 {
+		if err := c.loadLocked(ctx, len(c.files)-1); err != nil {
+			return nil, err
+		}
+		c.end = c.records[len(c.records)-1].Lsn + 1
+	}
+	return c, nil
+}
+
+func (c *backupLogClient) loadLocked(ctx context.Context, i int) error {
+	if c.loaded == i {
+		return nil
+	}
+	records, err := readBackupLog(ctx, c.fs, path.Join(c.dir, c.files[i].name))
+	if err != nil {
+		return err
+	}
+	c.loaded, c.records = i, records
+	return nil
+}
+
+// Close does nothing, the client is shared by all the users.
+func (c *backupLogClient) Close() error {
+	return nil
+}
+
+func (c *backupLogClient) Config() logservice.ClientConfig {
+	return logservice.ClientConfig{}
+}
+
+func (c *backupLogClient) GetLogRecord(payloadLength int) logservice.LogRecord {
+	data := make([]byte, pb.HeaderSize+8+payloadLength)
+	binary.BigEndian.PutUint32(data, uint32(pb.UserEntryUpdate))
+	return logservice.LogRecord{Data: data}
+}
+
+func (c *backupLogClient) Append(_ context.Context, rec logservice.LogRecord) (logservice.Lsn, error) {
+	c.Lock()
+	defer c.Unlock()
+	lsn := c.end + logservice.Lsn(len(c.appended))
+	c.appended = append(c.appended, logservice.LogRecord{
+		Lsn:  lsn,
+		Type: pb.UserRecord,
+		Data: append([]byte(nil), rec.Data...),
+	})
+	return lsn, nil
+}
+
+// Read returns the entries from firstLsn in the file of the backup holding
+// it, or in the appended entries.
+func (c *backupLogClient) Read(
+	ctx context.Context,
+	firstLsn logservice.Lsn,
+	maxSize uint64) ([]logservice.LogRecord, logservice.Lsn, error) {
+	c.Lock()
+	defer c.Unlock()
+	if firstLsn <= c.truncated {
+		return nil, 0, moerr.NewInvalidTruncateLsn(ctx, 0, firstLsn)
+	}
+	records := c.appended
+	if firstLsn < c.end && len(c.files) > 0 {
+		i := sort.Search(len(c.files), func(i int) bool {
+			return c.files[i].firstLsn > firstLsn
+		}) - 1
+		if i < 0 {
+			i = 0
+		}
+		if err := c.loadLocked(ctx, i); err != nil {
+			return nil, 0, err
+		}
+		records = c.records
+	}
+	i := 0
+	for i < len(records) && records[i].Lsn < firstLsn {
+		i++
+	}
+	var size uint64
+	j := i
+	for j < len(records) && (j == i || size+uint64(len(records[j].Data)) <= maxSize) {
+		size += uint64(len(records[j].Data))
+		j++
+	}
+	if i == j {
+		return nil, firstLsn, nil
+	}
+	return records[i:j], records[j-1].Lsn + 1, nil
+}
+
+func (c *backupLogClient) Truncate(_ context.Context, lsn logservice.Lsn) error {
+	c.Lock()
+	defer c.Unlock()
+	if lsn > c.truncated {
+		c.truncated = lsn
+	}
+	return nil
+}
+
+func (c *backupLogClient) GetTruncatedLsn(_ context.Context) (logservice.Lsn, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.truncated, nil
+}
+
+func (c *backupLogClient) GetTSOTimestamp(ctx context.Context, _ uint64) (uint64, error) {
+	return 0, moerr.NewNotSupported(ctx, "tso in restoring")
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/lni/vfs"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogservice(t *testing.T) (*logservice.Service, options.LogstoreType, func() (logservice.Client, error)) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	service, ccfg, err := logservice.NewTestService(vfs.NewStrictMem())
+	require.NoError(t, err)
+	return service, options.LogstoreLogservice, func() (logservice.Client, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		return logservice.NewClient(ctx, ccfg)
+	}
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+	service, logstore, factory := newTestLogservice(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.LogStoreT, opts.Lc = logstore, factory
+	tae := newTestEngine(t, opts)
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	bats := bat.Split(3)
+
+	tae.createRelAndAppend(bats[0], true)
+	require.NoError(t, tae.ForceCheckpoint(ctx, tae.TxnMgr.StatMaxCommitTS(), time.Minute))
+	tae.DoAppend(bats[1])
+	restoreTS := *tae.TxnMgr.MaxCommittedTS.Load()
+	tae.DoAppend(bats[2])
+
+	// the objects are kept while the gc is disabled
+	tae.DiskCleaner.DisableGC()
+	tae.DiskCleaner.DisableGC()
+	tae.DiskCleaner.EnableGC()
+	tae.DiskCleaner.EnableGC()
+	assert.Panics(t, tae.DiskCleaner.EnableGC)
+
+	fs, dir, err := GetBackupFS(t.TempDir())
+	require.NoError(t, err)
+	meta, err := tae.Backup(ctx, fs, dir)
+	require.NoError(t, err)
+	assert.NotEmpty(t, meta.Checkpoints)
+	assert.NotEmpty(t, meta.Objects)
+	assert.NotZero(t, meta.LogRecords)
+	assert.True(t, types.StringToTS(meta.StartTS).LessEq(restoreTS))
+	assert.True(t, restoreTS.Less(types.StringToTS(meta.EndTS)))
+	// the test log services listen on the same address
+	require.NoError(t, tae.Close())
+	require.NoError(t, service.Close())
+
+	restore := func(ts types.TS, rows int) {
+		restoreDir := t.TempDir()
+		opts := config.WithLongScanAndCKPOpts(nil)
+		opts.Fs = objectio.TmpNewFileservice(path.Join(restoreDir, "data"))
+		require.NoError(t, Restore(ctx, fs, dir, restoreDir, opts, ts))
+
+		// the restored db is opened with an empty log service
+		service, logstore, factory := newTestLogservice(t)
+		defer service.Close()
+		opts = config.WithLongScanAndCKPOpts(nil)
+		opts.Fs = objectio.TmpNewFileservice(path.Join(restoreDir, "data"))
+		opts.LogStoreT, opts.Lc = logstore, factory
+		db, err := Open(restoreDir, opts)
+		require.NoError(t, err)
+		defer db.Close()
+		txn, rel := getDefaultRelation(t, db, schema.Name)
+		checkAllColRowsByScan(t, rel, rows, true)
+		assert.NoError(t, txn.Commit())
+	}
+	restore(restoreTS, 20)
+	restore(types.TS{}, 30)
+
+	err = Restore(ctx, fs, dir, t.TempDir(), config.WithLongScanAndCKPOpts(nil), types.StringToTS(meta.StartTS).Prev())
+	assert.Error(t, err)
+}
+
+func TestBackupMissingObject(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+	service, logstore, factory := newTestLogservice(t)
+	defer service.Close()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.LogStoreT, opts.Lc = logstore, factory
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 10)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(false)
+	require.NoError(t, tae.ForceCheckpoint(ctx, tae.TxnMgr.StatMaxCommitTS(), time.Minute))
+
+	// the object of a live block must be copied
+	var missing string
+	for name, required := range tae.collectObjects(*tae.TxnMgr.MaxCommittedTS.Load()) {
+		if required {
+			missing = name
+		}
+	}
+	require.NotEmpty(t, missing)
+	require.NoError(t, tae.Fs.Service.Delete(ctx, missing))
+
+	fs, dir, err := GetBackupFS(t.TempDir())
+	require.NoError(t, err)
+	_, err = tae.Backup(ctx, fs, dir)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+}
+
+func TestBackupLogClient(t *testing.T) {
+	ctx := context.Background()
+	fs, dir, err := GetBackupFS(t.TempDir())
+	require.NoError(t, err)
+	meta := &BackupMeta{TruncatedLsn: 10}
+	for lsn := logservice.Lsn(11); lsn < 17; lsn += 3 {
+		records := make([]logservice.LogRecord, 3)
+		for i := range records {
+			records[i] = logservice.LogRecord{Lsn: lsn + logservice.Lsn(i), Data: []byte{byte(i)}}
+		}
+		require.NoError(t, writeBackupLog(ctx, fs, dir, meta, records))
+	}
+	require.Equal(t, 2, len(meta.LogFiles))
+	require.Equal(t, 6, meta.LogRecords)
+
+	client, err := newBackupLogClient(ctx, fs, dir, meta)
+	require.NoError(t, err)
+	lsn, err := client.Append(ctx, logservice.LogRecord{Data: []byte{3}})
+	require.NoError(t, err)
+	require.Equal(t, logservice.Lsn(17), lsn)
+
+	_, _, err = client.Read(ctx, 10, 1024)
+	require.Error(t, err)
+	var lsns []logservice.Lsn
+	for next := logservice.Lsn(11); ; {
+		records, lsn, err := client.Read(ctx, next, 2)
+		require.NoError(t, err)
+		if lsn == next {
+			break
+		}
+		for _, record := range records {
+			lsns = append(lsns, record.Lsn)
+		}
+		next = lsn
+	}
+	require.Equal(t, []logservice.Lsn{11, 12, 13, 14, 15, 16, 17}, lsns)
+}
@@ -30,6 +30,7 @@ type RunnerReader interface {
 	GetGlobalCheckpointCount() int
 	CollectCheckpointsInRange(ctx context.Context, start, end types.TS) (ckpLoc string, lastEnd types.TS, err error)
 	ICKPSeekLT(ts types.TS, cnt int) []*CheckpointEntry
+	GetCheckpointsForBackup() []*CheckpointEntry
 	MaxLSN() uint64
 }
 
@@ -40,23 +41,48 @@ func (r *runner) collectCheckpointMetadata(start, end types.TS) *containers.Batc
 		if !entry.IsFinished() && !entry.end.Equal(end) {
 			continue
 		}
-		bat.GetVectorByName(CheckpointAttr_StartTS).Append(entry.start, false)
-		bat.GetVectorByName(CheckpointAttr_EndTS).Append(entry.end, false)
-		bat.GetVectorByName(CheckpointAttr_MetaLocation).Append([]byte(entry.GetLocation()), false)
-		bat.GetVectorByName(CheckpointAttr_EntryType).Append(true, false)
+		appendCheckpointMetadata(bat, entry)
 	}
 	entries = r.GetAllGlobalCheckpoints()
 	for _, entry := range entries {
 		if !entry.IsFinished() && !entry.end.Equal(end) {
 			continue
 		}
-		bat.GetVectorByName(CheckpointAttr_StartTS).Append(entry.start, false)
-		bat.GetVectorByName(CheckpointAttr_EndTS).Append(entry.end, false)
-		bat.GetVectorByName(CheckpointAttr_MetaLocation).Append([]byte(entry.GetLocation()), false)
-		bat.GetVectorByName(CheckpointAttr_EntryType).Append(false, false)
+		appendCheckpointMetadata(bat, entry)
 	}
 	return bat
 }
+
+func appendCheckpointMetadata(bat *containers.Batch, entry *CheckpointEntry) {
+	bat.GetVectorByName(CheckpointAttr_StartTS).Append(entry.start, false)
+	bat.GetVectorByName(CheckpointAttr_EndTS).Append(entry.end, false)
+	bat.GetVectorByName(CheckpointAttr_MetaLocation).Append([]byte(entry.GetLocation()), false)
+	bat.GetVectorByName(CheckpointAttr_EntryType).Append(entry.IsIncremental(), false)
+}
+
+// GetCheckpointsForBackup returns the checkpoints needed to rebuild the
+// catalog, which are the max global checkpoint and the finished incremental
+// checkpoints after it.
+func (r *runner) GetCheckpointsForBackup() []*CheckpointEntry {
+	entries := make([]*CheckpointEntry, 0)
+	global := r.MaxGlobalCheckpoint()
+	if global != nil && global.IsFinished() {
+		entries = append(entries, global)
+	} else {
+		global = nil
+	}
+	for _, entry := range r.GetAllIncrementalCheckpoints() {
+		if global != nil && entry.end.LessEq(global.end) {
+			continue
+		}
+		if !entry.IsFinished() {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
 func (r *runner) GetAllIncrementalCheckpoints() []*CheckpointEntry {
 	r.storage.Lock()
 	snapshot := r.storage.entries.Copy()
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
@@ -437,8 +438,33 @@ func (r *runner) FlushTable(ctx context.Context, dbID, tableID uint64, ts types.
 
 func (r *runner) saveCheckpoint(start, end types.TS) (err error) {
 	bat := r.collectCheckpointMetadata(start, end)
+	return writeCheckpointMetadata(context.Background(), r.fs.Service, start, end, bat)
+}
+
+// SaveCheckpointMetadata writes a metadata file listing the finished entries,
+// it is used to rebuild the checkpoints from a backup.
+func SaveCheckpointMetadata(ctx context.Context, fs fileservice.FileService, entries []*CheckpointEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	bat := makeRespBatchFromSchema(CheckpointSchema)
+	defer bat.Close()
+	start, end := entries[0].start, entries[0].end
+	for _, entry := range entries {
+		appendCheckpointMetadata(bat, entry)
+		if entry.start.Less(start) {
+			start = entry.start
+		}
+		if entry.end.Greater(end) {
+			end = entry.end
+		}
+	}
+	return writeCheckpointMetadata(ctx, fs, start, end, bat)
+}
+
+func writeCheckpointMetadata(ctx context.Context, fs fileservice.FileService, start, end types.TS, bat *containers.Batch) (err error) {
 	name := blockio.EncodeCheckpointMetadataFileName(CheckpointDir, PrefixMetadata, start, end)
-	writer, err := objectio.NewObjectWriterSpecial(objectio.WriterCheckpoint, name, r.fs.Service)
+	writer, err := objectio.NewObjectWriterSpecial(objectio.WriterCheckpoint, name, fs)
 	if err != nil {
 		return err
 	}
@@ -447,7 +473,7 @@ func (r *runner) saveCheckpoint(start, end types.TS) (err error) {
 	}
 
 	// TODO: checkpoint entry should maintain the location
-	_, err = writer.WriteEnd(context.Background())
+	_, err = writer.WriteEnd(ctx)
 	return
 }
 
@@ -4351,7 +4351,7 @@ func TestBlockRead(t *testing.T) {
 	err = blockio.BlockPrefetch(colIdxs, fs, infos)
 	assert.NoError(t, err)
 	b1, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		beforeDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4359,13 +4359,13 @@ func TestBlockRead(t *testing.T) {
 	assert.Equal(t, 20, b1.Vecs[0].Length())
 
 	b2, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterFirstDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
 	assert.Equal(t, 19, b2.Vecs[0].Length())
 	b3, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4377,6 +4377,7 @@ func TestBlockRead(t *testing.T) {
 		context.Background(), info,
 		[]uint16{2},
 		[]types.Type{types.T_Rowid.ToType()},
+		nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4389,6 +4390,7 @@ func TestBlockRead(t *testing.T) {
 		context.Background(), info,
 		[]uint16{2},
 		[]types.Type{types.T_Rowid.ToType()},
+		nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
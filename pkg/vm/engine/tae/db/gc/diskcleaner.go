@@ -89,6 +89,13 @@ type DiskCleaner struct {
 		extras []func(item any) bool
 	}
 
+	// disabled counts the callers that block the GC, the objects
+	// are not deleted until all of them enable the GC again
+	disabled struct {
+		sync.Mutex
+		count int
+	}
+
 	processQueue sm.Queue
 
 	onceStart sync.Once
@@ -196,18 +203,44 @@ func (cleaner *DiskCleaner) replay() error {
 	return nil
 }
 
+// DisableGC blocks the GC of the objects. It waits for the running GC, so
+// no object is deleted after it returns.
+func (cleaner *DiskCleaner) DisableGC() {
+	cleaner.disabled.Lock()
+	defer cleaner.disabled.Unlock()
+	cleaner.disabled.count++
+}
+
+func (cleaner *DiskCleaner) EnableGC() {
+	cleaner.disabled.Lock()
+	defer cleaner.disabled.Unlock()
+	if cleaner.disabled.count == 0 {
+		panic("enable the gc without disabling it")
+	}
+	cleaner.disabled.count--
+}
+
 func (cleaner *DiskCleaner) process(items ...any) {
+	// hold the lock while processing so DisableGC waits for it
+	cleaner.disabled.Lock()
+	defer cleaner.disabled.Unlock()
 	if items[0].(int) == MessgeReplay {
 		err := cleaner.replay()
 		if err != nil {
 			panic(err)
 		}
 		// TODO:
-		cleaner.tryGC()
+		if cleaner.disabled.count == 0 {
+			cleaner.tryGC()
+		}
 		if len(items) == 1 {
 			return
 		}
 	}
+	if cleaner.disabled.count > 0 {
+		logutil.Info("GC is disabled")
+		return
+	}
 
 	var ts types.TS
 	maxConsumed := cleaner.maxConsumed.Load()
@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gc2 "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 
@@ -84,11 +85,18 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		Closed:     new(atomic.Value),
 	}
 
+	// the WAL entries are compressed only if the compression is configured
+	walCompressAlg, walCompressLevel := uint8(compress.None), 0
+	if opts.CompressionCfg != nil {
+		objectio.SetDefaultCompression(opts.CompressionCfg.Alg, opts.CompressionCfg.Level)
+		walCompressAlg, walCompressLevel = opts.CompressionCfg.Alg, opts.CompressionCfg.Level
+	}
+
 	switch opts.LogStoreT {
 	case options.LogstoreBatchStore:
 		db.Wal = wal.NewDriverWithBatchStore(dirname, WALDir, nil)
 	case options.LogstoreLogservice:
-		db.Wal = wal.NewDriverWithLogservice(opts.Lc)
+		db.Wal = wal.NewDriverWithLogservice(opts.Lc, walCompressAlg, walCompressLevel)
 	}
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
 	dataFactory := tables.NewDataFactory(
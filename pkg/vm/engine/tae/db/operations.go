@@ -16,6 +16,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	"time"
 
@@ -114,6 +115,19 @@ func (m *InspectDN) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+// Backup is not defined in operations.proto, it is encoded as json.
+type Backup struct {
+	Target string `json:"target"`
+}
+
+func (m *Backup) MarshalBinary() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *Backup) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
 type CreateDatabaseResp struct {
 	ID uint64
 }
@@ -226,6 +240,22 @@ func (m *InspectResp) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+type BackupResp struct {
+	StartTS     string `json:"start_ts"`
+	EndTS       string `json:"end_ts"`
+	Checkpoints int    `json:"checkpoints"`
+	Objects     int    `json:"objects"`
+	LogRecords  int    `json:"log_records"`
+}
+
+func (m *BackupResp) MarshalBinary() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *BackupResp) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
 const (
 	InspectNormal = 0
 	InspectCata   = 1
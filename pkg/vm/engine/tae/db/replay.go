@@ -137,6 +137,9 @@ func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, lsn uint64) {
 	if txnCmd.PrepareTS.LessEq(replayer.maxTs) {
 		return
 	}
+	if restoreTS := replayer.db.Opts.RestoreTS; !restoreTS.IsEmpty() && txnCmd.PrepareTS.Greater(restoreTS) {
+		return
+	}
 	txn := txnimpl.MakeReplayTxn(replayer.db.TxnMgr, txnCmd.TxnCtx, lsn,
 		txnCmd, replayer, replayer.db.Catalog, replayer.DataFactory, replayer.db.Wal)
 	if err = replayer.db.TxnMgr.OnReplayTxn(txn); err != nil {
@@ -90,4 +90,11 @@ type Handler interface {
 		req *db.InspectDN,
 		resp *db.InspectResp,
 	) error
+
+	HandleBackup(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.Backup,
+		resp *db.BackupResp,
+	) error
 }
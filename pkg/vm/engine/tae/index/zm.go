@@ -317,11 +317,11 @@ func (zm ZM) getValue(buf []byte) any {
 		return types.DecodeFixed[int64](buf)
 	case types.T_uint8:
 		return types.DecodeFixed[uint8](buf)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		return types.DecodeFixed[uint16](buf)
 	case types.T_uint32:
 		return types.DecodeFixed[uint32](buf)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		return types.DecodeFixed[uint64](buf)
 	case types.T_float32:
 		return types.DecodeFixed[float32](buf)
@@ -347,7 +347,7 @@ func (zm ZM) getValue(buf []byte) any {
 		return types.DecodeFixed[types.Rowid](buf)
 	case types.T_Blockid:
 		return types.DecodeFixed[types.Rowid](buf)
-	case types.T_char, types.T_varchar, types.T_json,
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		return buf
 	}
@@ -576,7 +576,7 @@ func (zm ZM) AnyIn(vec *vector.Vector, firstRun bool) (res, ok bool) {
 
 		return lowerBound < len(col) && maxVal >= col[lowerBound], true
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		col := vector.MustFixedCol[uint16](vec)
 		if firstRun {
 			sort.Slice(col, func(i, j int) bool {
@@ -606,7 +606,7 @@ func (zm ZM) AnyIn(vec *vector.Vector, firstRun bool) (res, ok bool) {
 
 		return lowerBound < len(col) && maxVal >= col[lowerBound], true
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		col := vector.MustFixedCol[uint64](vec)
 		if firstRun {
 			sort.Slice(col, func(i, j int) bool {
@@ -741,7 +741,7 @@ func (zm ZM) AnyIn(vec *vector.Vector, firstRun bool) (res, ok bool) {
 
 		return lowerBound < len(col) && col[lowerBound].Less(maxVal), true
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		col, area := vector.MustVarlenaRawData(vec)
 		if firstRun {
 			sort.Slice(col, func(i, j int) bool {
@@ -909,7 +909,7 @@ func applyArithmetic(v1, v2, res ZM, op byte, scale1, scale2 int32) (ok bool) {
 		}
 		UpdateZM(res, types.EncodeUint8(&minv))
 		UpdateZM(res, types.EncodeUint8(&maxv))
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		var minv, maxv uint16
 		switch op {
 		case '+':
@@ -947,7 +947,7 @@ func applyArithmetic(v1, v2, res ZM, op byte, scale1, scale2 int32) (ok bool) {
 		}
 		UpdateZM(res, types.EncodeUint32(&minv))
 		UpdateZM(res, types.EncodeUint32(&maxv))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		var minv, maxv uint64
 		switch op {
 		case '+':
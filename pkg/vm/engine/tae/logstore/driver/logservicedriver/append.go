@@ -62,6 +62,8 @@ func (d *LogServiceDriver) onAppendQueue(appender *driverAppender) {
 	appender.client, appender.appendlsn = d.getClient()
 	appender.entry.SetAppended(d.getSynced())
 	appender.contextDuration = d.config.NewClientDuration
+	appender.compressAlg = d.config.Compression
+	appender.compressLevel = d.config.CompressionLevel
 	appender.wg.Add(1)
 	go appender.append(d.config.RetryTimeout, d.config.ClientAppendDuration)
 }
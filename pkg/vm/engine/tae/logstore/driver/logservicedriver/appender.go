@@ -29,6 +29,8 @@ type driverAppender struct {
 	logserviceLsn   uint64
 	entry           *recordEntry
 	contextDuration time.Duration
+	compressAlg     uint8
+	compressLevel   int
 	wg              sync.WaitGroup //wait client
 }
 
@@ -44,7 +46,7 @@ func (a *driverAppender) appendEntry(e *entry.Entry) {
 }
 
 func (a *driverAppender) append(retryTimout, appendTimeout time.Duration) {
-	size := a.entry.prepareRecord()
+	size := a.entry.prepareRecord(a.compressAlg, a.compressLevel)
 	// if size > int(common.K)*20 { //todo
 	// 	panic(moerr.NewInternalError("record size %d, larger than max size 20K", size))
 	// }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/lni/vfs"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
 
@@ -118,6 +119,43 @@ func TestReplay1(t *testing.T) {
 	driver.Close()
 }
 
+func TestReplayCompressed(t *testing.T) {
+	service, ccfg := initTest(t)
+	defer service.Close()
+
+	cfg := NewTestConfig(ccfg)
+	cfg.Compression = compress.Zstd
+	driver := NewLogServiceDriver(cfg)
+
+	entryCount := 1000
+	entries := make([]*entry.Entry, entryCount)
+
+	for i := 0; i < entryCount; i++ {
+		payload := []byte(fmt.Sprintf("payload %d", i))
+		e := entry.MockEntryWithPayload(payload)
+		driver.Append(e)
+		entries[i] = e
+	}
+
+	for _, e := range entries {
+		e.WaitDone()
+	}
+
+	replayed := 0
+	driver = restartDriver(t, driver, func(e *entry.Entry) {
+		payload := []byte(fmt.Sprintf("payload %d", e.Lsn-1))
+		assert.Equal(t, payload, e.Entry.GetPayload())
+		replayed++
+	})
+	assert.Equal(t, entryCount, replayed)
+
+	for _, e := range entries {
+		e.Entry.Free()
+	}
+
+	driver.Close()
+}
+
 func TestReplay2(t *testing.T) {
 	t.Skip("debug")
 
@@ -21,6 +21,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -38,6 +39,9 @@ const (
 
 const (
 	IOET_WALRecord_V1 uint16 = 1
+	// IOET_WALRecord_V2 is the compressed IOET_WALRecord_V1, the layout is
+	// | alg | originSize | compressed V1 record without the header |
+	IOET_WALRecord_V2 uint16 = 2
 	IOET_WALRecord    uint16 = 1000
 
 	IOET_WALRecord_CurrVer = IOET_WALRecord_V1
@@ -60,6 +64,26 @@ func init() {
 			return record, err
 		},
 	)
+	objectio.RegisterIOEnrtyCodec(
+		objectio.IOEntryHeader{
+			Type:    IOET_WALRecord,
+			Version: IOET_WALRecord_V2,
+		},
+		nil,
+		func(b []byte) (any, error) {
+			alg := types.DecodeUint8(b[:1])
+			originSize := types.DecodeUint32(b[1:5])
+			buf, err := compress.Decompress(b[5:], make([]byte, originSize), int(alg))
+			if err != nil {
+				return nil, err
+			}
+			record := &baseEntry{
+				meta: &meta{},
+			}
+			err = record.Unmarshal(buf)
+			return record, err
+		},
+	)
 }
 
 type meta struct {
@@ -302,15 +326,38 @@ func (r *recordEntry) append(e *entry.Entry) {
 	r.payloadSize += uint64(e.GetSize())
 }
 
-func (r *recordEntry) prepareRecord() (size int) {
+func (r *recordEntry) prepareRecord(alg uint8, level int) (size int) {
 	var err error
 	r.payload, err = r.Marshal()
 	if err != nil {
 		panic(err)
 	}
+	if alg != compress.None {
+		r.payload, err = compressRecord(r.payload, alg, level)
+		if err != nil {
+			panic(err)
+		}
+	}
 	return len(r.payload)
 }
 
+// compressRecord converts the IOET_WALRecord_V1 record to IOET_WALRecord_V2.
+func compressRecord(record []byte, alg uint8, level int) ([]byte, error) {
+	body := record[objectio.IOEntryHeaderSize:]
+	originSize := uint32(len(body))
+	compressed, err := compress.CompressWithLevel(
+		body, make([]byte, compress.CompressBlockBound(len(body), int(alg))), int(alg), level)
+	if err != nil {
+		return nil, err
+	}
+	h := objectio.IOEntryHeader{Type: IOET_WALRecord, Version: IOET_WALRecord_V2}
+	buf := make([]byte, 0, objectio.IOEntryHeaderSize+5+len(compressed))
+	buf = append(buf, objectio.EncodeIOEntryHeader(&h)...)
+	buf = append(buf, types.EncodeUint8(&alg)...)
+	buf = append(buf, types.EncodeUint32(&originSize)...)
+	return append(buf, compressed...), nil
+}
+
 func (r *recordEntry) unmarshal() {
 	if r.unmarshaled.Load() == 1 {
 		return
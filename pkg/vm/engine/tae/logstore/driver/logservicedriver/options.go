@@ -42,6 +42,11 @@ type Config struct {
 	GetTruncateDuration time.Duration
 	ReadDuration        time.Duration
 
+	// Compression is the algorithm compressing the records, and
+	// CompressionLevel is the level of zstd
+	Compression      uint8
+	CompressionLevel int
+
 	ClientFactory LogServiceClientFactory
 }
 
@@ -184,7 +184,7 @@ func (r *replayer) AppendSkipCmd(skipMap map[uint64]uint64) {
 	recordEntry := newRecordEntry()
 	recordEntry.meta.metaType = TReplay
 	recordEntry.cmd = cmd
-	size := recordEntry.prepareRecord()
+	size := recordEntry.prepareRecord(r.d.config.Compression, r.d.config.CompressionLevel)
 	c, lsn := r.d.getClient()
 	r.appended = append(r.appended, lsn)
 	c.TryResize(size)
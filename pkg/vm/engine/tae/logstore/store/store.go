@@ -46,8 +46,10 @@ type StoreImpl struct {
 	truncateQueue   sm.Queue
 }
 
-func NewStoreWithLogserviceDriver(factory logservicedriver.LogServiceClientFactory) Store {
+func NewStoreWithLogserviceDriver(factory logservicedriver.LogServiceClientFactory, compressAlg uint8, compressLevel int) Store {
 	cfg := logservicedriver.NewDefaultConfig(factory)
+	cfg.Compression = compressAlg
+	cfg.CompressionLevel = compressLevel
 	driver := logservicedriver.NewLogServiceDriver(cfg)
 	return NewStore(driver)
 }
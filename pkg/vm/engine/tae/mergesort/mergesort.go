@@ -84,11 +84,11 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		Sort(cols[pk], numericLess[int64], sortedIdx)
 	case types.T_uint8:
 		Sort(cols[pk], numericLess[uint8], sortedIdx)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		Sort(cols[pk], numericLess[uint16], sortedIdx)
 	case types.T_uint32:
 		Sort(cols[pk], numericLess[uint32], sortedIdx)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		Sort(cols[pk], numericLess[uint64], sortedIdx)
 	case types.T_float32:
 		Sort(cols[pk], numericLess[float32], sortedIdx)
@@ -114,7 +114,7 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		Sort(cols[pk], rowidLess, sortedIdx)
 	case types.T_Blockid:
 		Sort(cols[pk], blockidLess, sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar,
+	case types.T_char, types.T_json, types.T_array_float32, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		Sort(cols[pk], bytesLess, sortedIdx)
 	default:
@@ -144,11 +144,11 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = Merge(column, sortedIdx, numericLess[int64], fromLayout, toLayout)
 	case types.T_uint8:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint8], fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint16], fromLayout, toLayout)
 	case types.T_uint32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint32], fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint64], fromLayout, toLayout)
 	case types.T_float32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[float32], fromLayout, toLayout)
@@ -174,7 +174,7 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = Merge(column, sortedIdx, rowidLess, fromLayout, toLayout)
 	case types.T_Blockid:
 		ret, mapping = Merge(column, sortedIdx, blockidLess, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar,
+	case types.T_char, types.T_json, types.T_array_float32, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		ret, mapping = Merge(column, sortedIdx, bytesLess, fromLayout, toLayout)
 	default:
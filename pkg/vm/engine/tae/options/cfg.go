@@ -38,6 +38,13 @@ type StorageCfg struct {
 	SegmentMaxBlocks uint16 `toml:"segment-max-blocks"`
 }
 
+// CompressionCfg is the compression of the objects, the checkpoint files and
+// the WAL entries
+type CompressionCfg struct {
+	Alg   uint8 `toml:"alg"`
+	Level int   `toml:"level"`
+}
+
 type CheckpointCfg struct {
 	FlushInterval             time.Duration `toml:"flush-inerterval"`
 	MinCount                  int64         `toml:"checkpoint-min-count"`
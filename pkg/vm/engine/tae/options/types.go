@@ -17,6 +17,7 @@ package options
 import (
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -71,6 +72,9 @@ type Options struct {
 	CatalogCfg    *CatalogCfg
 	Catalog       *catalog.Catalog
 
+	// CompressionCfg is nil if the default compression is used
+	CompressionCfg *CompressionCfg `toml:"compression-cfg"`
+
 	TransferTableTTL time.Duration
 
 	Clock     clock.Clock
@@ -78,4 +82,8 @@ type Options struct {
 	Lc        logservicedriver.LogServiceClientFactory
 	Shard     metadata.DNShard
 	LogStoreT LogstoreType
+
+	// RestoreTS is not empty when the DB is restored from a backup, the
+	// txns committed after it are skipped in the replay
+	RestoreTS types.TS
 }
@@ -372,6 +372,27 @@ func (h *Handle) HandleForceCheckpoint(
 	return err
 }
 
+func (h *Handle) HandleBackup(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.Backup,
+	resp *db.BackupResp) (err error) {
+	fs, dir, err := db.GetBackupFS(req.Target)
+	if err != nil {
+		return err
+	}
+	backup, err := h.db.Backup(ctx, fs, dir)
+	if err != nil {
+		return err
+	}
+	resp.StartTS = backup.StartTS
+	resp.EndTS = backup.EndTS
+	resp.Checkpoints = len(backup.Checkpoints)
+	resp.Objects = len(backup.Objects)
+	resp.LogRecords = backup.LogRecords
+	return nil
+}
+
 func (h *Handle) HandleInspectDN(
 	ctx context.Context,
 	meta txn.TxnMeta,
@@ -575,6 +596,12 @@ func (h *Handle) HandlePreCommitWrite(
 					return err
 				}
 			}
+		case []*apipb.AlterTableReq:
+			for _, req := range cmds {
+				if err = h.CacheTxnRequest(ctx, meta, req, nil); err != nil {
+					return err
+				}
+			}
 		case []catalog.DropDatabase:
 			for _, cmd := range cmds {
 				req := &db.DropDatabaseReq{
@@ -1726,3 +1726,86 @@ func TestHandle_MVCCVisibility(t *testing.T) {
 	assert.Nil(t, err)
 	wg.Wait()
 }
+
+func TestHandle_HandlePreCommitAlterTable(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	handle := mockTAEHandle(t, opts)
+	defer handle.HandleClose(context.TODO())
+	IDAlloc := catalog.NewIDAllocator()
+	schema := catalog.MockSchema(3, 0)
+	schema.Name = "tbtest"
+	dbName := "dbtest"
+	ac := AccessInfo{}
+
+	//create db and table
+	dbId := IDAlloc.NextDB()
+	createDbEntries, err := makeCreateDatabaseEntries("", ac, dbName, dbId, handle.m)
+	assert.Nil(t, err)
+	defs, err := SchemaToDefs(schema)
+	assert.Nil(t, err)
+	tbId := IDAlloc.NextTable()
+	createTbEntries, err := makeCreateTableEntries("", ac, schema.Name, tbId, dbId, dbName, schema.Constraint, handle.m, defs)
+	assert.Nil(t, err)
+	createTxn := mock1PCTxn(handle.db)
+	err = handle.HandlePreCommit(context.TODO(), createTxn,
+		&api.PrecommitWriteCmd{EntryList: append(createDbEntries, createTbEntries...)},
+		new(api.SyncLogTailResp))
+	assert.Nil(t, err)
+	_, err = handle.HandleCommit(context.TODO(), createTxn)
+	assert.Nil(t, err)
+
+	//rename column mock_1 and widen column mock_2
+	reqs := []*api.AlterTableReq{
+		api.NewRenameColumnReq(dbId, tbId, "mock_1", "renamed", 1),
+		api.NewModifyColumnReq(dbId, tbId, 2, &plan.ColDef{
+			Name:    "mock_2",
+			Typ:     types.NewProtoType(types.T_int32),
+			Default: &plan.Default{NullAbility: true},
+			Comment: "modified",
+		}),
+	}
+	bat := batch.NewWithSize(5)
+	bat.Attrs = append(bat.Attrs, catalog2.MoTablesSchema[:4]...)
+	bat.Attrs = append(bat.Attrs, catalog2.SystemRelAttr_Constraint)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.NewVec(catalog2.MoTablesTypes[i])
+	}
+	for _, req := range reqs {
+		data, err := req.Marshal()
+		assert.Nil(t, err)
+		assert.Nil(t, vector.AppendFixed(bat.Vecs[catalog2.MO_TABLES_REL_ID_IDX], tbId, false, handle.m))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[catalog2.MO_TABLES_REL_NAME_IDX], []byte(schema.Name), false, handle.m))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[catalog2.MO_TABLES_RELDATABASE_IDX], []byte(dbName), false, handle.m))
+		assert.Nil(t, vector.AppendFixed(bat.Vecs[catalog2.MO_TABLES_RELDATABASE_ID_IDX], dbId, false, handle.m))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[catalog2.MO_TABLES_ALTER_TABLE], data, false, handle.m))
+	}
+	pbBat, err := toPBBatch(bat)
+	assert.Nil(t, err)
+	alterTxn := mock1PCTxn(handle.db)
+	err = handle.HandlePreCommit(context.TODO(), alterTxn,
+		&api.PrecommitWriteCmd{EntryList: []*api.Entry{{
+			EntryType:    api.Entry_Alter,
+			Bat:          pbBat,
+			TableId:      catalog2.MO_TABLES_ID,
+			DatabaseId:   catalog2.MO_CATALOG_ID,
+			TableName:    catalog2.MO_TABLES,
+			DatabaseName: catalog2.MO_CATALOG,
+		}}},
+		new(api.SyncLogTailResp))
+	assert.Nil(t, err)
+	_, err = handle.HandleCommit(context.TODO(), alterTxn)
+	assert.Nil(t, err)
+
+	txn, err := handle.db.StartTxn(nil)
+	assert.NoError(t, err)
+	dbH, err := txn.GetDatabase(dbName)
+	assert.NoError(t, err)
+	tbH, err := dbH.GetRelationByName(schema.Name)
+	assert.NoError(t, err)
+	newSchema := tbH.Schema().(*catalog.Schema)
+	assert.Equal(t, 1, newSchema.GetColIdx("renamed"))
+	assert.Equal(t, uint16(1), newSchema.GetSeqnum("renamed"))
+	assert.Equal(t, "modified", newSchema.ColDefs[2].Comment)
+	assert.NoError(t, txn.Commit())
+}
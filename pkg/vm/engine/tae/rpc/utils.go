@@ -171,6 +171,7 @@ func AttrFromColDef(col *catalog.ColDef) (attrs *engine.Attribute, err error) {
 		OnUpdate:      onUpdate,
 		AutoIncrement: col.IsAutoIncrement(),
 		ClusterBy:     col.IsClusterBy(),
+		EnumValues:    col.EnumValues,
 	}
 	return attr, nil
 }
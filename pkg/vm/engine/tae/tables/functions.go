@@ -36,6 +36,10 @@ var dedupNABlkFunctions = map[types.T]any{
 	types.T_uint16:     dedupNABlkOrderedFunc[uint16],
 	types.T_uint32:     dedupNABlkOrderedFunc[uint32],
 	types.T_uint64:     dedupNABlkOrderedFunc[uint64],
+	types.T_bit:        dedupNABlkOrderedFunc[uint64],
+	types.T_year:       dedupNABlkOrderedFunc[uint16],
+	types.T_enum:       dedupNABlkOrderedFunc[uint16],
+	types.T_set:        dedupNABlkOrderedFunc[uint64],
 	types.T_float32:    dedupNABlkOrderedFunc[float32],
 	types.T_float64:    dedupNABlkOrderedFunc[float64],
 	types.T_timestamp:  dedupNABlkOrderedFunc[types.Timestamp],
@@ -50,13 +54,14 @@ var dedupNABlkFunctions = map[types.T]any{
 	types.T_Blockid:    dedupNABlkFuncFactory(types.CompareBlockidBlockidAligned),
 	types.T_uuid:       dedupNABlkFuncFactory(types.CompareUuid),
 
-	types.T_char:      dedupNABlkBytesFunc,
-	types.T_varchar:   dedupNABlkBytesFunc,
-	types.T_blob:      dedupNABlkBytesFunc,
-	types.T_binary:    dedupNABlkBytesFunc,
-	types.T_varbinary: dedupNABlkBytesFunc,
-	types.T_json:      dedupNABlkBytesFunc,
-	types.T_text:      dedupNABlkBytesFunc,
+	types.T_char:          dedupNABlkBytesFunc,
+	types.T_varchar:       dedupNABlkBytesFunc,
+	types.T_blob:          dedupNABlkBytesFunc,
+	types.T_binary:        dedupNABlkBytesFunc,
+	types.T_varbinary:     dedupNABlkBytesFunc,
+	types.T_json:          dedupNABlkBytesFunc,
+	types.T_array_float32: dedupNABlkBytesFunc,
+	types.T_text:          dedupNABlkBytesFunc,
 }
 
 var dedupAlkFunctions = map[types.T]any{
@@ -69,6 +74,10 @@ var dedupAlkFunctions = map[types.T]any{
 	types.T_uint16:     dedupABlkFuncFactory(compute.CompareOrdered[uint16]),
 	types.T_uint32:     dedupABlkFuncFactory(compute.CompareOrdered[uint32]),
 	types.T_uint64:     dedupABlkFuncFactory(compute.CompareOrdered[uint64]),
+	types.T_bit:        dedupABlkFuncFactory(compute.CompareOrdered[uint64]),
+	types.T_year:       dedupABlkFuncFactory(compute.CompareOrdered[uint16]),
+	types.T_enum:       dedupABlkFuncFactory(compute.CompareOrdered[uint16]),
+	types.T_set:        dedupABlkFuncFactory(compute.CompareOrdered[uint64]),
 	types.T_float32:    dedupABlkFuncFactory(compute.CompareOrdered[float32]),
 	types.T_float64:    dedupABlkFuncFactory(compute.CompareOrdered[float64]),
 	types.T_timestamp:  dedupABlkFuncFactory(compute.CompareOrdered[types.Timestamp]),
@@ -83,13 +92,14 @@ var dedupAlkFunctions = map[types.T]any{
 	types.T_Blockid:    dedupABlkFuncFactory(types.CompareBlockidBlockidAligned),
 	types.T_uuid:       dedupABlkFuncFactory(types.CompareUuid),
 
-	types.T_char:      dedupABlkBytesFunc,
-	types.T_varchar:   dedupABlkBytesFunc,
-	types.T_blob:      dedupABlkBytesFunc,
-	types.T_binary:    dedupABlkBytesFunc,
-	types.T_varbinary: dedupABlkBytesFunc,
-	types.T_json:      dedupABlkBytesFunc,
-	types.T_text:      dedupABlkBytesFunc,
+	types.T_char:          dedupABlkBytesFunc,
+	types.T_varchar:       dedupABlkBytesFunc,
+	types.T_blob:          dedupABlkBytesFunc,
+	types.T_binary:        dedupABlkBytesFunc,
+	types.T_varbinary:     dedupABlkBytesFunc,
+	types.T_json:          dedupABlkBytesFunc,
+	types.T_array_float32: dedupABlkBytesFunc,
+	types.T_text:          dedupABlkBytesFunc,
 }
 
 func parseNADedeupArgs(args ...any) (vec *vector.Vector, mask *roaring.Bitmap, def *catalog.ColDef) {
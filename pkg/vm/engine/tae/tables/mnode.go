@@ -17,6 +17,7 @@ package tables
 import (
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -127,13 +128,33 @@ func (node *memoryNode) GetColumnDataWindow(
 	to uint32,
 	col int,
 ) (vec containers.Vector, err error) {
-	idx, ok := node.writeSchema.SeqnumMap[readSchema.ColDefs[col].SeqNum]
+	def := readSchema.ColDefs[col]
+	idx, ok := node.writeSchema.SeqnumMap[def.SeqNum]
 	if !ok {
-		return containers.FillConstVector(int(to-from), readSchema.ColDefs[col].Type, nil), nil
+		return containers.FillConstVector(int(to-from), def.Type, def.FillValue()), nil
 	}
 	data := node.data.Vecs[idx]
 	vec = data.CloneWindow(int(from), int(to-from), common.DefaultAllocator)
-	return
+	return widenDNVector(vec, def.Type)
+}
+
+// widenDNVector returns the values of vec read as the type typ, the type of the column
+// may be widened after vec is written, see containers.WidenCNVector.
+func widenDNVector(vec containers.Vector, typ types.Type) (containers.Vector, error) {
+	if vec.GetType().Oid == typ.Oid || !types.IsWidening(*vec.GetType(), typ) {
+		return vec, nil
+	}
+	if types.IsInPlaceWidening(*vec.GetType(), typ) {
+		// vec is cloned from the node, so it's retyped in place.
+		vec.GetDownstreamVector().SetType(typ)
+		return vec, nil
+	}
+	defer vec.Close()
+	res, err := containers.WidenCNVector(vec.GetDownstreamVector(), typ, common.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	return containers.ToDNVector(res), nil
 }
 
 func (node *memoryNode) GetDataWindowOnWriteSchema(
@@ -160,9 +181,13 @@ func (node *memoryNode) GetDataWindow(
 		idx, ok := node.writeSchema.SeqnumMap[col.SeqNum]
 		var vec containers.Vector
 		if !ok {
-			vec = containers.FillConstVector(int(from-to), col.Type, nil)
+			vec = containers.FillConstVector(int(to-from), col.Type, col.FillValue())
 		} else {
 			vec = node.data.Vecs[idx].CloneWindow(int(from), int(to-from), common.DefaultAllocator)
+			if vec, err = widenDNVector(vec, col.Type); err != nil {
+				bat.Close()
+				return nil, err
+			}
 		}
 		bat.AddVector(col.Name, vec)
 	}
@@ -38,7 +38,15 @@ func LoadPersistedColumnData(
 	if def.IsPhyAddr() {
 		return model.PreparePhyAddrData(&id.BlockID, 0, location.Rows())
 	}
-	bat, err := blockio.LoadColumns(context.Background(), []uint16{uint16(def.SeqNum)}, []types.Type{def.Type}, fs.Service, location, nil)
+	bat, err := blockio.LoadColumnsWithFills(
+		context.Background(),
+		[]uint16{uint16(def.SeqNum)},
+		[]types.Type{def.Type},
+		[]any{def.FillValue()},
+		fs.Service,
+		location,
+		nil,
+	)
 	if err != nil {
 		return
 	}
@@ -97,7 +97,7 @@ func InsertOp[T comparable](
 func (idx *simpleTableIndex) KeyToVector(kType types.Type) containers.Vector {
 	vec := containers.MakeVector(kType)
 	switch kType.Oid {
-	case types.T_char, types.T_varchar, types.T_json,
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		for k := range idx.tree {
 			vec.Append([]byte(k.(string)), false)
@@ -114,7 +114,7 @@ func (idx *simpleTableIndex) KeyToVectors(kType types.Type) []containers.Vector
 	vec := containers.MakeVector(kType)
 	var vecs []containers.Vector
 	switch kType.Oid {
-	case types.T_char, types.T_varchar, types.T_json,
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		for k := range idx.tree {
 			if vec.Length() > int(MaxNodeRows) {
@@ -216,13 +216,13 @@ func (idx *simpleTableIndex) BatchInsert(
 	case types.T_uint8:
 		vs := vector.MustFixedCol[uint8](col.GetDownstreamVector())
 		return InsertOp(colType, attr, vs, start, count, row, dedupInput, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		vs := vector.MustFixedCol[uint16](col.GetDownstreamVector())
 		return InsertOp(colType, attr, vs, start, count, row, dedupInput, idx.tree)
 	case types.T_uint32:
 		vs := vector.MustFixedCol[uint32](col.GetDownstreamVector())
 		return InsertOp(colType, attr, vs, start, count, row, dedupInput, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		vs := vector.MustFixedCol[uint64](col.GetDownstreamVector())
 		return InsertOp(colType, attr, vs, start, count, row, dedupInput, idx.tree)
 	case types.T_decimal64:
@@ -261,7 +261,7 @@ func (idx *simpleTableIndex) BatchInsert(
 	case types.T_Blockid:
 		vs := vector.MustFixedCol[types.Blockid](col.GetDownstreamVector())
 		return InsertOp(colType, attr, vs, start, count, row, dedupInput, idx.tree)
-	case types.T_char, types.T_varchar, types.T_json,
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		vec := col.GetDownstreamVector()
 		if dedupInput {
@@ -315,13 +315,13 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 	case types.T_uint8:
 		vals := vector.MustFixedCol[uint8](col.GetDownstreamVector())
 		return DedupOp(colType, attr, vals, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_year, types.T_enum:
 		vals := vector.MustFixedCol[uint16](col.GetDownstreamVector())
 		return DedupOp(colType, attr, vals, idx.tree)
 	case types.T_uint32:
 		vals := vector.MustFixedCol[uint32](col.GetDownstreamVector())
 		return DedupOp(colType, attr, vals, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit, types.T_set:
 		vals := vector.MustFixedCol[uint64](col.GetDownstreamVector())
 		return DedupOp(colType, attr, vals, idx.tree)
 	case types.T_decimal64:
@@ -357,7 +357,7 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 	case types.T_Blockid:
 		vals := vector.MustFixedCol[types.Blockid](col.GetDownstreamVector())
 		return DedupOp(colType, attr, vals, idx.tree)
-	case types.T_char, types.T_varchar, types.T_json,
+	case types.T_char, types.T_varchar, types.T_json, types.T_array_float32,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		vec := col.GetDownstreamVector()
 		for i := 0; i < col.Length(); i++ {
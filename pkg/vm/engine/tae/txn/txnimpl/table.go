@@ -829,7 +829,10 @@ func (tbl *txnTable) AlterTable(ctx context.Context, req *apipb.AlterTableReq) e
 		apipb.AlterKind_UpdateComment,
 		apipb.AlterKind_AddColumn,
 		apipb.AlterKind_DropColumn,
-		apipb.AlterKind_RenameTable:
+		apipb.AlterKind_RenameTable,
+		apipb.AlterKind_RenameColumn,
+		apipb.AlterKind_ModifyColumn,
+		apipb.AlterKind_UpdatePartition:
 	default:
 		return moerr.NewNYI(ctx, "alter table %s", req.Kind.String())
 	}
@@ -40,9 +40,9 @@ type walDriver struct {
 	wg            sync.WaitGroup
 }
 
-func NewDriverWithLogservice(factory logservicedriver.LogServiceClientFactory) Driver {
+func NewDriverWithLogservice(factory logservicedriver.LogServiceClientFactory, compressAlg uint8, compressLevel int) Driver {
 	ckpDuration := time.Second * 5
-	impl := store.NewStoreWithLogserviceDriver(factory)
+	impl := store.NewStoreWithLogserviceDriver(factory, compressAlg, compressLevel)
 	driver := NewDriverWithStore(impl, true, ckpDuration)
 	return driver
 }
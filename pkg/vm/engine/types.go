@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -68,6 +69,8 @@ type Attribute struct {
 	AutoIncrement bool
 	// Seqnum, do not change during the whole lifetime of the table
 	Seqnum uint16
+	// EnumValues is the members of enum and set types
+	EnumValues string
 }
 
 type PropertiesDef struct {
@@ -103,6 +106,10 @@ func (node IndexT) ToString() string {
 		return "ZONEMAP"
 	case BsiIndex:
 		return "BSI"
+	case FullTextIndex:
+		return "FULLTEXT"
+	case IvfFlatIndex:
+		return "IVFFLAT"
 	default:
 		return "INVAILD"
 	}
@@ -112,6 +119,8 @@ const (
 	Invalid IndexT = iota
 	ZoneMap
 	BsiIndex
+	FullTextIndex
+	IvfFlatIndex
 )
 
 type AttributeDef struct {
@@ -536,6 +545,9 @@ type Relation interface {
 	// only ConstraintDef can be modified
 	UpdateConstraint(context.Context, *ConstraintDef) error
 
+	// AlterTable changes the columns of the table, the requests are applied in order
+	AlterTable(context.Context, []*api.AlterTableReq) error
+
 	GetTableID(context.Context) uint64
 
 	// second argument is the number of reader, third argument is the filter extend, foruth parameter is the payload required by the engine
@@ -549,6 +561,11 @@ type Relation interface {
 	GetEngineType() EngineType
 
 	GetMetadataScanInfoBytes(ctx context.Context, name string) ([][]byte, error)
+
+	// GetChanges returns the rows inserted into the table after the timestamp
+	// from until the snapshot of the transaction, ErrTableChangesUnavailable
+	// is returned if the changes can't be read, e.g. rows have been deleted.
+	GetChanges(ctx context.Context, from timestamp.Timestamp) ([]*batch.Batch, error)
 }
 
 type Reader interface {
@@ -615,6 +632,9 @@ type VectorPool interface {
 
 type Hints struct {
 	CommitOrRollbackTimeout time.Duration
+	// SnapshotRetention is how long the history versions are kept to be read
+	// by AS OF TIMESTAMP, zero means no limit.
+	SnapshotRetention time.Duration
 }
 
 // EntireEngine is a wrapper for Engine to support temporary table
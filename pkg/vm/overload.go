@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/indexdelete"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersectall"
@@ -54,6 +55,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/rightanti"
@@ -62,7 +64,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/trigger"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -119,6 +123,14 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	TableFunction: table_function.String,
 
 	LockOp: lockop.String,
+
+	Window: window.String,
+
+	RecursiveCte: recursivecte.String,
+
+	Trigger: trigger.String,
+
+	IndexDelete: indexdelete.String,
 }
 
 var prepareFunc = [...]func(*process.Process, any) error{
@@ -174,6 +186,14 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	TableFunction: table_function.Prepare,
 
 	LockOp: lockop.Prepare,
+
+	Window: window.Prepare,
+
+	RecursiveCte: recursivecte.Prepare,
+
+	Trigger: trigger.Prepare,
+
+	IndexDelete: indexdelete.Prepare,
 }
 
 var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
@@ -230,4 +250,12 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 	TableFunction: table_function.Call,
 
 	LockOp: lockop.Call,
+
+	Window: window.Call,
+
+	RecursiveCte: recursivecte.Call,
+
+	Trigger: trigger.Call,
+
+	IndexDelete: indexdelete.Call,
 }
@@ -51,6 +51,7 @@ func New(
 		LastInsertID: new(uint64),
 		LockService:  lockService,
 		Aicm:         aicm,
+		spillMemory:  new(atomic.Int64),
 		vp: &vectorPool{
 			vecs: make(map[uint8][]*vector.Vector),
 		},
@@ -83,6 +84,7 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	proc.LockService = p.LockService
 	proc.Aicm = p.Aicm
 	proc.LoadTag = p.LoadTag
+	proc.spillMemory = p.spillMemory
 
 	// reg and cancel
 	proc.Ctx = newctx
@@ -155,6 +157,24 @@ func (proc *Process) OperatorOutofMemory(size int64) bool {
 	return proc.Mp().Cap() < size
 }
 
+// GrowSpillMemory records that n more bytes are held by a spillable operator,
+// it returns false if the memory held by the spillable operators of the query
+// is over Lim.SpillSize, and the operator should spill its state to disk.
+func (proc *Process) GrowSpillMemory(n int64) bool {
+	if proc.Lim.SpillSize <= 0 || proc.spillMemory == nil {
+		return true
+	}
+	return proc.spillMemory.Add(n) <= proc.Lim.SpillSize
+}
+
+// ReleaseSpillMemory records that n bytes are released by a spillable operator.
+func (proc *Process) ReleaseSpillMemory(n int64) {
+	if proc.Lim.SpillSize <= 0 || proc.spillMemory == nil {
+		return
+	}
+	proc.spillMemory.Add(-n)
+}
+
 func (proc *Process) SetInputBatch(bat *batch.Batch) {
 	proc.Reg.InputBatch = bat
 }
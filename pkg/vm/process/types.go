@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -48,6 +50,7 @@ type Analyze interface {
 	Network(*batch.Batch)
 	AddScanTime(t time.Time)
 	AddInsertTime(t time.Time)
+	Spill(size int64)
 }
 
 // WaitRegister channel
@@ -82,6 +85,9 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// SpillSize, memory budget of the query for the state of the hash join,
+	// group by and order by, the operators spill to disk over it. 0 for no limit.
+	SpillSize int64
 }
 
 // SessionInfo session information
@@ -140,6 +146,10 @@ type AnalyzeInfo struct {
 	ScanTime int64
 	// InsertTime, insert cost time in load flow
 	InsertTime int64
+	// SpillCount, number of files spilled to disk
+	SpillCount int64
+	// SpillSize, data size spilled to disk
+	SpillSize int64
 }
 
 // Process contains context used in query execution
@@ -182,6 +192,9 @@ type Process struct {
 	DispatchNotifyCh chan WrapCs
 
 	Aicm *defines.AutoIncrCacheManager
+
+	// spillMemory is the memory held by the spillable operators of the query.
+	spillMemory *atomic.Int64
 }
 
 type vectorPool struct {
@@ -191,6 +204,9 @@ type vectorPool struct {
 
 type sqlHelper interface {
 	ExecSql(string) ([]interface{}, error)
+	// ExecTrigger runs the body of the trigger for a row whose NEW and OLD
+	// values are given by the vars, the NEW values are updated in place.
+	ExecTrigger(*plan.TriggerDef, map[string]interface{}) error
 }
 
 type WrapCs struct {
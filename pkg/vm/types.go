@@ -73,6 +73,14 @@ const (
 	Right
 	OnDuplicateKey
 	PreInsert
+	Window
+	RecursiveCte
+	// Trigger runs the row-level triggers of the rows changed by the DML
+	// operator next to it.
+	Trigger
+	// IndexDelete deletes the rows of the index tables joined by the primary
+	// key, and passes every row changed by the DML operator behind once.
+	IndexDelete
 
 	// LastInstructionOp is not a true operator and must set at last.
 	// It was used by unit testing to ensure that
@@ -119,6 +127,8 @@ func (ins *Instruction) IsBrokenNode() bool {
 		return true
 	case Top, MergeTop:
 		return true
+	case Window, RecursiveCte:
+		return true
 	}
 	return false
 }